@@ -148,6 +148,7 @@ import (
 	"github.com/provenance-io/provenance/x/exchange"
 	exchangekeeper "github.com/provenance-io/provenance/x/exchange/keeper"
 	exchangemodule "github.com/provenance-io/provenance/x/exchange/module"
+	exchangewasm "github.com/provenance-io/provenance/x/exchange/wasm"
 	"github.com/provenance-io/provenance/x/hold"
 	holdkeeper "github.com/provenance-io/provenance/x/hold/keeper"
 	holdmodule "github.com/provenance-io/provenance/x/hold/module"
@@ -500,9 +501,6 @@ func New(
 		app.txConfig.TxDecoder(), interfaceRegistry,
 	)
 
-	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
-	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
-
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	restrictHooks := piohandlers.NewStakingRestrictionHooks(app.StakingKeeper, *piohandlers.DefaultRestrictionOptions)
 	app.StakingKeeper.SetHooks(
@@ -540,7 +538,7 @@ func New(
 		app.IbcHooks,
 	)
 
-	rateLimtingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil)
+	rateLimtingKeeper := ibcratelimitkeeper.NewKeeper(appCodec, keys[ibcratelimit.StoreKey], nil, app.BankKeeper)
 	app.RateLimitingKeeper = &rateLimtingKeeper
 
 	// Create Transfer Keepers
@@ -563,12 +561,14 @@ func New(
 	hooksTransferModule := ibchooks.NewIBCMiddleware(app.RateLimitMiddleware, &app.HooksICS4Wrapper)
 	app.TransferStack = &hooksTransferModule
 
-	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey])
+	app.NameKeeper = namekeeper.NewKeeper(appCodec, keys[nametypes.StoreKey], app.BankKeeper, app.DistrKeeper)
 
 	app.AttributeKeeper = attributekeeper.NewKeeper(
 		appCodec, keys[attributetypes.StoreKey], app.AccountKeeper, &app.NameKeeper,
 	)
 
+	app.MsgFeesKeeper.SetAttributeKeeper(app.AttributeKeeper)
+
 	markerReqAttrBypassAddrs := []sdk.AccAddress{
 		authtypes.NewModuleAddress(authtypes.FeeCollectorName),     // Allow collecting fees in restricted coins.
 		authtypes.NewModuleAddress(quarantine.ModuleName),          // Allow quarantine to hold onto restricted coins.
@@ -598,6 +598,14 @@ func New(
 		app.AccountKeeper, app.AttributeKeeper, app.BankKeeper, app.HoldKeeper, app.MarkerKeeper,
 	)
 
+	app.MsgFeesKeeper.AddUsdRateSource(msgfeestypes.UsdRateSourceMarkerNav, msgfeeskeeper.NewMarkerNavUsdRateSource(app.MarkerKeeper))
+	app.MsgFeesKeeper.AddUsdRateSource(msgfeestypes.UsdRateSourceExchange, msgfeeskeeper.NewExchangeUsdRateSource(app.ExchangeKeeper))
+	app.MsgFeesKeeper.SetSponsorKeepers(app.MarkerKeeper, app.ExchangeKeeper)
+
+	// The msg fees keeper needs the attribute, marker and exchange keepers, so the router gets it after those are set.
+	pioMsgFeesRouter := app.MsgServiceRouter().(*piohandlers.PioMsgServiceRouter)
+	pioMsgFeesRouter.SetMsgFeesKeeper(app.MsgFeesKeeper)
+
 	pioMessageRouter := MessageRouterFunc(func(msg sdk.Msg) baseapp.MsgServiceHandler {
 		return pioMsgFeesRouter.Handler(msg)
 	})
@@ -636,6 +644,7 @@ func New(
 	encoderRegistry.RegisterEncoder(markertypes.RouterKey, markerwasm.Encoder)
 	encoderRegistry.RegisterEncoder(metadatatypes.RouterKey, metadatawasm.Encoder)
 	encoderRegistry.RegisterEncoder(msgfeestypes.RouterKey, msgfeeswasm.Encoder)
+	encoderRegistry.RegisterEncoder(exchange.RouterKey, exchangewasm.Encoder)
 
 	// Init CosmWasm query integrations
 	querierRegistry := provwasm.NewQuerierRegistry()
@@ -643,6 +652,7 @@ func New(
 	querierRegistry.RegisterQuerier(attributetypes.RouterKey, attributewasm.Querier(app.AttributeKeeper))
 	querierRegistry.RegisterQuerier(markertypes.RouterKey, markerwasm.Querier(app.MarkerKeeper))
 	querierRegistry.RegisterQuerier(metadatatypes.RouterKey, metadatawasm.Querier(app.MetadataKeeper))
+	querierRegistry.RegisterQuerier(exchange.RouterKey, exchangewasm.Querier(app.ExchangeKeeper))
 
 	// Add the staking feature and indicate that provwasm contracts can be run on this chain.
 	// Addition of cosmwasm_1_1 adds capability defined here: https://github.com/CosmWasm/cosmwasm/pull/1356
@@ -846,6 +856,10 @@ func New(
 		stakingtypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		exchange.ModuleName,
+		hold.ModuleName,
+		markertypes.ModuleName,
+		nametypes.ModuleName,
 		triggertypes.ModuleName,
 	)
 
@@ -142,6 +142,32 @@ var upgrades = map[string]appUpgrade{
 			return vm, nil
 		},
 	},
+	"viridian-rc1": { // upgrade for v1.20.0-rc1
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			var err error
+			vm, err = runModuleMigrations(ctx, app, vm)
+			if err != nil {
+				return nil, err
+			}
+
+			removeInactiveValidatorDelegations(ctx, app)
+
+			return vm, nil
+		},
+	},
+	"viridian": { // upgrade for v1.20.0
+		Handler: func(ctx sdk.Context, app *App, vm module.VersionMap) (module.VersionMap, error) {
+			var err error
+			vm, err = runModuleMigrations(ctx, app, vm)
+			if err != nil {
+				return nil, err
+			}
+
+			removeInactiveValidatorDelegations(ctx, app)
+
+			return vm, nil
+		},
+	},
 	// TODO - Add new upgrade definitions here.
 }
 
@@ -23,6 +23,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	internalsdk "github.com/provenance-io/provenance/internal/sdk"
+	attributetypes "github.com/provenance-io/provenance/x/attribute/types"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 type UpgradeTestSuite struct {
@@ -429,6 +431,78 @@ func (s *UpgradeTestSuite) TestUmber() {
 	s.AssertUpgradeHandlerLogs("umber", expInLog, nil)
 }
 
+func (s *UpgradeTestSuite) TestViridianRC1() {
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Module migrations completed.",
+		"INF Removing inactive validator delegations.",
+		"INF Threshold: 21 days",
+		"INF A total of 0 inactive (unbonded) validators have had all their delegators removed.",
+	}
+
+	s.AssertUpgradeHandlerLogs("viridian-rc1", expInLog, nil)
+}
+
+func (s *UpgradeTestSuite) TestViridian() {
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Module migrations completed.",
+		"INF Removing inactive validator delegations.",
+		"INF Threshold: 21 days",
+		"INF A total of 0 inactive (unbonded) validators have had all their delegators removed.",
+	}
+
+	s.AssertUpgradeHandlerLogs("viridian", expInLog, nil)
+}
+
+func (s *UpgradeTestSuite) TestViridianMigratesAttributes() {
+	// The attribute module is at version 3 in this app, so we have to tell the handler it's at 2 to get it to migrate.
+	// The migration should then build the attribute value index.
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err, "GetModuleVersionMap")
+	vm[attributetypes.ModuleName] = 2
+
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Building attribute value index.",
+		"INF Done building attribute value index.",
+		"INF Module migrations completed.",
+	}
+
+	var newVM module.VersionMap
+	runner := func() {
+		newVM, err = upgrades["viridian"].Handler(s.ctx, s.app, vm)
+	}
+	s.ExecuteAndAssertLogs(runner, expInLog, nil, true, "viridian handler")
+	s.Require().NoError(err, "viridian handler error")
+	s.Assert().Equal(3, int(newVM[attributetypes.ModuleName]), "attribute module version after the viridian upgrade")
+}
+
+func (s *UpgradeTestSuite) TestViridianMigratesMarkerParams() {
+	// Chains from before the max nav report age param was added have it stored as zero.
+	params := s.app.MarkerKeeper.GetParams(s.ctx)
+	params.MaxNavReportAgeSeconds = 0
+	s.app.MarkerKeeper.SetParams(s.ctx, params)
+	vm, err := s.app.UpgradeKeeper.GetModuleVersionMap(s.ctx)
+	s.Require().NoError(err, "GetModuleVersionMap")
+	vm[markertypes.ModuleName] = 2
+
+	expInLog := []string{
+		"INF Starting module migrations. This may take a significant amount of time to complete. Do not restart node.",
+		"INF Setting marker max net asset value report age. seconds=86400",
+		"INF Module migrations completed.",
+	}
+
+	var newVM module.VersionMap
+	runner := func() {
+		newVM, err = upgrades["viridian"].Handler(s.ctx, s.app, vm)
+	}
+	s.ExecuteAndAssertLogs(runner, expInLog, nil, true, "viridian handler")
+	s.Require().NoError(err, "viridian handler error")
+	s.Assert().Equal(3, int(newVM[markertypes.ModuleName]), "marker module version after the viridian upgrade")
+	s.Assert().Equal(markertypes.DefaultMaxNavReportAgeSeconds, s.app.MarkerKeeper.GetParams(s.ctx).MaxNavReportAgeSeconds, "MaxNavReportAgeSeconds after the viridian upgrade")
+}
+
 func (s *UpgradeTestSuite) TestRemoveInactiveValidatorDelegations() {
 	addr1 := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1000000))
 	addr2 := s.CreateAndFundAccount(sdk.NewInt64Coin("stake", 1000000))
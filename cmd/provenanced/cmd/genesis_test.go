@@ -198,6 +198,14 @@ func fixEmptiesInExchangeGenState(exGenState *exchange.GenesisState) {
 	if exGenState.Payments == nil {
 		exGenState.Payments = make([]exchange.Payment, 0)
 	}
+
+	if exGenState.ConditionalOrders == nil {
+		exGenState.ConditionalOrders = make([]exchange.ConditionalOrder, 0)
+	}
+
+	if exGenState.Trades == nil {
+		exGenState.Trades = make([]exchange.Trade, 0)
+	}
 	for i, payment := range exGenState.Payments {
 		if payment.SourceAmount == nil {
 			exGenState.Payments[i].SourceAmount = make([]sdk.Coin, 0)
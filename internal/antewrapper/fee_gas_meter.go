@@ -29,6 +29,9 @@ type FeeGasMeter struct {
 	// tracks the total amount of fees per msg type url
 	usedFees map[string]sdk.Coins
 
+	// the itemized msg fees (including waived ones) in the order they were assessed
+	assessedFees []msgfeestypes.AssessedMsgFee
+
 	// this is the base fee charged in decorator
 	baseFeeCharged sdk.Coins
 
@@ -123,6 +126,16 @@ func (g *FeeGasMeter) ConsumeFee(amount sdk.Coins, msgType string, recipient str
 	g.feeCalls[key]++
 }
 
+// RecordAssessedFees records the itemized msg fees assessed for a msg, including any that were waived.
+func (g *FeeGasMeter) RecordAssessedFees(assessed ...msgfeestypes.AssessedMsgFee) {
+	g.assessedFees = append(g.assessedFees, assessed...)
+}
+
+// AssessedFees returns the itemized msg fees recorded so far.
+func (g *FeeGasMeter) AssessedFees() []msgfeestypes.AssessedMsgFee {
+	return g.assessedFees
+}
+
 func (g *FeeGasMeter) FeeConsumedForType(msgType string, recipient string) sdk.Coins {
 	return g.usedFees[msgfeestypes.GetCompositeKey(msgType, recipient)]
 }
@@ -155,6 +168,16 @@ func (g *FeeGasMeter) FeeConsumedByMsg() map[string]sdk.Coins {
 	return consumedByMsg
 }
 
+// FeeCallsByMsg returns the number of msg fee calls for each msg type and recipient.
+// The keys are the same as those of FeeConsumedByMsg.
+func (g *FeeGasMeter) FeeCallsByMsg() map[string]uint64 {
+	callsByMsg := make(map[string]uint64, len(g.feeCalls))
+	for key, calls := range g.feeCalls {
+		callsByMsg[key] = calls
+	}
+	return callsByMsg
+}
+
 func (g *FeeGasMeter) IsSimulate() bool {
 	return g.simulate
 }
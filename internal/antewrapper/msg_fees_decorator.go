@@ -68,7 +68,7 @@ func (mfd MsgFeesDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool
 			return ctx, sdkerrors.ErrInsufficientFee.Wrap(calcErr.Error())
 		}
 
-		mpErr := EnsureSufficientFloorAndMsgFees(ctx, feeCoins, floorGasPrice, gas, msgFeesDistribution.TotalAdditionalFees)
+		mpErr := EnsureSufficientFloorAndMsgFees(ctx, feeCoins, floorGasPrice, gas, msgFeesDistribution.TotalAdditionalFees, mfd.msgFeeKeeper)
 		if mpErr != nil && !simulate {
 			return ctx, sdkerrors.ErrInsufficientFee.Wrap(mpErr.Error())
 		}
@@ -86,15 +86,26 @@ func isTestContext(ctx sdk.Context) bool {
 
 // EnsureSufficientFloorAndMsgFees verifies that the given transaction has supplied
 // enough fees(gas + additional fees) to cover x/msgfees costs.
+// Any additional fees priced in usd are converted (using the usdConverter) into one of
+// the fee denoms that can be used to pay them before checking.
 //
 // Contract: This should only be called during CheckTx as it cannot be part of
 // consensus.
-func EnsureSufficientFloorAndMsgFees(ctx sdk.Context, feeCoins sdk.Coins, floorGasPrice sdk.Coin, gas uint64, additionalFees sdk.Coins) error {
+func EnsureSufficientFloorAndMsgFees(ctx sdk.Context, feeCoins sdk.Coins, floorGasPrice sdk.Coin, gas uint64, additionalFees sdk.Coins, usdConverter msgfeestypes.UsdFeeConverter) error {
 	// the isTestContext is exclusively for not breaking all existing sim tests which freak out when denom is anything other than stake.
 	if isTestContext(ctx) {
 		return nil
 	}
 
+	if usdAmount := additionalFees.AmountOf(msgfeestypes.UsdDenom); !usdAmount.IsZero() && usdConverter != nil {
+		usdFee := sdk.NewCoin(msgfeestypes.UsdDenom, usdAmount)
+		converted, err := usdConverter.ConvertUsdFee(ctx, usdFee, feeCoins)
+		if err != nil {
+			return sdkerrors.ErrInsufficientFee.Wrapf("could not convert %q additional fee: %v", usdFee, err)
+		}
+		additionalFees = additionalFees.Sub(usdFee).Add(converted)
+	}
+
 	var baseFee sdk.Coins
 	if !floorGasPrice.IsZero() {
 		baseFee = baseFee.Add(sdk.NewCoin(floorGasPrice.Denom, floorGasPrice.Amount.Mul(sdkmath.NewIntFromUint64(gas))))
@@ -12,6 +12,7 @@ import (
 
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	msgfeetype "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
 const (
@@ -137,6 +138,59 @@ func (s *AnteTestSuite) TestMsgFeesDecoratorWrongDenom() {
 	s.Assert().ErrorContains(err, `insufficient fee`)
 }
 
+// testUsdRateSource is a usd rate source that always provides the same rate.
+type testUsdRateSource struct {
+	rate *msgfeetype.UsdRate
+}
+
+func (t testUsdRateSource) GetUsdRate(_ sdk.Context, _ msgfeetype.UsdFeeDenom) (*msgfeetype.UsdRate, error) {
+	return t.rate, nil
+}
+
+// setUpUsdFeeApp sets up an antehandler with a usd msg fee that can be paid in usdstable, which has the provided rate.
+// The returned context is at block height 100.
+func setUpUsdFeeApp(s *AnteTestSuite, rate *msgfeetype.UsdRate) (sdk.AnteHandler, sdk.Context) {
+	antehandler := setUpApp(s, true, msgfeetype.UsdDenom, 500)
+	ctx := s.ctx.WithChainID("test-chain").WithBlockHeight(100)
+	params := s.app.MsgFeesKeeper.GetParams(ctx)
+	params.FloorGasPrice = sdk.NewInt64Coin(NHash, 0)
+	s.app.MsgFeesKeeper.SetParams(ctx, params)
+	s.app.MsgFeesKeeper.AddUsdRateSource("test", testUsdRateSource{rate: rate})
+	err := s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, []msgfeetype.UsdFeeDenom{msgfeetype.NewUsdFeeDenom("usdstable", "test", 0, 10)}, nil)
+	s.Require().NoError(err, "UpdateUsdFeeDenoms")
+	return antehandler, ctx
+}
+
+func (s *AnteTestSuite) TestMsgFeesDecoratorUsdFeePaidWithUsdFeeDenom() {
+	antehandler, ctx := setUpUsdFeeApp(s, msgfeetype.NewUsdRate(sdkmath.NewInt(1000), sdkmath.NewInt(2000), 95))
+	tx, _ := createTestTx(s, sdk.NewCoins(sdk.NewInt64Coin("usdstable", 1000)))
+
+	_, err := antehandler(ctx, tx, false)
+	s.Require().NoError(err, "antehandler")
+}
+
+func (s *AnteTestSuite) TestMsgFeesDecoratorUsdFeeNotEnoughUsdFeeDenom() {
+	antehandler, ctx := setUpUsdFeeApp(s, msgfeetype.NewUsdRate(sdkmath.NewInt(1000), sdkmath.NewInt(2000), 95))
+	tx, _ := createTestTx(s, sdk.NewCoins(sdk.NewInt64Coin("usdstable", 999)))
+
+	_, err := antehandler(ctx, tx, false)
+	s.Require().Error(err, "antehandler")
+	s.Assert().ErrorContains(err, `base fee + additional fee cannot be paid with provided fees: "999usdstable"`)
+	s.Assert().ErrorContains(err, `= ""(base-fee) + "1000usdstable"(additional-fees)`)
+}
+
+func (s *AnteTestSuite) TestMsgFeesDecoratorUsdFeeStaleRate() {
+	antehandler, ctx := setUpUsdFeeApp(s, msgfeetype.NewUsdRate(sdkmath.NewInt(1000), sdkmath.NewInt(2000), 89))
+	tx, _ := createTestTx(s, sdk.NewCoins(sdk.NewInt64Coin("usdstable", 1000)))
+
+	// With a stale rate, the usd fee is converted to the conversion fee denom using the nhash per usd mil param.
+	_, err := antehandler(ctx, tx, false)
+	s.Require().Error(err, "antehandler")
+	s.Assert().ErrorContains(err, `base fee + additional fee cannot be paid with provided fees: "1000usdstable"`)
+	expFee := sdk.NewCoin(s.app.MsgFeesKeeper.GetConversionFeeDenom(ctx), sdkmath.NewIntFromUint64(500*s.app.MsgFeesKeeper.GetNhashPerUsdMil(ctx)))
+	s.Assert().ErrorContains(err, fmt.Sprintf(`= ""(base-fee) + "%s"(additional-fees)`, expFee))
+}
+
 func createTestTx(s *AnteTestSuite, feeAmount sdk.Coins) (signing.Tx, sdk.AccountI) {
 	// keys and addresses
 	priv1, _, addr1 := testdata.KeyTestPubAddr()
@@ -208,6 +208,77 @@ func (s *AnteTestSuite) TestDeductFeesNoDelegation() {
 	}
 }
 
+func (s *AnteTestSuite) TestDeductFeesWithSponsorship() {
+	s.SetupTest(false)
+	app, ctx := s.app, s.ctx
+
+	protoTxCfg := tx.NewTxConfig(codec.NewProtoCodec(app.InterfaceRegistry()), tx.DefaultSignModes)
+	dfd := pioante.NewProvenanceDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.MsgFeesKeeper)
+	feeAnteHandler := sdk.ChainAnteDecorators(pioante.NewFeeMeterContextDecorator(), dfd)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, _, addr2 := testdata.KeyTestPubAddr()
+	_, _, sponsor := testdata.KeyTestPubAddr()
+
+	err := testutil.FundAccount(ctx, app.BankKeeper, sponsor, []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, defaultGas*10)})
+	s.Require().NoError(err, "funding sponsor")
+
+	// The sponsor pays for everyone's test msgs up to a budget of 3 txs, and at most 2 txs for each fee payer each day.
+	msgType := sdk.MsgTypeURL(testdata.NewTestMsg(addr1))
+	budget := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, defaultGas*3))
+	userCap := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, defaultGas*2))
+	err = app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, sponsor.String(), msgType, 0, "", budget, userCap)
+	s.Require().NoError(err, "UpdateFeeSponsorship")
+
+	cases := []struct {
+		name      string
+		signerKey cryptotypes.PrivKey
+		signer    sdk.AccAddress
+		expInErr  []string
+	}{
+		{name: "first tx for addr1", signerKey: priv1, signer: addr1},
+		{name: "second tx for addr1", signerKey: priv1, signer: addr1},
+		{
+			name:      "third tx for addr1",
+			signerKey: priv1,
+			signer:    addr1,
+			expInErr:  []string{"failed to use fee sponsorship", "sponsor: " + sponsor.String(), "fee payer: " + addr1.String(), "fee sponsorship limit exceeded"},
+		},
+		{name: "first tx for addr2", signerKey: priv2, signer: addr2},
+		{
+			name:      "second tx for addr2",
+			signerKey: priv2,
+			signer:    addr2,
+			expInErr:  []string{"failed to use fee sponsorship", "remaining budget", "fee sponsorship limit exceeded"},
+		},
+	}
+
+	for _, tc := range cases {
+		s.T().Run(tc.name, func(t *testing.T) {
+			fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, defaultGas))
+			msgs := []sdk.Msg{testdata.NewTestMsg(tc.signer)}
+			txfg, err := genTxWithFeeGranter(ctx, protoTxCfg, msgs, fee, defaultGas, ctx.ChainID(), []uint64{0}, []uint64{0}, sponsor, tc.signerKey)
+			require.NoError(t, err, "genTxWithFeeGranter")
+
+			_, err = feeAnteHandler(ctx, txfg, false)
+			if len(tc.expInErr) == 0 {
+				require.NoError(t, err, "feeAnteHandler")
+			} else {
+				require.Error(t, err, "feeAnteHandler")
+				for _, exp := range tc.expInErr {
+					assert.ErrorContains(t, err, exp, "feeAnteHandler err")
+				}
+			}
+		})
+	}
+
+	sponsorship, err := app.MsgFeesKeeper.GetFeeSponsorship(ctx, sponsor, msgType, 0, "")
+	s.Require().NoError(err, "GetFeeSponsorship")
+	s.Assert().Equal(budget.String(), sponsorship.Spent.String(), "sponsorship spent")
+	s.Assert().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, defaultGas*7).String(),
+		app.BankKeeper.GetBalance(ctx, sponsor, sdk.DefaultBondDenom).String(), "sponsor balance")
+}
+
 func genTxWithFeeGranter(ctx context.Context, gen client.TxConfig, msgs []sdk.Msg, feeAmt sdk.Coins, gas uint64, chainID string, accNums,
 	accSeqs []uint64, feeGranter sdk.AccAddress, priv ...cryptotypes.PrivKey) (sdk.Tx, error) {
 	sigs := make([]sdksigning.SignatureV2, len(priv))
@@ -15,7 +15,7 @@ import (
 	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
 
-// ProvenanceDeductFeeDecorator identifies the payer (using feegrant funds if appropriate),
+// ProvenanceDeductFeeDecorator identifies the payer (using a fee sponsorship or feegrant funds if appropriate),
 // makes sure the payer has enough funds to cover the fees, and deducts the base fee from
 // the payer's account. The base fee is the floor gas price * gas.
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
@@ -68,7 +68,7 @@ func (dfd ProvenanceDeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, s
 }
 
 // checkDeductBaseFee does several things:
-//  1. Checks for a feegrant and uses the base fees on it if it exists.
+//  1. Checks for a fee sponsorship or feegrant and uses the base fees on it if it exists.
 //  2. Makes sure the payer has enough funds to cover the base fee + additional fees.
 //  3. Deducts the base fee from the payer.
 //  4. Emits Tx events: 1. with the full fee and payer, 2. with base fee.
@@ -90,8 +90,12 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 	if err != nil {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	feeDist, err = dfd.msgFeeKeeper.ConvertUsdFees(ctx, feeDist, feeTx.GetFee())
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 
-	deductFeesFrom, err := GetFeePayerUsingFeeGrant(ctx, dfd.feegrantKeeper, feeTx, baseFeeToConsume, msgs)
+	deductFeesFrom, err := GetFeePayer(ctx, dfd.msgFeeKeeper, dfd.feegrantKeeper, feeTx, baseFeeToConsume, msgs)
 	if err != nil {
 		return err
 	}
@@ -151,6 +155,26 @@ func (dfd ProvenanceDeductFeeDecorator) checkDeductBaseFee(ctx sdk.Context, feeT
 	return nil
 }
 
+// GetFeePayer returns the account that pays the provided fee for a tx.
+// When the tx has a fee granter, that account pays if one of its fee sponsorships covers all the msgs,
+// otherwise it must have granted a fee allowance to the fee payer.
+func GetFeePayer(ctx sdk.Context, msgFeeKeeper msgfeestypes.MsgFeesKeeper, feegrantKeeper msgfeestypes.FeegrantKeeper, feeTx sdk.FeeTx, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, error) {
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	feeGranter := sdk.AccAddress(feeTx.FeeGranter())
+
+	if feeGranter != nil && !bytes.Equal(feeGranter, feePayer) && msgFeeKeeper != nil {
+		sponsored, err := msgFeeKeeper.UseSponsoredFees(ctx, feeGranter, feePayer, fee, msgs)
+		if err != nil {
+			return nil, cerrs.Wrapf(err, "failed to use fee sponsorship: sponsor: %s, fee payer: %s, fee: %q", feeGranter, feePayer, fee)
+		}
+		if sponsored {
+			return feeGranter, nil
+		}
+	}
+
+	return GetFeePayerUsingFeeGrant(ctx, feegrantKeeper, feeTx, fee, msgs)
+}
+
 func GetFeePayerUsingFeeGrant(ctx sdk.Context, feegrantKeeper msgfeestypes.FeegrantKeeper, feeTx sdk.FeeTx, fee sdk.Coins, msgs []sdk.Msg) (sdk.AccAddress, error) {
 	feePayer := sdk.AccAddress(feeTx.FeePayer())
 	feeGranter := sdk.AccAddress(feeTx.FeeGranter())
@@ -69,10 +69,12 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, _ bool) (sdk.Coins, sdk.Events,
 		baseFeeConsumed := feeGasMeter.BaseFeeConsumed()
 		unchargedFees, _ := feeTx.GetFee().SafeSub(baseFeeConsumed...)
 
-		deductFeesFrom, err := antewrapper.GetFeePayerUsingFeeGrant(ctx, afd.feegrantKeeper, feeTx, unchargedFees, tx.GetMsgs())
+		payerCtx := ctx.WithEventManager(sdk.NewEventManager())
+		deductFeesFrom, err := antewrapper.GetFeePayer(payerCtx, afd.msgFeeKeeper, afd.feegrantKeeper, feeTx, unchargedFees, tx.GetMsgs())
 		if err != nil {
 			return nil, nil, err
 		}
+		eventsToReturn = append(eventsToReturn, payerCtx.EventManager().Events()...)
 
 		deductFeesFromAcc := afd.accountKeeper.GetAccount(ctx, deductFeesFrom)
 		if deductFeesFromAcc == nil {
@@ -88,6 +90,18 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, _ bool) (sdk.Coins, sdk.Events,
 			}
 			eventsToReturn = append(eventsToReturn, eventCtx.EventManager().Events()...)
 		}
+		// Keep a running total of the msg fees sent to each recipient.
+		if !consumedFees.IsZero() {
+			feeCalls := feeGasMeter.FeeCallsByMsg()
+			consumedByMsg := feeGasMeter.FeeConsumedByMsg()
+			for _, key := range sortedKeys(consumedByMsg) {
+				msgType, recipient := msgfeestypes.SplitCompositeKey(key)
+				err = afd.msgFeeKeeper.RecordFeeRevenue(ctx, msgType, recipient, consumedByMsg[key], feeCalls[key])
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+		}
 		// the uncharged fees have now been charged.
 		chargedFees = chargedFees.Add(unchargedFees...)
 
@@ -109,6 +123,18 @@ func (afd MsgFeeInvoker) Invoke(ctx sdk.Context, _ bool) (sdk.Coins, sdk.Events,
 				eventsToReturn = append(eventsToReturn, msgFeesSummaryEvent)
 			}
 		}
+
+		// Let everyone know about any msg fees that were waived due to their conditions.
+		for _, assessed := range feeGasMeter.AssessedFees() {
+			if !assessed.IsWaived() {
+				continue
+			}
+			waivedEvent, err := sdk.TypedEventToEvent(msgfeestypes.NewEventMsgFeeWaived(assessed.MsgTypeUrl, assessed.WaivedReason))
+			if err != nil {
+				return nil, nil, err
+			}
+			eventsToReturn = append(eventsToReturn, waivedEvent)
+		}
 	}
 
 	return chargedFees, eventsToReturn, nil
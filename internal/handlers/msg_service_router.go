@@ -260,12 +260,19 @@ func (msr *PioMsgServiceRouter) consumeMsgFees(ctx sdk.Context, req sdk.Msg) err
 	if err != nil {
 		return err
 	}
+	feeGasMeter.RecordAssessedFees(feeDist.Assessed...)
+
+	// Fees priced in usd are charged in one of the provided fee denoms.
+	feeDist, err = msr.msgFeesKeeper.ConvertUsdFees(ctx, feeDist, feeTx.GetFee())
+	if err != nil {
+		return err
+	}
 
 	if !feeDist.TotalAdditionalFees.IsZero() {
 		if !feeGasMeter.IsSimulate() {
 			err = antewrapper.EnsureSufficientFloorAndMsgFees(ctx,
 				feeTx.GetFee(), msr.msgFeesKeeper.GetFloorGasPrice(ctx),
-				ctx.GasMeter().Limit(), feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...))
+				ctx.GasMeter().Limit(), feeGasMeter.FeeConsumed().Add(feeDist.TotalAdditionalFees...), msr.msgFeesKeeper)
 			if err != nil {
 				return err
 			}
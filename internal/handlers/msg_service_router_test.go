@@ -32,6 +32,7 @@ import (
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/handlers"
 	"github.com/provenance-io/provenance/internal/pioconfig"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 	"github.com/provenance-io/provenance/x/msgfees/types"
 	msgfeestypes "github.com/provenance-io/provenance/x/msgfees/types"
 )
@@ -521,6 +522,22 @@ func TestMsgServiceMsgFeeWithRecipient(t *testing.T) {
 	assert.Equal(t, "100hotdog", addr1AfterBalance, "addr1AfterBalance")
 	assert.Equal(t, "700hotdog", addr2AfterBalance, "addr2AfterBalance")
 
+	// Both parts of the msg fee should be recorded as revenue for the block's day.
+	day := msgfeestypes.GetBlockDay(ctx.BlockTime())
+	for _, exp := range []struct {
+		recipient string
+		total     string
+	}{
+		{recipient: "", total: "200hotdog"},
+		{recipient: addr2.String(), total: "600hotdog"},
+	} {
+		revenue, err := app.MsgFeesKeeper.GetFeeRevenue(ctx, day, exp.recipient, sdk.MsgTypeURL(msg))
+		if assert.NoError(t, err, "GetFeeRevenue(%q)", exp.recipient) && assert.NotNil(t, revenue, "GetFeeRevenue(%q)", exp.recipient) {
+			assert.Equal(t, exp.total, revenue.Total.String(), "GetFeeRevenue(%q) total", exp.recipient)
+			assert.Equal(t, 1, int(revenue.Count), "GetFeeRevenue(%q) count", exp.recipient)
+		}
+	}
+
 	expEvents := []abci.Event{
 		NewEvent(sdk.EventTypeTx,
 			NewAttribute(sdk.AttributeKeyFee, "800hotdog,170000stake"),
@@ -545,6 +562,112 @@ func TestMsgServiceMsgFeeWithRecipient(t *testing.T) {
 	assertEventsContains(t, blockRes.TxResults[0].Events, expEvents)
 }
 
+func TestMsgServiceMsgFeeWaived(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	gasAmt := NewTestGasLimit() + 20_000
+	acct1Balance := sdk.NewCoins(sdk.NewInt64Coin("hotdog", 1_000), sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(gasAmt)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "msgfee-testing",
+		[]authtypes.GenesisAccount{acct1},
+		banktypes.Balance{Address: addr1.String(), Coins: acct1Balance},
+	)
+	encCfg := app.GetEncodingConfig()
+	blockTime := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContextLegacy(false, cmtproto.Header{ChainID: "msgfee-testing", Time: blockTime})
+	require.NoError(t, app.AccountKeeper.Params.Set(ctx, authtypes.DefaultParams()), "Setting default account params")
+
+	// Sending 100hotdog coin from 1 to 2 during a fee-free window, so the 800hotdog msg fee isn't charged.
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("hotdog", 100)))
+	msgbasedFee := msgfeestypes.NewMsgFee(sdk.MsgTypeURL(msg), sdk.NewInt64Coin("hotdog", 800), "", 0).
+		WithConditions(msgfeestypes.MsgFeeConditions{FeeFreeWindows: []msgfeestypes.FeeFreeWindow{
+			msgfeestypes.NewFeeFreeWindow(blockTime.Add(-time.Hour), blockTime.Add(time.Hour)),
+		}})
+	require.NoError(t, app.MsgFeesKeeper.SetMsgFee(ctx, msgbasedFee), "setting fee 800hotdog with fee-free window")
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(gasAmt)))
+	txBytes, err := SignTxAndGetBytes(ctx, gasAmt, fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg)
+	require.NoError(t, err, "SignTxAndGetBytes")
+	blockRes, err := app.FinalizeBlock(
+		&abci.RequestFinalizeBlock{
+			Height: ctx.BlockHeight() + 1,
+			Time:   blockTime,
+			Txs:    [][]byte{txBytes},
+		},
+	)
+	require.NoError(t, err, "FinalizeBlock() error")
+	require.Equal(t, abci.CodeTypeOK, blockRes.TxResults[0].Code, "tx result code, log: %s", blockRes.TxResults[0].Log)
+
+	addr1AfterBalance := app.BankKeeper.GetAllBalances(ctx, addr1).String()
+	addr2AfterBalance := app.BankKeeper.GetAllBalances(ctx, addr2).String()
+	assert.Equal(t, "900hotdog", addr1AfterBalance, "addr1AfterBalance")
+	assert.Equal(t, "100hotdog", addr2AfterBalance, "addr2AfterBalance")
+
+	expEvents := []abci.Event{
+		NewEvent("provenance.msgfees.v1.EventMsgFeeWaived",
+			NewAttribute("msg_type", `"`+sdk.MsgTypeURL(msg)+`"`),
+			NewAttribute("reason", `"`+msgfeestypes.WaivedReasonFeeFreeWindow+`"`)),
+	}
+	assertEventsContains(t, blockRes.TxResults[0].Events, expEvents)
+}
+
+func TestMsgServiceUsdMsgFee(t *testing.T) {
+	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
+	priv, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	acct1 := authtypes.NewBaseAccount(addr1, priv.PubKey(), 0, 0)
+	gasAmt := NewTestGasLimit() + 20_000
+	acct1Balance := sdk.NewCoins(sdk.NewInt64Coin("usdstable", 1_000), sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(gasAmt)))
+	app := piosimapp.SetupWithGenesisAccounts(t, "msgfee-testing",
+		[]authtypes.GenesisAccount{acct1},
+		banktypes.Balance{Address: addr1.String(), Coins: acct1Balance},
+	)
+	encCfg := app.GetEncodingConfig()
+	ctx := app.BaseApp.NewContextLegacy(false, cmtproto.Header{ChainID: "msgfee-testing"})
+	require.NoError(t, app.AccountKeeper.Params.Set(ctx, authtypes.DefaultParams()), "Setting default account params")
+	feeModuleAccount := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName)
+
+	// usdstable is worth $1.000 for every 2,000, so it takes 2usdstable to pay each usd mil.
+	marker := markertypes.NewEmptyMarkerAccount("usdstable", addr1.String(), nil)
+	nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(markertypes.UsdDenom, 1000), 2000)
+	require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, marker, nav, "test"), "SetNetAssetValue")
+	usdFeeDenom := msgfeestypes.NewUsdFeeDenom("usdstable", msgfeestypes.UsdRateSourceMarkerNav, 0, 10)
+	require.NoError(t, app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, []msgfeestypes.UsdFeeDenom{usdFeeDenom}, nil), "UpdateUsdFeeDenoms")
+
+	// Sending 100usdstable from 1 to 2.
+	// Will have a msg fee of $0.400 = 800usdstable, 600 will go to 2, 200 to module.
+	msg := banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("usdstable", 100)))
+	msgbasedFee := msgfeestypes.NewMsgFee(sdk.MsgTypeURL(msg), sdk.NewInt64Coin(msgfeestypes.UsdDenom, 400), addr2.String(), 7_500)
+	require.NoError(t, app.MsgFeesKeeper.SetMsgFee(ctx, msgbasedFee), "setting fee 400usd addr2 75%")
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(gasAmt)), sdk.NewInt64Coin("usdstable", 800))
+	txBytes, err := SignTxAndGetBytes(ctx, gasAmt, fees, encCfg, priv.PubKey(), priv, *acct1, ctx.ChainID(), msg)
+	require.NoError(t, err, "SignTxAndGetBytes")
+	blockRes, err := app.FinalizeBlock(
+		&abci.RequestFinalizeBlock{
+			Height: ctx.BlockHeight() + 1,
+			Txs:    [][]byte{txBytes},
+		},
+	)
+	require.NoError(t, err, "FinalizeBlock() error")
+	require.Equal(t, abci.CodeTypeOK, blockRes.TxResults[0].Code, "tx result code, log: %s", blockRes.TxResults[0].Log)
+
+	addr1AfterBalance := app.BankKeeper.GetAllBalances(ctx, addr1).String()
+	addr2AfterBalance := app.BankKeeper.GetAllBalances(ctx, addr2).String()
+	assert.Equal(t, "100usdstable", addr1AfterBalance, "addr1AfterBalance")
+	assert.Equal(t, "700usdstable", addr2AfterBalance, "addr2AfterBalance")
+
+	expEvents := []abci.Event{
+		NewEvent(sdk.EventTypeTx,
+			NewAttribute(antewrapper.AttributeKeyAdditionalFee, "800usdstable"),
+			NewAttribute(sdk.AttributeKeyFeePayer, addr1.String())),
+	}
+	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), feeModuleAccount.GetAddress().String(), sdk.NewCoins(sdk.NewInt64Coin("usdstable", 200)))...)
+	expEvents = append(expEvents, CreateSendCoinEvents(addr1.String(), addr2.String(), sdk.NewCoins(sdk.NewInt64Coin("usdstable", 600)))...)
+	assertEventsContains(t, blockRes.TxResults[0].Events, expEvents)
+}
+
 func TestMsgServiceAuthz(tt *testing.T) {
 	pioconfig.SetProvenanceConfig(sdk.DefaultBondDenom, 1)
 	priv, _, addr1 := testdata.KeyTestPubAddr()
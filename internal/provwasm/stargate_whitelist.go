@@ -98,6 +98,11 @@ func init() {
 	setWhitelistedQuery("/provenance.attribute.v1.Query/Scan", &attributetypes.QueryScanResponse{})
 	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeAccounts", &attributetypes.QueryAttributeAccountsResponse{})
 	setWhitelistedQuery("/provenance.attribute.v1.Query/AccountData", &attributetypes.QueryAccountDataResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeSchema", &attributetypes.QueryAttributeSchemaResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeSchemas", &attributetypes.QueryAttributeSchemasResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeIssuers", &attributetypes.QueryAttributeIssuersResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeValueRange", &attributetypes.QueryAttributeValueRangeResponse{})
+	setWhitelistedQuery("/provenance.attribute.v1.Query/AttributeValuePrefix", &attributetypes.QueryAttributeValuePrefixResponse{})
 
 	// exchange
 	setWhitelistedQuery("/provenance.exchange.v1.Query/OrderFeeCalc", &exchange.QueryOrderFeeCalcResponse{})
@@ -107,6 +112,11 @@ func init() {
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOwnerOrders", &exchange.QueryGetOwnerOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAssetOrders", &exchange.QueryGetAssetOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAllOrders", &exchange.QueryGetAllOrdersResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetOrderBook", &exchange.QueryGetOrderBookResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketTrades", &exchange.QueryGetMarketTradesResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketCandles", &exchange.QueryGetMarketCandlesResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetConditionalOrder", &exchange.QueryGetConditionalOrderResponse{})
+	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarketConditionalOrders", &exchange.QueryGetMarketConditionalOrdersResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetCommitment", &exchange.QueryGetCommitmentResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetAccountCommitments", &exchange.QueryGetAccountCommitmentsResponse{})
 	setWhitelistedQuery("/provenance.exchange.v1.Query/GetMarkerCommitments", &exchange.QueryGetMarketCommitmentsResponse{})
@@ -142,6 +152,15 @@ func init() {
 	setWhitelistedQuery("/provenance.marker.v1.Query/DenomMetadata", &markertypes.QueryDenomMetadataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/AccountData", &markertypes.QueryAccountDataResponse{})
 	setWhitelistedQuery("/provenance.marker.v1.Query/NetAssetValues", &markertypes.QueryNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/AggregatedNetAssetValues", &markertypes.QueryAggregatedNetAssetValuesResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/MintSchedule", &markertypes.QueryMintScheduleResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/ApprovalThresholds", &markertypes.QueryApprovalThresholdsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/PendingActions", &markertypes.QueryPendingActionsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/TransferLimits", &markertypes.QueryTransferLimitsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/AddressTransferLimits", &markertypes.QueryAddressTransferLimitsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/Distributions", &markertypes.QueryDistributionsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/DistributionPayouts", &markertypes.QueryDistributionPayoutsResponse{})
+	setWhitelistedQuery("/provenance.marker.v1.Query/HolderSnapshot", &markertypes.QueryHolderSnapshotResponse{})
 
 	// metadata
 	setWhitelistedQuery("/provenance.metadata.v1.Query/Params", &metadatatypes.QueryParamsResponse{})
@@ -178,6 +197,8 @@ func init() {
 	setWhitelistedQuery("/provenance.name.v1.Query/Params", &nametypes.QueryParamsResponse{})
 	setWhitelistedQuery("/provenance.name.v1.Query/Resolve", &nametypes.QueryResolveResponse{})
 	setWhitelistedQuery("/provenance.name.v1.Query/ReverseLookup", &nametypes.QueryReverseLookupResponse{})
+	setWhitelistedQuery("/provenance.name.v1.Query/Lease", &nametypes.QueryLeaseResponse{})
+	setWhitelistedQuery("/provenance.name.v1.Query/Leases", &nametypes.QueryLeasesResponse{})
 
 	// oracle
 	setWhitelistedQuery("/provenance.oracle.v1.Query/OracleAddress", &oracletypes.QueryOracleAddressResponse{})
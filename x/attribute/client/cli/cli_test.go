@@ -3,6 +3,8 @@ package cli_test
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"sort"
 	"strings"
 	"testing"
@@ -20,6 +22,7 @@ import (
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 	testnet "github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/gogoproto/proto"
@@ -110,6 +113,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	var nameData nametypes.GenesisState
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("attribute", s.account1Addr, false))
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("example.attribute", s.account1Addr, false))
+	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord("schema.attribute", s.account1Addr, false))
 	nameData.Bindings = append(nameData.Bindings, nametypes.NewNameRecord(attributetypes.AccountDataName, attrModAddr, true))
 	nameData.Params.AllowUnrestrictedNames = false
 	nameData.Params.MaxNameLevels = 3
@@ -210,6 +214,9 @@ func (s *IntegrationTestSuite) SetupSuite() {
 				nil),
 		)
 	}
+	attributeData.Schemas = append(attributeData.Schemas,
+		attributetypes.NewAttributeSchema("schema.attribute", attributetypes.AttributeType_JSON, `{"type":"object"}`),
+	)
 	attributeData.Params.MaxValueLength = 128
 	attributeDataBz, err := s.cfg.Codec.MarshalJSON(&attributeData)
 	s.Require().NoError(err)
@@ -1512,3 +1519,355 @@ func (s *IntegrationTestSuite) TestUpdateAccountAttributeExpirationCmd() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestAttributeSchemaCmds() {
+	testCases := []struct {
+		name           string
+		cmd            *cobra.Command
+		args           []string
+		expectedOutput string
+		expectedErr    string
+	}{
+		{
+			name:           "schema found",
+			cmd:            cli.GetAttributeSchemaCmd(),
+			args:           []string{"Schema.Attribute"},
+			expectedOutput: `{"schema":{"name":"schema.attribute","attribute_type":"ATTRIBUTE_TYPE_JSON","json_schema":"{\"type\":\"object\"}","proto_type_url":""}}`,
+		},
+		{
+			name:        "schema not found",
+			cmd:         cli.GetAttributeSchemaCmd(),
+			args:        []string{"example.attribute"},
+			expectedErr: `failed to query schema for "example.attribute"`,
+		},
+		{
+			name:           "all schemas",
+			cmd:            cli.GetAttributeSchemasCmd(),
+			args:           []string{},
+			expectedOutput: `{"schemas":[{"name":"schema.attribute","attribute_type":"ATTRIBUTE_TYPE_JSON","json_schema":"{\"type\":\"object\"}","proto_type_url":""}],"pagination":{"next_key":null,"total":"0"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, args)
+			if len(tc.expectedErr) > 0 {
+				s.Require().ErrorContains(err, tc.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAttributeSchemaTxCommands() {
+	txArgs := func(args ...string) []string {
+		return append(args,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+			fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+			fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+			fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+		)
+	}
+	schemaFile := filepath.Join(s.T().TempDir(), "schema.json")
+	s.Require().NoError(os.WriteFile(schemaFile, []byte(`{"type":"object","required":["id"]}`), 0o644), "writing schema file")
+
+	testCases := []struct {
+		name         string
+		cmd          *cobra.Command
+		args         []string
+		expectErr    string
+		expectedCode uint32
+	}{
+		{
+			name: "bind a new attribute name for schema testing",
+			cmd:  namecli.GetBindNameCmd(),
+			args: txArgs("schematest", s.testnet.Validators[0].Address.String(), "attribute"),
+		},
+		{
+			name:      "set schema: invalid type",
+			cmd:       cli.NewSetAttributeSchemaCmd(),
+			args:      txArgs("schematest.attribute", "blah", "{}"),
+			expectErr: "attribute type is invalid: 'ATTRIBUTE_TYPE_BLAH' is not a valid attribute type option",
+		},
+		{
+			name:      "set schema: no schema",
+			cmd:       cli.NewSetAttributeSchemaCmd(),
+			args:      txArgs("schematest.attribute", "json"),
+			expectErr: "a schema argument or --file <file> must be provided",
+		},
+		{
+			name:      "set schema: both arg and file",
+			cmd:       cli.NewSetAttributeSchemaCmd(),
+			args:      txArgs("schematest.attribute", "json", "{}", "--"+cli.FlagFile, schemaFile),
+			expectErr: "cannot provide both a schema argument and --file <file>",
+		},
+		{
+			name: "set schema from file",
+			cmd:  cli.NewSetAttributeSchemaCmd(),
+			args: txArgs("schematest.attribute", "json", "--"+cli.FlagFile, schemaFile),
+		},
+		{
+			name:         "add attribute not matching schema",
+			cmd:          cli.NewAddAccountAttributeCmd(),
+			args:         txArgs("schematest.attribute", s.account2Addr.String(), "json", `{"name":"x"}`),
+			expectedCode: 1,
+		},
+		{
+			name: "add attribute matching schema",
+			cmd:  cli.NewAddAccountAttributeCmd(),
+			args: txArgs("schematest.attribute", s.account2Addr.String(), "json", `{"id":1}`),
+		},
+		{
+			name: "delete schema",
+			cmd:  cli.NewDeleteAttributeSchemaCmd(),
+			args: txArgs("schematest.attribute"),
+		},
+		{
+			name:         "delete schema again",
+			cmd:          cli.NewDeleteAttributeSchemaCmd(),
+			args:         txArgs("schematest.attribute"),
+			expectedCode: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			testcli.NewTxExecutor(tc.cmd, tc.args).
+				WithExpErrMsg(tc.expectErr).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAttributeIssuerCmds() {
+	jsonOut := "--" + cmtcli.OutputFlag + "=json"
+	txFlags := func(from string, args ...string) []string {
+		return append(args,
+			"--"+flags.FlagFrom, from,
+			"--"+flags.FlagSkipConfirmation,
+			"--"+flags.FlagBroadcastMode, flags.BroadcastSync,
+			"--"+flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String(),
+			jsonOut,
+		)
+	}
+	owner, issuer := s.account1Str, s.account5Str
+	add := attributetypes.IssuerPermission_Add
+	update := attributetypes.IssuerPermission_Update
+	exp := time.Date(2050, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		cmd      *cobra.Command
+		args     []string
+		expErr   string
+		respType proto.Message // Don't set if the command should error before sending anything.
+		expResp  proto.Message // Only applicable if respType is neither nil nor a *sdk.TxResponse.
+		expCode  int           // Only applicable if respType is a *sdk.TxResponse.
+	}{
+		{
+			name:     "bind a new attribute name for issuer testing",
+			cmd:      namecli.GetBindNameCmd(),
+			args:     txFlags(owner, "issuertest", owner, "attribute"),
+			respType: &sdk.TxResponse{},
+		},
+		{
+			name:     "add attribute as issuer before grant",
+			cmd:      cli.NewAddAccountAttributeCmd(),
+			args:     txFlags(issuer, "issuertest.attribute", s.account2Str, "string", "before"),
+			respType: &sdk.TxResponse{},
+			expCode:  1,
+		},
+		{
+			name:   "grant issuer: invalid permission",
+			cmd:    cli.NewGrantAttributeIssuerCmd(),
+			args:   txFlags(owner, "issuertest.attribute", issuer, "add,blah"),
+			expErr: "invalid permissions: 'blah' is not a valid issuer permission option",
+		},
+		{
+			name: "grant issuer: invalid expiration",
+			cmd:  cli.NewGrantAttributeIssuerCmd(),
+			args: txFlags(owner, "issuertest.attribute", issuer, "add", "tomorrow"),
+			expErr: `unable to parse time "tomorrow" required format is RFC3339 (2006-01-02T15:04:05Z07:00): ` +
+				`parsing time "tomorrow" as "2006-01-02T15:04:05Z07:00": cannot parse "tomorrow" as "2006"`,
+		},
+		{
+			name:     "grant issuer add and update",
+			cmd:      cli.NewGrantAttributeIssuerCmd(),
+			args:     txFlags(owner, "issuertest.attribute", issuer, "add,update", "2050-01-15T00:00:00Z"),
+			respType: &sdk.TxResponse{},
+		},
+		{
+			name:     "query issuers after grant",
+			cmd:      cli.GetAttributeIssuersCmd(),
+			args:     []string{"issuertest.attribute", jsonOut},
+			respType: &attributetypes.QueryAttributeIssuersResponse{},
+			expResp: &attributetypes.QueryAttributeIssuersResponse{
+				Issuers: []attributetypes.AttributeIssuer{
+					attributetypes.NewAttributeIssuer("issuertest.attribute", issuer, []attributetypes.IssuerPermission{add, update}, &exp),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:     "add attribute as issuer",
+			cmd:      cli.NewAddAccountAttributeCmd(),
+			args:     txFlags(issuer, "issuertest.attribute", s.account2Str, "string", "issued"),
+			respType: &sdk.TxResponse{},
+		},
+		{
+			name:     "delete attribute as issuer without delete permission",
+			cmd:      cli.NewDeleteAccountAttributeCmd(),
+			args:     txFlags(issuer, "issuertest.attribute", s.account2Str),
+			respType: &sdk.TxResponse{},
+			expCode:  1,
+		},
+		{
+			name:     "revoke issuer as non-owner",
+			cmd:      cli.NewRevokeAttributeIssuerCmd(),
+			args:     txFlags(issuer, "issuertest.attribute", issuer),
+			respType: &sdk.TxResponse{},
+			expCode:  1,
+		},
+		{
+			name:     "revoke issuer",
+			cmd:      cli.NewRevokeAttributeIssuerCmd(),
+			args:     txFlags(owner, "issuertest.attribute", issuer),
+			respType: &sdk.TxResponse{},
+		},
+		{
+			name:     "revoke issuer again",
+			cmd:      cli.NewRevokeAttributeIssuerCmd(),
+			args:     txFlags(owner, "issuertest.attribute", issuer),
+			respType: &sdk.TxResponse{},
+			expCode:  1,
+		},
+		{
+			name:     "query issuers after revoke",
+			cmd:      cli.GetAttributeIssuersCmd(),
+			args:     []string{"issuertest.attribute", jsonOut},
+			respType: &attributetypes.QueryAttributeIssuersResponse{},
+			expResp: &attributetypes.QueryAttributeIssuersResponse{
+				Issuers:    []attributetypes.AttributeIssuer{},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name:     "add attribute as revoked issuer",
+			cmd:      cli.NewAddAccountAttributeCmd(),
+			args:     txFlags(issuer, "issuertest.attribute", s.account3Str, "string", "after"),
+			respType: &sdk.TxResponse{},
+			expCode:  1,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx.WithKeyring(s.keyring)
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, tc.args)
+			outBz := out.Bytes()
+			s.T().Logf("ExecTestCLICmd %q %q\nOutput:\n%s", tc.cmd.Name(), tc.args, string(outBz))
+
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "cmd execution error")
+			} else {
+				s.Require().NoError(err, "cmd execution error")
+			}
+
+			if tc.respType != nil {
+				if _, isTxResp := tc.respType.(*sdk.TxResponse); isTxResp {
+					txResp := queries.GetTxFromResponse(s.T(), s.testnet, outBz)
+					s.Assert().Equal(tc.expCode, int(txResp.Code), "TxResponse code")
+				} else {
+					s.Require().NoError(clientCtx.Codec.UnmarshalJSON(outBz, tc.respType), "marshalling output to %T", tc.respType)
+					s.Assert().Equal(tc.expResp, tc.respType, "command response")
+				}
+			}
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestAttributeValueCmds() {
+	testCases := []struct {
+		name      string
+		cmd       *cobra.Command
+		args      []string
+		expErr    string
+		expValues []string
+	}{
+		{
+			name:      "int range with min",
+			cmd:       cli.GetAttributeValueRangeCmd(),
+			args:      []string{"example.attribute.count", "int", "--" + cli.FlagMin, "1"},
+			expValues: []string{"2"},
+		},
+		{
+			name:      "int range excluding value",
+			cmd:       cli.GetAttributeValueRangeCmd(),
+			args:      []string{"example.attribute.count", "int", "--" + cli.FlagMin, "3"},
+			expValues: []string{},
+		},
+		{
+			name:      "string range",
+			cmd:       cli.GetAttributeValueRangeCmd(),
+			args:      []string{"example.attribute.overload", "string", "--" + cli.FlagMin, "ninety", "--" + cli.FlagMax, "ninety3"},
+			expValues: []string{"ninety", "ninety1", "ninety2", "ninety3"},
+		},
+		{
+			name:      "string range with limit",
+			cmd:       cli.GetAttributeValueRangeCmd(),
+			args:      []string{"example.attribute.overload", "string", "--" + cli.FlagMin, "ninety", limitArg(2)},
+			expValues: []string{"ninety", "ninety1"},
+		},
+		{
+			name:   "invalid type",
+			cmd:    cli.GetAttributeValueRangeCmd(),
+			args:   []string{"example.attribute.count", "blah"},
+			expErr: "attribute type is invalid: 'ATTRIBUTE_TYPE_BLAH' is not a valid attribute type option",
+		},
+		{
+			name:   "invalid min",
+			cmd:    cli.GetAttributeValueRangeCmd(),
+			args:   []string{"example.attribute.count", "int", "--" + cli.FlagMin, "two"},
+			expErr: `failed to query ATTRIBUTE_TYPE_INT values of "example.attribute.count"`,
+		},
+		{
+			name:      "prefix",
+			cmd:       cli.GetAttributeValuePrefixCmd(),
+			args:      []string{"example.attribute.overload", "eighty"},
+			expValues: []string{"eighty", "eighty1", "eighty2", "eighty3", "eighty4", "eighty5", "eighty6", "eighty7", "eighty8", "eighty9"},
+		},
+		{
+			name:      "prefix without matches",
+			cmd:       cli.GetAttributeValuePrefixCmd(),
+			args:      []string{"example.attribute.overload", "eleventy"},
+			expValues: []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.testnet.Validators[0].ClientCtx
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, tc.cmd, args)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			// Both commands have responses with the same fields, so just use one to unmarshal.
+			var resp attributetypes.QueryAttributeValueRangeResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp), "unmarshalling response")
+			values := make([]string, len(resp.Attributes))
+			for i, attr := range resp.Attributes {
+				values[i] = string(attr.Value)
+			}
+			s.Assert().Equal(tc.expValues, values, "attribute values")
+		})
+	}
+}
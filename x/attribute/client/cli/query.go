@@ -31,6 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		ScanAccountAttributesCmd(),
 		GetAttributeAccountsCmd(),
 		GetAccountDataCmd(),
+		GetAttributeSchemaCmd(),
+		GetAttributeSchemasCmd(),
+		GetAttributeIssuersCmd(),
+		GetAttributeValueRangeCmd(),
+		GetAttributeValuePrefixCmd(),
 	)
 
 	return queryCmd
@@ -273,3 +278,222 @@ func GetAccountDataCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetAttributeSchemaCmd gets the schema of an attribute name.
+func GetAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schema <name>",
+		Short:   "Look up the schema of an attribute name",
+		Example: fmt.Sprintf(`$ %[1]s query attribute schema example.provenance.io`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryAttributeSchemaRequest{Name: strings.ToLower(strings.TrimSpace(args[0]))}
+
+			response, err := queryClient.AttributeSchema(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query schema for %q: %w", req.Name, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeSchemasCmd lists all attribute schemas.
+func GetAttributeSchemasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schemas",
+		Short: "List all attribute schemas",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute schemas
+				$ %[1]s query attribute schemas --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.AttributeSchemas(context.Background(), &types.QueryAttributeSchemasRequest{Pagination: pageReq})
+			if err != nil {
+				return fmt.Errorf("failed to query schemas: %w", err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "schemas")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeIssuersCmd lists the delegated issuers of an attribute name.
+func GetAttributeIssuersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issuers <name>",
+		Short: "List the accounts allowed to manage attributes of a name",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute issuers example.provenance.io
+				$ %[1]s query attribute issuers example.provenance.io --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttributeIssuersRequest{Name: strings.ToLower(strings.TrimSpace(args[0])), Pagination: pageReq}
+			response, err := queryClient.AttributeIssuers(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query issuers for %q: %w", req.Name, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "issuers")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeValueRangeCmd lists the attributes of a name and type with values in a range.
+func GetAttributeValueRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "value-range <name> {int|float|string|uuid} [--min <value>] [--max <value>]",
+		Short: "List attributes of a name and type with values in a range",
+		Long: `List attributes of a name and type with values in a range, ordered by value.
+Both --min and --max are inclusive. If either is omitted, that end of the range is unbounded.`,
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute value-range kyc.level.provenance.io int --min 2
+				$ %[1]s query attribute value-range score.provenance.io float --min 0.5 --max 1.5 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			attrType, err := types.AttributeTypeFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("attribute type is invalid: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttributeValueRangeRequest{
+				Name:          strings.ToLower(strings.TrimSpace(args[0])),
+				AttributeType: attrType,
+				Pagination:    pageReq,
+			}
+			req.Min, err = cmd.Flags().GetString(FlagMin)
+			if err != nil {
+				return err
+			}
+			req.Max, err = cmd.Flags().GetString(FlagMax)
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.AttributeValueRange(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query %s values of %q: %w", attrType, req.Name, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(FlagMin, "", "The smallest value to include")
+	cmd.Flags().String(FlagMax, "", "The largest value to include")
+	flags.AddPaginationFlagsToCmd(cmd, "value-range")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAttributeValuePrefixCmd lists the string attributes of a name with values starting with a prefix.
+func GetAttributeValuePrefixCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "value-prefix <name> <prefix>",
+		Short: "List string attributes of a name with values starting with a prefix",
+		Example: strings.TrimSpace(
+			fmt.Sprintf(`
+				$ %[1]s query attribute value-prefix jurisdiction.provenance.io US-
+				$ %[1]s query attribute value-prefix jurisdiction.provenance.io US- --page=2 --limit=100
+				`,
+				version.AppName,
+			)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAttributeValuePrefixRequest{
+				Name:       strings.ToLower(strings.TrimSpace(args[0])),
+				Prefix:     args[1],
+				Pagination: pageReq,
+			}
+			response, err := queryClient.AttributeValuePrefix(context.Background(), req)
+			if err != nil {
+				return fmt.Errorf("failed to query values of %q starting with %q: %w", req.Name, req.Prefix, err)
+			}
+
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "value-prefix")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
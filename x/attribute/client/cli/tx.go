@@ -3,6 +3,7 @@ package cli
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -37,6 +38,10 @@ func NewTxCmd() *cobra.Command {
 		NewSetAccountDataCmd(),
 		NewUpdateAccountAttributeExpirationCmd(),
 		NewUpdateParamsCmd(),
+		NewSetAttributeSchemaCmd(),
+		NewDeleteAttributeSchemaCmd(),
+		NewGrantAttributeIssuerCmd(),
+		NewRevokeAttributeIssuerCmd(),
 	)
 	return txCmd
 }
@@ -350,3 +355,146 @@ func NewUpdateParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewSetAttributeSchemaCmd creates a command for setting the schema that values of an attribute name must conform to.
+func NewSetAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-schema <name> {json <json-schema>|proto <type-url>|json " + flagFileUse + "}",
+		Short: "Set the schema that values of an attribute name must conform to",
+		Long: `Set the schema that values of an attribute name must conform to.
+For a json attribute, provide a JSON schema either as an argument or in a file.
+For a proto attribute, provide the type url of the message that values must decode as.`,
+		Args: cobra.RangeArgs(2, 3),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute set-schema "attr1.pb" json '{"type":"object","required":["id"]}'
+$ %[1]s tx attribute set-schema "attr1.pb" json --%[2]s schema.json
+$ %[1]s tx attribute set-schema "attr1.pb" proto /cosmos.bank.v1beta1.Metadata`, version.AppName, FlagFile),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			attributeType, err := types.AttributeTypeFromString(strings.TrimSpace(args[1]))
+			if err != nil {
+				return fmt.Errorf("attribute type is invalid: %w", err)
+			}
+
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return fmt.Errorf("failed to read %s flag: %w", flagFileUse, err)
+			}
+			var schemaValue string
+			switch {
+			case len(args) == 3 && len(file) > 0:
+				return fmt.Errorf("cannot provide both a schema argument and %s", flagFileUse)
+			case len(args) == 3:
+				schemaValue = args[2]
+			case len(file) > 0:
+				bz, err := os.ReadFile(file)
+				if err != nil {
+					return fmt.Errorf("failed to read schema from %s: %w", flagFileUse, err)
+				}
+				schemaValue = string(bz)
+			default:
+				return fmt.Errorf("a schema argument or %s must be provided", flagFileUse)
+			}
+
+			schema := types.NewAttributeSchema(args[0], attributeType, strings.TrimSpace(schemaValue))
+			msg := types.NewMsgSetAttributeSchemaRequest(schema, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagFile, "", "A file containing the json schema")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewDeleteAttributeSchemaCmd creates a command for removing the schema of an attribute name.
+func NewDeleteAttributeSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delete-schema <name>",
+		Short:   "Delete the schema of an attribute name",
+		Example: fmt.Sprintf(`$ %s tx attribute delete-schema "attr1.pb"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteAttributeSchemaRequest(args[0], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGrantAttributeIssuerCmd creates a command for allowing another account to manage attributes of a name.
+func NewGrantAttributeIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-issuer <name> <issuer> <permissions> [expiration]",
+		Short: "Allow another account to manage attributes of a name",
+		Long: `Allow another account to manage attributes of a name without transferring the name.
+The permissions are a comma separated list of: add, update, delete.
+The optional expiration is an RFC3339 date/time after which the issuer can no longer manage attributes.
+Granting an existing issuer replaces its permissions and expiration.`,
+		Args: cobra.RangeArgs(3, 4),
+		Example: fmt.Sprintf(`$ %[1]s tx attribute grant-issuer "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx add,update
+$ %[1]s tx attribute grant-issuer "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx add,update,delete 2050-01-15T00:00:00Z`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			permissions, err := types.IssuerPermissionsFromStrings(strings.Split(args[2], ","))
+			if err != nil {
+				return fmt.Errorf("invalid permissions: %w", err)
+			}
+
+			issuer := types.NewAttributeIssuer(args[0], args[1], permissions, nil)
+			if len(args) == 4 {
+				expiration, err := time.Parse(time.RFC3339, args[3])
+				if err != nil {
+					return fmt.Errorf("unable to parse time %q required format is RFC3339 (%v): %w", args[3], time.RFC3339, err)
+				}
+				issuer.Expiration = &expiration
+			}
+
+			msg := types.NewMsgGrantAttributeIssuerRequest(issuer, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewRevokeAttributeIssuerCmd creates a command for removing an account's permission to manage attributes of a name.
+func NewRevokeAttributeIssuerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "revoke-issuer <name> <issuer>",
+		Short:   "Remove an account's permission to manage attributes of a name",
+		Example: fmt.Sprintf(`$ %s tx attribute revoke-issuer "attr1.pb" tp1jypkeck8vywptdltjnwspwzulkqu7jv6ey90dx`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeAttributeIssuerRequest(args[0], args[1], clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -22,6 +22,10 @@ const (
 	FlagDelete = "delete"
 	// flagDeleteUse is a use string for the delete flag.
 	flagDeleteUse = "--" + FlagDelete
+	// FlagMin is a flag name for defining the smallest value of a range.
+	FlagMin = "min"
+	// FlagMax is a flag name for defining the largest value of a range.
+	FlagMax = "max"
 
 	// AccountDataFlagsUse is a use string for the mutually exclusive account data flags.
 	AccountDataFlagsUse = "{" + flagValueUse + "|" + flagFileUse + "|" + flagDeleteUse + "}"
@@ -21,6 +21,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, schema := range data.Schemas {
+		k.importAttributeSchema(ctx, schema)
+	}
+	for _, issuer := range data.Issuers {
+		if err := k.importAttributeIssuer(ctx, issuer); err != nil {
+			panic(err)
+		}
+	}
 
 	if err := EnsureModuleAccountAndAccountDataNameRecord(ctx.WithLogger(log.NewNopLogger()), k.authKeeper, k.nameKeeper); err != nil {
 		panic(err)
@@ -97,5 +105,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, attrs)
+	schemas := make([]types.AttributeSchema, 0)
+	err := k.IterateAttributeSchemas(ctx, func(schema types.AttributeSchema) bool {
+		schemas = append(schemas, schema)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	issuers := make([]types.AttributeIssuer, 0)
+	err = k.IterateAttributeIssuers(ctx, func(issuer types.AttributeIssuer) bool {
+		issuers = append(issuers, issuer)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, attrs, schemas, issuers)
 }
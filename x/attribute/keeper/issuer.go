@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetAttributeIssuer returns the issuer entry for the given attribute name and issuer address, or nil if there isn't one.
+func (k Keeper) GetAttributeIssuer(ctx sdk.Context, name string, issuer sdk.AccAddress) (*types.AttributeIssuer, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeIssuerKey(name, issuer))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rv types.AttributeIssuer
+	if err := k.cdc.Unmarshal(bz, &rv); err != nil {
+		return nil, err
+	}
+	return &rv, nil
+}
+
+// IterateAttributeIssuers calls the provided callback for each attribute issuer (of all names).
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateAttributeIssuers(ctx sdk.Context, cb func(issuer types.AttributeIssuer) (stop bool)) error {
+	return k.iterateAttributeIssuers(ctx, types.AttributeIssuerKeyPrefix, cb)
+}
+
+// IterateAttributeNameIssuers calls the provided callback for each issuer of the given attribute name.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateAttributeNameIssuers(ctx sdk.Context, name string, cb func(issuer types.AttributeIssuer) (stop bool)) error {
+	return k.iterateAttributeIssuers(ctx, types.AttributeIssuersKeyPrefix(name), cb)
+}
+
+// iterateAttributeIssuers calls the provided callback for each attribute issuer under the given key prefix.
+func (k Keeper) iterateAttributeIssuers(ctx sdk.Context, keyPrefix []byte, cb func(issuer types.AttributeIssuer) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var issuer types.AttributeIssuer
+		if err := k.cdc.Unmarshal(it.Value(), &issuer); err != nil {
+			return err
+		}
+		if cb(issuer) {
+			break
+		}
+	}
+	return nil
+}
+
+// GrantAttributeIssuer allows an account to manage attributes of a name on the name owner's behalf.
+// The attribute name must resolve to the given owner address. An existing grant for the issuer is replaced.
+func (k Keeper) GrantAttributeIssuer(ctx sdk.Context, issuer types.AttributeIssuer, owner sdk.AccAddress) error {
+	if err := issuer.ValidateBasic(); err != nil {
+		return err
+	}
+	if issuer.IsExpired(ctx.BlockTime()) {
+		return fmt.Errorf("issuer expiration %s must be after the current block time %s", issuer.Expiration, ctx.BlockTime())
+	}
+
+	normalizedName, err := k.nameKeeper.Normalize(ctx, issuer.Name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", issuer.Name, err)
+	}
+	issuer.Name = normalizedName
+
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, issuer.Name, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", issuer.Name, owner.String())
+	}
+
+	if err = k.importAttributeIssuer(ctx, issuer); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeIssuerGranted(issuer, owner.String()))
+}
+
+// RevokeAttributeIssuer removes an account's permission to manage attributes of a name.
+// The attribute name must resolve to the given owner address.
+func (k Keeper) RevokeAttributeIssuer(ctx sdk.Context, name string, issuer sdk.AccAddress, owner sdk.AccAddress) error {
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, normalizedName, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", normalizedName, owner.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.AttributeIssuerKey(normalizedName, issuer)
+	if !store.Has(key) {
+		return fmt.Errorf("%q is not an issuer of %q", issuer.String(), normalizedName)
+	}
+	store.Delete(key)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeIssuerRevoked(normalizedName, issuer.String(), owner.String()))
+}
+
+// RevokeAllAttributeIssuers removes every issuer grant for the given attribute name without any ownership checks.
+// It is used when a name gets a new owner, since the grants were made by the previous owner.
+func (k Keeper) RevokeAllAttributeIssuers(ctx sdk.Context, name string, previousOwner sdk.AccAddress) error {
+	var issuers []types.AttributeIssuer
+	if err := k.IterateAttributeNameIssuers(ctx, name, func(issuer types.AttributeIssuer) bool {
+		issuers = append(issuers, issuer)
+		return false
+	}); err != nil {
+		return err
+	}
+	k.deleteAttributeIssuers(ctx.KVStore(k.storeKey), name)
+	for _, issuer := range issuers {
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventAttributeIssuerRevoked(name, issuer.Issuer, previousOwner.String())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkNameAuthority returns an error if the signer is not allowed to take the provided action on attributes with the given name.
+// The signer is allowed if the name resolves to it, or if it is an unexpired issuer of the name with the needed permission.
+// If allowed because of an issuer grant, the signer's address is returned as the issuer, otherwise the issuer is empty.
+func (k Keeper) checkNameAuthority(ctx sdk.Context, name string, signer sdk.AccAddress, perm types.IssuerPermission) (string, error) {
+	if k.nameKeeper.ResolvesTo(ctx, name, signer) {
+		return "", nil
+	}
+	issuer, err := k.GetAttributeIssuer(ctx, name, signer)
+	if err != nil {
+		return "", err
+	}
+	if issuer != nil && !issuer.IsExpired(ctx.BlockTime()) && issuer.HasPermission(perm) {
+		return issuer.Issuer, nil
+	}
+	return "", fmt.Errorf("%q does not resolve to address %q", name, signer.String())
+}
+
+// deleteAttributeIssuers removes all issuer grants for the given attribute name.
+func (k Keeper) deleteAttributeIssuers(store storetypes.KVStore, name string) {
+	var keys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeIssuersKeyPrefix(name))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// importAttributeIssuer stores an attribute issuer without any ownership checks.
+func (k Keeper) importAttributeIssuer(ctx sdk.Context, issuer types.AttributeIssuer) error {
+	issuerAddr, err := sdk.AccAddressFromBech32(issuer.Issuer)
+	if err != nil {
+		return fmt.Errorf("invalid issuer %q: %w", issuer.Issuer, err)
+	}
+	bz, err := k.cdc.Marshal(&issuer)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.AttributeIssuerKey(issuer.Name, issuerAddr), bz)
+	return nil
+}
@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestGrantAttributeIssuer() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	past := s.startBlockTime.Add(-1 * time.Hour)
+	future := s.startBlockTime.Add(1 * time.Hour)
+
+	tests := []struct {
+		name   string
+		issuer types.AttributeIssuer
+		owner  sdk.AccAddress
+		exp    string
+	}{
+		{
+			name:   "invalid issuer",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, nil, nil),
+			owner:  s.user1Addr,
+			exp:    "invalid permissions: empty",
+		},
+		{
+			name:   "already expired",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, &past),
+			owner:  s.user1Addr,
+			exp:    "issuer expiration " + past.String() + " must be after the current block time",
+		},
+		{
+			name:   "not the name owner",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil),
+			owner:  s.user2Addr,
+			exp:    `"example.attribute" does not resolve to address "` + s.user2 + `"`,
+		},
+		{
+			name:   "owner account does not exist",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil),
+			owner:  sdk.AccAddress("nobody______________"),
+			exp:    "no account found for owner address",
+		},
+		{
+			name:   "granted without expiration",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil),
+			owner:  s.user1Addr,
+		},
+		{
+			name:   "granted again with expiration",
+			issuer: types.NewAttributeIssuer("example.attribute", s.user2, []types.IssuerPermission{types.IssuerPermission_Update}, &future),
+			owner:  s.user1Addr,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			err := s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx.WithEventManager(em), tc.issuer, tc.owner)
+			if len(tc.exp) > 0 {
+				s.Require().ErrorContains(err, tc.exp, "GrantAttributeIssuer")
+				return
+			}
+			s.Require().NoError(err, "GrantAttributeIssuer")
+
+			stored, err := s.app.AttributeKeeper.GetAttributeIssuer(s.ctx, tc.issuer.Name, s.user2Addr)
+			s.Require().NoError(err, "GetAttributeIssuer")
+			if s.Assert().NotNil(stored, "stored issuer") {
+				s.Assert().Equal(tc.issuer.Permissions, stored.Permissions, "stored permissions")
+				s.Assert().Equal(tc.issuer.Expiration == nil, stored.Expiration == nil, "stored expiration is nil")
+			}
+			s.Assert().Contains(em.Events()[0].Type, "EventAttributeIssuerGranted", "event type")
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestAttributeIssuerPermissions() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	account := sdk.AccAddress("account_____________").String()
+	expiration := s.startBlockTime.Add(1 * time.Hour)
+	name := "example.attribute"
+
+	attr := types.NewAttribute(name, account, types.AttributeType_String, []byte("first"), nil)
+	updated := types.NewAttribute(name, account, types.AttributeType_String, []byte("second"), nil)
+	notResolved := `"example.attribute" does not resolve to address "` + s.user2 + `"`
+
+	// Without a grant, the issuer can't do anything.
+	err := s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user2Addr)
+	s.Require().EqualError(err, notResolved, "SetAttribute without grant")
+
+	// With only add, it can add, but not update or delete.
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx,
+		types.NewAttributeIssuer(name, s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil), s.user1Addr), "GrantAttributeIssuer add")
+	em := sdk.NewEventManager()
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx.WithEventManager(em), attr, s.user2Addr)
+	s.Require().NoError(err, "SetAttribute with add grant")
+	s.Assert().True(hasTypedEvent(em, func(ev *types.EventAttributeAdd) bool { return ev.Issuer == s.user2 }), "add event has issuer")
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, attr, updated, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "UpdateAttribute with add grant")
+	err = s.app.AttributeKeeper.DeleteAttribute(s.ctx, account, name, nil, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "DeleteAttribute with add grant")
+
+	// The name's owner still has full control, and its events don't have an issuer.
+	em = sdk.NewEventManager()
+	err = s.app.AttributeKeeper.UpdateAttributeExpiration(s.ctx.WithEventManager(em), attr, s.user1Addr)
+	s.Require().NoError(err, "UpdateAttributeExpiration by owner")
+	s.Assert().True(hasTypedEvent(em, func(ev *types.EventAttributeExpirationUpdate) bool { return ev.Issuer == "" }), "owner expiration event has no issuer")
+
+	// With update and delete, it can do those, until it expires.
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx,
+		types.NewAttributeIssuer(name, s.user2, []types.IssuerPermission{types.IssuerPermission_Update, types.IssuerPermission_Delete}, &expiration), s.user1Addr),
+		"GrantAttributeIssuer update delete")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, updated, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "SetAttribute after grant replaced")
+	em = sdk.NewEventManager()
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx.WithEventManager(em), attr, updated, s.user2Addr)
+	s.Require().NoError(err, "UpdateAttribute with update grant")
+	s.Assert().True(hasTypedEvent(em, func(ev *types.EventAttributeUpdate) bool { return ev.Issuer == s.user2 }), "update event has issuer")
+
+	expiredCtx := s.ctx.WithBlockTime(expiration)
+	err = s.app.AttributeKeeper.DeleteAttribute(expiredCtx, account, name, nil, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "DeleteAttribute after grant expired")
+
+	em = sdk.NewEventManager()
+	value := updated.Value
+	err = s.app.AttributeKeeper.DeleteAttribute(s.ctx.WithEventManager(em), account, name, &value, s.user2Addr)
+	s.Require().NoError(err, "DeleteAttribute with delete grant")
+	s.Assert().True(hasTypedEvent(em, func(ev *types.EventAttributeDistinctDelete) bool { return ev.Issuer == s.user2 }), "delete event has issuer")
+
+	// Once revoked, the issuer can't do anything.
+	genState := s.app.AttributeKeeper.ExportGenesis(s.ctx)
+	s.Assert().Len(genState.Issuers, 1, "exported issuers")
+	err = s.app.AttributeKeeper.RevokeAttributeIssuer(s.ctx, name, s.user2Addr, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "RevokeAttributeIssuer by issuer")
+	s.Require().NoError(s.app.AttributeKeeper.RevokeAttributeIssuer(s.ctx, name, s.user2Addr, s.user1Addr), "RevokeAttributeIssuer")
+	err = s.app.AttributeKeeper.RevokeAttributeIssuer(s.ctx, name, s.user2Addr, s.user1Addr)
+	s.Assert().EqualError(err, `"`+s.user2+`" is not an issuer of "example.attribute"`, "RevokeAttributeIssuer again")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user2Addr)
+	s.Assert().EqualError(err, notResolved, "SetAttribute after revoke")
+
+	// Issuers are restored from genesis.
+	s.Assert().NotPanics(func() { s.app.AttributeKeeper.InitGenesis(s.ctx, genState) }, "InitGenesis")
+	issuer, err := s.app.AttributeKeeper.GetAttributeIssuer(s.ctx, name, s.user2Addr)
+	s.Require().NoError(err, "GetAttributeIssuer after InitGenesis")
+	s.Assert().NotNil(issuer, "issuer after InitGenesis")
+}
+
+// hasTypedEvent returns true if the event manager has a typed event of type T that passes the provided check.
+func hasTypedEvent[T proto.Message](em *sdk.EventManager, check func(ev T) bool) bool {
+	for _, abciEvent := range em.ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(abciEvent)
+		if err != nil {
+			continue
+		}
+		if ev, ok := msg.(T); ok && check(ev) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *KeeperTestSuite) TestAttributeIssuerRemovedWhenNameRebound() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	newOwner := sdk.AccAddress("new_owner___________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, newOwner))
+	name := "example.attribute"
+	attr := types.NewAttribute(name, sdk.AccAddress("account_____________").String(), types.AttributeType_String, []byte("value"), nil)
+
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx,
+		types.NewAttributeIssuer(name, s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil), s.user1Addr), "GrantAttributeIssuer")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx,
+		types.NewAttributeSchema(name, types.AttributeType_JSON, `{}`), s.user1Addr), "SetAttributeSchema")
+
+	// Delete the name the same way the name module does, then bind it to someone else.
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, name), "DeleteRecord")
+	s.Require().NoError(s.app.AttributeKeeper.PurgeAttribute(s.ctx, name, s.user1Addr), "PurgeAttribute")
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, name, newOwner, false), "SetNameRecord new owner")
+
+	issuer, err := s.app.AttributeKeeper.GetAttributeIssuer(s.ctx, name, s.user2Addr)
+	s.Require().NoError(err, "GetAttributeIssuer")
+	s.Assert().Nil(issuer, "issuer after rebind")
+	schema, err := s.app.AttributeKeeper.GetAttributeSchema(s.ctx, name)
+	s.Require().NoError(err, "GetAttributeSchema")
+	s.Assert().Nil(schema, "schema after rebind")
+
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user2Addr)
+	s.Assert().EqualError(err, `"example.attribute" does not resolve to address "`+s.user2+`"`, "SetAttribute by old issuer")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, attr, newOwner)
+	s.Assert().NoError(err, "SetAttribute by new owner")
+}
+
+func (s *KeeperTestSuite) TestAttributeIssuerRevokedWhenNameOwnerChanges() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	newOwner := sdk.AccAddress("new_owner___________")
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, newOwner))
+	name := "example.attribute"
+	attr := types.NewAttribute(name, sdk.AccAddress("account_____________").String(), types.AttributeType_String, []byte("value"), nil)
+
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx,
+		types.NewAttributeIssuer(name, s.user2, []types.IssuerPermission{types.IssuerPermission_Add}, nil), s.user1Addr), "GrantAttributeIssuer")
+
+	// Change the owner the same way MsgModifyName does.
+	em := sdk.NewEventManager()
+	ctx := s.ctx.WithEventManager(em)
+	s.Require().NoError(s.app.NameKeeper.UpdateNameRecord(ctx, name, newOwner, false), "UpdateNameRecord new owner")
+
+	issuer, err := s.app.AttributeKeeper.GetAttributeIssuer(s.ctx, name, s.user2Addr)
+	s.Require().NoError(err, "GetAttributeIssuer")
+	s.Assert().Nil(issuer, "issuer after owner change")
+	s.Assert().True(hasTypedEvent(em, func(ev *types.EventAttributeIssuerRevoked) bool {
+		return ev.Name == name && ev.Issuer == s.user2 && ev.Owner == s.user1
+	}), "EventAttributeIssuerRevoked emitted")
+
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, attr, s.user2Addr)
+	s.Assert().EqualError(err, `"example.attribute" does not resolve to address "`+s.user2+`"`, "SetAttribute by old issuer")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, attr, newOwner)
+	s.Assert().NoError(err, "SetAttribute by new owner")
+}
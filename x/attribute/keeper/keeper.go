@@ -163,9 +163,14 @@ func (k Keeper) SetAttribute(
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
-	// Verify name resolves to owner
-	if !k.nameKeeper.ResolvesTo(ctx, attr.Name, owner) {
-		return fmt.Errorf("%q does not resolve to address %q", attr.Name, owner.String())
+	// Verify name resolves to owner, or that the owner is an issuer allowed to add it
+	issuer, err := k.checkNameAuthority(ctx, attr.Name, owner, types.IssuerPermission_Add)
+	if err != nil {
+		return err
+	}
+	// Verify the value conforms to the name's schema
+	if err = k.validateAgainstSchema(ctx, attr); err != nil {
+		return err
 	}
 	// Store the sanitized account attribute
 	bz, err := k.cdc.Marshal(&attr)
@@ -179,8 +184,10 @@ func (k Keeper) SetAttribute(
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
 	k.addAttributeExpireLookup(store, attr)
+	k.addAttributeValueIndex(store, attr)
 
 	attributeAddEvent := types.NewEventAttributeAdd(attr, owner.String())
+	attributeAddEvent.Issuer = issuer
 
 	return ctx.EventManager().EmitTypedEvent(attributeAddEvent)
 }
@@ -252,8 +259,13 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
-		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
+	issuer, err := k.checkNameAuthority(ctx, updateAttribute.Name, owner, types.IssuerPermission_Update)
+	if err != nil {
+		return err
+	}
+
+	if err = k.validateAgainstSchema(ctx, updateAttribute); err != nil {
+		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
@@ -274,6 +286,7 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 			store.Delete(attrKey)
 			k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
 			k.deleteAttributeExpireLookup(store, attr)
+			k.deleteAttributeValueIndex(store, attr)
 
 			bz, err := k.cdc.Marshal(&updateAttribute)
 			if err != nil {
@@ -283,8 +296,10 @@ func (k Keeper) UpdateAttribute(ctx sdk.Context, originalAttribute types.Attribu
 			store.Set(updatedKey, bz)
 			k.IncAttrNameAddressLookup(ctx, updateAttribute.Name, updateAttribute.GetAddressBytes())
 			k.addAttributeExpireLookup(store, updateAttribute)
+			k.addAttributeValueIndex(store, updateAttribute)
 
 			attributeUpdateEvent := types.NewEventAttributeUpdate(originalAttribute, updateAttribute, owner.String())
+			attributeUpdateEvent.Issuer = issuer
 			if err := ctx.EventManager().EmitTypedEvent(attributeUpdateEvent); err != nil {
 				return err
 			}
@@ -316,8 +331,9 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, updateAttribute.Name, owner) {
-		return fmt.Errorf("%q does not resolve to address %q", updateAttribute.Name, owner.String())
+	issuer, err := k.checkNameAuthority(ctx, updateAttribute.Name, owner, types.IssuerPermission_Update)
+	if err != nil {
+		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
@@ -342,6 +358,7 @@ func (k Keeper) UpdateAttributeExpiration(ctx sdk.Context, updateAttribute types
 		k.addAttributeExpireLookup(store, attr)
 
 		attributeExpirationUpdateEvent := types.NewEventAttributeExpirationUpdate(attr, originalExpiration, owner.String())
+		attributeExpirationUpdateEvent.Issuer = issuer
 		if err := ctx.EventManager().EmitTypedEvent(attributeExpirationUpdateEvent); err != nil {
 			return err
 		}
@@ -383,12 +400,11 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		return fmt.Errorf("no account found for owner address %q", owner.String())
 	}
 
-	if !k.nameKeeper.ResolvesTo(ctx, name, owner) {
-		if k.nameKeeper.NameExists(ctx, name) {
-			return fmt.Errorf("%q does not resolve to address %q", name, owner.String())
-		}
-		// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
+	issuer, err := k.checkNameAuthority(ctx, name, owner, types.IssuerPermission_Delete)
+	if err != nil && k.nameKeeper.NameExists(ctx, name) {
+		return err
 	}
+	// else name does not exist (anymore) so we can't enforce permission check on delete here, proceed.
 
 	store := ctx.KVStore(k.storeKey)
 	iter := storetypes.KVStorePrefixIterator(store, types.AddrStrAttributesNameKeyPrefix(addr, name))
@@ -417,13 +433,16 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 		store.Delete(types.AddrAttributeKey(addrBz, attr))
 		k.DecAttrNameAddressLookup(ctx, attr.Name, addrBz)
 		k.deleteAttributeExpireLookup(store, attr)
+		k.deleteAttributeValueIndex(store, attr)
 		if !deleteDistinct {
 			deleteEvent := types.NewEventAttributeDelete(name, addr, owner.String())
+			deleteEvent.Issuer = issuer
 			if err := ctx.EventManager().EmitTypedEvent(deleteEvent); err != nil {
 				return err
 			}
 		} else {
 			deleteEvent := types.NewEventDistinctAttributeDelete(name, string(*value), addr, owner.String())
+			deleteEvent.Issuer = issuer
 			if err := ctx.EventManager().EmitTypedEvent(deleteEvent); err != nil {
 				return err
 			}
@@ -441,6 +460,7 @@ func (k Keeper) DeleteAttribute(ctx sdk.Context, addr string, name string, value
 }
 
 // PurgeAttribute removes attributes under the given account from the state store.
+// Any issuer grants and schema for the name are removed too so they don't carry over to a new owner of the name.
 func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error {
 	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
 		return fmt.Errorf("no account found for owner address %q", owner.String())
@@ -461,10 +481,17 @@ func (k Keeper) PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddres
 	for _, acct := range accts {
 		attrToDelete := k.getAddrAttributesKeysByName(store, acct, name)
 		for _, key := range attrToDelete {
+			var attr types.Attribute
+			if err = k.cdc.Unmarshal(store.Get(key), &attr); err != nil {
+				return err
+			}
 			store.Delete(key)
 			k.DecAttrNameAddressLookup(ctx, name, acct)
+			k.deleteAttributeValueIndex(store, attr)
 		}
 	}
+	k.deleteAttributeIssuers(store, name)
+	store.Delete(types.AttributeSchemaKey(name))
 	return nil
 }
 
@@ -525,6 +552,7 @@ func (k Keeper) importAttribute(ctx sdk.Context, attr types.Attribute) error {
 	store.Set(key, bz)
 	k.IncAttrNameAddressLookup(ctx, attr.Name, attr.GetAddressBytes())
 	k.addAttributeExpireLookup(store, attr)
+	k.addAttributeValueIndex(store, attr)
 	return nil
 }
 
@@ -551,6 +579,7 @@ func (k Keeper) DeleteExpiredAttributes(ctx sdk.Context, limit int) int {
 				store.Delete(attrKey)
 				// dec name to address lookup table count
 				k.DecAttrNameAddressLookup(ctx, attribute.Name, attribute.GetAddressBytes())
+				k.deleteAttributeValueIndex(store, attribute)
 
 				deleteExpirationEvent := types.NewEventAttributeExpired(attribute)
 				if err = ctx.EventManager().EmitTypedEvent(deleteExpirationEvent); err != nil {
@@ -1,5 +1,9 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +13,13 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 builds the attribute value index from the existing attributes.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Building attribute value index.")
+	if err := m.keeper.buildAttributeValueIndex(ctx); err != nil {
+		return err
+	}
+	ctx.Logger().Info("Done building attribute value index.")
+	return nil
+}
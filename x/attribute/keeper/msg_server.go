@@ -272,3 +272,71 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetAttributeSchema defines a method for setting the schema that values of an attribute name must conform to.
+func (k msgServer) SetAttributeSchema(goCtx context.Context, msg *types.MsgSetAttributeSchemaRequest) (*types.MsgSetAttributeSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.SetAttributeSchema(ctx, msg.Schema, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAttributeSchemaResponse{}, nil
+}
+
+// DeleteAttributeSchema defines a method for removing the schema of an attribute name.
+func (k msgServer) DeleteAttributeSchema(goCtx context.Context, msg *types.MsgDeleteAttributeSchemaRequest) (*types.MsgDeleteAttributeSchemaResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.DeleteAttributeSchema(ctx, msg.Name, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeleteAttributeSchemaResponse{}, nil
+}
+
+// GrantAttributeIssuer defines a method for allowing another account to manage attributes of a name.
+func (k msgServer) GrantAttributeIssuer(goCtx context.Context, msg *types.MsgGrantAttributeIssuerRequest) (*types.MsgGrantAttributeIssuerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.GrantAttributeIssuer(ctx, msg.Issuer, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantAttributeIssuerResponse{}, nil
+}
+
+// RevokeAttributeIssuer defines a method for removing an account's permission to manage attributes of a name.
+func (k msgServer) RevokeAttributeIssuer(goCtx context.Context, msg *types.MsgRevokeAttributeIssuerRequest) (*types.MsgRevokeAttributeIssuerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	issuerAddr, err := sdk.AccAddressFromBech32(msg.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.Keeper.RevokeAttributeIssuer(ctx, msg.Name, issuerAddr, ownerAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeAttributeIssuerResponse{}, nil
+}
@@ -371,3 +371,109 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (s *MsgServerTestSuite) TestMsgSetAttributeSchemaRequest() {
+	schema := types.NewAttributeSchema("example.name", types.AttributeType_JSON, `{"type":"object"}`)
+
+	testcases := []struct {
+		name          string
+		msg           *types.MsgSetAttributeSchemaRequest
+		errorMsg      string
+		expectedEvent proto.Message
+	}{
+		{
+			name:     "invalid owner",
+			msg:      &types.MsgSetAttributeSchemaRequest{Schema: schema, Owner: "invalid"},
+			errorMsg: "decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:          "should successfully set schema",
+			msg:           types.NewMsgSetAttributeSchemaRequest(schema, s.owner1Addr),
+			expectedEvent: types.NewEventAttributeSchemaSet(schema, s.owner1),
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.SetAttributeSchema(s.ctx, tc.msg)
+
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg)
+			} else {
+				s.Assert().NoError(err)
+				result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+				s.True(result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestMsgDeleteAttributeSchemaRequest() {
+	schema := types.NewAttributeSchema("example.name", types.AttributeType_JSON, `{"type":"object"}`)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.owner1Addr), "SetAttributeSchema")
+
+	testcases := []struct {
+		name          string
+		msg           *types.MsgDeleteAttributeSchemaRequest
+		errorMsg      string
+		expectedEvent proto.Message
+	}{
+		{
+			name:          "should successfully delete schema",
+			msg:           types.NewMsgDeleteAttributeSchemaRequest("example.name", s.owner1Addr),
+			expectedEvent: types.NewEventAttributeSchemaDeleted("example.name", s.owner1),
+		},
+		{
+			name:     "schema already deleted",
+			msg:      types.NewMsgDeleteAttributeSchemaRequest("example.name", s.owner1Addr),
+			errorMsg: `no schema found for attribute "example.name"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		s.Run(tc.name, func() {
+			s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.DeleteAttributeSchema(s.ctx, tc.msg)
+
+			if len(tc.errorMsg) > 0 {
+				s.Assert().EqualError(err, tc.errorMsg)
+			} else {
+				s.Assert().NoError(err)
+				result := s.containsMessage(s.ctx.EventManager().ABCIEvents(), tc.expectedEvent)
+				s.True(result, fmt.Sprintf("Expected typed event was not found: %v", tc.expectedEvent))
+			}
+		})
+	}
+}
+
+func (s *MsgServerTestSuite) TestMsgGrantAndRevokeAttributeIssuerRequest() {
+	issuerAddr := sdk.AccAddress("issuer______________")
+	issuer := types.NewAttributeIssuer("example.name", issuerAddr.String(), []types.IssuerPermission{types.IssuerPermission_Add}, nil)
+
+	s.Run("grant: invalid owner", func() {
+		_, err := s.msgServer.GrantAttributeIssuer(s.ctx, &types.MsgGrantAttributeIssuerRequest{Issuer: issuer, Owner: "invalid"})
+		s.Assert().EqualError(err, "decoding bech32 failed: invalid bech32 string length 7")
+	})
+
+	s.Run("grant", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.GrantAttributeIssuer(s.ctx, types.NewMsgGrantAttributeIssuerRequest(issuer, s.owner1Addr))
+		s.Require().NoError(err)
+		expectedEvent := types.NewEventAttributeIssuerGranted(issuer, s.owner1)
+		s.True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expectedEvent), fmt.Sprintf("Expected typed event was not found: %v", expectedEvent))
+	})
+
+	s.Run("revoke: invalid issuer", func() {
+		_, err := s.msgServer.RevokeAttributeIssuer(s.ctx, &types.MsgRevokeAttributeIssuerRequest{Name: "example.name", Issuer: "invalid", Owner: s.owner1})
+		s.Assert().EqualError(err, "decoding bech32 failed: invalid bech32 string length 7")
+	})
+
+	s.Run("revoke", func() {
+		s.ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		_, err := s.msgServer.RevokeAttributeIssuer(s.ctx, types.NewMsgRevokeAttributeIssuerRequest("example.name", issuerAddr.String(), s.owner1Addr))
+		s.Require().NoError(err)
+		expectedEvent := types.NewEventAttributeIssuerRevoked("example.name", issuerAddr.String(), s.owner1)
+		s.True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expectedEvent), fmt.Sprintf("Expected typed event was not found: %v", expectedEvent))
+	})
+}
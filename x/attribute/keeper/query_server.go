@@ -185,3 +185,126 @@ func (k Keeper) AccountData(c context.Context, req *types.QueryAccountDataReques
 	}
 	return resp, nil
 }
+
+// AttributeSchema returns the schema registered for an attribute name.
+func (k Keeper) AttributeSchema(c context.Context, req *types.QueryAttributeSchemaRequest) (*types.QueryAttributeSchemaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schema, err := k.GetAttributeSchema(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if schema == nil {
+		return nil, status.Errorf(codes.NotFound, "no schema found for attribute %q", name)
+	}
+
+	return &types.QueryAttributeSchemaResponse{Schema: schema}, nil
+}
+
+// AttributeSchemas returns all registered attribute schemas.
+func (k Keeper) AttributeSchemas(c context.Context, req *types.QueryAttributeSchemasRequest) (*types.QueryAttributeSchemasResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	schemas := make([]types.AttributeSchema, 0)
+	schemaStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttributeSchemaKeyPrefix)
+	pageRes, err := query.Paginate(schemaStore, req.Pagination, func(_ []byte, value []byte) error {
+		var schema types.AttributeSchema
+		if err := k.cdc.Unmarshal(value, &schema); err != nil {
+			return err
+		}
+		schemas = append(schemas, schema)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttributeSchemasResponse{Schemas: schemas, Pagination: pageRes}, nil
+}
+
+// AttributeIssuers returns the delegated issuers of an attribute name.
+func (k Keeper) AttributeIssuers(c context.Context, req *types.QueryAttributeIssuersRequest) (*types.QueryAttributeIssuersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	issuers := make([]types.AttributeIssuer, 0)
+	issuerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AttributeIssuersKeyPrefix(name))
+	pageRes, err := query.Paginate(issuerStore, req.Pagination, func(_ []byte, value []byte) error {
+		var issuer types.AttributeIssuer
+		if err := k.cdc.Unmarshal(value, &issuer); err != nil {
+			return err
+		}
+		issuers = append(issuers, issuer)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttributeIssuersResponse{Issuers: issuers, Pagination: pageRes}, nil
+}
+
+// AttributeValueRange returns the attributes with a given name and type whose values are within a range.
+func (k Keeper) AttributeValueRange(c context.Context, req *types.QueryAttributeValueRangeRequest) (*types.QueryAttributeValueRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	if !types.IsIndexedAttributeType(req.AttributeType) {
+		return nil, status.Errorf(codes.InvalidArgument, "attribute type %s is not indexed", req.AttributeType)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	attrs := make([]types.Attribute, 0)
+	pageRes, err := k.IterateAttributeValueRange(ctx, name, req.AttributeType, req.Min, req.Max, req.Pagination, func(attr types.Attribute) error {
+		attrs = append(attrs, attr)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAttributeValueRangeResponse{Attributes: attrs, Pagination: pageRes}, nil
+}
+
+// AttributeValuePrefix returns the string attributes with a given name whose values start with a prefix.
+func (k Keeper) AttributeValuePrefix(c context.Context, req *types.QueryAttributeValuePrefixRequest) (*types.QueryAttributeValuePrefixResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	name := strings.ToLower(strings.TrimSpace(req.Name))
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "empty attribute name")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	attrs := make([]types.Attribute, 0)
+	pageRes, err := k.IterateAttributeValuePrefix(ctx, name, req.Prefix, req.Pagination, func(attr types.Attribute) error {
+		attrs = append(attrs, attr)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryAttributeValuePrefixResponse{Attributes: attrs, Pagination: pageRes}, nil
+}
@@ -198,3 +198,89 @@ func (s *QueryServerTestSuite) TestAccountData() {
 		})
 	}
 }
+
+func (s *QueryServerTestSuite) TestAttributeSchemaQueries() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "example.attribute", s.owner1Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "other.attribute", s.owner1Addr, false))
+	schema1 := types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, `{"type":"object"}`)
+	schema2 := types.NewAttributeSchema("other.attribute", types.AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema1, s.owner1Addr), "SetAttributeSchema schema1")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema2, s.owner1Addr), "SetAttributeSchema schema2")
+
+	res, err := s.queryClient.AttributeSchema(s.ctx, &types.QueryAttributeSchemaRequest{Name: "Example.Attribute"})
+	s.Require().NoError(err, "AttributeSchema")
+	s.Assert().Equal(&schema1, res.Schema, "AttributeSchema result")
+
+	_, err = s.queryClient.AttributeSchema(s.ctx, &types.QueryAttributeSchemaRequest{Name: "missing.attribute"})
+	s.Assert().ErrorContains(err, `no schema found for attribute "missing.attribute"`, "AttributeSchema missing")
+
+	_, err = s.queryClient.AttributeSchema(s.ctx, &types.QueryAttributeSchemaRequest{Name: " "})
+	s.Assert().ErrorContains(err, "empty attribute name", "AttributeSchema empty name")
+
+	allRes, err := s.queryClient.AttributeSchemas(s.ctx, &types.QueryAttributeSchemasRequest{})
+	s.Require().NoError(err, "AttributeSchemas")
+	s.Assert().ElementsMatch([]types.AttributeSchema{schema1, schema2}, allRes.Schemas, "AttributeSchemas result")
+
+	pageRes, err := s.queryClient.AttributeSchemas(s.ctx, &types.QueryAttributeSchemasRequest{Pagination: &query.PageRequest{Limit: 1}})
+	s.Require().NoError(err, "AttributeSchemas limit 1")
+	s.Assert().Len(pageRes.Schemas, 1, "AttributeSchemas limit 1 result")
+	s.Assert().NotEmpty(pageRes.Pagination.NextKey, "AttributeSchemas limit 1 next key")
+}
+
+func (s *QueryServerTestSuite) TestAttributeIssuersQuery() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "example.attribute", s.owner1Addr, false))
+	issuer1 := types.NewAttributeIssuer("example.attribute", sdk.AccAddress("issuer1_____________").String(), []types.IssuerPermission{types.IssuerPermission_Add}, nil)
+	issuer2 := types.NewAttributeIssuer("example.attribute", sdk.AccAddress("issuer2_____________").String(), []types.IssuerPermission{types.IssuerPermission_Delete}, nil)
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx, issuer1, s.owner1Addr), "GrantAttributeIssuer issuer1")
+	s.Require().NoError(s.app.AttributeKeeper.GrantAttributeIssuer(s.ctx, issuer2, s.owner1Addr), "GrantAttributeIssuer issuer2")
+
+	res, err := s.queryClient.AttributeIssuers(s.ctx, &types.QueryAttributeIssuersRequest{Name: "Example.Attribute"})
+	s.Require().NoError(err, "AttributeIssuers")
+	s.Assert().ElementsMatch([]types.AttributeIssuer{issuer1, issuer2}, res.Issuers, "AttributeIssuers result")
+
+	res, err = s.queryClient.AttributeIssuers(s.ctx, &types.QueryAttributeIssuersRequest{Name: "other.attribute"})
+	s.Require().NoError(err, "AttributeIssuers other")
+	s.Assert().Empty(res.Issuers, "AttributeIssuers other result")
+
+	_, err = s.queryClient.AttributeIssuers(s.ctx, &types.QueryAttributeIssuersRequest{})
+	s.Assert().ErrorContains(err, "empty attribute name", "AttributeIssuers empty name")
+}
+
+func (s *QueryServerTestSuite) TestAttributeValueQueries() {
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "kyc.level", s.owner1Addr, false))
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(s.ctx, "jurisdiction", s.owner1Addr, false))
+	addrs := []string{
+		sdk.AccAddress("value_addr_1________").String(),
+		sdk.AccAddress("value_addr_2________").String(),
+		sdk.AccAddress("value_addr_3________").String(),
+	}
+	levels := []string{"1", "3", "2"}
+	jurisdictions := []string{"US-NY", "CA-ON", "US-CA"}
+	for i, addr := range addrs {
+		levelAttr := types.NewAttribute("kyc.level", addr, types.AttributeType_Int, []byte(levels[i]), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, levelAttr, s.owner1Addr), "SetAttribute kyc.level %s", addr)
+		jurisAttr := types.NewAttribute("jurisdiction", addr, types.AttributeType_String, []byte(jurisdictions[i]), nil)
+		s.Require().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, jurisAttr, s.owner1Addr), "SetAttribute jurisdiction %s", addr)
+	}
+
+	rangeRes, err := s.queryClient.AttributeValueRange(s.ctx, &types.QueryAttributeValueRangeRequest{
+		Name: "kyc.level", AttributeType: types.AttributeType_Int, Min: "2",
+	})
+	s.Require().NoError(err, "AttributeValueRange")
+	s.Require().Len(rangeRes.Attributes, 2, "AttributeValueRange attributes")
+	s.Assert().Equal(addrs[2], rangeRes.Attributes[0].Address, "first AttributeValueRange account")
+	s.Assert().Equal(addrs[1], rangeRes.Attributes[1].Address, "second AttributeValueRange account")
+
+	prefixRes, err := s.queryClient.AttributeValuePrefix(s.ctx, &types.QueryAttributeValuePrefixRequest{Name: "jurisdiction", Prefix: "US-"})
+	s.Require().NoError(err, "AttributeValuePrefix")
+	s.Require().Len(prefixRes.Attributes, 2, "AttributeValuePrefix attributes")
+	s.Assert().Equal("US-CA", string(prefixRes.Attributes[0].Value), "first AttributeValuePrefix value")
+	s.Assert().Equal("US-NY", string(prefixRes.Attributes[1].Value), "second AttributeValuePrefix value")
+
+	_, err = s.queryClient.AttributeValueRange(s.ctx, &types.QueryAttributeValueRangeRequest{Name: "kyc.level", AttributeType: types.AttributeType_Bytes})
+	s.Assert().ErrorContains(err, "attribute type ATTRIBUTE_TYPE_BYTES is not indexed", "AttributeValueRange bytes")
+	_, err = s.queryClient.AttributeValueRange(s.ctx, &types.QueryAttributeValueRangeRequest{AttributeType: types.AttributeType_Int})
+	s.Assert().ErrorContains(err, "empty attribute name", "AttributeValueRange empty name")
+	_, err = s.queryClient.AttributeValuePrefix(s.ctx, &types.QueryAttributeValuePrefixRequest{Prefix: "US"})
+	s.Assert().ErrorContains(err, "empty attribute name", "AttributeValuePrefix empty name")
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// GetAttributeSchema returns the schema registered for the given attribute name, or nil if there isn't one.
+func (k Keeper) GetAttributeSchema(ctx sdk.Context, name string) (*types.AttributeSchema, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AttributeSchemaKey(name))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var schema types.AttributeSchema
+	if err := k.cdc.Unmarshal(bz, &schema); err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// IterateAttributeSchemas calls the provided callback for each attribute schema.
+// Iteration stops when the callback returns true.
+func (k Keeper) IterateAttributeSchemas(ctx sdk.Context, cb func(schema types.AttributeSchema) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AttributeSchemaKeyPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var schema types.AttributeSchema
+		if err := k.cdc.Unmarshal(it.Value(), &schema); err != nil {
+			return err
+		}
+		if cb(schema) {
+			break
+		}
+	}
+	return nil
+}
+
+// SetAttributeSchema stores a schema that all future values of an attribute name must conform to.
+// The attribute name must resolve to the given owner address.
+// A JSON schema cannot be longer than the max value length, and gas is charged for each byte of it.
+func (k Keeper) SetAttributeSchema(ctx sdk.Context, schema types.AttributeSchema, owner sdk.AccAddress) error {
+	maxLength := k.GetMaxValueLength(ctx)
+	if len(schema.JsonSchema) > int(maxLength) {
+		return fmt.Errorf("json schema length of %v exceeds max length %v", len(schema.JsonSchema), maxLength)
+	}
+	ctx.GasMeter().ConsumeGas(uint64(len(schema.JsonSchema))*types.SchemaValidationGasPerByte, "attribute schema compile")
+	if err := schema.ValidateBasic(); err != nil {
+		return err
+	}
+
+	normalizedName, err := k.nameKeeper.Normalize(ctx, schema.Name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", schema.Name, err)
+	}
+	schema.Name = normalizedName
+
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, schema.Name, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", schema.Name, owner.String())
+	}
+
+	// An empty message is valid for any proto type, so this only fails if the type url can't be resolved.
+	if schema.AttributeType == types.AttributeType_Proto {
+		if err = types.ValidateProtoValue(schema.ProtoTypeUrl, nil); err != nil {
+			return fmt.Errorf("invalid proto type url %q: %w", schema.ProtoTypeUrl, err)
+		}
+	}
+
+	k.importAttributeSchema(ctx, schema)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeSchemaSet(schema, owner.String()))
+}
+
+// DeleteAttributeSchema removes the schema for an attribute name. The attribute name must resolve to the given owner address.
+// Existing attribute values are not affected.
+func (k Keeper) DeleteAttributeSchema(ctx sdk.Context, name string, owner sdk.AccAddress) error {
+	normalizedName, err := k.nameKeeper.Normalize(ctx, name)
+	if err != nil {
+		return fmt.Errorf("unable to normalize attribute name %q: %w", name, err)
+	}
+
+	if ownerAcc := k.authKeeper.GetAccount(ctx, owner); ownerAcc == nil {
+		return fmt.Errorf("no account found for owner address %q", owner.String())
+	}
+	if !k.nameKeeper.ResolvesTo(ctx, normalizedName, owner) {
+		return fmt.Errorf("%q does not resolve to address %q", normalizedName, owner.String())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.AttributeSchemaKey(normalizedName)
+	if !store.Has(key) {
+		return fmt.Errorf("no schema found for attribute %q", normalizedName)
+	}
+	store.Delete(key)
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventAttributeSchemaDeleted(normalizedName, owner.String()))
+}
+
+// validateAgainstSchema makes sure the attribute conforms to the schema registered for its name (if there is one).
+// Gas is charged for each byte of the schema and value. The attribute name must already be normalized.
+func (k Keeper) validateAgainstSchema(ctx sdk.Context, attr types.Attribute) error {
+	schema, err := k.GetAttributeSchema(ctx, attr.Name)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+	size := len(schema.JsonSchema) + len(attr.Value)
+	ctx.GasMeter().ConsumeGas(uint64(size)*types.SchemaValidationGasPerByte, "attribute schema validation")
+	return schema.ValidateValue(attr.AttributeType, attr.Value)
+}
+
+// importAttributeSchema stores an attribute schema without any ownership checks.
+func (k Keeper) importAttributeSchema(ctx sdk.Context, schema types.AttributeSchema) {
+	bz := k.cdc.MustMarshal(&schema)
+	ctx.KVStore(k.storeKey).Set(types.AttributeSchemaKey(schema.Name), bz)
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func (s *KeeperTestSuite) TestSetAttributeSchema() {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	defer s.app.AttributeKeeper.SetParams(s.ctx, params)
+	s.app.AttributeKeeper.SetParams(s.ctx, types.Params{MaxValueLength: 100})
+
+	jsonSchema := types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, `{"type":"object"}`)
+	protoSchema := types.NewAttributeSchema("attribute", types.AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata")
+
+	tests := []struct {
+		name   string
+		schema types.AttributeSchema
+		owner  sdk.AccAddress
+		exp    string
+	}{
+		{
+			name:   "invalid schema",
+			schema: types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, ""),
+			owner:  s.user1Addr,
+			exp:    "json schema required for type ATTRIBUTE_TYPE_JSON",
+		},
+		{
+			name:   "json schema longer than max value length",
+			schema: types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, `{"description":"`+strings.Repeat("x", 100)+`"}`),
+			owner:  s.user1Addr,
+			exp:    "json schema length of 118 exceeds max length 100",
+		},
+		{
+			name:   "owner account does not exist",
+			schema: jsonSchema,
+			owner:  s.user2Addr,
+			exp:    "no account found for owner address \"" + s.user2 + "\"",
+		},
+		{
+			name:   "name does not exist",
+			schema: types.NewAttributeSchema("missing.attribute", types.AttributeType_JSON, `{}`),
+			owner:  s.user1Addr,
+			exp:    "\"missing.attribute\" does not resolve to address \"" + s.user1 + "\"",
+		},
+		{
+			name:   "unknown proto type",
+			schema: types.NewAttributeSchema("attribute", types.AttributeType_Proto, "/not.a.Thing"),
+			owner:  s.user1Addr,
+			exp:    "invalid proto type url \"/not.a.Thing\"",
+		},
+		{
+			name:   "json schema",
+			schema: jsonSchema,
+			owner:  s.user1Addr,
+		},
+		{
+			name:   "proto schema",
+			schema: protoSchema,
+			owner:  s.user1Addr,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			err := s.app.AttributeKeeper.SetAttributeSchema(ctx, tc.schema, tc.owner)
+			if len(tc.exp) > 0 {
+				s.Require().ErrorContains(err, tc.exp, "SetAttributeSchema")
+				return
+			}
+			s.Require().NoError(err, "SetAttributeSchema")
+
+			stored, err := s.app.AttributeKeeper.GetAttributeSchema(s.ctx, tc.schema.Name)
+			s.Require().NoError(err, "GetAttributeSchema")
+			s.Assert().Equal(&tc.schema, stored, "stored schema")
+
+			events := em.Events()
+			if s.Assert().Len(events, 1, "events emitted") {
+				s.Assert().Equal("provenance.attribute.v1.EventAttributeSchemaSet", events[0].Type, "event type")
+			}
+		})
+	}
+
+	var schemas []types.AttributeSchema
+	err := s.app.AttributeKeeper.IterateAttributeSchemas(s.ctx, func(schema types.AttributeSchema) bool {
+		schemas = append(schemas, schema)
+		return false
+	})
+	s.Require().NoError(err, "IterateAttributeSchemas")
+	s.Assert().ElementsMatch([]types.AttributeSchema{jsonSchema, protoSchema}, schemas, "all schemas")
+}
+
+func (s *KeeperTestSuite) TestDeleteAttributeSchema() {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	defer s.app.AttributeKeeper.SetParams(s.ctx, params)
+	s.app.AttributeKeeper.SetParams(s.ctx, types.Params{MaxValueLength: 100})
+
+	schema := types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, `{"type":"object"}`)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx, schema, s.user1Addr), "SetAttributeSchema")
+
+	err := s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx, schema.Name, s.user2Addr)
+	s.Assert().EqualError(err, "no account found for owner address \""+s.user2+"\"", "DeleteAttributeSchema wrong owner")
+
+	err = s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx, "attribute", s.user1Addr)
+	s.Assert().EqualError(err, "no schema found for attribute \"attribute\"", "DeleteAttributeSchema no schema")
+
+	em := sdk.NewEventManager()
+	err = s.app.AttributeKeeper.DeleteAttributeSchema(s.ctx.WithEventManager(em), schema.Name, s.user1Addr)
+	s.Require().NoError(err, "DeleteAttributeSchema")
+	events := em.Events()
+	if s.Assert().Len(events, 1, "events emitted") {
+		s.Assert().Equal("provenance.attribute.v1.EventAttributeSchemaDeleted", events[0].Type, "event type")
+	}
+
+	stored, err := s.app.AttributeKeeper.GetAttributeSchema(s.ctx, schema.Name)
+	s.Require().NoError(err, "GetAttributeSchema")
+	s.Assert().Nil(stored, "schema after delete")
+}
+
+func (s *KeeperTestSuite) TestAttributeSchemaEnforcement() {
+	params := s.app.AttributeKeeper.GetParams(s.ctx)
+	defer s.app.AttributeKeeper.SetParams(s.ctx, params)
+	s.app.AttributeKeeper.SetParams(s.ctx, types.Params{MaxValueLength: 1000})
+
+	metadata := banktypes.Metadata{Base: "nhash", Display: "hash"}
+	metadataBz, err := metadata.Marshal()
+	s.Require().NoError(err, "metadata.Marshal()")
+
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx,
+		types.NewAttributeSchema("example.attribute", types.AttributeType_JSON, `{"type":"object","required":["id"]}`), s.user1Addr),
+		"SetAttributeSchema json")
+	s.Require().NoError(s.app.AttributeKeeper.SetAttributeSchema(s.ctx,
+		types.NewAttributeSchema("attribute", types.AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata"), s.user1Addr),
+		"SetAttributeSchema proto")
+
+	newAttr := func(name string, attrType types.AttributeType, value []byte) types.Attribute {
+		return types.NewAttribute(name, s.user1, attrType, value, nil)
+	}
+
+	validJSON := newAttr("example.attribute", types.AttributeType_JSON, []byte(`{"id":1}`))
+	s.Assert().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, validJSON, s.user1Addr), "SetAttribute valid json")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("example.attribute", types.AttributeType_JSON, []byte(`{"name":"x"}`)), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" value does not conform to its schema: $: missing required property "id"`, "SetAttribute invalid json")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("example.attribute", types.AttributeType_String, []byte("x")), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" must have type ATTRIBUTE_TYPE_JSON`, "SetAttribute wrong type")
+
+	s.Assert().NoError(s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("attribute", types.AttributeType_Proto, metadataBz), s.user1Addr), "SetAttribute valid proto")
+	err = s.app.AttributeKeeper.SetAttribute(s.ctx, newAttr("attribute", types.AttributeType_Proto, []byte{0xff, 0xff}), s.user1Addr)
+	s.Assert().ErrorContains(err, `attribute "attribute" value does not conform to its schema: `, "SetAttribute invalid proto")
+
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, validJSON, newAttr("example.attribute", types.AttributeType_JSON, []byte(`[]`)), s.user1Addr)
+	s.Assert().EqualError(err, `attribute "example.attribute" value does not conform to its schema: $: expected object, got array`, "UpdateAttribute invalid json")
+	err = s.app.AttributeKeeper.UpdateAttribute(s.ctx, validJSON, newAttr("example.attribute", types.AttributeType_JSON, []byte(`{"id":2}`)), s.user1Addr)
+	s.Assert().NoError(err, "UpdateAttribute valid json")
+
+	s.Run("gas is charged for the schema and value", func() {
+		value := []byte(`{"id":3}`)
+		schemaLen := len(`{"type":"object","required":["id"]}`)
+		gasUsed := func(withSchema bool) storetypes.Gas {
+			ctx, _ := s.ctx.CacheContext()
+			if !withSchema {
+				s.Require().NoError(s.app.AttributeKeeper.DeleteAttributeSchema(ctx, "example.attribute", s.user1Addr), "DeleteAttributeSchema")
+			}
+			ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+			attr := newAttr("example.attribute", types.AttributeType_JSON, value)
+			s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr, s.user1Addr), "SetAttribute")
+			return ctx.GasMeter().GasConsumed()
+		}
+		withSchema := gasUsed(true)
+		withoutSchema := gasUsed(false)
+		expExtra := uint64(schemaLen+len(value)) * types.SchemaValidationGasPerByte
+		s.Assert().GreaterOrEqual(withSchema-withoutSchema, expExtra, "extra gas used with a schema")
+	})
+
+	// Genesis imports are not checked against schemas.
+	genState := s.app.AttributeKeeper.ExportGenesis(s.ctx)
+	genState.Attributes = append(genState.Attributes, newAttr("example.attribute", types.AttributeType_String, []byte("x")))
+	s.Assert().NotPanics(func() { s.app.AttributeKeeper.InitGenesis(s.ctx, genState) }, "InitGenesis")
+	s.Assert().Len(genState.Schemas, 2, "exported schemas")
+}
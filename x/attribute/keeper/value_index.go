@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// addAttributeValueIndex safely adds an attribute to the value index if its type is indexed, else no-op
+func (k Keeper) addAttributeValueIndex(store storetypes.KVStore, attr types.Attribute) {
+	indexKey := types.AttributeValueIndexKey(attr)
+	if indexKey != nil {
+		store.Set(indexKey, types.AddrAttributeKey(attr.GetAddressBytes(), attr))
+	}
+}
+
+// deleteAttributeValueIndex safely removes an attribute from the value index if its type is indexed, else no-op
+func (k Keeper) deleteAttributeValueIndex(store storetypes.KVStore, attr types.Attribute) {
+	indexKey := types.AttributeValueIndexKey(attr)
+	if indexKey != nil {
+		store.Delete(indexKey)
+	}
+}
+
+// IterateAttributeValueRange iterates over the attributes with the given name and type that have values between
+// min and max (both inclusive) in value order, using the provided page request.
+// An empty min or max means that end of the range is unbounded.
+func (k Keeper) IterateAttributeValueRange(
+	ctx sdk.Context, name string, attrType types.AttributeType, min, max string, pageReq *query.PageRequest,
+	handle Handler,
+) (*query.PageResponse, error) {
+	if !types.IsIndexedAttributeType(attrType) {
+		return nil, fmt.Errorf("attribute type %s is not indexed", attrType)
+	}
+	var start, end []byte
+	if len(min) > 0 {
+		minBz, err := types.EncodeIndexValue(attrType, []byte(min))
+		if err != nil {
+			return nil, fmt.Errorf("invalid min: %w", err)
+		}
+		start = minBz
+	}
+	if len(max) > 0 {
+		maxBz, err := types.EncodeIndexValue(attrType, []byte(max))
+		if err != nil {
+			return nil, fmt.Errorf("invalid max: %w", err)
+		}
+		if start != nil && bytes.Compare(start, maxBz) > 0 {
+			return nil, fmt.Errorf("min %q is greater than max %q", min, max)
+		}
+		end = storetypes.PrefixEndBytes(maxBz)
+	}
+	return k.paginateAttributeValueIndex(ctx, types.AttributeValueIndexTypePrefix(name, attrType), start, end, pageReq, handle)
+}
+
+// IterateAttributeValuePrefix iterates over the string attributes with the given name that have values starting
+// with the given prefix in value order, using the provided page request.
+func (k Keeper) IterateAttributeValuePrefix(
+	ctx sdk.Context, name string, valuePrefix string, pageReq *query.PageRequest, handle Handler,
+) (*query.PageResponse, error) {
+	start := types.EncodeIndexStringPrefix(valuePrefix)
+	end := storetypes.PrefixEndBytes(start)
+	return k.paginateAttributeValueIndex(ctx, types.AttributeValueIndexTypePrefix(name, types.AttributeType_String), start, end, pageReq, handle)
+}
+
+// paginateAttributeValueIndex is similar to query.Paginate except it only iterates over the index entries
+// (under the given prefix) in the range [start, end), and provides the indexed attributes to the handler.
+func (k Keeper) paginateAttributeValueIndex(
+	ctx sdk.Context, indexPrefix []byte, start, end []byte, pageRequest *query.PageRequest, handle Handler,
+) (*query.PageResponse, error) {
+	// if the PageRequest is nil, use default PageRequest
+	if pageRequest == nil {
+		pageRequest = &query.PageRequest{}
+	}
+
+	offset := pageRequest.Offset
+	key := pageRequest.Key
+	limit := pageRequest.Limit
+	countTotal := pageRequest.CountTotal
+	reverse := pageRequest.Reverse
+
+	if offset > 0 && key != nil {
+		return nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+
+	if limit == 0 {
+		limit = query.DefaultLimit
+
+		// count total results when the limit is zero/not supplied
+		countTotal = true
+	}
+
+	// When using a key, the total is not counted, and the key is the first entry to provide.
+	if len(key) != 0 {
+		countTotal = false
+		if reverse {
+			end = append(bytes.Clone(key), 0x00)
+		} else {
+			start = key
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, indexPrefix)
+	var iterator storetypes.Iterator
+	if reverse {
+		iterator = indexStore.ReverseIterator(start, end)
+	} else {
+		iterator = indexStore.Iterator(start, end)
+	}
+	defer iterator.Close()
+
+	var (
+		count   uint64
+		nextKey []byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count <= offset {
+			continue
+		}
+		if count <= offset+limit {
+			bz := store.Get(iterator.Value())
+			if bz == nil {
+				return nil, fmt.Errorf("no attribute found for value index entry %X", iterator.Key())
+			}
+			var attr types.Attribute
+			if err := k.cdc.Unmarshal(bz, &attr); err != nil {
+				return nil, err
+			}
+			if err := handle(attr); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if nextKey == nil {
+			nextKey = iterator.Key()
+		}
+		if !countTotal {
+			break
+		}
+	}
+
+	res := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		res.Total = count
+	}
+	return res, nil
+}
+
+// buildAttributeValueIndex adds all existing attributes to the value index.
+func (k Keeper) buildAttributeValueIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	return k.IterateRecords(ctx, types.AttributeKeyPrefix, func(attr types.Attribute) error {
+		k.addAttributeValueIndex(store, attr)
+		return nil
+	})
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/attribute/keeper"
+	"github.com/provenance-io/provenance/x/attribute/types"
+)
+
+// getAttributeValues returns the values of the provided attributes as strings.
+func getAttributeValues(attrs []types.Attribute) []string {
+	rv := make([]string, len(attrs))
+	for i, attr := range attrs {
+		rv[i] = string(attr.Value)
+	}
+	return rv
+}
+
+// collectAttributes returns a keeper.Handler that appends each attribute to the provided slice.
+func collectAttributes(attrs *[]types.Attribute) keeper.Handler {
+	return func(attr types.Attribute) error {
+		*attrs = append(*attrs, attr)
+		return nil
+	}
+}
+
+func (s *KeeperTestSuite) TestAttributeValueIndex() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	addrs := []string{s.user1, s.user2, sdk.AccAddress("addr3_______________").String(), sdk.AccAddress("addr4_______________").String()}
+	k := s.app.AttributeKeeper
+
+	setAttr := func(addr string, attrType types.AttributeType, value string) types.Attribute {
+		attr := types.NewAttribute("example.attribute", addr, attrType, []byte(value), nil)
+		s.Require().NoError(k.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s %q)", attrType, value)
+		return attr
+	}
+	for i, level := range []string{"3", "-1", "10", "2"} {
+		setAttr(addrs[i], types.AttributeType_Int, level)
+	}
+	for i, juris := range []string{"US-NY", "CA-ON", "US-CA", "USA"} {
+		setAttr(addrs[i], types.AttributeType_String, juris)
+	}
+	setAttr(s.user1, types.AttributeType_Float, "1.5")
+	setAttr(s.user1, types.AttributeType_JSON, `{}`)
+
+	getRange := func(attrType types.AttributeType, min, max string, pageReq *query.PageRequest) ([]string, *query.PageResponse) {
+		var attrs []types.Attribute
+		pageResp, err := k.IterateAttributeValueRange(s.ctx, "example.attribute", attrType, min, max, pageReq, collectAttributes(&attrs))
+		s.Require().NoError(err, "IterateAttributeValueRange(%s, %q, %q)", attrType, min, max)
+		return getAttributeValues(attrs), pageResp
+	}
+	getPrefix := func(valuePrefix string) []string {
+		var attrs []types.Attribute
+		_, err := k.IterateAttributeValuePrefix(s.ctx, "example.attribute", valuePrefix, nil, collectAttributes(&attrs))
+		s.Require().NoError(err, "IterateAttributeValuePrefix(%q)", valuePrefix)
+		return getAttributeValues(attrs)
+	}
+
+	s.Run("int ranges", func() {
+		values, _ := getRange(types.AttributeType_Int, "", "", nil)
+		s.Assert().Equal([]string{"-1", "2", "3", "10"}, values, "all ints")
+		values, _ = getRange(types.AttributeType_Int, "2", "", nil)
+		s.Assert().Equal([]string{"2", "3", "10"}, values, ">= 2")
+		values, _ = getRange(types.AttributeType_Int, "", "3", nil)
+		s.Assert().Equal([]string{"-1", "2", "3"}, values, "<= 3")
+		values, _ = getRange(types.AttributeType_Int, "0", "9", nil)
+		s.Assert().Equal([]string{"2", "3"}, values, "0 to 9")
+	})
+
+	s.Run("string range and prefix", func() {
+		values, _ := getRange(types.AttributeType_String, "CA", "US-Z", nil)
+		s.Assert().Equal([]string{"CA-ON", "US-CA", "US-NY"}, values, "CA to US-Z")
+		s.Assert().Equal([]string{"US-CA", "US-NY"}, getPrefix("US-"), "prefix US-")
+		s.Assert().Equal([]string{"US-CA", "US-NY", "USA"}, getPrefix("US"), "prefix US")
+		s.Assert().Empty(getPrefix("MX"), "prefix MX")
+	})
+
+	s.Run("pagination", func() {
+		values, pageResp := getRange(types.AttributeType_Int, "", "", &query.PageRequest{Limit: 3, CountTotal: true})
+		s.Assert().Equal([]string{"-1", "2", "3"}, values, "first page")
+		s.Assert().Equal(4, int(pageResp.Total), "total")
+		s.Require().NotEmpty(pageResp.NextKey, "next key")
+		values, pageResp = getRange(types.AttributeType_Int, "", "", &query.PageRequest{Key: pageResp.NextKey, Limit: 3})
+		s.Assert().Equal([]string{"10"}, values, "second page")
+		s.Assert().Empty(pageResp.NextKey, "next key of second page")
+		values, _ = getRange(types.AttributeType_Int, "", "", &query.PageRequest{Offset: 1, Limit: 2})
+		s.Assert().Equal([]string{"2", "3"}, values, "with offset")
+		values, pageResp = getRange(types.AttributeType_Int, "", "", &query.PageRequest{Limit: 2, Reverse: true})
+		s.Assert().Equal([]string{"10", "3"}, values, "reversed first page")
+		values, _ = getRange(types.AttributeType_Int, "", "", &query.PageRequest{Key: pageResp.NextKey, Limit: 2, Reverse: true})
+		s.Assert().Equal([]string{"2", "-1"}, values, "reversed second page")
+	})
+
+	s.Run("index follows updates and deletes", func() {
+		orig := types.NewAttribute("example.attribute", addrs[1], types.AttributeType_Int, []byte("-1"), nil)
+		updated := types.NewAttribute("example.attribute", addrs[1], types.AttributeType_Int, []byte("5"), nil)
+		s.Require().NoError(k.UpdateAttribute(s.ctx, orig, updated, s.user1Addr), "UpdateAttribute")
+		values, _ := getRange(types.AttributeType_Int, "", "", nil)
+		s.Assert().Equal([]string{"2", "3", "5", "10"}, values, "ints after update")
+
+		value := []byte("US-CA")
+		s.Require().NoError(k.DeleteAttribute(s.ctx, addrs[2], "example.attribute", &value, s.user1Addr), "DeleteAttribute")
+		s.Assert().Equal([]string{"US-NY"}, getPrefix("US-"), "prefix US- after delete")
+	})
+
+	s.Run("errors", func() {
+		_, err := k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_JSON, "", "", nil, collectAttributes(nil))
+		s.Assert().EqualError(err, "attribute type ATTRIBUTE_TYPE_JSON is not indexed", "json range")
+		_, err = k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_Int, "x", "", nil, collectAttributes(nil))
+		s.Assert().EqualError(err, `invalid min: invalid int value "x"`, "bad min")
+		_, err = k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_Int, "", "x", nil, collectAttributes(nil))
+		s.Assert().EqualError(err, `invalid max: invalid int value "x"`, "bad max")
+		_, err = k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_Int, "5", "1", nil, collectAttributes(nil))
+		s.Assert().EqualError(err, `min "5" is greater than max "1"`, "min > max")
+	})
+
+	s.Run("purge", func() {
+		s.Require().NoError(k.PurgeAttribute(s.ctx, "example.attribute", s.user1Addr), "PurgeAttribute")
+		values, _ := getRange(types.AttributeType_Int, "", "", nil)
+		s.Assert().Empty(values, "ints after purge")
+		s.Assert().Empty(getPrefix(""), "strings after purge")
+	})
+}
+
+func (s *KeeperTestSuite) TestMigrate2to3() {
+	s.app.AccountKeeper.SetAccount(s.ctx, s.app.AccountKeeper.NewAccountWithAddress(s.ctx, s.user2Addr))
+	k := s.app.AttributeKeeper
+	for _, addr := range []string{s.user1, s.user2} {
+		for _, level := range []string{"1", "2"} {
+			attr := types.NewAttribute("example.attribute", addr, types.AttributeType_Int, []byte(level), nil)
+			s.Require().NoError(k.SetAttribute(s.ctx, attr, s.user1Addr), "SetAttribute(%s, %s)", addr, level)
+		}
+	}
+
+	// Remove the index entries to mimic state from before the index existed.
+	store := s.ctx.KVStore(s.app.GetKey(types.StoreKey))
+	var indexKeys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.AttributeValueIndexKeyPrefix)
+	for ; it.Valid(); it.Next() {
+		indexKeys = append(indexKeys, it.Key())
+	}
+	s.Require().NoError(it.Close(), "closing iterator")
+	s.Require().Len(indexKeys, 4, "index entries")
+	for _, key := range indexKeys {
+		store.Delete(key)
+	}
+
+	var attrs []types.Attribute
+	_, err := k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_Int, "2", "", nil, collectAttributes(&attrs))
+	s.Require().NoError(err, "IterateAttributeValueRange before migration")
+	s.Require().Empty(attrs, "attributes before migration")
+
+	s.Require().NoError(keeper.NewMigrator(k).Migrate2to3(s.ctx), "Migrate2to3")
+	_, err = k.IterateAttributeValueRange(s.ctx, "example.attribute", types.AttributeType_Int, "2", "", nil, collectAttributes(&attrs))
+	s.Require().NoError(err, "IterateAttributeValueRange after migration")
+	s.Assert().Equal([]string{"2", "2"}, getAttributeValues(attrs), "values after migration")
+}
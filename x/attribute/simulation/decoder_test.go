@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/app"
@@ -18,10 +19,15 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	testAttributeRecord := types.NewAttribute("test", "", types.AttributeType_Int, []byte{1}, nil)
+	testAttributeSchema := types.NewAttributeSchema("test", types.AttributeType_JSON, `{"type":"object"}`)
+	testIssuerAddr := sdk.AccAddress("issuer______________")
+	testAttributeIssuer := types.NewAttributeIssuer("test", testIssuerAddr.String(), []types.IssuerPermission{types.IssuerPermission_Add}, nil)
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.AttributeKeyPrefix, Value: cdc.MustMarshal(&testAttributeRecord)},
+			{Key: types.AttributeSchemaKey("test"), Value: cdc.MustMarshal(&testAttributeSchema)},
+			{Key: types.AttributeIssuerKey("test", testIssuerAddr), Value: cdc.MustMarshal(&testAttributeIssuer)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -31,6 +37,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Attribute Record", fmt.Sprintf("%v\n%v", testAttributeRecord, testAttributeRecord)},
+		{"Attribute Schema", fmt.Sprintf("%v\n%v", testAttributeSchema, testAttributeSchema)},
+		{"Attribute Issuer", fmt.Sprintf("%v\n%v", testAttributeIssuer, testAttributeIssuer)},
 		{"other", ""},
 	}
 
@@ -27,6 +27,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// IssuerPermission defines an action that an attribute issuer can be allowed to take.
+type IssuerPermission int32
+
+const (
+	// ISSUER_PERMISSION_UNSPECIFIED defines a no-op permission.
+	IssuerPermission_Unspecified IssuerPermission = 0
+	// ISSUER_PERMISSION_ADD allows adding attributes.
+	IssuerPermission_Add IssuerPermission = 1
+	// ISSUER_PERMISSION_UPDATE allows updating the value or expiration of attributes.
+	IssuerPermission_Update IssuerPermission = 2
+	// ISSUER_PERMISSION_DELETE allows deleting attributes.
+	IssuerPermission_Delete IssuerPermission = 3
+)
+
+var IssuerPermission_name = map[int32]string{
+	0: "ISSUER_PERMISSION_UNSPECIFIED",
+	1: "ISSUER_PERMISSION_ADD",
+	2: "ISSUER_PERMISSION_UPDATE",
+	3: "ISSUER_PERMISSION_DELETE",
+}
+
+var IssuerPermission_value = map[string]int32{
+	"ISSUER_PERMISSION_UNSPECIFIED": 0,
+	"ISSUER_PERMISSION_ADD":         1,
+	"ISSUER_PERMISSION_UPDATE":      2,
+	"ISSUER_PERMISSION_DELETE":      3,
+}
+
+func (x IssuerPermission) String() string {
+	return proto.EnumName(IssuerPermission_name, int32(x))
+}
+
+func (IssuerPermission) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{0}
+}
+
 // AttributeType defines the type of the data stored in the attribute value
 type AttributeType int32
 
@@ -80,7 +116,7 @@ func (x AttributeType) String() string {
 }
 
 func (AttributeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{0}
+	return fileDescriptor_14fe7eb43c711f5e, []int{1}
 }
 
 // Params defines the set of params for the attribute module.
@@ -210,6 +246,153 @@ func (m *Attribute) GetExpirationDate() *time.Time {
 	return nil
 }
 
+// AttributeSchema defines the schema that the values of all attributes with a given name must conform to.
+type AttributeSchema struct {
+	// name is the attribute name that this schema applies to.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// attribute_type is the type that attributes with this name must have.
+	// It must be either ATTRIBUTE_TYPE_JSON or ATTRIBUTE_TYPE_PROTO.
+	AttributeType AttributeType `protobuf:"varint,2,opt,name=attribute_type,json=attributeType,proto3,enum=provenance.attribute.v1.AttributeType" json:"attribute_type,omitempty"`
+	// json_schema is a JSON Schema document that values must conform to. Only used with ATTRIBUTE_TYPE_JSON.
+	JsonSchema string `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	// proto_type_url is the type url of the proto message that values must be. Only used with ATTRIBUTE_TYPE_PROTO.
+	ProtoTypeUrl string `protobuf:"bytes,4,opt,name=proto_type_url,json=protoTypeUrl,proto3" json:"proto_type_url,omitempty"`
+}
+
+func (m *AttributeSchema) Reset()         { *m = AttributeSchema{} }
+func (m *AttributeSchema) String() string { return proto.CompactTextString(m) }
+func (*AttributeSchema) ProtoMessage()    {}
+func (*AttributeSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+}
+func (m *AttributeSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeSchema.Merge(m, src)
+}
+func (m *AttributeSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeSchema proto.InternalMessageInfo
+
+func (m *AttributeSchema) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetAttributeType() AttributeType {
+	if m != nil {
+		return m.AttributeType
+	}
+	return AttributeType_Unspecified
+}
+
+func (m *AttributeSchema) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
+func (m *AttributeSchema) GetProtoTypeUrl() string {
+	if m != nil {
+		return m.ProtoTypeUrl
+	}
+	return ""
+}
+
+// AttributeIssuer is an account that a name's owner has allowed to manage attributes with that name.
+type AttributeIssuer struct {
+	// name is the attribute name that the issuer may manage.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// issuer is the bech32 address of the account being allowed to manage attributes.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// permissions are the actions the issuer is allowed to take.
+	Permissions []IssuerPermission `protobuf:"varint,3,rep,packed,name=permissions,proto3,enum=provenance.attribute.v1.IssuerPermission" json:"permissions,omitempty"`
+	// expiration is the time after which the issuer may no longer manage attributes. If not set, it does not expire.
+	Expiration *time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *AttributeIssuer) Reset()         { *m = AttributeIssuer{} }
+func (m *AttributeIssuer) String() string { return proto.CompactTextString(m) }
+func (*AttributeIssuer) ProtoMessage()    {}
+func (*AttributeIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+}
+func (m *AttributeIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeIssuer.Merge(m, src)
+}
+func (m *AttributeIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeIssuer proto.InternalMessageInfo
+
+func (m *AttributeIssuer) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeIssuer) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *AttributeIssuer) GetPermissions() []IssuerPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *AttributeIssuer) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 // EventAttributeAdd event emitted when attribute is added
 type EventAttributeAdd struct {
 	Name       string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -218,13 +401,15 @@ type EventAttributeAdd struct {
 	Account    string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
 	Owner      string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
 	Expiration string `protobuf:"bytes,6,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// issuer is the delegated issuer that added the attribute. It is empty if the name's owner added it.
+	Issuer string `protobuf:"bytes,7,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *EventAttributeAdd) Reset()         { *m = EventAttributeAdd{} }
 func (m *EventAttributeAdd) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeAdd) ProtoMessage()    {}
 func (*EventAttributeAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{2}
+	return fileDescriptor_14fe7eb43c711f5e, []int{4}
 }
 func (m *EventAttributeAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,6 +480,13 @@ func (m *EventAttributeAdd) GetExpiration() string {
 	return ""
 }
 
+func (m *EventAttributeAdd) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 // EventAttributeUpdate event emitted when attribute is updated
 type EventAttributeUpdate struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -304,13 +496,15 @@ type EventAttributeUpdate struct {
 	UpdateType    string `protobuf:"bytes,5,opt,name=update_type,json=updateType,proto3" json:"update_type,omitempty"`
 	Account       string `protobuf:"bytes,6,opt,name=account,proto3" json:"account,omitempty"`
 	Owner         string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+	// issuer is the delegated issuer that updated the attribute. It is empty if the name's owner updated it.
+	Issuer string `protobuf:"bytes,8,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *EventAttributeUpdate) Reset()         { *m = EventAttributeUpdate{} }
 func (m *EventAttributeUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeUpdate) ProtoMessage()    {}
 func (*EventAttributeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{3}
+	return fileDescriptor_14fe7eb43c711f5e, []int{5}
 }
 func (m *EventAttributeUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,6 +582,13 @@ func (m *EventAttributeUpdate) GetOwner() string {
 	return ""
 }
 
+func (m *EventAttributeUpdate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 // EventAttributeExpirationUpdate event emitted when attribute expiration is updated
 type EventAttributeExpirationUpdate struct {
 	Name               string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -396,13 +597,15 @@ type EventAttributeExpirationUpdate struct {
 	Owner              string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
 	OriginalExpiration string `protobuf:"bytes,5,opt,name=original_expiration,json=originalExpiration,proto3" json:"original_expiration,omitempty"`
 	UpdatedExpiration  string `protobuf:"bytes,6,opt,name=updated_expiration,json=updatedExpiration,proto3" json:"updated_expiration,omitempty"`
+	// issuer is the delegated issuer that updated the expiration. It is empty if the name's owner updated it.
+	Issuer string `protobuf:"bytes,7,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *EventAttributeExpirationUpdate) Reset()         { *m = EventAttributeExpirationUpdate{} }
 func (m *EventAttributeExpirationUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpirationUpdate) ProtoMessage()    {}
 func (*EventAttributeExpirationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{4}
+	return fileDescriptor_14fe7eb43c711f5e, []int{6}
 }
 func (m *EventAttributeExpirationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -473,18 +676,27 @@ func (m *EventAttributeExpirationUpdate) GetUpdatedExpiration() string {
 	return ""
 }
 
+func (m *EventAttributeExpirationUpdate) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 // EventAttributeDelete event emitted when attribute is deleted
 type EventAttributeDelete struct {
 	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 	Owner   string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// issuer is the delegated issuer that deleted the attribute. It is empty if the name's owner deleted it.
+	Issuer string `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *EventAttributeDelete) Reset()         { *m = EventAttributeDelete{} }
 func (m *EventAttributeDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDelete) ProtoMessage()    {}
 func (*EventAttributeDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{5}
+	return fileDescriptor_14fe7eb43c711f5e, []int{7}
 }
 func (m *EventAttributeDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,6 +746,13 @@ func (m *EventAttributeDelete) GetOwner() string {
 	return ""
 }
 
+func (m *EventAttributeDelete) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 // EventAttributeDistinctDelete event emitted when attribute is deleted with matching value
 type EventAttributeDistinctDelete struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -541,13 +760,15 @@ type EventAttributeDistinctDelete struct {
 	AttributeType string `protobuf:"bytes,3,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
 	Account       string `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
 	Owner         string `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	// issuer is the delegated issuer that deleted the attribute. It is empty if the name's owner deleted it.
+	Issuer string `protobuf:"bytes,6,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
 func (m *EventAttributeDistinctDelete) Reset()         { *m = EventAttributeDistinctDelete{} }
 func (m *EventAttributeDistinctDelete) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeDistinctDelete) ProtoMessage()    {}
 func (*EventAttributeDistinctDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{6}
+	return fileDescriptor_14fe7eb43c711f5e, []int{8}
 }
 func (m *EventAttributeDistinctDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,6 +832,13 @@ func (m *EventAttributeDistinctDelete) GetOwner() string {
 	return ""
 }
 
+func (m *EventAttributeDistinctDelete) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
 // EventAttributeExpired event emitted when attribute has expired and been deleted in BeginBlocker
 type EventAttributeExpired struct {
 	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -624,7 +852,7 @@ func (m *EventAttributeExpired) Reset()         { *m = EventAttributeExpired{} }
 func (m *EventAttributeExpired) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeExpired) ProtoMessage()    {}
 func (*EventAttributeExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{7}
+	return fileDescriptor_14fe7eb43c711f5e, []int{9}
 }
 func (m *EventAttributeExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -697,7 +925,7 @@ func (m *EventAccountDataUpdated) Reset()         { *m = EventAccountDataUpdated
 func (m *EventAccountDataUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAccountDataUpdated) ProtoMessage()    {}
 func (*EventAccountDataUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{8}
+	return fileDescriptor_14fe7eb43c711f5e, []int{10}
 }
 func (m *EventAccountDataUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -742,7 +970,7 @@ func (m *EventAttributeParamsUpdated) Reset()         { *m = EventAttributeParam
 func (m *EventAttributeParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventAttributeParamsUpdated) ProtoMessage()    {}
 func (*EventAttributeParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14fe7eb43c711f5e, []int{9}
+	return fileDescriptor_14fe7eb43c711f5e, []int{11}
 }
 func (m *EventAttributeParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,170 +1006,359 @@ func (m *EventAttributeParamsUpdated) GetMaxValueLength() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
-	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
-	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
-	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
-	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
-	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
-	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
-	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
-	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
-	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
-	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+// EventAttributeSchemaSet event emitted when an attribute schema is set.
+type EventAttributeSchemaSet struct {
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AttributeType string `protobuf:"bytes,2,opt,name=attribute_type,json=attributeType,proto3" json:"attribute_type,omitempty"`
+	Owner         string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+func (m *EventAttributeSchemaSet) Reset()         { *m = EventAttributeSchemaSet{} }
+func (m *EventAttributeSchemaSet) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeSchemaSet) ProtoMessage()    {}
+func (*EventAttributeSchemaSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{12}
 }
-
-var fileDescriptor_14fe7eb43c711f5e = []byte{
-	// 853 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0xd6, 0x5a, 0x3f, 0x36, 0xc7, 0xb6, 0xc2, 0x6c, 0x1c, 0x44, 0x60, 0x5b, 0x89, 0x51, 0xe0,
-	0x56, 0x28, 0x10, 0x11, 0x71, 0xd0, 0x4b, 0x6f, 0x56, 0x25, 0xa7, 0x2a, 0x12, 0x5b, 0xa0, 0xa8,
-	0x02, 0xc9, 0x85, 0x58, 0x4b, 0x1b, 0x89, 0x80, 0xf8, 0x03, 0x72, 0xa5, 0xda, 0xaf, 0xa0, 0x53,
-	0x8e, 0xbd, 0x08, 0x6d, 0xcf, 0x7d, 0x91, 0x1c, 0x73, 0x2c, 0x7a, 0x48, 0x0b, 0xfb, 0xd6, 0x6b,
-	0x5f, 0xa0, 0xd0, 0xae, 0xf9, 0x23, 0x9a, 0x72, 0x51, 0xf4, 0xb6, 0x33, 0xfb, 0xed, 0xcc, 0xf7,
-	0xcd, 0xec, 0xce, 0xc2, 0x17, 0x9e, 0xef, 0xce, 0xa9, 0x43, 0x9c, 0x21, 0xd5, 0x08, 0x63, 0xbe,
-	0x75, 0x3e, 0x63, 0x54, 0x9b, 0x3f, 0x8b, 0x8d, 0xa6, 0xe7, 0xbb, 0xcc, 0xc5, 0x8f, 0x62, 0x60,
-	0x33, 0xde, 0x9b, 0x3f, 0x53, 0x0e, 0xc6, 0xee, 0xd8, 0xe5, 0x18, 0x6d, 0xb5, 0x12, 0x70, 0xa5,
-	0x36, 0x76, 0xdd, 0xf1, 0x94, 0x6a, 0xdc, 0x3a, 0x9f, 0xbd, 0xd5, 0x98, 0x65, 0xd3, 0x80, 0x11,
-	0xdb, 0x13, 0x80, 0xfa, 0x11, 0x94, 0x7a, 0xc4, 0x27, 0x76, 0x80, 0x1b, 0x20, 0xdb, 0xe4, 0xc2,
-	0x9c, 0x93, 0xe9, 0x8c, 0x9a, 0x53, 0xea, 0x8c, 0xd9, 0xa4, 0x82, 0x54, 0xd4, 0xd8, 0xd7, 0xcb,
-	0x36, 0xb9, 0xf8, 0x7e, 0xe5, 0x7e, 0xc9, 0xbd, 0xf5, 0xbf, 0x11, 0x48, 0xc7, 0x61, 0x6e, 0x8c,
-	0xa1, 0xe0, 0x10, 0x9b, 0x72, 0xac, 0xa4, 0xf3, 0x35, 0x3e, 0x80, 0x22, 0x8f, 0x53, 0xd9, 0x52,
-	0x51, 0x63, 0x4f, 0x17, 0x06, 0x7e, 0x05, 0xe5, 0x88, 0xb2, 0xc9, 0x2e, 0x3d, 0x5a, 0xc9, 0xab,
-	0xa8, 0x51, 0x3e, 0xfa, 0xbc, 0xb9, 0x41, 0x54, 0x33, 0xca, 0x62, 0x5c, 0x7a, 0x54, 0xdf, 0x27,
-	0x49, 0x13, 0x57, 0x60, 0x9b, 0x8c, 0x46, 0x3e, 0x0d, 0x82, 0x4a, 0x81, 0xe7, 0x0e, 0x4d, 0xfc,
-	0x0a, 0xee, 0xd1, 0x0b, 0xcf, 0xf2, 0x09, 0xb3, 0x5c, 0xc7, 0x1c, 0x11, 0x46, 0x2b, 0x45, 0x15,
-	0x35, 0x76, 0x8f, 0x94, 0xa6, 0xa8, 0x47, 0x33, 0xac, 0x47, 0xd3, 0x08, 0xeb, 0xd1, 0xda, 0x79,
-	0xff, 0xb1, 0x86, 0xde, 0xfd, 0x51, 0x43, 0x7a, 0x39, 0x3e, 0xdc, 0x26, 0x8c, 0x7e, 0x5d, 0xf8,
-	0xf1, 0xe7, 0x5a, 0xae, 0xfe, 0x0b, 0x82, 0xfb, 0x9d, 0x39, 0x75, 0x58, 0x44, 0xea, 0x78, 0x34,
-	0xfa, 0x77, 0xf5, 0x52, 0xa8, 0x1e, 0x43, 0x21, 0xd2, 0x2c, 0xe9, 0x7c, 0xcd, 0x25, 0x0c, 0x87,
-	0xee, 0xcc, 0x61, 0x91, 0x04, 0x61, 0xae, 0x62, 0xb8, 0x3f, 0x38, 0xd4, 0xe7, 0xc4, 0x25, 0x5d,
-	0x18, 0xb8, 0x0a, 0x10, 0x73, 0xab, 0x94, 0xf8, 0x56, 0xc2, 0x53, 0xff, 0x0b, 0xc1, 0xc1, 0x3a,
-	0xc7, 0x81, 0xb7, 0x92, 0x9f, 0x49, 0xf3, 0x10, 0xca, 0xae, 0x6f, 0x8d, 0x2d, 0x87, 0x4c, 0xcd,
-	0x24, 0xdf, 0xfd, 0xd0, 0xcb, 0x7b, 0x8e, 0x9f, 0x40, 0xe4, 0x30, 0x13, 0x02, 0xf6, 0x42, 0x27,
-	0xef, 0xc5, 0x63, 0xd8, 0x9b, 0xf1, 0x4c, 0x37, 0x91, 0x84, 0x9a, 0x5d, 0xe1, 0x13, 0x71, 0x6a,
-	0x70, 0x63, 0x8a, 0x28, 0x42, 0x17, 0x08, 0x97, 0x91, 0x2a, 0x46, 0x69, 0x43, 0x31, 0xb6, 0x13,
-	0xc5, 0xa8, 0xff, 0x8e, 0xa0, 0xba, 0x2e, 0xb6, 0x13, 0x55, 0xe2, 0x0e, 0xd9, 0xd9, 0xdd, 0x49,
-	0x24, 0xcf, 0x6f, 0x48, 0x5e, 0x48, 0x76, 0x42, 0x83, 0x07, 0x51, 0x55, 0x12, 0x2d, 0x11, 0xaa,
-	0x70, 0xb8, 0x15, 0x13, 0xc2, 0x4f, 0x01, 0x0b, 0xad, 0x23, 0xf3, 0x56, 0x0b, 0xef, 0xdf, 0xec,
-	0xc4, 0xf0, 0xfa, 0x9b, 0x74, 0x23, 0xdb, 0x74, 0x4a, 0x37, 0x28, 0x4a, 0x70, 0xdf, 0xda, 0xc0,
-	0x3d, 0x9f, 0x2c, 0xdc, 0x4f, 0x08, 0x3e, 0x4d, 0x05, 0xb7, 0x02, 0x66, 0x39, 0x43, 0x76, 0x47,
-	0x92, 0xec, 0xb2, 0x1d, 0x66, 0x3e, 0x69, 0x29, 0xeb, 0xa9, 0xfe, 0x87, 0x7b, 0x5e, 0xff, 0x15,
-	0xc1, 0xc3, 0x8c, 0xd6, 0xd2, 0xec, 0xf7, 0xf6, 0x19, 0x80, 0x98, 0x5a, 0x13, 0x12, 0x4c, 0x6e,
-	0xf8, 0x49, 0xdc, 0xf3, 0x2d, 0x09, 0x26, 0xff, 0x9f, 0xe3, 0xfa, 0xab, 0x2b, 0xde, 0x7a, 0x75,
-	0xcf, 0xe1, 0x91, 0x20, 0x2b, 0xf0, 0x6d, 0xc2, 0x88, 0xb8, 0x7f, 0xa3, 0x64, 0x50, 0xb4, 0x16,
-	0xb4, 0xfe, 0x02, 0x3e, 0x59, 0x57, 0x28, 0xc6, 0x70, 0x78, 0x70, 0xd3, 0x34, 0x96, 0xd2, 0xd3,
-	0xf8, 0xcb, 0x8f, 0x5b, 0xb0, 0xbf, 0x36, 0x27, 0xb1, 0x06, 0xca, 0xb1, 0x61, 0xe8, 0xdd, 0xd6,
-	0xc0, 0xe8, 0x98, 0xc6, 0xeb, 0x5e, 0xc7, 0x1c, 0x9c, 0xf6, 0x7b, 0x9d, 0x6f, 0xba, 0x27, 0xdd,
-	0x4e, 0x5b, 0xce, 0x29, 0xf7, 0x16, 0x4b, 0x75, 0x77, 0xe0, 0x04, 0x1e, 0x1d, 0x5a, 0x6f, 0x2d,
-	0x3a, 0xc2, 0x8f, 0xe1, 0x41, 0xfa, 0xc0, 0xa0, 0xdb, 0x96, 0x91, 0xb2, 0xb3, 0x58, 0xaa, 0x85,
-	0xd5, 0x3a, 0x03, 0xf2, 0x5d, 0xff, 0xec, 0x54, 0xde, 0x12, 0x90, 0xd5, 0x1a, 0x1f, 0xc2, 0xc3,
-	0x14, 0xa4, 0x6f, 0xe8, 0xdd, 0xd3, 0x17, 0x72, 0x5e, 0x81, 0xc5, 0x52, 0x2d, 0xf5, 0x99, 0x6f,
-	0x39, 0x63, 0x5c, 0x03, 0x9c, 0x4e, 0xa6, 0x77, 0xe5, 0x82, 0xb2, 0xbd, 0x58, 0xaa, 0xf9, 0x81,
-	0x6f, 0x65, 0x00, 0xba, 0xa7, 0x86, 0x5c, 0x14, 0x80, 0xae, 0xc3, 0xf0, 0x13, 0x38, 0x48, 0x01,
-	0x4e, 0x5e, 0x9e, 0x1d, 0x1b, 0x72, 0x49, 0x91, 0x16, 0x4b, 0xb5, 0x78, 0x32, 0x75, 0x49, 0x16,
-	0xa8, 0xa7, 0x9f, 0x19, 0x67, 0xf2, 0xb6, 0x00, 0xf5, 0xf8, 0x6f, 0x7a, 0x1b, 0xd4, 0x7a, 0x6d,
-	0x74, 0xfa, 0xf2, 0x8e, 0x00, 0xb5, 0x2e, 0x19, 0x0d, 0x5a, 0xf6, 0xfb, 0xab, 0x2a, 0xfa, 0x70,
-	0x55, 0x45, 0x7f, 0x5e, 0x55, 0xd1, 0xbb, 0xeb, 0x6a, 0xee, 0xc3, 0x75, 0x35, 0xf7, 0xdb, 0x75,
-	0x35, 0x07, 0x8a, 0xe5, 0x6e, 0xfa, 0xba, 0x7a, 0xe8, 0xcd, 0x57, 0x63, 0x8b, 0x4d, 0x66, 0xe7,
-	0xcd, 0xa1, 0x6b, 0x6b, 0x31, 0xea, 0xa9, 0xe5, 0x26, 0x2c, 0xed, 0x22, 0xf1, 0xdd, 0xaf, 0xee,
-	0x66, 0x70, 0x5e, 0xe2, 0x7f, 0xd3, 0xf3, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x36, 0x7d, 0x76,
-	0x18, 0x13, 0x08, 0x00, 0x00,
+func (m *EventAttributeSchemaSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeSchemaSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeSchemaSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventAttributeSchemaSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeSchemaSet.Merge(m, src)
+}
+func (m *EventAttributeSchemaSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeSchemaSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeSchemaSet.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventAttributeSchemaSet proto.InternalMessageInfo
+
+func (m *EventAttributeSchemaSet) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
-		i--
-		dAtA[i] = 0x8
+func (m *EventAttributeSchemaSet) GetAttributeType() string {
+	if m != nil {
+		return m.AttributeType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Attribute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventAttributeSchemaSet) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventAttributeSchemaDeleted event emitted when an attribute schema is deleted.
+type EventAttributeSchemaDeleted struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ExpirationDate != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
-		if err1 != nil {
-			return 0, err1
+func (m *EventAttributeSchemaDeleted) Reset()         { *m = EventAttributeSchemaDeleted{} }
+func (m *EventAttributeSchemaDeleted) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeSchemaDeleted) ProtoMessage()    {}
+func (*EventAttributeSchemaDeleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{13}
+}
+func (m *EventAttributeSchemaDeleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeSchemaDeleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeSchemaDeleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n1
-		i = encodeVarintAttribute(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.AttributeType != 0 {
-		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *EventAttributeSchemaDeleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeSchemaDeleted.Merge(m, src)
+}
+func (m *EventAttributeSchemaDeleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeSchemaDeleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeSchemaDeleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeSchemaDeleted proto.InternalMessageInfo
+
+func (m *EventAttributeSchemaDeleted) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventAttributeSchemaDeleted) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+// EventAttributeIssuerGranted event emitted when an account is allowed to manage attributes of a name.
+type EventAttributeIssuerGranted struct {
+	Name        string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer      string   `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Expiration  string   `protobuf:"bytes,4,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	Owner       string   `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeIssuerGranted) Reset()         { *m = EventAttributeIssuerGranted{} }
+func (m *EventAttributeIssuerGranted) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeIssuerGranted) ProtoMessage()    {}
+func (*EventAttributeIssuerGranted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{14}
+}
+func (m *EventAttributeIssuerGranted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeIssuerGranted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeIssuerGranted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventAttributeIssuerGranted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeIssuerGranted.Merge(m, src)
+}
+func (m *EventAttributeIssuerGranted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeIssuerGranted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeIssuerGranted.DiscardUnknown(m)
 }
 
-func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+var xxx_messageInfo_EventAttributeIssuerGranted proto.InternalMessageInfo
+
+func (m *EventAttributeIssuerGranted) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeIssuerGranted) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *EventAttributeIssuerGranted) GetPermissions() []string {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *EventAttributeIssuerGranted) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
+	}
+	return ""
+}
+
+func (m *EventAttributeIssuerGranted) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// EventAttributeIssuerRevoked event emitted when an account is no longer allowed to manage attributes of a name.
+type EventAttributeIssuerRevoked struct {
+	Name   string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Owner  string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventAttributeIssuerRevoked) Reset()         { *m = EventAttributeIssuerRevoked{} }
+func (m *EventAttributeIssuerRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventAttributeIssuerRevoked) ProtoMessage()    {}
+func (*EventAttributeIssuerRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe7eb43c711f5e, []int{15}
+}
+func (m *EventAttributeIssuerRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttributeIssuerRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttributeIssuerRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAttributeIssuerRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttributeIssuerRevoked.Merge(m, src)
+}
+func (m *EventAttributeIssuerRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttributeIssuerRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttributeIssuerRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttributeIssuerRevoked proto.InternalMessageInfo
+
+func (m *EventAttributeIssuerRevoked) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventAttributeIssuerRevoked) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *EventAttributeIssuerRevoked) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("provenance.attribute.v1.IssuerPermission", IssuerPermission_name, IssuerPermission_value)
+	proto.RegisterEnum("provenance.attribute.v1.AttributeType", AttributeType_name, AttributeType_value)
+	proto.RegisterType((*Params)(nil), "provenance.attribute.v1.Params")
+	proto.RegisterType((*Attribute)(nil), "provenance.attribute.v1.Attribute")
+	proto.RegisterType((*AttributeSchema)(nil), "provenance.attribute.v1.AttributeSchema")
+	proto.RegisterType((*AttributeIssuer)(nil), "provenance.attribute.v1.AttributeIssuer")
+	proto.RegisterType((*EventAttributeAdd)(nil), "provenance.attribute.v1.EventAttributeAdd")
+	proto.RegisterType((*EventAttributeUpdate)(nil), "provenance.attribute.v1.EventAttributeUpdate")
+	proto.RegisterType((*EventAttributeExpirationUpdate)(nil), "provenance.attribute.v1.EventAttributeExpirationUpdate")
+	proto.RegisterType((*EventAttributeDelete)(nil), "provenance.attribute.v1.EventAttributeDelete")
+	proto.RegisterType((*EventAttributeDistinctDelete)(nil), "provenance.attribute.v1.EventAttributeDistinctDelete")
+	proto.RegisterType((*EventAttributeExpired)(nil), "provenance.attribute.v1.EventAttributeExpired")
+	proto.RegisterType((*EventAccountDataUpdated)(nil), "provenance.attribute.v1.EventAccountDataUpdated")
+	proto.RegisterType((*EventAttributeParamsUpdated)(nil), "provenance.attribute.v1.EventAttributeParamsUpdated")
+	proto.RegisterType((*EventAttributeSchemaSet)(nil), "provenance.attribute.v1.EventAttributeSchemaSet")
+	proto.RegisterType((*EventAttributeSchemaDeleted)(nil), "provenance.attribute.v1.EventAttributeSchemaDeleted")
+	proto.RegisterType((*EventAttributeIssuerGranted)(nil), "provenance.attribute.v1.EventAttributeIssuerGranted")
+	proto.RegisterType((*EventAttributeIssuerRevoked)(nil), "provenance.attribute.v1.EventAttributeIssuerRevoked")
+}
+
+func init() {
+	proto.RegisterFile("provenance/attribute/v1/attribute.proto", fileDescriptor_14fe7eb43c711f5e)
+}
+
+var fileDescriptor_14fe7eb43c711f5e = []byte{
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x59, 0x36, 0xc7, 0xb6, 0xc2, 0x6c, 0xec, 0x5a, 0x50, 0x1b, 0x89, 0x51, 0xea,
+	0x56, 0x0d, 0x10, 0x09, 0x71, 0xd0, 0x4b, 0x6f, 0x72, 0x45, 0xbb, 0x6c, 0xfd, 0x23, 0x90, 0x54,
+	0x81, 0xf4, 0x42, 0xac, 0xa5, 0x8d, 0xc4, 0x54, 0x22, 0x05, 0x72, 0xa5, 0xda, 0xd7, 0x1e, 0x7d,
+	0xca, 0xb1, 0x17, 0x03, 0xed, 0xb9, 0x0f, 0x50, 0x14, 0x05, 0x7a, 0xce, 0xd1, 0xc7, 0x9e, 0xd2,
+	0xc2, 0x7e, 0x83, 0xf6, 0x05, 0x0a, 0xee, 0x8a, 0x12, 0x29, 0x91, 0x31, 0x8c, 0xdc, 0x38, 0xc3,
+	0x6f, 0x67, 0xbe, 0xf9, 0x76, 0x76, 0x87, 0x84, 0x4f, 0x87, 0xae, 0x33, 0x26, 0x36, 0xb6, 0xdb,
+	0xa4, 0x86, 0x29, 0x75, 0xad, 0xd3, 0x11, 0x25, 0xb5, 0xf1, 0xb3, 0x99, 0x51, 0x1d, 0xba, 0x0e,
+	0x75, 0xd0, 0xf6, 0x0c, 0x58, 0x9d, 0xbd, 0x1b, 0x3f, 0x2b, 0x6c, 0x76, 0x9d, 0xae, 0xc3, 0x30,
+	0x35, 0xff, 0x89, 0xc3, 0x0b, 0xa5, 0xae, 0xe3, 0x74, 0xfb, 0xa4, 0xc6, 0xac, 0xd3, 0xd1, 0xcb,
+	0x1a, 0xb5, 0x06, 0xc4, 0xa3, 0x78, 0x30, 0xe4, 0x80, 0xf2, 0x2e, 0x64, 0x9b, 0xd8, 0xc5, 0x03,
+	0x0f, 0x55, 0x40, 0x1a, 0xe0, 0x33, 0x73, 0x8c, 0xfb, 0x23, 0x62, 0xf6, 0x89, 0xdd, 0xa5, 0xbd,
+	0xbc, 0x20, 0x0b, 0x95, 0x0d, 0x2d, 0x37, 0xc0, 0x67, 0xdf, 0xfa, 0xee, 0x43, 0xe6, 0x2d, 0xff,
+	0x27, 0x80, 0x58, 0x0f, 0x72, 0x23, 0x04, 0x19, 0x1b, 0x0f, 0x08, 0xc3, 0x8a, 0x1a, 0x7b, 0x46,
+	0x9b, 0xb0, 0xcc, 0xe2, 0xe4, 0x53, 0xb2, 0x50, 0x59, 0xd7, 0xb8, 0x81, 0x8e, 0x20, 0x37, 0xa5,
+	0x6c, 0xd2, 0xf3, 0x21, 0xc9, 0xa7, 0x65, 0xa1, 0x92, 0xdb, 0xfd, 0xa4, 0x9a, 0x50, 0x54, 0x75,
+	0x9a, 0xc5, 0x38, 0x1f, 0x12, 0x6d, 0x03, 0x87, 0x4d, 0x94, 0x87, 0x15, 0xdc, 0xe9, 0xb8, 0xc4,
+	0xf3, 0xf2, 0x19, 0x96, 0x3b, 0x30, 0xd1, 0x11, 0xdc, 0x23, 0x67, 0x43, 0xcb, 0xc5, 0xd4, 0x72,
+	0x6c, 0xb3, 0x83, 0x29, 0xc9, 0x2f, 0xcb, 0x42, 0x65, 0x6d, 0xb7, 0x50, 0xe5, 0x7a, 0x54, 0x03,
+	0x3d, 0xaa, 0x46, 0xa0, 0xc7, 0xde, 0xea, 0x9b, 0xb7, 0x25, 0xe1, 0xf5, 0xdf, 0x25, 0x41, 0xcb,
+	0xcd, 0x16, 0x37, 0x30, 0x25, 0x5f, 0x64, 0x7e, 0xfa, 0xb9, 0xb4, 0x54, 0xfe, 0x43, 0x80, 0x7b,
+	0x53, 0x3e, 0x7a, 0xbb, 0x47, 0x06, 0x38, 0xb6, 0xf6, 0xc5, 0x2a, 0x53, 0xef, 0x53, 0x65, 0x09,
+	0xd6, 0x5e, 0x79, 0x8e, 0x6d, 0x7a, 0x2c, 0x23, 0x53, 0x4c, 0xd4, 0xc0, 0x77, 0x4d, 0x38, 0x7c,
+	0x0c, 0x39, 0x56, 0x0d, 0xcb, 0x65, 0x8e, 0xdc, 0xfe, 0x44, 0x8d, 0x75, 0xe6, 0xf5, 0x63, 0xb4,
+	0xdc, 0x7e, 0xf9, 0x2a, 0xcc, 0x5e, 0xf5, 0xbc, 0x11, 0x71, 0x63, 0xd9, 0x7f, 0x00, 0x59, 0x8b,
+	0xbd, 0x65, 0xac, 0x45, 0x6d, 0x62, 0xa1, 0x6f, 0x60, 0x6d, 0x48, 0xdc, 0x81, 0xe5, 0x79, 0x96,
+	0x63, 0x7b, 0xf9, 0xb4, 0x9c, 0xae, 0xe4, 0x76, 0x3f, 0x4b, 0x2c, 0x89, 0x67, 0x68, 0x4e, 0x57,
+	0x68, 0xe1, 0xd5, 0xa8, 0x01, 0x30, 0x93, 0x38, 0x9f, 0xb9, 0xc3, 0xd6, 0x84, 0xd6, 0x95, 0x7f,
+	0x17, 0xe0, 0xbe, 0x32, 0x26, 0x36, 0x9d, 0xd6, 0x55, 0xef, 0x74, 0x6e, 0x6f, 0x47, 0x31, 0x68,
+	0x47, 0x04, 0x99, 0x69, 0x13, 0x8a, 0x5a, 0x86, 0x06, 0x3d, 0xd5, 0x6e, 0x3b, 0x23, 0x9b, 0x4e,
+	0x7b, 0x8a, 0x9b, 0x7e, 0x0c, 0xe7, 0x07, 0x9b, 0xb8, 0xac, 0x93, 0x44, 0x8d, 0x1b, 0xa8, 0x18,
+	0xa9, 0x24, 0xcb, 0x37, 0x67, 0xe6, 0x09, 0xc9, 0xb9, 0x12, 0x96, 0xb3, 0xfc, 0x63, 0x0a, 0x36,
+	0xa3, 0xdc, 0x5b, 0x43, 0xbf, 0x4f, 0x63, 0xe9, 0xef, 0x40, 0xce, 0x71, 0xad, 0xae, 0x65, 0xe3,
+	0xbe, 0x19, 0xae, 0x63, 0x23, 0xf0, 0xb2, 0xc3, 0x89, 0x1e, 0xc3, 0xd4, 0x61, 0x86, 0x0a, 0x5b,
+	0x0f, 0x9c, 0xac, 0x9d, 0x1e, 0xc1, 0xfa, 0x88, 0x65, 0x9a, 0x44, 0xe2, 0x55, 0xae, 0x71, 0x1f,
+	0x8f, 0x53, 0x82, 0x89, 0xc9, 0xa3, 0xf0, 0x7a, 0x81, 0xbb, 0x8c, 0x39, 0x91, 0xb2, 0x09, 0x22,
+	0xad, 0x84, 0x45, 0x9a, 0x89, 0xb0, 0x1a, 0x11, 0xe1, 0x5f, 0x01, 0x8a, 0x51, 0x11, 0x94, 0xa9,
+	0x72, 0xef, 0x90, 0x23, 0x7e, 0x37, 0x43, 0xa4, 0xd2, 0x09, 0xa4, 0x32, 0x61, 0x52, 0x35, 0x78,
+	0x30, 0x55, 0x2b, 0xb4, 0x85, 0xbc, 0x5a, 0x14, 0xbc, 0x9a, 0x11, 0x42, 0x4f, 0x01, 0x71, 0x0d,
+	0x3a, 0xe6, 0xc2, 0x96, 0xdf, 0x9f, 0xbc, 0x51, 0x6e, 0xdf, 0x79, 0x77, 0x7e, 0xe3, 0x1b, 0xa4,
+	0x4f, 0x12, 0x2a, 0x0d, 0xd5, 0x94, 0x4a, 0xa8, 0x29, 0x1d, 0x2f, 0x74, 0x26, 0x92, 0xf3, 0x37,
+	0x01, 0x3e, 0x9a, 0x4b, 0x6a, 0x79, 0xd4, 0xb2, 0xdb, 0xf4, 0x1d, 0xc9, 0xe3, 0x65, 0xde, 0x89,
+	0xbd, 0xc3, 0xc5, 0xb8, 0xbb, 0xf9, 0x2e, 0xe7, 0x68, 0xc6, 0x3c, 0x1b, 0x61, 0xfe, 0xab, 0x00,
+	0x5b, 0x31, 0x2d, 0x42, 0xe2, 0xcf, 0xf9, 0x43, 0x00, 0x3e, 0xbe, 0x7a, 0xd8, 0xeb, 0x4d, 0x78,
+	0x8b, 0xcc, 0xf3, 0x15, 0xf6, 0x7a, 0xef, 0xcf, 0x3d, 0x7a, 0xda, 0x97, 0xe7, 0x4f, 0x7b, 0xf9,
+	0x39, 0x6c, 0x73, 0xb2, 0x1c, 0xdf, 0xc0, 0x14, 0xf3, 0x3e, 0xee, 0x84, 0x83, 0x0a, 0x91, 0xa0,
+	0xe5, 0x03, 0xf8, 0x30, 0x5a, 0x21, 0x9f, 0xc7, 0xc1, 0xc2, 0xa4, 0xb1, 0x2c, 0x2e, 0x8c, 0xe5,
+	0x57, 0xb0, 0x1d, 0x0d, 0xc4, 0x07, 0x84, 0x4e, 0x68, 0xd2, 0xad, 0x12, 0x33, 0xa7, 0x16, 0xd4,
+	0x88, 0xed, 0xb4, 0x45, 0xd2, 0x3c, 0x17, 0x6f, 0xa7, 0xc4, 0x4b, 0x98, 0x07, 0x4a, 0x85, 0x03,
+	0xfd, 0x22, 0xcc, 0x47, 0xe2, 0xa3, 0xe3, 0xc0, 0xc5, 0x76, 0x52, 0xa4, 0xa4, 0x19, 0x25, 0x2f,
+	0xce, 0x28, 0x31, 0x3a, 0x78, 0x8a, 0x0b, 0x83, 0x27, 0x7a, 0x5d, 0xc7, 0x36, 0x67, 0xd9, 0x8c,
+	0xa7, 0xa8, 0x91, 0xb1, 0xf3, 0xfd, 0x1d, 0x29, 0xc6, 0xaa, 0xf9, 0xe4, 0x4f, 0x01, 0xa4, 0xf9,
+	0x89, 0x89, 0x76, 0xe1, 0xa1, 0xaa, 0xeb, 0x2d, 0x45, 0x33, 0x9b, 0x8a, 0x76, 0xa4, 0xea, 0xba,
+	0x7a, 0x72, 0x6c, 0xb6, 0x8e, 0xf5, 0xa6, 0xf2, 0xa5, 0xba, 0xaf, 0x2a, 0x0d, 0x69, 0xa9, 0x70,
+	0xef, 0xe2, 0x52, 0x5e, 0x6b, 0xd9, 0xde, 0x90, 0xb4, 0xad, 0x97, 0x16, 0xe9, 0xa0, 0x32, 0x6c,
+	0x2d, 0xae, 0xa9, 0x37, 0x1a, 0x92, 0x50, 0x58, 0xb9, 0xb8, 0x94, 0xd3, 0xfe, 0x80, 0xac, 0x40,
+	0x3e, 0x26, 0x6e, 0xb3, 0x51, 0x37, 0x14, 0x29, 0x55, 0x80, 0x8b, 0x4b, 0x39, 0x3b, 0xb9, 0x7c,
+	0x63, 0x91, 0x0d, 0xe5, 0x50, 0x31, 0x14, 0x29, 0xcd, 0x91, 0x7c, 0xc3, 0x9f, 0xbc, 0x4d, 0xc1,
+	0x46, 0xe4, 0x2b, 0x06, 0xd5, 0xa0, 0x50, 0x37, 0x0c, 0x4d, 0xdd, 0x6b, 0x19, 0x8a, 0x69, 0xbc,
+	0x68, 0x2a, 0xb7, 0x51, 0x7f, 0x04, 0x0f, 0xe6, 0x17, 0xb4, 0x54, 0x9f, 0xf8, 0xea, 0xc5, 0xa5,
+	0x9c, 0xf1, 0x9f, 0x63, 0x20, 0x5f, 0xeb, 0x27, 0xc7, 0x52, 0x8a, 0x43, 0xfc, 0x67, 0xb4, 0x03,
+	0x5b, 0x73, 0x10, 0xdd, 0xd0, 0xd4, 0xe3, 0x83, 0x80, 0xaf, 0x4e, 0x5d, 0xcb, 0xee, 0xa2, 0x12,
+	0xa0, 0xf9, 0x64, 0x9a, 0x2a, 0x65, 0xb8, 0x48, 0x2d, 0xd7, 0x8a, 0x01, 0xa8, 0xc7, 0x86, 0xb4,
+	0xcc, 0x01, 0xaa, 0x4d, 0xd1, 0x63, 0xd8, 0x9c, 0x03, 0xec, 0x1f, 0x9e, 0xd4, 0x0d, 0x29, 0x5b,
+	0x10, 0x2f, 0x2e, 0xe5, 0xe5, 0xfd, 0xbe, 0x83, 0xe3, 0x40, 0x4d, 0xed, 0xc4, 0x38, 0x91, 0x56,
+	0x38, 0xa8, 0xc9, 0xbe, 0xe8, 0x17, 0x41, 0x7b, 0x2f, 0x0c, 0x45, 0x97, 0x56, 0x39, 0x68, 0xef,
+	0x9c, 0x12, 0x6f, 0x6f, 0xf0, 0xe6, 0xba, 0x28, 0x5c, 0x5d, 0x17, 0x85, 0x7f, 0xae, 0x8b, 0xc2,
+	0xeb, 0x9b, 0xe2, 0xd2, 0xd5, 0x4d, 0x71, 0xe9, 0xaf, 0x9b, 0xe2, 0x12, 0x14, 0x2c, 0x27, 0xe9,
+	0x2b, 0xac, 0x29, 0x7c, 0xf7, 0x79, 0xd7, 0xa2, 0xbd, 0xd1, 0x69, 0xb5, 0xed, 0x0c, 0x6a, 0x33,
+	0xd4, 0x53, 0xcb, 0x09, 0x59, 0xb5, 0xb3, 0xd0, 0x2f, 0x87, 0x7f, 0x11, 0x78, 0xa7, 0x59, 0xf6,
+	0x11, 0xf6, 0xfc, 0xff, 0x01, 0x00, 0x74, 0x8d, 0xa4, 0x33, 0x97, 0x0c, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -951,43 +1368,65 @@ func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Expiration) > 0 {
-		i -= len(m.Expiration)
-		copy(dAtA[i:], m.Expiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+	if m.MaxValueLength != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.MaxValueLength))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x8
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+	return len(dAtA) - i, nil
+}
+
+func (m *Attribute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attribute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpirationDate != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationDate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAttribute(dAtA, i, uint64(n1))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+	if m.AttributeType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
@@ -1006,7 +1445,7 @@ func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
+func (m *AttributeSchema) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1016,57 +1455,34 @@ func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttributeSchema) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttributeSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.UpdateType) > 0 {
-		i -= len(m.UpdateType)
-		copy(dAtA[i:], m.UpdateType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UpdateValue) > 0 {
-		i -= len(m.UpdateValue)
-		copy(dAtA[i:], m.UpdateValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
+	if len(m.ProtoTypeUrl) > 0 {
+		i -= len(m.ProtoTypeUrl)
+		copy(dAtA[i:], m.ProtoTypeUrl)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.ProtoTypeUrl)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.OriginalType) > 0 {
-		i -= len(m.OriginalType)
-		copy(dAtA[i:], m.OriginalType)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.JsonSchema)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.OriginalValue) > 0 {
-		i -= len(m.OriginalValue)
-		copy(dAtA[i:], m.OriginalValue)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
+	if m.AttributeType != 0 {
+		i = encodeVarintAttribute(dAtA, i, uint64(m.AttributeType))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
@@ -1078,7 +1494,7 @@ func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAttributeExpirationUpdate) Marshal() (dAtA []byte, err error) {
+func (m *AttributeIssuer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1088,45 +1504,268 @@ func (m *EventAttributeExpirationUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAttributeExpirationUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttributeIssuer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAttributeExpirationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttributeIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedExpiration) > 0 {
-		i -= len(m.UpdatedExpiration)
-		copy(dAtA[i:], m.UpdatedExpiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdatedExpiration)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.OriginalExpiration) > 0 {
-		i -= len(m.OriginalExpiration)
-		copy(dAtA[i:], m.OriginalExpiration)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalExpiration)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAttribute(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+	if len(m.Permissions) > 0 {
+		dAtA4 := make([]byte, len(m.Permissions)*10)
+		var j3 int
+		for _, num := range m.Permissions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintAttribute(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Value) > 0 {
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeAdd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeAdd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeAdd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UpdateType) > 0 {
+		i -= len(m.UpdateType)
+		copy(dAtA[i:], m.UpdateType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UpdateValue) > 0 {
+		i -= len(m.UpdateValue)
+		copy(dAtA[i:], m.UpdateValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdateValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OriginalType) > 0 {
+		i -= len(m.OriginalType)
+		copy(dAtA[i:], m.OriginalType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OriginalValue) > 0 {
+		i -= len(m.OriginalValue)
+		copy(dAtA[i:], m.OriginalValue)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeExpirationUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeExpirationUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeExpirationUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UpdatedExpiration) > 0 {
+		i -= len(m.UpdatedExpiration)
+		copy(dAtA[i:], m.UpdatedExpiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.UpdatedExpiration)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.OriginalExpiration) > 0 {
+		i -= len(m.OriginalExpiration)
+		copy(dAtA[i:], m.OriginalExpiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.OriginalExpiration)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
 		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Value)))
@@ -1163,6 +1802,13 @@ func (m *EventAttributeDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1207,6 +1853,13 @@ func (m *EventAttributeDistinctDelete) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
@@ -1363,38 +2016,223 @@ func (m *EventAttributeParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttribute(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAttributeSchemaSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxValueLength != 0 {
-		n += 1 + sovAttribute(uint64(m.MaxValueLength))
-	}
-	return n
+
+func (m *EventAttributeSchemaSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Attribute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventAttributeSchemaSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovAttribute(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AttributeType) > 0 {
+		i -= len(m.AttributeType)
+		copy(dAtA[i:], m.AttributeType)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.AttributeType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeSchemaDeleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeSchemaDeleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeSchemaDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeIssuerGranted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeIssuerGranted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeIssuerGranted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Permissions) > 0 {
+		for iNdEx := len(m.Permissions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Permissions[iNdEx])
+			copy(dAtA[i:], m.Permissions[iNdEx])
+			i = encodeVarintAttribute(dAtA, i, uint64(len(m.Permissions[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttributeIssuerRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttributeIssuerRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttributeIssuerRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintAttribute(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttribute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttribute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxValueLength != 0 {
+		n += 1 + sovAttribute(uint64(m.MaxValueLength))
+	}
+	return n
+}
+
+func (m *Attribute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
 	}
 	l = len(m.Value)
 	if l > 0 {
@@ -1414,6 +2252,58 @@ func (m *Attribute) Size() (n int) {
 	return n
 }
 
+func (m *AttributeSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovAttribute(uint64(m.AttributeType))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.ProtoTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *AttributeIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		l = 0
+		for _, e := range m.Permissions {
+			l += sovAttribute(uint64(e))
+		}
+		n += 1 + sovAttribute(uint64(l)) + l
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
 func (m *EventAttributeAdd) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1444,6 +2334,10 @@ func (m *EventAttributeAdd) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1481,6 +2375,10 @@ func (m *EventAttributeUpdate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1514,6 +2412,10 @@ func (m *EventAttributeExpirationUpdate) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1535,6 +2437,10 @@ func (m *EventAttributeDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1564,6 +2470,10 @@ func (m *EventAttributeDistinctDelete) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttribute(uint64(l))
 	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
 	return n
 }
 
@@ -1622,23 +2532,113 @@ func (m *EventAttributeParamsUpdated) Size() (n int) {
 	return n
 }
 
-func sovAttribute(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttribute(x uint64) (n int) {
-	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventAttributeSchemaSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.AttributeType)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
-			}
-			if iNdEx >= l {
+
+func (m *EventAttributeSchemaDeleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeIssuerGranted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	if len(m.Permissions) > 0 {
+		for _, s := range m.Permissions {
+			l = len(s)
+			n += 1 + l + sovAttribute(uint64(l))
+		}
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAttributeIssuerRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttribute(uint64(l))
+	}
+	return n
+}
+
+func sovAttribute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttribute(x uint64) (n int) {
+	return sovAttribute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
@@ -1728,7 +2728,1238 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtoTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttributeIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttributeIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttributeIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v IssuerPermission
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttribute
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= IssuerPermission(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Permissions = append(m.Permissions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowAttribute
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthAttribute
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthAttribute
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Permissions) == 0 {
+					m.Permissions = make([]IssuerPermission, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v IssuerPermission
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowAttribute
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= IssuerPermission(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Permissions = append(m.Permissions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1756,13 +3987,13 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1772,31 +4003,29 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
 			}
-			m.AttributeType = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1806,14 +4035,27 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1841,13 +4083,13 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttribute
@@ -1857,27 +4099,23 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttribute
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1900,7 +4138,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,10 +4161,10 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeAdd: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeAdd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1962,70 +4200,6 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2057,7 +4231,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2089,9 +4263,9 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			}
 			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2119,7 +4293,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expiration = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2142,7 +4316,7 @@ func (m *EventAttributeAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2165,10 +4339,10 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2205,7 +4379,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2233,11 +4407,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2265,11 +4439,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalType = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2297,11 +4471,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateValue = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2329,43 +4503,11 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdateType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2393,7 +4535,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2416,7 +4558,7 @@ func (m *EventAttributeUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2439,10 +4581,10 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpirationUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2479,7 +4621,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2507,11 +4649,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ValueHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2539,43 +4681,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2603,11 +4713,11 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalExpiration = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedExpiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2635,7 +4745,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UpdatedExpiration = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2658,7 +4768,7 @@ func (m *EventAttributeExpirationUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,15 +4791,15 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2717,43 +4827,61 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAttribute
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAttribute
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttribute
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2781,7 +4909,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2804,7 +4932,7 @@ func (m *EventAttributeDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeSchemaSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2827,10 +4955,10 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeSchemaSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeDistinctDelete: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeSchemaSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2867,7 +4995,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2895,11 +5023,11 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.AttributeType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2927,11 +5055,61 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttribute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttribute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttributeSchemaDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttribute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttributeSchemaDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttributeSchemaDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2959,9 +5137,9 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -3014,7 +5192,7 @@ func (m *EventAttributeDistinctDelete) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeIssuerGranted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,10 +5215,10 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeExpired: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeIssuerGranted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeIssuerGranted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3077,7 +5255,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3105,11 +5283,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueHash = string(dAtA[iNdEx:postIndex])
+			m.Issuer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3137,11 +5315,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttributeType = string(dAtA[iNdEx:postIndex])
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3169,11 +5347,11 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3201,7 +5379,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Expiration = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3224,7 +5402,7 @@ func (m *EventAttributeExpired) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventAttributeIssuerRevoked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3247,15 +5425,15 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttributeIssuerRevoked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAccountDataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttributeIssuerRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3283,61 +5461,43 @@ func (m *EventAccountDataUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttribute(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAttribute
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttribute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttribute
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttribute
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttribute
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttributeParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxValueLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3365,7 +5525,7 @@ func (m *EventAttributeParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxValueLength = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -126,3 +126,44 @@ func NewEventAttributeExpired(attribute Attribute) *EventAttributeExpired {
 func NewEventAttributeParamsUpdated(params Params) *EventAttributeParamsUpdated {
 	return &EventAttributeParamsUpdated{MaxValueLength: strconv.FormatUint(uint64(params.MaxValueLength), 10)}
 }
+
+func NewEventAttributeSchemaSet(schema AttributeSchema, owner string) *EventAttributeSchemaSet {
+	return &EventAttributeSchemaSet{
+		Name:          schema.Name,
+		AttributeType: schema.AttributeType.String(),
+		Owner:         owner,
+	}
+}
+
+func NewEventAttributeSchemaDeleted(name string, owner string) *EventAttributeSchemaDeleted {
+	return &EventAttributeSchemaDeleted{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func NewEventAttributeIssuerGranted(issuer AttributeIssuer, owner string) *EventAttributeIssuerGranted {
+	var expiration string
+	if issuer.Expiration != nil {
+		expiration = issuer.Expiration.String()
+	}
+	permissions := make([]string, len(issuer.Permissions))
+	for i, perm := range issuer.Permissions {
+		permissions[i] = perm.String()
+	}
+	return &EventAttributeIssuerGranted{
+		Name:        issuer.Name,
+		Issuer:      issuer.Issuer,
+		Permissions: permissions,
+		Expiration:  expiration,
+		Owner:       owner,
+	}
+}
+
+func NewEventAttributeIssuerRevoked(name string, issuer string, owner string) *EventAttributeIssuerRevoked {
+	return &EventAttributeIssuerRevoked{
+		Name:   name,
+		Issuer: issuer,
+		Owner:  owner,
+	}
+}
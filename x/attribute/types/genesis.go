@@ -1,10 +1,17 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+)
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, attributes []Attribute) *GenesisState {
+func NewGenesisState(params Params, attributes []Attribute, schemas []AttributeSchema, issuers []AttributeIssuer) *GenesisState {
 	return &GenesisState{
 		Params:     params,
 		Attributes: attributes,
+		Schemas:    schemas,
+		Issuers:    issuers,
 	}
 }
 
@@ -15,6 +22,28 @@ func (state GenesisState) ValidateBasic() error {
 			return err
 		}
 	}
+	seen := make(map[string]bool)
+	for _, s := range state.Schemas {
+		if err := s.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid schema for %q: %w", s.Name, err)
+		}
+		name := strings.ToLower(strings.TrimSpace(s.Name))
+		if seen[name] {
+			return fmt.Errorf("duplicate schema for %q", s.Name)
+		}
+		seen[name] = true
+	}
+	seenIssuers := make(map[string]bool)
+	for _, i := range state.Issuers {
+		if err := i.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid issuer %q for %q: %w", i.Issuer, i.Name, err)
+		}
+		key := strings.ToLower(strings.TrimSpace(i.Name)) + " " + i.Issuer
+		if seenIssuers[key] {
+			return fmt.Errorf("duplicate issuer %q for %q", i.Issuer, i.Name)
+		}
+		seenIssuers[key] = true
+	}
 	return nil
 }
 
@@ -23,5 +52,7 @@ func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
 		Params:     DefaultParams(),
 		Attributes: []Attribute{},
+		Schemas:    []AttributeSchema{},
+		Issuers:    []AttributeIssuer{},
 	}
 }
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestEqualDefaultGenesis(t *testing.T) {
@@ -11,3 +13,88 @@ func TestEqualDefaultGenesis(t *testing.T) {
 	state2 := GenesisState{}
 	require.Equal(t, state1, state2)
 }
+
+func TestGenesisStateValidateBasicSchemas(t *testing.T) {
+	jsonSchema := NewAttributeSchema("example.name", AttributeType_JSON, `{"type":"string"}`)
+	tests := []struct {
+		name    string
+		schemas []AttributeSchema
+		exp     string
+	}{
+		{
+			name:    "no schemas",
+			schemas: nil,
+			exp:     "",
+		},
+		{
+			name:    "valid schemas",
+			schemas: []AttributeSchema{jsonSchema, NewAttributeSchema("other.name", AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata")},
+			exp:     "",
+		},
+		{
+			name:    "invalid schema",
+			schemas: []AttributeSchema{NewAttributeSchema("bad.name", AttributeType_JSON, `{"type":"nope"}`)},
+			exp:     `invalid schema for "bad.name": invalid json schema: #/type: unknown type "nope"`,
+		},
+		{
+			name:    "duplicate schema",
+			schemas: []AttributeSchema{jsonSchema, NewAttributeSchema("Example.Name", AttributeType_JSON, `{"type":"number"}`)},
+			exp:     `duplicate schema for "example.name"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := NewGenesisState(DefaultParams(), nil, tc.schemas, nil)
+			err := state.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestGenesisStateValidateBasicIssuers(t *testing.T) {
+	issuerAddr := sdk.AccAddress("issuer______________").String()
+	issuer := NewAttributeIssuer("example.name", issuerAddr, []IssuerPermission{IssuerPermission_Add}, nil)
+	tests := []struct {
+		name    string
+		issuers []AttributeIssuer
+		exp     string
+	}{
+		{
+			name:    "no issuers",
+			issuers: nil,
+			exp:     "",
+		},
+		{
+			name:    "valid issuers",
+			issuers: []AttributeIssuer{issuer, NewAttributeIssuer("other.name", issuerAddr, []IssuerPermission{IssuerPermission_Delete}, nil)},
+			exp:     "",
+		},
+		{
+			name:    "invalid issuer",
+			issuers: []AttributeIssuer{NewAttributeIssuer("example.name", issuerAddr, nil, nil)},
+			exp:     `invalid issuer "` + issuerAddr + `" for "example.name": invalid permissions: empty`,
+		},
+		{
+			name:    "duplicate issuer",
+			issuers: []AttributeIssuer{issuer, NewAttributeIssuer("Example.Name", issuerAddr, []IssuerPermission{IssuerPermission_Update}, nil)},
+			exp:     `duplicate issuer "` + issuerAddr + `" for "example.name"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			state := NewGenesisState(DefaultParams(), nil, nil, tc.issuers)
+			err := state.ValidateBasic()
+			if len(tc.exp) > 0 {
+				require.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
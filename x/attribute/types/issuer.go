@@ -0,0 +1,85 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewAttributeIssuer creates a new instance of an AttributeIssuer.
+func NewAttributeIssuer(name string, issuer string, permissions []IssuerPermission, expiration *time.Time) AttributeIssuer {
+	return AttributeIssuer{
+		Name:        strings.ToLower(strings.TrimSpace(name)),
+		Issuer:      issuer,
+		Permissions: permissions,
+		Expiration:  expiration,
+	}
+}
+
+// ValidateBasic ensures an attribute issuer is valid.
+func (i AttributeIssuer) ValidateBasic() error {
+	if strings.TrimSpace(i.Name) == "" {
+		return errors.New("invalid name: empty")
+	}
+	if _, err := sdk.AccAddressFromBech32(i.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer %q: %w", i.Issuer, err)
+	}
+	if len(i.Permissions) == 0 {
+		return errors.New("invalid permissions: empty")
+	}
+	seen := make(map[IssuerPermission]bool)
+	for _, perm := range i.Permissions {
+		if _, known := IssuerPermission_name[int32(perm)]; !known || perm == IssuerPermission_Unspecified {
+			return fmt.Errorf("invalid permission %s", perm)
+		}
+		if seen[perm] {
+			return fmt.Errorf("duplicate permission %s", perm)
+		}
+		seen[perm] = true
+	}
+	return nil
+}
+
+// HasPermission returns true if this issuer has the provided permission.
+func (i AttributeIssuer) HasPermission(perm IssuerPermission) bool {
+	for _, p := range i.Permissions {
+		if p == perm {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired returns true if this issuer has an expiration that is not after the provided time.
+func (i AttributeIssuer) IsExpired(blockTime time.Time) bool {
+	return i.Expiration != nil && !blockTime.Before(*i.Expiration)
+}
+
+// IssuerPermissionFromString returns an IssuerPermission from a string, e.g. "add" or "ISSUER_PERMISSION_ADD".
+// It returns an error if the string is invalid.
+func IssuerPermissionFromString(str string) (IssuerPermission, error) {
+	val := strings.ToUpper(strings.TrimSpace(str))
+	if !strings.HasPrefix(val, "ISSUER_PERMISSION_") {
+		val = "ISSUER_PERMISSION_" + val
+	}
+	option, ok := IssuerPermission_value[val]
+	if !ok || option == int32(IssuerPermission_Unspecified) {
+		return IssuerPermission_Unspecified, fmt.Errorf("'%s' is not a valid issuer permission option", str)
+	}
+	return IssuerPermission(option), nil
+}
+
+// IssuerPermissionsFromStrings converts each of the provided strings into an IssuerPermission.
+func IssuerPermissionsFromStrings(strs []string) ([]IssuerPermission, error) {
+	rv := make([]IssuerPermission, len(strs))
+	for i, str := range strs {
+		var err error
+		if rv[i], err = IssuerPermissionFromString(str); err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
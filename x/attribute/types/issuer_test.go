@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	. "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestAttributeIssuerValidateBasic(t *testing.T) {
+	issuerAddr := sdk.AccAddress("issuer______________").String()
+	tests := []struct {
+		name   string
+		issuer AttributeIssuer
+		exp    string
+	}{
+		{
+			name:   "all permissions",
+			issuer: NewAttributeIssuer("example.name", issuerAddr, []IssuerPermission{IssuerPermission_Add, IssuerPermission_Update, IssuerPermission_Delete}, nil),
+		},
+		{
+			name:   "empty name",
+			issuer: NewAttributeIssuer(" ", issuerAddr, []IssuerPermission{IssuerPermission_Add}, nil),
+			exp:    "invalid name: empty",
+		},
+		{
+			name:   "bad issuer",
+			issuer: NewAttributeIssuer("example.name", "bad", []IssuerPermission{IssuerPermission_Add}, nil),
+			exp:    `invalid issuer "bad": decoding bech32 failed: invalid bech32 string length 3`,
+		},
+		{
+			name:   "no permissions",
+			issuer: NewAttributeIssuer("example.name", issuerAddr, nil, nil),
+			exp:    "invalid permissions: empty",
+		},
+		{
+			name:   "unspecified permission",
+			issuer: NewAttributeIssuer("example.name", issuerAddr, []IssuerPermission{IssuerPermission_Unspecified}, nil),
+			exp:    "invalid permission ISSUER_PERMISSION_UNSPECIFIED",
+		},
+		{
+			name:   "unknown permission",
+			issuer: NewAttributeIssuer("example.name", issuerAddr, []IssuerPermission{99}, nil),
+			exp:    "invalid permission 99",
+		},
+		{
+			name:   "duplicate permission",
+			issuer: NewAttributeIssuer("example.name", issuerAddr, []IssuerPermission{IssuerPermission_Add, IssuerPermission_Add}, nil),
+			exp:    "duplicate permission ISSUER_PERMISSION_ADD",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.issuer.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestAttributeIssuerHasPermissionAndIsExpired(t *testing.T) {
+	now := time.Now()
+	later := now.Add(time.Hour)
+	issuer := NewAttributeIssuer("example.name", "", []IssuerPermission{IssuerPermission_Add, IssuerPermission_Delete}, &later)
+
+	assert.True(t, issuer.HasPermission(IssuerPermission_Add), "HasPermission(Add)")
+	assert.False(t, issuer.HasPermission(IssuerPermission_Update), "HasPermission(Update)")
+	assert.True(t, issuer.HasPermission(IssuerPermission_Delete), "HasPermission(Delete)")
+
+	assert.False(t, issuer.IsExpired(now), "IsExpired(now)")
+	assert.True(t, issuer.IsExpired(later), "IsExpired(later)")
+	assert.True(t, issuer.IsExpired(later.Add(time.Second)), "IsExpired(after later)")
+	issuer.Expiration = nil
+	assert.False(t, issuer.IsExpired(later), "IsExpired(later) without expiration")
+}
+
+func TestIssuerPermissionsFromStrings(t *testing.T) {
+	perms, err := IssuerPermissionsFromStrings([]string{"add", " UPDATE ", "issuer_permission_delete"})
+	require.NoError(t, err, "IssuerPermissionsFromStrings")
+	assert.Equal(t, []IssuerPermission{IssuerPermission_Add, IssuerPermission_Update, IssuerPermission_Delete}, perms, "permissions")
+
+	_, err = IssuerPermissionsFromStrings([]string{"add", "unspecified"})
+	assert.EqualError(t, err, "'unspecified' is not a valid issuer permission option", "unspecified permission")
+	_, err = IssuerPermissionsFromStrings([]string{"nope"})
+	assert.EqualError(t, err, "'nope' is not a valid issuer permission option", "unknown permission")
+}
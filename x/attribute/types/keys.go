@@ -32,6 +32,9 @@ var (
 	AttributeAddrLookupKeyPrefix = []byte{0x03}
 	AttributeExpirationKeyPrefix = []byte{0x04}
 	AttributeParamPrefix         = []byte{0x05}
+	AttributeSchemaKeyPrefix     = []byte{0x06}
+	AttributeIssuerKeyPrefix     = []byte{0x07}
+	AttributeValueIndexKeyPrefix = []byte{0x08}
 )
 
 // AddrAttributeKey creates a key for an account attribute
@@ -93,6 +96,48 @@ func AttributeNameAddrKeyPrefix(attributeName string, addr []byte) []byte {
 	return append(key, address.MustLengthPrefix(addr)...)
 }
 
+// AttributeSchemaKey returns a key for an attribute schema [AttributeSchemaKeyPrefix][name hash]
+func AttributeSchemaKey(attributeName string) []byte {
+	key := AttributeSchemaKeyPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeIssuersKeyPrefix returns a prefix key for all issuers of an attribute name [AttributeIssuerKeyPrefix][name hash]
+func AttributeIssuersKeyPrefix(attributeName string) []byte {
+	key := AttributeIssuerKeyPrefix
+	return append(key, GetNameKeyBytes(attributeName)...)
+}
+
+// AttributeIssuerKey returns a key for an attribute issuer [AttributeIssuerKeyPrefix][name hash][issuer address]
+func AttributeIssuerKey(attributeName string, issuer sdk.AccAddress) []byte {
+	return append(AttributeIssuersKeyPrefix(attributeName), address.MustLengthPrefix(issuer)...)
+}
+
+// AttributeValueIndexTypePrefix returns a prefix key for the value index of attributes with a given name and type
+// [AttributeValueIndexKeyPrefix][name hash][attribute type]
+func AttributeValueIndexTypePrefix(attributeName string, attrType AttributeType) []byte {
+	key := AttributeValueIndexKeyPrefix
+	key = append(key, GetNameKeyBytes(attributeName)...)
+	return append(key, byte(attrType))
+}
+
+// AttributeValueIndexKey returns a key for the value index of an attribute
+// [AttributeValueIndexKeyPrefix][name hash][attribute type][encoded value][AccAddress bytes][attribute hash]
+// If the attribute's type is not indexed, or its value cannot be encoded, nil is returned.
+func AttributeValueIndexKey(attr Attribute) []byte {
+	if !IsIndexedAttributeType(attr.AttributeType) {
+		return nil
+	}
+	valueBz, err := EncodeIndexValue(attr.AttributeType, attr.Value)
+	if err != nil {
+		return nil
+	}
+	key := AttributeValueIndexTypePrefix(attr.Name, attr.AttributeType)
+	key = append(key, valueBz...)
+	key = append(key, address.MustLengthPrefix(attr.GetAddressBytes())...)
+	return append(key, attr.Hash()...)
+}
+
 // GetAddressFromKey returns the AccAddress from full attribute address key ([prefix][name hash][length + AccAddress bytes][attribute hash])
 func GetAddressFromKey(nameAddrKey []byte) (sdk.AccAddress, error) {
 	// start index of slice is [prefix (1)] + [name hash (32)] + [address len prefix (1)]
@@ -139,3 +139,16 @@ func TestGetAddressFromKey(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, attr2.GetAddressBytes(), shortKey.Bytes())
 }
+
+func TestAttributeIssuerKey(t *testing.T) {
+	issuer := []byte("issuer______________")
+	nameHash := GetNameKeyBytes("Example.Name")
+
+	prefix := AttributeIssuersKeyPrefix("example.name")
+	assert.Equal(t, AttributeIssuerKeyPrefix, prefix[0:1], "prefix byte")
+	assert.Equal(t, nameHash, prefix[1:], "name hash")
+
+	key := AttributeIssuerKey("example.name", issuer)
+	assert.Equal(t, prefix, key[0:len(prefix)], "issuers prefix")
+	assert.Equal(t, address.MustLengthPrefix(issuer), key[len(prefix):], "issuer address")
+}
@@ -17,6 +17,10 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgDeleteDistinctAttributeRequest)(nil),
 	(*MsgSetAccountDataRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetAttributeSchemaRequest)(nil),
+	(*MsgDeleteAttributeSchemaRequest)(nil),
+	(*MsgGrantAttributeIssuerRequest)(nil),
+	(*MsgRevokeAttributeIssuerRequest)(nil),
 }
 
 func NewMsgAddAttributeRequest(account string, owner sdk.AccAddress, name string, attributeType AttributeType, value []byte) *MsgAddAttributeRequest {
@@ -110,7 +114,7 @@ func (msg MsgDeleteAttributeRequest) ValidateBasic() error {
 		return fmt.Errorf("empty owner address")
 	}
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
-		return err
+		return fmt.Errorf("invalid owner: %w", err)
 	}
 	return nil
 }
@@ -138,7 +142,7 @@ func (msg MsgDeleteDistinctAttributeRequest) ValidateBasic() error {
 		return fmt.Errorf("empty owner address")
 	}
 	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
-		return err
+		return fmt.Errorf("invalid owner: %w", err)
 	}
 	return nil
 }
@@ -166,3 +170,89 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewMsgSetAttributeSchemaRequest creates a new SetAttributeSchemaRequest message.
+func NewMsgSetAttributeSchemaRequest(schema AttributeSchema, owner sdk.AccAddress) *MsgSetAttributeSchemaRequest {
+	return &MsgSetAttributeSchemaRequest{
+		Schema: schema,
+		Owner:  owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgSetAttributeSchemaRequest) ValidateBasic() error {
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return msg.Schema.ValidateBasic()
+}
+
+// NewMsgDeleteAttributeSchemaRequest creates a new DeleteAttributeSchemaRequest message.
+func NewMsgDeleteAttributeSchemaRequest(name string, owner sdk.AccAddress) *MsgDeleteAttributeSchemaRequest {
+	return &MsgDeleteAttributeSchemaRequest{
+		Name:  strings.ToLower(strings.TrimSpace(name)),
+		Owner: owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgDeleteAttributeSchemaRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return nil
+}
+
+// NewMsgGrantAttributeIssuerRequest creates a new GrantAttributeIssuerRequest message.
+func NewMsgGrantAttributeIssuerRequest(issuer AttributeIssuer, owner sdk.AccAddress) *MsgGrantAttributeIssuerRequest {
+	return &MsgGrantAttributeIssuerRequest{
+		Issuer: issuer,
+		Owner:  owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgGrantAttributeIssuerRequest) ValidateBasic() error {
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return msg.Issuer.ValidateBasic()
+}
+
+// NewMsgRevokeAttributeIssuerRequest creates a new RevokeAttributeIssuerRequest message.
+func NewMsgRevokeAttributeIssuerRequest(name string, issuer string, owner sdk.AccAddress) *MsgRevokeAttributeIssuerRequest {
+	return &MsgRevokeAttributeIssuerRequest{
+		Name:   strings.ToLower(strings.TrimSpace(name)),
+		Issuer: issuer,
+		Owner:  owner.String(),
+	}
+}
+
+// ValidateBasic runs stateless validation checks on the message.
+func (msg MsgRevokeAttributeIssuerRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("empty name")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Issuer); err != nil {
+		return fmt.Errorf("invalid issuer: %w", err)
+	}
+	if len(msg.Owner) == 0 {
+		return fmt.Errorf("empty owner address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return fmt.Errorf("invalid owner: %w", err)
+	}
+	return nil
+}
@@ -32,6 +32,10 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgDeleteDistinctAttributeRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgSetAccountDataRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetAttributeSchemaRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgDeleteAttributeSchemaRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgGrantAttributeIssuerRequest{Owner: signer} },
+		func(signer string) sdk.Msg { return &MsgRevokeAttributeIssuerRequest{Owner: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -198,3 +202,175 @@ func TestMsgUpdateParamsRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetAttributeSchemaRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner").String()
+	tests := []struct {
+		name string
+		msg  MsgSetAttributeSchemaRequest
+		exp  string
+	}{
+		{
+			name: "json schema",
+			msg:  MsgSetAttributeSchemaRequest{Schema: NewAttributeSchema("example.name", AttributeType_JSON, `{"type":"object"}`), Owner: owner},
+			exp:  "",
+		},
+		{
+			name: "proto schema",
+			msg:  MsgSetAttributeSchemaRequest{Schema: NewAttributeSchema("example.name", AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata"), Owner: owner},
+			exp:  "",
+		},
+		{
+			name: "empty owner",
+			msg:  MsgSetAttributeSchemaRequest{Schema: NewAttributeSchema("example.name", AttributeType_JSON, `{"type":"object"}`)},
+			exp:  "empty owner address",
+		},
+		{
+			name: "bad owner",
+			msg:  MsgSetAttributeSchemaRequest{Schema: NewAttributeSchema("example.name", AttributeType_JSON, `{"type":"object"}`), Owner: "notabech32"},
+			exp:  "invalid owner: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "invalid schema",
+			msg:  MsgSetAttributeSchemaRequest{Schema: NewAttributeSchema("example.name", AttributeType_String, "x"), Owner: owner},
+			exp:  "invalid attribute type ATTRIBUTE_TYPE_STRING: must be ATTRIBUTE_TYPE_JSON or ATTRIBUTE_TYPE_PROTO",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgDeleteAttributeSchemaRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgDeleteAttributeSchemaRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgDeleteAttributeSchemaRequest(" Example.Name ", addrs[0]),
+			exp:  "",
+		},
+		{
+			name: "empty name",
+			msg:  NewMsgDeleteAttributeSchemaRequest("", addrs[0]),
+			exp:  "empty name",
+		},
+		{
+			name: "empty owner",
+			msg:  &MsgDeleteAttributeSchemaRequest{Name: "example.name"},
+			exp:  "empty owner address",
+		},
+		{
+			name: "bad owner",
+			msg:  &MsgDeleteAttributeSchemaRequest{Name: "example.name", Owner: "notabech32"},
+			exp:  "invalid owner: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgGrantAttributeIssuerRequest_ValidateBasic(t *testing.T) {
+	issuer := NewAttributeIssuer("example.name", addrs[1].String(), []IssuerPermission{IssuerPermission_Add}, nil)
+	tests := []struct {
+		name string
+		msg  *MsgGrantAttributeIssuerRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgGrantAttributeIssuerRequest(issuer, addrs[0]),
+			exp:  "",
+		},
+		{
+			name: "empty owner",
+			msg:  &MsgGrantAttributeIssuerRequest{Issuer: issuer},
+			exp:  "empty owner address",
+		},
+		{
+			name: "bad owner",
+			msg:  &MsgGrantAttributeIssuerRequest{Issuer: issuer, Owner: "notabech32"},
+			exp:  "invalid owner: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "invalid issuer",
+			msg:  NewMsgGrantAttributeIssuerRequest(NewAttributeIssuer("example.name", "notabech32", []IssuerPermission{IssuerPermission_Add}, nil), addrs[0]),
+			exp:  `invalid issuer "notabech32": decoding bech32 failed: invalid separator index -1`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
+
+func TestMsgRevokeAttributeIssuerRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *MsgRevokeAttributeIssuerRequest
+		exp  string
+	}{
+		{
+			name: "control",
+			msg:  NewMsgRevokeAttributeIssuerRequest(" Example.Name ", addrs[1].String(), addrs[0]),
+			exp:  "",
+		},
+		{
+			name: "empty name",
+			msg:  NewMsgRevokeAttributeIssuerRequest("", addrs[1].String(), addrs[0]),
+			exp:  "empty name",
+		},
+		{
+			name: "bad issuer",
+			msg:  NewMsgRevokeAttributeIssuerRequest("example.name", "notabech32", addrs[0]),
+			exp:  "invalid issuer: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name: "empty owner",
+			msg:  &MsgRevokeAttributeIssuerRequest{Name: "example.name", Issuer: addrs[1].String()},
+			exp:  "empty owner address",
+		},
+		{
+			name: "bad owner",
+			msg:  &MsgRevokeAttributeIssuerRequest{Name: "example.name", Issuer: addrs[1].String(), Owner: "notabech32"},
+			exp:  "invalid owner: decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic error")
+			} else {
+				assert.NoError(t, err, "ValidateBasic error")
+			}
+		})
+	}
+}
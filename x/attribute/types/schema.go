@@ -0,0 +1,598 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec/unknownproto"
+)
+
+// maxJSONSchemaDepth is the maximum nesting depth allowed in a JSON schema.
+const maxJSONSchemaDepth = 32
+
+// maxJSONNumberExponent is the largest exponent (positive or negative) allowed in numbers being compared.
+// It keeps a short number (e.g. 1e999999999) from becoming a huge value.
+const maxJSONNumberExponent = 1000
+
+// MaxJSONSchemaLength is the maximum length (in bytes) of a JSON schema.
+// A schema is also limited by the max_value_length param when it's set.
+const MaxJSONSchemaLength = 10000
+
+// SchemaValidationGasPerByte is the gas charged per byte of a JSON schema whenever it is compiled,
+// and per byte of a schema and value whenever the value is validated against it.
+const SchemaValidationGasPerByte = 20
+
+// NewAttributeSchema creates a new instance of an AttributeSchema.
+// The schemaValue is used as the json_schema for JSON attributes, and the proto_type_url for PROTO attributes.
+func NewAttributeSchema(name string, attrType AttributeType, schemaValue string) AttributeSchema {
+	rv := AttributeSchema{
+		Name:          strings.ToLower(strings.TrimSpace(name)),
+		AttributeType: attrType,
+	}
+	switch attrType {
+	case AttributeType_JSON:
+		rv.JsonSchema = schemaValue
+	case AttributeType_Proto:
+		rv.ProtoTypeUrl = schemaValue
+	}
+	return rv
+}
+
+// ValidateBasic ensures an attribute schema is valid.
+func (s AttributeSchema) ValidateBasic() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return errors.New("invalid name: empty")
+	}
+	switch s.AttributeType {
+	case AttributeType_JSON:
+		if len(s.ProtoTypeUrl) > 0 {
+			return fmt.Errorf("proto type url not allowed for type %s", s.AttributeType)
+		}
+		if len(strings.TrimSpace(s.JsonSchema)) == 0 {
+			return fmt.Errorf("json schema required for type %s", s.AttributeType)
+		}
+		if len(s.JsonSchema) > MaxJSONSchemaLength {
+			return fmt.Errorf("json schema length of %d exceeds max length %d", len(s.JsonSchema), MaxJSONSchemaLength)
+		}
+		if _, err := CompileJSONSchema([]byte(s.JsonSchema)); err != nil {
+			return fmt.Errorf("invalid json schema: %w", err)
+		}
+	case AttributeType_Proto:
+		if len(s.JsonSchema) > 0 {
+			return fmt.Errorf("json schema not allowed for type %s", s.AttributeType)
+		}
+		if !strings.HasPrefix(s.ProtoTypeUrl, "/") || len(s.ProtoTypeUrl) == 1 {
+			return fmt.Errorf("invalid proto type url %q: must have format /<message name>", s.ProtoTypeUrl)
+		}
+	default:
+		return fmt.Errorf("invalid attribute type %s: must be %s or %s", s.AttributeType, AttributeType_JSON, AttributeType_Proto)
+	}
+	return nil
+}
+
+// ValidateValue returns an error if the provided attribute type and value do not conform to this schema.
+func (s AttributeSchema) ValidateValue(attrType AttributeType, value []byte) error {
+	if attrType != s.AttributeType {
+		return fmt.Errorf("attribute %q must have type %s", s.Name, s.AttributeType)
+	}
+	var err error
+	switch s.AttributeType {
+	case AttributeType_JSON:
+		err = ValidateJSONValue(s.JsonSchema, value)
+	case AttributeType_Proto:
+		err = ValidateProtoValue(s.ProtoTypeUrl, value)
+	default:
+		err = fmt.Errorf("unsupported schema type %s", s.AttributeType)
+	}
+	if err != nil {
+		return fmt.Errorf("attribute %q value does not conform to its schema: %w", s.Name, err)
+	}
+	return nil
+}
+
+// ValidateProtoValue returns an error if the value is not a valid encoding of the proto message with the given type url.
+// Values with fields unknown to that message type are not valid.
+func ValidateProtoValue(typeURL string, value []byte) error {
+	resolver := unknownproto.DefaultAnyResolver{}
+	msg, err := resolver.Resolve(typeURL)
+	if err != nil {
+		return err
+	}
+	if err = unknownproto.RejectUnknownFieldsStrict(value, msg, resolver); err != nil {
+		return err
+	}
+	return proto.Unmarshal(value, msg)
+}
+
+// ValidateJSONValue returns an error if the value does not conform to the provided JSON schema.
+func ValidateJSONValue(schema string, value []byte) error {
+	compiled, err := CompileJSONSchema([]byte(schema))
+	if err != nil {
+		return fmt.Errorf("invalid json schema: %w", err)
+	}
+	doc, err := decodeJSON(value)
+	if err != nil {
+		return fmt.Errorf("invalid json value: %w", err)
+	}
+	return compiled.validate("$", doc)
+}
+
+// JSONSchema is a compiled JSON Schema.
+//
+// Only a subset of the JSON Schema keywords are supported:
+//   - Annotations (ignored): $schema, $id, $comment, title, description, default, examples.
+//   - Any type: type, enum, const.
+//   - Objects: properties, required, additionalProperties, minProperties, maxProperties.
+//   - Arrays: items, minItems, maxItems, uniqueItems.
+//   - Strings: minLength, maxLength, pattern (using RE2 syntax).
+//   - Numbers: minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf.
+//
+// A schema with any other keyword is not valid.
+type JSONSchema struct {
+	// alwaysFail is true for the "false" schema.
+	alwaysFail bool
+
+	types    []string
+	enum     []interface{}
+	hasConst bool
+	constVal interface{}
+
+	properties           map[string]*JSONSchema
+	required             []string
+	additionalProperties *JSONSchema
+	minProperties        *int
+	maxProperties        *int
+
+	items       *JSONSchema
+	minItems    *int
+	maxItems    *int
+	uniqueItems bool
+
+	minLength *int
+	maxLength *int
+	pattern   *regexp.Regexp
+
+	minimum          *big.Rat
+	maximum          *big.Rat
+	exclusiveMinimum *big.Rat
+	exclusiveMaximum *big.Rat
+	multipleOf       *big.Rat
+}
+
+// jsonSchemaTypes are the allowed entries for the "type" keyword.
+var jsonSchemaTypes = map[string]bool{
+	"null": true, "boolean": true, "object": true, "array": true, "number": true, "integer": true, "string": true,
+}
+
+// jsonSchemaAnnotations are keywords that are allowed, but do not affect validation.
+var jsonSchemaAnnotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true, "default": true, "examples": true,
+}
+
+// CompileJSONSchema parses the provided JSON Schema document.
+func CompileJSONSchema(schema []byte) (*JSONSchema, error) {
+	doc, err := decodeJSON(schema)
+	if err != nil {
+		return nil, err
+	}
+	return compileJSONSchema("#", doc, 0)
+}
+
+// compileJSONSchema compiles a decoded JSON Schema (or sub-schema) found at the given path.
+func compileJSONSchema(path string, doc interface{}, depth int) (*JSONSchema, error) {
+	if depth > maxJSONSchemaDepth {
+		return nil, fmt.Errorf("%s: schema nested too deeply: max depth %d", path, maxJSONSchemaDepth)
+	}
+	if b, ok := doc.(bool); ok {
+		return &JSONSchema{alwaysFail: !b}, nil
+	}
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: schema must be an object or boolean", path)
+	}
+
+	rv := &JSONSchema{}
+	var err error
+	for _, key := range sortedKeys(obj) {
+		val := obj[key]
+		keyPath := path + "/" + key
+		switch key {
+		case "type":
+			rv.types, err = compileSchemaTypes(keyPath, val)
+		case "enum":
+			arr, isArr := val.([]interface{})
+			if !isArr || len(arr) == 0 {
+				err = fmt.Errorf("%s: must be a non-empty array", keyPath)
+			}
+			rv.enum = arr
+		case "const":
+			rv.hasConst = true
+			rv.constVal = val
+		case "properties":
+			props, isObj := val.(map[string]interface{})
+			if !isObj {
+				err = fmt.Errorf("%s: must be an object", keyPath)
+				break
+			}
+			rv.properties = make(map[string]*JSONSchema, len(props))
+			for _, prop := range sortedKeys(props) {
+				if rv.properties[prop], err = compileJSONSchema(keyPath+"/"+prop, props[prop], depth+1); err != nil {
+					break
+				}
+			}
+		case "required":
+			rv.required, err = compileStrings(keyPath, val)
+		case "additionalProperties":
+			rv.additionalProperties, err = compileJSONSchema(keyPath, val, depth+1)
+		case "minProperties":
+			rv.minProperties, err = compileNonNegInt(keyPath, val)
+		case "maxProperties":
+			rv.maxProperties, err = compileNonNegInt(keyPath, val)
+		case "items":
+			rv.items, err = compileJSONSchema(keyPath, val, depth+1)
+		case "minItems":
+			rv.minItems, err = compileNonNegInt(keyPath, val)
+		case "maxItems":
+			rv.maxItems, err = compileNonNegInt(keyPath, val)
+		case "uniqueItems":
+			b, isBool := val.(bool)
+			if !isBool {
+				err = fmt.Errorf("%s: must be a boolean", keyPath)
+			}
+			rv.uniqueItems = b
+		case "minLength":
+			rv.minLength, err = compileNonNegInt(keyPath, val)
+		case "maxLength":
+			rv.maxLength, err = compileNonNegInt(keyPath, val)
+		case "pattern":
+			str, isStr := val.(string)
+			if !isStr {
+				err = fmt.Errorf("%s: must be a string", keyPath)
+				break
+			}
+			if rv.pattern, err = regexp.Compile(str); err != nil {
+				err = fmt.Errorf("%s: %w", keyPath, err)
+			}
+		case "minimum":
+			rv.minimum, err = compileNumber(keyPath, val)
+		case "maximum":
+			rv.maximum, err = compileNumber(keyPath, val)
+		case "exclusiveMinimum":
+			rv.exclusiveMinimum, err = compileNumber(keyPath, val)
+		case "exclusiveMaximum":
+			rv.exclusiveMaximum, err = compileNumber(keyPath, val)
+		case "multipleOf":
+			rv.multipleOf, err = compileNumber(keyPath, val)
+			if err == nil && rv.multipleOf.Sign() <= 0 {
+				err = fmt.Errorf("%s: must be greater than zero", keyPath)
+			}
+		default:
+			if !jsonSchemaAnnotations[key] {
+				err = fmt.Errorf("%s: unsupported keyword", keyPath)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// validate returns an error if the provided decoded JSON value (found at the given path) does not conform to this schema.
+func (s *JSONSchema) validate(path string, val interface{}) error {
+	if s.alwaysFail {
+		return fmt.Errorf("%s: not allowed", path)
+	}
+
+	if len(s.types) > 0 {
+		valType := jsonTypeOf(val)
+		found := false
+		for _, t := range s.types {
+			if t == valType || (t == "number" && valType == "integer") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: expected %s, got %s", path, strings.Join(s.types, " or "), valType)
+		}
+	}
+
+	if len(s.enum) > 0 {
+		found := false
+		for _, opt := range s.enum {
+			if jsonEqual(val, opt) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s: value is not one of the allowed values", path)
+		}
+	}
+
+	if s.hasConst && !jsonEqual(val, s.constVal) {
+		return fmt.Errorf("%s: value does not equal the required constant", path)
+	}
+
+	switch v := val.(type) {
+	case map[string]interface{}:
+		return s.validateObject(path, v)
+	case []interface{}:
+		return s.validateArray(path, v)
+	case string:
+		return s.validateString(path, v)
+	case json.Number:
+		return s.validateNumber(path, v)
+	}
+	return nil
+}
+
+// validateObject applies the object keywords of this schema to the provided object.
+func (s *JSONSchema) validateObject(path string, obj map[string]interface{}) error {
+	if s.minProperties != nil && len(obj) < *s.minProperties {
+		return fmt.Errorf("%s: must have at least %d properties", path, *s.minProperties)
+	}
+	if s.maxProperties != nil && len(obj) > *s.maxProperties {
+		return fmt.Errorf("%s: must have at most %d properties", path, *s.maxProperties)
+	}
+	for _, req := range s.required {
+		if _, has := obj[req]; !has {
+			return fmt.Errorf("%s: missing required property %q", path, req)
+		}
+	}
+	for _, key := range sortedKeys(obj) {
+		propSchema, known := s.properties[key]
+		if !known {
+			propSchema = s.additionalProperties
+		}
+		if propSchema == nil {
+			continue
+		}
+		if err := propSchema.validate(path+"."+key, obj[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateArray applies the array keywords of this schema to the provided array.
+func (s *JSONSchema) validateArray(path string, arr []interface{}) error {
+	if s.minItems != nil && len(arr) < *s.minItems {
+		return fmt.Errorf("%s: must have at least %d items", path, *s.minItems)
+	}
+	if s.maxItems != nil && len(arr) > *s.maxItems {
+		return fmt.Errorf("%s: must have at most %d items", path, *s.maxItems)
+	}
+	if s.uniqueItems {
+		for i := range arr {
+			for j := i + 1; j < len(arr); j++ {
+				if jsonEqual(arr[i], arr[j]) {
+					return fmt.Errorf("%s: items %d and %d are equal", path, i, j)
+				}
+			}
+		}
+	}
+	if s.items != nil {
+		for i, item := range arr {
+			if err := s.items.validate(path+"["+strconv.Itoa(i)+"]", item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateString applies the string keywords of this schema to the provided string.
+func (s *JSONSchema) validateString(path string, str string) error {
+	length := utf8.RuneCountInString(str)
+	if s.minLength != nil && length < *s.minLength {
+		return fmt.Errorf("%s: length must be at least %d", path, *s.minLength)
+	}
+	if s.maxLength != nil && length > *s.maxLength {
+		return fmt.Errorf("%s: length must be at most %d", path, *s.maxLength)
+	}
+	if s.pattern != nil && !s.pattern.MatchString(str) {
+		return fmt.Errorf("%s: does not match pattern %q", path, s.pattern.String())
+	}
+	return nil
+}
+
+// validateNumber applies the number keywords of this schema to the provided number.
+func (s *JSONSchema) validateNumber(path string, num json.Number) error {
+	if s.minimum == nil && s.maximum == nil && s.exclusiveMinimum == nil && s.exclusiveMaximum == nil && s.multipleOf == nil {
+		return nil
+	}
+	val, ok := parseJSONNumber(num)
+	if !ok {
+		return fmt.Errorf("%s: unsupported number %q", path, num)
+	}
+	if s.minimum != nil && val.Cmp(s.minimum) < 0 {
+		return fmt.Errorf("%s: must be at least %s", path, s.minimum.RatString())
+	}
+	if s.maximum != nil && val.Cmp(s.maximum) > 0 {
+		return fmt.Errorf("%s: must be at most %s", path, s.maximum.RatString())
+	}
+	if s.exclusiveMinimum != nil && val.Cmp(s.exclusiveMinimum) <= 0 {
+		return fmt.Errorf("%s: must be greater than %s", path, s.exclusiveMinimum.RatString())
+	}
+	if s.exclusiveMaximum != nil && val.Cmp(s.exclusiveMaximum) >= 0 {
+		return fmt.Errorf("%s: must be less than %s", path, s.exclusiveMaximum.RatString())
+	}
+	if s.multipleOf != nil && !new(big.Rat).Quo(val, s.multipleOf).IsInt() {
+		return fmt.Errorf("%s: must be a multiple of %s", path, s.multipleOf.RatString())
+	}
+	return nil
+}
+
+// decodeJSON decodes a single JSON value, keeping numbers as json.Number.
+func decodeJSON(bz []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var rv interface{}
+	if err := dec.Decode(&rv); err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("unexpected data after top-level value")
+	}
+	return rv, nil
+}
+
+// parseJSONNumber converts a JSON number to a big.Rat.
+// Returns false if it cannot be parsed or has an exponent that is too large.
+func parseJSONNumber(num json.Number) (*big.Rat, bool) {
+	str := num.String()
+	if i := strings.IndexAny(str, "eE"); i >= 0 {
+		exp, err := strconv.Atoi(str[i+1:])
+		if err != nil || exp > maxJSONNumberExponent || exp < -maxJSONNumberExponent {
+			return nil, false
+		}
+	}
+	return new(big.Rat).SetString(str)
+}
+
+// jsonTypeOf returns the JSON Schema type name of a decoded JSON value.
+func jsonTypeOf(val interface{}) string {
+	switch v := val.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if r, ok := parseJSONNumber(v); ok && r.IsInt() {
+			return "integer"
+		}
+		return "number"
+	}
+	return fmt.Sprintf("%T", val)
+}
+
+// jsonEqual returns true if the two decoded JSON values are equal.
+// Numbers are equal if they have the same value, regardless of how they are written.
+func jsonEqual(a, b interface{}) bool {
+	switch av := a.(type) {
+	case json.Number:
+		bv, ok := b.(json.Number)
+		if !ok {
+			return false
+		}
+		ar, aok := parseJSONNumber(av)
+		br, bok := parseJSONNumber(bv)
+		return aok && bok && ar.Cmp(br) == 0
+	case map[string]interface{}:
+		bv, ok := b.(map[string]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for k, v := range av {
+			if bval, has := bv[k]; !has || !jsonEqual(v, bval) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		bv, ok := b.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range av {
+			if !jsonEqual(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return a == b
+	}
+}
+
+// compileSchemaTypes compiles the value of a "type" keyword.
+func compileSchemaTypes(path string, val interface{}) ([]string, error) {
+	var rv []string
+	if str, ok := val.(string); ok {
+		rv = []string{str}
+	} else {
+		var err error
+		if rv, err = compileStrings(path, val); err != nil {
+			return nil, err
+		}
+	}
+	if len(rv) == 0 {
+		return nil, fmt.Errorf("%s: must not be empty", path)
+	}
+	for _, t := range rv {
+		if !jsonSchemaTypes[t] {
+			return nil, fmt.Errorf("%s: unknown type %q", path, t)
+		}
+	}
+	return rv, nil
+}
+
+// compileStrings compiles a keyword value that must be an array of strings.
+func compileStrings(path string, val interface{}) ([]string, error) {
+	arr, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s: must be an array of strings", path)
+	}
+	rv := make([]string, len(arr))
+	for i, entry := range arr {
+		if rv[i], ok = entry.(string); !ok {
+			return nil, fmt.Errorf("%s: must be an array of strings", path)
+		}
+	}
+	return rv, nil
+}
+
+// compileNonNegInt compiles a keyword value that must be a non-negative integer.
+func compileNonNegInt(path string, val interface{}) (*int, error) {
+	num, ok := val.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+	i, err := strconv.Atoi(num.String())
+	if err != nil || i < 0 {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", path)
+	}
+	return &i, nil
+}
+
+// compileNumber compiles a keyword value that must be a number.
+func compileNumber(path string, val interface{}) (*big.Rat, error) {
+	num, ok := val.(json.Number)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", path)
+	}
+	rv, ok := parseJSONNumber(num)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number with an exponent of at most %d", path, maxJSONNumberExponent)
+	}
+	return rv, nil
+}
+
+// sortedKeys returns the keys of the provided map in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	rv := make([]string, 0, len(m))
+	for k := range m {
+		rv = append(rv, k)
+	}
+	sort.Strings(rv)
+	return rv
+}
@@ -0,0 +1,275 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	. "github.com/provenance-io/provenance/x/attribute/types"
+)
+
+func TestAttributeSchemaValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema AttributeSchema
+		exp    string
+	}{
+		{
+			name:   "json",
+			schema: NewAttributeSchema("example.name", AttributeType_JSON, `{"type":"object"}`),
+		},
+		{
+			name:   "proto",
+			schema: NewAttributeSchema("example.name", AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata"),
+		},
+		{
+			name:   "empty name",
+			schema: NewAttributeSchema(" ", AttributeType_JSON, `{"type":"object"}`),
+			exp:    "invalid name: empty",
+		},
+		{
+			name:   "json with proto type url",
+			schema: AttributeSchema{Name: "a", AttributeType: AttributeType_JSON, JsonSchema: `{}`, ProtoTypeUrl: "/a.B"},
+			exp:    "proto type url not allowed for type ATTRIBUTE_TYPE_JSON",
+		},
+		{
+			name:   "json without schema",
+			schema: NewAttributeSchema("a", AttributeType_JSON, ""),
+			exp:    "json schema required for type ATTRIBUTE_TYPE_JSON",
+		},
+		{
+			name:   "json with bad schema",
+			schema: NewAttributeSchema("a", AttributeType_JSON, `{"if":{}}`),
+			exp:    "invalid json schema: #/if: unsupported keyword",
+		},
+		{
+			name:   "json schema too long",
+			schema: NewAttributeSchema("a", AttributeType_JSON, `{"description":"`+strings.Repeat("x", MaxJSONSchemaLength)+`"}`),
+			exp:    "json schema length of 10018 exceeds max length 10000",
+		},
+		{
+			name:   "proto with json schema",
+			schema: AttributeSchema{Name: "a", AttributeType: AttributeType_Proto, JsonSchema: `{}`, ProtoTypeUrl: "/a.B"},
+			exp:    "json schema not allowed for type ATTRIBUTE_TYPE_PROTO",
+		},
+		{
+			name:   "proto type url without slash",
+			schema: NewAttributeSchema("a", AttributeType_Proto, "a.B"),
+			exp:    `invalid proto type url "a.B": must have format /<message name>`,
+		},
+		{
+			name:   "proto type url only slash",
+			schema: NewAttributeSchema("a", AttributeType_Proto, "/"),
+			exp:    `invalid proto type url "/": must have format /<message name>`,
+		},
+		{
+			name:   "unsupported type",
+			schema: NewAttributeSchema("a", AttributeType_Int, "x"),
+			exp:    "invalid attribute type ATTRIBUTE_TYPE_INT: must be ATTRIBUTE_TYPE_JSON or ATTRIBUTE_TYPE_PROTO",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.ValidateBasic()
+			if len(tc.exp) > 0 {
+				assert.EqualError(t, err, tc.exp, "ValidateBasic")
+			} else {
+				assert.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestAttributeSchemaValidateValue(t *testing.T) {
+	jsonSchema := NewAttributeSchema("json.name", AttributeType_JSON, `{"type":"object","required":["id"]}`)
+	protoSchema := NewAttributeSchema("proto.name", AttributeType_Proto, "/cosmos.bank.v1beta1.Metadata")
+
+	metadata := banktypes.Metadata{Base: "nhash", Display: "hash"}
+	metadataBz, err := metadata.Marshal()
+	require.NoError(t, err, "metadata.Marshal()")
+	sendBz, err := (&banktypes.MsgSend{FromAddress: "from", ToAddress: "to"}).Marshal()
+	require.NoError(t, err, "MsgSend.Marshal()")
+
+	tests := []struct {
+		name     string
+		schema   AttributeSchema
+		attrType AttributeType
+		value    []byte
+		exp      string
+	}{
+		{
+			name:     "json ok",
+			schema:   jsonSchema,
+			attrType: AttributeType_JSON,
+			value:    []byte(`{"id":1}`),
+		},
+		{
+			name:     "json wrong type",
+			schema:   jsonSchema,
+			attrType: AttributeType_String,
+			value:    []byte(`{"id":1}`),
+			exp:      `attribute "json.name" must have type ATTRIBUTE_TYPE_JSON`,
+		},
+		{
+			name:     "json missing property",
+			schema:   jsonSchema,
+			attrType: AttributeType_JSON,
+			value:    []byte(`{"name":"x"}`),
+			exp:      `attribute "json.name" value does not conform to its schema: $: missing required property "id"`,
+		},
+		{
+			name:     "json not json",
+			schema:   jsonSchema,
+			attrType: AttributeType_JSON,
+			value:    []byte(`{"id":1`),
+			exp:      `attribute "json.name" value does not conform to its schema: invalid json value: unexpected EOF`,
+		},
+		{
+			name:     "proto ok",
+			schema:   protoSchema,
+			attrType: AttributeType_Proto,
+			value:    metadataBz,
+		},
+		{
+			name:     "proto different message",
+			schema:   protoSchema,
+			attrType: AttributeType_Proto,
+			value:    sendBz,
+			exp:      `attribute "proto.name" value does not conform to its schema: `,
+		},
+		{
+			name:     "proto unknown type url",
+			schema:   NewAttributeSchema("proto.name", AttributeType_Proto, "/not.a.Thing"),
+			attrType: AttributeType_Proto,
+			value:    metadataBz,
+			exp:      `attribute "proto.name" value does not conform to its schema: `,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schema.ValidateValue(tc.attrType, tc.value)
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateValue")
+			} else {
+				assert.NoError(t, err, "ValidateValue")
+			}
+		})
+	}
+}
+
+func TestCompileJSONSchema(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		exp    string
+	}{
+		{name: "empty object", schema: `{}`},
+		{name: "true", schema: `true`},
+		{name: "false", schema: `false`},
+		{name: "annotations", schema: `{"$schema":"x","$id":"y","$comment":"z","title":"t","description":"d","default":1,"examples":[1]}`},
+		{name: "not an object", schema: `"string"`, exp: "#: schema must be an object or boolean"},
+		{name: "not json", schema: `{`, exp: "unexpected EOF"},
+		{name: "trailing data", schema: `{} {}`, exp: "unexpected data after top-level value"},
+		{name: "unknown keyword", schema: `{"oneOf":[]}`, exp: "#/oneOf: unsupported keyword"},
+		{name: "nested unknown keyword", schema: `{"properties":{"a":{"items":{"$ref":"#"}}}}`, exp: "#/properties/a/items/$ref: unsupported keyword"},
+		{name: "unknown type", schema: `{"type":"float"}`, exp: `#/type: unknown type "float"`},
+		{name: "empty enum", schema: `{"enum":[]}`, exp: "#/enum: must be a non-empty array"},
+		{name: "negative min length", schema: `{"minLength":-1}`, exp: "#/minLength"},
+		{name: "bad pattern", schema: `{"pattern":"("}`, exp: "#/pattern: error parsing regexp"},
+		{name: "zero multiple of", schema: `{"multipleOf":0}`, exp: "#/multipleOf: must be greater than zero"},
+		{name: "unique items not bool", schema: `{"uniqueItems":1}`, exp: "#/uniqueItems: must be a boolean"},
+		{
+			name:   "too deep",
+			schema: `{"items":` + strings.Repeat(`{"items":`, 40) + `{}` + strings.Repeat(`}`, 41),
+			exp:    "schema nested too deeply",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := CompileJSONSchema([]byte(tc.schema))
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "CompileJSONSchema")
+			} else {
+				assert.NoError(t, err, "CompileJSONSchema")
+			}
+		})
+	}
+}
+
+func TestValidateJSONValue(t *testing.T) {
+	objSchema := `{
+	"type": "object",
+	"properties": {
+		"id": {"type": "integer", "minimum": 1},
+		"name": {"type": "string", "minLength": 2, "maxLength": 5, "pattern": "^[a-z]+$"},
+		"tags": {"type": "array", "items": {"type": "string"}, "maxItems": 2, "uniqueItems": true},
+		"kind": {"enum": ["a", "b"]},
+		"version": {"const": 2}
+	},
+	"required": ["id"],
+	"additionalProperties": false
+}`
+
+	tests := []struct {
+		name   string
+		schema string
+		value  string
+		exp    string
+	}{
+		{name: "obj: minimal", schema: objSchema, value: `{"id":1}`},
+		{name: "obj: full", schema: objSchema, value: `{"id":3,"name":"abc","tags":["x","y"],"kind":"b","version":2}`},
+		{name: "obj: not an object", schema: objSchema, value: `[]`, exp: "$: expected object, got array"},
+		{name: "obj: missing id", schema: objSchema, value: `{"name":"abc"}`, exp: `$: missing required property "id"`},
+		{name: "obj: id not integer", schema: objSchema, value: `{"id":1.5}`, exp: "$.id: expected integer, got number"},
+		{name: "obj: id too small", schema: objSchema, value: `{"id":0}`, exp: "$.id: must be at least 1"},
+		{name: "obj: name too short", schema: objSchema, value: `{"id":1,"name":"a"}`, exp: "$.name: length must be at least 2"},
+		{name: "obj: name too long", schema: objSchema, value: `{"id":1,"name":"abcdef"}`, exp: "$.name: length must be at most 5"},
+		{name: "obj: name bad pattern", schema: objSchema, value: `{"id":1,"name":"AB"}`, exp: `$.name: does not match pattern "^[a-z]+$"`},
+		{name: "obj: too many tags", schema: objSchema, value: `{"id":1,"tags":["a","b","c"]}`, exp: "$.tags: must have at most 2 items"},
+		{name: "obj: duplicate tags", schema: objSchema, value: `{"id":1,"tags":["a","a"]}`, exp: "$.tags: items 0 and 1 are equal"},
+		{name: "obj: tag not string", schema: objSchema, value: `{"id":1,"tags":[1]}`, exp: "$.tags[0]: expected string, got integer"},
+		{name: "obj: bad kind", schema: objSchema, value: `{"id":1,"kind":"c"}`, exp: "$.kind: value is not one of the allowed values"},
+		{name: "obj: bad version", schema: objSchema, value: `{"id":1,"version":2.5}`, exp: "$.version: value does not equal the required constant"},
+		{name: "obj: equivalent version", schema: objSchema, value: `{"id":1,"version":2.0}`},
+		{name: "obj: additional property", schema: objSchema, value: `{"id":1,"other":true}`, exp: "$.other: not allowed"},
+		{name: "obj: trailing data", schema: objSchema, value: `{"id":1}x`, exp: "invalid json value: unexpected data after top-level value"},
+
+		{name: "num: exclusive min ok", schema: `{"exclusiveMinimum":0}`, value: `0.001`},
+		{name: "num: exclusive min", schema: `{"exclusiveMinimum":0}`, value: `0`, exp: "$: must be greater than 0"},
+		{name: "num: exclusive max", schema: `{"exclusiveMaximum":10}`, value: `10`, exp: "$: must be less than 10"},
+		{name: "num: max", schema: `{"maximum":10}`, value: `1e2`, exp: "$: must be at most 10"},
+		{name: "num: multiple of ok", schema: `{"multipleOf":0.1}`, value: `0.3`},
+		{name: "num: multiple of", schema: `{"multipleOf":0.1}`, value: `0.35`, exp: "$: must be a multiple of 1/10"},
+		{name: "num: huge exponent", schema: `{"maximum":10}`, value: `1e999999999`, exp: `$: unsupported number "1e999999999"`},
+		{name: "num: huge exponent no constraints", schema: `{"type":"number"}`, value: `1e999999999`},
+
+		{name: "obj: min properties", schema: `{"minProperties":1}`, value: `{}`, exp: "$: must have at least 1 properties"},
+		{name: "obj: max properties", schema: `{"maxProperties":1}`, value: `{"a":1,"b":2}`, exp: "$: must have at most 1 properties"},
+		{name: "obj: additional properties schema", schema: `{"additionalProperties":{"type":"string"}}`, value: `{"a":"x","b":1}`, exp: "$.b: expected string, got integer"},
+		{name: "arr: min items", schema: `{"minItems":1}`, value: `[]`, exp: "$: must have at least 1 items"},
+		{name: "arr: unique objects", schema: `{"uniqueItems":true}`, value: `[{"a":1},{"a":1.0}]`, exp: "$: items 0 and 1 are equal"},
+		{name: "multiple types", schema: `{"type":["string","null"]}`, value: `null`},
+		{name: "multiple types wrong", schema: `{"type":["string","null"]}`, value: `true`, exp: "$: expected string or null, got boolean"},
+		{name: "false schema", schema: `false`, value: `1`, exp: "$: not allowed"},
+		{name: "true schema", schema: `true`, value: `{"anything":[1,2,3]}`},
+		{name: "bad schema", schema: `{"type":1}`, value: `1`, exp: "invalid json schema: #/type"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateJSONValue(tc.schema, []byte(tc.value))
+			if len(tc.exp) > 0 {
+				assert.ErrorContains(t, err, tc.exp, "ValidateJSONValue")
+			} else {
+				assert.NoError(t, err, "ValidateJSONValue")
+			}
+		})
+	}
+}
@@ -816,6 +816,388 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetAttributeSchemaRequest defines a message to set the schema that values of an attribute name must conform to.
+// A schema may only be set by the account that the attribute name resolves to.
+type MsgSetAttributeSchemaRequest struct {
+	// The schema to set.
+	Schema AttributeSchema `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgSetAttributeSchemaRequest) Reset()         { *m = MsgSetAttributeSchemaRequest{} }
+func (m *MsgSetAttributeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeSchemaRequest) ProtoMessage()    {}
+func (*MsgSetAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{14}
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeSchemaRequest.Merge(m, src)
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeSchemaRequest proto.InternalMessageInfo
+
+func (m *MsgSetAttributeSchemaRequest) GetSchema() AttributeSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return AttributeSchema{}
+}
+
+func (m *MsgSetAttributeSchemaRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgSetAttributeSchemaResponse defines the Msg/SetAttributeSchema response type.
+type MsgSetAttributeSchemaResponse struct {
+}
+
+func (m *MsgSetAttributeSchemaResponse) Reset()         { *m = MsgSetAttributeSchemaResponse{} }
+func (m *MsgSetAttributeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAttributeSchemaResponse) ProtoMessage()    {}
+func (*MsgSetAttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{15}
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAttributeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAttributeSchemaResponse.Merge(m, src)
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAttributeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAttributeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAttributeSchemaResponse proto.InternalMessageInfo
+
+// MsgDeleteAttributeSchemaRequest defines a message to remove the schema of an attribute name.
+// A schema may only be removed by the account that the attribute name resolves to.
+type MsgDeleteAttributeSchemaRequest struct {
+	// The attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) Reset()         { *m = MsgDeleteAttributeSchemaRequest{} }
+func (m *MsgDeleteAttributeSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAttributeSchemaRequest) ProtoMessage()    {}
+func (*MsgDeleteAttributeSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{16}
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAttributeSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAttributeSchemaRequest.Merge(m, src)
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAttributeSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAttributeSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAttributeSchemaRequest proto.InternalMessageInfo
+
+func (m *MsgDeleteAttributeSchemaRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgDeleteAttributeSchemaResponse defines the Msg/DeleteAttributeSchema response type.
+type MsgDeleteAttributeSchemaResponse struct {
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) Reset()         { *m = MsgDeleteAttributeSchemaResponse{} }
+func (m *MsgDeleteAttributeSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeleteAttributeSchemaResponse) ProtoMessage()    {}
+func (*MsgDeleteAttributeSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{17}
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeleteAttributeSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeleteAttributeSchemaResponse.Merge(m, src)
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeleteAttributeSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeleteAttributeSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDeleteAttributeSchemaResponse proto.InternalMessageInfo
+
+// MsgGrantAttributeIssuerRequest defines a message to allow another account to manage attributes of a name.
+// An issuer may only be granted by the account that the attribute name resolves to.
+// Granting an existing issuer replaces its permissions and expiration.
+type MsgGrantAttributeIssuerRequest struct {
+	// The issuer to grant.
+	Issuer AttributeIssuer `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgGrantAttributeIssuerRequest) Reset()         { *m = MsgGrantAttributeIssuerRequest{} }
+func (m *MsgGrantAttributeIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAttributeIssuerRequest) ProtoMessage()    {}
+func (*MsgGrantAttributeIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{18}
+}
+func (m *MsgGrantAttributeIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAttributeIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAttributeIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantAttributeIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAttributeIssuerRequest.Merge(m, src)
+}
+func (m *MsgGrantAttributeIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAttributeIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAttributeIssuerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAttributeIssuerRequest proto.InternalMessageInfo
+
+func (m *MsgGrantAttributeIssuerRequest) GetIssuer() AttributeIssuer {
+	if m != nil {
+		return m.Issuer
+	}
+	return AttributeIssuer{}
+}
+
+func (m *MsgGrantAttributeIssuerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgGrantAttributeIssuerResponse defines the Msg/GrantAttributeIssuer response type.
+type MsgGrantAttributeIssuerResponse struct {
+}
+
+func (m *MsgGrantAttributeIssuerResponse) Reset()         { *m = MsgGrantAttributeIssuerResponse{} }
+func (m *MsgGrantAttributeIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantAttributeIssuerResponse) ProtoMessage()    {}
+func (*MsgGrantAttributeIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{19}
+}
+func (m *MsgGrantAttributeIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantAttributeIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantAttributeIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgGrantAttributeIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantAttributeIssuerResponse.Merge(m, src)
+}
+func (m *MsgGrantAttributeIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantAttributeIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantAttributeIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantAttributeIssuerResponse proto.InternalMessageInfo
+
+// MsgRevokeAttributeIssuerRequest defines a message to remove an account's permission to manage attributes of a name.
+// An issuer may only be revoked by the account that the attribute name resolves to.
+type MsgRevokeAttributeIssuerRequest struct {
+	// The attribute name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The issuer to revoke.
+	Issuer string `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// The address that the name must resolve to.
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) Reset()         { *m = MsgRevokeAttributeIssuerRequest{} }
+func (m *MsgRevokeAttributeIssuerRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttributeIssuerRequest) ProtoMessage()    {}
+func (*MsgRevokeAttributeIssuerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{20}
+}
+func (m *MsgRevokeAttributeIssuerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttributeIssuerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttributeIssuerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttributeIssuerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttributeIssuerRequest.Merge(m, src)
+}
+func (m *MsgRevokeAttributeIssuerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttributeIssuerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttributeIssuerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttributeIssuerRequest proto.InternalMessageInfo
+
+func (m *MsgRevokeAttributeIssuerRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgRevokeAttributeIssuerResponse defines the Msg/RevokeAttributeIssuer response type.
+type MsgRevokeAttributeIssuerResponse struct {
+}
+
+func (m *MsgRevokeAttributeIssuerResponse) Reset()         { *m = MsgRevokeAttributeIssuerResponse{} }
+func (m *MsgRevokeAttributeIssuerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeAttributeIssuerResponse) ProtoMessage()    {}
+func (*MsgRevokeAttributeIssuerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5de344c1a12714be, []int{21}
+}
+func (m *MsgRevokeAttributeIssuerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeAttributeIssuerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeAttributeIssuerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeAttributeIssuerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeAttributeIssuerResponse.Merge(m, src)
+}
+func (m *MsgRevokeAttributeIssuerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeAttributeIssuerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeAttributeIssuerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeAttributeIssuerResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAddAttributeRequest)(nil), "provenance.attribute.v1.MsgAddAttributeRequest")
 	proto.RegisterType((*MsgAddAttributeResponse)(nil), "provenance.attribute.v1.MsgAddAttributeResponse")
@@ -831,66 +1213,86 @@ func init() {
 	proto.RegisterType((*MsgSetAccountDataResponse)(nil), "provenance.attribute.v1.MsgSetAccountDataResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.attribute.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.attribute.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetAttributeSchemaRequest)(nil), "provenance.attribute.v1.MsgSetAttributeSchemaRequest")
+	proto.RegisterType((*MsgSetAttributeSchemaResponse)(nil), "provenance.attribute.v1.MsgSetAttributeSchemaResponse")
+	proto.RegisterType((*MsgDeleteAttributeSchemaRequest)(nil), "provenance.attribute.v1.MsgDeleteAttributeSchemaRequest")
+	proto.RegisterType((*MsgDeleteAttributeSchemaResponse)(nil), "provenance.attribute.v1.MsgDeleteAttributeSchemaResponse")
+	proto.RegisterType((*MsgGrantAttributeIssuerRequest)(nil), "provenance.attribute.v1.MsgGrantAttributeIssuerRequest")
+	proto.RegisterType((*MsgGrantAttributeIssuerResponse)(nil), "provenance.attribute.v1.MsgGrantAttributeIssuerResponse")
+	proto.RegisterType((*MsgRevokeAttributeIssuerRequest)(nil), "provenance.attribute.v1.MsgRevokeAttributeIssuerRequest")
+	proto.RegisterType((*MsgRevokeAttributeIssuerResponse)(nil), "provenance.attribute.v1.MsgRevokeAttributeIssuerResponse")
 }
 
 func init() { proto.RegisterFile("provenance/attribute/v1/tx.proto", fileDescriptor_5de344c1a12714be) }
 
 var fileDescriptor_5de344c1a12714be = []byte{
-	// 850 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0xe4, 0x57, 0xd9, 0x69, 0x36, 0x2b, 0x0d, 0x5d, 0xe2, 0x18, 0x94, 0x64, 0xc3, 0xb2,
-	0x44, 0x2b, 0xad, 0x4d, 0xb3, 0x82, 0x43, 0xa0, 0x87, 0x44, 0xe1, 0x18, 0xa9, 0x4a, 0x0b, 0x42,
-	0x3d, 0x10, 0x4d, 0x92, 0xc1, 0xb5, 0x88, 0x3d, 0xae, 0x67, 0x9c, 0xb6, 0x9c, 0x10, 0x37, 0x6e,
-	0x15, 0x5c, 0x38, 0x20, 0xf1, 0x2f, 0xf4, 0xc0, 0x1f, 0xd1, 0x63, 0xc5, 0x09, 0x71, 0x28, 0xa8,
-	0x3d, 0xf4, 0xcc, 0x7f, 0x80, 0xe2, 0x19, 0x27, 0xce, 0x0f, 0x9b, 0x26, 0x7b, 0xf3, 0x9b, 0x79,
-	0xef, 0xfb, 0x3e, 0x7f, 0x6f, 0xde, 0xd8, 0xb0, 0xe2, 0xb8, 0x74, 0x4c, 0x6c, 0x6c, 0x0f, 0x88,
-	0x8e, 0x39, 0x77, 0xcd, 0xbe, 0xc7, 0x89, 0x3e, 0xde, 0xd5, 0xf9, 0x99, 0xe6, 0xb8, 0x94, 0x53,
-	0x54, 0x98, 0x65, 0x68, 0xd3, 0x0c, 0x6d, 0xbc, 0xab, 0x16, 0x06, 0x94, 0x59, 0x94, 0xe9, 0x16,
-	0x33, 0x26, 0x05, 0x16, 0x33, 0x44, 0x85, 0x5a, 0x14, 0x1b, 0x3d, 0x3f, 0xd2, 0x45, 0x20, 0xb7,
-	0x76, 0x0c, 0x6a, 0x50, 0xb1, 0x3e, 0x79, 0x92, 0xab, 0x65, 0x83, 0x52, 0x63, 0x44, 0x74, 0x3f,
-	0xea, 0x7b, 0xdf, 0xe8, 0xdc, 0xb4, 0x08, 0xe3, 0xd8, 0x72, 0x64, 0xc2, 0x87, 0x51, 0x2a, 0x67,
-	0x82, 0xfc, 0xc4, 0xea, 0xaf, 0x49, 0xf8, 0x4e, 0x87, 0x19, 0xcd, 0xe1, 0xb0, 0x19, 0xec, 0x74,
-	0xc9, 0x89, 0x47, 0x18, 0x47, 0x08, 0xa6, 0x6d, 0x6c, 0x11, 0x05, 0x54, 0x40, 0xed, 0x51, 0xd7,
-	0x7f, 0x46, 0x3b, 0x30, 0x33, 0xc6, 0x23, 0x8f, 0x28, 0xc9, 0x0a, 0xa8, 0xe5, 0xba, 0x22, 0x40,
-	0x1d, 0x98, 0x9f, 0xe2, 0xf6, 0xf8, 0xb9, 0x43, 0x94, 0x54, 0x05, 0xd4, 0xf2, 0xf5, 0x17, 0x5a,
-	0x84, 0x15, 0xda, 0x94, 0xec, 0xf0, 0xdc, 0x21, 0xdd, 0xc7, 0x38, 0x1c, 0x22, 0x05, 0x6e, 0xe1,
-	0xc1, 0x80, 0x7a, 0x36, 0x57, 0xd2, 0x3e, 0x77, 0x10, 0x4e, 0xe8, 0xe9, 0xa9, 0x4d, 0x5c, 0x25,
-	0xe3, 0xaf, 0x8b, 0x00, 0x75, 0xe0, 0x13, 0x72, 0xe6, 0x98, 0x2e, 0xe6, 0x26, 0xb5, 0x7b, 0x43,
-	0xcc, 0x89, 0x92, 0xad, 0x80, 0xda, 0x76, 0x5d, 0xd5, 0x84, 0x4f, 0x5a, 0xe0, 0x93, 0x76, 0x18,
-	0xf8, 0xd4, 0x7a, 0xeb, 0xea, 0xa6, 0x0c, 0x2e, 0xfe, 0x2e, 0x83, 0x6e, 0x7e, 0x56, 0xdc, 0xc6,
-	0x9c, 0x34, 0xe0, 0x0f, 0xf7, 0x97, 0x2f, 0x05, 0x74, 0xb5, 0x08, 0x0b, 0x4b, 0xee, 0x30, 0x87,
-	0xda, 0x8c, 0x54, 0xff, 0x4d, 0xc2, 0x62, 0x87, 0x19, 0x5f, 0x38, 0x13, 0xc2, 0x07, 0x99, 0xf7,
-	0x01, 0xcc, 0x53, 0xd7, 0x34, 0x4c, 0x1b, 0x8f, 0x7a, 0x61, 0x17, 0x1f, 0x07, 0xab, 0x5f, 0xfa,
-	0x6e, 0x3e, 0x83, 0x39, 0xcf, 0x07, 0x95, 0x49, 0x29, 0x3f, 0x69, 0x5b, 0xac, 0x89, 0x94, 0xaf,
-	0x61, 0x61, 0x8a, 0xb4, 0xe0, 0x7c, 0x7a, 0x2d, 0xe7, 0x9f, 0x06, 0x30, 0x73, 0xcb, 0xe8, 0x08,
-	0x3e, 0x95, 0x12, 0x16, 0xd0, 0x33, 0x6b, 0xa1, 0xbf, 0xed, 0xcd, 0x9b, 0xb3, 0xd8, 0xdd, 0x6c,
-	0x44, 0x77, 0xb7, 0x42, 0xdd, 0x9d, 0x6b, 0xc7, 0x7b, 0x50, 0x5d, 0x65, 0xb9, 0xec, 0xc8, 0x5f,
-	0x00, 0xbe, 0xbf, 0xbc, 0xfd, 0xf9, 0xb4, 0xbb, 0x9b, 0x1c, 0xec, 0xa5, 0x93, 0x95, 0xda, 0xfc,
-	0x64, 0xad, 0x7b, 0xb0, 0xe7, 0x5e, 0xfd, 0x05, 0x7c, 0x1e, 0xff, 0x6e, 0xd2, 0x84, 0x6f, 0xfd,
-	0x53, 0xd9, 0x26, 0x23, 0xf2, 0xc0, 0x53, 0x19, 0x12, 0x95, 0x8c, 0x10, 0x95, 0x8a, 0xef, 0xc7,
-	0x12, 0x99, 0x94, 0xf2, 0x23, 0x80, 0xcf, 0xa6, 0xdb, 0x6d, 0x93, 0x71, 0xd3, 0x1e, 0xf0, 0x37,
-	0xb8, 0x66, 0x42, 0x4a, 0x53, 0x11, 0x4a, 0xd3, 0x51, 0x4a, 0x9f, 0xc3, 0x6a, 0x9c, 0x14, 0xa9,
-	0xf8, 0x2b, 0xa8, 0x74, 0x98, 0x71, 0x40, 0x78, 0x53, 0x00, 0xb7, 0x31, 0xc7, 0x81, 0xce, 0xa9,
-	0x26, 0x21, 0x74, 0x59, 0xd3, 0xbc, 0x7b, 0x8d, 0xdc, 0x84, 0x3d, 0x88, 0xaa, 0xef, 0xfa, 0x6d,
-	0x59, 0x44, 0x96, 0xb4, 0xbf, 0x01, 0xff, 0x12, 0x16, 0xcd, 0xdd, 0xc7, 0x2e, 0xb6, 0x58, 0xc0,
-	0xfa, 0x09, 0x7c, 0x84, 0x3d, 0x7e, 0x4c, 0x5d, 0x93, 0x9f, 0x0b, 0xe6, 0x96, 0xf2, 0xc7, 0xef,
-	0xaf, 0x76, 0xe4, 0x47, 0xa2, 0x39, 0x1c, 0xba, 0x84, 0xb1, 0x03, 0xee, 0x9a, 0xb6, 0xd1, 0x9d,
-	0xa5, 0xa2, 0x3d, 0x98, 0x75, 0x7c, 0x20, 0x5f, 0xd6, 0x76, 0xbd, 0x1c, 0x39, 0xb2, 0x82, 0xaf,
-	0x95, 0xbe, 0xba, 0x29, 0x27, 0xba, 0xb2, 0xa8, 0x91, 0x9f, 0x88, 0x9f, 0xc1, 0xc9, 0x7b, 0x70,
-	0x5e, 0xa0, 0x10, 0x5f, 0xff, 0x79, 0x0b, 0xa6, 0x3a, 0xcc, 0x40, 0x27, 0x30, 0x17, 0xbe, 0x27,
-	0x91, 0x1e, 0xc9, 0xb8, 0xfa, 0x7b, 0xa3, 0x7e, 0xf4, 0xf0, 0x02, 0x41, 0x8d, 0xbe, 0x83, 0x4f,
-	0x16, 0x06, 0x02, 0xd5, 0xe3, 0x40, 0x56, 0xdf, 0xd5, 0xea, 0xeb, 0xb5, 0x6a, 0x24, 0xf7, 0x2f,
-	0x00, 0x16, 0x23, 0xa7, 0x11, 0x7d, 0xb6, 0x06, 0xe4, 0xd2, 0x05, 0xa5, 0xee, 0x6d, 0x58, 0x3d,
-	0xb3, 0x65, 0x61, 0x24, 0xe3, 0x6d, 0x59, 0x7d, 0x59, 0xc4, 0xdb, 0x12, 0x31, 0xf3, 0xe8, 0x27,
-	0x00, 0x0b, 0x11, 0x53, 0x86, 0x1a, 0xff, 0x0f, 0x18, 0x75, 0x4b, 0xa8, 0x9f, 0x6e, 0x54, 0x2b,
-	0x45, 0x9d, 0xc2, 0xfc, 0xfc, 0xe4, 0xa1, 0xdd, 0x38, 0xb8, 0x95, 0xf3, 0xaf, 0xd6, 0xd7, 0x29,
-	0x91, 0xc4, 0x27, 0x30, 0x17, 0x9e, 0x99, 0xf8, 0x99, 0x58, 0x31, 0xfe, 0xf1, 0x33, 0xb1, 0x6a,
-	0x1c, 0xd5, 0xcc, 0xf7, 0xf7, 0x97, 0x2f, 0x41, 0xcb, 0xba, 0xba, 0x2d, 0x81, 0xeb, 0xdb, 0x12,
-	0xf8, 0xe7, 0xb6, 0x04, 0x2e, 0xee, 0x4a, 0x89, 0xeb, 0xbb, 0x52, 0xe2, 0xcf, 0xbb, 0x52, 0x02,
-	0xaa, 0x26, 0x8d, 0x02, 0xdd, 0x07, 0x47, 0x1f, 0x1b, 0x26, 0x3f, 0xf6, 0xfa, 0xda, 0x80, 0x5a,
-	0xfa, 0x2c, 0xeb, 0x95, 0x49, 0x43, 0x91, 0x7e, 0x16, 0xfa, 0xa7, 0x9c, 0xfc, 0x16, 0xb0, 0x7e,
-	0xd6, 0xff, 0x0e, 0xbe, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4, 0xc7, 0x08, 0x30, 0x1e, 0x0b,
-	0x00, 0x00,
+	// 1050 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xd8, 0x8e, 0x4b, 0x9f, 0x5d, 0x57, 0x1a, 0x92, 0xd8, 0x5e, 0x8a, 0xed, 0x98, 0x52,
+	0xac, 0x4a, 0xf5, 0x36, 0x8e, 0x5a, 0xda, 0x40, 0x0f, 0x89, 0x02, 0x88, 0x83, 0xa5, 0xca, 0x29,
+	0x3f, 0xd4, 0x03, 0xd1, 0xc6, 0x1e, 0x36, 0xab, 0x66, 0x77, 0x9c, 0x9d, 0x59, 0x37, 0xe1, 0x84,
+	0xe0, 0x42, 0x11, 0x87, 0x8a, 0x13, 0x07, 0x24, 0xfe, 0x85, 0x1e, 0xf8, 0x0f, 0xb8, 0xe4, 0x58,
+	0x71, 0x42, 0x1c, 0x0a, 0x4a, 0x0e, 0x39, 0xf3, 0x1f, 0x20, 0xef, 0xcc, 0xae, 0xd7, 0xf6, 0xee,
+	0xc6, 0xeb, 0xde, 0x3c, 0x33, 0xef, 0x7d, 0xdf, 0xb7, 0xdf, 0x7b, 0x7e, 0xb3, 0x0b, 0xb5, 0xbe,
+	0x4d, 0x07, 0xc4, 0xd2, 0xac, 0x2e, 0x51, 0x35, 0xce, 0x6d, 0x63, 0xcf, 0xe1, 0x44, 0x1d, 0xac,
+	0xa9, 0xfc, 0xa8, 0xd9, 0xb7, 0x29, 0xa7, 0xb8, 0x38, 0x8a, 0x68, 0xfa, 0x11, 0xcd, 0xc1, 0x9a,
+	0x52, 0xec, 0x52, 0x66, 0x52, 0xa6, 0x9a, 0x4c, 0x1f, 0x26, 0x98, 0x4c, 0x17, 0x19, 0x4a, 0x59,
+	0x1c, 0xec, 0xba, 0x2b, 0x55, 0x2c, 0xe4, 0xd1, 0x92, 0x4e, 0x75, 0x2a, 0xf6, 0x87, 0xbf, 0xe4,
+	0x6e, 0x55, 0xa7, 0x54, 0x3f, 0x20, 0xaa, 0xbb, 0xda, 0x73, 0xbe, 0x56, 0xb9, 0x61, 0x12, 0xc6,
+	0x35, 0xb3, 0x2f, 0x03, 0xde, 0x8b, 0x52, 0x39, 0x12, 0xe4, 0x06, 0xd6, 0x7f, 0x4d, 0xc1, 0x4a,
+	0x9b, 0xe9, 0x9b, 0xbd, 0xde, 0xa6, 0x77, 0xd2, 0x21, 0x87, 0x0e, 0x61, 0x1c, 0x63, 0xc8, 0x58,
+	0x9a, 0x49, 0x4a, 0xa8, 0x86, 0x1a, 0x97, 0x3b, 0xee, 0x6f, 0xbc, 0x04, 0x8b, 0x03, 0xed, 0xc0,
+	0x21, 0xa5, 0x54, 0x0d, 0x35, 0xf2, 0x1d, 0xb1, 0xc0, 0x6d, 0x28, 0xf8, 0xb8, 0xbb, 0xfc, 0xb8,
+	0x4f, 0x4a, 0xe9, 0x1a, 0x6a, 0x14, 0x5a, 0x37, 0x9a, 0x11, 0x56, 0x34, 0x7d, 0xb2, 0x47, 0xc7,
+	0x7d, 0xd2, 0xb9, 0xa2, 0x05, 0x97, 0xb8, 0x04, 0x97, 0xb4, 0x6e, 0x97, 0x3a, 0x16, 0x2f, 0x65,
+	0x5c, 0x6e, 0x6f, 0x39, 0xa4, 0xa7, 0x4f, 0x2d, 0x62, 0x97, 0x16, 0xdd, 0x7d, 0xb1, 0xc0, 0x6d,
+	0xb8, 0x4a, 0x8e, 0xfa, 0x86, 0xad, 0x71, 0x83, 0x5a, 0xbb, 0x3d, 0x8d, 0x93, 0x52, 0xb6, 0x86,
+	0x1a, 0xb9, 0x96, 0xd2, 0x14, 0x3e, 0x35, 0x3d, 0x9f, 0x9a, 0x8f, 0x3c, 0x9f, 0xb6, 0xde, 0x38,
+	0x79, 0x55, 0x45, 0xcf, 0xff, 0xa9, 0xa2, 0x4e, 0x61, 0x94, 0xbc, 0xad, 0x71, 0xb2, 0x01, 0xdf,
+	0x9d, 0xbf, 0xb8, 0x29, 0xa0, 0xeb, 0x65, 0x28, 0x4e, 0xb9, 0xc3, 0xfa, 0xd4, 0x62, 0xa4, 0xfe,
+	0x5f, 0x0a, 0xca, 0x6d, 0xa6, 0x7f, 0xd6, 0x1f, 0x12, 0xce, 0x64, 0xde, 0xbb, 0x50, 0xa0, 0xb6,
+	0xa1, 0x1b, 0x96, 0x76, 0xb0, 0x1b, 0x74, 0xf1, 0x8a, 0xb7, 0xfb, 0xb9, 0xeb, 0xe6, 0x2a, 0xe4,
+	0x1d, 0x17, 0x54, 0x06, 0xa5, 0xdd, 0xa0, 0x9c, 0xd8, 0x13, 0x21, 0x5f, 0x41, 0xd1, 0x47, 0x9a,
+	0x70, 0x3e, 0x93, 0xc8, 0xf9, 0x65, 0x0f, 0x66, 0x6c, 0x1b, 0x3f, 0x86, 0x65, 0x29, 0x61, 0x02,
+	0x7d, 0x31, 0x11, 0xfa, 0x9b, 0xce, 0xb8, 0x39, 0x93, 0xd5, 0xcd, 0x46, 0x54, 0xf7, 0x52, 0xa0,
+	0xba, 0x63, 0xe5, 0xb8, 0x06, 0x4a, 0x98, 0xe5, 0xb2, 0x22, 0x7f, 0x23, 0x78, 0x67, 0xfa, 0xf8,
+	0x23, 0xbf, 0xba, 0xf3, 0x34, 0xf6, 0x54, 0x67, 0xa5, 0xe7, 0xef, 0xac, 0xa4, 0x8d, 0x3d, 0xf6,
+	0xe8, 0x37, 0xe0, 0x7a, 0xfc, 0xb3, 0x49, 0x13, 0x9e, 0xb8, 0x5d, 0xb9, 0x4d, 0x0e, 0xc8, 0x8c,
+	0x5d, 0x19, 0x10, 0x95, 0x8a, 0x10, 0x95, 0x8e, 0xaf, 0xc7, 0x14, 0x99, 0x94, 0xf2, 0x0c, 0xc1,
+	0xaa, 0x7f, 0xbc, 0x6d, 0x30, 0x6e, 0x58, 0x5d, 0xfe, 0x1a, 0x63, 0x26, 0xa0, 0x34, 0x1d, 0xa1,
+	0x34, 0x13, 0xa5, 0xf4, 0x3a, 0xd4, 0xe3, 0xa4, 0x48, 0xc5, 0x5f, 0x42, 0xa9, 0xcd, 0xf4, 0x1d,
+	0xc2, 0x37, 0x05, 0xf0, 0xb6, 0xc6, 0x35, 0x4f, 0xa7, 0xaf, 0x49, 0x08, 0x9d, 0xd6, 0x34, 0xee,
+	0xde, 0x46, 0x7e, 0xc8, 0xee, 0xad, 0xea, 0x6f, 0x41, 0x39, 0x04, 0x59, 0xd2, 0xfe, 0x86, 0x60,
+	0xc5, 0x2f, 0xee, 0x43, 0xcd, 0xd6, 0x4c, 0xe6, 0xb1, 0xde, 0x85, 0xcb, 0x9a, 0xc3, 0xf7, 0xa9,
+	0x6d, 0xf0, 0x63, 0xc1, 0xbc, 0x55, 0xfa, 0xf3, 0xf7, 0x5b, 0x4b, 0xf2, 0x92, 0xd8, 0xec, 0xf5,
+	0x6c, 0xc2, 0xd8, 0x0e, 0xb7, 0x0d, 0x4b, 0xef, 0x8c, 0x42, 0xf1, 0x03, 0xc8, 0xf6, 0x5d, 0x20,
+	0x57, 0x56, 0xae, 0x55, 0x8d, 0xfc, 0xcb, 0x0a, 0xbe, 0xad, 0xcc, 0xc9, 0xab, 0xea, 0x42, 0x47,
+	0x26, 0x6d, 0x14, 0x86, 0xe2, 0x47, 0x70, 0x72, 0x0e, 0x8e, 0x0b, 0x94, 0xe2, 0x7f, 0x40, 0x70,
+	0x4d, 0x3e, 0x9a, 0x07, 0xbb, 0xd3, 0xdd, 0x27, 0xa6, 0x6f, 0xdc, 0xc7, 0x90, 0x65, 0xee, 0x86,
+	0xab, 0x3f, 0xd7, 0x6a, 0x5c, 0x3c, 0x3d, 0x04, 0x80, 0xa7, 0x49, 0x64, 0x8f, 0x8a, 0x9c, 0x8a,
+	0x2a, 0x72, 0x15, 0xde, 0x8e, 0x50, 0x22, 0xb5, 0x7e, 0x01, 0xd5, 0xe9, 0x7e, 0x1d, 0x57, 0x1b,
+	0xd1, 0x8e, 0x17, 0x30, 0xd7, 0xa1, 0x16, 0x0d, 0x2c, 0xc9, 0x7f, 0x44, 0x50, 0x69, 0x33, 0xfd,
+	0x13, 0x5b, 0xb3, 0x46, 0x02, 0x3f, 0x65, 0xcc, 0x21, 0x76, 0xc0, 0x2a, 0xc3, 0xdd, 0x98, 0xdd,
+	0x2a, 0x01, 0xe0, 0x59, 0x25, 0xb2, 0x67, 0x10, 0xbc, 0x0a, 0xd5, 0x48, 0x2d, 0x52, 0x2f, 0x75,
+	0x43, 0x3a, 0x64, 0x40, 0x9f, 0x90, 0x08, 0xbd, 0x61, 0x66, 0xad, 0xf8, 0xcf, 0x20, 0xc8, 0xa7,
+	0x34, 0xa5, 0xe3, 0x4d, 0x8c, 0x20, 0x14, 0xa2, 0x5a, 0x7f, 0xe4, 0x20, 0xdd, 0x66, 0x3a, 0x3e,
+	0x84, 0x7c, 0xf0, 0x56, 0xc6, 0x6a, 0xa4, 0x53, 0xe1, 0x6f, 0x37, 0xca, 0xed, 0xd9, 0x13, 0x04,
+	0x35, 0xfe, 0x06, 0xae, 0x4e, 0x8c, 0x5f, 0xdc, 0x8a, 0x03, 0x09, 0x7f, 0x33, 0x50, 0xd6, 0x13,
+	0xe5, 0x48, 0xee, 0x5f, 0x10, 0x94, 0x23, 0x67, 0x3f, 0xfe, 0x30, 0x01, 0xe4, 0xd4, 0x75, 0xa8,
+	0x3c, 0x98, 0x33, 0x7b, 0x64, 0xcb, 0x44, 0xdf, 0xc7, 0xdb, 0x12, 0x7e, 0x35, 0x29, 0xeb, 0x89,
+	0x72, 0x24, 0xf7, 0xcf, 0x08, 0x8a, 0x11, 0x33, 0x1d, 0x6f, 0x5c, 0x0c, 0x18, 0x75, 0x27, 0x29,
+	0x1f, 0xcc, 0x95, 0x2b, 0x45, 0x3d, 0x85, 0xc2, 0xf8, 0x9c, 0xc7, 0x6b, 0x71, 0x70, 0xa1, 0xb7,
+	0x8d, 0xd2, 0x4a, 0x92, 0x22, 0x89, 0x0f, 0x21, 0x1f, 0x9c, 0xd0, 0xf1, 0xff, 0x89, 0x90, 0xcb,
+	0x46, 0xb9, 0x3d, 0x7b, 0x82, 0xa4, 0xfc, 0x1e, 0x01, 0x9e, 0x9e, 0xb7, 0xf8, 0xce, 0x45, 0xea,
+	0x43, 0x67, 0xaf, 0x72, 0x37, 0x69, 0x9a, 0x54, 0xf1, 0x13, 0x82, 0xe5, 0xd0, 0xd9, 0x8b, 0xef,
+	0x25, 0xe8, 0xaa, 0x71, 0x2d, 0xf7, 0xe7, 0xc8, 0x94, 0x72, 0x9e, 0x21, 0x58, 0x0a, 0x9b, 0xac,
+	0xf8, 0xfd, 0x38, 0xcc, 0x98, 0x7b, 0x41, 0xb9, 0x97, 0x3c, 0x31, 0x60, 0x4d, 0xe8, 0x44, 0x8d,
+	0xb7, 0x26, 0x6e, 0xea, 0x2b, 0xf7, 0xe7, 0xc8, 0x14, 0x72, 0x94, 0xc5, 0x6f, 0xcf, 0x5f, 0xdc,
+	0x44, 0x5b, 0xe6, 0xc9, 0x69, 0x05, 0xbd, 0x3c, 0xad, 0xa0, 0x7f, 0x4f, 0x2b, 0xe8, 0xf9, 0x59,
+	0x65, 0xe1, 0xe5, 0x59, 0x65, 0xe1, 0xaf, 0xb3, 0xca, 0x02, 0x28, 0x06, 0x8d, 0x42, 0x7f, 0x88,
+	0x1e, 0xdf, 0xd1, 0x0d, 0xbe, 0xef, 0xec, 0x35, 0xbb, 0xd4, 0x54, 0x47, 0x51, 0xb7, 0x0c, 0x1a,
+	0x58, 0xa9, 0x47, 0x81, 0x2f, 0xde, 0xe1, 0x47, 0x0b, 0xdb, 0xcb, 0xba, 0x6f, 0xe9, 0xeb, 0xff,
+	0x0f, 0x00, 0x2e, 0xba, 0x07, 0x1f, 0xbc, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -919,6 +1321,14 @@ type MsgClient interface {
 	SetAccountData(ctx context.Context, in *MsgSetAccountDataRequest, opts ...grpc.CallOption) (*MsgSetAccountDataResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetAttributeSchema defines a method for setting the schema that values of an attribute name must conform to.
+	SetAttributeSchema(ctx context.Context, in *MsgSetAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgSetAttributeSchemaResponse, error)
+	// DeleteAttributeSchema defines a method for removing the schema of an attribute name.
+	DeleteAttributeSchema(ctx context.Context, in *MsgDeleteAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeSchemaResponse, error)
+	// GrantAttributeIssuer defines a method for allowing another account to manage attributes of a name.
+	GrantAttributeIssuer(ctx context.Context, in *MsgGrantAttributeIssuerRequest, opts ...grpc.CallOption) (*MsgGrantAttributeIssuerResponse, error)
+	// RevokeAttributeIssuer defines a method for removing an account's permission to manage attributes of a name.
+	RevokeAttributeIssuer(ctx context.Context, in *MsgRevokeAttributeIssuerRequest, opts ...grpc.CallOption) (*MsgRevokeAttributeIssuerResponse, error)
 }
 
 type msgClient struct {
@@ -992,6 +1402,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) SetAttributeSchema(ctx context.Context, in *MsgSetAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgSetAttributeSchemaResponse, error) {
+	out := new(MsgSetAttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/SetAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteAttributeSchema(ctx context.Context, in *MsgDeleteAttributeSchemaRequest, opts ...grpc.CallOption) (*MsgDeleteAttributeSchemaResponse, error) {
+	out := new(MsgDeleteAttributeSchemaResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/DeleteAttributeSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantAttributeIssuer(ctx context.Context, in *MsgGrantAttributeIssuerRequest, opts ...grpc.CallOption) (*MsgGrantAttributeIssuerResponse, error) {
+	out := new(MsgGrantAttributeIssuerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/GrantAttributeIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeAttributeIssuer(ctx context.Context, in *MsgRevokeAttributeIssuerRequest, opts ...grpc.CallOption) (*MsgRevokeAttributeIssuerResponse, error) {
+	out := new(MsgRevokeAttributeIssuerResponse)
+	err := c.cc.Invoke(ctx, "/provenance.attribute.v1.Msg/RevokeAttributeIssuer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AddAttribute defines a method to verify a particular invariance.
@@ -1008,6 +1454,14 @@ type MsgServer interface {
 	SetAccountData(context.Context, *MsgSetAccountDataRequest) (*MsgSetAccountDataResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the attribute module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// SetAttributeSchema defines a method for setting the schema that values of an attribute name must conform to.
+	SetAttributeSchema(context.Context, *MsgSetAttributeSchemaRequest) (*MsgSetAttributeSchemaResponse, error)
+	// DeleteAttributeSchema defines a method for removing the schema of an attribute name.
+	DeleteAttributeSchema(context.Context, *MsgDeleteAttributeSchemaRequest) (*MsgDeleteAttributeSchemaResponse, error)
+	// GrantAttributeIssuer defines a method for allowing another account to manage attributes of a name.
+	GrantAttributeIssuer(context.Context, *MsgGrantAttributeIssuerRequest) (*MsgGrantAttributeIssuerResponse, error)
+	// RevokeAttributeIssuer defines a method for removing an account's permission to manage attributes of a name.
+	RevokeAttributeIssuer(context.Context, *MsgRevokeAttributeIssuerRequest) (*MsgRevokeAttributeIssuerResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1035,6 +1489,18 @@ func (*UnimplementedMsgServer) SetAccountData(ctx context.Context, req *MsgSetAc
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetAttributeSchema(ctx context.Context, req *MsgSetAttributeSchemaRequest) (*MsgSetAttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAttributeSchema not implemented")
+}
+func (*UnimplementedMsgServer) DeleteAttributeSchema(ctx context.Context, req *MsgDeleteAttributeSchemaRequest) (*MsgDeleteAttributeSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttributeSchema not implemented")
+}
+func (*UnimplementedMsgServer) GrantAttributeIssuer(ctx context.Context, req *MsgGrantAttributeIssuerRequest) (*MsgGrantAttributeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantAttributeIssuer not implemented")
+}
+func (*UnimplementedMsgServer) RevokeAttributeIssuer(ctx context.Context, req *MsgRevokeAttributeIssuerRequest) (*MsgRevokeAttributeIssuerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAttributeIssuer not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1166,8 +1632,80 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.attribute.v1.Msg",
+func _Msg_SetAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/SetAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAttributeSchema(ctx, req.(*MsgSetAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteAttributeSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteAttributeSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteAttributeSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/DeleteAttributeSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteAttributeSchema(ctx, req.(*MsgDeleteAttributeSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantAttributeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantAttributeIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantAttributeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/GrantAttributeIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantAttributeIssuer(ctx, req.(*MsgGrantAttributeIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeAttributeIssuer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeAttributeIssuerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeAttributeIssuer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.attribute.v1.Msg/RevokeAttributeIssuer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeAttributeIssuer(ctx, req.(*MsgRevokeAttributeIssuerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.attribute.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -1198,6 +1736,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetAttributeSchema",
+			Handler:    _Msg_SetAttributeSchema_Handler,
+		},
+		{
+			MethodName: "DeleteAttributeSchema",
+			Handler:    _Msg_DeleteAttributeSchema_Handler,
+		},
+		{
+			MethodName: "GrantAttributeIssuer",
+			Handler:    _Msg_GrantAttributeIssuer_Handler,
+		},
+		{
+			MethodName: "RevokeAttributeIssuer",
+			Handler:    _Msg_RevokeAttributeIssuer_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/attribute/v1/tx.proto",
@@ -1731,145 +2285,398 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetAttributeSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetAttributeSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAttributeSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.AttributeType != 0 {
-		n += 1 + sovTx(uint64(m.AttributeType))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size, err := m.Schema.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAttributeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OriginalValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UpdateValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.OriginalAttributeType != 0 {
-		n += 1 + sovTx(uint64(m.OriginalAttributeType))
-	}
-	if m.UpdateAttributeType != 0 {
-		n += 1 + sovTx(uint64(m.UpdateAttributeType))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSetAttributeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateAttributeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetAttributeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAttributeExpirationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteAttributeSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.ExpirationDate != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAttributeExpirationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDeleteAttributeSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDeleteAttributeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGrantAttributeIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAttributeIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAttributeIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Issuer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantAttributeIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantAttributeIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantAttributeIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeAttributeIssuerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeAttributeIssuerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeAttributeIssuerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AttributeType != 0 {
+		n += 1 + sovTx(uint64(m.AttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OriginalValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.UpdateValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OriginalAttributeType != 0 {
+		n += 1 + sovTx(uint64(m.OriginalAttributeType))
+	}
+	if m.UpdateAttributeType != 0 {
+		n += 1 + sovTx(uint64(m.UpdateAttributeType))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAttributeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAttributeExpirationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationDate != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationDate)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAttributeExpirationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAttributeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Name)
 	if l > 0 {
@@ -1965,27 +2772,718 @@ func (m *MsgUpdateParamsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAttributeSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schema.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAttributeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteAttributeSchemaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteAttributeSchemaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantAttributeIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Issuer.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantAttributeIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeAttributeIssuerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeAttributeIssuerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			}
+			m.AttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalValue == nil {
+				m.OriginalValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
+			if m.UpdateValue == nil {
+				m.UpdateValue = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
+			}
+			m.OriginalAttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
+			}
+			m.UpdateAttributeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2008,10 +3506,10 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2081,10 +3579,10 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttributeType", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
 			}
-			m.AttributeType = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2094,14 +3592,195 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttributeType |= AttributeType(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationDate == nil {
+				m.ExpirationDate = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2129,11 +3808,11 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2161,13 +3840,13 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2177,27 +3856,23 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2220,7 +3895,7 @@ func (m *MsgAddAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2243,10 +3918,10 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2270,7 +3945,7 @@ func (m *MsgAddAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2293,10 +3968,10 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2333,7 +4008,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2360,84 +4035,12 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalValue = append(m.OriginalValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalValue == nil {
-				m.OriginalValue = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateValue", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.UpdateValue = append(m.UpdateValue[:0], dAtA[iNdEx:postIndex]...)
-			if m.UpdateValue == nil {
-				m.UpdateValue = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttributeType", wireType)
-			}
-			m.OriginalAttributeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OriginalAttributeType |= AttributeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAttributeType", wireType)
-			}
-			m.UpdateAttributeType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpdateAttributeType |= AttributeType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
@@ -2469,7 +4072,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2522,7 +4125,7 @@ func (m *MsgUpdateAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2545,10 +4148,10 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2572,7 +4175,7 @@ func (m *MsgUpdateAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2595,15 +4198,15 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2631,13 +4234,13 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2647,65 +4250,127 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationDate == nil {
-				m.ExpirationDate = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationDate, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2733,13 +4398,13 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2749,23 +4414,24 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2788,7 +4454,7 @@ func (m *MsgUpdateAttributeExpirationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2811,10 +4477,10 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttributeExpirationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2838,7 +4504,7 @@ func (m *MsgUpdateAttributeExpirationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2861,17 +4527,17 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2881,57 +4547,26 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Schema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -2984,7 +4619,7 @@ func (m *MsgDeleteAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAttributeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,10 +4642,10 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAttributeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAttributeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3034,7 +4669,7 @@ func (m *MsgDeleteAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeSchemaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,10 +4692,10 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3096,72 +4731,6 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
-			if m.Value == nil {
-				m.Value = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -3214,7 +4783,7 @@ func (m *MsgDeleteDistinctAttributeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteAttributeSchemaResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,10 +4806,10 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDistinctAttributeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteAttributeSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3264,7 +4833,7 @@ func (m *MsgDeleteDistinctAttributeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAttributeIssuerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3287,17 +4856,17 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAttributeIssuerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAttributeIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3307,27 +4876,28 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if err := m.Issuer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3355,7 +4925,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3378,7 +4948,7 @@ func (m *MsgSetAccountDataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantAttributeIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3401,10 +4971,10 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantAttributeIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetAccountDataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantAttributeIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3428,7 +4998,7 @@ func (m *MsgSetAccountDataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAttributeIssuerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3451,15 +5021,15 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAttributeIssuerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAttributeIssuerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3487,13 +5057,13 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3503,24 +5073,55 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3543,7 +5144,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeAttributeIssuerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3566,10 +5167,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeAttributeIssuerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeAttributeIssuerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
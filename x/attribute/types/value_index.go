@@ -0,0 +1,104 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// stringTerminator marks the end of an encoded string value. Zero bytes within the string are escaped as 0x00 0xFF,
+// so the terminator always sorts before any continuation of the string.
+var stringTerminator = []byte{0x00, 0x01}
+
+// IsIndexedAttributeType returns true if attributes of the given type are included in the value index.
+func IsIndexedAttributeType(attrType AttributeType) bool {
+	switch attrType {
+	case AttributeType_Int, AttributeType_Float, AttributeType_String, AttributeType_UUID:
+		return true
+	default:
+		return false
+	}
+}
+
+// EncodeIndexValue converts a value of the given type into bytes that sort in the same order as the values.
+func EncodeIndexValue(attrType AttributeType, value []byte) ([]byte, error) {
+	switch attrType {
+	case AttributeType_Int:
+		return encodeIndexInt(value)
+	case AttributeType_Float:
+		return encodeIndexFloat(value)
+	case AttributeType_String:
+		return append(escapeIndexString(value), stringTerminator...), nil
+	case AttributeType_UUID:
+		return encodeIndexUUID(value)
+	default:
+		return nil, fmt.Errorf("attribute type %s is not indexed", attrType)
+	}
+}
+
+// encodeIndexInt encodes a base-10 integer as [sign][magnitude length][magnitude].
+// Negative numbers have their length and magnitude inverted so that larger magnitudes sort first.
+func encodeIndexInt(value []byte) ([]byte, error) {
+	i, ok := new(big.Int).SetString(strings.TrimSpace(string(value)), 10)
+	if !ok {
+		return nil, fmt.Errorf("invalid int value %q", string(value))
+	}
+	mag := i.Bytes()
+	rv := make([]byte, 5, 5+len(mag))
+	binary.BigEndian.PutUint32(rv[1:], uint32(len(mag)))
+	rv = append(rv, mag...)
+	if i.Sign() >= 0 {
+		rv[0] = 0x01
+		return rv, nil
+	}
+	for j := 1; j < len(rv); j++ {
+		rv[j] = ^rv[j]
+	}
+	return rv, nil
+}
+
+// encodeIndexFloat encodes a decimal value as the sortable bits of its nearest 64-bit float.
+func encodeIndexFloat(value []byte) ([]byte, error) {
+	f, ok := new(big.Float).SetString(strings.TrimSpace(string(value)))
+	if !ok {
+		return nil, fmt.Errorf("invalid float value %q", string(value))
+	}
+	f64, _ := f.Float64()
+	if f64 == 0 {
+		// Treat -0 the same as 0.
+		f64 = 0
+	}
+	bits := math.Float64bits(f64)
+	if f64 < 0 {
+		bits = ^bits
+	} else {
+		bits |= 1 << 63
+	}
+	rv := make([]byte, 8)
+	binary.BigEndian.PutUint64(rv, bits)
+	return rv, nil
+}
+
+// EncodeIndexStringPrefix converts a string into the bytes that begin the encoded form of every string starting with it.
+func EncodeIndexStringPrefix(prefix string) []byte {
+	return escapeIndexString([]byte(prefix))
+}
+
+// escapeIndexString escapes zero bytes in a string value so that a terminator can be appended to it.
+func escapeIndexString(value []byte) []byte {
+	return bytes.ReplaceAll(value, []byte{0x00}, []byte{0x00, 0xFF})
+}
+
+// encodeIndexUUID encodes a UUID value as its 16 bytes.
+func encodeIndexUUID(value []byte) ([]byte, error) {
+	u, err := uuid.Parse(strings.TrimSpace(string(value)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid uuid value %q: %w", string(value), err)
+	}
+	return u[:], nil
+}
@@ -0,0 +1,143 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestEncodeIndexValueOrder(t *testing.T) {
+	tests := []struct {
+		attrType AttributeType
+		values   []string // in ascending order
+	}{
+		{
+			attrType: AttributeType_Int,
+			values: []string{
+				"-100000000000000000000000000000", "-256", "-255", "-2", "-1", "0",
+				"1", "2", "255", "256", "100000000000000000000000000000",
+			},
+		},
+		{
+			attrType: AttributeType_Float,
+			values:   []string{"-Inf", "-1e300", "-2.5", "-0.001", "0", "0.001", "1", "1.5", "2", "1e300", "+Inf"},
+		},
+		{
+			attrType: AttributeType_String,
+			values:   []string{"", "\x00", "\x00\x00", "\x00a", "\x01", "A", "US", "US-", "US-CA", "US-NY", "USA", "a", "ab", "b"},
+		},
+		{
+			attrType: AttributeType_UUID,
+			values: []string{
+				"00000000-0000-0000-0000-000000000000",
+				"0a0b0c0d-0000-0000-0000-000000000000",
+				"91978ba2-5f35-459a-86a7-feca1b0512e0",
+				"ffffffff-ffff-ffff-ffff-ffffffffffff",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.attrType.String(), func(t *testing.T) {
+			encoded := make([][]byte, len(tc.values))
+			for i, value := range tc.values {
+				var err error
+				encoded[i], err = EncodeIndexValue(tc.attrType, []byte(value))
+				require.NoError(t, err, "EncodeIndexValue(%q)", value)
+			}
+			for i := 1; i < len(encoded); i++ {
+				assert.Equal(t, -1, bytes.Compare(encoded[i-1], encoded[i]), "%q should sort before %q", tc.values[i-1], tc.values[i])
+			}
+		})
+	}
+}
+
+func TestEncodeIndexValueEquivalents(t *testing.T) {
+	tests := []struct {
+		attrType AttributeType
+		a, b     string
+	}{
+		{attrType: AttributeType_Int, a: "02", b: "2"},
+		{attrType: AttributeType_Int, a: " 5 ", b: "5"},
+		{attrType: AttributeType_Int, a: "-0", b: "0"},
+		{attrType: AttributeType_Float, a: "1.50", b: "1.5"},
+		{attrType: AttributeType_Float, a: "-0", b: "0"},
+		{attrType: AttributeType_UUID, a: "91978BA2-5F35-459A-86A7-FECA1B0512E0", b: "91978ba2-5f35-459a-86a7-feca1b0512e0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%s %q %q", tc.attrType, tc.a, tc.b), func(t *testing.T) {
+			aBz, err := EncodeIndexValue(tc.attrType, []byte(tc.a))
+			require.NoError(t, err, "EncodeIndexValue(%q)", tc.a)
+			bBz, err := EncodeIndexValue(tc.attrType, []byte(tc.b))
+			require.NoError(t, err, "EncodeIndexValue(%q)", tc.b)
+			assert.Equal(t, aBz, bBz, "encoded values")
+		})
+	}
+}
+
+func TestEncodeIndexValueErrors(t *testing.T) {
+	tests := []struct {
+		attrType AttributeType
+		value    string
+		expErr   string
+	}{
+		{attrType: AttributeType_Int, value: "1.5", expErr: `invalid int value "1.5"`},
+		{attrType: AttributeType_Float, value: "abc", expErr: `invalid float value "abc"`},
+		{attrType: AttributeType_UUID, value: "abc", expErr: `invalid uuid value "abc": invalid UUID length: 3`},
+		{attrType: AttributeType_JSON, value: "{}", expErr: "attribute type ATTRIBUTE_TYPE_JSON is not indexed"},
+		{attrType: AttributeType_Bytes, value: "abc", expErr: "attribute type ATTRIBUTE_TYPE_BYTES is not indexed"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.attrType.String()+" "+tc.value, func(t *testing.T) {
+			_, err := EncodeIndexValue(tc.attrType, []byte(tc.value))
+			assert.EqualError(t, err, tc.expErr, "EncodeIndexValue error")
+		})
+	}
+}
+
+func TestEncodeIndexStringPrefix(t *testing.T) {
+	prefix := EncodeIndexStringPrefix("US-")
+	for _, value := range []string{"US-", "US-CA", "US-\x00"} {
+		encoded, err := EncodeIndexValue(AttributeType_String, []byte(value))
+		require.NoError(t, err, "EncodeIndexValue(%q)", value)
+		assert.True(t, bytes.HasPrefix(encoded, prefix), "%q should start with the prefix", value)
+	}
+	for _, value := range []string{"US", "USA", "us-ca"} {
+		encoded, err := EncodeIndexValue(AttributeType_String, []byte(value))
+		require.NoError(t, err, "EncodeIndexValue(%q)", value)
+		assert.False(t, bytes.HasPrefix(encoded, prefix), "%q should not start with the prefix", value)
+	}
+}
+
+func TestAttributeValueIndexKey(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	attr := NewAttribute("kyc.level", addr.String(), AttributeType_Int, []byte("2"), nil)
+	key := AttributeValueIndexKey(attr)
+	valueBz, err := EncodeIndexValue(AttributeType_Int, []byte("2"))
+	require.NoError(t, err, "EncodeIndexValue")
+
+	typePrefix := AttributeValueIndexTypePrefix("kyc.level", AttributeType_Int)
+	assert.Equal(t, AttributeValueIndexKeyPrefix, typePrefix[0:1], "key prefix")
+	assert.Equal(t, GetNameKeyBytes("kyc.level"), typePrefix[1:33], "name hash")
+	assert.Equal(t, byte(AttributeType_Int), typePrefix[33], "attribute type")
+	require.True(t, bytes.HasPrefix(key, typePrefix), "key should start with the type prefix")
+	rest := key[len(typePrefix):]
+	require.True(t, bytes.HasPrefix(rest, valueBz), "key should have the encoded value after the type prefix")
+	rest = rest[len(valueBz):]
+	assert.Equal(t, append([]byte{byte(len(addr))}, addr...), rest[:len(addr)+1], "address")
+	assert.Equal(t, attr.Hash(), rest[len(addr)+1:], "attribute hash")
+
+	attr.AttributeType = AttributeType_JSON
+	attr.Value = []byte("{}")
+	assert.Nil(t, AttributeValueIndexKey(attr), "key for a json attribute")
+	attr.AttributeType = AttributeType_Int
+	attr.Value = []byte("bad")
+	assert.Nil(t, AttributeValueIndexKey(attr), "key for an invalid int attribute")
+}
@@ -283,6 +283,20 @@ func (s *CmdTestSuite) SetupSuite() {
 		exchangeGen.Payments = append(exchangeGen.Payments, *payment)
 	}
 
+	tradeTime := time.Date(2024, 3, 14, 15, 9, 26, 0, time.UTC)
+	exchangeGen.Trades = append(exchangeGen.Trades,
+		exchange.Trade{
+			MarketId: 421, TradeId: 1, AskOrderIds: []uint64{71}, BidOrderIds: []uint64{72},
+			Assets: sdk.NewInt64Coin("apple", 10), Price: sdk.NewInt64Coin("peach", 50),
+			BlockHeight: 3, BlockTime: tradeTime,
+		},
+		exchange.Trade{
+			MarketId: 421, TradeId: 2, AskOrderIds: []uint64{73}, BidOrderIds: []uint64{74, 75},
+			Assets: sdk.NewInt64Coin("apple", 20), Price: sdk.NewInt64Coin("peach", 120),
+			BlockHeight: 4, BlockTime: tradeTime.Add(5 * time.Second),
+		},
+	)
+
 	toHold := make(map[string]sdk.Coins)
 	for _, order := range exchangeGen.Orders {
 		toHold[order.GetOwner()] = toHold[order.GetOwner()].Add(order.GetHoldAmount()...)
@@ -1111,6 +1125,11 @@ func joinErrs(errs ...string) string {
 	return strings.Join(errs, "\n")
 }
 
+// timePtr returns a pointer to the provided time.
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
+
 // toStringSlice applies the stringer to each value and returns a slice with the results.
 //
 // T is the type of things being converted to strings.
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -36,6 +37,7 @@ const (
 	FlagAsks                 = "asks"
 	FlagAssets               = "assets"
 	FlagAuthority            = "authority"
+	FlagAutoMatch            = "auto-match"
 	FlagBid                  = "bid"
 	FlagBidAdd               = "bid-add"
 	FlagBidRemove            = "bid-remove"
@@ -63,11 +65,16 @@ const (
 	FlagEmptyExternalID      = "empty-external-id"
 	FlagExternalID           = "external-id"
 	FlagExternalIDs          = "external-ids"
+	FlagExpirationHeight     = "expiration-height"
+	FlagExpirationTime       = "expiration-time"
 	FlagFile                 = "file"
 	FlagGrant                = "grant"
 	FlagIcon                 = "icon"
 	FlagInputs               = "inputs"
+	FlagInterval             = "interval"
 	FlagMarket               = "market"
+	FlagMaxCandles           = "max-candles"
+	FlagMaxLevels            = "max-levels"
 	FlagName                 = "name"
 	FlagNavs                 = "navs"
 	FlagNewTarget            = "new-target"
@@ -76,6 +83,7 @@ const (
 	FlagOwner                = "owner"
 	FlagPartial              = "partial"
 	FlagPrice                = "price"
+	FlagPriceDenom           = "price-denom"
 	FlagProposal             = "proposal"
 	FlagRelease              = "release"
 	FlagReleaseAll           = "release-all"
@@ -102,6 +110,8 @@ const (
 	FlagTarget               = "target"
 	FlagTargetAmount         = "target-amount"
 	FlagTo                   = "to"
+	FlagTriggerDirection     = "trigger-direction"
+	FlagTriggerPrice         = "trigger-price"
 	FlagUnsetBips            = "unset-bips"
 	FlagURL                  = "url"
 )
@@ -433,6 +443,44 @@ func ReadReqCoinFlag(flagSet *pflag.FlagSet, name string) (sdk.Coin, error) {
 	return *rv, nil
 }
 
+// ReadFlagTriggerDirection reads a required TriggerDirection flag.
+func ReadFlagTriggerDirection(flagSet *pflag.FlagSet, name string) (exchange.TriggerDirection, error) {
+	value, err := flagSet.GetString(name)
+	if err != nil {
+		return exchange.TriggerDirection_unspecified, err
+	}
+	if len(value) == 0 {
+		return exchange.TriggerDirection_unspecified, fmt.Errorf("missing required --%s flag", name)
+	}
+	return exchange.ParseTriggerDirection(value)
+}
+
+// ReadFlagCandleInterval reads a required CandleInterval flag.
+func ReadFlagCandleInterval(flagSet *pflag.FlagSet, name string) (exchange.CandleInterval, error) {
+	value, err := flagSet.GetString(name)
+	if err != nil {
+		return exchange.CandleInterval_unspecified, err
+	}
+	if len(value) == 0 {
+		return exchange.CandleInterval_unspecified, fmt.Errorf("missing required --%s flag", name)
+	}
+	return exchange.ParseCandleInterval(value)
+}
+
+// ReadTimeFlag reads a string flag and converts it into a *time.Time using the RFC3339 format.
+// If the flag wasn't provided, this returns nil, nil.
+func ReadTimeFlag(flagSet *pflag.FlagSet, name string) (*time.Time, error) {
+	value, err := flagSet.GetString(name)
+	if len(value) == 0 || err != nil {
+		return nil, err
+	}
+	rv, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing --%s as a time (required format is RFC3339 %q): %w", name, time.RFC3339, err)
+	}
+	return &rv, nil
+}
+
 // ReadOrderIDsFlag reads a UintSlice flag and converts it into a []uint64.
 func ReadOrderIDsFlag(flagSet *pflag.FlagSet, name string) ([]uint64, error) {
 	ids, err := flagSet.GetUintSlice(name)
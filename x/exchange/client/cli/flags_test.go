@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -1356,6 +1357,63 @@ func TestReadCoinFlag(t *testing.T) {
 	}
 }
 
+func TestReadTimeFlag(t *testing.T) {
+	tests := []struct {
+		testName string
+		flags    []string
+		name     string
+		expTime  *time.Time
+		expErr   string
+	}{
+		{
+			testName: "unknown flag",
+			name:     "unknown",
+			expErr:   "flag accessed but not defined: unknown",
+		},
+		{
+			testName: "wrong flag type",
+			name:     flagInt,
+			expErr:   "trying to get string value of flag of type int",
+		},
+		{
+			testName: "nothing provided",
+			name:     flagString,
+			expErr:   "",
+		},
+		{
+			testName: "invalid time",
+			flags:    []string{"--" + flagString, "tomorrow"},
+			name:     flagString,
+			expErr: "error parsing --" + flagString + " as a time (required format is RFC3339 \"2006-01-02T15:04:05Z07:00\"): " +
+				"parsing time \"tomorrow\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"tomorrow\" as \"2006\"",
+		},
+		{
+			testName: "utc time",
+			flags:    []string{"--" + flagString, "2024-05-06T07:08:09Z"},
+			name:     flagString,
+			expTime:  timePtr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.testName, func(t *testing.T) {
+			flagSet := pflag.NewFlagSet("", pflag.ContinueOnError)
+			flagSet.String(flagString, "", "A string")
+			flagSet.Int(flagInt, 0, "An int")
+			err := flagSet.Parse(tc.flags)
+			require.NoError(t, err, "flagSet.Parse(%q)", tc.flags)
+
+			var actTime *time.Time
+			testFunc := func() {
+				actTime, err = cli.ReadTimeFlag(flagSet, tc.name)
+			}
+			require.NotPanics(t, testFunc, "ReadTimeFlag(%q)", tc.name)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ReadTimeFlag(%q) error", tc.name)
+			assert.Equal(t, tc.expTime, actTime, "ReadTimeFlag(%q)", tc.name)
+		})
+	}
+}
+
 func TestReadReqCoinFlag(t *testing.T) {
 	tests := []struct {
 		testName string
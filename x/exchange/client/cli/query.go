@@ -27,6 +27,11 @@ func CmdQuery() *cobra.Command {
 		CmdQueryGetOwnerOrders(),
 		CmdQueryGetAssetOrders(),
 		CmdQueryGetAllOrders(),
+		CmdQueryGetOrderBook(),
+		CmdQueryGetConditionalOrder(),
+		CmdQueryGetMarketConditionalOrders(),
+		CmdQueryGetMarketTrades(),
+		CmdQueryGetMarketCandles(),
 		CmdQueryGetCommitment(),
 		CmdQueryGetAccountCommitments(),
 		CmdQueryGetMarketCommitments(),
@@ -146,6 +151,76 @@ func CmdQueryGetAllOrders() *cobra.Command {
 	return cmd
 }
 
+// CmdQueryGetOrderBook creates the order-book sub-command for the exchange query command.
+func CmdQueryGetOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "order-book",
+		Aliases: []string{"get-order-book", "book"},
+		Short:   "Get the price levels and best ask and bid of a market's orders",
+		RunE:    genericQueryRunE(MakeQueryGetOrderBook, exchange.QueryClient.GetOrderBook),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetOrderBook(cmd)
+	return cmd
+}
+
+// CmdQueryGetConditionalOrder creates the conditional-order sub-command for the exchange query command.
+func CmdQueryGetConditionalOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "conditional-order",
+		Aliases: []string{"get-conditional-order"},
+		Short:   "Get a conditional order by id",
+		RunE:    genericQueryRunE(MakeQueryGetConditionalOrder, exchange.QueryClient.GetConditionalOrder),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetConditionalOrder(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketConditionalOrders creates the market-conditional-orders sub-command for the exchange query command.
+func CmdQueryGetMarketConditionalOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-conditional-orders",
+		Aliases: []string{"get-market-conditional-orders"},
+		Short:   "Look up conditional orders for a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketConditionalOrders, exchange.QueryClient.GetMarketConditionalOrders),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketConditionalOrders(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketTrades creates the market-trades sub-command for the exchange query command.
+func CmdQueryGetMarketTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-trades",
+		Aliases: []string{"get-market-trades", "trades"},
+		Short:   "Look up the recent trades in a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketTrades, exchange.QueryClient.GetMarketTrades),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketTrades(cmd)
+	return cmd
+}
+
+// CmdQueryGetMarketCandles creates the market-candles sub-command for the exchange query command.
+func CmdQueryGetMarketCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-candles",
+		Aliases: []string{"get-market-candles", "candles"},
+		Short:   "Get price candles of the recent trades in a market",
+		RunE:    genericQueryRunE(MakeQueryGetMarketCandles, exchange.QueryClient.GetMarketCandles),
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	SetupCmdQueryGetMarketCandles(cmd)
+	return cmd
+}
+
 // CmdQueryGetCommitment creates the commitment sub-command for the exchange query command.
 func CmdQueryGetCommitment() *cobra.Command {
 	cmd := &cobra.Command{
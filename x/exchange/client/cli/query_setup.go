@@ -289,6 +289,175 @@ func MakeQueryGetAllOrders(_ client.Context, flagSet *pflag.FlagSet, _ []string)
 	return req, err
 }
 
+// SetupCmdQueryGetOrderBook adds all the flags needed for MakeQueryGetOrderBook.
+func SetupCmdQueryGetOrderBook(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagDenom, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPriceDenom, "", "The price denom (required)")
+	cmd.Flags().Uint32(FlagMaxLevels, 0, "The maximum number of price levels to get for each side")
+
+	MarkFlagsRequired(cmd, FlagDenom, FlagPriceDenom)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagDenom, "asset denom"),
+		ReqFlagUse(FlagPriceDenom, "price denom"),
+		OptFlagUse(FlagMaxLevels, "count"),
+	)
+	AddUseDetails(cmd,
+		"A <market id> is required as either an arg or flag, but not both.",
+		"If --"+FlagMaxLevels+" is not provided (or is zero), up to 100 price levels are returned. At most 1000 can be requested.",
+	)
+	AddQueryExample(cmd, "3", "--"+FlagDenom, "nhash", "--"+FlagPriceDenom, "nusd")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagDenom, "nhash", "--"+FlagPriceDenom, "nusd", "--"+FlagMaxLevels, "5")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetOrderBook reads all the SetupCmdQueryGetOrderBook flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetOrderBook(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetOrderBookRequest, error) {
+	req := &exchange.QueryGetOrderBookRequest{}
+
+	errs := make([]error, 4)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AssetDenom, errs[1] = flagSet.GetString(FlagDenom)
+	req.PriceDenom, errs[2] = flagSet.GetString(FlagPriceDenom)
+	req.MaxLevels, errs[3] = flagSet.GetUint32(FlagMaxLevels)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetConditionalOrder adds all the flags needed for MakeQueryGetConditionalOrder.
+func SetupCmdQueryGetConditionalOrder(cmd *cobra.Command) {
+	cmd.Flags().Uint64(FlagOrder, 0, "The order id")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<order id>|--%s <order id>}", FlagOrder),
+	)
+	AddUseDetails(cmd, "An <order id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "8")
+	AddQueryExample(cmd, "--"+FlagOrder, "8")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetConditionalOrder reads all the SetupCmdQueryGetConditionalOrder flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetConditionalOrder(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetConditionalOrderRequest, error) {
+	req := &exchange.QueryGetConditionalOrderRequest{}
+
+	var err error
+	req.OrderId, err = ReadFlagOrderOrArg(flagSet, args)
+
+	return req, err
+}
+
+// SetupCmdQueryGetMarketConditionalOrders adds all the flags needed for MakeQueryGetMarketConditionalOrders.
+func SetupCmdQueryGetMarketConditionalOrders(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "conditional orders")
+
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd, "A <market id> is required as either an arg or flag, but not both.")
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+flags.FlagLimit, "10")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketConditionalOrders reads all the SetupCmdQueryGetMarketConditionalOrders flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketConditionalOrders(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketConditionalOrdersRequest, error) {
+	req := &exchange.QueryGetMarketConditionalOrdersRequest{}
+
+	errs := make([]error, 2)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetMarketTrades adds all the flags needed for MakeQueryGetMarketTrades.
+func SetupCmdQueryGetMarketTrades(cmd *cobra.Command) {
+	flags.AddPaginationFlagsToCmd(cmd, "trades")
+
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		PageFlagsUse,
+	)
+	AddUseDetails(cmd,
+		"A <market id> is required as either an arg or flag, but not both.",
+		"Trades are returned oldest first. Use --"+flags.FlagReverse+" to get the most recent trades first.",
+	)
+	AddQueryExample(cmd, "3")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+flags.FlagLimit, "10", "--"+flags.FlagReverse)
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketTrades reads all the SetupCmdQueryGetMarketTrades flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketTrades(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketTradesRequest, error) {
+	req := &exchange.QueryGetMarketTradesRequest{}
+
+	errs := make([]error, 2)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.Pagination, errs[1] = client.ReadPageRequestWithPageKeyDecoded(flagSet)
+
+	return req, errors.Join(errs...)
+}
+
+// SetupCmdQueryGetMarketCandles adds all the flags needed for MakeQueryGetMarketCandles.
+func SetupCmdQueryGetMarketCandles(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagDenom, "", "The asset denom (required)")
+	cmd.Flags().String(FlagPriceDenom, "", "The price denom (required)")
+	cmd.Flags().String(FlagInterval, "", "The candle interval, e.g. 1m, 1h, or 1d (required)")
+	cmd.Flags().Uint32(FlagMaxCandles, 0, "The maximum number of candles to get")
+
+	MarkFlagsRequired(cmd, FlagDenom, FlagPriceDenom, FlagInterval)
+
+	AddUseArgs(cmd,
+		fmt.Sprintf("{<market id>|--%s <market id>}", FlagMarket),
+		ReqFlagUse(FlagDenom, "asset denom"),
+		ReqFlagUse(FlagPriceDenom, "price denom"),
+		ReqFlagUse(FlagInterval, "interval"),
+		OptFlagUse(FlagMaxCandles, "count"),
+	)
+	AddUseDetails(cmd,
+		"A <market id> is required as either an arg or flag, but not both.",
+		"The <interval> can be 1m, 1h, or 1d (or one_minute, one_hour, or one_day).",
+		"If --"+FlagMaxCandles+" is not provided (or is zero), candles for all recorded trades are returned.",
+	)
+	AddQueryExample(cmd, "3", "--"+FlagDenom, "nhash", "--"+FlagPriceDenom, "nusd", "--"+FlagInterval, "1h")
+	AddQueryExample(cmd, "--"+FlagMarket, "1", "--"+FlagDenom, "nhash", "--"+FlagPriceDenom, "nusd",
+		"--"+FlagInterval, "1d", "--"+FlagMaxCandles, "30")
+
+	cmd.Args = cobra.MaximumNArgs(1)
+}
+
+// MakeQueryGetMarketCandles reads all the SetupCmdQueryGetMarketCandles flags and creates the desired request.
+// Satisfies the queryReqMaker type.
+func MakeQueryGetMarketCandles(_ client.Context, flagSet *pflag.FlagSet, args []string) (*exchange.QueryGetMarketCandlesRequest, error) {
+	req := &exchange.QueryGetMarketCandlesRequest{}
+
+	errs := make([]error, 5)
+	req.MarketId, errs[0] = ReadFlagMarketOrArg(flagSet, args)
+	req.AssetDenom, errs[1] = flagSet.GetString(FlagDenom)
+	req.PriceDenom, errs[2] = flagSet.GetString(FlagPriceDenom)
+	req.Interval, errs[3] = ReadFlagCandleInterval(flagSet, FlagInterval)
+	req.MaxCandles, errs[4] = flagSet.GetUint32(FlagMaxCandles)
+
+	return req, errors.Join(errs...)
+}
+
 // SetupCmdQueryGetCommitment adds all the flags needed for MakeQueryGetCommitment.
 func SetupCmdQueryGetCommitment(cmd *cobra.Command) {
 	cmd.Flags().String(FlagAccount, "", "The account's address")
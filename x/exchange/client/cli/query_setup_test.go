@@ -729,6 +729,378 @@ func TestMakeQueryGetAllOrders(t *testing.T) {
 	}
 }
 
+func TestSetupCmdQueryGetOrderBook(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetOrderBook",
+		setup: cli.SetupCmdQueryGetOrderBook,
+		expFlags: []string{
+			cli.FlagMarket, cli.FlagDenom, cli.FlagPriceDenom, cli.FlagMaxLevels,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagDenom:      {required: {"true"}},
+			cli.FlagPriceDenom: {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"--denom <asset denom>", "--price-denom <price denom>",
+			"[--max-levels <count>]",
+			"A <market id> is required as either an arg or flag, but not both.",
+			"If --max-levels is not provided (or is zero), up to 100 price levels are returned. At most 1000 can be requested.",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --denom nhash --price-denom nusd",
+			exampleStart + " --market 1 --denom nhash --price-denom nusd --max-levels 5",
+		},
+	})
+}
+
+func TestMakeQueryGetOrderBook(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetOrderBookRequest]{
+		makerName: "MakeQueryGetOrderBook",
+		maker:     cli.MakeQueryGetOrderBook,
+		setup:     cli.SetupCmdQueryGetOrderBook,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetOrderBookRequest]{
+		{
+			name:   "no market id",
+			flags:  []string{"--denom", "apple", "--price-denom", "plum"},
+			expReq: &exchange.QueryGetOrderBookRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "market id flag",
+			flags:  []string{"--market", "2", "--denom", "apple", "--price-denom", "plum"},
+			expReq: &exchange.QueryGetOrderBookRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum"},
+		},
+		{
+			name:   "market id arg",
+			flags:  []string{"--denom", "apple", "--price-denom", "plum"},
+			args:   []string{"3"},
+			expReq: &exchange.QueryGetOrderBookRequest{MarketId: 3, AssetDenom: "apple", PriceDenom: "plum"},
+		},
+		{
+			name:   "both market id flag and arg",
+			flags:  []string{"--market", "1", "--denom", "apple", "--price-denom", "plum"},
+			args:   []string{"1"},
+			expReq: &exchange.QueryGetOrderBookRequest{AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "cannot provide <market id> as both an arg (\"1\") and flag (--market 1)",
+		},
+		{
+			name:  "all fields",
+			flags: []string{"--price-denom", "plum", "--max-levels", "10", "--denom", "apple"},
+			args:  []string{"5"},
+			expReq: &exchange.QueryGetOrderBookRequest{
+				MarketId:   5,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				MaxLevels:  10,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetConditionalOrder(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:     "SetupCmdQueryGetConditionalOrder",
+		setup:    cli.SetupCmdQueryGetConditionalOrder,
+		expFlags: []string{cli.FlagOrder},
+		expInUse: []string{
+			"{<order id>|--order <order id>}",
+			"An <order id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 8",
+			exampleStart + " --order 8",
+		},
+	})
+}
+
+func TestMakeQueryGetConditionalOrder(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetConditionalOrderRequest]{
+		makerName: "MakeQueryGetConditionalOrder",
+		maker:     cli.MakeQueryGetConditionalOrder,
+		setup:     cli.SetupCmdQueryGetConditionalOrder,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetConditionalOrderRequest]{
+		{
+			name:   "no order id",
+			expReq: &exchange.QueryGetConditionalOrderRequest{},
+			expErr: "no <order id> provided",
+		},
+		{
+			name:   "just order flag",
+			flags:  []string{"--order", "15"},
+			expReq: &exchange.QueryGetConditionalOrderRequest{OrderId: 15},
+		},
+		{
+			name:   "just order id arg",
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetConditionalOrderRequest{OrderId: 83},
+		},
+		{
+			name:   "both order flag and arg",
+			flags:  []string{"--order", "15"},
+			args:   []string{"83"},
+			expReq: &exchange.QueryGetConditionalOrderRequest{},
+			expErr: "cannot provide <order id> as both an arg (\"83\") and flag (--order 15)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketConditionalOrders(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketConditionalOrders",
+		setup: cli.SetupCmdQueryGetMarketConditionalOrders,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}", cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketConditionalOrders(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketConditionalOrdersRequest]{
+		makerName: "MakeQueryGetMarketConditionalOrders",
+		maker:     cli.MakeQueryGetMarketConditionalOrders,
+		setup:     cli.SetupCmdQueryGetMarketConditionalOrders,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketConditionalOrdersRequest]{
+		{
+			name: "no market id",
+			expReq: &exchange.QueryGetMarketConditionalOrdersRequest{
+				Pagination: defaultPageReq,
+			},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketConditionalOrdersRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "market id arg and pagination",
+			args:  []string{"4"},
+			flags: []string{"--limit", "5", "--reverse"},
+			expReq: &exchange.QueryGetMarketConditionalOrdersRequest{
+				MarketId: 4,
+				Pagination: &query.PageRequest{
+					Key:     []byte{},
+					Limit:   5,
+					Reverse: true,
+				},
+			},
+		},
+		{
+			name:  "both market id flag and arg",
+			flags: []string{"--market", "1"},
+			args:  []string{"1"},
+			expReq: &exchange.QueryGetMarketConditionalOrdersRequest{
+				Pagination: defaultPageReq,
+			},
+			expErr: "cannot provide <market id> as both an arg (\"1\") and flag (--market 1)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketTrades(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketTrades",
+		setup: cli.SetupCmdQueryGetMarketTrades,
+		expFlags: []string{
+			flags.FlagPage, flags.FlagPageKey, flags.FlagOffset,
+			flags.FlagLimit, flags.FlagCountTotal, flags.FlagReverse,
+			cli.FlagMarket,
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}", cli.PageFlagsUse,
+			"A <market id> is required as either an arg or flag, but not both.",
+			"Trades are returned oldest first. Use --reverse to get the most recent trades first.",
+		},
+		expExamples: []string{
+			exampleStart + " 3",
+			exampleStart + " --market 1 --limit 10 --reverse",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketTrades(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketTradesRequest]{
+		makerName: "MakeQueryGetMarketTrades",
+		maker:     cli.MakeQueryGetMarketTrades,
+		setup:     cli.SetupCmdQueryGetMarketTrades,
+	}
+
+	defaultPageReq := &query.PageRequest{
+		Key:   []byte{},
+		Limit: 100,
+	}
+	tests := []queryMakerTestCase[exchange.QueryGetMarketTradesRequest]{
+		{
+			name: "no market id",
+			expReq: &exchange.QueryGetMarketTradesRequest{
+				Pagination: defaultPageReq,
+			},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:  "just market id flag",
+			flags: []string{"--market", "1"},
+			expReq: &exchange.QueryGetMarketTradesRequest{
+				MarketId:   1,
+				Pagination: defaultPageReq,
+			},
+		},
+		{
+			name:  "market id arg and pagination",
+			args:  []string{"4"},
+			flags: []string{"--limit", "5", "--reverse"},
+			expReq: &exchange.QueryGetMarketTradesRequest{
+				MarketId: 4,
+				Pagination: &query.PageRequest{
+					Key:     []byte{},
+					Limit:   5,
+					Reverse: true,
+				},
+			},
+		},
+		{
+			name:  "both market id flag and arg",
+			flags: []string{"--market", "1"},
+			args:  []string{"1"},
+			expReq: &exchange.QueryGetMarketTradesRequest{
+				Pagination: defaultPageReq,
+			},
+			expErr: "cannot provide <market id> as both an arg (\"1\") and flag (--market 1)",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
+func TestSetupCmdQueryGetMarketCandles(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdQueryGetMarketCandles",
+		setup: cli.SetupCmdQueryGetMarketCandles,
+		expFlags: []string{
+			cli.FlagMarket, cli.FlagDenom, cli.FlagPriceDenom, cli.FlagInterval, cli.FlagMaxCandles,
+		},
+		expAnnotations: map[string]map[string][]string{
+			cli.FlagDenom:      {required: {"true"}},
+			cli.FlagPriceDenom: {required: {"true"}},
+			cli.FlagInterval:   {required: {"true"}},
+		},
+		expInUse: []string{
+			"{<market id>|--market <market id>}",
+			"--denom <asset denom>", "--price-denom <price denom>", "--interval <interval>",
+			"[--max-candles <count>]",
+			"A <market id> is required as either an arg or flag, but not both.",
+			"The <interval> can be 1m, 1h, or 1d (or one_minute, one_hour, or one_day).",
+			"If --max-candles is not provided (or is zero), candles for all recorded trades are returned.",
+		},
+		expExamples: []string{
+			exampleStart + " 3 --denom nhash --price-denom nusd --interval 1h",
+			exampleStart + " --market 1 --denom nhash --price-denom nusd --interval 1d --max-candles 30",
+		},
+	})
+}
+
+func TestMakeQueryGetMarketCandles(t *testing.T) {
+	td := queryMakerTestDef[exchange.QueryGetMarketCandlesRequest]{
+		makerName: "MakeQueryGetMarketCandles",
+		maker:     cli.MakeQueryGetMarketCandles,
+		setup:     cli.SetupCmdQueryGetMarketCandles,
+	}
+
+	tests := []queryMakerTestCase[exchange.QueryGetMarketCandlesRequest]{
+		{
+			name:  "no market id",
+			flags: []string{"--denom", "apple", "--price-denom", "plum", "--interval", "1m"},
+			expReq: &exchange.QueryGetMarketCandlesRequest{
+				AssetDenom: "apple", PriceDenom: "plum", Interval: exchange.CandleInterval_one_minute,
+			},
+			expErr: "no <market id> provided",
+		},
+		{
+			name:   "no interval",
+			flags:  []string{"--market", "2", "--denom", "apple", "--price-denom", "plum"},
+			expReq: &exchange.QueryGetMarketCandlesRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "missing required --interval flag",
+		},
+		{
+			name:   "invalid interval",
+			flags:  []string{"--market", "2", "--denom", "apple", "--price-denom", "plum", "--interval", "1w"},
+			expReq: &exchange.QueryGetMarketCandlesRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum"},
+			expErr: "invalid candle interval: \"1w\"",
+		},
+		{
+			name:  "market id flag",
+			flags: []string{"--market", "2", "--denom", "apple", "--price-denom", "plum", "--interval", "one_hour"},
+			expReq: &exchange.QueryGetMarketCandlesRequest{
+				MarketId: 2, AssetDenom: "apple", PriceDenom: "plum", Interval: exchange.CandleInterval_one_hour,
+			},
+		},
+		{
+			name:  "all fields",
+			flags: []string{"--price-denom", "plum", "--max-candles", "10", "--interval", "1d", "--denom", "apple"},
+			args:  []string{"5"},
+			expReq: &exchange.QueryGetMarketCandlesRequest{
+				MarketId:   5,
+				AssetDenom: "apple",
+				PriceDenom: "plum",
+				Interval:   exchange.CandleInterval_one_day,
+				MaxCandles: 10,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runQueryMakerTest(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdQueryGetCommitment(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdQueryGetCommitment",
@@ -1323,7 +1695,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAutoMatch, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
@@ -1334,7 +1706,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
 			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]", "[--auto-match]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
 			"[--bips <bips>]", "[--denom <denom>]",
@@ -1348,7 +1720,7 @@ func TestSetupCmdQueryValidateCreateMarket(t *testing.T) {
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAutoMatch, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
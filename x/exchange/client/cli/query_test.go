@@ -116,6 +116,8 @@ func (s *CmdTestSuite) TestCmdQueryGetOrder() {
     assets:
       amount: "4200"
       denom: acorn
+    expiration_height: "0"
+    expiration_time: null
     external_id: my-id-42
     market_id: 420
     price:
@@ -319,6 +321,149 @@ func (s *CmdTestSuite) TestCmdQueryGetAllOrders() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdQueryGetOrderBook() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "cmd error",
+			args:     []string{"order-book", "--denom", "apple", "--price-denom", "peach"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name: "no orders",
+			args: []string{"order-book", "421", "--denom", "apple", "--price-denom", "peach"},
+			expOut: `asks: []
+best_ask: null
+best_bid: null
+bids: []
+`,
+		},
+		{
+			name: "several orders",
+			args: []string{"book", "--market", "420", "--denom", "apple", "--price-denom", "peach", "--max-levels", "2", "--output", "json"},
+			expInOut: []string{
+				`"asks":[{"unit_price":"`, `"bids":[{"unit_price":"`,
+				`"best_ask":{"unit_price":"`, `"best_bid":{"unit_price":"`,
+				`"total_assets":{"denom":"apple",`, `"total_price":{"denom":"peach",`,
+				`"order_count":1`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetConditionalOrder() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no order id",
+			args:     []string{"conditional-order"},
+			expInErr: []string{"no <order id> provided"},
+		},
+		{
+			name:     "conditional order does not exist",
+			args:     []string{"get-conditional-order", "1234567899"},
+			expInErr: []string{"conditional order 1234567899 not found", "invalid request", "InvalidArgument"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetMarketConditionalOrders() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no market id",
+			args:     []string{"market-conditional-orders"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name:     "no conditional orders",
+			args:     []string{"get-market-conditional-orders", "421", "--output", "json"},
+			expInOut: []string{`"conditional_orders":[]`},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetMarketTrades() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no market id",
+			args:     []string{"market-trades"},
+			expInErr: []string{"no <market id> provided"},
+		},
+		{
+			name:     "no trades",
+			args:     []string{"get-market-trades", "420", "--output", "json"},
+			expInOut: []string{`"trades":[]`},
+		},
+		{
+			name: "market with trades",
+			args: []string{"trades", "--market", "421", "--reverse", "--output", "json"},
+			expInOut: []string{
+				`"trades":[{"market_id":421,"trade_id":"2",`,
+				`"bid_order_ids":["74","75"]`,
+				`{"market_id":421,"trade_id":"1",`,
+				`"block_time":"2024-03-14T15:09:26Z"`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
+func (s *CmdTestSuite) TestCmdQueryGetMarketCandles() {
+	tests := []queryCmdTestCase{
+		{
+			name:     "no interval",
+			args:     []string{"market-candles", "421", "--denom", "apple", "--price-denom", "peach"},
+			expInErr: []string{"required flag(s) \"interval\" not set"},
+		},
+		{
+			name:     "no trades",
+			args:     []string{"get-market-candles", "420", "--denom", "apple", "--price-denom", "peach", "--interval", "1h", "--output", "json"},
+			expInOut: []string{`"candles":[]`},
+		},
+		{
+			name: "market with trades",
+			args: []string{"candles", "--market", "421", "--denom", "apple", "--price-denom", "peach", "--interval", "1m", "--output", "json"},
+			expInOut: []string{
+				`"start_time":"2024-03-14T15:09:00Z"`,
+				`"open":"5.000000000000000000"`,
+				`"high":"6.000000000000000000"`,
+				`"low":"5.000000000000000000"`,
+				`"close":"6.000000000000000000"`,
+				`"volume":{"denom":"apple","amount":"30"}`,
+				`"value":{"denom":"peach","amount":"170"}`,
+				`"trade_count":2`,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runQueryCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdQueryGetCommitment() {
 	tests := []queryCmdTestCase{
 		{
@@ -575,6 +720,7 @@ market:
     - PERMISSION_PERMISSIONS
     - PERMISSION_ATTRIBUTES
   allow_user_settlement: true
+  auto_match: false
   commitment_settlement_bips: 50
   fee_buyer_settlement_flat:
   - amount: "105"
@@ -28,6 +28,7 @@ func CmdTx() *cobra.Command {
 		CmdTxCreateBid(),
 		CmdTxCommitFunds(),
 		CmdTxCancelOrder(),
+		CmdTxCreateConditionalOrder(),
 		CmdTxFillBids(),
 		CmdTxFillAsks(),
 		CmdTxMarketSettle(),
@@ -39,6 +40,7 @@ func CmdTx() *cobra.Command {
 		CmdTxMarketUpdateAcceptingOrders(),
 		CmdTxMarketUpdateUserSettle(),
 		CmdTxMarketUpdateAcceptingCommitments(),
+		CmdTxMarketUpdateAutoMatch(),
 		CmdTxMarketUpdateIntermediaryDenom(),
 		CmdTxMarketManagePermissions(),
 		CmdTxMarketManageReqAttrs(),
@@ -113,6 +115,20 @@ func CmdTxCancelOrder() *cobra.Command {
 	return cmd
 }
 
+// CmdTxCreateConditionalOrder creates the create-conditional-order sub-command for the exchange tx command.
+func CmdTxCreateConditionalOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-conditional-order",
+		Aliases: []string{"conditional-order", "create-stop-order", "stop-order"},
+		Short:   "Create an order that is placed once a trade price condition is met",
+		RunE:    genericTxRunE(MakeMsgCreateConditionalOrder),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxCreateConditionalOrder(cmd)
+	return cmd
+}
+
 // CmdTxFillBids creates the fill-bids sub-command for the exchange tx command.
 func CmdTxFillBids() *cobra.Command {
 	cmd := &cobra.Command{
@@ -265,6 +281,20 @@ func CmdTxMarketUpdateAcceptingCommitments() *cobra.Command {
 	return cmd
 }
 
+// CmdTxMarketUpdateAutoMatch creates the market-auto-match sub-command for the exchange tx command.
+func CmdTxMarketUpdateAutoMatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "market-auto-match",
+		Aliases: []string{"market-update-auto-match", "update-market-auto-match", "update-auto-match"},
+		Short:   "Change whether a market automatically matches and settles its orders",
+		RunE:    genericTxRunE(MakeMsgMarketUpdateAutoMatch),
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	SetupCmdTxMarketUpdateAutoMatch(cmd)
+	return cmd
+}
+
 // CmdTxMarketUpdateIntermediaryDenom creates the market-intermediary-denom sub-command for the exchange tx command.
 func CmdTxMarketUpdateIntermediaryDenom() *cobra.Command {
 	cmd := &cobra.Command{
@@ -22,6 +22,8 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().Uint64(FlagExpirationHeight, 0, "The block height at which this order expires")
+	cmd.Flags().String(FlagExpirationTime, "", "The block time at which this order expires (RFC3339 format)")
 	cmd.Flags().String(FlagCreationFee, "", "The ask order creation fee, e.g. 10nhash")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagSeller)
@@ -36,6 +38,8 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpirationHeight, "height"),
+		OptFlagUse(FlagExpirationTime, "time"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagSeller))
@@ -48,7 +52,7 @@ func SetupCmdTxCreateAsk(cmd *cobra.Command) {
 func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateAskRequest, error) {
 	msg := &exchange.MsgCreateAskRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 10)
 	msg.AskOrder.Seller, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagSeller)
 	msg.AskOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.AskOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -56,7 +60,9 @@ func MakeMsgCreateAsk(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.AskOrder.SellerSettlementFlatFee, errs[4] = ReadCoinFlag(flagSet, FlagSettlementFee)
 	msg.AskOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.AskOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
-	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.AskOrder.ExpirationHeight, errs[7] = flagSet.GetUint64(FlagExpirationHeight)
+	msg.AskOrder.ExpirationTime, errs[8] = ReadTimeFlag(flagSet, FlagExpirationTime)
+	msg.OrderCreationFee, errs[9] = ReadCoinFlag(flagSet, FlagCreationFee)
 
 	return msg, errors.Join(errs...)
 }
@@ -70,6 +76,8 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
 	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
 	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().Uint64(FlagExpirationHeight, 0, "The block height at which this order expires")
+	cmd.Flags().String(FlagExpirationTime, "", "The block time at which this order expires (RFC3339 format)")
 	cmd.Flags().String(FlagCreationFee, "", "The bid order creation fee, e.g. 10nhash")
 
 	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagBuyer)
@@ -84,6 +92,8 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 		OptFlagUse(FlagSettlementFee, "seller settlement flat fee"),
 		OptFlagUse(FlagPartial, ""),
 		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpirationHeight, "height"),
+		OptFlagUse(FlagExpirationTime, "time"),
 		OptFlagUse(FlagCreationFee, "creation fee"),
 	)
 	AddUseDetails(cmd, ReqSignerDesc(FlagBuyer))
@@ -96,7 +106,7 @@ func SetupCmdTxCreateBid(cmd *cobra.Command) {
 func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateBidRequest, error) {
 	msg := &exchange.MsgCreateBidRequest{}
 
-	errs := make([]error, 8)
+	errs := make([]error, 10)
 	msg.BidOrder.Buyer, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagBuyer)
 	msg.BidOrder.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
 	msg.BidOrder.Assets, errs[2] = ReadReqCoinFlag(flagSet, FlagAssets)
@@ -104,7 +114,112 @@ func MakeMsgCreateBid(clientCtx client.Context, flagSet *pflag.FlagSet, _ []stri
 	msg.BidOrder.BuyerSettlementFees, errs[4] = ReadCoinsFlag(flagSet, FlagSettlementFee)
 	msg.BidOrder.AllowPartial, errs[5] = flagSet.GetBool(FlagPartial)
 	msg.BidOrder.ExternalId, errs[6] = flagSet.GetString(FlagExternalID)
-	msg.OrderCreationFee, errs[7] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.BidOrder.ExpirationHeight, errs[7] = flagSet.GetUint64(FlagExpirationHeight)
+	msg.BidOrder.ExpirationTime, errs[8] = ReadTimeFlag(flagSet, FlagExpirationTime)
+	msg.OrderCreationFee, errs[9] = ReadCoinFlag(flagSet, FlagCreationFee)
+
+	return msg, errors.Join(errs...)
+}
+
+// SetupCmdTxCreateConditionalOrder adds all the flags needed for MakeMsgCreateConditionalOrder.
+func SetupCmdTxCreateConditionalOrder(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOwner, "", "The order owner (defaults to --from account)")
+	cmd.Flags().Bool(FlagAsk, false, "Create a conditional ask order")
+	cmd.Flags().Bool(FlagBid, false, "Create a conditional bid order")
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	cmd.Flags().String(FlagAssets, "", "The assets for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagPrice, "", "The price for this order, e.g. 10nhash (required)")
+	cmd.Flags().String(FlagSettlementFee, "", "The settlement fee Coin string for this order, e.g. 10nhash")
+	cmd.Flags().Bool(FlagPartial, false, "Allow this order to be partially filled")
+	cmd.Flags().String(FlagExternalID, "", "The external id for this order")
+	cmd.Flags().Uint64(FlagExpirationHeight, 0, "The block height at which this order expires")
+	cmd.Flags().String(FlagExpirationTime, "", "The block time at which this order expires (RFC3339 format)")
+	cmd.Flags().String(FlagCreationFee, "", "The order creation fee (collected when the order is placed), e.g. 10nhash")
+	cmd.Flags().String(FlagTriggerPrice, "", "The unit price that triggers this order, e.g. 1.5 (required)")
+	cmd.Flags().String(FlagTriggerDirection, "", "When to trigger this order: at_or_below or at_or_above (required)")
+
+	cmd.MarkFlagsOneRequired(flags.FlagFrom, FlagOwner)
+	cmd.MarkFlagsMutuallyExclusive(FlagAsk, FlagBid)
+	cmd.MarkFlagsOneRequired(FlagAsk, FlagBid)
+	MarkFlagsRequired(cmd, FlagMarket, FlagAssets, FlagPrice, FlagTriggerPrice, FlagTriggerDirection)
+
+	AddUseArgs(cmd,
+		ReqSignerUse(FlagOwner),
+		ReqAskBidUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqFlagUse(FlagAssets, "assets"),
+		ReqFlagUse(FlagPrice, "price"),
+		ReqFlagUse(FlagTriggerPrice, "unit price"),
+		ReqFlagUse(FlagTriggerDirection, "direction"),
+		UseFlagsBreak,
+		OptFlagUse(FlagSettlementFee, "settlement fee"),
+		OptFlagUse(FlagPartial, ""),
+		OptFlagUse(FlagExternalID, "external id"),
+		OptFlagUse(FlagExpirationHeight, "height"),
+		OptFlagUse(FlagExpirationTime, "time"),
+		OptFlagUse(FlagCreationFee, "creation fee"),
+	)
+	AddUseDetails(cmd,
+		ReqSignerDesc(FlagOwner),
+		ReqAskBidDesc,
+		fmt.Sprintf(`The <unit price> is the price per one asset, e.g. 1.5.
+The order is placed once a trade in the market has a unit price that meets the --%s.
+The <direction> is either at_or_below or at_or_above.`, FlagTriggerDirection),
+		fmt.Sprintf("A --%s can only have one coin for an ask order.", FlagSettlementFee),
+	)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgCreateConditionalOrder reads all the SetupCmdTxCreateConditionalOrder flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgCreateConditionalOrder(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgCreateConditionalOrderRequest, error) {
+	msg := &exchange.MsgCreateConditionalOrderRequest{}
+	bidOrder := &exchange.BidOrder{}
+
+	errs := make([]error, 14, 15)
+	msg.Owner, errs[0] = ReadAddrFlagOrFrom(clientCtx, flagSet, FlagOwner)
+	var isAsk, isBid bool
+	isAsk, errs[1] = flagSet.GetBool(FlagAsk)
+	isBid, errs[2] = flagSet.GetBool(FlagBid)
+	bidOrder.MarketId, errs[3] = flagSet.GetUint32(FlagMarket)
+	bidOrder.Assets, errs[4] = ReadReqCoinFlag(flagSet, FlagAssets)
+	bidOrder.Price, errs[5] = ReadReqCoinFlag(flagSet, FlagPrice)
+	bidOrder.BuyerSettlementFees, errs[6] = ReadCoinsFlag(flagSet, FlagSettlementFee)
+	bidOrder.AllowPartial, errs[7] = flagSet.GetBool(FlagPartial)
+	bidOrder.ExternalId, errs[8] = flagSet.GetString(FlagExternalID)
+	bidOrder.ExpirationHeight, errs[9] = flagSet.GetUint64(FlagExpirationHeight)
+	bidOrder.ExpirationTime, errs[10] = ReadTimeFlag(flagSet, FlagExpirationTime)
+	msg.OrderCreationFee, errs[11] = ReadCoinFlag(flagSet, FlagCreationFee)
+	msg.TriggerPrice, errs[12] = flagSet.GetString(FlagTriggerPrice)
+	msg.TriggerDirection, errs[13] = ReadFlagTriggerDirection(flagSet, FlagTriggerDirection)
+
+	switch {
+	case isAsk:
+		msg.AskOrder = &exchange.AskOrder{
+			MarketId:         bidOrder.MarketId,
+			Seller:           msg.Owner,
+			Assets:           bidOrder.Assets,
+			Price:            bidOrder.Price,
+			AllowPartial:     bidOrder.AllowPartial,
+			ExternalId:       bidOrder.ExternalId,
+			ExpirationHeight: bidOrder.ExpirationHeight,
+			ExpirationTime:   bidOrder.ExpirationTime,
+		}
+		switch len(bidOrder.BuyerSettlementFees) {
+		case 0:
+		case 1:
+			msg.AskOrder.SellerSettlementFlatFee = &bidOrder.BuyerSettlementFees[0]
+		default:
+			errs = append(errs, fmt.Errorf("only one settlement fee coin is allowed for ask orders, found %q",
+				bidOrder.BuyerSettlementFees))
+		}
+	case isBid:
+		bidOrder.Buyer = msg.Owner
+		msg.BidOrder = bidOrder
+	default:
+		errs = append(errs, fmt.Errorf("one of --%s or --%s must be provided", FlagAsk, FlagBid))
+	}
 
 	return msg, errors.Join(errs...)
 }
@@ -619,6 +734,37 @@ func MakeMsgMarketUpdateAcceptingCommitments(clientCtx client.Context, flagSet *
 	return msg, errors.Join(errs...)
 }
 
+// SetupCmdTxMarketUpdateAutoMatch adds all the flags needed for MakeMsgMarketUpdateAutoMatch.
+func SetupCmdTxMarketUpdateAutoMatch(cmd *cobra.Command) {
+	AddFlagsAdmin(cmd)
+	cmd.Flags().Uint32(FlagMarket, 0, "The market id (required)")
+	AddFlagsEnableDisable(cmd, "auto_match")
+
+	MarkFlagsRequired(cmd, FlagMarket)
+
+	AddUseArgs(cmd,
+		ReqAdminUse,
+		ReqFlagUse(FlagMarket, "market id"),
+		ReqEnableDisableUse,
+	)
+	AddUseDetails(cmd, ReqAdminDesc, ReqEnableDisableDesc)
+
+	cmd.Args = cobra.NoArgs
+}
+
+// MakeMsgMarketUpdateAutoMatch reads all the SetupCmdTxMarketUpdateAutoMatch flags and creates the desired Msg.
+// Satisfies the msgMaker type.
+func MakeMsgMarketUpdateAutoMatch(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgMarketUpdateAutoMatchRequest, error) {
+	msg := &exchange.MsgMarketUpdateAutoMatchRequest{}
+
+	errs := make([]error, 3)
+	msg.Admin, errs[0] = ReadFlagsAdminOrFrom(clientCtx, flagSet)
+	msg.MarketId, errs[1] = flagSet.GetUint32(FlagMarket)
+	msg.AutoMatch, errs[2] = ReadFlagsEnableDisable(flagSet)
+
+	return msg, errors.Join(errs...)
+}
+
 // SetupCmdTxMarketUpdateIntermediaryDenom adds all the flags needed for MakeMsgMarketUpdateIntermediaryDenom.
 func SetupCmdTxMarketUpdateIntermediaryDenom(cmd *cobra.Command) {
 	AddFlagsAdmin(cmd)
@@ -973,12 +1119,13 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 	cmd.Flags().Uint32(FlagBips, 0, "The commitment settlement bips (min=0, max=10,000)")
 	cmd.Flags().String(FlagDenom, "", "The intermediary denom")
 	cmd.Flags().StringSlice(FlagReqAttrCommitment, nil, "Attributes required to create commitments (repeatable)")
+	cmd.Flags().Bool(FlagAutoMatch, false, "The market should automatically match and settle orders")
 
 	cmd.MarkFlagsOneRequired(
 		FlagMarket, FlagName, FlagDescription, FlagURL, FlagIcon,
 		FlagCreateAsk, FlagCreateBid, FlagCreateCommitment,
 		FlagSellerFlat, FlagSellerRatios, FlagBuyerFlat, FlagBuyerRatios,
-		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagAccessGrants,
+		FlagAcceptingOrders, FlagAllowUserSettle, FlagAcceptingCommitments, FlagAutoMatch, FlagAccessGrants,
 		FlagReqAttrAsk, FlagReqAttrBid, FlagReqAttrCommitment,
 		FlagBips, FlagDenom,
 		FlagProposal,
@@ -1006,6 +1153,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 		OptFlagUse(FlagAcceptingOrders, ""),
 		OptFlagUse(FlagAllowUserSettle, ""),
 		OptFlagUse(FlagAcceptingCommitments, ""),
+		OptFlagUse(FlagAutoMatch, ""),
 		UseFlagsBreak,
 		OptFlagUse(FlagAccessGrants, "access grants"),
 		UseFlagsBreak,
@@ -1031,7 +1179,7 @@ func SetupCmdTxGovCreateMarket(cmd *cobra.Command) {
 func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _ []string) (*exchange.MsgGovCreateMarketRequest, error) {
 	var msg *exchange.MsgGovCreateMarketRequest
 
-	errs := make([]error, 20)
+	errs := make([]error, 21)
 	msg, errs[0] = ReadMsgGovCreateMarketRequestFromProposalFlag(clientCtx, flagSet)
 	msg.Authority, errs[1] = ReadFlagAuthorityOrDefault(flagSet, msg.Authority)
 	msg.Market.MarketId, errs[2] = ReadFlagUint32OrDefault(flagSet, FlagMarket, msg.Market.MarketId)
@@ -1052,6 +1200,7 @@ func MakeMsgGovCreateMarket(clientCtx client.Context, flagSet *pflag.FlagSet, _
 	msg.Market.ReqAttrCreateCommitment, errs[17] = ReadFlagStringSliceOrDefault(flagSet, FlagReqAttrCommitment, msg.Market.ReqAttrCreateCommitment)
 	msg.Market.CommitmentSettlementBips, errs[18] = ReadFlagUint32OrDefault(flagSet, FlagBips, msg.Market.CommitmentSettlementBips)
 	msg.Market.IntermediaryDenom, errs[19] = ReadFlagStringOrDefault(flagSet, FlagDenom, msg.Market.IntermediaryDenom)
+	msg.Market.AutoMatch, errs[20] = ReadFlagBoolOrDefault(flagSet, FlagAutoMatch, msg.Market.AutoMatch)
 
 	return msg, errors.Join(errs...)
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -85,7 +86,8 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		setup: cli.SetupCmdTxCreateAsk,
 		expFlags: []string{
 			cli.FlagSeller, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID,
+			cli.FlagExpirationHeight, cli.FlagExpirationTime, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -98,7 +100,8 @@ func TestSetupCmdTxCreateAsk(t *testing.T) {
 		expInUse: []string{
 			"--seller", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
-			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--external-id <external id>]", "[--expiration-height <height>]",
+			"[--expiration-time <time>]", "[--creation-fee <creation fee>]",
 			cli.ReqSignerDesc(cli.FlagSeller),
 		},
 	})
@@ -132,6 +135,7 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
 				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--expiration-height", "1000", "--expiration-time", "2024-05-06T07:08:09Z",
 			},
 			expMsg: &exchange.MsgCreateAskRequest{
 				AskOrder: exchange.AskOrder{
@@ -142,6 +146,8 @@ func TestMakeMsgCreateAsk(t *testing.T) {
 					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
 					AllowPartial:            true,
 					ExternalId:              "uuid",
+					ExpirationHeight:        1000,
+					ExpirationTime:          timePtr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -161,7 +167,8 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		setup: cli.SetupCmdTxCreateBid,
 		expFlags: []string{
 			cli.FlagBuyer, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
-			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID, cli.FlagCreationFee,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID,
+			cli.FlagExpirationHeight, cli.FlagExpirationTime, cli.FlagCreationFee,
 			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
 		},
 		expAnnotations: map[string]map[string][]string{
@@ -174,7 +181,8 @@ func TestSetupCmdTxCreateBid(t *testing.T) {
 		expInUse: []string{
 			"--buyer", "--market <market id>", "--assets <assets>", "--price <price>",
 			"[--settlement-fee <seller settlement flat fee>]", "[--partial]",
-			"[--external-id <external id>]", "[--creation-fee <creation fee>]",
+			"[--external-id <external id>]", "[--expiration-height <height>]",
+			"[--expiration-time <time>]", "[--creation-fee <creation fee>]",
 			cli.ReqSignerDesc(cli.FlagBuyer),
 		},
 	})
@@ -208,6 +216,7 @@ func TestMakeMsgCreateBid(t *testing.T) {
 				"--assets", "10apple", "--price", "55plum",
 				"--settlement-fee", "5fig", "--partial",
 				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--expiration-height", "1000", "--expiration-time", "2024-05-06T07:08:09Z",
 			},
 			expMsg: &exchange.MsgCreateBidRequest{
 				BidOrder: exchange.BidOrder{
@@ -218,6 +227,8 @@ func TestMakeMsgCreateBid(t *testing.T) {
 					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
 					AllowPartial:        true,
 					ExternalId:          "uuid",
+					ExpirationHeight:    1000,
+					ExpirationTime:      timePtr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
 				},
 				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
 			},
@@ -231,6 +242,154 @@ func TestMakeMsgCreateBid(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxCreateConditionalOrder(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxCreateConditionalOrder",
+		setup: cli.SetupCmdTxCreateConditionalOrder,
+		expFlags: []string{
+			cli.FlagOwner, cli.FlagAsk, cli.FlagBid, cli.FlagMarket, cli.FlagAssets, cli.FlagPrice,
+			cli.FlagSettlementFee, cli.FlagPartial, cli.FlagExternalID,
+			cli.FlagExpirationHeight, cli.FlagExpirationTime, cli.FlagCreationFee,
+			cli.FlagTriggerPrice, cli.FlagTriggerDirection,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagOwner:  {oneReq: {flags.FlagFrom + " " + cli.FlagOwner}},
+			cli.FlagAsk: {
+				mutExc: {cli.FlagAsk + " " + cli.FlagBid},
+				oneReq: {cli.FlagAsk + " " + cli.FlagBid},
+			},
+			cli.FlagBid: {
+				mutExc: {cli.FlagAsk + " " + cli.FlagBid},
+				oneReq: {cli.FlagAsk + " " + cli.FlagBid},
+			},
+			cli.FlagMarket:           {required: {"true"}},
+			cli.FlagAssets:           {required: {"true"}},
+			cli.FlagPrice:            {required: {"true"}},
+			cli.FlagTriggerPrice:     {required: {"true"}},
+			cli.FlagTriggerDirection: {required: {"true"}},
+		},
+		expInUse: []string{
+			"--owner", cli.ReqAskBidUse, "--market <market id>", "--assets <assets>", "--price <price>",
+			"--trigger-price <unit price>", "--trigger-direction <direction>",
+			"[--settlement-fee <settlement fee>]", "[--partial]",
+			"[--external-id <external id>]", "[--expiration-height <height>]",
+			"[--expiration-time <time>]", "[--creation-fee <creation fee>]",
+			cli.ReqSignerDesc(cli.FlagOwner), cli.ReqAskBidDesc,
+			"The <direction> is either at_or_below or at_or_above.",
+			"A --settlement-fee can only have one coin for an ask order.",
+		},
+	})
+}
+
+func TestMakeMsgCreateConditionalOrder(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgCreateConditionalOrderRequest]{
+		makerName: "MakeMsgCreateConditionalOrder",
+		maker:     cli.MakeMsgCreateConditionalOrder,
+		setup:     cli.SetupCmdTxCreateConditionalOrder,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgCreateConditionalOrderRequest]{
+		{
+			name:      "a few errors",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--assets", "nope", "--trigger-direction", "sideways"},
+			expMsg: &exchange.MsgCreateConditionalOrderRequest{
+				Owner: sdk.AccAddress("FromAddress_________").String(),
+			},
+			expErr: joinErrs(
+				"error parsing --assets as a coin: invalid coin expression: \"nope\"",
+				"missing required --price flag",
+				"invalid trigger direction: \"sideways\"",
+				"one of --ask or --bid must be provided",
+			),
+		},
+		{
+			name: "ask with two settlement fee coins",
+			flags: []string{
+				"--owner", "someaddr", "--ask", "--market", "4",
+				"--assets", "10apple", "--price", "55plum", "--settlement-fee", "5fig,6grape",
+				"--trigger-price", "5.5", "--trigger-direction", "at_or_below",
+			},
+			expMsg: &exchange.MsgCreateConditionalOrderRequest{
+				Owner: "someaddr",
+				AskOrder: &exchange.AskOrder{
+					MarketId: 4,
+					Seller:   "someaddr",
+					Assets:   sdk.NewInt64Coin("apple", 10),
+					Price:    sdk.NewInt64Coin("plum", 55),
+				},
+				TriggerPrice:     "5.5",
+				TriggerDirection: exchange.TriggerDirection_at_or_below,
+			},
+			expErr: "only one settlement fee coin is allowed for ask orders, found \"5fig,6grape\"",
+		},
+		{
+			name: "ask: all fields",
+			flags: []string{
+				"--owner", "someaddr", "--ask", "--market", "4",
+				"--assets", "10apple", "--price", "55plum",
+				"--settlement-fee", "5fig", "--partial",
+				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--expiration-height", "1000", "--expiration-time", "2024-05-06T07:08:09Z",
+				"--trigger-price", "5.5", "--trigger-direction", "at_or_below",
+			},
+			expMsg: &exchange.MsgCreateConditionalOrderRequest{
+				Owner: "someaddr",
+				AskOrder: &exchange.AskOrder{
+					MarketId:                4,
+					Seller:                  "someaddr",
+					Assets:                  sdk.NewInt64Coin("apple", 10),
+					Price:                   sdk.NewInt64Coin("plum", 55),
+					SellerSettlementFlatFee: &sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)},
+					AllowPartial:            true,
+					ExternalId:              "uuid",
+					ExpirationHeight:        1000,
+					ExpirationTime:          timePtr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+				},
+				TriggerPrice:     "5.5",
+				TriggerDirection: exchange.TriggerDirection_at_or_below,
+				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+			},
+		},
+		{
+			name: "bid: all fields",
+			flags: []string{
+				"--owner", "someaddr", "--bid", "--market", "4",
+				"--assets", "10apple", "--price", "55plum",
+				"--settlement-fee", "5fig", "--partial",
+				"--external-id", "uuid", "--creation-fee", "6grape",
+				"--expiration-height", "1000", "--expiration-time", "2024-05-06T07:08:09Z",
+				"--trigger-price", "6", "--trigger-direction", "AT_OR_ABOVE",
+			},
+			expMsg: &exchange.MsgCreateConditionalOrderRequest{
+				Owner: "someaddr",
+				BidOrder: &exchange.BidOrder{
+					MarketId:            4,
+					Buyer:               "someaddr",
+					Assets:              sdk.NewInt64Coin("apple", 10),
+					Price:               sdk.NewInt64Coin("plum", 55),
+					BuyerSettlementFees: sdk.Coins{sdk.Coin{Denom: "fig", Amount: sdkmath.NewInt(5)}},
+					AllowPartial:        true,
+					ExternalId:          "uuid",
+					ExpirationHeight:    1000,
+					ExpirationTime:      timePtr(time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)),
+				},
+				TriggerPrice:     "6",
+				TriggerDirection: exchange.TriggerDirection_at_or_above,
+				OrderCreationFee: &sdk.Coin{Denom: "grape", Amount: sdkmath.NewInt(6)},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxCommitFunds(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxCommitFunds",
@@ -1462,6 +1621,88 @@ func TestMakeMsgMarketUpdateAcceptingCommitments(t *testing.T) {
 	}
 }
 
+func TestSetupCmdTxMarketUpdateAutoMatch(t *testing.T) {
+	runSetupTestCase(t, setupTestCase{
+		name:  "SetupCmdTxMarketUpdateAutoMatch",
+		setup: cli.SetupCmdTxMarketUpdateAutoMatch,
+		expFlags: []string{
+			cli.FlagAdmin, cli.FlagAuthority,
+			cli.FlagMarket, cli.FlagEnable, cli.FlagDisable,
+			flags.FlagFrom, // not added by setup, but include so the annotation is checked.
+		},
+		expAnnotations: map[string]map[string][]string{
+			flags.FlagFrom: {oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority}},
+			cli.FlagAdmin: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagAuthority: {
+				mutExc: {cli.FlagAdmin + " " + cli.FlagAuthority},
+				oneReq: {flags.FlagFrom + " " + cli.FlagAdmin + " " + cli.FlagAuthority},
+			},
+			cli.FlagMarket: {required: {"true"}},
+			cli.FlagEnable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+			cli.FlagDisable: {
+				mutExc: {cli.FlagEnable + " " + cli.FlagDisable},
+				oneReq: {cli.FlagEnable + " " + cli.FlagDisable},
+			},
+		},
+		expInUse: []string{
+			cli.ReqAdminUse, "--market <market id>", cli.ReqEnableDisableUse,
+			cli.ReqAdminDesc, cli.ReqEnableDisableDesc,
+		},
+	})
+}
+
+func TestMakeMsgMarketUpdateAutoMatch(t *testing.T) {
+	td := txMakerTestDef[*exchange.MsgMarketUpdateAutoMatchRequest]{
+		makerName: "MakeMsgMarketUpdateAutoMatch",
+		maker:     cli.MakeMsgMarketUpdateAutoMatch,
+		setup:     cli.SetupCmdTxMarketUpdateAutoMatch,
+	}
+
+	tests := []txMakerTestCase[*exchange.MsgMarketUpdateAutoMatchRequest]{
+		{
+			name:   "some errors",
+			flags:  []string{"--market", "56"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{MarketId: 56},
+			expErr: joinErrs(
+				"no <admin> provided",
+				"exactly one of --enable or --disable must be provided",
+			),
+		},
+		{
+			name:      "enable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--enable", "--market", "4"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     sdk.AccAddress("FromAddress_________").String(),
+				MarketId:  4,
+				AutoMatch: true,
+			},
+		},
+		{
+			name:      "disable",
+			clientCtx: client.Context{FromAddress: sdk.AccAddress("FromAddress_________")},
+			flags:     []string{"--admin", "Blake", "--market", "94", "--disable"},
+			expMsg: &exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     "Blake",
+				MarketId:  94,
+				AutoMatch: false,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runTxMakerTestCase(t, td, tc)
+		})
+	}
+}
+
 func TestSetupCmdTxMarketUpdateIntermediaryDenom(t *testing.T) {
 	runSetupTestCase(t, setupTestCase{
 		name:  "SetupCmdTxMarketUpdateIntermediaryDenom",
@@ -2270,7 +2511,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 			cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 			cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+			cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAutoMatch, cli.FlagAccessGrants,
 			cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 			cli.FlagBips, cli.FlagDenom,
 			cli.FlagProposal,
@@ -2281,7 +2522,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 			"[--create-ask <coins>]", "[--create-bid <coins>]", "[--create-commitment <coins>]",
 			"[--seller-flat <coins>]", "[--seller-ratios <fee ratios>]",
 			"[--buyer-flat <coins>]", "[--buyer-ratios <fee ratios>]",
-			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]",
+			"[--accepting-orders]", "[--allow-user-settle]", "[--accepting-commitments]", "[--auto-match]",
 			"[--access-grants <access grants>]",
 			"[--req-attr-ask <attrs>]", "[--req-attr-bid <attrs>]", "[--req-attr-commitment <attrs>]",
 			"[--bips <bips>]", "[--denom <denom>]",
@@ -2295,7 +2536,7 @@ func TestSetupCmdTxGovCreateMarket(t *testing.T) {
 		cli.FlagMarket, cli.FlagName, cli.FlagDescription, cli.FlagURL, cli.FlagIcon,
 		cli.FlagCreateAsk, cli.FlagCreateBid, cli.FlagCreateCommitment,
 		cli.FlagSellerFlat, cli.FlagSellerRatios, cli.FlagBuyerFlat, cli.FlagBuyerRatios,
-		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAccessGrants,
+		cli.FlagAcceptingOrders, cli.FlagAllowUserSettle, cli.FlagAcceptingCommitments, cli.FlagAutoMatch, cli.FlagAccessGrants,
 		cli.FlagReqAttrAsk, cli.FlagReqAttrBid, cli.FlagReqAttrCommitment,
 		cli.FlagBips, cli.FlagDenom,
 		cli.FlagProposal,
@@ -2395,7 +2636,7 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 				"--create-ask", "10fig", "--create-bid", "5grape", "--create-commitment", "7honeydew",
 				"--seller-flat", "12fig", "--seller-ratios", "100prune:1prune",
 				"--buyer-flat", "17fig", "--buyer-ratios", "88plum:3plum",
-				"--accepting-orders", "--allow-user-settle", "--accepting-commitments",
+				"--accepting-orders", "--allow-user-settle", "--accepting-commitments", "--auto-match",
 				"--access-grants", "addr1:settle+cancel", "--access-grants", "addr2:update+permissions",
 				"--req-attr-ask", "seller.kyc", "--req-attr-bid", "buyer.kyc", "--req-attr-commitment", "com.kyc",
 				"--name", "Special market", "--description", "This market is special.",
@@ -2447,6 +2688,8 @@ func TestMakeMsgGovCreateMarket(t *testing.T) {
 					CommitmentSettlementBips: 47,
 					IntermediaryDenom:        "raisin",
 					ReqAttrCreateCommitment:  []string{"com.kyc"},
+
+					AutoMatch: true,
 				},
 			},
 		},
@@ -132,6 +132,43 @@ func (s *CmdTestSuite) TestCmdTxCreateBid() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxCreateConditionalOrder() {
+	tests := []txCmdTestCase{
+		{
+			name: "cmd error",
+			args: []string{"create-conditional-order", "--bid", "--market", "5",
+				"--assets", "10apple", "--price", "20peach",
+				"--trigger-price", "2", "--trigger-direction", "at_or_above",
+			},
+			expInErr: []string{"at least one of the flags in the group [from owner] is required"},
+		},
+		{
+			name: "bad trigger price",
+			args: []string{"stop-order", "--bid", "--market", "5",
+				"--assets", "10apple", "--price", "20peach",
+				"--trigger-price", "-2", "--trigger-direction", "at_or_above",
+				"--from", s.addr2.String(),
+			},
+			expInErr: []string{"invalid trigger price -2.000000000000000000: must be positive"},
+		},
+		{
+			name: "okay",
+			args: []string{"conditional-order", "--ask", "--market", "5",
+				"--assets", "1000apple", "--price", "2000peach",
+				"--trigger-price", "1.5", "--trigger-direction", "at_or_below",
+				"--from", s.addr2.String(),
+			},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxCommitFunds() {
 	tests := []txCmdTestCase{
 		{
@@ -366,6 +403,7 @@ func (s *CmdTestSuite) TestCmdTxFillAsks() {
 			},
 			args: []string{"fill-asks", "--from", s.addr4.String(), "--market", "5",
 				"--price", "2500peach", "--settlement-fee", "75peach", "--creation-fee", "10peach"},
+			gas:          300_000,
 			expectedCode: 0,
 		},
 	}
@@ -446,7 +484,7 @@ func (s *CmdTestSuite) TestCmdTxMarketSettle() {
 				return args, s.assertBalancesFollowup(expBals)
 			},
 			args:         []string{"settle", "--from", s.addr1.String(), "--market", "5"},
-			gas:          300_000,
+			gas:          350_000,
 			expectedCode: 0,
 		},
 	}
@@ -945,6 +983,51 @@ func (s *CmdTestSuite) TestCmdTxMarketUpdateAcceptingCommitments() {
 	}
 }
 
+func (s *CmdTestSuite) TestCmdTxMarketUpdateAutoMatch() {
+	tests := []txCmdTestCase{
+		{
+			name:     "no market",
+			args:     []string{"market-auto-match", "--from", s.addr1.String(), "--enable"},
+			expInErr: []string{"required flag(s) \"market\" not set"},
+		},
+		{
+			name: "market does not exist",
+			args: []string{"market-update-auto-match", "--market", "419",
+				"--from", s.addr4.String(), "--enable"},
+			expInRawLog: []string{"failed to execute message", "invalid request",
+				"account " + s.addr4.String() + " does not have permission to update market 419",
+			},
+			expectedCode: invReqCode,
+		},
+		{
+			name: "enable auto-match",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market421 := s.getMarket("421")
+				market421.AutoMatch = true
+				return nil, s.getMarketFollowup("421", market421)
+			},
+			args:         []string{"update-market-auto-match", "--enable", "--market", "421", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+		{
+			name: "disable auto-match",
+			preRun: func() ([]string, func(*sdk.TxResponse)) {
+				market421 := s.getMarket("421")
+				market421.AutoMatch = false
+				return nil, s.getMarketFollowup("421", market421)
+			},
+			args:         []string{"update-auto-match", "--disable", "--market", "421", "--from", s.addr1.String()},
+			expectedCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.runTxCmdTestCase(tc)
+		})
+	}
+}
+
 func (s *CmdTestSuite) TestCmdTxMarketUpdateIntermediaryDenom() {
 	tests := []txCmdTestCase{
 		{
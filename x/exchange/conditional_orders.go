@@ -0,0 +1,106 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimpleString returns a lower-cased version of the trigger direction without the leading "TRIGGER_DIRECTION_",
+// e.g. "at_or_below".
+func (d TriggerDirection) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(d.String(), "TRIGGER_DIRECTION_"))
+}
+
+// Validate returns an error if this TriggerDirection is unspecified or an unknown value.
+func (d TriggerDirection) Validate() error {
+	if d == TriggerDirection_unspecified {
+		return errors.New("trigger direction is unspecified")
+	}
+	_, exists := TriggerDirection_name[int32(d)]
+	if !exists {
+		return fmt.Errorf("trigger direction %d does not exist", d)
+	}
+	return nil
+}
+
+// ParseTriggerDirection converts the provided string into a TriggerDirection.
+// The "TRIGGER_DIRECTION_" prefix is optional, and the string is case-insensitive.
+func ParseTriggerDirection(direction string) (TriggerDirection, error) {
+	dirUC := strings.ToUpper(strings.TrimSpace(direction))
+	if !strings.HasPrefix(dirUC, "TRIGGER_DIRECTION_") {
+		dirUC = "TRIGGER_DIRECTION_" + dirUC
+	}
+	if val, found := TriggerDirection_value[dirUC]; found && val != int32(TriggerDirection_unspecified) {
+		return TriggerDirection(val), nil
+	}
+	return TriggerDirection_unspecified, fmt.Errorf("invalid trigger direction: %q", direction)
+}
+
+// NewConditionalOrder creates a new ConditionalOrder for the provided order and condition.
+func NewConditionalOrder(order *Order, triggerPrice sdkmath.LegacyDec, direction TriggerDirection, creationFee *sdk.Coin) *ConditionalOrder {
+	return &ConditionalOrder{
+		Order:            *order,
+		TriggerPrice:     triggerPrice,
+		TriggerDirection: direction,
+		OrderCreationFee: creationFee,
+	}
+}
+
+// GetOrderID gets the id reserved for this conditional order.
+func (c ConditionalOrder) GetOrderID() uint64 {
+	return c.Order.OrderId
+}
+
+// GetMarketID gets the id of the market this conditional order is in.
+func (c ConditionalOrder) GetMarketID() uint32 {
+	return c.Order.GetMarketID()
+}
+
+// Validate returns an error if anything in this conditional order is invalid.
+func (c ConditionalOrder) Validate() error {
+	var errs []error
+	if err := c.Order.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ValidateTriggerPrice(c.TriggerPrice); err != nil {
+		errs = append(errs, err)
+	}
+	if err := c.TriggerDirection.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if c.OrderCreationFee != nil {
+		if err := c.OrderCreationFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid order creation fee: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateTriggerPrice returns an error if the provided trigger price is not positive.
+func ValidateTriggerPrice(triggerPrice sdkmath.LegacyDec) error {
+	if triggerPrice.IsNil() || !triggerPrice.IsPositive() {
+		return fmt.Errorf("invalid trigger price %s: must be positive", triggerPrice)
+	}
+	return nil
+}
+
+// IsTriggeredBy checks whether a trade with a unit price in the provided range (inclusive) meets this order's condition.
+// If it does, the trade price that met the condition is returned along with true.
+func (c ConditionalOrder) IsTriggeredBy(lowPrice, highPrice sdkmath.LegacyDec) (sdkmath.LegacyDec, bool) {
+	switch c.TriggerDirection {
+	case TriggerDirection_at_or_below:
+		if lowPrice.LTE(c.TriggerPrice) {
+			return lowPrice, true
+		}
+	case TriggerDirection_at_or_above:
+		if highPrice.GTE(c.TriggerPrice) {
+			return highPrice, true
+		}
+	}
+	return sdkmath.LegacyDec{}, false
+}
@@ -0,0 +1,155 @@
+package exchange
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestTriggerDirection_SimpleString(t *testing.T) {
+	tests := []struct {
+		dir TriggerDirection
+		exp string
+	}{
+		{dir: TriggerDirection_unspecified, exp: "unspecified"},
+		{dir: TriggerDirection_at_or_below, exp: "at_or_below"},
+		{dir: TriggerDirection_at_or_above, exp: "at_or_above"},
+		{dir: 5, exp: "5"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			act := tc.dir.SimpleString()
+			assert.Equal(t, tc.exp, act, "%d.SimpleString()", tc.dir)
+		})
+	}
+}
+
+func TestTriggerDirection_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		dir    TriggerDirection
+		expErr string
+	}{
+		{name: "unspecified", dir: TriggerDirection_unspecified, expErr: "trigger direction is unspecified"},
+		{name: "at_or_below", dir: TriggerDirection_at_or_below},
+		{name: "at_or_above", dir: TriggerDirection_at_or_above},
+		{name: "unknown", dir: 3, expErr: "trigger direction 3 does not exist"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.dir.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "%d.Validate()", tc.dir)
+		})
+	}
+}
+
+func TestParseTriggerDirection(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    TriggerDirection
+		expErr string
+	}{
+		{input: "at_or_below", exp: TriggerDirection_at_or_below},
+		{input: "AT_OR_ABOVE", exp: TriggerDirection_at_or_above},
+		{input: " trigger_direction_at_or_above ", exp: TriggerDirection_at_or_above},
+		{input: "unspecified", expErr: "invalid trigger direction: \"unspecified\""},
+		{input: "", expErr: "invalid trigger direction: \"\""},
+		{input: "below", expErr: "invalid trigger direction: \"below\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			act, err := ParseTriggerDirection(tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseTriggerDirection(%q) error", tc.input)
+			assert.Equal(t, tc.exp, act, "ParseTriggerDirection(%q) result", tc.input)
+		})
+	}
+}
+
+func TestConditionalOrder_Validate(t *testing.T) {
+	order := NewOrder(1).WithAsk(&AskOrder{
+		MarketId: 1,
+		Seller:   sdk.AccAddress("seller______________").String(),
+		Assets:   sdk.NewInt64Coin("apple", 10),
+		Price:    sdk.NewInt64Coin("peach", 5),
+	})
+
+	tests := []struct {
+		name   string
+		order  ConditionalOrder
+		expErr []string
+	}{
+		{
+			name:  "control",
+			order: *NewConditionalOrder(order, sdkmath.LegacyOneDec(), TriggerDirection_at_or_above, nil),
+		},
+		{
+			name:   "order id zero",
+			order:  *NewConditionalOrder(NewOrder(0).WithAsk(order.GetAskOrder()), sdkmath.LegacyOneDec(), TriggerDirection_at_or_above, nil),
+			expErr: []string{"invalid order id: cannot be zero"},
+		},
+		{
+			name:   "nil trigger price",
+			order:  *NewConditionalOrder(order, sdkmath.LegacyDec{}, TriggerDirection_at_or_above, nil),
+			expErr: []string{"invalid trigger price"},
+		},
+		{
+			name:   "negative trigger price and unknown direction",
+			order:  *NewConditionalOrder(order, sdkmath.LegacyNewDec(-1), 4, nil),
+			expErr: []string{"invalid trigger price -1.000000000000000000: must be positive", "trigger direction 4 does not exist"},
+		},
+		{
+			name:   "invalid creation fee",
+			order:  *NewConditionalOrder(order, sdkmath.LegacyOneDec(), TriggerDirection_at_or_below, &sdk.Coin{Denom: "x", Amount: sdkmath.NewInt(1)}),
+			expErr: []string{"invalid order creation fee: invalid denom: x"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.order.Validate()
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate()")
+		})
+	}
+}
+
+func TestConditionalOrder_IsTriggeredBy(t *testing.T) {
+	dec := sdkmath.LegacyMustNewDecFromStr
+
+	tests := []struct {
+		name      string
+		dir       TriggerDirection
+		trigger   string
+		low       string
+		high      string
+		expPrice  string
+		expResult bool
+	}{
+		{name: "below: low under trigger", dir: TriggerDirection_at_or_below, trigger: "5", low: "4.9", high: "6", expPrice: "4.9", expResult: true},
+		{name: "below: low equals trigger", dir: TriggerDirection_at_or_below, trigger: "5", low: "5", high: "5", expPrice: "5", expResult: true},
+		{name: "below: low over trigger", dir: TriggerDirection_at_or_below, trigger: "5", low: "5.1", high: "6"},
+		{name: "above: high over trigger", dir: TriggerDirection_at_or_above, trigger: "5", low: "1", high: "5.1", expPrice: "5.1", expResult: true},
+		{name: "above: high equals trigger", dir: TriggerDirection_at_or_above, trigger: "5", low: "5", high: "5", expPrice: "5", expResult: true},
+		{name: "above: high under trigger", dir: TriggerDirection_at_or_above, trigger: "5", low: "1", high: "4.9"},
+		{name: "unspecified", dir: TriggerDirection_unspecified, trigger: "5", low: "5", high: "5"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			order := ConditionalOrder{TriggerPrice: dec(tc.trigger), TriggerDirection: tc.dir}
+			price, ok := order.IsTriggeredBy(dec(tc.low), dec(tc.high))
+			assert.Equal(t, tc.expResult, ok, "IsTriggeredBy result bool")
+			if tc.expResult {
+				assert.Equal(t, dec(tc.expPrice).String(), price.String(), "IsTriggeredBy trade price")
+			}
+		})
+	}
+}
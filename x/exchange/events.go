@@ -1,6 +1,8 @@
 package exchange
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 )
@@ -23,6 +25,50 @@ func NewEventOrderCancelled(order OrderI, cancelledBy string) *EventOrderCancell
 	}
 }
 
+func NewEventOrderExpired(order OrderI) *EventOrderExpired {
+	return &EventOrderExpired{
+		OrderId:    order.GetOrderID(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+	}
+}
+
+func NewEventOrderExpirationFailed(order OrderI, reason string) *EventOrderExpirationFailed {
+	return &EventOrderExpirationFailed{
+		OrderId:    order.GetOrderID(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+		Reason:     reason,
+	}
+}
+
+func NewEventConditionalOrderCreated(order OrderI) *EventConditionalOrderCreated {
+	return &EventConditionalOrderCreated{
+		OrderId:    order.GetOrderID(),
+		OrderType:  order.GetOrderType(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+	}
+}
+
+func NewEventConditionalOrderTriggered(order OrderI, tradePrice sdkmath.LegacyDec) *EventConditionalOrderTriggered {
+	return &EventConditionalOrderTriggered{
+		OrderId:    order.GetOrderID(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+		TradePrice: tradePrice.String(),
+	}
+}
+
+func NewEventConditionalOrderFailed(order OrderI, reason string) *EventConditionalOrderFailed {
+	return &EventConditionalOrderFailed{
+		OrderId:    order.GetOrderID(),
+		MarketId:   order.GetMarketID(),
+		ExternalId: order.GetExternalID(),
+		Reason:     reason,
+	}
+}
+
 func NewEventOrderFilled(order OrderI) *EventOrderFilled {
 	return &EventOrderFilled{
 		OrderId:    order.GetOrderID(),
@@ -156,6 +202,29 @@ func NewEventMarketCommitmentsDisabled(marketID uint32, updatedBy string) *Event
 	}
 }
 
+// NewEventMarketAutoMatchUpdated returns a new EventMarketAutoMatchEnabled if isEnabled == true,
+// or a new EventMarketAutoMatchDisabled if isEnabled == false.
+func NewEventMarketAutoMatchUpdated(marketID uint32, updatedBy string, isEnabled bool) proto.Message {
+	if isEnabled {
+		return NewEventMarketAutoMatchEnabled(marketID, updatedBy)
+	}
+	return NewEventMarketAutoMatchDisabled(marketID, updatedBy)
+}
+
+func NewEventMarketAutoMatchEnabled(marketID uint32, updatedBy string) *EventMarketAutoMatchEnabled {
+	return &EventMarketAutoMatchEnabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
+func NewEventMarketAutoMatchDisabled(marketID uint32, updatedBy string) *EventMarketAutoMatchDisabled {
+	return &EventMarketAutoMatchDisabled{
+		MarketId:  marketID,
+		UpdatedBy: updatedBy,
+	}
+}
+
 func NewEventMarketIntermediaryDenomUpdated(marketID uint32, updatedBy string) *EventMarketIntermediaryDenomUpdated {
 	return &EventMarketIntermediaryDenomUpdated{
 		MarketId:  marketID,
@@ -169,6 +169,364 @@ func (m *EventOrderCancelled) GetExternalId() string {
 	return ""
 }
 
+// EventOrderExpired is an event emitted when an order is cancelled because it has expired.
+type EventOrderExpired struct {
+	// order_id is the numerical identifier of the order that expired.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventOrderExpired) Reset()         { *m = EventOrderExpired{} }
+func (m *EventOrderExpired) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExpired) ProtoMessage()    {}
+func (*EventOrderExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{2}
+}
+func (m *EventOrderExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExpired.Merge(m, src)
+}
+func (m *EventOrderExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderExpired proto.InternalMessageInfo
+
+func (m *EventOrderExpired) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventOrderExpired) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventOrderExpired) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventOrderExpirationFailed is an event emitted when an order has expired, but its hold cannot be released.
+// The order is left in place and will be tried again in a later block.
+type EventOrderExpirationFailed struct {
+	// order_id is the numerical identifier of the order that expired.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// reason is a description of why the order could not be removed.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventOrderExpirationFailed) Reset()         { *m = EventOrderExpirationFailed{} }
+func (m *EventOrderExpirationFailed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderExpirationFailed) ProtoMessage()    {}
+func (*EventOrderExpirationFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{3}
+}
+func (m *EventOrderExpirationFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderExpirationFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderExpirationFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderExpirationFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderExpirationFailed.Merge(m, src)
+}
+func (m *EventOrderExpirationFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderExpirationFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderExpirationFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderExpirationFailed proto.InternalMessageInfo
+
+func (m *EventOrderExpirationFailed) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventOrderExpirationFailed) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventOrderExpirationFailed) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventOrderExpirationFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventConditionalOrderCreated is an event emitted when a conditional order is created.
+type EventConditionalOrderCreated struct {
+	// order_id is the numerical identifier reserved for the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// order_type is the type of order, e.g. "ask" or "bid".
+	OrderType string `protobuf:"bytes,2,opt,name=order_type,json=orderType,proto3" json:"order_type,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,4,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+}
+
+func (m *EventConditionalOrderCreated) Reset()         { *m = EventConditionalOrderCreated{} }
+func (m *EventConditionalOrderCreated) String() string { return proto.CompactTextString(m) }
+func (*EventConditionalOrderCreated) ProtoMessage()    {}
+func (*EventConditionalOrderCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{4}
+}
+func (m *EventConditionalOrderCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConditionalOrderCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConditionalOrderCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConditionalOrderCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConditionalOrderCreated.Merge(m, src)
+}
+func (m *EventConditionalOrderCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConditionalOrderCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConditionalOrderCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConditionalOrderCreated proto.InternalMessageInfo
+
+func (m *EventConditionalOrderCreated) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderCreated) GetOrderType() string {
+	if m != nil {
+		return m.OrderType
+	}
+	return ""
+}
+
+func (m *EventConditionalOrderCreated) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderCreated) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+// EventConditionalOrderTriggered is an event emitted when a conditional order's condition is met and it is placed.
+type EventConditionalOrderTriggered struct {
+	// order_id is the numerical identifier of the order placed.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// trade_price is the unit price of the trade that met the condition.
+	TradePrice string `protobuf:"bytes,4,opt,name=trade_price,json=tradePrice,proto3" json:"trade_price,omitempty"`
+}
+
+func (m *EventConditionalOrderTriggered) Reset()         { *m = EventConditionalOrderTriggered{} }
+func (m *EventConditionalOrderTriggered) String() string { return proto.CompactTextString(m) }
+func (*EventConditionalOrderTriggered) ProtoMessage()    {}
+func (*EventConditionalOrderTriggered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{5}
+}
+func (m *EventConditionalOrderTriggered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConditionalOrderTriggered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConditionalOrderTriggered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConditionalOrderTriggered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConditionalOrderTriggered.Merge(m, src)
+}
+func (m *EventConditionalOrderTriggered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConditionalOrderTriggered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConditionalOrderTriggered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConditionalOrderTriggered proto.InternalMessageInfo
+
+func (m *EventConditionalOrderTriggered) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderTriggered) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderTriggered) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventConditionalOrderTriggered) GetTradePrice() string {
+	if m != nil {
+		return m.TradePrice
+	}
+	return ""
+}
+
+// EventConditionalOrderFailed is an event emitted when a conditional order's condition is met, but it cannot be placed.
+// The conditional order is deleted.
+type EventConditionalOrderFailed struct {
+	// order_id is the numerical identifier reserved for the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// external_id is the order's external id.
+	ExternalId string `protobuf:"bytes,3,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// reason is a description of why the order could not be placed.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventConditionalOrderFailed) Reset()         { *m = EventConditionalOrderFailed{} }
+func (m *EventConditionalOrderFailed) String() string { return proto.CompactTextString(m) }
+func (*EventConditionalOrderFailed) ProtoMessage()    {}
+func (*EventConditionalOrderFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{6}
+}
+func (m *EventConditionalOrderFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConditionalOrderFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConditionalOrderFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventConditionalOrderFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConditionalOrderFailed.Merge(m, src)
+}
+func (m *EventConditionalOrderFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConditionalOrderFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConditionalOrderFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConditionalOrderFailed proto.InternalMessageInfo
+
+func (m *EventConditionalOrderFailed) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderFailed) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventConditionalOrderFailed) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
+}
+
+func (m *EventConditionalOrderFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // EventOrderFilled is an event emitted when an order has been filled in full.
 // This event is also used for orders that were previously partially filled, but have now been filled in full.
 type EventOrderFilled struct {
@@ -190,7 +548,7 @@ func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
 func (m *EventOrderFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderFilled) ProtoMessage()    {}
 func (*EventOrderFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{2}
+	return fileDescriptor_c1b69385a348cffa, []int{7}
 }
 func (m *EventOrderFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -283,7 +641,7 @@ func (m *EventOrderPartiallyFilled) Reset()         { *m = EventOrderPartiallyFi
 func (m *EventOrderPartiallyFilled) String() string { return proto.CompactTextString(m) }
 func (*EventOrderPartiallyFilled) ProtoMessage()    {}
 func (*EventOrderPartiallyFilled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{3}
+	return fileDescriptor_c1b69385a348cffa, []int{8}
 }
 func (m *EventOrderPartiallyFilled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +726,7 @@ func (m *EventOrderExternalIDUpdated) Reset()         { *m = EventOrderExternalI
 func (m *EventOrderExternalIDUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOrderExternalIDUpdated) ProtoMessage()    {}
 func (*EventOrderExternalIDUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{4}
+	return fileDescriptor_c1b69385a348cffa, []int{9}
 }
 func (m *EventOrderExternalIDUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +792,7 @@ func (m *EventFundsCommitted) Reset()         { *m = EventFundsCommitted{} }
 func (m *EventFundsCommitted) String() string { return proto.CompactTextString(m) }
 func (*EventFundsCommitted) ProtoMessage()    {}
 func (*EventFundsCommitted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{5}
+	return fileDescriptor_c1b69385a348cffa, []int{10}
 }
 func (m *EventFundsCommitted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -507,7 +865,7 @@ func (m *EventCommitmentReleased) Reset()         { *m = EventCommitmentReleased
 func (m *EventCommitmentReleased) String() string { return proto.CompactTextString(m) }
 func (*EventCommitmentReleased) ProtoMessage()    {}
 func (*EventCommitmentReleased) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{6}
+	return fileDescriptor_c1b69385a348cffa, []int{11}
 }
 func (m *EventCommitmentReleased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +938,7 @@ func (m *EventMarketWithdraw) Reset()         { *m = EventMarketWithdraw{} }
 func (m *EventMarketWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventMarketWithdraw) ProtoMessage()    {}
 func (*EventMarketWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{7}
+	return fileDescriptor_c1b69385a348cffa, []int{12}
 }
 func (m *EventMarketWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +1007,7 @@ func (m *EventMarketDetailsUpdated) Reset()         { *m = EventMarketDetailsUpd
 func (m *EventMarketDetailsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketDetailsUpdated) ProtoMessage()    {}
 func (*EventMarketDetailsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{8}
+	return fileDescriptor_c1b69385a348cffa, []int{13}
 }
 func (m *EventMarketDetailsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -707,7 +1065,7 @@ func (m *EventMarketEnabled) Reset()         { *m = EventMarketEnabled{} }
 func (m *EventMarketEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketEnabled) ProtoMessage()    {}
 func (*EventMarketEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{9}
+	return fileDescriptor_c1b69385a348cffa, []int{14}
 }
 func (m *EventMarketEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -765,7 +1123,7 @@ func (m *EventMarketDisabled) Reset()         { *m = EventMarketDisabled{} }
 func (m *EventMarketDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketDisabled) ProtoMessage()    {}
 func (*EventMarketDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{10}
+	return fileDescriptor_c1b69385a348cffa, []int{15}
 }
 func (m *EventMarketDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +1178,7 @@ func (m *EventMarketOrdersEnabled) Reset()         { *m = EventMarketOrdersEnabl
 func (m *EventMarketOrdersEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketOrdersEnabled) ProtoMessage()    {}
 func (*EventMarketOrdersEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{11}
+	return fileDescriptor_c1b69385a348cffa, []int{16}
 }
 func (m *EventMarketOrdersEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1233,7 @@ func (m *EventMarketOrdersDisabled) Reset()         { *m = EventMarketOrdersDisa
 func (m *EventMarketOrdersDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketOrdersDisabled) ProtoMessage()    {}
 func (*EventMarketOrdersDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{12}
+	return fileDescriptor_c1b69385a348cffa, []int{17}
 }
 func (m *EventMarketOrdersDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -930,7 +1288,7 @@ func (m *EventMarketUserSettleEnabled) Reset()         { *m = EventMarketUserSet
 func (m *EventMarketUserSettleEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketUserSettleEnabled) ProtoMessage()    {}
 func (*EventMarketUserSettleEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{13}
+	return fileDescriptor_c1b69385a348cffa, []int{18}
 }
 func (m *EventMarketUserSettleEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -985,7 +1343,7 @@ func (m *EventMarketUserSettleDisabled) Reset()         { *m = EventMarketUserSe
 func (m *EventMarketUserSettleDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketUserSettleDisabled) ProtoMessage()    {}
 func (*EventMarketUserSettleDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{14}
+	return fileDescriptor_c1b69385a348cffa, []int{19}
 }
 func (m *EventMarketUserSettleDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1040,7 +1398,7 @@ func (m *EventMarketCommitmentsEnabled) Reset()         { *m = EventMarketCommit
 func (m *EventMarketCommitmentsEnabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCommitmentsEnabled) ProtoMessage()    {}
 func (*EventMarketCommitmentsEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{15}
+	return fileDescriptor_c1b69385a348cffa, []int{20}
 }
 func (m *EventMarketCommitmentsEnabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1453,7 @@ func (m *EventMarketCommitmentsDisabled) Reset()         { *m = EventMarketCommi
 func (m *EventMarketCommitmentsDisabled) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCommitmentsDisabled) ProtoMessage()    {}
 func (*EventMarketCommitmentsDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{16}
+	return fileDescriptor_c1b69385a348cffa, []int{21}
 }
 func (m *EventMarketCommitmentsDisabled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1138,6 +1496,116 @@ func (m *EventMarketCommitmentsDisabled) GetUpdatedBy() string {
 	return ""
 }
 
+// EventMarketAutoMatchEnabled is an event emitted when a market's auto_match option is enabled.
+type EventMarketAutoMatchEnabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the auto_match option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *EventMarketAutoMatchEnabled) Reset()         { *m = EventMarketAutoMatchEnabled{} }
+func (m *EventMarketAutoMatchEnabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAutoMatchEnabled) ProtoMessage()    {}
+func (*EventMarketAutoMatchEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{22}
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAutoMatchEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAutoMatchEnabled.Merge(m, src)
+}
+func (m *EventMarketAutoMatchEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAutoMatchEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAutoMatchEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketAutoMatchEnabled proto.InternalMessageInfo
+
+func (m *EventMarketAutoMatchEnabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventMarketAutoMatchEnabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+// EventMarketAutoMatchDisabled is an event emitted when a market's auto_match option is disabled.
+type EventMarketAutoMatchDisabled struct {
+	// market_id is the numerical identifier of the market.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// updated_by is the account that updated the auto_match option.
+	UpdatedBy string `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+}
+
+func (m *EventMarketAutoMatchDisabled) Reset()         { *m = EventMarketAutoMatchDisabled{} }
+func (m *EventMarketAutoMatchDisabled) String() string { return proto.CompactTextString(m) }
+func (*EventMarketAutoMatchDisabled) ProtoMessage()    {}
+func (*EventMarketAutoMatchDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1b69385a348cffa, []int{23}
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMarketAutoMatchDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMarketAutoMatchDisabled.Merge(m, src)
+}
+func (m *EventMarketAutoMatchDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMarketAutoMatchDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMarketAutoMatchDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMarketAutoMatchDisabled proto.InternalMessageInfo
+
+func (m *EventMarketAutoMatchDisabled) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *EventMarketAutoMatchDisabled) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
 // EventMarketIntermediaryDenomUpdated is an event emitted when a market updates its
 // commitment_settlement_intermediary_denom field.
 type EventMarketIntermediaryDenomUpdated struct {
@@ -1151,7 +1619,7 @@ func (m *EventMarketIntermediaryDenomUpdated) Reset()         { *m = EventMarket
 func (m *EventMarketIntermediaryDenomUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketIntermediaryDenomUpdated) ProtoMessage()    {}
 func (*EventMarketIntermediaryDenomUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{17}
+	return fileDescriptor_c1b69385a348cffa, []int{24}
 }
 func (m *EventMarketIntermediaryDenomUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1674,7 @@ func (m *EventMarketPermissionsUpdated) Reset()         { *m = EventMarketPermis
 func (m *EventMarketPermissionsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketPermissionsUpdated) ProtoMessage()    {}
 func (*EventMarketPermissionsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{18}
+	return fileDescriptor_c1b69385a348cffa, []int{25}
 }
 func (m *EventMarketPermissionsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,7 +1729,7 @@ func (m *EventMarketReqAttrUpdated) Reset()         { *m = EventMarketReqAttrUpd
 func (m *EventMarketReqAttrUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketReqAttrUpdated) ProtoMessage()    {}
 func (*EventMarketReqAttrUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{19}
+	return fileDescriptor_c1b69385a348cffa, []int{26}
 }
 func (m *EventMarketReqAttrUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1314,7 +1782,7 @@ func (m *EventMarketCreated) Reset()         { *m = EventMarketCreated{} }
 func (m *EventMarketCreated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketCreated) ProtoMessage()    {}
 func (*EventMarketCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{20}
+	return fileDescriptor_c1b69385a348cffa, []int{27}
 }
 func (m *EventMarketCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1828,7 @@ func (m *EventMarketFeesUpdated) Reset()         { *m = EventMarketFeesUpdated{}
 func (m *EventMarketFeesUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventMarketFeesUpdated) ProtoMessage()    {}
 func (*EventMarketFeesUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{21}
+	return fileDescriptor_c1b69385a348cffa, []int{28}
 }
 func (m *EventMarketFeesUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1404,7 +1872,7 @@ func (m *EventParamsUpdated) Reset()         { *m = EventParamsUpdated{} }
 func (m *EventParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventParamsUpdated) ProtoMessage()    {}
 func (*EventParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{22}
+	return fileDescriptor_c1b69385a348cffa, []int{29}
 }
 func (m *EventParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1919,7 @@ func (m *EventPaymentCreated) Reset()         { *m = EventPaymentCreated{} }
 func (m *EventPaymentCreated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCreated) ProtoMessage()    {}
 func (*EventPaymentCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{23}
+	return fileDescriptor_c1b69385a348cffa, []int{30}
 }
 func (m *EventPaymentCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +2003,7 @@ func (m *EventPaymentUpdated) Reset()         { *m = EventPaymentUpdated{} }
 func (m *EventPaymentUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentUpdated) ProtoMessage()    {}
 func (*EventPaymentUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{24}
+	return fileDescriptor_c1b69385a348cffa, []int{31}
 }
 func (m *EventPaymentUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1624,7 +2092,7 @@ func (m *EventPaymentAccepted) Reset()         { *m = EventPaymentAccepted{} }
 func (m *EventPaymentAccepted) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentAccepted) ProtoMessage()    {}
 func (*EventPaymentAccepted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{25}
+	return fileDescriptor_c1b69385a348cffa, []int{32}
 }
 func (m *EventPaymentAccepted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1702,7 +2170,7 @@ func (m *EventPaymentRejected) Reset()         { *m = EventPaymentRejected{} }
 func (m *EventPaymentRejected) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentRejected) ProtoMessage()    {}
 func (*EventPaymentRejected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{26}
+	return fileDescriptor_c1b69385a348cffa, []int{33}
 }
 func (m *EventPaymentRejected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1766,7 +2234,7 @@ func (m *EventPaymentCancelled) Reset()         { *m = EventPaymentCancelled{} }
 func (m *EventPaymentCancelled) String() string { return proto.CompactTextString(m) }
 func (*EventPaymentCancelled) ProtoMessage()    {}
 func (*EventPaymentCancelled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c1b69385a348cffa, []int{27}
+	return fileDescriptor_c1b69385a348cffa, []int{34}
 }
 func (m *EventPaymentCancelled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,6 +2287,11 @@ func (m *EventPaymentCancelled) GetExternalId() string {
 func init() {
 	proto.RegisterType((*EventOrderCreated)(nil), "provenance.exchange.v1.EventOrderCreated")
 	proto.RegisterType((*EventOrderCancelled)(nil), "provenance.exchange.v1.EventOrderCancelled")
+	proto.RegisterType((*EventOrderExpired)(nil), "provenance.exchange.v1.EventOrderExpired")
+	proto.RegisterType((*EventOrderExpirationFailed)(nil), "provenance.exchange.v1.EventOrderExpirationFailed")
+	proto.RegisterType((*EventConditionalOrderCreated)(nil), "provenance.exchange.v1.EventConditionalOrderCreated")
+	proto.RegisterType((*EventConditionalOrderTriggered)(nil), "provenance.exchange.v1.EventConditionalOrderTriggered")
+	proto.RegisterType((*EventConditionalOrderFailed)(nil), "provenance.exchange.v1.EventConditionalOrderFailed")
 	proto.RegisterType((*EventOrderFilled)(nil), "provenance.exchange.v1.EventOrderFilled")
 	proto.RegisterType((*EventOrderPartiallyFilled)(nil), "provenance.exchange.v1.EventOrderPartiallyFilled")
 	proto.RegisterType((*EventOrderExternalIDUpdated)(nil), "provenance.exchange.v1.EventOrderExternalIDUpdated")
@@ -1834,6 +2307,8 @@ func init() {
 	proto.RegisterType((*EventMarketUserSettleDisabled)(nil), "provenance.exchange.v1.EventMarketUserSettleDisabled")
 	proto.RegisterType((*EventMarketCommitmentsEnabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsEnabled")
 	proto.RegisterType((*EventMarketCommitmentsDisabled)(nil), "provenance.exchange.v1.EventMarketCommitmentsDisabled")
+	proto.RegisterType((*EventMarketAutoMatchEnabled)(nil), "provenance.exchange.v1.EventMarketAutoMatchEnabled")
+	proto.RegisterType((*EventMarketAutoMatchDisabled)(nil), "provenance.exchange.v1.EventMarketAutoMatchDisabled")
 	proto.RegisterType((*EventMarketIntermediaryDenomUpdated)(nil), "provenance.exchange.v1.EventMarketIntermediaryDenomUpdated")
 	proto.RegisterType((*EventMarketPermissionsUpdated)(nil), "provenance.exchange.v1.EventMarketPermissionsUpdated")
 	proto.RegisterType((*EventMarketReqAttrUpdated)(nil), "provenance.exchange.v1.EventMarketReqAttrUpdated")
@@ -1852,63 +2327,69 @@ func init() {
 }
 
 var fileDescriptor_c1b69385a348cffa = []byte{
-	// 884 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x31, 0x6f, 0xe4, 0x44,
-	0x14, 0xce, 0x6c, 0x92, 0xbd, 0xdb, 0x97, 0x9c, 0x74, 0x98, 0x10, 0x36, 0x1c, 0xb7, 0x44, 0x4e,
-	0x93, 0xe6, 0x76, 0x2f, 0x20, 0x14, 0xe9, 0xa8, 0xb2, 0x97, 0x44, 0x4a, 0x81, 0x58, 0xf9, 0x72,
-	0x42, 0xa2, 0x59, 0x4d, 0xec, 0xc7, 0x66, 0xc0, 0x9e, 0xf1, 0xcd, 0xcc, 0x6e, 0x62, 0xf1, 0x13,
-	0x68, 0xae, 0xa0, 0x83, 0x92, 0x0e, 0xd1, 0x21, 0xfe, 0x00, 0x0d, 0xe5, 0x89, 0x8a, 0x12, 0x25,
-	0xf0, 0x3f, 0x90, 0x3d, 0x76, 0xd6, 0xce, 0xe6, 0xec, 0x15, 0xc8, 0x22, 0xa2, 0x9b, 0x19, 0xbf,
-	0xf7, 0xbe, 0xef, 0x7b, 0x9e, 0x79, 0x6f, 0x06, 0xb6, 0x42, 0x29, 0x26, 0xc8, 0x29, 0x77, 0xb1,
-	0x87, 0xe7, 0xee, 0x29, 0xe5, 0x23, 0xec, 0x4d, 0x76, 0x7a, 0x38, 0x41, 0xae, 0x55, 0x37, 0x94,
-	0x42, 0x0b, 0x6b, 0x7d, 0x6a, 0xd4, 0xcd, 0x8c, 0xba, 0x93, 0x9d, 0x77, 0x36, 0x5c, 0xa1, 0x02,
-	0xa1, 0x86, 0x89, 0x55, 0xcf, 0x4c, 0x8c, 0x8b, 0xfd, 0x35, 0x81, 0x37, 0x0e, 0xe2, 0x18, 0x9f,
-	0x48, 0x0f, 0xe5, 0x53, 0x89, 0x54, 0xa3, 0x67, 0x6d, 0xc0, 0x5d, 0x11, 0xcf, 0x87, 0xcc, 0x6b,
-	0x93, 0x4d, 0xb2, 0xbd, 0xe4, 0xdc, 0x49, 0xe6, 0x47, 0x9e, 0xf5, 0x10, 0xc0, 0x7c, 0xd2, 0x51,
-	0x88, 0xed, 0xc6, 0x26, 0xd9, 0x6e, 0x39, 0xad, 0x64, 0xe5, 0x38, 0x0a, 0xd1, 0x7a, 0x00, 0xad,
-	0x80, 0xca, 0x2f, 0x51, 0xc7, 0xae, 0x8b, 0x9b, 0x64, 0xfb, 0x9e, 0x73, 0xd7, 0x2c, 0x1c, 0x79,
-	0xd6, 0x7b, 0xb0, 0x82, 0xe7, 0x1a, 0x25, 0xa7, 0x7e, 0xfc, 0x79, 0x29, 0x71, 0x86, 0x6c, 0xe9,
-	0xc8, 0xb3, 0x7f, 0x20, 0xf0, 0x66, 0x8e, 0x4d, 0x2c, 0xc4, 0xf7, 0xcb, 0xf9, 0x7c, 0x04, 0xab,
-	0x6e, 0x66, 0x37, 0x3c, 0x89, 0x0c, 0xa3, 0x7e, 0xfb, 0xb7, 0x9f, 0x1e, 0xad, 0xa5, 0x42, 0xf7,
-	0x3c, 0x4f, 0xa2, 0x52, 0xcf, 0xb4, 0x64, 0x7c, 0xe4, 0xac, 0x5c, 0x59, 0xf7, 0xa3, 0x7f, 0xc9,
-	0xf6, 0x47, 0x02, 0xf7, 0xa7, 0x6c, 0x0f, 0x59, 0x15, 0xd5, 0x75, 0x68, 0x52, 0xa5, 0x50, 0xab,
-	0x34, 0x6d, 0xe9, 0xcc, 0x5a, 0x83, 0xe5, 0x50, 0x32, 0x17, 0x13, 0x06, 0x2d, 0xc7, 0x4c, 0x2c,
-	0x0b, 0x96, 0x3e, 0x47, 0x54, 0x29, 0x6e, 0x32, 0x2e, 0xf2, 0x5d, 0x2e, 0xe7, 0xdb, 0x9c, 0xe1,
-	0xfb, 0x33, 0x81, 0x8d, 0x29, 0xdf, 0x01, 0x95, 0x9a, 0x51, 0xdf, 0x8f, 0x6e, 0x3f, 0xf1, 0x09,
-	0x3c, 0x98, 0xf2, 0x3e, 0xc8, 0xd6, 0xf7, 0x9f, 0x87, 0x5e, 0xd5, 0x6e, 0x2d, 0xe0, 0x36, 0xca,
-	0x71, 0x17, 0x67, 0x70, 0x5f, 0x66, 0xdb, 0xf1, 0x70, 0xcc, 0x3d, 0xf5, 0x54, 0x04, 0x01, 0xd3,
-	0x31, 0xe0, 0xfb, 0x70, 0x87, 0xba, 0xae, 0x18, 0x73, 0x9d, 0xe0, 0x95, 0x6d, 0xb7, 0xcc, 0xb0,
-	0x9c, 0x49, 0x9c, 0xe0, 0x20, 0x89, 0xb7, 0x98, 0x26, 0x38, 0x99, 0x59, 0xf7, 0x61, 0x51, 0xd3,
-	0x51, 0x9a, 0xc9, 0x78, 0x68, 0x7f, 0x43, 0xe0, 0xed, 0x84, 0x92, 0x61, 0x13, 0x20, 0xd7, 0x0e,
-	0xfa, 0x48, 0xd5, 0x7f, 0x4b, 0xeb, 0x97, 0x2c, 0x53, 0x1f, 0x27, 0xbe, 0x9f, 0x32, 0x7d, 0xea,
-	0x49, 0x7a, 0x56, 0x0c, 0x4f, 0x5e, 0x1b, 0xbe, 0x51, 0x08, 0xff, 0x04, 0x56, 0x3c, 0x54, 0x9a,
-	0x71, 0xaa, 0x99, 0xe0, 0x06, 0xbb, 0xec, 0x44, 0xe7, 0x8c, 0xe3, 0x72, 0x70, 0x96, 0x82, 0xf3,
-	0xb8, 0x1c, 0x2c, 0x55, 0x39, 0x5f, 0x59, 0xf7, 0x23, 0xfb, 0x45, 0x7a, 0x3e, 0x8c, 0x88, 0x7d,
-	0xd4, 0x94, 0xf9, 0x2a, 0xdb, 0x65, 0xa5, 0x52, 0x76, 0x01, 0xc6, 0xc6, 0x6e, 0x9e, 0x1a, 0xd4,
-	0x4a, 0x6d, 0xfb, 0x91, 0xcd, 0xc1, 0xca, 0x41, 0x1e, 0x70, 0x7a, 0xe2, 0xd7, 0x85, 0xf5, 0xa4,
-	0xd1, 0x26, 0xb6, 0x28, 0xfc, 0xa7, 0x7d, 0xa6, 0xea, 0x06, 0x0c, 0xa1, 0x9d, 0x03, 0x4c, 0x4e,
-	0xb0, 0xaa, 0x55, 0xe6, 0xb5, 0xbf, 0x68, 0x10, 0xeb, 0x15, 0x6a, 0x6b, 0x78, 0x37, 0x07, 0xf9,
-	0x5c, 0xa1, 0x7c, 0x86, 0x5a, 0xfb, 0x58, 0xaf, 0xd0, 0x31, 0x3c, 0xbc, 0x11, 0xb5, 0x66, 0xb1,
-	0x45, 0xd8, 0x69, 0x1d, 0xaa, 0xf9, 0xb7, 0x4e, 0xa0, 0x73, 0x33, 0x6c, 0xcd, 0x72, 0xbf, 0x82,
-	0xad, 0x1c, 0xee, 0x11, 0xd7, 0x28, 0x03, 0xf4, 0x18, 0x95, 0xd1, 0x3e, 0x72, 0x11, 0xd4, 0x5b,
-	0x1e, 0x8a, 0xb9, 0x1e, 0xa0, 0x0c, 0x98, 0x52, 0x4c, 0xf0, 0x9a, 0xab, 0x52, 0xf1, 0x08, 0x39,
-	0xf8, 0x62, 0x4f, 0x6b, 0x59, 0x2f, 0xe4, 0x4e, 0xa1, 0x10, 0x66, 0x17, 0xd1, 0x32, 0x2c, 0xfb,
-	0x43, 0x58, 0xcf, 0xb9, 0x1c, 0x22, 0xce, 0x95, 0x15, 0x7b, 0x2d, 0x45, 0x1a, 0x50, 0x49, 0x83,
-	0xcc, 0xc5, 0xfe, 0x33, 0xeb, 0x60, 0x03, 0x1a, 0xc5, 0xdb, 0x2a, 0x63, 0xf0, 0x18, 0x9a, 0x4a,
-	0x8c, 0xa5, 0x8b, 0x95, 0x3d, 0x35, 0xb5, 0xb3, 0xb6, 0xe0, 0x9e, 0x19, 0x0d, 0x0b, 0xdd, 0x6d,
-	0xd5, 0x2c, 0xee, 0x99, 0x1e, 0xf7, 0x18, 0x9a, 0x9a, 0xca, 0x11, 0xea, 0xca, 0xf6, 0x96, 0xda,
-	0xc5, 0x61, 0xcd, 0x28, 0x0b, 0x6b, 0xda, 0xef, 0xaa, 0x59, 0x4c, 0xc3, 0x5e, 0xbb, 0xd2, 0x2c,
-	0xcf, 0x5c, 0x69, 0xbe, 0x6f, 0x14, 0x65, 0x66, 0x19, 0xab, 0x49, 0xe6, 0x2e, 0x80, 0xf0, 0xbd,
-	0xe1, 0x9c, 0x52, 0x5b, 0xc2, 0xf7, 0x8e, 0x8d, 0xda, 0x5d, 0x00, 0x8e, 0x67, 0x99, 0x63, 0x55,
-	0x17, 0x6f, 0x71, 0x3c, 0x3b, 0x7e, 0x4d, 0x9a, 0x96, 0xab, 0xd3, 0x34, 0x7b, 0xe3, 0xfc, 0x8b,
-	0xc0, 0x5a, 0x3e, 0x4d, 0x7b, 0xae, 0x8b, 0xe1, 0xff, 0x70, 0x3b, 0x7c, 0x7b, 0x4d, 0xa7, 0x83,
-	0x5f, 0xa0, 0xfb, 0xcf, 0x74, 0x4e, 0x25, 0x34, 0xe6, 0x94, 0x50, 0x79, 0xff, 0xfe, 0x8e, 0xc0,
-	0x5b, 0x85, 0x33, 0x79, 0xf5, 0x20, 0xbc, 0x0d, 0xf4, 0xfa, 0xf8, 0xeb, 0x45, 0x87, 0xbc, 0xba,
-	0xe8, 0x90, 0x3f, 0x2e, 0x3a, 0xe4, 0xe5, 0x65, 0x67, 0xe1, 0xd5, 0x65, 0x67, 0xe1, 0xf7, 0xcb,
-	0xce, 0x02, 0x6c, 0x30, 0xd1, 0xbd, 0xf9, 0x2d, 0x3e, 0x20, 0x9f, 0x75, 0x47, 0x4c, 0x9f, 0x8e,
-	0x4f, 0xba, 0xae, 0x08, 0x7a, 0x53, 0xa3, 0x47, 0x4c, 0xe4, 0x66, 0xbd, 0xf3, 0xab, 0x57, 0xfe,
-	0x49, 0x33, 0x79, 0xa9, 0x7f, 0xf0, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x79, 0x48, 0x20,
-	0x03, 0x10, 0x00, 0x00,
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xd8, 0x89, 0x5b, 0xbf, 0xa4, 0x52, 0x59, 0x42, 0xb0, 0x9b, 0xd6, 0x44, 0x9b, 0x4b,
+	0x2e, 0xb5, 0x09, 0x08, 0x45, 0x2a, 0x27, 0x3b, 0x71, 0xa4, 0x1c, 0x2a, 0x2c, 0x37, 0x15, 0x12,
+	0x17, 0x6b, 0xb2, 0xfb, 0x70, 0x06, 0x76, 0x67, 0xb6, 0x33, 0x63, 0x27, 0x2b, 0x3e, 0x02, 0x02,
+	0xf5, 0x80, 0x38, 0x00, 0x47, 0x6e, 0x88, 0x1b, 0xe2, 0x0b, 0x70, 0xe1, 0x58, 0x71, 0xe2, 0x88,
+	0x12, 0xf8, 0x1e, 0x68, 0xff, 0xd9, 0xbb, 0x89, 0xeb, 0x8d, 0xa8, 0x96, 0x44, 0xbd, 0xed, 0x3c,
+	0xbf, 0xf7, 0x7e, 0xbf, 0xdf, 0x9b, 0x99, 0x37, 0xe3, 0x81, 0x4d, 0x4f, 0x8a, 0x31, 0x72, 0xca,
+	0x2d, 0x6c, 0xe1, 0xa9, 0x75, 0x4c, 0xf9, 0x10, 0x5b, 0xe3, 0xed, 0x16, 0x8e, 0x91, 0x6b, 0xd5,
+	0xf4, 0xa4, 0xd0, 0xc2, 0x58, 0x9b, 0x3a, 0x35, 0x13, 0xa7, 0xe6, 0x78, 0xfb, 0x5e, 0xdd, 0x12,
+	0xca, 0x15, 0x6a, 0x10, 0x7a, 0xb5, 0xa2, 0x41, 0x14, 0x62, 0x7e, 0x49, 0xe0, 0x8d, 0x6e, 0x90,
+	0xe3, 0x23, 0x69, 0xa3, 0xdc, 0x95, 0x48, 0x35, 0xda, 0x46, 0x1d, 0x6e, 0x8b, 0x60, 0x3c, 0x60,
+	0x76, 0x8d, 0x6c, 0x90, 0xad, 0xc5, 0xfe, 0xad, 0x70, 0x7c, 0x60, 0x1b, 0x0f, 0x00, 0xa2, 0x9f,
+	0xb4, 0xef, 0x61, 0xad, 0xb4, 0x41, 0xb6, 0xaa, 0xfd, 0x6a, 0x68, 0x39, 0xf4, 0x3d, 0x34, 0xd6,
+	0xa1, 0xea, 0x52, 0xf9, 0x39, 0xea, 0x20, 0xb4, 0xbc, 0x41, 0xb6, 0xee, 0xf4, 0x6f, 0x47, 0x86,
+	0x03, 0xdb, 0x78, 0x07, 0x96, 0xf1, 0x54, 0xa3, 0xe4, 0xd4, 0x09, 0x7e, 0x5e, 0x0c, 0x83, 0x21,
+	0x31, 0x1d, 0xd8, 0xe6, 0x4f, 0x04, 0xde, 0x4c, 0xb1, 0x09, 0x84, 0x38, 0xce, 0x7c, 0x3e, 0x1f,
+	0xc2, 0x8a, 0x95, 0xf8, 0x0d, 0x8e, 0xfc, 0x88, 0x51, 0xa7, 0xf6, 0xc7, 0x2f, 0x0f, 0x57, 0x63,
+	0xa1, 0x6d, 0xdb, 0x96, 0xa8, 0xd4, 0x13, 0x2d, 0x19, 0x1f, 0xf6, 0x97, 0x27, 0xde, 0x1d, 0xff,
+	0x15, 0xd9, 0x3a, 0xe9, 0xd2, 0x75, 0x4f, 0x3d, 0x26, 0xe7, 0x53, 0xcd, 0xa0, 0x95, 0xe6, 0xa3,
+	0x95, 0x2f, 0xa1, 0x7d, 0x45, 0xe0, 0xde, 0x05, 0x38, 0xaa, 0x99, 0xe0, 0xfb, 0x94, 0x39, 0x05,
+	0xe2, 0x1a, 0x6b, 0x50, 0x91, 0x48, 0x95, 0xe0, 0x71, 0x05, 0xe2, 0x91, 0xf9, 0x2d, 0x81, 0xfb,
+	0x21, 0x9f, 0x5d, 0xc1, 0x6d, 0x16, 0x30, 0xa1, 0xce, 0x8d, 0x58, 0x44, 0xdf, 0x11, 0x68, 0xcc,
+	0x24, 0x76, 0x28, 0xd9, 0x70, 0x88, 0x45, 0x4e, 0x52, 0xe0, 0xa0, 0x25, 0xb5, 0x71, 0xe0, 0x49,
+	0x66, 0x61, 0x42, 0x2e, 0x34, 0xf5, 0x02, 0x8b, 0xf9, 0x35, 0x81, 0xf5, 0x99, 0xe4, 0xae, 0x6b,
+	0x1a, 0x7f, 0x26, 0x70, 0x77, 0xba, 0xac, 0xf6, 0x59, 0xde, 0x7e, 0x5b, 0x83, 0x0a, 0x55, 0x0a,
+	0xb5, 0x8a, 0xa7, 0x2d, 0x1e, 0x19, 0xab, 0xb0, 0x14, 0x69, 0x8e, 0xa0, 0xa3, 0x81, 0x61, 0xc0,
+	0xe2, 0xa7, 0x88, 0x2a, 0xc6, 0x0c, 0xbf, 0xb3, 0x3a, 0x96, 0xe6, 0xeb, 0xa8, 0x5c, 0x9a, 0xdd,
+	0x5f, 0x09, 0xd4, 0xa7, 0x7c, 0x7b, 0x54, 0x6a, 0x46, 0x1d, 0xc7, 0xbf, 0xf9, 0xc4, 0xc7, 0xb0,
+	0x3e, 0xe5, 0xdd, 0x4d, 0xec, 0x7b, 0x4f, 0x3d, 0x3b, 0x6f, 0xb7, 0xbc, 0x5a, 0xdf, 0x78, 0x9e,
+	0xf4, 0xd4, 0xfd, 0x11, 0xb7, 0xd5, 0xae, 0x70, 0x5d, 0xa6, 0x03, 0xc0, 0xf7, 0xe0, 0x16, 0xb5,
+	0x2c, 0x31, 0xe2, 0xba, 0x46, 0x72, 0x7a, 0x66, 0xe2, 0x38, 0x9f, 0x49, 0x50, 0x60, 0x37, 0xcc,
+	0x57, 0x8e, 0x0b, 0x1c, 0x8e, 0x8c, 0xbb, 0x50, 0xd6, 0x74, 0x18, 0x57, 0x32, 0xf8, 0x34, 0xbf,
+	0x21, 0xf0, 0x76, 0xbc, 0x09, 0x02, 0x36, 0x2e, 0x72, 0xdd, 0x47, 0x07, 0xa9, 0xba, 0x5e, 0x5a,
+	0xbf, 0x25, 0x95, 0x7a, 0x1c, 0xc6, 0x7e, 0xcc, 0xf4, 0xb1, 0x2d, 0xe9, 0x49, 0x36, 0x3d, 0x79,
+	0x69, 0xfa, 0x52, 0x26, 0xfd, 0x23, 0x58, 0xb6, 0x51, 0x69, 0xc6, 0xc3, 0x26, 0x5d, 0x2b, 0xe7,
+	0x68, 0x49, 0x3b, 0x07, 0x67, 0xda, 0x49, 0x0c, 0xce, 0x83, 0x33, 0x6d, 0x31, 0x2f, 0x78, 0xe2,
+	0xdd, 0xf1, 0xcd, 0x67, 0x50, 0x4f, 0x89, 0xd8, 0x43, 0x4d, 0x99, 0xa3, 0x92, 0x55, 0x36, 0x57,
+	0xca, 0x0e, 0xc0, 0x28, 0xf2, 0xbb, 0xca, 0x41, 0x5a, 0x8d, 0x7d, 0x3b, 0xbe, 0xc9, 0xc1, 0x48,
+	0x41, 0x76, 0x39, 0x3d, 0x72, 0x8a, 0xc2, 0x7a, 0x54, 0xaa, 0x11, 0x53, 0x64, 0xe6, 0x69, 0x8f,
+	0xa9, 0xa2, 0x01, 0x3d, 0xa8, 0xa5, 0x00, 0xc3, 0x1d, 0xac, 0x0a, 0x95, 0x79, 0x61, 0x16, 0x23,
+	0xc4, 0x62, 0x85, 0x9a, 0x1a, 0xee, 0xa7, 0x20, 0x9f, 0x2a, 0x94, 0x4f, 0x50, 0x6b, 0x07, 0x8b,
+	0x15, 0x3a, 0x82, 0x07, 0x33, 0x51, 0x0b, 0x16, 0x9b, 0x85, 0x9d, 0xf6, 0xa1, 0x82, 0xa7, 0x75,
+	0x0c, 0x8d, 0xd9, 0xb0, 0x05, 0xcb, 0x55, 0xb0, 0x9e, 0xc2, 0x6d, 0x8f, 0xb4, 0x78, 0x4c, 0xb5,
+	0x75, 0xdc, 0xe5, 0xff, 0xdf, 0x82, 0x9a, 0x80, 0x16, 0x2c, 0xf5, 0x0b, 0xd8, 0x4c, 0xa1, 0x1e,
+	0x70, 0x8d, 0xd2, 0x45, 0x9b, 0x51, 0xe9, 0xef, 0x21, 0x17, 0x6e, 0xb1, 0x9d, 0x30, 0xbb, 0xac,
+	0x7a, 0x28, 0x5d, 0xa6, 0x14, 0x13, 0xbc, 0xe0, 0x06, 0x9c, 0xed, 0x16, 0x7d, 0x7c, 0xd6, 0xd6,
+	0x5a, 0x16, 0x0b, 0xb9, 0x9d, 0xe9, 0xf9, 0xc9, 0x9d, 0x7f, 0x1e, 0x96, 0xf9, 0x01, 0xac, 0xa5,
+	0x42, 0xf6, 0x11, 0xaf, 0x54, 0x15, 0x73, 0x35, 0x46, 0xea, 0x51, 0x49, 0xdd, 0x24, 0xc4, 0xfc,
+	0x3b, 0x39, 0xac, 0x7b, 0xd4, 0x0f, 0x76, 0x50, 0xc2, 0xe0, 0x5d, 0xa8, 0x28, 0x31, 0x92, 0x16,
+	0xe6, 0x5e, 0x1f, 0x62, 0x3f, 0x63, 0x13, 0xee, 0x44, 0x5f, 0x83, 0xcc, 0x41, 0xbe, 0x12, 0x19,
+	0xdb, 0xa1, 0x2d, 0x48, 0xab, 0xa9, 0x1c, 0xa2, 0xce, 0x3d, 0xc9, 0x63, 0xbf, 0x20, 0x6d, 0xf4,
+	0x95, 0xa4, 0x8d, 0x6e, 0x1a, 0x2b, 0x91, 0x31, 0x4e, 0x7b, 0xe1, 0xf6, 0xb6, 0x74, 0xe9, 0xf6,
+	0xf6, 0x63, 0x29, 0x2b, 0x33, 0xa9, 0x58, 0x41, 0x32, 0x77, 0x00, 0x84, 0x63, 0x0f, 0xae, 0x28,
+	0xb5, 0x2a, 0x1c, 0xfb, 0x30, 0x52, 0xbb, 0x03, 0xc0, 0xf1, 0x24, 0x09, 0xcc, 0xbb, 0xb0, 0x54,
+	0x39, 0x9e, 0x1c, 0xbe, 0xa4, 0x4c, 0x4b, 0xf9, 0x65, 0xba, 0x7c, 0xb9, 0xfe, 0x87, 0xc0, 0x6a,
+	0xba, 0x4c, 0x6d, 0xcb, 0x42, 0xef, 0x35, 0x5c, 0x0e, 0xdf, 0x5f, 0xd0, 0xd9, 0xc7, 0xcf, 0xd0,
+	0xfa, 0x6f, 0x3a, 0xa7, 0x12, 0x4a, 0x57, 0x94, 0x90, 0xfb, 0x57, 0xe3, 0x07, 0x02, 0x6f, 0x65,
+	0xf6, 0xe4, 0xe4, 0x01, 0xe7, 0x26, 0xd0, 0xeb, 0x20, 0xd4, 0x99, 0x68, 0xce, 0x7e, 0x23, 0xeb,
+	0x91, 0x4f, 0x9a, 0x43, 0xa6, 0x8f, 0x47, 0x47, 0x4d, 0x4b, 0xb8, 0xad, 0xa9, 0xd3, 0x43, 0x26,
+	0x52, 0xa3, 0xd6, 0xe9, 0xe4, 0xf5, 0xed, 0xf7, 0xb3, 0x06, 0x79, 0x71, 0xd6, 0x20, 0x7f, 0x9d,
+	0x35, 0xc8, 0xf3, 0xf3, 0xc6, 0xc2, 0x8b, 0xf3, 0xc6, 0xc2, 0x9f, 0xe7, 0x8d, 0x85, 0xa3, 0x4a,
+	0xf8, 0xb2, 0xf6, 0xfe, 0xbf, 0x03, 0x00, 0x6d, 0x57, 0x82, 0xb6, 0xb3, 0x13, 0x00, 0x00,
 }
 
 func (m *EventOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -2005,7 +2486,7 @@ func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderExpired) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2015,12 +2496,12 @@ func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderExpired) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2030,33 +2511,12 @@ func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.OrderId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
@@ -2066,7 +2526,7 @@ func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderExpirationFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2076,48 +2536,34 @@ func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderExpirationFailed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderExpirationFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Fees) > 0 {
-		i -= len(m.Fees)
-		copy(dAtA[i:], m.Fees)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Assets) > 0 {
-		i -= len(m.Assets)
-		copy(dAtA[i:], m.Assets)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
-		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
 	if m.OrderId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
@@ -2127,7 +2573,7 @@ func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventConditionalOrderCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2137,12 +2583,12 @@ func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderCreated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2152,12 +2598,19 @@ func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 		copy(dAtA[i:], m.ExternalId)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.OrderId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
@@ -2167,7 +2620,7 @@ func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
+func (m *EventConditionalOrderTriggered) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2177,27 +2630,27 @@ func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderTriggered) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderTriggered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+	if len(m.TradePrice) > 0 {
+		i -= len(m.TradePrice)
+		copy(dAtA[i:], m.TradePrice)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TradePrice)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2206,17 +2659,15 @@ func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
+func (m *EventConditionalOrderFailed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2226,27 +2677,27 @@ func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderFailed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventConditionalOrderFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -2255,17 +2706,15 @@ func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2275,46 +2724,58 @@ func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.WithdrawnBy) > 0 {
-		i -= len(m.WithdrawnBy)
-		copy(dAtA[i:], m.WithdrawnBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if len(m.Destination) > 0 {
-		i -= len(m.Destination)
-		copy(dAtA[i:], m.Destination)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderPartiallyFilled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2324,32 +2785,58 @@ func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderPartiallyFilled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderPartiallyFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x32
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Assets) > 0 {
+		i -= len(m.Assets)
+		copy(dAtA[i:], m.Assets)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Assets)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
+func (m *EventOrderExternalIDUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2359,32 +2846,37 @@ func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventOrderExternalIDUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventOrderExternalIDUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
+		dAtA[i] = 0x10
+	}
+	if m.OrderId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OrderId))
+		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
+func (m *EventFundsCommitted) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2394,32 +2886,46 @@ func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventFundsCommitted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventFundsCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
+func (m *EventCommitmentReleased) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2429,32 +2935,46 @@ func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCommitmentReleased) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCommitmentReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Tag)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketWithdraw) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2464,20 +2984,34 @@ func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketWithdraw) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UpdatedBy) > 0 {
-		i -= len(m.UpdatedBy)
-		copy(dAtA[i:], m.UpdatedBy)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+	if len(m.WithdrawnBy) > 0 {
+		i -= len(m.WithdrawnBy)
+		copy(dAtA[i:], m.WithdrawnBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.WithdrawnBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2489,7 +3023,7 @@ func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketDetailsUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2499,12 +3033,12 @@ func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketDetailsUpdated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketDetailsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2524,7 +3058,7 @@ func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2534,12 +3068,12 @@ func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2559,7 +3093,7 @@ func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketDisabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2569,12 +3103,12 @@ func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketDisabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2594,7 +3128,7 @@ func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketOrdersEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2604,12 +3138,12 @@ func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2629,7 +3163,7 @@ func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketOrdersDisabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2639,12 +3173,12 @@ func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersDisabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketOrdersDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2664,7 +3198,7 @@ func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketUserSettleEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2674,12 +3208,12 @@ func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketUserSettleEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketUserSettleEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2699,7 +3233,7 @@ func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketUserSettleDisabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2709,12 +3243,12 @@ func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketUserSettleDisabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketUserSettleDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2734,7 +3268,7 @@ func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketCommitmentsEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2744,16 +3278,23 @@ func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketCommitmentsEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketCommitmentsEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
@@ -2762,7 +3303,7 @@ func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketCommitmentsDisabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2772,16 +3313,23 @@ func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketCommitmentsDisabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketCommitmentsDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MarketId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
 		i--
@@ -2790,7 +3338,7 @@ func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventMarketAutoMatchEnabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2800,22 +3348,253 @@ func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventMarketAutoMatchEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventMarketAutoMatchEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketAutoMatchDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketAutoMatchDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketAutoMatchDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketIntermediaryDenomUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketPermissionsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketPermissionsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketReqAttrUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketReqAttrUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UpdatedBy) > 0 {
+		i -= len(m.UpdatedBy)
+		copy(dAtA[i:], m.UpdatedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UpdatedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMarketFeesUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMarketFeesUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMarketFeesUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
 		return nil, err
@@ -3139,7 +3918,7 @@ func (m *EventOrderCancelled) Size() (n int) {
 	return n
 }
 
-func (m *EventOrderFilled) Size() (n int) {
+func (m *EventOrderExpired) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3148,18 +3927,6 @@ func (m *EventOrderFilled) Size() (n int) {
 	if m.OrderId != 0 {
 		n += 1 + sovEvents(uint64(m.OrderId))
 	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Fees)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	if m.MarketId != 0 {
 		n += 1 + sovEvents(uint64(m.MarketId))
 	}
@@ -3170,7 +3937,7 @@ func (m *EventOrderFilled) Size() (n int) {
 	return n
 }
 
-func (m *EventOrderPartiallyFilled) Size() (n int) {
+func (m *EventOrderExpirationFailed) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -3179,15 +3946,138 @@ func (m *EventOrderPartiallyFilled) Size() (n int) {
 	if m.OrderId != 0 {
 		n += 1 + sovEvents(uint64(m.OrderId))
 	}
-	l = len(m.Assets)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
 	}
-	l = len(m.Price)
+	l = len(m.ExternalId)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.Fees)
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConditionalOrderCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConditionalOrderTriggered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TradePrice)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventConditionalOrderFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderPartiallyFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovEvents(uint64(m.OrderId))
+	}
+	l = len(m.Assets)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fees)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -3436,6 +4326,38 @@ func (m *EventMarketCommitmentsDisabled) Size() (n int) {
 	return n
 }
 
+func (m *EventMarketAutoMatchEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMarketAutoMatchDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovEvents(uint64(m.MarketId))
+	}
+	l = len(m.UpdatedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventMarketIntermediaryDenomUpdated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3757,7 +4679,735 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderExpirationFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderExpirationFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderExpirationFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConditionalOrderCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConditionalOrderCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConditionalOrderCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventConditionalOrderTriggered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventConditionalOrderTriggered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventConditionalOrderTriggered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3785,7 +5435,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.TradePrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3808,7 +5458,7 @@ func (m *EventOrderCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+func (m *EventConditionalOrderFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3831,10 +5481,10 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConditionalOrderFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConditionalOrderFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3857,10 +5507,10 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var stringLen uint64
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3870,29 +5520,16 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CancelledBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3902,14 +5539,27 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3937,7 +5587,7 @@ func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5916,6 +7566,208 @@ func (m *EventMarketCommitmentsDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventMarketAutoMatchEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAutoMatchEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAutoMatchEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMarketAutoMatchDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMarketAutoMatchDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMarketAutoMatchDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UpdatedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventMarketIntermediaryDenomUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -9,6 +9,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -146,6 +148,97 @@ func TestNewEventOrderCancelled(t *testing.T) {
 	}
 }
 
+func TestNewEventOrderExpired(t *testing.T) {
+	tests := []struct {
+		name     string
+		order    OrderI
+		expected *EventOrderExpired
+	}{
+		{
+			name:  "ask order",
+			order: NewOrder(11).WithAsk(&AskOrder{MarketId: 71, ExternalId: "an external identifier"}),
+			expected: &EventOrderExpired{
+				OrderId:    11,
+				MarketId:   71,
+				ExternalId: "an external identifier",
+			},
+		},
+		{
+			name:  "bid order",
+			order: NewOrder(55).WithBid(&BidOrder{MarketId: 88, ExternalId: "another external identifier"}),
+			expected: &EventOrderExpired{
+				OrderId:    55,
+				MarketId:   88,
+				ExternalId: "another external identifier",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var event *EventOrderExpired
+			testFunc := func() {
+				event = NewEventOrderExpired(tc.order)
+			}
+			require.NotPanics(t, testFunc, "NewEventOrderExpired")
+			assert.Equal(t, tc.expected, event, "NewEventOrderExpired result")
+			assertEverythingSet(t, event, "EventOrderExpired")
+		})
+	}
+}
+
+func TestNewEventOrderExpirationFailed(t *testing.T) {
+	order := NewOrder(12).WithAsk(&AskOrder{MarketId: 72, ExternalId: "twelve"})
+	expected := &EventOrderExpirationFailed{
+		OrderId:    12,
+		MarketId:   72,
+		ExternalId: "twelve",
+		Reason:     "hold could not be released",
+	}
+	actual := NewEventOrderExpirationFailed(order, "hold could not be released")
+	assert.Equal(t, expected, actual, "NewEventOrderExpirationFailed result")
+	assertEverythingSet(t, actual, "EventOrderExpirationFailed")
+}
+
+func TestNewEventConditionalOrderCreated(t *testing.T) {
+	order := NewOrder(4).WithBid(&BidOrder{MarketId: 12, ExternalId: "fourfourfour"})
+	expected := &EventConditionalOrderCreated{
+		OrderId:    4,
+		OrderType:  "bid",
+		MarketId:   12,
+		ExternalId: "fourfourfour",
+	}
+	actual := NewEventConditionalOrderCreated(order)
+	assert.Equal(t, expected, actual, "NewEventConditionalOrderCreated result")
+	assertEverythingSet(t, actual, "EventConditionalOrderCreated")
+}
+
+func TestNewEventConditionalOrderTriggered(t *testing.T) {
+	order := NewOrder(5).WithAsk(&AskOrder{MarketId: 13, ExternalId: "fivefivefive"})
+	expected := &EventConditionalOrderTriggered{
+		OrderId:    5,
+		MarketId:   13,
+		ExternalId: "fivefivefive",
+		TradePrice: "2.500000000000000000",
+	}
+	actual := NewEventConditionalOrderTriggered(order, sdkmath.LegacyMustNewDecFromStr("2.5"))
+	assert.Equal(t, expected, actual, "NewEventConditionalOrderTriggered result")
+	assertEverythingSet(t, actual, "EventConditionalOrderTriggered")
+}
+
+func TestNewEventConditionalOrderFailed(t *testing.T) {
+	order := NewOrder(6).WithBid(&BidOrder{MarketId: 14, ExternalId: "sixsixsix"})
+	expected := &EventConditionalOrderFailed{
+		OrderId:    6,
+		MarketId:   14,
+		ExternalId: "sixsixsix",
+		Reason:     "market 14 is not accepting orders",
+	}
+	actual := NewEventConditionalOrderFailed(order, "market 14 is not accepting orders")
+	assert.Equal(t, expected, actual, "NewEventConditionalOrderFailed result")
+	assertEverythingSet(t, actual, "EventConditionalOrderFailed")
+}
+
 func TestNewEventOrderFilled(t *testing.T) {
 	coinP := func(denom string, amount int64) *sdk.Coin {
 		rv := sdk.NewInt64Coin(denom, amount)
@@ -1339,6 +1432,70 @@ func TestTypedEventToEvent(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "EventOrderExpired",
+			tev:  NewEventOrderExpired(NewOrder(3).WithAsk(&AskOrder{MarketId: 66, ExternalId: "outside 8"})),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrderExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("outside 8")},
+					{Key: "market_id", Value: "66"},
+					{Key: "order_id", Value: quoteStr("3")},
+				},
+			},
+		},
+		{
+			name: "EventOrderExpirationFailed",
+			tev:  NewEventOrderExpirationFailed(NewOrder(3).WithBid(&BidOrder{MarketId: 43, ExternalId: "three"}), "no good"),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventOrderExpirationFailed",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("three")},
+					{Key: "market_id", Value: "43"},
+					{Key: "order_id", Value: quoteStr("3")},
+					{Key: "reason", Value: quoteStr("no good")},
+				},
+			},
+		},
+		{
+			name: "EventConditionalOrderCreated",
+			tev:  NewEventConditionalOrderCreated(NewOrder(4).WithAsk(&AskOrder{MarketId: 44, ExternalId: "stop 4"})),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventConditionalOrderCreated",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("stop 4")},
+					{Key: "market_id", Value: "44"},
+					{Key: "order_id", Value: quoteStr("4")},
+					{Key: "order_type", Value: quoteStr("ask")},
+				},
+			},
+		},
+		{
+			name: "EventConditionalOrderTriggered",
+			tev:  NewEventConditionalOrderTriggered(NewOrder(5).WithBid(&BidOrder{MarketId: 45, ExternalId: "stop 5"}), sdkmath.LegacyNewDec(3)),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventConditionalOrderTriggered",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("stop 5")},
+					{Key: "market_id", Value: "45"},
+					{Key: "order_id", Value: quoteStr("5")},
+					{Key: "trade_price", Value: quoteStr("3.000000000000000000")},
+				},
+			},
+		},
+		{
+			name: "EventConditionalOrderFailed",
+			tev:  NewEventConditionalOrderFailed(NewOrder(6).WithAsk(&AskOrder{MarketId: 46, ExternalId: "stop 6"}), "no good"),
+			expEvent: sdk.Event{
+				Type: "provenance.exchange.v1.EventConditionalOrderFailed",
+				Attributes: []abci.EventAttribute{
+					{Key: "external_id", Value: quoteStr("stop 6")},
+					{Key: "market_id", Value: "46"},
+					{Key: "order_id", Value: quoteStr("6")},
+					{Key: "reason", Value: quoteStr("no good")},
+				},
+			},
+		},
 		{
 			name: "EventOrderFilled ask",
 			tev: NewEventOrderFilled(NewOrder(4).WithAsk(&AskOrder{
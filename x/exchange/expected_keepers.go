@@ -29,8 +29,8 @@ type BankKeeper interface {
 }
 
 type HoldKeeper interface {
-	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error
-	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error
+	AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error
+	ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error
 	GetHoldCoin(ctx sdk.Context, addr sdk.AccAddress, denom string) (sdk.Coin, error)
 }
 
@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -288,6 +289,21 @@ func (f orderFulfillment) GetExternalID() string {
 	return f.Order.GetExternalID()
 }
 
+// GetExpirationHeight gets this fulfillment's order's expiration height.
+func (f orderFulfillment) GetExpirationHeight() uint64 {
+	return f.Order.GetExpirationHeight()
+}
+
+// GetExpirationTime gets this fulfillment's order's expiration time.
+func (f orderFulfillment) GetExpirationTime() *time.Time {
+	return f.Order.GetExpirationTime()
+}
+
+// IsExpiredAt returns true if this fulfillment's order has expired as of the provided block height and time.
+func (f orderFulfillment) IsExpiredAt(blockHeight int64, blockTime time.Time) bool {
+	return f.Order.IsExpiredAt(blockHeight, blockTime)
+}
+
 // GetOrderType gets this fulfillment's order's type string.
 func (f orderFulfillment) GetOrderType() string {
 	return f.Order.GetOrderType()
@@ -40,6 +40,7 @@ func (g GenesisState) Validate() error {
 
 	maxOrderID := uint64(0)
 	orderIDs := make(map[uint64]int, len(g.Orders))
+	condOrderIDs := make(map[uint64]int, len(g.ConditionalOrders))
 	for i, order := range g.Orders {
 		if order.OrderId != 0 {
 			j, seen := orderIDs[order.OrderId]
@@ -65,6 +66,35 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	for i, order := range g.ConditionalOrders {
+		orderID := order.GetOrderID()
+		if orderID != 0 {
+			if j, seen := orderIDs[orderID]; seen {
+				errs = append(errs, fmt.Errorf("invalid conditional order[%d]: order id %d already used by order[%d]", i, orderID, j))
+				continue
+			}
+			if j, seen := condOrderIDs[orderID]; seen {
+				errs = append(errs, fmt.Errorf("invalid conditional order[%d]: duplicate order id %d seen at [%d]", i, orderID, j))
+				continue
+			}
+			condOrderIDs[orderID] = i
+		}
+
+		if err := order.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid conditional order[%d]: %w", i, err))
+			continue
+		}
+
+		_, knownMarket := marketIDs[order.GetMarketID()]
+		if !knownMarket {
+			errs = append(errs, fmt.Errorf("invalid conditional order[%d]: unknown market id %d", i, order.GetMarketID()))
+		}
+
+		if orderID > maxOrderID {
+			maxOrderID = orderID
+		}
+	}
+
 	if g.LastOrderId < maxOrderID {
 		errs = append(errs, fmt.Errorf("last order id %d is less than the largest id in the provided orders %d",
 			g.LastOrderId, maxOrderID))
@@ -95,5 +125,22 @@ func (g GenesisState) Validate() error {
 		}
 	}
 
+	tradeIDs := make(map[string]int)
+	for i, trade := range g.Trades {
+		id := fmt.Sprintf("%d %d", trade.MarketId, trade.TradeId)
+		if j, seen := tradeIDs[id]; seen {
+			errs = append(errs, fmt.Errorf("invalid trade[%d]: duplicate trade, market %d and trade id %d seen at [%d]",
+				i, trade.MarketId, trade.TradeId, j))
+			continue
+		}
+		tradeIDs[id] = i
+
+		if err := trade.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid trade[%d]: %w", i, err))
+		} else if _, known := marketIDs[trade.MarketId]; !known {
+			errs = append(errs, fmt.Errorf("invalid trade[%d]: unknown market id %d", i, trade.MarketId))
+		}
+	}
+
 	return errors.Join(errs...)
 }
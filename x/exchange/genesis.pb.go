@@ -39,6 +39,10 @@ type GenesisState struct {
 	Commitments []Commitment `protobuf:"bytes,6,rep,name=commitments,proto3" json:"commitments"`
 	// payments are all the payments to create at genesis.
 	Payments []Payment `protobuf:"bytes,7,rep,name=payments,proto3" json:"payments"`
+	// conditional_orders are all the conditional orders to create at genesis.
+	ConditionalOrders []ConditionalOrder `protobuf:"bytes,8,rep,name=conditional_orders,json=conditionalOrders,proto3" json:"conditional_orders"`
+	// trades are all the trade records to store at genesis.
+	Trades []Trade `protobuf:"bytes,9,rep,name=trades,proto3" json:"trades"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -83,32 +87,35 @@ func init() {
 }
 
 var fileDescriptor_087ceebafabf03c9 = []byte{
-	// 387 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x29, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0x4f, 0xad, 0x48, 0xce, 0x48, 0xcc, 0x4b, 0x4f, 0xd5,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x43, 0xa8, 0xd2, 0x83, 0xa9, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x34, 0x70, 0x98, 0x99, 0x9c, 0x9f, 0x9b, 0x9b,
-	0x59, 0x92, 0x9b, 0x9a, 0x57, 0x02, 0x35, 0x57, 0x4a, 0x19, 0x87, 0xca, 0xdc, 0xc4, 0xa2, 0xec,
-	0xd4, 0x12, 0x02, 0x8a, 0xf2, 0x8b, 0x52, 0x52, 0x8b, 0x08, 0x99, 0x54, 0x90, 0x58, 0x94, 0x98,
-	0x0b, 0x53, 0xa4, 0x8a, 0x53, 0x51, 0x25, 0x92, 0xab, 0x94, 0x96, 0x31, 0x73, 0xf1, 0xb8, 0x43,
-	0xfc, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc6, 0xc5, 0x06, 0x31, 0x47, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0xdb, 0x48, 0x4e, 0x0f, 0x7b, 0x78, 0xe8, 0x05, 0x80, 0x55, 0x05, 0x41, 0x55, 0x0b,
-	0xd9, 0x71, 0xb1, 0x43, 0x7c, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0x8c, 0x4f, 0xa3, 0x2f, 0x58, 0x99,
-	0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x30, 0x4d, 0x42, 0xd6, 0x5c, 0x6c, 0x10, 0x4f, 0x4a,
-	0x30, 0x83, 0xb5, 0xcb, 0xe2, 0xd2, 0xee, 0x0f, 0x52, 0x05, 0xd5, 0x0d, 0xd5, 0x22, 0xa4, 0xc2,
-	0xc5, 0x97, 0x93, 0x58, 0x5c, 0x12, 0x0f, 0x31, 0x2c, 0x3e, 0x33, 0x45, 0x82, 0x45, 0x81, 0x51,
-	0x83, 0x37, 0x88, 0x07, 0x24, 0x0a, 0xb1, 0xcf, 0x33, 0x45, 0x48, 0x89, 0x8b, 0x17, 0xac, 0x0a,
-	0xac, 0x09, 0xa4, 0x88, 0x55, 0x81, 0x51, 0x83, 0x25, 0x88, 0x1b, 0x24, 0x08, 0x36, 0xd5, 0x33,
-	0x45, 0xc8, 0x8b, 0x8b, 0x1b, 0x29, 0xea, 0x24, 0xd8, 0xc0, 0x6e, 0x51, 0xc2, 0xe5, 0x16, 0x67,
-	0xb8, 0x52, 0xa8, 0x83, 0x90, 0x35, 0x0b, 0x39, 0x72, 0x71, 0xc0, 0x42, 0x5b, 0x82, 0x1d, 0x6c,
-	0x90, 0x3c, 0xee, 0xc0, 0xac, 0x44, 0x32, 0x05, 0xae, 0xcd, 0x8a, 0xa3, 0x63, 0x81, 0x3c, 0xc3,
-	0x8b, 0x05, 0xf2, 0x0c, 0x4e, 0xa9, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0xc0,
-	0x25, 0x99, 0x99, 0x8f, 0xc3, 0xd8, 0x00, 0xc6, 0x28, 0xbd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x84, 0x22, 0xdd, 0xcc, 0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x3c, 0x85,
-	0x24, 0xb1, 0x81, 0x93, 0x85, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x2c, 0xee, 0x93, 0x2c,
-	0x03, 0x00, 0x00,
+	// 433 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xdf, 0xda, 0x77, 0x66, 0xef, 0x04, 0x83, 0x48, 0x1d, 0xd8, 0x96, 0x39, 0xa1,
+	0x17, 0x5b, 0xa6, 0xe0, 0x41, 0x41, 0x70, 0x1e, 0x64, 0x82, 0x38, 0xaa, 0x27, 0x41, 0x46, 0xd6,
+	0x86, 0x2e, 0xb8, 0x36, 0xa3, 0x8d, 0x63, 0xfb, 0x06, 0x1e, 0xfd, 0x08, 0xfb, 0x2c, 0x9e, 0x76,
+	0xdc, 0xd1, 0x93, 0xc8, 0x76, 0xf1, 0x63, 0x48, 0x93, 0xb6, 0x2b, 0x62, 0xb6, 0xf7, 0xb6, 0x85,
+	0xdf, 0xef, 0x9f, 0xa7, 0xff, 0x3c, 0xb0, 0xbf, 0xc8, 0xd8, 0x92, 0xa4, 0x38, 0x0d, 0x89, 0x4f,
+	0x56, 0xe1, 0x0c, 0xa7, 0x31, 0xf1, 0x97, 0x03, 0x3f, 0x26, 0x29, 0xc9, 0x69, 0xee, 0x2d, 0x32,
+	0xc6, 0x19, 0xba, 0x77, 0xa4, 0xbc, 0x8a, 0xf2, 0x96, 0x83, 0xee, 0xdd, 0x98, 0xc5, 0x4c, 0x20,
+	0x7e, 0xf1, 0x4b, 0xd2, 0x5d, 0x57, 0x91, 0x19, 0xb2, 0x24, 0xa1, 0x3c, 0x21, 0x29, 0x2f, 0x73,
+	0xbb, 0x0f, 0x15, 0x64, 0x82, 0xb3, 0x2f, 0x84, 0x9f, 0x81, 0x58, 0x16, 0x91, 0xec, 0x5c, 0xd2,
+	0x02, 0x67, 0x38, 0xa9, 0xa0, 0x47, 0x4a, 0x68, 0x7d, 0x9d, 0xa9, 0x78, 0x86, 0x23, 0x52, 0x42,
+	0xbd, 0x1f, 0x3a, 0xbc, 0x7a, 0x23, 0x4b, 0xfa, 0xc0, 0x31, 0x27, 0xe8, 0x19, 0x34, 0xe4, 0x65,
+	0x26, 0x70, 0x80, 0xdb, 0x7e, 0x62, 0x79, 0xff, 0x2f, 0xcd, 0x1b, 0x0b, 0x2a, 0x28, 0x69, 0xf4,
+	0x12, 0x5e, 0xca, 0xcf, 0xcd, 0xcd, 0x1b, 0xce, 0xc5, 0x29, 0xf1, 0x9d, 0xc0, 0x86, 0xfa, 0xf6,
+	0x97, 0xad, 0x05, 0x95, 0x84, 0x5e, 0x40, 0x43, 0x36, 0x61, 0x5e, 0x08, 0xfd, 0x81, 0x4a, 0x7f,
+	0x5f, 0x50, 0xa5, 0x5d, 0x2a, 0xa8, 0x0f, 0x6f, 0xcf, 0x71, 0xce, 0x27, 0x32, 0x6c, 0x42, 0x23,
+	0x53, 0x77, 0x80, 0xdb, 0x09, 0xae, 0x8a, 0x53, 0x79, 0xdf, 0x28, 0x42, 0x3d, 0xd8, 0x11, 0x94,
+	0x90, 0x0a, 0xe8, 0xa6, 0x03, 0x5c, 0x3d, 0x68, 0x17, 0x87, 0x22, 0x75, 0x14, 0xa1, 0xb7, 0xb0,
+	0xdd, 0x78, 0x5f, 0xd3, 0x10, 0xb3, 0xf4, 0x54, 0xb3, 0xbc, 0xae, 0xd1, 0x72, 0xa0, 0xa6, 0x8c,
+	0x5e, 0xc1, 0x56, 0xf5, 0x24, 0xe6, 0xa5, 0x08, 0xb2, 0xd5, 0x65, 0xae, 0x1b, 0x29, 0xb5, 0x86,
+	0x3e, 0x43, 0x14, 0xb2, 0x34, 0xa2, 0x9c, 0xb2, 0x14, 0xcf, 0x27, 0x65, 0x43, 0x2d, 0x11, 0xe6,
+	0xaa, 0xa7, 0xaa, 0x8d, 0x66, 0x59, 0x77, 0xc2, 0x7f, 0xce, 0x45, 0xe9, 0x72, 0x1b, 0xcc, 0x5b,
+	0xa7, 0x4b, 0xff, 0x58, 0x50, 0x55, 0xe9, 0x52, 0x79, 0xde, 0xfa, 0xb6, 0xb1, 0xb5, 0x3f, 0x1b,
+	0x5b, 0x1b, 0x92, 0xed, 0xde, 0x02, 0xbb, 0xbd, 0x05, 0x7e, 0xef, 0x2d, 0xf0, 0xfd, 0x60, 0x69,
+	0xbb, 0x83, 0xa5, 0xfd, 0x3c, 0x58, 0x1a, 0xbc, 0x4f, 0x99, 0x22, 0x72, 0x0c, 0x3e, 0x79, 0x31,
+	0xe5, 0xb3, 0xaf, 0x53, 0x2f, 0x64, 0x89, 0x7f, 0x84, 0x1e, 0x53, 0xd6, 0xf8, 0xe7, 0xaf, 0xea,
+	0xdd, 0x9d, 0x1a, 0x62, 0x65, 0x9f, 0xfe, 0x1d, 0x00, 0xaa, 0x67, 0xe1, 0x05, 0xed, 0x03, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +138,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for iNdEx := len(m.ConditionalOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConditionalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Payments) > 0 {
 		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -263,6 +298,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ConditionalOrders) > 0 {
+		for _, e := range m.ConditionalOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -511,6 +558,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConditionalOrders = append(m.ConditionalOrders, ConditionalOrder{})
+			if err := m.ConditionalOrders[len(m.ConditionalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
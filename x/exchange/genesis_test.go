@@ -69,6 +69,9 @@ func TestGenesisState_Validate(t *testing.T) {
 			Price:    priceCoin,
 		})
 	}
+	condOrder := func(order Order, triggerPrice sdkmath.LegacyDec, direction TriggerDirection) ConditionalOrder {
+		return *NewConditionalOrder(&order, triggerPrice, direction, nil)
+	}
 	payment := func(source, sourceAmount, target, targetAmount, externalID string) Payment {
 		rv := Payment{
 			Source:     source,
@@ -86,6 +89,20 @@ func TestGenesisState_Validate(t *testing.T) {
 		}
 		return rv
 	}
+	trade := func(marketID uint32, tradeID uint64, assets, price string) Trade {
+		assetsCoin, err := sdk.ParseCoinNormalized(assets)
+		require.NoError(t, err, "trade assets sdk.ParseCoinNormalized(%q)", assets)
+		priceCoin, err := sdk.ParseCoinNormalized(price)
+		require.NoError(t, err, "trade price sdk.ParseCoinNormalized(%q)", price)
+		return Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID * 2},
+			BidOrderIds: []uint64{tradeID*2 + 1},
+			Assets:      assetsCoin,
+			Price:       priceCoin,
+		}
+	}
 
 	tests := []struct {
 		name     string
@@ -339,6 +356,40 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expErr: nil,
 		},
+		{
+			name: "conditional orders: okay",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Orders:  []Order{askOrder(1, 1, "28fry", "2bender")},
+				ConditionalOrders: []ConditionalOrder{
+					condOrder(bidOrder(2, 1, "28fry", "2bender"), sdkmath.LegacyNewDec(3), TriggerDirection_at_or_above),
+					condOrder(askOrder(5, 1, "28fry", "2bender"), sdkmath.LegacyOneDec(), TriggerDirection_at_or_below),
+				},
+				LastOrderId: 5,
+			},
+			expErr: nil,
+		},
+		{
+			name: "conditional orders: several problems",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Orders:  []Order{askOrder(1, 1, "28fry", "2bender")},
+				ConditionalOrders: []ConditionalOrder{
+					condOrder(bidOrder(1, 1, "28fry", "2bender"), sdkmath.LegacyNewDec(3), TriggerDirection_at_or_above),
+					condOrder(askOrder(2, 1, "28fry", "2bender"), sdkmath.LegacyOneDec(), TriggerDirection_at_or_below),
+					condOrder(askOrder(2, 1, "28fry", "2bender"), sdkmath.LegacyOneDec(), TriggerDirection_at_or_below),
+					condOrder(askOrder(3, 2, "28fry", "2bender"), sdkmath.LegacyOneDec(), TriggerDirection_at_or_below),
+					condOrder(askOrder(4, 1, "28fry", "2bender"), sdkmath.LegacyZeroDec(), TriggerDirection_at_or_below),
+				},
+				LastOrderId: 3,
+			},
+			expErr: []string{
+				"invalid conditional order[0]: order id 1 already used by order[0]",
+				"invalid conditional order[2]: duplicate order id 2 seen at [1]",
+				"invalid conditional order[3]: unknown market id 2",
+				"invalid conditional order[4]: invalid trigger price 0.000000000000000000: must be positive",
+			},
+		},
 		{
 			name: "one commitment: bad account",
 			genState: GenesisState{
@@ -436,6 +487,35 @@ func TestGenesisState_Validate(t *testing.T) {
 				"invalid payment[2]: duplicate payment, source " + addr3 + " and external id \"there's two of me\" seen at [1]",
 			},
 		},
+		{
+			name: "trades: okay",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}, {MarketId: 2}},
+				Trades: []Trade{
+					trade(1, 1, "10apple", "50plum"),
+					trade(1, 2, "5apple", "30plum"),
+					trade(2, 1, "10apple", "50plum"),
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name: "trades: several problems",
+			genState: GenesisState{
+				Markets: []Market{{MarketId: 1}},
+				Trades: []Trade{
+					trade(1, 1, "10apple", "50plum"),
+					trade(1, 1, "5apple", "30plum"),
+					trade(1, 0, "5apple", "30plum"),
+					trade(3, 1, "5apple", "30plum"),
+				},
+			},
+			expErr: []string{
+				"invalid trade[1]: duplicate trade, market 1 and trade id 1 seen at [0]",
+				"invalid trade[2]: invalid trade id: cannot be zero",
+				"invalid trade[3]: unknown market id 3",
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// AutoMatchMaxSettlementsPerMarket is the maximum number of settlements
+// that will be made in a single market during a single block.
+const AutoMatchMaxSettlementsPerMarket = 100
+
+// AutoMatchMaxOrdersReadPerMarket is the maximum number of orders that will be read
+// while auto-matching a single market during a single block.
+const AutoMatchMaxOrdersReadPerMarket = 1000
+
+// autoMatchBatchSize is the number of index entries that an orderStream reads at a time.
+const autoMatchBatchSize = 20
+
+// orderStream provides one side of an order book, best price first, reading the orders as they're needed.
+// Orders are read in batches so that no iterator is open while orders are being settled.
+type orderStream struct {
+	k     Keeper
+	store storetypes.KVStore
+	// prefix is the market price to order index prefix for this side of the order book.
+	prefix []byte
+	// isBid is true if this is the bid side, which is read in reverse (highest price first).
+	isBid bool
+	// next is the key to start the next batch at (exclusive for bids, inclusive for asks).
+	next []byte
+	// done is true once all the entries in the index have been read.
+	done bool
+	// orders are the orders that have been read but not yet consumed.
+	orders []*exchange.Order
+	// budget is shared with the other side of the order book and counts down as orders are read.
+	budget *int
+	// errs are any errors encountered reading orders.
+	errs []error
+}
+
+// newOrderStream creates a new orderStream for one side of an order book.
+func (k Keeper) newOrderStream(store storetypes.KVStore, prefix []byte, isBid bool, budget *int) *orderStream {
+	return &orderStream{k: k, store: store, prefix: prefix, isBid: isBid, budget: budget}
+}
+
+// Peek returns the best order in this stream without consuming it, or nil if there aren't any more orders.
+func (s *orderStream) Peek() *exchange.Order {
+	if len(s.orders) == 0 {
+		s.readBatch()
+	}
+	if len(s.orders) == 0 {
+		return nil
+	}
+	return s.orders[0]
+}
+
+// Next consumes the best order in this stream.
+func (s *orderStream) Next() {
+	if len(s.orders) > 0 {
+		s.orders = s.orders[1:]
+	}
+}
+
+// Replace replaces the best order in this stream with the provided one (e.g. what's left of a partially filled order).
+func (s *orderStream) Replace(order *exchange.Order) {
+	if len(s.orders) > 0 {
+		s.orders[0] = order
+	}
+}
+
+// readBatch reads the next batch of orders from the index.
+func (s *orderStream) readBatch() {
+	if s.done || *s.budget <= 0 {
+		return
+	}
+
+	var iter storetypes.Iterator
+	switch {
+	case s.isBid && s.next == nil:
+		iter = storetypes.KVStoreReversePrefixIterator(s.store, s.prefix)
+	case s.isBid:
+		iter = s.store.ReverseIterator(s.prefix, s.next)
+	case s.next == nil:
+		iter = storetypes.KVStorePrefixIterator(s.store, s.prefix)
+	default:
+		iter = s.store.Iterator(s.next, storetypes.PrefixEndBytes(s.prefix))
+	}
+
+	var orderIDs []uint64
+	var lastKey []byte
+	for ; iter.Valid() && len(orderIDs) < autoMatchBatchSize && len(orderIDs) < *s.budget; iter.Next() {
+		key := iter.Key()
+		_, _, _, _, orderID, err := ParseIndexKeyMarketPriceToOrder(key)
+		if err != nil {
+			s.errs = append(s.errs, err)
+		} else {
+			orderIDs = append(orderIDs, orderID)
+		}
+		lastKey = key
+	}
+	if !iter.Valid() {
+		s.done = true
+	}
+	if err := iter.Close(); err != nil {
+		s.errs = append(s.errs, err)
+	}
+
+	*s.budget -= len(orderIDs)
+	switch {
+	case lastKey == nil:
+		s.done = true
+	case s.isBid:
+		s.next = lastKey
+	default:
+		s.next = append(lastKey, 0x00)
+	}
+
+	for _, orderID := range orderIDs {
+		order, err := s.k.getOrderFromStore(s.store, orderID)
+		switch {
+		case err != nil:
+			s.errs = append(s.errs, err)
+		case order == nil:
+			s.errs = append(s.errs, fmt.Errorf("order %d not found", orderID))
+		default:
+			s.orders = append(s.orders, order)
+		}
+	}
+}
+
+// getMarketOrderBooks gets the asset and price denoms of each order book in a market.
+// Only one index entry is read per order book.
+func getMarketOrderBooks(store storetypes.KVStore, marketID uint32) ([][2]string, error) {
+	var rv [][2]string
+	marketPrefix := GetIndexKeyPrefixMarketPriceToOrder(marketID)
+	start := marketPrefix
+	for {
+		iter := store.Iterator(start, storetypes.PrefixEndBytes(marketPrefix))
+		if !iter.Valid() {
+			return rv, iter.Close()
+		}
+		_, assetDenom, priceDenom, _, _, err := ParseIndexKeyMarketPriceToOrder(iter.Key())
+		if cerr := iter.Close(); cerr != nil {
+			return rv, cerr
+		}
+		if err != nil {
+			return rv, err
+		}
+		rv = append(rv, [2]string{assetDenom, priceDenom})
+		start = storetypes.PrefixEndBytes(GetIndexKeyPrefixMarketPriceToOrderBook(marketID, assetDenom, priceDenom))
+	}
+}
+
+// cmpUnitPrice compares the unit prices (price / assets) of the two provided orders.
+// Returns -1 if order1's unit price is less than order2's, 0 if they're equal, and 1 if order1's is greater.
+func cmpUnitPrice(order1, order2 *exchange.Order) int {
+	// a/b < c/d <=> a*d < c*b (for positive b and d).
+	left := order1.GetPrice().Amount.Mul(order2.GetAssets().Amount)
+	right := order2.GetPrice().Amount.Mul(order1.GetAssets().Amount)
+	switch {
+	case left.LT(right):
+		return -1
+	case left.GT(right):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ordersCross returns true if the bid order's unit price is at least the ask order's unit price.
+func ordersCross(askOrder, bidOrder *exchange.Order) bool {
+	return cmpUnitPrice(bidOrder, askOrder) >= 0
+}
+
+// getAutoMatchMarketIDs gets the ids of all the markets that have auto-match enabled.
+func (k Keeper) getAutoMatchMarketIDs(ctx sdk.Context) []uint32 {
+	store := k.getStore(ctx)
+	var rv []uint32
+	k.IterateKnownMarketIDs(ctx, func(marketID uint32) bool {
+		if isMarketAutoMatch(store, marketID) {
+			rv = append(rv, marketID)
+		}
+		return false
+	})
+	return rv
+}
+
+// AutoMatchOrders matches and settles the crossing orders in each market that has auto-match enabled.
+func (k Keeper) AutoMatchOrders(ctx sdk.Context) {
+	for _, marketID := range k.getAutoMatchMarketIDs(ctx) {
+		k.autoMatchMarketOrders(ctx, marketID)
+	}
+}
+
+// autoMatchMarketOrders matches and settles the crossing orders in the provided market.
+// Orders are matched by price, then by order id, one ask order and one bid order at a time.
+// Each order book is read from the best prices until the prices no longer cross, or a limit is reached.
+// Any problems are logged, and the orders involved are skipped until the next block.
+func (k Keeper) autoMatchMarketOrders(ctx sdk.Context, marketID uint32) {
+	store := k.getStore(ctx)
+	books, err := getMarketOrderBooks(store, marketID)
+	var errs []error
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	settlements := 0
+	budget := AutoMatchMaxOrdersReadPerMarket
+	for _, book := range books {
+		if settlements >= AutoMatchMaxSettlementsPerMarket || budget <= 0 {
+			break
+		}
+		asks := k.newOrderStream(store, GetIndexKeyPrefixMarketPriceToOrderBookSide(marketID, book[0], book[1], OrderKeyTypeAsk), false, &budget)
+		bids := k.newOrderStream(store, GetIndexKeyPrefixMarketPriceToOrderBookSide(marketID, book[0], book[1], OrderKeyTypeBid), true, &budget)
+		for settlements < AutoMatchMaxSettlementsPerMarket {
+			askOrder, bidOrder := asks.Peek(), bids.Peek()
+			if askOrder == nil || bidOrder == nil || !ordersCross(askOrder, bidOrder) {
+				break
+			}
+
+			// Expired orders are left for ExpireOrders to clean up.
+			if askOrder.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+				asks.Next()
+				continue
+			}
+			if bidOrder.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+				bids.Next()
+				continue
+			}
+
+			// An order that doesn't allow partial fulfillment can only be matched with something at least as big.
+			askAmt, bidAmt := askOrder.GetAssets().Amount, bidOrder.GetAssets().Amount
+			if askAmt.GT(bidAmt) && !askOrder.PartialFillAllowed() {
+				asks.Next()
+				continue
+			}
+			if bidAmt.GT(askAmt) && !bidOrder.PartialFillAllowed() {
+				bids.Next()
+				continue
+			}
+
+			partialLeft, serr := k.autoSettle(ctx, marketID, askOrder, bidOrder)
+			if serr != nil {
+				errs = append(errs, fmt.Errorf("could not settle ask order %d with bid order %d: %w",
+					askOrder.OrderId, bidOrder.OrderId, serr))
+				// Skip whichever order would have been partially filled, or both if neither.
+				switch {
+				case askAmt.GT(bidAmt):
+					asks.Next()
+				case bidAmt.GT(askAmt):
+					bids.Next()
+				default:
+					asks.Next()
+					bids.Next()
+				}
+				continue
+			}
+			settlements++
+
+			switch {
+			case partialLeft == nil:
+				asks.Next()
+				bids.Next()
+			case partialLeft.IsAskOrder():
+				asks.Replace(partialLeft)
+				bids.Next()
+			default:
+				bids.Replace(partialLeft)
+				asks.Next()
+			}
+		}
+		errs = append(errs, asks.errs...)
+		errs = append(errs, bids.errs...)
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered auto-matching orders for market %d:\n%v",
+			len(errs), marketID, errors.Join(errs...))
+	}
+}
+
+// autoSettle builds and closes a settlement between the provided ask and bid orders.
+// If one of the orders is partially filled, what's left of it is returned.
+// If there's an error, no state changes are made.
+func (k Keeper) autoSettle(ctx sdk.Context, marketID uint32, askOrder, bidOrder *exchange.Order) (*exchange.Order, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+	ratioGetter := func(denom string) (*exchange.FeeRatio, error) {
+		return getSellerSettlementRatio(store, marketID, denom)
+	}
+
+	settlement, err := exchange.BuildSettlement([]*exchange.Order{askOrder}, []*exchange.Order{bidOrder}, ratioGetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.closeSettlement(cacheCtx, store, marketID, settlement); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return settlement.PartialOrderLeft, nil
+}
@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+func (s *TestSuite) TestKeeper_AutoMatchOrders() {
+	noMarkerLog := "INF no marker found for asset denom \"apple\" module=x/exchange"
+	askOrder := func(orderID uint64, marketID uint32, assets, price int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:     marketID,
+			Seller:       sdk.AccAddress(fmt.Sprintf("seller%d______________", orderID)[:20]).String(),
+			Assets:       sdk.NewInt64Coin("apple", assets),
+			Price:        sdk.NewInt64Coin("peach", price),
+			AllowPartial: allowPartial,
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, assets, price int64, allowPartial bool) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:     marketID,
+			Buyer:        sdk.AccAddress(fmt.Sprintf("buyer%d_______________", orderID)[:20]).String(),
+			Assets:       sdk.NewInt64Coin("apple", assets),
+			Price:        sdk.NewInt64Coin("peach", price),
+			AllowPartial: allowPartial,
+		})
+	}
+
+	expTime := s.ctx.BlockTime()
+	expired := func(order *exchange.Order) *exchange.Order {
+		if order.IsAskOrder() {
+			order.GetAskOrder().ExpirationTime = &expTime
+		} else {
+			order.GetBidOrder().ExpirationTime = &expTime
+		}
+		return order
+	}
+
+	// manyOrders has more asks than are read from the index at once, all but the last crossing the bid.
+	var manyLogs []string
+	var manyOrders []*exchange.Order
+	for i := uint64(1); i <= 22; i++ {
+		manyOrders = append(manyOrders, askOrder(i, 1, 1, 5, false))
+		manyLogs = append(manyLogs, noMarkerLog)
+	}
+	manyOrders = append(manyOrders, askOrder(23, 1, 1, 6, false), bidOrder(24, 1, 23, 115, true))
+	// manyBids has more bids than are read from the index at once, all but the last crossing the ask.
+	var manyBids []*exchange.Order
+	for i := uint64(1); i <= 22; i++ {
+		manyBids = append(manyBids, bidOrder(i, 1, 1, 5, false))
+	}
+	manyBids = append(manyBids, bidOrder(23, 1, 1, 4, false), askOrder(24, 1, 21, 105, true))
+
+	tests := []struct {
+		name    string
+		markets []exchange.Market
+		orders  []*exchange.Order
+		expLeft []*exchange.Order
+		expLog  []string
+	}{
+		{
+			name: "no markets",
+		},
+		{
+			name:    "market without auto-match: crossing orders left alone",
+			markets: []exchange.Market{{MarketId: 1}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+		},
+		{
+			name:    "auto-match market: orders do not cross",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 49, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 49, false),
+			},
+		},
+		{
+			name:    "auto-match market: one ask fully filled by one bid",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+			expLog: []string{noMarkerLog},
+		},
+		{
+			name: "only the auto-match market is matched",
+			markets: []exchange.Market{
+				{MarketId: 1},
+				{MarketId: 2, AutoMatch: true},
+			},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+				askOrder(3, 2, 10, 50, false),
+				bidOrder(4, 2, 10, 55, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+			expLog: []string{noMarkerLog},
+		},
+		{
+			name:    "price-time priority with partial fills",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 60, true),
+				askOrder(2, 1, 10, 50, true),
+				askOrder(3, 1, 10, 50, true),
+				bidOrder(4, 1, 15, 90, true),
+				bidOrder(5, 1, 5, 20, true),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 60, true),
+				askOrder(3, 1, 5, 25, true),
+				bidOrder(5, 1, 5, 20, true),
+			},
+			expLog: []string{noMarkerLog, noMarkerLog},
+		},
+		{
+			name:    "order that cannot be partially filled is skipped",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				askOrder(2, 1, 5, 30, false),
+				bidOrder(3, 1, 5, 30, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+			},
+			expLog: []string{noMarkerLog},
+		},
+		{
+			name:    "expired orders are skipped",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders: []*exchange.Order{
+				expired(askOrder(1, 1, 10, 40, false)),
+				askOrder(2, 1, 10, 50, false),
+				expired(bidOrder(3, 1, 10, 60, false)),
+				bidOrder(4, 1, 10, 50, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 40, false),
+				bidOrder(3, 1, 10, 60, false),
+			},
+			expLog: []string{noMarkerLog},
+		},
+		{
+			name:    "more crossing orders than are read at once",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders:  manyOrders,
+			expLeft: []*exchange.Order{
+				askOrder(23, 1, 1, 6, false),
+				bidOrder(24, 1, 1, 5, true),
+			},
+			expLog: manyLogs,
+		},
+		{
+			name:    "more crossing bids than are read at once: earlier bids first",
+			markets: []exchange.Market{{MarketId: 1, AutoMatch: true}},
+			orders:  manyBids,
+			expLeft: []*exchange.Order{
+				bidOrder(22, 1, 1, 5, false),
+				bidOrder(23, 1, 1, 4, false),
+			},
+			expLog: manyLogs[:21],
+		},
+		{
+			name: "settlement error: orders left and error logged",
+			markets: []exchange.Market{{
+				MarketId:                  1,
+				AutoMatch:                 true,
+				FeeSellerSettlementRatios: []exchange.FeeRatio{{Price: sdk.NewInt64Coin("plum", 100), Fee: sdk.NewInt64Coin("plum", 1)}},
+			}},
+			orders: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+			expLeft: []*exchange.Order{
+				askOrder(1, 1, 10, 50, false),
+				bidOrder(2, 1, 10, 50, false),
+			},
+			expLog: []string{
+				"ERR 1 error(s) encountered auto-matching orders for market 1:",
+				"could not settle ask order 1 with bid order 2: no seller settlement fee ratio found for denom \"peach\" module=x/exchange",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			for _, market := range tc.markets {
+				s.requireCreateMarket(market)
+			}
+			store := s.getStore()
+			for _, order := range tc.orders {
+				s.requireSetOrderInStore(store, order)
+			}
+
+			kpr := s.k.WithAccountKeeper(s.accKeeper).
+				WithBankKeeper(NewMockBankKeeper()).
+				WithHoldKeeper(NewMockHoldKeeper()).
+				WithMarkerKeeper(NewMockMarkerKeeper())
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.AutoMatchOrders(s.ctx)
+			}
+			s.Require().NotPanics(testFunc, "AutoMatchOrders")
+
+			outputLog := s.getLogOutput("AutoMatchOrders")
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during AutoMatchOrders")
+
+			var actLeft []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				actLeft = append(actLeft, order)
+				return false
+			})
+			s.Require().NoError(err, "IterateOrders")
+			s.Assert().Equal(s.ordersStrings(tc.expLeft), s.ordersStrings(actLeft), "orders left after AutoMatchOrders")
+		})
+	}
+}
+
+// ordersStrings gets a short string for each of the provided orders with its id, type, assets, and price.
+func (s *TestSuite) ordersStrings(orders []*exchange.Order) []string {
+	if len(orders) == 0 {
+		return nil
+	}
+	rv := make([]string, len(orders))
+	for i, order := range orders {
+		rv[i] = fmt.Sprintf("%d %s: %s for %s", order.OrderId, order.GetOrderType(), order.GetAssets(), order.GetPrice())
+	}
+	return rv
+}
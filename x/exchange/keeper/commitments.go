@@ -93,6 +93,11 @@ func (k Keeper) GetCommitmentAmount(ctx sdk.Context, marketID uint32, addr sdk.A
 	return getCommitmentAmount(k.getStore(ctx), marketID, addr)
 }
 
+// commitmentHoldReason returns the reason used for the hold on funds committed to a market.
+func commitmentHoldReason(marketID uint32) string {
+	return fmt.Sprintf("x/exchange: commitment to %d", marketID)
+}
+
 // addCommitment commits the provided amount by the addr to the given market, and places a hold on them.
 // If the addr already has funds committed to the market, the provided amount is added to that.
 // Otherwise a new commitment record is created.
@@ -116,7 +121,7 @@ func (k Keeper) addCommitment(ctx sdk.Context, marketID uint32, addr sdk.AccAddr
 		}
 	}
 
-	err := k.holdKeeper.AddHold(ctx, addr, amount, fmt.Sprintf("x/exchange: commitment to %d", marketID))
+	err := k.holdKeeper.AddHold(ctx, addr, amount, exchange.ModuleName, commitmentHoldReason(marketID))
 	if err != nil {
 		return err
 	}
@@ -178,7 +183,7 @@ func (k Keeper) ReleaseCommitment(ctx sdk.Context, marketID uint32, addr sdk.Acc
 		toRelease = cur
 	}
 
-	err := k.holdKeeper.ReleaseHold(ctx, addr, toRelease)
+	err := k.holdKeeper.ReleaseHold(ctx, addr, toRelease, exchange.ModuleName, commitmentHoldReason(marketID))
 	if err != nil {
 		return err
 	}
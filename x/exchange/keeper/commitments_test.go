@@ -757,7 +757,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitment() {
 		s.Run(tc.name, func() {
 			var expHoldCalls HoldCalls
 			if tc.expHoldRel != nil {
-				expHoldCalls.ReleaseHold = append(expHoldCalls.ReleaseHold, NewReleaseHoldArgs(tc.addr, tc.expHoldRel))
+				expHoldCalls.ReleaseHold = append(expHoldCalls.ReleaseHold, NewReleaseHoldArgs(tc.addr, tc.expHoldRel, fmt.Sprintf("x/exchange: commitment to %d", tc.marketID)))
 			}
 
 			var expEvents sdk.Events
@@ -813,6 +813,9 @@ func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 		keeper.SetCommitmentAmount(store, 3, s.addr4, s.coins("34apple"))
 	}
 	eventTag := "justsomeothertag"
+	reason := func(marketID uint32) string {
+		return fmt.Sprintf("x/exchange: commitment to %d", marketID)
+	}
 
 	tests := []struct {
 		name            string
@@ -898,7 +901,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 			expEvents: sdk.Events{
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr2.String(), 2, s.coins("3apple"), eventTag)),
 			},
-			expRelHoldCalls: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("3apple"))},
+			expRelHoldCalls: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("3apple"), reason(2))},
 		},
 		{
 			name:      "one to release: full amount",
@@ -915,7 +918,7 @@ func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 			expEvents: sdk.Events{
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr2.String(), 2, s.coins("22apple"), eventTag)),
 			},
-			expRelHoldCalls: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("22apple"))},
+			expRelHoldCalls: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("22apple"), reason(2))},
 		},
 		{
 			name:       "five to release: some errors",
@@ -947,8 +950,8 @@ func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr4.String(), 2, s.coins("24apple"), eventTag)),
 			},
 			expRelHoldCalls: []*ReleaseHoldArgs{
-				NewReleaseHoldArgs(s.addr1, s.coins("1apple")),
-				NewReleaseHoldArgs(s.addr4, s.coins("24apple")),
+				NewReleaseHoldArgs(s.addr1, s.coins("1apple"), reason(2)),
+				NewReleaseHoldArgs(s.addr4, s.coins("24apple"), reason(2)),
 			},
 		},
 		{
@@ -975,10 +978,10 @@ func (s *TestSuite) TestKeeper_ReleaseCommitments() {
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr4.String(), 2, s.coins("6apple"), eventTag)),
 			},
 			expRelHoldCalls: []*ReleaseHoldArgs{
-				NewReleaseHoldArgs(s.addr1, s.coins("21apple")),
-				NewReleaseHoldArgs(s.addr2, s.coins("22apple")),
-				NewReleaseHoldArgs(s.addr4, s.coins("5apple")),
-				NewReleaseHoldArgs(s.addr4, s.coins("6apple")),
+				NewReleaseHoldArgs(s.addr1, s.coins("21apple"), reason(2)),
+				NewReleaseHoldArgs(s.addr2, s.coins("22apple"), reason(2)),
+				NewReleaseHoldArgs(s.addr4, s.coins("5apple"), reason(2)),
+				NewReleaseHoldArgs(s.addr4, s.coins("6apple"), reason(2)),
 			},
 		},
 	}
@@ -1874,7 +1877,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr2.String(), 2, s.coins("10apple"), "testtag1")),
 			},
 			expHoldCalls: HoldCalls{
-				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("10apple"))},
+				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("10apple"), holdReason(2))},
 			},
 			expErr: "input coins \"10apple\" does not equal output coins \"11apple\"",
 		},
@@ -1896,7 +1899,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 				s.untypeEvent(exchange.NewEventCommitmentReleased(s.addr3.String(), 4, s.coins("10apple"), "testtag2")),
 			},
 			expHoldCalls: HoldCalls{
-				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("10apple"))},
+				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("10apple"), holdReason(4))},
 				AddHold:     []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("10apple"), holdReason(4))},
 			},
 			expBankCalls: BankCalls{
@@ -1955,7 +1958,7 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 				},
 			},
 			expHoldCalls: HoldCalls{
-				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("10apple,10banana"))},
+				ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("10apple,10banana"), holdReason(4))},
 				AddHold:     []*AddHoldArgs{NewAddHoldArgs(s.addr5, s.coins("10apple,10banana"), holdReason(4))},
 			},
 			expBankCalls: BankCalls{
@@ -1999,11 +2002,11 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					NewReleaseHoldArgs(s.addr4, s.coins("10apple,4cherry")),
-					NewReleaseHoldArgs(s.addr1, s.coins("1cherry")),
-					NewReleaseHoldArgs(s.addr2, s.coins("2cherry")),
-					NewReleaseHoldArgs(s.addr3, s.coins("3cherry")),
-					NewReleaseHoldArgs(s.addr5, s.coins("5cherry")),
+					NewReleaseHoldArgs(s.addr4, s.coins("10apple,4cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr1, s.coins("1cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr2, s.coins("2cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr3, s.coins("3cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr5, s.coins("5cherry"), holdReason(2)),
 				},
 				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("10apple"), holdReason(2))},
 			},
@@ -2077,11 +2080,11 @@ func (s *TestSuite) TestKeeper_SettleCommitments() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					NewReleaseHoldArgs(s.addr1, s.coins("10apple,51banana,1cherry")),
-					NewReleaseHoldArgs(s.addr2, s.coins("10apple,2cherry,35orange")),
-					NewReleaseHoldArgs(s.addr3, s.coins("13apple,3cherry,50orange,41pear")),
-					NewReleaseHoldArgs(s.addr4, s.coins("10apple,4cherry")),
-					NewReleaseHoldArgs(s.addr5, s.coins("5cherry,500raspberry")),
+					NewReleaseHoldArgs(s.addr1, s.coins("10apple,51banana,1cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr2, s.coins("10apple,2cherry,35orange"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr3, s.coins("13apple,3cherry,50orange,41pear"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr4, s.coins("10apple,4cherry"), holdReason(2)),
+					NewReleaseHoldArgs(s.addr5, s.coins("5cherry,500raspberry"), holdReason(2)),
 				},
 				AddHold: []*AddHoldArgs{
 					NewAddHoldArgs(s.addr1, s.coins("77orange,65raspberry"), holdReason(2)),
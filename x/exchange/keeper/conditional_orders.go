@@ -0,0 +1,425 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// createConditionalOrderIndexEntries creates all the key/value index entries for a conditional order.
+func createConditionalOrderIndexEntries(order exchange.ConditionalOrder) []kv.Pair {
+	orderID := order.GetOrderID()
+	orderTypeByte := order.Order.GetOrderTypeByte()
+
+	rv := []kv.Pair{
+		{
+			Key:   MakeIndexKeyMarketToConditionalOrder(order.GetMarketID(), orderID),
+			Value: []byte{orderTypeByte},
+		},
+		{
+			Key:   MakeIndexKeyAddressToConditionalOrder(sdk.MustAccAddressFromBech32(order.Order.GetOwner()), orderID),
+			Value: []byte{orderTypeByte},
+		},
+		{
+			Key:   MakeIndexKeyTriggerPriceToConditionalOrder(order),
+			Value: []byte{orderTypeByte},
+		},
+	}
+
+	if expHeight := order.Order.GetExpirationHeight(); expHeight != 0 {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationHeightToOrder(expHeight, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+	if expTime := order.Order.GetExpirationTime(); expTime != nil {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationTimeToOrder(*expTime, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+
+	return rv
+}
+
+// getConditionalOrderFromStore looks up a conditional order from the store. Returns nil, nil if it does not exist.
+func (k Keeper) getConditionalOrderFromStore(store storetypes.KVStore, orderID uint64) (*exchange.ConditionalOrder, error) {
+	value := store.Get(MakeKeyConditionalOrder(orderID))
+	if len(value) == 0 {
+		return nil, nil
+	}
+	var rv exchange.ConditionalOrder
+	if err := k.cdc.Unmarshal(value, &rv); err != nil {
+		return nil, fmt.Errorf("failed to read conditional order %d: %w", orderID, err)
+	}
+	return &rv, nil
+}
+
+// setConditionalOrderInStore writes a conditional order to the store (along with its indexes).
+func (k Keeper) setConditionalOrderInStore(store storetypes.KVStore, order exchange.ConditionalOrder) error {
+	value, err := k.cdc.Marshal(&order)
+	if err != nil {
+		return fmt.Errorf("failed to marshal conditional order %d: %w", order.GetOrderID(), err)
+	}
+	store.Set(MakeKeyConditionalOrder(order.GetOrderID()), value)
+	for _, entry := range createConditionalOrderIndexEntries(order) {
+		store.Set(entry.Key, entry.Value)
+	}
+	return nil
+}
+
+// deleteAndDeIndexConditionalOrder deletes a conditional order from the store along with its indexes.
+func deleteAndDeIndexConditionalOrder(store storetypes.KVStore, order exchange.ConditionalOrder) {
+	store.Delete(MakeKeyConditionalOrder(order.GetOrderID()))
+	for _, entry := range createConditionalOrderIndexEntries(order) {
+		store.Delete(entry.Key)
+	}
+}
+
+// GetConditionalOrder gets a conditional order. Returns nil, nil if the conditional order does not exist.
+func (k Keeper) GetConditionalOrder(ctx sdk.Context, orderID uint64) (*exchange.ConditionalOrder, error) {
+	return k.getConditionalOrderFromStore(k.getStore(ctx), orderID)
+}
+
+// IterateConditionalOrders iterates over all conditional orders.
+// An error is returned if there was a problem reading an entry along the way.
+// Such a problem will not interrupt iteration.
+// The callback takes in the conditional order and should return whether to stop iterating.
+func (k Keeper) IterateConditionalOrders(ctx sdk.Context, cb func(order *exchange.ConditionalOrder) bool) error {
+	var errs []error
+	k.iterate(ctx, GetKeyPrefixConditionalOrder(), func(key, value []byte) bool {
+		var order exchange.ConditionalOrder
+		if err := k.cdc.Unmarshal(value, &order); err != nil {
+			orderID, _ := uint64FromBz(key)
+			errs = append(errs, fmt.Errorf("failed to read conditional order %d: %w", orderID, err))
+			return false
+		}
+		return cb(&order)
+	})
+	return errors.Join(errs...)
+}
+
+// IterateMarketConditionalOrders iterates over the market to conditional order index for a specific market.
+// The callback takes in the order id and order type byte and should return whether to stop iterating.
+func (k Keeper) IterateMarketConditionalOrders(ctx sdk.Context, marketID uint32, cb func(orderID uint64, orderTypeByte byte) bool) {
+	k.iterateOrderIndex(ctx, GetIndexKeyPrefixMarketToConditionalOrder(marketID), cb)
+}
+
+// GetPageOfMarketConditionalOrders gets a page of the conditional orders in a market.
+func (k Keeper) GetPageOfMarketConditionalOrders(
+	ctx sdk.Context,
+	marketID uint32,
+	pageReq *query.PageRequest,
+) ([]*exchange.ConditionalOrder, *query.PageResponse, error) {
+	store := k.getStore(ctx)
+	indexStore := prefix.NewStore(store, GetIndexKeyPrefixMarketToConditionalOrder(marketID))
+	var orders []*exchange.ConditionalOrder
+	pageResp, err := query.Paginate(indexStore, pageReq, func(key, _ []byte) error {
+		orderID, ok := ParseIndexKeySuffixOrderID(key)
+		if !ok {
+			return nil
+		}
+		order, err := k.getConditionalOrderFromStore(store, orderID)
+		if err != nil {
+			return err
+		}
+		if order != nil {
+			orders = append(orders, order)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return orders, pageResp, nil
+}
+
+// MaxConditionalOrdersPerOwner is the maximum number of conditional orders that an account can have at once.
+const MaxConditionalOrdersPerOwner = 100
+
+// countOwnerConditionalOrders counts the conditional orders owned by the provided address, stopping once max is reached.
+func countOwnerConditionalOrders(store storetypes.KVStore, owner sdk.AccAddress, max int) int {
+	iter := storetypes.KVStorePrefixIterator(store, GetIndexKeyPrefixAddressToConditionalOrder(owner))
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid() && count < max; iter.Next() {
+		count++
+	}
+	return count
+}
+
+// CreateConditionalOrder validates and stores a conditional order.
+// The provided order's id is ignored; a new one is reserved for it.
+// No fees are collected and no funds are put on hold until the order is placed.
+// An account can have at most MaxConditionalOrdersPerOwner conditional orders at once.
+func (k Keeper) CreateConditionalOrder(
+	ctx sdk.Context,
+	order exchange.Order,
+	triggerPrice sdkmath.LegacyDec,
+	direction exchange.TriggerDirection,
+	creationFee *sdk.Coin,
+) (uint64, error) {
+	if err := exchange.ValidateTriggerPrice(triggerPrice); err != nil {
+		return 0, err
+	}
+	if err := direction.Validate(); err != nil {
+		return 0, err
+	}
+
+	store := k.getStore(ctx)
+	if err := k.validateNewOrder(ctx, store, &order, creationFee); err != nil {
+		return 0, err
+	}
+	owner := sdk.MustAccAddressFromBech32(order.GetOwner())
+	if countOwnerConditionalOrders(store, owner, MaxConditionalOrdersPerOwner) >= MaxConditionalOrdersPerOwner {
+		return 0, fmt.Errorf("account %s already has the maximum of %d conditional orders", owner, MaxConditionalOrdersPerOwner)
+	}
+
+	order.OrderId = nextOrderID(store)
+	condOrder := exchange.NewConditionalOrder(&order, triggerPrice, direction, creationFee)
+	if err := k.setConditionalOrderInStore(store, *condOrder); err != nil {
+		return 0, fmt.Errorf("error storing conditional order: %w", err)
+	}
+
+	k.emitEvent(ctx, exchange.NewEventConditionalOrderCreated(&order))
+	return order.OrderId, nil
+}
+
+// cancelConditionalOrder deletes a conditional order if the signer is allowed to cancel it.
+// There are no holds to release for a conditional order.
+func (k Keeper) cancelConditionalOrder(ctx sdk.Context, order *exchange.ConditionalOrder, signer string) error {
+	if signer != order.Order.GetOwner() && !k.CanCancelOrdersForMarket(ctx, order.GetMarketID(), signer) {
+		return fmt.Errorf("account %s does not have permission to cancel order %d", signer, order.GetOrderID())
+	}
+
+	deleteAndDeIndexConditionalOrder(k.getStore(ctx), *order)
+	k.emitEvent(ctx, exchange.NewEventOrderCancelled(&order.Order, signer))
+	return nil
+}
+
+// expireConditionalOrder deletes a conditional order if it has expired as of the current block.
+// Returns an error if the conditional order does not exist.
+func (k Keeper) expireConditionalOrder(ctx sdk.Context, store storetypes.KVStore, orderID uint64) error {
+	order, err := k.getConditionalOrderFromStore(store, orderID)
+	if err != nil {
+		return err
+	}
+	if order == nil {
+		return fmt.Errorf("order %d not found", orderID)
+	}
+	if !order.Order.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+		return nil
+	}
+
+	deleteAndDeIndexConditionalOrder(store, *order)
+	k.emitEvent(ctx, exchange.NewEventOrderExpired(&order.Order))
+	return nil
+}
+
+// recordTradePrice updates the range of unit prices traded in the current block for the provided assets and price.
+func recordTradePrice(store storetypes.KVStore, marketID uint32, assets, price sdk.Coin) {
+	if !assets.Amount.IsPositive() {
+		return
+	}
+	unitPrice := sdkmath.LegacyNewDecFromInt(price.Amount).QuoInt(assets.Amount)
+	recordTradePriceRange(store, blockTradePrice{
+		marketID:   marketID,
+		assetDenom: assets.Denom,
+		priceDenom: price.Denom,
+		low:        unitPrice,
+		high:       unitPrice,
+	})
+}
+
+// recordTradePriceRange widens the range of unit prices traded in the current block to include the provided range.
+func recordTradePriceRange(store storetypes.KVStore, tp blockTradePrice) {
+	key := MakeKeyBlockTradePrice(tp.marketID, tp.assetDenom, tp.priceDenom)
+	low, high := tp.low, tp.high
+	if value := store.Get(key); len(value) > 0 {
+		curLow, curHigh, err := ParseBlockTradePriceStoreValue(value)
+		if err == nil {
+			if curLow.LT(low) {
+				low = curLow
+			}
+			if curHigh.GT(high) {
+				high = curHigh
+			}
+		}
+	}
+	store.Set(key, GetBlockTradePriceStoreValue(low, high))
+}
+
+// recordTradePrices records the unit prices of the provided net-asset-values as trades in the current block.
+func recordTradePrices(store storetypes.KVStore, marketID uint32, navs []exchange.NetAssetPrice) {
+	for _, nav := range navs {
+		recordTradePrice(store, marketID, nav.Assets, nav.Price)
+	}
+}
+
+// blockTradePrice is the range of unit prices traded in a block for a market's asset and price denoms.
+type blockTradePrice struct {
+	marketID   uint32
+	assetDenom string
+	priceDenom string
+	low        sdkmath.LegacyDec
+	high       sdkmath.LegacyDec
+}
+
+// popBlockTradePrices gets all the trade prices recorded in the current block and deletes them from state.
+func popBlockTradePrices(store storetypes.KVStore) ([]blockTradePrice, []error) {
+	var keys [][]byte
+	var rv []blockTradePrice
+	var errs []error
+
+	iter := storetypes.KVStorePrefixIterator(store, GetKeyPrefixBlockTradePrices())
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()
+		keys = append(keys, key)
+
+		marketID, assetDenom, priceDenom, err := ParseKeyBlockTradePrice(key)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		low, high, err := ParseBlockTradePriceStoreValue(iter.Value())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		rv = append(rv, blockTradePrice{
+			marketID:   marketID,
+			assetDenom: assetDenom,
+			priceDenom: priceDenom,
+			low:        low,
+			high:       high,
+		})
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return rv, errs
+}
+
+// placeConditionalOrder turns a triggered conditional order into a regular order.
+// The creation fee is collected, and holds are placed, just like a newly created order.
+// Nothing is changed in state if an error is returned.
+func (k Keeper) placeConditionalOrder(ctx sdk.Context, condOrder *exchange.ConditionalOrder) error {
+	cacheCtx, writeCache := ctx.CacheContext()
+	store := k.getStore(cacheCtx)
+	order := &condOrder.Order
+
+	if err := k.validateNewOrder(cacheCtx, store, order, condOrder.OrderCreationFee); err != nil {
+		return err
+	}
+	if err := k.collectOrderCreationFee(cacheCtx, order, condOrder.OrderCreationFee); err != nil {
+		return err
+	}
+	deleteAndDeIndexConditionalOrder(store, *condOrder)
+	if err := k.storeNewOrder(cacheCtx, store, order); err != nil {
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// getTriggeredConditionalOrderIDs gets the ids of up to maxIDs conditional orders whose trigger price was reached by the provided trade prices.
+// Only the entries in the trigger price to conditional order index that were crossed are read.
+// The returned order ids are sorted. The returned bool is true if there are more entries that weren't read.
+func getTriggeredConditionalOrderIDs(store storetypes.KVStore, tp blockTradePrice, maxIDs int) ([]uint64, bool) {
+	var rv []uint64
+	more := false
+	addOrderIDs := func(iter storetypes.Iterator) {
+		defer iter.Close()
+		for ; iter.Valid(); iter.Next() {
+			if len(rv) >= maxIDs {
+				more = true
+				return
+			}
+			if orderID, ok := ParseIndexKeySuffixOrderID(iter.Key()); ok {
+				rv = append(rv, orderID)
+			}
+		}
+	}
+
+	// An at-or-below order is triggered if the lowest trade price is at or below its trigger price.
+	belowPrefix := GetIndexKeyPrefixTriggerPriceToConditionalOrder(tp.marketID, tp.assetDenom, tp.priceDenom, exchange.TriggerDirection_at_or_below)
+	belowStart := GetIndexKeyPrefixTriggerPriceToConditionalOrderAt(tp.marketID, tp.assetDenom, tp.priceDenom, exchange.TriggerDirection_at_or_below, tp.low)
+	addOrderIDs(store.Iterator(belowStart, storetypes.PrefixEndBytes(belowPrefix)))
+
+	// An at-or-above order is triggered if the highest trade price is at or above its trigger price.
+	abovePrefix := GetIndexKeyPrefixTriggerPriceToConditionalOrder(tp.marketID, tp.assetDenom, tp.priceDenom, exchange.TriggerDirection_at_or_above)
+	aboveEnd := GetIndexKeyPrefixTriggerPriceToConditionalOrderAt(tp.marketID, tp.assetDenom, tp.priceDenom, exchange.TriggerDirection_at_or_above, tp.high)
+	addOrderIDs(store.Iterator(abovePrefix, storetypes.PrefixEndBytes(aboveEnd)))
+
+	// Place them in the order they were created.
+	sort.Slice(rv, func(i, j int) bool {
+		return rv[i] < rv[j]
+	})
+	return rv, more
+}
+
+// MaxConditionalOrdersTriggeredPerBlock is the maximum number of conditional orders that will be checked in a single block.
+// Any others that were triggered are left for the following blocks.
+const MaxConditionalOrdersTriggeredPerBlock = 1000
+
+// TriggerConditionalOrders places the conditional orders whose trigger condition was met by a trade in the current block.
+// Conditional orders that are triggered but cannot be placed are deleted.
+// The trade prices recorded during the block are cleared once done, except for those with conditional orders
+// that weren't checked because MaxConditionalOrdersTriggeredPerBlock was reached; those are kept for the next block.
+func (k Keeper) TriggerConditionalOrders(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	tradePrices, errs := popBlockTradePrices(store)
+
+	budget := MaxConditionalOrdersTriggeredPerBlock
+	for _, tp := range tradePrices {
+		if budget <= 0 {
+			recordTradePriceRange(store, tp)
+			continue
+		}
+		orderIDs, more := getTriggeredConditionalOrderIDs(store, tp, budget)
+		budget -= len(orderIDs)
+		if more {
+			recordTradePriceRange(store, tp)
+		}
+
+		for _, orderID := range orderIDs {
+			condOrder, err := k.getConditionalOrderFromStore(store, orderID)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if condOrder == nil {
+				continue
+			}
+
+			tradePrice, triggered := condOrder.IsTriggeredBy(tp.low, tp.high)
+			if !triggered {
+				continue
+			}
+
+			if err = k.placeConditionalOrder(ctx, condOrder); err != nil {
+				deleteAndDeIndexConditionalOrder(store, *condOrder)
+				k.emitEvent(ctx, exchange.NewEventConditionalOrderFailed(&condOrder.Order, err.Error()))
+				continue
+			}
+			k.emitEvent(ctx, exchange.NewEventConditionalOrderTriggered(&condOrder.Order, tradePrice))
+		}
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered triggering conditional orders:\n%v", len(errs), errors.Join(errs...))
+	}
+}
@@ -0,0 +1,443 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestKeeper_CreateConditionalOrder() {
+	askOrder := func(marketID uint32) *exchange.Order {
+		return exchange.NewOrder(0).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("50peach"),
+		})
+	}
+	bidOrder := func(marketID uint32) *exchange.Order {
+		return exchange.NewOrder(0).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("50peach"),
+		})
+	}
+
+	tests := []struct {
+		name         string
+		setup        func()
+		order        *exchange.Order
+		triggerPrice sdkmath.LegacyDec
+		direction    exchange.TriggerDirection
+		creationFee  *sdk.Coin
+		expOrderID   uint64
+		expErr       string
+	}{
+		{
+			name:         "trigger price zero",
+			order:        askOrder(1),
+			triggerPrice: sdkmath.LegacyZeroDec(),
+			direction:    exchange.TriggerDirection_at_or_below,
+			expErr:       "invalid trigger price 0.000000000000000000: must be positive",
+		},
+		{
+			name:         "unspecified direction",
+			order:        askOrder(1),
+			triggerPrice: sdkmath.LegacyOneDec(),
+			expErr:       "trigger direction is unspecified",
+		},
+		{
+			name:         "market does not exist",
+			order:        askOrder(2),
+			triggerPrice: sdkmath.LegacyOneDec(),
+			direction:    exchange.TriggerDirection_at_or_below,
+			expErr:       "market 2 does not exist",
+		},
+		{
+			name: "creation fee required but not provided",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:         1,
+					AcceptingOrders:  true,
+					FeeCreateBidFlat: s.coins("3fig"),
+				})
+			},
+			order:        bidOrder(1),
+			triggerPrice: sdkmath.LegacyOneDec(),
+			direction:    exchange.TriggerDirection_at_or_above,
+			expErr:       "no bid order creation fee provided, must be one of: 3fig",
+		},
+		{
+			name: "owner already has the maximum conditional orders",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true})
+				for i := 1; i <= keeper.MaxConditionalOrdersPerOwner; i++ {
+					order := askOrder(1)
+					order.OrderId = uint64(i)
+					condOrder := exchange.NewConditionalOrder(order, sdkmath.LegacyOneDec(), exchange.TriggerDirection_at_or_below, nil)
+					s.Require().NoError(s.k.SetConditionalOrderInStore(s.getStore(), *condOrder), "SetConditionalOrderInStore(%d)", i)
+				}
+			},
+			order:        askOrder(1),
+			triggerPrice: sdkmath.LegacyOneDec(),
+			direction:    exchange.TriggerDirection_at_or_below,
+			expErr:       "account " + s.addr1.String() + " already has the maximum of 100 conditional orders",
+		},
+		{
+			name: "ask order",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true})
+				keeper.SetLastOrderID(s.getStore(), 5)
+			},
+			order:        askOrder(1),
+			triggerPrice: sdkmath.LegacyMustNewDecFromStr("4.5"),
+			direction:    exchange.TriggerDirection_at_or_below,
+			expOrderID:   6,
+		},
+		{
+			name: "bid order with creation fee",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{
+					MarketId:         1,
+					AcceptingOrders:  true,
+					FeeCreateBidFlat: s.coins("3fig"),
+				})
+				keeper.SetLastOrderID(s.getStore(), 77)
+			},
+			order:        bidOrder(1),
+			triggerPrice: sdkmath.LegacyMustNewDecFromStr("5.5"),
+			direction:    exchange.TriggerDirection_at_or_above,
+			creationFee:  s.coinP("3fig"),
+			expOrderID:   78,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			var expCondOrder *exchange.ConditionalOrder
+			if len(tc.expErr) == 0 {
+				expOrder := *tc.order
+				expOrder.OrderId = tc.expOrderID
+				expCondOrder = exchange.NewConditionalOrder(&expOrder, tc.triggerPrice, tc.direction, tc.creationFee)
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventConditionalOrderCreated(&expOrder)))
+			}
+
+			bankKeeper := NewMockBankKeeper()
+			holdKeeper := NewMockHoldKeeper()
+			kpr := s.k.WithAccountKeeper(s.accKeeper).WithBankKeeper(bankKeeper).WithHoldKeeper(holdKeeper)
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				orderID, err = kpr.CreateConditionalOrder(ctx, *tc.order, tc.triggerPrice, tc.direction, tc.creationFee)
+			}
+			s.Require().NotPanics(testFunc, "CreateConditionalOrder")
+			s.assertErrorValue(err, tc.expErr, "CreateConditionalOrder error")
+			s.Assert().Equal(tc.expOrderID, orderID, "CreateConditionalOrder order id")
+			s.assertEqualEvents(expEvents, em.Events(), "CreateConditionalOrder events")
+			s.assertBankKeeperCalls(bankKeeper, BankCalls{}, "CreateConditionalOrder")
+			s.assertHoldKeeperCalls(holdKeeper, HoldCalls{}, "CreateConditionalOrder")
+
+			if expCondOrder == nil {
+				return
+			}
+			actCondOrder, err := s.k.GetConditionalOrder(s.ctx, tc.expOrderID)
+			s.Require().NoError(err, "GetConditionalOrder(%d)", tc.expOrderID)
+			s.Assert().Equal(expCondOrder, actCondOrder, "GetConditionalOrder(%d)", tc.expOrderID)
+			order, err := s.k.GetOrder(s.ctx, tc.expOrderID)
+			s.Require().NoError(err, "GetOrder(%d)", tc.expOrderID)
+			s.Assert().Nil(order, "GetOrder(%d)", tc.expOrderID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_CancelOrder_ConditionalOrder() {
+	condOrder := exchange.NewConditionalOrder(
+		exchange.NewOrder(3).WithBid(&exchange.BidOrder{
+			MarketId: 1,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("50peach"),
+		}),
+		sdkmath.LegacyOneDec(), exchange.TriggerDirection_at_or_above, nil)
+
+	tests := []struct {
+		name   string
+		signer string
+		expErr string
+	}{
+		{
+			name:   "signer not allowed",
+			signer: s.addr1.String(),
+			expErr: "account " + s.addr1.String() + " does not have permission to cancel order 3",
+		},
+		{
+			name:   "signer is owner",
+			signer: s.addr2.String(),
+		},
+		{
+			name:   "signer is authority",
+			signer: s.k.GetAuthority(),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true})
+			assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+				return s.k.SetConditionalOrderInStore(s.getStore(), *condOrder)
+			}, "SetConditionalOrderInStore")
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderCancelled(&condOrder.Order, tc.signer)))
+			}
+
+			holdKeeper := NewMockHoldKeeper()
+			kpr := s.k.WithHoldKeeper(holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = kpr.CancelOrder(ctx, 3, tc.signer)
+			}
+			s.Require().NotPanics(testFunc, "CancelOrder")
+			s.assertErrorValue(err, tc.expErr, "CancelOrder error")
+			s.assertEqualEvents(expEvents, em.Events(), "CancelOrder events")
+			s.assertHoldKeeperCalls(holdKeeper, HoldCalls{}, "CancelOrder")
+
+			actCondOrder, err := s.k.GetConditionalOrder(s.ctx, 3)
+			s.Require().NoError(err, "GetConditionalOrder(3)")
+			if len(tc.expErr) > 0 {
+				s.Assert().NotNil(actCondOrder, "GetConditionalOrder(3) after failed cancel")
+			} else {
+				s.Assert().Nil(actCondOrder, "GetConditionalOrder(3) after cancel")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_TriggerConditionalOrders() {
+	reason := func(orderID uint64) string {
+		return fmt.Sprintf("x/exchange: order %d", orderID)
+	}
+	askOrder := func(orderID uint64, marketID uint32, assets string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin("50peach"),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    s.addr2.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("50peach"),
+		})
+	}
+	condOrder := func(order *exchange.Order, triggerPrice string, direction exchange.TriggerDirection) *exchange.ConditionalOrder {
+		return exchange.NewConditionalOrder(order, sdkmath.LegacyMustNewDecFromStr(triggerPrice), direction, nil)
+	}
+	trade := func(marketID uint32, assets, price string) func() {
+		return func() {
+			keeper.RecordTradePrice(s.getStore(), marketID, s.coin(assets), s.coin(price))
+		}
+	}
+	below, above := exchange.TriggerDirection_at_or_below, exchange.TriggerDirection_at_or_above
+
+	tests := []struct {
+		name          string
+		markets       []exchange.Market
+		condOrders    []*exchange.ConditionalOrder
+		trades        []func()
+		expPlaced     []*exchange.Order
+		expCondLeft   []uint64
+		expEvents     func() sdk.Events
+		holdKeeper    *MockHoldKeeper
+		expHoldCalls  HoldCalls
+		expLogContain string
+	}{
+		{
+			name:        "no trades",
+			markets:     []exchange.Market{{MarketId: 1, AcceptingOrders: true}},
+			condOrders:  []*exchange.ConditionalOrder{condOrder(askOrder(1, 1, "10apple"), "100", below)},
+			expCondLeft: []uint64{1},
+		},
+		{
+			name: "only matching orders triggered",
+			markets: []exchange.Market{
+				{MarketId: 1, AcceptingOrders: true},
+				{MarketId: 2, AcceptingOrders: true},
+			},
+			condOrders: []*exchange.ConditionalOrder{
+				condOrder(askOrder(1, 1, "10apple"), "5", below),
+				condOrder(bidOrder(2, 1), "7", above),
+				condOrder(askOrder(3, 1, "10acorn"), "1", above),
+				condOrder(askOrder(4, 2, "10apple"), "10", below),
+				condOrder(bidOrder(5, 1), "6", above),
+				condOrder(askOrder(6, 1, "10apple"), "3.99", below),
+				condOrder(bidOrder(7, 1), "6.01", above),
+			},
+			trades: []func(){
+				trade(1, "10apple", "60peach"),
+				trade(1, "10apple", "40peach"),
+			},
+			expPlaced:   []*exchange.Order{askOrder(1, 1, "10apple"), bidOrder(5, 1)},
+			expCondLeft: []uint64{2, 3, 4, 6, 7},
+			expEvents: func() sdk.Events {
+				return sdk.Events{
+					s.untypeEvent(exchange.NewEventOrderCreated(askOrder(1, 1, "10apple"))),
+					s.untypeEvent(exchange.NewEventConditionalOrderTriggered(askOrder(1, 1, "10apple"), sdkmath.LegacyNewDec(4))),
+					s.untypeEvent(exchange.NewEventOrderCreated(bidOrder(5, 1))),
+					s.untypeEvent(exchange.NewEventConditionalOrderTriggered(bidOrder(5, 1), sdkmath.LegacyNewDec(6))),
+				}
+			},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{
+				NewAddHoldArgs(s.addr1, s.coins("10apple"), reason(1)),
+				NewAddHoldArgs(s.addr2, s.coins("50peach"), reason(5)),
+			}},
+		},
+		{
+			name:       "triggered order cannot be placed",
+			markets:    []exchange.Market{{MarketId: 1, AcceptingOrders: false}},
+			condOrders: []*exchange.ConditionalOrder{condOrder(askOrder(1, 1, "10apple"), "5", below)},
+			trades:     []func(){trade(1, "10apple", "50peach")},
+			expEvents: func() sdk.Events {
+				return sdk.Events{
+					s.untypeEvent(exchange.NewEventConditionalOrderFailed(askOrder(1, 1, "10apple"), "market 1 is not accepting orders")),
+				}
+			},
+		},
+		{
+			name:       "triggered order funds cannot be put on hold",
+			markets:    []exchange.Market{{MarketId: 1, AcceptingOrders: true}},
+			condOrders: []*exchange.ConditionalOrder{condOrder(askOrder(1, 1, "10apple"), "5", below)},
+			trades:     []func(){trade(1, "10apple", "50peach")},
+			holdKeeper: NewMockHoldKeeper().WithAddHoldResults("injected problem"),
+			expEvents: func() sdk.Events {
+				return sdk.Events{
+					s.untypeEvent(exchange.NewEventConditionalOrderFailed(askOrder(1, 1, "10apple"), "error placing hold for ask order 1: injected problem")),
+				}
+			},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("10apple"), reason(1))}},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			for _, market := range tc.markets {
+				s.requireCreateMarket(market)
+			}
+			for _, co := range tc.condOrders {
+				assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+					return s.k.SetConditionalOrderInStore(s.getStore(), *co)
+				}, "SetConditionalOrderInStore(%d)", co.GetOrderID())
+			}
+			for _, tr := range tc.trades {
+				tr()
+			}
+
+			var expEvents sdk.Events
+			if tc.expEvents != nil {
+				expEvents = tc.expEvents()
+			}
+
+			holdKeeper := tc.holdKeeper
+			if holdKeeper == nil {
+				holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithAccountKeeper(s.accKeeper).WithBankKeeper(NewMockBankKeeper()).WithHoldKeeper(holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.TriggerConditionalOrders(ctx)
+			}
+			s.Require().NotPanics(testFunc, "TriggerConditionalOrders")
+			s.assertEqualEvents(expEvents, em.Events(), "TriggerConditionalOrders events")
+			s.assertHoldKeeperCalls(holdKeeper, tc.expHoldCalls, "TriggerConditionalOrders")
+			s.Assert().Empty(s.getLogOutput("TriggerConditionalOrders"), "TriggerConditionalOrders log output")
+
+			var actPlaced []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				actPlaced = append(actPlaced, order)
+				return false
+			})
+			s.Require().NoError(err, "IterateOrders")
+			s.Assert().Equal(s.ordersStrings(tc.expPlaced), s.ordersStrings(actPlaced), "orders placed")
+
+			var actCondLeft []uint64
+			err = s.k.IterateConditionalOrders(s.ctx, func(order *exchange.ConditionalOrder) bool {
+				actCondLeft = append(actCondLeft, order.GetOrderID())
+				return false
+			})
+			s.Require().NoError(err, "IterateConditionalOrders")
+			s.Assert().Equal(tc.expCondLeft, actCondLeft, "conditional orders left")
+
+			tradeStore := prefix.NewStore(s.getStore(), keeper.GetKeyPrefixBlockTradePrices())
+			iter := tradeStore.Iterator(nil, nil)
+			s.Assert().False(iter.Valid(), "block trade prices exist after TriggerConditionalOrders")
+			s.Require().NoError(iter.Close(), "iter.Close()")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_TriggerConditionalOrders_PerBlockLimit() {
+	s.clearExchangeState()
+	s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true})
+	total := keeper.MaxConditionalOrdersTriggeredPerBlock + 5
+	for i := 1; i <= total; i++ {
+		order := exchange.NewOrder(uint64(i)).WithAsk(&exchange.AskOrder{
+			MarketId: 1,
+			Seller:   s.addr1.String(),
+			Assets:   s.coin("10apple"),
+			Price:    s.coin("50peach"),
+		})
+		condOrder := exchange.NewConditionalOrder(order, sdkmath.LegacyNewDec(5), exchange.TriggerDirection_at_or_below, nil)
+		s.Require().NoError(s.k.SetConditionalOrderInStore(s.getStore(), *condOrder), "SetConditionalOrderInStore(%d)", i)
+	}
+	keeper.RecordTradePrice(s.getStore(), 1, s.coin("10apple"), s.coin("40peach"))
+
+	kpr := s.k.WithAccountKeeper(s.accKeeper).WithBankKeeper(NewMockBankKeeper()).WithHoldKeeper(NewMockHoldKeeper())
+	countLeft := func() (int, bool) {
+		count := 0
+		err := s.k.IterateConditionalOrders(s.ctx, func(_ *exchange.ConditionalOrder) bool {
+			count++
+			return false
+		})
+		s.Require().NoError(err, "IterateConditionalOrders")
+		iter := prefix.NewStore(s.getStore(), keeper.GetKeyPrefixBlockTradePrices()).Iterator(nil, nil)
+		defer iter.Close()
+		return count, iter.Valid()
+	}
+
+	kpr.TriggerConditionalOrders(s.ctx.WithEventManager(sdk.NewEventManager()))
+	left, hasTradePrices := countLeft()
+	s.Assert().Equal(5, left, "conditional orders left after first block")
+	s.Assert().True(hasTradePrices, "block trade prices kept after first block")
+
+	kpr.TriggerConditionalOrders(s.ctx.WithEventManager(sdk.NewEventManager()))
+	left, hasTradePrices = countLeft()
+	s.Assert().Equal(0, left, "conditional orders left after second block")
+	s.Assert().False(hasTradePrices, "block trade prices kept after second block")
+}
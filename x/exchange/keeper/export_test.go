@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -52,6 +54,21 @@ func (k Keeper) SetOrderInStore(store storetypes.KVStore, order exchange.Order)
 	return k.setOrderInStore(store, order)
 }
 
+// SetConditionalOrderInStore is a test-only exposure of setConditionalOrderInStore.
+func (k Keeper) SetConditionalOrderInStore(store storetypes.KVStore, order exchange.ConditionalOrder) error {
+	return k.setConditionalOrderInStore(store, order)
+}
+
+// SetTradeInStore is a test-only exposure of setTradeInStore.
+func (k Keeper) SetTradeInStore(store storetypes.KVStore, trade exchange.Trade) error {
+	return k.setTradeInStore(store, trade)
+}
+
+// RecordTrades is a test-only exposure of recordTrades.
+func (k Keeper) RecordTrades(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement, navs []exchange.NetAssetPrice) {
+	k.recordTrades(ctx, store, marketID, settlement, navs)
+}
+
 // GetOrderStoreKeyValue is a test-only exposure of getOrderStoreKeyValue.
 func (k Keeper) GetOrderStoreKeyValue(order exchange.Order) ([]byte, []byte, error) {
 	return k.getOrderStoreKeyValue(order)
@@ -123,6 +140,8 @@ var (
 	SetUserSettlementAllowed = setUserSettlementAllowed
 	// SetMarketAcceptingCommitments is a test-only exposure of setMarketAcceptingCommitments.
 	SetMarketAcceptingCommitments = setMarketAcceptingCommitments
+	// SetMarketAutoMatch is a test-only exposure of setMarketAutoMatch.
+	SetMarketAutoMatch = setMarketAutoMatch
 	// GrantPermissions is a test-only exposure of grantPermissions.
 	GrantPermissions = grantPermissions
 	// SetReqAttrsAsk is a test-only exposure of setReqAttrsAsk.
@@ -142,7 +161,14 @@ var (
 	CreateConstantIndexEntries = createConstantIndexEntries
 	// CreateMarketExternalIDToOrderEntry is a test-only exposure of createMarketExternalIDToOrderEntry.
 	CreateMarketExternalIDToOrderEntry = createMarketExternalIDToOrderEntry
+	// RecordTradePrice is a test-only exposure of recordTradePrice.
+	RecordTradePrice = recordTradePrice
 
 	// SetCommitmentAmount is a test-only exposure of setCommitmentAmount.
 	SetCommitmentAmount = setCommitmentAmount
 )
+
+// GetExpiredOrderIDs is a test-only exposure of getExpiredOrderIDs.
+func GetExpiredOrderIDs(store storetypes.KVStore, blockHeight int64, blockTime time.Time, maxIDs int) []uint64 {
+	return getExpiredOrderIDs(store, blockHeight, blockTime, maxIDs)
+}
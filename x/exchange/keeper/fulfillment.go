@@ -56,7 +56,7 @@ func (k Keeper) FillBids(ctx sdk.Context, msg *exchange.MsgFillBidsRequest) erro
 		return err
 	}
 
-	orders, oerrs := k.getBidOrders(store, marketID, msg.BidOrderIds, msg.Seller)
+	orders, oerrs := k.getBidOrders(ctx, store, marketID, msg.BidOrderIds, msg.Seller)
 	if oerrs != nil {
 		return oerrs
 	}
@@ -152,7 +152,7 @@ func (k Keeper) FillAsks(ctx sdk.Context, msg *exchange.MsgFillAsksRequest) erro
 		return err
 	}
 
-	orders, oerrs := k.getAskOrders(store, marketID, msg.AskOrderIds, msg.Buyer)
+	orders, oerrs := k.getAskOrders(ctx, store, marketID, msg.AskOrderIds, msg.Buyer)
 	if oerrs != nil {
 		return oerrs
 	}
@@ -235,8 +235,8 @@ func (k Keeper) SettleOrders(ctx sdk.Context, req *exchange.MsgMarketSettleReque
 		return err
 	}
 
-	askOrders, aoerr := k.getAskOrders(store, req.MarketId, req.AskOrderIds, "")
-	bidOrders, boerr := k.getBidOrders(store, req.MarketId, req.BidOrderIds, "")
+	askOrders, aoerr := k.getAskOrders(ctx, store, req.MarketId, req.AskOrderIds, "")
+	bidOrders, boerr := k.getBidOrders(ctx, store, req.MarketId, req.BidOrderIds, "")
 	if aoerr != nil || boerr != nil {
 		return errors.Join(aoerr, boerr)
 	}
@@ -320,6 +320,8 @@ func (k Keeper) closeSettlement(ctx sdk.Context, store storetypes.KVStore, marke
 	// Record the NAVs
 	navs := exchange.GetNAVs(settlement)
 	k.recordNAVs(ctx, marketID, navs)
+	recordTradePrices(store, marketID, navs)
+	k.recordTrades(ctx, store, marketID, settlement, navs)
 
 	return nil
 }
@@ -376,6 +378,11 @@ func (k Keeper) recordNAVs(ctx sdk.Context, marketID uint32, navs []exchange.Net
 			k.emitNAVEvents(ctx, denom, markerNAVs[denom], source)
 			continue
 		}
+		if len(marker.AddressListForPermission(markertypes.Access_Nav)) > 0 {
+			k.logInfof(ctx, "not recording net-asset-values for asset denom %q: it has nav reporters", denom)
+			k.emitNAVEvents(ctx, denom, markerNAVs[denom], source)
+			continue
+		}
 
 		err = k.markerKeeper.AddSetNetAssetValues(ctx, marker, markerNAVs[denom], source)
 		if err != nil {
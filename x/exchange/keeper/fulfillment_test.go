@@ -12,6 +12,7 @@ import (
 func (s *TestSuite) TestKeeper_FillBids() {
 	appleMarker := s.markerAccount("100000000000apple")
 	acornMarker := s.markerAccount("100000000000acorn")
+	expTime := s.ctx.BlockTime()
 
 	tests := []struct {
 		name           string
@@ -204,6 +205,26 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			},
 			expErr: "order 8 has the same buyer " + s.addr1.String() + " as the requested seller",
 		},
+		{
+			name: "order has expired",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithBid(&exchange.BidOrder{
+					MarketId:       1,
+					Buyer:          s.addr2.String(),
+					Assets:         s.coin("1apple"),
+					Price:          s.coin("1plum"),
+					ExpirationTime: &expTime,
+				}))
+			},
+			msg: exchange.MsgFillBidsRequest{
+				Seller:      s.addr1.String(),
+				MarketId:    1,
+				TotalAssets: s.coins("1apple"),
+				BidOrderIds: []uint64{8},
+			},
+			expErr: "order 8 has expired",
+		},
 		{
 			name: "multiple problems with orders",
 			setup: func() {
@@ -338,7 +359,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{1},
 			},
 			expErr:       "error releasing hold for bid order 1: no plum for you",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("6plum"), "x/exchange: order 1")}},
 		},
 		{
 			name:       "error transferring assets",
@@ -356,7 +377,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{1},
 			},
 			expErr:       "first transfer error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("6plum"), "x/exchange: order 1")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr4},
 				SendCoins: []*SendCoinsArgs{
@@ -382,7 +403,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{1},
 			},
 			expErr:       "second transfer error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("6plum"), "x/exchange: order 1")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr4},
 				SendCoins: []*SendCoinsArgs{
@@ -413,7 +434,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				BidOrderIds: []uint64{99},
 			},
 			expErr:       "error collecting fees for market 2: first fake error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("2fig,6plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("2fig,6plum"), "x/exchange: order 99")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr4},
 				SendCoins: []*SendCoinsArgs{
@@ -455,7 +476,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("6plum"), "x/exchange: order 99")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr4},
 				SendCoins: []*SendCoinsArgs{
@@ -495,7 +516,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -533,7 +554,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("12apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -564,7 +585,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("12apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -596,7 +617,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("184467440737095516150apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -629,7 +650,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -678,7 +699,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "10fig", MarketId: 3, ExternalId: "thirteen"},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("10fig,60plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("10fig,60plum"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr5},
 				SendCoins: []*SendCoinsArgs{
@@ -743,9 +764,9 @@ func (s *TestSuite) TestKeeper_FillBids() {
 				{OrderId: 17, Assets: "12apple", Price: "60plum", MarketId: 3},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr2, funds: s.coins("22fig,50prune")},
-				{addr: s.addr3, funds: s.coins("33prune")},
-				{addr: s.addr2, funds: s.coins("60plum")},
+				NewReleaseHoldArgs(s.addr2, s.coins("22fig,50prune"), "x/exchange: order 55"),
+				NewReleaseHoldArgs(s.addr3, s.coins("33prune"), "x/exchange: order 121"),
+				NewReleaseHoldArgs(s.addr2, s.coins("60plum"), "x/exchange: order 17"),
 			}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr2, s.addr3, s.addr1},
@@ -870,6 +891,7 @@ func (s *TestSuite) TestKeeper_FillBids() {
 func (s *TestSuite) TestKeeper_FillAsks() {
 	appleMarker := s.markerAccount("100000apple")
 	acornMarker := s.markerAccount("100000acorn")
+	expTime := s.ctx.BlockTime()
 
 	tests := []struct {
 		name           string
@@ -1066,6 +1088,26 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			},
 			expErr: "order 8 has the same seller " + s.addr1.String() + " as the requested buyer",
 		},
+		{
+			name: "order has expired",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1, AcceptingOrders: true, AllowUserSettlement: true})
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(8).WithAsk(&exchange.AskOrder{
+					MarketId:       1,
+					Seller:         s.addr2.String(),
+					Assets:         s.coin("1apple"),
+					Price:          s.coin("1plum"),
+					ExpirationTime: &expTime,
+				}))
+			},
+			msg: exchange.MsgFillAsksRequest{
+				Buyer:       s.addr1.String(),
+				MarketId:    1,
+				TotalPrice:  s.coin("1plum"),
+				AskOrderIds: []uint64{8},
+			},
+			expErr: "order 8 has expired",
+		},
 		{
 			name: "multiple problems with orders",
 			setup: func() {
@@ -1200,7 +1242,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{1},
 			},
 			expErr:       "error releasing hold for ask order 1: no apple for you",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("6apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("6apple"), "x/exchange: order 1")}},
 		},
 		{
 			name:       "error transferring assets",
@@ -1218,7 +1260,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{1},
 			},
 			expErr:       "first transfer error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("1apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("1apple"), "x/exchange: order 1")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr1},
 				SendCoins: []*SendCoinsArgs{
@@ -1244,7 +1286,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				AskOrderIds: []uint64{1},
 			},
 			expErr:       "second transfer error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("1apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("1apple"), "x/exchange: order 1")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr1},
 				SendCoins: []*SendCoinsArgs{
@@ -1276,7 +1318,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				BuyerSettlementFees: s.coins("2fig"),
 			},
 			expErr:       "error collecting fees for market 2: first fake error",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("2fig,1apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("2fig,1apple"), "x/exchange: order 99")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr1},
 				SendCoins: []*SendCoinsArgs{
@@ -1318,7 +1360,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 99, Assets: "1apple", Price: "6plum", MarketId: 2},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("1apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("1apple"), "x/exchange: order 99")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr4, s.addr1},
 				SendCoins: []*SendCoinsArgs{
@@ -1358,7 +1400,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("12apple"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1396,7 +1438,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("12apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("12apple"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1427,7 +1469,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("12apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("12apple"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1458,7 +1500,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				{OrderId: 13, Assets: "184467440737095516150apple", Price: "60plum", MarketId: 6},
 			},
 			adlEvents:    sdk.Events{s.navSetEvent("184467440737095516150apple", "60plum", 6)},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("184467440737095516150apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("184467440737095516150apple"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1491,7 +1533,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", MarketId: 6},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("12apple"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1540,7 +1582,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 			expEvents: []*exchange.EventOrderFilled{
 				{OrderId: 13, Assets: "12apple", Price: "60plum", Fees: "8fig,2plum", MarketId: 3, ExternalId: "thirteen"},
 			},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("12apple,8fig")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("12apple,8fig"), "x/exchange: order 13")}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr5, s.addr2},
 				SendCoins: []*SendCoinsArgs{
@@ -1605,9 +1647,9 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 				{OrderId: 17, Assets: "12apple", Price: "60prune", MarketId: 3, Fees: "3prune"},
 			},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr2, funds: s.coins("5acorn,22fig")},
-				{addr: s.addr3, funds: s.coins("6apple")},
-				{addr: s.addr2, funds: s.coins("12apple")},
+				NewReleaseHoldArgs(s.addr2, s.coins("5acorn,22fig"), "x/exchange: order 55"),
+				NewReleaseHoldArgs(s.addr3, s.coins("6apple"), "x/exchange: order 121"),
+				NewReleaseHoldArgs(s.addr2, s.coins("12apple"), "x/exchange: order 17"),
 			}},
 			expBankCalls: BankCalls{
 				BlockedAddr: []sdk.AccAddress{s.addr1, s.addr2, s.addr3},
@@ -1730,6 +1772,7 @@ func (s *TestSuite) TestKeeper_FillAsks() {
 
 func (s *TestSuite) TestKeeper_SettleOrders() {
 	appleMarker := s.markerAccount("1000000000apple")
+	expTime := s.ctx.BlockTime()
 
 	tests := []struct {
 		name           string
@@ -1790,6 +1833,26 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				"order 6 market id 3 does not equal requested market id 1",
 			),
 		},
+		{
+			name: "expired orders",
+			setup: func() {
+				s.requireCreateMarket(exchange.Market{MarketId: 1})
+				store := s.getStore()
+				s.requireSetOrderInStore(store, exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Seller: s.addr1.String(),
+					ExpirationTime: &expTime,
+				}))
+				s.requireSetOrderInStore(store, exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+					Assets: s.coin("1apple"), Price: s.coin("6peach"), MarketId: 1, Buyer: s.addr2.String(),
+					ExpirationTime: &expTime,
+				}))
+			},
+			marketID:      1,
+			askOrderIDs:   []uint64{3},
+			bidOrderIDs:   []uint64{2},
+			expectPartial: false,
+			expErr:        s.joinErrs("order 3 has expired", "order 2 has expired"),
+		},
 		{
 			name: "errors building settlement",
 			setup: func() {
@@ -1877,9 +1940,9 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			),
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr1, funds: s.coins("4apple")},
-					{addr: s.addr2, funds: s.coins("8peach")},
-					{addr: s.addr3, funds: s.coins("8peach")},
+					NewReleaseHoldArgs(s.addr1, s.coins("4apple"), "x/exchange: order 3"),
+					NewReleaseHoldArgs(s.addr2, s.coins("8peach"), "x/exchange: order 2"),
+					NewReleaseHoldArgs(s.addr3, s.coins("8peach"), "x/exchange: order 4"),
 				},
 			},
 		},
@@ -1916,8 +1979,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			),
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr1, funds: s.coins("100fig,4apple")},
-					{addr: s.addr2, funds: s.coins("50grape,16peach")},
+					NewReleaseHoldArgs(s.addr1, s.coins("100fig,4apple"), "x/exchange: order 3"),
+					NewReleaseHoldArgs(s.addr2, s.coins("50grape,16peach"), "x/exchange: order 2"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -1974,8 +2037,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 				"already in use by order 5: cannot be used for order 8",
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr4, funds: s.coins("5peach")},
-					{addr: s.addr5, funds: s.coins("1apple")},
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
+					NewReleaseHoldArgs(s.addr5, s.coins("1apple"), "x/exchange: order 8"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2011,8 +2074,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("1apple")},
-					{addr: s.addr4, funds: s.coins("5peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("1apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2057,8 +2120,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			adlEvents: sdk.Events{s.navSetEvent("1apple", "5peach", 1)},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("1apple")},
-					{addr: s.addr4, funds: s.coins("5peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("1apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2096,8 +2159,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			adlEvents: sdk.Events{s.navSetEvent("1apple", "5peach", 1)},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("1apple")},
-					{addr: s.addr4, funds: s.coins("5peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("1apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2135,8 +2198,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("184467440737095516150apple")},
-					{addr: s.addr4, funds: s.coins("5peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("184467440737095516150apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
 				},
 			},
 			adlEvents: sdk.Events{s.navSetEvent("184467440737095516150apple", "5peach", 1)},
@@ -2174,8 +2237,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("1apple")},
-					{addr: s.addr4, funds: s.coins("5peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("1apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("5peach"), "x/exchange: order 5"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2226,8 +2289,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("10apple")},
-					{addr: s.addr4, funds: s.coins("65peach")},
+					NewReleaseHoldArgs(s.addr3, s.coins("10apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr4, s.coins("65peach"), "x/exchange: order 5"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2300,8 +2363,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			}),
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr3, funds: s.coins("40peach")},
-					{addr: s.addr5, funds: s.coins("14fig,7apple")},
+					NewReleaseHoldArgs(s.addr3, s.coins("40peach"), "x/exchange: order 2"),
+					NewReleaseHoldArgs(s.addr5, s.coins("14fig,7apple"), "x/exchange: order 1"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2363,8 +2426,8 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			}),
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr5, funds: s.coins("7apple")},
-					{addr: s.addr3, funds: s.coins("14fig,35peach")},
+					NewReleaseHoldArgs(s.addr5, s.coins("7apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr3, s.coins("14fig,35peach"), "x/exchange: order 2"),
 				},
 			},
 			expBankCalls: BankCalls{
@@ -2422,11 +2485,11 @@ func (s *TestSuite) TestKeeper_SettleOrders() {
 			},
 			expHoldCalls: HoldCalls{
 				ReleaseHold: []*ReleaseHoldArgs{
-					{addr: s.addr4, funds: s.coins("75apple")},
-					{addr: s.addr1, funds: s.coins("25apple")},
-					{addr: s.addr3, funds: s.coins("60peach")},
-					{addr: s.addr2, funds: s.coins("40peach")},
-					{addr: s.addr5, funds: s.coins("50peach")},
+					NewReleaseHoldArgs(s.addr4, s.coins("75apple"), "x/exchange: order 77"),
+					NewReleaseHoldArgs(s.addr1, s.coins("25apple"), "x/exchange: order 1"),
+					NewReleaseHoldArgs(s.addr3, s.coins("60peach"), "x/exchange: order 7"),
+					NewReleaseHoldArgs(s.addr2, s.coins("40peach"), "x/exchange: order 6"),
+					NewReleaseHoldArgs(s.addr5, s.coins("50peach"), "x/exchange: order 88"),
 				},
 			},
 			expBankCalls: BankCalls{
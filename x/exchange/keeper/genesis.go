@@ -46,6 +46,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		}
 	}
 
+	for i, order := range genState.ConditionalOrders {
+		if err := k.setConditionalOrderInStore(store, order); err != nil {
+			panic(fmt.Errorf("failed to store ConditionalOrders[%d]: %w", i, err))
+		}
+		if order.GetOrderID() > maxOrderID {
+			maxOrderID = order.GetOrderID()
+		}
+	}
+
 	if genState.LastOrderId < maxOrderID {
 		panic(fmt.Errorf("last order id %d is less than largest order id %d", genState.LastOrderId, maxOrderID))
 	}
@@ -69,6 +78,12 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *exchange.GenesisState) {
 		recordHold(payment.Source, payment.SourceAmount)
 	}
 
+	for i, trade := range genState.Trades {
+		if err := k.setTradeInStore(store, trade); err != nil {
+			panic(fmt.Errorf("failed to store Trades[%d]: %w", i, err))
+		}
+	}
+
 	// Make sure all the needed funds have holds on them. These should have been placed during initialization of the hold module.
 	for _, addr := range holdAddrs {
 		for _, reqAmt := range holdAmounts[addr] {
@@ -105,6 +120,14 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 		k.logErrorf(ctx, "error (ignored) while reading orders: %v", err)
 	}
 
+	err = k.IterateConditionalOrders(ctx, func(order *exchange.ConditionalOrder) bool {
+		genState.ConditionalOrders = append(genState.ConditionalOrders, *order)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading conditional orders: %v", err)
+	}
+
 	k.IterateCommitments(ctx, func(commitment exchange.Commitment) bool {
 		genState.Commitments = append(genState.Commitments, commitment)
 		return false
@@ -115,5 +138,13 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *exchange.GenesisState {
 		return false
 	})
 
+	err = k.IterateTrades(ctx, func(trade *exchange.Trade) bool {
+		genState.Trades = append(genState.Trades, *trade)
+		return false
+	})
+	if err != nil {
+		k.logErrorf(ctx, "error (ignored) while reading trades: %v", err)
+	}
+
 	return genState
 }
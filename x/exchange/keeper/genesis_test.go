@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -33,9 +34,15 @@ func (s *TestSuite) assertEqualGenState(expected, actual *exchange.GenesisState,
 	s.Assert().Equalf(fmt.Sprintf("%d", expected.LastOrderId), fmt.Sprintf("%d", actual.LastOrderId), msg+" LastMarketId", args...)
 	s.assertEqualCommitments(expected.Commitments, actual.Commitments, msg+" Commitments", args...)
 	assertEqualSlice(s, expected.Payments, actual.Payments, s.getPaymentString, msg+" Payments", args...)
+	assertEqualSlice(s, expected.Trades, actual.Trades, s.getGenStateTradeStr, msg+" Trades", args...)
 	return false
 }
 
+// getGenStateTradeStr returns a string representing the trade to help identify slice entries.
+func (s *TestSuite) getGenStateTradeStr(trade exchange.Trade) string {
+	return fmt.Sprintf("%d/%d", trade.MarketId, trade.TradeId)
+}
+
 // getGenStateMarketStr returns a string representing the market to help identify slice entries.
 func (s *TestSuite) getGenStateDenomSplitStr(split exchange.DenomSplit) string {
 	return fmt.Sprintf("%s=%d", split.Denom, split.Split)
@@ -665,6 +672,23 @@ func (s *TestSuite) TestKeeper_InitAndExportGenesis() {
 					payment(s.addr2, "8strawberry", s.addr3, "1tangerine", "def"),
 					payment(s.addr4, "22starfruit", s.addr2, "", "ghi"),
 				},
+				Trades: []exchange.Trade{
+					{
+						MarketId: 420, TradeId: 1, AskOrderIds: []uint64{3}, BidOrderIds: []uint64{4},
+						Assets: s.coin("10apple"), Price: s.coin("30peach"),
+						BlockHeight: 5, BlockTime: time.Unix(1_700_000_000, 0).UTC(),
+					},
+					{
+						MarketId: 1, TradeId: 2, AskOrderIds: []uint64{5},
+						Assets: s.coin("7apple"), Price: s.coin("14peach"),
+						BlockHeight: 6, BlockTime: time.Unix(1_700_000_005, 0).UTC(),
+					},
+					{
+						MarketId: 1, TradeId: 1, BidOrderIds: []uint64{6, 7},
+						Assets: s.coin("3apple"), Price: s.coin("9peach"),
+						BlockHeight: 6, BlockTime: time.Unix(1_700_000_005, 0).UTC(),
+					},
+				},
 			},
 			expAccCalls: AccountCalls{
 				GetAccount: []sdk.AccAddress{s.marketAddr1, exchange.GetMarketAddress(420)},
@@ -219,6 +219,101 @@ func (k QueryServer) GetAllOrders(goCtx context.Context, req *exchange.QueryGetA
 	return resp, nil
 }
 
+// GetOrderBook gets the price levels and best ask and bid of the orders in a market for an asset and price denom.
+func (k QueryServer) GetOrderBook(goCtx context.Context, req *exchange.QueryGetOrderBookRequest) (*exchange.QueryGetOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.MarketId == 0 || len(req.AssetDenom) == 0 || len(req.PriceDenom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	asks, bids, err := k.Keeper.GetOrderBook(ctx, req.MarketId, req.AssetDenom, req.PriceDenom, req.MaxLevels)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error getting order book for market %d: %v", req.MarketId, err)
+	}
+
+	resp := &exchange.QueryGetOrderBookResponse{Asks: asks, Bids: bids}
+	if len(asks) > 0 {
+		resp.BestAsk = &asks[0]
+	}
+	if len(bids) > 0 {
+		resp.BestBid = &bids[0]
+	}
+	return resp, nil
+}
+
+// GetMarketTrades gets the recorded trades in a market, oldest first.
+func (k QueryServer) GetMarketTrades(goCtx context.Context, req *exchange.QueryGetMarketTradesRequest) (*exchange.QueryGetMarketTradesResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryGetMarketTradesResponse{}
+	var err error
+	resp.Trades, resp.Pagination, err = k.Keeper.GetPageOfMarketTrades(ctx, req.MarketId, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating trades for market %d: %v", req.MarketId, err)
+	}
+
+	return resp, nil
+}
+
+// GetMarketCandles gets the OHLCV candles of the recorded trades in a market for an asset and price denom.
+func (k QueryServer) GetMarketCandles(goCtx context.Context, req *exchange.QueryGetMarketCandlesRequest) (*exchange.QueryGetMarketCandlesResponse, error) {
+	if req == nil || req.MarketId == 0 || len(req.AssetDenom) == 0 || len(req.PriceDenom) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := req.Interval.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	candles, err := k.Keeper.GetMarketCandles(ctx, req.MarketId, req.AssetDenom, req.PriceDenom, req.Interval, req.MaxCandles)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error building candles for market %d: %v", req.MarketId, err)
+	}
+
+	return &exchange.QueryGetMarketCandlesResponse{Candles: candles}, nil
+}
+
+// GetConditionalOrder looks up a conditional order by id.
+func (k QueryServer) GetConditionalOrder(goCtx context.Context, req *exchange.QueryGetConditionalOrderRequest) (*exchange.QueryGetConditionalOrderResponse, error) {
+	if req == nil || req.OrderId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	order, err := k.Keeper.GetConditionalOrder(ctx, req.OrderId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if order == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "conditional order %d not found", req.OrderId)
+	}
+
+	return &exchange.QueryGetConditionalOrderResponse{ConditionalOrder: order}, nil
+}
+
+// GetMarketConditionalOrders looks up the conditional orders in a market.
+func (k QueryServer) GetMarketConditionalOrders(goCtx context.Context, req *exchange.QueryGetMarketConditionalOrdersRequest) (*exchange.QueryGetMarketConditionalOrdersResponse, error) {
+	if req == nil || req.MarketId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &exchange.QueryGetMarketConditionalOrdersResponse{}
+	var err error
+	resp.ConditionalOrders, resp.Pagination, err = k.Keeper.GetPageOfMarketConditionalOrders(ctx, req.MarketId, req.Pagination)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "error iterating conditional orders for market %d: %v", req.MarketId, err)
+	}
+
+	return resp, nil
+}
+
 // GetCommitment gets the funds in an account that are committed to the market.
 func (k QueryServer) GetCommitment(goCtx context.Context, req *exchange.QueryGetCommitmentRequest) (*exchange.QueryGetCommitmentResponse, error) {
 	if req == nil || len(req.Account) == 0 || req.MarketId == 0 {
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -3129,6 +3132,549 @@ func (s *TestSuite) TestQueryServer_GetAllOrders() {
 	}
 }
 
+func (s *TestSuite) TestQueryServer_GetOrderBook() {
+	testDef := queryTestDef[exchange.QueryGetOrderBookRequest, exchange.QueryGetOrderBookResponse]{
+		queryName: "GetOrderBook",
+		query:     keeper.NewQueryServer(s.k).GetOrderBook,
+	}
+
+	askOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: marketID,
+			Seller:   sdk.AccAddress(fmt.Sprintf("seller_%d____________", orderID)[:20]).String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, marketID uint32, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    sdk.AccAddress(fmt.Sprintf("buyer_%d_____________", orderID)[:20]).String(),
+			Assets:   s.coin(assets),
+			Price:    s.coin(price),
+		})
+	}
+	level := func(unitPrice, totalAssets, totalPrice string, orderCount uint32) exchange.PriceLevel {
+		return exchange.PriceLevel{
+			UnitPrice:   sdkmath.LegacyMustNewDecFromStr(unitPrice),
+			TotalAssets: s.coin(totalAssets),
+			TotalPrice:  s.coin(totalPrice),
+			OrderCount:  orderCount,
+		}
+	}
+	levelP := func(unitPrice, totalAssets, totalPrice string, orderCount uint32) *exchange.PriceLevel {
+		rv := level(unitPrice, totalAssets, totalPrice, orderCount)
+		return &rv
+	}
+	standardSetup := func() {
+		store := s.getStore()
+		s.requireSetOrdersInStore(store,
+			askOrder(1, 1, "10apple", "50plum"),
+			askOrder(2, 1, "20apple", "100plum"),
+			askOrder(3, 1, "10apple", "70plum"),
+			askOrder(4, 1, "3apple", "10plum"),
+			bidOrder(5, 1, "10apple", "40plum"),
+			bidOrder(6, 1, "5apple", "15plum"),
+			bidOrder(7, 1, "10apple", "40plum"),
+			askOrder(8, 1, "10apple", "50peach"),
+			askOrder(9, 2, "10apple", "50plum"),
+			bidOrder(10, 1, "10banana", "50plum"),
+		)
+	}
+
+	// manyAsksSetup creates count asks, each with its own unit price (1 through count).
+	manyAsksSetup := func(count int) func() {
+		return func() {
+			orders := make([]*exchange.Order, count)
+			for i := range orders {
+				orders[i] = askOrder(uint64(i+1), 1, "1apple", fmt.Sprintf("%dplum", i+1))
+			}
+			s.requireSetOrdersInStore(s.getStore(), orders...)
+		}
+	}
+	// manyAsksResp is the expected response to an order book query with manyAsksSetup that returns count levels.
+	manyAsksResp := func(count int) *exchange.QueryGetOrderBookResponse {
+		rv := &exchange.QueryGetOrderBookResponse{Asks: make([]exchange.PriceLevel, count)}
+		for i := range rv.Asks {
+			rv.Asks[i] = level(fmt.Sprintf("%d", i+1), "1apple", fmt.Sprintf("%dplum", i+1), 1)
+		}
+		rv.BestAsk = levelP("1", "1apple", "1plum", 1)
+		return rv
+	}
+
+	tests := []queryTestCase[exchange.QueryGetOrderBookRequest, exchange.QueryGetOrderBookResponse]{
+		{
+			name:     "nil request",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market 0",
+			req:      &exchange.QueryGetOrderBookRequest{MarketId: 0, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name:     "no asset denom",
+			req:      &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name:     "no price denom",
+			req:      &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: ""},
+			expInErr: []string{invalidArgErr, "invalid request"},
+		},
+		{
+			name: "error reading order",
+			setup: func() {
+				order := askOrder(5, 1, "1apple", "1plum")
+				store := s.getStore()
+				// Save it normally to get the indexes with it, then overwite the value with a bad one.
+				s.requireSetOrderInStore(store, order)
+				key, value, err := s.k.GetOrderStoreKeyValue(*order)
+				s.Require().NoError(err, "GetOrderStoreKeyValue 5")
+				value[0] = 9
+				store.Set(key, value)
+			},
+			req:      &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "error getting order book for market 1", "failed to read order 5: unknown type byte 0x9"},
+		},
+		{
+			name:    "no orders",
+			req:     &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetOrderBookResponse{},
+		},
+		{
+			name:    "no orders in market",
+			setup:   standardSetup,
+			req:     &exchange.QueryGetOrderBookRequest{MarketId: 3, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetOrderBookResponse{},
+		},
+		{
+			name:  "only asks",
+			setup: standardSetup,
+			req:   &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "peach"},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Asks:    []exchange.PriceLevel{level("5", "10apple", "50peach", 1)},
+				BestAsk: levelP("5", "10apple", "50peach", 1),
+			},
+		},
+		{
+			name:  "only bids",
+			setup: standardSetup,
+			req:   &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "banana", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Bids:    []exchange.PriceLevel{level("5", "10banana", "50plum", 1)},
+				BestBid: levelP("5", "10banana", "50plum", 1),
+			},
+		},
+		{
+			name:  "both sides, all levels",
+			setup: standardSetup,
+			req:   &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Asks: []exchange.PriceLevel{
+					level("3.333333333333333333", "3apple", "10plum", 1),
+					level("5", "30apple", "150plum", 2),
+					level("7", "10apple", "70plum", 1),
+				},
+				Bids: []exchange.PriceLevel{
+					level("4", "20apple", "80plum", 2),
+					level("3", "5apple", "15plum", 1),
+				},
+				BestAsk: levelP("3.333333333333333333", "3apple", "10plum", 1),
+				BestBid: levelP("4", "20apple", "80plum", 2),
+			},
+		},
+		{
+			name:  "both sides, max levels 2",
+			setup: standardSetup,
+			req:   &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", MaxLevels: 2},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Asks: []exchange.PriceLevel{
+					level("3.333333333333333333", "3apple", "10plum", 1),
+					level("5", "30apple", "150plum", 2),
+				},
+				Bids: []exchange.PriceLevel{
+					level("4", "20apple", "80plum", 2),
+					level("3", "5apple", "15plum", 1),
+				},
+				BestAsk: levelP("3.333333333333333333", "3apple", "10plum", 1),
+				BestBid: levelP("4", "20apple", "80plum", 2),
+			},
+		},
+		{
+			name:  "both sides, max levels 1",
+			setup: standardSetup,
+			req:   &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", MaxLevels: 1},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Asks:    []exchange.PriceLevel{level("3.333333333333333333", "3apple", "10plum", 1)},
+				Bids:    []exchange.PriceLevel{level("4", "20apple", "80plum", 2)},
+				BestAsk: levelP("3.333333333333333333", "3apple", "10plum", 1),
+				BestBid: levelP("4", "20apple", "80plum", 2),
+			},
+		},
+		{
+			name: "max levels 1, bad order past the first level",
+			setup: func() {
+				standardSetup()
+				// Orders past the requested levels are never read, so a bad one there doesn't cause an error.
+				store := s.getStore()
+				order := askOrder(11, 1, "1apple", "9plum")
+				s.requireSetOrderInStore(store, order)
+				key, value, err := s.k.GetOrderStoreKeyValue(*order)
+				s.Require().NoError(err, "GetOrderStoreKeyValue 11")
+				value[0] = 9
+				store.Set(key, value)
+			},
+			req: &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", MaxLevels: 1},
+			expResp: &exchange.QueryGetOrderBookResponse{
+				Asks:    []exchange.PriceLevel{level("3.333333333333333333", "3apple", "10plum", 1)},
+				Bids:    []exchange.PriceLevel{level("4", "20apple", "80plum", 2)},
+				BestAsk: levelP("3.333333333333333333", "3apple", "10plum", 1),
+				BestBid: levelP("4", "20apple", "80plum", 2),
+			},
+		},
+		{
+			name:    "no max levels: default levels",
+			setup:   manyAsksSetup(keeper.DefaultOrderBookLevels + 1),
+			req:     &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expResp: manyAsksResp(keeper.DefaultOrderBookLevels),
+		},
+		{
+			name:    "max levels more than the max",
+			setup:   manyAsksSetup(keeper.MaxOrderBookLevels + 1),
+			req:     &exchange.QueryGetOrderBookRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", MaxLevels: keeper.MaxOrderBookLevels + 1},
+			expResp: manyAsksResp(keeper.MaxOrderBookLevels),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketTrades() {
+	testDef := queryTestDef[exchange.QueryGetMarketTradesRequest, exchange.QueryGetMarketTradesResponse]{
+		queryName: "GetMarketTrades",
+		query:     keeper.NewQueryServer(s.k).GetMarketTrades,
+		followup: func(expected, actual *exchange.QueryGetMarketTradesResponse) {
+			s.Assert().Equal(expected.Trades, actual.Trades, "Trades")
+			s.assertEqualPageResponse(expected.Pagination, actual.Pagination, "Pagination")
+		},
+	}
+
+	blockTime := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	trade := func(marketID uint32, tradeID uint64) *exchange.Trade {
+		return &exchange.Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID * 2},
+			BidOrderIds: []uint64{tradeID*2 + 1},
+			Assets:      sdk.NewInt64Coin("apple", int64(tradeID)),
+			Price:       sdk.NewInt64Coin("plum", int64(tradeID)*3),
+			BlockHeight: int64(tradeID),
+			BlockTime:   blockTime,
+		}
+	}
+	trades := []*exchange.Trade{trade(1, 1), trade(1, 2), trade(1, 3), trade(2, 1), trade(2, 2)}
+	store := s.getStore()
+	for _, t := range trades {
+		s.Require().NoError(s.k.SetTradeInStore(store, *t), "SetTradeInStore(%d, %d)", t.MarketId, t.TradeId)
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketTradesRequest, exchange.QueryGetMarketTradesResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market 0",
+			req:      &exchange.QueryGetMarketTradesRequest{MarketId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:    "no trades",
+			req:     &exchange.QueryGetMarketTradesRequest{MarketId: 3},
+			expResp: &exchange.QueryGetMarketTradesResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name: "market 1",
+			req:  &exchange.QueryGetMarketTradesRequest{MarketId: 1},
+			expResp: &exchange.QueryGetMarketTradesResponse{
+				Trades:     []*exchange.Trade{trades[0], trades[1], trades[2]},
+				Pagination: &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "market 1: limit 2",
+			req: &exchange.QueryGetMarketTradesRequest{
+				MarketId:   1,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expResp: &exchange.QueryGetMarketTradesResponse{
+				Trades:     []*exchange.Trade{trades[0], trades[1]},
+				Pagination: &query.PageResponse{NextKey: keeper.Uint64Bz(3)},
+			},
+		},
+		{
+			name: "market 2: reversed",
+			req: &exchange.QueryGetMarketTradesRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketTradesResponse{
+				Trades:     []*exchange.Trade{trades[4], trades[3]},
+				Pagination: &query.PageResponse{Total: 2},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketCandles() {
+	testDef := queryTestDef[exchange.QueryGetMarketCandlesRequest, exchange.QueryGetMarketCandlesResponse]{
+		queryName: "GetMarketCandles",
+		query:     keeper.NewQueryServer(s.k).GetMarketCandles,
+	}
+
+	startTime := time.Date(2024, 2, 3, 4, 0, 0, 0, time.UTC)
+	trade := func(tradeID uint64, offset time.Duration, assets, price string) exchange.Trade {
+		return exchange.Trade{
+			MarketId:    1,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID},
+			Assets:      s.coin(assets),
+			Price:       s.coin(price),
+			BlockTime:   startTime.Add(offset),
+		}
+	}
+	store := s.getStore()
+	for _, t := range []exchange.Trade{
+		trade(1, 0, "10apple", "20plum"),
+		trade(2, 20*time.Minute, "10apple", "30plum"),
+		trade(3, 90*time.Minute, "5apple", "5plum"),
+	} {
+		s.Require().NoError(s.k.SetTradeInStore(store, t), "SetTradeInStore(%d)", t.TradeId)
+	}
+	dec := sdkmath.LegacyMustNewDecFromStr
+
+	tests := []queryTestCase[exchange.QueryGetMarketCandlesRequest, exchange.QueryGetMarketCandlesResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market 0",
+			req:      &exchange.QueryGetMarketCandlesRequest{AssetDenom: "apple", PriceDenom: "plum", Interval: exchange.CandleInterval_one_hour},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no asset denom",
+			req:      &exchange.QueryGetMarketCandlesRequest{MarketId: 1, PriceDenom: "plum", Interval: exchange.CandleInterval_one_hour},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "no price denom",
+			req:      &exchange.QueryGetMarketCandlesRequest{MarketId: 1, AssetDenom: "apple", Interval: exchange.CandleInterval_one_hour},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "unspecified interval",
+			req:      &exchange.QueryGetMarketCandlesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum"},
+			expInErr: []string{invalidArgErr, "candle interval is unspecified"},
+		},
+		{
+			name:    "no trades",
+			req:     &exchange.QueryGetMarketCandlesRequest{MarketId: 2, AssetDenom: "apple", PriceDenom: "plum", Interval: exchange.CandleInterval_one_hour},
+			expResp: &exchange.QueryGetMarketCandlesResponse{},
+		},
+		{
+			name: "one hour",
+			req:  &exchange.QueryGetMarketCandlesRequest{MarketId: 1, AssetDenom: "apple", PriceDenom: "plum", Interval: exchange.CandleInterval_one_hour},
+			expResp: &exchange.QueryGetMarketCandlesResponse{
+				Candles: []exchange.Candle{
+					{
+						StartTime: startTime,
+						Open:      dec("2"), High: dec("3"), Low: dec("2"), Close: dec("3"),
+						Volume: s.coin("20apple"), Value: s.coin("50plum"), TradeCount: 2,
+					},
+					{
+						StartTime: startTime.Add(time.Hour),
+						Open:      dec("1"), High: dec("1"), Low: dec("1"), Close: dec("1"),
+						Volume: s.coin("5apple"), Value: s.coin("5plum"), TradeCount: 1,
+					},
+				},
+			},
+		},
+		{
+			name: "one hour, max one",
+			req: &exchange.QueryGetMarketCandlesRequest{
+				MarketId: 1, AssetDenom: "apple", PriceDenom: "plum",
+				Interval: exchange.CandleInterval_one_hour, MaxCandles: 1,
+			},
+			expResp: &exchange.QueryGetMarketCandlesResponse{
+				Candles: []exchange.Candle{
+					{
+						StartTime: startTime.Add(time.Hour),
+						Open:      dec("1"), High: dec("1"), Low: dec("1"), Close: dec("1"),
+						Volume: s.coin("5apple"), Value: s.coin("5plum"), TradeCount: 1,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetConditionalOrder() {
+	testDef := queryTestDef[exchange.QueryGetConditionalOrderRequest, exchange.QueryGetConditionalOrderResponse]{
+		queryName: "GetConditionalOrder",
+		query:     keeper.NewQueryServer(s.k).GetConditionalOrder,
+	}
+
+	condOrder := exchange.NewConditionalOrder(exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+		MarketId: 1,
+		Seller:   s.addr1.String(),
+		Assets:   s.coin("20apple"),
+		Price:    s.coin("3pineapple"),
+	}), sdkmath.LegacyMustNewDecFromStr("0.15"), exchange.TriggerDirection_at_or_below, s.coinP("5fig"))
+
+	tests := []queryTestCase[exchange.QueryGetConditionalOrderRequest, exchange.QueryGetConditionalOrderResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "order 0",
+			req:      &exchange.QueryGetConditionalOrderRequest{OrderId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "not found",
+			req:      &exchange.QueryGetConditionalOrderRequest{OrderId: 3},
+			expInErr: []string{invalidArgErr, "conditional order 3 not found"},
+		},
+		{
+			name: "regular order with same id",
+			setup: func() {
+				s.requireSetOrderInStore(s.getStore(), exchange.NewOrder(3).WithAsk(condOrder.Order.GetAskOrder()))
+			},
+			req:      &exchange.QueryGetConditionalOrderRequest{OrderId: 3},
+			expInErr: []string{invalidArgErr, "conditional order 3 not found"},
+		},
+		{
+			name: "found",
+			setup: func() {
+				s.Require().NoError(s.k.SetConditionalOrderInStore(s.getStore(), *condOrder), "SetConditionalOrderInStore")
+			},
+			req:     &exchange.QueryGetConditionalOrderRequest{OrderId: 3},
+			expResp: &exchange.QueryGetConditionalOrderResponse{ConditionalOrder: condOrder},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
+func (s *TestSuite) TestQueryServer_GetMarketConditionalOrders() {
+	testDef := queryTestDef[exchange.QueryGetMarketConditionalOrdersRequest, exchange.QueryGetMarketConditionalOrdersResponse]{
+		queryName: "GetMarketConditionalOrders",
+		query:     keeper.NewQueryServer(s.k).GetMarketConditionalOrders,
+		followup: func(expected, actual *exchange.QueryGetMarketConditionalOrdersResponse) {
+			s.Assert().Equal(expected.ConditionalOrders, actual.ConditionalOrders, "ConditionalOrders")
+			s.assertEqualPageResponse(expected.Pagination, actual.Pagination, "Pagination")
+		},
+	}
+
+	condOrder := func(orderID uint64, marketID uint32) *exchange.ConditionalOrder {
+		return exchange.NewConditionalOrder(exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: marketID,
+			Buyer:    sdk.AccAddress(fmt.Sprintf("buyer_%d_____________", orderID)[:20]).String(),
+			Assets:   sdk.NewInt64Coin("apple", int64(orderID)),
+			Price:    sdk.NewInt64Coin("plum", int64(orderID)),
+		}), sdkmath.LegacyNewDec(int64(orderID)), exchange.TriggerDirection_at_or_above, nil)
+	}
+	orders := []*exchange.ConditionalOrder{
+		condOrder(1, 1), condOrder(2, 2), condOrder(3, 1), condOrder(4, 1), condOrder(5, 2),
+	}
+	store := s.getStore()
+	for _, order := range orders {
+		s.Require().NoError(s.k.SetConditionalOrderInStore(store, *order), "SetConditionalOrderInStore(%d)", order.GetOrderID())
+	}
+
+	tests := []queryTestCase[exchange.QueryGetMarketConditionalOrdersRequest, exchange.QueryGetMarketConditionalOrdersResponse]{
+		{
+			name:     "nil req",
+			req:      nil,
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:     "market 0",
+			req:      &exchange.QueryGetMarketConditionalOrdersRequest{MarketId: 0},
+			expInErr: []string{invalidArgErr, "empty request"},
+		},
+		{
+			name:    "no conditional orders",
+			req:     &exchange.QueryGetMarketConditionalOrdersRequest{MarketId: 3},
+			expResp: &exchange.QueryGetMarketConditionalOrdersResponse{Pagination: &query.PageResponse{}},
+		},
+		{
+			name: "market 1",
+			req:  &exchange.QueryGetMarketConditionalOrdersRequest{MarketId: 1},
+			expResp: &exchange.QueryGetMarketConditionalOrdersResponse{
+				ConditionalOrders: []*exchange.ConditionalOrder{orders[0], orders[2], orders[3]},
+				Pagination:        &query.PageResponse{Total: 3},
+			},
+		},
+		{
+			name: "market 1: limit 2",
+			req: &exchange.QueryGetMarketConditionalOrdersRequest{
+				MarketId:   1,
+				Pagination: &query.PageRequest{Limit: 2},
+			},
+			expResp: &exchange.QueryGetMarketConditionalOrdersResponse{
+				ConditionalOrders: []*exchange.ConditionalOrder{orders[0], orders[2]},
+				Pagination:        &query.PageResponse{NextKey: keeper.Uint64Bz(4)},
+			},
+		},
+		{
+			name: "market 2: reversed",
+			req: &exchange.QueryGetMarketConditionalOrdersRequest{
+				MarketId:   2,
+				Pagination: &query.PageRequest{Reverse: true},
+			},
+			expResp: &exchange.QueryGetMarketConditionalOrdersResponse{
+				ConditionalOrders: []*exchange.ConditionalOrder{orders[4], orders[1]},
+				Pagination:        &query.PageResponse{Total: 2},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runQueryTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestQueryServer_GetCommitment() {
 	testDef := queryTestDef[exchange.QueryGetCommitmentRequest, exchange.QueryGetCommitmentResponse]{
 		queryName: "GetCommitment",
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -53,6 +54,7 @@ import (
 //   Market Create-Commitment Flat Fee: 0x01 | <market_id> | 0x11 | <denom> => <amount> (string)
 //   Market Commitment Settlement Bips: 0x01 | <market_id> | 0x12 => uint16
 //   Market Intermediary Denom: 0x01 | <market_id> | 0x13 => <denom>
+//   Market auto-match indicator: 0x01 | <market_id> | 0x14 => nil
 //
 //   The <permission_type_byte> is a single byte as uint8 with the same values as the enum entries.
 //   The <req_attr_type_byte> is either an order type byte or 0x63 (= 'c' for commitments).
@@ -79,7 +81,26 @@ import (
 //    Address to order: 0x04 | len(<address>) (1 byte) | <address> | <order_id> (8 bytes) => <order type byte>
 //    Asset denom to order: 0x05 | <asset_denom> | <order_id> (8 bytes) => <order type byte>
 //    Market + external id to order: 0x09 | <market id> (4 bytes) | <external_id> => <order id> (8 bytes)
+//    Expiration height to order: 0x0A | <height> (8 bytes) | <order_id> (8 bytes) => <order type byte>
+//    Expiration time to order: 0x0B | <unix seconds> (8 bytes) | <order_id> (8 bytes) => <order type byte>
 //    Target to payment: 0x10 | len(<target>) (1 byte) | <target> | len(<source>) (1 byte) | <source> | <external id>
+//    Market to conditional order: 0x0D | <market_id> (4 bytes) | <order_id> (8 bytes) => <order type byte>
+//    Address to conditional order: 0x13 | len(<address>) (1 byte) | <address> | <order_id> (8 bytes) => <order type byte>
+//    Market price to order: 0x11 | <market_id> (4 bytes) | <asset_denom> | 0x1E | <price_denom> | 0x1E | <order type byte> | len(<unit price>) (1 byte) | <unit price> | <order_id> (8 bytes) => <order type byte>
+//      The <unit price> is the big-endian bytes of the order's price per asset (truncated to 18 decimal places),
+//      so that an order book's orders are ordered by unit price, then by order id.
+//      For bid orders, the <order_id> is inverted (bitwise not) since they're read highest price first.
+//    Trigger price to conditional order: 0x12 | <market_id> (4 bytes) | <asset_denom> | 0x1E | <price_denom> | 0x1E | <trigger direction byte> | len(<trigger price>) (1 byte) | <trigger price> | <order_id> (8 bytes) => <order type byte>
+//      The <trigger price> is the big-endian bytes of the conditional order's trigger price (with 18 decimal places).
+//
+// Conditional Orders: 0x0C | <order_id> (8 bytes) => protobuf(ConditionalOrder)
+//
+// Block Trade Prices: 0x0E | <market_id> (4 bytes) | <asset_denom> | 0x1E | <price_denom> => <low price> | 0x1E | <high price> (strings)
+//   These only exist during a block; they are deleted once conditional orders have been checked in the EndBlocker,
+//   unless there were more triggered conditional orders than could be checked, in which case they are kept for the next block.
+//
+// Trades: 0x0F | <market_id> (4 bytes) | <trade_id> (8 bytes) => protobuf(Trade)
+//   Only the most recent MaxTradesPerMarket trades are kept for each market.
 
 const (
 	// KeyTypeParams is the type byte for params entries.
@@ -102,12 +123,30 @@ const (
 	KeyTypeAssetToOrderIndex = byte(0x05)
 	// KeyTypeMarketExternalIDToOrderIndex is the type byte for entries in the market and uuid to order index.
 	KeyTypeMarketExternalIDToOrderIndex = byte(0x09)
+	// KeyTypeExpirationHeightToOrderIndex is the type byte for entries in the expiration height to order index.
+	KeyTypeExpirationHeightToOrderIndex = byte(0x0A)
+	// KeyTypeExpirationTimeToOrderIndex is the type byte for entries in the expiration time to order index.
+	KeyTypeExpirationTimeToOrderIndex = byte(0x0B)
+	// KeyTypeConditionalOrder is the type byte for conditional order entries.
+	KeyTypeConditionalOrder = byte(0x0C)
+	// KeyTypeMarketToConditionalOrderIndex is the type byte for entries in the market to conditional order index.
+	KeyTypeMarketToConditionalOrderIndex = byte(0x0D)
+	// KeyTypeBlockTradePrice is the type byte for the trade price ranges recorded during a block.
+	KeyTypeBlockTradePrice = byte(0x0E)
+	// KeyTypeTrade is the type byte for trade entries.
+	KeyTypeTrade = byte(0x0F)
 	// KeyTypeCommitment is the type byte for commitments.
 	KeyTypeCommitment = byte(0x63)
 	// KeyTypePayment is the type byte for payments.
 	KeyTypePayment = byte(0x70)
 	// KeyTypeTargetToPaymentIndex is the type byte for entries in the target to payment index.
 	KeyTypeTargetToPaymentIndex = byte(0x10)
+	// KeyTypeMarketPriceToOrderIndex is the type byte for entries in the market price to order index.
+	KeyTypeMarketPriceToOrderIndex = byte(0x11)
+	// KeyTypeTriggerPriceToConditionalOrderIndex is the type byte for entries in the trigger price to conditional order index.
+	KeyTypeTriggerPriceToConditionalOrderIndex = byte(0x12)
+	// KeyTypeAddressToConditionalOrderIndex is the type byte for entries in the address to conditional order index.
+	KeyTypeAddressToConditionalOrderIndex = byte(0x13)
 
 	// ParamsKeyTypeSplit is the type string used in the keys for params.DefaultSplit and params.DenomSplits.
 	ParamsKeyTypeSplit = "split"
@@ -144,6 +183,8 @@ const (
 	MarketKeyTypeCommitmentSettlementBips = byte(0x12)
 	// MarketKeyTypeIntermediaryDenom is the market-specific type byte for the intermediary denom used in fee calcs.
 	MarketKeyTypeIntermediaryDenom = byte(0x13)
+	// MarketKeyTypeAutoMatch is the market-specific type byte for the auto-match indicators.
+	MarketKeyTypeAutoMatch = byte(0x14)
 
 	// OrderKeyTypeAsk is the order-specific type byte for ask orders.
 	OrderKeyTypeAsk = exchange.OrderTypeByteAsk
@@ -613,6 +654,11 @@ func MakeKeyMarketIntermediaryDenom(marketID uint32) []byte {
 	return keyPrefixMarketType(marketID, MarketKeyTypeIntermediaryDenom, 0)
 }
 
+// MakeKeyMarketAutoMatch creates the key to use to indicate that a market's orders are automatically matched.
+func MakeKeyMarketAutoMatch(marketID uint32) []byte {
+	return keyPrefixMarketType(marketID, MarketKeyTypeAutoMatch, 0)
+}
+
 // keyPrefixOrder creates the key prefix for orders with the provided extra capacity for additional elements.
 func keyPrefixOrder(extraCap int) []byte {
 	return prepKey(KeyTypeOrder, nil, extraCap)
@@ -818,6 +864,296 @@ func MakeIndexKeyMarketExternalIDToOrder(marketID uint32, externalID string) []b
 	return rv
 }
 
+// GetIndexKeyPrefixExpirationHeightToOrder creates the key prefix for all entries in the expiration height to order index.
+func GetIndexKeyPrefixExpirationHeightToOrder() []byte {
+	return prepKey(KeyTypeExpirationHeightToOrderIndex, nil, 0)
+}
+
+// MakeIndexKeyExpirationHeightToOrder creates the key to use for the expiration height to order index for the provided values.
+func MakeIndexKeyExpirationHeightToOrder(height uint64, orderID uint64) []byte {
+	rv := prepKey(KeyTypeExpirationHeightToOrderIndex, uint64Bz(height), 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// GetIndexKeyPrefixExpirationTimeToOrder creates the key prefix for all entries in the expiration time to order index.
+func GetIndexKeyPrefixExpirationTimeToOrder() []byte {
+	return prepKey(KeyTypeExpirationTimeToOrderIndex, nil, 0)
+}
+
+// expirationTimeBz converts the provided time into the 8 bytes used in the expiration time to order index.
+// Only the whole seconds are used, and anything before the epoch is treated as the epoch.
+func expirationTimeBz(t time.Time) []byte {
+	secs := t.Unix()
+	if secs < 0 {
+		secs = 0
+	}
+	return uint64Bz(uint64(secs))
+}
+
+// MakeIndexKeyExpirationTimeToOrder creates the key to use for the expiration time to order index for the provided values.
+func MakeIndexKeyExpirationTimeToOrder(expTime time.Time, orderID uint64) []byte {
+	rv := prepKey(KeyTypeExpirationTimeToOrderIndex, expirationTimeBz(expTime), 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// ParseIndexKeyExpirationToOrder extracts the expiration value and order id from
+// an expiration height to order or expiration time to order index key.
+// For expiration time keys, the returned value is the unix seconds of the expiration.
+// The input can have the following formats:
+//   - <type byte> | <expiration> (8 bytes) | <order id> (8 bytes)
+//   - <expiration> (8 bytes) | <order id> (8 bytes)
+func ParseIndexKeyExpirationToOrder(key []byte) (uint64, uint64, error) {
+	switch len(key) {
+	case 16:
+	case 17:
+		if key[0] != KeyTypeExpirationHeightToOrderIndex && key[0] != KeyTypeExpirationTimeToOrderIndex {
+			return 0, 0, fmt.Errorf("cannot parse expiration to order key: unknown type byte %#x, expected %#x or %#x",
+				key[0], KeyTypeExpirationHeightToOrderIndex, KeyTypeExpirationTimeToOrderIndex)
+		}
+		key = key[1:]
+	default:
+		return 0, 0, fmt.Errorf("cannot parse expiration to order key: length %d, expected 16 or 17", len(key))
+	}
+	expiration, _ := uint64FromBz(key[:8])
+	orderID, _ := uint64FromBz(key[8:])
+	return expiration, orderID, nil
+}
+
+// keyPrefixConditionalOrder creates the key prefix for conditional orders with the provided extra capacity for additional elements.
+func keyPrefixConditionalOrder(extraCap int) []byte {
+	return prepKey(KeyTypeConditionalOrder, nil, extraCap)
+}
+
+// GetKeyPrefixConditionalOrder gets the key prefix for all conditional orders.
+func GetKeyPrefixConditionalOrder() []byte {
+	return keyPrefixConditionalOrder(0)
+}
+
+// MakeKeyConditionalOrder creates the key to use for a conditional order ID.
+func MakeKeyConditionalOrder(orderID uint64) []byte {
+	suffix := uint64Bz(orderID)
+	rv := keyPrefixConditionalOrder(len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// indexPrefixMarketToConditionalOrder creates the prefix for the market to conditional order index entries with some extra space for the rest.
+func indexPrefixMarketToConditionalOrder(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeMarketToConditionalOrderIndex, uint32Bz(marketID), extraCap)
+}
+
+// GetIndexKeyPrefixMarketToConditionalOrder creates the prefix for the market to conditional order index limited to the given market id.
+func GetIndexKeyPrefixMarketToConditionalOrder(marketID uint32) []byte {
+	return indexPrefixMarketToConditionalOrder(marketID, 0)
+}
+
+// MakeIndexKeyMarketToConditionalOrder creates the key to use for the market to conditional order index with the given ids.
+func MakeIndexKeyMarketToConditionalOrder(marketID uint32, orderID uint64) []byte {
+	rv := indexPrefixMarketToConditionalOrder(marketID, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// indexPrefixAddressToConditionalOrder creates the prefix for the address to conditional order index entries with some extra space for the rest.
+func indexPrefixAddressToConditionalOrder(addr sdk.AccAddress, extraCap int) []byte {
+	if len(addr) == 0 {
+		panic(errors.New("empty address not allowed"))
+	}
+	return prepKey(KeyTypeAddressToConditionalOrderIndex, address.MustLengthPrefix(addr), extraCap)
+}
+
+// GetIndexKeyPrefixAddressToConditionalOrder creates the prefix for the address to conditional order index limited to the given address.
+func GetIndexKeyPrefixAddressToConditionalOrder(addr sdk.AccAddress) []byte {
+	return indexPrefixAddressToConditionalOrder(addr, 0)
+}
+
+// MakeIndexKeyAddressToConditionalOrder creates the key to use for the address to conditional order index with the given values.
+func MakeIndexKeyAddressToConditionalOrder(addr sdk.AccAddress, orderID uint64) []byte {
+	rv := indexPrefixAddressToConditionalOrder(addr, 8)
+	rv = append(rv, uint64Bz(orderID)...)
+	return rv
+}
+
+// priceBz converts a (non-negative) price into bytes that sort the same way the prices do.
+// The result is a length byte followed by the big-endian bytes of the price (with 18 decimal places).
+func priceBz(price sdkmath.LegacyDec) []byte {
+	var bz []byte
+	if !price.IsNil() && price.IsPositive() {
+		bz = price.BigInt().Bytes()
+	}
+	rv := make([]byte, 0, 1+len(bz))
+	rv = append(rv, byte(len(bz)))
+	rv = append(rv, bz...)
+	return rv
+}
+
+// unitPriceBz converts the unit price (price / assets) into bytes that sort the same way the unit prices do.
+// The unit price is truncated to 18 decimal places.
+func unitPriceBz(price, assets sdkmath.Int) []byte {
+	if !assets.IsPositive() {
+		return priceBz(sdkmath.LegacyZeroDec())
+	}
+	return priceBz(sdkmath.LegacyNewDecFromInt(price).QuoInt(assets))
+}
+
+// priceIndexOrderID converts an order id to/from the value used in a market price to order index key.
+// Bid orders are read from the index in reverse (highest price first), so their order ids are inverted
+// to keep the earlier orders first among those with the same price.
+func priceIndexOrderID(orderTypeByte byte, orderID uint64) uint64 {
+	if orderTypeByte == OrderKeyTypeBid {
+		return ^orderID
+	}
+	return orderID
+}
+
+// indexPrefixMarketPriceToOrder creates the prefix for the market price to order index entries with some extra space for the rest.
+func indexPrefixMarketPriceToOrder(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeMarketPriceToOrderIndex, uint32Bz(marketID), extraCap)
+}
+
+// GetIndexKeyPrefixMarketPriceToOrder creates the prefix for the market price to order index limited to the given market id.
+func GetIndexKeyPrefixMarketPriceToOrder(marketID uint32) []byte {
+	return indexPrefixMarketPriceToOrder(marketID, 0)
+}
+
+// GetIndexKeyPrefixMarketPriceToOrderBook creates the prefix for the market price to order index limited to the
+// orders in the given market with the given asset and price denoms.
+func GetIndexKeyPrefixMarketPriceToOrderBook(marketID uint32, assetDenom, priceDenom string) []byte {
+	rv := indexPrefixMarketPriceToOrder(marketID, len(assetDenom)+len(priceDenom)+2)
+	rv = append(rv, assetDenom...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, priceDenom...)
+	rv = append(rv, RecordSeparator)
+	return rv
+}
+
+// GetIndexKeyPrefixMarketPriceToOrderBookSide creates the prefix for the market price to order index limited to the
+// orders of the given type in the given market with the given asset and price denoms.
+func GetIndexKeyPrefixMarketPriceToOrderBookSide(marketID uint32, assetDenom, priceDenom string, orderTypeByte byte) []byte {
+	return append(GetIndexKeyPrefixMarketPriceToOrderBook(marketID, assetDenom, priceDenom), orderTypeByte)
+}
+
+// MakeIndexKeyMarketPriceToOrder creates the key to use in the market price to order index for the provided order.
+func MakeIndexKeyMarketPriceToOrder(order exchange.OrderI) []byte {
+	assets, price := order.GetAssets(), order.GetPrice()
+	priceBz := unitPriceBz(price.Amount, assets.Amount)
+	rv := GetIndexKeyPrefixMarketPriceToOrderBookSide(order.GetMarketID(), assets.Denom, price.Denom, order.GetOrderTypeByte())
+	rv = append(rv, priceBz...)
+	rv = append(rv, uint64Bz(priceIndexOrderID(order.GetOrderTypeByte(), order.GetOrderID()))...)
+	return rv
+}
+
+// ParseIndexKeyMarketPriceToOrder extracts the market id, asset denom, price denom, order type byte, and order id from
+// a market price to order index key. The input must have the format:
+// <type byte> | <market id> (4 bytes) | <asset denom> | 0x1E | <price denom> | 0x1E | <order type byte> | len(<unit price>) (1 byte) | <unit price> | <order id> (8 bytes).
+func ParseIndexKeyMarketPriceToOrder(key []byte) (marketID uint32, assetDenom, priceDenom string, orderTypeByte byte, orderID uint64, err error) {
+	if len(key) < 18 || key[0] != KeyTypeMarketPriceToOrderIndex {
+		return 0, "", "", 0, 0, fmt.Errorf("cannot parse market price to order key %v: unknown format", key)
+	}
+	marketID, _ = uint32FromBz(key[1:5])
+	parts := bytes.SplitN(key[5:], []byte{RecordSeparator}, 3)
+	if len(parts) != 3 || len(parts[2]) < 10 {
+		return 0, "", "", 0, 0, fmt.Errorf("cannot parse market price to order key %v: unknown format", key)
+	}
+	assetDenom, priceDenom = string(parts[0]), string(parts[1])
+	rest := parts[2]
+	orderTypeByte, priceLen := rest[0], int(rest[1])
+	if len(rest) != 2+priceLen+8 {
+		return 0, "", "", 0, 0, fmt.Errorf("cannot parse market price to order key %v: unknown format", key)
+	}
+	orderID, _ = uint64FromBz(rest[2+priceLen:])
+	orderID = priceIndexOrderID(orderTypeByte, orderID)
+	return marketID, assetDenom, priceDenom, orderTypeByte, orderID, nil
+}
+
+// GetIndexKeyPrefixTriggerPriceToConditionalOrder creates the prefix for the trigger price to conditional order index
+// limited to the conditional orders in the given market, with the given denoms and trigger direction.
+func GetIndexKeyPrefixTriggerPriceToConditionalOrder(marketID uint32, assetDenom, priceDenom string, direction exchange.TriggerDirection) []byte {
+	rv := prepKey(KeyTypeTriggerPriceToConditionalOrderIndex, uint32Bz(marketID), len(assetDenom)+len(priceDenom)+3)
+	rv = append(rv, assetDenom...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, priceDenom...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, byte(direction))
+	return rv
+}
+
+// GetIndexKeyPrefixTriggerPriceToConditionalOrderAt creates the prefix for the trigger price to conditional order index
+// limited to the conditional orders in the given market, with the given denoms, trigger direction, and trigger price.
+func GetIndexKeyPrefixTriggerPriceToConditionalOrderAt(marketID uint32, assetDenom, priceDenom string, direction exchange.TriggerDirection, triggerPrice sdkmath.LegacyDec) []byte {
+	return append(GetIndexKeyPrefixTriggerPriceToConditionalOrder(marketID, assetDenom, priceDenom, direction), priceBz(triggerPrice)...)
+}
+
+// MakeIndexKeyTriggerPriceToConditionalOrder creates the key to use in the trigger price to conditional order index for the provided conditional order.
+func MakeIndexKeyTriggerPriceToConditionalOrder(order exchange.ConditionalOrder) []byte {
+	rv := GetIndexKeyPrefixTriggerPriceToConditionalOrderAt(order.GetMarketID(), order.Order.GetAssets().Denom,
+		order.Order.GetPrice().Denom, order.TriggerDirection, order.TriggerPrice)
+	rv = append(rv, uint64Bz(order.GetOrderID())...)
+	return rv
+}
+
+// GetKeyPrefixBlockTradePrices gets the key prefix for all the trade prices recorded in the current block.
+func GetKeyPrefixBlockTradePrices() []byte {
+	return prepKey(KeyTypeBlockTradePrice, nil, 0)
+}
+
+// MakeKeyBlockTradePrice creates the key to use for the trade price range of a market's asset and price denom pair.
+func MakeKeyBlockTradePrice(marketID uint32, assetDenom, priceDenom string) []byte {
+	rv := prepKey(KeyTypeBlockTradePrice, uint32Bz(marketID), len(assetDenom)+1+len(priceDenom))
+	rv = append(rv, assetDenom...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, priceDenom...)
+	return rv
+}
+
+// ParseKeyBlockTradePrice extracts the market id, asset denom, and price denom from a block trade price key.
+// The input can have the following formats:
+//   - <type byte> | <market id> (4 bytes) | <asset denom> | 0x1E | <price denom>
+//   - <market id> (4 bytes) | <asset denom> | 0x1E | <price denom>
+func ParseKeyBlockTradePrice(key []byte) (uint32, string, string, error) {
+	if len(key) > 0 && key[0] == KeyTypeBlockTradePrice {
+		key = key[1:]
+	}
+	if len(key) < 7 {
+		return 0, "", "", fmt.Errorf("cannot parse block trade price key: length %d, expected at least 7", len(key))
+	}
+	marketID, _ := uint32FromBz(key[:4])
+	denoms := bytes.Split(key[4:], []byte{RecordSeparator})
+	if len(denoms) != 2 || len(denoms[0]) == 0 || len(denoms[1]) == 0 {
+		return 0, "", "", errors.New("cannot parse block trade price key: expected two non-empty denoms separated by 0x1E")
+	}
+	return marketID, string(denoms[0]), string(denoms[1]), nil
+}
+
+// GetBlockTradePriceStoreValue creates the value to store for the provided low and high trade prices.
+func GetBlockTradePriceStoreValue(low, high sdkmath.LegacyDec) []byte {
+	lowStr, highStr := low.String(), high.String()
+	rv := make([]byte, 0, len(lowStr)+1+len(highStr))
+	rv = append(rv, lowStr...)
+	rv = append(rv, RecordSeparator)
+	rv = append(rv, highStr...)
+	return rv
+}
+
+// ParseBlockTradePriceStoreValue extracts the low and high trade prices from the provided block trade price store value.
+func ParseBlockTradePriceStoreValue(value []byte) (low, high sdkmath.LegacyDec, err error) {
+	parts := bytes.Split(value, []byte{RecordSeparator})
+	if len(parts) != 2 {
+		return low, high, fmt.Errorf("cannot parse block trade price value: expected 2 parts, found %d", len(parts))
+	}
+	low, err = sdkmath.LegacyNewDecFromStr(string(parts[0]))
+	if err != nil {
+		return low, high, fmt.Errorf("cannot parse block trade price value: invalid low price %q: %w", parts[0], err)
+	}
+	high, err = sdkmath.LegacyNewDecFromStr(string(parts[1]))
+	if err != nil {
+		return low, high, fmt.Errorf("cannot parse block trade price value: invalid high price %q: %w", parts[1], err)
+	}
+	return low, high, nil
+}
+
 // keyPrefixCommitment creates the key prefix for commitments with the provided extra capacity for additional elements.
 func keyPrefixCommitment(extraCap int) []byte {
 	return prepKey(KeyTypeCommitment, nil, extraCap)
@@ -1011,3 +1347,42 @@ func ParseIndexKeySuffixTargetToPayment(suffix []byte) (sdk.AccAddress, string,
 	}
 	return source, string(left), nil
 }
+
+// keyPrefixTrade creates the key prefix for a market's trades with some extra space for the rest.
+func keyPrefixTrade(marketID uint32, extraCap int) []byte {
+	return prepKey(KeyTypeTrade, uint32Bz(marketID), extraCap)
+}
+
+// GetKeyPrefixTrades gets the key prefix for all trades in all markets.
+func GetKeyPrefixTrades() []byte {
+	return prepKey(KeyTypeTrade, nil, 0)
+}
+
+// GetKeyPrefixMarketTrades gets the key prefix for all trades in a market.
+func GetKeyPrefixMarketTrades(marketID uint32) []byte {
+	return keyPrefixTrade(marketID, 0)
+}
+
+// MakeKeyTrade creates the key to use for a trade in a market.
+func MakeKeyTrade(marketID uint32, tradeID uint64) []byte {
+	suffix := uint64Bz(tradeID)
+	rv := keyPrefixTrade(marketID, len(suffix))
+	rv = append(rv, suffix...)
+	return rv
+}
+
+// ParseKeyTrade extracts the market id and trade id from a trade key.
+// The input can have the following formats:
+//   - <type byte> | <market id> (4 bytes) | <trade id> (8 bytes)
+//   - <market id> (4 bytes) | <trade id> (8 bytes)
+func ParseKeyTrade(key []byte) (uint32, uint64, error) {
+	if len(key) == 13 && key[0] == KeyTypeTrade {
+		key = key[1:]
+	}
+	if len(key) != 12 {
+		return 0, 0, fmt.Errorf("cannot parse trade key: length %d, expected 12 or 13", len(key))
+	}
+	marketID, _ := uint32FromBz(key[:4])
+	tradeID, _ := uint64FromBz(key[4:])
+	return marketID, tradeID, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,9 +104,18 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "KeyTypeAddressToOrderIndex", value: keeper.KeyTypeAddressToOrderIndex},
 				{name: "KeyTypeAssetToOrderIndex", value: keeper.KeyTypeAssetToOrderIndex},
 				{name: "KeyTypeMarketExternalIDToOrderIndex", value: keeper.KeyTypeMarketExternalIDToOrderIndex},
+				{name: "KeyTypeExpirationHeightToOrderIndex", value: keeper.KeyTypeExpirationHeightToOrderIndex},
+				{name: "KeyTypeExpirationTimeToOrderIndex", value: keeper.KeyTypeExpirationTimeToOrderIndex},
+				{name: "KeyTypeConditionalOrder", value: keeper.KeyTypeConditionalOrder},
+				{name: "KeyTypeMarketToConditionalOrderIndex", value: keeper.KeyTypeMarketToConditionalOrderIndex},
+				{name: "KeyTypeBlockTradePrice", value: keeper.KeyTypeBlockTradePrice},
+				{name: "KeyTypeTrade", value: keeper.KeyTypeTrade},
 				{name: "KeyTypeCommitment", value: keeper.KeyTypeCommitment},
 				{name: "KeyTypePayment", value: keeper.KeyTypePayment},
 				{name: "KeyTypeTargetToPaymentIndex", value: keeper.KeyTypeTargetToPaymentIndex},
+				{name: "KeyTypeMarketPriceToOrderIndex", value: keeper.KeyTypeMarketPriceToOrderIndex},
+				{name: "KeyTypeTriggerPriceToConditionalOrderIndex", value: keeper.KeyTypeTriggerPriceToConditionalOrderIndex},
+				{name: "KeyTypeAddressToConditionalOrderIndex", value: keeper.KeyTypeAddressToConditionalOrderIndex},
 			},
 		},
 		{
@@ -125,6 +135,7 @@ func TestKeyTypeUniqueness(t *testing.T) {
 				{name: "MarketKeyTypeCreateCommitmentFlat", value: keeper.MarketKeyTypeCreateCommitmentFlat},
 				{name: "MarketKeyTypeCommitmentSettlementBips", value: keeper.MarketKeyTypeCommitmentSettlementBips},
 				{name: "MarketKeyTypeIntermediaryDenom", value: keeper.MarketKeyTypeIntermediaryDenom},
+				{name: "MarketKeyTypeAutoMatch", value: keeper.MarketKeyTypeAutoMatch},
 			},
 		},
 		{
@@ -3231,6 +3242,72 @@ func TestMakeKeyMarketIntermediaryDenom(t *testing.T) {
 	}
 }
 
+func TestMakeKeyMarketAutoMatch(t *testing.T) {
+	marketTypeByte := keeper.MarketKeyTypeAutoMatch
+
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 255",
+			marketID: 255,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 0, 255, marketTypeByte},
+		},
+		{
+			name:     "market id 256",
+			marketID: 256,
+			expected: []byte{keeper.KeyTypeMarket, 0, 0, 1, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 65_536",
+			marketID: 65_536,
+			expected: []byte{keeper.KeyTypeMarket, 0, 1, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,777,216",
+			marketID: 16_777_216,
+			expected: []byte{keeper.KeyTypeMarket, 1, 0, 0, 0, marketTypeByte},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeMarket, 1, 1, 1, 1, marketTypeByte},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeMarket, 255, 255, 255, 255, marketTypeByte},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyMarketAutoMatch(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixMarket", value: keeper.GetKeyPrefixMarket(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyMarketAutoMatch(%d)", tc.marketID)
+		})
+	}
+}
+
 func TestGetKeyPrefixOrder(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -4277,6 +4354,157 @@ func TestMakeIndexKeyMarketExternalIDToOrder(t *testing.T) {
 	}
 }
 
+func TestMakeIndexKeyExpirationHeightToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		height   uint64
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "height 0 order 0",
+			height:   0,
+			orderID:  0,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "height 258 order 1",
+			height:   258,
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex, 0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:    "height max order max",
+			height:  18_446_744_073_709_551_615,
+			orderID: 18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeExpirationHeightToOrderIndex,
+				255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationHeightToOrder(tc.height, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "", value: keeper.GetIndexKeyPrefixExpirationHeightToOrder()},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationHeightToOrder(%d, %d)", tc.height, tc.orderID)
+		})
+	}
+}
+
+func TestMakeIndexKeyExpirationTimeToOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		expTime  time.Time
+		orderID  uint64
+		expected []byte
+	}{
+		{
+			name:     "zero time",
+			expTime:  time.Time{},
+			orderID:  1,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "epoch",
+			expTime:  time.Unix(0, 0),
+			orderID:  2,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+		},
+		{
+			name:     "258 seconds and some nanos after epoch",
+			expTime:  time.Unix(258, 999_999_999),
+			orderID:  3,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0, 3},
+		},
+		{
+			name:     "2024-01-01",
+			expTime:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			orderID:  4,
+			expected: []byte{keeper.KeyTypeExpirationTimeToOrderIndex, 0, 0, 0, 0, 0x65, 0x92, 0x00, 0x80, 0, 0, 0, 0, 0, 0, 0, 4},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeIndexKeyExpirationTimeToOrder(tc.expTime, tc.orderID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "", value: keeper.GetIndexKeyPrefixExpirationTimeToOrder()},
+				},
+			}
+			checkKey(t, ktc, "MakeIndexKeyExpirationTimeToOrder(%s, %d)", tc.expTime, tc.orderID)
+		})
+	}
+}
+
+func TestParseIndexKeyExpirationToOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        []byte
+		expExp     uint64
+		expOrderID uint64
+		expErr     string
+	}{
+		{
+			name:   "nil key",
+			key:    nil,
+			expErr: "cannot parse expiration to order key: length 0, expected 16 or 17",
+		},
+		{
+			name:   "15 bytes",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			expErr: "cannot parse expiration to order key: length 15, expected 16 or 17",
+		},
+		{
+			name:   "17 bytes unknown type",
+			key:    []byte{keeper.KeyTypeOrder, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+			expErr: "cannot parse expiration to order key: unknown type byte 0x2, expected 0xa or 0xb",
+		},
+		{
+			name:       "without type byte",
+			key:        []byte{0, 0, 0, 0, 0, 0, 1, 2, 0, 0, 0, 0, 0, 0, 0, 3},
+			expExp:     258,
+			expOrderID: 3,
+		},
+		{
+			name:       "height key",
+			key:        keeper.MakeIndexKeyExpirationHeightToOrder(5555, 77),
+			expExp:     5555,
+			expOrderID: 77,
+		},
+		{
+			name:       "time key",
+			key:        keeper.MakeIndexKeyExpirationTimeToOrder(time.Unix(1_700_000_000, 5), 88),
+			expExp:     1_700_000_000,
+			expOrderID: 88,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var exp, orderID uint64
+			var err error
+			testFunc := func() {
+				exp, orderID, err = keeper.ParseIndexKeyExpirationToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyExpirationToOrder")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyExpirationToOrder error")
+			assert.Equal(t, tc.expExp, exp, "ParseIndexKeyExpirationToOrder expiration")
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyExpirationToOrder order id")
+		})
+	}
+}
+
 func TestGetKeyPrefixCommitments(t *testing.T) {
 	ktc := keyTestCase{
 		maker: func() []byte {
@@ -5375,3 +5603,338 @@ func TestParseIndexKeySuffixTargetToPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestGetKeyPrefixTrades(t *testing.T) {
+	ktc := keyTestCase{
+		maker: func() []byte {
+			return keeper.GetKeyPrefixTrades()
+		},
+		expected: []byte{keeper.KeyTypeTrade},
+	}
+	checkKey(t, ktc, "GetKeyPrefixTrades")
+}
+
+func TestGetKeyPrefixMarketTrades(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		expected []byte
+	}{
+		{
+			name:     "market id 0",
+			marketID: 0,
+			expected: []byte{keeper.KeyTypeTrade, 0, 0, 0, 0},
+		},
+		{
+			name:     "market id 1",
+			marketID: 1,
+			expected: []byte{keeper.KeyTypeTrade, 0, 0, 0, 1},
+		},
+		{
+			name:     "market id 16,843,009",
+			marketID: 16_843_009,
+			expected: []byte{keeper.KeyTypeTrade, 1, 1, 1, 1},
+		},
+		{
+			name:     "market id 4,294,967,295",
+			marketID: 4_294_967_295,
+			expected: []byte{keeper.KeyTypeTrade, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.GetKeyPrefixMarketTrades(tc.marketID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixTrades", value: keeper.GetKeyPrefixTrades()},
+				},
+			}
+			checkKey(t, ktc, "GetKeyPrefixMarketTrades(%d)", tc.marketID)
+		})
+	}
+}
+
+func TestMakeKeyTrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		marketID uint32
+		tradeID  uint64
+		expected []byte
+	}{
+		{
+			name:     "zeros",
+			marketID: 0,
+			tradeID:  0,
+			expected: []byte{keeper.KeyTypeTrade, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		},
+		{
+			name:     "market 1 trade 1",
+			marketID: 1,
+			tradeID:  1,
+			expected: []byte{keeper.KeyTypeTrade, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:     "market 258 trade 72,623,859,790,382,856",
+			marketID: 258,
+			tradeID:  72_623_859_790_382_856,
+			expected: []byte{keeper.KeyTypeTrade, 0, 0, 1, 2, 1, 2, 3, 4, 5, 6, 7, 8},
+		},
+		{
+			name:     "max market max trade",
+			marketID: 4_294_967_295,
+			tradeID:  18_446_744_073_709_551_615,
+			expected: []byte{keeper.KeyTypeTrade, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ktc := keyTestCase{
+				maker: func() []byte {
+					return keeper.MakeKeyTrade(tc.marketID, tc.tradeID)
+				},
+				expected: tc.expected,
+				expPrefixes: []expectedPrefix{
+					{name: "GetKeyPrefixTrades", value: keeper.GetKeyPrefixTrades()},
+					{name: "GetKeyPrefixMarketTrades", value: keeper.GetKeyPrefixMarketTrades(tc.marketID)},
+				},
+			}
+			checkKey(t, ktc, "MakeKeyTrade(%d, %d)", tc.marketID, tc.tradeID)
+		})
+	}
+}
+
+func TestParseKeyTrade(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expTradeID  uint64
+		expErr      string
+	}{
+		{name: "nil key", key: nil, expErr: "cannot parse trade key: length 0, expected 12 or 13"},
+		{name: "empty key", key: []byte{}, expErr: "cannot parse trade key: length 0, expected 12 or 13"},
+		{
+			name:   "11 byte key",
+			key:    []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			expErr: "cannot parse trade key: length 11, expected 12 or 13",
+		},
+		{
+			name:   "13 byte key wrong type",
+			key:    []byte{keeper.KeyTypeOrder, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+			expErr: "cannot parse trade key: length 13, expected 12 or 13",
+		},
+		{
+			name:        "12 byte key",
+			key:         []byte{0, 0, 1, 2, 1, 2, 3, 4, 5, 6, 7, 8},
+			expMarketID: 258,
+			expTradeID:  72_623_859_790_382_856,
+		},
+		{
+			name:        "13 byte key",
+			key:         []byte{keeper.KeyTypeTrade, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 2},
+			expMarketID: 1,
+			expTradeID:  2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var marketID uint32
+			var tradeID uint64
+			var err error
+			testFunc := func() {
+				marketID, tradeID, err = keeper.ParseKeyTrade(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseKeyTrade")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseKeyTrade error")
+			assert.Equal(t, tc.expMarketID, marketID, "ParseKeyTrade market id")
+			assert.Equal(t, tc.expTradeID, tradeID, "ParseKeyTrade trade id")
+		})
+	}
+}
+
+func TestMakeIndexKeyMarketPriceToOrder(t *testing.T) {
+	askOrder := func(orderID uint64, assets, price int64) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 3,
+			Assets:   sdk.NewInt64Coin("apple", assets),
+			Price:    sdk.NewInt64Coin("peach", price),
+		})
+	}
+	bidOrder := func(orderID uint64, assets, price int64) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: 3,
+			Assets:   sdk.NewInt64Coin("apple", assets),
+			Price:    sdk.NewInt64Coin("peach", price),
+		})
+	}
+
+	t.Run("ask order", func(t *testing.T) {
+		key := keeper.MakeIndexKeyMarketPriceToOrder(askOrder(7, 2, 3))
+		// 3 / 2 = 1.5 = 1_500_000_000_000_000_000 (18 decimals) = 0x14d1120d7b160000.
+		expected := []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 3}
+		expected = append(expected, "apple"...)
+		expected = append(expected, keeper.RecordSeparator)
+		expected = append(expected, "peach"...)
+		expected = append(expected, keeper.RecordSeparator, exchange.OrderTypeByteAsk)
+		expected = append(expected, 8, 0x14, 0xd1, 0x12, 0x0d, 0x7b, 0x16, 0x00, 0x00)
+		expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 7)
+		assert.Equal(t, expected, key, "MakeIndexKeyMarketPriceToOrder")
+		assert.True(t, bytes.HasPrefix(key, keeper.GetIndexKeyPrefixMarketPriceToOrder(3)), "has market prefix")
+		assert.True(t, bytes.HasPrefix(key, keeper.GetIndexKeyPrefixMarketPriceToOrderBook(3, "apple", "peach")), "has book prefix")
+		assert.True(t, bytes.HasPrefix(key, keeper.GetIndexKeyPrefixMarketPriceToOrderBookSide(3, "apple", "peach", exchange.OrderTypeByteAsk)), "has book side prefix")
+	})
+
+	t.Run("keys sort by unit price then order id", func(t *testing.T) {
+		// Ordered the way their keys should sort.
+		orders := []*exchange.Order{
+			askOrder(9, 3, 1),
+			askOrder(5, 10, 10),
+			askOrder(6, 1, 1),
+			askOrder(4, 2, 3),
+			askOrder(1, 1, 2),
+			askOrder(2, 1, 2),
+			askOrder(3, 1, 256),
+			askOrder(8, 1, 1_000_000_000),
+			bidOrder(3, 5, 1),
+			bidOrder(2, 5, 1),
+			bidOrder(4, 1, 1),
+		}
+		for i := 1; i < len(orders); i++ {
+			prev := keeper.MakeIndexKeyMarketPriceToOrder(orders[i-1])
+			cur := keeper.MakeIndexKeyMarketPriceToOrder(orders[i])
+			assert.Equal(t, -1, bytes.Compare(prev, cur), "compare key for order %d (%s) to key for order %d (%s)",
+				orders[i-1].OrderId, orders[i-1].GetPrice(), orders[i].OrderId, orders[i].GetPrice())
+		}
+	})
+}
+
+func TestParseIndexKeyMarketPriceToOrder(t *testing.T) {
+	order := exchange.NewOrder(12).WithBid(&exchange.BidOrder{
+		MarketId: 258,
+		Assets:   sdk.NewInt64Coin("apple", 3),
+		Price:    sdk.NewInt64Coin("peach", 100),
+	})
+	goodKey := keeper.MakeIndexKeyMarketPriceToOrder(order)
+	errMsg := func(key []byte) string {
+		return fmt.Sprintf("cannot parse market price to order key %v: unknown format", key)
+	}
+
+	tests := []struct {
+		name        string
+		key         []byte
+		expMarketID uint32
+		expAsset    string
+		expPrice    string
+		expTypeByte byte
+		expOrderID  uint64
+		expErr      string
+	}{
+		{name: "nil key", key: nil, expErr: errMsg(nil)},
+		{name: "wrong type byte", key: append([]byte{keeper.KeyTypeOrder}, goodKey[1:]...)},
+		{name: "missing order id byte", key: goodKey[:len(goodKey)-1]},
+		{name: "no separators", key: []byte{keeper.KeyTypeMarketPriceToOrderIndex, 0, 0, 0, 1, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13}},
+		{
+			name:        "good key",
+			key:         goodKey,
+			expMarketID: 258,
+			expAsset:    "apple",
+			expPrice:    "peach",
+			expTypeByte: exchange.OrderTypeByteBid,
+			expOrderID:  12,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expOrderID == 0 && len(tc.expErr) == 0 {
+				tc.expErr = errMsg(tc.key)
+			}
+			var marketID uint32
+			var assetDenom, priceDenom string
+			var typeByte byte
+			var orderID uint64
+			var err error
+			testFunc := func() {
+				marketID, assetDenom, priceDenom, typeByte, orderID, err = keeper.ParseIndexKeyMarketPriceToOrder(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseIndexKeyMarketPriceToOrder")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseIndexKeyMarketPriceToOrder error")
+			assert.Equal(t, tc.expMarketID, marketID, "ParseIndexKeyMarketPriceToOrder market id")
+			assert.Equal(t, tc.expAsset, assetDenom, "ParseIndexKeyMarketPriceToOrder asset denom")
+			assert.Equal(t, tc.expPrice, priceDenom, "ParseIndexKeyMarketPriceToOrder price denom")
+			assert.Equal(t, tc.expTypeByte, typeByte, "ParseIndexKeyMarketPriceToOrder order type byte")
+			assert.Equal(t, tc.expOrderID, orderID, "ParseIndexKeyMarketPriceToOrder order id")
+		})
+	}
+}
+
+func TestMakeIndexKeyAddressToConditionalOrder(t *testing.T) {
+	addr := sdk.AccAddress("abcdefghijklmnopqrst")
+	key := keeper.MakeIndexKeyAddressToConditionalOrder(addr, 258)
+	expected := concatBz(
+		[]byte{keeper.KeyTypeAddressToConditionalOrderIndex, 20},
+		[]byte("abcdefghijklmnopqrst"),
+		[]byte{0, 0, 0, 0, 0, 0, 1, 2},
+	)
+	assert.Equal(t, expected, key, "MakeIndexKeyAddressToConditionalOrder")
+	prefix := keeper.GetIndexKeyPrefixAddressToConditionalOrder(addr)
+	assert.Equal(t, expected[:22], prefix, "GetIndexKeyPrefixAddressToConditionalOrder")
+
+	assert.PanicsWithError(t, "empty address not allowed", func() {
+		keeper.MakeIndexKeyAddressToConditionalOrder(nil, 1)
+	}, "MakeIndexKeyAddressToConditionalOrder(nil, 1)")
+}
+
+func TestMakeIndexKeyTriggerPriceToConditionalOrder(t *testing.T) {
+	condOrder := func(orderID uint64, triggerPrice string, direction exchange.TriggerDirection) exchange.ConditionalOrder {
+		order := exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 3,
+			Assets:   sdk.NewInt64Coin("apple", 10),
+			Price:    sdk.NewInt64Coin("peach", 50),
+		})
+		return *exchange.NewConditionalOrder(order, sdkmath.LegacyMustNewDecFromStr(triggerPrice), direction, nil)
+	}
+	below, above := exchange.TriggerDirection_at_or_below, exchange.TriggerDirection_at_or_above
+
+	t.Run("at or below", func(t *testing.T) {
+		key := keeper.MakeIndexKeyTriggerPriceToConditionalOrder(condOrder(7, "1.5", below))
+		expected := []byte{keeper.KeyTypeTriggerPriceToConditionalOrderIndex, 0, 0, 0, 3}
+		expected = append(expected, "apple"...)
+		expected = append(expected, keeper.RecordSeparator)
+		expected = append(expected, "peach"...)
+		expected = append(expected, keeper.RecordSeparator, byte(below))
+		expected = append(expected, 8, 0x14, 0xd1, 0x12, 0x0d, 0x7b, 0x16, 0x00, 0x00)
+		expected = append(expected, 0, 0, 0, 0, 0, 0, 0, 7)
+		assert.Equal(t, expected, key, "MakeIndexKeyTriggerPriceToConditionalOrder")
+		prefix := keeper.GetIndexKeyPrefixTriggerPriceToConditionalOrder(3, "apple", "peach", below)
+		assert.True(t, bytes.HasPrefix(key, prefix), "has direction prefix")
+		prefixAt := keeper.GetIndexKeyPrefixTriggerPriceToConditionalOrderAt(3, "apple", "peach", below, sdkmath.LegacyMustNewDecFromStr("1.5"))
+		assert.True(t, bytes.HasPrefix(key, prefixAt), "has trigger price prefix")
+	})
+
+	t.Run("keys sort by direction then trigger price then order id", func(t *testing.T) {
+		// Ordered the way their keys should sort.
+		orders := []exchange.ConditionalOrder{
+			condOrder(9, "0.000000000000000001", below),
+			condOrder(5, "0.5", below),
+			condOrder(2, "1", below),
+			condOrder(3, "1", below),
+			condOrder(1, "256", below),
+			condOrder(4, "0.1", above),
+			condOrder(6, "1000000000", above),
+		}
+		for i := 1; i < len(orders); i++ {
+			prev := keeper.MakeIndexKeyTriggerPriceToConditionalOrder(orders[i-1])
+			cur := keeper.MakeIndexKeyTriggerPriceToConditionalOrder(orders[i])
+			assert.Equal(t, -1, bytes.Compare(prev, cur), "compare key for order %d (%s %s) to key for order %d (%s %s)",
+				orders[i-1].GetOrderID(), orders[i-1].TriggerDirection, orders[i-1].TriggerPrice,
+				orders[i].GetOrderID(), orders[i].TriggerDirection, orders[i].TriggerPrice)
+		}
+	})
+}
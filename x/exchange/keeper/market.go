@@ -879,6 +879,22 @@ func setMarketAcceptingCommitments(store storetypes.KVStore, marketID uint32, al
 	}
 }
 
+// isMarketAutoMatch gets whether a market's orders are automatically matched.
+func isMarketAutoMatch(store storetypes.KVStore, marketID uint32) bool {
+	key := MakeKeyMarketAutoMatch(marketID)
+	return store.Has(key)
+}
+
+// setMarketAutoMatch sets whether a market's orders are automatically matched.
+func setMarketAutoMatch(store storetypes.KVStore, marketID uint32, autoMatch bool) {
+	key := MakeKeyMarketAutoMatch(marketID)
+	if autoMatch {
+		store.Set(key, []byte{})
+	} else {
+		store.Delete(key)
+	}
+}
+
 // IsMarketKnown returns true if the provided market id is a known market's id.
 func (k Keeper) IsMarketKnown(ctx sdk.Context, marketID uint32) bool {
 	return isMarketKnown(k.getStore(ctx), marketID)
@@ -942,6 +958,24 @@ func (k Keeper) UpdateMarketAcceptingCommitments(ctx sdk.Context, marketID uint3
 	return nil
 }
 
+// IsMarketAutoMatch gets whether a market's orders are automatically matched.
+func (k Keeper) IsMarketAutoMatch(ctx sdk.Context, marketID uint32) bool {
+	return isMarketAutoMatch(k.getStore(ctx), marketID)
+}
+
+// UpdateMarketAutoMatch updates the auto-match flag for a market.
+// An error is returned if the setting is already what is provided.
+func (k Keeper) UpdateMarketAutoMatch(ctx sdk.Context, marketID uint32, autoMatch bool, updatedBy string) error {
+	store := k.getStore(ctx)
+	current := isMarketAutoMatch(store, marketID)
+	if current == autoMatch {
+		return fmt.Errorf("market %d already has auto-match %t", marketID, autoMatch)
+	}
+	setMarketAutoMatch(store, marketID, autoMatch)
+	k.emitEvent(ctx, exchange.NewEventMarketAutoMatchUpdated(marketID, updatedBy, autoMatch))
+	return nil
+}
+
 // storeHasPermission returns true if there is an entry in the store for the given market, address, and permissions.
 func storeHasPermission(store storetypes.KVStore, marketID uint32, addr sdk.AccAddress, permission exchange.Permission) bool {
 	key := MakeKeyMarketPermissions(marketID, addr, permission)
@@ -1414,6 +1448,7 @@ func storeMarket(store storetypes.KVStore, market exchange.Market) {
 	setMarketAcceptingCommitments(store, marketID, market.AcceptingCommitments)
 	setCommitmentSettlementBips(store, marketID, market.CommitmentSettlementBips)
 	setIntermediaryDenom(store, marketID, market.IntermediaryDenom)
+	setMarketAutoMatch(store, marketID, market.AutoMatch)
 }
 
 // initMarket is similar to CreateMarket but assumes the market has already been
@@ -1506,6 +1541,7 @@ func (k Keeper) GetMarket(ctx sdk.Context, marketID uint32) *exchange.Market {
 	market.AcceptingCommitments = isMarketAcceptingCommitments(store, marketID)
 	market.CommitmentSettlementBips = getCommitmentSettlementBips(store, marketID)
 	market.IntermediaryDenom = getIntermediaryDenom(store, marketID)
+	market.AutoMatch = isMarketAutoMatch(store, marketID)
 
 	if marketAcc := k.GetMarketAccount(ctx, marketID); marketAcc != nil {
 		market.MarketDetails = marketAcc.MarketDetails
@@ -4452,6 +4452,190 @@ func (s *TestSuite) TestKeeper_UpdateMarketAcceptingCommitments() {
 	}
 }
 
+func (s *TestSuite) TestKeeper_IsMarketAutoMatch() {
+	setter := keeper.SetMarketAutoMatch
+	tests := []struct {
+		name     string
+		setup    func()
+		marketID uint32
+		expected bool
+	}{
+		{
+			name:     "empty state",
+			marketID: 1,
+			expected: false,
+		},
+		{
+			name: "unknown market id",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "not allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: false,
+		},
+		{
+			name: "allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, true)
+				setter(store, 3, true)
+			},
+			marketID: 2,
+			expected: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var actual bool
+			testFunc := func() {
+				actual = s.k.IsMarketAutoMatch(s.ctx, tc.marketID)
+			}
+			s.Require().NotPanics(testFunc, "IsMarketAutoMatch(%d)", tc.marketID)
+			s.Assert().Equal(tc.expected, actual, "IsMarketAutoMatch(%d) result", tc.marketID)
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_UpdateMarketAutoMatch() {
+	setter := keeper.SetMarketAutoMatch
+	tests := []struct {
+		name      string
+		setup     func()
+		marketID  uint32
+		allow     bool
+		updatedBy string
+		expErr    string
+	}{
+		{
+			name:      "empty state to allowed",
+			marketID:  1,
+			allow:     true,
+			updatedBy: "updatedBy___________",
+			expErr:    "",
+		},
+		{
+			name:      "empty state to not allowed",
+			marketID:  1,
+			allow:     false,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 1 already has auto-match false",
+		},
+		{
+			name: "allowed to allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			allow:     true,
+			updatedBy: "updatedBy___________",
+			expErr:    "market 3 already has auto-match true",
+		},
+		{
+			name: "allowed to not allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 1, true)
+				setter(store, 2, false)
+				setter(store, 3, true)
+				setter(store, 4, true)
+				setter(store, 5, false)
+			},
+			marketID:  3,
+			allow:     false,
+			updatedBy: "updated_by__________",
+			expErr:    "",
+		},
+		{
+			name: "not allowed to allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			allow:     true,
+			updatedBy: "updated___by________",
+			expErr:    "",
+		},
+		{
+			name: "not allowed to not allowed",
+			setup: func() {
+				store := s.getStore()
+				setter(store, 11, true)
+				setter(store, 12, false)
+				setter(store, 13, false)
+				setter(store, 14, true)
+				setter(store, 15, false)
+			},
+			marketID:  13,
+			allow:     false,
+			updatedBy: "__updated_____by____",
+			expErr:    "market 13 already has auto-match false",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			var expEvents sdk.Events
+			if len(tc.expErr) == 0 {
+				event := exchange.NewEventMarketAutoMatchUpdated(tc.marketID, tc.updatedBy, tc.allow)
+				expEvents = append(expEvents, s.untypeEvent(event))
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			var err error
+			testFunc := func() {
+				err = s.k.UpdateMarketAutoMatch(ctx, tc.marketID, tc.allow, tc.updatedBy)
+			}
+			s.Require().NotPanics(testFunc, "UpdateMarketAutoMatch(%d, %t, %s)", tc.marketID, tc.allow, string(tc.updatedBy))
+			s.assertErrorValue(err, tc.expErr, "UpdateMarketAutoMatch(%d, %t, %s)", tc.marketID, tc.allow, string(tc.updatedBy))
+
+			events := em.Events()
+			s.assertEqualEvents(expEvents, events, "events after UpdateMarketAutoMatch")
+
+			if len(tc.expErr) == 0 {
+				isActive := s.k.IsMarketAutoMatch(s.ctx, tc.marketID)
+				s.Assert().Equal(tc.allow, isActive, "IsMarketAutoMatch(%d) after UpdateMarketAutoMatch(%d, %t, ...)",
+					tc.marketID, tc.marketID, tc.allow)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestKeeper_HasPermission() {
 	goodAcc := sdk.AccAddress("goodAddr____________")
 	goodAddr := goodAcc.String()
@@ -7303,6 +7487,8 @@ func (s *TestSuite) TestKeeper_CreateMarket() {
 				CommitmentSettlementBips: 15,
 				IntermediaryDenom:        "cherry",
 				ReqAttrCreateCommitment:  []string{"*.com.whatever"},
+
+				AutoMatch: true,
 			},
 			expMarketID:   3,
 			expHasAccCall: true,
@@ -7509,6 +7695,8 @@ func (s *TestSuite) TestKeeper_GetMarket() {
 					CommitmentSettlementBips: 15,
 					IntermediaryDenom:        "cherry",
 					ReqAttrCreateCommitment:  []string{"create-com.my.market", "*.kyc.someone"},
+
+					AutoMatch: true,
 				}
 
 				store := s.getStore()
@@ -8201,15 +8389,15 @@ func (s *TestSuite) TestKeeper_CloseMarket() {
 
 	expHoldCalls := HoldCalls{
 		ReleaseHold: []*ReleaseHoldArgs{
-			NewReleaseHoldArgs(s.addr1, s.coins("10apple")),
-			NewReleaseHoldArgs(s.addr1, s.coins("15apple")),
-			NewReleaseHoldArgs(s.addr1, s.coins("20apple")),
-			NewReleaseHoldArgs(s.addr2, s.coins("70peach")),
-			NewReleaseHoldArgs(s.addr3, s.coins("5plum")),
-			NewReleaseHoldArgs(s.addr3, s.coins("67acorn")),
-			NewReleaseHoldArgs(s.addr1, s.coins("57cherry,12orange")),
-			NewReleaseHoldArgs(s.addr3, s.coins("88apple,52banana")),
-			NewReleaseHoldArgs(s.addr5, s.coins("14acorn,8peach,15plum")),
+			NewReleaseHoldArgs(s.addr1, s.coins("10apple"), "x/exchange: order 1"),
+			NewReleaseHoldArgs(s.addr1, s.coins("15apple"), "x/exchange: order 2"),
+			NewReleaseHoldArgs(s.addr1, s.coins("20apple"), "x/exchange: order 3"),
+			NewReleaseHoldArgs(s.addr2, s.coins("70peach"), "x/exchange: order 10"),
+			NewReleaseHoldArgs(s.addr3, s.coins("5plum"), "x/exchange: order 22"),
+			NewReleaseHoldArgs(s.addr3, s.coins("67acorn"), "x/exchange: order 24"),
+			NewReleaseHoldArgs(s.addr1, s.coins("57cherry,12orange"), "x/exchange: commitment to 14"),
+			NewReleaseHoldArgs(s.addr3, s.coins("88apple,52banana"), "x/exchange: commitment to 14"),
+			NewReleaseHoldArgs(s.addr5, s.coins("14acorn,8peach,15plum"), "x/exchange: commitment to 14"),
 		},
 	}
 	expEvents := make(sdk.Events, 2, 2+len(marketOrders)+len(marketCommitments))
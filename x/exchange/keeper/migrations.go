@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 adds the existing orders to the market price to order index.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	logger := m.keeper.getLogger(ctx)
+	logger.Info("Building exchange market price to order index.")
+	var entries []kv.Pair
+	err := m.keeper.IterateOrders(ctx, func(order *exchange.Order) bool {
+		entries = append(entries, kv.Pair{Key: MakeIndexKeyMarketPriceToOrder(order), Value: []byte{order.GetOrderTypeByte()}})
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	store := m.keeper.getStore(ctx)
+	for _, entry := range entries {
+		store.Set(entry.Key, entry.Value)
+	}
+	logger.Info("Done building exchange market price to order index.")
+	return nil
+}
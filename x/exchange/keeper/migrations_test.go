@@ -0,0 +1,50 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+func (s *TestSuite) TestMigrate1to2() {
+	seller, buyer := sdk.AccAddress("seller______________").String(), sdk.AccAddress("buyer_______________").String()
+	s.clearExchangeState()
+	store := s.getStore()
+	orders := s.requireSetOrdersInStore(store,
+		exchange.NewOrder(1).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: seller, Assets: s.coin("10apple"), Price: s.coin("50peach"),
+		}),
+		exchange.NewOrder(2).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: buyer, Assets: s.coin("10apple"), Price: s.coin("55peach"),
+		}),
+		exchange.NewOrder(3).WithAsk(&exchange.AskOrder{
+			MarketId: 2, Seller: seller, Assets: s.coin("5apple"), Price: s.coin("20plum"),
+		}),
+	)
+
+	// Remove the index entries to mimic state from before the index existed.
+	var expKeys [][]byte
+	for _, order := range orders {
+		key := keeper.MakeIndexKeyMarketPriceToOrder(order)
+		s.Require().True(store.Has(key), "store.Has(price index key for order %d) before delete", order.OrderId)
+		store.Delete(key)
+		expKeys = append(expKeys, key)
+	}
+
+	s.logBuffer.Reset()
+	err := keeper.NewMigrator(s.k).Migrate1to2(s.ctx)
+	s.Require().NoError(err, "Migrate1to2")
+
+	actLog := s.splitOutputLog(s.getLogOutput("Migrate1to2"))
+	expLog := []string{
+		"INF Building exchange market price to order index. module=x/exchange",
+		"INF Done building exchange market price to order index. module=x/exchange",
+	}
+	s.Assert().Equal(expLog, actLog, "Lines logged during Migrate1to2")
+
+	for i, key := range expKeys {
+		s.Assert().Equal([]byte{orders[i].GetOrderTypeByte()}, store.Get(key), "value of price index key for order %d", orders[i].OrderId)
+	}
+
+}
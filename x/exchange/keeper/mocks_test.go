@@ -512,13 +512,16 @@ type HoldCalls struct {
 type AddHoldArgs struct {
 	addr   sdk.AccAddress
 	funds  sdk.Coins
+	holder string
 	reason string
 }
 
 // ReleaseHoldArgs is a record of a call that is made to ReleaseHold.
 type ReleaseHoldArgs struct {
-	addr  sdk.AccAddress
-	funds sdk.Coins
+	addr   sdk.AccAddress
+	funds  sdk.Coins
+	holder string
+	reason string
 }
 
 // GetHoldCoinArgs is a record of a call that is made to GetHoldCoin.
@@ -589,8 +592,8 @@ func (k *MockHoldKeeper) WithGetHoldCoinErrorResult(addr sdk.AccAddress, denom s
 	return k
 }
 
-func (k *MockHoldKeeper) AddHold(_ sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error {
-	k.Calls.AddHold = append(k.Calls.AddHold, NewAddHoldArgs(addr, funds, reason))
+func (k *MockHoldKeeper) AddHold(_ sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error {
+	k.Calls.AddHold = append(k.Calls.AddHold, &AddHoldArgs{addr: addr, funds: funds, holder: holder, reason: reason})
 	var err error
 	if len(k.AddHoldResultsQueue) > 0 {
 		if len(k.AddHoldResultsQueue[0]) > 0 {
@@ -601,8 +604,8 @@ func (k *MockHoldKeeper) AddHold(_ sdk.Context, addr sdk.AccAddress, funds sdk.C
 	return err
 }
 
-func (k *MockHoldKeeper) ReleaseHold(_ sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error {
-	k.Calls.ReleaseHold = append(k.Calls.ReleaseHold, NewReleaseHoldArgs(addr, funds))
+func (k *MockHoldKeeper) ReleaseHold(_ sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error {
+	k.Calls.ReleaseHold = append(k.Calls.ReleaseHold, &ReleaseHoldArgs{addr: addr, funds: funds, holder: holder, reason: reason})
 	var err error
 	if len(k.ReleaseHoldResultsQueue) > 0 {
 		if len(k.ReleaseHoldResultsQueue[0]) > 0 {
@@ -652,31 +655,34 @@ func (s *TestSuite) assertHoldKeeperCalls(mk *MockHoldKeeper, expected HoldCalls
 	return s.assertGetHoldCoinCalls(mk, expected.GetHoldCoin, msg, args...) && rv
 }
 
-// NewAddHoldArgs creates a new record of args provided to a call to AddHold.
+// NewAddHoldArgs creates a new record of args provided to a call to AddHold by the exchange module.
 func NewAddHoldArgs(addr sdk.AccAddress, funds sdk.Coins, reason string) *AddHoldArgs {
 	return &AddHoldArgs{
 		addr:   addr,
 		funds:  funds,
+		holder: exchange.ModuleName,
 		reason: reason,
 	}
 }
 
 // addHoldArgsString creates a string of a AddHoldArgs substituting the address names as possible.
 func (s *TestSuite) addHoldArgsString(a *AddHoldArgs) string {
-	return fmt.Sprintf("{addr:%s, funds:%s, reason:%q}", s.getAddrName(a.addr), a.funds, a.reason)
+	return fmt.Sprintf("{addr:%s, funds:%s, holder:%q, reason:%q}", s.getAddrName(a.addr), a.funds, a.holder, a.reason)
 }
 
-// NewReleaseHoldArgs creates a new record of args provided to a call to ReleaseHold.
-func NewReleaseHoldArgs(addr sdk.AccAddress, funds sdk.Coins) *ReleaseHoldArgs {
+// NewReleaseHoldArgs creates a new record of args provided to a call to ReleaseHold by the exchange module.
+func NewReleaseHoldArgs(addr sdk.AccAddress, funds sdk.Coins, reason string) *ReleaseHoldArgs {
 	return &ReleaseHoldArgs{
-		addr:  addr,
-		funds: funds,
+		addr:   addr,
+		funds:  funds,
+		holder: exchange.ModuleName,
+		reason: reason,
 	}
 }
 
 // releaseHoldArgsString creates a string of a ReleaseHoldArgs substituting the address names as possible.
 func (s *TestSuite) releaseHoldArgsString(a *ReleaseHoldArgs) string {
-	return fmt.Sprintf("{addr:%s, funds:%s}", s.getAddrName(a.addr), a.funds)
+	return fmt.Sprintf("{addr:%s, funds:%s, holder:%q, reason:%q}", s.getAddrName(a.addr), a.funds, a.holder, a.reason)
 }
 
 // NewGetHoldCoinArgs creates a new record of args provided to a call to GetHoldCoin.
@@ -71,6 +71,24 @@ func (k MsgServer) CancelOrder(goCtx context.Context, msg *exchange.MsgCancelOrd
 	return &exchange.MsgCancelOrderResponse{}, nil
 }
 
+// CreateConditionalOrder creates an ask or bid order that is only placed once a trade price condition is met.
+func (k MsgServer) CreateConditionalOrder(goCtx context.Context, msg *exchange.MsgCreateConditionalOrderRequest) (*exchange.MsgCreateConditionalOrderResponse, error) {
+	order := msg.GetOrder()
+	if order == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("either an ask order or bid order must be provided")
+	}
+	triggerPrice, err := msg.GetTriggerPriceDec()
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	orderID, err := k.Keeper.CreateConditionalOrder(ctx, *order, triggerPrice, msg.TriggerDirection, msg.OrderCreationFee)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgCreateConditionalOrderResponse{OrderId: orderID}, nil
+}
+
 // FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 func (k MsgServer) FillBids(goCtx context.Context, msg *exchange.MsgFillBidsRequest) (*exchange.MsgFillBidsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -232,6 +250,19 @@ func (k MsgServer) MarketUpdateAcceptingCommitments(goCtx context.Context, msg *
 	return &exchange.MsgMarketUpdateAcceptingCommitmentsResponse{}, nil
 }
 
+// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+func (k MsgServer) MarketUpdateAutoMatch(goCtx context.Context, msg *exchange.MsgMarketUpdateAutoMatchRequest) (*exchange.MsgMarketUpdateAutoMatchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.CanUpdateMarket(ctx, msg.MarketId, msg.Admin) {
+		return nil, permError("update", msg.Admin, msg.MarketId)
+	}
+	err := k.UpdateMarketAutoMatch(ctx, msg.MarketId, msg.AutoMatch, msg.Admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	return &exchange.MsgMarketUpdateAutoMatchResponse{}, nil
+}
+
 // MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 func (k MsgServer) MarketUpdateIntermediaryDenom(goCtx context.Context, msg *exchange.MsgMarketUpdateIntermediaryDenomRequest) (*exchange.MsgMarketUpdateIntermediaryDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
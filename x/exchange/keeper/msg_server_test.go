@@ -161,29 +161,33 @@ func (s *TestSuite) eventMessageSender(sender sdk.AccAddress) sdk.Event {
 }
 
 // eventHoldAddedOrder creates a new event emitted when a hold is added for an order (emitted by the hold module).
-func (s *TestSuite) eventHoldAddedOrder(addr sdk.AccAddress, amount string, orderID uint64) sdk.Event {
-	return s.untypeEvent(&hold.EventHoldAdded{
-		Address: addr.String(), Amount: amount, Reason: fmt.Sprintf("x/exchange: order %d", orderID),
-	})
+func (s *TestSuite) eventHoldAddedOrder(addr sdk.AccAddress, amount string, orderID uint64, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldAdded(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: order %d", orderID), holdID))
 }
 
 // eventHoldAddedCommitment creates a new event emitted when a hold is added for a commitment (emitted by the hold module).
-func (s *TestSuite) eventHoldAddedCommitment(addr sdk.AccAddress, amount string, marketID uint32) sdk.Event {
-	return s.untypeEvent(&hold.EventHoldAdded{
-		Address: addr.String(), Amount: amount, Reason: fmt.Sprintf("x/exchange: commitment to %d", marketID),
-	})
+func (s *TestSuite) eventHoldAddedCommitment(addr sdk.AccAddress, amount string, marketID uint32, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldAdded(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: commitment to %d", marketID), holdID))
 }
 
 // eventHoldAddedPayment creates a new event emitted when a hold is added for a payment (emitted by the hold module).
-func (s *TestSuite) eventHoldAddedPayment(addr sdk.AccAddress, amount string, externalID string) sdk.Event {
-	return s.untypeEvent(&hold.EventHoldAdded{
-		Address: addr.String(), Amount: amount, Reason: fmt.Sprintf("x/exchange: payment %q", externalID),
-	})
+func (s *TestSuite) eventHoldAddedPayment(addr sdk.AccAddress, amount string, externalID string, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldAdded(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: payment %q", externalID), holdID))
 }
 
-// eventHoldReleased creates a new event emitted when a hold is released (emitted by the hold module).
-func (s *TestSuite) eventHoldReleased(addr sdk.AccAddress, amount string) sdk.Event {
-	return s.untypeEvent(&hold.EventHoldReleased{Address: addr.String(), Amount: amount})
+// eventHoldReleasedOrder creates a new event emitted when a hold is released for an order (emitted by the hold module).
+func (s *TestSuite) eventHoldReleasedOrder(addr sdk.AccAddress, amount string, orderID uint64, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldReleased(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: order %d", orderID), holdID))
+}
+
+// eventHoldReleasedCommitment creates a new event emitted when a hold is released for a commitment (emitted by the hold module).
+func (s *TestSuite) eventHoldReleasedCommitment(addr sdk.AccAddress, amount string, marketID uint32, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldReleased(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: commitment to %d", marketID), holdID))
+}
+
+// eventHoldReleasedPayment creates a new event emitted when a hold is released for a payment (emitted by the hold module).
+func (s *TestSuite) eventHoldReleasedPayment(addr sdk.AccAddress, amount string, externalID string, holdID uint64) sdk.Event {
+	return s.untypeEvent(hold.NewEventHoldReleased(addr, s.coins(amount), exchange.ModuleName, fmt.Sprintf("x/exchange: payment %q", externalID), holdID))
 }
 
 // eventFundsCommitted creates a new event emitted when funds are committed.
@@ -206,9 +210,9 @@ func (s *TestSuite) requireFundAccount(addr sdk.AccAddress, coins string) {
 // requireAddHold calls s.app.HoldKeeper.AddHold, making sure it doesn't panic or return an error.
 func (s *TestSuite) requireAddHold(addr sdk.AccAddress, holdCoins string, orderID uint64) {
 	coins := s.coins(holdCoins)
-	reason := fmt.Sprintf("test hold on order %d", orderID)
+	reason := fmt.Sprintf("x/exchange: order %d", orderID)
 	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
-		return s.app.HoldKeeper.AddHold(s.ctx, addr, coins, reason)
+		return s.app.HoldKeeper.AddHold(s.ctx, addr, coins, exchange.ModuleName, reason)
 	}, "AddHold(%s, %q, %q)", s.getAddrName(addr), holdCoins, reason)
 }
 
@@ -216,9 +220,9 @@ func (s *TestSuite) requireAddHold(addr sdk.AccAddress, holdCoins string, orderI
 func (s *TestSuite) requireSetCommitmentAmount(marketID uint32, addr sdk.AccAddress, amount string) {
 	coins := s.coins(amount)
 	keeper.SetCommitmentAmount(s.getStore(), marketID, addr, coins)
-	reason := fmt.Sprintf("test commitment for market %d", marketID)
+	reason := fmt.Sprintf("x/exchange: commitment to %d", marketID)
 	assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
-		return s.app.HoldKeeper.AddHold(s.ctx, addr, coins, reason)
+		return s.app.HoldKeeper.AddHold(s.ctx, addr, coins, exchange.ModuleName, reason)
 	}, "AddHold(%s, %q, %q)", s.getAddrName(addr), amount, reason)
 }
 
@@ -549,7 +553,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedOrder(s.addr2, "60apple", 84),
+				s.eventHoldAddedOrder(s.addr2, "60apple", 84, 1),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 84, OrderType: "ask", MarketId: 5, ExternalId: "",
 				}),
@@ -593,7 +597,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1pear"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "1pear"),
 				s.eventMessageSender(s.marketAddr2),
-				s.eventHoldAddedOrder(s.addr2, "75apple", 7),
+				s.eventHoldAddedOrder(s.addr2, "75apple", 7, 1),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 7, OrderType: "ask", MarketId: 2, ExternalId: "just-an-id",
 				}),
@@ -636,7 +640,7 @@ func (s *TestSuite) TestMsgServer_CreateAsk() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1fig"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1fig"),
 				s.eventMessageSender(s.marketAddr3),
-				s.eventHoldAddedOrder(s.addr2, "75apple,12fig", 12345),
+				s.eventHoldAddedOrder(s.addr2, "75apple,12fig", 12345, 1),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 12345, OrderType: "ask", MarketId: 3, ExternalId: "",
 				}),
@@ -799,7 +803,7 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedOrder(s.addr2, "45pear", 84),
+				s.eventHoldAddedOrder(s.addr2, "45pear", 84, 1),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 84, OrderType: "bid", MarketId: 2, ExternalId: "",
 				}),
@@ -843,7 +847,7 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1pear"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr2, "1pear"),
 				s.eventMessageSender(s.marketAddr2),
-				s.eventHoldAddedOrder(s.addr2, "87pear", 7),
+				s.eventHoldAddedOrder(s.addr2, "87pear", 7, 1),
 				s.untypeEvent(&exchange.EventOrderCreated{
 					OrderId: 7, OrderType: "bid", MarketId: 2, ExternalId: "some-random-id",
 				}),
@@ -860,6 +864,95 @@ func (s *TestSuite) TestMsgServer_CreateBid() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_CreateConditionalOrder() {
+	type followupArgs struct {
+		expOrderID uint64
+		expOrder   *exchange.ConditionalOrder
+	}
+	testDef := msgServerTestDef[exchange.MsgCreateConditionalOrderRequest, exchange.MsgCreateConditionalOrderResponse, followupArgs]{
+		endpointName: "CreateConditionalOrder",
+		endpoint:     keeper.NewMsgServer(s.k).CreateConditionalOrder,
+		followup: func(_ *exchange.MsgCreateConditionalOrderRequest, fargs followupArgs) {
+			if fargs.expOrder == nil {
+				return
+			}
+			order, err := s.k.GetConditionalOrder(s.ctx, fargs.expOrderID)
+			s.Assert().NoError(err, "GetConditionalOrder(%d) error", fargs.expOrderID)
+			s.Assert().Equal(fargs.expOrder, order, "GetConditionalOrder(%d) result", fargs.expOrderID)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgCreateConditionalOrderRequest, followupArgs]{
+		{
+			name:     "no order",
+			msg:      exchange.MsgCreateConditionalOrderRequest{Owner: s.addr1.String(), TriggerPrice: "1"},
+			expInErr: []string{invReqErr, "either an ask order or bid order must be provided"},
+		},
+		{
+			name: "invalid trigger price",
+			msg: exchange.MsgCreateConditionalOrderRequest{
+				Owner: s.addr1.String(),
+				BidOrder: &exchange.BidOrder{
+					MarketId: 1, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice:     "bad",
+				TriggerDirection: exchange.TriggerDirection_at_or_above,
+			},
+			expInErr: []string{invReqErr, "invalid trigger price \"bad\""},
+		},
+		{
+			name: "market does not exist",
+			msg: exchange.MsgCreateConditionalOrderRequest{
+				Owner: s.addr1.String(),
+				BidOrder: &exchange.BidOrder{
+					MarketId: 7, Buyer: s.addr1.String(), Assets: s.coin("1apple"), Price: s.coin("1peach"),
+				},
+				TriggerPrice:     "1",
+				TriggerDirection: exchange.TriggerDirection_at_or_above,
+			},
+			expInErr: []string{invReqErr, "market 7 does not exist"},
+		},
+		{
+			name: "okay",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{MarketId: 2, AcceptingOrders: true})
+				keeper.SetLastOrderID(s.getStore(), 83)
+			},
+			msg: exchange.MsgCreateConditionalOrderRequest{
+				Owner: s.addr2.String(),
+				AskOrder: &exchange.AskOrder{
+					MarketId: 2, Seller: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+					ExternalId: "stop-loss",
+				},
+				TriggerPrice:     "0.75",
+				TriggerDirection: exchange.TriggerDirection_at_or_below,
+			},
+			fArgs: followupArgs{
+				expOrderID: 84,
+				expOrder: exchange.NewConditionalOrder(exchange.NewOrder(84).WithAsk(&exchange.AskOrder{
+					MarketId: 2, Seller: s.addr2.String(),
+					Assets: s.coin("60apple"), Price: s.coin("45pear"),
+					ExternalId: "stop-loss",
+				}), sdkmath.LegacyMustNewDecFromStr("0.75"), exchange.TriggerDirection_at_or_below, nil),
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventConditionalOrderCreated{
+					OrderId: 84, OrderType: "ask", MarketId: 2, ExternalId: "stop-loss",
+				}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			td := testDef
+			td.expResp = &exchange.MsgCreateConditionalOrderResponse{OrderId: tc.fArgs.expOrderID}
+			runMsgServerTestCase(s, td, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_CommitFunds() {
 	testDef := msgServerTestDef[exchange.MsgCommitFundsRequest, exchange.MsgCommitFundsResponse, expBalances]{
 		endpointName: "CommitFunds",
@@ -971,7 +1064,7 @@ func (s *TestSuite) TestMsgServer_CommitFunds() {
 				s.eventCoinReceived(s.feeCollectorAddr, "1cherry"),
 				s.eventTransfer(s.feeCollectorAddr, s.marketAddr3, "1cherry"),
 				s.eventMessageSender(s.marketAddr3),
-				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), "x/exchange: commitment to 3")),
+				s.untypeEvent(hold.NewEventHoldAdded(s.addr2, s.coins("50apple,90cherry"), exchange.ModuleName, "x/exchange: commitment to 3", 1)),
 				s.untypeEvent(exchange.NewEventFundsCommitted(s.addr2.String(), 3, s.coins("50apple,90cherry"), "yayayayeah")),
 			},
 		},
@@ -1051,7 +1144,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("9apple"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1apple"),
+				s.eventHoldReleasedOrder(s.addr1, "1apple", 44, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
@@ -1078,7 +1171,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("10pear"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1pear"),
+				s.eventHoldReleasedOrder(s.addr1, "1pear", 44, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 44, CancelledBy: s.addr5.String(), MarketId: 2, ExternalId: "",
 				}),
@@ -1106,7 +1199,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("15apple,5fig"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "10apple,1fig"),
+				s.eventHoldReleasedOrder(s.addr1, "10apple,1fig", 5555, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
 				}),
@@ -1134,7 +1227,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("15apple,4fig"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventHoldReleasedOrder(s.addr2, "10apple", 98765, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
 				}),
@@ -1162,7 +1255,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("15pear,5fig"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "1fig,5pear"),
+				s.eventHoldReleasedOrder(s.addr1, "1fig,5pear", 5555, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 5555, CancelledBy: s.addr1.String(), MarketId: 1, ExternalId: "ext-id-5555",
 				}),
@@ -1190,7 +1283,7 @@ func (s *TestSuite) TestMsgServer_CancelOrder() {
 				expSpend: s.coins("15pear,5fig"),
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "6pear"),
+				s.eventHoldReleasedOrder(s.addr2, "6pear", 98765, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{
 					OrderId: 98765, CancelledBy: s.addr2.String(), MarketId: 3, ExternalId: "whatever",
 				}),
@@ -1281,7 +1374,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "50pear"),
+				s.eventHoldReleasedOrder(s.addr1, "50pear", 54, 1),
 				s.eventCoinSpent(s.addr2, "10apple"),
 				s.eventCoinReceived(s.addr1, "10apple"),
 				s.eventTransfer(s.addr1, s.addr2, "10apple"),
@@ -1339,7 +1432,7 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr1, "50pear"),
+				s.eventHoldReleasedOrder(s.addr1, "50pear", 54, 1),
 				s.eventCoinSpent(s.addr2, "10apple"),
 				s.eventCoinReceived(s.addr1, "10apple"),
 				s.eventTransfer(s.addr1, s.addr2, "10apple"),
@@ -1614,8 +1707,8 @@ func (s *TestSuite) TestMsgServer_FillBids() {
 			},
 			expEvents: sdk.Events{
 				// Hold release events.
-				s.eventHoldReleased(s.addr2, "35fig,50pear"),
-				s.eventHoldReleased(s.addr3, "32fig,20pear"),
+				s.eventHoldReleasedOrder(s.addr2, "35fig,50pear", 12345, 1),
+				s.eventHoldReleasedOrder(s.addr3, "32fig,20pear", 98765, 2),
 
 				// Asset transfer events.
 				s.eventCoinSpent(s.addr1, "13apple"),
@@ -1768,7 +1861,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventHoldReleasedOrder(s.addr2, "10apple", 54, 1),
 				s.eventCoinSpent(s.addr2, "10apple"),
 				s.eventCoinReceived(s.addr1, "10apple"),
 				s.eventTransfer(s.addr1, s.addr2, "10apple"),
@@ -1826,7 +1919,7 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "10apple"),
+				s.eventHoldReleasedOrder(s.addr2, "10apple", 54, 1),
 				s.eventCoinSpent(s.addr2, "10apple"),
 				s.eventCoinReceived(s.addr1, "10apple"),
 				s.eventTransfer(s.addr1, s.addr2, "10apple"),
@@ -2100,8 +2193,8 @@ func (s *TestSuite) TestMsgServer_FillAsks() {
 			},
 			expEvents: sdk.Events{
 				// Hold release events.
-				s.eventHoldReleased(s.addr2, "10apple"),
-				s.eventHoldReleased(s.addr3, "3apple,12fig"),
+				s.eventHoldReleasedOrder(s.addr2, "10apple", 12345, 1),
+				s.eventHoldReleasedOrder(s.addr3, "3apple,12fig", 98765, 2),
 
 				// Asset transfer events.
 				s.eventCoinSpent(s.addr2, "10apple"),
@@ -2388,10 +2481,10 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases (0-3)
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "100pear"),
-				s.eventHoldReleased(s.addr4, "85pear"),
+				s.eventHoldReleasedOrder(s.addr3, "11apple", 333, 3),
+				s.eventHoldReleasedOrder(s.addr1, "7apple", 1, 1),
+				s.eventHoldReleasedOrder(s.addr2, "100pear", 22, 2),
+				s.eventHoldReleasedOrder(s.addr4, "85pear", 4444, 4),
 
 				// Asset transfers (4-9, 10-13)
 				s.eventCoinSpent(s.addr3, "11apple"),
@@ -2562,10 +2655,10 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases (0-3)
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "100pear"),
-				s.eventHoldReleased(s.addr4, "85pear"),
+				s.eventHoldReleasedOrder(s.addr3, "11apple", 333, 3),
+				s.eventHoldReleasedOrder(s.addr1, "7apple", 1, 1),
+				s.eventHoldReleasedOrder(s.addr2, "100pear", 22, 2),
+				s.eventHoldReleasedOrder(s.addr4, "85pear", 4444, 4),
 
 				// Asset transfers (4-9, 10-13)
 				s.eventCoinSpent(s.addr3, "11apple"),
@@ -2679,10 +2772,10 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr4, "85pear"),
-				s.eventHoldReleased(s.addr2, "100pear"),
+				s.eventHoldReleasedOrder(s.addr1, "7apple", 1, 1),
+				s.eventHoldReleasedOrder(s.addr3, "11apple", 333, 3),
+				s.eventHoldReleasedOrder(s.addr4, "85pear", 4444, 4),
+				s.eventHoldReleasedOrder(s.addr2, "100pear", 22, 2),
 
 				// Asset transfers
 				s.eventCoinSpent(s.addr1, "7apple"),
@@ -2776,8 +2869,8 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases
-				s.eventHoldReleased(s.addr2, "75pear"),
-				s.eventHoldReleased(s.addr1, "7apple"),
+				s.eventHoldReleasedOrder(s.addr2, "75pear", 22, 2),
+				s.eventHoldReleasedOrder(s.addr1, "7apple", 1, 1),
 
 				// Asset transfer
 				s.eventCoinSpent(s.addr1, "7apple"),
@@ -2852,8 +2945,8 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple"),
-				s.eventHoldReleased(s.addr2, "70pear"),
+				s.eventHoldReleasedOrder(s.addr1, "7apple", 1, 1),
+				s.eventHoldReleasedOrder(s.addr2, "70pear", 22, 2),
 
 				// Asset transfer
 				s.eventCoinSpent(s.addr1, "7apple"),
@@ -3021,10 +3114,10 @@ func (s *TestSuite) TestMsgServer_MarketSettle() {
 			},
 			expEvents: sdk.Events{
 				// Hold releases
-				s.eventHoldReleased(s.addr1, "7apple,10fig"),
-				s.eventHoldReleased(s.addr3, "11apple"),
-				s.eventHoldReleased(s.addr2, "20fig,100pear"),
-				s.eventHoldReleased(s.addr4, "95pear"),
+				s.eventHoldReleasedOrder(s.addr1, "7apple,10fig", 1, 1),
+				s.eventHoldReleasedOrder(s.addr3, "11apple", 333, 3),
+				s.eventHoldReleasedOrder(s.addr2, "20fig,100pear", 22, 2),
+				s.eventHoldReleasedOrder(s.addr4, "95pear", 4444, 4),
 
 				// Asset transfers
 				s.eventCoinSpent(s.addr1, "7apple"),
@@ -3208,11 +3301,11 @@ func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
 			},
 			expEvents: sdk.Events{
 				// commitment releases
-				s.eventHoldReleased(s.addr1, "95apple,2cherry"),
+				s.eventHoldReleasedCommitment(s.addr1, "95apple,2cherry", 3, 1),
 				s.eventCommitmentReleased(s.addr1, 3, "95apple,2cherry", "tagtestbackagain"),
-				s.eventHoldReleased(s.addr2, "3cherry,50plum"),
+				s.eventHoldReleasedCommitment(s.addr2, "3cherry,50plum", 3, 2),
 				s.eventCommitmentReleased(s.addr2, 3, "3cherry,50plum", "tagtestbackagain"),
-				s.eventHoldReleased(s.addr3, "77plum"),
+				s.eventHoldReleasedCommitment(s.addr3, "77plum", 3, 3),
 				s.eventCommitmentReleased(s.addr3, 3, "77plum", "tagtestbackagain"),
 
 				// Transfer from addr1
@@ -3248,13 +3341,13 @@ func (s *TestSuite) TestMsgServer_MarketCommitmentSettle() {
 				s.eventTransfer(s.marketAddr3, nil, "5cherry"),
 
 				// re-commits
-				s.eventHoldAddedCommitment(s.addr1, "90plum", 3),
+				s.eventHoldAddedCommitment(s.addr1, "90plum", 3, 1),
 				s.eventFundsCommitted(s.addr1, 3, "90plum", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr2, "57apple", 3),
+				s.eventHoldAddedCommitment(s.addr2, "57apple", 3, 2),
 				s.eventFundsCommitted(s.addr2, 3, "57apple", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr3, "12apple", 3),
+				s.eventHoldAddedCommitment(s.addr3, "12apple", 3, 3),
 				s.eventFundsCommitted(s.addr3, 3, "12apple", "tagtestbackagain"),
-				s.eventHoldAddedCommitment(s.addr4, "26apple,37plum", 3),
+				s.eventHoldAddedCommitment(s.addr4, "26apple,37plum", 3, 4),
 				s.eventFundsCommitted(s.addr4, 3, "26apple,37plum", "tagtestbackagain"),
 			},
 			fArgs: []expBalances{
@@ -3371,7 +3464,7 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 				EventTag:  "byebyebye",
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "40apple"),
+				s.eventHoldReleasedCommitment(s.addr2, "40apple", 1, 1),
 				s.eventCommitmentReleased(s.addr2, 1, "40apple", "byebyebye"),
 			},
 			fArgs: []expBalances{{
@@ -3398,7 +3491,7 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 				EventTag:  "hellogoodbye",
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "50apple"),
+				s.eventHoldReleasedCommitment(s.addr2, "50apple", 1, 1),
 				s.eventCommitmentReleased(s.addr2, 1, "50apple", "hellogoodbye"),
 			},
 			fArgs: []expBalances{{
@@ -3425,7 +3518,7 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 				EventTag:  "allgonow",
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "50apple"),
+				s.eventHoldReleasedCommitment(s.addr2, "50apple", 1, 1),
 				s.eventCommitmentReleased(s.addr2, 1, "50apple", "allgonow"),
 			},
 			fArgs: []expBalances{{
@@ -3467,15 +3560,15 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 				EventTag: "multifree",
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
+				s.eventHoldReleasedCommitment(s.addr3, "6apple,111cherry", 2, 3),
 				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
-				s.eventHoldReleased(s.addr5, "180cherry"),
+				s.eventHoldReleasedCommitment(s.addr5, "180cherry", 2, 5),
 				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
-				s.eventHoldReleased(s.addr1, "75apple"),
+				s.eventHoldReleasedCommitment(s.addr1, "75apple", 2, 1),
 				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
-				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
+				s.eventHoldReleasedCommitment(s.addr4, "100apple,20cherry", 2, 4),
 				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
-				s.eventHoldReleased(s.addr2, "200cherry"),
+				s.eventHoldReleasedCommitment(s.addr2, "200cherry", 2, 2),
 				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
 			},
 			fArgs: []expBalances{
@@ -3566,15 +3659,15 @@ func (s *TestSuite) TestMsgServer_MarketReleaseCommitments() {
 				EventTag: "multifree",
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr3, "6apple,111cherry"),
+				s.eventHoldReleasedCommitment(s.addr3, "6apple,111cherry", 2, 3),
 				s.eventCommitmentReleased(s.addr3, 2, "6apple,111cherry", "multifree"),
-				s.eventHoldReleased(s.addr5, "180cherry"),
+				s.eventHoldReleasedCommitment(s.addr5, "180cherry", 2, 5),
 				s.eventCommitmentReleased(s.addr5, 2, "180cherry", "multifree"),
-				s.eventHoldReleased(s.addr1, "75apple"),
+				s.eventHoldReleasedCommitment(s.addr1, "75apple", 2, 1),
 				s.eventCommitmentReleased(s.addr1, 2, "75apple", "multifree"),
-				s.eventHoldReleased(s.addr4, "100apple,20cherry"),
+				s.eventHoldReleasedCommitment(s.addr4, "100apple,20cherry", 2, 4),
 				s.eventCommitmentReleased(s.addr4, 2, "100apple,20cherry", "multifree"),
-				s.eventHoldReleased(s.addr2, "200cherry"),
+				s.eventHoldReleasedCommitment(s.addr2, "200cherry", 2, 2),
 				s.eventCommitmentReleased(s.addr2, 2, "200cherry", "multifree"),
 			},
 			fArgs: []expBalances{
@@ -4303,6 +4396,107 @@ func (s *TestSuite) TestMsgServer_MarketUpdateAcceptingCommitments() {
 	}
 }
 
+func (s *TestSuite) TestMsgServer_MarketUpdateAutoMatch() {
+	testDef := msgServerTestDef[exchange.MsgMarketUpdateAutoMatchRequest, exchange.MsgMarketUpdateAutoMatchResponse, struct{}]{
+		endpointName: "MarketUpdateAutoMatch",
+		endpoint:     keeper.NewMsgServer(s.k).MarketUpdateAutoMatch,
+		expResp:      &exchange.MsgMarketUpdateAutoMatchResponse{},
+		followup: func(msg *exchange.MsgMarketUpdateAutoMatchRequest, _ struct{}) {
+			autoMatch := s.k.IsMarketAutoMatch(s.ctx, msg.MarketId)
+			s.Assert().Equal(msg.AutoMatch, autoMatch, "IsMarketAutoMatch(%d)", msg.MarketId)
+		},
+	}
+
+	tests := []msgServerTestCase[exchange.MsgMarketUpdateAutoMatchRequest, struct{}]{
+		{
+			name: "admin does not have permission to update market",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId:     3,
+					AccessGrants: []exchange.AccessGrant{s.agCanAllBut(s.addr5, exchange.Permission_update)},
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expInErr: []string{invReqErr,
+				"account " + s.addr5.String() + " does not have permission to update market 3"},
+		},
+		{
+			name: "false to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: false,
+			},
+			expInErr: []string{invReqErr, "market 3 already has auto-match false"},
+		},
+		{
+			name: "true to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expInErr: []string{invReqErr, "market 3 already has auto-match true"},
+		},
+		{
+			name: "false to true",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: false,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: true,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAutoMatchEnabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+		{
+			name: "true to false",
+			setup: func() {
+				s.requireCreateMarketUnmocked(exchange.Market{
+					MarketId: 3, AccessGrants: []exchange.AccessGrant{s.agCanOnly(s.addr5, exchange.Permission_update)},
+					AutoMatch: true,
+				})
+			},
+			msg: exchange.MsgMarketUpdateAutoMatchRequest{
+				Admin:     s.addr5.String(),
+				MarketId:  3,
+				AutoMatch: false,
+			},
+			expEvents: sdk.Events{
+				s.untypeEvent(&exchange.EventMarketAutoMatchDisabled{MarketId: 3, UpdatedBy: s.addr5.String()}),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			runMsgServerTestCase(s, testDef, tc)
+		})
+	}
+}
+
 func (s *TestSuite) TestMsgServer_MarketUpdateIntermediaryDenom() {
 	testDef := msgServerTestDef[exchange.MsgMarketUpdateIntermediaryDenomRequest, exchange.MsgMarketUpdateIntermediaryDenomResponse, struct{}]{
 		endpointName: "MarketUpdateIntermediaryDenom",
@@ -4606,7 +4800,7 @@ func (s *TestSuite) TestMsgServer_CreatePayment() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldAddedPayment(s.addr1, "23strawberry", "four-five-six"),
+				s.eventHoldAddedPayment(s.addr1, "23strawberry", "four-five-six", 1),
 				s.untypeEvent(exchange.NewEventPaymentCreated(
 					s.newTestPayment(s.addr1, "23strawberry", s.addr2, "12tangerine", "four-five-six"))),
 			},
@@ -4673,7 +4867,7 @@ func (s *TestSuite) TestMsgServer_AcceptPayment() {
 			},
 			expEvents: sdk.Events{
 				// Hold released.
-				s.eventHoldReleased(s.longAddr1, "5starfruit"),
+				s.eventHoldReleasedPayment(s.longAddr1, "5starfruit", "ex-why-zee", 1),
 				// Send from source to target.
 				s.eventCoinSpent(s.longAddr1, "5starfruit"),
 				s.eventCoinReceived(s.addr4, "5starfruit"),
@@ -4804,7 +4998,7 @@ func (s *TestSuite) TestMsgServer_RejectPayment() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.addr2, "1starfruit,49strawberry"),
+				s.eventHoldReleasedPayment(s.addr2, "1starfruit,49strawberry", "four-oh-six", 1),
 				s.untypeEvent(exchange.NewEventPaymentRejected(
 					s.newTestPayment(s.addr2, "1starfruit,49strawberry", s.addr3, "100tangerine", "four-oh-six"))),
 			},
@@ -4926,9 +5120,9 @@ func (s *TestSuite) TestMsgServer_RejectPayments() {
 			},
 			expEvents: sdk.Events{
 				// no hold release event for s.longAddr3 because that payment doesn't have any source funds.
-				s.eventHoldReleased(s.addr2, "7starfruit"),
-				s.eventHoldReleased(s.addr2, "33strawberry"),
-				s.eventHoldReleased(s.addr1, "13strawberry"),
+				s.eventHoldReleasedPayment(s.addr2, "7starfruit", "a", 2),
+				s.eventHoldReleasedPayment(s.addr2, "33strawberry", "b", 3),
+				s.eventHoldReleasedPayment(s.addr1, "13strawberry", "z", 1),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.longAddr3, "", s.longAddr1, "100tangerine,100tomato", ""))),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.addr2, "7starfruit", s.longAddr1, "16tangerine", "a"))),
 				s.untypeEvent(exchange.NewEventPaymentRejected(s.newTestPayment(s.addr2, "33strawberry", s.longAddr1, "54tomato", "b"))),
@@ -5035,9 +5229,9 @@ func (s *TestSuite) TestMsgServer_CancelPayments() {
 				},
 			},
 			expEvents: sdk.Events{
-				s.eventHoldReleased(s.longAddr3, "4strawberry"),
-				s.eventHoldReleased(s.longAddr3, "8strawberry"),
-				s.eventHoldReleased(s.longAddr3, "1strawberry"),
+				s.eventHoldReleasedPayment(s.longAddr3, "4strawberry", "ghi", 3),
+				s.eventHoldReleasedPayment(s.longAddr3, "8strawberry", "", 4),
+				s.eventHoldReleasedPayment(s.longAddr3, "1strawberry", "abc", 1),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "4strawberry", s.addr4, "12tangerine", "ghi"))),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "8strawberry", s.addr1, "13tangerine", ""))),
 				s.untypeEvent(exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "1strawberry", s.longAddr2, "10tangerine", "abc"))),
@@ -5417,11 +5611,11 @@ func (s *TestSuite) TestMsgServer_GovCloseMarket() {
 			expEvents: sdk.Events{
 				s.untypeEvent(exchange.NewEventMarketOrdersDisabled(2, s.k.GetAuthority())),
 				s.untypeEvent(exchange.NewEventMarketCommitmentsDisabled(2, s.k.GetAuthority())),
-				s.eventHoldReleased(s.addr1, "10apple"),
+				s.eventHoldReleasedOrder(s.addr1, "10apple", 18, 1),
 				s.untypeEvent(&exchange.EventOrderCancelled{OrderId: 18, MarketId: 2, CancelledBy: s.k.GetAuthority()}),
-				s.eventHoldReleased(s.addr2, "20peach"),
+				s.eventHoldReleasedOrder(s.addr2, "20peach", 19, 2),
 				s.untypeEvent(&exchange.EventOrderCancelled{OrderId: 19, MarketId: 2, CancelledBy: s.k.GetAuthority()}),
-				s.eventHoldReleased(s.addr3, "30banana"),
+				s.eventHoldReleasedCommitment(s.addr3, "30banana", 2, 3),
 				s.eventCommitmentReleased(s.addr3, 2, "30banana", "GovCloseMarket"),
 			},
 		},
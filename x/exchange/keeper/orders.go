@@ -4,9 +4,11 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	dbm "github.com/cometbft/cometbft-db"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 
@@ -127,7 +129,7 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 	addr := sdk.MustAccAddressFromBech32(owner)
 	assets := order.GetAssets()
 
-	return []kv.Pair{
+	rv := []kv.Pair{
 		{
 			Key:   MakeIndexKeyMarketToOrder(marketID, orderID),
 			Value: []byte{orderTypeByte},
@@ -140,7 +142,26 @@ func createConstantIndexEntries(order exchange.Order) []kv.Pair {
 			Key:   MakeIndexKeyAssetToOrder(assets.Denom, orderID),
 			Value: []byte{orderTypeByte},
 		},
+		{
+			Key:   MakeIndexKeyMarketPriceToOrder(order),
+			Value: []byte{orderTypeByte},
+		},
+	}
+
+	if expHeight := order.GetExpirationHeight(); expHeight != 0 {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationHeightToOrder(expHeight, orderID),
+			Value: []byte{orderTypeByte},
+		})
 	}
+	if expTime := order.GetExpirationTime(); expTime != nil {
+		rv = append(rv, kv.Pair{
+			Key:   MakeIndexKeyExpirationTimeToOrder(*expTime, orderID),
+			Value: []byte{orderTypeByte},
+		})
+	}
+
+	return rv
 }
 
 // createMarketExternalIDToOrderEntry creates the market external id to order store entry.
@@ -449,6 +470,14 @@ func validateMarketIsAcceptingOrders(store storetypes.KVStore, marketID uint32)
 	return nil
 }
 
+// validateOrderNotExpired makes sure the provided order has not already expired.
+func validateOrderNotExpired(ctx sdk.Context, order exchange.SubOrderI) error {
+	if order.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+		return fmt.Errorf("%s order has already expired", order.GetOrderType())
+	}
+	return nil
+}
+
 // validateUserCanCreateAsk makes sure the user can create an ask order in the given market.
 func (k Keeper) validateUserCanCreateAsk(ctx sdk.Context, marketID uint32, seller sdk.AccAddress) error {
 	if !k.CanCreateAsk(ctx, marketID, seller) {
@@ -481,9 +510,9 @@ func validateCreateBidFees(store storetypes.KVStore, marketID uint32, creationFe
 	return validateBuyerSettlementFee(store, marketID, price, settlementFees)
 }
 
-// getAskOrders gets orders from the store, making sure they're ask orders in the given market
+// getAskOrders gets orders from the store, making sure they're unexpired ask orders in the given market
 // and do not have the same seller as the provided buyer. If the buyer isn't yet known, just provide "" for it.
-func (k Keeper) getAskOrders(store storetypes.KVStore, marketID uint32, orderIDs []uint64, buyer string) ([]*exchange.Order, error) {
+func (k Keeper) getAskOrders(ctx sdk.Context, store storetypes.KVStore, marketID uint32, orderIDs []uint64, buyer string) ([]*exchange.Order, error) {
 	var errs []error
 	orders := make([]*exchange.Order, 0, len(orderIDs))
 
@@ -514,6 +543,10 @@ func (k Keeper) getAskOrders(store storetypes.KVStore, marketID uint32, orderIDs
 			errs = append(errs, fmt.Errorf("order %d has the same seller %s as the requested buyer", orderID, seller))
 			continue
 		}
+		if askOrder.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+			errs = append(errs, fmt.Errorf("order %d has expired", orderID))
+			continue
+		}
 
 		orders = append(orders, order)
 	}
@@ -521,9 +554,9 @@ func (k Keeper) getAskOrders(store storetypes.KVStore, marketID uint32, orderIDs
 	return orders, errors.Join(errs...)
 }
 
-// getBidOrders gets orders from the store, making sure they're bid orders in the given market
+// getBidOrders gets orders from the store, making sure they're unexpired bid orders in the given market
 // and do not have the same buyer as the provided seller. If the seller isn't yet known, just provide "" for it.
-func (k Keeper) getBidOrders(store storetypes.KVStore, marketID uint32, orderIDs []uint64, seller string) ([]*exchange.Order, error) {
+func (k Keeper) getBidOrders(ctx sdk.Context, store storetypes.KVStore, marketID uint32, orderIDs []uint64, seller string) ([]*exchange.Order, error) {
 	var errs []error
 	orders := make([]*exchange.Order, 0, len(orderIDs))
 
@@ -554,6 +587,10 @@ func (k Keeper) getBidOrders(store storetypes.KVStore, marketID uint32, orderIDs
 			errs = append(errs, fmt.Errorf("order %d has the same buyer %s as the requested seller", orderID, buyer))
 			continue
 		}
+		if bidOrder.IsExpiredAt(ctx.BlockHeight(), ctx.BlockTime()) {
+			errs = append(errs, fmt.Errorf("order %d has expired", orderID))
+			continue
+		}
 
 		orders = append(orders, order)
 	}
@@ -561,6 +598,11 @@ func (k Keeper) getBidOrders(store storetypes.KVStore, marketID uint32, orderIDs
 	return orders, errors.Join(errs...)
 }
 
+// orderHoldReason returns the reason used for the hold on an order's funds.
+func orderHoldReason(orderID uint64) string {
+	return fmt.Sprintf("x/exchange: order %d", orderID)
+}
+
 // placeHoldOnOrder places a hold on an order's funds in the owner's account.
 func (k Keeper) placeHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error {
 	orderID := order.GetOrderID()
@@ -571,7 +613,7 @@ func (k Keeper) placeHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error {
 		return fmt.Errorf("invalid %s order %d owner %q: %w", orderType, orderID, owner, err)
 	}
 	toHold := order.GetHoldAmount()
-	err = k.holdKeeper.AddHold(ctx, ownerAddr, toHold, fmt.Sprintf("x/exchange: order %d", orderID))
+	err = k.holdKeeper.AddHold(ctx, ownerAddr, toHold, exchange.ModuleName, orderHoldReason(orderID))
 	if err != nil {
 		return fmt.Errorf("error placing hold for %s order %d: %w", orderType, orderID, err)
 	}
@@ -588,7 +630,7 @@ func (k Keeper) releaseHoldOnOrder(ctx sdk.Context, order exchange.OrderI) error
 		return fmt.Errorf("invalid %s order %d owner %q: %w", orderType, orderID, owner, err)
 	}
 	held := order.GetHoldAmount()
-	err = k.holdKeeper.ReleaseHold(ctx, ownerAddr, held)
+	err = k.holdKeeper.ReleaseHold(ctx, ownerAddr, held, exchange.ModuleName, orderHoldReason(orderID))
 	if err != nil {
 		return fmt.Errorf("error releasing hold for %s order %d: %w", orderType, orderID, err)
 	}
@@ -620,88 +662,123 @@ func (k Keeper) GetOrderByExternalID(ctx sdk.Context, marketID uint32, externalI
 	return k.getOrderFromStore(store, orderID)
 }
 
-// CreateAskOrder creates an ask order, collects the creation fee, and places all needed holds.
-func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, creationFee *sdk.Coin) (uint64, error) {
+// validateNewAskOrder makes sure the provided ask order can be created.
+func (k Keeper) validateNewAskOrder(ctx sdk.Context, store storetypes.KVStore, askOrder exchange.AskOrder, creationFee *sdk.Coin) error {
 	if err := askOrder.Validate(); err != nil {
-		return 0, err
+		return err
+	}
+	if err := validateOrderNotExpired(ctx, askOrder); err != nil {
+		return err
 	}
 
-	store := k.getStore(ctx)
 	marketID := askOrder.MarketId
-
 	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
-		return 0, err
+		return err
 	}
 	seller := sdk.MustAccAddressFromBech32(askOrder.Seller)
 	if err := k.validateUserCanCreateAsk(ctx, marketID, seller); err != nil {
-		return 0, err
+		return err
 	}
 	if err := validateCreateAskFees(store, marketID, creationFee, askOrder.SellerSettlementFlatFee); err != nil {
-		return 0, err
+		return err
 	}
-	if err := validateAskPrice(store, marketID, askOrder.Price, askOrder.SellerSettlementFlatFee); err != nil {
-		return 0, err
+	return validateAskPrice(store, marketID, askOrder.Price, askOrder.SellerSettlementFlatFee)
+}
+
+// validateNewBidOrder makes sure the provided bid order can be created.
+func (k Keeper) validateNewBidOrder(ctx sdk.Context, store storetypes.KVStore, bidOrder exchange.BidOrder, creationFee *sdk.Coin) error {
+	if err := bidOrder.Validate(); err != nil {
+		return err
+	}
+	if err := validateOrderNotExpired(ctx, bidOrder); err != nil {
+		return err
 	}
 
-	if creationFee != nil {
-		err := k.CollectFee(ctx, marketID, seller, sdk.Coins{*creationFee})
-		if err != nil {
-			return 0, fmt.Errorf("error collecting ask order creation fee: %w", err)
-		}
+	marketID := bidOrder.MarketId
+	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
+		return err
+	}
+	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
+	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
+		return err
 	}
+	return validateCreateBidFees(store, marketID, creationFee, bidOrder.Price, bidOrder.BuyerSettlementFees)
+}
 
-	orderID := nextOrderID(store)
-	order := exchange.NewOrder(orderID).WithAsk(&askOrder)
+// validateNewOrder makes sure the provided order (of either type) can be created.
+func (k Keeper) validateNewOrder(ctx sdk.Context, store storetypes.KVStore, order *exchange.Order, creationFee *sdk.Coin) error {
+	switch {
+	case order.IsAskOrder():
+		return k.validateNewAskOrder(ctx, store, *order.GetAskOrder(), creationFee)
+	case order.IsBidOrder():
+		return k.validateNewBidOrder(ctx, store, *order.GetBidOrder(), creationFee)
+	default:
+		return fmt.Errorf("unknown order type %s", order.GetOrderType())
+	}
+}
+
+// collectOrderCreationFee collects the creation fee (if there is one) for an order from its owner.
+func (k Keeper) collectOrderCreationFee(ctx sdk.Context, order exchange.SubOrderI, creationFee *sdk.Coin) error {
+	if creationFee == nil {
+		return nil
+	}
+	owner := sdk.MustAccAddressFromBech32(order.GetOwner())
+	err := k.CollectFee(ctx, order.GetMarketID(), owner, sdk.Coins{*creationFee})
+	if err != nil {
+		return fmt.Errorf("error collecting %s order creation fee: %w", order.GetOrderType(), err)
+	}
+	return nil
+}
+
+// storeNewOrder writes a new order to state, places the needed holds, and emits the order-created event.
+func (k Keeper) storeNewOrder(ctx sdk.Context, store storetypes.KVStore, order *exchange.Order) error {
 	if err := k.setOrderInStore(store, *order); err != nil {
-		return 0, fmt.Errorf("error storing ask order: %w", err)
+		return fmt.Errorf("error storing %s order: %w", order.GetOrderType(), err)
 	}
 
 	if err := k.placeHoldOnOrder(ctx, order); err != nil {
-		return 0, err
+		return err
 	}
 
 	k.emitEvent(ctx, exchange.NewEventOrderCreated(order))
-	return orderID, nil
+	return nil
 }
 
-// CreateBidOrder creates a bid order, collects the creation fee, and places all needed holds.
-func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, creationFee *sdk.Coin) (uint64, error) {
-	if err := bidOrder.Validate(); err != nil {
+// CreateAskOrder creates an ask order, collects the creation fee, and places all needed holds.
+func (k Keeper) CreateAskOrder(ctx sdk.Context, askOrder exchange.AskOrder, creationFee *sdk.Coin) (uint64, error) {
+	store := k.getStore(ctx)
+	if err := k.validateNewAskOrder(ctx, store, askOrder, creationFee); err != nil {
 		return 0, err
 	}
 
-	store := k.getStore(ctx)
-	marketID := bidOrder.MarketId
-
-	if err := validateMarketIsAcceptingOrders(store, marketID); err != nil {
+	if err := k.collectOrderCreationFee(ctx, askOrder, creationFee); err != nil {
 		return 0, err
 	}
-	buyer := sdk.MustAccAddressFromBech32(bidOrder.Buyer)
-	if err := k.validateUserCanCreateBid(ctx, marketID, buyer); err != nil {
+
+	orderID := nextOrderID(store)
+	order := exchange.NewOrder(orderID).WithAsk(&askOrder)
+	if err := k.storeNewOrder(ctx, store, order); err != nil {
 		return 0, err
 	}
-	if err := validateCreateBidFees(store, marketID, creationFee, bidOrder.Price, bidOrder.BuyerSettlementFees); err != nil {
+	return orderID, nil
+}
+
+// CreateBidOrder creates a bid order, collects the creation fee, and places all needed holds.
+func (k Keeper) CreateBidOrder(ctx sdk.Context, bidOrder exchange.BidOrder, creationFee *sdk.Coin) (uint64, error) {
+	store := k.getStore(ctx)
+	if err := k.validateNewBidOrder(ctx, store, bidOrder, creationFee); err != nil {
 		return 0, err
 	}
 
-	if creationFee != nil {
-		err := k.CollectFee(ctx, marketID, buyer, sdk.Coins{*creationFee})
-		if err != nil {
-			return 0, fmt.Errorf("error collecting bid order creation fee: %w", err)
-		}
+	if err := k.collectOrderCreationFee(ctx, bidOrder, creationFee); err != nil {
+		return 0, err
 	}
 
 	orderID := nextOrderID(store)
 	order := exchange.NewOrder(orderID).WithBid(&bidOrder)
-	if err := k.setOrderInStore(store, *order); err != nil {
-		return 0, fmt.Errorf("error storing bid order: %w", err)
-	}
-
-	if err := k.placeHoldOnOrder(ctx, order); err != nil {
+	if err := k.storeNewOrder(ctx, store, order); err != nil {
 		return 0, err
 	}
-
-	k.emitEvent(ctx, exchange.NewEventOrderCreated(order))
 	return orderID, nil
 }
 
@@ -712,6 +789,13 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 		return err
 	}
 	if order == nil {
+		condOrder, cerr := k.GetConditionalOrder(ctx, orderID)
+		if cerr != nil {
+			return cerr
+		}
+		if condOrder != nil {
+			return k.cancelConditionalOrder(ctx, condOrder, signer)
+		}
 		return fmt.Errorf("order %d does not exist", orderID)
 	}
 
@@ -722,7 +806,7 @@ func (k Keeper) CancelOrder(ctx sdk.Context, orderID uint64, signer string) erro
 
 	orderOwnerAddr := sdk.MustAccAddressFromBech32(orderOwner)
 	heldAmount := order.GetHoldAmount()
-	err = k.holdKeeper.ReleaseHold(ctx, orderOwnerAddr, heldAmount)
+	err = k.holdKeeper.ReleaseHold(ctx, orderOwnerAddr, heldAmount, exchange.ModuleName, orderHoldReason(orderID))
 	if err != nil {
 		return fmt.Errorf("unable to release hold on order %d funds: %w", orderID, err)
 	}
@@ -816,13 +900,170 @@ func (k Keeper) IterateAssetOrders(ctx sdk.Context, assetDenom string, cb func(o
 	k.iterateOrderIndex(ctx, GetIndexKeyPrefixAssetToOrder(assetDenom), cb)
 }
 
-// CancelAllOrdersForMarket cancels all orders for a market, deleting them and releasing their holds.
+// getOrderBookSide gets the price levels of the orders under the provided market price index prefix (one side of an
+// order book). Bid levels are read from the highest unit price to the lowest, and ask levels from the lowest to the
+// highest. If maxLevels is greater than zero, the index is only read until that many levels are filled.
+func (k Keeper) getOrderBookSide(store storetypes.KVStore, indexPrefix []byte, isBid bool, maxLevels uint32) ([]exchange.PriceLevel, error) {
+	var iter storetypes.Iterator
+	if isBid {
+		iter = storetypes.KVStoreReversePrefixIterator(store, indexPrefix)
+	} else {
+		iter = storetypes.KVStorePrefixIterator(store, indexPrefix)
+	}
+	defer iter.Close()
+
+	var rv []exchange.PriceLevel
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		_, _, _, _, orderID, err := ParseIndexKeyMarketPriceToOrder(iter.Key())
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if order == nil {
+			errs = append(errs, fmt.Errorf("order %d not found", orderID))
+			continue
+		}
+
+		// The index is ordered by unit price, so all the orders with the same unit price are next to each other.
+		assets, price := order.GetAssets(), order.GetPrice()
+		unitPrice := sdkmath.LegacyNewDecFromInt(price.Amount).QuoInt(assets.Amount)
+		if len(rv) > 0 && rv[len(rv)-1].UnitPrice.Equal(unitPrice) {
+			level := &rv[len(rv)-1]
+			level.TotalAssets = level.TotalAssets.Add(assets)
+			level.TotalPrice = level.TotalPrice.Add(price)
+			level.OrderCount++
+			continue
+		}
+		if maxLevels > 0 && len(rv) >= int(maxLevels) {
+			break
+		}
+		rv = append(rv, exchange.PriceLevel{
+			UnitPrice:   unitPrice,
+			TotalAssets: assets,
+			TotalPrice:  price,
+			OrderCount:  1,
+		})
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return rv, nil
+}
+
+// DefaultOrderBookLevels is the number of price levels returned for each side of an order book when no maximum is given.
+const DefaultOrderBookLevels = 100
+
+// MaxOrderBookLevels is the most price levels that will be returned for each side of an order book.
+const MaxOrderBookLevels = 1000
+
+// GetOrderBook gets the price levels of the orders in a market that have the provided asset and price denoms.
+// The ask levels are ordered from lowest unit price to highest, and the bid levels from highest to lowest.
+// At most maxLevels levels are returned for each side. If maxLevels is zero, DefaultOrderBookLevels is used,
+// and it is capped at MaxOrderBookLevels.
+func (k Keeper) GetOrderBook(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string, maxLevels uint32) ([]exchange.PriceLevel, []exchange.PriceLevel, error) {
+	switch {
+	case maxLevels == 0:
+		maxLevels = DefaultOrderBookLevels
+	case maxLevels > MaxOrderBookLevels:
+		maxLevels = MaxOrderBookLevels
+	}
+	store := k.getStore(ctx)
+	asks, askErr := k.getOrderBookSide(store, GetIndexKeyPrefixMarketPriceToOrderBookSide(marketID, assetDenom, priceDenom, OrderKeyTypeAsk), false, maxLevels)
+	bids, bidErr := k.getOrderBookSide(store, GetIndexKeyPrefixMarketPriceToOrderBookSide(marketID, assetDenom, priceDenom, OrderKeyTypeBid), true, maxLevels)
+	if err := errors.Join(askErr, bidErr); err != nil {
+		return nil, nil, err
+	}
+	return asks, bids, nil
+}
+
+// MaxOrdersExpiredPerBlock is the maximum number of orders that will be expired in a single block.
+// Any other expired orders are left for the following blocks.
+const MaxOrdersExpiredPerBlock = 1000
+
+// getExpiredOrderIDs gets the ids of up to maxIDs orders that might have expired as of the provided block height and time.
+// Since the expiration time index only has whole seconds, some of the returned orders might not be expired yet.
+func getExpiredOrderIDs(store storetypes.KVStore, blockHeight int64, blockTime time.Time, maxIDs int) []uint64 {
+	var rv []uint64
+	seen := make(map[uint64]bool)
+	addIDs := func(indexPrefix, end []byte) {
+		iter := prefix.NewStore(store, indexPrefix).Iterator(nil, end)
+		defer iter.Close()
+		for ; iter.Valid() && len(rv) < maxIDs; iter.Next() {
+			_, orderID, err := ParseIndexKeyExpirationToOrder(iter.Key())
+			if err == nil && !seen[orderID] {
+				rv = append(rv, orderID)
+				seen[orderID] = true
+			}
+		}
+	}
+
+	if blockHeight >= 0 {
+		addIDs(GetIndexKeyPrefixExpirationHeightToOrder(), uint64Bz(uint64(blockHeight)+1))
+	}
+	// The end is exclusive, so we add a second to get everything in the block time's second too.
+	addIDs(GetIndexKeyPrefixExpirationTimeToOrder(), expirationTimeBz(blockTime.Add(time.Second)))
+
+	return rv
+}
+
+// ExpireOrders cancels up to MaxOrdersExpiredPerBlock orders that have expired as of the current block, releasing their holds.
+// If an order's hold cannot be released, the order is left in place (so its funds aren't stuck) and is tried again later.
+func (k Keeper) ExpireOrders(ctx sdk.Context) {
+	store := k.getStore(ctx)
+	blockHeight, blockTime := ctx.BlockHeight(), ctx.BlockTime()
+	orderIDs := getExpiredOrderIDs(store, blockHeight, blockTime, MaxOrdersExpiredPerBlock)
+
+	var errs []error
+	for _, orderID := range orderIDs {
+		order, err := k.getOrderFromStore(store, orderID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if order == nil {
+			if err = k.expireConditionalOrder(ctx, store, orderID); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if !order.IsExpiredAt(blockHeight, blockTime) {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.releaseHoldOnOrder(cacheCtx, order); err != nil {
+			errs = append(errs, err)
+			k.emitEvent(ctx, exchange.NewEventOrderExpirationFailed(order, err.Error()))
+			continue
+		}
+		deleteAndDeIndexOrder(k.getStore(cacheCtx), *order)
+		k.emitEvent(cacheCtx, exchange.NewEventOrderExpired(order))
+		writeCache()
+	}
+
+	if len(errs) > 0 {
+		k.logErrorf(ctx, "%d error(s) encountered expiring orders:\n%v", len(errs), errors.Join(errs...))
+	}
+}
+
+// CancelAllOrdersForMarket cancels all orders (including conditional orders) for a market, deleting them and releasing their holds.
 func (k Keeper) CancelAllOrdersForMarket(ctx sdk.Context, marketID uint32, signer string) {
 	var orderIDs []uint64
 	k.IterateMarketOrders(ctx, marketID, func(orderID uint64, _ byte) bool {
 		orderIDs = append(orderIDs, orderID)
 		return false
 	})
+	k.IterateMarketConditionalOrders(ctx, marketID, func(orderID uint64, _ byte) bool {
+		orderIDs = append(orderIDs, orderID)
+		return false
+	})
 
 	var errs []error
 	for _, orderID := range orderIDs {
@@ -5,8 +5,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -484,6 +486,17 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			},
 			expErr: "invalid market id: cannot be zero",
 		},
+		{
+			name: "order already expired",
+			askOrder: exchange.AskOrder{
+				MarketId:       2,
+				Seller:         s.addr2.String(),
+				Assets:         s.coin("35apple"),
+				Price:          s.coin("10peach"),
+				ExpirationTime: &time.Time{},
+			},
+			expErr: "ask order has already expired",
+		},
 		{
 			name: "market does not exist",
 			askOrder: exchange.AskOrder{
@@ -653,11 +666,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			expErr:       "error placing hold for ask order 1: nope, this is a test error, sorry",
 			expBankCalls: BankCalls{},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{
-					addr:   s.addr1,
-					funds:  s.coins("22apple"),
-					reason: "x/exchange: order 1",
-				}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("22apple"), "x/exchange: order 1")},
 			},
 		},
 		{
@@ -681,11 +690,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 			expErr:       "error placing hold for ask order 6: nope, this is a test error, sorry",
 			expBankCalls: BankCalls{},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{
-					addr:   s.addr1,
-					funds:  s.coins("22apple,3fig"),
-					reason: reason(6),
-				}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("22apple,3fig"), reason(6))},
 			},
 		},
 
@@ -717,7 +722,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				},
 			},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{addr: s.addr3, funds: s.coins("100apple"), reason: reason(50_001)}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr3, s.coins("100apple"), reason(50_001))},
 			},
 		},
 		{
@@ -753,7 +758,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				},
 			},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{addr: s.addr2, funds: s.coins("57apple"), reason: reason(889)}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("57apple"), reason(889))},
 			},
 		},
 		{
@@ -772,7 +777,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				Price:    s.coin("57plum"),
 			},
 			expOrderID:   2,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr4, funds: s.coins("33apple"), reason: reason(2)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr4, s.coins("33apple"), reason(2))}},
 		},
 		{
 			name: "settlement fee denom same as price: hold okay",
@@ -792,7 +797,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				SellerSettlementFlatFee: s.coinP("20peach"),
 			},
 			expOrderID:   123,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr3, funds: s.coins("500acorn"), reason: reason(123)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr3, s.coins("500acorn"), reason(123))}},
 		},
 		{
 			name: "settlement fee denom diff from price: hold okay",
@@ -812,7 +817,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				SellerSettlementFlatFee: s.coinP("20peach"),
 			},
 			expOrderID:   1000,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("500acorn,20peach"), reason: reason(1000)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("500acorn,20peach"), reason(1000))}},
 		},
 		{
 			name: "external id in use but in different market",
@@ -843,7 +848,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				ExternalId: "unoriginal",
 			},
 			expOrderID:   98766,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("11acorn"), reason: reason(98766)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("11acorn"), reason(98766))}},
 		},
 		{
 			name: "new external id",
@@ -870,7 +875,7 @@ func (s *TestSuite) TestKeeper_CreateAskOrder() {
 				ExternalId: "C52B5350-BBD6-48B4-9AA7-2F2197260F9E",
 			},
 			expOrderID:   66,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("11acorn"), reason: reason(66)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("11acorn"), reason(66))}},
 		},
 	}
 
@@ -957,6 +962,17 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			},
 			expErr: "invalid market id: cannot be zero",
 		},
+		{
+			name: "order already expired",
+			bidOrder: exchange.BidOrder{
+				MarketId:       2,
+				Buyer:          s.addr2.String(),
+				Assets:         s.coin("35apple"),
+				Price:          s.coin("10peach"),
+				ExpirationTime: &time.Time{},
+			},
+			expErr: "bid order has already expired",
+		},
 		{
 			name: "market does not exist",
 			bidOrder: exchange.BidOrder{
@@ -1155,7 +1171,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			creationFee:  s.coinP("3fig"),
 			expErr:       "error placing hold for bid order 777: injected problem",
 			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{{fromAddr: s.addr1, toAddr: s.marketAddr3, amt: s.coins("3fig")}}},
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55peach"), reason: reason(777)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("55peach"), reason(777))}},
 		},
 		{
 			name:       "with settlement fee: cannot place hold",
@@ -1178,7 +1194,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 			creationFee:  s.coinP("3fig"),
 			expErr:       "error placing hold for bid order 83484: injected problem",
 			expBankCalls: BankCalls{SendCoins: []*SendCoinsArgs{{fromAddr: s.addr1, toAddr: s.marketAddr3, amt: s.coins("3fig")}}},
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("5grape,57peach"), reason: reason(83484)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("5grape,57peach"), reason(83484))}},
 		},
 
 		// Tests that should not give an error.
@@ -1209,7 +1225,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				},
 			},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{addr: s.addr3, funds: s.coins("3pineapple"), reason: reason(50_001)}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr3, s.coins("3pineapple"), reason(50_001))},
 			},
 		},
 		{
@@ -1245,7 +1261,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				},
 			},
 			expHoldCalls: HoldCalls{
-				AddHold: []*AddHoldArgs{{addr: s.addr2, funds: s.coins("3pineapple"), reason: reason(889)}},
+				AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr2, s.coins("3pineapple"), reason(889))},
 			},
 		},
 		{
@@ -1264,7 +1280,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				Price:    s.coin("57plum"),
 			},
 			expOrderID:   2,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr4, funds: s.coins("57plum"), reason: reason(2)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr4, s.coins("57plum"), reason(2))}},
 		},
 		{
 			name: "no settlement fee: hold okay",
@@ -1292,7 +1308,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 					{senderAddr: s.marketAddr1, recipientModule: s.feeCollector, amt: s.coins("1fig")},
 				},
 			},
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr3, funds: s.coins("100peach"), reason: reason(123)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr3, s.coins("100peach"), reason(123))}},
 		},
 		{
 			name: "with settlement fee: hold okay",
@@ -1313,7 +1329,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				BuyerSettlementFees: s.coins("20fig,30peach"),
 			},
 			expOrderID:   1000,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("20fig,1030peach"), reason: reason(1000)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("20fig,1030peach"), reason(1000))}},
 		},
 		{
 			name: "external id in use but in different market",
@@ -1344,7 +1360,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				ExternalId: "unoriginal",
 			},
 			expOrderID:   98766,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55plum"), reason: reason(98766)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("55plum"), reason(98766))}},
 		},
 		{
 			name: "new external id",
@@ -1371,7 +1387,7 @@ func (s *TestSuite) TestKeeper_CreateBidOrder() {
 				ExternalId: "C52B5350-BBD6-48B4-9AA7-2F2197260F9E",
 			},
 			expOrderID:   66,
-			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{{addr: s.addr1, funds: s.coins("55plum"), reason: reason(66)}}},
+			expHoldCalls: HoldCalls{AddHold: []*AddHoldArgs{NewAddHoldArgs(s.addr1, s.coins("55plum"), reason(66))}},
 		},
 	}
 
@@ -1494,7 +1510,7 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			orderID:      7,
 			signer:       s.addr3.String(),
 			expErr:       "unable to release hold on order 7 funds: there's not enough here",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: s.coins("333prune")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("333prune"), "x/exchange: order 7")}},
 		},
 		{
 			name: "signer can cancel in other market but not this one",
@@ -1557,7 +1573,7 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			},
 			orderID:      52,
 			signer:       s.addr1.String(),
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr1, funds: s.coins("50apricot,8fig")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr1, s.coins("50apricot,8fig"), "x/exchange: order 52")}},
 		},
 		{
 			name: "signer is bid order buyer",
@@ -1590,7 +1606,7 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			},
 			orderID:      57,
 			signer:       s.addr4.String(),
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr4, funds: s.coins("8fig,55plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr4, s.coins("8fig,55plum"), "x/exchange: order 57")}},
 		},
 		{
 			name: "signer is authority",
@@ -1623,7 +1639,7 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			},
 			orderID:      100,
 			signer:       s.k.GetAuthority(),
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: s.coins("12apricot")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("12apricot"), "x/exchange: order 100")}},
 		},
 		{
 			name: "signer can cancel in market",
@@ -1645,7 +1661,7 @@ func (s *TestSuite) TestKeeper_CancelOrder() {
 			},
 			orderID:      999,
 			signer:       s.addr1.String(),
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("55plum")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("55plum"), "x/exchange: order 999")}},
 		},
 	}
 
@@ -2975,10 +2991,11 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 		})
 	}
 	bidReleaseHoldArgs := func(orderID uint64) *ReleaseHoldArgs {
-		return &ReleaseHoldArgs{
-			addr:  sdk.AccAddress(fmt.Sprintf("buyer%d_______________", orderID)[:20]),
-			funds: sdk.Coins{sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))}},
-		}
+		return NewReleaseHoldArgs(
+			sdk.AccAddress(fmt.Sprintf("buyer%d_______________", orderID)[:20]),
+			sdk.Coins{sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))}},
+			fmt.Sprintf("x/exchange: order %d", orderID),
+		)
 	}
 	askOrder := func(marketID uint32, orderID uint64) *exchange.Order {
 		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
@@ -2990,10 +3007,11 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 		})
 	}
 	askReleaseHoldArgs := func(orderID uint64) *ReleaseHoldArgs {
-		return &ReleaseHoldArgs{
-			addr:  sdk.AccAddress(fmt.Sprintf("seller%d______________", orderID)[:20]),
-			funds: sdk.Coins{sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))}},
-		}
+		return NewReleaseHoldArgs(
+			sdk.AccAddress(fmt.Sprintf("seller%d______________", orderID)[:20]),
+			sdk.Coins{sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))}},
+			fmt.Sprintf("x/exchange: order %d", orderID),
+		)
 	}
 
 	tests := []struct {
@@ -3159,7 +3177,7 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 				tc.expHoldCalls = &HoldCalls{}
 				for _, order := range expOrdersCancelled {
 					addr, _ := sdk.AccAddressFromBech32(order.GetOwner())
-					tc.expHoldCalls.ReleaseHold = append(tc.expHoldCalls.ReleaseHold, NewReleaseHoldArgs(addr, order.GetHoldAmount()))
+					tc.expHoldCalls.ReleaseHold = append(tc.expHoldCalls.ReleaseHold, NewReleaseHoldArgs(addr, order.GetHoldAmount(), fmt.Sprintf("x/exchange: order %d", order.OrderId)))
 				}
 			}
 			var expEvents sdk.Events
@@ -3237,3 +3255,195 @@ func (s *TestSuite) TestKeeper_CancelAllOrdersForMarket() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_ExpireOrders() {
+	blockHeight := int64(100)
+	blockTime := time.Date(2024, 3, 4, 5, 6, 7, 500_000_000, time.UTC)
+	assetDenom, priceDenom := "apple", "prune"
+	timePtr := func(t time.Time) *time.Time {
+		return &t
+	}
+	bidOrder := func(orderID uint64, expHeight uint64, expTime *time.Time) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId:         1,
+			Buyer:            sdk.AccAddress(fmt.Sprintf("buyer%d_______________", orderID)[:20]).String(),
+			Assets:           sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))},
+			Price:            sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))},
+			ExternalId:       fmt.Sprintf("order-%d", orderID),
+			ExpirationHeight: expHeight,
+			ExpirationTime:   expTime,
+		})
+	}
+	askOrder := func(orderID uint64, expHeight uint64, expTime *time.Time) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId:         1,
+			Seller:           sdk.AccAddress(fmt.Sprintf("seller%d______________", orderID)[:20]).String(),
+			Assets:           sdk.Coin{Denom: assetDenom, Amount: sdkmath.NewInt(500 + int64(orderID))},
+			Price:            sdk.Coin{Denom: priceDenom, Amount: sdkmath.NewInt(1000 + int64(orderID))},
+			ExternalId:       fmt.Sprintf("order-%d", orderID),
+			ExpirationHeight: expHeight,
+			ExpirationTime:   expTime,
+		})
+	}
+
+	tests := []struct {
+		name       string
+		setup      func() (expKept []*exchange.Order, expDel []*exchange.Order)
+		holdKeeper *MockHoldKeeper
+		expEvents  func(expKept, expDel []*exchange.Order) sdk.Events
+		expLog     []string
+	}{
+		{
+			name: "no orders in state",
+		},
+		{
+			name: "no orders with expirations",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				expKept := s.requireSetOrdersInStore(s.getStore(),
+					askOrder(1, 0, nil), bidOrder(2, 0, nil),
+				)
+				return expKept, nil
+			},
+		},
+		{
+			name: "expirations not yet reached",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				expKept := s.requireSetOrdersInStore(s.getStore(),
+					askOrder(1, 101, nil), bidOrder(2, 0, timePtr(blockTime.Add(time.Nanosecond))),
+					askOrder(3, 0, timePtr(blockTime.Add(time.Hour))), bidOrder(4, 5000, timePtr(blockTime.Add(time.Second))),
+				)
+				return expKept, nil
+			},
+		},
+		{
+			name: "some orders expired by height and time",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				store := s.getStore()
+				expKept := s.requireSetOrdersInStore(store,
+					askOrder(1, 101, nil), bidOrder(2, 0, nil),
+					askOrder(5, 0, timePtr(blockTime.Add(time.Millisecond))),
+				)
+				// These are in the order they're expected to be expired: by height, then by time.
+				expDel := s.requireSetOrdersInStore(store,
+					askOrder(8, 50, timePtr(blockTime.Add(-1*time.Minute))),
+					bidOrder(4, 99, timePtr(blockTime.Add(time.Hour))), askOrder(3, 100, nil),
+					bidOrder(7, 0, timePtr(blockTime.Add(-1*time.Hour))), askOrder(6, 0, timePtr(blockTime)),
+				)
+				return expKept, expDel
+			},
+		},
+		{
+			name: "error releasing hold",
+			setup: func() ([]*exchange.Order, []*exchange.Order) {
+				store := s.getStore()
+				expDel := s.requireSetOrdersInStore(store, askOrder(1, 100, nil), askOrder(3, 100, nil))
+				expKept := s.requireSetOrdersInStore(store, bidOrder(2, 100, nil))
+				return expKept, expDel
+			},
+			holdKeeper: NewMockHoldKeeper().WithReleaseHoldResults("", "injected error for 2"),
+			expEvents: func(expKept, expDel []*exchange.Order) sdk.Events {
+				return sdk.Events{
+					s.untypeEvent(exchange.NewEventOrderExpired(expDel[0])),
+					s.untypeEvent(exchange.NewEventOrderExpirationFailed(expKept[0], "error releasing hold for bid order 2: injected error for 2")),
+					s.untypeEvent(exchange.NewEventOrderExpired(expDel[1])),
+				}
+			},
+			expLog: []string{
+				"ERR 1 error(s) encountered expiring orders:",
+				"error releasing hold for bid order 2: injected error for 2 module=x/exchange",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			var expOrdersLeft, expOrdersExpired []*exchange.Order
+			if tc.setup != nil {
+				expOrdersLeft, expOrdersExpired = tc.setup()
+			}
+			sort.Slice(expOrdersLeft, func(i, j int) bool {
+				return expOrdersLeft[i].OrderId < expOrdersLeft[j].OrderId
+			})
+
+			var expEvents sdk.Events
+			if tc.expEvents != nil {
+				expEvents = tc.expEvents(expOrdersLeft, expOrdersExpired)
+			} else {
+				for _, order := range expOrdersExpired {
+					expEvents = append(expEvents, s.untypeEvent(exchange.NewEventOrderExpired(order)))
+				}
+			}
+
+			if tc.holdKeeper == nil {
+				tc.holdKeeper = NewMockHoldKeeper()
+			}
+			kpr := s.k.WithHoldKeeper(tc.holdKeeper)
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em).WithBlockHeight(blockHeight).WithBlockTime(blockTime)
+			s.logBuffer.Reset()
+			testFunc := func() {
+				kpr.ExpireOrders(ctx)
+			}
+			s.Require().NotPanics(testFunc, "ExpireOrders")
+
+			outputLog := s.getLogOutput("ExpireOrders")
+			actLog := s.splitOutputLog(outputLog)
+			s.Assert().Equal(tc.expLog, actLog, "Lines logged during ExpireOrders")
+
+			actEvents := em.Events()
+			s.assertEqualEvents(expEvents, actEvents, "Events emitted during ExpireOrders")
+
+			var ordersLeft []*exchange.Order
+			err := s.k.IterateOrders(s.ctx, func(order *exchange.Order) bool {
+				ordersLeft = append(ordersLeft, order)
+				return false
+			})
+			if s.Assert().NoError(err, "IterateOrders") {
+				s.assertEqualOrders(expOrdersLeft, ordersLeft, "orders left in state after ExpireOrders")
+			}
+
+			var expIndexed []uint64
+			for _, order := range expOrdersLeft {
+				if order.GetExpirationHeight() != 0 || order.GetExpirationTime() != nil {
+					expIndexed = append(expIndexed, order.OrderId)
+				}
+			}
+			var actIndexed []uint64
+			for _, pre := range [][]byte{keeper.GetIndexKeyPrefixExpirationHeightToOrder(), keeper.GetIndexKeyPrefixExpirationTimeToOrder()} {
+				iter := prefix.NewStore(s.getStore(), pre).Iterator(nil, nil)
+				for ; iter.Valid(); iter.Next() {
+					_, orderID, perr := keeper.ParseIndexKeyExpirationToOrder(iter.Key())
+					if s.Assert().NoError(perr, "ParseIndexKeyExpirationToOrder(%v)", iter.Key()) && !exchange.ContainsUint64(actIndexed, orderID) {
+						actIndexed = append(actIndexed, orderID)
+					}
+				}
+				s.Require().NoError(iter.Close(), "iter.Close()")
+			}
+			sort.Slice(actIndexed, func(i, j int) bool {
+				return actIndexed[i] < actIndexed[j]
+			})
+			s.Assert().Equal(expIndexed, actIndexed, "order ids in the expiration indexes after ExpireOrders")
+		})
+	}
+}
+
+func (s *TestSuite) TestGetExpiredOrderIDs() {
+	s.clearExchangeState()
+	blockHeight := int64(100)
+	blockTime := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	store := s.getStore()
+	for i := uint64(1); i <= 5; i++ {
+		s.requireSetOrderInStore(store, exchange.NewOrder(i).WithAsk(&exchange.AskOrder{
+			MarketId:         1,
+			Seller:           sdk.AccAddress(fmt.Sprintf("seller%d______________", i)[:20]).String(),
+			Assets:           sdk.NewInt64Coin("apple", 10),
+			Price:            sdk.NewInt64Coin("prune", 10),
+			ExpirationHeight: 90 + i,
+		}))
+	}
+
+	s.Assert().Equal([]uint64{1, 2, 3, 4, 5}, keeper.GetExpiredOrderIDs(store, blockHeight, blockTime, 10), "more than enough")
+	s.Assert().Equal([]uint64{1, 2, 3}, keeper.GetExpiredOrderIDs(store, blockHeight, blockTime, 3), "limited to 3")
+	s.Assert().Equal([]uint64{1, 2}, keeper.GetExpiredOrderIDs(store, 92, blockTime, 3), "only 2 expired")
+}
@@ -166,6 +166,11 @@ func deletePaymentFromStore(store storetypes.KVStore, payment *exchange.Payment)
 	return nil
 }
 
+// paymentHoldReason returns the reason used for the hold on a payment's source funds.
+func paymentHoldReason(externalID string) string {
+	return fmt.Sprintf("x/exchange: payment %q", externalID)
+}
+
 // deletePaymentAndReleaseHold deletes a payment from the state store and releases its hold.
 func (k Keeper) deletePaymentAndReleaseHold(ctx sdk.Context, store storetypes.KVStore, payment *exchange.Payment) error {
 	err := deletePaymentFromStore(store, payment)
@@ -177,7 +182,7 @@ func (k Keeper) deletePaymentAndReleaseHold(ctx sdk.Context, store storetypes.KV
 	}
 
 	source, _ := sdk.AccAddressFromBech32(payment.Source)
-	err = k.holdKeeper.ReleaseHold(ctx, source, payment.SourceAmount)
+	err = k.holdKeeper.ReleaseHold(ctx, source, payment.SourceAmount, exchange.ModuleName, paymentHoldReason(payment.ExternalId))
 	if err != nil {
 		return fmt.Errorf("error releasing hold on payment source: %w", err)
 	}
@@ -216,7 +221,7 @@ func (k Keeper) CreatePayment(ctx sdk.Context, payment *exchange.Payment) error
 	}
 
 	source, _ := sdk.AccAddressFromBech32(payment.Source)
-	err = k.holdKeeper.AddHold(ctx, source, payment.SourceAmount, fmt.Sprintf("x/exchange: payment %q", payment.ExternalId))
+	err = k.holdKeeper.AddHold(ctx, source, payment.SourceAmount, exchange.ModuleName, paymentHoldReason(payment.ExternalId))
 	if err != nil {
 		return fmt.Errorf("error placing hold on payment source: %w", err)
 	}
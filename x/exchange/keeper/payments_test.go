@@ -326,11 +326,11 @@ func (s *TestSuite) TestKeeper_CreatePayment() {
 			if tc.expAddHold {
 				s.Require().NotNil(tc.payment, "tc.payment cannot be nil when tc.expAddHold = true")
 				expHoldCalls.AddHold = []*AddHoldArgs{
-					{
-						addr:   s.requireAccAddressFromBech32(tc.payment.Source, "valid payment source required when tc.expAddHold = true"),
-						funds:  tc.payment.SourceAmount,
-						reason: fmt.Sprintf("x/exchange: payment %q", tc.payment.ExternalId),
-					},
+					NewAddHoldArgs(
+						s.requireAccAddressFromBech32(tc.payment.Source, "valid payment source required when tc.expAddHold = true"),
+						tc.payment.SourceAmount,
+						fmt.Sprintf("x/exchange: payment %q", tc.payment.ExternalId),
+					),
 				}
 			}
 
@@ -647,10 +647,13 @@ func (s *TestSuite) TestKeeper_AcceptPayment() {
 			var expHoldCalls HoldCalls
 			if tc.expReleaseHold {
 				s.Require().NotNil(tc.payment, "tc.payment cannot be nil when tc.expReleaseHold = true")
-				expHoldCalls.ReleaseHold = []*ReleaseHoldArgs{{
-					addr:  s.requireAccAddressFromBech32(tc.payment.Source, "valid payment source required when tc.expReleaseHold = true"),
-					funds: tc.payment.SourceAmount,
-				}}
+				expHoldCalls.ReleaseHold = []*ReleaseHoldArgs{
+					NewReleaseHoldArgs(
+						s.requireAccAddressFromBech32(tc.payment.Source, "valid payment source required when tc.expReleaseHold = true"),
+						tc.payment.SourceAmount,
+						fmt.Sprintf("x/exchange: payment %q", tc.payment.ExternalId),
+					),
+				}
 			}
 
 			for i := range tc.expBankCalls.SendCoins {
@@ -786,7 +789,7 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			externalID:   "nonono",
 			expErr:       "error releasing hold on payment source: oops, can't do that",
 			expDeleted:   true,
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.longAddr1, funds: s.coins("1strawberry")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.longAddr1, s.coins("1strawberry"), "x/exchange: payment \"nonono\"")}},
 		},
 		{
 			name: "no source funds",
@@ -799,7 +802,7 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			source:       s.addr3,
 			externalID:   "gimmiegimmie",
 			expDeleted:   true,
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: nil}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, nil, "x/exchange: payment \"gimmiegimmie\"")}},
 			expEvent: exchange.NewEventPaymentRejected(
 				s.newTestPayment(s.addr3, "", s.addr1, "41tomato", "gimmiegimmie")),
 		},
@@ -814,7 +817,7 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			source:       s.addr2,
 			externalID:   "all4u",
 			expDeleted:   true,
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("81starfruit")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("81starfruit"), "x/exchange: payment \"all4u\"")}},
 			expEvent: exchange.NewEventPaymentRejected(
 				s.newTestPayment(s.addr2, "81starfruit", s.addr4, "", "all4u")),
 		},
@@ -829,7 +832,7 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			source:       s.longAddr1,
 			externalID:   "I am an external id.",
 			expDeleted:   true,
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.longAddr1, funds: s.coins("497strawberry")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.longAddr1, s.coins("497strawberry"), "x/exchange: payment \"I am an external id.\"")}},
 			expEvent: exchange.NewEventPaymentRejected(
 				s.newTestPayment(s.longAddr1, "497strawberry", s.addr5, "13tangerine,12tomato", "I am an external id.")),
 		},
@@ -844,7 +847,7 @@ func (s *TestSuite) TestKeeper_RejectPayment() {
 			source:       s.addr2,
 			externalID:   "",
 			expDeleted:   true,
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr2, funds: s.coins("18starfruit,371strawberry")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr2, s.coins("18starfruit,371strawberry"), "x/exchange: payment \"\"")}},
 			expEvent: exchange.NewEventPaymentRejected(
 				s.newTestPayment(s.addr2, "18starfruit,371strawberry", s.longAddr1, "945tomato", "")),
 		},
@@ -974,7 +977,7 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			target:       s.longAddr1,
 			sources:      []sdk.AccAddress{s.addr4},
 			expErr:       "error releasing hold on payment source: stop right there",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr4, funds: s.coins("13strawberry")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr4, s.coins("13strawberry"), "x/exchange: payment \"anid\"")}},
 			expDeleted:   []paymentKey{newPKey(s.addr4, "anid")},
 		},
 		{
@@ -984,7 +987,7 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			},
 			target:       s.longAddr3,
 			sources:      []sdk.AccAddress{s.longAddr1},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.longAddr1, funds: s.coins("1starfruit")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.longAddr1, s.coins("1starfruit"), "x/exchange: payment \"\"")}},
 			expEvents: []*exchange.EventPaymentRejected{
 				exchange.NewEventPaymentRejected(s.newTestPayment(s.longAddr1, "1starfruit", s.longAddr3, "3tangerine", "")),
 			},
@@ -1004,9 +1007,9 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			target:  s.addr2,
 			sources: []sdk.AccAddress{s.addr3},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr3, funds: s.coins("18strawberry")},
-				{addr: s.addr3, funds: s.coins("38starfruit")},
-				{addr: s.addr3, funds: s.coins("28strawberry")},
+				NewReleaseHoldArgs(s.addr3, s.coins("18strawberry"), "x/exchange: payment \"one\""),
+				NewReleaseHoldArgs(s.addr3, s.coins("38starfruit"), "x/exchange: payment \"three\""),
+				NewReleaseHoldArgs(s.addr3, s.coins("28strawberry"), "x/exchange: payment \"two\""),
 			}},
 			expEvents: []*exchange.EventPaymentRejected{
 				exchange.NewEventPaymentRejected(s.newTestPayment(s.addr3, "18strawberry", s.addr2, "81tomato", "one")),
@@ -1027,7 +1030,7 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			target:  s.longAddr1,
 			sources: []sdk.AccAddress{s.addr3, s.addr3, s.addr2},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr3, funds: nil}, {addr: s.addr2, funds: s.coins("7strawberry")},
+				NewReleaseHoldArgs(s.addr3, nil, "x/exchange: payment \"abc\""), NewReleaseHoldArgs(s.addr2, s.coins("7strawberry"), "x/exchange: payment \"abc\""),
 			}},
 			expEvents: []*exchange.EventPaymentRejected{
 				exchange.NewEventPaymentRejected(s.newTestPayment(s.addr3, "", s.longAddr1, "8tangerine", "abc")),
@@ -1074,12 +1077,12 @@ func (s *TestSuite) TestKeeper_RejectPayments() {
 			target:  s.addr5,
 			sources: []sdk.AccAddress{s.addr2, s.longAddr3, s.addr1},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr2, funds: s.coins("251strawberry")},
-				{addr: s.addr2, funds: s.coins("252strawberry")},
-				{addr: s.longAddr3, funds: s.coins("3351strawberry")},
-				{addr: s.longAddr3, funds: s.coins("3352strawberry")},
-				{addr: s.longAddr3, funds: s.coins("3353strawberry")},
-				{addr: s.addr1, funds: s.coins("151strawberry,3starfruit")},
+				NewReleaseHoldArgs(s.addr2, s.coins("251strawberry"), "x/exchange: payment \"111\""),
+				NewReleaseHoldArgs(s.addr2, s.coins("252strawberry"), "x/exchange: payment \"222\""),
+				NewReleaseHoldArgs(s.longAddr3, s.coins("3351strawberry"), "x/exchange: payment \"111\""),
+				NewReleaseHoldArgs(s.longAddr3, s.coins("3352strawberry"), "x/exchange: payment \"222\""),
+				NewReleaseHoldArgs(s.longAddr3, s.coins("3353strawberry"), "x/exchange: payment \"444\""),
+				NewReleaseHoldArgs(s.addr1, s.coins("151strawberry,3starfruit"), "x/exchange: payment \"\""),
 			}},
 			expEvents: []*exchange.EventPaymentRejected{
 				exchange.NewEventPaymentRejected(s.newTestPayment(s.addr2, "251strawberry", s.addr5, "12tomato", "111")),
@@ -1233,7 +1236,7 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			source:       s.longAddr1,
 			externalIDs:  []string{"abc"},
 			expErr:       "error releasing hold on payment source: let it go",
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.longAddr1, funds: nil}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.longAddr1, nil, "x/exchange: payment \"abc\"")}},
 			expDeleted:   []paymentKey{newPKey(s.longAddr1, "abc")},
 		},
 		{
@@ -1245,7 +1248,7 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			},
 			source:       s.longAddr2,
 			externalIDs:  []string{"whatever"},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.longAddr2, funds: s.coins("8starfruit")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.longAddr2, s.coins("8starfruit"), "x/exchange: payment \"whatever\"")}},
 			expEvents: []*exchange.EventPaymentCancelled{
 				exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr2, "8starfruit", s.addr4, "", "whatever")),
 			},
@@ -1274,7 +1277,7 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			},
 			source:       s.addr3,
 			externalIDs:  []string{""},
-			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{{addr: s.addr3, funds: s.coins("3strawberry")}}},
+			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{NewReleaseHoldArgs(s.addr3, s.coins("3strawberry"), "x/exchange: payment \"\"")}},
 			expEvents: []*exchange.EventPaymentCancelled{
 				exchange.NewEventPaymentCancelled(s.newTestPayment(s.addr3, "3strawberry", s.longAddr1, "", "")),
 			},
@@ -1293,8 +1296,8 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			source:      s.longAddr3,
 			externalIDs: []string{"456", "", "456"},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.longAddr3, funds: s.coins("5starfruit")},
-				{addr: s.longAddr3, funds: s.coins("3strawberry")},
+				NewReleaseHoldArgs(s.longAddr3, s.coins("5starfruit"), "x/exchange: payment \"456\""),
+				NewReleaseHoldArgs(s.longAddr3, s.coins("3strawberry"), "x/exchange: payment \"\""),
 			}},
 			expEvents: []*exchange.EventPaymentCancelled{
 				exchange.NewEventPaymentCancelled(s.newTestPayment(s.longAddr3, "5starfruit", s.longAddr1, "", "456")),
@@ -1331,9 +1334,9 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			externalIDs: []string{"123", "456", ""},
 			expErr:      "error releasing hold on payment source: third time fails",
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr2, funds: s.coins("4strawberry")},
-				{addr: s.addr2, funds: nil},
-				{addr: s.addr2, funds: s.coins("3strawberry")},
+				NewReleaseHoldArgs(s.addr2, s.coins("4strawberry"), "x/exchange: payment \"123\""),
+				NewReleaseHoldArgs(s.addr2, nil, "x/exchange: payment \"456\""),
+				NewReleaseHoldArgs(s.addr2, s.coins("3strawberry"), "x/exchange: payment \"\""),
 			}},
 			expDeleted: []paymentKey{newPKey(s.addr2, ""), newPKey(s.addr2, "123"), newPKey(s.addr2, "456")},
 		},
@@ -1358,9 +1361,9 @@ func (s *TestSuite) TestKeeper_CancelPayments() {
 			source:      s.addr3,
 			externalIDs: []string{"DD", "BB", "CC"},
 			expHoldCalls: HoldCalls{ReleaseHold: []*ReleaseHoldArgs{
-				{addr: s.addr3, funds: s.coins("16strawberry")},
-				{addr: s.addr3, funds: s.coins("14strawberry")},
-				{addr: s.addr3, funds: s.coins("15strawberry")},
+				NewReleaseHoldArgs(s.addr3, s.coins("16strawberry"), "x/exchange: payment \"DD\""),
+				NewReleaseHoldArgs(s.addr3, s.coins("14strawberry"), "x/exchange: payment \"BB\""),
+				NewReleaseHoldArgs(s.addr3, s.coins("15strawberry"), "x/exchange: payment \"CC\""),
 			}},
 			expEvents: []*exchange.EventPaymentCancelled{
 				exchange.NewEventPaymentCancelled(s.newTestPayment(s.addr3, "16strawberry", s.addr4, "", "DD")),
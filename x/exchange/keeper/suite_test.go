@@ -6,6 +6,7 @@ import (
 	"sort"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -271,6 +272,15 @@ func (s *TestSuite) copyCoinP(orig *sdk.Coin) *sdk.Coin {
 	return &rv
 }
 
+// copyTimeP creates a copy of a *time.Time.
+func (s *TestSuite) copyTimeP(orig *time.Time) *time.Time {
+	if orig == nil {
+		return nil
+	}
+	rv := *orig
+	return &rv
+}
+
 // copyCoins creates a copy of coins (as best as possible).
 func (s *TestSuite) copyCoins(orig []sdk.Coin) []sdk.Coin {
 	return copySlice(orig, s.copyCoin)
@@ -333,6 +343,7 @@ func (s *TestSuite) copyMarket(orig exchange.Market) exchange.Market {
 		CommitmentSettlementBips:  orig.CommitmentSettlementBips,
 		IntermediaryDenom:         orig.IntermediaryDenom,
 		ReqAttrCreateCommitment:   s.copyStrings(orig.ReqAttrCreateCommitment),
+		AutoMatch:                 orig.AutoMatch,
 	}
 }
 
@@ -373,6 +384,8 @@ func (s *TestSuite) copyAskOrder(orig *exchange.AskOrder) *exchange.AskOrder {
 		SellerSettlementFlatFee: s.copyCoinP(orig.SellerSettlementFlatFee),
 		AllowPartial:            orig.AllowPartial,
 		ExternalId:              orig.ExternalId,
+		ExpirationHeight:        orig.ExpirationHeight,
+		ExpirationTime:          s.copyTimeP(orig.ExpirationTime),
 	}
 }
 
@@ -389,6 +402,8 @@ func (s *TestSuite) copyBidOrder(orig *exchange.BidOrder) *exchange.BidOrder {
 		BuyerSettlementFees: s.copyCoins(orig.BuyerSettlementFees),
 		AllowPartial:        orig.AllowPartial,
 		ExternalId:          orig.ExternalId,
+		ExpirationHeight:    orig.ExpirationHeight,
+		ExpirationTime:      s.copyTimeP(orig.ExpirationTime),
 	}
 }
 
@@ -479,9 +494,29 @@ func (s *TestSuite) copyGenState(genState *exchange.GenesisState) *exchange.Gene
 		LastOrderId:  genState.LastOrderId,
 		Commitments:  s.copyCommitments(genState.Commitments),
 		Payments:     s.copyPayments(genState.Payments),
+		Trades:       s.copyTrades(genState.Trades),
 	}
 }
 
+// copyTrade creates a copy of a trade.
+func (s *TestSuite) copyTrade(orig exchange.Trade) exchange.Trade {
+	return exchange.Trade{
+		MarketId:    orig.MarketId,
+		TradeId:     orig.TradeId,
+		AskOrderIds: copySlice(orig.AskOrderIds, func(id uint64) uint64 { return id }),
+		BidOrderIds: copySlice(orig.BidOrderIds, func(id uint64) uint64 { return id }),
+		Assets:      s.copyCoin(orig.Assets),
+		Price:       s.copyCoin(orig.Price),
+		BlockHeight: orig.BlockHeight,
+		BlockTime:   orig.BlockTime,
+	}
+}
+
+// copyTrades creates a copy of a slice of trades.
+func (s *TestSuite) copyTrades(orig []exchange.Trade) []exchange.Trade {
+	return copySlice(orig, s.copyTrade)
+}
+
 // sortMarket sorts all the fields in a market.
 func (s *TestSuite) sortMarket(market *exchange.Market) *exchange.Market {
 	if len(market.FeeSellerSettlementRatios) > 0 {
@@ -586,6 +621,15 @@ func (s *TestSuite) sortGenState(genState *exchange.GenesisState) *exchange.Gene
 		})
 	}
 
+	if len(genState.Trades) > 0 {
+		sort.Slice(genState.Trades, func(i, j int) bool {
+			if genState.Trades[i].MarketId != genState.Trades[j].MarketId {
+				return genState.Trades[i].MarketId < genState.Trades[j].MarketId
+			}
+			return genState.Trades[i].TradeId < genState.Trades[j].TradeId
+		})
+	}
+
 	return genState
 }
 
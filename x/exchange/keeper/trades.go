@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// MaxTradesPerMarket is the maximum number of trades kept in state for each market.
+// Once a market has more trades than this, the oldest ones are deleted.
+const MaxTradesPerMarket = 10_000
+
+// getLastTradeID gets the id of the most recent trade recorded in a market. Returns 0 if there aren't any.
+func getLastTradeID(store storetypes.KVStore, marketID uint32) uint64 {
+	iter := storetypes.KVStoreReversePrefixIterator(store, GetKeyPrefixMarketTrades(marketID))
+	defer iter.Close()
+	if !iter.Valid() {
+		return 0
+	}
+	_, tradeID, err := ParseKeyTrade(iter.Key())
+	if err != nil {
+		return 0
+	}
+	return tradeID
+}
+
+// setTradeInStore writes a trade to the store.
+func (k Keeper) setTradeInStore(store storetypes.KVStore, trade exchange.Trade) error {
+	value, err := k.cdc.Marshal(&trade)
+	if err != nil {
+		return fmt.Errorf("failed to marshal market %d trade %d: %w", trade.MarketId, trade.TradeId, err)
+	}
+	store.Set(MakeKeyTrade(trade.MarketId, trade.TradeId), value)
+	return nil
+}
+
+// pruneTrades deletes the trades in a market that are no longer among the most recent MaxTradesPerMarket.
+func pruneTrades(store storetypes.KVStore, marketID uint32, lastTradeID uint64) {
+	if lastTradeID <= MaxTradesPerMarket {
+		return
+	}
+	end := MakeKeyTrade(marketID, lastTradeID-MaxTradesPerMarket+1)
+	var keys [][]byte
+	iter := store.Iterator(GetKeyPrefixMarketTrades(marketID), end)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// recordTrades records a trade for each of the provided net-asset-values of a settlement.
+// The ids of the settlement's orders with the same assets and price denoms are included in each trade.
+func (k Keeper) recordTrades(ctx sdk.Context, store storetypes.KVStore, marketID uint32, settlement *exchange.Settlement, navs []exchange.NetAssetPrice) {
+	if len(navs) == 0 {
+		return
+	}
+
+	orders := settlement.FullyFilledOrders
+	if settlement.PartialOrderFilled != nil {
+		orders = append(orders[:len(orders):len(orders)], settlement.PartialOrderFilled)
+	}
+
+	tradeID := getLastTradeID(store, marketID)
+	for _, nav := range navs {
+		tradeID++
+		trade := exchange.Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			Assets:      nav.Assets,
+			Price:       nav.Price,
+			BlockHeight: ctx.BlockHeight(),
+			BlockTime:   ctx.BlockTime().UTC(),
+		}
+		for _, order := range orders {
+			if order.GetAssets().Denom != nav.Assets.Denom || order.GetPrice().Denom != nav.Price.Denom {
+				continue
+			}
+			if order.IsAskOrder() {
+				trade.AskOrderIds = append(trade.AskOrderIds, order.GetOrderID())
+			} else {
+				trade.BidOrderIds = append(trade.BidOrderIds, order.GetOrderID())
+			}
+		}
+		if err := k.setTradeInStore(store, trade); err != nil {
+			k.logErrorf(ctx, "error recording trade in market %d: %v", marketID, err)
+		}
+	}
+
+	pruneTrades(store, marketID, tradeID)
+}
+
+// IterateTrades iterates over all trades in all markets.
+// An error is returned if there was a problem reading an entry along the way.
+// Such a problem will not interrupt iteration.
+// The callback takes in the trade and should return whether to stop iterating.
+func (k Keeper) IterateTrades(ctx sdk.Context, cb func(trade *exchange.Trade) bool) error {
+	return k.iterateTrades(ctx, GetKeyPrefixTrades(), cb)
+}
+
+// IterateMarketTrades iterates over the trades in a market, oldest first.
+// An error is returned if there was a problem reading an entry along the way.
+// Such a problem will not interrupt iteration.
+// The callback takes in the trade and should return whether to stop iterating.
+func (k Keeper) IterateMarketTrades(ctx sdk.Context, marketID uint32, cb func(trade *exchange.Trade) bool) error {
+	return k.iterateTrades(ctx, GetKeyPrefixMarketTrades(marketID), cb)
+}
+
+// iterateTrades iterates over the trades with the given key prefix.
+func (k Keeper) iterateTrades(ctx sdk.Context, pre []byte, cb func(trade *exchange.Trade) bool) error {
+	var errs []error
+	k.iterate(ctx, pre, func(key, value []byte) bool {
+		var trade exchange.Trade
+		if err := k.cdc.Unmarshal(value, &trade); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read trade %x: %w", key, err))
+			return false
+		}
+		return cb(&trade)
+	})
+	return errors.Join(errs...)
+}
+
+// GetLastMarketTrade gets the most recent trade in a market for an asset and price denom.
+// Returns nil if the market doesn't have any such trades.
+func (k Keeper) GetLastMarketTrade(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string) (*exchange.Trade, error) {
+	iter := storetypes.KVStoreReversePrefixIterator(k.getStore(ctx), GetKeyPrefixMarketTrades(marketID))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var trade exchange.Trade
+		if err := k.cdc.Unmarshal(iter.Value(), &trade); err != nil {
+			return nil, fmt.Errorf("failed to read trade %x: %w", iter.Key(), err)
+		}
+		if trade.Assets.Denom == assetDenom && trade.Price.Denom == priceDenom {
+			return &trade, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetRecentMarketTrades gets up to maxTrades of the most recent trades in a market for an asset and price denom,
+// newest first. Only trades from blocks minBlockHeight through maxBlockHeight (inclusive) are included.
+func (k Keeper) GetRecentMarketTrades(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string, minBlockHeight, maxBlockHeight int64, maxTrades int) ([]*exchange.Trade, error) {
+	var rv []*exchange.Trade
+	iter := storetypes.KVStoreReversePrefixIterator(k.getStore(ctx), GetKeyPrefixMarketTrades(marketID))
+	defer iter.Close()
+	for ; iter.Valid() && len(rv) < maxTrades; iter.Next() {
+		var trade exchange.Trade
+		if err := k.cdc.Unmarshal(iter.Value(), &trade); err != nil {
+			return nil, fmt.Errorf("failed to read trade %x: %w", iter.Key(), err)
+		}
+		// Trade ids increase with block height, so all the remaining trades are older too.
+		if trade.BlockHeight < minBlockHeight {
+			break
+		}
+		if trade.BlockHeight > maxBlockHeight {
+			continue
+		}
+		if trade.Assets.Denom == assetDenom && trade.Price.Denom == priceDenom {
+			rv = append(rv, &trade)
+		}
+	}
+	return rv, nil
+}
+
+// GetPageOfMarketTrades gets a page of the trades in a market.
+func (k Keeper) GetPageOfMarketTrades(
+	ctx sdk.Context,
+	marketID uint32,
+	pageReq *query.PageRequest,
+) ([]*exchange.Trade, *query.PageResponse, error) {
+	tradeStore := prefix.NewStore(k.getStore(ctx), GetKeyPrefixMarketTrades(marketID))
+	var trades []*exchange.Trade
+	pageResp, err := query.Paginate(tradeStore, pageReq, func(_, value []byte) error {
+		var trade exchange.Trade
+		if err := k.cdc.Unmarshal(value, &trade); err != nil {
+			return err
+		}
+		trades = append(trades, &trade)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return trades, pageResp, nil
+}
+
+// GetMarketCandles builds the candles of the trades in a market for an asset and price denom.
+// If maxCandles is not zero, only that many of the most recent candles are returned.
+func (k Keeper) GetMarketCandles(
+	ctx sdk.Context,
+	marketID uint32,
+	assetDenom, priceDenom string,
+	interval exchange.CandleInterval,
+	maxCandles uint32,
+) ([]exchange.Candle, error) {
+	if err := interval.Validate(); err != nil {
+		return nil, err
+	}
+
+	var trades []*exchange.Trade
+	err := k.IterateMarketTrades(ctx, marketID, func(trade *exchange.Trade) bool {
+		if trade.Assets.Denom == assetDenom && trade.Price.Denom == priceDenom {
+			trades = append(trades, trade)
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	candles := exchange.BuildCandles(trades, interval)
+	if maxCandles > 0 && len(candles) > int(maxCandles) {
+		candles = candles[len(candles)-int(maxCandles):]
+	}
+	return candles, nil
+}
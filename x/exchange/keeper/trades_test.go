@@ -0,0 +1,419 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// requireSetTradesInStore stores each of the provided trades, requiring it to not panic or error.
+func (s *TestSuite) requireSetTradesInStore(trades ...exchange.Trade) {
+	store := s.getStore()
+	for _, trade := range trades {
+		assertions.RequireNotPanicsNoErrorf(s.T(), func() error {
+			return s.k.SetTradeInStore(store, trade)
+		}, "SetTradeInStore market %d trade %d", trade.MarketId, trade.TradeId)
+	}
+}
+
+// getAllTrades gets all the trades in the state store.
+func (s *TestSuite) getAllTrades() []*exchange.Trade {
+	var rv []*exchange.Trade
+	err := s.k.IterateTrades(s.ctx, func(trade *exchange.Trade) bool {
+		rv = append(rv, trade)
+		return false
+	})
+	s.Require().NoError(err, "IterateTrades")
+	return rv
+}
+
+func (s *TestSuite) TestKeeper_RecordTrades() {
+	blockTime := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	askOrder := func(orderID uint64, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithAsk(&exchange.AskOrder{
+			MarketId: 1, Seller: s.addr1.String(), Assets: s.coin(assets), Price: s.coin(price),
+		})
+	}
+	bidOrder := func(orderID uint64, assets, price string) *exchange.Order {
+		return exchange.NewOrder(orderID).WithBid(&exchange.BidOrder{
+			MarketId: 1, Buyer: s.addr2.String(), Assets: s.coin(assets), Price: s.coin(price),
+		})
+	}
+	trade := func(tradeID uint64, askIDs, bidIDs []uint64, assets, price string) *exchange.Trade {
+		return &exchange.Trade{
+			MarketId:    1,
+			TradeId:     tradeID,
+			AskOrderIds: askIDs,
+			BidOrderIds: bidIDs,
+			Assets:      s.coin(assets),
+			Price:       s.coin(price),
+			BlockHeight: 12,
+			BlockTime:   blockTime,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		existing   []exchange.Trade
+		settlement *exchange.Settlement
+		navs       []exchange.NetAssetPrice
+		expTrades  []*exchange.Trade
+	}{
+		{
+			name:       "no navs",
+			settlement: &exchange.Settlement{FullyFilledOrders: []*exchange.FilledOrder{}},
+			navs:       nil,
+			expTrades:  nil,
+		},
+		{
+			name: "one nav, first trade",
+			settlement: &exchange.Settlement{
+				FullyFilledOrders: []*exchange.FilledOrder{
+					exchange.NewFilledOrder(askOrder(3, "10apple", "40peach"), s.coin("50peach"), nil),
+					exchange.NewFilledOrder(bidOrder(4, "10apple", "50peach"), s.coin("50peach"), nil),
+				},
+			},
+			navs:      []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("50peach")}},
+			expTrades: []*exchange.Trade{trade(1, []uint64{3}, []uint64{4}, "10apple", "50peach")},
+		},
+		{
+			name: "two navs with a partial order",
+			existing: []exchange.Trade{
+				*trade(7, []uint64{1}, []uint64{2}, "1apple", "1peach"),
+			},
+			settlement: &exchange.Settlement{
+				FullyFilledOrders: []*exchange.FilledOrder{
+					exchange.NewFilledOrder(askOrder(11, "10apple", "40peach"), s.coin("50peach"), nil),
+					exchange.NewFilledOrder(bidOrder(12, "5apple", "30peach"), s.coin("30peach"), nil),
+					exchange.NewFilledOrder(askOrder(13, "2cherry", "8plum"), s.coin("8plum"), nil),
+					exchange.NewFilledOrder(bidOrder(14, "2cherry", "8plum"), s.coin("8plum"), nil),
+				},
+				PartialOrderFilled: exchange.NewFilledOrder(bidOrder(15, "5apple", "20peach"), s.coin("20peach"), nil),
+			},
+			navs: []exchange.NetAssetPrice{
+				{Assets: s.coin("10apple"), Price: s.coin("50peach")},
+				{Assets: s.coin("2cherry"), Price: s.coin("8plum")},
+			},
+			expTrades: []*exchange.Trade{
+				trade(7, []uint64{1}, []uint64{2}, "1apple", "1peach"),
+				trade(8, []uint64{11}, []uint64{12, 15}, "10apple", "50peach"),
+				trade(9, []uint64{13}, []uint64{14}, "2cherry", "8plum"),
+			},
+		},
+		{
+			name: "old trades are pruned",
+			existing: []exchange.Trade{
+				*trade(1, []uint64{1}, nil, "1apple", "1peach"),
+				*trade(2, []uint64{1}, nil, "1apple", "1peach"),
+				*trade(keeper.MaxTradesPerMarket, []uint64{1}, nil, "1apple", "1peach"),
+			},
+			settlement: &exchange.Settlement{
+				FullyFilledOrders: []*exchange.FilledOrder{
+					exchange.NewFilledOrder(askOrder(3, "10apple", "40peach"), s.coin("50peach"), nil),
+				},
+			},
+			navs: []exchange.NetAssetPrice{{Assets: s.coin("10apple"), Price: s.coin("50peach")}},
+			expTrades: []*exchange.Trade{
+				trade(2, []uint64{1}, nil, "1apple", "1peach"),
+				trade(keeper.MaxTradesPerMarket, []uint64{1}, nil, "1apple", "1peach"),
+				trade(keeper.MaxTradesPerMarket+1, []uint64{3}, nil, "10apple", "50peach"),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetTradesInStore(tc.existing...)
+
+			ctx := s.ctx.WithBlockHeight(12).WithBlockTime(blockTime)
+			testFunc := func() {
+				s.k.RecordTrades(ctx, s.getStore(), 1, tc.settlement, tc.navs)
+			}
+			s.Require().NotPanics(testFunc, "RecordTrades")
+			actTrades := s.getAllTrades()
+			s.Assert().Equal(tc.expTrades, actTrades, "trades in state after RecordTrades")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetLastMarketTrade() {
+	blockTime := time.Date(2024, 2, 3, 4, 5, 0, 0, time.UTC)
+	trade := func(marketID uint32, tradeID uint64, assets, price string) exchange.Trade {
+		return exchange.Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID},
+			Assets:      s.coin(assets),
+			Price:       s.coin(price),
+			BlockHeight: int64(tradeID),
+			BlockTime:   blockTime.Add(time.Duration(tradeID) * time.Second),
+		}
+	}
+	trades := []exchange.Trade{
+		trade(1, 1, "10apple", "30peach"),
+		trade(1, 2, "10apple", "50peach"),
+		trade(1, 3, "2cherry", "7peach"),
+		trade(2, 4, "10apple", "90peach"),
+	}
+
+	tests := []struct {
+		name       string
+		marketID   uint32
+		assetDenom string
+		priceDenom string
+		expTrade   *exchange.Trade
+	}{
+		{name: "unknown market", marketID: 3, assetDenom: "apple", priceDenom: "peach", expTrade: nil},
+		{name: "unknown denoms", marketID: 1, assetDenom: "apple", priceDenom: "plum", expTrade: nil},
+		{name: "not the most recent trade in the market", marketID: 1, assetDenom: "apple", priceDenom: "peach", expTrade: &trades[1]},
+		{name: "most recent trade in the market", marketID: 1, assetDenom: "cherry", priceDenom: "peach", expTrade: &trades[2]},
+		{name: "other market", marketID: 2, assetDenom: "apple", priceDenom: "peach", expTrade: &trades[3]},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetTradesInStore(trades...)
+
+			var actual *exchange.Trade
+			var err error
+			testFunc := func() {
+				actual, err = s.k.GetLastMarketTrade(s.ctx, tc.marketID, tc.assetDenom, tc.priceDenom)
+			}
+			s.Require().NotPanics(testFunc, "GetLastMarketTrade")
+			s.Require().NoError(err, "GetLastMarketTrade error")
+			s.Assert().Equal(tc.expTrade, actual, "GetLastMarketTrade result")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetRecentMarketTrades() {
+	blockTime := time.Date(2024, 2, 3, 4, 5, 0, 0, time.UTC)
+	trade := func(marketID uint32, tradeID uint64, assets, price string) exchange.Trade {
+		return exchange.Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID},
+			Assets:      s.coin(assets),
+			Price:       s.coin(price),
+			BlockHeight: int64(tradeID),
+			BlockTime:   blockTime.Add(time.Duration(tradeID) * time.Second),
+		}
+	}
+	trades := []exchange.Trade{
+		trade(1, 1, "10apple", "30peach"),
+		trade(1, 2, "10apple", "50peach"),
+		trade(1, 3, "2cherry", "7peach"),
+		trade(1, 4, "10apple", "60peach"),
+		trade(2, 5, "10apple", "90peach"),
+	}
+
+	tests := []struct {
+		name           string
+		marketID       uint32
+		assetDenom     string
+		priceDenom     string
+		minBlockHeight int64
+		maxBlockHeight int64
+		maxTrades      int
+		expTrades      []*exchange.Trade
+	}{
+		{name: "unknown market", marketID: 3, assetDenom: "apple", priceDenom: "peach", maxTrades: 10, expTrades: nil},
+		{name: "unknown denoms", marketID: 1, assetDenom: "apple", priceDenom: "plum", maxTrades: 10, expTrades: nil},
+		{
+			name:       "all trades of the denoms",
+			marketID:   1,
+			assetDenom: "apple", priceDenom: "peach",
+			maxTrades: 10,
+			expTrades: []*exchange.Trade{&trades[3], &trades[1], &trades[0]},
+		},
+		{
+			name:       "limited to max trades",
+			marketID:   1,
+			assetDenom: "apple", priceDenom: "peach",
+			maxTrades: 2,
+			expTrades: []*exchange.Trade{&trades[3], &trades[1]},
+		},
+		{
+			name:       "limited to min block height",
+			marketID:   1,
+			assetDenom: "apple", priceDenom: "peach",
+			minBlockHeight: 2,
+			maxTrades:      10,
+			expTrades:      []*exchange.Trade{&trades[3], &trades[1]},
+		},
+		{
+			name:       "limited to max block height",
+			marketID:   1,
+			assetDenom: "apple", priceDenom: "peach",
+			maxBlockHeight: 3,
+			maxTrades:      10,
+			expTrades:      []*exchange.Trade{&trades[1], &trades[0]},
+		},
+		{
+			name:       "other market",
+			marketID:   2,
+			assetDenom: "apple", priceDenom: "peach",
+			maxTrades: 10,
+			expTrades: []*exchange.Trade{&trades[4]},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetTradesInStore(trades...)
+
+			if tc.maxBlockHeight == 0 {
+				tc.maxBlockHeight = 100
+			}
+
+			var actual []*exchange.Trade
+			var err error
+			testFunc := func() {
+				actual, err = s.k.GetRecentMarketTrades(s.ctx, tc.marketID, tc.assetDenom, tc.priceDenom, tc.minBlockHeight, tc.maxBlockHeight, tc.maxTrades)
+			}
+			s.Require().NotPanics(testFunc, "GetRecentMarketTrades")
+			s.Require().NoError(err, "GetRecentMarketTrades error")
+			s.Assert().Equal(tc.expTrades, actual, "GetRecentMarketTrades result")
+		})
+	}
+}
+
+func (s *TestSuite) TestKeeper_GetMarketCandles() {
+	startTime := time.Date(2024, 2, 3, 4, 5, 0, 0, time.UTC)
+	trade := func(marketID uint32, tradeID uint64, offset time.Duration, assets, price string) exchange.Trade {
+		return exchange.Trade{
+			MarketId:    marketID,
+			TradeId:     tradeID,
+			AskOrderIds: []uint64{tradeID},
+			Assets:      s.coin(assets),
+			Price:       s.coin(price),
+			BlockTime:   startTime.Add(offset),
+		}
+	}
+	dec := sdkmath.LegacyMustNewDecFromStr
+	trades := []exchange.Trade{
+		trade(1, 1, 0, "10apple", "30peach"),
+		trade(1, 2, 10*time.Second, "2cherry", "7plum"),
+		trade(1, 3, 20*time.Second, "10apple", "50peach"),
+		trade(1, 4, 2*time.Minute, "10apple", "40peach"),
+		trade(1, 5, 3*time.Minute, "10apple", "20peach"),
+		trade(2, 1, 30*time.Second, "10apple", "90peach"),
+	}
+
+	tests := []struct {
+		name       string
+		marketID   uint32
+		assetDenom string
+		priceDenom string
+		interval   exchange.CandleInterval
+		maxCandles uint32
+		expCandles []exchange.Candle
+		expErr     string
+	}{
+		{
+			name:       "invalid interval",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "peach",
+			interval:   exchange.CandleInterval_unspecified,
+			expErr:     "candle interval is unspecified",
+		},
+		{
+			name:       "unknown market",
+			marketID:   3,
+			assetDenom: "apple",
+			priceDenom: "peach",
+			interval:   exchange.CandleInterval_one_minute,
+			expCandles: nil,
+		},
+		{
+			name:       "unknown denoms",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "plum",
+			interval:   exchange.CandleInterval_one_minute,
+			expCandles: nil,
+		},
+		{
+			name:       "one minute",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "peach",
+			interval:   exchange.CandleInterval_one_minute,
+			expCandles: []exchange.Candle{
+				{
+					StartTime: startTime,
+					Open:      dec("3"), High: dec("5"), Low: dec("3"), Close: dec("5"),
+					Volume: s.coin("20apple"), Value: s.coin("80peach"), TradeCount: 2,
+				},
+				{
+					StartTime: startTime.Add(2 * time.Minute),
+					Open:      dec("4"), High: dec("4"), Low: dec("4"), Close: dec("4"),
+					Volume: s.coin("10apple"), Value: s.coin("40peach"), TradeCount: 1,
+				},
+				{
+					StartTime: startTime.Add(3 * time.Minute),
+					Open:      dec("2"), High: dec("2"), Low: dec("2"), Close: dec("2"),
+					Volume: s.coin("10apple"), Value: s.coin("20peach"), TradeCount: 1,
+				},
+			},
+		},
+		{
+			name:       "one minute, max two",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "peach",
+			interval:   exchange.CandleInterval_one_minute,
+			maxCandles: 2,
+			expCandles: []exchange.Candle{
+				{
+					StartTime: startTime.Add(2 * time.Minute),
+					Open:      dec("4"), High: dec("4"), Low: dec("4"), Close: dec("4"),
+					Volume: s.coin("10apple"), Value: s.coin("40peach"), TradeCount: 1,
+				},
+				{
+					StartTime: startTime.Add(3 * time.Minute),
+					Open:      dec("2"), High: dec("2"), Low: dec("2"), Close: dec("2"),
+					Volume: s.coin("10apple"), Value: s.coin("20peach"), TradeCount: 1,
+				},
+			},
+		},
+		{
+			name:       "one day",
+			marketID:   1,
+			assetDenom: "apple",
+			priceDenom: "peach",
+			interval:   exchange.CandleInterval_one_day,
+			expCandles: []exchange.Candle{
+				{
+					StartTime: startTime.Truncate(24 * time.Hour),
+					Open:      dec("3"), High: dec("5"), Low: dec("2"), Close: dec("2"),
+					Volume: s.coin("40apple"), Value: s.coin("140peach"), TradeCount: 4,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearExchangeState()
+			s.requireSetTradesInStore(trades...)
+
+			var candles []exchange.Candle
+			var err error
+			testFunc := func() {
+				candles, err = s.k.GetMarketCandles(s.ctx, tc.marketID, tc.assetDenom, tc.priceDenom, tc.interval, tc.maxCandles)
+			}
+			s.Require().NotPanics(testFunc, "GetMarketCandles")
+			s.assertErrorValue(err, tc.expErr, "GetMarketCandles error")
+			s.Assert().Equal(tc.expCandles, candles, "GetMarketCandles candles")
+		})
+	}
+}
@@ -14,6 +14,9 @@ const (
 
 	// StoreKey is the store key string for the exchange module.
 	StoreKey = ModuleName
+
+	// RouterKey is the message route for the exchange module.
+	RouterKey = ModuleName
 )
 
 // GetMarketAddress returns the module account address for the given marketID.
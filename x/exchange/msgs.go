@@ -8,6 +8,7 @@ import (
 	"google.golang.org/protobuf/protoadapt"
 	"google.golang.org/protobuf/reflect/protoreflect"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/x/tx/signing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgCreateBidRequest)(nil),
 	(*MsgCommitFundsRequest)(nil),
 	(*MsgCancelOrderRequest)(nil),
+	(*MsgCreateConditionalOrderRequest)(nil),
 	(*MsgFillBidsRequest)(nil),
 	(*MsgFillAsksRequest)(nil),
 	(*MsgMarketSettleRequest)(nil),
@@ -31,6 +33,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgMarketUpdateAcceptingOrdersRequest)(nil),
 	(*MsgMarketUpdateUserSettleRequest)(nil),
 	(*MsgMarketUpdateAcceptingCommitmentsRequest)(nil),
+	(*MsgMarketUpdateAutoMatchRequest)(nil),
 	(*MsgMarketUpdateIntermediaryDenomRequest)(nil),
 	(*MsgMarketManagePermissionsRequest)(nil),
 	(*MsgMarketManageReqAttrsRequest)(nil),
@@ -154,6 +157,75 @@ func (m MsgCancelOrderRequest) ValidateBasic() error {
 	return nil
 }
 
+func (m MsgCreateConditionalOrderRequest) ValidateBasic() error {
+	var errs []error
+
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		errs = append(errs, fmt.Errorf("invalid owner: %w", err))
+	}
+
+	switch {
+	case m.AskOrder != nil && m.BidOrder != nil:
+		errs = append(errs, errors.New("only one of ask order or bid order can be provided"))
+	case m.AskOrder != nil:
+		if err := m.AskOrder.Validate(); err != nil {
+			errs = append(errs, err)
+		} else if m.AskOrder.Seller != m.Owner {
+			errs = append(errs, fmt.Errorf("ask order seller %q does not equal owner %q", m.AskOrder.Seller, m.Owner))
+		}
+	case m.BidOrder != nil:
+		if err := m.BidOrder.Validate(); err != nil {
+			errs = append(errs, err)
+		} else if m.BidOrder.Buyer != m.Owner {
+			errs = append(errs, fmt.Errorf("bid order buyer %q does not equal owner %q", m.BidOrder.Buyer, m.Owner))
+		}
+	default:
+		errs = append(errs, errors.New("either an ask order or bid order must be provided"))
+	}
+
+	if _, err := m.GetTriggerPriceDec(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := m.TriggerDirection.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	if m.OrderCreationFee != nil {
+		if err := m.OrderCreationFee.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid order creation fee: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetTriggerPriceDec parses this request's trigger price, returning an error if it is invalid or not positive.
+func (m MsgCreateConditionalOrderRequest) GetTriggerPriceDec() (sdkmath.LegacyDec, error) {
+	if len(m.TriggerPrice) == 0 {
+		return sdkmath.LegacyDec{}, errors.New("invalid trigger price: cannot be empty")
+	}
+	rv, err := sdkmath.LegacyNewDecFromStr(m.TriggerPrice)
+	if err != nil {
+		return sdkmath.LegacyDec{}, fmt.Errorf("invalid trigger price %q: %w", m.TriggerPrice, err)
+	}
+	if err = ValidateTriggerPrice(rv); err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	return rv, nil
+}
+
+// GetOrder gets the order (with an id of zero) that this conditional order will create.
+func (m MsgCreateConditionalOrderRequest) GetOrder() *Order {
+	switch {
+	case m.AskOrder != nil:
+		return NewOrder(0).WithAsk(m.AskOrder)
+	case m.BidOrder != nil:
+		return NewOrder(0).WithBid(m.BidOrder)
+	}
+	return nil
+}
+
 func (m MsgFillBidsRequest) ValidateBasic() error {
 	var errs []error
 
@@ -473,6 +545,17 @@ func (m MsgMarketUpdateAcceptingCommitmentsRequest) ValidateBasic() error {
 	return errors.Join(errs...)
 }
 
+func (m MsgMarketUpdateAutoMatchRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		errs = append(errs, fmt.Errorf("invalid administrator %q: %w", m.Admin, err))
+	}
+	if m.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
 func (m MsgMarketUpdateIntermediaryDenomRequest) ValidateBasic() error {
 	var errs []error
 	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
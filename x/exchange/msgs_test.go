@@ -35,6 +35,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgCreateBidRequest{BidOrder: BidOrder{Buyer: signer}} },
 		func(signer string) sdk.Msg { return &MsgCommitFundsRequest{Account: signer} },
 		func(signer string) sdk.Msg { return &MsgCancelOrderRequest{Signer: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateConditionalOrderRequest{Owner: signer} },
 		func(signer string) sdk.Msg { return &MsgFillBidsRequest{Seller: signer} },
 		func(signer string) sdk.Msg { return &MsgFillAsksRequest{Buyer: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketSettleRequest{Admin: signer} },
@@ -47,6 +48,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingOrdersRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateUserSettleRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateAcceptingCommitmentsRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgMarketUpdateAutoMatchRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketUpdateIntermediaryDenomRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManagePermissionsRequest{Admin: signer} },
 		func(signer string) sdk.Msg { return &MsgMarketManageReqAttrsRequest{Admin: signer} },
@@ -471,6 +473,158 @@ func TestMsgCancelOrderRequest_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgCreateConditionalOrderRequest_ValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	other := sdk.AccAddress("other_______________").String()
+	askOrder := func(seller string) *AskOrder {
+		return &AskOrder{
+			MarketId: 1,
+			Seller:   seller,
+			Assets:   sdk.NewInt64Coin("apple", 10),
+			Price:    sdk.NewInt64Coin("peach", 5),
+		}
+	}
+	bidOrder := func(buyer string) *BidOrder {
+		return &BidOrder{
+			MarketId: 1,
+			Buyer:    buyer,
+			Assets:   sdk.NewInt64Coin("apple", 10),
+			Price:    sdk.NewInt64Coin("peach", 5),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		msg    MsgCreateConditionalOrderRequest
+		expErr []string
+	}{
+		{
+			name: "control: ask",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				TriggerPrice:     "0.4",
+				TriggerDirection: TriggerDirection_at_or_below,
+				OrderCreationFee: &sdk.Coin{Denom: "peach", Amount: sdkmath.NewInt(1)},
+			},
+		},
+		{
+			name: "control: bid",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				BidOrder:         bidOrder(owner),
+				TriggerPrice:     "0.6",
+				TriggerDirection: TriggerDirection_at_or_above,
+			},
+		},
+		{
+			name: "invalid owner",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            "notgonnawork",
+				AskOrder:         askOrder(owner),
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"invalid owner: ", bech32Err + "invalid separator index -1"},
+		},
+		{
+			name: "neither order",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"either an ask order or bid order must be provided"},
+		},
+		{
+			name: "both orders",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				BidOrder:         bidOrder(owner),
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"only one of ask order or bid order can be provided"},
+		},
+		{
+			name: "ask seller is not owner",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(other),
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"ask order seller \"" + other + "\" does not equal owner \"" + owner + "\""},
+		},
+		{
+			name: "bid buyer is not owner",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				BidOrder:         bidOrder(other),
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"bid order buyer \"" + other + "\" does not equal owner \"" + owner + "\""},
+		},
+		{
+			name: "zero trigger price",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				TriggerPrice:     "0",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"invalid trigger price 0.000000000000000000: must be positive"},
+		},
+		{
+			name: "empty trigger price",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"invalid trigger price: cannot be empty"},
+		},
+		{
+			name: "invalid trigger price",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				TriggerPrice:     "one",
+				TriggerDirection: TriggerDirection_at_or_below,
+			},
+			expErr: []string{"invalid trigger price \"one\": "},
+		},
+		{
+			name: "unspecified direction",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:        owner,
+				AskOrder:     askOrder(owner),
+				TriggerPrice: "1",
+			},
+			expErr: []string{"trigger direction is unspecified"},
+		},
+		{
+			name: "invalid creation fee",
+			msg: MsgCreateConditionalOrderRequest{
+				Owner:            owner,
+				AskOrder:         askOrder(owner),
+				TriggerPrice:     "1",
+				TriggerDirection: TriggerDirection_at_or_above,
+				OrderCreationFee: &sdk.Coin{Denom: "peach", Amount: sdkmath.NewInt(-1)},
+			},
+			expErr: []string{"invalid order creation fee: negative coin amount: -1"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgFillBidsRequest_ValidateBasic(t *testing.T) {
 	coin := func(amount int64, denom string) *sdk.Coin {
 		return &sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
@@ -1912,6 +2066,69 @@ func TestMsgMarketUpdateAcceptingCommitmentsRequest_ValidateBasic(t *testing.T)
 	}
 }
 
+func TestMsgMarketUpdateAutoMatchRequest_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    MsgMarketUpdateAutoMatchRequest
+		expErr []string
+	}{
+		{
+			name: "control: false",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:     sdk.AccAddress("admin_______________").String(),
+				MarketId:  1,
+				AutoMatch: false,
+			},
+		},
+		{
+			name: "control: true",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:     sdk.AccAddress("admin_______________").String(),
+				MarketId:  1,
+				AutoMatch: true,
+			},
+		},
+		{
+			name: "no admin",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    "",
+				MarketId: 1,
+			},
+			expErr: []string{"invalid administrator \"\": " + emptyAddrErr},
+		},
+		{
+			name: "bad admin",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    "notanadminaddr",
+				MarketId: 1,
+			},
+			expErr: []string{"invalid administrator \"notanadminaddr\": " + bech32Err},
+		},
+		{
+			name: "market zero",
+			msg: MsgMarketUpdateAutoMatchRequest{
+				Admin:    sdk.AccAddress("admin_______________").String(),
+				MarketId: 0,
+			},
+			expErr: []string{"invalid market id: cannot be zero"},
+		},
+		{
+			name: "multiple errors",
+			msg:  MsgMarketUpdateAutoMatchRequest{},
+			expErr: []string{
+				"invalid administrator \"\": " + emptyAddrErr,
+				"invalid market id: cannot be zero",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			testValidateBasic(t, &tc.msg, tc.expErr)
+		})
+	}
+}
+
 func TestMsgMarketUpdateIntermediaryDenomRequest_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name   string
@@ -3,6 +3,7 @@ package exchange
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -35,6 +36,9 @@ type SubOrderI interface {
 	GetSettlementFees() sdk.Coins
 	PartialFillAllowed() bool
 	GetExternalID() string
+	GetExpirationHeight() uint64
+	GetExpirationTime() *time.Time
+	IsExpiredAt(blockHeight int64, blockTime time.Time) bool
 	GetOrderType() string
 	GetOrderTypeByte() byte
 	GetHoldAmount() sdk.Coins
@@ -107,6 +111,15 @@ func ValidateExternalID(externalID string) error {
 	return nil
 }
 
+// isExpiredAt returns true if the provided expiration height or time has been reached by the provided block info.
+// A zero expHeight or nil expTime is treated as not set.
+func isExpiredAt(expHeight uint64, expTime *time.Time, blockHeight int64, blockTime time.Time) bool {
+	if expHeight != 0 && blockHeight >= 0 && uint64(blockHeight) >= expHeight {
+		return true
+	}
+	return expTime != nil && !blockTime.Before(*expTime)
+}
+
 // NewOrder creates a new empty Order with the provided order id.
 // The order details are set using one of: WithAsk, WithBid.
 func NewOrder(orderID uint64) *Order {
@@ -204,6 +217,24 @@ func (o Order) GetExternalID() string {
 	return o.MustGetSubOrder().GetExternalID()
 }
 
+// GetExpirationHeight returns the block height at which this order expires (or 0 if not set).
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) GetExpirationHeight() uint64 {
+	return o.MustGetSubOrder().GetExpirationHeight()
+}
+
+// GetExpirationTime returns the block time at which this order expires (or nil if not set).
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) GetExpirationTime() *time.Time {
+	return o.MustGetSubOrder().GetExpirationTime()
+}
+
+// IsExpiredAt returns true if this order has expired as of the provided block height and time.
+// Panics if the sub-order is not set or is something unexpected.
+func (o Order) IsExpiredAt(blockHeight int64, blockTime time.Time) bool {
+	return o.MustGetSubOrder().IsExpiredAt(blockHeight, blockTime)
+}
+
 // GetOrderType returns a string indicating what type this order is.
 // E.g: OrderTypeAsk or OrderTypeBid
 func (o Order) GetOrderType() string {
@@ -354,6 +385,21 @@ func (a AskOrder) GetExternalID() string {
 	return a.ExternalId
 }
 
+// GetExpirationHeight returns the block height at which this ask order expires (or 0 if not set).
+func (a AskOrder) GetExpirationHeight() uint64 {
+	return a.ExpirationHeight
+}
+
+// GetExpirationTime returns the block time at which this ask order expires (or nil if not set).
+func (a AskOrder) GetExpirationTime() *time.Time {
+	return a.ExpirationTime
+}
+
+// IsExpiredAt returns true if this ask order has expired as of the provided block height and time.
+func (a AskOrder) IsExpiredAt(blockHeight int64, blockTime time.Time) bool {
+	return isExpiredAt(a.ExpirationHeight, a.ExpirationTime, blockHeight, blockTime)
+}
+
 // GetOrderType returns the order type string for this ask order: "ask".
 func (a AskOrder) GetOrderType() string {
 	return OrderTypeAsk
@@ -430,6 +476,8 @@ func (a AskOrder) CopyChange(newAssets, newPrice sdk.Coin, newFee *sdk.Coin) *As
 		SellerSettlementFlatFee: newFee,
 		AllowPartial:            a.AllowPartial,
 		ExternalId:              a.ExternalId,
+		ExpirationHeight:        a.ExpirationHeight,
+		ExpirationTime:          a.ExpirationTime,
 	}
 }
 
@@ -468,6 +516,21 @@ func (b BidOrder) GetExternalID() string {
 	return b.ExternalId
 }
 
+// GetExpirationHeight returns the block height at which this bid order expires (or 0 if not set).
+func (b BidOrder) GetExpirationHeight() uint64 {
+	return b.ExpirationHeight
+}
+
+// GetExpirationTime returns the block time at which this bid order expires (or nil if not set).
+func (b BidOrder) GetExpirationTime() *time.Time {
+	return b.ExpirationTime
+}
+
+// IsExpiredAt returns true if this bid order has expired as of the provided block height and time.
+func (b BidOrder) IsExpiredAt(blockHeight int64, blockTime time.Time) bool {
+	return isExpiredAt(b.ExpirationHeight, b.ExpirationTime, blockHeight, blockTime)
+}
+
 // GetOrderType returns the order type string for this bid order: "bid".
 func (b BidOrder) GetOrderType() string {
 	return OrderTypeBid
@@ -536,6 +599,8 @@ func (b BidOrder) CopyChange(newAssets, newPrice sdk.Coin, newFees sdk.Coins) *B
 		BuyerSettlementFees: newFees,
 		AllowPartial:        b.AllowPartial,
 		ExternalId:          b.ExternalId,
+		ExpirationHeight:    b.ExpirationHeight,
+		ExpirationTime:      b.ExpirationTime,
 	}
 }
 
@@ -623,6 +688,21 @@ func (o FilledOrder) GetExternalID() string {
 	return o.order.GetExternalID()
 }
 
+// GetExpirationHeight returns the block height at which this order expires (or 0 if not set).
+func (o FilledOrder) GetExpirationHeight() uint64 {
+	return o.order.GetExpirationHeight()
+}
+
+// GetExpirationTime returns the block time at which this order expires (or nil if not set).
+func (o FilledOrder) GetExpirationTime() *time.Time {
+	return o.order.GetExpirationTime()
+}
+
+// IsExpiredAt returns true if this order has expired as of the provided block height and time.
+func (o FilledOrder) IsExpiredAt(blockHeight int64, blockTime time.Time) bool {
+	return o.order.IsExpiredAt(blockHeight, blockTime)
+}
+
 // GetOrderType returns a string indicating what type this order is.
 // E.g: OrderTypeAsk or OrderTypeBid
 func (o FilledOrder) GetOrderType() string {
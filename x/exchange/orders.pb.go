@@ -4,6 +4,7 @@
 package exchange
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -12,15 +13,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +33,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TriggerDirection defines which side of a trigger price a trade price must be on to place a conditional order.
+type TriggerDirection int32
+
+const (
+	// TRIGGER_DIRECTION_UNSPECIFIED is the zero-value TriggerDirection; it is an error to use it.
+	TriggerDirection_unspecified TriggerDirection = 0
+	// TRIGGER_DIRECTION_AT_OR_BELOW places the order once there's a trade at or below the trigger price.
+	TriggerDirection_at_or_below TriggerDirection = 1
+	// TRIGGER_DIRECTION_AT_OR_ABOVE places the order once there's a trade at or above the trigger price.
+	TriggerDirection_at_or_above TriggerDirection = 2
+)
+
+var TriggerDirection_name = map[int32]string{
+	0: "TRIGGER_DIRECTION_UNSPECIFIED",
+	1: "TRIGGER_DIRECTION_AT_OR_BELOW",
+	2: "TRIGGER_DIRECTION_AT_OR_ABOVE",
+}
+
+var TriggerDirection_value = map[string]int32{
+	"TRIGGER_DIRECTION_UNSPECIFIED": 0,
+	"TRIGGER_DIRECTION_AT_OR_BELOW": 1,
+	"TRIGGER_DIRECTION_AT_OR_ABOVE": 2,
+}
+
+func (x TriggerDirection) String() string {
+	return proto.EnumName(TriggerDirection_name, int32(x))
+}
+
+func (TriggerDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{0}
+}
+
 // Order associates an order id with one of the order types.
 type Order struct {
 	// order_id is the numerical identifier for this order.
@@ -141,6 +178,12 @@ type AskOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration_height is an optional block height at which this order expires.
+	// If set, the order is cancelled (and its hold released) at the end of the first block with this height or later.
+	ExpirationHeight uint64 `protobuf:"varint,8,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// expiration_time is an optional block time at which this order expires.
+	// If set, the order is cancelled (and its hold released) at the end of the first block with this time or later.
+	ExpirationTime *time.Time `protobuf:"bytes,9,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 }
 
 func (m *AskOrder) Reset()         { *m = AskOrder{} }
@@ -196,6 +239,12 @@ type BidOrder struct {
 	// external_id is an optional string used to externally identify this order. Max length is 100 characters.
 	// If an order in this market with this external id already exists, this order will be rejected.
 	ExternalId string `protobuf:"bytes,7,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+	// expiration_height is an optional block height at which this order expires.
+	// If set, the order is cancelled (and its hold released) at the end of the first block with this height or later.
+	ExpirationHeight uint64 `protobuf:"varint,8,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// expiration_time is an optional block time at which this order expires.
+	// If set, the order is cancelled (and its hold released) at the end of the first block with this time or later.
+	ExpirationTime *time.Time `protobuf:"bytes,9,opt,name=expiration_time,json=expirationTime,proto3,stdtime" json:"expiration_time,omitempty"`
 }
 
 func (m *BidOrder) Reset()         { *m = BidOrder{} }
@@ -231,10 +280,126 @@ func (m *BidOrder) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BidOrder proto.InternalMessageInfo
 
+// PriceLevel is the combination of all the orders on one side of an order book that have the same unit price.
+type PriceLevel struct {
+	// unit_price is the price per one asset of each order in this level.
+	UnitPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=unit_price,json=unitPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"unit_price"`
+	// total_assets is the sum of the assets of the orders in this level.
+	TotalAssets types.Coin `protobuf:"bytes,2,opt,name=total_assets,json=totalAssets,proto3" json:"total_assets"`
+	// total_price is the sum of the prices of the orders in this level.
+	TotalPrice types.Coin `protobuf:"bytes,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price"`
+	// order_count is the number of orders in this level.
+	OrderCount uint32 `protobuf:"varint,4,opt,name=order_count,json=orderCount,proto3" json:"order_count,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{3}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetTotalAssets() types.Coin {
+	if m != nil {
+		return m.TotalAssets
+	}
+	return types.Coin{}
+}
+
+func (m *PriceLevel) GetTotalPrice() types.Coin {
+	if m != nil {
+		return m.TotalPrice
+	}
+	return types.Coin{}
+}
+
+func (m *PriceLevel) GetOrderCount() uint32 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+// ConditionalOrder is an order that is not placed (and has no hold) until a trade in its market meets a price condition.
+type ConditionalOrder struct {
+	// order is the order to place once the condition is met.
+	// Its order id is reserved when the conditional order is created and is kept when the order is placed.
+	Order Order `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// trigger_price is the unit price (amount of the order's price denom per one of its asset denom)
+	// that the trade prices in the order's market are compared to.
+	TriggerPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=trigger_price,json=triggerPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"trigger_price"`
+	// trigger_direction defines which side of the trigger_price a trade price must be on to place the order.
+	TriggerDirection TriggerDirection `protobuf:"varint,3,opt,name=trigger_direction,json=triggerDirection,proto3,enum=provenance.exchange.v1.TriggerDirection" json:"trigger_direction,omitempty"`
+	// order_creation_fee is the fee that will be paid when the order is placed.
+	OrderCreationFee *types.Coin `protobuf:"bytes,4,opt,name=order_creation_fee,json=orderCreationFee,proto3" json:"order_creation_fee,omitempty"`
+}
+
+func (m *ConditionalOrder) Reset()         { *m = ConditionalOrder{} }
+func (m *ConditionalOrder) String() string { return proto.CompactTextString(m) }
+func (*ConditionalOrder) ProtoMessage()    {}
+func (*ConditionalOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dab7cbe63f582471, []int{4}
+}
+func (m *ConditionalOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConditionalOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConditionalOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConditionalOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConditionalOrder.Merge(m, src)
+}
+func (m *ConditionalOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConditionalOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConditionalOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConditionalOrder proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("provenance.exchange.v1.TriggerDirection", TriggerDirection_name, TriggerDirection_value)
 	proto.RegisterType((*Order)(nil), "provenance.exchange.v1.Order")
 	proto.RegisterType((*AskOrder)(nil), "provenance.exchange.v1.AskOrder")
 	proto.RegisterType((*BidOrder)(nil), "provenance.exchange.v1.BidOrder")
+	proto.RegisterType((*PriceLevel)(nil), "provenance.exchange.v1.PriceLevel")
+	proto.RegisterType((*ConditionalOrder)(nil), "provenance.exchange.v1.ConditionalOrder")
 }
 
 func init() {
@@ -242,45 +407,70 @@ func init() {
 }
 
 var fileDescriptor_dab7cbe63f582471 = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xb1, 0x6f, 0xd3, 0x4e,
-	0x14, 0xb6, 0xdb, 0x38, 0x75, 0xaf, 0xed, 0xef, 0x27, 0x4c, 0xa1, 0x4e, 0x91, 0x9c, 0xa8, 0x5d,
-	0xa2, 0x4a, 0xb5, 0x29, 0x08, 0x21, 0x75, 0x41, 0x0d, 0x52, 0x45, 0x27, 0x2a, 0x57, 0x62, 0x60,
-	0xb1, 0xce, 0xf6, 0xab, 0x7b, 0x8a, 0xed, 0x8b, 0x7c, 0xd7, 0xd2, 0xae, 0x4c, 0x8c, 0x2c, 0x2c,
-	0x4c, 0x8c, 0x88, 0x29, 0x12, 0xf0, 0x3f, 0x74, 0xac, 0x98, 0x98, 0x00, 0x25, 0x43, 0xfe, 0x05,
-	0x46, 0xe4, 0xbb, 0x73, 0x1a, 0x24, 0x08, 0x1d, 0x10, 0x8b, 0x7d, 0xef, 0xbd, 0xef, 0x7d, 0xef,
-	0xdd, 0xf7, 0xf4, 0x0e, 0xad, 0xf7, 0x0a, 0x7a, 0x02, 0x39, 0xce, 0x23, 0xf0, 0xe0, 0x34, 0x3a,
-	0xc2, 0x79, 0x02, 0xde, 0xc9, 0x96, 0x47, 0x8b, 0x18, 0x0a, 0xe6, 0xf6, 0x0a, 0xca, 0xa9, 0x75,
-	0xf3, 0x12, 0xe4, 0x56, 0x20, 0xf7, 0x64, 0x6b, 0xf5, 0x1a, 0xce, 0x48, 0x4e, 0x3d, 0xf1, 0x95,
-	0xd0, 0x55, 0x27, 0xa2, 0x2c, 0xa3, 0xcc, 0x0b, 0x31, 0x2b, 0x79, 0x42, 0xe0, 0x78, 0xcb, 0x8b,
-	0x28, 0xc9, 0x55, 0x7c, 0x45, 0xc5, 0x33, 0x96, 0x94, 0x65, 0x32, 0x96, 0xa8, 0x40, 0x43, 0x06,
-	0x02, 0x61, 0x79, 0xd2, 0x50, 0xa1, 0xe5, 0x84, 0x26, 0x54, 0xfa, 0xcb, 0x93, 0xf4, 0xae, 0xbd,
-	0xd7, 0x91, 0xf1, 0xb8, 0xec, 0xd2, 0x6a, 0x20, 0x53, 0xb4, 0x1b, 0x90, 0xd8, 0xd6, 0x5b, 0x7a,
-	0xbb, 0xe6, 0xcf, 0x09, 0x7b, 0x2f, 0xb6, 0x1e, 0xa0, 0x79, 0xcc, 0xba, 0x81, 0x30, 0xed, 0x99,
-	0x96, 0xde, 0x5e, 0xb8, 0xd3, 0x72, 0x7f, 0x7d, 0x1b, 0x77, 0x87, 0x75, 0x05, 0xdf, 0x23, 0xcd,
-	0x37, 0xb1, 0x3a, 0x97, 0x04, 0x21, 0x89, 0x15, 0xc1, 0xec, 0x74, 0x82, 0x0e, 0x89, 0xc7, 0x04,
-	0xa1, 0x3a, 0x6f, 0xd7, 0x5e, 0xbc, 0x69, 0x6a, 0x9d, 0x39, 0x64, 0x08, 0x8a, 0xb5, 0xef, 0x33,
-	0xc8, 0xac, 0x0a, 0x59, 0xb7, 0xd0, 0x7c, 0x86, 0x8b, 0x2e, 0xf0, 0xaa, 0xf3, 0x25, 0xdf, 0x94,
-	0x8e, 0xbd, 0xd8, 0xba, 0x8d, 0xea, 0x0c, 0xd2, 0x54, 0xf5, 0x3d, 0xdf, 0xb1, 0x3f, 0x7d, 0xd8,
-	0x5c, 0x56, 0xba, 0xec, 0xc4, 0x71, 0x01, 0x8c, 0x1d, 0xf0, 0x82, 0xe4, 0x89, 0xaf, 0x70, 0xd6,
-	0x7d, 0x54, 0xc7, 0x8c, 0x01, 0x67, 0xaa, 0xd1, 0x86, 0xab, 0xe0, 0xe5, 0x30, 0x5c, 0x35, 0x0c,
-	0xf7, 0x21, 0x25, 0x79, 0xa7, 0x76, 0xfe, 0xa5, 0xa9, 0xf9, 0x0a, 0x6e, 0xdd, 0x43, 0x46, 0xaf,
-	0x20, 0x11, 0xd8, 0xb5, 0xab, 0xe5, 0x49, 0xb4, 0xf5, 0x04, 0xad, 0xca, 0xca, 0x01, 0x03, 0xce,
-	0x53, 0xc8, 0x20, 0xe7, 0xc1, 0x61, 0x8a, 0x79, 0x70, 0x08, 0x60, 0x1b, 0x7f, 0xe0, 0xf2, 0x57,
-	0x64, 0xf2, 0xc1, 0x38, 0x77, 0x37, 0xc5, 0x7c, 0x17, 0xc0, 0x5a, 0x47, 0x4b, 0x38, 0x4d, 0xe9,
-	0xb3, 0xa0, 0x87, 0x0b, 0x4e, 0x70, 0x6a, 0xd7, 0x5b, 0x7a, 0xdb, 0xf4, 0x17, 0x85, 0x73, 0x5f,
-	0xfa, 0xac, 0x26, 0x5a, 0x80, 0x53, 0x0e, 0x45, 0x8e, 0xd3, 0x52, 0xbd, 0xb9, 0x52, 0x23, 0x1f,
-	0x55, 0xae, 0xbd, 0x78, 0xfb, 0xff, 0x52, 0xf8, 0xe7, 0xa3, 0xfe, 0x86, 0x92, 0x67, 0xed, 0xe3,
-	0x2c, 0x32, 0xab, 0x11, 0x4d, 0x97, 0xde, 0x45, 0x46, 0x78, 0x7c, 0x76, 0x05, 0xe5, 0x25, 0xec,
-	0x9f, 0x0b, 0xff, 0x4a, 0x47, 0x37, 0x44, 0xe5, 0x9f, 0x84, 0x07, 0x60, 0xb6, 0xd1, 0x9a, 0x9d,
-	0xce, 0xb3, 0x5b, 0xf2, 0xbc, 0xfb, 0xda, 0x6c, 0x27, 0x84, 0x1f, 0x1d, 0x87, 0x6e, 0x44, 0x33,
-	0xb5, 0x6c, 0xea, 0xb7, 0xc9, 0xe2, 0xae, 0xc7, 0xcf, 0x7a, 0xc0, 0x44, 0x02, 0x7b, 0x3d, 0xea,
-	0x6f, 0x2c, 0xa6, 0x90, 0xe0, 0xe8, 0x2c, 0x28, 0xf7, 0x98, 0xbd, 0x1d, 0xf5, 0x37, 0x74, 0xff,
-	0xba, 0xa8, 0x3f, 0x31, 0x3b, 0x00, 0xf6, 0x97, 0x06, 0xf7, 0x5f, 0x35, 0x38, 0xa9, 0x6e, 0x07,
-	0xce, 0x07, 0x8e, 0x7e, 0x31, 0x70, 0xf4, 0x6f, 0x03, 0x47, 0x7f, 0x39, 0x74, 0xb4, 0x8b, 0xa1,
-	0xa3, 0x7d, 0x1e, 0x3a, 0x1a, 0x6a, 0x10, 0xfa, 0x9b, 0x5d, 0xdc, 0xd7, 0x9f, 0xba, 0x13, 0x37,
-	0xbc, 0x04, 0x6d, 0x12, 0x3a, 0x61, 0x79, 0xa7, 0xe3, 0x47, 0x2f, 0xac, 0x8b, 0x67, 0xe5, 0xee,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0x15, 0x76, 0x98, 0x12, 0x05, 0x00, 0x00,
+	// 997 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x6b, 0x1b, 0x47,
+	0x14, 0xd6, 0xca, 0x92, 0x2d, 0x8f, 0xfc, 0x43, 0xde, 0xa6, 0x8d, 0xec, 0x10, 0x49, 0x38, 0x17,
+	0xe3, 0xe2, 0xdd, 0xda, 0xa5, 0x94, 0xe6, 0xd2, 0x5a, 0xb6, 0xec, 0x08, 0x4c, 0x6c, 0xd6, 0x8a,
+	0x0b, 0xbd, 0x2c, 0xb3, 0xbb, 0xcf, 0xab, 0x41, 0xbb, 0x3b, 0x62, 0x67, 0xa4, 0xd8, 0xd7, 0x42,
+	0xa1, 0xf4, 0x94, 0x4b, 0x2f, 0x85, 0x42, 0x8f, 0xa5, 0xd0, 0x62, 0x68, 0xfe, 0x88, 0x40, 0x2f,
+	0x21, 0xa7, 0xd2, 0x43, 0x52, 0xec, 0x83, 0xaf, 0xfd, 0x13, 0xca, 0xfc, 0x58, 0x5b, 0x0d, 0xb1,
+	0x63, 0x7a, 0x28, 0xf4, 0x22, 0xed, 0xbc, 0x79, 0xdf, 0x37, 0x6f, 0xbe, 0xf7, 0xed, 0x93, 0xd0,
+	0xbd, 0x7e, 0x4a, 0x87, 0x90, 0xe0, 0xc4, 0x07, 0x1b, 0x8e, 0xfc, 0x2e, 0x4e, 0x42, 0xb0, 0x87,
+	0xab, 0x36, 0x4d, 0x03, 0x48, 0x99, 0xd5, 0x4f, 0x29, 0xa7, 0xe6, 0x7b, 0x97, 0x49, 0x56, 0x96,
+	0x64, 0x0d, 0x57, 0x17, 0xe6, 0x70, 0x4c, 0x12, 0x6a, 0xcb, 0x4f, 0x95, 0xba, 0x50, 0xf3, 0x29,
+	0x8b, 0x29, 0xb3, 0x3d, 0xcc, 0x04, 0x8f, 0x07, 0x1c, 0xaf, 0xda, 0x3e, 0x25, 0x89, 0xde, 0xbf,
+	0xad, 0xf7, 0x63, 0x16, 0x8a, 0x63, 0x62, 0x16, 0xea, 0x8d, 0x79, 0xb5, 0xe1, 0xca, 0x95, 0xad,
+	0x16, 0x7a, 0xeb, 0x56, 0x48, 0x43, 0xaa, 0xe2, 0xe2, 0x49, 0x47, 0xeb, 0x21, 0xa5, 0x61, 0x04,
+	0xb6, 0x5c, 0x79, 0x83, 0x43, 0x9b, 0x93, 0x18, 0x18, 0xc7, 0x71, 0x5f, 0x25, 0x2c, 0xfe, 0x6a,
+	0xa0, 0xe2, 0xae, 0xb8, 0x86, 0x39, 0x8f, 0x4a, 0xf2, 0x3e, 0x2e, 0x09, 0xaa, 0x46, 0xc3, 0x58,
+	0x2a, 0x38, 0x13, 0x72, 0xdd, 0x0e, 0xcc, 0x4f, 0xd1, 0x24, 0x66, 0x3d, 0x57, 0x2e, 0xab, 0xf9,
+	0x86, 0xb1, 0x54, 0x5e, 0x6b, 0x58, 0x6f, 0xbe, 0xae, 0xb5, 0xce, 0x7a, 0x92, 0xef, 0x41, 0xce,
+	0x29, 0x61, 0xfd, 0x2c, 0x08, 0x3c, 0x12, 0x68, 0x82, 0xb1, 0xeb, 0x09, 0x9a, 0x24, 0xb8, 0x20,
+	0xf0, 0xf4, 0xf3, 0xfd, 0xc2, 0xd7, 0x3f, 0xd4, 0x73, 0xcd, 0x09, 0x54, 0x94, 0x14, 0x8b, 0x7f,
+	0x8d, 0xa1, 0x52, 0x76, 0x90, 0x79, 0x07, 0x4d, 0xc6, 0x38, 0xed, 0x01, 0xcf, 0x2a, 0x9f, 0x76,
+	0x4a, 0x2a, 0xd0, 0x0e, 0xcc, 0x0f, 0xd0, 0x38, 0x83, 0x28, 0xd2, 0x75, 0x4f, 0x36, 0xab, 0x2f,
+	0x9e, 0xae, 0xdc, 0xd2, 0xc2, 0xad, 0x07, 0x41, 0x0a, 0x8c, 0xed, 0xf3, 0x94, 0x24, 0xa1, 0xa3,
+	0xf3, 0xcc, 0x8f, 0xd1, 0x38, 0x66, 0x0c, 0x38, 0xd3, 0x85, 0xce, 0x5b, 0x3a, 0x5d, 0x74, 0xcb,
+	0xd2, 0xdd, 0xb2, 0x36, 0x28, 0x49, 0x9a, 0x85, 0x67, 0x2f, 0xeb, 0x39, 0x47, 0xa7, 0x9b, 0x1f,
+	0xa1, 0x62, 0x3f, 0x25, 0x3e, 0x54, 0x0b, 0x37, 0xc3, 0xa9, 0x6c, 0xf3, 0x00, 0x2d, 0xa8, 0x93,
+	0x5d, 0x06, 0x9c, 0x47, 0x10, 0x43, 0xc2, 0xdd, 0xc3, 0x08, 0x73, 0xf7, 0x10, 0xa0, 0x5a, 0x7c,
+	0x0b, 0x97, 0x73, 0x5b, 0x81, 0xf7, 0x2f, 0xb0, 0x5b, 0x11, 0xe6, 0x5b, 0x00, 0xe6, 0x3d, 0x34,
+	0x8d, 0xa3, 0x88, 0x3e, 0x76, 0xfb, 0x38, 0xe5, 0x04, 0x47, 0xd5, 0xf1, 0x86, 0xb1, 0x54, 0x72,
+	0xa6, 0x64, 0x70, 0x4f, 0xc5, 0xcc, 0x3a, 0x2a, 0xc3, 0x11, 0x87, 0x34, 0xc1, 0x91, 0x50, 0x6f,
+	0x42, 0x68, 0xe4, 0xa0, 0x2c, 0xd4, 0x0e, 0xcc, 0xf7, 0xd1, 0x1c, 0x1c, 0xf5, 0x49, 0x8a, 0x39,
+	0xa1, 0x89, 0xdb, 0x05, 0x12, 0x76, 0x79, 0xb5, 0x24, 0xed, 0x51, 0xb9, 0xdc, 0x78, 0x20, 0xe3,
+	0x66, 0x1b, 0xcd, 0x8e, 0x24, 0x0b, 0xab, 0x55, 0x27, 0x65, 0xfd, 0x0b, 0x96, 0xf2, 0xa1, 0x95,
+	0xf9, 0xd0, 0xea, 0x64, 0x3e, 0x6c, 0x16, 0x9e, 0xbc, 0xaa, 0x1b, 0xce, 0xcc, 0x25, 0x50, 0x6c,
+	0xdd, 0x9f, 0x15, 0x0d, 0xff, 0xf2, 0xfc, 0x64, 0x59, 0xb7, 0x65, 0xf1, 0xe7, 0x02, 0x2a, 0x65,
+	0xd6, 0xb8, 0xbe, 0xe5, 0x16, 0x2a, 0x7a, 0x83, 0xe3, 0x1b, 0x74, 0x5c, 0xa5, 0xfd, 0xe7, 0x0d,
+	0xff, 0xd6, 0x40, 0xef, 0xca, 0x93, 0xff, 0xd1, 0x70, 0x00, 0x56, 0x2d, 0x36, 0xc6, 0xae, 0xe7,
+	0xd9, 0x12, 0x3c, 0x3f, 0xbd, 0xaa, 0x2f, 0x85, 0x84, 0x77, 0x07, 0x9e, 0xe5, 0xd3, 0x58, 0x4f,
+	0x01, 0xfd, 0xb5, 0xc2, 0x82, 0x9e, 0xcd, 0x8f, 0xfb, 0xc0, 0x24, 0x80, 0x7d, 0x77, 0x7e, 0xb2,
+	0x3c, 0x15, 0x41, 0x88, 0xfd, 0x63, 0x57, 0x0c, 0x18, 0xf6, 0xe3, 0xf9, 0xc9, 0xb2, 0xe1, 0xbc,
+	0x23, 0xcf, 0x1f, 0xf1, 0x0c, 0x00, 0xfb, 0x9f, 0x1b, 0x66, 0x26, 0x33, 0x8c, 0xea, 0xea, 0xe2,
+	0x57, 0x79, 0x84, 0xf6, 0x84, 0xde, 0x3b, 0x30, 0x84, 0xc8, 0xdc, 0x43, 0x68, 0x90, 0x10, 0xee,
+	0xaa, 0x86, 0x19, 0xd2, 0x19, 0xab, 0x42, 0xcd, 0x3f, 0x5e, 0xd6, 0xef, 0x28, 0xed, 0x58, 0xd0,
+	0xb3, 0x08, 0xb5, 0x63, 0xcc, 0xbb, 0xd6, 0x8e, 0x94, 0x6c, 0x13, 0xfc, 0x17, 0x4f, 0x57, 0x90,
+	0x6e, 0xc7, 0x26, 0xf8, 0xce, 0xa4, 0x20, 0x91, 0xb4, 0x66, 0x13, 0x4d, 0x71, 0xca, 0x71, 0xe4,
+	0x6a, 0xf3, 0xe4, 0x6f, 0x66, 0x82, 0xb2, 0x04, 0xad, 0x2b, 0x07, 0x7d, 0x86, 0xd4, 0x52, 0x97,
+	0x75, 0x43, 0xff, 0x21, 0x89, 0x51, 0x55, 0xd4, 0x51, 0x59, 0x4d, 0x6d, 0x9f, 0x0e, 0x12, 0x2e,
+	0x9d, 0x38, 0xed, 0x20, 0x19, 0xda, 0x10, 0x91, 0xc5, 0xdf, 0xf2, 0xa8, 0xb2, 0x41, 0x93, 0x80,
+	0x08, 0xa5, 0x70, 0xa4, 0xde, 0x9f, 0x4f, 0xf4, 0x20, 0x95, 0x42, 0x94, 0xd7, 0xee, 0x5e, 0x35,
+	0x8b, 0x65, 0x76, 0xe6, 0x5e, 0x89, 0x30, 0x0f, 0xd0, 0x34, 0x4f, 0x49, 0x18, 0x42, 0xaa, 0x8b,
+	0xce, 0xff, 0x5b, 0x2d, 0xa7, 0x34, 0x8f, 0xba, 0xc8, 0x23, 0x34, 0x97, 0xf1, 0x06, 0x24, 0x05,
+	0x5f, 0x94, 0x2b, 0x05, 0x99, 0x59, 0x5b, 0xba, 0xaa, 0xbc, 0x8e, 0x02, 0x6c, 0x66, 0xf9, 0x4e,
+	0x85, 0xbf, 0x16, 0x31, 0xb7, 0x91, 0xa9, 0xf5, 0x49, 0x41, 0xb9, 0x4c, 0x4c, 0xd5, 0xb7, 0xbd,
+	0xb0, 0x4e, 0x45, 0x29, 0xa8, 0x31, 0x5b, 0x00, 0xea, 0x17, 0x68, 0xf9, 0x17, 0x03, 0x55, 0x5e,
+	0x3f, 0xd5, 0x5c, 0x43, 0x77, 0x3b, 0x4e, 0x7b, 0x7b, 0xbb, 0xe5, 0xb8, 0x9b, 0x6d, 0xa7, 0xb5,
+	0xd1, 0x69, 0xef, 0x3e, 0x74, 0x1f, 0x3d, 0xdc, 0xdf, 0x6b, 0x6d, 0xb4, 0xb7, 0xda, 0xad, 0xcd,
+	0x4a, 0x6e, 0x61, 0xf6, 0x9b, 0xef, 0x1b, 0xe5, 0x41, 0xc2, 0xfa, 0xe0, 0x93, 0x43, 0x02, 0xc1,
+	0x9b, 0x31, 0xeb, 0x1d, 0x77, 0xd7, 0x71, 0x9b, 0xad, 0x9d, 0xdd, 0xcf, 0x2b, 0x86, 0xc2, 0x60,
+	0xee, 0xd2, 0xd4, 0xf5, 0x20, 0xa2, 0x8f, 0xaf, 0xc3, 0xac, 0x37, 0x77, 0x0f, 0x5a, 0x95, 0xfc,
+	0x28, 0x06, 0x7b, 0x74, 0x08, 0x4d, 0x78, 0x76, 0x5a, 0x33, 0x9e, 0x9f, 0xd6, 0x8c, 0x3f, 0x4f,
+	0x6b, 0xc6, 0x93, 0xb3, 0x5a, 0xee, 0xf9, 0x59, 0x2d, 0xf7, 0xfb, 0x59, 0x2d, 0x87, 0xe6, 0x09,
+	0xbd, 0x42, 0xd7, 0x3d, 0xe3, 0x0b, 0x6b, 0x64, 0xc0, 0x5c, 0x26, 0xad, 0x10, 0x3a, 0xb2, 0xb2,
+	0x8f, 0x2e, 0xfe, 0x0c, 0x79, 0xe3, 0xf2, 0x3d, 0xfd, 0xf0, 0xef, 0x01, 0x00, 0xa8, 0x4c, 0xf9,
+	0xad, 0x2a, 0x09, 0x00, 0x00,
 }
 
 func (m *Order) Marshal() (dAtA []byte, err error) {
@@ -382,6 +572,21 @@ func (m *AskOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintOrders(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -466,6 +671,21 @@ func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTime != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintOrders(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.ExternalId) > 0 {
 		i -= len(m.ExternalId)
 		copy(dAtA[i:], m.ExternalId)
@@ -532,6 +752,124 @@ func (m *BidOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderCount != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.OrderCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TotalPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalAssets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.UnitPrice.Size()
+		i -= size
+		if _, err := m.UnitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConditionalOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConditionalOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConditionalOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderCreationFee != nil {
+		{
+			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOrders(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TriggerDirection != 0 {
+		i = encodeVarintOrders(dAtA, i, uint64(m.TriggerDirection))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrders(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOrders(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOrders(v)
 	base := offset
@@ -610,6 +948,13 @@ func (m *AskOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovOrders(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovOrders(uint64(l))
+	}
 	return n
 }
 
@@ -643,6 +988,51 @@ func (m *BidOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOrders(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovOrders(uint64(m.ExpirationHeight))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovOrders(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TotalAssets.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TotalPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.OrderCount != 0 {
+		n += 1 + sovOrders(uint64(m.OrderCount))
+	}
+	return n
+}
+
+func (m *ConditionalOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovOrders(uint64(l))
+	if m.TriggerDirection != 0 {
+		n += 1 + sovOrders(uint64(m.TriggerDirection))
+	}
+	if m.OrderCreationFee != nil {
+		l = m.OrderCreationFee.Size()
+		n += 1 + l + sovOrders(uint64(l))
+	}
 	return n
 }
 
@@ -1025,6 +1415,61 @@ func (m *AskOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -1278,6 +1723,402 @@ func (m *BidOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExternalId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAssets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCount", wireType)
+			}
+			m.OrderCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrders(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConditionalOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrders
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConditionalOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConditionalOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerDirection", wireType)
+			}
+			m.TriggerDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerDirection |= TriggerDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrders
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrders
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrders
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderCreationFee == nil {
+				m.OrderCreationFee = &types.Coin{}
+			}
+			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOrders(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -1026,6 +1027,77 @@ func TestOrder_GetExternalID(t *testing.T) {
 	}
 }
 
+func TestOrder_IsExpiredAt(t *testing.T) {
+	blockTime := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
+	timeP := func(t time.Time) *time.Time {
+		return &t
+	}
+
+	tests := []struct {
+		name     string
+		order    *Order
+		expected bool
+		expPanic string
+	}{
+		{
+			name:     "no expiration",
+			order:    NewOrder(1).WithAsk(&AskOrder{}),
+			expected: false,
+		},
+		{
+			name:     "height not yet reached",
+			order:    NewOrder(1).WithBid(&BidOrder{ExpirationHeight: 101}),
+			expected: false,
+		},
+		{
+			name:     "height reached",
+			order:    NewOrder(1).WithAsk(&AskOrder{ExpirationHeight: 100}),
+			expected: true,
+		},
+		{
+			name:     "height passed",
+			order:    NewOrder(1).WithBid(&BidOrder{ExpirationHeight: 99}),
+			expected: true,
+		},
+		{
+			name:     "time not yet reached",
+			order:    NewOrder(1).WithAsk(&AskOrder{ExpirationTime: timeP(blockTime.Add(time.Nanosecond))}),
+			expected: false,
+		},
+		{
+			name:     "time reached",
+			order:    NewOrder(1).WithBid(&BidOrder{ExpirationTime: timeP(blockTime)}),
+			expected: true,
+		},
+		{
+			name:     "time passed, height not yet reached",
+			order:    NewOrder(1).WithAsk(&AskOrder{ExpirationHeight: 500, ExpirationTime: timeP(blockTime.Add(-time.Hour))}),
+			expected: true,
+		},
+		{
+			name:     "nil inside order",
+			order:    NewOrder(3),
+			expPanic: nilSubTypeErr(3),
+		},
+		{
+			name:     "unknown order type",
+			order:    newUnknownOrder(4),
+			expPanic: unknownSubTypeErr(4),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual bool
+			testFunc := func() {
+				actual = tc.order.IsExpiredAt(100, blockTime)
+			}
+			assertions.RequirePanicEquals(t, testFunc, tc.expPanic, "IsExpiredAt(100, %v)", blockTime)
+			assert.Equal(t, tc.expected, actual, "IsExpiredAt(100, %v) result", blockTime)
+		})
+	}
+}
+
 func TestOrder_GetOrderType(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -1879,6 +1951,7 @@ func TestAskOrder_Validate(t *testing.T) {
 }
 
 func TestAskOrder_CopyChange(t *testing.T) {
+	expTime := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
@@ -1904,6 +1977,8 @@ func TestAskOrder_CopyChange(t *testing.T) {
 				Price:                   coin(55, "peach"),
 				SellerSettlementFlatFee: coinP(12, "fig"),
 				AllowPartial:            true,
+				ExpirationHeight:        77,
+				ExpirationTime:          &expTime,
 			},
 			newAssets: coin(14, "avocado"),
 			newPrice:  coin(55, "peach"),
@@ -1915,6 +1990,8 @@ func TestAskOrder_CopyChange(t *testing.T) {
 				Price:                   coin(55, "peach"),
 				SellerSettlementFlatFee: coinP(12, "fig"),
 				AllowPartial:            true,
+				ExpirationHeight:        77,
+				ExpirationTime:          &expTime,
 			},
 		},
 		{
@@ -2498,6 +2575,7 @@ func TestBidOrder_Validate(t *testing.T) {
 }
 
 func TestBidOrder_CopyChange(t *testing.T) {
+	expTime := time.Date(2024, 3, 4, 5, 6, 7, 0, time.UTC)
 	coin := func(amount int64, denom string) sdk.Coin {
 		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
 	}
@@ -2519,6 +2597,8 @@ func TestBidOrder_CopyChange(t *testing.T) {
 				Price:               coin(55, "peach"),
 				BuyerSettlementFees: sdk.Coins{coin(12, "fig")},
 				AllowPartial:        true,
+				ExpirationHeight:    77,
+				ExpirationTime:      &expTime,
 			},
 			newAssets: coin(14, "avocado"),
 			newPrice:  coin(55, "peach"),
@@ -2530,6 +2610,8 @@ func TestBidOrder_CopyChange(t *testing.T) {
 				Price:               coin(55, "peach"),
 				BuyerSettlementFees: sdk.Coins{coin(12, "fig")},
 				AllowPartial:        true,
+				ExpirationHeight:    77,
+				ExpirationTime:      &expTime,
 			},
 		},
 		{
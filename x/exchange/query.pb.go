@@ -843,26 +843,30 @@ func (m *QueryGetAllOrdersResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// QueryGetCommitmentRequest is a request message for the GetCommitment query.
-type QueryGetCommitmentRequest struct {
-	// account is the bech32 address string of the account in the commitment.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// market_id is the numeric identifier of the market in the commitment.
-	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-}
-
-func (m *QueryGetCommitmentRequest) Reset()         { *m = QueryGetCommitmentRequest{} }
-func (m *QueryGetCommitmentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCommitmentRequest) ProtoMessage()    {}
-func (*QueryGetCommitmentRequest) Descriptor() ([]byte, []int) {
+// QueryGetOrderBookRequest is a request message for the GetOrderBook query.
+type QueryGetOrderBookRequest struct {
+	// market_id is the id of the market to get the order book of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// asset_denom is the denom of the assets of the orders to include.
+	AssetDenom string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the orders to include.
+	PriceDenom string `protobuf:"bytes,3,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// max_levels is an optional maximum number of price levels to return for each side. Zero means 100. At most 1000.
+	MaxLevels uint32 `protobuf:"varint,4,opt,name=max_levels,json=maxLevels,proto3" json:"max_levels,omitempty"`
+}
+
+func (m *QueryGetOrderBookRequest) Reset()         { *m = QueryGetOrderBookRequest{} }
+func (m *QueryGetOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderBookRequest) ProtoMessage()    {}
+func (*QueryGetOrderBookRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{14}
 }
-func (m *QueryGetCommitmentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetOrderBookRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCommitmentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOrderBookRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -872,50 +876,70 @@ func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCommitmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCommitmentRequest.Merge(m, src)
+func (m *QueryGetOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderBookRequest.Merge(m, src)
 }
-func (m *QueryGetCommitmentRequest) XXX_Size() int {
+func (m *QueryGetOrderBookRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCommitmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCommitmentRequest.DiscardUnknown(m)
+func (m *QueryGetOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderBookRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCommitmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetOrderBookRequest proto.InternalMessageInfo
 
-func (m *QueryGetCommitmentRequest) GetAccount() string {
+func (m *QueryGetOrderBookRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Account
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *QueryGetOrderBookRequest) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
 	}
 	return ""
 }
 
-func (m *QueryGetCommitmentRequest) GetMarketId() uint32 {
+func (m *QueryGetOrderBookRequest) GetPriceDenom() string {
 	if m != nil {
-		return m.MarketId
+		return m.PriceDenom
+	}
+	return ""
+}
+
+func (m *QueryGetOrderBookRequest) GetMaxLevels() uint32 {
+	if m != nil {
+		return m.MaxLevels
 	}
 	return 0
 }
 
-// QueryGetCommitmentResponse is a response message for the GetCommitment query.
-type QueryGetCommitmentResponse struct {
-	// amount is the total funds committed to the market by the account.
-	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+// QueryGetOrderBookResponse is a response message for the GetOrderBook query.
+type QueryGetOrderBookResponse struct {
+	// asks are the price levels of the ask orders, ordered from lowest unit price to highest.
+	Asks []PriceLevel `protobuf:"bytes,1,rep,name=asks,proto3" json:"asks"`
+	// bids are the price levels of the bid orders, ordered from highest unit price to lowest.
+	Bids []PriceLevel `protobuf:"bytes,2,rep,name=bids,proto3" json:"bids"`
+	// best_ask is the price level of the ask orders with the lowest unit price. It is nil if there are no ask orders.
+	BestAsk *PriceLevel `protobuf:"bytes,3,opt,name=best_ask,json=bestAsk,proto3" json:"best_ask,omitempty"`
+	// best_bid is the price level of the bid orders with the highest unit price. It is nil if there are no bid orders.
+	BestBid *PriceLevel `protobuf:"bytes,4,opt,name=best_bid,json=bestBid,proto3" json:"best_bid,omitempty"`
 }
 
-func (m *QueryGetCommitmentResponse) Reset()         { *m = QueryGetCommitmentResponse{} }
-func (m *QueryGetCommitmentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCommitmentResponse) ProtoMessage()    {}
-func (*QueryGetCommitmentResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetOrderBookResponse) Reset()         { *m = QueryGetOrderBookResponse{} }
+func (m *QueryGetOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetOrderBookResponse) ProtoMessage()    {}
+func (*QueryGetOrderBookResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{15}
 }
-func (m *QueryGetCommitmentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetOrderBookResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCommitmentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetOrderBookResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -925,43 +949,66 @@ func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCommitmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCommitmentResponse.Merge(m, src)
+func (m *QueryGetOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetOrderBookResponse.Merge(m, src)
 }
-func (m *QueryGetCommitmentResponse) XXX_Size() int {
+func (m *QueryGetOrderBookResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCommitmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCommitmentResponse.DiscardUnknown(m)
+func (m *QueryGetOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetOrderBookResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCommitmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetOrderBookResponse proto.InternalMessageInfo
 
-func (m *QueryGetCommitmentResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryGetOrderBookResponse) GetAsks() []PriceLevel {
 	if m != nil {
-		return m.Amount
+		return m.Asks
 	}
 	return nil
 }
 
-// QueryGetAccountCommitmentsRequest is a request message for the GetAccountCommitments query.
-type QueryGetAccountCommitmentsRequest struct {
-	// account is the bech32 address string of the account with the commitments.
-	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+func (m *QueryGetOrderBookResponse) GetBids() []PriceLevel {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Reset()         { *m = QueryGetAccountCommitmentsRequest{} }
-func (m *QueryGetAccountCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAccountCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetAccountCommitmentsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetOrderBookResponse) GetBestAsk() *PriceLevel {
+	if m != nil {
+		return m.BestAsk
+	}
+	return nil
+}
+
+func (m *QueryGetOrderBookResponse) GetBestBid() *PriceLevel {
+	if m != nil {
+		return m.BestBid
+	}
+	return nil
+}
+
+// QueryGetMarketTradesRequest is a request message for the GetMarketTrades query.
+type QueryGetMarketTradesRequest struct {
+	// market_id is the id of the market to get the trades of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetMarketTradesRequest) Reset()         { *m = QueryGetMarketTradesRequest{} }
+func (m *QueryGetMarketTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketTradesRequest) ProtoMessage()    {}
+func (*QueryGetMarketTradesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{16}
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketTradesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAccountCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketTradesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -971,43 +1018,52 @@ func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAccountCommitmentsRequest.Merge(m, src)
+func (m *QueryGetMarketTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketTradesRequest.Merge(m, src)
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetMarketTradesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAccountCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAccountCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetMarketTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketTradesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAccountCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketTradesRequest proto.InternalMessageInfo
 
-func (m *QueryGetAccountCommitmentsRequest) GetAccount() string {
+func (m *QueryGetMarketTradesRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Account
+		return m.MarketId
 	}
-	return ""
+	return 0
 }
 
-// QueryGetAccountCommitmentsResponse is a response message for the GetAccountCommitments query.
-type QueryGetAccountCommitmentsResponse struct {
-	// commitments is the amounts committed from the account to the any market.
-	Commitments []*MarketAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+func (m *QueryGetMarketTradesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Reset()         { *m = QueryGetAccountCommitmentsResponse{} }
-func (m *QueryGetAccountCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAccountCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetAccountCommitmentsResponse) Descriptor() ([]byte, []int) {
+// QueryGetMarketTradesResponse is a response message for the GetMarketTrades query.
+type QueryGetMarketTradesResponse struct {
+	// trades are a page of the trades recorded in the market.
+	Trades []*Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetMarketTradesResponse) Reset()         { *m = QueryGetMarketTradesResponse{} }
+func (m *QueryGetMarketTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketTradesResponse) ProtoMessage()    {}
+func (*QueryGetMarketTradesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{17}
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketTradesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAccountCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketTradesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1017,45 +1073,58 @@ func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAccountCommitmentsResponse.Merge(m, src)
+func (m *QueryGetMarketTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketTradesResponse.Merge(m, src)
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetMarketTradesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAccountCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAccountCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetMarketTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketTradesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAccountCommitmentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketTradesResponse proto.InternalMessageInfo
 
-func (m *QueryGetAccountCommitmentsResponse) GetCommitments() []*MarketAmount {
+func (m *QueryGetMarketTradesResponse) GetTrades() []*Trade {
 	if m != nil {
-		return m.Commitments
+		return m.Trades
 	}
 	return nil
 }
 
-// QueryGetMarketCommitmentsRequest is a request message for the GetMarketCommitments query.
-type QueryGetMarketCommitmentsRequest struct {
-	// market_id is the numeric identifier of the market with the commitment.
-	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetMarketTradesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Reset()         { *m = QueryGetMarketCommitmentsRequest{} }
-func (m *QueryGetMarketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetMarketCommitmentsRequest) Descriptor() ([]byte, []int) {
+// QueryGetMarketCandlesRequest is a request message for the GetMarketCandles query.
+type QueryGetMarketCandlesRequest struct {
+	// market_id is the id of the market to get the candles of.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// asset_denom is the denom of the assets of the trades to include.
+	AssetDenom string `protobuf:"bytes,2,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// price_denom is the denom of the price of the trades to include.
+	PriceDenom string `protobuf:"bytes,3,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	// interval is the length of time that each candle covers.
+	Interval CandleInterval `protobuf:"varint,4,opt,name=interval,proto3,enum=provenance.exchange.v1.CandleInterval" json:"interval,omitempty"`
+	// max_candles is an optional maximum number of (most recent) candles to return. Zero means no limit.
+	MaxCandles uint32 `protobuf:"varint,5,opt,name=max_candles,json=maxCandles,proto3" json:"max_candles,omitempty"`
+}
+
+func (m *QueryGetMarketCandlesRequest) Reset()         { *m = QueryGetMarketCandlesRequest{} }
+func (m *QueryGetMarketCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCandlesRequest) ProtoMessage()    {}
+func (*QueryGetMarketCandlesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{18}
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketCandlesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketCandlesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1065,52 +1134,72 @@ func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketCommitmentsRequest.Merge(m, src)
+func (m *QueryGetMarketCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCandlesRequest.Merge(m, src)
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetMarketCandlesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetMarketCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCandlesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketCandlesRequest proto.InternalMessageInfo
 
-func (m *QueryGetMarketCommitmentsRequest) GetMarketId() uint32 {
+func (m *QueryGetMarketCandlesRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-func (m *QueryGetMarketCommitmentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetMarketCandlesRequest) GetAssetDenom() string {
 	if m != nil {
-		return m.Pagination
+		return m.AssetDenom
 	}
-	return nil
+	return ""
 }
 
-// QueryGetMarketCommitmentsResponse is a response message for the GetMarketCommitments query.
-type QueryGetMarketCommitmentsResponse struct {
-	// commitments is the amounts committed to the market from any account.
-	Commitments []*AccountAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetMarketCandlesRequest) GetPriceDenom() string {
+	if m != nil {
+		return m.PriceDenom
+	}
+	return ""
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Reset()         { *m = QueryGetMarketCommitmentsResponse{} }
-func (m *QueryGetMarketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetMarketCommitmentsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketCandlesRequest) GetInterval() CandleInterval {
+	if m != nil {
+		return m.Interval
+	}
+	return CandleInterval_unspecified
+}
+
+func (m *QueryGetMarketCandlesRequest) GetMaxCandles() uint32 {
+	if m != nil {
+		return m.MaxCandles
+	}
+	return 0
+}
+
+// QueryGetMarketCandlesResponse is a response message for the GetMarketCandles query.
+type QueryGetMarketCandlesResponse struct {
+	// candles are the candles of the requested trades, ordered from oldest to newest.
+	// Intervals without any trades are not included.
+	Candles []Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+}
+
+func (m *QueryGetMarketCandlesResponse) Reset()         { *m = QueryGetMarketCandlesResponse{} }
+func (m *QueryGetMarketCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCandlesResponse) ProtoMessage()    {}
+func (*QueryGetMarketCandlesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{19}
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketCandlesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketCandlesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1120,50 +1209,43 @@ func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketCommitmentsResponse.Merge(m, src)
+func (m *QueryGetMarketCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCandlesResponse.Merge(m, src)
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetMarketCandlesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetMarketCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCandlesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketCommitmentsResponse proto.InternalMessageInfo
-
-func (m *QueryGetMarketCommitmentsResponse) GetCommitments() []*AccountAmount {
-	if m != nil {
-		return m.Commitments
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetMarketCandlesResponse proto.InternalMessageInfo
 
-func (m *QueryGetMarketCommitmentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetMarketCandlesResponse) GetCandles() []Candle {
 	if m != nil {
-		return m.Pagination
+		return m.Candles
 	}
 	return nil
 }
 
-// QueryGetAllCommitmentsRequest is a request message for the GetAllCommitments query.
-type QueryGetAllCommitmentsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetConditionalOrderRequest is a request message for the GetConditionalOrder query.
+type QueryGetConditionalOrderRequest struct {
+	// order_id is the id of the conditional order to look up.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (m *QueryGetAllCommitmentsRequest) Reset()         { *m = QueryGetAllCommitmentsRequest{} }
-func (m *QueryGetAllCommitmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllCommitmentsRequest) ProtoMessage()    {}
-func (*QueryGetAllCommitmentsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetConditionalOrderRequest) Reset()         { *m = QueryGetConditionalOrderRequest{} }
+func (m *QueryGetConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetConditionalOrderRequest) ProtoMessage()    {}
+func (*QueryGetConditionalOrderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{20}
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllCommitmentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetConditionalOrderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1173,45 +1255,43 @@ func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllCommitmentsRequest.Merge(m, src)
+func (m *QueryGetConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetConditionalOrderRequest.Merge(m, src)
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_Size() int {
+func (m *QueryGetConditionalOrderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllCommitmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllCommitmentsRequest.DiscardUnknown(m)
+func (m *QueryGetConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetConditionalOrderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllCommitmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetConditionalOrderRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllCommitmentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetConditionalOrderRequest) GetOrderId() uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.OrderId
 	}
-	return nil
+	return 0
 }
 
-// QueryGetAllCommitmentsResponse is a response message for the GetAllCommitments query.
-type QueryGetAllCommitmentsResponse struct {
-	// commitments is the requested commitment information.
-	Commitments []*Commitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetConditionalOrderResponse is a response message for the GetConditionalOrder query.
+type QueryGetConditionalOrderResponse struct {
+	// conditional_order is the requested conditional order.
+	ConditionalOrder *ConditionalOrder `protobuf:"bytes,1,opt,name=conditional_order,json=conditionalOrder,proto3" json:"conditional_order,omitempty"`
 }
 
-func (m *QueryGetAllCommitmentsResponse) Reset()         { *m = QueryGetAllCommitmentsResponse{} }
-func (m *QueryGetAllCommitmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllCommitmentsResponse) ProtoMessage()    {}
-func (*QueryGetAllCommitmentsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetConditionalOrderResponse) Reset()         { *m = QueryGetConditionalOrderResponse{} }
+func (m *QueryGetConditionalOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetConditionalOrderResponse) ProtoMessage()    {}
+func (*QueryGetConditionalOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{21}
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetConditionalOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetConditionalOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllCommitmentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetConditionalOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1221,50 +1301,47 @@ func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllCommitmentsResponse.Merge(m, src)
+func (m *QueryGetConditionalOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetConditionalOrderResponse.Merge(m, src)
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_Size() int {
+func (m *QueryGetConditionalOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllCommitmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllCommitmentsResponse.DiscardUnknown(m)
+func (m *QueryGetConditionalOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetConditionalOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllCommitmentsResponse proto.InternalMessageInfo
-
-func (m *QueryGetAllCommitmentsResponse) GetCommitments() []*Commitment {
-	if m != nil {
-		return m.Commitments
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetConditionalOrderResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllCommitmentsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetConditionalOrderResponse) GetConditionalOrder() *ConditionalOrder {
 	if m != nil {
-		return m.Pagination
+		return m.ConditionalOrder
 	}
 	return nil
 }
 
-// QueryGetMarketRequest is a request message for the GetMarket query.
-type QueryGetMarketRequest struct {
-	// market_id is the id of the market to look up.
+// QueryGetMarketConditionalOrdersRequest is a request message for the GetMarketConditionalOrders query.
+type QueryGetMarketConditionalOrdersRequest struct {
+	// market_id is the id of the market to get all the conditional orders for.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetMarketRequest) Reset()         { *m = QueryGetMarketRequest{} }
-func (m *QueryGetMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketRequest) ProtoMessage()    {}
-func (*QueryGetMarketRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketConditionalOrdersRequest) Reset() {
+	*m = QueryGetMarketConditionalOrdersRequest{}
+}
+func (m *QueryGetMarketConditionalOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketConditionalOrdersRequest) ProtoMessage()    {}
+func (*QueryGetMarketConditionalOrdersRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{22}
 }
-func (m *QueryGetMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketConditionalOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketConditionalOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketConditionalOrdersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1274,45 +1351,54 @@ func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketRequest.Merge(m, src)
+func (m *QueryGetMarketConditionalOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketConditionalOrdersRequest.Merge(m, src)
 }
-func (m *QueryGetMarketRequest) XXX_Size() int {
+func (m *QueryGetMarketConditionalOrdersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketRequest.DiscardUnknown(m)
+func (m *QueryGetMarketConditionalOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketConditionalOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketConditionalOrdersRequest proto.InternalMessageInfo
 
-func (m *QueryGetMarketRequest) GetMarketId() uint32 {
+func (m *QueryGetMarketConditionalOrdersRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// QueryGetMarketResponse is a response message for the GetMarket query.
-type QueryGetMarketResponse struct {
-	// address is the bech32 address string of this market's account.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	// market is all information and details of the market.
-	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
+func (m *QueryGetMarketConditionalOrdersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetMarketResponse) Reset()         { *m = QueryGetMarketResponse{} }
-func (m *QueryGetMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetMarketResponse) ProtoMessage()    {}
-func (*QueryGetMarketResponse) Descriptor() ([]byte, []int) {
+// QueryGetMarketConditionalOrdersResponse is a response message for the GetMarketConditionalOrders query.
+type QueryGetMarketConditionalOrdersResponse struct {
+	// conditional_orders are a page of the conditional orders in the provided market.
+	ConditionalOrders []*ConditionalOrder `protobuf:"bytes,1,rep,name=conditional_orders,json=conditionalOrders,proto3" json:"conditional_orders,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetMarketConditionalOrdersResponse) Reset() {
+	*m = QueryGetMarketConditionalOrdersResponse{}
+}
+func (m *QueryGetMarketConditionalOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketConditionalOrdersResponse) ProtoMessage()    {}
+func (*QueryGetMarketConditionalOrdersResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{23}
 }
-func (m *QueryGetMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketConditionalOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketConditionalOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketConditionalOrdersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1322,50 +1408,52 @@ func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetMarketResponse.Merge(m, src)
+func (m *QueryGetMarketConditionalOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketConditionalOrdersResponse.Merge(m, src)
 }
-func (m *QueryGetMarketResponse) XXX_Size() int {
+func (m *QueryGetMarketConditionalOrdersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetMarketResponse.DiscardUnknown(m)
+func (m *QueryGetMarketConditionalOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketConditionalOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketConditionalOrdersResponse proto.InternalMessageInfo
 
-func (m *QueryGetMarketResponse) GetAddress() string {
+func (m *QueryGetMarketConditionalOrdersResponse) GetConditionalOrders() []*ConditionalOrder {
 	if m != nil {
-		return m.Address
+		return m.ConditionalOrders
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryGetMarketResponse) GetMarket() *Market {
+func (m *QueryGetMarketConditionalOrdersResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Market
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryGetAllMarketsRequest is a request message for the GetAllMarkets query.
-type QueryGetAllMarketsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryGetCommitmentRequest is a request message for the GetCommitment query.
+type QueryGetCommitmentRequest struct {
+	// account is the bech32 address string of the account in the commitment.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// market_id is the numeric identifier of the market in the commitment.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *QueryGetAllMarketsRequest) Reset()         { *m = QueryGetAllMarketsRequest{} }
-func (m *QueryGetAllMarketsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllMarketsRequest) ProtoMessage()    {}
-func (*QueryGetAllMarketsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetCommitmentRequest) Reset()         { *m = QueryGetCommitmentRequest{} }
+func (m *QueryGetCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentRequest) ProtoMessage()    {}
+func (*QueryGetCommitmentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{24}
 }
-func (m *QueryGetAllMarketsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllMarketsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCommitmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1375,45 +1463,50 @@ func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllMarketsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllMarketsRequest.Merge(m, src)
+func (m *QueryGetCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentRequest.Merge(m, src)
 }
-func (m *QueryGetAllMarketsRequest) XXX_Size() int {
+func (m *QueryGetCommitmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllMarketsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllMarketsRequest.DiscardUnknown(m)
+func (m *QueryGetCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllMarketsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetCommitmentRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllMarketsRequest) GetPagination() *query.PageRequest {
+func (m *QueryGetCommitmentRequest) GetAccount() string {
 	if m != nil {
-		return m.Pagination
+		return m.Account
 	}
-	return nil
+	return ""
 }
 
-// QueryGetAllMarketsResponse is a response message for the GetAllMarkets query.
-type QueryGetAllMarketsResponse struct {
-	// markets are a page of the briefs for all markets.
-	Markets []*MarketBrief `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryGetCommitmentRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-func (m *QueryGetAllMarketsResponse) Reset()         { *m = QueryGetAllMarketsResponse{} }
-func (m *QueryGetAllMarketsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllMarketsResponse) ProtoMessage()    {}
-func (*QueryGetAllMarketsResponse) Descriptor() ([]byte, []int) {
+// QueryGetCommitmentResponse is a response message for the GetCommitment query.
+type QueryGetCommitmentResponse struct {
+	// amount is the total funds committed to the market by the account.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *QueryGetCommitmentResponse) Reset()         { *m = QueryGetCommitmentResponse{} }
+func (m *QueryGetCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCommitmentResponse) ProtoMessage()    {}
+func (*QueryGetCommitmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{25}
 }
-func (m *QueryGetAllMarketsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllMarketsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetCommitmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1423,48 +1516,43 @@ func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllMarketsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllMarketsResponse.Merge(m, src)
+func (m *QueryGetCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCommitmentResponse.Merge(m, src)
 }
-func (m *QueryGetAllMarketsResponse) XXX_Size() int {
+func (m *QueryGetCommitmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllMarketsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllMarketsResponse.DiscardUnknown(m)
+func (m *QueryGetCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCommitmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllMarketsResponse proto.InternalMessageInfo
-
-func (m *QueryGetAllMarketsResponse) GetMarkets() []*MarketBrief {
-	if m != nil {
-		return m.Markets
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetCommitmentResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllMarketsResponse) GetPagination() *query.PageResponse {
+func (m *QueryGetCommitmentResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Pagination
+		return m.Amount
 	}
 	return nil
 }
 
-// QueryParamsRequest is a request message for the Params query.
-type QueryParamsRequest struct {
+// QueryGetAccountCommitmentsRequest is a request message for the GetAccountCommitments query.
+type QueryGetAccountCommitmentsRequest struct {
+	// account is the bech32 address string of the account with the commitments.
+	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetAccountCommitmentsRequest) Reset()         { *m = QueryGetAccountCommitmentsRequest{} }
+func (m *QueryGetAccountCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{26}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAccountCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAccountCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAccountCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1474,36 +1562,43 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryGetAccountCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryGetAccountCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryGetAccountCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAccountCommitmentsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse is a response message for the Params query.
-type QueryParamsResponse struct {
-	// params are the exchange module parameter values.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryGetAccountCommitmentsRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+// QueryGetAccountCommitmentsResponse is a response message for the GetAccountCommitments query.
+type QueryGetAccountCommitmentsResponse struct {
+	// commitments is the amounts committed from the account to the any market.
+	Commitments []*MarketAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+}
+
+func (m *QueryGetAccountCommitmentsResponse) Reset()         { *m = QueryGetAccountCommitmentsResponse{} }
+func (m *QueryGetAccountCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAccountCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetAccountCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{27}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAccountCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAccountCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAccountCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1513,51 +1608,45 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryGetAccountCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAccountCommitmentsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryGetAccountCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryGetAccountCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAccountCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAccountCommitmentsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() *Params {
+func (m *QueryGetAccountCommitmentsResponse) GetCommitments() []*MarketAmount {
 	if m != nil {
-		return m.Params
+		return m.Commitments
 	}
 	return nil
 }
 
-// QueryCommitmentSettlementFeeCalcRequest is a request message for the CommitmentSettlementFeeCalc query.
-type QueryCommitmentSettlementFeeCalcRequest struct {
-	// settlement is a market's commitment settlement request message.
-	// If no inputs are provided, only the to_fee_nav field will be populated in the response.
-	Settlement *MsgMarketCommitmentSettleRequest `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
-	// include_breakdown_fields controls the fields that are populated in the response.
-	// If false, only the exchange_fees field is populated.
-	// If true, all of the fields are populated as possible.
-	// If the settlement does not have any inputs, this field defaults to true.
-	IncludeBreakdownFields bool `protobuf:"varint,2,opt,name=include_breakdown_fields,json=includeBreakdownFields,proto3" json:"include_breakdown_fields,omitempty"`
+// QueryGetMarketCommitmentsRequest is a request message for the GetMarketCommitments query.
+type QueryGetMarketCommitmentsRequest struct {
+	// market_id is the numeric identifier of the market with the commitment.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Reset() {
-	*m = QueryCommitmentSettlementFeeCalcRequest{}
-}
-func (m *QueryCommitmentSettlementFeeCalcRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentSettlementFeeCalcRequest) ProtoMessage()    {}
-func (*QueryCommitmentSettlementFeeCalcRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketCommitmentsRequest) Reset()         { *m = QueryGetMarketCommitmentsRequest{} }
+func (m *QueryGetMarketCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetMarketCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{28}
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1567,60 +1656,52 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, determin
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Merge(m, src)
+func (m *QueryGetMarketCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCommitmentsRequest.Merge(m, src)
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Size() int {
+func (m *QueryGetMarketCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.DiscardUnknown(m)
+func (m *QueryGetMarketCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketCommitmentsRequest proto.InternalMessageInfo
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) GetSettlement() *MsgMarketCommitmentSettleRequest {
+func (m *QueryGetMarketCommitmentsRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Settlement
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) GetIncludeBreakdownFields() bool {
+func (m *QueryGetMarketCommitmentsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.IncludeBreakdownFields
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// QueryCommitmentSettlementFeeCalcResponse is a response message for the CommitmentSettlementFeeCalc query.
-type QueryCommitmentSettlementFeeCalcResponse struct {
-	// exchange_fees is the total that the exchange would currently pay for the provided settlement.
-	ExchangeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=exchange_fees,json=exchangeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exchange_fees"`
-	// input_total is the sum of all the inputs in the provided settlement.
-	InputTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=input_total,json=inputTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"input_total"`
-	// converted_total is the input_total converted to a single intermediary denom or left as the fee denom.
-	ConvertedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=converted_total,json=convertedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_total"`
-	// conversion_navs are the NAVs used to convert the input_total to the converted_total.
-	ConversionNavs []NetAssetPrice `protobuf:"bytes,4,rep,name=conversion_navs,json=conversionNavs,proto3" json:"conversion_navs"`
-	// to_fee_nav is the NAV used to convert the converted_total into the fee denom.
-	ToFeeNav *NetAssetPrice `protobuf:"bytes,5,opt,name=to_fee_nav,json=toFeeNav,proto3" json:"to_fee_nav,omitempty"`
+// QueryGetMarketCommitmentsResponse is a response message for the GetMarketCommitments query.
+type QueryGetMarketCommitmentsResponse struct {
+	// commitments is the amounts committed to the market from any account.
+	Commitments []*AccountAmount `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
-	*m = QueryCommitmentSettlementFeeCalcResponse{}
-}
-func (m *QueryCommitmentSettlementFeeCalcResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryCommitmentSettlementFeeCalcResponse) ProtoMessage()    {}
-func (*QueryCommitmentSettlementFeeCalcResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketCommitmentsResponse) Reset()         { *m = QueryGetMarketCommitmentsResponse{} }
+func (m *QueryGetMarketCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetMarketCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{29}
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1630,71 +1711,50 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Merge(m, src)
+func (m *QueryGetMarketCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketCommitmentsResponse.Merge(m, src)
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Size() int {
+func (m *QueryGetMarketCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse proto.InternalMessageInfo
-
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetExchangeFees() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.ExchangeFees
-	}
-	return nil
-}
-
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetInputTotal() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.InputTotal
-	}
-	return nil
+func (m *QueryGetMarketCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketCommitmentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetConvertedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.ConvertedTotal
-	}
-	return nil
-}
+var xxx_messageInfo_QueryGetMarketCommitmentsResponse proto.InternalMessageInfo
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetConversionNavs() []NetAssetPrice {
+func (m *QueryGetMarketCommitmentsResponse) GetCommitments() []*AccountAmount {
 	if m != nil {
-		return m.ConversionNavs
+		return m.Commitments
 	}
 	return nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) GetToFeeNav() *NetAssetPrice {
+func (m *QueryGetMarketCommitmentsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.ToFeeNav
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryValidateCreateMarketRequest is a request message for the ValidateCreateMarket query.
-type QueryValidateCreateMarketRequest struct {
-	// create_market_request is the request to run validation on.
-	CreateMarketRequest *MsgGovCreateMarketRequest `protobuf:"bytes,1,opt,name=create_market_request,json=createMarketRequest,proto3" json:"create_market_request,omitempty"`
+// QueryGetAllCommitmentsRequest is a request message for the GetAllCommitments query.
+type QueryGetAllCommitmentsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateCreateMarketRequest) Reset()         { *m = QueryValidateCreateMarketRequest{} }
-func (m *QueryValidateCreateMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateCreateMarketRequest) ProtoMessage()    {}
-func (*QueryValidateCreateMarketRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetAllCommitmentsRequest) Reset()         { *m = QueryGetAllCommitmentsRequest{} }
+func (m *QueryGetAllCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllCommitmentsRequest) ProtoMessage()    {}
+func (*QueryGetAllCommitmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{30}
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateCreateMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllCommitmentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1704,49 +1764,45 @@ func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateCreateMarketRequest.Merge(m, src)
+func (m *QueryGetAllCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllCommitmentsRequest.Merge(m, src)
 }
-func (m *QueryValidateCreateMarketRequest) XXX_Size() int {
+func (m *QueryGetAllCommitmentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateCreateMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateCreateMarketRequest.DiscardUnknown(m)
+func (m *QueryGetAllCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllCommitmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateCreateMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllCommitmentsRequest proto.InternalMessageInfo
 
-func (m *QueryValidateCreateMarketRequest) GetCreateMarketRequest() *MsgGovCreateMarketRequest {
+func (m *QueryGetAllCommitmentsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.CreateMarketRequest
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryValidateCreateMarketResponse is a response message for the ValidateCreateMarket query.
-type QueryValidateCreateMarketResponse struct {
-	// error is any problems or inconsistencies in the provided gov prop msg.
-	// This goes above and beyond the validation done when actually processing the governance proposal.
-	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
-	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
-	// period (assuming it passes).
-	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
+// QueryGetAllCommitmentsResponse is a response message for the GetAllCommitments query.
+type QueryGetAllCommitmentsResponse struct {
+	// commitments is the requested commitment information.
+	Commitments []*Commitment `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateCreateMarketResponse) Reset()         { *m = QueryValidateCreateMarketResponse{} }
-func (m *QueryValidateCreateMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateCreateMarketResponse) ProtoMessage()    {}
-func (*QueryValidateCreateMarketResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetAllCommitmentsResponse) Reset()         { *m = QueryGetAllCommitmentsResponse{} }
+func (m *QueryGetAllCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllCommitmentsResponse) ProtoMessage()    {}
+func (*QueryGetAllCommitmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{31}
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateCreateMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllCommitmentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1756,50 +1812,50 @@ func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateCreateMarketResponse.Merge(m, src)
+func (m *QueryGetAllCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllCommitmentsResponse.Merge(m, src)
 }
-func (m *QueryValidateCreateMarketResponse) XXX_Size() int {
+func (m *QueryGetAllCommitmentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateCreateMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateCreateMarketResponse.DiscardUnknown(m)
+func (m *QueryGetAllCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllCommitmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateCreateMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllCommitmentsResponse proto.InternalMessageInfo
 
-func (m *QueryValidateCreateMarketResponse) GetError() string {
+func (m *QueryGetAllCommitmentsResponse) GetCommitments() []*Commitment {
 	if m != nil {
-		return m.Error
+		return m.Commitments
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryValidateCreateMarketResponse) GetGovPropWillPass() bool {
+func (m *QueryGetAllCommitmentsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.GovPropWillPass
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// QueryValidateMarketRequest is a request message for the ValidateMarket query.
-type QueryValidateMarketRequest struct {
-	// market_id is the id of the market to check.
+// QueryGetMarketRequest is a request message for the GetMarket query.
+type QueryGetMarketRequest struct {
+	// market_id is the id of the market to look up.
 	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *QueryValidateMarketRequest) Reset()         { *m = QueryValidateMarketRequest{} }
-func (m *QueryValidateMarketRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateMarketRequest) ProtoMessage()    {}
-func (*QueryValidateMarketRequest) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketRequest) Reset()         { *m = QueryGetMarketRequest{} }
+func (m *QueryGetMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketRequest) ProtoMessage()    {}
+func (*QueryGetMarketRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{32}
 }
-func (m *QueryValidateMarketRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateMarketRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1809,43 +1865,45 @@ func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateMarketRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateMarketRequest.Merge(m, src)
+func (m *QueryGetMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketRequest.Merge(m, src)
 }
-func (m *QueryValidateMarketRequest) XXX_Size() int {
+func (m *QueryGetMarketRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateMarketRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateMarketRequest.DiscardUnknown(m)
+func (m *QueryGetMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateMarketRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketRequest proto.InternalMessageInfo
 
-func (m *QueryValidateMarketRequest) GetMarketId() uint32 {
+func (m *QueryGetMarketRequest) GetMarketId() uint32 {
 	if m != nil {
 		return m.MarketId
 	}
 	return 0
 }
 
-// QueryValidateMarketResponse is a response message for the ValidateMarket query.
-type QueryValidateMarketResponse struct {
-	// error is any problems or inconsistencies in the provided market.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+// QueryGetMarketResponse is a response message for the GetMarket query.
+type QueryGetMarketResponse struct {
+	// address is the bech32 address string of this market's account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// market is all information and details of the market.
+	Market *Market `protobuf:"bytes,2,opt,name=market,proto3" json:"market,omitempty"`
 }
 
-func (m *QueryValidateMarketResponse) Reset()         { *m = QueryValidateMarketResponse{} }
-func (m *QueryValidateMarketResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateMarketResponse) ProtoMessage()    {}
-func (*QueryValidateMarketResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetMarketResponse) Reset()         { *m = QueryGetMarketResponse{} }
+func (m *QueryGetMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetMarketResponse) ProtoMessage()    {}
+func (*QueryGetMarketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{33}
 }
-func (m *QueryValidateMarketResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateMarketResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1855,43 +1913,50 @@ func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateMarketResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateMarketResponse.Merge(m, src)
+func (m *QueryGetMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetMarketResponse.Merge(m, src)
 }
-func (m *QueryValidateMarketResponse) XXX_Size() int {
+func (m *QueryGetMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateMarketResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateMarketResponse.DiscardUnknown(m)
+func (m *QueryGetMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateMarketResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetMarketResponse proto.InternalMessageInfo
 
-func (m *QueryValidateMarketResponse) GetError() string {
+func (m *QueryGetMarketResponse) GetAddress() string {
 	if m != nil {
-		return m.Error
+		return m.Address
 	}
 	return ""
 }
 
-// QueryValidateManageFeesRequest is a request message for the ValidateManageFees query.
-type QueryValidateManageFeesRequest struct {
-	// manage_fees_request is the request to run validation on.
-	ManageFeesRequest *MsgGovManageFeesRequest `protobuf:"bytes,1,opt,name=manage_fees_request,json=manageFeesRequest,proto3" json:"manage_fees_request,omitempty"`
+func (m *QueryGetMarketResponse) GetMarket() *Market {
+	if m != nil {
+		return m.Market
+	}
+	return nil
 }
 
-func (m *QueryValidateManageFeesRequest) Reset()         { *m = QueryValidateManageFeesRequest{} }
-func (m *QueryValidateManageFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateManageFeesRequest) ProtoMessage()    {}
-func (*QueryValidateManageFeesRequest) Descriptor() ([]byte, []int) {
+// QueryGetAllMarketsRequest is a request message for the GetAllMarkets query.
+type QueryGetAllMarketsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGetAllMarketsRequest) Reset()         { *m = QueryGetAllMarketsRequest{} }
+func (m *QueryGetAllMarketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllMarketsRequest) ProtoMessage()    {}
+func (*QueryGetAllMarketsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{34}
 }
-func (m *QueryValidateManageFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllMarketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllMarketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateManageFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllMarketsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1901,49 +1966,45 @@ func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateManageFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateManageFeesRequest.Merge(m, src)
+func (m *QueryGetAllMarketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllMarketsRequest.Merge(m, src)
 }
-func (m *QueryValidateManageFeesRequest) XXX_Size() int {
+func (m *QueryGetAllMarketsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateManageFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateManageFeesRequest.DiscardUnknown(m)
+func (m *QueryGetAllMarketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllMarketsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateManageFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllMarketsRequest proto.InternalMessageInfo
 
-func (m *QueryValidateManageFeesRequest) GetManageFeesRequest() *MsgGovManageFeesRequest {
+func (m *QueryGetAllMarketsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.ManageFeesRequest
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryValidateManageFeesResponse is a response message for the ValidateManageFees query.
-type QueryValidateManageFeesResponse struct {
-	// error is any problems or inconsistencies in the provided gov prop msg.
-	// This goes above and beyond the validation done when actually processing the governance proposal.
-	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
-	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
-	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
-	// period (assuming it passes).
-	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
+// QueryGetAllMarketsResponse is a response message for the GetAllMarkets query.
+type QueryGetAllMarketsResponse struct {
+	// markets are a page of the briefs for all markets.
+	Markets []*MarketBrief `protobuf:"bytes,1,rep,name=markets,proto3" json:"markets,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryValidateManageFeesResponse) Reset()         { *m = QueryValidateManageFeesResponse{} }
-func (m *QueryValidateManageFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryValidateManageFeesResponse) ProtoMessage()    {}
-func (*QueryValidateManageFeesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryGetAllMarketsResponse) Reset()         { *m = QueryGetAllMarketsResponse{} }
+func (m *QueryGetAllMarketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllMarketsResponse) ProtoMessage()    {}
+func (*QueryGetAllMarketsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{35}
 }
-func (m *QueryValidateManageFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryGetAllMarketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryGetAllMarketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryValidateManageFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryGetAllMarketsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1953,52 +2014,48 @@ func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryValidateManageFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryValidateManageFeesResponse.Merge(m, src)
+func (m *QueryGetAllMarketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllMarketsResponse.Merge(m, src)
 }
-func (m *QueryValidateManageFeesResponse) XXX_Size() int {
+func (m *QueryGetAllMarketsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryValidateManageFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryValidateManageFeesResponse.DiscardUnknown(m)
+func (m *QueryGetAllMarketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllMarketsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryValidateManageFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryGetAllMarketsResponse proto.InternalMessageInfo
 
-func (m *QueryValidateManageFeesResponse) GetError() string {
+func (m *QueryGetAllMarketsResponse) GetMarkets() []*MarketBrief {
 	if m != nil {
-		return m.Error
+		return m.Markets
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryValidateManageFeesResponse) GetGovPropWillPass() bool {
+func (m *QueryGetAllMarketsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.GovPropWillPass
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-// QueryGetPaymentRequest is a request message for the GetPayment query.
-type QueryGetPaymentRequest struct {
-	// source is the source account of the payment to get.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// external_id is the external id of the payment to get.
-	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
+// QueryParamsRequest is a request message for the Params query.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryGetPaymentRequest) Reset()         { *m = QueryGetPaymentRequest{} }
-func (m *QueryGetPaymentRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentRequest) ProtoMessage()    {}
-func (*QueryGetPaymentRequest) Descriptor() ([]byte, []int) {
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{36}
 }
-func (m *QueryGetPaymentRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2008,50 +2065,36 @@ func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentRequest.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentRequest) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryGetPaymentRequest proto.InternalMessageInfo
-
-func (m *QueryGetPaymentRequest) GetSource() string {
-	if m != nil {
-		return m.Source
-	}
-	return ""
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetPaymentRequest) GetExternalId() string {
-	if m != nil {
-		return m.ExternalId
-	}
-	return ""
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-// QueryGetPaymentResponse is a response message for the GetPayment query.
-type QueryGetPaymentResponse struct {
-	// payment is the info on the requested payment.
-	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
+// QueryParamsResponse is a response message for the Params query.
+type QueryParamsResponse struct {
+	// params are the exchange module parameter values.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *QueryGetPaymentResponse) Reset()         { *m = QueryGetPaymentResponse{} }
-func (m *QueryGetPaymentResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentResponse) ProtoMessage()    {}
-func (*QueryGetPaymentResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{37}
 }
-func (m *QueryGetPaymentResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2061,45 +2104,51 @@ func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentResponse.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentResponse) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentResponse.DiscardUnknown(m)
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentResponse) GetPayment() *Payment {
+func (m *QueryParamsResponse) GetParams() *Params {
 	if m != nil {
-		return m.Payment
+		return m.Params
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithSourceRequest is a request message for the GetPaymentsWithSource query.
-type QueryGetPaymentsWithSourceRequest struct {
-	// source is the source account of the payments to get.
-	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryCommitmentSettlementFeeCalcRequest is a request message for the CommitmentSettlementFeeCalc query.
+type QueryCommitmentSettlementFeeCalcRequest struct {
+	// settlement is a market's commitment settlement request message.
+	// If no inputs are provided, only the to_fee_nav field will be populated in the response.
+	Settlement *MsgMarketCommitmentSettleRequest `protobuf:"bytes,1,opt,name=settlement,proto3" json:"settlement,omitempty"`
+	// include_breakdown_fields controls the fields that are populated in the response.
+	// If false, only the exchange_fees field is populated.
+	// If true, all of the fields are populated as possible.
+	// If the settlement does not have any inputs, this field defaults to true.
+	IncludeBreakdownFields bool `protobuf:"varint,2,opt,name=include_breakdown_fields,json=includeBreakdownFields,proto3" json:"include_breakdown_fields,omitempty"`
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Reset()         { *m = QueryGetPaymentsWithSourceRequest{} }
-func (m *QueryGetPaymentsWithSourceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithSourceRequest) ProtoMessage()    {}
-func (*QueryGetPaymentsWithSourceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCommitmentSettlementFeeCalcRequest) Reset() {
+	*m = QueryCommitmentSettlementFeeCalcRequest{}
+}
+func (m *QueryCommitmentSettlementFeeCalcRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentSettlementFeeCalcRequest) ProtoMessage()    {}
+func (*QueryCommitmentSettlementFeeCalcRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{38}
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2109,52 +2158,60 @@ func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Merge(m, src)
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_Size() int {
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithSourceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.DiscardUnknown(m)
+func (m *QueryCommitmentSettlementFeeCalcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithSourceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCommitmentSettlementFeeCalcRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithSourceRequest) GetSource() string {
+func (m *QueryCommitmentSettlementFeeCalcRequest) GetSettlement() *MsgMarketCommitmentSettleRequest {
 	if m != nil {
-		return m.Source
+		return m.Settlement
 	}
-	return ""
+	return nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) GetPagination() *query.PageRequest {
+func (m *QueryCommitmentSettlementFeeCalcRequest) GetIncludeBreakdownFields() bool {
 	if m != nil {
-		return m.Pagination
+		return m.IncludeBreakdownFields
 	}
-	return nil
-}
-
-// QueryGetPaymentsWithSourceResponse is a response message for the GetPaymentsWithSource query.
-type QueryGetPaymentsWithSourceResponse struct {
-	// payments is all the payments with the requested source.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	return false
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Reset()         { *m = QueryGetPaymentsWithSourceResponse{} }
-func (m *QueryGetPaymentsWithSourceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithSourceResponse) ProtoMessage()    {}
-func (*QueryGetPaymentsWithSourceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_00949b75b1c10bfe, []int{39}
+// QueryCommitmentSettlementFeeCalcResponse is a response message for the CommitmentSettlementFeeCalc query.
+type QueryCommitmentSettlementFeeCalcResponse struct {
+	// exchange_fees is the total that the exchange would currently pay for the provided settlement.
+	ExchangeFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=exchange_fees,json=exchangeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"exchange_fees"`
+	// input_total is the sum of all the inputs in the provided settlement.
+	InputTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=input_total,json=inputTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"input_total"`
+	// converted_total is the input_total converted to a single intermediary denom or left as the fee denom.
+	ConvertedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=converted_total,json=convertedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"converted_total"`
+	// conversion_navs are the NAVs used to convert the input_total to the converted_total.
+	ConversionNavs []NetAssetPrice `protobuf:"bytes,4,rep,name=conversion_navs,json=conversionNavs,proto3" json:"conversion_navs"`
+	// to_fee_nav is the NAV used to convert the converted_total into the fee denom.
+	ToFeeNav *NetAssetPrice `protobuf:"bytes,5,opt,name=to_fee_nav,json=toFeeNav,proto3" json:"to_fee_nav,omitempty"`
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) Reset() {
+	*m = QueryCommitmentSettlementFeeCalcResponse{}
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentSettlementFeeCalcResponse) ProtoMessage()    {}
+func (*QueryCommitmentSettlementFeeCalcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{39}
+}
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2164,52 +2221,71 @@ func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Merge(m, src)
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_Size() int {
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithSourceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.DiscardUnknown(m)
+func (m *QueryCommitmentSettlementFeeCalcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithSourceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCommitmentSettlementFeeCalcResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithSourceResponse) GetPayments() []*Payment {
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetExchangeFees() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Payments
+		return m.ExchangeFees
 	}
 	return nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) GetPagination() *query.PageResponse {
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetInputTotal() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Pagination
+		return m.InputTotal
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithTargetRequest is a request message for the GetPaymentsWithTarget query.
-type QueryGetPaymentsWithTargetRequest struct {
-	// target is the target account of the payments to get.
-	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetConvertedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ConvertedTotal
+	}
+	return nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Reset()         { *m = QueryGetPaymentsWithTargetRequest{} }
-func (m *QueryGetPaymentsWithTargetRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithTargetRequest) ProtoMessage()    {}
-func (*QueryGetPaymentsWithTargetRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetConversionNavs() []NetAssetPrice {
+	if m != nil {
+		return m.ConversionNavs
+	}
+	return nil
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) GetToFeeNav() *NetAssetPrice {
+	if m != nil {
+		return m.ToFeeNav
+	}
+	return nil
+}
+
+// QueryValidateCreateMarketRequest is a request message for the ValidateCreateMarket query.
+type QueryValidateCreateMarketRequest struct {
+	// create_market_request is the request to run validation on.
+	CreateMarketRequest *MsgGovCreateMarketRequest `protobuf:"bytes,1,opt,name=create_market_request,json=createMarketRequest,proto3" json:"create_market_request,omitempty"`
+}
+
+func (m *QueryValidateCreateMarketRequest) Reset()         { *m = QueryValidateCreateMarketRequest{} }
+func (m *QueryValidateCreateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCreateMarketRequest) ProtoMessage()    {}
+func (*QueryValidateCreateMarketRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{40}
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateCreateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateCreateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateCreateMarketRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2219,52 +2295,49 @@ func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Merge(m, src)
+func (m *QueryValidateCreateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCreateMarketRequest.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_Size() int {
+func (m *QueryValidateCreateMarketRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithTargetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.DiscardUnknown(m)
+func (m *QueryValidateCreateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCreateMarketRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithTargetRequest proto.InternalMessageInfo
-
-func (m *QueryGetPaymentsWithTargetRequest) GetTarget() string {
-	if m != nil {
-		return m.Target
-	}
-	return ""
-}
+var xxx_messageInfo_QueryValidateCreateMarketRequest proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithTargetRequest) GetPagination() *query.PageRequest {
+func (m *QueryValidateCreateMarketRequest) GetCreateMarketRequest() *MsgGovCreateMarketRequest {
 	if m != nil {
-		return m.Pagination
+		return m.CreateMarketRequest
 	}
 	return nil
 }
 
-// QueryGetPaymentsWithTargetResponse is a response message for the GetPaymentsWithTarget query.
-type QueryGetPaymentsWithTargetResponse struct {
-	// payments is all the payments with the requested target.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryValidateCreateMarketResponse is a response message for the ValidateCreateMarket query.
+type QueryValidateCreateMarketResponse struct {
+	// error is any problems or inconsistencies in the provided gov prop msg.
+	// This goes above and beyond the validation done when actually processing the governance proposal.
+	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
+	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
+	// period (assuming it passes).
+	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Reset()         { *m = QueryGetPaymentsWithTargetResponse{} }
-func (m *QueryGetPaymentsWithTargetResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetPaymentsWithTargetResponse) ProtoMessage()    {}
-func (*QueryGetPaymentsWithTargetResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidateCreateMarketResponse) Reset()         { *m = QueryValidateCreateMarketResponse{} }
+func (m *QueryValidateCreateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateCreateMarketResponse) ProtoMessage()    {}
+func (*QueryValidateCreateMarketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{41}
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateCreateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateCreateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateCreateMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2274,50 +2347,50 @@ func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Merge(m, src)
+func (m *QueryValidateCreateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateCreateMarketResponse.Merge(m, src)
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_Size() int {
+func (m *QueryValidateCreateMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetPaymentsWithTargetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.DiscardUnknown(m)
+func (m *QueryValidateCreateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateCreateMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetPaymentsWithTargetResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidateCreateMarketResponse proto.InternalMessageInfo
 
-func (m *QueryGetPaymentsWithTargetResponse) GetPayments() []*Payment {
+func (m *QueryValidateCreateMarketResponse) GetError() string {
 	if m != nil {
-		return m.Payments
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) GetPagination() *query.PageResponse {
+func (m *QueryValidateCreateMarketResponse) GetGovPropWillPass() bool {
 	if m != nil {
-		return m.Pagination
+		return m.GovPropWillPass
 	}
-	return nil
+	return false
 }
 
-// QueryGetAllPaymentsRequest is a request message for the GetAllPayments query.
-type QueryGetAllPaymentsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryValidateMarketRequest is a request message for the ValidateMarket query.
+type QueryValidateMarketRequest struct {
+	// market_id is the id of the market to check.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 }
 
-func (m *QueryGetAllPaymentsRequest) Reset()         { *m = QueryGetAllPaymentsRequest{} }
-func (m *QueryGetAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllPaymentsRequest) ProtoMessage()    {}
-func (*QueryGetAllPaymentsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidateMarketRequest) Reset()         { *m = QueryValidateMarketRequest{} }
+func (m *QueryValidateMarketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateMarketRequest) ProtoMessage()    {}
+func (*QueryValidateMarketRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{42}
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateMarketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllPaymentsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateMarketRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2327,45 +2400,43 @@ func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllPaymentsRequest.Merge(m, src)
+func (m *QueryValidateMarketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateMarketRequest.Merge(m, src)
 }
-func (m *QueryGetAllPaymentsRequest) XXX_Size() int {
+func (m *QueryValidateMarketRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllPaymentsRequest.DiscardUnknown(m)
+func (m *QueryValidateMarketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateMarketRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidateMarketRequest proto.InternalMessageInfo
 
-func (m *QueryGetAllPaymentsRequest) GetPagination() *query.PageRequest {
+func (m *QueryValidateMarketRequest) GetMarketId() uint32 {
 	if m != nil {
-		return m.Pagination
+		return m.MarketId
 	}
-	return nil
+	return 0
 }
 
-// QueryGetAllPaymentsResponse is a response message for the GetAllPayments query.
-type QueryGetAllPaymentsResponse struct {
-	// payments is all the payments on this page of results.
-	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	// pagination is the resulting pagination parameters.
-	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryValidateMarketResponse is a response message for the ValidateMarket query.
+type QueryValidateMarketResponse struct {
+	// error is any problems or inconsistencies in the provided market.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *QueryGetAllPaymentsResponse) Reset()         { *m = QueryGetAllPaymentsResponse{} }
-func (m *QueryGetAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetAllPaymentsResponse) ProtoMessage()    {}
-func (*QueryGetAllPaymentsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidateMarketResponse) Reset()         { *m = QueryValidateMarketResponse{} }
+func (m *QueryValidateMarketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateMarketResponse) ProtoMessage()    {}
+func (*QueryValidateMarketResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{43}
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateMarketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetAllPaymentsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateMarketResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2375,50 +2446,43 @@ func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetAllPaymentsResponse.Merge(m, src)
+func (m *QueryValidateMarketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateMarketResponse.Merge(m, src)
 }
-func (m *QueryGetAllPaymentsResponse) XXX_Size() int {
+func (m *QueryValidateMarketResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetAllPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetAllPaymentsResponse.DiscardUnknown(m)
+func (m *QueryValidateMarketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateMarketResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetAllPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidateMarketResponse proto.InternalMessageInfo
 
-func (m *QueryGetAllPaymentsResponse) GetPayments() []*Payment {
+func (m *QueryValidateMarketResponse) GetError() string {
 	if m != nil {
-		return m.Payments
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryGetAllPaymentsResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryPaymentFeeCalcRequest is a request message for the PaymentFeeCalc query.
-type QueryPaymentFeeCalcRequest struct {
-	// payment is the details of the payment to create or accept.
-	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
+// QueryValidateManageFeesRequest is a request message for the ValidateManageFees query.
+type QueryValidateManageFeesRequest struct {
+	// manage_fees_request is the request to run validation on.
+	ManageFeesRequest *MsgGovManageFeesRequest `protobuf:"bytes,1,opt,name=manage_fees_request,json=manageFeesRequest,proto3" json:"manage_fees_request,omitempty"`
 }
 
-func (m *QueryPaymentFeeCalcRequest) Reset()         { *m = QueryPaymentFeeCalcRequest{} }
-func (m *QueryPaymentFeeCalcRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPaymentFeeCalcRequest) ProtoMessage()    {}
-func (*QueryPaymentFeeCalcRequest) Descriptor() ([]byte, []int) {
+func (m *QueryValidateManageFeesRequest) Reset()         { *m = QueryValidateManageFeesRequest{} }
+func (m *QueryValidateManageFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateManageFeesRequest) ProtoMessage()    {}
+func (*QueryValidateManageFeesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{44}
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateManageFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateManageFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPaymentFeeCalcRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateManageFeesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2428,45 +2492,49 @@ func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPaymentFeeCalcRequest.Merge(m, src)
+func (m *QueryValidateManageFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateManageFeesRequest.Merge(m, src)
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_Size() int {
+func (m *QueryValidateManageFeesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPaymentFeeCalcRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPaymentFeeCalcRequest.DiscardUnknown(m)
+func (m *QueryValidateManageFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateManageFeesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPaymentFeeCalcRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidateManageFeesRequest proto.InternalMessageInfo
 
-func (m *QueryPaymentFeeCalcRequest) GetPayment() Payment {
+func (m *QueryValidateManageFeesRequest) GetManageFeesRequest() *MsgGovManageFeesRequest {
 	if m != nil {
-		return m.Payment
+		return m.ManageFeesRequest
 	}
-	return Payment{}
+	return nil
 }
 
-// QueryPaymentFeeCalcResponse is a response message for the PaymentFeeCalc query.
-type QueryPaymentFeeCalcResponse struct {
-	// fee_create is the fee required to create the provided payment.
-	FeeCreate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_create,json=feeCreate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_create"`
-	// fee_accept is the fee required to accept the provided payment.
-	FeeAccept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_accept,json=feeAccept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_accept"`
+// QueryValidateManageFeesResponse is a response message for the ValidateManageFees query.
+type QueryValidateManageFeesResponse struct {
+	// error is any problems or inconsistencies in the provided gov prop msg.
+	// This goes above and beyond the validation done when actually processing the governance proposal.
+	// If an error is returned, and gov_prop_will_pass is true, it means the error is more of an
+	// inconsistency that might cause certain aspects of the market to behave unexpectedly.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// gov_prop_will_pass will be true if the the provided msg will be successfully processed at the end of it's voting
+	// period (assuming it passes).
+	GovPropWillPass bool `protobuf:"varint,2,opt,name=gov_prop_will_pass,json=govPropWillPass,proto3" json:"gov_prop_will_pass,omitempty"`
 }
 
-func (m *QueryPaymentFeeCalcResponse) Reset()         { *m = QueryPaymentFeeCalcResponse{} }
-func (m *QueryPaymentFeeCalcResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPaymentFeeCalcResponse) ProtoMessage()    {}
-func (*QueryPaymentFeeCalcResponse) Descriptor() ([]byte, []int) {
+func (m *QueryValidateManageFeesResponse) Reset()         { *m = QueryValidateManageFeesResponse{} }
+func (m *QueryValidateManageFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateManageFeesResponse) ProtoMessage()    {}
+func (*QueryValidateManageFeesResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_00949b75b1c10bfe, []int{45}
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryValidateManageFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryValidateManageFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPaymentFeeCalcResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryValidateManageFeesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2476,2066 +2544,1934 @@ func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPaymentFeeCalcResponse.Merge(m, src)
+func (m *QueryValidateManageFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateManageFeesResponse.Merge(m, src)
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_Size() int {
+func (m *QueryValidateManageFeesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPaymentFeeCalcResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPaymentFeeCalcResponse.DiscardUnknown(m)
+func (m *QueryValidateManageFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateManageFeesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPaymentFeeCalcResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryValidateManageFeesResponse proto.InternalMessageInfo
 
-func (m *QueryPaymentFeeCalcResponse) GetFeeCreate() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryValidateManageFeesResponse) GetError() string {
 	if m != nil {
-		return m.FeeCreate
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryPaymentFeeCalcResponse) GetFeeAccept() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryValidateManageFeesResponse) GetGovPropWillPass() bool {
 	if m != nil {
-		return m.FeeAccept
+		return m.GovPropWillPass
 	}
-	return nil
+	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryOrderFeeCalcRequest)(nil), "provenance.exchange.v1.QueryOrderFeeCalcRequest")
-	proto.RegisterType((*QueryOrderFeeCalcResponse)(nil), "provenance.exchange.v1.QueryOrderFeeCalcResponse")
-	proto.RegisterType((*QueryGetOrderRequest)(nil), "provenance.exchange.v1.QueryGetOrderRequest")
-	proto.RegisterType((*QueryGetOrderResponse)(nil), "provenance.exchange.v1.QueryGetOrderResponse")
-	proto.RegisterType((*QueryGetOrderByExternalIDRequest)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDRequest")
-	proto.RegisterType((*QueryGetOrderByExternalIDResponse)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDResponse")
-	proto.RegisterType((*QueryGetMarketOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketOrdersRequest")
-	proto.RegisterType((*QueryGetMarketOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketOrdersResponse")
-	proto.RegisterType((*QueryGetOwnerOrdersRequest)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersRequest")
-	proto.RegisterType((*QueryGetOwnerOrdersResponse)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersResponse")
-	proto.RegisterType((*QueryGetAssetOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAssetOrdersRequest")
-	proto.RegisterType((*QueryGetAssetOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAssetOrdersResponse")
-	proto.RegisterType((*QueryGetAllOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAllOrdersRequest")
-	proto.RegisterType((*QueryGetAllOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAllOrdersResponse")
-	proto.RegisterType((*QueryGetCommitmentRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRequest")
-	proto.RegisterType((*QueryGetCommitmentResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentResponse")
-	proto.RegisterType((*QueryGetAccountCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsRequest")
-	proto.RegisterType((*QueryGetAccountCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsResponse")
-	proto.RegisterType((*QueryGetMarketCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsRequest")
-	proto.RegisterType((*QueryGetMarketCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsResponse")
-	proto.RegisterType((*QueryGetAllCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsRequest")
-	proto.RegisterType((*QueryGetAllCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsResponse")
-	proto.RegisterType((*QueryGetMarketRequest)(nil), "provenance.exchange.v1.QueryGetMarketRequest")
-	proto.RegisterType((*QueryGetMarketResponse)(nil), "provenance.exchange.v1.QueryGetMarketResponse")
-	proto.RegisterType((*QueryGetAllMarketsRequest)(nil), "provenance.exchange.v1.QueryGetAllMarketsRequest")
-	proto.RegisterType((*QueryGetAllMarketsResponse)(nil), "provenance.exchange.v1.QueryGetAllMarketsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.exchange.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.exchange.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCommitmentSettlementFeeCalcRequest)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcRequest")
-	proto.RegisterType((*QueryCommitmentSettlementFeeCalcResponse)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcResponse")
-	proto.RegisterType((*QueryValidateCreateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateCreateMarketRequest")
-	proto.RegisterType((*QueryValidateCreateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateCreateMarketResponse")
-	proto.RegisterType((*QueryValidateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateMarketRequest")
-	proto.RegisterType((*QueryValidateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateMarketResponse")
-	proto.RegisterType((*QueryValidateManageFeesRequest)(nil), "provenance.exchange.v1.QueryValidateManageFeesRequest")
-	proto.RegisterType((*QueryValidateManageFeesResponse)(nil), "provenance.exchange.v1.QueryValidateManageFeesResponse")
-	proto.RegisterType((*QueryGetPaymentRequest)(nil), "provenance.exchange.v1.QueryGetPaymentRequest")
-	proto.RegisterType((*QueryGetPaymentResponse)(nil), "provenance.exchange.v1.QueryGetPaymentResponse")
-	proto.RegisterType((*QueryGetPaymentsWithSourceRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceRequest")
-	proto.RegisterType((*QueryGetPaymentsWithSourceResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceResponse")
-	proto.RegisterType((*QueryGetPaymentsWithTargetRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetRequest")
-	proto.RegisterType((*QueryGetPaymentsWithTargetResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetResponse")
-	proto.RegisterType((*QueryGetAllPaymentsRequest)(nil), "provenance.exchange.v1.QueryGetAllPaymentsRequest")
-	proto.RegisterType((*QueryGetAllPaymentsResponse)(nil), "provenance.exchange.v1.QueryGetAllPaymentsResponse")
-	proto.RegisterType((*QueryPaymentFeeCalcRequest)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcRequest")
-	proto.RegisterType((*QueryPaymentFeeCalcResponse)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcResponse")
+// QueryGetPaymentRequest is a request message for the GetPayment query.
+type QueryGetPaymentRequest struct {
+	// source is the source account of the payment to get.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// external_id is the external id of the payment to get.
+	ExternalId string `protobuf:"bytes,2,opt,name=external_id,json=externalId,proto3" json:"external_id,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("provenance/exchange/v1/query.proto", fileDescriptor_00949b75b1c10bfe)
+func (m *QueryGetPaymentRequest) Reset()         { *m = QueryGetPaymentRequest{} }
+func (m *QueryGetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentRequest) ProtoMessage()    {}
+func (*QueryGetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{46}
 }
-
-var fileDescriptor_00949b75b1c10bfe = []byte{
-	// 2424 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcb, 0x6f, 0xdc, 0xd6,
-	0xf5, 0xf6, 0x95, 0x2d, 0x59, 0x3a, 0xb6, 0x65, 0xf8, 0x5a, 0xf6, 0x6f, 0x34, 0xb6, 0x47, 0x32,
-	0xfd, 0x12, 0x64, 0x7b, 0x68, 0x49, 0xb6, 0x22, 0xe7, 0x07, 0xd7, 0x91, 0x94, 0x4a, 0x30, 0xd0,
-	0xd8, 0xca, 0x58, 0xa8, 0x03, 0x01, 0xed, 0x84, 0xe2, 0x5c, 0x8d, 0x09, 0x71, 0xc8, 0x09, 0x49,
-	0x8d, 0x25, 0x08, 0x02, 0xda, 0xf4, 0x11, 0x24, 0x8b, 0xa2, 0x40, 0x17, 0x4d, 0x1b, 0x34, 0x5d,
-	0xb8, 0x68, 0x8b, 0x6c, 0xe2, 0x45, 0xbb, 0x2a, 0x8a, 0x2c, 0xba, 0xa8, 0x37, 0x05, 0x82, 0x76,
-	0xd3, 0x02, 0x45, 0x1b, 0xd8, 0x05, 0xb2, 0x69, 0xff, 0x85, 0xa2, 0xe0, 0xbd, 0x87, 0x43, 0x72,
-	0x86, 0xaf, 0x51, 0x26, 0x86, 0x36, 0x1e, 0x91, 0x3c, 0xe7, 0x9e, 0xef, 0x7c, 0xf7, 0x71, 0xee,
-	0xfd, 0xae, 0x41, 0xaa, 0x5b, 0x66, 0x83, 0x19, 0x8a, 0xa1, 0x32, 0x99, 0x6d, 0xaa, 0x0f, 0x15,
-	0xa3, 0xca, 0xe4, 0xc6, 0x84, 0xfc, 0xd6, 0x06, 0xb3, 0xb6, 0x8a, 0x75, 0xcb, 0x74, 0x4c, 0x7a,
-	0xd2, 0xb7, 0x29, 0x7a, 0x36, 0xc5, 0xc6, 0x44, 0xfe, 0x98, 0x52, 0xd3, 0x0c, 0x53, 0xe6, 0xff,
-	0x0a, 0xd3, 0xfc, 0xb0, 0x6a, 0xda, 0x35, 0xd3, 0x2e, 0xf3, 0x27, 0x59, 0x3c, 0xe0, 0xa7, 0x71,
-	0xf1, 0x24, 0xaf, 0x2a, 0x36, 0x13, 0xcd, 0xcb, 0x8d, 0x89, 0x55, 0xe6, 0x28, 0x13, 0x72, 0x5d,
-	0xa9, 0x6a, 0x86, 0xe2, 0x68, 0xa6, 0x81, 0xb6, 0x85, 0xa0, 0xad, 0x67, 0xa5, 0x9a, 0x9a, 0xf7,
-	0xfd, 0x74, 0xd5, 0x34, 0xab, 0x3a, 0x93, 0x95, 0xba, 0x26, 0x2b, 0x86, 0x61, 0x3a, 0xdc, 0xd9,
-	0x8b, 0x34, 0x54, 0x35, 0xab, 0xa6, 0x40, 0xe0, 0xfe, 0x85, 0x6f, 0xc7, 0x62, 0x32, 0x55, 0xcd,
-	0x5a, 0x4d, 0x73, 0x6a, 0xcc, 0x70, 0x3c, 0xff, 0x73, 0x31, 0x96, 0x35, 0xc5, 0x5a, 0x67, 0x4e,
-	0x8a, 0x91, 0x69, 0x55, 0x98, 0x95, 0xd6, 0x52, 0x5d, 0xb1, 0x94, 0x9a, 0x67, 0x74, 0x21, 0xd6,
-	0x68, 0x2b, 0x88, 0x6a, 0x24, 0xc6, 0xcc, 0xd9, 0x14, 0x06, 0xd2, 0xfb, 0x04, 0x72, 0xaf, 0xbb,
-	0xbc, 0xde, 0x73, 0x21, 0x2c, 0x30, 0x36, 0xaf, 0xe8, 0x6a, 0x89, 0xbd, 0xb5, 0xc1, 0x6c, 0x87,
-	0xde, 0x82, 0x01, 0xc5, 0x5e, 0x2f, 0x73, 0x74, 0xb9, 0x9e, 0x51, 0x32, 0x76, 0x68, 0x72, 0xb4,
-	0x18, 0xdd, 0xaf, 0xc5, 0x59, 0x7b, 0x9d, 0x37, 0x51, 0xea, 0x57, 0xf0, 0x2f, 0xd7, 0x7d, 0x55,
-	0xab, 0xa0, 0xfb, 0xfe, 0x64, 0xf7, 0x39, 0xad, 0x82, 0xee, 0xab, 0xf8, 0x97, 0xf4, 0xa4, 0x07,
-	0x86, 0x23, 0xa0, 0xd9, 0x75, 0xd3, 0xb0, 0x19, 0x7d, 0x1d, 0x86, 0x54, 0x8b, 0xf1, 0x2e, 0x2c,
-	0xaf, 0x31, 0x56, 0x36, 0xeb, 0xbc, 0x37, 0x73, 0x64, 0x74, 0xff, 0xd8, 0xa1, 0xc9, 0xe1, 0x22,
-	0x0e, 0x23, 0x77, 0x30, 0x14, 0x71, 0x30, 0x14, 0xe7, 0x4d, 0xcd, 0x98, 0x3b, 0xf0, 0xf4, 0x1f,
-	0x23, 0xfb, 0x4a, 0xd4, 0x73, 0x5e, 0x60, 0xec, 0x9e, 0x70, 0xa5, 0xdf, 0x84, 0x53, 0x36, 0x73,
-	0x1c, 0x9d, 0xb9, 0x0c, 0x96, 0xd7, 0x74, 0xc5, 0x09, 0xb5, 0xdc, 0x93, 0xad, 0xe5, 0x9c, 0xdf,
-	0xc6, 0x82, 0xae, 0x38, 0x81, 0xf6, 0xdf, 0x84, 0xd3, 0x81, 0xf6, 0x2d, 0x37, 0x7c, 0x28, 0xc0,
-	0xfe, 0x6c, 0x01, 0x86, 0xfd, 0x46, 0x4a, 0x6e, 0x1b, 0x7e, 0x04, 0x69, 0x02, 0x86, 0x38, 0x63,
-	0x8b, 0xcc, 0x11, 0x6c, 0x62, 0x47, 0x0e, 0x43, 0x3f, 0xef, 0x85, 0xb2, 0x56, 0xc9, 0x91, 0x51,
-	0x32, 0x76, 0xa0, 0x74, 0x90, 0x3f, 0xdf, 0xa9, 0x48, 0x5f, 0x83, 0x13, 0x2d, 0x2e, 0x48, 0xf0,
-	0x14, 0xf4, 0x8a, 0x9e, 0x23, 0xbc, 0xe7, 0xce, 0xc4, 0xf5, 0x9c, 0xf0, 0x12, 0xb6, 0xd2, 0x9b,
-	0x30, 0x1a, 0x6a, 0x6d, 0x6e, 0xeb, 0xab, 0x9b, 0x0e, 0xb3, 0x0c, 0x45, 0xbf, 0xf3, 0xaa, 0x07,
-	0xe6, 0x14, 0x0c, 0x88, 0x49, 0xe1, 0xa1, 0x39, 0x52, 0xea, 0x17, 0x2f, 0xee, 0x54, 0xe8, 0x08,
-	0x1c, 0x62, 0xe8, 0xe1, 0x7e, 0x76, 0x07, 0xdd, 0x40, 0x09, 0xbc, 0x57, 0x77, 0x2a, 0xd2, 0x1b,
-	0x70, 0x36, 0x21, 0xc2, 0x17, 0xc1, 0xfe, 0x47, 0x02, 0xa7, 0xbc, 0xa6, 0x5f, 0xe3, 0x78, 0xf8,
-	0x67, 0x3b, 0x13, 0xee, 0x33, 0x00, 0x82, 0x61, 0x67, 0xab, 0xce, 0x10, 0xf6, 0x00, 0x7f, 0xb3,
-	0xbc, 0x55, 0x67, 0xf4, 0x3c, 0x0c, 0x2a, 0x6b, 0x0e, 0xb3, 0xca, 0xcd, 0x6e, 0xd8, 0xcf, 0xbb,
-	0xe1, 0x30, 0x7f, 0x7b, 0x4f, 0xf4, 0x05, 0x5d, 0x00, 0xf0, 0x57, 0xb5, 0x9c, 0xca, 0xb1, 0x5f,
-	0x0c, 0x0d, 0x07, 0xb1, 0xc2, 0x7a, 0x83, 0x62, 0x49, 0xa9, 0x32, 0x44, 0x57, 0x0a, 0x78, 0x4a,
-	0x1f, 0x12, 0x38, 0x1d, 0x9d, 0x09, 0xf2, 0x73, 0x03, 0xfa, 0xc4, 0x92, 0x83, 0xd3, 0x25, 0x85,
-	0x20, 0x34, 0xa6, 0x8b, 0x11, 0xf8, 0x2e, 0xa5, 0xe2, 0x13, 0x31, 0x43, 0x00, 0xff, 0x46, 0x20,
-	0xdf, 0xec, 0xc5, 0x47, 0x06, 0x32, 0xd0, 0x64, 0xba, 0x08, 0xbd, 0xa6, 0xfb, 0x96, 0xb3, 0x3c,
-	0x30, 0x97, 0xfb, 0xf3, 0x6f, 0xae, 0x0e, 0x61, 0x94, 0xd9, 0x4a, 0xc5, 0x62, 0xb6, 0x7d, 0xdf,
-	0xb1, 0x34, 0xa3, 0x5a, 0x12, 0x66, 0x7b, 0x8b, 0xfc, 0x9f, 0x05, 0x86, 0x51, 0x28, 0xb7, 0x3d,
-	0xc2, 0xfd, 0x27, 0x01, 0xee, 0x67, 0x6d, 0xbb, 0x75, 0x94, 0x0f, 0x41, 0xaf, 0xe2, 0xbe, 0x15,
-	0xdc, 0x97, 0xc4, 0xc3, 0xde, 0x65, 0x38, 0x94, 0xc1, 0x1e, 0x61, 0x78, 0x15, 0x4b, 0xaa, 0x0b,
-	0x4f, 0xd7, 0xc3, 0xf4, 0x76, 0x8b, 0x83, 0x0f, 0x08, 0x16, 0xc7, 0x70, 0x90, 0x3d, 0xc2, 0x80,
-	0xee, 0x83, 0x9b, 0x6f, 0xee, 0x94, 0x3c, 0x0a, 0x26, 0xe1, 0xa0, 0xa2, 0xaa, 0xe6, 0x86, 0xe1,
-	0xa4, 0xce, 0x6f, 0xcf, 0x30, 0xbc, 0xf6, 0xf6, 0x84, 0xd7, 0x5e, 0xe9, 0xc7, 0x81, 0x11, 0x1d,
-	0x0c, 0x87, 0x64, 0x6c, 0x41, 0x9f, 0x52, 0xc3, 0x70, 0x29, 0x05, 0x76, 0xc1, 0x2d, 0xb0, 0x1f,
-	0xfd, 0x73, 0x64, 0xac, 0xaa, 0x39, 0x0f, 0x37, 0x56, 0x8b, 0xaa, 0x59, 0xc3, 0xfd, 0x28, 0xfe,
-	0x5c, 0xb5, 0x2b, 0xeb, 0xb2, 0x3b, 0x07, 0x6c, 0xee, 0x60, 0xff, 0xf4, 0xf3, 0x27, 0xe3, 0x87,
-	0x75, 0x56, 0x55, 0xd4, 0xad, 0xb2, 0xbb, 0xd5, 0xb4, 0x7f, 0xfd, 0xf9, 0x93, 0x71, 0x52, 0xc2,
-	0x80, 0xd2, 0x03, 0xbf, 0x58, 0xcd, 0x8a, 0x4c, 0x7c, 0x7c, 0xf6, 0x17, 0xe0, 0x43, 0xd2, 0x41,
-	0x4a, 0x6a, 0x18, 0x33, 0x5f, 0x80, 0x43, 0x81, 0x8d, 0x2a, 0xa6, 0x7f, 0x3e, 0x6e, 0x2c, 0x88,
-	0x4a, 0x31, 0xcb, 0x91, 0x97, 0x82, 0x8e, 0xd2, 0x3b, 0xc4, 0x2f, 0xeb, 0xc2, 0x2a, 0x22, 0x8d,
-	0xc4, 0xf2, 0xd8, 0xad, 0x61, 0xff, 0x5b, 0xe2, 0x33, 0x1a, 0x81, 0x04, 0xf3, 0x5e, 0x8c, 0xca,
-	0xfb, 0x42, 0xec, 0xce, 0x55, 0x10, 0x18, 0x91, 0x78, 0xf7, 0x26, 0x44, 0x15, 0xce, 0x04, 0x66,
-	0x6b, 0x04, 0x7b, 0xdd, 0x22, 0xe8, 0x63, 0x02, 0x85, 0xb8, 0x48, 0xc8, 0xce, 0xab, 0x51, 0xec,
-	0x48, 0x71, 0xec, 0x04, 0x26, 0xd4, 0x97, 0x43, 0xcd, 0x75, 0x7f, 0x03, 0x2a, 0x7a, 0x34, 0xcb,
-	0x80, 0x92, 0xbe, 0x4b, 0xe0, 0x64, 0xab, 0x1b, 0xe6, 0xe7, 0xce, 0x27, 0x31, 0x6b, 0x32, 0xcc,
-	0x27, 0xf1, 0x48, 0xa7, 0xa1, 0x4f, 0x34, 0x8d, 0xc7, 0x9c, 0x42, 0xf2, 0x24, 0x29, 0xa1, 0xb5,
-	0xa4, 0x86, 0x56, 0x61, 0xf1, 0xb1, 0xeb, 0x7d, 0xfa, 0x8b, 0x60, 0xc5, 0x0e, 0x44, 0xc1, 0x7c,
-	0x6f, 0xc1, 0x41, 0x81, 0xc6, 0xeb, 0xcb, 0x73, 0xc9, 0xe0, 0xe7, 0x2c, 0x8d, 0xad, 0x95, 0x3c,
-	0x9f, 0xee, 0x75, 0xe4, 0x10, 0x50, 0x8e, 0x72, 0x89, 0x9f, 0x53, 0x31, 0x11, 0xe9, 0x35, 0x38,
-	0x1e, 0x7a, 0x8b, 0xa0, 0xa7, 0xa1, 0x4f, 0x9c, 0x67, 0x71, 0x8b, 0x1e, 0x4b, 0x38, 0xfa, 0xa1,
-	0xb5, 0xf4, 0x7b, 0x02, 0x97, 0x78, 0x7b, 0xfe, 0xb8, 0xbc, 0xef, 0x9f, 0xb7, 0xc2, 0xc7, 0xd7,
-	0x37, 0x00, 0xfc, 0xa3, 0x12, 0xc6, 0x99, 0x89, 0xe5, 0xc6, 0xae, 0xb6, 0x2e, 0x28, 0xa2, 0xe1,
-	0x66, 0x8f, 0xf8, 0x6d, 0xd1, 0x19, 0xc8, 0x69, 0x86, 0xaa, 0x6f, 0x54, 0x58, 0x79, 0xd5, 0x62,
-	0xca, 0x7a, 0xc5, 0x7c, 0x64, 0x94, 0xd7, 0x34, 0xa6, 0x57, 0x6c, 0x3e, 0x80, 0xfa, 0x4b, 0x27,
-	0xf1, 0xfb, 0x9c, 0xf7, 0x79, 0x81, 0x7f, 0x95, 0x3e, 0x3b, 0x00, 0x63, 0xe9, 0xf8, 0x91, 0xa4,
-	0xef, 0x13, 0x38, 0xe2, 0x61, 0x74, 0x4f, 0x8a, 0xf6, 0x8b, 0xab, 0x60, 0x87, 0xbd, 0xb8, 0x0b,
-	0x8c, 0xd9, 0xf4, 0x6d, 0x02, 0x87, 0x34, 0xa3, 0xbe, 0xe1, 0x94, 0x1d, 0xd3, 0x51, 0xf4, 0xf4,
-	0xa3, 0x70, 0xb7, 0x60, 0x00, 0x8f, 0xba, 0xec, 0x06, 0xa5, 0xef, 0x11, 0x38, 0xaa, 0x9a, 0x46,
-	0x83, 0x59, 0x0e, 0xab, 0x20, 0x90, 0xfd, 0x2f, 0x0a, 0xc8, 0x60, 0x33, 0xb2, 0x00, 0xb3, 0xec,
-	0x61, 0xb1, 0x35, 0xd3, 0x28, 0x1b, 0x4a, 0xc3, 0xce, 0x1d, 0x48, 0x2e, 0x33, 0x77, 0x71, 0xb3,
-	0xba, 0x64, 0x69, 0x2a, 0xc3, 0xa3, 0xfc, 0xa0, 0xdf, 0xc6, 0x5d, 0xa5, 0x61, 0xd3, 0x79, 0x00,
-	0x47, 0x68, 0x02, 0x86, 0xd2, 0xc8, 0xf5, 0xf2, 0x11, 0x9b, 0xad, 0xc1, 0x52, 0xbf, 0x63, 0x2e,
-	0x30, 0x76, 0x57, 0x69, 0x48, 0xef, 0x7a, 0xd5, 0xfa, 0xeb, 0x8a, 0xae, 0x55, 0x14, 0x87, 0xcd,
-	0x5b, 0x4c, 0x71, 0x58, 0x78, 0x71, 0x65, 0x70, 0x82, 0x2b, 0x20, 0xac, 0x8c, 0x6b, 0xac, 0x25,
-	0x3e, 0xe0, 0x34, 0x99, 0x48, 0x98, 0x26, 0x8b, 0x66, 0x23, 0xa2, 0xc5, 0xd2, 0x71, 0xb5, 0xfd,
-	0xa5, 0xb4, 0x86, 0xe5, 0x3a, 0x1a, 0x0a, 0x0e, 0xf3, 0x21, 0xe8, 0x65, 0x96, 0x65, 0x5a, 0xde,
-	0x91, 0x83, 0x3f, 0xd0, 0xcb, 0x40, 0xab, 0x66, 0xa3, 0x5c, 0xb7, 0xcc, 0x7a, 0xf9, 0x91, 0xa6,
-	0xeb, 0xe5, 0xba, 0x62, 0x7b, 0xb3, 0xeb, 0x68, 0xd5, 0x6c, 0x2c, 0x59, 0x66, 0xfd, 0x81, 0xa6,
-	0xeb, 0x4b, 0x8a, 0x6d, 0x4b, 0x37, 0x71, 0x85, 0xf4, 0xe2, 0x74, 0x50, 0x49, 0xa6, 0xf0, 0x30,
-	0xd1, 0xea, 0x9a, 0x04, 0x4e, 0xfa, 0xb6, 0x57, 0x66, 0x7d, 0x2f, 0x43, 0x11, 0x93, 0xc5, 0x0b,
-	0x5a, 0x86, 0xe3, 0x35, 0xfe, 0x92, 0xcf, 0xdc, 0x16, 0x7e, 0xe5, 0x64, 0x7e, 0xdb, 0x5a, 0x2b,
-	0x1d, 0xab, 0xb5, 0xbe, 0x92, 0x2a, 0x30, 0x12, 0x0b, 0xa1, 0x7b, 0xcc, 0xae, 0xfb, 0x75, 0x76,
-	0x49, 0x68, 0x8b, 0x5e, 0x82, 0xd7, 0xa0, 0xcf, 0x36, 0x37, 0x2c, 0x95, 0xa5, 0x96, 0x59, 0xb4,
-	0x4b, 0x17, 0x77, 0x96, 0xe1, 0xff, 0xda, 0x82, 0x61, 0x2a, 0x37, 0xe1, 0x20, 0x6a, 0x9b, 0x48,
-	0xe1, 0x48, 0x7c, 0xc5, 0x10, 0x9e, 0x9e, 0xbd, 0x7b, 0x5e, 0x3c, 0xdb, 0xd2, 0xac, 0xfd, 0x40,
-	0x73, 0x1e, 0xde, 0xe7, 0xa8, 0x76, 0x9f, 0x4e, 0xb7, 0xea, 0xfb, 0x47, 0xc4, 0xdf, 0xcd, 0x47,
-	0xe1, 0x43, 0x06, 0xfe, 0x1f, 0xfa, 0x3d, 0x75, 0x17, 0xeb, 0x40, 0x2a, 0x05, 0x4d, 0x87, 0xee,
-	0x55, 0xf9, 0x38, 0x32, 0x97, 0x15, 0xab, 0xca, 0x82, 0x63, 0xc3, 0xe1, 0x2f, 0xd2, 0xc9, 0x14,
-	0x76, 0x5f, 0x3a, 0x99, 0x1e, 0xbe, 0x3d, 0x45, 0x66, 0x25, 0xb4, 0xb1, 0xf3, 0xe0, 0x76, 0x7b,
-	0xff, 0xf8, 0x38, 0xa8, 0x97, 0x04, 0xc3, 0xec, 0x29, 0x2e, 0xbe, 0x81, 0x5c, 0x60, 0x88, 0x96,
-	0xbd, 0xdc, 0xed, 0x4e, 0xa7, 0x3f, 0x56, 0xd8, 0xe6, 0x22, 0xf0, 0xb8, 0x07, 0x49, 0x68, 0x6d,
-	0x1f, 0x49, 0xf8, 0x16, 0x01, 0x70, 0x0b, 0xaf, 0xa8, 0x62, 0x2f, 0x6e, 0xa3, 0x35, 0xb0, 0xc6,
-	0xb0, 0x2a, 0x36, 0x21, 0x28, 0xaa, 0xca, 0xea, 0xce, 0x8b, 0xdb, 0x64, 0xb9, 0x10, 0x66, 0x79,
-	0xcc, 0xc9, 0x5f, 0x9e, 0x83, 0x5e, 0xce, 0x12, 0xfd, 0x39, 0x81, 0xc3, 0xc1, 0x8b, 0x17, 0x7a,
-	0x2d, 0x8e, 0xf0, 0xb8, 0xeb, 0xa3, 0xfc, 0x44, 0x07, 0x1e, 0xa2, 0x17, 0xa4, 0xf1, 0xb7, 0xff,
-	0xf2, 0xaf, 0x1f, 0xf5, 0x9c, 0xa7, 0x92, 0x1c, 0x73, 0x71, 0xe5, 0xd6, 0x52, 0x71, 0x5d, 0x46,
-	0x7f, 0x42, 0xa0, 0xdf, 0xbb, 0x05, 0xa0, 0x57, 0x12, 0x63, 0xb5, 0xdc, 0x87, 0xe4, 0xaf, 0x66,
-	0xb4, 0x46, 0x54, 0xd7, 0x38, 0xaa, 0x71, 0x3a, 0x26, 0x27, 0xdd, 0xdf, 0xc9, 0xdb, 0x9e, 0xfa,
-	0xb9, 0x43, 0xdf, 0xef, 0x81, 0xa1, 0xa8, 0x1b, 0x0a, 0x3a, 0x93, 0x29, 0x72, 0xc4, 0xb5, 0x49,
-	0xfe, 0xe6, 0x2e, 0x3c, 0x11, 0xff, 0x7b, 0x84, 0x27, 0xf0, 0x1d, 0xb2, 0xf2, 0x0a, 0xfd, 0x8a,
-	0x9c, 0x78, 0x51, 0x29, 0x6f, 0x37, 0x77, 0x4a, 0x3b, 0x5e, 0x5a, 0x81, 0x9a, 0xbd, 0x43, 0x6f,
-	0x27, 0x72, 0x60, 0x47, 0x35, 0x13, 0x6e, 0xe0, 0xdf, 0x04, 0x8e, 0xb6, 0xdc, 0x4b, 0xd0, 0xa9,
-	0xb4, 0xdc, 0x22, 0xee, 0x63, 0xf2, 0xd7, 0x3b, 0x73, 0x42, 0x2e, 0x0c, 0x4e, 0xc5, 0xc3, 0x95,
-	0x29, 0x3a, 0xd1, 0x29, 0x13, 0x76, 0xbc, 0x4b, 0x6c, 0xf2, 0xf4, 0x63, 0x02, 0x83, 0xe1, 0x9b,
-	0x00, 0x3a, 0x99, 0xda, 0x93, 0x6d, 0x57, 0x22, 0xf9, 0xa9, 0x8e, 0x7c, 0x30, 0xd7, 0xeb, 0x3c,
-	0xd7, 0x22, 0xbd, 0x92, 0x02, 0x9b, 0xdf, 0xa2, 0xc8, 0xdb, 0xfc, 0xa7, 0x89, 0x38, 0xa0, 0xac,
-	0xa7, 0x23, 0x6e, 0xbf, 0x48, 0x48, 0x47, 0x1c, 0x21, 0xdd, 0x67, 0x46, 0xcc, 0x6f, 0x25, 0xe4,
-	0x6d, 0xfe, 0xb3, 0x43, 0x3f, 0x20, 0x70, 0x38, 0xa8, 0x83, 0xa7, 0xac, 0x55, 0x11, 0xba, 0x7c,
-	0xca, 0x5a, 0x15, 0x25, 0xb2, 0x4b, 0x17, 0x39, 0xd6, 0x51, 0x5a, 0x48, 0xc6, 0x4a, 0x3f, 0x21,
-	0x70, 0x24, 0xa4, 0x4c, 0xd3, 0xd4, 0x60, 0x6d, 0xa2, 0x79, 0x7e, 0xb2, 0x13, 0x17, 0x04, 0xb8,
-	0xc8, 0x01, 0xce, 0xc6, 0x4f, 0xd9, 0x88, 0x81, 0xee, 0x4b, 0x7c, 0xf2, 0x36, 0x8a, 0xcd, 0x3b,
-	0xf4, 0x4f, 0x04, 0x4e, 0x44, 0x2a, 0xcd, 0x34, 0x75, 0x51, 0x8a, 0x95, 0xbd, 0xf3, 0x2f, 0xef,
-	0xc6, 0x15, 0x33, 0xbb, 0xc5, 0x33, 0x7b, 0x89, 0xde, 0x90, 0xd3, 0xff, 0x7f, 0x86, 0x8c, 0x69,
-	0x04, 0xf2, 0xf9, 0x9e, 0x58, 0x9d, 0xdb, 0x04, 0xe4, 0xf4, 0xd5, 0x39, 0x4e, 0xfd, 0x4e, 0x5f,
-	0x9d, 0x63, 0xd5, 0x6a, 0x69, 0x93, 0x27, 0x63, 0xad, 0xcc, 0xd0, 0xe9, 0x5d, 0x75, 0x94, 0x1d,
-	0xef, 0x17, 0xa4, 0x21, 0x7a, 0x6d, 0x3a, 0xd6, 0xa6, 0x13, 0xd3, 0x1b, 0x19, 0xa6, 0x42, 0x04,
-	0x03, 0xd3, 0x9d, 0xba, 0x61, 0xfa, 0x97, 0x79, 0xfa, 0x17, 0xe8, 0xb9, 0x0c, 0x49, 0xd0, 0x0f,
-	0x09, 0x0c, 0x34, 0xc9, 0xa4, 0x57, 0xb3, 0x91, 0xee, 0x21, 0x2c, 0x66, 0x35, 0x47, 0x64, 0x93,
-	0x1c, 0xd9, 0x15, 0x3a, 0x9e, 0xbd, 0x5b, 0xdc, 0x6d, 0xd3, 0x91, 0x90, 0x4c, 0x4b, 0xb3, 0xac,
-	0x2c, 0x61, 0xe1, 0x38, 0x7d, 0xb2, 0xb7, 0xab, 0xc0, 0xd2, 0x25, 0x0e, 0xf6, 0x2c, 0x1d, 0x49,
-	0x06, 0x6b, 0xd3, 0x77, 0x09, 0xf4, 0x09, 0x51, 0x95, 0x8e, 0x27, 0xc6, 0x09, 0xe9, 0xb8, 0xf9,
-	0xcb, 0x99, 0x6c, 0xb3, 0x2e, 0x8d, 0x42, 0xcd, 0xa5, 0x7f, 0x27, 0x70, 0x2a, 0x41, 0x08, 0xa5,
-	0xb7, 0x13, 0x83, 0xa6, 0x4b, 0xc0, 0xf9, 0x57, 0x76, 0xdf, 0x00, 0xa6, 0xf2, 0x32, 0x4f, 0xe5,
-	0x3a, 0x9d, 0x4c, 0xdc, 0x91, 0xfa, 0x63, 0xb4, 0x1c, 0x90, 0x89, 0xff, 0x40, 0x60, 0x28, 0x4a,
-	0xf9, 0x4a, 0x59, 0x67, 0x12, 0x74, 0xbb, 0x94, 0x75, 0x26, 0x49, 0x66, 0x93, 0xa6, 0x79, 0x26,
-	0xd7, 0x68, 0x31, 0x2e, 0x93, 0x06, 0x7a, 0xcb, 0x21, 0x65, 0x90, 0xfe, 0x87, 0xc0, 0x60, 0x58,
-	0x1c, 0x4b, 0xd9, 0x0f, 0x44, 0x8a, 0x70, 0x29, 0xfb, 0x81, 0x68, 0xf5, 0x4d, 0xb2, 0x38, 0x66,
-	0x7d, 0xe5, 0x06, 0x9d, 0xea, 0x60, 0x6d, 0xf4, 0x12, 0x89, 0x77, 0x6a, 0xa6, 0x1a, 0x31, 0x85,
-	0x7f, 0x47, 0x80, 0xb6, 0x6b, 0x6a, 0x74, 0x3a, 0x23, 0xfe, 0x16, 0x99, 0x2e, 0xff, 0x52, 0xc7,
-	0x7e, 0x59, 0xf7, 0x42, 0x81, 0x24, 0x9a, 0x3a, 0x23, 0xfd, 0x2f, 0x01, 0xf0, 0xa5, 0x0f, 0x9a,
-	0xba, 0xe6, 0x85, 0x45, 0xbd, 0xbc, 0x9c, 0xd9, 0x1e, 0x51, 0xfe, 0x40, 0x9c, 0x2d, 0xde, 0x21,
-	0x2b, 0x09, 0xe7, 0x23, 0x3c, 0x84, 0xcb, 0xdb, 0x42, 0x39, 0xdb, 0x49, 0xaa, 0x75, 0xad, 0xb6,
-	0x2d, 0xc7, 0x87, 0x91, 0x14, 0x3f, 0xfa, 0x54, 0x6c, 0x56, 0xda, 0x85, 0xb4, 0xf4, 0xcd, 0x4a,
-	0xac, 0x38, 0x98, 0xbe, 0x59, 0x89, 0xd7, 0xed, 0xa4, 0x19, 0x4e, 0xd0, 0x24, 0xbd, 0x96, 0x82,
-	0xdc, 0x96, 0x45, 0xc6, 0xcd, 0xcc, 0xa3, 0x52, 0x11, 0x32, 0x56, 0x67, 0xa9, 0x84, 0xa4, 0xb9,
-	0xce, 0x52, 0x09, 0xab, 0x66, 0x1d, 0xa4, 0x22, 0x54, 0x3d, 0x79, 0x5b, 0xfc, 0xee, 0xd0, 0xc7,
-	0x78, 0xa8, 0xf0, 0xe5, 0x27, 0x9a, 0xa5, 0xca, 0xb5, 0x48, 0x62, 0x19, 0x0e, 0x15, 0xed, 0xfa,
-	0x96, 0x34, 0xc6, 0x51, 0x4b, 0x74, 0x34, 0x0d, 0x35, 0xfd, 0x15, 0x81, 0xc1, 0xb0, 0x3e, 0x94,
-	0x82, 0x32, 0x52, 0xac, 0x4a, 0x41, 0x19, 0x2d, 0x40, 0x49, 0x57, 0x38, 0xca, 0x8b, 0xf4, 0x7c,
-	0x62, 0xa1, 0x41, 0xa8, 0x73, 0xec, 0xe9, 0xb3, 0x02, 0xf9, 0xf4, 0x59, 0x81, 0x7c, 0xf6, 0xac,
-	0x40, 0x7e, 0xf8, 0xbc, 0xb0, 0xef, 0xd3, 0xe7, 0x85, 0x7d, 0x7f, 0x7d, 0x5e, 0xd8, 0x07, 0xc3,
-	0x9a, 0x19, 0x13, 0x7e, 0x89, 0xac, 0x14, 0x03, 0x52, 0x91, 0x6f, 0x74, 0x55, 0x33, 0x83, 0x41,
-	0x37, 0x9b, 0x61, 0x57, 0xfb, 0xf8, 0xff, 0x12, 0x9e, 0xfa, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf2, 0x8a, 0xf8, 0x1c, 0xf2, 0x2d, 0x00, 0x00,
+func (m *QueryGetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentRequest.Merge(m, src)
+}
+func (m *QueryGetPaymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetPaymentRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// OrderFeeCalc calculates the fees that will be associated with the provided order.
-	OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error)
-	// GetOrder looks up an order by id.
-	GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error)
-	// GetOrderByExternalID looks up an order by market id and external id.
-	GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error)
-	// GetMarketOrders looks up the orders in a market.
-	GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error)
-	// GetOwnerOrders looks up the orders from the provided owner address.
-	GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error)
-	// GetAssetOrders looks up the orders for a specific asset denom.
-	GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error)
-	// GetAllOrders gets all orders in the exchange module.
-	GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error)
-	// GetCommitment gets the funds in an account that are committed to the market.
-	GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error)
-	// GetAccountCommitments gets all the funds in an account that are committed to any market.
-	GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error)
-	// GetMarketCommitments gets all the funds committed to a market from any account.
-	GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error)
-	// GetAllCommitments gets all fund committed to any market from any account.
-	GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error)
-	// GetMarket returns all the information and details about a market.
-	GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error)
-	// GetAllMarkets returns brief information about each market.
-	GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error)
-	// Params returns the exchange module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
-	CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error)
-	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
-	ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error)
-	// ValidateMarket checks for any problems with a market's setup.
-	ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error)
-	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
-	ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error)
-	// GetPayment gets a single specific payment.
-	GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error)
-	// GetPaymentsWithSource gets all payments with a specific source account.
-	GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error)
-	// GetPaymentsWithTarget gets all payments with a specific target account.
-	GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error)
-	// GetAllPayments gets all payments.
-	GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error)
-	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
-	PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error)
+func (m *QueryGetPaymentRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetPaymentRequest) GetExternalId() string {
+	if m != nil {
+		return m.ExternalId
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryGetPaymentResponse is a response message for the GetPayment query.
+type QueryGetPaymentResponse struct {
+	// payment is the info on the requested payment.
+	Payment *Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment,omitempty"`
 }
 
-func (c *queryClient) OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error) {
-	out := new(QueryOrderFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/OrderFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentResponse) Reset()         { *m = QueryGetPaymentResponse{} }
+func (m *QueryGetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentResponse) ProtoMessage()    {}
+func (*QueryGetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{47}
 }
-
-func (c *queryClient) GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error) {
-	out := new(QueryGetOrderResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentResponse.Merge(m, src)
+}
+func (m *QueryGetPaymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error) {
-	out := new(QueryGetOrderByExternalIDResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderByExternalID", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentResponse) GetPayment() *Payment {
+	if m != nil {
+		return m.Payment
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error) {
-	out := new(QueryGetMarketOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetPaymentsWithSourceRequest is a request message for the GetPaymentsWithSource query.
+type QueryGetPaymentsWithSourceRequest struct {
+	// source is the source account of the payments to get.
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error) {
-	out := new(QueryGetOwnerOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOwnerOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceRequest) Reset()         { *m = QueryGetPaymentsWithSourceRequest{} }
+func (m *QueryGetPaymentsWithSourceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithSourceRequest) ProtoMessage()    {}
+func (*QueryGetPaymentsWithSourceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{48}
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithSourceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithSourceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error) {
-	out := new(QueryGetAssetOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAssetOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentsWithSourceRequest proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithSourceRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error) {
-	out := new(QueryGetAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error) {
-	out := new(QueryGetCommitmentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitment", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetPaymentsWithSourceResponse is a response message for the GetPaymentsWithSource query.
+type QueryGetPaymentsWithSourceResponse struct {
+	// payments is all the payments with the requested source.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error) {
-	out := new(QueryGetAccountCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceResponse) Reset()         { *m = QueryGetPaymentsWithSourceResponse{} }
+func (m *QueryGetPaymentsWithSourceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithSourceResponse) ProtoMessage()    {}
+func (*QueryGetPaymentsWithSourceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{49}
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithSourceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithSourceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error) {
-	out := new(QueryGetMarketCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentsWithSourceResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithSourceResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error) {
-	out := new(QueryGetAllCommitmentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllCommitments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithSourceResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error) {
-	out := new(QueryGetMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetPaymentsWithTargetRequest is a request message for the GetPaymentsWithTarget query.
+type QueryGetPaymentsWithTargetRequest struct {
+	// target is the target account of the payments to get.
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error) {
-	out := new(QueryGetAllMarketsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllMarkets", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithTargetRequest) Reset()         { *m = QueryGetPaymentsWithTargetRequest{} }
+func (m *QueryGetPaymentsWithTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithTargetRequest) ProtoMessage()    {}
+func (*QueryGetPaymentsWithTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{50}
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.Merge(m, src)
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPaymentsWithTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithTargetRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentsWithTargetRequest proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithTargetRequest) GetTarget() string {
+	if m != nil {
+		return m.Target
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error) {
-	out := new(QueryCommitmentSettlementFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithTargetRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error) {
-	out := new(QueryValidateCreateMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateCreateMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetPaymentsWithTargetResponse is a response message for the GetPaymentsWithTarget query.
+type QueryGetPaymentsWithTargetResponse struct {
+	// payments is all the payments with the requested target.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error) {
-	out := new(QueryValidateMarketResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateMarket", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentsWithTargetResponse) Reset()         { *m = QueryGetPaymentsWithTargetResponse{} }
+func (m *QueryGetPaymentsWithTargetResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPaymentsWithTargetResponse) ProtoMessage()    {}
+func (*QueryGetPaymentsWithTargetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{51}
 }
-
-func (c *queryClient) ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error) {
-	out := new(QueryValidateManageFeesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateManageFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error) {
-	out := new(QueryGetPaymentResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error) {
-	out := new(QueryGetPaymentsWithSourceResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithSource", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.Merge(m, src)
 }
-
-func (c *queryClient) GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error) {
-	out := new(QueryGetPaymentsWithTargetResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithTarget", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentsWithTargetResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error) {
-	out := new(QueryGetAllPaymentsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllPayments", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetPaymentsWithTargetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPaymentsWithTargetResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error) {
-	out := new(QueryPaymentFeeCalcResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/PaymentFeeCalc", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPaymentsWithTargetResponse proto.InternalMessageInfo
+
+func (m *QueryGetPaymentsWithTargetResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// OrderFeeCalc calculates the fees that will be associated with the provided order.
-	OrderFeeCalc(context.Context, *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error)
-	// GetOrder looks up an order by id.
-	GetOrder(context.Context, *QueryGetOrderRequest) (*QueryGetOrderResponse, error)
-	// GetOrderByExternalID looks up an order by market id and external id.
-	GetOrderByExternalID(context.Context, *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error)
-	// GetMarketOrders looks up the orders in a market.
-	GetMarketOrders(context.Context, *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error)
-	// GetOwnerOrders looks up the orders from the provided owner address.
-	GetOwnerOrders(context.Context, *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error)
-	// GetAssetOrders looks up the orders for a specific asset denom.
-	GetAssetOrders(context.Context, *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error)
-	// GetAllOrders gets all orders in the exchange module.
-	GetAllOrders(context.Context, *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error)
-	// GetCommitment gets the funds in an account that are committed to the market.
-	GetCommitment(context.Context, *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error)
-	// GetAccountCommitments gets all the funds in an account that are committed to any market.
-	GetAccountCommitments(context.Context, *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error)
-	// GetMarketCommitments gets all the funds committed to a market from any account.
-	GetMarketCommitments(context.Context, *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error)
-	// GetAllCommitments gets all fund committed to any market from any account.
-	GetAllCommitments(context.Context, *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error)
-	// GetMarket returns all the information and details about a market.
-	GetMarket(context.Context, *QueryGetMarketRequest) (*QueryGetMarketResponse, error)
-	// GetAllMarkets returns brief information about each market.
-	GetAllMarkets(context.Context, *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error)
-	// Params returns the exchange module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
-	CommitmentSettlementFeeCalc(context.Context, *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error)
-	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
-	ValidateCreateMarket(context.Context, *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error)
-	// ValidateMarket checks for any problems with a market's setup.
-	ValidateMarket(context.Context, *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error)
-	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
-	ValidateManageFees(context.Context, *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error)
-	// GetPayment gets a single specific payment.
-	GetPayment(context.Context, *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error)
-	// GetPaymentsWithSource gets all payments with a specific source account.
-	GetPaymentsWithSource(context.Context, *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error)
-	// GetPaymentsWithTarget gets all payments with a specific target account.
-	GetPaymentsWithTarget(context.Context, *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error)
-	// GetAllPayments gets all payments.
-	GetAllPayments(context.Context, *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error)
-	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
-	PaymentFeeCalc(context.Context, *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error)
+func (m *QueryGetPaymentsWithTargetResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetAllPaymentsRequest is a request message for the GetAllPayments query.
+type QueryGetAllPaymentsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) OrderFeeCalc(ctx context.Context, req *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OrderFeeCalc not implemented")
-}
-func (*UnimplementedQueryServer) GetOrder(ctx context.Context, req *QueryGetOrderRequest) (*QueryGetOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+func (m *QueryGetAllPaymentsRequest) Reset()         { *m = QueryGetAllPaymentsRequest{} }
+func (m *QueryGetAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllPaymentsRequest) ProtoMessage()    {}
+func (*QueryGetAllPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{52}
 }
-func (*UnimplementedQueryServer) GetOrderByExternalID(ctx context.Context, req *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByExternalID not implemented")
+func (m *QueryGetAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMarketOrders(ctx context.Context, req *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOrders not implemented")
+func (m *QueryGetAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllPaymentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetOwnerOrders(ctx context.Context, req *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerOrders not implemented")
+func (m *QueryGetAllPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllPaymentsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAssetOrders(ctx context.Context, req *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAssetOrders not implemented")
+func (m *QueryGetAllPaymentsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetAllOrders(ctx context.Context, req *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
+func (m *QueryGetAllPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllPaymentsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetCommitment(ctx context.Context, req *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommitment not implemented")
+
+var xxx_messageInfo_QueryGetAllPaymentsRequest proto.InternalMessageInfo
+
+func (m *QueryGetAllPaymentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetAccountCommitments(ctx context.Context, req *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCommitments not implemented")
+
+// QueryGetAllPaymentsResponse is a response message for the GetAllPayments query.
+type QueryGetAllPaymentsResponse struct {
+	// payments is all the payments on this page of results.
+	Payments []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	// pagination is the resulting pagination parameters.
+	Pagination *query.PageResponse `protobuf:"bytes,99,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) GetMarketCommitments(ctx context.Context, req *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCommitments not implemented")
+
+func (m *QueryGetAllPaymentsResponse) Reset()         { *m = QueryGetAllPaymentsResponse{} }
+func (m *QueryGetAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAllPaymentsResponse) ProtoMessage()    {}
+func (*QueryGetAllPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{53}
 }
-func (*UnimplementedQueryServer) GetAllCommitments(ctx context.Context, req *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommitments not implemented")
+func (m *QueryGetAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetMarket(ctx context.Context, req *QueryGetMarketRequest) (*QueryGetMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+func (m *QueryGetAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAllPaymentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetAllMarkets(ctx context.Context, req *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllMarkets not implemented")
+func (m *QueryGetAllPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAllPaymentsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetAllPaymentsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CommitmentSettlementFeeCalc(ctx context.Context, req *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitmentSettlementFeeCalc not implemented")
+func (m *QueryGetAllPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAllPaymentsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ValidateCreateMarket(ctx context.Context, req *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreateMarket not implemented")
+
+var xxx_messageInfo_QueryGetAllPaymentsResponse proto.InternalMessageInfo
+
+func (m *QueryGetAllPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ValidateMarket(ctx context.Context, req *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarket not implemented")
+
+func (m *QueryGetAllPaymentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ValidateManageFees(ctx context.Context, req *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValidateManageFees not implemented")
+
+// QueryPaymentFeeCalcRequest is a request message for the PaymentFeeCalc query.
+type QueryPaymentFeeCalcRequest struct {
+	// payment is the details of the payment to create or accept.
+	Payment Payment `protobuf:"bytes,1,opt,name=payment,proto3" json:"payment"`
 }
-func (*UnimplementedQueryServer) GetPayment(ctx context.Context, req *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+
+func (m *QueryPaymentFeeCalcRequest) Reset()         { *m = QueryPaymentFeeCalcRequest{} }
+func (m *QueryPaymentFeeCalcRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentFeeCalcRequest) ProtoMessage()    {}
+func (*QueryPaymentFeeCalcRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{54}
 }
-func (*UnimplementedQueryServer) GetPaymentsWithSource(ctx context.Context, req *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithSource not implemented")
+func (m *QueryPaymentFeeCalcRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetPaymentsWithTarget(ctx context.Context, req *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithTarget not implemented")
+func (m *QueryPaymentFeeCalcRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentFeeCalcRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetAllPayments(ctx context.Context, req *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAllPayments not implemented")
+func (m *QueryPaymentFeeCalcRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentFeeCalcRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) PaymentFeeCalc(ctx context.Context, req *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PaymentFeeCalc not implemented")
+func (m *QueryPaymentFeeCalcRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPaymentFeeCalcRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentFeeCalcRequest.DiscardUnknown(m)
 }
 
-func _Query_OrderFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOrderFeeCalcRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OrderFeeCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/OrderFeeCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OrderFeeCalc(ctx, req.(*QueryOrderFeeCalcRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryPaymentFeeCalcRequest proto.InternalMessageInfo
 
-func _Query_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOrderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOrder(ctx, req.(*QueryGetOrderRequest))
+func (m *QueryPaymentFeeCalcRequest) GetPayment() Payment {
+	if m != nil {
+		return m.Payment
 	}
-	return interceptor(ctx, in, info, handler)
+	return Payment{}
 }
 
-func _Query_GetOrderByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOrderByExternalIDRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOrderByExternalID(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOrderByExternalID",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOrderByExternalID(ctx, req.(*QueryGetOrderByExternalIDRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryPaymentFeeCalcResponse is a response message for the PaymentFeeCalc query.
+type QueryPaymentFeeCalcResponse struct {
+	// fee_create is the fee required to create the provided payment.
+	FeeCreate github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_create,json=feeCreate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_create"`
+	// fee_accept is the fee required to accept the provided payment.
+	FeeAccept github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_accept,json=feeAccept,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_accept"`
 }
 
-func _Query_GetMarketOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarketOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarketOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarketOrders(ctx, req.(*QueryGetMarketOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentFeeCalcResponse) Reset()         { *m = QueryPaymentFeeCalcResponse{} }
+func (m *QueryPaymentFeeCalcResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentFeeCalcResponse) ProtoMessage()    {}
+func (*QueryPaymentFeeCalcResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_00949b75b1c10bfe, []int{55}
 }
-
-func _Query_GetOwnerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetOwnerOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetOwnerOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetOwnerOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetOwnerOrders(ctx, req.(*QueryGetOwnerOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentFeeCalcResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_GetAssetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAssetOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAssetOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAssetOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAssetOrders(ctx, req.(*QueryGetAssetOrdersRequest))
+func (m *QueryPaymentFeeCalcResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentFeeCalcResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllOrdersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllOrders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllOrders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllOrders(ctx, req.(*QueryGetAllOrdersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentFeeCalcResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentFeeCalcResponse.Merge(m, src)
 }
-
-func _Query_GetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCommitmentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCommitment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetCommitment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCommitment(ctx, req.(*QueryGetCommitmentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryPaymentFeeCalcResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentFeeCalcResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentFeeCalcResponse.DiscardUnknown(m)
 }
 
-func _Query_GetAccountCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAccountCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAccountCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAccountCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAccountCommitments(ctx, req.(*QueryGetAccountCommitmentsRequest))
+var xxx_messageInfo_QueryPaymentFeeCalcResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentFeeCalcResponse) GetFeeCreate() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCreate
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetMarketCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketCommitmentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarketCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarketCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarketCommitments(ctx, req.(*QueryGetMarketCommitmentsRequest))
+func (m *QueryPaymentFeeCalcResponse) GetFeeAccept() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeAccept
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetAllCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllCommitmentsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryOrderFeeCalcRequest)(nil), "provenance.exchange.v1.QueryOrderFeeCalcRequest")
+	proto.RegisterType((*QueryOrderFeeCalcResponse)(nil), "provenance.exchange.v1.QueryOrderFeeCalcResponse")
+	proto.RegisterType((*QueryGetOrderRequest)(nil), "provenance.exchange.v1.QueryGetOrderRequest")
+	proto.RegisterType((*QueryGetOrderResponse)(nil), "provenance.exchange.v1.QueryGetOrderResponse")
+	proto.RegisterType((*QueryGetOrderByExternalIDRequest)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDRequest")
+	proto.RegisterType((*QueryGetOrderByExternalIDResponse)(nil), "provenance.exchange.v1.QueryGetOrderByExternalIDResponse")
+	proto.RegisterType((*QueryGetMarketOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketOrdersRequest")
+	proto.RegisterType((*QueryGetMarketOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketOrdersResponse")
+	proto.RegisterType((*QueryGetOwnerOrdersRequest)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersRequest")
+	proto.RegisterType((*QueryGetOwnerOrdersResponse)(nil), "provenance.exchange.v1.QueryGetOwnerOrdersResponse")
+	proto.RegisterType((*QueryGetAssetOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAssetOrdersRequest")
+	proto.RegisterType((*QueryGetAssetOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAssetOrdersResponse")
+	proto.RegisterType((*QueryGetAllOrdersRequest)(nil), "provenance.exchange.v1.QueryGetAllOrdersRequest")
+	proto.RegisterType((*QueryGetAllOrdersResponse)(nil), "provenance.exchange.v1.QueryGetAllOrdersResponse")
+	proto.RegisterType((*QueryGetOrderBookRequest)(nil), "provenance.exchange.v1.QueryGetOrderBookRequest")
+	proto.RegisterType((*QueryGetOrderBookResponse)(nil), "provenance.exchange.v1.QueryGetOrderBookResponse")
+	proto.RegisterType((*QueryGetMarketTradesRequest)(nil), "provenance.exchange.v1.QueryGetMarketTradesRequest")
+	proto.RegisterType((*QueryGetMarketTradesResponse)(nil), "provenance.exchange.v1.QueryGetMarketTradesResponse")
+	proto.RegisterType((*QueryGetMarketCandlesRequest)(nil), "provenance.exchange.v1.QueryGetMarketCandlesRequest")
+	proto.RegisterType((*QueryGetMarketCandlesResponse)(nil), "provenance.exchange.v1.QueryGetMarketCandlesResponse")
+	proto.RegisterType((*QueryGetConditionalOrderRequest)(nil), "provenance.exchange.v1.QueryGetConditionalOrderRequest")
+	proto.RegisterType((*QueryGetConditionalOrderResponse)(nil), "provenance.exchange.v1.QueryGetConditionalOrderResponse")
+	proto.RegisterType((*QueryGetMarketConditionalOrdersRequest)(nil), "provenance.exchange.v1.QueryGetMarketConditionalOrdersRequest")
+	proto.RegisterType((*QueryGetMarketConditionalOrdersResponse)(nil), "provenance.exchange.v1.QueryGetMarketConditionalOrdersResponse")
+	proto.RegisterType((*QueryGetCommitmentRequest)(nil), "provenance.exchange.v1.QueryGetCommitmentRequest")
+	proto.RegisterType((*QueryGetCommitmentResponse)(nil), "provenance.exchange.v1.QueryGetCommitmentResponse")
+	proto.RegisterType((*QueryGetAccountCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsRequest")
+	proto.RegisterType((*QueryGetAccountCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAccountCommitmentsResponse")
+	proto.RegisterType((*QueryGetMarketCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsRequest")
+	proto.RegisterType((*QueryGetMarketCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetMarketCommitmentsResponse")
+	proto.RegisterType((*QueryGetAllCommitmentsRequest)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsRequest")
+	proto.RegisterType((*QueryGetAllCommitmentsResponse)(nil), "provenance.exchange.v1.QueryGetAllCommitmentsResponse")
+	proto.RegisterType((*QueryGetMarketRequest)(nil), "provenance.exchange.v1.QueryGetMarketRequest")
+	proto.RegisterType((*QueryGetMarketResponse)(nil), "provenance.exchange.v1.QueryGetMarketResponse")
+	proto.RegisterType((*QueryGetAllMarketsRequest)(nil), "provenance.exchange.v1.QueryGetAllMarketsRequest")
+	proto.RegisterType((*QueryGetAllMarketsResponse)(nil), "provenance.exchange.v1.QueryGetAllMarketsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.exchange.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.exchange.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCommitmentSettlementFeeCalcRequest)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcRequest")
+	proto.RegisterType((*QueryCommitmentSettlementFeeCalcResponse)(nil), "provenance.exchange.v1.QueryCommitmentSettlementFeeCalcResponse")
+	proto.RegisterType((*QueryValidateCreateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateCreateMarketRequest")
+	proto.RegisterType((*QueryValidateCreateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateCreateMarketResponse")
+	proto.RegisterType((*QueryValidateMarketRequest)(nil), "provenance.exchange.v1.QueryValidateMarketRequest")
+	proto.RegisterType((*QueryValidateMarketResponse)(nil), "provenance.exchange.v1.QueryValidateMarketResponse")
+	proto.RegisterType((*QueryValidateManageFeesRequest)(nil), "provenance.exchange.v1.QueryValidateManageFeesRequest")
+	proto.RegisterType((*QueryValidateManageFeesResponse)(nil), "provenance.exchange.v1.QueryValidateManageFeesResponse")
+	proto.RegisterType((*QueryGetPaymentRequest)(nil), "provenance.exchange.v1.QueryGetPaymentRequest")
+	proto.RegisterType((*QueryGetPaymentResponse)(nil), "provenance.exchange.v1.QueryGetPaymentResponse")
+	proto.RegisterType((*QueryGetPaymentsWithSourceRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceRequest")
+	proto.RegisterType((*QueryGetPaymentsWithSourceResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithSourceResponse")
+	proto.RegisterType((*QueryGetPaymentsWithTargetRequest)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetRequest")
+	proto.RegisterType((*QueryGetPaymentsWithTargetResponse)(nil), "provenance.exchange.v1.QueryGetPaymentsWithTargetResponse")
+	proto.RegisterType((*QueryGetAllPaymentsRequest)(nil), "provenance.exchange.v1.QueryGetAllPaymentsRequest")
+	proto.RegisterType((*QueryGetAllPaymentsResponse)(nil), "provenance.exchange.v1.QueryGetAllPaymentsResponse")
+	proto.RegisterType((*QueryPaymentFeeCalcRequest)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcRequest")
+	proto.RegisterType((*QueryPaymentFeeCalcResponse)(nil), "provenance.exchange.v1.QueryPaymentFeeCalcResponse")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/query.proto", fileDescriptor_00949b75b1c10bfe)
+}
+
+var fileDescriptor_00949b75b1c10bfe = []byte{
+	// 2892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0x75, 0x12, 0xc7, 0x3e, 0x49, 0xdd, 0xe6, 0xc6, 0x29, 0xeb, 0x49, 0x63, 0x3b, 0xd3,
+	0x34, 0xb5, 0xdc, 0x64, 0x27, 0xb6, 0x13, 0x37, 0x09, 0x4d, 0x53, 0xdb, 0xc5, 0x51, 0xa4, 0x7e,
+	0xb8, 0xdb, 0x40, 0x2a, 0x4b, 0xb0, 0x9d, 0xdd, 0xbd, 0xde, 0x0c, 0x9e, 0x9d, 0xd9, 0xce, 0x8c,
+	0xb7, 0xb6, 0x2c, 0x4b, 0xa5, 0x7c, 0x54, 0xad, 0x04, 0x42, 0x42, 0x88, 0x42, 0x45, 0xfb, 0x50,
+	0xa4, 0xa2, 0xbe, 0xb4, 0x0f, 0xf0, 0x84, 0x50, 0x1f, 0x90, 0xa0, 0x12, 0x42, 0xaa, 0xe0, 0x05,
+	0x24, 0x04, 0x55, 0x0a, 0xf4, 0x05, 0x1e, 0xf8, 0x07, 0x10, 0x9a, 0x7b, 0xcf, 0xdd, 0x9d, 0xd9,
+	0x9d, 0x4f, 0xd7, 0xb5, 0xfc, 0x12, 0xef, 0xde, 0x39, 0xbf, 0x7b, 0x7e, 0xe7, 0x77, 0x3f, 0xe7,
+	0x9c, 0x0d, 0xa8, 0x4d, 0xc7, 0x6e, 0x31, 0x4b, 0xb7, 0xaa, 0x4c, 0x63, 0xeb, 0xd5, 0xdb, 0xba,
+	0x55, 0x67, 0x5a, 0x6b, 0x4a, 0x7b, 0x61, 0x8d, 0x39, 0x1b, 0xc5, 0xa6, 0x63, 0x7b, 0x36, 0xbd,
+	0xb7, 0x63, 0x53, 0x94, 0x36, 0xc5, 0xd6, 0x94, 0x72, 0x54, 0x6f, 0x18, 0x96, 0xad, 0xf1, 0x7f,
+	0x85, 0xa9, 0x32, 0x52, 0xb5, 0xdd, 0x86, 0xed, 0x96, 0xf9, 0x37, 0x4d, 0x7c, 0xc1, 0x47, 0x93,
+	0xe2, 0x9b, 0x56, 0xd1, 0x5d, 0x26, 0xba, 0xd7, 0x5a, 0x53, 0x15, 0xe6, 0xe9, 0x53, 0x5a, 0x53,
+	0xaf, 0x1b, 0x96, 0xee, 0x19, 0xb6, 0x85, 0xb6, 0xa3, 0x41, 0x5b, 0x69, 0x55, 0xb5, 0x0d, 0xf9,
+	0xfc, 0xbe, 0xba, 0x6d, 0xd7, 0x4d, 0xa6, 0xe9, 0x4d, 0x43, 0xd3, 0x2d, 0xcb, 0xf6, 0x38, 0x58,
+	0x7a, 0x1a, 0xae, 0xdb, 0x75, 0x5b, 0x30, 0xf0, 0x3f, 0x61, 0xeb, 0x44, 0x4c, 0xa4, 0x55, 0xbb,
+	0xd1, 0x30, 0xbc, 0x06, 0xb3, 0x3c, 0x89, 0xbf, 0x3f, 0xc6, 0xb2, 0xa1, 0x3b, 0xab, 0xcc, 0x4b,
+	0x31, 0xb2, 0x9d, 0x1a, 0x73, 0xd2, 0x7a, 0x6a, 0xea, 0x8e, 0xde, 0x90, 0x46, 0x0f, 0xc4, 0x1a,
+	0x6d, 0x64, 0x61, 0xe5, 0x39, 0x7a, 0x8d, 0x49, 0xa3, 0xb1, 0x38, 0xa3, 0x75, 0x61, 0xa0, 0xbe,
+	0x4e, 0xa0, 0xf0, 0x8c, 0x2f, 0xfe, 0xd3, 0x3e, 0xcf, 0x45, 0xc6, 0x16, 0x74, 0xb3, 0x5a, 0x62,
+	0x2f, 0xac, 0x31, 0xd7, 0xa3, 0x57, 0x61, 0x50, 0x77, 0x57, 0xcb, 0x3c, 0x84, 0x42, 0xdf, 0x38,
+	0x99, 0x38, 0x3c, 0x3d, 0x5e, 0x8c, 0x1e, 0xfc, 0xe2, 0x9c, 0xbb, 0xca, 0xbb, 0x28, 0x0d, 0xe8,
+	0xf8, 0xc9, 0x87, 0x57, 0x8c, 0x1a, 0xc2, 0xf7, 0x27, 0xc3, 0xe7, 0x8d, 0x1a, 0xc2, 0x2b, 0xf8,
+	0x49, 0x7d, 0xbf, 0x0f, 0x46, 0x22, 0xa8, 0xb9, 0x4d, 0xdb, 0x72, 0x19, 0x7d, 0x06, 0x86, 0xab,
+	0x0e, 0xe3, 0xe3, 0x5c, 0x5e, 0x61, 0xac, 0x6c, 0x37, 0xfd, 0x8f, 0x6e, 0x81, 0x8c, 0xef, 0x9f,
+	0x38, 0x3c, 0x3d, 0x52, 0xc4, 0xb9, 0xe6, 0xcf, 0x98, 0x22, 0xce, 0x98, 0xe2, 0x82, 0x6d, 0x58,
+	0xf3, 0x07, 0x3e, 0xfc, 0xdb, 0xd8, 0xbe, 0x12, 0x95, 0xe0, 0x45, 0xc6, 0x9e, 0x16, 0x50, 0xfa,
+	0x35, 0x38, 0xe1, 0x32, 0xcf, 0x33, 0x99, 0x2f, 0x73, 0x79, 0xc5, 0xd4, 0xbd, 0x50, 0xcf, 0x7d,
+	0xd9, 0x7a, 0x2e, 0x74, 0xfa, 0x58, 0x34, 0x75, 0x2f, 0xd0, 0xff, 0xf3, 0x70, 0x5f, 0xa0, 0x7f,
+	0xc7, 0x77, 0x1f, 0x72, 0xb0, 0x3f, 0x9b, 0x83, 0x91, 0x4e, 0x27, 0x25, 0xbf, 0x8f, 0x8e, 0x07,
+	0x75, 0x0a, 0x86, 0xb9, 0x62, 0xd7, 0x99, 0x27, 0xd4, 0xc4, 0x81, 0x1c, 0x81, 0x01, 0x3e, 0x0a,
+	0x65, 0xa3, 0x56, 0x20, 0xe3, 0x64, 0xe2, 0x40, 0xe9, 0x10, 0xff, 0x7e, 0xa3, 0xa6, 0x3e, 0x01,
+	0xc7, 0xbb, 0x20, 0x28, 0xf0, 0x0c, 0x1c, 0x14, 0x23, 0x47, 0xf8, 0xc8, 0x9d, 0x8c, 0x1b, 0x39,
+	0x81, 0x12, 0xb6, 0xea, 0xf3, 0x30, 0x1e, 0xea, 0x6d, 0x7e, 0xe3, 0x4b, 0xeb, 0x1e, 0x73, 0x2c,
+	0xdd, 0xbc, 0xf1, 0xb8, 0x24, 0x73, 0x02, 0x06, 0xc5, 0xca, 0x91, 0x6c, 0xee, 0x2a, 0x0d, 0x88,
+	0x86, 0x1b, 0x35, 0x3a, 0x06, 0x87, 0x19, 0x22, 0xfc, 0xc7, 0xfe, 0xa4, 0x1b, 0x2c, 0x81, 0x6c,
+	0xba, 0x51, 0x53, 0x9f, 0x83, 0x53, 0x09, 0x1e, 0x3e, 0x0b, 0xf7, 0xdf, 0x11, 0x38, 0x21, 0xbb,
+	0x7e, 0x92, 0xf3, 0xe1, 0x8f, 0xdd, 0x4c, 0xbc, 0x4f, 0x02, 0x08, 0x85, 0xbd, 0x8d, 0x26, 0x43,
+	0xda, 0x83, 0xbc, 0xe5, 0xe6, 0x46, 0x93, 0xd1, 0xd3, 0x30, 0xa4, 0xaf, 0x78, 0xcc, 0x29, 0xb7,
+	0x87, 0x61, 0x3f, 0x1f, 0x86, 0x23, 0xbc, 0xf5, 0x69, 0x31, 0x16, 0x74, 0x11, 0xa0, 0xb3, 0xf5,
+	0x15, 0xaa, 0x9c, 0xfb, 0x99, 0xd0, 0x74, 0x10, 0xdb, 0xb0, 0x9c, 0x14, 0x4b, 0x7a, 0x9d, 0x21,
+	0xbb, 0x52, 0x00, 0xa9, 0xbe, 0x49, 0xe0, 0xbe, 0xe8, 0x48, 0x50, 0x9f, 0x8b, 0xd0, 0x2f, 0xf6,
+	0x25, 0x5c, 0x2e, 0x29, 0x02, 0xa1, 0x31, 0xbd, 0x1e, 0xc1, 0xef, 0xc1, 0x54, 0x7e, 0xc2, 0x67,
+	0x88, 0xe0, 0x5f, 0x08, 0x28, 0xed, 0x51, 0x7c, 0xd1, 0x62, 0x4e, 0x58, 0xe9, 0x22, 0x1c, 0xb4,
+	0xfd, 0x56, 0xae, 0xf2, 0xe0, 0x7c, 0xe1, 0x8f, 0xbf, 0x38, 0x37, 0x8c, 0x5e, 0xe6, 0x6a, 0x35,
+	0x87, 0xb9, 0xee, 0xb3, 0x9e, 0x63, 0x58, 0xf5, 0x92, 0x30, 0xdb, 0x5b, 0xe2, 0xff, 0x34, 0x30,
+	0x8d, 0x42, 0xb1, 0xed, 0x11, 0xed, 0x3f, 0x08, 0x68, 0x3f, 0xe7, 0xba, 0xdd, 0xb3, 0x7c, 0x18,
+	0x0e, 0xea, 0x7e, 0xab, 0xd0, 0xbe, 0x24, 0xbe, 0xec, 0x5d, 0x85, 0x43, 0x11, 0xec, 0x11, 0x85,
+	0x2b, 0x50, 0x68, 0xd3, 0x33, 0xcd, 0xb0, 0xbc, 0x3b, 0xa5, 0xc1, 0x1b, 0x04, 0x46, 0x22, 0x9c,
+	0xec, 0x11, 0x05, 0xda, 0xb7, 0x8a, 0xf6, 0x2e, 0x6d, 0xdb, 0xab, 0x59, 0xf7, 0x7f, 0x3e, 0xe3,
+	0xca, 0x35, 0x66, 0xd9, 0x0d, 0xb9, 0xff, 0xf3, 0xa6, 0xc7, 0xfd, 0x16, 0xdf, 0xa0, 0xe9, 0x18,
+	0x55, 0x86, 0x06, 0xfb, 0x85, 0x01, 0x6f, 0x12, 0x06, 0x27, 0x01, 0x1a, 0xfa, 0x7a, 0xd9, 0x64,
+	0x2d, 0x66, 0xba, 0x85, 0x03, 0xbc, 0xff, 0xc1, 0x86, 0xbe, 0xfe, 0x04, 0x6f, 0x50, 0x7f, 0xd8,
+	0x07, 0x23, 0x11, 0xd4, 0x50, 0xb8, 0x47, 0xe0, 0x80, 0xee, 0xae, 0x4a, 0xd9, 0xd4, 0x38, 0xd9,
+	0x96, 0x7c, 0x77, 0xbc, 0x43, 0x3c, 0x93, 0x39, 0xca, 0x47, 0x57, 0x8c, 0x9a, 0xbc, 0x29, 0xe4,
+	0x40, 0xfb, 0x28, 0x7a, 0x15, 0x06, 0x2a, 0xcc, 0xf5, 0xca, 0xba, 0xbb, 0x8a, 0xb7, 0xa5, 0x0c,
+	0x3d, 0x94, 0x0e, 0xf9, 0x98, 0x39, 0x77, 0xb5, 0x0d, 0xaf, 0x18, 0xb5, 0xc2, 0x81, 0x7c, 0xf0,
+	0x79, 0xa3, 0xa6, 0xbe, 0xdc, 0x73, 0xfa, 0xdd, 0xe4, 0x17, 0xc9, 0x4c, 0xa3, 0xf6, 0xf9, 0x1d,
+	0x5c, 0x92, 0x44, 0x67, 0x62, 0x8b, 0xfb, 0x6d, 0xda, 0xc4, 0xe6, 0xb8, 0x12, 0x1a, 0xef, 0xdc,
+	0xc4, 0xbe, 0xd3, 0x43, 0x70, 0x41, 0xb7, 0x6a, 0x26, 0x73, 0x77, 0x69, 0x72, 0xcf, 0xc3, 0x80,
+	0x61, 0x79, 0xcc, 0x69, 0xe9, 0x26, 0x1f, 0xe4, 0xa1, 0xe9, 0x33, 0x71, 0x0a, 0x08, 0x62, 0x37,
+	0xd0, 0xba, 0xd4, 0xc6, 0xf9, 0x4e, 0xfc, 0x05, 0x52, 0x15, 0xc4, 0x0b, 0x07, 0x39, 0x49, 0x7f,
+	0xcd, 0x60, 0x28, 0x6a, 0x19, 0x4e, 0xc6, 0xc4, 0x88, 0xa3, 0xf0, 0x28, 0x1c, 0x92, 0x68, 0x31,
+	0x0c, 0xa3, 0xc9, 0x24, 0x70, 0x9a, 0x4b, 0x90, 0xfa, 0x08, 0x8c, 0x49, 0x07, 0x0b, 0xb6, 0x55,
+	0x33, 0x7c, 0x69, 0x75, 0x33, 0xeb, 0x8d, 0x75, 0x03, 0xc6, 0xe3, 0xd1, 0xc8, 0xf0, 0xcb, 0x70,
+	0xb4, 0xda, 0x79, 0x56, 0x0e, 0x5e, 0x06, 0x27, 0x62, 0xb9, 0x76, 0x77, 0x76, 0x4f, 0xb5, 0xab,
+	0x45, 0xfd, 0x2e, 0x81, 0x33, 0x5d, 0xd2, 0x74, 0x99, 0xec, 0xee, 0x7a, 0xf9, 0x3d, 0x81, 0x07,
+	0x53, 0xf9, 0xa0, 0x24, 0xb7, 0x80, 0xf6, 0x48, 0x22, 0xc7, 0x2f, 0xbb, 0x26, 0x47, 0xbb, 0x35,
+	0xd9, 0xc1, 0xc5, 0x65, 0x76, 0x76, 0xe6, 0x85, 0xf6, 0x4b, 0xb8, 0xd4, 0x73, 0x1a, 0x0e, 0xe9,
+	0xd5, 0xaa, 0xbd, 0x66, 0x79, 0xa9, 0xb7, 0x42, 0x69, 0x18, 0x1e, 0x83, 0xbe, 0xf0, 0x18, 0xa8,
+	0x3f, 0x0a, 0xdc, 0x83, 0x82, 0xee, 0x50, 0xae, 0x0d, 0xe8, 0xd7, 0x1b, 0xe8, 0x2e, 0xe5, 0xb5,
+	0x6c, 0xd1, 0x9f, 0xdd, 0xef, 0xfe, 0x7d, 0x6c, 0xa2, 0x6e, 0x78, 0xb7, 0xd7, 0x2a, 0xc5, 0xaa,
+	0xdd, 0xc0, 0x54, 0x07, 0xfe, 0x39, 0xe7, 0xd6, 0x56, 0x35, 0xff, 0xe6, 0xe4, 0x72, 0x80, 0xfb,
+	0x93, 0x4f, 0xdf, 0x9f, 0x3c, 0x62, 0xb2, 0xba, 0x5e, 0xdd, 0x28, 0xfb, 0x59, 0x0c, 0xf7, 0xe7,
+	0x9f, 0xbe, 0x3f, 0x49, 0x4a, 0xe8, 0x50, 0xbd, 0xd5, 0x79, 0xc5, 0x99, 0x13, 0x91, 0x74, 0xf8,
+	0xb9, 0x9f, 0x41, 0x0f, 0xd5, 0x04, 0x35, 0xa9, 0x63, 0x8c, 0x7c, 0x11, 0x0e, 0x07, 0x72, 0x20,
+	0x18, 0xfe, 0xe9, 0xb8, 0x19, 0x22, 0xa6, 0xdd, 0x1c, 0x67, 0x5e, 0x0a, 0x02, 0xd5, 0x57, 0x48,
+	0x67, 0xa1, 0xca, 0xc9, 0xd9, 0x13, 0xc6, 0xae, 0x2c, 0x93, 0x5f, 0x12, 0x38, 0x95, 0xc0, 0x04,
+	0xe3, 0xbe, 0x1e, 0x15, 0xf7, 0x03, 0xb1, 0xf9, 0x0e, 0x21, 0x60, 0x44, 0xe0, 0x3b, 0xb7, 0x20,
+	0xea, 0x9d, 0x8d, 0x78, 0xce, 0x34, 0x23, 0xd4, 0xdb, 0x29, 0x81, 0xde, 0x23, 0x30, 0x1a, 0xe7,
+	0x09, 0xd5, 0x79, 0x3c, 0x4a, 0x1d, 0x35, 0x7e, 0xdf, 0x68, 0x2f, 0xa8, 0xcf, 0x47, 0x9a, 0x0b,
+	0x70, 0x3c, 0x3c, 0xa2, 0x59, 0x26, 0x94, 0xfa, 0x2d, 0x02, 0xf7, 0x76, 0xc3, 0x30, 0x3e, 0x7f,
+	0x3d, 0x89, 0x55, 0x93, 0x61, 0x3d, 0x89, 0xaf, 0x74, 0x16, 0xfa, 0x45, 0xd7, 0x98, 0x1c, 0x1b,
+	0x4d, 0x5e, 0x24, 0x25, 0xb4, 0x56, 0xab, 0xa1, 0xbb, 0xbb, 0x78, 0xb8, 0xe3, 0x63, 0xfa, 0xb3,
+	0xe0, 0x7b, 0x5e, 0xc0, 0x0b, 0xc6, 0x7b, 0x15, 0x0e, 0x09, 0x36, 0x72, 0x2c, 0xef, 0x4f, 0x26,
+	0x3f, 0xef, 0x18, 0x6c, 0xa5, 0x24, 0x31, 0x3b, 0x37, 0x90, 0xc3, 0x40, 0x39, 0xcb, 0x25, 0x9e,
+	0x02, 0xc5, 0x40, 0xd4, 0x27, 0xe1, 0x58, 0xa8, 0x15, 0x49, 0xcf, 0x42, 0xbf, 0x48, 0x95, 0x16,
+	0x48, 0xb2, 0xe0, 0x88, 0x43, 0x6b, 0xf5, 0xd7, 0xf2, 0x9c, 0xec, 0xcc, 0xcb, 0x67, 0x3b, 0x59,
+	0xba, 0x70, 0xd2, 0xf3, 0x39, 0x80, 0x4e, 0x82, 0x0d, 0xfd, 0x5c, 0x8a, 0xd5, 0xc6, 0xad, 0x77,
+	0x6f, 0x28, 0xa2, 0xe3, 0xf6, 0x88, 0x74, 0xfa, 0xa2, 0x97, 0xa0, 0x60, 0x58, 0x55, 0x73, 0xad,
+	0xc6, 0xca, 0x15, 0x87, 0xe9, 0xab, 0x35, 0xfb, 0x45, 0xab, 0xbc, 0x62, 0x30, 0x93, 0xbf, 0x32,
+	0x90, 0x89, 0x81, 0xd2, 0xbd, 0xf8, 0x7c, 0x5e, 0x3e, 0x5e, 0xe4, 0x4f, 0xd5, 0x8f, 0x0f, 0xc0,
+	0x44, 0x3a, 0x7f, 0x14, 0xe9, 0x3b, 0x04, 0xee, 0x92, 0x1c, 0xfd, 0xfc, 0xa2, 0xbb, 0x7b, 0x27,
+	0xd8, 0x11, 0xe9, 0x77, 0x91, 0x31, 0x97, 0xbe, 0x4c, 0xe0, 0xb0, 0x61, 0x35, 0xd7, 0xbc, 0xb2,
+	0x67, 0x7b, 0xba, 0x59, 0xe8, 0xdb, 0x2d, 0x1a, 0xc0, 0xbd, 0xde, 0xf4, 0x9d, 0xd2, 0xd7, 0x08,
+	0xdc, 0x5d, 0xb5, 0xad, 0x16, 0x73, 0x3c, 0x56, 0x43, 0x22, 0xfb, 0x77, 0x8b, 0xc8, 0x50, 0xdb,
+	0xb3, 0x20, 0x73, 0x53, 0x72, 0x71, 0xfd, 0xb4, 0xb5, 0xa5, 0xb7, 0xfc, 0x17, 0xd4, 0xc4, 0x63,
+	0xe6, 0x29, 0x4c, 0x71, 0xf0, 0x57, 0x36, 0xbc, 0x47, 0x0f, 0x75, 0xfa, 0x78, 0x4a, 0x6f, 0xb9,
+	0x74, 0x01, 0xc0, 0x13, 0x99, 0x64, 0x4b, 0x6f, 0xf1, 0xfb, 0x7c, 0xd6, 0x0e, 0x4b, 0x03, 0x9e,
+	0xbd, 0xc8, 0xd8, 0x53, 0x7a, 0x4b, 0x7d, 0x55, 0x9e, 0xd6, 0x5f, 0xd1, 0x4d, 0xa3, 0xa6, 0x7b,
+	0x6c, 0xc1, 0x61, 0xba, 0xc7, 0xc2, 0x9b, 0x2b, 0x83, 0xe3, 0x3c, 0x6f, 0xce, 0xca, 0xb8, 0xc7,
+	0x3a, 0xe2, 0x01, 0x2e, 0x93, 0xa9, 0x84, 0x65, 0x72, 0xdd, 0x6e, 0x45, 0xf4, 0x58, 0x3a, 0x56,
+	0xed, 0x6d, 0x54, 0x57, 0xe0, 0x54, 0x02, 0x15, 0x9c, 0xe6, 0xc3, 0x70, 0x90, 0x39, 0x8e, 0xed,
+	0xc8, 0x44, 0x15, 0xff, 0x42, 0x1f, 0x02, 0x5a, 0xb7, 0x5b, 0x7e, 0xbd, 0xa9, 0x59, 0x7e, 0xd1,
+	0x30, 0xcd, 0x72, 0x53, 0x77, 0xe5, 0xea, 0xba, 0xbb, 0x6e, 0xb7, 0x96, 0x1c, 0xbb, 0x79, 0xcb,
+	0x30, 0xcd, 0x25, 0xdd, 0x75, 0xd5, 0xcb, 0xa0, 0x84, 0xfc, 0xe4, 0x38, 0x49, 0x66, 0xe0, 0x44,
+	0x24, 0x34, 0x89, 0x9c, 0xfa, 0x0d, 0x79, 0xcc, 0x76, 0x50, 0x96, 0x2e, 0x16, 0x8b, 0x74, 0x5a,
+	0x86, 0x63, 0x0d, 0xde, 0xc8, 0x57, 0x6e, 0x97, 0xbe, 0x5a, 0xb2, 0xbe, 0x3d, 0xbd, 0x95, 0x8e,
+	0x36, 0xba, 0x9b, 0xd4, 0x1a, 0x8c, 0xc5, 0x52, 0xd8, 0x39, 0x65, 0x57, 0x3b, 0xe7, 0xec, 0x92,
+	0x28, 0x5b, 0xc9, 0x00, 0xcf, 0x43, 0xbf, 0x6b, 0xaf, 0x39, 0x55, 0x96, 0x7a, 0xcc, 0xa2, 0x5d,
+	0x7a, 0x49, 0xe0, 0x26, 0x7c, 0xa1, 0xc7, 0x19, 0x86, 0x72, 0x19, 0x0e, 0x61, 0xd9, 0x0c, 0x25,
+	0x1c, 0x8b, 0x3f, 0x31, 0x04, 0x52, 0xda, 0xfb, 0x59, 0xc6, 0x53, 0x5d, 0xdd, 0xba, 0xb7, 0x0c,
+	0xef, 0xf6, 0xb3, 0x9c, 0xd5, 0xf6, 0xc3, 0xd9, 0xa9, 0xf3, 0xfd, 0x5d, 0x02, 0x6a, 0x12, 0x3f,
+	0x54, 0xe0, 0x8b, 0x30, 0x80, 0x11, 0xc9, 0x73, 0x20, 0x55, 0x82, 0x36, 0x60, 0xe7, 0x4e, 0xf9,
+	0x38, 0x31, 0x6f, 0xea, 0x4e, 0x9d, 0x05, 0xe7, 0x86, 0xc7, 0x1b, 0xd2, 0xc5, 0x14, 0x76, 0x9f,
+	0xbb, 0x98, 0x92, 0xdf, 0x9e, 0x12, 0xb3, 0x16, 0xba, 0xd8, 0x49, 0xba, 0x3b, 0x7d, 0x7f, 0x7c,
+	0x3b, 0x98, 0x65, 0x0f, 0xba, 0xd9, 0x53, 0x5a, 0x7c, 0x15, 0xb5, 0x40, 0x17, 0x5d, 0x77, 0xb9,
+	0x6b, 0x79, 0x97, 0xbf, 0xcc, 0x54, 0xc9, 0x4d, 0xe0, 0xed, 0x3e, 0x14, 0xa1, 0xbb, 0x7f, 0x14,
+	0xe1, 0x25, 0x02, 0xe0, 0x1f, 0xbc, 0xe2, 0x14, 0xdb, 0xbd, 0x8b, 0xd6, 0xe0, 0x0a, 0xc3, 0x53,
+	0xb1, 0x4d, 0x41, 0xaf, 0x56, 0x59, 0xd3, 0x2b, 0xf4, 0xed, 0x26, 0x85, 0x39, 0xee, 0x73, 0xfa,
+	0x9f, 0x67, 0xe1, 0x20, 0x57, 0x89, 0xbe, 0x45, 0xe0, 0x48, 0xb0, 0x5c, 0x4f, 0xcf, 0xc7, 0x09,
+	0x1e, 0xf7, 0xa3, 0x03, 0x65, 0x2a, 0x07, 0x42, 0x8c, 0x82, 0x3a, 0xf9, 0xf2, 0x9f, 0xfe, 0xf1,
+	0x83, 0xbe, 0xd3, 0x54, 0xd5, 0x62, 0x7e, 0xee, 0xe0, 0x9f, 0xa5, 0xe2, 0x97, 0x18, 0xf4, 0xc7,
+	0x04, 0x06, 0x64, 0xea, 0x9f, 0x9e, 0x4d, 0xf4, 0xd5, 0x55, 0x45, 0x57, 0xce, 0x65, 0xb4, 0x46,
+	0x56, 0xe7, 0x39, 0xab, 0x49, 0x3a, 0xa1, 0x25, 0xfd, 0x34, 0x44, 0xdb, 0x94, 0x79, 0xce, 0x2d,
+	0xfa, 0x7a, 0x1f, 0x0c, 0x47, 0xd5, 0xb5, 0xe9, 0xa5, 0x4c, 0x9e, 0x23, 0x8a, 0xed, 0xca, 0xe5,
+	0x6d, 0x20, 0x91, 0xff, 0x6b, 0x84, 0x07, 0xf0, 0x4d, 0xb2, 0xfc, 0x18, 0x7d, 0x54, 0x4b, 0xfc,
+	0x0d, 0x8c, 0xb6, 0xd9, 0xbe, 0x29, 0x6d, 0xc9, 0xb0, 0x02, 0x67, 0xf6, 0x16, 0xbd, 0x96, 0xa8,
+	0x81, 0x1b, 0xd5, 0x4d, 0xb8, 0x83, 0x7f, 0x13, 0xb8, 0xbb, 0xab, 0x9a, 0x4d, 0x67, 0xd2, 0x62,
+	0x8b, 0xa8, 0xe2, 0x2b, 0x17, 0xf2, 0x81, 0x50, 0x0b, 0x8b, 0x4b, 0x71, 0x7b, 0x79, 0x86, 0x4e,
+	0xe5, 0x55, 0xc2, 0x8d, 0x87, 0xc4, 0x06, 0x4f, 0xdf, 0x23, 0x30, 0x14, 0xae, 0x1f, 0xd3, 0xe9,
+	0xd4, 0x91, 0xec, 0x29, 0xa4, 0x2b, 0x33, 0xb9, 0x30, 0x18, 0xeb, 0x05, 0x1e, 0x6b, 0x91, 0x9e,
+	0x4d, 0xa1, 0xcd, 0x6b, 0xef, 0xda, 0x26, 0xff, 0xd3, 0x66, 0x1c, 0xa8, 0xc7, 0xa6, 0x33, 0xee,
+	0x2d, 0x3f, 0x2b, 0x33, 0xb9, 0x30, 0x39, 0x19, 0xf3, 0x4a, 0x8b, 0xb6, 0xc9, 0xff, 0x6c, 0xd1,
+	0x37, 0x08, 0x1c, 0x09, 0x56, 0x4f, 0x53, 0xf6, 0xaa, 0x88, 0x6a, 0xae, 0x32, 0x95, 0x03, 0x81,
+	0x5c, 0xcf, 0x70, 0xae, 0xe3, 0x74, 0x34, 0x99, 0x2b, 0xfd, 0x97, 0x60, 0xd7, 0x2e, 0x51, 0xa6,
+	0xb3, 0xeb, 0x2e, 0xb4, 0x2a, 0x53, 0x39, 0x10, 0xc8, 0xee, 0x05, 0xce, 0x6e, 0x75, 0x79, 0x8a,
+	0x6a, 0x39, 0xe6, 0x79, 0xc5, 0xe7, 0x37, 0x9b, 0x7f, 0x89, 0x73, 0x5c, 0x68, 0x65, 0x8b, 0x72,
+	0x5f, 0xd6, 0x95, 0x1d, 0xaa, 0x50, 0x2a, 0x17, 0xf2, 0x81, 0x3e, 0xd3, 0xca, 0xc6, 0xba, 0x62,
+	0x2c, 0x44, 0x3c, 0x8f, 0x5a, 0xd9, 0xff, 0x25, 0x70, 0x4f, 0x77, 0x61, 0x8d, 0x66, 0xa4, 0x1e,
+	0xae, 0x35, 0x2a, 0x17, 0x73, 0xa2, 0x30, 0xe2, 0x26, 0x8f, 0xf8, 0xeb, 0xcb, 0x17, 0xe8, 0x74,
+	0x8e, 0x88, 0xb1, 0x76, 0x17, 0x8f, 0x41, 0x83, 0xa8, 0x98, 0x7f, 0x4b, 0xe0, 0x58, 0x44, 0xb5,
+	0x8e, 0x3e, 0x9c, 0x16, 0x40, 0x4c, 0x75, 0x50, 0xb9, 0x94, 0x1f, 0x88, 0xc1, 0x3f, 0xc2, 0x83,
+	0x9f, 0xa5, 0x17, 0x62, 0xc3, 0xe8, 0xae, 0x91, 0x05, 0x0f, 0xe8, 0x77, 0xfa, 0x40, 0x89, 0x2f,
+	0xb5, 0xd1, 0x47, 0x33, 0x0e, 0x48, 0x4c, 0xcd, 0x50, 0xb9, 0xb6, 0x6d, 0x3c, 0x46, 0xf7, 0x8a,
+	0x38, 0xb2, 0x5f, 0x22, 0xcb, 0xd7, 0xe8, 0xd5, 0x3c, 0x83, 0xdb, 0x1d, 0xb4, 0x1b, 0x0f, 0xef,
+	0xb5, 0x8d, 0x1a, 0xf2, 0x0f, 0x08, 0xdc, 0x15, 0x2a, 0xac, 0xd1, 0xa9, 0xf4, 0x31, 0xeb, 0xaa,
+	0xf9, 0x29, 0xd3, 0x79, 0x20, 0x28, 0xc1, 0x75, 0xae, 0xc0, 0x5c, 0xfc, 0x8d, 0x23, 0x32, 0x7c,
+	0xd9, 0x8d, 0xb6, 0x89, 0xb5, 0xb2, 0x2d, 0xfa, 0x07, 0x02, 0xc7, 0x23, 0x0b, 0x65, 0x34, 0xf5,
+	0x4e, 0x15, 0x5b, 0xb5, 0x53, 0xae, 0x6c, 0x07, 0x8a, 0x91, 0x5d, 0xe5, 0x91, 0x3d, 0x4c, 0x2f,
+	0x6a, 0xe9, 0xbf, 0x5c, 0xd6, 0x30, 0x8c, 0x40, 0x3c, 0xdf, 0x16, 0x97, 0xcb, 0x9e, 0xfa, 0x57,
+	0xfa, 0xe5, 0x32, 0xae, 0x78, 0xa7, 0x5c, 0xde, 0x06, 0x12, 0x83, 0x59, 0xe7, 0xc1, 0x38, 0xcb,
+	0x97, 0xe2, 0xcf, 0x8d, 0xc4, 0x81, 0x72, 0xe3, 0x71, 0x41, 0x19, 0xa2, 0xaf, 0x56, 0x47, 0x7b,
+	0xca, 0x5c, 0xf4, 0x62, 0x86, 0x93, 0x3c, 0x42, 0x81, 0xd9, 0xbc, 0x30, 0x0c, 0xff, 0x21, 0x1e,
+	0xfe, 0x03, 0xf4, 0xfe, 0x0c, 0x41, 0xd0, 0x37, 0x09, 0x0c, 0xb6, 0xc5, 0xa4, 0xe7, 0xb2, 0x89,
+	0x2e, 0x19, 0x16, 0xb3, 0x9a, 0x23, 0xb3, 0x69, 0xce, 0xec, 0x2c, 0x9d, 0xcc, 0x3e, 0x2c, 0xf4,
+	0x2d, 0xb1, 0xd8, 0x3b, 0x55, 0x26, 0x9a, 0xe5, 0x62, 0x14, 0xae, 0x7b, 0x29, 0xd3, 0x79, 0x20,
+	0x48, 0xf6, 0x41, 0x4e, 0xf6, 0x14, 0x1d, 0x4b, 0x26, 0xeb, 0xd2, 0x57, 0x09, 0xf4, 0x8b, 0x9a,
+	0x10, 0x9d, 0x4c, 0xf4, 0x13, 0x2a, 0x43, 0x29, 0x0f, 0x65, 0xb2, 0xcd, 0x7a, 0xb3, 0x13, 0xc5,
+	0x28, 0xfa, 0x57, 0x02, 0x27, 0x12, 0xea, 0x38, 0x34, 0xf9, 0x14, 0x48, 0xaf, 0x60, 0x29, 0x8f,
+	0x6d, 0xbf, 0x03, 0x0c, 0xe5, 0x0a, 0x0f, 0x25, 0xe1, 0x82, 0xc0, 0x5f, 0xa8, 0x3b, 0x73, 0xb4,
+	0x1c, 0xa8, 0x72, 0xfd, 0x86, 0xc0, 0x70, 0x54, 0xe2, 0x3e, 0x65, 0x9f, 0x49, 0x28, 0x3b, 0x28,
+	0x97, 0xb7, 0x81, 0xc4, 0x48, 0x66, 0x79, 0x24, 0xe7, 0x69, 0x31, 0x2e, 0x92, 0x16, 0xa2, 0xb5,
+	0x50, 0x61, 0x83, 0xfe, 0x87, 0xc0, 0x50, 0x38, 0xb7, 0x9f, 0xf2, 0x3a, 0x13, 0x59, 0x43, 0x50,
+	0x66, 0x72, 0x61, 0x90, 0xb3, 0xc3, 0x39, 0x9b, 0xcb, 0x17, 0xe9, 0x4c, 0x8e, 0xbd, 0x51, 0x06,
+	0x12, 0x0f, 0x6a, 0x87, 0x1a, 0xb1, 0x84, 0x7f, 0x45, 0x80, 0xf6, 0x96, 0x04, 0xe8, 0x6c, 0x46,
+	0xfe, 0x5d, 0x55, 0x06, 0xe5, 0xe1, 0xdc, 0xb8, 0xac, 0xaf, 0x72, 0x81, 0x20, 0xda, 0x65, 0x12,
+	0xfa, 0x3f, 0x02, 0xd0, 0xc9, 0xdc, 0xd2, 0xd4, 0x3d, 0x2f, 0x5c, 0x93, 0x50, 0xb4, 0xcc, 0xf6,
+	0xc8, 0xf2, 0x7b, 0xe2, 0x9e, 0xf5, 0x0a, 0x59, 0x4e, 0x48, 0xef, 0x60, 0x0e, 0x51, 0xdb, 0x14,
+	0x89, 0xff, 0xad, 0xa4, 0xb3, 0xae, 0xdb, 0xb6, 0x2b, 0xfb, 0x31, 0x96, 0x82, 0xa3, 0x1f, 0x8a,
+	0xcb, 0x4a, 0x6f, 0x1d, 0x20, 0xfd, 0xb2, 0x12, 0x5b, 0xdb, 0x50, 0xae, 0x6c, 0x07, 0x8a, 0x0a,
+	0x5d, 0xe2, 0x02, 0x4d, 0xd3, 0xf3, 0x29, 0xcc, 0x5d, 0x4d, 0x44, 0xdc, 0x8e, 0x3c, 0x2a, 0x14,
+	0x91, 0x85, 0xcf, 0x17, 0x4a, 0xa8, 0xb2, 0xa0, 0x5c, 0xd9, 0x0e, 0x34, 0x77, 0x28, 0xa2, 0x28,
+	0xa1, 0x6d, 0x8a, 0xbf, 0x5b, 0xf4, 0x6d, 0xcc, 0x89, 0x74, 0xb2, 0xe7, 0x34, 0xcb, 0x29, 0xd7,
+	0x95, 0xd1, 0x57, 0x66, 0x72, 0x61, 0x90, 0xf5, 0x04, 0x67, 0xad, 0xd2, 0xf1, 0x34, 0xd6, 0xf4,
+	0x1d, 0x02, 0x43, 0xe1, 0xf4, 0x76, 0x0a, 0xcb, 0xc8, 0x5c, 0xbb, 0x32, 0x93, 0x0b, 0x83, 0x2c,
+	0xcf, 0x72, 0x96, 0x67, 0xe8, 0xe9, 0xc4, 0x83, 0x06, 0xa9, 0xce, 0xb3, 0x0f, 0xef, 0x8c, 0x92,
+	0x8f, 0xee, 0x8c, 0x92, 0x8f, 0xef, 0x8c, 0x92, 0xef, 0x7f, 0x32, 0xba, 0xef, 0xa3, 0x4f, 0x46,
+	0xf7, 0xfd, 0xf9, 0x93, 0xd1, 0x7d, 0x30, 0x62, 0xd8, 0x31, 0xee, 0x97, 0xc8, 0x72, 0x31, 0x90,
+	0xe9, 0xee, 0x18, 0x9d, 0x33, 0xec, 0xa0, 0xd3, 0xf5, 0xb6, 0xdb, 0x4a, 0x3f, 0xff, 0xaf, 0x71,
+	0x33, 0xff, 0x1f, 0x00, 0x65, 0x27, 0x97, 0xdc, 0x0c, 0x39, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OrderFeeCalc calculates the fees that will be associated with the provided order.
+	OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error)
+	// GetOrder looks up an order by id.
+	GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error)
+	// GetOrderByExternalID looks up an order by market id and external id.
+	GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error)
+	// GetMarketOrders looks up the orders in a market.
+	GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error)
+	// GetOwnerOrders looks up the orders from the provided owner address.
+	GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error)
+	// GetAssetOrders looks up the orders for a specific asset denom.
+	GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error)
+	// GetAllOrders gets all orders in the exchange module.
+	GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error)
+	// GetOrderBook gets the price levels and best ask and bid of the orders in a market for an asset and price denom.
+	GetOrderBook(ctx context.Context, in *QueryGetOrderBookRequest, opts ...grpc.CallOption) (*QueryGetOrderBookResponse, error)
+	// GetMarketTrades gets the recorded trades in a market, oldest first.
+	GetMarketTrades(ctx context.Context, in *QueryGetMarketTradesRequest, opts ...grpc.CallOption) (*QueryGetMarketTradesResponse, error)
+	// GetMarketCandles gets the OHLCV candles of the recorded trades in a market for an asset and price denom.
+	GetMarketCandles(ctx context.Context, in *QueryGetMarketCandlesRequest, opts ...grpc.CallOption) (*QueryGetMarketCandlesResponse, error)
+	// GetConditionalOrder looks up a conditional order by id.
+	GetConditionalOrder(ctx context.Context, in *QueryGetConditionalOrderRequest, opts ...grpc.CallOption) (*QueryGetConditionalOrderResponse, error)
+	// GetMarketConditionalOrders looks up the conditional orders in a market.
+	GetMarketConditionalOrders(ctx context.Context, in *QueryGetMarketConditionalOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketConditionalOrdersResponse, error)
+	// GetCommitment gets the funds in an account that are committed to the market.
+	GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error)
+	// GetAccountCommitments gets all the funds in an account that are committed to any market.
+	GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error)
+	// GetMarketCommitments gets all the funds committed to a market from any account.
+	GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error)
+	// GetAllCommitments gets all fund committed to any market from any account.
+	GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error)
+	// GetMarket returns all the information and details about a market.
+	GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error)
+	// GetAllMarkets returns brief information about each market.
+	GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error)
+	// Params returns the exchange module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
+	CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error)
+	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
+	ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error)
+	// ValidateMarket checks for any problems with a market's setup.
+	ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error)
+	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
+	ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error)
+	// GetPayment gets a single specific payment.
+	GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error)
+	// GetPaymentsWithSource gets all payments with a specific source account.
+	GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error)
+	// GetPaymentsWithTarget gets all payments with a specific target account.
+	GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error)
+	// GetAllPayments gets all payments.
+	GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error)
+	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
+	PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OrderFeeCalc(ctx context.Context, in *QueryOrderFeeCalcRequest, opts ...grpc.CallOption) (*QueryOrderFeeCalcResponse, error) {
+	out := new(QueryOrderFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/OrderFeeCalc", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllCommitments(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllCommitments",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllCommitments(ctx, req.(*QueryGetAllCommitmentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetMarketRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetOrder(ctx context.Context, in *QueryGetOrderRequest, opts ...grpc.CallOption) (*QueryGetOrderResponse, error) {
+	out := new(QueryGetOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMarket(ctx, req.(*QueryGetMarketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetAllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllMarketsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetOrderByExternalID(ctx context.Context, in *QueryGetOrderByExternalIDRequest, opts ...grpc.CallOption) (*QueryGetOrderByExternalIDResponse, error) {
+	out := new(QueryGetOrderByExternalIDResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderByExternalID", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllMarkets(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllMarkets",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllMarkets(ctx, req.(*QueryGetAllMarketsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetMarketOrders(ctx context.Context, in *QueryGetMarketOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketOrdersResponse, error) {
+	out := new(QueryGetMarketOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CommitmentSettlementFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCommitmentSettlementFeeCalcRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetOwnerOrders(ctx context.Context, in *QueryGetOwnerOrdersRequest, opts ...grpc.CallOption) (*QueryGetOwnerOrdersResponse, error) {
+	out := new(QueryGetOwnerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOwnerOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, req.(*QueryCommitmentSettlementFeeCalcRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ValidateCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateCreateMarketRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetAssetOrders(ctx context.Context, in *QueryGetAssetOrdersRequest, opts ...grpc.CallOption) (*QueryGetAssetOrdersResponse, error) {
+	out := new(QueryGetAssetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAssetOrders", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateCreateMarket(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateCreateMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateCreateMarket(ctx, req.(*QueryValidateCreateMarketRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetAllOrders(ctx context.Context, in *QueryGetAllOrdersRequest, opts ...grpc.CallOption) (*QueryGetAllOrdersResponse, error) {
+	out := new(QueryGetAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ValidateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateMarketRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetOrderBook(ctx context.Context, in *QueryGetOrderBookRequest, opts ...grpc.CallOption) (*QueryGetOrderBookResponse, error) {
+	out := new(QueryGetOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetOrderBook", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateMarket(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetMarketTrades(ctx context.Context, in *QueryGetMarketTradesRequest, opts ...grpc.CallOption) (*QueryGetMarketTradesResponse, error) {
+	out := new(QueryGetMarketTradesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateMarket",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateMarket(ctx, req.(*QueryValidateMarketRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ValidateManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidateManageFeesRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetMarketCandles(ctx context.Context, in *QueryGetMarketCandlesRequest, opts ...grpc.CallOption) (*QueryGetMarketCandlesResponse, error) {
+	out := new(QueryGetMarketCandlesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketCandles", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValidateManageFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/ValidateManageFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValidateManageFees(ctx, req.(*QueryValidateManageFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetConditionalOrder(ctx context.Context, in *QueryGetConditionalOrderRequest, opts ...grpc.CallOption) (*QueryGetConditionalOrderResponse, error) {
+	out := new(QueryGetConditionalOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetConditionalOrder", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPayment(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPayment",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPayment(ctx, req.(*QueryGetPaymentRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetMarketConditionalOrders(ctx context.Context, in *QueryGetMarketConditionalOrdersRequest, opts ...grpc.CallOption) (*QueryGetMarketConditionalOrdersResponse, error) {
+	out := new(QueryGetMarketConditionalOrdersResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketConditionalOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetPaymentsWithSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentsWithSourceRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetCommitment(ctx context.Context, in *QueryGetCommitmentRequest, opts ...grpc.CallOption) (*QueryGetCommitmentResponse, error) {
+	out := new(QueryGetCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetCommitment", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPaymentsWithSource(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetAccountCommitments(ctx context.Context, in *QueryGetAccountCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAccountCommitmentsResponse, error) {
+	out := new(QueryGetAccountCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAccountCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithSource",
+	return out, nil
+}
+
+func (c *queryClient) GetMarketCommitments(ctx context.Context, in *QueryGetMarketCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetMarketCommitmentsResponse, error) {
+	out := new(QueryGetMarketCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarketCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPaymentsWithSource(ctx, req.(*QueryGetPaymentsWithSourceRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetAllCommitments(ctx context.Context, in *QueryGetAllCommitmentsRequest, opts ...grpc.CallOption) (*QueryGetAllCommitmentsResponse, error) {
+	out := new(QueryGetAllCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetPaymentsWithTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPaymentsWithTargetRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetMarket(ctx context.Context, in *QueryGetMarketRequest, opts ...grpc.CallOption) (*QueryGetMarketResponse, error) {
+	out := new(QueryGetMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetMarket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPaymentsWithTarget(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetAllMarkets(ctx context.Context, in *QueryGetAllMarketsRequest, opts ...grpc.CallOption) (*QueryGetAllMarketsResponse, error) {
+	out := new(QueryGetAllMarketsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllMarkets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithTarget",
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPaymentsWithTarget(ctx, req.(*QueryGetPaymentsWithTargetRequest))
+	return out, nil
+}
+
+func (c *queryClient) CommitmentSettlementFeeCalc(ctx context.Context, in *QueryCommitmentSettlementFeeCalcRequest, opts ...grpc.CallOption) (*QueryCommitmentSettlementFeeCalcResponse, error) {
+	out := new(QueryCommitmentSettlementFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_GetAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAllPaymentsRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ValidateCreateMarket(ctx context.Context, in *QueryValidateCreateMarketRequest, opts ...grpc.CallOption) (*QueryValidateCreateMarketResponse, error) {
+	out := new(QueryValidateCreateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateCreateMarket", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAllPayments(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) ValidateMarket(ctx context.Context, in *QueryValidateMarketRequest, opts ...grpc.CallOption) (*QueryValidateMarketResponse, error) {
+	out := new(QueryValidateMarketResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateMarket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/GetAllPayments",
+	return out, nil
+}
+
+func (c *queryClient) ValidateManageFees(ctx context.Context, in *QueryValidateManageFeesRequest, opts ...grpc.CallOption) (*QueryValidateManageFeesResponse, error) {
+	out := new(QueryValidateManageFeesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/ValidateManageFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAllPayments(ctx, req.(*QueryGetAllPaymentsRequest))
+	return out, nil
+}
+
+func (c *queryClient) GetPayment(ctx context.Context, in *QueryGetPaymentRequest, opts ...grpc.CallOption) (*QueryGetPaymentResponse, error) {
+	out := new(QueryGetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_PaymentFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPaymentFeeCalcRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) GetPaymentsWithSource(ctx context.Context, in *QueryGetPaymentsWithSourceRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithSourceResponse, error) {
+	out := new(QueryGetPaymentsWithSourceResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithSource", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).PaymentFeeCalc(ctx, in)
+	return out, nil
+}
+
+func (c *queryClient) GetPaymentsWithTarget(ctx context.Context, in *QueryGetPaymentsWithTargetRequest, opts ...grpc.CallOption) (*QueryGetPaymentsWithTargetResponse, error) {
+	out := new(QueryGetPaymentsWithTargetResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetPaymentsWithTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.exchange.v1.Query/PaymentFeeCalc",
+	return out, nil
+}
+
+func (c *queryClient) GetAllPayments(ctx context.Context, in *QueryGetAllPaymentsRequest, opts ...grpc.CallOption) (*QueryGetAllPaymentsResponse, error) {
+	out := new(QueryGetAllPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/GetAllPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PaymentFeeCalc(ctx, req.(*QueryPaymentFeeCalcRequest))
+	return out, nil
+}
+
+func (c *queryClient) PaymentFeeCalc(ctx context.Context, in *QueryPaymentFeeCalcRequest, opts ...grpc.CallOption) (*QueryPaymentFeeCalcResponse, error) {
+	out := new(QueryPaymentFeeCalcResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Query/PaymentFeeCalc", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.exchange.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "OrderFeeCalc",
-			Handler:    _Query_OrderFeeCalc_Handler,
-		},
-		{
-			MethodName: "GetOrder",
-			Handler:    _Query_GetOrder_Handler,
-		},
-		{
-			MethodName: "GetOrderByExternalID",
-			Handler:    _Query_GetOrderByExternalID_Handler,
-		},
-		{
-			MethodName: "GetMarketOrders",
-			Handler:    _Query_GetMarketOrders_Handler,
-		},
-		{
-			MethodName: "GetOwnerOrders",
-			Handler:    _Query_GetOwnerOrders_Handler,
-		},
-		{
-			MethodName: "GetAssetOrders",
-			Handler:    _Query_GetAssetOrders_Handler,
-		},
-		{
-			MethodName: "GetAllOrders",
-			Handler:    _Query_GetAllOrders_Handler,
-		},
-		{
-			MethodName: "GetCommitment",
-			Handler:    _Query_GetCommitment_Handler,
-		},
-		{
-			MethodName: "GetAccountCommitments",
-			Handler:    _Query_GetAccountCommitments_Handler,
-		},
-		{
-			MethodName: "GetMarketCommitments",
-			Handler:    _Query_GetMarketCommitments_Handler,
-		},
-		{
-			MethodName: "GetAllCommitments",
-			Handler:    _Query_GetAllCommitments_Handler,
-		},
-		{
-			MethodName: "GetMarket",
-			Handler:    _Query_GetMarket_Handler,
-		},
-		{
-			MethodName: "GetAllMarkets",
-			Handler:    _Query_GetAllMarkets_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CommitmentSettlementFeeCalc",
-			Handler:    _Query_CommitmentSettlementFeeCalc_Handler,
-		},
-		{
-			MethodName: "ValidateCreateMarket",
-			Handler:    _Query_ValidateCreateMarket_Handler,
-		},
-		{
-			MethodName: "ValidateMarket",
-			Handler:    _Query_ValidateMarket_Handler,
-		},
-		{
-			MethodName: "ValidateManageFees",
-			Handler:    _Query_ValidateManageFees_Handler,
-		},
-		{
-			MethodName: "GetPayment",
-			Handler:    _Query_GetPayment_Handler,
-		},
-		{
-			MethodName: "GetPaymentsWithSource",
-			Handler:    _Query_GetPaymentsWithSource_Handler,
-		},
-		{
-			MethodName: "GetPaymentsWithTarget",
-			Handler:    _Query_GetPaymentsWithTarget_Handler,
-		},
-		{
-			MethodName: "GetAllPayments",
-			Handler:    _Query_GetAllPayments_Handler,
-		},
-		{
-			MethodName: "PaymentFeeCalc",
-			Handler:    _Query_PaymentFeeCalc_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/exchange/v1/query.proto",
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// OrderFeeCalc calculates the fees that will be associated with the provided order.
+	OrderFeeCalc(context.Context, *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error)
+	// GetOrder looks up an order by id.
+	GetOrder(context.Context, *QueryGetOrderRequest) (*QueryGetOrderResponse, error)
+	// GetOrderByExternalID looks up an order by market id and external id.
+	GetOrderByExternalID(context.Context, *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error)
+	// GetMarketOrders looks up the orders in a market.
+	GetMarketOrders(context.Context, *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error)
+	// GetOwnerOrders looks up the orders from the provided owner address.
+	GetOwnerOrders(context.Context, *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error)
+	// GetAssetOrders looks up the orders for a specific asset denom.
+	GetAssetOrders(context.Context, *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error)
+	// GetAllOrders gets all orders in the exchange module.
+	GetAllOrders(context.Context, *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error)
+	// GetOrderBook gets the price levels and best ask and bid of the orders in a market for an asset and price denom.
+	GetOrderBook(context.Context, *QueryGetOrderBookRequest) (*QueryGetOrderBookResponse, error)
+	// GetMarketTrades gets the recorded trades in a market, oldest first.
+	GetMarketTrades(context.Context, *QueryGetMarketTradesRequest) (*QueryGetMarketTradesResponse, error)
+	// GetMarketCandles gets the OHLCV candles of the recorded trades in a market for an asset and price denom.
+	GetMarketCandles(context.Context, *QueryGetMarketCandlesRequest) (*QueryGetMarketCandlesResponse, error)
+	// GetConditionalOrder looks up a conditional order by id.
+	GetConditionalOrder(context.Context, *QueryGetConditionalOrderRequest) (*QueryGetConditionalOrderResponse, error)
+	// GetMarketConditionalOrders looks up the conditional orders in a market.
+	GetMarketConditionalOrders(context.Context, *QueryGetMarketConditionalOrdersRequest) (*QueryGetMarketConditionalOrdersResponse, error)
+	// GetCommitment gets the funds in an account that are committed to the market.
+	GetCommitment(context.Context, *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error)
+	// GetAccountCommitments gets all the funds in an account that are committed to any market.
+	GetAccountCommitments(context.Context, *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error)
+	// GetMarketCommitments gets all the funds committed to a market from any account.
+	GetMarketCommitments(context.Context, *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error)
+	// GetAllCommitments gets all fund committed to any market from any account.
+	GetAllCommitments(context.Context, *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error)
+	// GetMarket returns all the information and details about a market.
+	GetMarket(context.Context, *QueryGetMarketRequest) (*QueryGetMarketResponse, error)
+	// GetAllMarkets returns brief information about each market.
+	GetAllMarkets(context.Context, *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error)
+	// Params returns the exchange module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CommitmentSettlementFeeCalc calculates the fees a market will pay for a commitment settlement using current NAVs.
+	CommitmentSettlementFeeCalc(context.Context, *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error)
+	// ValidateCreateMarket checks the provided MsgGovCreateMarketResponse and returns any errors it might have.
+	ValidateCreateMarket(context.Context, *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error)
+	// ValidateMarket checks for any problems with a market's setup.
+	ValidateMarket(context.Context, *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error)
+	// ValidateManageFees checks the provided MsgGovManageFeesRequest and returns any errors that it might have.
+	ValidateManageFees(context.Context, *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error)
+	// GetPayment gets a single specific payment.
+	GetPayment(context.Context, *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error)
+	// GetPaymentsWithSource gets all payments with a specific source account.
+	GetPaymentsWithSource(context.Context, *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error)
+	// GetPaymentsWithTarget gets all payments with a specific target account.
+	GetPaymentsWithTarget(context.Context, *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error)
+	// GetAllPayments gets all payments.
+	GetAllPayments(context.Context, *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error)
+	// PaymentFeeCalc calculates the fees that must be paid for creating or accepting a specific payment.
+	PaymentFeeCalc(context.Context, *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error)
 }
 
-func (m *QueryOrderFeeCalcRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryOrderFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) OrderFeeCalc(ctx context.Context, req *QueryOrderFeeCalcRequest) (*QueryOrderFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderFeeCalc not implemented")
 }
-
-func (m *QueryOrderFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BidOrder != nil {
-		{
-			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.AskOrder != nil {
-		{
-			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetOrder(ctx context.Context, req *QueryGetOrderRequest) (*QueryGetOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
 }
-
-func (m *QueryOrderFeeCalcResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetOrderByExternalID(ctx context.Context, req *QueryGetOrderByExternalIDRequest) (*QueryGetOrderByExternalIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderByExternalID not implemented")
 }
-
-func (m *QueryOrderFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetMarketOrders(ctx context.Context, req *QueryGetMarketOrdersRequest) (*QueryGetMarketOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketOrders not implemented")
 }
-
-func (m *QueryOrderFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettlementRatioFeeOptions) > 0 {
-		for iNdEx := len(m.SettlementRatioFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettlementRatioFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.SettlementFlatFeeOptions) > 0 {
-		for iNdEx := len(m.SettlementFlatFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettlementFlatFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.CreationFeeOptions) > 0 {
-		for iNdEx := len(m.CreationFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CreationFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetOwnerOrders(ctx context.Context, req *QueryGetOwnerOrdersRequest) (*QueryGetOwnerOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOwnerOrders not implemented")
 }
-
-func (m *QueryGetOrderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetAssetOrders(ctx context.Context, req *QueryGetAssetOrdersRequest) (*QueryGetAssetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetOrders not implemented")
 }
-
-func (m *QueryGetOrderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetAllOrders(ctx context.Context, req *QueryGetAllOrdersRequest) (*QueryGetAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllOrders not implemented")
 }
-
-func (m *QueryGetOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetOrderBook(ctx context.Context, req *QueryGetOrderBookRequest) (*QueryGetOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderBook not implemented")
 }
-
-func (m *QueryGetOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetMarketTrades(ctx context.Context, req *QueryGetMarketTradesRequest) (*QueryGetMarketTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketTrades not implemented")
 }
-
-func (m *QueryGetOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetMarketCandles(ctx context.Context, req *QueryGetMarketCandlesRequest) (*QueryGetMarketCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCandles not implemented")
 }
-
-func (m *QueryGetOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetConditionalOrder(ctx context.Context, req *QueryGetConditionalOrderRequest) (*QueryGetConditionalOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConditionalOrder not implemented")
 }
-
-func (m *QueryGetOrderByExternalIDRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetMarketConditionalOrders(ctx context.Context, req *QueryGetMarketConditionalOrdersRequest) (*QueryGetMarketConditionalOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketConditionalOrders not implemented")
+}
+func (*UnimplementedQueryServer) GetCommitment(ctx context.Context, req *QueryGetCommitmentRequest) (*QueryGetCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommitment not implemented")
+}
+func (*UnimplementedQueryServer) GetAccountCommitments(ctx context.Context, req *QueryGetAccountCommitmentsRequest) (*QueryGetAccountCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetMarketCommitments(ctx context.Context, req *QueryGetMarketCommitmentsRequest) (*QueryGetMarketCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarketCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetAllCommitments(ctx context.Context, req *QueryGetAllCommitmentsRequest) (*QueryGetAllCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllCommitments not implemented")
+}
+func (*UnimplementedQueryServer) GetMarket(ctx context.Context, req *QueryGetMarketRequest) (*QueryGetMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarket not implemented")
+}
+func (*UnimplementedQueryServer) GetAllMarkets(ctx context.Context, req *QueryGetAllMarketsRequest) (*QueryGetAllMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllMarkets not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) CommitmentSettlementFeeCalc(ctx context.Context, req *QueryCommitmentSettlementFeeCalcRequest) (*QueryCommitmentSettlementFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitmentSettlementFeeCalc not implemented")
+}
+func (*UnimplementedQueryServer) ValidateCreateMarket(ctx context.Context, req *QueryValidateCreateMarketRequest) (*QueryValidateCreateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCreateMarket not implemented")
+}
+func (*UnimplementedQueryServer) ValidateMarket(ctx context.Context, req *QueryValidateMarketRequest) (*QueryValidateMarketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMarket not implemented")
+}
+func (*UnimplementedQueryServer) ValidateManageFees(ctx context.Context, req *QueryValidateManageFeesRequest) (*QueryValidateManageFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateManageFees not implemented")
+}
+func (*UnimplementedQueryServer) GetPayment(ctx context.Context, req *QueryGetPaymentRequest) (*QueryGetPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayment not implemented")
+}
+func (*UnimplementedQueryServer) GetPaymentsWithSource(ctx context.Context, req *QueryGetPaymentsWithSourceRequest) (*QueryGetPaymentsWithSourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithSource not implemented")
+}
+func (*UnimplementedQueryServer) GetPaymentsWithTarget(ctx context.Context, req *QueryGetPaymentsWithTargetRequest) (*QueryGetPaymentsWithTargetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentsWithTarget not implemented")
+}
+func (*UnimplementedQueryServer) GetAllPayments(ctx context.Context, req *QueryGetAllPaymentsRequest) (*QueryGetAllPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAllPayments not implemented")
+}
+func (*UnimplementedQueryServer) PaymentFeeCalc(ctx context.Context, req *QueryPaymentFeeCalcRequest) (*QueryPaymentFeeCalcResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentFeeCalc not implemented")
 }
 
-func (m *QueryGetOrderByExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetOrderByExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
-		i--
-		dAtA[i] = 0x12
+func _Query_OrderFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderFeeCalcRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).OrderFeeCalc(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/OrderFeeCalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderFeeCalc(ctx, req.(*QueryOrderFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOrderByExternalIDResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetOrderByExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetOrderByExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Order != nil {
-		{
-			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrder(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrder(ctx, req.(*QueryGetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetOrderByExternalID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderByExternalIDRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrderByExternalID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrderByExternalID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrderByExternalID(ctx, req.(*QueryGetOrderByExternalIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetMarketOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketOrders(ctx, req.(*QueryGetMarketOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetOwnerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOwnerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).GetOwnerOrders(ctx, in)
 	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOwnerOrders",
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOwnerOrders(ctx, req.(*QueryGetOwnerOrdersRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAssetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAssetOrdersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAssetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAssetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAssetOrders(ctx, req.(*QueryGetAssetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetMarketOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllOrders(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllOrders(ctx, req.(*QueryGetAllOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOwnerOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetOrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetOrderBookRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetOwnerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetOrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetOrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetOrderBook(ctx, req.(*QueryGetOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOwnerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetMarketTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketTrades(ctx, in)
 	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketTrades",
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketTrades(ctx, req.(*QueryGetMarketTradesRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetOwnerOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetMarketCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketCandlesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetOwnerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetOwnerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketCandles(ctx, in)
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketCandles",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketCandles(ctx, req.(*QueryGetMarketCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetConditionalOrderRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAssetOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetConditionalOrder(ctx, req.(*QueryGetConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetMarketConditionalOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketConditionalOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.AfterOrderId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
-		i--
-		dAtA[i] = 0x18
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketConditionalOrders(ctx, in)
 	}
-	if len(m.OrderType) > 0 {
-		i -= len(m.OrderType)
-		copy(dAtA[i:], m.OrderType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketConditionalOrders",
 	}
-	if len(m.Asset) > 0 {
-		i -= len(m.Asset)
-		copy(dAtA[i:], m.Asset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketConditionalOrders(ctx, req.(*QueryGetMarketConditionalOrdersRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCommitmentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAssetOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCommitment(ctx, req.(*QueryGetCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAssetOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetAccountCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAccountCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAccountCommitments(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAccountCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAccountCommitments(ctx, req.(*QueryGetAccountCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllOrdersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetMarketCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketCommitmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarketCommitments(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarketCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarketCommitments(ctx, req.(*QueryGetMarketCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllOrdersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAllCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllCommitmentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllCommitments(ctx, in)
 	}
-	if len(m.Orders) > 0 {
-		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllCommitments",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllCommitments(ctx, req.(*QueryGetAllCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetMarketRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarket(ctx, req.(*QueryGetMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetAllMarkets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllMarkets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllMarkets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllMarkets(ctx, req.(*QueryGetAllMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x10
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CommitmentSettlementFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentSettlementFeeCalcRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/CommitmentSettlementFeeCalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommitmentSettlementFeeCalc(ctx, req.(*QueryCommitmentSettlementFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ValidateCreateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateCreateMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateCreateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateCreateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateCreateMarket(ctx, req.(*QueryValidateCreateMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_ValidateMarket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateMarketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateMarket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateMarket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateMarket(ctx, req.(*QueryValidateMarketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ValidateManageFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateManageFeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateManageFees(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/ValidateManageFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateManageFees(ctx, req.(*QueryValidateManageFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPayment(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPayment(ctx, req.(*QueryGetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPaymentsWithSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentsWithSourceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+	if interceptor == nil {
+		return srv.(QueryServer).GetPaymentsWithSource(ctx, in)
 	}
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithSource",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPaymentsWithSource(ctx, req.(*QueryGetPaymentsWithSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetPaymentsWithTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPaymentsWithTargetRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetPaymentsWithTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetPaymentsWithTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPaymentsWithTarget(ctx, req.(*QueryGetPaymentsWithTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
+func _Query_GetAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAllPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAllPayments(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/GetAllPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAllPayments(ctx, req.(*QueryGetAllPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_PaymentFeeCalc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentFeeCalcRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentFeeCalc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Query/PaymentFeeCalc",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentFeeCalc(ctx, req.(*QueryPaymentFeeCalcRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.exchange.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OrderFeeCalc",
+			Handler:    _Query_OrderFeeCalc_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _Query_GetOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderByExternalID",
+			Handler:    _Query_GetOrderByExternalID_Handler,
+		},
+		{
+			MethodName: "GetMarketOrders",
+			Handler:    _Query_GetMarketOrders_Handler,
+		},
+		{
+			MethodName: "GetOwnerOrders",
+			Handler:    _Query_GetOwnerOrders_Handler,
+		},
+		{
+			MethodName: "GetAssetOrders",
+			Handler:    _Query_GetAssetOrders_Handler,
+		},
+		{
+			MethodName: "GetAllOrders",
+			Handler:    _Query_GetAllOrders_Handler,
+		},
+		{
+			MethodName: "GetOrderBook",
+			Handler:    _Query_GetOrderBook_Handler,
+		},
+		{
+			MethodName: "GetMarketTrades",
+			Handler:    _Query_GetMarketTrades_Handler,
+		},
+		{
+			MethodName: "GetMarketCandles",
+			Handler:    _Query_GetMarketCandles_Handler,
+		},
+		{
+			MethodName: "GetConditionalOrder",
+			Handler:    _Query_GetConditionalOrder_Handler,
+		},
+		{
+			MethodName: "GetMarketConditionalOrders",
+			Handler:    _Query_GetMarketConditionalOrders_Handler,
+		},
+		{
+			MethodName: "GetCommitment",
+			Handler:    _Query_GetCommitment_Handler,
+		},
+		{
+			MethodName: "GetAccountCommitments",
+			Handler:    _Query_GetAccountCommitments_Handler,
+		},
+		{
+			MethodName: "GetMarketCommitments",
+			Handler:    _Query_GetMarketCommitments_Handler,
+		},
+		{
+			MethodName: "GetAllCommitments",
+			Handler:    _Query_GetAllCommitments_Handler,
+		},
+		{
+			MethodName: "GetMarket",
+			Handler:    _Query_GetMarket_Handler,
+		},
+		{
+			MethodName: "GetAllMarkets",
+			Handler:    _Query_GetAllMarkets_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CommitmentSettlementFeeCalc",
+			Handler:    _Query_CommitmentSettlementFeeCalc_Handler,
+		},
+		{
+			MethodName: "ValidateCreateMarket",
+			Handler:    _Query_ValidateCreateMarket_Handler,
+		},
+		{
+			MethodName: "ValidateMarket",
+			Handler:    _Query_ValidateMarket_Handler,
+		},
+		{
+			MethodName: "ValidateManageFees",
+			Handler:    _Query_ValidateManageFees_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _Query_GetPayment_Handler,
+		},
+		{
+			MethodName: "GetPaymentsWithSource",
+			Handler:    _Query_GetPaymentsWithSource_Handler,
+		},
+		{
+			MethodName: "GetPaymentsWithTarget",
+			Handler:    _Query_GetPaymentsWithTarget_Handler,
+		},
+		{
+			MethodName: "GetAllPayments",
+			Handler:    _Query_GetAllPayments_Handler,
+		},
+		{
+			MethodName: "PaymentFeeCalc",
+			Handler:    _Query_PaymentFeeCalc_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/exchange/v1/query.proto",
+}
 
-func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOrderFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.BidOrder != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4543,14 +4479,24 @@ func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x1a
+	}
+	if m.AskOrder != nil {
+		{
+			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryOrderFeeCalcResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4560,34 +4506,48 @@ func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryOrderFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryOrderFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.SettlementRatioFeeOptions) > 0 {
+		for iNdEx := len(m.SettlementRatioFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettlementRatioFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
 	}
-	if len(m.Commitments) > 0 {
-		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.SettlementFlatFeeOptions) > 0 {
+		for iNdEx := len(m.SettlementFlatFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.SettlementFlatFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CreationFeeOptions) > 0 {
+		for iNdEx := len(m.CreationFeeOptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreationFeeOptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4601,7 +4561,7 @@ func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4611,25 +4571,25 @@ func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4639,19 +4599,19 @@ func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Market != nil {
+	if m.Order != nil {
 		{
-			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4659,19 +4619,12 @@ func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderByExternalIDRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4681,34 +4634,32 @@ func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderByExternalIDRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderByExternalIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderByExternalIDResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4718,19 +4669,19 @@ func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderByExternalIDResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderByExternalIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
+	if m.Order != nil {
 		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4738,28 +4689,12 @@ func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4769,20 +4704,51 @@ func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4792,19 +4758,19 @@ func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4812,12 +4778,28 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOwnerOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4827,29 +4809,19 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOwnerOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOwnerOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IncludeBreakdownFields {
-		i--
-		if m.IncludeBreakdownFields {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Settlement != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4857,12 +4829,33 @@ func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []by
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOwnerOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4872,19 +4865,19 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOwnerOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOwnerOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ToFeeNav != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4892,54 +4885,14 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []b
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ConversionNavs) > 0 {
-		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.ConvertedTotal) > 0 {
-		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.ExchangeFees) > 0 {
-		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4953,7 +4906,7 @@ func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAssetOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4963,19 +4916,19 @@ func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAssetOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAssetOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CreateMarketRequest != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4983,52 +4936,33 @@ func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (in
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GovPropWillPass {
+	if m.AfterOrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AfterOrderId))
 		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderType) > 0 {
+		i -= len(m.OrderType)
+		copy(dAtA[i:], m.OrderType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderType)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Asset)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAssetOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5038,25 +4972,48 @@ func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAssetOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAssetOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x8
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllOrdersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5066,27 +5023,34 @@ func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetAllOrdersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5096,19 +5060,19 @@ func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetAllOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetAllOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ManageFeesRequest != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5116,12 +5080,28 @@ func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderBookRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5131,74 +5111,44 @@ func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.GovPropWillPass {
-		i--
-		if m.GovPropWillPass {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if m.MaxLevels != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxLevels))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x20
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
 		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x1a
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExternalId) > 0 {
-		i -= len(m.ExternalId)
-		copy(dAtA[i:], m.ExternalId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetOrderBookResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5208,19 +5158,19 @@ func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Payment != nil {
+	if m.BestBid != nil {
 		{
-			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.BestBid.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5228,12 +5178,52 @@ func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.BestAsk != nil {
+		{
+			size, err := m.BestAsk.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Asks) > 0 {
+		for iNdEx := len(m.Asks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketTradesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5243,12 +5233,12 @@ func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketTradesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5267,17 +5257,15 @@ func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Source) > 0 {
-		i -= len(m.Source)
-		copy(dAtA[i:], m.Source)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketTradesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5287,12 +5275,12 @@ func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketTradesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5311,10 +5299,10 @@ func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5328,7 +5316,7 @@ func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketCandlesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5338,41 +5326,49 @@ func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.MaxCandles != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxCandles))
 		i--
-		dAtA[i] = 0x6
+		dAtA[i] = 0x28
+	}
+	if m.Interval != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Interval))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x20
 	}
-	if len(m.Target) > 0 {
-		i -= len(m.Target)
-		copy(dAtA[i:], m.Target)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+	if len(m.PriceDenom) > 0 {
+		i -= len(m.PriceDenom)
+		copy(dAtA[i:], m.PriceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceDenom)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
+	}
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetMarketCandlesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5382,34 +5378,20 @@ func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetMarketCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
-		i--
-		dAtA[i] = 0x9a
-	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5423,7 +5405,7 @@ func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetConditionalOrderRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5433,34 +5415,25 @@ func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetConditionalOrderRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetConditionalOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6
+	if m.OrderId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OrderId))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetConditionalOrderResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5470,12 +5443,47 @@ func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetConditionalOrderResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetConditionalOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConditionalOrder != nil {
+		{
+			size, err := m.ConditionalOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketConditionalOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketConditionalOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketConditionalOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5494,10 +5502,52 @@ func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x9a
 	}
-	if len(m.Payments) > 0 {
-		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetMarketConditionalOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketConditionalOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketConditionalOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ConditionalOrders) > 0 {
+		for iNdEx := len(m.ConditionalOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ConditionalOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5511,7 +5561,7 @@ func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCommitmentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5521,30 +5571,32 @@ func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryGetCommitmentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5554,20 +5606,20 @@ func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryGetCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FeeAccept) > 0 {
-		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5575,13 +5627,66 @@ func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0xa
 		}
 	}
-	if len(m.FeeCreate) > 0 {
-		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAccountCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAccountCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAccountCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5595,793 +5700,3217 @@ func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryGetMarketCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryOrderFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.AskOrder != nil {
-		l = m.AskOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BidOrder != nil {
-		l = m.BidOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryGetMarketCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOrderFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetMarketCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CreationFeeOptions) > 0 {
-		for _, e := range m.CreationFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.SettlementFlatFeeOptions) > 0 {
-		for _, e := range m.SettlementFlatFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if len(m.SettlementRatioFeeOptions) > 0 {
-		for _, e := range m.SettlementRatioFeeOptions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMarketCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.OrderId != 0 {
-		n += 1 + sovQuery(uint64(m.OrderId))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetMarketCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetMarketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Order != nil {
-		l = m.Order.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetAllCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetAllCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Market != nil {
+		{
+			size, err := m.Market.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAssetOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Asset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrderType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AfterOrderId != 0 {
-		n += 1 + sovQuery(uint64(m.AfterOrderId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryGetAllMarketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetAssetOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryGetAllMarketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAllOrdersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetAllMarketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllOrdersResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllMarketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllMarketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllMarketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Orders) > 0 {
-		for _, e := range m.Orders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetCommitmentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetCommitmentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+func (m *QueryCommitmentSettlementFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Commitments) > 0 {
-		for _, e := range m.Commitments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.IncludeBreakdownFields {
+		i--
+		if m.IncludeBreakdownFields {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.Settlement != nil {
+		{
+			size, err := m.Settlement.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
+func (m *QueryCommitmentSettlementFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ToFeeNav != nil {
+		{
+			size, err := m.ToFeeNav.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Market != nil {
-		l = m.Market.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ConversionNavs) > 0 {
+		for iNdEx := len(m.ConversionNavs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConversionNavs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	if len(m.ConvertedTotal) > 0 {
+		for iNdEx := len(m.ConvertedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConvertedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for iNdEx := len(m.InputTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InputTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ExchangeFees) > 0 {
+		for iNdEx := len(m.ExchangeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateCreateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCreateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCreateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if m.CreateMarketRequest != nil {
+		{
+			size, err := m.CreateMarketRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllMarketsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateCreateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateCreateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateCreateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.GovPropWillPass {
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryValidateMarketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidateMarketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateMarketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Settlement != nil {
-		l = m.Settlement.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.IncludeBreakdownFields {
-		n += 2
+func (m *QueryValidateMarketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ExchangeFees) > 0 {
-		for _, e := range m.ExchangeFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.InputTotal) > 0 {
-		for _, e := range m.InputTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ConvertedTotal) > 0 {
-		for _, e := range m.ConvertedTotal {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.ConversionNavs) > 0 {
-		for _, e := range m.ConversionNavs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.ToFeeNav != nil {
-		l = m.ToFeeNav.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryValidateMarketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateCreateMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidateMarketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CreateMarketRequest != nil {
-		l = m.CreateMarketRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateCreateMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GovPropWillPass {
-		n += 2
+func (m *QueryValidateManageFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidateMarketRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MarketId != 0 {
-		n += 1 + sovQuery(uint64(m.MarketId))
-	}
-	return n
+func (m *QueryValidateManageFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValidateMarketResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidateManageFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ManageFeesRequest != nil {
+		{
+			size, err := m.ManageFeesRequest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValidateManageFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ManageFeesRequest != nil {
-		l = m.ManageFeesRequest.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidateManageFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValidateManageFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryValidateManageFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateManageFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.GovPropWillPass {
-		n += 2
+		i--
+		if m.GovPropWillPass {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExternalId) > 0 {
+		i -= len(m.ExternalId)
+		copy(dAtA[i:], m.ExternalId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExternalId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ExternalId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Payment != nil {
-		l = m.Payment.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryGetPaymentsWithSourceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Source)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentsWithSourceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentsWithTargetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Target)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetPaymentsWithTargetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+	if len(m.Payments) > 0 {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllPaymentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetAllPaymentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryGetAllPaymentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAllPaymentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.Payments) > 0 {
-		for _, e := range m.Payments {
+		for iNdEx := len(m.Payments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentFeeCalcRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentFeeCalcResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentFeeCalcResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeAccept) > 0 {
+		for iNdEx := len(m.FeeAccept) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeAccept[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCreate) > 0 {
+		for iNdEx := len(m.FeeCreate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCreate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOrderFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AskOrder != nil {
+		l = m.AskOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BidOrder != nil {
+		l = m.BidOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOrderFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CreationFeeOptions) > 0 {
+		for _, e := range m.CreationFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementFlatFeeOptions) > 0 {
+		for _, e := range m.SettlementFlatFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SettlementRatioFeeOptions) > 0 {
+		for _, e := range m.SettlementRatioFeeOptions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryGetOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderByExternalIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderByExternalIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Order != nil {
+		l = m.Order.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Payment.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOwnerOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAssetOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrderType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AfterOrderId != 0 {
+		n += 1 + sovQuery(uint64(m.AfterOrderId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAssetOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxLevels != 0 {
+		n += 1 + sovQuery(uint64(m.MaxLevels))
+	}
+	return n
+}
+
+func (m *QueryGetOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Asks) > 0 {
+		for _, e := range m.Asks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.BestAsk != nil {
+		l = m.BestAsk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BestBid != nil {
+		l = m.BestBid.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovQuery(uint64(m.Interval))
+	}
+	if m.MaxCandles != 0 {
+		n += 1 + sovQuery(uint64(m.MaxCandles))
+	}
+	return n
+}
+
+func (m *QueryGetMarketCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetConditionalOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovQuery(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *QueryGetConditionalOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConditionalOrder != nil {
+		l = m.ConditionalOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketConditionalOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketConditionalOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConditionalOrders) > 0 {
+		for _, e := range m.ConditionalOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAccountCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetMarketCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryGetMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Market != nil {
+		l = m.Market.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllMarketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Settlement != nil {
+		l = m.Settlement.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.IncludeBreakdownFields {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCommitmentSettlementFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeFees) > 0 {
+		for _, e := range m.ExchangeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.InputTotal) > 0 {
+		for _, e := range m.InputTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConvertedTotal) > 0 {
+		for _, e := range m.ConvertedTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ConversionNavs) > 0 {
+		for _, e := range m.ConversionNavs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ToFeeNav != nil {
+		l = m.ToFeeNav.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CreateMarketRequest != nil {
+		l = m.CreateMarketRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateCreateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryValidateMarketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	return n
+}
+
+func (m *QueryValidateMarketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ManageFeesRequest != nil {
+		l = m.ManageFeesRequest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateManageFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GovPropWillPass {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetPaymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExternalId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Payment != nil {
+		l = m.Payment.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithSourceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPaymentsWithTargetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAllPaymentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payments) > 0 {
+		for _, e := range m.Payments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentFeeCalcRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Payment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCreate) > 0 {
+		for _, e := range m.FeeCreate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeAccept) > 0 {
+		for _, e := range m.FeeAccept {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AskOrder == nil {
+				m.AskOrder = &AskOrder{}
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidOrder == nil {
+				m.BidOrder = &BidOrder{}
+			}
+			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
+			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
+			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
+			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Order == nil {
+				m.Order = &Order{}
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryPaymentFeeCalcResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.FeeCreate) > 0 {
-		for _, e := range m.FeeCreate {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.FeeAccept) > 0 {
-		for _, e := range m.FeeAccept {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6404,15 +8933,15 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6439,16 +8968,14 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AskOrder == nil {
-				m.AskOrder = &AskOrder{}
-			}
-			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6475,10 +9002,10 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BidOrder == nil {
-				m.BidOrder = &BidOrder{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6503,7 +9030,7 @@ func (m *QueryOrderFeeCalcRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6526,17 +9053,17 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOrderFeeCalcResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6546,31 +9073,29 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreationFeeOptions = append(m.CreationFeeOptions, types.Coin{})
-			if err := m.CreationFeeOptions[len(m.CreationFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementFlatFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6580,29 +9105,46 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementFlatFeeOptions = append(m.SettlementFlatFeeOptions, types.Coin{})
-			if err := m.SettlementFlatFeeOptions[len(m.SettlementFlatFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			}
+			m.AfterOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AfterOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementRatioFeeOptions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6629,8 +9171,10 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettlementRatioFeeOptions = append(m.SettlementRatioFeeOptions, types.Coin{})
-			if err := m.SettlementRatioFeeOptions[len(m.SettlementRatioFeeOptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6655,7 +9199,7 @@ func (m *QueryOrderFeeCalcResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6678,17 +9222,17 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
-			m.OrderId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6698,64 +9242,29 @@ func (m *QueryGetOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6782,10 +9291,10 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6810,7 +9319,7 @@ func (m *QueryGetOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6833,36 +9342,17 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
-			}
-			m.MarketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MarketId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		case 99:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6872,23 +9362,27 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6911,7 +9405,7 @@ func (m *QueryGetOrderByExternalIDRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6934,15 +9428,15 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOrderByExternalIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6969,10 +9463,44 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Order == nil {
-				m.Order = &Order{}
+			m.Orders = append(m.Orders, &Order{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 99:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6997,7 +9525,7 @@ func (m *QueryGetOrderByExternalIDResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOrderBookRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7020,10 +9548,10 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOrderBookRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7047,7 +9575,7 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7075,13 +9603,13 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
-			m.AfterOrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7091,16 +9619,29 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 99:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var msglen int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLevels", wireType)
+			}
+			m.MaxLevels = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7110,28 +9651,11 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxLevels |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7153,7 +9677,7 @@ func (m *QueryGetMarketOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetOrderBookResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7176,15 +9700,15 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetOrderBookResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMarketOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7211,14 +9735,14 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Asks = append(m.Asks, PriceLevel{})
+			if err := m.Asks[len(m.Asks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 99:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7245,68 +9769,16 @@ func (m *QueryGetMarketOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, PriceLevel{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestAsk", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7316,29 +9788,33 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if m.BestAsk == nil {
+				m.BestAsk = &PriceLevel{}
+			}
+			if err := m.BestAsk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BestBid", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7348,29 +9824,83 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BestBid == nil {
+				m.BestBid = &PriceLevel{}
+			}
+			if err := m.BestBid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.AfterOrderId = 0
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7380,7 +9910,7 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7442,7 +9972,7 @@ func (m *QueryGetOwnerOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketTradesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7465,15 +9995,15 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketTradesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetOwnerOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7500,8 +10030,8 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trades = append(m.Trades, &Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7562,7 +10092,7 @@ func (m *QueryGetOwnerOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7585,15 +10115,34 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7621,11 +10170,11 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Asset = string(dAtA[iNdEx:postIndex])
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7653,13 +10202,13 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderType = string(dAtA[iNdEx:postIndex])
+			m.PriceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterOrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			m.AfterOrderId = 0
+			m.Interval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7669,14 +10218,83 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AfterOrderId |= uint64(b&0x7F) << shift
+				m.Interval |= CandleInterval(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 99:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCandles", wireType)
+			}
+			m.MaxCandles = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCandles |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetMarketCandlesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetMarketCandlesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetMarketCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7703,10 +10321,8 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7731,7 +10347,7 @@ func (m *QueryGetAssetOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetConditionalOrderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7754,17 +10370,17 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetConditionalOrderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAssetOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetConditionalOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			m.OrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7774,29 +10390,64 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetConditionalOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 99:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetConditionalOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetConditionalOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7823,10 +10474,10 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if m.ConditionalOrder == nil {
+				m.ConditionalOrder = &ConditionalOrder{}
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConditionalOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7851,7 +10502,7 @@ func (m *QueryGetAssetOrdersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketConditionalOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7874,12 +10525,31 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketConditionalOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketConditionalOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 99:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -7937,7 +10607,7 @@ func (m *QueryGetAllOrdersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMarketConditionalOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7960,15 +10630,15 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMarketConditionalOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAllOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMarketConditionalOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7995,8 +10665,8 @@ func (m *QueryGetAllOrdersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orders = append(m.Orders, &Order{})
-			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ConditionalOrders = append(m.ConditionalOrders, &ConditionalOrder{})
+			if err := m.ConditionalOrders[len(m.ConditionalOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
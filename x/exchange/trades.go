@@ -0,0 +1,149 @@
+package exchange
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SimpleString returns a lower-cased version of the candle interval without the leading "CANDLE_INTERVAL_",
+// e.g. "one_hour".
+func (i CandleInterval) SimpleString() string {
+	return strings.ToLower(strings.TrimPrefix(i.String(), "CANDLE_INTERVAL_"))
+}
+
+// Validate returns an error if this CandleInterval is unspecified or an unknown value.
+func (i CandleInterval) Validate() error {
+	if i == CandleInterval_unspecified {
+		return errors.New("candle interval is unspecified")
+	}
+	_, exists := CandleInterval_name[int32(i)]
+	if !exists {
+		return fmt.Errorf("candle interval %d does not exist", i)
+	}
+	return nil
+}
+
+// Duration returns the length of time that this candle interval covers.
+// Returns zero if the interval is unspecified or unknown.
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval_one_minute:
+		return time.Minute
+	case CandleInterval_one_hour:
+		return time.Hour
+	case CandleInterval_one_day:
+		return 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// candleIntervalShortNames are the abbreviated names that can be used for the candle intervals.
+var candleIntervalShortNames = map[string]CandleInterval{
+	"1M": CandleInterval_one_minute,
+	"1H": CandleInterval_one_hour,
+	"1D": CandleInterval_one_day,
+}
+
+// ParseCandleInterval converts the provided string into a CandleInterval.
+// The "CANDLE_INTERVAL_" prefix is optional, and the string is case-insensitive.
+// The abbreviations "1m", "1h", and "1d" can also be used.
+func ParseCandleInterval(interval string) (CandleInterval, error) {
+	intUC := strings.ToUpper(strings.TrimSpace(interval))
+	if val, found := candleIntervalShortNames[intUC]; found {
+		return val, nil
+	}
+	if !strings.HasPrefix(intUC, "CANDLE_INTERVAL_") {
+		intUC = "CANDLE_INTERVAL_" + intUC
+	}
+	if val, found := CandleInterval_value[intUC]; found && val != int32(CandleInterval_unspecified) {
+		return CandleInterval(val), nil
+	}
+	return CandleInterval_unspecified, fmt.Errorf("invalid candle interval: %q", interval)
+}
+
+// Validate returns an error if anything in this trade is invalid.
+func (t Trade) Validate() error {
+	var errs []error
+	if t.MarketId == 0 {
+		errs = append(errs, errors.New("invalid market id: cannot be zero"))
+	}
+	if t.TradeId == 0 {
+		errs = append(errs, errors.New("invalid trade id: cannot be zero"))
+	}
+	if len(t.AskOrderIds) == 0 && len(t.BidOrderIds) == 0 {
+		errs = append(errs, errors.New("no order ids"))
+	}
+	if err := validateTradeCoin("assets", t.Assets); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateTradeCoin("price", t.Price); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// validateTradeCoin returns an error if the provided coin is invalid or not positive.
+func validateTradeCoin(field string, coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", field, coin, err)
+	}
+	if !coin.IsPositive() {
+		return fmt.Errorf("invalid %s %q: amount must be positive", field, coin)
+	}
+	return nil
+}
+
+// GetUnitPrice returns the price per one asset of this trade.
+func (t Trade) GetUnitPrice() sdkmath.LegacyDec {
+	return sdkmath.LegacyNewDecFromInt(t.Price.Amount).QuoInt(t.Assets.Amount)
+}
+
+// BuildCandles summarizes the provided trades into candles of the given interval.
+// The trades must all have the same assets and price denoms and be ordered from oldest to newest.
+// The candles are returned ordered from oldest to newest, and intervals without trades are skipped.
+func BuildCandles(trades []*Trade, interval CandleInterval) []Candle {
+	dur := interval.Duration()
+	if dur == 0 || len(trades) == 0 {
+		return nil
+	}
+
+	var rv []Candle
+	for _, trade := range trades {
+		start := trade.BlockTime.UTC().Truncate(dur)
+		unitPrice := trade.GetUnitPrice()
+		if len(rv) == 0 || !rv[len(rv)-1].StartTime.Equal(start) {
+			rv = append(rv, Candle{
+				StartTime:  start,
+				Open:       unitPrice,
+				High:       unitPrice,
+				Low:        unitPrice,
+				Close:      unitPrice,
+				Volume:     trade.Assets,
+				Value:      trade.Price,
+				TradeCount: 1,
+			})
+			continue
+		}
+
+		candle := &rv[len(rv)-1]
+		if unitPrice.GT(candle.High) {
+			candle.High = unitPrice
+		}
+		if unitPrice.LT(candle.Low) {
+			candle.Low = unitPrice
+		}
+		candle.Close = unitPrice
+		candle.Volume = candle.Volume.Add(trade.Assets)
+		candle.Value = candle.Value.Add(trade.Price)
+		candle.TradeCount++
+	}
+
+	return rv
+}
@@ -0,0 +1,1341 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/exchange/v1/trades.proto
+
+package exchange
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CandleInterval is the length of time that a candle covers.
+type CandleInterval int32
+
+const (
+	// CANDLE_INTERVAL_UNSPECIFIED is the zero-value CandleInterval; it is an error to use it.
+	CandleInterval_unspecified CandleInterval = 0
+	// CANDLE_INTERVAL_ONE_MINUTE is for candles that each cover one minute.
+	CandleInterval_one_minute CandleInterval = 1
+	// CANDLE_INTERVAL_ONE_HOUR is for candles that each cover one hour.
+	CandleInterval_one_hour CandleInterval = 2
+	// CANDLE_INTERVAL_ONE_DAY is for candles that each cover one day.
+	CandleInterval_one_day CandleInterval = 3
+)
+
+var CandleInterval_name = map[int32]string{
+	0: "CANDLE_INTERVAL_UNSPECIFIED",
+	1: "CANDLE_INTERVAL_ONE_MINUTE",
+	2: "CANDLE_INTERVAL_ONE_HOUR",
+	3: "CANDLE_INTERVAL_ONE_DAY",
+}
+
+var CandleInterval_value = map[string]int32{
+	"CANDLE_INTERVAL_UNSPECIFIED": 0,
+	"CANDLE_INTERVAL_ONE_MINUTE":  1,
+	"CANDLE_INTERVAL_ONE_HOUR":    2,
+	"CANDLE_INTERVAL_ONE_DAY":     3,
+}
+
+func (x CandleInterval) String() string {
+	return proto.EnumName(CandleInterval_name, int32(x))
+}
+
+func (CandleInterval) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bc4fdd946e917253, []int{0}
+}
+
+// Trade is a record of a settlement that has taken place in a market.
+type Trade struct {
+	// market_id is the numerical identifier of the market the trade took place in.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// trade_id is the numerical identifier of this trade. It is unique within the market.
+	TradeId uint64 `protobuf:"varint,2,opt,name=trade_id,json=tradeId,proto3" json:"trade_id,omitempty"`
+	// ask_order_ids are the ids of the ask orders that were (fully or partially) filled in this trade.
+	AskOrderIds []uint64 `protobuf:"varint,3,rep,packed,name=ask_order_ids,json=askOrderIds,proto3" json:"ask_order_ids,omitempty"`
+	// bid_order_ids are the ids of the bid orders that were (fully or partially) filled in this trade.
+	BidOrderIds []uint64 `protobuf:"varint,4,rep,packed,name=bid_order_ids,json=bidOrderIds,proto3" json:"bid_order_ids,omitempty"`
+	// assets are the total assets that were traded.
+	Assets types.Coin `protobuf:"bytes,5,opt,name=assets,proto3" json:"assets"`
+	// price is the total price paid for the assets.
+	Price types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
+	// block_height is the height of the block that the trade took place in.
+	BlockHeight int64 `protobuf:"varint,7,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block that the trade took place in.
+	BlockTime time.Time `protobuf:"bytes,8,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4fdd946e917253, []int{0}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *Trade) GetTradeId() uint64 {
+	if m != nil {
+		return m.TradeId
+	}
+	return 0
+}
+
+func (m *Trade) GetAskOrderIds() []uint64 {
+	if m != nil {
+		return m.AskOrderIds
+	}
+	return nil
+}
+
+func (m *Trade) GetBidOrderIds() []uint64 {
+	if m != nil {
+		return m.BidOrderIds
+	}
+	return nil
+}
+
+func (m *Trade) GetAssets() types.Coin {
+	if m != nil {
+		return m.Assets
+	}
+	return types.Coin{}
+}
+
+func (m *Trade) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Trade) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Trade) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+// Candle is a summary of the trades of an asset and price denom pair in a market during a single time interval.
+type Candle struct {
+	// start_time is the (inclusive) beginning of the interval this candle covers.
+	StartTime time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// open is the unit price of the first trade in the interval.
+	Open cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	// high is the highest unit price of the trades in the interval.
+	High cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	// low is the lowest unit price of the trades in the interval.
+	Low cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	// close is the unit price of the last trade in the interval.
+	Close cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+	// volume is the total assets traded in the interval.
+	Volume types.Coin `protobuf:"bytes,6,opt,name=volume,proto3" json:"volume"`
+	// value is the total price paid for the assets traded in the interval.
+	Value types.Coin `protobuf:"bytes,7,opt,name=value,proto3" json:"value"`
+	// trade_count is the number of trades in the interval.
+	TradeCount uint32 `protobuf:"varint,8,opt,name=trade_count,json=tradeCount,proto3" json:"trade_count,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bc4fdd946e917253, []int{1}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetVolume() types.Coin {
+	if m != nil {
+		return m.Volume
+	}
+	return types.Coin{}
+}
+
+func (m *Candle) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
+	}
+	return types.Coin{}
+}
+
+func (m *Candle) GetTradeCount() uint32 {
+	if m != nil {
+		return m.TradeCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("provenance.exchange.v1.CandleInterval", CandleInterval_name, CandleInterval_value)
+	proto.RegisterType((*Trade)(nil), "provenance.exchange.v1.Trade")
+	proto.RegisterType((*Candle)(nil), "provenance.exchange.v1.Candle")
+}
+
+func init() {
+	proto.RegisterFile("provenance/exchange/v1/trades.proto", fileDescriptor_bc4fdd946e917253)
+}
+
+var fileDescriptor_bc4fdd946e917253 = []byte{
+	// 691 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc7, 0x63, 0xf2, 0xc9, 0x04, 0xb8, 0x91, 0x75, 0x75, 0xaf, 0x63, 0xa4, 0xc4, 0x97, 0xbb,
+	0x89, 0x90, 0x18, 0xdf, 0xdc, 0xaa, 0xea, 0x9a, 0x7c, 0xb4, 0x58, 0xa2, 0x01, 0xb9, 0xa1, 0x52,
+	0xbb, 0xb1, 0xc6, 0xf6, 0x60, 0x8f, 0x62, 0x7b, 0x22, 0xcf, 0x38, 0x85, 0x57, 0xc8, 0x8a, 0x17,
+	0xc8, 0x5b, 0xf4, 0x21, 0x58, 0xa2, 0xae, 0xaa, 0x2e, 0xa0, 0x82, 0xb7, 0xe8, 0xaa, 0x1a, 0x4f,
+	0xf8, 0x50, 0xc5, 0x82, 0xb2, 0xcb, 0xf9, 0x9f, 0xdf, 0xff, 0xaf, 0xcc, 0xf1, 0x99, 0x01, 0xff,
+	0x4e, 0x53, 0x3a, 0xc3, 0x09, 0x4a, 0x3c, 0x6c, 0xe2, 0x13, 0x2f, 0x44, 0x49, 0x80, 0xcd, 0x59,
+	0xd7, 0xe4, 0x29, 0xf2, 0x31, 0x83, 0xd3, 0x94, 0x72, 0xaa, 0xfe, 0x75, 0x0f, 0xc1, 0x5b, 0x08,
+	0xce, 0xba, 0x7a, 0xcb, 0xa3, 0x2c, 0xa6, 0xcc, 0x74, 0x11, 0x13, 0x26, 0x17, 0x73, 0xd4, 0x35,
+	0x3d, 0x4a, 0x12, 0xe9, 0xd3, 0x9b, 0xb2, 0xef, 0xe4, 0x95, 0x29, 0x8b, 0x65, 0xeb, 0xcf, 0x80,
+	0x06, 0x54, 0xea, 0xe2, 0xd7, 0x52, 0x6d, 0x07, 0x94, 0x06, 0x11, 0x36, 0xf3, 0xca, 0xcd, 0x8e,
+	0x4d, 0x4e, 0x62, 0xcc, 0x38, 0x8a, 0xa7, 0x12, 0xd8, 0xba, 0x5a, 0x01, 0xe5, 0xb1, 0xf8, 0x6b,
+	0xea, 0x26, 0x58, 0x8d, 0x51, 0x3a, 0xc1, 0xdc, 0x21, 0xbe, 0xa6, 0x18, 0x4a, 0x67, 0xdd, 0xae,
+	0x49, 0xc1, 0xf2, 0xd5, 0x26, 0xa8, 0xe5, 0x07, 0x10, 0xbd, 0x15, 0x43, 0xe9, 0x94, 0xec, 0x6a,
+	0x5e, 0x5b, 0xbe, 0xba, 0x05, 0xd6, 0x11, 0x9b, 0x38, 0x34, 0xf5, 0x71, 0xea, 0x10, 0x9f, 0x69,
+	0x45, 0xa3, 0xd8, 0x29, 0xd9, 0x75, 0xc4, 0x26, 0x07, 0x42, 0xb3, 0x7c, 0x26, 0x18, 0x97, 0xf8,
+	0x0f, 0x98, 0x92, 0x64, 0x5c, 0xe2, 0xdf, 0x31, 0xaf, 0x40, 0x05, 0x31, 0x86, 0x39, 0xd3, 0xca,
+	0x86, 0xd2, 0xa9, 0xff, 0xdf, 0x84, 0xcb, 0xf3, 0x89, 0x61, 0xc0, 0xe5, 0x30, 0x60, 0x9f, 0x92,
+	0xa4, 0x57, 0x3a, 0xbf, 0x6c, 0x17, 0xec, 0x25, 0xae, 0xbe, 0x04, 0xe5, 0x69, 0x4a, 0x3c, 0xac,
+	0x55, 0x9e, 0xe6, 0x93, 0xb4, 0xfa, 0x0f, 0x58, 0x73, 0x23, 0xea, 0x4d, 0x9c, 0x10, 0x93, 0x20,
+	0xe4, 0x5a, 0xd5, 0x50, 0x3a, 0x45, 0xbb, 0x9e, 0x6b, 0x7b, 0xb9, 0xa4, 0xf6, 0x01, 0x90, 0x88,
+	0x98, 0x9a, 0x56, 0xcb, 0xe3, 0x75, 0x28, 0x47, 0x0a, 0x6f, 0x47, 0x0a, 0xc7, 0xb7, 0x23, 0xed,
+	0xd5, 0x44, 0xfe, 0xd9, 0x55, 0x5b, 0xb1, 0x57, 0x73, 0x9f, 0xe8, 0x6c, 0xfd, 0x28, 0x82, 0x4a,
+	0x1f, 0x25, 0x7e, 0x84, 0x45, 0x1e, 0xe3, 0x28, 0xe5, 0x32, 0x4f, 0xf9, 0x9d, 0xbc, 0xdc, 0x27,
+	0x3a, 0xea, 0x10, 0x94, 0xe8, 0x14, 0x27, 0xf9, 0x67, 0x58, 0xed, 0x75, 0x05, 0xf2, 0xed, 0xb2,
+	0xbd, 0x29, 0x0f, 0xcd, 0xfc, 0x09, 0x24, 0xd4, 0x8c, 0x11, 0x0f, 0xe1, 0x3e, 0x0e, 0x90, 0x77,
+	0x3a, 0xc0, 0xde, 0x97, 0xcf, 0x3b, 0x60, 0x39, 0x93, 0x01, 0xf6, 0xec, 0xdc, 0x2e, 0x62, 0x42,
+	0x12, 0x84, 0x5a, 0xf1, 0xd9, 0x31, 0xc2, 0xae, 0xf6, 0x41, 0x31, 0xa2, 0x9f, 0xb4, 0xd2, 0x73,
+	0x53, 0x84, 0x5b, 0x7d, 0x03, 0xca, 0x5e, 0x44, 0x19, 0xd6, 0xca, 0xcf, 0x8d, 0x91, 0x7e, 0xb1,
+	0x43, 0x33, 0x1a, 0x65, 0xf1, 0x93, 0x77, 0x61, 0x89, 0x8b, 0x1d, 0x9a, 0xa1, 0x28, 0xc3, 0x5a,
+	0xf5, 0x69, 0x3e, 0x49, 0xab, 0x6d, 0x50, 0x97, 0xd7, 0xc2, 0xa3, 0x59, 0xc2, 0xf3, 0x0d, 0x59,
+	0xb7, 0x41, 0x2e, 0xf5, 0x85, 0xb2, 0x7d, 0xae, 0x80, 0x0d, 0xf9, 0xf1, 0xad, 0x84, 0xe3, 0x74,
+	0x86, 0x22, 0xf5, 0x3f, 0xb0, 0xd9, 0xdf, 0x1d, 0x0d, 0xf6, 0x87, 0x8e, 0x35, 0x1a, 0x0f, 0xed,
+	0xf7, 0xbb, 0xfb, 0xce, 0xd1, 0xe8, 0xdd, 0xe1, 0xb0, 0x6f, 0xbd, 0xb6, 0x86, 0x83, 0x46, 0x41,
+	0xff, 0x63, 0xbe, 0x30, 0xea, 0x59, 0xc2, 0xa6, 0xd8, 0x23, 0xc7, 0x04, 0xfb, 0x2a, 0x04, 0xfa,
+	0xaf, 0x8e, 0x83, 0xd1, 0xd0, 0x79, 0x6b, 0x8d, 0x8e, 0xc6, 0xc3, 0x86, 0xa2, 0x6f, 0xcc, 0x17,
+	0x06, 0xa0, 0x09, 0x76, 0x62, 0x92, 0x64, 0x1c, 0xab, 0xdb, 0x40, 0x7b, 0x8c, 0xdf, 0x3b, 0x38,
+	0xb2, 0x1b, 0x2b, 0xfa, 0xda, 0x7c, 0x61, 0xd4, 0x04, 0x1d, 0xd2, 0x2c, 0x55, 0x3b, 0xe0, 0xef,
+	0xc7, 0xd8, 0xc1, 0xee, 0x87, 0x46, 0x51, 0xaf, 0xcf, 0x17, 0x46, 0x55, 0xa0, 0x3e, 0x3a, 0xed,
+	0xe1, 0xf3, 0xeb, 0x96, 0x72, 0x71, 0xdd, 0x52, 0xbe, 0x5f, 0xb7, 0x94, 0xb3, 0x9b, 0x56, 0xe1,
+	0xe2, 0xa6, 0x55, 0xf8, 0x7a, 0xd3, 0x2a, 0x80, 0x26, 0xa1, 0xf0, 0xf1, 0x07, 0xed, 0x50, 0xf9,
+	0x08, 0x03, 0xc2, 0xc3, 0xcc, 0x85, 0x1e, 0x8d, 0xcd, 0x7b, 0x68, 0x87, 0xd0, 0x07, 0x95, 0x79,
+	0x72, 0xf7, 0x54, 0xba, 0x95, 0xfc, 0x1e, 0xbc, 0xf8, 0x39, 0x00, 0xde, 0x66, 0x42, 0xdb, 0x48,
+	0x05, 0x00, 0x00,
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTrades(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if m.BlockHeight != 0 {
+		i = encodeVarintTrades(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Assets.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.BidOrderIds) > 0 {
+		dAtA5 := make([]byte, len(m.BidOrderIds)*10)
+		var j4 int
+		for _, num := range m.BidOrderIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTrades(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AskOrderIds) > 0 {
+		dAtA7 := make([]byte, len(m.AskOrderIds)*10)
+		var j6 int
+		for _, num := range m.AskOrderIds {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintTrades(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TradeId != 0 {
+		i = encodeVarintTrades(dAtA, i, uint64(m.TradeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTrades(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TradeCount != 0 {
+		i = encodeVarintTrades(dAtA, i, uint64(m.TradeCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Volume.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTrades(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintTrades(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrades(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrades(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovTrades(uint64(m.MarketId))
+	}
+	if m.TradeId != 0 {
+		n += 1 + sovTrades(uint64(m.TradeId))
+	}
+	if len(m.AskOrderIds) > 0 {
+		l = 0
+		for _, e := range m.AskOrderIds {
+			l += sovTrades(uint64(e))
+		}
+		n += 1 + sovTrades(uint64(l)) + l
+	}
+	if len(m.BidOrderIds) > 0 {
+		l = 0
+		for _, e := range m.BidOrderIds {
+			l += sovTrades(uint64(e))
+		}
+		n += 1 + sovTrades(uint64(l)) + l
+	}
+	l = m.Assets.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTrades(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovTrades(uint64(l))
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovTrades(uint64(l))
+	if m.TradeCount != 0 {
+		n += 1 + sovTrades(uint64(m.TradeCount))
+	}
+	return n
+}
+
+func sovTrades(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrades(x uint64) (n int) {
+	return sovTrades(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrades
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeId", wireType)
+			}
+			m.TradeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTrades
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AskOrderIds = append(m.AskOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTrades
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTrades
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTrades
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.AskOrderIds) == 0 {
+					m.AskOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTrades
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AskOrderIds = append(m.AskOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrderIds", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTrades
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BidOrderIds = append(m.BidOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTrades
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTrades
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTrades
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BidOrderIds) == 0 {
+					m.BidOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTrades
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BidOrderIds = append(m.BidOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrderIds", wireType)
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Assets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrades(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Candle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrades
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrades
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeCount", wireType)
+			}
+			m.TradeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrades(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrades
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTrades(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTrades
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTrades
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTrades
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTrades
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTrades
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTrades        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTrades          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTrades = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,290 @@
+package exchange
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil/assertions"
+)
+
+func TestCandleInterval_SimpleString(t *testing.T) {
+	tests := []struct {
+		interval CandleInterval
+		exp      string
+	}{
+		{interval: CandleInterval_unspecified, exp: "unspecified"},
+		{interval: CandleInterval_one_minute, exp: "one_minute"},
+		{interval: CandleInterval_one_hour, exp: "one_hour"},
+		{interval: CandleInterval_one_day, exp: "one_day"},
+		{interval: 7, exp: "7"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.exp, func(t *testing.T) {
+			act := tc.interval.SimpleString()
+			assert.Equal(t, tc.exp, act, "%d.SimpleString()", tc.interval)
+		})
+	}
+}
+
+func TestCandleInterval_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval CandleInterval
+		expErr   string
+	}{
+		{name: "unspecified", interval: CandleInterval_unspecified, expErr: "candle interval is unspecified"},
+		{name: "one_minute", interval: CandleInterval_one_minute},
+		{name: "one_hour", interval: CandleInterval_one_hour},
+		{name: "one_day", interval: CandleInterval_one_day},
+		{name: "unknown", interval: 4, expErr: "candle interval 4 does not exist"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.interval.Validate()
+			assertions.AssertErrorValue(t, err, tc.expErr, "%d.Validate()", tc.interval)
+		})
+	}
+}
+
+func TestCandleInterval_Duration(t *testing.T) {
+	tests := []struct {
+		interval CandleInterval
+		exp      time.Duration
+	}{
+		{interval: CandleInterval_unspecified, exp: 0},
+		{interval: CandleInterval_one_minute, exp: time.Minute},
+		{interval: CandleInterval_one_hour, exp: time.Hour},
+		{interval: CandleInterval_one_day, exp: 24 * time.Hour},
+		{interval: 4, exp: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.interval.SimpleString(), func(t *testing.T) {
+			act := tc.interval.Duration()
+			assert.Equal(t, tc.exp, act, "%d.Duration()", tc.interval)
+		})
+	}
+}
+
+func TestParseCandleInterval(t *testing.T) {
+	tests := []struct {
+		input  string
+		exp    CandleInterval
+		expErr string
+	}{
+		{input: "1m", exp: CandleInterval_one_minute},
+		{input: "1H", exp: CandleInterval_one_hour},
+		{input: " 1d ", exp: CandleInterval_one_day},
+		{input: "one_minute", exp: CandleInterval_one_minute},
+		{input: "ONE_HOUR", exp: CandleInterval_one_hour},
+		{input: "candle_interval_one_day", exp: CandleInterval_one_day},
+		{input: "unspecified", expErr: "invalid candle interval: \"unspecified\""},
+		{input: "", expErr: "invalid candle interval: \"\""},
+		{input: "1w", expErr: "invalid candle interval: \"1w\""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.input, func(t *testing.T) {
+			act, err := ParseCandleInterval(tc.input)
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseCandleInterval(%q) error", tc.input)
+			assert.Equal(t, tc.exp, act, "ParseCandleInterval(%q) result", tc.input)
+		})
+	}
+}
+
+func TestTrade_Validate(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	newTrade := func(mod func(trade *Trade)) Trade {
+		rv := Trade{
+			MarketId:    1,
+			TradeId:     2,
+			AskOrderIds: []uint64{3},
+			BidOrderIds: []uint64{4},
+			Assets:      coin(10, "apple"),
+			Price:       coin(50, "plum"),
+		}
+		if mod != nil {
+			mod(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name   string
+		trade  Trade
+		expErr string
+	}{
+		{name: "okay", trade: newTrade(nil)},
+		{
+			name:  "only ask order ids",
+			trade: newTrade(func(trade *Trade) { trade.BidOrderIds = nil }),
+		},
+		{
+			name:  "only bid order ids",
+			trade: newTrade(func(trade *Trade) { trade.AskOrderIds = nil }),
+		},
+		{
+			name:   "no market id",
+			trade:  newTrade(func(trade *Trade) { trade.MarketId = 0 }),
+			expErr: "invalid market id: cannot be zero",
+		},
+		{
+			name:   "no trade id",
+			trade:  newTrade(func(trade *Trade) { trade.TradeId = 0 }),
+			expErr: "invalid trade id: cannot be zero",
+		},
+		{
+			name: "no order ids",
+			trade: newTrade(func(trade *Trade) {
+				trade.AskOrderIds = nil
+				trade.BidOrderIds = nil
+			}),
+			expErr: "no order ids",
+		},
+		{
+			name:   "invalid assets denom",
+			trade:  newTrade(func(trade *Trade) { trade.Assets.Denom = "x" }),
+			expErr: "invalid assets \"10x\": invalid denom: x",
+		},
+		{
+			name:   "zero assets",
+			trade:  newTrade(func(trade *Trade) { trade.Assets = coin(0, "apple") }),
+			expErr: "invalid assets \"0apple\": amount must be positive",
+		},
+		{
+			name:   "negative price",
+			trade:  newTrade(func(trade *Trade) { trade.Price = coin(-3, "plum") }),
+			expErr: "invalid price \"-3plum\": negative coin amount: -3",
+		},
+		{
+			name:  "multiple errors",
+			trade: Trade{},
+			expErr: joinErrs(
+				"invalid market id: cannot be zero",
+				"invalid trade id: cannot be zero",
+				"no order ids",
+				"invalid assets \"<nil>\": invalid denom: ",
+				"invalid price \"<nil>\": invalid denom: ",
+			),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.trade.Validate()
+			}
+			assert.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorValue(t, err, tc.expErr, "Validate() error")
+		})
+	}
+}
+
+func TestBuildCandles(t *testing.T) {
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.Coin{Denom: denom, Amount: sdkmath.NewInt(amount)}
+	}
+	startTime := time.Date(2024, 5, 6, 7, 8, 0, 0, time.UTC)
+	newTrade := func(offset time.Duration, assets, price int64) *Trade {
+		return &Trade{
+			Assets:    coin(assets, "apple"),
+			Price:     coin(price, "plum"),
+			BlockTime: startTime.Add(offset),
+		}
+	}
+	dec := sdkmath.LegacyMustNewDecFromStr
+
+	tests := []struct {
+		name     string
+		trades   []*Trade
+		interval CandleInterval
+		exp      []Candle
+	}{
+		{
+			name:     "no trades",
+			interval: CandleInterval_one_minute,
+			exp:      nil,
+		},
+		{
+			name:     "unspecified interval",
+			trades:   []*Trade{newTrade(0, 1, 1)},
+			interval: CandleInterval_unspecified,
+			exp:      nil,
+		},
+		{
+			name:     "one trade",
+			trades:   []*Trade{newTrade(5*time.Second, 4, 10)},
+			interval: CandleInterval_one_minute,
+			exp: []Candle{
+				{
+					StartTime: startTime,
+					Open:      dec("2.5"), High: dec("2.5"), Low: dec("2.5"), Close: dec("2.5"),
+					Volume: coin(4, "apple"), Value: coin(10, "plum"), TradeCount: 1,
+				},
+			},
+		},
+		{
+			name: "several trades in several minutes",
+			trades: []*Trade{
+				newTrade(1*time.Second, 10, 30),
+				newTrade(20*time.Second, 10, 50),
+				newTrade(40*time.Second, 10, 20),
+				newTrade(50*time.Second, 10, 40),
+				newTrade(3*time.Minute, 5, 30),
+			},
+			interval: CandleInterval_one_minute,
+			exp: []Candle{
+				{
+					StartTime: startTime,
+					Open:      dec("3"), High: dec("5"), Low: dec("2"), Close: dec("4"),
+					Volume: coin(40, "apple"), Value: coin(140, "plum"), TradeCount: 4,
+				},
+				{
+					StartTime: startTime.Add(3 * time.Minute),
+					Open:      dec("6"), High: dec("6"), Low: dec("6"), Close: dec("6"),
+					Volume: coin(5, "apple"), Value: coin(30, "plum"), TradeCount: 1,
+				},
+			},
+		},
+		{
+			name: "same trades in one hour",
+			trades: []*Trade{
+				newTrade(1*time.Second, 10, 30),
+				newTrade(20*time.Second, 10, 50),
+				newTrade(40*time.Second, 10, 20),
+				newTrade(50*time.Second, 10, 40),
+				newTrade(3*time.Minute, 5, 30),
+			},
+			interval: CandleInterval_one_hour,
+			exp: []Candle{
+				{
+					StartTime: startTime.Truncate(time.Hour),
+					Open:      dec("3"), High: dec("6"), Low: dec("2"), Close: dec("6"),
+					Volume: coin(45, "apple"), Value: coin(170, "plum"), TradeCount: 5,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var act []Candle
+			testFunc := func() {
+				act = BuildCandles(tc.trades, tc.interval)
+			}
+			assert.NotPanics(t, testFunc, "BuildCandles")
+			assert.Equal(t, tc.exp, act, "BuildCandles result")
+		})
+	}
+}
@@ -448,6 +448,145 @@ func (m *MsgCancelOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelOrderResponse proto.InternalMessageInfo
 
+// MsgCreateConditionalOrderRequest is a request message for the CreateConditionalOrder endpoint.
+type MsgCreateConditionalOrderRequest struct {
+	// owner is the account creating the conditional order.
+	// It must be the seller of the ask_order or the buyer of the bid_order.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// ask_order is the ask order to place once the condition is met. Exactly one of ask_order or bid_order is required.
+	AskOrder *AskOrder `protobuf:"bytes,2,opt,name=ask_order,json=askOrder,proto3" json:"ask_order,omitempty"`
+	// bid_order is the bid order to place once the condition is met. Exactly one of ask_order or bid_order is required.
+	BidOrder *BidOrder `protobuf:"bytes,3,opt,name=bid_order,json=bidOrder,proto3" json:"bid_order,omitempty"`
+	// trigger_price is the unit price (amount of the order's price denom per one of its asset denom)
+	// that the trade prices in the order's market are compared to, e.g. "1.5".
+	TriggerPrice string `protobuf:"bytes,4,opt,name=trigger_price,json=triggerPrice,proto3" json:"trigger_price,omitempty"`
+	// trigger_direction defines which side of the trigger_price a trade price must be on to place the order.
+	TriggerDirection TriggerDirection `protobuf:"varint,5,opt,name=trigger_direction,json=triggerDirection,proto3,enum=provenance.exchange.v1.TriggerDirection" json:"trigger_direction,omitempty"`
+	// order_creation_fee is the fee that will be paid when the order is placed.
+	OrderCreationFee *types.Coin `protobuf:"bytes,6,opt,name=order_creation_fee,json=orderCreationFee,proto3" json:"order_creation_fee,omitempty"`
+}
+
+func (m *MsgCreateConditionalOrderRequest) Reset()         { *m = MsgCreateConditionalOrderRequest{} }
+func (m *MsgCreateConditionalOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateConditionalOrderRequest) ProtoMessage()    {}
+func (*MsgCreateConditionalOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{8}
+}
+func (m *MsgCreateConditionalOrderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateConditionalOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateConditionalOrderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateConditionalOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateConditionalOrderRequest.Merge(m, src)
+}
+func (m *MsgCreateConditionalOrderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateConditionalOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateConditionalOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateConditionalOrderRequest proto.InternalMessageInfo
+
+func (m *MsgCreateConditionalOrderRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCreateConditionalOrderRequest) GetAskOrder() *AskOrder {
+	if m != nil {
+		return m.AskOrder
+	}
+	return nil
+}
+
+func (m *MsgCreateConditionalOrderRequest) GetBidOrder() *BidOrder {
+	if m != nil {
+		return m.BidOrder
+	}
+	return nil
+}
+
+func (m *MsgCreateConditionalOrderRequest) GetTriggerPrice() string {
+	if m != nil {
+		return m.TriggerPrice
+	}
+	return ""
+}
+
+func (m *MsgCreateConditionalOrderRequest) GetTriggerDirection() TriggerDirection {
+	if m != nil {
+		return m.TriggerDirection
+	}
+	return TriggerDirection_unspecified
+}
+
+func (m *MsgCreateConditionalOrderRequest) GetOrderCreationFee() *types.Coin {
+	if m != nil {
+		return m.OrderCreationFee
+	}
+	return nil
+}
+
+// MsgCreateConditionalOrderResponse is a response message for the CreateConditionalOrder endpoint.
+type MsgCreateConditionalOrderResponse struct {
+	// order_id is the id reserved for the order.
+	OrderId uint64 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgCreateConditionalOrderResponse) Reset()         { *m = MsgCreateConditionalOrderResponse{} }
+func (m *MsgCreateConditionalOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateConditionalOrderResponse) ProtoMessage()    {}
+func (*MsgCreateConditionalOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{9}
+}
+func (m *MsgCreateConditionalOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateConditionalOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateConditionalOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateConditionalOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateConditionalOrderResponse.Merge(m, src)
+}
+func (m *MsgCreateConditionalOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateConditionalOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateConditionalOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateConditionalOrderResponse proto.InternalMessageInfo
+
+func (m *MsgCreateConditionalOrderResponse) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
 // MsgFillBidsRequest is a request message for the FillBids endpoint.
 type MsgFillBidsRequest struct {
 	// seller is the address of the account with the assets to sell.
@@ -471,7 +610,7 @@ func (m *MsgFillBidsRequest) Reset()         { *m = MsgFillBidsRequest{} }
 func (m *MsgFillBidsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgFillBidsRequest) ProtoMessage()    {}
 func (*MsgFillBidsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{8}
+	return fileDescriptor_e333fcffc093bd1b, []int{10}
 }
 func (m *MsgFillBidsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,7 +689,7 @@ func (m *MsgFillBidsResponse) Reset()         { *m = MsgFillBidsResponse{} }
 func (m *MsgFillBidsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFillBidsResponse) ProtoMessage()    {}
 func (*MsgFillBidsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{9}
+	return fileDescriptor_e333fcffc093bd1b, []int{11}
 }
 func (m *MsgFillBidsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -603,7 +742,7 @@ func (m *MsgFillAsksRequest) Reset()         { *m = MsgFillAsksRequest{} }
 func (m *MsgFillAsksRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgFillAsksRequest) ProtoMessage()    {}
 func (*MsgFillAsksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{10}
+	return fileDescriptor_e333fcffc093bd1b, []int{12}
 }
 func (m *MsgFillAsksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -682,7 +821,7 @@ func (m *MsgFillAsksResponse) Reset()         { *m = MsgFillAsksResponse{} }
 func (m *MsgFillAsksResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFillAsksResponse) ProtoMessage()    {}
 func (*MsgFillAsksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{11}
+	return fileDescriptor_e333fcffc093bd1b, []int{13}
 }
 func (m *MsgFillAsksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +870,7 @@ func (m *MsgMarketSettleRequest) Reset()         { *m = MsgMarketSettleRequest{}
 func (m *MsgMarketSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSettleRequest) ProtoMessage()    {}
 func (*MsgMarketSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{12}
+	return fileDescriptor_e333fcffc093bd1b, []int{14}
 }
 func (m *MsgMarketSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +942,7 @@ func (m *MsgMarketSettleResponse) Reset()         { *m = MsgMarketSettleResponse
 func (m *MsgMarketSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSettleResponse) ProtoMessage()    {}
 func (*MsgMarketSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{13}
+	return fileDescriptor_e333fcffc093bd1b, []int{15}
 }
 func (m *MsgMarketSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +994,7 @@ func (m *MsgMarketCommitmentSettleRequest) Reset()         { *m = MsgMarketCommi
 func (m *MsgMarketCommitmentSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketCommitmentSettleRequest) ProtoMessage()    {}
 func (*MsgMarketCommitmentSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{14}
+	return fileDescriptor_e333fcffc093bd1b, []int{16}
 }
 func (m *MsgMarketCommitmentSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -941,7 +1080,7 @@ func (m *MsgMarketCommitmentSettleResponse) Reset()         { *m = MsgMarketComm
 func (m *MsgMarketCommitmentSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketCommitmentSettleResponse) ProtoMessage()    {}
 func (*MsgMarketCommitmentSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{15}
+	return fileDescriptor_e333fcffc093bd1b, []int{17}
 }
 func (m *MsgMarketCommitmentSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -987,7 +1126,7 @@ func (m *MsgMarketReleaseCommitmentsRequest) Reset()         { *m = MsgMarketRel
 func (m *MsgMarketReleaseCommitmentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketReleaseCommitmentsRequest) ProtoMessage()    {}
 func (*MsgMarketReleaseCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{16}
+	return fileDescriptor_e333fcffc093bd1b, []int{18}
 }
 func (m *MsgMarketReleaseCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1052,7 +1191,7 @@ func (m *MsgMarketReleaseCommitmentsResponse) Reset()         { *m = MsgMarketRe
 func (m *MsgMarketReleaseCommitmentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketReleaseCommitmentsResponse) ProtoMessage()    {}
 func (*MsgMarketReleaseCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{17}
+	return fileDescriptor_e333fcffc093bd1b, []int{19}
 }
 func (m *MsgMarketReleaseCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1098,7 +1237,7 @@ func (m *MsgMarketSetOrderExternalIDRequest) Reset()         { *m = MsgMarketSet
 func (m *MsgMarketSetOrderExternalIDRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSetOrderExternalIDRequest) ProtoMessage()    {}
 func (*MsgMarketSetOrderExternalIDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{18}
+	return fileDescriptor_e333fcffc093bd1b, []int{20}
 }
 func (m *MsgMarketSetOrderExternalIDRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1163,7 +1302,7 @@ func (m *MsgMarketSetOrderExternalIDResponse) Reset()         { *m = MsgMarketSe
 func (m *MsgMarketSetOrderExternalIDResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketSetOrderExternalIDResponse) ProtoMessage()    {}
 func (*MsgMarketSetOrderExternalIDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{19}
+	return fileDescriptor_e333fcffc093bd1b, []int{21}
 }
 func (m *MsgMarketSetOrderExternalIDResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1347,7 @@ func (m *MsgMarketWithdrawRequest) Reset()         { *m = MsgMarketWithdrawReque
 func (m *MsgMarketWithdrawRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketWithdrawRequest) ProtoMessage()    {}
 func (*MsgMarketWithdrawRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{20}
+	return fileDescriptor_e333fcffc093bd1b, []int{22}
 }
 func (m *MsgMarketWithdrawRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1412,7 @@ func (m *MsgMarketWithdrawResponse) Reset()         { *m = MsgMarketWithdrawResp
 func (m *MsgMarketWithdrawResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketWithdrawResponse) ProtoMessage()    {}
 func (*MsgMarketWithdrawResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{21}
+	return fileDescriptor_e333fcffc093bd1b, []int{23}
 }
 func (m *MsgMarketWithdrawResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1316,7 +1455,7 @@ func (m *MsgMarketUpdateDetailsRequest) Reset()         { *m = MsgMarketUpdateDe
 func (m *MsgMarketUpdateDetailsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateDetailsRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateDetailsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{22}
+	return fileDescriptor_e333fcffc093bd1b, []int{24}
 }
 func (m *MsgMarketUpdateDetailsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1374,7 +1513,7 @@ func (m *MsgMarketUpdateDetailsResponse) Reset()         { *m = MsgMarketUpdateD
 func (m *MsgMarketUpdateDetailsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateDetailsResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateDetailsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{23}
+	return fileDescriptor_e333fcffc093bd1b, []int{25}
 }
 func (m *MsgMarketUpdateDetailsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1562,7 @@ func (m *MsgMarketUpdateEnabledRequest) Reset()         { *m = MsgMarketUpdateEn
 func (m *MsgMarketUpdateEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateEnabledRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{24}
+	return fileDescriptor_e333fcffc093bd1b, []int{26}
 }
 func (m *MsgMarketUpdateEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1487,7 +1626,7 @@ func (m *MsgMarketUpdateEnabledResponse) Reset()         { *m = MsgMarketUpdateE
 func (m *MsgMarketUpdateEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateEnabledResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{25}
+	return fileDescriptor_e333fcffc093bd1b, []int{27}
 }
 func (m *MsgMarketUpdateEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1530,7 +1669,7 @@ func (m *MsgMarketUpdateAcceptingOrdersRequest) Reset()         { *m = MsgMarket
 func (m *MsgMarketUpdateAcceptingOrdersRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateAcceptingOrdersRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateAcceptingOrdersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{26}
+	return fileDescriptor_e333fcffc093bd1b, []int{28}
 }
 func (m *MsgMarketUpdateAcceptingOrdersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1590,7 +1729,7 @@ func (m *MsgMarketUpdateAcceptingOrdersResponse) Reset() {
 func (m *MsgMarketUpdateAcceptingOrdersResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateAcceptingOrdersResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateAcceptingOrdersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{27}
+	return fileDescriptor_e333fcffc093bd1b, []int{29}
 }
 func (m *MsgMarketUpdateAcceptingOrdersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1635,7 +1774,7 @@ func (m *MsgMarketUpdateUserSettleRequest) Reset()         { *m = MsgMarketUpdat
 func (m *MsgMarketUpdateUserSettleRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateUserSettleRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateUserSettleRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{28}
+	return fileDescriptor_e333fcffc093bd1b, []int{30}
 }
 func (m *MsgMarketUpdateUserSettleRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1693,7 +1832,7 @@ func (m *MsgMarketUpdateUserSettleResponse) Reset()         { *m = MsgMarketUpda
 func (m *MsgMarketUpdateUserSettleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateUserSettleResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateUserSettleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{29}
+	return fileDescriptor_e333fcffc093bd1b, []int{31}
 }
 func (m *MsgMarketUpdateUserSettleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1742,7 +1881,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsRequest) String() string {
 }
 func (*MsgMarketUpdateAcceptingCommitmentsRequest) ProtoMessage() {}
 func (*MsgMarketUpdateAcceptingCommitmentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{30}
+	return fileDescriptor_e333fcffc093bd1b, []int{32}
 }
 func (m *MsgMarketUpdateAcceptingCommitmentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1804,7 +1943,7 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) String() string {
 }
 func (*MsgMarketUpdateAcceptingCommitmentsResponse) ProtoMessage() {}
 func (*MsgMarketUpdateAcceptingCommitmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{31}
+	return fileDescriptor_e333fcffc093bd1b, []int{33}
 }
 func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1833,6 +1972,108 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgMarketUpdateAcceptingCommitmentsResponse proto.InternalMessageInfo
 
+// MsgMarketUpdateAutoMatchRequest is a request message for the MarketUpdateAutoMatch endpoint.
+type MsgMarketUpdateAutoMatchRequest struct {
+	// admin is the account with "update" permission requesting this change.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// market_id is the numerical identifier of the market to enable or disable auto-matching for.
+	MarketId uint32 `protobuf:"varint,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// auto_match is whether this market's crossing orders are automatically matched and settled at the end of each block.
+	// The MarketSettle, FillBids, and FillAsks endpoints are unaffected by the value of this field.
+	AutoMatch bool `protobuf:"varint,3,opt,name=auto_match,json=autoMatch,proto3" json:"auto_match,omitempty"`
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) Reset()         { *m = MsgMarketUpdateAutoMatchRequest{} }
+func (m *MsgMarketUpdateAutoMatchRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAutoMatchRequest) ProtoMessage()    {}
+func (*MsgMarketUpdateAutoMatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{34}
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.Merge(m, src)
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateAutoMatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateAutoMatchRequest proto.InternalMessageInfo
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) GetAutoMatch() bool {
+	if m != nil {
+		return m.AutoMatch
+	}
+	return false
+}
+
+// MsgMarketUpdateAutoMatchResponse is a response message for the MarketUpdateAutoMatch endpoint.
+type MsgMarketUpdateAutoMatchResponse struct {
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Reset()         { *m = MsgMarketUpdateAutoMatchResponse{} }
+func (m *MsgMarketUpdateAutoMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarketUpdateAutoMatchResponse) ProtoMessage()    {}
+func (*MsgMarketUpdateAutoMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e333fcffc093bd1b, []int{35}
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.Merge(m, src)
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMarketUpdateAutoMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarketUpdateAutoMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMarketUpdateAutoMatchResponse proto.InternalMessageInfo
+
 // MsgMarketUpdateIntermediaryDenomRequest is a request message for the MarketUpdateIntermediaryDenom endpoint.
 type MsgMarketUpdateIntermediaryDenomRequest struct {
 	// admin is the account with "update" permission requesting this change.
@@ -1849,7 +2090,7 @@ func (m *MsgMarketUpdateIntermediaryDenomRequest) Reset() {
 func (m *MsgMarketUpdateIntermediaryDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateIntermediaryDenomRequest) ProtoMessage()    {}
 func (*MsgMarketUpdateIntermediaryDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{32}
+	return fileDescriptor_e333fcffc093bd1b, []int{36}
 }
 func (m *MsgMarketUpdateIntermediaryDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2150,7 @@ func (m *MsgMarketUpdateIntermediaryDenomResponse) Reset() {
 func (m *MsgMarketUpdateIntermediaryDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketUpdateIntermediaryDenomResponse) ProtoMessage()    {}
 func (*MsgMarketUpdateIntermediaryDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{33}
+	return fileDescriptor_e333fcffc093bd1b, []int{37}
 }
 func (m *MsgMarketUpdateIntermediaryDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1956,7 +2197,7 @@ func (m *MsgMarketManagePermissionsRequest) Reset()         { *m = MsgMarketMana
 func (m *MsgMarketManagePermissionsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsRequest) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{34}
+	return fileDescriptor_e333fcffc093bd1b, []int{38}
 }
 func (m *MsgMarketManagePermissionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2028,7 +2269,7 @@ func (m *MsgMarketManagePermissionsResponse) Reset()         { *m = MsgMarketMan
 func (m *MsgMarketManagePermissionsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManagePermissionsResponse) ProtoMessage()    {}
 func (*MsgMarketManagePermissionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{35}
+	return fileDescriptor_e333fcffc093bd1b, []int{39}
 }
 func (m *MsgMarketManagePermissionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2081,7 +2322,7 @@ func (m *MsgMarketManageReqAttrsRequest) Reset()         { *m = MsgMarketManageR
 func (m *MsgMarketManageReqAttrsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsRequest) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{36}
+	return fileDescriptor_e333fcffc093bd1b, []int{40}
 }
 func (m *MsgMarketManageReqAttrsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2174,7 +2415,7 @@ func (m *MsgMarketManageReqAttrsResponse) Reset()         { *m = MsgMarketManage
 func (m *MsgMarketManageReqAttrsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgMarketManageReqAttrsResponse) ProtoMessage()    {}
 func (*MsgMarketManageReqAttrsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{37}
+	return fileDescriptor_e333fcffc093bd1b, []int{41}
 }
 func (m *MsgMarketManageReqAttrsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2454,7 @@ func (m *MsgCreatePaymentRequest) Reset()         { *m = MsgCreatePaymentRequest
 func (m *MsgCreatePaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentRequest) ProtoMessage()    {}
 func (*MsgCreatePaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{38}
+	return fileDescriptor_e333fcffc093bd1b, []int{42}
 }
 func (m *MsgCreatePaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2257,7 +2498,7 @@ func (m *MsgCreatePaymentResponse) Reset()         { *m = MsgCreatePaymentRespon
 func (m *MsgCreatePaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreatePaymentResponse) ProtoMessage()    {}
 func (*MsgCreatePaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{39}
+	return fileDescriptor_e333fcffc093bd1b, []int{43}
 }
 func (m *MsgCreatePaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2296,7 +2537,7 @@ func (m *MsgAcceptPaymentRequest) Reset()         { *m = MsgAcceptPaymentRequest
 func (m *MsgAcceptPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentRequest) ProtoMessage()    {}
 func (*MsgAcceptPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{40}
+	return fileDescriptor_e333fcffc093bd1b, []int{44}
 }
 func (m *MsgAcceptPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2581,7 @@ func (m *MsgAcceptPaymentResponse) Reset()         { *m = MsgAcceptPaymentRespon
 func (m *MsgAcceptPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAcceptPaymentResponse) ProtoMessage()    {}
 func (*MsgAcceptPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{41}
+	return fileDescriptor_e333fcffc093bd1b, []int{45}
 }
 func (m *MsgAcceptPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2383,7 +2624,7 @@ func (m *MsgRejectPaymentRequest) Reset()         { *m = MsgRejectPaymentRequest
 func (m *MsgRejectPaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{42}
+	return fileDescriptor_e333fcffc093bd1b, []int{46}
 }
 func (m *MsgRejectPaymentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2441,7 +2682,7 @@ func (m *MsgRejectPaymentResponse) Reset()         { *m = MsgRejectPaymentRespon
 func (m *MsgRejectPaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{43}
+	return fileDescriptor_e333fcffc093bd1b, []int{47}
 }
 func (m *MsgRejectPaymentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2723,7 @@ func (m *MsgRejectPaymentsRequest) Reset()         { *m = MsgRejectPaymentsReque
 func (m *MsgRejectPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsRequest) ProtoMessage()    {}
 func (*MsgRejectPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{44}
+	return fileDescriptor_e333fcffc093bd1b, []int{48}
 }
 func (m *MsgRejectPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2533,7 +2774,7 @@ func (m *MsgRejectPaymentsResponse) Reset()         { *m = MsgRejectPaymentsResp
 func (m *MsgRejectPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRejectPaymentsResponse) ProtoMessage()    {}
 func (*MsgRejectPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{45}
+	return fileDescriptor_e333fcffc093bd1b, []int{49}
 }
 func (m *MsgRejectPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2574,7 +2815,7 @@ func (m *MsgCancelPaymentsRequest) Reset()         { *m = MsgCancelPaymentsReque
 func (m *MsgCancelPaymentsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsRequest) ProtoMessage()    {}
 func (*MsgCancelPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{46}
+	return fileDescriptor_e333fcffc093bd1b, []int{50}
 }
 func (m *MsgCancelPaymentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2625,7 +2866,7 @@ func (m *MsgCancelPaymentsResponse) Reset()         { *m = MsgCancelPaymentsResp
 func (m *MsgCancelPaymentsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelPaymentsResponse) ProtoMessage()    {}
 func (*MsgCancelPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{47}
+	return fileDescriptor_e333fcffc093bd1b, []int{51}
 }
 func (m *MsgCancelPaymentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2668,7 +2909,7 @@ func (m *MsgChangePaymentTargetRequest) Reset()         { *m = MsgChangePaymentT
 func (m *MsgChangePaymentTargetRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetRequest) ProtoMessage()    {}
 func (*MsgChangePaymentTargetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{48}
+	return fileDescriptor_e333fcffc093bd1b, []int{52}
 }
 func (m *MsgChangePaymentTargetRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2726,7 +2967,7 @@ func (m *MsgChangePaymentTargetResponse) Reset()         { *m = MsgChangePayment
 func (m *MsgChangePaymentTargetResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangePaymentTargetResponse) ProtoMessage()    {}
 func (*MsgChangePaymentTargetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{49}
+	return fileDescriptor_e333fcffc093bd1b, []int{53}
 }
 func (m *MsgChangePaymentTargetResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2769,7 +3010,7 @@ func (m *MsgGovCreateMarketRequest) Reset()         { *m = MsgGovCreateMarketReq
 func (m *MsgGovCreateMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketRequest) ProtoMessage()    {}
 func (*MsgGovCreateMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{50}
+	return fileDescriptor_e333fcffc093bd1b, []int{54}
 }
 func (m *MsgGovCreateMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2820,7 +3061,7 @@ func (m *MsgGovCreateMarketResponse) Reset()         { *m = MsgGovCreateMarketRe
 func (m *MsgGovCreateMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCreateMarketResponse) ProtoMessage()    {}
 func (*MsgGovCreateMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{51}
+	return fileDescriptor_e333fcffc093bd1b, []int{55}
 }
 func (m *MsgGovCreateMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2895,7 +3136,7 @@ func (m *MsgGovManageFeesRequest) Reset()         { *m = MsgGovManageFeesRequest
 func (m *MsgGovManageFeesRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesRequest) ProtoMessage()    {}
 func (*MsgGovManageFeesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{52}
+	return fileDescriptor_e333fcffc093bd1b, []int{56}
 }
 func (m *MsgGovManageFeesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3058,7 +3299,7 @@ func (m *MsgGovManageFeesResponse) Reset()         { *m = MsgGovManageFeesRespon
 func (m *MsgGovManageFeesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovManageFeesResponse) ProtoMessage()    {}
 func (*MsgGovManageFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{53}
+	return fileDescriptor_e333fcffc093bd1b, []int{57}
 }
 func (m *MsgGovManageFeesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3099,7 +3340,7 @@ func (m *MsgGovCloseMarketRequest) Reset()         { *m = MsgGovCloseMarketReque
 func (m *MsgGovCloseMarketRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketRequest) ProtoMessage()    {}
 func (*MsgGovCloseMarketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{54}
+	return fileDescriptor_e333fcffc093bd1b, []int{58}
 }
 func (m *MsgGovCloseMarketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3150,7 +3391,7 @@ func (m *MsgGovCloseMarketResponse) Reset()         { *m = MsgGovCloseMarketResp
 func (m *MsgGovCloseMarketResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovCloseMarketResponse) ProtoMessage()    {}
 func (*MsgGovCloseMarketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{55}
+	return fileDescriptor_e333fcffc093bd1b, []int{59}
 }
 func (m *MsgGovCloseMarketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3194,7 +3435,7 @@ func (m *MsgGovUpdateParamsRequest) Reset()         { *m = MsgGovUpdateParamsReq
 func (m *MsgGovUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgGovUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{56}
+	return fileDescriptor_e333fcffc093bd1b, []int{60}
 }
 func (m *MsgGovUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3248,7 +3489,7 @@ func (m *MsgGovUpdateParamsResponse) Reset()         { *m = MsgGovUpdateParamsRe
 func (m *MsgGovUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgGovUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgGovUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{57}
+	return fileDescriptor_e333fcffc093bd1b, []int{61}
 }
 func (m *MsgGovUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3530,7 @@ func (m *MsgUpdateParamsRequest) Reset()         { *m = MsgUpdateParamsRequest{}
 func (m *MsgUpdateParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsRequest) ProtoMessage()    {}
 func (*MsgUpdateParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{58}
+	return fileDescriptor_e333fcffc093bd1b, []int{62}
 }
 func (m *MsgUpdateParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3340,7 +3581,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e333fcffc093bd1b, []int{59}
+	return fileDescriptor_e333fcffc093bd1b, []int{63}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3378,6 +3619,8 @@ func init() {
 	proto.RegisterType((*MsgCommitFundsResponse)(nil), "provenance.exchange.v1.MsgCommitFundsResponse")
 	proto.RegisterType((*MsgCancelOrderRequest)(nil), "provenance.exchange.v1.MsgCancelOrderRequest")
 	proto.RegisterType((*MsgCancelOrderResponse)(nil), "provenance.exchange.v1.MsgCancelOrderResponse")
+	proto.RegisterType((*MsgCreateConditionalOrderRequest)(nil), "provenance.exchange.v1.MsgCreateConditionalOrderRequest")
+	proto.RegisterType((*MsgCreateConditionalOrderResponse)(nil), "provenance.exchange.v1.MsgCreateConditionalOrderResponse")
 	proto.RegisterType((*MsgFillBidsRequest)(nil), "provenance.exchange.v1.MsgFillBidsRequest")
 	proto.RegisterType((*MsgFillBidsResponse)(nil), "provenance.exchange.v1.MsgFillBidsResponse")
 	proto.RegisterType((*MsgFillAsksRequest)(nil), "provenance.exchange.v1.MsgFillAsksRequest")
@@ -3402,6 +3645,8 @@ func init() {
 	proto.RegisterType((*MsgMarketUpdateUserSettleResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateUserSettleResponse")
 	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsRequest")
 	proto.RegisterType((*MsgMarketUpdateAcceptingCommitmentsResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAcceptingCommitmentsResponse")
+	proto.RegisterType((*MsgMarketUpdateAutoMatchRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateAutoMatchRequest")
+	proto.RegisterType((*MsgMarketUpdateAutoMatchResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateAutoMatchResponse")
 	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomRequest)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomRequest")
 	proto.RegisterType((*MsgMarketUpdateIntermediaryDenomResponse)(nil), "provenance.exchange.v1.MsgMarketUpdateIntermediaryDenomResponse")
 	proto.RegisterType((*MsgMarketManagePermissionsRequest)(nil), "provenance.exchange.v1.MsgMarketManagePermissionsRequest")
@@ -3435,184 +3680,194 @@ func init() {
 func init() { proto.RegisterFile("provenance/exchange/v1/tx.proto", fileDescriptor_e333fcffc093bd1b) }
 
 var fileDescriptor_e333fcffc093bd1b = []byte{
-	// 2830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xdd, 0x6f, 0x1c, 0x57,
-	0x15, 0xcf, 0x78, 0xfd, 0xb5, 0xc7, 0x1f, 0x4d, 0xae, 0xed, 0x64, 0x3d, 0xae, 0xd7, 0x9b, 0x4d,
-	0x03, 0xc6, 0xc5, 0xbb, 0xb1, 0x2b, 0x5a, 0x30, 0x2d, 0xa9, 0xd7, 0x89, 0x23, 0x57, 0x4a, 0xb0,
-	0x36, 0x09, 0x48, 0xe5, 0x61, 0x75, 0xbd, 0x73, 0xb3, 0x19, 0x3c, 0x3b, 0xb3, 0x99, 0x3b, 0xeb,
-	0xd8, 0x12, 0x08, 0x84, 0x2a, 0x01, 0x0f, 0x95, 0x2a, 0x21, 0x5e, 0x10, 0x42, 0x02, 0x24, 0x04,
-	0xe4, 0x81, 0x20, 0x10, 0xe2, 0xe3, 0x91, 0x97, 0x3e, 0xf4, 0xa1, 0xe2, 0x89, 0x27, 0xa8, 0x12,
-	0x89, 0xfc, 0x17, 0x08, 0xdd, 0x8f, 0xd9, 0x9d, 0xef, 0x99, 0x75, 0xb3, 0x11, 0x2f, 0x6d, 0x76,
-	0xe6, 0x9c, 0xf3, 0x3b, 0xbf, 0x73, 0xee, 0x9d, 0x7b, 0xe6, 0x9c, 0x31, 0xac, 0x74, 0x6c, 0xeb,
-	0x88, 0x98, 0xd8, 0x6c, 0x92, 0x2a, 0x39, 0x6e, 0xde, 0xc7, 0x66, 0x8b, 0x54, 0x8f, 0x36, 0xaa,
-	0xce, 0x71, 0xa5, 0x63, 0x5b, 0x8e, 0x85, 0xce, 0xf7, 0x05, 0x2a, 0xae, 0x40, 0xe5, 0x68, 0x43,
-	0x3d, 0x87, 0xdb, 0xba, 0x69, 0x55, 0xf9, 0x7f, 0x85, 0xa8, 0x5a, 0x6c, 0x5a, 0xb4, 0x6d, 0xd1,
-	0xea, 0x01, 0xa6, 0xcc, 0xc6, 0x01, 0x71, 0xf0, 0x46, 0xb5, 0x69, 0xe9, 0xa6, 0xbc, 0x7f, 0x41,
-	0xde, 0x6f, 0xd3, 0x16, 0x83, 0x68, 0xd3, 0x96, 0xbc, 0xb1, 0x28, 0x6e, 0x34, 0xf8, 0xaf, 0xaa,
-	0xf8, 0x21, 0x6f, 0xcd, 0xb7, 0xac, 0x96, 0x25, 0xae, 0xb3, 0x7f, 0xc9, 0xab, 0xab, 0x31, 0x5e,
-	0x37, 0xad, 0x76, 0x5b, 0x77, 0xda, 0xc4, 0x74, 0x5c, 0xfd, 0x4b, 0x31, 0x92, 0x6d, 0x6c, 0x1f,
-	0x12, 0x27, 0x45, 0xc8, 0xb2, 0x35, 0x62, 0xa7, 0x59, 0xea, 0x60, 0x1b, 0xb7, 0x5d, 0xa1, 0xcb,
-	0xb1, 0x42, 0x27, 0x1e, 0xaf, 0xca, 0x7f, 0x50, 0x60, 0xee, 0x26, 0x6d, 0xed, 0xd8, 0x04, 0x3b,
-	0x64, 0x9b, 0x1e, 0xd6, 0xc9, 0x83, 0x2e, 0xa1, 0x0e, 0xda, 0x81, 0x3c, 0xa6, 0x87, 0x0d, 0x8e,
-	0x5b, 0x50, 0x4a, 0xca, 0xea, 0xd4, 0x66, 0xa9, 0x12, 0x9d, 0x80, 0xca, 0x36, 0x3d, 0xfc, 0x2a,
-	0x93, 0xab, 0x8d, 0x7e, 0xf8, 0xaf, 0x95, 0x33, 0xf5, 0x49, 0x2c, 0x7f, 0xa3, 0x1b, 0x80, 0xb8,
-	0x81, 0x46, 0x93, 0x99, 0xd7, 0x2d, 0xb3, 0x71, 0x8f, 0x90, 0xc2, 0x08, 0xb7, 0xb6, 0x58, 0x91,
-	0xd1, 0x65, 0x39, 0xaa, 0xc8, 0x1c, 0x55, 0x76, 0x2c, 0xdd, 0xac, 0x9f, 0xe5, 0x4a, 0x3b, 0x52,
-	0x67, 0x97, 0x90, 0xad, 0xd9, 0xef, 0x3d, 0x7b, 0xbc, 0xd6, 0x77, 0xa8, 0xbc, 0x01, 0xf3, 0x7e,
-	0xa7, 0x69, 0xc7, 0x32, 0x29, 0x41, 0x8b, 0x30, 0x29, 0x00, 0x75, 0x8d, 0x3b, 0x3d, 0x5a, 0x9f,
-	0xe0, 0xbf, 0xf7, 0x34, 0x3f, 0xd1, 0x9a, 0xae, 0x79, 0x88, 0x1e, 0xe8, 0x5a, 0x36, 0xa2, 0x35,
-	0x5d, 0xf3, 0x11, 0x3d, 0x90, 0xbf, 0x9f, 0x37, 0xd1, 0x9e, 0x43, 0x3e, 0xa2, 0xdc, 0xe9, 0x74,
-	0xa2, 0x1f, 0x8d, 0xc0, 0x02, 0xd3, 0xe1, 0x0b, 0x70, 0xb7, 0x6b, 0x6a, 0xd4, 0xa5, 0xba, 0x09,
-	0x13, 0xb8, 0xd9, 0xb4, 0xba, 0xa6, 0xc3, 0x75, 0xf2, 0xb5, 0xc2, 0x3f, 0xfe, 0xb8, 0x3e, 0x2f,
-	0xbd, 0xdb, 0xd6, 0x34, 0x9b, 0x50, 0x7a, 0xdb, 0xb1, 0x75, 0xb3, 0x55, 0x77, 0x05, 0xd1, 0x12,
-	0xe4, 0xc5, 0x02, 0x65, 0x48, 0x8c, 0xd0, 0x4c, 0x7d, 0x52, 0x5c, 0xd8, 0xd3, 0xd0, 0x09, 0x8c,
-	0xe3, 0x36, 0xb7, 0x97, 0x2b, 0xe5, 0x12, 0xa9, 0xd6, 0x76, 0x59, 0xc4, 0x7e, 0xfb, 0xef, 0x95,
-	0xd5, 0x96, 0xee, 0xdc, 0xef, 0x1e, 0x54, 0x9a, 0x56, 0x5b, 0x6e, 0x2f, 0xf9, 0xbf, 0x75, 0xaa,
-	0x1d, 0x56, 0x9d, 0x93, 0x0e, 0xa1, 0x5c, 0x81, 0xfe, 0xe4, 0xd9, 0xe3, 0xb5, 0x69, 0x83, 0xb4,
-	0x70, 0xf3, 0xa4, 0xc1, 0x76, 0x2e, 0xfd, 0xf5, 0xb3, 0xc7, 0x6b, 0x4a, 0x5d, 0x02, 0xa2, 0x37,
-	0x61, 0xda, 0x17, 0xeb, 0xd1, 0xb4, 0x58, 0x4f, 0x35, 0xfb, 0x61, 0x66, 0xac, 0xc8, 0x11, 0x31,
-	0x9d, 0x86, 0x83, 0x5b, 0x85, 0x31, 0x16, 0x8b, 0xfa, 0x24, 0xbf, 0x70, 0x07, 0xb7, 0xb6, 0xa6,
-	0x59, 0x0e, 0xdc, 0x00, 0x94, 0x0b, 0x70, 0x3e, 0x18, 0x4d, 0x91, 0x83, 0xf2, 0x03, 0x11, 0x67,
-	0xb6, 0x4a, 0x0c, 0xbe, 0x0c, 0xdc, 0x38, 0x5f, 0x81, 0x71, 0xaa, 0xb7, 0x4c, 0xb9, 0x9e, 0x92,
-	0xc2, 0x2c, 0xe5, 0x7c, 0xe9, 0x1c, 0xf1, 0xa5, 0x73, 0x6b, 0x8a, 0x79, 0x23, 0xe5, 0x5c, 0x67,
-	0xbc, 0x90, 0xd2, 0x99, 0xbf, 0xe7, 0x00, 0xdd, 0xa4, 0xad, 0x5d, 0xdd, 0x30, 0x6a, 0x7a, 0x3f,
-	0xe5, 0xcc, 0x15, 0x62, 0x18, 0x99, 0x5c, 0xe1, 0x72, 0xc9, 0x09, 0x7f, 0x4f, 0x81, 0x69, 0xc7,
-	0x72, 0xb0, 0xd1, 0xc0, 0x94, 0x12, 0x87, 0xbe, 0xb8, 0xbc, 0x4f, 0x71, 0xd8, 0x6d, 0x8e, 0x8a,
-	0xca, 0x30, 0xd3, 0xdb, 0x22, 0x0d, 0x5d, 0xa3, 0x85, 0xd1, 0x52, 0x6e, 0x75, 0xb4, 0x3e, 0xe5,
-	0xee, 0xc7, 0x3d, 0x8d, 0xa2, 0xaf, 0x81, 0x2a, 0x18, 0x35, 0x28, 0x71, 0x1c, 0x83, 0xb0, 0x87,
-	0x5e, 0xe3, 0x9e, 0x81, 0x1d, 0xbe, 0x5c, 0xc6, 0xd2, 0x96, 0xcb, 0x05, 0xa1, 0x7c, 0xbb, 0xa7,
-	0xbb, 0x6b, 0x60, 0x87, 0x2d, 0x9d, 0x5b, 0x70, 0xbe, 0xf7, 0x1c, 0xf2, 0x6f, 0xf7, 0xf1, 0x34,
-	0x9b, 0x73, 0xee, 0x83, 0xd1, 0xbb, 0xe3, 0x65, 0x7e, 0x39, 0x5a, 0x79, 0x81, 0x3f, 0xa3, 0xfa,
-	0x49, 0x94, 0xc9, 0xfd, 0x5b, 0x3f, 0xb9, 0xdb, 0xf4, 0xb0, 0x97, 0xdc, 0x0a, 0x8c, 0x1d, 0x74,
-	0x4f, 0x32, 0xe4, 0x56, 0x88, 0x25, 0xa7, 0xf6, 0x6d, 0x10, 0x21, 0x6e, 0x74, 0x6c, 0xbd, 0x49,
-	0x0a, 0xb9, 0x14, 0x32, 0xf2, 0x11, 0x08, 0x5c, 0x67, 0x9f, 0xa9, 0xb0, 0xac, 0xf4, 0x23, 0xe3,
-	0xc9, 0x8a, 0xcb, 0x9a, 0x65, 0xe5, 0xc7, 0x0a, 0x2c, 0x70, 0x67, 0x7c, 0x59, 0x21, 0x84, 0x16,
-	0xc6, 0x5e, 0xd4, 0x4a, 0x9a, 0xe3, 0xf8, 0x9e, 0xc4, 0x12, 0x42, 0x59, 0x56, 0xfb, 0x2b, 0x6a,
-	0xc0, 0xac, 0xba, 0xab, 0xce, 0x9b, 0x55, 0x60, 0x59, 0x15, 0x61, 0xf7, 0x24, 0x55, 0x24, 0x4f,
-	0x26, 0xf5, 0x13, 0x85, 0x6f, 0xe6, 0x9b, 0x3c, 0x01, 0xc2, 0x1d, 0x4f, 0x62, 0xb1, 0xd6, 0xd6,
-	0xcd, 0xf4, 0xc4, 0x72, 0xb1, 0xe4, 0xc4, 0x86, 0xd2, 0x92, 0x0b, 0xa7, 0x25, 0xcb, 0x86, 0xba,
-	0x0c, 0xb3, 0xe4, 0xb8, 0x43, 0x9a, 0x4e, 0xa3, 0x83, 0x6d, 0x47, 0xc7, 0x06, 0xdf, 0x44, 0x93,
-	0xf5, 0x19, 0x71, 0x75, 0x5f, 0x5c, 0x94, 0xcc, 0xb9, 0x5f, 0xe5, 0x45, 0xb8, 0x10, 0x62, 0x28,
-	0xd9, 0xff, 0x2a, 0x07, 0xa5, 0xde, 0xbd, 0x9d, 0x5e, 0xb1, 0x34, 0xc4, 0x38, 0xec, 0xc0, 0xb8,
-	0x6e, 0x76, 0xba, 0xbd, 0x87, 0xd6, 0xe5, 0xd8, 0x72, 0x46, 0x3c, 0xf9, 0xb7, 0xf9, 0x41, 0x23,
-	0xd7, 0xb9, 0x54, 0x45, 0xd7, 0x61, 0xc2, 0xea, 0x3a, 0xdc, 0xca, 0xe8, 0xe0, 0x56, 0x5c, 0x5d,
-	0x74, 0x15, 0x46, 0x3d, 0x8b, 0x7e, 0x20, 0x1b, 0x5c, 0x91, 0x19, 0x30, 0xf1, 0x11, 0x2d, 0x8c,
-	0x27, 0x1b, 0xb8, 0x45, 0x1c, 0xfe, 0xc8, 0xe4, 0x1b, 0xd4, 0x35, 0xc0, 0x14, 0xfd, 0x27, 0xe0,
-	0x44, 0xe0, 0x04, 0xf4, 0xe6, 0xf0, 0x12, 0x5c, 0x4c, 0xc8, 0x93, 0xcc, 0xe6, 0x7f, 0x14, 0x28,
-	0xf7, 0xa4, 0xea, 0xc4, 0x20, 0x98, 0x92, 0xbe, 0x30, 0x1d, 0x4a, 0x3e, 0xdf, 0x01, 0x70, 0xac,
-	0x86, 0x2d, 0xc0, 0x4e, 0x93, 0xd3, 0xbc, 0x63, 0x49, 0x57, 0xfd, 0xd1, 0x18, 0x4d, 0x88, 0xc6,
-	0x65, 0xb8, 0x94, 0xc8, 0x53, 0xc6, 0xe3, 0x2f, 0xde, 0x78, 0xdc, 0x26, 0x0e, 0xdf, 0x44, 0xd7,
-	0x8f, 0x1d, 0x62, 0x9b, 0xd8, 0xd8, 0xbb, 0x36, 0x94, 0x78, 0x78, 0x6b, 0x88, 0x9c, 0xaf, 0x86,
-	0x40, 0x2b, 0x30, 0x45, 0x24, 0x38, 0xbb, 0x2b, 0x08, 0x82, 0x7b, 0x69, 0x4f, 0x8b, 0xa5, 0x18,
-	0xe5, 0xba, 0xa4, 0xf8, 0xfe, 0x08, 0x14, 0x7a, 0x72, 0x5f, 0xd7, 0x9d, 0xfb, 0x9a, 0x8d, 0x1f,
-	0x0e, 0x85, 0xd8, 0x32, 0x4f, 0x34, 0x16, 0x7a, 0x9c, 0x5a, 0x9e, 0xe5, 0x4e, 0x1a, 0xf2, 0x14,
-	0xa1, 0xa3, 0x2f, 0xb8, 0x08, 0xf5, 0x85, 0x6d, 0x09, 0x16, 0x23, 0xc2, 0x21, 0x83, 0xf5, 0x91,
-	0x02, 0xcb, 0xbd, 0xbb, 0x77, 0x3b, 0x1a, 0x76, 0xc8, 0x35, 0xe2, 0x60, 0xdd, 0x18, 0xce, 0xd6,
-	0xa8, 0xc3, 0xac, 0xbc, 0xa9, 0x09, 0x14, 0x79, 0x9c, 0xc7, 0x6e, 0x0f, 0xe1, 0x98, 0x74, 0x49,
-	0x6e, 0x8f, 0x99, 0xb6, 0xf7, 0xa2, 0x8f, 0x6b, 0x09, 0x8a, 0x71, 0x6c, 0x24, 0xe1, 0xdf, 0x85,
-	0x09, 0x5f, 0x37, 0xf1, 0x81, 0x41, 0xb4, 0x7e, 0x65, 0xea, 0x23, 0xac, 0xc6, 0x11, 0x2e, 0x28,
-	0x2e, 0xe5, 0x95, 0x10, 0xe5, 0xda, 0x48, 0x41, 0xf1, 0xd0, 0x5e, 0x87, 0xb3, 0xb8, 0xd9, 0x24,
-	0x1d, 0x47, 0x37, 0x5b, 0xe2, 0x2c, 0x13, 0xc4, 0x27, 0xb9, 0xdc, 0x4b, 0xbd, 0x7b, 0x7c, 0x49,
-	0x53, 0x51, 0xe7, 0xbb, 0x4e, 0x94, 0x5f, 0x09, 0x71, 0xea, 0x39, 0x2c, 0x38, 0x6d, 0x8d, 0x14,
-	0x94, 0xf2, 0x23, 0x05, 0x2e, 0x07, 0xc4, 0xb6, 0xfd, 0x66, 0x87, 0x92, 0xd0, 0xcf, 0xc5, 0x31,
-	0x0b, 0xb3, 0xf2, 0xe6, 0x69, 0x15, 0x3e, 0x93, 0xe6, 0x6c, 0x3f, 0x5f, 0xa5, 0x80, 0xe8, 0x5d,
-	0xea, 0x56, 0x49, 0x43, 0xa1, 0xb4, 0x09, 0x0b, 0xd8, 0x30, 0xac, 0x87, 0x8d, 0x2e, 0xf5, 0x55,
-	0x83, 0x92, 0xd7, 0x1c, 0xbf, 0xd9, 0xf7, 0x81, 0xdd, 0x8a, 0x3d, 0x97, 0xc2, 0x0e, 0x4b, 0x5a,
-	0x7f, 0x55, 0x60, 0x2d, 0x2e, 0x02, 0xc3, 0x3e, 0x9f, 0x5e, 0x83, 0x85, 0x7e, 0xce, 0x3c, 0xed,
-	0x20, 0x49, 0x70, 0x1e, 0x47, 0x38, 0xe2, 0x63, 0xb8, 0x0e, 0xaf, 0x66, 0xf2, 0x5d, 0x72, 0xfd,
-	0xbd, 0x02, 0x9f, 0x0d, 0xc8, 0xef, 0x99, 0x0e, 0xb1, 0xdb, 0x44, 0xd3, 0xb1, 0x7d, 0x72, 0x8d,
-	0x98, 0x56, 0x7b, 0x28, 0x44, 0xd7, 0x01, 0xe9, 0x1e, 0xa0, 0x86, 0xc6, 0x90, 0xe4, 0x73, 0xfa,
-	0x9c, 0x1e, 0x74, 0xc1, 0x47, 0x71, 0x0d, 0x56, 0xd3, 0x5d, 0x96, 0xfc, 0x7e, 0x33, 0xe2, 0xc9,
-	0xf8, 0x4d, 0x6c, 0xe2, 0x16, 0xd9, 0x27, 0x76, 0x5b, 0xa7, 0x54, 0xb7, 0x4c, 0x3a, 0xac, 0x93,
-	0xc7, 0x26, 0x47, 0xd6, 0x21, 0x69, 0x60, 0xc3, 0xe0, 0x25, 0x46, 0xbe, 0x9e, 0x17, 0x57, 0xb6,
-	0x0d, 0x03, 0xed, 0x42, 0x9e, 0x57, 0x20, 0xec, 0xb7, 0x3c, 0x7c, 0x2e, 0x25, 0x14, 0x20, 0x84,
-	0xd2, 0x1b, 0x36, 0xee, 0x95, 0x1f, 0x93, 0xac, 0xfc, 0x60, 0xaa, 0xe8, 0x1a, 0x4c, 0x3a, 0x56,
-	0xa3, 0xc5, 0xee, 0xc9, 0x8a, 0x70, 0x00, 0x33, 0x13, 0x8e, 0xc5, 0x7f, 0xfa, 0xe2, 0xfa, 0x8a,
-	0xa7, 0xfc, 0x88, 0x08, 0x95, 0x1b, 0xd1, 0x9c, 0xe7, 0x99, 0x27, 0xc4, 0xea, 0xe4, 0xc1, 0xb6,
-	0xe3, 0x0c, 0xed, 0x29, 0x76, 0x8e, 0xbf, 0x5a, 0x91, 0x06, 0x7b, 0x21, 0x11, 0x67, 0xba, 0x8c,
-	0xea, 0x6c, 0xd3, 0xed, 0xe5, 0xdd, 0x61, 0x07, 0x3b, 0xaa, 0xc2, 0xbc, 0x5f, 0xd4, 0x26, 0x6d,
-	0xeb, 0x48, 0x44, 0x39, 0x5f, 0x3f, 0xe7, 0x91, 0xae, 0xf3, 0x1b, 0x1e, 0xdb, 0xec, 0x45, 0x46,
-	0xda, 0x1e, 0xf3, 0xda, 0xae, 0xe9, 0x5a, 0xd0, 0xb6, 0x14, 0x95, 0xb6, 0xc7, 0xbd, 0xb6, 0xb9,
-	0xb4, 0xb4, 0xfd, 0x06, 0x14, 0xa4, 0x42, 0x7f, 0x1b, 0xbb, 0x10, 0x13, 0x5c, 0x69, 0x41, 0xdc,
-	0xef, 0x6f, 0x4b, 0x81, 0xf4, 0x16, 0x2c, 0x45, 0x2a, 0x4a, 0xc0, 0x49, 0xae, 0x5b, 0x08, 0xeb,
-	0x0a, 0x5c, 0x5f, 0x46, 0x2f, 0xc2, 0x4a, 0x6c, 0xaa, 0x64, 0x3a, 0xdf, 0xe5, 0x6f, 0x5b, 0xa2,
-	0x57, 0xb8, 0x2f, 0xba, 0xbc, 0x6e, 0x1a, 0xaf, 0xc2, 0x84, 0xec, 0xfb, 0xca, 0x16, 0xe7, 0x4a,
-	0xdc, 0x02, 0x93, 0x8a, 0xee, 0xe2, 0x92, 0x5a, 0x65, 0x95, 0x17, 0x7b, 0x01, 0xdb, 0x3e, 0x5c,
-	0xf1, 0x6c, 0x1a, 0x0e, 0x6e, 0xc0, 0xb6, 0xc4, 0x7d, 0xa4, 0x70, 0xe0, 0x3a, 0xf9, 0x26, 0x7f,
-	0xfd, 0xf4, 0x01, 0x5f, 0x81, 0x71, 0x07, 0xdb, 0x2d, 0x92, 0xde, 0xe9, 0x94, 0x72, 0xbc, 0x53,
-	0x66, 0x75, 0xed, 0xa6, 0x68, 0xdb, 0x26, 0x77, 0xca, 0xb8, 0x5c, 0xb0, 0xaa, 0xce, 0x85, 0xaa,
-	0x6a, 0xd1, 0xda, 0x11, 0xf6, 0x25, 0x93, 0x80, 0xb3, 0x6e, 0x2d, 0xad, 0x84, 0x6f, 0xd2, 0xd3,
-	0x53, 0xd9, 0x84, 0x09, 0xe1, 0x22, 0x2d, 0x8c, 0xb0, 0x25, 0x96, 0xd4, 0xe7, 0x95, 0x82, 0x7e,
-	0x5f, 0x45, 0x2d, 0x1b, 0x74, 0x47, 0x3a, 0xfb, 0x2d, 0xb1, 0x14, 0x78, 0x0f, 0x32, 0xc2, 0x57,
-	0x19, 0x44, 0x25, 0x63, 0x10, 0x2f, 0xc2, 0xb4, 0x27, 0x88, 0xd2, 0xe1, 0xfa, 0x54, 0x3f, 0x8a,
-	0xae, 0x6b, 0x42, 0x5e, 0xba, 0x16, 0x44, 0x97, 0xae, 0xfd, 0x59, 0x54, 0x9d, 0x3b, 0x7c, 0x55,
-	0xc9, 0xbb, 0x77, 0x38, 0xa5, 0xd3, 0x3b, 0x18, 0xc8, 0xf2, 0x48, 0x30, 0xcb, 0xe8, 0x0d, 0x00,
-	0x93, 0x3c, 0x6c, 0xc8, 0x1c, 0xe5, 0x52, 0xcc, 0xe6, 0x4d, 0xf2, 0x50, 0xb8, 0xe4, 0xe7, 0x25,
-	0x4a, 0xea, 0x48, 0xcf, 0x25, 0xb9, 0x9f, 0x2b, 0x9c, 0xfa, 0x0d, 0xeb, 0x48, 0x6c, 0x43, 0xf7,
-	0x25, 0x54, 0x10, 0x7b, 0x1d, 0xf2, 0xb8, 0xeb, 0xdc, 0xb7, 0x6c, 0xdd, 0x39, 0x49, 0xe5, 0xd6,
-	0x17, 0x45, 0x6f, 0xc2, 0xb8, 0x78, 0x3e, 0xcb, 0x69, 0x45, 0x31, 0xf9, 0x15, 0xc1, 0x6d, 0x87,
-	0x08, 0x1d, 0x77, 0x2e, 0xe3, 0x5a, 0x2b, 0xbf, 0x0c, 0x6a, 0x94, 0x8b, 0x92, 0xc1, 0x9f, 0x66,
-	0xf8, 0x86, 0xbd, 0x61, 0x1d, 0x89, 0x27, 0xd8, 0x2e, 0x21, 0xf4, 0xd3, 0xfa, 0x9f, 0x78, 0xe0,
-	0xdc, 0x85, 0x0b, 0x58, 0xd3, 0x1a, 0xf7, 0x08, 0x69, 0x78, 0x4e, 0x93, 0x7b, 0x06, 0xce, 0x30,
-	0xb0, 0x10, 0x44, 0xe7, 0xb0, 0xa6, 0xed, 0x12, 0xd2, 0x9b, 0x34, 0xed, 0x1a, 0xd8, 0x41, 0xdf,
-	0x00, 0x55, 0x3c, 0xc1, 0x23, 0x2d, 0x8f, 0x66, 0xb3, 0x7c, 0x5e, 0x98, 0x08, 0x19, 0x0f, 0xfb,
-	0xcc, 0x4e, 0x29, 0x6e, 0x79, 0xec, 0x14, 0x3e, 0xd7, 0x74, 0x2d, 0xde, 0xe7, 0x9e, 0xe5, 0xf1,
-	0xd3, 0xf9, 0xec, 0x1a, 0x6f, 0x42, 0xd1, 0xf5, 0x39, 0xba, 0xe7, 0xce, 0x8f, 0xc9, 0x0c, 0x00,
-	0xaa, 0x70, 0xfd, 0x76, 0x44, 0xef, 0x1d, 0xe9, 0x70, 0xd1, 0xc3, 0x20, 0x06, 0x67, 0x32, 0x1b,
-	0xce, 0x72, 0x8f, 0x48, 0x24, 0x94, 0x09, 0xa5, 0x78, 0x3e, 0x36, 0x76, 0x74, 0x8b, 0x16, 0xf2,
-	0x1c, 0x29, 0x76, 0x54, 0xb8, 0x4b, 0x48, 0x9d, 0x09, 0x4a, 0xc0, 0x97, 0xa3, 0x89, 0x71, 0x11,
-	0x8a, 0x1c, 0xb8, 0x94, 0x48, 0x4d, 0x42, 0xc2, 0x40, 0x90, 0x2b, 0xb1, 0x1c, 0x25, 0x2a, 0x86,
-	0x65, 0x97, 0x65, 0xb8, 0x25, 0xcf, 0x82, 0x39, 0x95, 0x2d, 0x98, 0x8b, 0x82, 0x5b, 0x2d, 0xd0,
-	0x56, 0x67, 0x81, 0x6c, 0x41, 0xc9, 0x43, 0x2c, 0x1a, 0x65, 0x3a, 0x1b, 0xca, 0xcb, 0x3d, 0x3a,
-	0x51, 0x40, 0x06, 0xac, 0xc4, 0x72, 0x91, 0xd1, 0x9b, 0x19, 0x28, 0x7a, 0x4b, 0x91, 0xa4, 0x64,
-	0xe4, 0x6c, 0x28, 0x27, 0xd1, 0x92, 0x80, 0xb3, 0x03, 0x01, 0x16, 0xe3, 0xf8, 0x49, 0x4c, 0xcf,
-	0x1e, 0x0b, 0xd7, 0x94, 0x3c, 0x90, 0x2f, 0x0d, 0xb4, 0xc7, 0x76, 0x02, 0x55, 0x67, 0xc4, 0x1e,
-	0x8b, 0xc1, 0x39, 0x3b, 0xe8, 0x1e, 0x8b, 0x84, 0x7a, 0x07, 0xca, 0x94, 0x38, 0x02, 0xa7, 0x0f,
-	0xe0, 0x89, 0xe2, 0x81, 0xde, 0xa1, 0x85, 0x73, 0xfc, 0x89, 0x5e, 0xa4, 0xc4, 0x61, 0x76, 0x02,
-	0xed, 0x67, 0x5e, 0x30, 0xea, 0x1d, 0x8a, 0x6e, 0xc1, 0x2b, 0x5d, 0x33, 0x83, 0x35, 0xc4, 0xdf,
-	0xbc, 0x4b, 0x5c, 0x36, 0xc1, 0x5e, 0xe8, 0x58, 0x13, 0xb5, 0x5b, 0xe0, 0xdc, 0x92, 0x87, 0xda,
-	0x77, 0xdc, 0x7b, 0x3b, 0x86, 0x45, 0x9f, 0xd3, 0xa1, 0x9c, 0x74, 0xa8, 0x85, 0x9c, 0x5b, 0xea,
-	0x95, 0x05, 0x5e, 0x07, 0xa4, 0x77, 0xbf, 0xec, 0x15, 0x0d, 0xe2, 0xf5, 0x7a, 0x9f, 0x7f, 0x22,
-	0xf2, 0x1c, 0x8a, 0x06, 0xf1, 0xad, 0x49, 0x5a, 0xd1, 0x20, 0xe0, 0xdc, 0xa2, 0x41, 0xe8, 0x6c,
-	0x9d, 0xf5, 0x13, 0x28, 0x28, 0xe5, 0x92, 0x5b, 0x36, 0xf8, 0x9d, 0xf4, 0xf4, 0xdd, 0x7e, 0x26,
-	0x86, 0x65, 0xff, 0x3f, 0x24, 0x82, 0x59, 0x10, 0xa3, 0xae, 0x28, 0xff, 0x37, 0xff, 0xbb, 0x0c,
-	0xb9, 0x9b, 0xb4, 0x85, 0xee, 0x41, 0xbe, 0x77, 0xd4, 0xa3, 0x57, 0x63, 0xeb, 0xac, 0xf0, 0xc7,
-	0x38, 0xea, 0xe7, 0xb3, 0x09, 0xcb, 0x6f, 0x43, 0x7a, 0x38, 0x35, 0x5d, 0xcb, 0x80, 0xd3, 0xff,
-	0x16, 0x26, 0x03, 0x8e, 0xf7, 0x1b, 0x14, 0x03, 0xa6, 0x3c, 0x9f, 0x45, 0xa0, 0xf5, 0x24, 0xe5,
-	0xd0, 0xc7, 0x28, 0x6a, 0x25, 0xab, 0xb8, 0x07, 0xad, 0xff, 0xdd, 0x43, 0x32, 0x5a, 0xe8, 0x93,
-	0x8c, 0x64, 0xb4, 0xf0, 0xe7, 0x14, 0xa8, 0x09, 0x93, 0xee, 0x14, 0x1e, 0xad, 0x25, 0xe8, 0x06,
-	0xbe, 0xb7, 0x50, 0x5f, 0xcd, 0x24, 0xeb, 0x07, 0xd9, 0xa6, 0x87, 0xe9, 0x20, 0x9e, 0xb9, 0x7f,
-	0x2a, 0x88, 0x77, 0xcc, 0x8c, 0x2c, 0x98, 0xf6, 0x0e, 0x60, 0x51, 0x52, 0x24, 0x22, 0x66, 0xd1,
-	0x6a, 0x35, 0xb3, 0xbc, 0x04, 0x7c, 0x9f, 0x6d, 0xd5, 0xc8, 0x71, 0x21, 0xfa, 0x62, 0xaa, 0xad,
-	0x98, 0x49, 0xb0, 0xfa, 0xa5, 0x53, 0x68, 0x4a, 0x7f, 0x7e, 0xc4, 0x5e, 0xae, 0x63, 0x06, 0x76,
-	0x68, 0x2b, 0xd5, 0x6e, 0xec, 0x34, 0x53, 0xfd, 0xf2, 0xa9, 0x74, 0x43, 0x5e, 0x85, 0x67, 0x6c,
-	0x19, 0xbc, 0x8a, 0x9d, 0x29, 0x66, 0xf0, 0x2a, 0x7e, 0xa8, 0x87, 0xba, 0x30, 0xeb, 0x9f, 0x60,
-	0xa1, 0x2b, 0xa9, 0xe6, 0x02, 0xb3, 0x3f, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x9e, 0x02, 0x73,
-	0x11, 0xd3, 0x24, 0xf4, 0x85, 0x54, 0x53, 0x51, 0xb3, 0x34, 0xf5, 0xf5, 0x41, 0xd5, 0xa4, 0x1b,
-	0x3f, 0x0c, 0xb8, 0x21, 0x07, 0x40, 0x99, 0xdd, 0xf0, 0x4f, 0xb8, 0x32, 0xbb, 0x11, 0x98, 0x33,
-	0x95, 0x73, 0x3f, 0x18, 0x51, 0xd0, 0x4f, 0x15, 0x58, 0x4a, 0x18, 0xdc, 0xa0, 0xb7, 0x32, 0x1a,
-	0x8f, 0x9e, 0x4e, 0xa9, 0x5f, 0x39, 0xad, 0x7a, 0x68, 0x93, 0x07, 0x67, 0x2f, 0x19, 0x36, 0x79,
-	0xcc, 0x7c, 0x29, 0xc3, 0x26, 0x8f, 0x1b, 0xf4, 0xa0, 0x47, 0x0a, 0x94, 0xd2, 0x26, 0x25, 0xa8,
-	0x36, 0x28, 0xe9, 0x88, 0x4d, 0xbf, 0xf3, 0xa9, 0x6c, 0x48, 0x6f, 0x7f, 0xa1, 0xc0, 0x72, 0xe2,
-	0xd0, 0x03, 0x5d, 0xcd, 0x08, 0x13, 0x37, 0xe1, 0x51, 0xdf, 0x3e, 0xbd, 0x01, 0xe9, 0xe4, 0x07,
-	0x0a, 0x5c, 0x88, 0x99, 0x20, 0xa0, 0xf4, 0x4c, 0xc5, 0x0d, 0x68, 0xd4, 0xad, 0xd3, 0xa8, 0x4a,
-	0x97, 0xbe, 0xaf, 0xc0, 0x7c, 0x54, 0x0b, 0x1c, 0xbd, 0x9e, 0xd1, 0x68, 0x60, 0xbc, 0xa1, 0xbe,
-	0x31, 0xb0, 0x9e, 0xf4, 0xc4, 0x86, 0x19, 0x5f, 0x33, 0x1c, 0x55, 0x53, 0x4b, 0x27, 0x7f, 0x87,
-	0x5a, 0xbd, 0x92, 0x5d, 0xa1, 0x8f, 0xe9, 0x6b, 0x84, 0x27, 0x62, 0x46, 0xb5, 0xe3, 0x13, 0x31,
-	0x23, 0x7b, 0xec, 0x0c, 0xd3, 0xd7, 0x06, 0x4e, 0xc4, 0x8c, 0xea, 0xc4, 0x27, 0x62, 0x46, 0x76,
-	0xc3, 0xd9, 0x21, 0xe4, 0x6f, 0x3d, 0xa3, 0xcc, 0x36, 0x68, 0x96, 0x43, 0x28, 0xba, 0xaf, 0xcd,
-	0x60, 0xfd, 0x6d, 0xe5, 0x44, 0xd8, 0xc8, 0xfe, 0x77, 0x22, 0x6c, 0x74, 0xcf, 0x9a, 0x9f, 0x7d,
-	0x11, 0x6d, 0xdf, 0xc4, 0x43, 0x27, 0xbe, 0xc1, 0x9d, 0x78, 0xe8, 0x24, 0x74, 0x97, 0xd1, 0x31,
-	0xbc, 0x14, 0x68, 0xdb, 0xa2, 0x24, 0x32, 0xd1, 0x5d, 0x68, 0x75, 0x73, 0x10, 0x95, 0xfe, 0x12,
-	0xf3, 0xbd, 0x59, 0x27, 0x2e, 0xb1, 0xa8, 0xde, 0x71, 0xe2, 0x12, 0x8b, 0x7c, 0x69, 0x67, 0xb9,
-	0xf6, 0xbf, 0x30, 0xa3, 0x14, 0x1b, 0xe1, 0x97, 0x7b, 0x75, 0x63, 0x00, 0x0d, 0x09, 0xfb, 0x6d,
-	0x1e, 0x64, 0xef, 0x4b, 0x62, 0x5a, 0x90, 0x23, 0x5e, 0x78, 0xd3, 0x82, 0x1c, 0xf5, 0x0e, 0x2a,
-	0x6a, 0x0a, 0x0b, 0xa6, 0x7d, 0xd8, 0x49, 0xaf, 0x02, 0x51, 0xc0, 0xd5, 0xcc, 0xf2, 0x02, 0x55,
-	0x1d, 0xfb, 0xee, 0xb3, 0xc7, 0x6b, 0x4a, 0x8d, 0x7c, 0xf8, 0xa4, 0xa8, 0x7c, 0xfc, 0xa4, 0xa8,
-	0x7c, 0xf2, 0xa4, 0xa8, 0x7c, 0xf0, 0xb4, 0x78, 0xe6, 0xe3, 0xa7, 0xc5, 0x33, 0xff, 0x7c, 0x5a,
-	0x3c, 0x03, 0x8b, 0xba, 0x15, 0x63, 0x73, 0x5f, 0x79, 0xb7, 0xe2, 0xf9, 0x4a, 0xab, 0x2f, 0xb4,
-	0xae, 0x5b, 0x9e, 0x5f, 0xd5, 0xe3, 0xde, 0x1f, 0xb7, 0x1c, 0x8c, 0xf3, 0xbf, 0x68, 0x79, 0xed,
-	0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd1, 0x40, 0x23, 0x7a, 0x49, 0x34, 0x00, 0x00,
+	// 2991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0xcb, 0x6f, 0x1b, 0xd7,
+	0xd5, 0xf7, 0x88, 0x7a, 0xf1, 0xe8, 0x11, 0x7b, 0x6c, 0xd9, 0xd4, 0x38, 0xa6, 0x68, 0x3a, 0xfe,
+	0x3e, 0xd5, 0x8e, 0x48, 0x4b, 0x41, 0xed, 0x44, 0x89, 0x93, 0x88, 0x72, 0x64, 0x38, 0x80, 0x53,
+	0x83, 0xb6, 0x5b, 0x20, 0x5d, 0x10, 0x23, 0xce, 0x0d, 0x3d, 0x15, 0x39, 0xc3, 0xcc, 0xbd, 0x94,
+	0x2d, 0xa0, 0x45, 0x8b, 0x22, 0x40, 0x1f, 0x40, 0x80, 0x00, 0x45, 0x17, 0x7d, 0xa0, 0x40, 0x5b,
+	0xa0, 0x68, 0x9b, 0x45, 0x53, 0x34, 0x28, 0xfa, 0x58, 0x76, 0x93, 0x45, 0x16, 0x41, 0x56, 0x5d,
+	0xb5, 0x41, 0x02, 0x34, 0xff, 0x46, 0x71, 0xef, 0x3d, 0xc3, 0x79, 0x3f, 0xc8, 0x84, 0x41, 0x37,
+	0x89, 0x39, 0xf7, 0x3c, 0x7e, 0xbf, 0x73, 0xee, 0x99, 0xfb, 0x38, 0x23, 0x58, 0xeb, 0x3b, 0xf6,
+	0x21, 0xb1, 0x74, 0xab, 0x4d, 0xea, 0xe4, 0x51, 0xfb, 0x81, 0x6e, 0x75, 0x48, 0xfd, 0x70, 0xb3,
+	0xce, 0x1e, 0xd5, 0xfa, 0x8e, 0xcd, 0x6c, 0xf5, 0xb4, 0x27, 0x50, 0x73, 0x05, 0x6a, 0x87, 0x9b,
+	0xda, 0x09, 0xbd, 0x67, 0x5a, 0x76, 0x5d, 0xfc, 0x57, 0x8a, 0x6a, 0xe5, 0xb6, 0x4d, 0x7b, 0x36,
+	0xad, 0xef, 0xeb, 0x94, 0xdb, 0xd8, 0x27, 0x4c, 0xdf, 0xac, 0xb7, 0x6d, 0xd3, 0xc2, 0xf1, 0x33,
+	0x38, 0xde, 0xa3, 0x1d, 0xee, 0xa2, 0x47, 0x3b, 0x38, 0xb0, 0x2a, 0x07, 0x5a, 0xe2, 0x57, 0x5d,
+	0xfe, 0xc0, 0xa1, 0x53, 0x1d, 0xbb, 0x63, 0xcb, 0xe7, 0xfc, 0x5f, 0xf8, 0x74, 0x3d, 0x01, 0x75,
+	0xdb, 0xee, 0xf5, 0x4c, 0xd6, 0x23, 0x16, 0x73, 0xf5, 0x2f, 0x24, 0x48, 0xf6, 0x74, 0xe7, 0x80,
+	0xb0, 0x0c, 0x21, 0xdb, 0x31, 0x88, 0x93, 0x65, 0xa9, 0xaf, 0x3b, 0x7a, 0xcf, 0x15, 0xba, 0x98,
+	0x28, 0x74, 0xe4, 0x43, 0x55, 0xfd, 0x93, 0x02, 0x27, 0x6f, 0xd3, 0xce, 0xae, 0x43, 0x74, 0x46,
+	0x76, 0xe8, 0x41, 0x93, 0xbc, 0x3e, 0x20, 0x94, 0xa9, 0xbb, 0x50, 0xd4, 0xe9, 0x41, 0x4b, 0xf8,
+	0x2d, 0x29, 0x15, 0x65, 0x7d, 0x61, 0xab, 0x52, 0x8b, 0x4f, 0x40, 0x6d, 0x87, 0x1e, 0x7c, 0x85,
+	0xcb, 0x35, 0xa6, 0xdf, 0xfb, 0xd7, 0xda, 0xb1, 0xe6, 0xbc, 0x8e, 0xbf, 0xd5, 0x9b, 0xa0, 0x0a,
+	0x03, 0xad, 0x36, 0x37, 0x6f, 0xda, 0x56, 0xeb, 0x35, 0x42, 0x4a, 0x53, 0xc2, 0xda, 0x6a, 0x0d,
+	0xa3, 0xcb, 0x73, 0x54, 0xc3, 0x1c, 0xd5, 0x76, 0x6d, 0xd3, 0x6a, 0x1e, 0x17, 0x4a, 0xbb, 0xa8,
+	0xb3, 0x47, 0xc8, 0xf6, 0xf2, 0x77, 0x3f, 0x7d, 0xe7, 0x92, 0x07, 0xa8, 0xba, 0x09, 0xa7, 0x82,
+	0xa0, 0x69, 0xdf, 0xb6, 0x28, 0x51, 0x57, 0x61, 0x5e, 0x3a, 0x34, 0x0d, 0x01, 0x7a, 0xba, 0x39,
+	0x27, 0x7e, 0xdf, 0x32, 0x82, 0x44, 0x1b, 0xa6, 0xe1, 0x23, 0xba, 0x6f, 0x1a, 0xf9, 0x88, 0x36,
+	0x4c, 0x23, 0x40, 0x74, 0xdf, 0x34, 0x26, 0x42, 0x74, 0x08, 0x28, 0x40, 0x54, 0x80, 0xce, 0x26,
+	0xfa, 0xfe, 0x14, 0xac, 0x70, 0x1d, 0x31, 0x01, 0xf7, 0x06, 0x96, 0x41, 0x5d, 0xaa, 0x5b, 0x30,
+	0xa7, 0xb7, 0xdb, 0xf6, 0xc0, 0x62, 0x42, 0xa7, 0xd8, 0x28, 0x7d, 0xf8, 0xee, 0xc6, 0x29, 0x44,
+	0xb7, 0x63, 0x18, 0x0e, 0xa1, 0xf4, 0x2e, 0x73, 0x4c, 0xab, 0xd3, 0x74, 0x05, 0xd5, 0xb3, 0x50,
+	0x94, 0x13, 0x94, 0x7b, 0xe2, 0x84, 0x96, 0x9a, 0xf3, 0xf2, 0xc1, 0x2d, 0x43, 0x3d, 0x82, 0x59,
+	0xbd, 0x27, 0xec, 0x15, 0x2a, 0x85, 0x54, 0xaa, 0x8d, 0x3d, 0x1e, 0xb1, 0xdf, 0xff, 0x7b, 0x6d,
+	0xbd, 0x63, 0xb2, 0x07, 0x83, 0xfd, 0x5a, 0xdb, 0xee, 0x61, 0x79, 0xe1, 0xff, 0x36, 0xa8, 0x71,
+	0x50, 0x67, 0x47, 0x7d, 0x42, 0x85, 0x02, 0xfd, 0xe9, 0xa7, 0xef, 0x5c, 0x5a, 0xec, 0x92, 0x8e,
+	0xde, 0x3e, 0x6a, 0xf1, 0xca, 0xa5, 0xbf, 0xfd, 0xf4, 0x9d, 0x4b, 0x4a, 0x13, 0x1d, 0xaa, 0xcf,
+	0xc1, 0x62, 0x20, 0xd6, 0xd3, 0x59, 0xb1, 0x5e, 0x68, 0x7b, 0x61, 0xe6, 0xac, 0xc8, 0x21, 0xb1,
+	0x58, 0x8b, 0xe9, 0x9d, 0xd2, 0x0c, 0x8f, 0x45, 0x73, 0x5e, 0x3c, 0xb8, 0xa7, 0x77, 0xb6, 0x17,
+	0x79, 0x0e, 0xdc, 0x00, 0x54, 0x4b, 0x70, 0x3a, 0x1c, 0x4d, 0x99, 0x83, 0xea, 0xeb, 0x32, 0xce,
+	0x7c, 0x96, 0x74, 0xc5, 0x34, 0x70, 0xe3, 0x7c, 0x05, 0x66, 0xa9, 0xd9, 0xb1, 0x88, 0x93, 0x19,
+	0x66, 0x94, 0x0b, 0xa4, 0x73, 0x2a, 0x90, 0xce, 0xed, 0x05, 0x8e, 0x06, 0xe5, 0x5c, 0x30, 0x7e,
+	0x97, 0x08, 0xe6, 0x8f, 0x05, 0xa8, 0x0c, 0x67, 0xca, 0xae, 0x6d, 0x19, 0x26, 0xe7, 0xaa, 0x07,
+	0x81, 0xd5, 0x60, 0xc6, 0x7e, 0x98, 0x07, 0x97, 0x14, 0x53, 0xaf, 0xfb, 0x5f, 0x02, 0x53, 0xf9,
+	0x5e, 0x02, 0xbe, 0xf2, 0xbf, 0xee, 0x2f, 0xad, 0x42, 0xbe, 0xd2, 0xf2, 0x15, 0xd5, 0x53, 0xb0,
+	0xc4, 0x1c, 0xb3, 0xd3, 0x21, 0x4e, 0xab, 0xef, 0x98, 0x6d, 0x99, 0xe3, 0x62, 0x63, 0xf9, 0xc3,
+	0x77, 0x37, 0x00, 0x51, 0xdf, 0x20, 0xed, 0xe6, 0x22, 0x0a, 0xdd, 0xe1, 0x32, 0xea, 0x7d, 0x38,
+	0xe1, 0x2a, 0x19, 0xa6, 0x43, 0xda, 0x3c, 0x0a, 0x22, 0xc3, 0xcb, 0x5b, 0xeb, 0x49, 0xbe, 0xef,
+	0x49, 0x85, 0x1b, 0xae, 0x7c, 0xf3, 0x38, 0x0b, 0x3d, 0x49, 0x28, 0xf0, 0xd9, 0xd1, 0x0b, 0x1c,
+	0x78, 0x3a, 0x65, 0x78, 0xab, 0xcf, 0xc3, 0xf9, 0x94, 0x94, 0x65, 0x57, 0xfa, 0x3f, 0x0a, 0xa0,
+	0xde, 0xa6, 0x9d, 0x3d, 0xb3, 0xdb, 0x6d, 0x98, 0x06, 0xf5, 0x4f, 0x3f, 0xd2, 0xed, 0xe6, 0x9a,
+	0x7e, 0x42, 0x2e, 0xbd, 0xc8, 0xdf, 0x50, 0x60, 0x91, 0xd9, 0x4c, 0xef, 0xb6, 0x74, 0x4a, 0x09,
+	0xa3, 0x5f, 0x5c, 0xad, 0x2f, 0x08, 0xb7, 0x3b, 0xc2, 0xab, 0x5a, 0x85, 0xa5, 0xe1, 0x64, 0x6a,
+	0x99, 0x06, 0x2d, 0x4d, 0x57, 0x0a, 0xeb, 0xd3, 0xcd, 0x05, 0x77, 0xba, 0xdc, 0x32, 0xa8, 0xfa,
+	0x55, 0xd0, 0x24, 0xa3, 0x16, 0x25, 0x8c, 0x75, 0x49, 0x8f, 0x97, 0xf8, 0x6b, 0x5d, 0x9d, 0x89,
+	0x6c, 0xcd, 0x64, 0x65, 0xeb, 0x8c, 0x54, 0xbe, 0x3b, 0xd4, 0xdd, 0xeb, 0xea, 0x8c, 0xbf, 0x2e,
+	0x5e, 0x81, 0xd3, 0xc3, 0x3a, 0x18, 0x71, 0x06, 0x9c, 0x74, 0xab, 0xc1, 0x3f, 0x09, 0xb0, 0xa6,
+	0x85, 0xb7, 0xea, 0x0a, 0x9c, 0x0c, 0x24, 0x11, 0x0b, 0xfa, 0xef, 0x5e, 0x72, 0x77, 0xe8, 0x01,
+	0xf5, 0x95, 0xf0, 0xfe, 0xe0, 0x28, 0x4f, 0x09, 0x0b, 0xb1, 0xf4, 0xd4, 0xbe, 0x08, 0x32, 0xc4,
+	0x58, 0x5f, 0x85, 0x0c, 0x32, 0xb8, 0xec, 0x81, 0xd0, 0x91, 0xe5, 0x56, 0x85, 0x25, 0x2f, 0x32,
+	0xbe, 0xac, 0xb8, 0xac, 0x79, 0x56, 0x7e, 0xac, 0xc0, 0x8a, 0x00, 0x13, 0xc8, 0x0a, 0x21, 0xb4,
+	0x34, 0xf3, 0x45, 0xcd, 0xa4, 0x93, 0xc2, 0xbf, 0x2f, 0xb1, 0x84, 0x50, 0x9e, 0x55, 0x6f, 0x46,
+	0x8d, 0x98, 0x55, 0x77, 0xd6, 0x45, 0x4b, 0x5b, 0x78, 0xf2, 0x25, 0x55, 0x26, 0x0f, 0x93, 0xfa,
+	0x91, 0x22, 0x5e, 0xe0, 0xb7, 0x45, 0x02, 0x24, 0x1c, 0x5f, 0x62, 0x75, 0xa3, 0x67, 0x5a, 0xd9,
+	0x89, 0x15, 0x62, 0xe9, 0x89, 0x8d, 0xa4, 0xa5, 0x10, 0x4d, 0x4b, 0x9e, 0x82, 0xba, 0x08, 0xcb,
+	0xe4, 0x51, 0x9f, 0xb4, 0x59, 0xab, 0xaf, 0x3b, 0xcc, 0xd4, 0xbb, 0xa2, 0x88, 0xe6, 0x9b, 0x4b,
+	0xf2, 0xe9, 0x1d, 0xf9, 0x10, 0x99, 0x0b, 0x5c, 0xd5, 0x55, 0x38, 0x13, 0x61, 0x88, 0xec, 0x7f,
+	0x23, 0xd7, 0x28, 0x39, 0xb6, 0x3b, 0xdc, 0x20, 0x4f, 0x30, 0x0e, 0xbb, 0x30, 0x6b, 0x5a, 0xfd,
+	0xc1, 0xf0, 0xa5, 0x75, 0x31, 0x71, 0xf5, 0x92, 0xab, 0xfd, 0x8e, 0xd8, 0x5c, 0xe0, 0x3c, 0x47,
+	0x55, 0xf5, 0x25, 0x98, 0xb3, 0x07, 0x4c, 0x58, 0x99, 0x1e, 0xdd, 0x8a, 0xab, 0xab, 0xbe, 0x00,
+	0xd3, 0xbe, 0x49, 0x3f, 0x92, 0x0d, 0xa1, 0xc8, 0x0d, 0x58, 0xfa, 0x21, 0x2d, 0xcd, 0xa6, 0x1b,
+	0x78, 0x85, 0x30, 0xf1, 0xca, 0x14, 0x05, 0xea, 0x1a, 0xe0, 0x8a, 0xc1, 0x5d, 0xcf, 0x5c, 0x68,
+	0xd7, 0xe3, 0xcf, 0xe1, 0x05, 0x38, 0x9f, 0x92, 0x27, 0xcc, 0xe6, 0x7f, 0x14, 0xa8, 0x0e, 0xa5,
+	0x9a, 0xa4, 0x4b, 0x74, 0x4a, 0x3c, 0x61, 0x3a, 0x91, 0x7c, 0xbe, 0x0c, 0xc0, 0xec, 0x96, 0x23,
+	0x9d, 0x8d, 0x93, 0xd3, 0x22, 0xb3, 0x11, 0x6a, 0x30, 0x1a, 0xd3, 0x29, 0xd1, 0xb8, 0x08, 0x17,
+	0x52, 0x79, 0x62, 0x3c, 0xfe, 0xea, 0x8f, 0xc7, 0x5d, 0xc2, 0x44, 0x11, 0xbd, 0xf4, 0x88, 0x11,
+	0xc7, 0xd2, 0xbb, 0xb7, 0x6e, 0x4c, 0x24, 0x1e, 0xfe, 0xcd, 0x41, 0x21, 0xb0, 0x39, 0x50, 0xd7,
+	0x60, 0x81, 0xa0, 0x73, 0x3e, 0x2a, 0x09, 0x82, 0xfb, 0xe8, 0x96, 0x91, 0x48, 0x31, 0x0e, 0x3a,
+	0x52, 0x7c, 0x73, 0x0a, 0x4a, 0x43, 0xb9, 0xaf, 0x99, 0xec, 0x81, 0xe1, 0xe8, 0x0f, 0x27, 0x42,
+	0xec, 0x9c, 0x48, 0xb4, 0x2e, 0xf5, 0x04, 0xb5, 0x22, 0xcf, 0x1d, 0x1a, 0xf2, 0x1d, 0x3c, 0xa6,
+	0xbf, 0xe0, 0x83, 0x47, 0x20, 0x6c, 0x67, 0x61, 0x35, 0x26, 0x1c, 0x18, 0xac, 0xf7, 0x15, 0x38,
+	0x37, 0x1c, 0xbd, 0xdf, 0x37, 0x74, 0x46, 0x6e, 0x10, 0xa6, 0x9b, 0xdd, 0xc9, 0x94, 0x46, 0x13,
+	0x96, 0x71, 0xd0, 0x90, 0x5e, 0x70, 0x39, 0x4f, 0x2c, 0x0f, 0x09, 0x0c, 0x21, 0x61, 0x79, 0x2c,
+	0xf5, 0xfc, 0x0f, 0x03, 0x5c, 0x2b, 0x50, 0x4e, 0x62, 0x83, 0x84, 0xff, 0x10, 0x25, 0xfc, 0x92,
+	0xa5, 0xef, 0x77, 0x89, 0xe1, 0xed, 0x4c, 0x03, 0x84, 0xb5, 0x24, 0xc2, 0x25, 0xc5, 0xa5, 0xbc,
+	0x16, 0xa1, 0xdc, 0x98, 0x2a, 0x29, 0x3e, 0xda, 0x1b, 0x70, 0x5c, 0x6f, 0xb7, 0x49, 0x9f, 0x99,
+	0x56, 0x47, 0xae, 0x65, 0x92, 0xf8, 0xbc, 0x90, 0x7b, 0x6c, 0x38, 0x26, 0xa6, 0x34, 0x95, 0x67,
+	0x3b, 0x17, 0x44, 0xf5, 0x09, 0x28, 0x27, 0x01, 0x96, 0x9c, 0xb6, 0xa7, 0x4a, 0x4a, 0xf5, 0x6d,
+	0x05, 0x2e, 0x86, 0xc4, 0x76, 0x82, 0x66, 0x27, 0x92, 0xd0, 0x2f, 0x25, 0x31, 0x8b, 0xb2, 0xf2,
+	0xe7, 0x69, 0x1d, 0xfe, 0x2f, 0x0b, 0xac, 0x97, 0xaf, 0x4a, 0x48, 0xf4, 0x3e, 0x75, 0x77, 0x49,
+	0x13, 0xa1, 0xb4, 0x05, 0x2b, 0x7a, 0xb7, 0x6b, 0x3f, 0x6c, 0x0d, 0x68, 0x60, 0x37, 0x88, 0xbc,
+	0x4e, 0x8a, 0x41, 0x0f, 0x03, 0x1f, 0x4a, 0x5c, 0x97, 0xa2, 0x80, 0x91, 0xd6, 0xdf, 0x14, 0xb8,
+	0x94, 0x14, 0x81, 0x49, 0xaf, 0x4f, 0x4f, 0xc1, 0x8a, 0x97, 0x33, 0xdf, 0x15, 0x20, 0x12, 0x3c,
+	0xa5, 0xc7, 0x00, 0x09, 0x30, 0xdc, 0x80, 0xcb, 0xb9, 0xb0, 0x23, 0xd7, 0x9f, 0x28, 0xb0, 0x16,
+	0x96, 0x1f, 0x30, 0xfb, 0xb6, 0xce, 0xda, 0x0f, 0x26, 0xf5, 0x5e, 0xd6, 0x07, 0xcc, 0x6e, 0xf5,
+	0xb8, 0x07, 0x64, 0x55, 0xd4, 0x5d, 0x97, 0x01, 0x2a, 0x55, 0xa8, 0x24, 0x43, 0x73, 0x6f, 0x2d,
+	0x14, 0xf8, 0xff, 0x90, 0xd0, 0x2d, 0x8b, 0x11, 0xa7, 0x47, 0x0c, 0x53, 0x77, 0x8e, 0x6e, 0x10,
+	0xcb, 0xee, 0x4d, 0x84, 0xc7, 0x06, 0xa8, 0xa6, 0xcf, 0x51, 0xcb, 0xe0, 0x9e, 0x70, 0x9d, 0x39,
+	0x61, 0x86, 0x21, 0x04, 0x78, 0x5d, 0x82, 0xf5, 0x6c, 0xc8, 0xc8, 0xef, 0x77, 0x53, 0xbe, 0x19,
+	0x7b, 0x5b, 0xb7, 0xf4, 0x0e, 0xb9, 0x43, 0x9c, 0x9e, 0x49, 0xa9, 0x69, 0x5b, 0x74, 0x52, 0x19,
+	0x72, 0xc8, 0xa1, 0x7d, 0x40, 0x5a, 0x7a, 0xb7, 0x2b, 0xb6, 0x48, 0xc5, 0x66, 0x51, 0x3e, 0xd9,
+	0xe9, 0x76, 0xd5, 0x3d, 0x28, 0x8a, 0x1d, 0x14, 0xff, 0x8d, 0x8b, 0xe7, 0x85, 0x94, 0x0d, 0x14,
+	0xa1, 0xf4, 0xa6, 0xa3, 0x0f, 0xb7, 0x4f, 0xf3, 0x7c, 0xfb, 0xc4, 0x55, 0xd5, 0x1b, 0x30, 0xcf,
+	0xec, 0x56, 0x87, 0x8f, 0x95, 0x66, 0x46, 0x35, 0x33, 0xc7, 0x6c, 0xf1, 0x33, 0x10, 0xd7, 0x27,
+	0xa0, 0x9a, 0x16, 0x2a, 0x37, 0xa2, 0x05, 0x28, 0x87, 0xc4, 0x9a, 0xe4, 0xf5, 0x1d, 0xc6, 0x26,
+	0xf6, 0x16, 0x3e, 0x21, 0x8e, 0x86, 0xa4, 0xc5, 0x0f, 0x54, 0x72, 0x4f, 0x82, 0x51, 0x5d, 0x6e,
+	0xbb, 0xf7, 0xcf, 0xf7, 0xf8, 0xc6, 0x44, 0xad, 0xc3, 0xa9, 0xa0, 0xa8, 0x43, 0x7a, 0xf6, 0xa1,
+	0x8c, 0x72, 0xb1, 0x79, 0xc2, 0x27, 0xdd, 0x14, 0x03, 0x3e, 0xdb, 0xfc, 0x20, 0x86, 0xb6, 0x67,
+	0xfc, 0xb6, 0x1b, 0xa6, 0x11, 0xb6, 0x8d, 0xa2, 0x68, 0x7b, 0xd6, 0x6f, 0x5b, 0x48, 0xa3, 0xed,
+	0x6b, 0x50, 0x42, 0x05, 0xef, 0x35, 0xe4, 0xba, 0x98, 0x13, 0x4a, 0x2b, 0x6d, 0xbc, 0x78, 0x72,
+	0x87, 0xa5, 0xa7, 0xeb, 0x70, 0x36, 0x56, 0x11, 0x1d, 0xce, 0x0b, 0xdd, 0x52, 0x54, 0x57, 0xfa,
+	0x0d, 0x64, 0xf4, 0x3c, 0xac, 0x25, 0xa6, 0x0a, 0xd3, 0xf9, 0xaa, 0x38, 0x2d, 0xca, 0x2b, 0xb0,
+	0x3b, 0xb2, 0x33, 0xe1, 0xa6, 0xf1, 0x05, 0x98, 0xc3, 0x5e, 0x05, 0x5e, 0xcb, 0xaf, 0x25, 0x4d,
+	0x30, 0x54, 0x74, 0x27, 0x17, 0x6a, 0x55, 0x35, 0x28, 0x45, 0x6d, 0x07, 0xfc, 0xca, 0x77, 0xeb,
+	0x64, 0xfc, 0x86, 0x6c, 0xa3, 0xdf, 0xb7, 0x15, 0xe1, 0xb8, 0x49, 0xbe, 0x41, 0xda, 0xde, 0xe0,
+	0xf0, 0xde, 0x8e, 0xe9, 0x4e, 0x87, 0x64, 0xdf, 0xce, 0xa3, 0x1c, 0xd7, 0xa0, 0xf6, 0xc0, 0x69,
+	0xcb, 0x56, 0x43, 0xaa, 0x86, 0x94, 0x0b, 0x9f, 0x0a, 0x0a, 0x91, 0x53, 0x81, 0xbc, 0x9a, 0x92,
+	0xf6, 0x91, 0x49, 0x08, 0xac, 0x7b, 0x16, 0x50, 0xa2, 0x83, 0x74, 0x7c, 0x2a, 0x5b, 0x30, 0x27,
+	0x21, 0xd2, 0xd2, 0x54, 0xa5, 0x90, 0xaa, 0xe2, 0x0a, 0x06, 0xb1, 0xca, 0xbd, 0x78, 0x18, 0x0e,
+	0x82, 0xfd, 0xa6, 0x9c, 0x0a, 0xe2, 0xde, 0x3c, 0x06, 0x2b, 0x06, 0x51, 0xc9, 0x19, 0xc4, 0xf3,
+	0xb0, 0xe8, 0x0b, 0x22, 0x02, 0x6e, 0x2e, 0x78, 0x51, 0x74, 0xa1, 0x49, 0x79, 0x84, 0x16, 0xf6,
+	0x8e, 0xd0, 0xfe, 0x22, 0x77, 0xcd, 0xbb, 0x62, 0x56, 0xe1, 0xe8, 0x3d, 0x41, 0x69, 0x7c, 0x80,
+	0xa1, 0x2c, 0x4f, 0x85, 0xb3, 0xac, 0x5e, 0x03, 0xb0, 0xc8, 0xc3, 0x16, 0xe6, 0xa8, 0x90, 0x61,
+	0xb6, 0x68, 0x91, 0x87, 0x12, 0x52, 0x90, 0x97, 0x3c, 0x12, 0xc4, 0x22, 0x47, 0x72, 0xbf, 0x54,
+	0x04, 0xf5, 0x9b, 0xf6, 0xa1, 0x2c, 0x43, 0xf7, 0x10, 0x2d, 0x89, 0x5d, 0x05, 0xbe, 0x75, 0x78,
+	0x60, 0x3b, 0x26, 0x3b, 0xca, 0xe4, 0xe6, 0x89, 0xaa, 0xcf, 0xc1, 0xac, 0x7c, 0x3f, 0x63, 0x4f,
+	0xa2, 0x9c, 0x7e, 0xc4, 0x71, 0xaf, 0x73, 0xa4, 0x8e, 0xdb, 0x4b, 0x74, 0xad, 0x55, 0x1f, 0x07,
+	0x2d, 0x0e, 0x22, 0x32, 0xf8, 0xf3, 0x92, 0x28, 0xd8, 0x9b, 0xf6, 0xa1, 0x7c, 0x83, 0xed, 0x11,
+	0x42, 0x3f, 0x2b, 0xfe, 0xd4, 0x05, 0xe7, 0x3e, 0x9c, 0xd1, 0x0d, 0x83, 0x5f, 0x43, 0xb6, 0x7c,
+	0xab, 0x09, 0xbf, 0xc4, 0xce, 0xbe, 0x78, 0x97, 0x44, 0x4f, 0xea, 0x86, 0xb1, 0x47, 0xc8, 0xb0,
+	0x3b, 0xca, 0x6f, 0xb1, 0xd5, 0xaf, 0x83, 0x26, 0xdf, 0xe0, 0xb1, 0x96, 0xa7, 0xf3, 0x59, 0x3e,
+	0x2d, 0x4d, 0x44, 0x8c, 0x47, 0x31, 0xf3, 0x55, 0x4a, 0x58, 0x9e, 0x19, 0x03, 0x73, 0xc3, 0x34,
+	0x92, 0x31, 0x0f, 0x2d, 0xcf, 0x8e, 0x87, 0xd9, 0x35, 0xde, 0x86, 0xb2, 0x8b, 0x39, 0xbe, 0x67,
+	0x50, 0x9a, 0xcb, 0xe7, 0x40, 0x93, 0xd0, 0xef, 0xc6, 0xf4, 0x0e, 0x54, 0x13, 0xce, 0xfb, 0x18,
+	0x24, 0xf8, 0x99, 0xcf, 0xe7, 0xe7, 0xdc, 0x90, 0x48, 0xac, 0x2b, 0x0b, 0x2a, 0xc9, 0x7c, 0x1c,
+	0x9d, 0x99, 0x36, 0x2d, 0x15, 0x2b, 0x85, 0xb4, 0x1e, 0xdc, 0x1e, 0x21, 0x4d, 0x2e, 0x88, 0x0e,
+	0x1f, 0x8f, 0x27, 0x26, 0x44, 0xa8, 0xca, 0xe0, 0x42, 0x2a, 0x35, 0x74, 0x09, 0x23, 0xb9, 0x5c,
+	0x4b, 0xe4, 0x88, 0x5e, 0x75, 0x38, 0xe7, 0xb2, 0x8c, 0xb6, 0x14, 0x78, 0x30, 0x17, 0xf2, 0x05,
+	0x73, 0x55, 0x72, 0x6b, 0x0c, 0x8e, 0x22, 0x81, 0xec, 0x40, 0xc5, 0x47, 0x2c, 0xde, 0xcb, 0x62,
+	0x3e, 0x2f, 0x8f, 0x0f, 0xe9, 0xc4, 0x39, 0xea, 0xc2, 0x5a, 0x22, 0x17, 0x8c, 0xde, 0xd2, 0x48,
+	0xd1, 0x3b, 0x1b, 0x4b, 0x0a, 0x23, 0xe7, 0x40, 0x35, 0x8d, 0x16, 0x3a, 0x5c, 0x1e, 0xc9, 0x61,
+	0x39, 0x89, 0x1f, 0xfa, 0xf4, 0xd5, 0x58, 0x74, 0x4f, 0x29, 0x02, 0xf9, 0xd8, 0x48, 0x35, 0xb6,
+	0x1b, 0xda, 0x75, 0xc6, 0xd4, 0x58, 0x82, 0x9f, 0xe3, 0xa3, 0xd6, 0x58, 0xac, 0xab, 0x97, 0xa1,
+	0x4a, 0x09, 0x93, 0x7e, 0x3c, 0x07, 0xbe, 0x28, 0xee, 0x9b, 0x7d, 0x5a, 0x3a, 0x21, 0xde, 0xe8,
+	0x65, 0x4a, 0x18, 0xb7, 0x13, 0xba, 0x3e, 0xe7, 0xff, 0x6a, 0x98, 0x7d, 0xde, 0x7d, 0x7a, 0x62,
+	0x60, 0xe5, 0xb0, 0xa6, 0x8a, 0x33, 0x76, 0x65, 0x60, 0xa5, 0xdb, 0x8b, 0x2c, 0x6b, 0x72, 0xef,
+	0x16, 0x5a, 0xb7, 0x70, 0x51, 0xfb, 0xb6, 0x3b, 0xb6, 0xdb, 0xb5, 0xe9, 0xe7, 0xb4, 0x28, 0xa7,
+	0x2d, 0x6a, 0x11, 0x70, 0x67, 0x61, 0x35, 0x06, 0x00, 0xa2, 0xfb, 0xf5, 0x70, 0xd3, 0x20, 0x8f,
+	0xd7, 0x77, 0xc4, 0x67, 0x4d, 0x9f, 0xc3, 0xa6, 0x41, 0x7e, 0x1f, 0x95, 0xb5, 0x69, 0x90, 0xee,
+	0xdc, 0x4d, 0x83, 0xd4, 0xd9, 0x3e, 0x1e, 0x24, 0x50, 0x52, 0xaa, 0x15, 0xd0, 0xe2, 0x40, 0xfa,
+	0xee, 0x0d, 0x7f, 0x21, 0x9b, 0x7d, 0xff, 0x3b, 0x24, 0xc2, 0x59, 0x90, 0xad, 0xba, 0x38, 0xfc,
+	0x5b, 0x3f, 0xab, 0x40, 0xe1, 0x36, 0xed, 0xa8, 0xaf, 0x41, 0x71, 0xb8, 0xd4, 0xab, 0x97, 0x13,
+	0xf7, 0x59, 0xd1, 0x0f, 0xc8, 0xb4, 0x27, 0xf3, 0x09, 0x4b, 0x7f, 0x9e, 0x9f, 0x86, 0x69, 0xe4,
+	0xf0, 0xe3, 0x7d, 0xbf, 0xa5, 0x3d, 0x99, 0x4f, 0x18, 0xfd, 0x74, 0x61, 0xc1, 0xf7, 0x29, 0x8f,
+	0xba, 0x91, 0xa6, 0x1c, 0xf9, 0x80, 0x4a, 0xab, 0xe5, 0x15, 0xf7, 0x79, 0xf3, 0xbe, 0xd5, 0x49,
+	0xf7, 0x16, 0xf9, 0x8c, 0x48, 0xab, 0xe5, 0x15, 0x47, 0x6f, 0x6f, 0x2a, 0x70, 0x3a, 0xfe, 0x63,
+	0x12, 0xf5, 0xe9, 0xcc, 0x20, 0x25, 0x7c, 0x32, 0xa4, 0x3d, 0x33, 0x86, 0x26, 0xe2, 0x69, 0xc3,
+	0xbc, 0xfb, 0x55, 0x83, 0x7a, 0x29, 0xc5, 0x4c, 0xe8, 0xfb, 0x15, 0xed, 0x72, 0x2e, 0xd9, 0xa0,
+	0x13, 0xde, 0x65, 0xcf, 0x74, 0xe2, 0xfb, 0x8e, 0x42, 0xbb, 0x9c, 0x4b, 0x16, 0x9d, 0xd8, 0xb0,
+	0xe8, 0x6f, 0x68, 0xab, 0x69, 0x99, 0x89, 0xe9, 0xed, 0x6b, 0xf5, 0xdc, 0xf2, 0xbe, 0x54, 0xc6,
+	0xb7, 0x5f, 0x53, 0x53, 0x99, 0xda, 0x59, 0xd7, 0x9e, 0x19, 0x43, 0x13, 0xf1, 0xfc, 0x88, 0x1f,
+	0xf6, 0x13, 0x1a, 0xa0, 0xea, 0x76, 0xa6, 0xdd, 0xc4, 0xee, 0xb0, 0xf6, 0xec, 0x58, 0xba, 0x11,
+	0x54, 0xd1, 0x9e, 0x65, 0x0e, 0x54, 0x89, 0x3d, 0x5a, 0xed, 0xd9, 0xb1, 0x74, 0x11, 0xd5, 0x00,
+	0x96, 0x83, 0x1d, 0x41, 0xf5, 0x4a, 0xa6, 0xb9, 0x50, 0x2f, 0x55, 0xdb, 0x1c, 0x41, 0x03, 0xdd,
+	0xbe, 0xc1, 0xbf, 0x6f, 0x8d, 0x76, 0xe7, 0xd4, 0x2f, 0x67, 0x9a, 0x8a, 0xeb, 0x4d, 0x6a, 0x57,
+	0x47, 0x55, 0x43, 0x18, 0x3f, 0x08, 0xc1, 0xc0, 0x86, 0x5a, 0x6e, 0x18, 0xc1, 0x8e, 0xa1, 0x76,
+	0x75, 0x54, 0x35, 0xdc, 0x43, 0x14, 0xbe, 0x3f, 0xa5, 0xa8, 0x3f, 0x57, 0xe0, 0x6c, 0x4a, 0x23,
+	0x4c, 0xbd, 0x9e, 0xd3, 0x78, 0x7c, 0xb7, 0x4f, 0x7b, 0x7e, 0x5c, 0xf5, 0x48, 0x91, 0x87, 0x7b,
+	0x59, 0x39, 0x8a, 0x3c, 0xa1, 0x5f, 0xa7, 0x3d, 0x33, 0x86, 0x26, 0xe2, 0x79, 0x9b, 0xf7, 0x03,
+	0x33, 0x3a, 0x4f, 0x6a, 0x63, 0x54, 0xd2, 0x31, 0x45, 0xbf, 0xfb, 0x99, 0x6c, 0x20, 0xda, 0x1f,
+	0x2a, 0xb0, 0x12, 0xdb, 0x5c, 0x52, 0xaf, 0xe5, 0x35, 0x1f, 0xea, 0x94, 0x69, 0x4f, 0x8f, 0xae,
+	0x88, 0x60, 0x7e, 0xc5, 0x2f, 0xf1, 0xd2, 0x3a, 0x42, 0xea, 0x0b, 0x39, 0x6d, 0x27, 0xb5, 0xbf,
+	0xb4, 0x17, 0xc7, 0x37, 0x80, 0x20, 0xdf, 0xe2, 0x77, 0xcf, 0xf1, 0xed, 0x15, 0x35, 0x7b, 0xda,
+	0x24, 0x75, 0xaf, 0xb4, 0xed, 0x71, 0x54, 0x11, 0xd2, 0xf7, 0x14, 0x38, 0x15, 0xd7, 0x1f, 0x50,
+	0xaf, 0xe6, 0x34, 0x1a, 0xea, 0xfd, 0x68, 0xd7, 0x46, 0xd6, 0x43, 0x24, 0x0e, 0x2c, 0x05, 0x3a,
+	0x05, 0x6a, 0x3d, 0x73, 0xe3, 0x13, 0xbc, 0xbe, 0xd7, 0xae, 0xe4, 0x57, 0xf0, 0x7c, 0x06, 0xba,
+	0x04, 0xa9, 0x3e, 0xe3, 0x7a, 0x15, 0xda, 0x95, 0xfc, 0x0a, 0x9e, 0xcf, 0xc0, 0x1d, 0x79, 0xaa,
+	0xcf, 0xb8, 0x36, 0x85, 0x76, 0x25, 0xbf, 0x82, 0xb7, 0x22, 0x06, 0x06, 0xa8, 0x9a, 0xdb, 0x06,
+	0xcd, 0xb3, 0x22, 0xc6, 0x5f, 0xfa, 0x73, 0xb7, 0xc1, 0x3b, 0xf7, 0x54, 0xb7, 0xb1, 0xcd, 0x01,
+	0x6d, 0x73, 0x04, 0x0d, 0xdf, 0x42, 0x1c, 0x73, 0x27, 0x9e, 0xba, 0x02, 0x26, 0xdf, 0xfe, 0x6b,
+	0x57, 0x47, 0x55, 0x43, 0x18, 0x8f, 0xe0, 0xb1, 0xd0, 0x9d, 0xb6, 0x9a, 0x46, 0x26, 0xfe, 0x8a,
+	0x5e, 0xdb, 0x1a, 0x45, 0xc5, 0x9b, 0x62, 0x81, 0x6b, 0x87, 0xd4, 0x29, 0x16, 0x77, 0xb1, 0xae,
+	0x5d, 0xc9, 0xaf, 0xe0, 0xe5, 0x3a, 0x78, 0x9b, 0xa0, 0x66, 0xd8, 0x88, 0xde, 0x7c, 0x68, 0x9b,
+	0x23, 0x68, 0xa0, 0xdb, 0x6f, 0x89, 0x20, 0xfb, 0x4f, 0xd0, 0x59, 0x41, 0x8e, 0xb9, 0x0d, 0xd0,
+	0xb6, 0x46, 0x51, 0xf1, 0x6f, 0x70, 0x6c, 0x58, 0x0c, 0xf8, 0x4e, 0x3b, 0x97, 0xc4, 0x39, 0xae,
+	0xe7, 0x96, 0x97, 0x5e, 0xb5, 0x99, 0xef, 0xf0, 0x6f, 0xe1, 0x1a, 0xe4, 0xbd, 0x8f, 0xcb, 0xca,
+	0x07, 0x1f, 0x97, 0x95, 0x8f, 0x3e, 0x2e, 0x2b, 0x6f, 0x7d, 0x52, 0x3e, 0xf6, 0xc1, 0x27, 0xe5,
+	0x63, 0xff, 0xfc, 0xa4, 0x7c, 0x0c, 0x56, 0x4d, 0x3b, 0xc1, 0xe6, 0x1d, 0xe5, 0xd5, 0x9a, 0xef,
+	0x13, 0x3c, 0x4f, 0x68, 0xc3, 0xb4, 0x7d, 0xbf, 0xea, 0x8f, 0x86, 0x7f, 0xad, 0xb6, 0x3f, 0x2b,
+	0xfe, 0x44, 0xed, 0xa9, 0xff, 0x0e, 0x00, 0x2a, 0x64, 0x49, 0xfd, 0x1a, 0x38, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3635,6 +3890,8 @@ type MsgClient interface {
 	CommitFunds(ctx context.Context, in *MsgCommitFundsRequest, opts ...grpc.CallOption) (*MsgCommitFundsResponse, error)
 	// CancelOrder cancels an order.
 	CancelOrder(ctx context.Context, in *MsgCancelOrderRequest, opts ...grpc.CallOption) (*MsgCancelOrderResponse, error)
+	// CreateConditionalOrder creates an ask or bid order that is only placed once a trade price condition is met.
+	CreateConditionalOrder(ctx context.Context, in *MsgCreateConditionalOrderRequest, opts ...grpc.CallOption) (*MsgCreateConditionalOrderResponse, error)
 	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 	FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error)
 	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
@@ -3660,6 +3917,8 @@ type MsgClient interface {
 	MarketUpdateUserSettle(ctx context.Context, in *MsgMarketUpdateUserSettleRequest, opts ...grpc.CallOption) (*MsgMarketUpdateUserSettleResponse, error)
 	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 	MarketUpdateAcceptingCommitments(ctx context.Context, in *MsgMarketUpdateAcceptingCommitmentsRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
+	// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+	MarketUpdateAutoMatch(ctx context.Context, in *MsgMarketUpdateAutoMatchRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAutoMatchResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 	MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error)
 	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
@@ -3736,6 +3995,15 @@ func (c *msgClient) CancelOrder(ctx context.Context, in *MsgCancelOrderRequest,
 	return out, nil
 }
 
+func (c *msgClient) CreateConditionalOrder(ctx context.Context, in *MsgCreateConditionalOrderRequest, opts ...grpc.CallOption) (*MsgCreateConditionalOrderResponse, error) {
+	out := new(MsgCreateConditionalOrderResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/CreateConditionalOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) FillBids(ctx context.Context, in *MsgFillBidsRequest, opts ...grpc.CallOption) (*MsgFillBidsResponse, error) {
 	out := new(MsgFillBidsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/FillBids", in, out, opts...)
@@ -3845,17 +4113,26 @@ func (c *msgClient) MarketUpdateAcceptingCommitments(ctx context.Context, in *Ms
 	return out, nil
 }
 
-func (c *msgClient) MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
-	out := new(MsgMarketUpdateIntermediaryDenomResponse)
-	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom", in, out, opts...)
+func (c *msgClient) MarketUpdateAutoMatch(ctx context.Context, in *MsgMarketUpdateAutoMatchRequest, opts ...grpc.CallOption) (*MsgMarketUpdateAutoMatchResponse, error) {
+	out := new(MsgMarketUpdateAutoMatchResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateAutoMatch", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *msgClient) MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error) {
-	out := new(MsgMarketManagePermissionsResponse)
+func (c *msgClient) MarketUpdateIntermediaryDenom(ctx context.Context, in *MsgMarketUpdateIntermediaryDenomRequest, opts ...grpc.CallOption) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
+	out := new(MsgMarketUpdateIntermediaryDenomResponse)
+	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketUpdateIntermediaryDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MarketManagePermissions(ctx context.Context, in *MsgMarketManagePermissionsRequest, opts ...grpc.CallOption) (*MsgMarketManagePermissionsResponse, error) {
+	out := new(MsgMarketManagePermissionsResponse)
 	err := c.cc.Invoke(ctx, "/provenance.exchange.v1.Msg/MarketManagePermissions", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -3982,6 +4259,8 @@ type MsgServer interface {
 	CommitFunds(context.Context, *MsgCommitFundsRequest) (*MsgCommitFundsResponse, error)
 	// CancelOrder cancels an order.
 	CancelOrder(context.Context, *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error)
+	// CreateConditionalOrder creates an ask or bid order that is only placed once a trade price condition is met.
+	CreateConditionalOrder(context.Context, *MsgCreateConditionalOrderRequest) (*MsgCreateConditionalOrderResponse, error)
 	// FillBids uses the assets in your account to fulfill one or more bids (similar to a fill-or-cancel ask).
 	FillBids(context.Context, *MsgFillBidsRequest) (*MsgFillBidsResponse, error)
 	// FillAsks uses the funds in your account to fulfill one or more asks (similar to a fill-or-cancel bid).
@@ -4007,6 +4286,8 @@ type MsgServer interface {
 	MarketUpdateUserSettle(context.Context, *MsgMarketUpdateUserSettleRequest) (*MsgMarketUpdateUserSettleResponse, error)
 	// MarketUpdateAcceptingCommitments is a market endpoint to update whether it accepts commitments.
 	MarketUpdateAcceptingCommitments(context.Context, *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error)
+	// MarketUpdateAutoMatch is a market endpoint to update whether its orders are automatically matched and settled.
+	MarketUpdateAutoMatch(context.Context, *MsgMarketUpdateAutoMatchRequest) (*MsgMarketUpdateAutoMatchResponse, error)
 	// MarketUpdateIntermediaryDenom sets a market's intermediary denom.
 	MarketUpdateIntermediaryDenom(context.Context, *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error)
 	// MarketManagePermissions is a market endpoint to manage a market's user permissions.
@@ -4055,6 +4336,9 @@ func (*UnimplementedMsgServer) CommitFunds(ctx context.Context, req *MsgCommitFu
 func (*UnimplementedMsgServer) CancelOrder(ctx context.Context, req *MsgCancelOrderRequest) (*MsgCancelOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
 }
+func (*UnimplementedMsgServer) CreateConditionalOrder(ctx context.Context, req *MsgCreateConditionalOrderRequest) (*MsgCreateConditionalOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConditionalOrder not implemented")
+}
 func (*UnimplementedMsgServer) FillBids(ctx context.Context, req *MsgFillBidsRequest) (*MsgFillBidsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FillBids not implemented")
 }
@@ -4091,6 +4375,9 @@ func (*UnimplementedMsgServer) MarketUpdateUserSettle(ctx context.Context, req *
 func (*UnimplementedMsgServer) MarketUpdateAcceptingCommitments(ctx context.Context, req *MsgMarketUpdateAcceptingCommitmentsRequest) (*MsgMarketUpdateAcceptingCommitmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAcceptingCommitments not implemented")
 }
+func (*UnimplementedMsgServer) MarketUpdateAutoMatch(ctx context.Context, req *MsgMarketUpdateAutoMatchRequest) (*MsgMarketUpdateAutoMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateAutoMatch not implemented")
+}
 func (*UnimplementedMsgServer) MarketUpdateIntermediaryDenom(ctx context.Context, req *MsgMarketUpdateIntermediaryDenomRequest) (*MsgMarketUpdateIntermediaryDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarketUpdateIntermediaryDenom not implemented")
 }
@@ -4210,6 +4497,24 @@ func _Msg_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CreateConditionalOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateConditionalOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateConditionalOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/CreateConditionalOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateConditionalOrder(ctx, req.(*MsgCreateConditionalOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_FillBids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgFillBidsRequest)
 	if err := dec(in); err != nil {
@@ -4426,6 +4731,24 @@ func _Msg_MarketUpdateAcceptingCommitments_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MarketUpdateAutoMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarketUpdateAutoMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MarketUpdateAutoMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.exchange.v1.Msg/MarketUpdateAutoMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarketUpdateAutoMatch(ctx, req.(*MsgMarketUpdateAutoMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_MarketUpdateIntermediaryDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgMarketUpdateIntermediaryDenomRequest)
 	if err := dec(in); err != nil {
@@ -4698,6 +5021,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CancelOrder",
 			Handler:    _Msg_CancelOrder_Handler,
 		},
+		{
+			MethodName: "CreateConditionalOrder",
+			Handler:    _Msg_CreateConditionalOrder_Handler,
+		},
 		{
 			MethodName: "FillBids",
 			Handler:    _Msg_FillBids_Handler,
@@ -4746,6 +5073,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MarketUpdateAcceptingCommitments",
 			Handler:    _Msg_MarketUpdateAcceptingCommitments_Handler,
 		},
+		{
+			MethodName: "MarketUpdateAutoMatch",
+			Handler:    _Msg_MarketUpdateAutoMatch_Handler,
+		},
 		{
 			MethodName: "MarketUpdateIntermediaryDenom",
 			Handler:    _Msg_MarketUpdateIntermediaryDenom_Handler,
@@ -5102,6 +5433,112 @@ func (m *MsgCancelOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCreateConditionalOrderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateConditionalOrderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateConditionalOrderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderCreationFee != nil {
+		{
+			size, err := m.OrderCreationFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.TriggerDirection != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TriggerDirection))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TriggerPrice) > 0 {
+		i -= len(m.TriggerPrice)
+		copy(dAtA[i:], m.TriggerPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TriggerPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BidOrder != nil {
+		{
+			size, err := m.BidOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AskOrder != nil {
+		{
+			size, err := m.AskOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateConditionalOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateConditionalOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateConditionalOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgFillBidsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -5147,20 +5584,20 @@ func (m *MsgFillBidsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x2a
 	}
 	if len(m.BidOrderIds) > 0 {
-		dAtA9 := make([]byte, len(m.BidOrderIds)*10)
-		var j8 int
+		dAtA12 := make([]byte, len(m.BidOrderIds)*10)
+		var j11 int
 		for _, num := range m.BidOrderIds {
 			for num >= 1<<7 {
-				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j8++
+				j11++
 			}
-			dAtA9[j8] = uint8(num)
-			j8++
+			dAtA12[j11] = uint8(num)
+			j11++
 		}
-		i -= j8
-		copy(dAtA[i:], dAtA9[:j8])
-		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5263,20 +5700,20 @@ func (m *MsgFillAsksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.AskOrderIds) > 0 {
-		dAtA12 := make([]byte, len(m.AskOrderIds)*10)
-		var j11 int
+		dAtA15 := make([]byte, len(m.AskOrderIds)*10)
+		var j14 int
 		for _, num := range m.AskOrderIds {
 			for num >= 1<<7 {
-				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j11++
+				j14++
 			}
-			dAtA12[j11] = uint8(num)
-			j11++
+			dAtA15[j14] = uint8(num)
+			j14++
 		}
-		i -= j11
-		copy(dAtA[i:], dAtA12[:j11])
-		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintTx(dAtA, i, uint64(j14))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -5359,38 +5796,38 @@ func (m *MsgMarketSettleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		dAtA[i] = 0x28
 	}
 	if len(m.BidOrderIds) > 0 {
-		dAtA15 := make([]byte, len(m.BidOrderIds)*10)
-		var j14 int
+		dAtA18 := make([]byte, len(m.BidOrderIds)*10)
+		var j17 int
 		for _, num := range m.BidOrderIds {
 			for num >= 1<<7 {
-				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA18[j17] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j14++
+				j17++
 			}
-			dAtA15[j14] = uint8(num)
-			j14++
+			dAtA18[j17] = uint8(num)
+			j17++
 		}
-		i -= j14
-		copy(dAtA[i:], dAtA15[:j14])
-		i = encodeVarintTx(dAtA, i, uint64(j14))
+		i -= j17
+		copy(dAtA[i:], dAtA18[:j17])
+		i = encodeVarintTx(dAtA, i, uint64(j17))
 		i--
 		dAtA[i] = 0x22
 	}
 	if len(m.AskOrderIds) > 0 {
-		dAtA17 := make([]byte, len(m.AskOrderIds)*10)
-		var j16 int
+		dAtA20 := make([]byte, len(m.AskOrderIds)*10)
+		var j19 int
 		for _, num := range m.AskOrderIds {
 			for num >= 1<<7 {
-				dAtA17[j16] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j16++
+				j19++
 			}
-			dAtA17[j16] = uint8(num)
-			j16++
+			dAtA20[j19] = uint8(num)
+			j19++
 		}
-		i -= j16
-		copy(dAtA[i:], dAtA17[:j16])
-		i = encodeVarintTx(dAtA, i, uint64(j16))
+		i -= j19
+		copy(dAtA[i:], dAtA20[:j19])
+		i = encodeVarintTx(dAtA, i, uint64(j19))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6121,6 +6558,74 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMarketUpdateAutoMatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAutoMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoMatch {
+		i--
+		if m.AutoMatch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgMarketUpdateIntermediaryDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7414,6 +7919,50 @@ func (m *MsgCancelOrderResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCreateConditionalOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AskOrder != nil {
+		l = m.AskOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.BidOrder != nil {
+		l = m.BidOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TriggerPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TriggerDirection != 0 {
+		n += 1 + sovTx(uint64(m.TriggerDirection))
+	}
+	if m.OrderCreationFee != nil {
+		l = m.OrderCreationFee.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateConditionalOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		n += 1 + sovTx(uint64(m.OrderId))
+	}
+	return n
+}
+
 func (m *MsgFillBidsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -7840,7 +8389,35 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgMarketUpdateIntermediaryDenomRequest) Size() (n int) {
+func (m *MsgMarketUpdateAutoMatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	if m.AutoMatch {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMarketUpdateAutoMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarketUpdateIntermediaryDenomRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -9127,6 +9704,316 @@ func (m *MsgCancelOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCreateConditionalOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateConditionalOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateConditionalOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AskOrder == nil {
+				m.AskOrder = &AskOrder{}
+			}
+			if err := m.AskOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BidOrder == nil {
+				m.BidOrder = &BidOrder{}
+			}
+			if err := m.BidOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerDirection", wireType)
+			}
+			m.TriggerDirection = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerDirection |= TriggerDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OrderCreationFee == nil {
+				m.OrderCreationFee = &types.Coin{}
+			}
+			if err := m.OrderCreationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateConditionalOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateConditionalOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateConditionalOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgFillBidsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11936,6 +12823,177 @@ func (m *MsgMarketUpdateAcceptingCommitmentsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
+func (m *MsgMarketUpdateAutoMatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMatch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoMatch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMarketUpdateAutoMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMarketUpdateAutoMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgMarketUpdateIntermediaryDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,275 @@
+// Package wasm supports smart contract integration with the provenance exchange module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// Compile time interface check
+var _ provwasm.Encoder = Encoder
+
+// ExchangeMsgParams are params for encoding []sdk.Msg types from the exchange module.
+// Only one field should be set.
+type ExchangeMsgParams struct {
+	// Encode a MsgCreateAskRequest
+	CreateAsk *CreateAskParams `json:"create_ask,omitempty"`
+	// Encode a MsgCreateBidRequest
+	CreateBid *CreateBidParams `json:"create_bid,omitempty"`
+	// Encode a MsgCancelOrderRequest
+	CancelOrder *CancelOrderParams `json:"cancel_order,omitempty"`
+	// Encode a MsgFillBidsRequest
+	FillBids *FillBidsParams `json:"fill_bids,omitempty"`
+	// Encode a MsgFillAsksRequest
+	FillAsks *FillAsksParams `json:"fill_asks,omitempty"`
+	// Encode a MsgCommitFundsRequest
+	CommitFunds *CommitFundsParams `json:"commit_funds,omitempty"`
+	// Encode a MsgCreatePaymentRequest
+	CreatePayment *CreatePaymentParams `json:"create_payment,omitempty"`
+}
+
+// CreateAskParams are params for encoding a MsgCreateAskRequest.
+// The contract is the seller.
+type CreateAskParams struct {
+	// The id of the market to create the ask order in.
+	MarketID uint32 `json:"market_id"`
+	// The assets being sold.
+	Assets sdk.Coin `json:"assets"`
+	// The minimum price the contract is willing to accept for the assets.
+	Price sdk.Coin `json:"price"`
+	// The flat fee the seller will pay during settlement (optional).
+	SellerSettlementFlatFee *sdk.Coin `json:"seller_settlement_flat_fee,omitempty"`
+	// Whether this order can be partially filled.
+	AllowPartial bool `json:"allow_partial,omitempty"`
+	// An optional identifier for this order that is unique within the market.
+	ExternalID string `json:"external_id,omitempty"`
+	// The block height at which this order expires (optional).
+	ExpirationHeight uint64 `json:"expiration_height,omitempty"`
+	// The block time at which this order expires (optional).
+	ExpirationTime *time.Time `json:"expiration_time,omitempty"`
+	// The fee to pay for creating the order (optional).
+	OrderCreationFee *sdk.Coin `json:"order_creation_fee,omitempty"`
+}
+
+// CreateBidParams are params for encoding a MsgCreateBidRequest.
+// The contract is the buyer.
+type CreateBidParams struct {
+	// The id of the market to create the bid order in.
+	MarketID uint32 `json:"market_id"`
+	// The assets being bought.
+	Assets sdk.Coin `json:"assets"`
+	// The price the contract is willing to pay for the assets.
+	Price sdk.Coin `json:"price"`
+	// The fees the buyer will pay during settlement (optional).
+	BuyerSettlementFees sdk.Coins `json:"buyer_settlement_fees,omitempty"`
+	// Whether this order can be partially filled.
+	AllowPartial bool `json:"allow_partial,omitempty"`
+	// An optional identifier for this order that is unique within the market.
+	ExternalID string `json:"external_id,omitempty"`
+	// The block height at which this order expires (optional).
+	ExpirationHeight uint64 `json:"expiration_height,omitempty"`
+	// The block time at which this order expires (optional).
+	ExpirationTime *time.Time `json:"expiration_time,omitempty"`
+	// The fee to pay for creating the order (optional).
+	OrderCreationFee *sdk.Coin `json:"order_creation_fee,omitempty"`
+}
+
+// CancelOrderParams are params for encoding a MsgCancelOrderRequest.
+type CancelOrderParams struct {
+	// The id of the order to cancel.
+	OrderID uint64 `json:"order_id"`
+}
+
+// FillBidsParams are params for encoding a MsgFillBidsRequest.
+// The contract is the seller.
+type FillBidsParams struct {
+	// The id of the market with the bid orders to fill.
+	MarketID uint32 `json:"market_id"`
+	// The total assets of all the bid orders being filled.
+	TotalAssets sdk.Coins `json:"total_assets"`
+	// The ids of the bid orders to fill.
+	BidOrderIDs []uint64 `json:"bid_order_ids"`
+	// The flat fee the seller will pay during settlement (optional).
+	SellerSettlementFlatFee *sdk.Coin `json:"seller_settlement_flat_fee,omitempty"`
+	// The ask order creation fee (optional).
+	AskOrderCreationFee *sdk.Coin `json:"ask_order_creation_fee,omitempty"`
+}
+
+// FillAsksParams are params for encoding a MsgFillAsksRequest.
+// The contract is the buyer.
+type FillAsksParams struct {
+	// The id of the market with the ask orders to fill.
+	MarketID uint32 `json:"market_id"`
+	// The total price of all the ask orders being filled.
+	TotalPrice sdk.Coin `json:"total_price"`
+	// The ids of the ask orders to fill.
+	AskOrderIDs []uint64 `json:"ask_order_ids"`
+	// The fees the buyer will pay during settlement (optional).
+	BuyerSettlementFees sdk.Coins `json:"buyer_settlement_fees,omitempty"`
+	// The bid order creation fee (optional).
+	BidOrderCreationFee *sdk.Coin `json:"bid_order_creation_fee,omitempty"`
+}
+
+// CommitFundsParams are params for encoding a MsgCommitFundsRequest.
+// The contract is the account committing funds.
+type CommitFundsParams struct {
+	// The id of the market the funds are being committed to.
+	MarketID uint32 `json:"market_id"`
+	// The funds to commit.
+	Amount sdk.Coins `json:"amount"`
+	// The fee to pay for the commitment (optional).
+	CreationFee *sdk.Coin `json:"creation_fee,omitempty"`
+	// A string to include in the funds-committed event (optional).
+	EventTag string `json:"event_tag,omitempty"`
+}
+
+// CreatePaymentParams are params for encoding a MsgCreatePaymentRequest.
+// The contract is the source of the payment.
+type CreatePaymentParams struct {
+	// The funds that the contract will provide.
+	SourceAmount sdk.Coins `json:"source_amount,omitempty"`
+	// The account that can accept the payment.
+	Target string `json:"target,omitempty"`
+	// The funds that the target will provide.
+	TargetAmount sdk.Coins `json:"target_amount,omitempty"`
+	// An identifier for this payment that is unique among the contract's payments.
+	ExternalID string `json:"external_id,omitempty"`
+}
+
+// Encoder returns a smart contract message encoder for the exchange module.
+func Encoder(contract sdk.AccAddress, msg json.RawMessage, _ string) ([]sdk.Msg, error) {
+	wrapper := struct {
+		Params *ExchangeMsgParams `json:"exchange"`
+	}{}
+	if err := json.Unmarshal(msg, &wrapper); err != nil {
+		return nil, fmt.Errorf("wasm: failed to unmarshal exchange encode params: %w", err)
+	}
+	params := wrapper.Params
+	if params == nil {
+		return nil, fmt.Errorf("wasm: nil exchange encode params")
+	}
+	switch {
+	case params.CreateAsk != nil:
+		return params.CreateAsk.Encode(contract)
+	case params.CreateBid != nil:
+		return params.CreateBid.Encode(contract)
+	case params.CancelOrder != nil:
+		return params.CancelOrder.Encode(contract)
+	case params.FillBids != nil:
+		return params.FillBids.Encode(contract)
+	case params.FillAsks != nil:
+		return params.FillAsks.Encode(contract)
+	case params.CommitFunds != nil:
+		return params.CommitFunds.Encode(contract)
+	case params.CreatePayment != nil:
+		return params.CreatePayment.Encode(contract)
+	default:
+		return nil, fmt.Errorf("wasm: invalid exchange encode request: %s", string(msg))
+	}
+}
+
+// Encode creates a MsgCreateAskRequest.
+func (params *CreateAskParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgCreateAskRequest{
+		AskOrder: exchange.AskOrder{
+			MarketId:                params.MarketID,
+			Seller:                  contract.String(),
+			Assets:                  params.Assets,
+			Price:                   params.Price,
+			SellerSettlementFlatFee: params.SellerSettlementFlatFee,
+			AllowPartial:            params.AllowPartial,
+			ExternalId:              params.ExternalID,
+			ExpirationHeight:        params.ExpirationHeight,
+			ExpirationTime:          params.ExpirationTime,
+		},
+		OrderCreationFee: params.OrderCreationFee,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCreateBidRequest.
+func (params *CreateBidParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgCreateBidRequest{
+		BidOrder: exchange.BidOrder{
+			MarketId:            params.MarketID,
+			Buyer:               contract.String(),
+			Assets:              params.Assets,
+			Price:               params.Price,
+			BuyerSettlementFees: params.BuyerSettlementFees,
+			AllowPartial:        params.AllowPartial,
+			ExternalId:          params.ExternalID,
+			ExpirationHeight:    params.ExpirationHeight,
+			ExpirationTime:      params.ExpirationTime,
+		},
+		OrderCreationFee: params.OrderCreationFee,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCancelOrderRequest.
+// The contract must be the owner of the order (or have permission to cancel orders in its market).
+func (params *CancelOrderParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgCancelOrderRequest{
+		Signer:  contract.String(),
+		OrderId: params.OrderID,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgFillBidsRequest.
+func (params *FillBidsParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgFillBidsRequest{
+		Seller:                  contract.String(),
+		MarketId:                params.MarketID,
+		TotalAssets:             params.TotalAssets,
+		BidOrderIds:             params.BidOrderIDs,
+		SellerSettlementFlatFee: params.SellerSettlementFlatFee,
+		AskOrderCreationFee:     params.AskOrderCreationFee,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgFillAsksRequest.
+func (params *FillAsksParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgFillAsksRequest{
+		Buyer:               contract.String(),
+		MarketId:            params.MarketID,
+		TotalPrice:          params.TotalPrice,
+		AskOrderIds:         params.AskOrderIDs,
+		BuyerSettlementFees: params.BuyerSettlementFees,
+		BidOrderCreationFee: params.BidOrderCreationFee,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCommitFundsRequest.
+func (params *CommitFundsParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgCommitFundsRequest{
+		Account:     contract.String(),
+		MarketId:    params.MarketID,
+		Amount:      params.Amount,
+		CreationFee: params.CreationFee,
+		EventTag:    params.EventTag,
+	}
+	return []sdk.Msg{msg}, nil
+}
+
+// Encode creates a MsgCreatePaymentRequest.
+func (params *CreatePaymentParams) Encode(contract sdk.AccAddress) ([]sdk.Msg, error) {
+	msg := &exchange.MsgCreatePaymentRequest{
+		Payment: exchange.Payment{
+			Source:       contract.String(),
+			SourceAmount: params.SourceAmount,
+			Target:       params.Target,
+			TargetAmount: params.TargetAmount,
+			ExternalId:   params.ExternalID,
+		},
+	}
+	return []sdk.Msg{msg}, nil
+}
@@ -0,0 +1,193 @@
+package wasm_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/wasm"
+)
+
+func TestEncoder(t *testing.T) {
+	encCfg := app.MakeTestEncodingConfig(t)
+	contract := sdk.AccAddress("contract____________")
+	target := sdk.AccAddress("target______________").String()
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, sdkmath.NewInt(amount))
+	}
+	coinP := func(amount int64, denom string) *sdk.Coin {
+		rv := coin(amount, denom)
+		return &rv
+	}
+	coins := func(amount int64, denom string) sdk.Coins {
+		return sdk.NewCoins(coin(amount, denom))
+	}
+	expTime := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		msg    string
+		expMsg sdk.Msg
+		expErr string
+	}{
+		{
+			name:   "invalid json",
+			msg:    `{"exchange":`,
+			expErr: "wasm: failed to unmarshal exchange encode params: unexpected end of JSON input",
+		},
+		{
+			name:   "wrong type",
+			msg:    `{"exchange":{"cancel_order":{"order_id":"one"}}}`,
+			expErr: "wasm: failed to unmarshal exchange encode params: json: cannot unmarshal string into Go struct field",
+		},
+		{
+			name:   "no exchange params",
+			msg:    `{"marker":{}}`,
+			expErr: "wasm: nil exchange encode params",
+		},
+		{
+			name:   "null exchange params",
+			msg:    `{"exchange":null}`,
+			expErr: "wasm: nil exchange encode params",
+		},
+		{
+			name:   "empty exchange params",
+			msg:    `{"exchange":{}}`,
+			expErr: `wasm: invalid exchange encode request: {"exchange":{}}`,
+		},
+		{
+			name: "create ask",
+			msg: `{"exchange":{"create_ask":{"market_id":3,"assets":{"denom":"apple","amount":"10"},` +
+				`"price":{"denom":"nhash","amount":"55"},"seller_settlement_flat_fee":{"denom":"nhash","amount":"2"},` +
+				`"allow_partial":true,"external_id":"ask1","expiration_height":100,` +
+				`"expiration_time":"2030-01-02T03:04:05Z","order_creation_fee":{"denom":"nhash","amount":"1"}}}}`,
+			expMsg: &exchange.MsgCreateAskRequest{
+				AskOrder: exchange.AskOrder{
+					MarketId:                3,
+					Seller:                  contract.String(),
+					Assets:                  coin(10, "apple"),
+					Price:                   coin(55, "nhash"),
+					SellerSettlementFlatFee: coinP(2, "nhash"),
+					AllowPartial:            true,
+					ExternalId:              "ask1",
+					ExpirationHeight:        100,
+					ExpirationTime:          &expTime,
+				},
+				OrderCreationFee: coinP(1, "nhash"),
+			},
+		},
+		{
+			name: "create bid",
+			msg: `{"exchange":{"create_bid":{"market_id":3,"assets":{"denom":"apple","amount":"10"},` +
+				`"price":{"denom":"nhash","amount":"55"},"buyer_settlement_fees":[{"denom":"nhash","amount":"3"}],` +
+				`"allow_partial":true,"external_id":"bid1","expiration_height":100,` +
+				`"expiration_time":"2030-01-02T03:04:05Z","order_creation_fee":{"denom":"nhash","amount":"1"}}}}`,
+			expMsg: &exchange.MsgCreateBidRequest{
+				BidOrder: exchange.BidOrder{
+					MarketId:            3,
+					Buyer:               contract.String(),
+					Assets:              coin(10, "apple"),
+					Price:               coin(55, "nhash"),
+					BuyerSettlementFees: coins(3, "nhash"),
+					AllowPartial:        true,
+					ExternalId:          "bid1",
+					ExpirationHeight:    100,
+					ExpirationTime:      &expTime,
+				},
+				OrderCreationFee: coinP(1, "nhash"),
+			},
+		},
+		{
+			name: "cancel order",
+			msg:  `{"exchange":{"cancel_order":{"order_id":12}}}`,
+			expMsg: &exchange.MsgCancelOrderRequest{
+				Signer:  contract.String(),
+				OrderId: 12,
+			},
+		},
+		{
+			name: "fill bids",
+			msg: `{"exchange":{"fill_bids":{"market_id":3,"total_assets":[{"denom":"apple","amount":"10"}],` +
+				`"bid_order_ids":[4,5],"seller_settlement_flat_fee":{"denom":"nhash","amount":"2"},` +
+				`"ask_order_creation_fee":{"denom":"nhash","amount":"1"}}}}`,
+			expMsg: &exchange.MsgFillBidsRequest{
+				Seller:                  contract.String(),
+				MarketId:                3,
+				TotalAssets:             coins(10, "apple"),
+				BidOrderIds:             []uint64{4, 5},
+				SellerSettlementFlatFee: coinP(2, "nhash"),
+				AskOrderCreationFee:     coinP(1, "nhash"),
+			},
+		},
+		{
+			name: "fill asks",
+			msg: `{"exchange":{"fill_asks":{"market_id":3,"total_price":{"denom":"nhash","amount":"55"},` +
+				`"ask_order_ids":[6,7],"buyer_settlement_fees":[{"denom":"nhash","amount":"3"}],` +
+				`"bid_order_creation_fee":{"denom":"nhash","amount":"1"}}}}`,
+			expMsg: &exchange.MsgFillAsksRequest{
+				Buyer:               contract.String(),
+				MarketId:            3,
+				TotalPrice:          coin(55, "nhash"),
+				AskOrderIds:         []uint64{6, 7},
+				BuyerSettlementFees: coins(3, "nhash"),
+				BidOrderCreationFee: coinP(1, "nhash"),
+			},
+		},
+		{
+			name: "commit funds",
+			msg: `{"exchange":{"commit_funds":{"market_id":3,"amount":[{"denom":"nhash","amount":"500"}],` +
+				`"creation_fee":{"denom":"nhash","amount":"1"},"event_tag":"tag1"}}}`,
+			expMsg: &exchange.MsgCommitFundsRequest{
+				Account:     contract.String(),
+				MarketId:    3,
+				Amount:      coins(500, "nhash"),
+				CreationFee: coinP(1, "nhash"),
+				EventTag:    "tag1",
+			},
+		},
+		{
+			name: "create payment",
+			msg: `{"exchange":{"create_payment":{"source_amount":[{"denom":"nhash","amount":"500"}],` +
+				`"target":"` + target + `","target_amount":[{"denom":"apple","amount":"10"}],"external_id":"pay1"}}}`,
+			expMsg: &exchange.MsgCreatePaymentRequest{
+				Payment: exchange.Payment{
+					Source:       contract.String(),
+					SourceAmount: coins(500, "nhash"),
+					Target:       target,
+					TargetAmount: coins(10, "apple"),
+					ExternalId:   "pay1",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var msgs []sdk.Msg
+			var err error
+			testFunc := func() {
+				msgs, err = wasm.Encoder(contract, []byte(tc.msg), "")
+			}
+			require.NotPanics(t, testFunc, "Encoder")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "Encoder error")
+				assert.Nil(t, msgs, "Encoder msgs")
+				return
+			}
+			require.NoError(t, err, "Encoder error")
+			require.Len(t, msgs, 1, "Encoder msgs")
+			assert.Equal(t, tc.expMsg, msgs[0], "Encoder msg")
+			signers, _, err := encCfg.Marshaler.GetMsgV1Signers(msgs[0])
+			require.NoError(t, err, "GetMsgV1Signers")
+			assert.Equal(t, [][]byte{contract}, signers, "msg signers")
+		})
+	}
+}
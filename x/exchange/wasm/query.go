@@ -0,0 +1,126 @@
+// Package wasm supports smart contract integration with the provenance exchange module.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/internal/provwasm"
+	"github.com/provenance-io/provenance/x/exchange/keeper"
+)
+
+// ExchangeQueryParams represent parameters used to query the exchange module.
+// Only one query field should be set.
+type ExchangeQueryParams struct {
+	// Get an order by id.
+	GetOrder *GetOrderParams `json:"get_order,omitempty"`
+	// Get the funds an account has committed to a market.
+	GetCommitment *GetCommitmentParams `json:"get_commitment,omitempty"`
+	// Get a market by id.
+	GetMarket *GetMarketParams `json:"get_market,omitempty"`
+}
+
+// GetOrderParams represent a query request to get an order by id.
+type GetOrderParams struct {
+	// The id of the order.
+	OrderID uint64 `json:"order_id"`
+}
+
+// GetCommitmentParams represent a query request to get the funds an account has committed to a market.
+type GetCommitmentParams struct {
+	// The account with the committed funds.
+	Account string `json:"account"`
+	// The id of the market the funds are committed to.
+	MarketID uint32 `json:"market_id"`
+}
+
+// GetMarketParams represent a query request to get a market by id.
+type GetMarketParams struct {
+	// The id of the market.
+	MarketID uint32 `json:"market_id"`
+}
+
+// Querier returns a smart contract querier for the exchange module.
+func Querier(keeper keeper.Keeper) provwasm.Querier {
+	return func(ctx sdk.Context, query json.RawMessage, _ string) ([]byte, error) {
+		wrapper := struct {
+			Params *ExchangeQueryParams `json:"exchange"`
+		}{}
+		if err := json.Unmarshal(query, &wrapper); err != nil {
+			return nil, fmt.Errorf("wasm: invalid query: %w", err)
+		}
+		params := wrapper.Params
+		if params == nil {
+			return nil, fmt.Errorf("wasm: nil exchange query params")
+		}
+		switch {
+		case params.GetOrder != nil:
+			return params.GetOrder.Run(ctx, keeper)
+		case params.GetCommitment != nil:
+			return params.GetCommitment.Run(ctx, keeper)
+		case params.GetMarket != nil:
+			return params.GetMarket.Run(ctx, keeper)
+		default:
+			return nil, fmt.Errorf("wasm: invalid exchange query: %s", string(query))
+		}
+	}
+}
+
+// Run gets an order by id.
+func (params *GetOrderParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if params.OrderID == 0 {
+		return nil, fmt.Errorf("wasm: order id cannot be zero")
+	}
+	order, err := keeper.GetOrder(ctx, params.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: get order query failed: %w", err)
+	}
+	if order == nil {
+		return nil, fmt.Errorf("wasm: order %d not found", params.OrderID)
+	}
+	return marshalResponse(createOrderResponse(order))
+}
+
+// Run gets the funds an account has committed to a market.
+func (params *GetCommitmentParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if strings.TrimSpace(params.Account) == "" {
+		return nil, fmt.Errorf("wasm: account cannot be empty")
+	}
+	addr, err := sdk.AccAddressFromBech32(params.Account)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: invalid account: %w", err)
+	}
+	if params.MarketID == 0 {
+		return nil, fmt.Errorf("wasm: market id cannot be zero")
+	}
+	amount := keeper.GetCommitmentAmount(ctx, params.MarketID, addr)
+	return marshalResponse(&QueryResCommitment{
+		Account:  params.Account,
+		MarketID: params.MarketID,
+		Amount:   amount,
+	})
+}
+
+// Run gets a market by id.
+func (params *GetMarketParams) Run(ctx sdk.Context, keeper keeper.Keeper) ([]byte, error) {
+	if params.MarketID == 0 {
+		return nil, fmt.Errorf("wasm: market id cannot be zero")
+	}
+	market := keeper.GetMarket(ctx, params.MarketID)
+	if market == nil {
+		return nil, fmt.Errorf("wasm: market %d not found", params.MarketID)
+	}
+	return marshalResponse(createMarketResponse(market))
+}
+
+// marshalResponse converts a query response into JSON.
+func marshalResponse(resp interface{}) ([]byte, error) {
+	bz, err := json.Marshal(resp)
+	if err != nil {
+		return nil, fmt.Errorf("wasm: marshal response failed: %w", err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,185 @@
+package wasm_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/exchange"
+	"github.com/provenance-io/provenance/x/exchange/wasm"
+)
+
+func TestQuerier(t *testing.T) {
+	pioApp := app.Setup(t)
+	ctx := pioApp.BaseApp.NewContext(false)
+	k := pioApp.ExchangeKeeper
+
+	addrs := app.AddTestAddrsIncremental(pioApp, ctx, 2, sdkmath.NewInt(1_000_000))
+	bondDenom, err := pioApp.StakingKeeper.BondDenom(ctx)
+	require.NoError(t, err, "BondDenom")
+	coin := func(amount int64, denom string) sdk.Coin {
+		return sdk.NewCoin(denom, sdkmath.NewInt(amount))
+	}
+
+	market := exchange.Market{
+		MarketId: 7,
+		MarketDetails: exchange.MarketDetails{
+			Name:        "Test Market",
+			Description: "A market for testing wasm queries.",
+		},
+		FeeCreateBidFlat:     []sdk.Coin{coin(1, "apple")},
+		AcceptingOrders:      true,
+		AllowUserSettlement:  true,
+		AcceptingCommitments: true,
+	}
+	marketID, err := k.CreateMarket(ctx, market)
+	require.NoError(t, err, "CreateMarket")
+	require.Equal(t, market.MarketId, marketID, "CreateMarket market id")
+
+	askOrder := exchange.AskOrder{
+		MarketId:     marketID,
+		Seller:       addrs[0].String(),
+		Assets:       coin(100, bondDenom),
+		Price:        coin(55, "apple"),
+		AllowPartial: true,
+		ExternalId:   "ask1",
+	}
+	orderID, err := k.CreateAskOrder(ctx, askOrder, nil)
+	require.NoError(t, err, "CreateAskOrder")
+
+	committed := sdk.NewCoins(coin(500, bondDenom))
+	err = k.AddCommitment(ctx, marketID, addrs[1], committed, "")
+	require.NoError(t, err, "AddCommitment")
+
+	tests := []struct {
+		name   string
+		query  string
+		expRes interface{}
+		expErr string
+	}{
+		{
+			name:   "invalid json",
+			query:  `{"exchange":`,
+			expErr: "wasm: invalid query: unexpected end of JSON input",
+		},
+		{
+			name:   "no exchange params",
+			query:  `{"marker":{}}`,
+			expErr: "wasm: nil exchange query params",
+		},
+		{
+			name:   "empty exchange params",
+			query:  `{"exchange":{}}`,
+			expErr: `wasm: invalid exchange query: {"exchange":{}}`,
+		},
+		{
+			name:   "get order: zero id",
+			query:  `{"exchange":{"get_order":{"order_id":0}}}`,
+			expErr: "wasm: order id cannot be zero",
+		},
+		{
+			name:   "get order: unknown",
+			query:  `{"exchange":{"get_order":{"order_id":9999}}}`,
+			expErr: "wasm: order 9999 not found",
+		},
+		{
+			name:  "get order: ask",
+			query: fmt.Sprintf(`{"exchange":{"get_order":{"order_id":%d}}}`, orderID),
+			expRes: &wasm.QueryResOrder{
+				OrderID:      orderID,
+				OrderType:    exchange.OrderTypeAsk,
+				MarketID:     marketID,
+				Owner:        addrs[0].String(),
+				Assets:       askOrder.Assets,
+				Price:        askOrder.Price,
+				AllowPartial: true,
+				ExternalID:   "ask1",
+			},
+		},
+		{
+			name:   "get commitment: no account",
+			query:  `{"exchange":{"get_commitment":{"account":"","market_id":7}}}`,
+			expErr: "wasm: account cannot be empty",
+		},
+		{
+			name:   "get commitment: invalid account",
+			query:  `{"exchange":{"get_commitment":{"account":"notanaddress","market_id":7}}}`,
+			expErr: "wasm: invalid account: decoding bech32 failed",
+		},
+		{
+			name:   "get commitment: zero market id",
+			query:  `{"exchange":{"get_commitment":{"account":"` + addrs[1].String() + `","market_id":0}}}`,
+			expErr: "wasm: market id cannot be zero",
+		},
+		{
+			name:  "get commitment: none",
+			query: `{"exchange":{"get_commitment":{"account":"` + addrs[0].String() + `","market_id":7}}}`,
+			expRes: &wasm.QueryResCommitment{
+				Account:  addrs[0].String(),
+				MarketID: marketID,
+			},
+		},
+		{
+			name:  "get commitment: funds committed",
+			query: `{"exchange":{"get_commitment":{"account":"` + addrs[1].String() + `","market_id":7}}}`,
+			expRes: &wasm.QueryResCommitment{
+				Account:  addrs[1].String(),
+				MarketID: marketID,
+				Amount:   committed,
+			},
+		},
+		{
+			name:   "get market: zero id",
+			query:  `{"exchange":{"get_market":{"market_id":0}}}`,
+			expErr: "wasm: market id cannot be zero",
+		},
+		{
+			name:   "get market: unknown",
+			query:  `{"exchange":{"get_market":{"market_id":8}}}`,
+			expErr: "wasm: market 8 not found",
+		},
+		{
+			name:  "get market: known",
+			query: `{"exchange":{"get_market":{"market_id":7}}}`,
+			expRes: &wasm.QueryResMarket{
+				MarketID:             marketID,
+				MarketAddress:        exchange.GetMarketAddress(marketID).String(),
+				Name:                 "Test Market",
+				Description:          "A market for testing wasm queries.",
+				FeeCreateBidFlat:     []sdk.Coin{coin(1, "apple")},
+				AcceptingOrders:      true,
+				AllowUserSettlement:  true,
+				AcceptingCommitments: true,
+			},
+		},
+	}
+
+	querier := wasm.Querier(k)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var bz []byte
+			var err error
+			testFunc := func() {
+				bz, err = querier(ctx, []byte(tc.query), "")
+			}
+			require.NotPanics(t, testFunc, "querier")
+			if len(tc.expErr) > 0 {
+				require.ErrorContains(t, err, tc.expErr, "querier error")
+				assert.Nil(t, bz, "querier result")
+				return
+			}
+			require.NoError(t, err, "querier error")
+			expBz, err := json.Marshal(tc.expRes)
+			require.NoError(t, err, "json.Marshal(expRes)")
+			assert.JSONEq(t, string(expBz), string(bz), "querier result")
+		})
+	}
+}
@@ -0,0 +1,103 @@
+// Package wasm supports smart contract integration with the provenance exchange module.
+package wasm
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+// QueryResOrder contains the details of an ask or bid order.
+type QueryResOrder struct {
+	OrderID          uint64     `json:"order_id"`
+	OrderType        string     `json:"order_type"`
+	MarketID         uint32     `json:"market_id"`
+	Owner            string     `json:"owner"`
+	Assets           sdk.Coin   `json:"assets"`
+	Price            sdk.Coin   `json:"price"`
+	SettlementFees   sdk.Coins  `json:"settlement_fees,omitempty"`
+	AllowPartial     bool       `json:"allow_partial"`
+	ExternalID       string     `json:"external_id,omitempty"`
+	ExpirationHeight uint64     `json:"expiration_height,omitempty"`
+	ExpirationTime   *time.Time `json:"expiration_time,omitempty"`
+}
+
+// QueryResCommitment contains the funds an account has committed to a market.
+type QueryResCommitment struct {
+	Account  string    `json:"account"`
+	MarketID uint32    `json:"market_id"`
+	Amount   sdk.Coins `json:"amount"`
+}
+
+// QueryResMarket contains the details and settings of a market.
+type QueryResMarket struct {
+	MarketID                 uint32              `json:"market_id"`
+	MarketAddress            string              `json:"market_address"`
+	Name                     string              `json:"name,omitempty"`
+	Description              string              `json:"description,omitempty"`
+	WebsiteURL               string              `json:"website_url,omitempty"`
+	IconURI                  string              `json:"icon_uri,omitempty"`
+	FeeCreateAskFlat         []sdk.Coin          `json:"fee_create_ask_flat,omitempty"`
+	FeeCreateBidFlat         []sdk.Coin          `json:"fee_create_bid_flat,omitempty"`
+	FeeSellerSettlementFlat  []sdk.Coin          `json:"fee_seller_settlement_flat,omitempty"`
+	FeeSellerSettlementRatio []exchange.FeeRatio `json:"fee_seller_settlement_ratios,omitempty"`
+	FeeBuyerSettlementFlat   []sdk.Coin          `json:"fee_buyer_settlement_flat,omitempty"`
+	FeeBuyerSettlementRatio  []exchange.FeeRatio `json:"fee_buyer_settlement_ratios,omitempty"`
+	FeeCreateCommitmentFlat  []sdk.Coin          `json:"fee_create_commitment_flat,omitempty"`
+	AcceptingOrders          bool                `json:"accepting_orders"`
+	AllowUserSettlement      bool                `json:"allow_user_settlement"`
+	AcceptingCommitments     bool                `json:"accepting_commitments"`
+	AutoMatch                bool                `json:"auto_match"`
+	CommitmentSettlementBips uint32              `json:"commitment_settlement_bips,omitempty"`
+	IntermediaryDenom        string              `json:"intermediary_denom,omitempty"`
+	ReqAttrCreateAsk         []string            `json:"req_attr_create_ask,omitempty"`
+	ReqAttrCreateBid         []string            `json:"req_attr_create_bid,omitempty"`
+	ReqAttrCreateCommitment  []string            `json:"req_attr_create_commitment,omitempty"`
+}
+
+// createOrderResponse converts an exchange order into a QueryResOrder.
+func createOrderResponse(order *exchange.Order) *QueryResOrder {
+	return &QueryResOrder{
+		OrderID:          order.GetOrderID(),
+		OrderType:        order.GetOrderType(),
+		MarketID:         order.GetMarketID(),
+		Owner:            order.GetOwner(),
+		Assets:           order.GetAssets(),
+		Price:            order.GetPrice(),
+		SettlementFees:   order.GetSettlementFees(),
+		AllowPartial:     order.PartialFillAllowed(),
+		ExternalID:       order.GetExternalID(),
+		ExpirationHeight: order.GetExpirationHeight(),
+		ExpirationTime:   order.GetExpirationTime(),
+	}
+}
+
+// createMarketResponse converts an exchange market into a QueryResMarket.
+func createMarketResponse(market *exchange.Market) *QueryResMarket {
+	return &QueryResMarket{
+		MarketID:                 market.MarketId,
+		MarketAddress:            exchange.GetMarketAddress(market.MarketId).String(),
+		Name:                     market.MarketDetails.Name,
+		Description:              market.MarketDetails.Description,
+		WebsiteURL:               market.MarketDetails.WebsiteUrl,
+		IconURI:                  market.MarketDetails.IconUri,
+		FeeCreateAskFlat:         market.FeeCreateAskFlat,
+		FeeCreateBidFlat:         market.FeeCreateBidFlat,
+		FeeSellerSettlementFlat:  market.FeeSellerSettlementFlat,
+		FeeSellerSettlementRatio: market.FeeSellerSettlementRatios,
+		FeeBuyerSettlementFlat:   market.FeeBuyerSettlementFlat,
+		FeeBuyerSettlementRatio:  market.FeeBuyerSettlementRatios,
+		FeeCreateCommitmentFlat:  market.FeeCreateCommitmentFlat,
+		AcceptingOrders:          market.AcceptingOrders,
+		AllowUserSettlement:      market.AllowUserSettlement,
+		AcceptingCommitments:     market.AcceptingCommitments,
+		AutoMatch:                market.AutoMatch,
+		CommitmentSettlementBips: market.CommitmentSettlementBips,
+		IntermediaryDenom:        market.IntermediaryDenom,
+		ReqAttrCreateAsk:         market.ReqAttrCreateAsk,
+		ReqAttrCreateBid:         market.ReqAttrCreateBid,
+		ReqAttrCreateCommitment:  market.ReqAttrCreateCommitment,
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/provenance-io/provenance/internal/antewrapper"
 	"github.com/provenance-io/provenance/internal/pioconfig"
 	"github.com/provenance-io/provenance/testutil"
+	testcli "github.com/provenance-io/provenance/testutil/cli"
 	"github.com/provenance-io/provenance/testutil/queries"
 	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/client/cli"
@@ -546,3 +547,52 @@ func (s *IntegrationCLITestSuite) TestHoldsNotInFromSpendable() {
 		})
 	}
 }
+
+func (s *IntegrationCLITestSuite) TestTxCmdReleaseHold() {
+	tests := []struct {
+		name    string
+		args    []string
+		expErr  string
+		expCode uint32
+	}{
+		{
+			name:   "no args",
+			args:   []string{},
+			expErr: "accepts 2 arg(s), received 0",
+		},
+		{
+			name:   "invalid address",
+			args:   []string{"nope", "1banana"},
+			expErr: "decoding bech32 failed: invalid bech32 string length 4: invalid address",
+		},
+		{
+			name:   "invalid amount",
+			args:   []string{s.addr1.String(), "banana"},
+			expErr: "invalid amount \"banana\": invalid decimal coin expression: banana",
+		},
+		{
+			name:    "proposal submitted",
+			args:    []string{s.addr1.String(), "1banana", "--deposit", "100000" + s.cfg.BondDenom},
+			expCode: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			cmd := cli.TxCmdReleaseHold()
+			args := append(tc.args,
+				"--title", "Release stuck hold", "--summary", "See title.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, s.testnet.Validators[0].Address.String()),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				s.flagAsJSON,
+			)
+
+			testcli.NewTxExecutor(cmd, args).
+				WithExpErrMsg(tc.expErr).
+				WithExpCode(tc.expCode).
+				Execute(s.T(), s.testnet)
+		})
+	}
+}
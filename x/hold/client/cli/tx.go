@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+
+	"github.com/provenance-io/provenance/internal/provcli"
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// exampleTxCmdBase is the base command that gets a user to one of the tx commands in here.
+var exampleTxCmdBase = fmt.Sprintf("%s tx %s", version.AppName, hold.ModuleName)
+
+func TxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        hold.ModuleName,
+		Short:                      "Transaction commands for the hold module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		TxCmdReleaseHold(),
+	)
+
+	return cmd
+}
+
+func TxCmdReleaseHold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "release <address> <amount>",
+		Aliases: []string{"release-hold"},
+		Short:   "Submit a governance proposal to release funds that are on hold for an address.",
+		Example: fmt.Sprintf("$ %s release %s 10nhash --deposit 50000nhash", exampleTxCmdBase, exampleQueryAddr1),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid amount %q: %w", args[1], err)
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := hold.NewMsgReleaseHoldRequest(authority, addr, amount)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
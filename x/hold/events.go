@@ -2,17 +2,37 @@ package hold
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
-func NewEventHoldAdded(addr sdk.AccAddress, amount sdk.Coins, reason string) *EventHoldAdded {
+func NewEventHoldAdded(addr sdk.AccAddress, amount sdk.Coins, holder, reason string, holdID uint64) *EventHoldAdded {
 	return &EventHoldAdded{
 		Address: addr.String(),
 		Amount:  amount.String(),
 		Reason:  reason,
+		Holder:  holder,
+		HoldId:  holdID,
 	}
 }
 
-func NewEventHoldReleased(addr sdk.AccAddress, amount sdk.Coins) *EventHoldReleased {
+func NewEventHoldReleased(addr sdk.AccAddress, amount sdk.Coins, holder, reason string, holdID uint64) *EventHoldReleased {
 	return &EventHoldReleased{
 		Address: addr.String(),
 		Amount:  amount.String(),
+		Holder:  holder,
+		Reason:  reason,
+		HoldId:  holdID,
+	}
+}
+
+func NewEventHoldExpired(entry *HoldEntry) *EventHoldExpired {
+	return &EventHoldExpired{
+		HoldId:  entry.HoldId,
+		Address: entry.Address,
+		Amount:  entry.Amount.String(),
+	}
+}
+
+func NewEventGovHoldReleased(addr sdk.AccAddress, amount sdk.Coins) *EventGovHoldReleased {
+	return &EventGovHoldReleased{
+		Address: addr.String(),
+		Amount:  amount.String(),
 	}
 }
@@ -31,6 +31,10 @@ type EventHoldAdded struct {
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// reason is a human-readable indicator of why this hold was added.
 	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// holder is the name of the module that placed the funds on hold.
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// hold_id is the id of the hold entry that the funds were added to.
+	HoldId uint64 `protobuf:"varint,5,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
 }
 
 func (m *EventHoldAdded) Reset()         { *m = EventHoldAdded{} }
@@ -87,12 +91,32 @@ func (m *EventHoldAdded) GetReason() string {
 	return ""
 }
 
+func (m *EventHoldAdded) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventHoldAdded) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
 // EventHoldReleased is an event indicating that some funds were released from hold for an account.
 type EventHoldReleased struct {
 	// address is the bech32 address string of the account with the funds.
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	// amount is a Coins string of the funds released from hold.
 	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// holder is the name of the module that had placed the funds on hold.
+	Holder string `protobuf:"bytes,3,opt,name=holder,proto3" json:"holder,omitempty"`
+	// reason is the reason that the funds had been placed on hold.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// hold_id is the id of the hold entry that the funds were released from.
+	HoldId uint64 `protobuf:"varint,5,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
 }
 
 func (m *EventHoldReleased) Reset()         { *m = EventHoldReleased{} }
@@ -142,31 +166,178 @@ func (m *EventHoldReleased) GetAmount() string {
 	return ""
 }
 
+func (m *EventHoldReleased) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *EventHoldReleased) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventHoldReleased) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
+// EventHoldExpired is an event indicating that a hold entry reached its expiration and its funds were released.
+type EventHoldExpired struct {
+	// hold_id is the id of the hold entry that expired.
+	HoldId uint64 `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	// address is the bech32 address string of the account with the funds.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is a Coins string of the funds released from hold.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventHoldExpired) Reset()         { *m = EventHoldExpired{} }
+func (m *EventHoldExpired) String() string { return proto.CompactTextString(m) }
+func (*EventHoldExpired) ProtoMessage()    {}
+func (*EventHoldExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3be3cec6aa38cf10, []int{2}
+}
+func (m *EventHoldExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventHoldExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventHoldExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventHoldExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventHoldExpired.Merge(m, src)
+}
+func (m *EventHoldExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventHoldExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventHoldExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventHoldExpired proto.InternalMessageInfo
+
+func (m *EventHoldExpired) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
+func (m *EventHoldExpired) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventHoldExpired) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventGovHoldReleased is an event indicating that governance released some funds from hold for an account.
+type EventGovHoldReleased struct {
+	// address is the bech32 address string of the account with the funds.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is a Coins string of the funds released from hold.
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventGovHoldReleased) Reset()         { *m = EventGovHoldReleased{} }
+func (m *EventGovHoldReleased) String() string { return proto.CompactTextString(m) }
+func (*EventGovHoldReleased) ProtoMessage()    {}
+func (*EventGovHoldReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3be3cec6aa38cf10, []int{3}
+}
+func (m *EventGovHoldReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventGovHoldReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventGovHoldReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventGovHoldReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventGovHoldReleased.Merge(m, src)
+}
+func (m *EventGovHoldReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventGovHoldReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventGovHoldReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventGovHoldReleased proto.InternalMessageInfo
+
+func (m *EventGovHoldReleased) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventGovHoldReleased) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventHoldAdded)(nil), "provenance.hold.v1.EventHoldAdded")
 	proto.RegisterType((*EventHoldReleased)(nil), "provenance.hold.v1.EventHoldReleased")
+	proto.RegisterType((*EventHoldExpired)(nil), "provenance.hold.v1.EventHoldExpired")
+	proto.RegisterType((*EventGovHoldReleased)(nil), "provenance.hold.v1.EventGovHoldReleased")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/events.proto", fileDescriptor_3be3cec6aa38cf10) }
 
 var fileDescriptor_3be3cec6aa38cf10 = []byte{
-	// 248 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2f, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0xc8, 0xcf, 0x49, 0xd1, 0x2f, 0x33, 0xd4, 0x4f,
-	0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x42, 0x28, 0xd0,
-	0x03, 0x29, 0xd0, 0x2b, 0x33, 0x94, 0x92, 0x4c, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0x8e, 0x07, 0xab,
-	0xd0, 0x87, 0x70, 0x20, 0xca, 0x95, 0x4a, 0xb8, 0xf8, 0x5c, 0x41, 0xda, 0x3d, 0xf2, 0x73, 0x52,
-	0x1c, 0x53, 0x52, 0x52, 0x53, 0x84, 0x8c, 0xb8, 0xd8, 0x13, 0x53, 0x52, 0x8a, 0x52, 0x8b, 0x8b,
-	0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x6a, 0x72, 0x84,
-	0xc8, 0x04, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07, 0xc1, 0x14, 0x0a, 0x89, 0x71, 0xb1, 0x25, 0xe6,
-	0xe6, 0x97, 0xe6, 0x95, 0x48, 0x30, 0x81, 0xb4, 0x04, 0x41, 0x79, 0x20, 0xf1, 0xa2, 0xd4, 0xc4,
-	0xe2, 0xfc, 0x3c, 0x09, 0x66, 0x88, 0x38, 0x84, 0xa7, 0x14, 0xcf, 0x25, 0x08, 0xb7, 0x35, 0x28,
-	0x35, 0x27, 0x35, 0xb1, 0x98, 0xba, 0x16, 0x3b, 0xc5, 0x9e, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x03, 0x97, 0x68, 0x66, 0xbe, 0x1e, 0x66, 0x10, 0x05, 0x30, 0x46, 0x69, 0xa5, 0x67,
-	0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x23, 0x14, 0xe8, 0x66, 0xe6, 0x23, 0xf1,
-	0xf4, 0x2b, 0xc0, 0x81, 0x9e, 0xc4, 0x06, 0x0e, 0x3c, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x4a, 0x00, 0x77, 0x22, 0x8e, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0x4a, 0x33, 0x31,
+	0x14, 0x85, 0x9b, 0xb6, 0x7f, 0xcb, 0x9f, 0x85, 0xe8, 0x50, 0x35, 0xba, 0x88, 0xa5, 0xab, 0x22,
+	0x74, 0x42, 0xf5, 0x09, 0x5a, 0x28, 0xea, 0x4e, 0xea, 0x4e, 0x90, 0x32, 0x6d, 0x42, 0x1b, 0x68,
+	0x73, 0x4b, 0x32, 0x1d, 0xfb, 0x18, 0xbe, 0x84, 0xe0, 0x03, 0xf8, 0x10, 0x2e, 0x8b, 0x2b, 0x97,
+	0xd2, 0x79, 0x11, 0xc9, 0x64, 0xec, 0x4c, 0x11, 0x17, 0x82, 0xee, 0xe6, 0x9c, 0xfb, 0x5d, 0xe6,
+	0x9c, 0x70, 0xf1, 0xc9, 0x5c, 0x43, 0x24, 0x54, 0xa0, 0x46, 0x82, 0x4d, 0x60, 0xca, 0x59, 0xd4,
+	0x66, 0x22, 0x12, 0x2a, 0x34, 0xfe, 0x5c, 0x43, 0x08, 0x9e, 0x97, 0x01, 0xbe, 0x05, 0xfc, 0xa8,
+	0x7d, 0x7c, 0x34, 0x02, 0x33, 0x03, 0x33, 0x48, 0x08, 0xe6, 0x84, 0xc3, 0x1b, 0x8f, 0x08, 0xef,
+	0xf4, 0xec, 0xfe, 0x25, 0x4c, 0x79, 0x87, 0x73, 0xc1, 0xbd, 0x33, 0x5c, 0x0d, 0x38, 0xd7, 0xc2,
+	0x18, 0x82, 0xea, 0xa8, 0xf9, 0xbf, 0x4b, 0x5e, 0x9f, 0x5b, 0xb5, 0x74, 0xab, 0xe3, 0x26, 0x37,
+	0xa1, 0x96, 0x6a, 0xdc, 0xff, 0x04, 0xbd, 0x03, 0x5c, 0x09, 0x66, 0xb0, 0x50, 0x21, 0x29, 0xda,
+	0x95, 0x7e, 0xaa, 0xac, 0xaf, 0x45, 0x60, 0x40, 0x91, 0x92, 0xf3, 0x9d, 0xb2, 0xbe, 0x0d, 0x27,
+	0x34, 0x29, 0x3b, 0xdf, 0x29, 0xef, 0x10, 0x57, 0xed, 0xd7, 0x40, 0x72, 0xf2, 0xaf, 0x8e, 0x9a,
+	0x65, 0x37, 0xb8, 0xe2, 0x8d, 0x27, 0x84, 0xf7, 0x36, 0x39, 0xfb, 0x62, 0x2a, 0x02, 0xf3, 0xfb,
+	0x51, 0xd3, 0x48, 0xa5, 0xad, 0x48, 0x59, 0x85, 0xf2, 0x56, 0x85, 0x6f, 0xa3, 0xde, 0xe3, 0xdd,
+	0x4d, 0xd2, 0xde, 0x72, 0x2e, 0xb5, 0xe0, 0x79, 0x18, 0xe5, 0xe1, 0x7c, 0x83, 0xe2, 0xcf, 0x1b,
+	0x94, 0xf2, 0x0d, 0x1a, 0x43, 0x5c, 0x4b, 0x7e, 0x7c, 0x01, 0xd1, 0x5f, 0xbd, 0x52, 0xf7, 0xee,
+	0x65, 0x4d, 0xd1, 0x6a, 0x4d, 0xd1, 0xfb, 0x9a, 0xa2, 0x87, 0x98, 0x16, 0x56, 0x31, 0x2d, 0xbc,
+	0xc5, 0xb4, 0x80, 0xf7, 0x25, 0xf8, 0x5f, 0x6f, 0xef, 0x1a, 0xdd, 0x9e, 0x8e, 0x65, 0x38, 0x59,
+	0x0c, 0xfd, 0x11, 0xcc, 0x58, 0x06, 0xb4, 0x24, 0xe4, 0x14, 0x5b, 0x26, 0xd7, 0x3c, 0xac, 0x24,
+	0x57, 0x79, 0xfe, 0x31, 0x00, 0x5f, 0x1b, 0x38, 0x89, 0xe7, 0x02, 0x00, 0x00,
 }
 
 func (m *EventHoldAdded) Marshal() (dAtA []byte, err error) {
@@ -189,6 +360,18 @@ func (m *EventHoldAdded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.HoldId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Reason) > 0 {
 		i -= len(m.Reason)
 		copy(dAtA[i:], m.Reason)
@@ -229,6 +412,104 @@ func (m *EventHoldReleased) MarshalTo(dAtA []byte) (int, error) {
 }
 
 func (m *EventHoldReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventHoldExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventHoldExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventHoldExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HoldId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventGovHoldReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventGovHoldReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventGovHoldReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -279,10 +560,65 @@ func (m *EventHoldAdded) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.HoldId != 0 {
+		n += 1 + sovEvents(uint64(m.HoldId))
+	}
 	return n
 }
 
 func (m *EventHoldReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.HoldId != 0 {
+		n += 1 + sovEvents(uint64(m.HoldId))
+	}
+	return n
+}
+
+func (m *EventHoldExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		n += 1 + sovEvents(uint64(m.HoldId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventGovHoldReleased) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -430,16 +766,67 @@ func (m *EventHoldAdded) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -480,6 +867,336 @@ func (m *EventHoldReleased) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: EventHoldReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventHoldExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventHoldExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventHoldExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventGovHoldReleased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventGovHoldReleased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventGovHoldReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
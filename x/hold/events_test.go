@@ -3,6 +3,7 @@ package hold
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/stretchr/testify/assert"
@@ -20,7 +21,9 @@ func TestNewEventHoldAdded(t *testing.T) {
 		name   string
 		addr   sdk.AccAddress
 		amount sdk.Coins
+		holder string
 		reason string
+		holdID uint64
 		exp    *EventHoldAdded
 	}{
 		{
@@ -49,22 +52,36 @@ func TestNewEventHoldAdded(t *testing.T) {
 			reason: "this is a test reason",
 			exp:    &EventHoldAdded{Reason: "this is a test reason"},
 		},
+		{
+			name:   "only a holder",
+			holder: "testholder",
+			exp:    &EventHoldAdded{Holder: "testholder"},
+		},
+		{
+			name:   "only a hold id",
+			holdID: 5,
+			exp:    &EventHoldAdded{HoldId: 5},
+		},
 		{
 			name:   "control",
 			addr:   sdk.AccAddress("control_address_____"),
 			amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)),
+			holder: "controlholder",
 			reason: "control reason",
+			holdID: 12,
 			exp: &EventHoldAdded{
 				Address: sdk.AccAddress("control_address_____").String(),
 				Amount:  sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)).String(),
 				Reason:  "control reason",
+				Holder:  "controlholder",
+				HoldId:  12,
 			},
 		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			event := NewEventHoldAdded(tc.addr, tc.amount, tc.reason)
+			event := NewEventHoldAdded(tc.addr, tc.amount, tc.holder, tc.reason, tc.holdID)
 			assert.Equal(t, tc.exp, event, "NewEventHoldAdded")
 		})
 	}
@@ -75,6 +92,9 @@ func TestNewEventHoldReleased(t *testing.T) {
 		name   string
 		addr   sdk.AccAddress
 		amount sdk.Coins
+		holder string
+		reason string
+		holdID uint64
 		exp    *EventHoldReleased
 	}{
 		{
@@ -98,16 +118,114 @@ func TestNewEventHoldReleased(t *testing.T) {
 				Amount:  "10fingercoin,9toecoin",
 			},
 		},
+		{
+			name:   "only a holder",
+			holder: "testholder",
+			exp:    &EventHoldReleased{Holder: "testholder"},
+		},
+		{
+			name:   "only a reason",
+			reason: "this is a test reason",
+			exp:    &EventHoldReleased{Reason: "this is a test reason"},
+		},
+		{
+			name:   "only a hold id",
+			holdID: 5,
+			exp:    &EventHoldReleased{HoldId: 5},
+		},
+		{
+			name:   "control",
+			addr:   sdk.AccAddress("control_address_____"),
+			amount: sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)),
+			holder: "controlholder",
+			reason: "control reason",
+			holdID: 12,
+			exp: &EventHoldReleased{
+				Address: sdk.AccAddress("control_address_____").String(),
+				Amount:  sdk.NewCoins(sdk.NewInt64Coin("cherry", 4)).String(),
+				Holder:  "controlholder",
+				Reason:  "control reason",
+				HoldId:  12,
+			},
+		},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			event := NewEventHoldReleased(tc.addr, tc.amount)
+			event := NewEventHoldReleased(tc.addr, tc.amount, tc.holder, tc.reason, tc.holdID)
 			assert.Equal(t, tc.exp, event, "NewEventHoldReleased")
 		})
 	}
 }
 
+func TestNewEventHoldExpired(t *testing.T) {
+	expiration := time.Unix(1700000000, 0).UTC()
+	tests := []struct {
+		name  string
+		entry *HoldEntry
+		exp   *EventHoldExpired
+	}{
+		{
+			name:  "empty entry",
+			entry: &HoldEntry{},
+			exp:   &EventHoldExpired{Amount: ""},
+		},
+		{
+			name: "control",
+			entry: &HoldEntry{
+				HoldId:     3,
+				Address:    sdk.AccAddress("control_address_____").String(),
+				Amount:     sdk.NewCoins(sdk.NewInt64Coin("fingercoin", 10), sdk.NewInt64Coin("toecoin", 9)),
+				Holder:     "controlholder",
+				Reason:     "control reason",
+				Expiration: &expiration,
+			},
+			exp: &EventHoldExpired{
+				HoldId:  3,
+				Address: sdk.AccAddress("control_address_____").String(),
+				Amount:  "10fingercoin,9toecoin",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event := NewEventHoldExpired(tc.entry)
+			assert.Equal(t, tc.exp, event, "NewEventHoldExpired")
+		})
+	}
+}
+
+func TestNewEventGovHoldReleased(t *testing.T) {
+	tests := []struct {
+		name   string
+		addr   sdk.AccAddress
+		amount sdk.Coins
+		exp    *EventGovHoldReleased
+	}{
+		{
+			name: "both nil",
+			exp:  &EventGovHoldReleased{Address: "", Amount: ""},
+		},
+		{
+			name:   "normal address and two denoms",
+			addr:   sdk.AccAddress("normal_address______"),
+			amount: sdk.NewCoins(sdk.NewInt64Coin("fingercoin", 10), sdk.NewInt64Coin("toecoin", 9)),
+			exp: &EventGovHoldReleased{
+				Address: sdk.AccAddress("normal_address______").String(),
+				Amount:  "10fingercoin,9toecoin",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event := NewEventGovHoldReleased(tc.addr, tc.amount)
+			assert.Equal(t, tc.exp, event, "NewEventGovHoldReleased")
+		})
+	}
+}
+
 func TestTypedEventToEvent(t *testing.T) {
 	addr := sdk.AccAddress("address_in_the_event")
 	coins := sdk.NewCoins(sdk.NewInt64Coin("elbowcoin", 4), sdk.NewInt64Coin("kneecoin", 2))
@@ -121,21 +239,49 @@ func TestTypedEventToEvent(t *testing.T) {
 	}{
 		{
 			name: "EventHoldAdded",
-			tev:  NewEventHoldAdded(addr, coins, "test reason"),
+			tev:  NewEventHoldAdded(addr, coins, "testholder", "test reason", 7),
 			expEvent: sdk.Event{
 				Type: "provenance.hold.v1.EventHoldAdded",
 				Attributes: []abci.EventAttribute{
 					{Key: "address", Value: addrQ},
 					{Key: "amount", Value: coinsQ},
+					{Key: "hold_id", Value: `"7"`},
+					{Key: "holder", Value: `"testholder"`},
 					{Key: "reason", Value: `"test reason"`},
 				},
 			},
 		},
 		{
 			name: "EventHoldReleased",
-			tev:  NewEventHoldReleased(addr, coins),
+			tev:  NewEventHoldReleased(addr, coins, "testholder", "test reason", 7),
 			expEvent: sdk.Event{
 				Type: "provenance.hold.v1.EventHoldReleased",
+				Attributes: []abci.EventAttribute{
+					{Key: "address", Value: addrQ},
+					{Key: "amount", Value: coinsQ},
+					{Key: "hold_id", Value: `"7"`},
+					{Key: "holder", Value: `"testholder"`},
+					{Key: "reason", Value: `"test reason"`},
+				},
+			},
+		},
+		{
+			name: "EventHoldExpired",
+			tev:  NewEventHoldExpired(&HoldEntry{HoldId: 7, Address: addr.String(), Amount: coins}),
+			expEvent: sdk.Event{
+				Type: "provenance.hold.v1.EventHoldExpired",
+				Attributes: []abci.EventAttribute{
+					{Key: "address", Value: addrQ},
+					{Key: "amount", Value: coinsQ},
+					{Key: "hold_id", Value: `"7"`},
+				},
+			},
+		},
+		{
+			name: "EventGovHoldReleased",
+			tev:  NewEventGovHoldReleased(addr, coins),
+			expEvent: sdk.Event{
+				Type: "provenance.hold.v1.EventGovHoldReleased",
 				Attributes: []abci.EventAttribute{
 					{Key: "address", Value: addrQ},
 					{Key: "amount", Value: coinsQ},
@@ -3,6 +3,8 @@ package hold
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func DefaultGenesisState() *GenesisState {
@@ -28,5 +30,58 @@ func (g GenesisState) Validate() error {
 			addrs[ah.Address] = i
 		}
 	}
+
+	ids := make(map[uint64]int)
+	entryKeys := make(map[string]int)
+	entryTotals := make(map[string]sdk.Coins)
+	for i, entry := range g.Entries {
+		if entry == nil {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: cannot be nil", i))
+			continue
+		}
+		if err := entry.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: %w", i, err))
+			continue
+		}
+		if entry.HoldId > g.LastHoldId {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: hold id %d is greater than last hold id %d", i, entry.HoldId, g.LastHoldId))
+		}
+		if j, seen := ids[entry.HoldId]; seen {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: duplicate hold id %d also at index %d", i, entry.HoldId, j))
+		} else {
+			ids[entry.HoldId] = i
+		}
+		key := entry.Address + " " + entry.Holder + " " + entry.Reason
+		if j, seen := entryKeys[key]; seen {
+			errs = append(errs, fmt.Errorf("invalid entries[%d]: duplicate address, holder and reason also at index %d", i, j))
+		} else {
+			entryKeys[key] = i
+		}
+		entryTotals[entry.Address] = entryTotals[entry.Address].Add(entry.Amount...)
+	}
+
+	for _, ah := range g.Holds {
+		if ah == nil {
+			continue
+		}
+		total, ok := entryTotals[ah.Address]
+		if !ok {
+			continue
+		}
+		delete(entryTotals, ah.Address)
+		if !ah.Amount.IsAllGTE(total) {
+			errs = append(errs, fmt.Errorf("invalid entries for %s: total %s is more than hold amount %s", ah.Address, total, ah.Amount))
+		}
+	}
+	for _, entry := range g.Entries {
+		if entry == nil {
+			continue
+		}
+		if total, ok := entryTotals[entry.Address]; ok {
+			delete(entryTotals, entry.Address)
+			errs = append(errs, fmt.Errorf("invalid entries for %s: total %s is more than hold amount 0", entry.Address, total))
+		}
+	}
+
 	return errors.Join(errs...)
 }
@@ -154,3 +154,109 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_Validate_Entries(t *testing.T) {
+	addr1 := sdk.AccAddress("entries_addr_1______").String()
+	addr2 := sdk.AccAddress("entries_addr_2______").String()
+	holds := []*AccountHold{
+		{Address: addr1, Amount: sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))},
+	}
+	entry := func(holdID uint64, addr string, amount int64, reason string) *HoldEntry {
+		return &HoldEntry{
+			HoldId:  holdID,
+			Address: addr,
+			Amount:  sdk.NewCoins(sdk.NewInt64Coin("nhash", amount)),
+			Holder:  "exchange",
+			Reason:  reason,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		genState GenesisState
+		expErr   []string
+	}{
+		{
+			name: "entries covered by hold",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(1, addr1, 60, "one"), entry(4, addr1, 40, "two")},
+				LastHoldId: 4,
+			},
+		},
+		{
+			name: "nil entry",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{nil},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries[0]: cannot be nil"},
+		},
+		{
+			name: "invalid entry",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(0, addr1, 60, "one")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries[0]: invalid hold id: cannot be zero"},
+		},
+		{
+			name: "id greater than last hold id",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(5, addr1, 60, "one")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries[0]: hold id 5 is greater than last hold id 4"},
+		},
+		{
+			name: "duplicate ids",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(2, addr1, 10, "one"), entry(2, addr1, 10, "two")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries[1]: duplicate hold id 2 also at index 0"},
+		},
+		{
+			name: "duplicate address holder and reason",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(1, addr1, 10, "one"), entry(2, addr1, 10, "one")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries[1]: duplicate address, holder and reason also at index 0"},
+		},
+		{
+			name: "entries total more than hold",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(1, addr1, 60, "one"), entry(2, addr1, 41, "two")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries for " + addr1 + ": total 101nhash is more than hold amount 100nhash"},
+		},
+		{
+			name: "entry without a hold",
+			genState: GenesisState{
+				Holds:      holds,
+				Entries:    []*HoldEntry{entry(1, addr2, 5, "one")},
+				LastHoldId: 4,
+			},
+			expErr: []string{"invalid entries for " + addr2 + ": total 5nhash is more than hold amount 0"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.genState.Validate()
+			}
+			require.NotPanics(t, testFunc, "Validate()")
+			assertions.AssertErrorContents(t, err, tc.expErr, "Validate()")
+		})
+	}
+}
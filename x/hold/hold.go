@@ -1,11 +1,16 @@
 package hold
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxHolderLength is the maximum length that a hold entry's holder can have.
+const MaxHolderLength = 64
+
 func (e AccountHold) Validate() error {
 	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
 		return fmt.Errorf("invalid address: %w", err)
@@ -15,3 +20,38 @@ func (e AccountHold) Validate() error {
 	}
 	return nil
 }
+
+// ValidateHolder returns an error if the provided holder is not a valid hold entry holder.
+func ValidateHolder(holder string) error {
+	if len(holder) == 0 {
+		return errors.New("holder cannot be empty")
+	}
+	if len(holder) > MaxHolderLength {
+		return fmt.Errorf("holder %q length %d exceeds max length %d", holder, len(holder), MaxHolderLength)
+	}
+	return nil
+}
+
+func (e HoldEntry) Validate() error {
+	if e.HoldId == 0 {
+		return errors.New("invalid hold id: cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Address); err != nil {
+		return fmt.Errorf("invalid address: %w", err)
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid amount: %w", err)
+	}
+	if e.Amount.IsZero() {
+		return errors.New("invalid amount: cannot be zero")
+	}
+	if err := ValidateHolder(e.Holder); err != nil {
+		return fmt.Errorf("invalid holder: %w", err)
+	}
+	return nil
+}
+
+// IsExpiredAt returns true if this hold entry has an expiration that is at or before the provided block time.
+func (e HoldEntry) IsExpiredAt(blockTime time.Time) bool {
+	return e.Expiration != nil && !e.Expiration.After(blockTime)
+}
@@ -10,15 +10,19 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -81,33 +85,133 @@ func (m *AccountHold) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+// HoldEntry is a record of funds placed on hold in an account by a holder for a specific reason.
+type HoldEntry struct {
+	// hold_id is the unique identifier of this hold entry.
+	HoldId uint64 `protobuf:"varint,1,opt,name=hold_id,json=holdId,proto3" json:"hold_id,omitempty"`
+	// address is the account address that holds the funds on hold.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds on hold for this entry.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// holder is the name of the module that placed the funds on hold.
+	Holder string `protobuf:"bytes,4,opt,name=holder,proto3" json:"holder,omitempty"`
+	// reason is a human-readable indicator of why the funds are on hold.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// expiration is an optional block time at which this hold is automatically released.
+	// If set, the hold is released at the end of the first block with this time or later.
+	Expiration *time.Time `protobuf:"bytes,6,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+}
+
+func (m *HoldEntry) Reset()         { *m = HoldEntry{} }
+func (m *HoldEntry) String() string { return proto.CompactTextString(m) }
+func (*HoldEntry) ProtoMessage()    {}
+func (*HoldEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc6e4f15dd47e2b, []int{1}
+}
+func (m *HoldEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HoldEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HoldEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HoldEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HoldEntry.Merge(m, src)
+}
+func (m *HoldEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *HoldEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_HoldEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HoldEntry proto.InternalMessageInfo
+
+func (m *HoldEntry) GetHoldId() uint64 {
+	if m != nil {
+		return m.HoldId
+	}
+	return 0
+}
+
+func (m *HoldEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *HoldEntry) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *HoldEntry) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *HoldEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *HoldEntry) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AccountHold)(nil), "provenance.hold.v1.AccountHold")
+	proto.RegisterType((*HoldEntry)(nil), "provenance.hold.v1.HoldEntry")
 }
 
 func init() { proto.RegisterFile("provenance/hold/v1/hold.proto", fileDescriptor_cfc6e4f15dd47e2b) }
 
 var fileDescriptor_cfc6e4f15dd47e2b = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x2d, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0xc8, 0xcf, 0x49, 0xd1, 0x2f, 0x33, 0x04, 0xd3,
-	0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9, 0x42, 0x42, 0x08, 0x69, 0x3d, 0xb0, 0x70, 0x99, 0xa1, 0x94,
-	0x60, 0x62, 0x6e, 0x66, 0x5e, 0xbe, 0x3e, 0x98, 0x84, 0x28, 0x93, 0x92, 0x4b, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x4a, 0x2c, 0x4e, 0xd5, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0xce, 0xcf, 0xcc, 0x83, 0xca, 0x8b, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16,
-	0x44, 0x54, 0x69, 0x11, 0x23, 0x17, 0xb7, 0x63, 0x72, 0x72, 0x7e, 0x69, 0x5e, 0x89, 0x47, 0x7e,
-	0x4e, 0x8a, 0x90, 0x04, 0x17, 0x7b, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0x67, 0x10, 0x8c, 0x2b, 0x54, 0xc9, 0xc5, 0x96, 0x98, 0x0b, 0x52, 0x27, 0xc1, 0xa4,
-	0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa9, 0x07, 0xb1, 0x50, 0x0f, 0x64, 0xa1, 0x1e, 0xd4, 0x42, 0x3d,
-	0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0xb7, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0xdd, 0x97, 0xd7, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xba, 0x0e, 0x42, 0xe9, 0x16, 0xa7,
-	0x64, 0xeb, 0x97, 0x54, 0x16, 0xa4, 0x16, 0x83, 0x35, 0x14, 0xcf, 0x7a, 0xbe, 0x41, 0x8b, 0x27,
-	0x27, 0x35, 0x3d, 0x31, 0xb9, 0x32, 0x1e, 0xe4, 0xe4, 0xe2, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06,
-	0x41, 0x2d, 0x74, 0x8a, 0x3d, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4,
-	0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x06, 0x2e, 0xd1,
-	0x4c, 0xb0, 0x47, 0xd0, 0x82, 0x27, 0x80, 0x31, 0x4a, 0x0b, 0xc9, 0x6a, 0x84, 0x02, 0xdd, 0xcc,
-	0x7c, 0x24, 0x9e, 0x7e, 0x05, 0x38, 0x98, 0x93, 0xd8, 0xc0, 0x41, 0x61, 0x0c, 0x08, 0x00, 0x00,
-	0xff, 0xff, 0x01, 0x23, 0xb7, 0xfe, 0x88, 0x01, 0x00, 0x00,
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x3f, 0x6f, 0xd4, 0x30,
+	0x18, 0xc6, 0xe3, 0xeb, 0x91, 0xaa, 0x3e, 0x16, 0x22, 0xfe, 0x84, 0x93, 0x48, 0x4e, 0x9d, 0xa2,
+	0x93, 0x6a, 0x2b, 0xe5, 0x0b, 0xc0, 0x21, 0x10, 0x6c, 0x28, 0x62, 0x42, 0x42, 0x95, 0x93, 0x98,
+	0xd4, 0x22, 0xf1, 0x1b, 0xc5, 0xbe, 0xa8, 0xf9, 0x16, 0x9d, 0x19, 0x99, 0x10, 0x2c, 0xfd, 0x18,
+	0x1d, 0x3b, 0x32, 0x51, 0x74, 0x37, 0xdc, 0xd7, 0x40, 0x76, 0x72, 0xba, 0x43, 0x88, 0x95, 0x25,
+	0xf6, 0xf3, 0xf8, 0xb1, 0xf2, 0x7b, 0xfd, 0xbe, 0xf8, 0x49, 0xdd, 0x40, 0xcb, 0x25, 0x93, 0x19,
+	0xa7, 0xe7, 0x50, 0xe6, 0xb4, 0x8d, 0xed, 0x4a, 0xea, 0x06, 0x34, 0x78, 0xde, 0xee, 0x98, 0x58,
+	0xbb, 0x8d, 0xa7, 0xf7, 0x58, 0x25, 0x24, 0x50, 0xfb, 0xed, 0x63, 0xd3, 0x20, 0x03, 0x55, 0x81,
+	0xa2, 0x29, 0x53, 0x9c, 0xb6, 0x71, 0xca, 0x35, 0x8b, 0x69, 0x06, 0x42, 0x0e, 0xe7, 0xf7, 0x0b,
+	0x28, 0xc0, 0x6e, 0xa9, 0xd9, 0x0d, 0x6e, 0x58, 0x00, 0x14, 0x25, 0xa7, 0x56, 0xa5, 0xcb, 0x8f,
+	0x54, 0x8b, 0x8a, 0x2b, 0xcd, 0xaa, 0xba, 0x0f, 0x1c, 0x7f, 0x41, 0x78, 0xf2, 0x3c, 0xcb, 0x60,
+	0x29, 0xf5, 0x6b, 0x28, 0x73, 0xcf, 0xc7, 0x87, 0x2c, 0xcf, 0x1b, 0xae, 0x94, 0x8f, 0x66, 0x28,
+	0x3a, 0x4a, 0xb6, 0xd2, 0xeb, 0xb0, 0xcb, 0x2a, 0x93, 0xf3, 0x47, 0xb3, 0x83, 0x68, 0x72, 0xfa,
+	0x98, 0xf4, 0x44, 0xc4, 0x10, 0x91, 0x81, 0x88, 0xbc, 0x00, 0x21, 0x17, 0xaf, 0xae, 0x7f, 0x86,
+	0xce, 0xb7, 0xdb, 0x30, 0x2a, 0x84, 0x3e, 0x5f, 0xa6, 0x24, 0x83, 0x8a, 0x0e, 0xf8, 0xfd, 0x72,
+	0xa2, 0xf2, 0x4f, 0x54, 0x77, 0x35, 0x57, 0xf6, 0x82, 0xfa, 0xbc, 0xb9, 0x9a, 0xdf, 0x2d, 0x79,
+	0xc1, 0xb2, 0xee, 0xcc, 0xd4, 0xa4, 0xbe, 0x6e, 0xae, 0xe6, 0x28, 0x19, 0x7e, 0x78, 0xfc, 0x7d,
+	0x84, 0x8f, 0x0c, 0xdd, 0x4b, 0xa9, 0x9b, 0xce, 0x7b, 0x84, 0x0f, 0xcd, 0x3b, 0x9d, 0x89, 0xdc,
+	0x22, 0x8e, 0x13, 0xd7, 0xc8, 0x37, 0x7f, 0xb0, 0x8f, 0xfe, 0xc5, 0x7e, 0xf0, 0x9f, 0xd9, 0xbd,
+	0x87, 0xd8, 0xe2, 0xf1, 0xc6, 0x1f, 0x5b, 0xa6, 0x41, 0x19, 0xbf, 0xe1, 0x4c, 0x81, 0xf4, 0xef,
+	0xf4, 0x7e, 0xaf, 0xbc, 0x67, 0x18, 0xf3, 0x8b, 0x5a, 0x34, 0x4c, 0x0b, 0x90, 0xbe, 0x3b, 0x43,
+	0xd1, 0xe4, 0x74, 0x4a, 0xfa, 0x36, 0x92, 0x6d, 0x1b, 0xc9, 0xbb, 0x6d, 0x1b, 0x17, 0xe3, 0xcb,
+	0xdb, 0x10, 0x25, 0x7b, 0x77, 0x16, 0x1f, 0xae, 0x57, 0x01, 0xba, 0x59, 0x05, 0xe8, 0xd7, 0x2a,
+	0x40, 0x97, 0xeb, 0xc0, 0xb9, 0x59, 0x07, 0xce, 0x8f, 0x75, 0xe0, 0xe0, 0x07, 0x02, 0xc8, 0xdf,
+	0xd3, 0xf6, 0x16, 0xbd, 0x9f, 0xef, 0x15, 0xbb, 0x0b, 0x9c, 0x08, 0xd8, 0x53, 0xf4, 0xc2, 0x4e,
+	0x6d, 0xea, 0x5a, 0x88, 0xa7, 0xbf, 0x07, 0x00, 0xab, 0x55, 0x0a, 0x3a, 0xd7, 0x02, 0x00, 0x00,
 }
 
 func (m *AccountHold) Marshal() (dAtA []byte, err error) {
@@ -154,6 +258,79 @@ func (m *AccountHold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HoldEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HoldEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HoldEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintHold(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHold(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintHold(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HoldId != 0 {
+		i = encodeVarintHold(dAtA, i, uint64(m.HoldId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHold(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHold(v)
 	base := offset
@@ -184,6 +361,40 @@ func (m *AccountHold) Size() (n int) {
 	return n
 }
 
+func (m *HoldEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldId != 0 {
+		n += 1 + sovHold(uint64(m.HoldId))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHold(uint64(l))
+		}
+	}
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovHold(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovHold(uint64(l))
+	}
+	return n
+}
+
 func sovHold(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -306,6 +517,241 @@ func (m *AccountHold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *HoldEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHold
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HoldEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HoldEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldId", wireType)
+			}
+			m.HoldId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Holder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHold
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHold
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHold
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHold(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHold
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipHold(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -1,8 +1,12 @@
 package hold
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdkmath "cosmossdk.io/math"
@@ -68,3 +72,102 @@ func TestAccountHold_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestHoldEntry_Validate(t *testing.T) {
+	coins := func(coins string) sdk.Coins {
+		rv, err := sdk.ParseCoinsNormalized(coins)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", coins)
+		return rv
+	}
+	addr := sdk.AccAddress("control_addr________").String()
+	entry := func(modifier func(e *HoldEntry)) HoldEntry {
+		rv := HoldEntry{
+			HoldId:  1,
+			Address: addr,
+			Amount:  coins("1000nhash"),
+			Holder:  "exchange",
+			Reason:  "x/exchange: order 1",
+		}
+		if modifier != nil {
+			modifier(&rv)
+		}
+		return rv
+	}
+
+	tests := []struct {
+		name  string
+		entry HoldEntry
+		exp   string
+	}{
+		{
+			name:  "control",
+			entry: entry(nil),
+		},
+		{
+			name:  "no reason",
+			entry: entry(func(e *HoldEntry) { e.Reason = "" }),
+		},
+		{
+			name:  "zero hold id",
+			entry: entry(func(e *HoldEntry) { e.HoldId = 0 }),
+			exp:   "invalid hold id: cannot be zero",
+		},
+		{
+			name:  "invalid address",
+			entry: entry(func(e *HoldEntry) { e.Address = "bad" }),
+			exp:   "invalid address: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:  "invalid amount",
+			entry: entry(func(e *HoldEntry) { e.Amount = sdk.Coins{sdk.Coin{Denom: "badcoin", Amount: sdkmath.NewInt(-50)}} }),
+			exp:   "invalid amount: coin -50badcoin amount is not positive",
+		},
+		{
+			name:  "no amount",
+			entry: entry(func(e *HoldEntry) { e.Amount = nil }),
+			exp:   "invalid amount: cannot be zero",
+		},
+		{
+			name:  "no holder",
+			entry: entry(func(e *HoldEntry) { e.Holder = "" }),
+			exp:   "invalid holder: holder cannot be empty",
+		},
+		{
+			name:  "holder too long",
+			entry: entry(func(e *HoldEntry) { e.Holder = strings.Repeat("h", MaxHolderLength+1) }),
+			exp: fmt.Sprintf("invalid holder: holder %q length %d exceeds max length %d",
+				strings.Repeat("h", MaxHolderLength+1), MaxHolderLength+1, MaxHolderLength),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.entry.Validate()
+			assertions.AssertErrorValue(t, err, tc.exp, "Validate()")
+		})
+	}
+}
+
+func TestHoldEntry_IsExpiredAt(t *testing.T) {
+	expiration := time.Unix(1700000000, 0)
+
+	tests := []struct {
+		name       string
+		expiration *time.Time
+		blockTime  time.Time
+		exp        bool
+	}{
+		{name: "no expiration", expiration: nil, blockTime: expiration.Add(time.Hour), exp: false},
+		{name: "before expiration", expiration: &expiration, blockTime: expiration.Add(-1 * time.Nanosecond), exp: false},
+		{name: "at expiration", expiration: &expiration, blockTime: expiration, exp: true},
+		{name: "after expiration", expiration: &expiration, blockTime: expiration.Add(time.Second), exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entry := HoldEntry{Expiration: tc.expiration}
+			actual := entry.IsExpiredAt(tc.blockTime)
+			assert.Equal(t, tc.exp, actual, "IsExpiredAt(%s)", tc.blockTime)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// getLastHoldID gets the most recently assigned hold id.
+func (k Keeper) getLastHoldID(store storetypes.KVStore) uint64 {
+	// Ignoring the error here since it's only possible if someone else is writing to this key.
+	rv, _ := UnmarshalHoldID(store.Get(KeyLastHoldID))
+	return rv
+}
+
+// setLastHoldID sets the most recently assigned hold id.
+func (k Keeper) setLastHoldID(store storetypes.KVStore, holdID uint64) {
+	store.Set(KeyLastHoldID, uint64Bz(holdID))
+}
+
+// nextHoldID gets the next available hold id and records it as the last one assigned.
+func (k Keeper) nextHoldID(store storetypes.KVStore) uint64 {
+	holdID := k.getLastHoldID(store) + 1
+	k.setLastHoldID(store, holdID)
+	return holdID
+}
+
+// getHoldEntryFromStore reads the hold entry with the provided id from the store.
+// Returns nil, nil if there isn't an entry with that id.
+func (k Keeper) getHoldEntryFromStore(store storetypes.KVStore, holdID uint64) (*hold.HoldEntry, error) {
+	bz := store.Get(CreateHoldEntryKey(holdID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	rv := &hold.HoldEntry{}
+	if err := k.cdc.Unmarshal(bz, rv); err != nil {
+		return nil, fmt.Errorf("failed to read hold entry %d: %w", holdID, err)
+	}
+	return rv, nil
+}
+
+// getHoldEntryIDFromStore gets the id of the hold entry for the provided address, holder and reason.
+// Returns 0 if there isn't such an entry.
+func (k Keeper) getHoldEntryIDFromStore(store storetypes.KVStore, addr sdk.AccAddress, holder, reason string) (uint64, error) {
+	holdID, err := UnmarshalHoldID(store.Get(CreateHoldEntryAddrIndexKey(addr, holder, reason)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read hold id for %s %s %q: %w", addr, holder, reason, err)
+	}
+	return holdID, nil
+}
+
+// setHoldEntryInStore writes the provided hold entry and its address index entry to the store.
+// If the entry has an expiration, it's also added to the expiration index.
+func (k Keeper) setHoldEntryInStore(store storetypes.KVStore, entry *hold.HoldEntry) error {
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid hold entry %d address %q: %w", entry.HoldId, entry.Address, err)
+	}
+	bz, err := k.cdc.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to marshal hold entry %d: %w", entry.HoldId, err)
+	}
+	idBz := uint64Bz(entry.HoldId)
+	store.Set(CreateHoldEntryKey(entry.HoldId), bz)
+	store.Set(CreateHoldEntryAddrIndexKey(addr, entry.Holder, entry.Reason), idBz)
+	if entry.Expiration != nil {
+		store.Set(CreateHoldEntryExpirationIndexKey(*entry.Expiration, entry.HoldId), []byte{})
+	}
+	return nil
+}
+
+// deleteHoldEntryFromStore deletes the provided hold entry and its index entries from the store.
+func (k Keeper) deleteHoldEntryFromStore(store storetypes.KVStore, addr sdk.AccAddress, entry *hold.HoldEntry) {
+	store.Delete(CreateHoldEntryKey(entry.HoldId))
+	store.Delete(CreateHoldEntryAddrIndexKey(addr, entry.Holder, entry.Reason))
+	if entry.Expiration != nil {
+		store.Delete(CreateHoldEntryExpirationIndexKey(*entry.Expiration, entry.HoldId))
+	}
+}
+
+// validateHoldEntryExpiration returns an error if there's already a hold entry for the address, holder and reason
+// that has a different expiration than the one provided.
+func (k Keeper) validateHoldEntryExpiration(store storetypes.KVStore, addr sdk.AccAddress, holder, reason string, expiration *time.Time) error {
+	holdID, err := k.getHoldEntryIDFromStore(store, addr, holder, reason)
+	if err != nil || holdID == 0 {
+		return err
+	}
+	entry, err := k.getHoldEntryFromStore(store, holdID)
+	if err != nil || entry == nil {
+		return err
+	}
+	if !expirationsEqual(entry.Expiration, expiration) {
+		return fmt.Errorf("hold entry %d for %s %s %q has expiration %s: cannot add funds with expiration %s",
+			holdID, addr, holder, reason, expirationString(entry.Expiration), expirationString(expiration))
+	}
+	return nil
+}
+
+// expirationsEqual returns true if both expirations are nil, or both are the same time.
+func expirationsEqual(exp1, exp2 *time.Time) bool {
+	if exp1 == nil || exp2 == nil {
+		return exp1 == nil && exp2 == nil
+	}
+	return exp1.Equal(*exp2)
+}
+
+// expirationString returns a string of the provided expiration, or "<none>" if it's nil.
+func expirationString(expiration *time.Time) string {
+	if expiration == nil {
+		return "<none>"
+	}
+	return expiration.UTC().Format(time.RFC3339)
+}
+
+// addToHoldEntry adds the provided funds to the hold entry for the address, holder and reason, creating it if needed.
+// The expiration is only used if a new entry is created; see also: validateHoldEntryExpiration.
+// Returns the id of the hold entry.
+func (k Keeper) addToHoldEntry(store storetypes.KVStore, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) (uint64, error) {
+	holdID, err := k.getHoldEntryIDFromStore(store, addr, holder, reason)
+	if err != nil {
+		return 0, err
+	}
+
+	var entry *hold.HoldEntry
+	if holdID != 0 {
+		entry, err = k.getHoldEntryFromStore(store, holdID)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if entry == nil {
+		entry = &hold.HoldEntry{
+			HoldId:     k.nextHoldID(store),
+			Address:    addr.String(),
+			Holder:     holder,
+			Reason:     reason,
+			Expiration: expiration,
+		}
+	}
+
+	entry.Amount = entry.Amount.Add(funds...)
+
+	return entry.HoldId, k.setHoldEntryInStore(store, entry)
+}
+
+// removeFromHoldEntry removes the provided funds from the hold entry for the address, holder and reason.
+// If the entry does not exist, nothing is done. If the entry has less than the funds, it's reduced as much as possible.
+// If the entry ends up empty, it is deleted.
+// Returns the id of the hold entry, or zero if there isn't one.
+func (k Keeper) removeFromHoldEntry(store storetypes.KVStore, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) (uint64, error) {
+	holdID, err := k.getHoldEntryIDFromStore(store, addr, holder, reason)
+	if err != nil || holdID == 0 {
+		return 0, err
+	}
+
+	entry, err := k.getHoldEntryFromStore(store, holdID)
+	if err != nil || entry == nil {
+		return 0, err
+	}
+
+	entry.Amount = entry.Amount.Sub(entry.Amount.Min(funds)...)
+	if entry.Amount.IsZero() {
+		k.deleteHoldEntryFromStore(store, addr, entry)
+		return entry.HoldId, nil
+	}
+	return entry.HoldId, k.setHoldEntryInStore(store, entry)
+}
+
+// ReleaseHoldEntry releases all the funds in the hold entry with the provided id.
+func (k Keeper) ReleaseHoldEntry(ctx sdk.Context, holdID uint64) error {
+	entry, err := k.getHoldEntryFromStore(ctx.KVStore(k.storeKey), holdID)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("hold entry %d not found", holdID)
+	}
+	addr, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return fmt.Errorf("invalid hold entry %d address %q: %w", entry.HoldId, entry.Address, err)
+	}
+	return k.ReleaseHold(ctx, addr, entry.Amount, entry.Holder, entry.Reason)
+}
+
+// ForceReleaseHold releases the provided funds from hold for an account regardless of who placed them on hold.
+// The funds are taken out of the account's hold entries in order of hold id (i.e. oldest first).
+// Any funds that are not part of a hold entry (e.g. from before hold entries existed) are released last.
+// An error is returned if the account does not have all of the funds on hold.
+func (k Keeper) ForceReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error {
+	if funds.IsZero() {
+		return nil
+	}
+	if funds.IsAnyNegative() {
+		return fmt.Errorf("cannot release %q from hold for %s: amounts cannot be negative", funds, addr)
+	}
+
+	onHold, err := k.GetHoldCoins(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if !onHold.IsAllGTE(funds) {
+		return fmt.Errorf("cannot release %s from hold for %s: account only has %q on hold", funds, addr, onHold)
+	}
+
+	entries, err := k.GetHoldEntries(ctx, addr)
+	if err != nil {
+		return err
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].HoldId < entries[j].HoldId
+	})
+
+	remaining := funds
+	for _, entry := range entries {
+		toRelease := entry.Amount.Min(remaining)
+		if toRelease.IsZero() {
+			continue
+		}
+		if err = k.ReleaseHold(ctx, addr, toRelease, entry.Holder, entry.Reason); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(toRelease...)
+	}
+
+	if !remaining.IsZero() {
+		if err = k.ReleaseHold(ctx, addr, remaining, hold.ModuleName, ""); err != nil {
+			return err
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(hold.NewEventGovHoldReleased(addr, funds))
+}
+
+// GetHoldEntry gets the hold entry with the provided id.
+// Returns nil, nil if there isn't an entry with that id.
+func (k Keeper) GetHoldEntry(ctx sdk.Context, holdID uint64) (*hold.HoldEntry, error) {
+	return k.getHoldEntryFromStore(ctx.KVStore(k.storeKey), holdID)
+}
+
+// GetHoldEntries gets all the hold entries for a given account.
+func (k Keeper) GetHoldEntries(ctx sdk.Context, addr sdk.AccAddress) ([]*hold.HoldEntry, error) {
+	store := ctx.KVStore(k.storeKey)
+	indexStore := prefix.NewStore(store, CreateHoldEntryAddrIndexAddrPrefix(addr))
+
+	iter := indexStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var rv []*hold.HoldEntry
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		holdID, err := UnmarshalHoldID(iter.Value())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to read hold id for account %s: %w", addr, err))
+			continue
+		}
+		entry, err := k.getHoldEntryFromStore(store, holdID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if entry == nil {
+			errs = append(errs, fmt.Errorf("hold entry %d not found for account %s", holdID, addr))
+			continue
+		}
+		rv = append(rv, entry)
+	}
+
+	return rv, errors.Join(errs...)
+}
+
+// IterateAllHoldEntries iterates over all hold entries, in order of hold id.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+// If an error is encountered while reading from the store, that entry is skipped and an error is
+// returned for it when iteration is completed.
+func (k Keeper) IterateAllHoldEntries(ctx sdk.Context, process func(*hold.HoldEntry) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), KeyPrefixHoldEntry)
+
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		entry := &hold.HoldEntry{}
+		if err := k.cdc.Unmarshal(iter.Value(), entry); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read hold entry %v: %w", iter.Key(), err))
+			continue
+		}
+		if process(entry) {
+			break
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// GetAllHoldEntries gets all the hold entries currently in the state store.
+func (k Keeper) GetAllHoldEntries(ctx sdk.Context) ([]*hold.HoldEntry, error) {
+	var rv []*hold.HoldEntry
+	err := k.IterateAllHoldEntries(ctx, func(entry *hold.HoldEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	return rv, err
+}
+
+// MaxHoldsExpiredPerBlock is the maximum number of expired hold entries that will be released in a single block.
+// Any other expired hold entries are left for the following blocks.
+const MaxHoldsExpiredPerBlock = 1000
+
+// getExpiredHoldIDs gets the ids of up to maxIDs hold entries in the expiration index with an expiration
+// at or before the provided time, earliest expiration first.
+func getExpiredHoldIDs(store storetypes.KVStore, blockTime time.Time, maxIDs int) []uint64 {
+	indexStore := prefix.NewStore(store, GetHoldEntryExpirationIndexPrefix())
+	// The end is exclusive, so we add a second to the block time to include entries from its second too.
+	iter := indexStore.Iterator(nil, expirationBz(blockTime.Add(time.Second)))
+	defer iter.Close()
+
+	var rv []uint64
+	for ; iter.Valid() && len(rv) < maxIDs; iter.Next() {
+		_, holdID, err := ParseHoldEntryExpirationIndexKeyUnprefixed(iter.Key())
+		if err == nil {
+			rv = append(rv, holdID)
+		}
+	}
+	return rv
+}
+
+// ReleaseExpiredHolds releases the funds of up to MaxHoldsExpiredPerBlock hold entries that have expired as of the
+// current block time. The ones that expired first are released first.
+func (k Keeper) ReleaseExpiredHolds(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	blockTime := ctx.BlockTime()
+	holdIDs := getExpiredHoldIDs(store, blockTime, MaxHoldsExpiredPerBlock)
+
+	var errs []error
+	for _, holdID := range holdIDs {
+		entry, err := k.getHoldEntryFromStore(store, holdID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if entry == nil {
+			errs = append(errs, fmt.Errorf("hold entry %d not found", holdID))
+			continue
+		}
+		if !entry.IsExpiredAt(blockTime) {
+			continue
+		}
+
+		if err = k.ReleaseHoldEntry(ctx, holdID); err != nil {
+			errs = append(errs, fmt.Errorf("could not release expired hold entry %d: %w", holdID, err))
+			continue
+		}
+		if err = ctx.EventManager().EmitTypedEvent(hold.NewEventHoldExpired(entry)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		ctx.Logger().With("module", "x/"+hold.ModuleName).Error(
+			fmt.Sprintf("%d error(s) encountered releasing expired holds:\n%v", len(errs), errors.Join(errs...)))
+	}
+}
@@ -0,0 +1,308 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/hold/keeper"
+)
+
+// bondCoins creates an sdk.Coins of the provided amount of the bond denom.
+func (s *TestSuite) bondCoins(amount int64) sdk.Coins {
+	return sdk.Coins{s.coin(amount, s.bondDenom)}
+}
+
+// requireGetHoldEntries calls GetHoldEntries, requiring it to not return an error.
+func (s *TestSuite) requireGetHoldEntries(addr sdk.AccAddress) []*hold.HoldEntry {
+	entries, err := s.keeper.GetHoldEntries(s.ctx, addr)
+	s.Require().NoError(err, "GetHoldEntries(%s)", s.getAddrName(addr))
+	return entries
+}
+
+func (s *TestSuite) TestKeeper_HoldEntries() {
+	s.clearHoldState()
+	holder := "testholder"
+
+	s.Run("add hold with an invalid holder", func() {
+		err := s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(5), "", "no holder")
+		s.Assert().EqualError(err, "cannot place 5"+s.bondDenom+" on hold for "+s.addr1.String()+": holder cannot be empty", "AddHold error")
+		s.Assert().Empty(s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+
+	s.Run("first hold creates an entry", func() {
+		err := s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(5), holder, "reason one")
+		s.Require().NoError(err, "AddHold")
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 1, Address: s.addr1.String(), Amount: s.bondCoins(5), Holder: holder, Reason: "reason one"},
+		}
+		s.Assert().Equal(expEntries, s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+
+	s.Run("same holder and reason adds to the entry", func() {
+		err := s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(3), holder, "reason one")
+		s.Require().NoError(err, "AddHold")
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 1, Address: s.addr1.String(), Amount: s.bondCoins(8), Holder: holder, Reason: "reason one"},
+		}
+		s.Assert().Equal(expEntries, s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+
+	s.Run("different reason creates a new entry", func() {
+		err := s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(2), holder, "reason two")
+		s.Require().NoError(err, "AddHold")
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 1, Address: s.addr1.String(), Amount: s.bondCoins(8), Holder: holder, Reason: "reason one"},
+			{HoldId: 2, Address: s.addr1.String(), Amount: s.bondCoins(2), Holder: holder, Reason: "reason two"},
+		}
+		s.Assert().Equal(expEntries, s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+		holdCoins, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Require().NoError(err, "GetHoldCoins")
+		s.Assert().Equal(s.bondCoins(10).String(), holdCoins.String(), "GetHoldCoins")
+	})
+
+	s.Run("partial release reduces the entry", func() {
+		em := sdk.NewEventManager()
+		err := s.keeper.ReleaseHold(s.ctx.WithEventManager(em), s.addr1, s.bondCoins(6), holder, "reason one")
+		s.Require().NoError(err, "ReleaseHold")
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(s.addr1, s.bondCoins(6), holder, "reason one", 1))
+		s.Require().NoError(err, "TypedEventToEvent")
+		s.assertEqualEvents(sdk.Events{event}, em.Events(), "ReleaseHold events")
+
+		entry, err := s.keeper.GetHoldEntry(s.ctx, 1)
+		s.Require().NoError(err, "GetHoldEntry(1)")
+		s.Require().NotNil(entry, "GetHoldEntry(1)")
+		s.Assert().Equal(s.bondCoins(2).String(), entry.Amount.String(), "entry 1 amount")
+	})
+
+	s.Run("releasing the rest deletes the entry", func() {
+		err := s.keeper.ReleaseHold(s.ctx, s.addr1, s.bondCoins(2), holder, "reason one")
+		s.Require().NoError(err, "ReleaseHold")
+		entry, err := s.keeper.GetHoldEntry(s.ctx, 1)
+		s.Require().NoError(err, "GetHoldEntry(1)")
+		s.Assert().Nil(entry, "GetHoldEntry(1)")
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 2, Address: s.addr1.String(), Amount: s.bondCoins(2), Holder: holder, Reason: "reason two"},
+		}
+		s.Assert().Equal(expEntries, s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+
+	s.Run("release entry that does not exist", func() {
+		err := s.keeper.ReleaseHoldEntry(s.ctx, 1)
+		s.Assert().EqualError(err, "hold entry 1 not found", "ReleaseHoldEntry(1)")
+	})
+
+	s.Run("release entry by id", func() {
+		err := s.keeper.ReleaseHoldEntry(s.ctx, 2)
+		s.Require().NoError(err, "ReleaseHoldEntry(2)")
+		s.Assert().Empty(s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+		holdCoins, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Require().NoError(err, "GetHoldCoins")
+		s.Assert().True(holdCoins.IsZero(), "GetHoldCoins: %s", holdCoins)
+	})
+
+	s.Run("new entry does not reuse an id", func() {
+		err := s.keeper.AddHold(s.ctx, s.addr2, s.bondCoins(1), holder, "reason one")
+		s.Require().NoError(err, "AddHold")
+		entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+		s.Require().NoError(err, "GetAllHoldEntries")
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 3, Address: s.addr2.String(), Amount: s.bondCoins(1), Holder: holder, Reason: "reason one"},
+		}
+		s.Assert().Equal(expEntries, entries, "GetAllHoldEntries")
+	})
+}
+
+func (s *TestSuite) TestKeeper_ReleaseExpiredHolds() {
+	s.clearHoldState()
+	holder := "testholder"
+	now := time.Unix(1_700_000_000, 0).UTC()
+	soon := now.Add(time.Hour)
+	later := now.Add(24 * time.Hour)
+
+	s.Require().NoError(s.keeper.AddExpiringHold(s.ctx, s.addr1, s.bondCoins(10), holder, "soon", soon), "AddExpiringHold soon")
+	s.Require().NoError(s.keeper.AddExpiringHold(s.ctx, s.addr2, s.bondCoins(20), holder, "later", later), "AddExpiringHold later")
+	s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr3, s.bondCoins(30), holder, "never"), "AddHold never")
+
+	assertHoldCoins := func(addr sdk.AccAddress, expAmt int64) {
+		holdCoins, err := s.keeper.GetHoldCoins(s.ctx, addr)
+		s.Require().NoError(err, "GetHoldCoins(%s)", s.getAddrName(addr))
+		s.Assert().Equal(sdk.NewCoins(s.bondCoins(expAmt)...).String(), holdCoins.String(), "GetHoldCoins(%s)", s.getAddrName(addr))
+	}
+	releaseAt := func(blockTime time.Time) sdk.Events {
+		em := sdk.NewEventManager()
+		ctx := s.ctx.WithBlockTime(blockTime).WithEventManager(em)
+		s.Require().NotPanics(func() { s.keeper.ReleaseExpiredHolds(ctx) }, "ReleaseExpiredHolds(%s)", blockTime)
+		return em.Events()
+	}
+	expiredEvents := func(entry *hold.HoldEntry) sdk.Events {
+		addr := sdk.MustAccAddressFromBech32(entry.Address)
+		released, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, entry.Amount, entry.Holder, entry.Reason, entry.HoldId))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldReleased")
+		expired, err := sdk.TypedEventToEvent(hold.NewEventHoldExpired(entry))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldExpired")
+		return sdk.Events{released, expired}
+	}
+
+	s.Run("nothing expired yet", func() {
+		events := releaseAt(soon.Add(-1 * time.Second))
+		s.assertEqualEvents(nil, events, "ReleaseExpiredHolds events")
+		assertHoldCoins(s.addr1, 10)
+		assertHoldCoins(s.addr2, 20)
+		assertHoldCoins(s.addr3, 30)
+	})
+
+	s.Run("first one expires", func() {
+		entry := &hold.HoldEntry{HoldId: 1, Address: s.addr1.String(), Amount: s.bondCoins(10), Holder: holder, Reason: "soon", Expiration: &soon}
+		events := releaseAt(soon)
+		s.assertEqualEvents(expiredEvents(entry), events, "ReleaseExpiredHolds events")
+		assertHoldCoins(s.addr1, 0)
+		assertHoldCoins(s.addr2, 20)
+		assertHoldCoins(s.addr3, 30)
+	})
+
+	s.Run("adding to the second one with a different expiration", func() {
+		extended := later.Add(time.Hour)
+		expErr := "hold entry 2 for " + s.addr2.String() + " testholder \"later\" has expiration " + later.Format(time.RFC3339) +
+			": cannot add funds with expiration " + extended.Format(time.RFC3339)
+		err := s.keeper.AddExpiringHold(s.ctx, s.addr2, s.bondCoins(1), holder, "later", extended)
+		s.Assert().EqualError(err, expErr, "AddExpiringHold with a different expiration")
+		expErr = "hold entry 2 for " + s.addr2.String() + " testholder \"later\" has expiration " + later.Format(time.RFC3339) +
+			": cannot add funds with expiration <none>"
+		err = s.keeper.AddHold(s.ctx, s.addr2, s.bondCoins(1), holder, "later")
+		s.Assert().EqualError(err, expErr, "AddHold without an expiration")
+		assertHoldCoins(s.addr2, 20)
+	})
+
+	s.Run("adding to a non-expiring one with an expiration", func() {
+		expErr := "hold entry 3 for " + s.addr3.String() + " testholder \"never\" has expiration <none>" +
+			": cannot add funds with expiration " + later.Format(time.RFC3339)
+		err := s.keeper.AddExpiringHold(s.ctx, s.addr3, s.bondCoins(1), holder, "never", later)
+		s.Assert().EqualError(err, expErr, "AddExpiringHold")
+		assertHoldCoins(s.addr3, 30)
+	})
+
+	s.Run("adding to the second one with the same expiration", func() {
+		s.Require().NoError(s.keeper.AddExpiringHold(s.ctx, s.addr2, s.bondCoins(1), holder, "later", later), "AddExpiringHold")
+		assertHoldCoins(s.addr2, 21)
+
+		entry := &hold.HoldEntry{HoldId: 2, Address: s.addr2.String(), Amount: s.bondCoins(21), Holder: holder, Reason: "later", Expiration: &later}
+		events := releaseAt(later)
+		s.assertEqualEvents(expiredEvents(entry), events, "ReleaseExpiredHolds events")
+		assertHoldCoins(s.addr2, 0)
+		assertHoldCoins(s.addr3, 30)
+	})
+
+	s.Run("entries without an expiration never expire", func() {
+		events := releaseAt(later.Add(1000 * time.Hour))
+		s.assertEqualEvents(nil, events, "ReleaseExpiredHolds events")
+		assertHoldCoins(s.addr3, 30)
+		entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+		s.Require().NoError(err, "GetAllHoldEntries")
+		s.Assert().Len(entries, 1, "GetAllHoldEntries")
+	})
+}
+
+func (s *TestSuite) TestKeeper_ForceReleaseHold() {
+	s.clearHoldState()
+	holder := "testholder"
+
+	s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(5), holder, "one"), "AddHold one")
+	s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(3), holder, "two"), "AddHold two")
+	// Add some more on hold that isn't part of any entry.
+	s.requireSetHoldCoinAmount(s.getStore(), s.addr1, s.bondDenom, s.int(12))
+
+	newReleasedEvent := func(amount int64, reason string, holdID uint64) sdk.Event {
+		rv, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(s.addr1, s.bondCoins(amount), holder, reason, holdID))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldReleased")
+		return rv
+	}
+	newGovEvent := func(amount int64) sdk.Event {
+		rv, err := sdk.TypedEventToEvent(hold.NewEventGovHoldReleased(s.addr1, s.bondCoins(amount)))
+		s.Require().NoError(err, "TypedEventToEvent EventGovHoldReleased")
+		return rv
+	}
+	assertHoldCoins := func(expAmt int64) {
+		holdCoins, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+		s.Require().NoError(err, "GetHoldCoins")
+		s.Assert().Equal(sdk.NewCoins(s.bondCoins(expAmt)...).String(), holdCoins.String(), "GetHoldCoins")
+	}
+
+	s.Run("more than is on hold", func() {
+		em := sdk.NewEventManager()
+		err := s.keeper.ForceReleaseHold(s.ctx.WithEventManager(em), s.addr1, s.bondCoins(13))
+		s.Assert().EqualError(err, "cannot release 13"+s.bondDenom+" from hold for "+s.addr1.String()+
+			": account only has \"12"+s.bondDenom+"\" on hold", "ForceReleaseHold error")
+		s.assertEqualEvents(nil, em.Events(), "ForceReleaseHold events")
+		assertHoldCoins(12)
+	})
+
+	s.Run("negative amount", func() {
+		amount := sdk.Coins{sdk.Coin{Denom: s.bondDenom, Amount: s.int(-1)}}
+		err := s.keeper.ForceReleaseHold(s.ctx, s.addr1, amount)
+		s.Assert().EqualError(err, "cannot release \"-1"+s.bondDenom+"\" from hold for "+s.addr1.String()+
+			": amounts cannot be negative", "ForceReleaseHold error")
+	})
+
+	s.Run("first entry and part of second", func() {
+		em := sdk.NewEventManager()
+		err := s.keeper.ForceReleaseHold(s.ctx.WithEventManager(em), s.addr1, s.bondCoins(7))
+		s.Require().NoError(err, "ForceReleaseHold")
+		expEvents := sdk.Events{newReleasedEvent(5, "one", 1), newReleasedEvent(2, "two", 2), newGovEvent(7)}
+		s.assertEqualEvents(expEvents, em.Events(), "ForceReleaseHold events")
+		assertHoldCoins(5)
+		expEntries := []*hold.HoldEntry{
+			{HoldId: 2, Address: s.addr1.String(), Amount: s.bondCoins(1), Holder: holder, Reason: "two"},
+		}
+		s.Assert().Equal(expEntries, s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+
+	s.Run("rest of second entry and some without an entry", func() {
+		em := sdk.NewEventManager()
+		err := s.keeper.ForceReleaseHold(s.ctx.WithEventManager(em), s.addr1, s.bondCoins(3))
+		s.Require().NoError(err, "ForceReleaseHold")
+		legacyEvent, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(s.addr1, s.bondCoins(2), hold.ModuleName, "", 0))
+		s.Require().NoError(err, "TypedEventToEvent EventHoldReleased")
+		expEvents := sdk.Events{newReleasedEvent(1, "two", 2), legacyEvent, newGovEvent(3)}
+		s.assertEqualEvents(expEvents, em.Events(), "ForceReleaseHold events")
+		assertHoldCoins(2)
+		s.Assert().Empty(s.requireGetHoldEntries(s.addr1), "GetHoldEntries")
+	})
+}
+
+func (s *TestSuite) TestKeeper_ReleaseExpiredHolds_PerBlockLimit() {
+	s.clearHoldState()
+	holder := "testholder"
+	now := time.Unix(1_700_000_000, 0).UTC()
+	soon := now.Add(time.Hour)
+	later := soon.Add(time.Hour)
+
+	total := keeper.MaxHoldsExpiredPerBlock + 5
+	for i := 1; i <= total; i++ {
+		expiration := later
+		if i <= 5 {
+			expiration = soon
+		}
+		reason := fmt.Sprintf("reason %d", i)
+		s.Require().NoError(s.keeper.AddExpiringHold(s.ctx, s.addr1, s.bondCoins(1), holder, reason, expiration), "AddExpiringHold %d", i)
+	}
+
+	ctx := s.ctx.WithBlockTime(later)
+	s.Require().NotPanics(func() { s.keeper.ReleaseExpiredHolds(ctx) }, "ReleaseExpiredHolds first block")
+	entries, err := s.keeper.GetAllHoldEntries(s.ctx)
+	s.Require().NoError(err, "GetAllHoldEntries after first block")
+	s.Require().Len(entries, 5, "GetAllHoldEntries after first block")
+	for _, entry := range entries {
+		s.Assert().Equal(later, *entry.Expiration, "hold entry %d expiration after first block", entry.HoldId)
+	}
+
+	s.Require().NotPanics(func() { s.keeper.ReleaseExpiredHolds(ctx) }, "ReleaseExpiredHolds second block")
+	entries, err = s.keeper.GetAllHoldEntries(s.ctx)
+	s.Require().NoError(err, "GetAllHoldEntries after second block")
+	s.Assert().Empty(entries, "GetAllHoldEntries after second block")
+	holdCoins, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+	s.Require().NoError(err, "GetHoldCoins")
+	s.Assert().True(holdCoins.IsZero(), "GetHoldCoins: %s", holdCoins)
+}
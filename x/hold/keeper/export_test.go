@@ -15,6 +15,9 @@ import (
 // HoldAccountBalancesInvariantHelper exposes the holdAccountBalancesInvariantHelper function for unit tests.
 var HoldAccountBalancesInvariantHelper = holdAccountBalancesInvariantHelper
 
+// HoldEntriesInvariantHelper exposes the holdEntriesInvariantHelper function for unit tests.
+var HoldEntriesInvariantHelper = holdEntriesInvariantHelper
+
 // WithBankKeeper returns a new keeper that uses the provided bank keeper for unit tests.
 func (k Keeper) WithBankKeeper(bk hold.BankKeeper) Keeper {
 	k.bankKeeper = bk
@@ -30,3 +33,13 @@ func (k Keeper) GetStoreKey() storetypes.StoreKey {
 func (k Keeper) SetHoldCoinAmount(store storetypes.KVStore, addr sdk.AccAddress, denom string, amount sdkmath.Int) error {
 	return k.setHoldCoinAmount(store, addr, denom, amount)
 }
+
+// SetHoldEntryInStore exposes this keeper's setHoldEntryInStore function for unit tests.
+func (k Keeper) SetHoldEntryInStore(store storetypes.KVStore, entry *hold.HoldEntry) error {
+	return k.setHoldEntryInStore(store, entry)
+}
+
+// SetLastHoldID exposes this keeper's setLastHoldID function for unit tests.
+func (k Keeper) SetLastHoldID(store storetypes.KVStore, holdID uint64) {
+	k.setLastHoldID(store, holdID)
+}
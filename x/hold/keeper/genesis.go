@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,21 +11,31 @@ import (
 
 // InitGenesis loads the provided GenesisState into the state store.
 // Panics if there's an error.
-func (k Keeper) InitGenesis(origCtx sdk.Context, genState *hold.GenesisState) {
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *hold.GenesisState) {
 	if genState == nil {
 		return
 	}
 
-	// We don't want the events from this, so use a context with a throw-away event manager.
-	ctx := origCtx.WithEventManager(sdk.NewEventManager())
-
+	store := ctx.KVStore(k.storeKey)
 	for i, ah := range genState.Holds {
 		// Not worrying about wrapping any bech32 error because I'm assuming
 		// genState.Validate() was called before this.
 		addr := sdk.MustAccAddressFromBech32(ah.Address)
-		if err := k.AddHold(ctx, addr, ah.Amount, "genesis"); err != nil {
+		if err := k.ValidateNewHold(ctx, addr, ah.Amount); err != nil {
 			panic(fmt.Errorf("holds[%d]: %w", i, err))
 		}
+		if _, errs := k.addHoldCoins(store, addr, ah.Amount); len(errs) > 0 {
+			panic(fmt.Errorf("holds[%d]: %w", i, errors.Join(errs...)))
+		}
+	}
+
+	for i, entry := range genState.Entries {
+		if err := k.setHoldEntryInStore(store, entry); err != nil {
+			panic(fmt.Errorf("entries[%d]: %w", i, err))
+		}
+	}
+	if genState.LastHoldId != 0 {
+		k.setLastHoldID(store, genState.LastHoldId)
 	}
 }
 
@@ -38,5 +49,12 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *hold.GenesisState {
 		panic(err)
 	}
 
+	rv.Entries, err = k.GetAllHoldEntries(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	rv.LastHoldId = k.getLastHoldID(ctx.KVStore(k.storeKey))
+
 	return rv
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"sort"
+	"time"
 
 	storetypes "cosmossdk.io/store/types"
 
@@ -249,3 +250,46 @@ func (s *TestSuite) TestKeeper_ExportGenesis() {
 		})
 	}
 }
+
+func (s *TestSuite) TestKeeper_GenesisWithEntries() {
+	s.clearHoldState()
+	s.requireFundAccount(s.addr1, "99banana")
+	expiration := time.Unix(1700000000, 0).UTC()
+
+	genState := &hold.GenesisState{
+		Holds: []*hold.AccountHold{
+			{Address: s.addr1.String(), Amount: s.coins("99banana")},
+			{Address: s.addr2.String(), Amount: s.coins("5" + s.bondDenom)},
+		},
+		Entries: []*hold.HoldEntry{
+			{HoldId: 3, Address: s.addr1.String(), Amount: s.coins("60banana"), Holder: "exchange", Reason: "x/exchange: order 8"},
+			{HoldId: 5, Address: s.addr1.String(), Amount: s.coins("39banana"), Holder: "exchange", Reason: "x/exchange: order 9", Expiration: &expiration},
+			{HoldId: 7, Address: s.addr2.String(), Amount: s.coins("5" + s.bondDenom), Holder: "other", Reason: "just because"},
+		},
+		LastHoldId: 8,
+	}
+
+	em := sdk.NewEventManager()
+	ctx := s.ctx.WithEventManager(em)
+	testInit := func() {
+		s.keeper.InitGenesis(ctx, genState)
+	}
+	s.Require().NotPanics(testInit, "InitGenesis")
+	s.Assert().Empty(em.Events(), "events emitted during InitGenesis")
+
+	entries, err := s.keeper.GetHoldEntries(s.ctx, s.addr1)
+	s.Require().NoError(err, "GetHoldEntries(addr1)")
+	s.Assert().Equal(genState.Entries[:2], entries, "GetHoldEntries(addr1)")
+
+	var exported *hold.GenesisState
+	testExport := func() {
+		exported = s.keeper.ExportGenesis(s.ctx)
+	}
+	s.Require().NotPanics(testExport, "ExportGenesis")
+	s.Assert().Equal(genState, exported, "exported genesis state")
+
+	s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr3, s.coins("1"+s.bondDenom), "other", "new one"), "AddHold")
+	entry, err := s.keeper.GetHoldEntry(s.ctx, 9)
+	s.Require().NoError(err, "GetHoldEntry(9)")
+	s.Assert().NotNil(entry, "GetHoldEntry(9): new entry after the genesis last hold id")
+}
@@ -17,7 +17,7 @@ import (
 	"github.com/provenance-io/provenance/x/hold"
 )
 
-// GetHolds looks up the funds that are on hold for an address.
+// GetHolds looks up the funds that are on hold for an address, and the hold entries that make it up.
 func (k Keeper) GetHolds(goCtx context.Context, req *hold.GetHoldsRequest) (*hold.GetHoldsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -37,7 +37,11 @@ func (k Keeper) GetHolds(goCtx context.Context, req *hold.GetHoldsRequest) (*hol
 	if err != nil {
 		return nil, err
 	}
-	return resp, err
+	resp.Entries, err = k.GetHoldEntries(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
 }
 
 // GetAllHolds returns all addresses with funds on hold, and the amount held.
@@ -17,6 +17,14 @@ func (s *TestSuite) TestKeeper_GetHolds() {
 	s.requireSetHoldCoinAmount(store, s.addr3, "cactus", s.int(55))
 	s.requireSetHoldCoinAmount(store, s.addr3, "date", s.int(34))
 	s.setHoldCoinAmountRaw(store, s.addr4, "dratcoin", "dratvalue")
+	s.requireSetHoldCoinAmount(store, s.addr5, "banana", s.int(21))
+	addr5Entries := []*hold.HoldEntry{
+		{HoldId: 1, Address: s.addr5.String(), Amount: s.coins("13banana"), Holder: "exchange", Reason: "x/exchange: order 1"},
+		{HoldId: 2, Address: s.addr5.String(), Amount: s.coins("8banana"), Holder: "exchange", Reason: "x/exchange: order 2"},
+	}
+	for _, entry := range addr5Entries {
+		s.Require().NoError(s.keeper.SetHoldEntryInStore(store, entry), "SetHoldEntryInStore(%d)", entry.HoldId)
+	}
 	store = nil
 
 	req := func(addr string) *hold.GetHoldsRequest {
@@ -70,6 +78,11 @@ func (s *TestSuite) TestKeeper_GetHolds() {
 				"math/big: cannot unmarshal \"dratvalue\" into a *big.Int",
 			},
 		},
+		{
+			name:    "with hold entries",
+			request: req(s.addr5.String()),
+			expResp: &hold.GetHoldsResponse{Amount: s.coins("21banana"), Entries: addr5Entries},
+		},
 	}
 
 	for _, tc := range tests {
@@ -10,11 +10,15 @@ import (
 	"github.com/provenance-io/provenance/x/hold"
 )
 
-const balanceInvariant = "Hold-Account-Balances"
+const (
+	balanceInvariant = "Hold-Account-Balances"
+	entriesInvariant = "Hold-Entries"
+)
 
 // RegisterInvariants registers all quarantine invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
 	ir.RegisterRoute(hold.ModuleName, balanceInvariant, HoldAccountBalancesInvariant(keeper))
+	ir.RegisterRoute(hold.ModuleName, entriesInvariant, HoldEntriesInvariant(keeper))
 }
 
 // HoldAccountBalancesInvariant checks that all funds on hold are also otherwise unlocked in the account.
@@ -73,6 +77,87 @@ func holdAccountBalancesInvariantHelper(ctx sdk.Context, keeper Keeper) (string,
 		msg.WriteString(fmt.Sprintf("%d accounts have %s on hold.", allCount, total))
 	}
 
+	return writeInvariantProblems(&msg, errs)
+}
+
+// HoldEntriesInvariant checks that all hold entries are valid, indexed, and covered by the funds on hold in their accounts.
+func HoldEntriesInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := holdEntriesInvariantHelper(ctx, keeper)
+		return sdk.FormatInvariant(hold.ModuleName, entriesInvariant, msg), broken
+	}
+}
+
+// holdEntriesInvariantHelper does all the heavy lifting for HoldEntriesInvariant.
+// It will look up all hold entries and make sure that each is valid and properly indexed,
+// and that the entries for each address do not add up to more than that address has on hold.
+func holdEntriesInvariantHelper(ctx sdk.Context, keeper Keeper) (string, bool) {
+	allEntries, err := keeper.GetAllHoldEntries(ctx)
+	if err != nil {
+		return fmt.Sprintf("Failed to get all hold entries: %v", err), true
+	}
+
+	store := ctx.KVStore(keeper.storeKey)
+	lastHoldID := keeper.getLastHoldID(store)
+	var addrs []string
+	totals := make(map[string]sdk.Coins)
+	var errs []error
+	for _, entry := range allEntries {
+		if err = entry.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid hold entry %d: %w", entry.HoldId, err))
+			continue
+		}
+		if entry.HoldId > lastHoldID {
+			errs = append(errs, fmt.Errorf("hold entry %d has an id greater than the last hold id %d", entry.HoldId, lastHoldID))
+		}
+
+		addr := sdk.MustAccAddressFromBech32(entry.Address)
+		indexedID, err := keeper.getHoldEntryIDFromStore(store, addr, entry.Holder, entry.Reason)
+		if err != nil {
+			errs = append(errs, err)
+		} else if indexedID != entry.HoldId {
+			errs = append(errs, fmt.Errorf("hold entry %d is indexed for %s %s %q as hold entry %d",
+				entry.HoldId, entry.Address, entry.Holder, entry.Reason, indexedID))
+		}
+		if entry.Expiration != nil && !store.Has(CreateHoldEntryExpirationIndexKey(*entry.Expiration, entry.HoldId)) {
+			errs = append(errs, fmt.Errorf("hold entry %d is missing from the expiration index", entry.HoldId))
+		}
+
+		if _, known := totals[entry.Address]; !known {
+			addrs = append(addrs, entry.Address)
+		}
+		totals[entry.Address] = totals[entry.Address].Add(entry.Amount...)
+	}
+
+	for _, addrStr := range addrs {
+		addr := sdk.MustAccAddressFromBech32(addrStr)
+		onHold, err := keeper.GetHoldCoins(ctx, addr)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !onHold.IsAllGTE(totals[addrStr]) {
+			errs = append(errs, fmt.Errorf("account %s has hold entries totaling %s but only %s on hold", addrStr, totals[addrStr], onHold))
+		}
+	}
+
+	var msg strings.Builder
+
+	allCount := len(allEntries)
+	switch allCount {
+	case 0:
+		msg.WriteString("No hold entries exist.")
+	case 1:
+		msg.WriteString("1 hold entry exists.")
+	default:
+		msg.WriteString(fmt.Sprintf("%d hold entries exist.", allCount))
+	}
+
+	return writeInvariantProblems(&msg, errs)
+}
+
+// writeInvariantProblems adds a description of the provided errors to the msg and returns it along with whether there were any.
+func writeInvariantProblems(msg *strings.Builder, errs []error) (string, bool) {
 	msg.WriteByte(' ')
 	errCount := len(errs)
 	broken := errCount != 0
@@ -10,6 +10,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	vesting "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 )
 
@@ -288,3 +289,129 @@ func (s *TestSuite) TestHoldAccountBalancesInvariantHelper() {
 		})
 	}
 }
+
+func (s *TestSuite) TestHoldEntriesInvariantHelper() {
+	expiration := time.Unix(1700000000, 0).UTC()
+	entry := func(holdID uint64, addr sdk.AccAddress, amount string, reason string) *hold.HoldEntry {
+		return &hold.HoldEntry{
+			HoldId:  holdID,
+			Address: addr.String(),
+			Amount:  s.coins(amount),
+			Holder:  "testholder",
+			Reason:  reason,
+		}
+	}
+	setEntry := func(store storetypes.KVStore, entry *hold.HoldEntry) {
+		s.Require().NoError(s.keeper.SetHoldEntryInStore(store, entry), "SetHoldEntryInStore(%d)", entry.HoldId)
+	}
+
+	tests := []struct {
+		name      string
+		setup     func(store storetypes.KVStore)
+		expMsg    string
+		expBroken bool
+	}{
+		{
+			name:   "no entries",
+			expMsg: "No hold entries exist. No problems detected.",
+		},
+		{
+			name: "one entry covered by the hold",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(10))
+				setEntry(store, entry(1, s.addr1, "10banana", "one"))
+				s.keeper.SetLastHoldID(store, 1)
+			},
+			expMsg: "1 hold entry exists. No problems detected.",
+		},
+		{
+			name: "two entries with expiration covered by the hold",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(15))
+				setEntry(store, entry(1, s.addr1, "10banana", "one"))
+				e2 := entry(2, s.addr1, "5banana", "two")
+				e2.Expiration = &expiration
+				setEntry(store, e2)
+				s.keeper.SetLastHoldID(store, 2)
+			},
+			expMsg: "2 hold entries exist. No problems detected.",
+		},
+		{
+			name: "entries total more than the hold",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr2, "banana", s.int(9))
+				setEntry(store, entry(1, s.addr2, "5banana", "one"))
+				setEntry(store, entry(2, s.addr2, "5banana", "two"))
+				s.keeper.SetLastHoldID(store, 2)
+			},
+			expMsg: "2 hold entries exist. 1 problem detected: account " + s.addr2.String() +
+				" has hold entries totaling 10banana but only 9banana on hold",
+			expBroken: true,
+		},
+		{
+			name: "id greater than last hold id",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(10))
+				setEntry(store, entry(3, s.addr1, "10banana", "one"))
+				s.keeper.SetLastHoldID(store, 2)
+			},
+			expMsg:    "1 hold entry exists. 1 problem detected: hold entry 3 has an id greater than the last hold id 2",
+			expBroken: true,
+		},
+		{
+			name: "index points to a different entry",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(10))
+				setEntry(store, entry(1, s.addr1, "10banana", "one"))
+				store.Set(keeper.CreateHoldEntryAddrIndexKey(s.addr1, "testholder", "one"), []byte{0, 0, 0, 0, 0, 0, 0, 7})
+				s.keeper.SetLastHoldID(store, 1)
+			},
+			expMsg: "1 hold entry exists. 1 problem detected: hold entry 1 is indexed for " + s.addr1.String() +
+				" testholder \"one\" as hold entry 7",
+			expBroken: true,
+		},
+		{
+			name: "missing from expiration index",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(10))
+				e1 := entry(1, s.addr1, "10banana", "one")
+				e1.Expiration = &expiration
+				setEntry(store, e1)
+				store.Delete(keeper.CreateHoldEntryExpirationIndexKey(expiration, 1))
+				s.keeper.SetLastHoldID(store, 1)
+			},
+			expMsg:    "1 hold entry exists. 1 problem detected: hold entry 1 is missing from the expiration index",
+			expBroken: true,
+		},
+		{
+			name: "invalid entry",
+			setup: func(store storetypes.KVStore) {
+				s.requireSetHoldCoinAmount(store, s.addr1, "banana", s.int(10))
+				e1 := entry(1, s.addr1, "10banana", "one")
+				e1.Holder = ""
+				setEntry(store, e1)
+				s.keeper.SetLastHoldID(store, 1)
+			},
+			expMsg:    "1 hold entry exists. 1 problem detected: invalid hold entry 1: invalid holder: holder cannot be empty",
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearHoldState()
+			if tc.setup != nil {
+				tc.setup(s.getStore())
+			}
+
+			var msg string
+			var broken bool
+			testFunc := func() {
+				msg, broken = keeper.HoldEntriesInvariantHelper(s.ctx, s.keeper)
+			}
+			s.Require().NotPanics(testFunc, "holdEntriesInvariantHelper")
+			s.Assert().Equal(tc.expBroken, broken, "broken bool")
+			s.Assert().Equal(tc.expMsg, msg, "result message")
+		})
+	}
+}
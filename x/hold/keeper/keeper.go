@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
@@ -10,6 +11,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/provenance-io/provenance/x/hold"
 )
@@ -19,6 +22,8 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	bankKeeper hold.BankKeeper
+
+	authority string
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper hold.BankKeeper) Keeper {
@@ -26,11 +31,25 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, bankKeeper h
 		cdc:        cdc,
 		storeKey:   storeKey,
 		bankKeeper: bankKeeper,
+		authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	}
 	bankKeeper.AppendLockedCoinsGetter(rv.GetLockedCoins)
 	return rv
 }
 
+// GetAuthority gets the address (as bech32) that has governance authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// ValidateAuthority returns an error if the provided address is not the authority.
+func (k Keeper) ValidateAuthority(addr string) error {
+	if k.authority != addr {
+		return govtypes.ErrInvalidSigner.Wrapf("expected %q got %q", k.authority, addr)
+	}
+	return nil
+}
+
 // setHoldCoinAmount updates the store with the provided hold info.
 // If the amount is zero, the hold coin entry for addr+denom is deleted.
 // Otherwise, the hold coin entry for addr+denom is created/updated in the provided amount.
@@ -91,16 +110,58 @@ func (k Keeper) ValidateNewHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.
 }
 
 // AddHold puts the provided funds on hold for the provided account.
-func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, reason string) error {
+// The funds are recorded in the hold entry for the account, holder and reason, which is created if needed.
+// An error is returned if that entry already exists and has an expiration.
+func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error {
+	return k.addHold(ctx, addr, funds, holder, reason, nil)
+}
+
+// AddExpiringHold puts the provided funds on hold for the provided account in a hold entry with the provided expiration.
+// Once expired, the entry is released in an EndBlocker. An error is returned if the hold entry for the account,
+// holder and reason already exists with a different expiration.
+func (k Keeper) AddExpiringHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration time.Time) error {
+	return k.addHold(ctx, addr, funds, holder, reason, &expiration)
+}
+
+// addHold puts the provided funds on hold for the provided account and records them in a hold entry.
+// The expiration must match that of the existing hold entry (if there is one).
+func (k Keeper) addHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string, expiration *time.Time) error {
 	if funds.IsZero() {
 		return nil
 	}
 
+	if err := hold.ValidateHolder(holder); err != nil {
+		return fmt.Errorf("cannot place %s on hold for %s: %w", funds, addr, err)
+	}
+
 	if err := k.ValidateNewHold(ctx, addr, funds); err != nil {
 		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
+	if err := k.validateHoldEntryExpiration(store, addr, holder, reason, expiration); err != nil {
+		return err
+	}
+	fundsAdded, errs := k.addHoldCoins(store, addr, funds)
+
+	if !fundsAdded.IsZero() {
+		holdID, err := k.addToHoldEntry(store, addr, fundsAdded, holder, reason, expiration)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		err = ctx.EventManager().EmitTypedEvent(hold.NewEventHoldAdded(addr, fundsAdded, holder, reason, holdID))
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// addHoldCoins increases the amounts on hold for the provided account by the provided funds.
+// It does not check the account's spendable balance or do anything with hold entries.
+// Returns the funds that were successfully added, and any errors encountered.
+func (k Keeper) addHoldCoins(store storetypes.KVStore, addr sdk.AccAddress, funds sdk.Coins) (sdk.Coins, []error) {
 	var fundsAdded sdk.Coins
 	var errs []error
 	for _, toAdd := range funds {
@@ -119,19 +180,14 @@ func (k Keeper) AddHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, r
 		}
 		fundsAdded = fundsAdded.Add(toAdd)
 	}
-
-	if !fundsAdded.IsZero() {
-		err := ctx.EventManager().EmitTypedEvent(hold.NewEventHoldAdded(addr, fundsAdded, reason))
-		if err != nil {
-			errs = append(errs, err)
-		}
-	}
-
-	return errors.Join(errs...)
+	return fundsAdded, errs
 }
 
 // ReleaseHold releases the hold on the provided funds for the provided account.
-func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins) error {
+// The funds are also removed from the hold entry for the account, holder and reason.
+// If there's no such entry (e.g. the funds were placed on hold before hold entries existed),
+// or the entry has less than is being released, only the account's total hold is reduced by the rest.
+func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coins, holder, reason string) error {
 	if funds.IsZero() {
 		return nil
 	}
@@ -169,7 +225,11 @@ func (k Keeper) ReleaseHold(ctx sdk.Context, addr sdk.AccAddress, funds sdk.Coin
 	}
 
 	if !fundsReleased.IsZero() {
-		err := ctx.EventManager().EmitTypedEvent(hold.NewEventHoldReleased(addr, fundsReleased))
+		holdID, err := k.removeFromHoldEntry(store, addr, fundsReleased, holder, reason)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		err = ctx.EventManager().EmitTypedEvent(hold.NewEventHoldReleased(addr, fundsReleased, holder, reason, holdID))
 		if err != nil {
 			errs = append(errs, err)
 		}
@@ -464,8 +464,9 @@ func (s *TestSuite) TestKeeper_AddHold() {
 	s.setHoldCoinAmountRaw(store, s.addr3, "crudcoin", "crudvalue")
 	store = nil
 
-	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins, reason string) sdk.Events {
-		event, err := sdk.TypedEventToEvent(hold.NewEventHoldAdded(addr, coins, reason))
+	holder := "testholder"
+	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins, reason string, holdID uint64) sdk.Events {
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldAdded(addr, coins, holder, reason, holdID))
 		s.Require().NoError(err, "TypedEventToEvent EventHoldAdded(%s, %q)", s.getAddrName(addr), coins)
 		return sdk.Events{event}
 	}
@@ -506,7 +507,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("2banana"),
 			spendBal:  s.coins("2banana,9cucumber,11durian"),
 			finalHold: s.coins("101banana,3cucumber"),
-			expEvents: makeEvents(s.addr1, s.coins("2banana"), "sufficient spendable: add to existing entry"),
+			expEvents: makeEvents(s.addr1, s.coins("2banana"), "sufficient spendable: add to existing entry", 1),
 		},
 		{
 			name:      "small amount added to existing amount over max uint64",
@@ -514,7 +515,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("99hugecoin"),
 			spendBal:  s.coins("5000000000000000000000hugecoin"),
 			finalHold: s.coins("1844674407370955161599hugecoin,10000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr2, s.coins("99hugecoin"), "small amount added to existing amount over max uint64"),
+			expEvents: makeEvents(s.addr2, s.coins("99hugecoin"), "small amount added to existing amount over max uint64", 2),
 		},
 		{
 			name:      "amount over max uint64 added to existing amount over max uint64",
@@ -522,7 +523,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("2000000000000000000000hugecoin"),
 			spendBal:  s.coins("5000000000000000000000hugecoin"),
 			finalHold: s.coins("3844674407370955161599hugecoin,10000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr2, s.coins("2000000000000000000000hugecoin"), "amount over max uint64 added to existing amount over max uint64"),
+			expEvents: makeEvents(s.addr2, s.coins("2000000000000000000000hugecoin"), "amount over max uint64 added to existing amount over max uint64", 3),
 		},
 		{
 			name:      "amount over max uint64 added to new entry",
@@ -530,7 +531,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("18446744073709551616bigcoin"),
 			spendBal:  s.coins("20000000000000000000bigcoin"),
 			finalHold: s.coins("18446744073709551616bigcoin,3844674407370955161599hugecoin,10000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr2, s.coins("18446744073709551616bigcoin"), "amount over max uint64 added to new entry"),
+			expEvents: makeEvents(s.addr2, s.coins("18446744073709551616bigcoin"), "amount over max uint64 added to new entry", 4),
 		},
 		{
 			name:      "amount under max uint64 added to another such amount resulting in more than max uint64",
@@ -538,7 +539,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("10000000000000000000mediumcoin"),
 			spendBal:  s.coins("10000000000000000000mediumcoin"),
 			finalHold: s.coins("18446744073709551616bigcoin,3844674407370955161599hugecoin,20000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr2, s.coins("10000000000000000000mediumcoin"), "amount under max uint64 added to another such amount resulting in more than max uint64"),
+			expEvents: makeEvents(s.addr2, s.coins("10000000000000000000mediumcoin"), "amount under max uint64 added to another such amount resulting in more than max uint64", 5),
 		},
 		{
 			name:     "existing entry is invalid",
@@ -562,7 +563,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("4goodcoin"),
 			spendBal:  s.coins("1badcoin,2banana,4goodcoin"),
 			finalHold: s.coins("4goodcoin"),
-			expEvents: makeEvents(s.addr3, s.coins("4goodcoin"), "addr has bad entry but adding different denom"),
+			expEvents: makeEvents(s.addr3, s.coins("4goodcoin"), "addr has bad entry but adding different denom", 6),
 		},
 		{
 			name:      "zero of bad denom with some of another",
@@ -570,7 +571,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("0badcoin,8goodcoin"),
 			spendBal:  s.coins("8goodcoin"),
 			finalHold: s.coins("12goodcoin"),
-			expEvents: makeEvents(s.addr3, s.coins("8goodcoin"), "zero of bad denom with some of another"),
+			expEvents: makeEvents(s.addr3, s.coins("8goodcoin"), "zero of bad denom with some of another", 7),
 		},
 		{
 			name:     "three denoms: two existing and bad",
@@ -584,7 +585,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 				"math/big: cannot unmarshal \"crudvalue\" into a *big.Int",
 			},
 			finalHold: s.coins("57acorn,12goodcoin"),
-			expEvents: makeEvents(s.addr3, s.coins("57acorn"), "three denoms: two existing and bad"),
+			expEvents: makeEvents(s.addr3, s.coins("57acorn"), "three denoms: two existing and bad", 8),
 		},
 		{
 			name:      "sufficient spendable: new denoms on hold",
@@ -592,7 +593,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("37acorn,12banana"),
 			spendBal:  s.coins("37acorn,12banana"),
 			finalHold: s.coins("37acorn,12banana"),
-			expEvents: makeEvents(s.addr4, s.coins("37acorn,12banana"), "sufficient spendable: new denoms on hold"),
+			expEvents: makeEvents(s.addr4, s.coins("37acorn,12banana"), "sufficient spendable: new denoms on hold", 9),
 		},
 		{
 			name:      "amount over max uint64 added to amount under uint64",
@@ -600,7 +601,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     s.coins("5000000000000000000000banana"),
 			spendBal:  s.coins("5000000000000000000000banana"),
 			finalHold: s.coins("37acorn,5000000000000000000012banana"),
-			expEvents: makeEvents(s.addr4, s.coins("5000000000000000000000banana"), "amount over max uint64 added to amount under uint64"),
+			expEvents: makeEvents(s.addr4, s.coins("5000000000000000000000banana"), "amount over max uint64 added to amount under uint64", 10),
 		},
 		{
 			name:  "zero funds",
@@ -627,7 +628,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			funds:     sdk.Coins{s.coin(1, "apple"), s.coin(0, "banana"), s.coin(0, "cucumber")},
 			spendBal:  s.coins("8apple"),
 			finalHold: s.coins("1apple"),
-			expEvents: makeEvents(s.addr5, s.coins("1apple"), "two zero coins plus one not"),
+			expEvents: makeEvents(s.addr5, s.coins("1apple"), "two zero coins plus one not", 11),
 		},
 	}
 
@@ -643,7 +644,7 @@ func (s *TestSuite) TestKeeper_AddHold() {
 			ctx := s.ctx.WithEventManager(em)
 			var err error
 			testFunc := func() {
-				err = k.AddHold(ctx, tc.addr, tc.funds, tc.name)
+				err = k.AddHold(ctx, tc.addr, tc.funds, holder, tc.name)
 			}
 			s.Require().NotPanics(testFunc, "AddHold")
 
@@ -672,8 +673,10 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 	s.requireSetHoldCoinAmount(store, s.addr4, "mediumcoin", s.intStr("20000000000000000000"))
 	store = nil
 
-	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins) sdk.Events {
-		event, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, coins))
+	holder := "testholder"
+	makeEvents := func(addr sdk.AccAddress, coins sdk.Coins, reason string) sdk.Events {
+		// There aren't any hold entries for these, so the hold id is always zero.
+		event, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, coins, holder, reason, 0))
 		s.Require().NoError(err, "TypedEventToEvent EventHoldReleased((%s, %q)", s.getAddrName(addr), coins)
 		return sdk.Events{event}
 	}
@@ -692,20 +695,20 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 			addr:      s.addr1,
 			funds:     s.coins("1banana,1cucumber"),
 			finalHold: s.coins("98banana,2cucumber"),
-			expEvents: makeEvents(s.addr1, s.coins("1banana,1cucumber")),
+			expEvents: makeEvents(s.addr1, s.coins("1banana,1cucumber"), "release some of two denoms"),
 		},
 		{
 			name:      "two zero coins one not",
 			addr:      s.addr1,
 			funds:     sdk.Coins{s.coin(0, "apple"), s.coin(1, "banana"), s.coin(0, "cucumber")},
 			finalHold: s.coins("97banana,2cucumber"),
-			expEvents: makeEvents(s.addr1, s.coins("1banana")),
+			expEvents: makeEvents(s.addr1, s.coins("1banana"), "two zero coins one not"),
 		},
 		{
 			name:      "release all of two denoms",
 			addr:      s.addr1,
 			funds:     s.coins("97banana,2cucumber"),
-			expEvents: makeEvents(s.addr1, s.coins("97banana,2cucumber")),
+			expEvents: makeEvents(s.addr1, s.coins("97banana,2cucumber"), "release all of two denoms"),
 		},
 		{
 			name:  "not enough on hold",
@@ -722,13 +725,13 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 			addr:      s.addr2,
 			funds:     s.coins("10banana"),
 			finalHold: s.coins("8banana"),
-			expEvents: makeEvents(s.addr2, s.coins("10banana")),
+			expEvents: makeEvents(s.addr2, s.coins("10banana"), "only release some of one denom"),
 		},
 		{
 			name:      "release all of one denom",
 			addr:      s.addr2,
 			funds:     s.coins("8banana"),
-			expEvents: makeEvents(s.addr2, s.coins("8banana")),
+			expEvents: makeEvents(s.addr2, s.coins("8banana"), "release all of one denom"),
 		},
 		{
 			name:  "bad existing entry",
@@ -745,7 +748,7 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 			addr:      s.addr3,
 			funds:     s.coins("0badcoin,1goodcoin"),
 			finalHold: s.coins("1goodcoin"),
-			expEvents: makeEvents(s.addr3, s.coins("1goodcoin")),
+			expEvents: makeEvents(s.addr3, s.coins("1goodcoin"), "bad existing entry but amount of that denom is zero"),
 		},
 		{
 			name:  "two bad denoms one good",
@@ -757,35 +760,35 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 				"failed to get current crudcoin hold amount",
 				"math/big: cannot unmarshal \"crudvalue\" into a *big.Int",
 			},
-			expEvents: makeEvents(s.addr3, s.coins("1goodcoin")),
+			expEvents: makeEvents(s.addr3, s.coins("1goodcoin"), "two bad denoms one good"),
 		},
 		{
 			name:      "amount left on hold still greater than max uint64",
 			addr:      s.addr4,
 			funds:     s.coins("1hugecoin"),
 			finalHold: s.coins("1844674407370955161499hugecoin,1000000000000000000000largecoin,20000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr4, s.coins("1hugecoin")),
+			expEvents: makeEvents(s.addr4, s.coins("1hugecoin"), "amount left on hold still greater than max uint64"),
 		},
 		{
 			name:      "amount released is greater than max uint64",
 			addr:      s.addr4,
 			funds:     s.coins("1844674407370955161400hugecoin"),
 			finalHold: s.coins("99hugecoin,1000000000000000000000largecoin,20000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr4, s.coins("1844674407370955161400hugecoin")),
+			expEvents: makeEvents(s.addr4, s.coins("1844674407370955161400hugecoin"), "amount released is greater than max uint64"),
 		},
 		{
 			name:      "exising amount more than max uint64 and amount released is less with result also less",
 			addr:      s.addr4,
 			funds:     s.coins("10000000000000000000mediumcoin"),
 			finalHold: s.coins("99hugecoin,1000000000000000000000largecoin,10000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr4, s.coins("10000000000000000000mediumcoin")),
+			expEvents: makeEvents(s.addr4, s.coins("10000000000000000000mediumcoin"), "exising amount more than max uint64 and amount released is less with result also less"),
 		},
 		{
 			name:      "amount released is more than max uint64 with result also more",
 			addr:      s.addr4,
 			funds:     s.coins("100000000000000000000largecoin"),
 			finalHold: s.coins("99hugecoin,900000000000000000000largecoin,10000000000000000000mediumcoin"),
-			expEvents: makeEvents(s.addr4, s.coins("100000000000000000000largecoin")),
+			expEvents: makeEvents(s.addr4, s.coins("100000000000000000000largecoin"), "amount released is more than max uint64 with result also more"),
 		},
 		{
 			name:  "existing amount and amount to release over max uint64 but insufficient",
@@ -830,7 +833,7 @@ func (s *TestSuite) TestKeeper_ReleaseHold() {
 			ctx := s.ctx.WithEventManager(em)
 			var err error
 			testFunc := func() {
-				err = s.keeper.ReleaseHold(ctx, tc.addr, tc.funds)
+				err = s.keeper.ReleaseHold(ctx, tc.addr, tc.funds, holder, tc.name)
 			}
 			s.Require().NotPanics(testFunc, "ReleaseHold")
 
@@ -1565,7 +1568,7 @@ func (s *TestSuite) TestVestingAndHoldOverTime() {
 				amt := coins(-1 * action.hold)
 				logf(step, "Releasing hold on: %s", amtOf(amt))
 				reqNoPanicNoErr(func() error {
-					return s.keeper.ReleaseHold(ctx, addr, amt)
+					return s.keeper.ReleaseHold(ctx, addr, amt, "test", "test hold")
 				}, "ReleaseHold(addr, %q)", amt)
 			}
 
@@ -1588,7 +1591,7 @@ func (s *TestSuite) TestVestingAndHoldOverTime() {
 				amt := coins(action.hold)
 				logf(step, "Putting hold on: %s", amtOf(amt))
 				reqNoPanicNoErr(func() error {
-					return s.keeper.AddHold(ctx, addr, amt, fmt.Sprintf("test at %d", step))
+					return s.keeper.AddHold(ctx, addr, amt, "test", "test hold")
 				}, "AddHold(addr, %q)", amt)
 			}
 
@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +16,29 @@ import (
 //
 // Coin on hold:
 // - 0x00<addr len (1 byte)><addr><denom> -> <amount>
+//
+// Hold entry:
+// - 0x01<hold id (8 bytes)> -> <HoldEntry (protobuf)>
+//
+// Hold entry by address, holder and reason index:
+// - 0x02<addr len (1 byte)><addr><holder len (1 byte)><holder><reason> -> <hold id (8 bytes)>
+//
+// Hold entry by expiration index:
+// - 0x03<unix seconds (8 bytes)><hold id (8 bytes)> -> nil
+//
+// Last hold id:
+// - 0x04 -> <hold id (8 bytes)>
 var (
 	// KeyPrefixHoldCoin is the prefix of a hold entry for an address and single denom.
 	KeyPrefixHoldCoin = []byte{0x00}
+	// KeyPrefixHoldEntry is the prefix of a hold entry record.
+	KeyPrefixHoldEntry = []byte{0x01}
+	// KeyPrefixHoldEntryAddrIndex is the prefix of the index of hold entries by address, holder and reason.
+	KeyPrefixHoldEntryAddrIndex = []byte{0x02}
+	// KeyPrefixHoldEntryExpirationIndex is the prefix of the index of hold entries by expiration.
+	KeyPrefixHoldEntryExpirationIndex = []byte{0x03}
+	// KeyLastHoldID is the key of the most recently assigned hold id.
+	KeyLastHoldID = []byte{0x04}
 )
 
 // concatBzPlusCap creates a single byte slice consisting of the two provided byte slices with some extra capacity in the underlying array.
@@ -85,3 +109,88 @@ func UnmarshalHoldCoinValue(value []byte) (sdkmath.Int, error) {
 	}
 	return rv, nil
 }
+
+// uint64Bz converts the provided uint64 into an 8-byte big-endian slice.
+func uint64Bz(val uint64) []byte {
+	rv := make([]byte, 8)
+	binary.BigEndian.PutUint64(rv, val)
+	return rv
+}
+
+// UnmarshalHoldID parses the provided 8-byte big-endian slice into a hold id.
+func UnmarshalHoldID(value []byte) (uint64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+	if len(value) != 8 {
+		return 0, fmt.Errorf("cannot convert %v to a hold id: expected 8 bytes, found %d", value, len(value))
+	}
+	return binary.BigEndian.Uint64(value), nil
+}
+
+// CreateHoldEntryKey creates the key for the hold entry with the provided id.
+func CreateHoldEntryKey(holdID uint64) []byte {
+	rv := concatBzPlusCap(KeyPrefixHoldEntry, nil, 8)
+	rv = append(rv, uint64Bz(holdID)...)
+	return rv
+}
+
+// ParseHoldEntryKey extracts the hold id from the provided hold entry key.
+func ParseHoldEntryKey(key []byte) (uint64, error) {
+	if len(key) != 9 {
+		return 0, fmt.Errorf("cannot parse hold entry key %v: expected 9 bytes, found %d", key, len(key))
+	}
+	return binary.BigEndian.Uint64(key[1:]), nil
+}
+
+// createHoldEntryAddrIndexAddrPrefixPlusCap creates a hold entry address index key prefix containing the provided address.
+// The resulting slice will have the provided amount of extra capacity (in case you want to append something to it).
+func createHoldEntryAddrIndexAddrPrefixPlusCap(addr sdk.AccAddress, extraCap int) []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntryAddrIndex, address.MustLengthPrefix(addr), extraCap)
+}
+
+// CreateHoldEntryAddrIndexAddrPrefix creates a hold entry address index key prefix containing the provided address.
+// It's useful for iterating over all hold entries for an address.
+func CreateHoldEntryAddrIndexAddrPrefix(addr sdk.AccAddress) []byte {
+	return createHoldEntryAddrIndexAddrPrefixPlusCap(addr, 0)
+}
+
+// CreateHoldEntryAddrIndexKey creates a hold entry address index key for the provided address, holder and reason.
+func CreateHoldEntryAddrIndexKey(addr sdk.AccAddress, holder, reason string) []byte {
+	rv := createHoldEntryAddrIndexAddrPrefixPlusCap(addr, 1+len(holder)+len(reason))
+	rv = append(rv, byte(len(holder)))
+	rv = append(rv, []byte(holder)...)
+	rv = append(rv, []byte(reason)...)
+	return rv
+}
+
+// GetHoldEntryExpirationIndexPrefix creates the key prefix for all entries in the hold entry expiration index.
+func GetHoldEntryExpirationIndexPrefix() []byte {
+	return concatBzPlusCap(KeyPrefixHoldEntryExpirationIndex, nil, 0)
+}
+
+// expirationBz converts the provided expiration time into the bytes used for it in the expiration index.
+// Times before the unix epoch are treated as the epoch.
+func expirationBz(expiration time.Time) []byte {
+	secs := expiration.Unix()
+	if secs < 0 {
+		secs = 0
+	}
+	return uint64Bz(uint64(secs))
+}
+
+// CreateHoldEntryExpirationIndexKey creates a hold entry expiration index key for the provided expiration and hold id.
+func CreateHoldEntryExpirationIndexKey(expiration time.Time, holdID uint64) []byte {
+	rv := concatBzPlusCap(KeyPrefixHoldEntryExpirationIndex, expirationBz(expiration), 8)
+	rv = append(rv, uint64Bz(holdID)...)
+	return rv
+}
+
+// ParseHoldEntryExpirationIndexKeyUnprefixed parses a hold entry expiration index key
+// (without the type prefix) into its expiration (unix seconds) and hold id.
+func ParseHoldEntryExpirationIndexKeyUnprefixed(key []byte) (uint64, uint64, error) {
+	if len(key) != 16 {
+		return 0, 0, fmt.Errorf("cannot parse hold entry expiration index key %v: expected 16 bytes, found %d", key, len(key))
+	}
+	return binary.BigEndian.Uint64(key[:8]), binary.BigEndian.Uint64(key[8:]), nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -295,3 +296,239 @@ func TestUnmarshalHoldCoinValue(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshalHoldID(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  []byte
+		expID  uint64
+		expErr string
+	}{
+		{name: "nil", value: nil, expID: 0},
+		{name: "empty", value: []byte{}, expID: 0},
+		{name: "one", value: []byte{0, 0, 0, 0, 0, 0, 0, 1}, expID: 1},
+		{name: "256", value: []byte{0, 0, 0, 0, 0, 0, 1, 0}, expID: 256},
+		{name: "max uint64", value: []byte{255, 255, 255, 255, 255, 255, 255, 255}, expID: 18_446_744_073_709_551_615},
+		{
+			name:   "too short",
+			value:  []byte{0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot convert [0 0 0 0 0 0 1] to a hold id: expected 8 bytes, found 7",
+		},
+		{
+			name:   "too long",
+			value:  []byte{0, 0, 0, 0, 0, 0, 0, 0, 1},
+			expErr: "cannot convert [0 0 0 0 0 0 0 0 1] to a hold id: expected 8 bytes, found 9",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var holdID uint64
+			var err error
+			testFunc := func() {
+				holdID, err = keeper.UnmarshalHoldID(tc.value)
+			}
+			require.NotPanics(t, testFunc, "UnmarshalHoldID")
+			assertions.AssertErrorValue(t, err, tc.expErr, "UnmarshalHoldID")
+			assert.Equal(t, tc.expID, holdID, "result hold id")
+		})
+	}
+}
+
+func TestCreateHoldEntryKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		holdID uint64
+		exp    []byte
+	}{
+		{name: "zero", holdID: 0, exp: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 0}},
+		{name: "one", holdID: 1, exp: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 1}},
+		{name: "big", holdID: 0x0102030405060708, exp: []byte{0x01, 1, 2, 3, 4, 5, 6, 7, 8}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []byte
+			testFunc := func() {
+				actual = keeper.CreateHoldEntryKey(tc.holdID)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryKey")
+			if assert.Equal(t, tc.exp, actual, "result") {
+				assert.Equal(t, len(actual), cap(actual), "result length (expected) vs capacity (actual)")
+			}
+
+			var holdID uint64
+			var err error
+			testParse := func() {
+				holdID, err = keeper.ParseHoldEntryKey(actual)
+			}
+			require.NotPanics(t, testParse, "ParseHoldEntryKey")
+			assert.NoError(t, err, "ParseHoldEntryKey error")
+			assert.Equal(t, tc.holdID, holdID, "ParseHoldEntryKey result")
+		})
+	}
+}
+
+func TestParseHoldEntryKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		key    []byte
+		expID  uint64
+		expErr string
+	}{
+		{name: "nil", key: nil, expErr: "cannot parse hold entry key []: expected 9 bytes, found 0"},
+		{name: "only prefix", key: []byte{0x01}, expErr: "cannot parse hold entry key [1]: expected 9 bytes, found 1"},
+		{name: "missing prefix", key: []byte{0, 0, 0, 0, 0, 0, 0, 5}, expErr: "cannot parse hold entry key [0 0 0 0 0 0 0 5]: expected 9 bytes, found 8"},
+		{name: "five", key: []byte{0x01, 0, 0, 0, 0, 0, 0, 0, 5}, expID: 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var holdID uint64
+			var err error
+			testFunc := func() {
+				holdID, err = keeper.ParseHoldEntryKey(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryKey")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseHoldEntryKey")
+			assert.Equal(t, tc.expID, holdID, "result hold id")
+		})
+	}
+}
+
+func TestCreateHoldEntryAddrIndexKey(t *testing.T) {
+	addr20 := sdk.AccAddress("addr_with_20_bytes__")
+	addr32 := sdk.AccAddress("longer__address__with__32__bytes")
+	addr20WLen, err := address.LengthPrefix(addr20)
+	require.NoError(t, err, "LengthPrefix(addr20)")
+	addr32WLen, err := address.LengthPrefix(addr32)
+	require.NoError(t, err, "LengthPrefix(addr32)")
+
+	tests := []struct {
+		name      string
+		addr      sdk.AccAddress
+		holder    string
+		reason    string
+		expPrefix []byte
+		exp       []byte
+	}{
+		{
+			name:      "20 byte address",
+			addr:      addr20,
+			holder:    "exchange",
+			reason:    "x/exchange: order 4",
+			expPrefix: concatBzs([]byte{0x02}, addr20WLen),
+			exp:       concatBzs([]byte{0x02}, addr20WLen, []byte{8}, []byte("exchange"), []byte("x/exchange: order 4")),
+		},
+		{
+			name:      "32 byte address no reason",
+			addr:      addr32,
+			holder:    "someholder",
+			expPrefix: concatBzs([]byte{0x02}, addr32WLen),
+			exp:       concatBzs([]byte{0x02}, addr32WLen, []byte{10}, []byte("someholder")),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var prefix []byte
+			testPrefix := func() {
+				prefix = keeper.CreateHoldEntryAddrIndexAddrPrefix(tc.addr)
+			}
+			require.NotPanics(t, testPrefix, "CreateHoldEntryAddrIndexAddrPrefix")
+			assert.Equal(t, tc.expPrefix, prefix, "CreateHoldEntryAddrIndexAddrPrefix result")
+
+			var actual []byte
+			testFunc := func() {
+				actual = keeper.CreateHoldEntryAddrIndexKey(tc.addr, tc.holder, tc.reason)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryAddrIndexKey")
+			if assert.Equal(t, tc.exp, actual, "CreateHoldEntryAddrIndexKey result") {
+				assert.Equal(t, len(actual), cap(actual), "result length (expected) vs capacity (actual)")
+			}
+		})
+	}
+}
+
+func TestCreateHoldEntryExpirationIndexKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		expiration time.Time
+		holdID     uint64
+		exp        []byte
+	}{
+		{
+			name:       "epoch",
+			expiration: time.Unix(0, 0),
+			holdID:     1,
+			exp:        []byte{0x03, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1},
+		},
+		{
+			name:       "before epoch",
+			expiration: time.Unix(-5000, 0),
+			holdID:     2,
+			exp:        []byte{0x03, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 2},
+		},
+		{
+			name:       "fraction of a second is ignored",
+			expiration: time.Unix(256, 999_999_999),
+			holdID:     0x0102,
+			exp:        []byte{0x03, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 2},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var actual []byte
+			testFunc := func() {
+				actual = keeper.CreateHoldEntryExpirationIndexKey(tc.expiration, tc.holdID)
+			}
+			require.NotPanics(t, testFunc, "CreateHoldEntryExpirationIndexKey")
+			if assert.Equal(t, tc.exp, actual, "result") {
+				assert.Equal(t, len(actual), cap(actual), "result length (expected) vs capacity (actual)")
+			}
+			assert.Equal(t, actual[:1], keeper.GetHoldEntryExpirationIndexPrefix(), "GetHoldEntryExpirationIndexPrefix")
+		})
+	}
+}
+
+func TestParseHoldEntryExpirationIndexKeyUnprefixed(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     []byte
+		expSecs uint64
+		expID   uint64
+		expErr  string
+	}{
+		{
+			name:   "nil",
+			key:    nil,
+			expErr: "cannot parse hold entry expiration index key []: expected 16 bytes, found 0",
+		},
+		{
+			name:   "with prefix",
+			key:    []byte{0x03, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 4},
+			expErr: "cannot parse hold entry expiration index key [3 0 0 0 0 0 0 0 9 0 0 0 0 0 0 0 4]: expected 16 bytes, found 17",
+		},
+		{
+			name:    "good",
+			key:     []byte{0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0, 0, 0, 0, 0, 4},
+			expSecs: 9,
+			expID:   4,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var secs, holdID uint64
+			var err error
+			testFunc := func() {
+				secs, holdID, err = keeper.ParseHoldEntryExpirationIndexKeyUnprefixed(tc.key)
+			}
+			require.NotPanics(t, testFunc, "ParseHoldEntryExpirationIndexKeyUnprefixed")
+			assertions.AssertErrorValue(t, err, tc.expErr, "ParseHoldEntryExpirationIndexKeyUnprefixed")
+			assert.Equal(t, tc.expSecs, secs, "result expiration seconds")
+			assert.Equal(t, tc.expID, holdID, "result hold id")
+		})
+	}
+}
@@ -295,7 +295,7 @@ func (s *TestSuite) TestBank_Send() {
 		ctx := hold.WithBypass(s.ctx)
 		err := s.bankKeeper.SendCoins(ctx, fromAddr, toAddr, s.coins("5banana"))
 		s.Assert().NoError(err, "SendCoins error")
-		err = s.keeper.ReleaseHold(s.ctx, fromAddr, s.coins("4banana"))
+		err = s.keeper.ReleaseHold(s.ctx, fromAddr, s.coins("4banana"), "test", "test hold")
 		s.Assert().NoError(err, "ReleaseHold error")
 		fromBal := s.bankKeeper.GetAllBalances(s.ctx, fromAddr)
 		s.Assert().Equal("100acorn,95banana,100coolcoin", fromBal.String(), "GetAllBalances(fromAddr)")
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/provenance-io/provenance/x/hold"
+)
+
+// MsgServer is an alias for a Keeper that implements the hold.MsgServer interface.
+type MsgServer struct {
+	Keeper
+}
+
+func NewMsgServer(k Keeper) hold.MsgServer {
+	return MsgServer{
+		Keeper: k,
+	}
+}
+
+var _ hold.MsgServer = MsgServer{}
+
+// ReleaseHold is a governance proposal endpoint for releasing funds that are on hold in an account.
+func (k MsgServer) ReleaseHold(goCtx context.Context, msg *hold.MsgReleaseHoldRequest) (*hold.MsgReleaseHoldResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err = k.ForceReleaseHold(ctx, addr, msg.Amount); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &hold.MsgReleaseHoldResponse{}, nil
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/hold"
+	"github.com/provenance-io/provenance/x/hold/keeper"
+)
+
+func (s *TestSuite) TestMsgServer_ReleaseHold() {
+	authority := s.keeper.GetAuthority()
+	holder := "testholder"
+
+	tests := []struct {
+		name      string
+		setup     func()
+		msg       *hold.MsgReleaseHoldRequest
+		expErr    string
+		expHold   sdk.Coins
+		expEvents bool
+	}{
+		{
+			name:   "wrong authority",
+			msg:    hold.NewMsgReleaseHoldRequest(s.addr5.String(), s.addr1, s.bondCoins(1)),
+			expErr: "expected \"" + authority + "\" got \"" + s.addr5.String() + "\": expected gov account as only signer for proposal message",
+		},
+		{
+			name:   "invalid address",
+			msg:    &hold.MsgReleaseHoldRequest{Authority: authority, Address: "nope", Amount: s.bondCoins(1)},
+			expErr: "decoding bech32 failed: invalid bech32 string length 4: invalid address",
+		},
+		{
+			name: "not enough on hold",
+			setup: func() {
+				s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(2), holder, "stuck"), "AddHold")
+			},
+			msg:     hold.NewMsgReleaseHoldRequest(authority, s.addr1, s.bondCoins(3)),
+			expErr:  "cannot release 3" + s.bondDenom + " from hold for " + s.addr1.String() + ": account only has \"2" + s.bondDenom + "\" on hold: invalid request",
+			expHold: s.bondCoins(2),
+		},
+		{
+			name: "all funds released",
+			setup: func() {
+				s.Require().NoError(s.keeper.AddHold(s.ctx, s.addr1, s.bondCoins(2), holder, "stuck"), "AddHold")
+			},
+			msg:       hold.NewMsgReleaseHoldRequest(authority, s.addr1, s.bondCoins(2)),
+			expEvents: true,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.clearHoldState()
+			if tc.setup != nil {
+				tc.setup()
+			}
+
+			em := sdk.NewEventManager()
+			ctx := s.ctx.WithEventManager(em)
+			msgServer := keeper.NewMsgServer(s.keeper)
+			var resp *hold.MsgReleaseHoldResponse
+			var err error
+			testFunc := func() {
+				resp, err = msgServer.ReleaseHold(ctx, tc.msg)
+			}
+			s.Require().NotPanics(testFunc, "ReleaseHold")
+			s.assertErrorValue(err, tc.expErr, "ReleaseHold error")
+			if len(tc.expErr) > 0 {
+				s.Assert().Nil(resp, "ReleaseHold response")
+			} else {
+				s.Assert().NotNil(resp, "ReleaseHold response")
+			}
+
+			if tc.expEvents {
+				addr := sdk.MustAccAddressFromBech32(tc.msg.Address)
+				released, err := sdk.TypedEventToEvent(hold.NewEventHoldReleased(addr, tc.msg.Amount, holder, "stuck", 1))
+				s.Require().NoError(err, "TypedEventToEvent EventHoldReleased")
+				govReleased, err := sdk.TypedEventToEvent(hold.NewEventGovHoldReleased(addr, tc.msg.Amount))
+				s.Require().NoError(err, "TypedEventToEvent EventGovHoldReleased")
+				s.assertEqualEvents(sdk.Events{released, govReleased}, em.Events(), "ReleaseHold events")
+			}
+
+			holdCoins, err := s.keeper.GetHoldCoins(s.ctx, s.addr1)
+			s.Require().NoError(err, "GetHoldCoins")
+			s.Assert().Equal(tc.expHold.String(), holdCoins.String(), "GetHoldCoins")
+		})
+	}
+}
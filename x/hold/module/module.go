@@ -30,7 +30,8 @@ var (
 	_ module.AppModuleBasic      = (*AppModule)(nil)
 	_ module.AppModuleSimulation = (*AppModule)(nil)
 
-	_ appmodule.AppModule = (*AppModule)(nil)
+	_ appmodule.AppModule     = (*AppModule)(nil)
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 type AppModule struct {
@@ -80,7 +81,7 @@ func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
 
 // GetTxCmd returns the transaction commands for the hold module.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.TxCmd()
 }
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the hold module.
@@ -91,7 +92,9 @@ func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx sdkclient.Context, m
 }
 
 // RegisterInterfaces registers the hold module's interface types
-func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+func (AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	hold.RegisterInterfaces(registry)
+}
 
 // RegisterLegacyAminoCodec registers the hold module's types for the given codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
@@ -116,11 +119,18 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-// RegisterServices registers a gRPC query service to respond to the hold-specific gRPC queries.
+// RegisterServices registers the hold module's gRPC msg and query services.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
+	hold.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(am.keeper))
 	hold.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 }
 
+// EndBlock releases any hold entries that have expired as of this block.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ReleaseExpiredHolds(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
@@ -0,0 +1,38 @@
+package hold
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AllRequestMsgs defines all the Msg*Request messages.
+var AllRequestMsgs = []sdk.Msg{
+	(*MsgReleaseHoldRequest)(nil),
+}
+
+// NewMsgReleaseHoldRequest creates a new ReleaseHold message.
+func NewMsgReleaseHoldRequest(authority string, addr sdk.AccAddress, amount sdk.Coins) *MsgReleaseHoldRequest {
+	return &MsgReleaseHoldRequest{
+		Authority: authority,
+		Address:   addr.String(),
+		Amount:    amount,
+	}
+}
+
+func (m MsgReleaseHoldRequest) ValidateBasic() error {
+	var errs []error
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		errs = append(errs, fmt.Errorf("invalid authority: %w", err))
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		errs = append(errs, fmt.Errorf("invalid address: %w", err))
+	}
+	if err := m.Amount.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid amount: %w", err))
+	} else if m.Amount.IsZero() {
+		errs = append(errs, errors.New("invalid amount: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
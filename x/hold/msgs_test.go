@@ -0,0 +1,90 @@
+package hold_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/testutil"
+	"github.com/provenance-io/provenance/testutil/assertions"
+
+	. "github.com/provenance-io/provenance/x/hold"
+)
+
+func TestAllMsgsGetSigners(t *testing.T) {
+	msgMakers := []testutil.MsgMaker{
+		func(signer string) sdk.Msg { return &MsgReleaseHoldRequest{Authority: signer} },
+	}
+
+	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
+}
+
+func TestNewMsgReleaseHoldRequest(t *testing.T) {
+	addr := sdk.AccAddress("addr________________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 3), sdk.NewInt64Coin("banana", 8))
+	expected := &MsgReleaseHoldRequest{
+		Authority: "authority",
+		Address:   addr.String(),
+		Amount:    amount,
+	}
+	msg := NewMsgReleaseHoldRequest("authority", addr, amount)
+	assert.Equal(t, expected, msg, "NewMsgReleaseHoldRequest")
+}
+
+func TestMsgReleaseHoldRequest_ValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("authority___________").String()
+	addr := sdk.AccAddress("addr________________").String()
+	amount := sdk.NewCoins(sdk.NewInt64Coin("apple", 3))
+
+	tests := []struct {
+		name   string
+		msg    MsgReleaseHoldRequest
+		expErr []string
+	}{
+		{
+			name: "control",
+			msg:  MsgReleaseHoldRequest{Authority: authority, Address: addr, Amount: amount},
+		},
+		{
+			name:   "no authority",
+			msg:    MsgReleaseHoldRequest{Authority: "", Address: addr, Amount: amount},
+			expErr: []string{"invalid authority: empty address string is not allowed"},
+		},
+		{
+			name:   "invalid address",
+			msg:    MsgReleaseHoldRequest{Authority: authority, Address: "nope", Amount: amount},
+			expErr: []string{"invalid address: decoding bech32 failed: invalid bech32 string length 4"},
+		},
+		{
+			name:   "nil amount",
+			msg:    MsgReleaseHoldRequest{Authority: authority, Address: addr, Amount: nil},
+			expErr: []string{"invalid amount: cannot be zero"},
+		},
+		{
+			name: "invalid amount",
+			msg: MsgReleaseHoldRequest{
+				Authority: authority, Address: addr,
+				Amount: sdk.Coins{sdk.Coin{Denom: "apple", Amount: sdk.NewInt64Coin("apple", 1).Amount.Neg()}},
+			},
+			expErr: []string{"invalid amount: coin -1apple amount is not positive"},
+		},
+		{
+			name:   "multiple errors",
+			msg:    MsgReleaseHoldRequest{},
+			expErr: []string{"invalid authority: ", "invalid address: ", "invalid amount: cannot be zero"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var err error
+			testFunc := func() {
+				err = tc.msg.ValidateBasic()
+			}
+			assert.NotPanics(t, testFunc, "ValidateBasic")
+			assertions.AssertErrorContents(t, err, tc.expErr, "ValidateBasic error")
+		})
+	}
+}
@@ -76,6 +76,9 @@ var xxx_messageInfo_GetHoldsRequest proto.InternalMessageInfo
 type GetHoldsResponse struct {
 	// amount is the total on hold for the requested address.
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// entries is the breakdown of the funds on hold by holder and reason.
+	// Funds that were placed on hold before hold entries existed are included in amount, but not in any entry.
+	Entries []*HoldEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
 func (m *GetHoldsResponse) Reset()         { *m = GetHoldsResponse{} }
@@ -215,41 +218,42 @@ func init() {
 func init() { proto.RegisterFile("provenance/hold/v1/query.proto", fileDescriptor_e41c9f383440a9df) }
 
 var fileDescriptor_e41c9f383440a9df = []byte{
-	// 539 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x3f, 0x6f, 0xd3, 0x4e,
-	0x18, 0xc7, 0x7d, 0xfd, 0xa9, 0xfd, 0x95, 0x0b, 0x12, 0x70, 0x80, 0x94, 0x06, 0x70, 0x42, 0x0a,
-	0x6d, 0x14, 0xd4, 0x3b, 0x25, 0xa8, 0x0b, 0x5b, 0x8b, 0xd4, 0x30, 0x96, 0x8c, 0x48, 0x08, 0x5d,
-	0xec, 0xab, 0x6b, 0xe1, 0xdc, 0xe3, 0xe6, 0xce, 0x11, 0x11, 0x62, 0xa0, 0x13, 0x63, 0x25, 0x84,
-	0x90, 0x98, 0x3a, 0x22, 0xa6, 0xbe, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x00, 0x25, 0x48, 0xe5, 0x65,
-	0x20, 0xdb, 0x67, 0xc5, 0x29, 0x2e, 0x5d, 0xec, 0xb3, 0x9f, 0xef, 0xe3, 0xef, 0xe7, 0xf9, 0x63,
-	0x6c, 0x87, 0x03, 0x18, 0x0a, 0xc9, 0xa5, 0x23, 0xd8, 0x2e, 0x04, 0x2e, 0x1b, 0xb6, 0xd8, 0x5e,
-	0x24, 0x06, 0x23, 0x1a, 0x0e, 0x40, 0x03, 0x21, 0xd3, 0x38, 0x8d, 0xe3, 0x74, 0xd8, 0xaa, 0x5c,
-	0xe3, 0x7d, 0x5f, 0x02, 0x4b, 0xae, 0xa9, 0xac, 0xd2, 0x74, 0x40, 0xf5, 0x41, 0xb1, 0x1e, 0x57,
-	0x22, 0xcd, 0x67, 0xc3, 0x56, 0x4f, 0x68, 0xde, 0x62, 0x21, 0xf7, 0x7c, 0xc9, 0xb5, 0x0f, 0xd2,
-	0x68, 0xed, 0xbc, 0x36, 0x53, 0x39, 0xe0, 0x67, 0xf1, 0x1b, 0x1e, 0x78, 0x90, 0x1c, 0x59, 0x7c,
-	0x32, 0x6f, 0x6f, 0x7b, 0x00, 0x5e, 0x20, 0x18, 0x0f, 0x7d, 0xc6, 0xa5, 0x04, 0x9d, 0x7c, 0x52,
-	0x99, 0xe8, 0x9d, 0x82, 0x32, 0x12, 0xdc, 0x24, 0x5c, 0x5f, 0xc7, 0x57, 0x3a, 0x42, 0x3f, 0x81,
-	0xc0, 0x55, 0x5d, 0xb1, 0x17, 0x09, 0xa5, 0x49, 0x19, 0xff, 0xcf, 0x5d, 0x77, 0x20, 0x94, 0x2a,
-	0xa3, 0x1a, 0x6a, 0x5c, 0xea, 0x66, 0x8f, 0x8f, 0x16, 0xdf, 0x1d, 0x56, 0xad, 0xdf, 0x87, 0x55,
-	0xab, 0xfe, 0x11, 0xe1, 0xab, 0xd3, 0x3c, 0x15, 0x82, 0x54, 0x82, 0x8c, 0xf0, 0x02, 0xef, 0x43,
-	0x24, 0x75, 0x19, 0xd5, 0xfe, 0x6b, 0x94, 0xda, 0x4b, 0x34, 0xad, 0x87, 0xc6, 0xf5, 0x50, 0x53,
-	0x0f, 0x7d, 0x0c, 0xbe, 0xdc, 0xdc, 0x3a, 0xfe, 0x5e, 0xb5, 0xbe, 0xfc, 0xa8, 0x36, 0x3c, 0x5f,
-	0xef, 0x46, 0x3d, 0xea, 0x40, 0x9f, 0x99, 0xe2, 0xd3, 0xdb, 0x9a, 0x72, 0x5f, 0x32, 0x3d, 0x0a,
-	0x85, 0x4a, 0x12, 0xd4, 0xa7, 0xd3, 0xa3, 0xe6, 0xe5, 0x40, 0x78, 0xdc, 0x19, 0xbd, 0x88, 0x3b,
-	0xa2, 0x3e, 0x9f, 0x1e, 0x35, 0x51, 0xd7, 0x18, 0xe6, 0xc8, 0x76, 0x30, 0xe9, 0x08, 0xbd, 0x11,
-	0x04, 0x33, 0x35, 0x6d, 0x61, 0x3c, 0xed, 0x76, 0xd9, 0xa9, 0xa1, 0x46, 0xa9, 0xbd, 0x32, 0x83,
-	0x97, 0x8e, 0x36, 0x83, 0xdc, 0xe6, 0x9e, 0x30, 0xb9, 0xdd, 0x5c, 0x66, 0xce, 0xe7, 0x03, 0xc2,
-	0xd7, 0x67, 0x8c, 0x4c, 0x13, 0xd6, 0xf1, 0x7c, 0xdc, 0x5e, 0x65, 0x7a, 0x50, 0xa5, 0x7f, 0xaf,
-	0x09, 0xdd, 0x70, 0x9c, 0x98, 0x3a, 0x4e, 0xec, 0xa6, 0x6a, 0xd2, 0x29, 0x00, 0x5c, 0xbd, 0x10,
-	0x30, 0xf5, 0xcc, 0x13, 0xb6, 0x0f, 0xe6, 0xf0, 0xfc, 0xd3, 0x58, 0x4a, 0xf6, 0x11, 0x5e, 0xcc,
-	0x66, 0x44, 0x96, 0x8b, 0x38, 0xce, 0x4c, 0xbe, 0x72, 0xef, 0xdf, 0xa2, 0xd4, 0xad, 0xfe, 0x60,
-	0xff, 0xeb, 0xaf, 0xf7, 0x73, 0xf7, 0xc9, 0x32, 0x2b, 0x58, 0xad, 0x9d, 0x48, 0xba, 0x8a, 0xbd,
-	0x36, 0x1b, 0xf3, 0x86, 0xbc, 0x45, 0xb8, 0x94, 0x6b, 0x13, 0x59, 0x39, 0xc7, 0xe2, 0xcc, 0xc0,
-	0x2a, 0xab, 0x17, 0xea, 0x0c, 0xcd, 0xdd, 0x84, 0xe6, 0x16, 0x59, 0x3a, 0x97, 0x66, 0xf3, 0xf9,
-	0xf1, 0xd8, 0x46, 0x27, 0x63, 0x1b, 0xfd, 0x1c, 0xdb, 0xe8, 0x60, 0x62, 0x5b, 0x27, 0x13, 0xdb,
-	0xfa, 0x36, 0xb1, 0x2d, 0x7c, 0xd3, 0x87, 0x02, 0x9f, 0x6d, 0xf4, 0xac, 0x99, 0xdb, 0xcb, 0xa9,
-	0x60, 0xcd, 0x87, 0xbc, 0xcb, 0xab, 0xc4, 0xa7, 0xb7, 0x90, 0xfc, 0x49, 0x0f, 0xff, 0x04, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x68, 0x91, 0x92, 0x31, 0x04, 0x00, 0x00,
+	// 558 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x15, 0xf5, 0x07, 0x57, 0x24, 0xe0, 0x00, 0x29, 0x0d, 0xd4, 0x09, 0x29, 0xb4, 0x51,
+	0x50, 0x7d, 0x4a, 0x50, 0x85, 0xc4, 0xd6, 0x22, 0x1a, 0xc6, 0xe2, 0x11, 0x09, 0xa1, 0x8b, 0x7d,
+	0x75, 0x2d, 0x9c, 0x7b, 0xae, 0xef, 0x12, 0x11, 0x21, 0x06, 0x3a, 0x31, 0x56, 0x42, 0x2c, 0x4c,
+	0x1d, 0x11, 0x53, 0xff, 0x8c, 0x8e, 0x91, 0x58, 0x98, 0x00, 0x25, 0x48, 0xe5, 0xcf, 0x40, 0xb6,
+	0xcf, 0x8a, 0x53, 0x5c, 0xba, 0xd8, 0x67, 0xbf, 0xef, 0xf3, 0xf7, 0x7d, 0xef, 0x3d, 0x63, 0x33,
+	0x8c, 0xa0, 0xcf, 0x05, 0x13, 0x0e, 0xa7, 0x7b, 0x10, 0xb8, 0xb4, 0xdf, 0xa4, 0xfb, 0x3d, 0x1e,
+	0x0d, 0xac, 0x30, 0x02, 0x05, 0x84, 0x4c, 0xea, 0x56, 0x5c, 0xb7, 0xfa, 0xcd, 0xf2, 0x75, 0xd6,
+	0xf5, 0x05, 0xd0, 0xe4, 0x9a, 0xc2, 0xca, 0x0d, 0x07, 0x64, 0x17, 0x24, 0xed, 0x30, 0xc9, 0x53,
+	0x3e, 0xed, 0x37, 0x3b, 0x5c, 0xb1, 0x26, 0x0d, 0x99, 0xe7, 0x0b, 0xa6, 0x7c, 0x10, 0x1a, 0x6b,
+	0xe6, 0xb1, 0x19, 0xca, 0x01, 0x3f, 0xab, 0xdf, 0xf4, 0xc0, 0x83, 0xe4, 0x48, 0xe3, 0x93, 0x7e,
+	0x7b, 0xc7, 0x03, 0xf0, 0x02, 0x4e, 0x59, 0xe8, 0x53, 0x26, 0x04, 0xa8, 0xe4, 0x93, 0x52, 0x57,
+	0x97, 0x0b, 0x62, 0x24, 0x76, 0x93, 0x72, 0x6d, 0x03, 0x5f, 0x6d, 0x73, 0xf5, 0x0c, 0x02, 0x57,
+	0xda, 0x7c, 0xbf, 0xc7, 0xa5, 0x22, 0x25, 0x3c, 0xcf, 0x5c, 0x37, 0xe2, 0x52, 0x96, 0x50, 0x15,
+	0xd5, 0x2f, 0xdb, 0xd9, 0xe3, 0xe3, 0x85, 0x0f, 0x47, 0x15, 0xe3, 0xcf, 0x51, 0xc5, 0xa8, 0x0d,
+	0x11, 0xbe, 0x36, 0xe1, 0xc9, 0x10, 0x84, 0xe4, 0x64, 0x80, 0xe7, 0x58, 0x17, 0x7a, 0x42, 0x95,
+	0x50, 0xf5, 0x52, 0x7d, 0xb1, 0xb5, 0x64, 0xa5, 0x79, 0xac, 0x38, 0x8f, 0xa5, 0xf3, 0x58, 0x4f,
+	0xc0, 0x17, 0x5b, 0xdb, 0x27, 0x3f, 0x2a, 0xc6, 0xd7, 0x9f, 0x95, 0xba, 0xe7, 0xab, 0xbd, 0x5e,
+	0xc7, 0x72, 0xa0, 0x4b, 0x75, 0xf8, 0xf4, 0xb6, 0x2e, 0xdd, 0xd7, 0x54, 0x0d, 0x42, 0x2e, 0x13,
+	0x82, 0xfc, 0x7c, 0x7a, 0xdc, 0xb8, 0x12, 0x70, 0x8f, 0x39, 0x83, 0x57, 0x71, 0x47, 0xe4, 0x97,
+	0xd3, 0xe3, 0x06, 0xb2, 0xb5, 0x20, 0x79, 0x84, 0xe7, 0xb9, 0x50, 0x91, 0xcf, 0x65, 0x69, 0x26,
+	0xd1, 0x5e, 0xb6, 0xfe, 0x1d, 0x8f, 0x15, 0xdb, 0x7d, 0x2a, 0x54, 0x34, 0xb0, 0x33, 0x74, 0x2e,
+	0xd2, 0x2e, 0x26, 0x6d, 0xae, 0x36, 0x83, 0x60, 0xaa, 0x19, 0xdb, 0x18, 0x4f, 0xc6, 0x54, 0x72,
+	0xaa, 0xa8, 0xbe, 0xd8, 0x5a, 0x9d, 0xca, 0x95, 0xee, 0x44, 0x96, 0x6e, 0x87, 0x79, 0x5c, 0x73,
+	0xed, 0x1c, 0x33, 0xa7, 0xf3, 0x09, 0xe1, 0x1b, 0x53, 0x42, 0xba, 0x7b, 0x1b, 0x78, 0x36, 0xf6,
+	0x29, 0x75, 0xf3, 0x2a, 0x45, 0x01, 0x36, 0x1d, 0x27, 0x8e, 0x1b, 0x13, 0xed, 0x14, 0x4d, 0xda,
+	0x05, 0x06, 0xd7, 0x2e, 0x34, 0x98, 0x6a, 0xe6, 0x1d, 0xb6, 0x0e, 0x67, 0xf0, 0xec, 0xf3, 0x18,
+	0x4a, 0x0e, 0x10, 0x5e, 0xc8, 0x86, 0x4b, 0x56, 0x8a, 0x7c, 0x9c, 0x59, 0x99, 0xf2, 0xbd, 0xff,
+	0x83, 0x52, 0xb5, 0xda, 0x83, 0x83, 0x6f, 0xbf, 0x3f, 0xce, 0xdc, 0x27, 0x2b, 0xb4, 0x60, 0x27,
+	0x77, 0x7b, 0xc2, 0x95, 0xf4, 0xad, 0x5e, 0xb5, 0x77, 0xe4, 0x3d, 0xc2, 0x8b, 0xb9, 0x36, 0x91,
+	0xd5, 0x73, 0x24, 0xce, 0x0c, 0xac, 0xbc, 0x76, 0x21, 0x4e, 0xbb, 0xb9, 0x9b, 0xb8, 0xb9, 0x4d,
+	0x96, 0xce, 0x75, 0xb3, 0xf5, 0xf2, 0x64, 0x64, 0xa2, 0xe1, 0xc8, 0x44, 0xbf, 0x46, 0x26, 0x3a,
+	0x1c, 0x9b, 0xc6, 0x70, 0x6c, 0x1a, 0xdf, 0xc7, 0xa6, 0x81, 0x6f, 0xf9, 0x50, 0xa0, 0xb3, 0x83,
+	0x5e, 0x34, 0x72, 0x0b, 0x3d, 0x01, 0xac, 0xfb, 0x90, 0x57, 0x79, 0x93, 0xe8, 0x74, 0xe6, 0x92,
+	0x5f, 0xf0, 0xe1, 0xdf, 0x01, 0x00, 0x86, 0x04, 0x5e, 0x8b, 0x6a, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -422,6 +426,20 @@ func (m *GetHoldsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Amount) > 0 {
 		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -563,6 +581,12 @@ func (m *GetHoldsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -749,6 +773,40 @@ func (m *GetHoldsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &HoldEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7,12 +7,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 )
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
 // Value to the corresponding group type.
-func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
 		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldCoin):
@@ -21,6 +22,22 @@ func NewDecodeStore(_ codec.Codec) func(kvA, kvB kv.Pair) string {
 			valBMsg := holdCoinValueMsg(kvB.Value)
 			return fmt.Sprintf("<HoldCoin><%s><%s>: A = %s, B = %s\n", addr, denom, valAMsg, valBMsg)
 
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntry):
+			holdID, _ := keeper.ParseHoldEntryKey(kvA.Key)
+			valAMsg := holdEntryValueMsg(cdc, kvA.Value)
+			valBMsg := holdEntryValueMsg(cdc, kvB.Value)
+			return fmt.Sprintf("<HoldEntry><%d>: A = %s, B = %s\n", holdID, valAMsg, valBMsg)
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntryAddrIndex):
+			return fmt.Sprintf("<HoldEntryAddrIndex><%X>: A = %s, B = %s\n", kvA.Key[1:], holdIDValueMsg(kvA.Value), holdIDValueMsg(kvB.Value))
+
+		case bytes.HasPrefix(kvA.Key, keeper.KeyPrefixHoldEntryExpirationIndex):
+			expiration, holdID, _ := keeper.ParseHoldEntryExpirationIndexKeyUnprefixed(kvA.Key[1:])
+			return fmt.Sprintf("<HoldEntryExpirationIndex><%d><%d>: A = %v, B = %v\n", expiration, holdID, kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key, keeper.KeyLastHoldID):
+			return fmt.Sprintf("<LastHoldID>: A = %s, B = %s\n", holdIDValueMsg(kvA.Value), holdIDValueMsg(kvB.Value))
+
 		default:
 			panic(fmt.Sprintf("invalid hold key %X", kvA.Key))
 		}
@@ -35,3 +52,21 @@ func holdCoinValueMsg(value []byte) string {
 	}
 	return `"` + val.String() + `"`
 }
+
+// holdEntryValueMsg converts the given bytes into a hold entry string.
+func holdEntryValueMsg(cdc codec.Codec, value []byte) string {
+	var entry hold.HoldEntry
+	if err := cdc.Unmarshal(value, &entry); err != nil {
+		return fmt.Sprintf("<invalid>: %v", value)
+	}
+	return entry.String()
+}
+
+// holdIDValueMsg converts the given bytes into a hold id string.
+func holdIDValueMsg(value []byte) string {
+	holdID, err := keeper.UnmarshalHoldID(value)
+	if err != nil {
+		return fmt.Sprintf("<invalid>: %v", value)
+	}
+	return fmt.Sprintf("%d", holdID)
+}
@@ -1,15 +1,19 @@
 package simulation_test
 
 import (
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/app"
 	"github.com/provenance-io/provenance/testutil/assertions"
+	"github.com/provenance-io/provenance/x/hold"
 	"github.com/provenance-io/provenance/x/hold/keeper"
 	"github.com/provenance-io/provenance/x/hold/simulation"
 )
@@ -20,6 +24,13 @@ func TestDecodeStore(t *testing.T) {
 
 	addr0 := sdk.AccAddress("addr0_______________")
 	addr1 := sdk.AccAddress("addr1_______________")
+	entryA := &hold.HoldEntry{HoldId: 3, Address: addr0.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 99)), Holder: "exchange", Reason: "a"}
+	entryB := &hold.HoldEntry{HoldId: 3, Address: addr0.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("banana", 12)), Holder: "exchange", Reason: "a"}
+	entryABz, err := cdc.Marshal(entryA)
+	require.NoError(t, err, "Marshal(entryA)")
+	entryBBz, err := cdc.Marshal(entryB)
+	require.NoError(t, err, "Marshal(entryB)")
+	expiration := time.Unix(1700000000, 0)
 
 	tests := []struct {
 		name     string
@@ -34,6 +45,36 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: keeper.CreateHoldCoinKey(addr1, "cherry"), Value: []byte("123")},
 			exp:  "<HoldCoin><" + addr0.String() + "><banana>: A = \"99\", B = \"123\"\n",
 		},
+		{
+			name: "HoldEntry",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: entryABz},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: entryBBz},
+			exp:  "<HoldEntry><3>: A = " + entryA.String() + ", B = " + entryB.String() + "\n",
+		},
+		{
+			name: "HoldEntry invalid value",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: []byte{0x9a}},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryKey(3), Value: entryBBz},
+			exp:  "<HoldEntry><3>: A = <invalid>: [154], B = " + entryB.String() + "\n",
+		},
+		{
+			name: "HoldEntryAddrIndex",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryAddrIndexKey(addr0, "ex", "a"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 3}},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryAddrIndexKey(addr0, "ex", "a"), Value: []byte{0, 0, 0, 0, 0, 0, 0, 4}},
+			exp:  fmt.Sprintf("<HoldEntryAddrIndex><%X>: A = 3, B = 4\n", keeper.CreateHoldEntryAddrIndexKey(addr0, "ex", "a")[1:]),
+		},
+		{
+			name: "HoldEntryExpirationIndex",
+			kvA:  kv.Pair{Key: keeper.CreateHoldEntryExpirationIndexKey(expiration, 3), Value: []byte{}},
+			kvB:  kv.Pair{Key: keeper.CreateHoldEntryExpirationIndexKey(expiration, 3), Value: []byte{}},
+			exp:  "<HoldEntryExpirationIndex><1700000000><3>: A = [], B = []\n",
+		},
+		{
+			name: "LastHoldID",
+			kvA:  kv.Pair{Key: keeper.KeyLastHoldID, Value: []byte{0, 0, 0, 0, 0, 0, 0, 5}},
+			kvB:  kv.Pair{Key: keeper.KeyLastHoldID, Value: []byte{1}},
+			exp:  "<LastHoldID>: A = 5, B = <invalid>: [1]\n",
+		},
 		{
 			name:     "unknown",
 			kvA:      kv.Pair{Key: []byte{0x9a}, Value: []byte{0x9b}},
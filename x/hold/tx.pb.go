@@ -0,0 +1,663 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: provenance/hold/v1/tx.proto
+
+package hold
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgReleaseHoldRequest is a request message for the ReleaseHold endpoint.
+type MsgReleaseHoldRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the bech32 address string of the account with the funds on hold.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the funds to release from hold.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgReleaseHoldRequest) Reset()         { *m = MsgReleaseHoldRequest{} }
+func (m *MsgReleaseHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHoldRequest) ProtoMessage()    {}
+func (*MsgReleaseHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9db16d4ea14d3f9, []int{0}
+}
+func (m *MsgReleaseHoldRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHoldRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHoldRequest.Merge(m, src)
+}
+func (m *MsgReleaseHoldRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHoldRequest proto.InternalMessageInfo
+
+func (m *MsgReleaseHoldRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgReleaseHoldRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgReleaseHoldRequest) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgReleaseHoldResponse is a response message for the ReleaseHold endpoint.
+type MsgReleaseHoldResponse struct {
+}
+
+func (m *MsgReleaseHoldResponse) Reset()         { *m = MsgReleaseHoldResponse{} }
+func (m *MsgReleaseHoldResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHoldResponse) ProtoMessage()    {}
+func (*MsgReleaseHoldResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e9db16d4ea14d3f9, []int{1}
+}
+func (m *MsgReleaseHoldResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHoldResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHoldResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHoldResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHoldResponse.Merge(m, src)
+}
+func (m *MsgReleaseHoldResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHoldResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHoldResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHoldResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgReleaseHoldRequest)(nil), "provenance.hold.v1.MsgReleaseHoldRequest")
+	proto.RegisterType((*MsgReleaseHoldResponse)(nil), "provenance.hold.v1.MsgReleaseHoldResponse")
+}
+
+func init() { proto.RegisterFile("provenance/hold/v1/tx.proto", fileDescriptor_e9db16d4ea14d3f9) }
+
+var fileDescriptor_e9db16d4ea14d3f9 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0x5d, 0xac, 0x74, 0x2a, 0x82, 0xa1, 0xd5, 0x74, 0x85, 0xb4, 0xf4, 0xb4, 0x06,
+	0x76, 0x86, 0x5d, 0xc1, 0x83, 0x37, 0x57, 0x10, 0x2f, 0x05, 0x59, 0x6f, 0x82, 0x94, 0x49, 0x32,
+	0xcc, 0x0e, 0x26, 0xf3, 0xc6, 0xbc, 0x93, 0xd0, 0xdc, 0xc4, 0x0f, 0x20, 0x9e, 0xfd, 0x04, 0xe2,
+	0x69, 0x0f, 0x7e, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0xbb, 0x87, 0xfd, 0x1a, 0x92, 0x64, 0x24,
+	0x8b, 0x2e, 0xd8, 0x4b, 0x92, 0x77, 0x7e, 0xcf, 0xfb, 0x27, 0xcf, 0xbc, 0xe4, 0x7e, 0x96, 0x43,
+	0x29, 0x34, 0xd7, 0x91, 0x60, 0x73, 0x48, 0x62, 0x56, 0x8e, 0x99, 0xb9, 0xa0, 0x59, 0x0e, 0x06,
+	0x5c, 0xb7, 0x83, 0xb4, 0x86, 0xb4, 0x1c, 0x0f, 0xee, 0xf0, 0x54, 0x69, 0x60, 0xcd, 0xb3, 0x95,
+	0x0d, 0xfc, 0x08, 0x30, 0x05, 0x64, 0x21, 0x47, 0xc1, 0xca, 0x71, 0x28, 0x0c, 0x1f, 0xb3, 0x08,
+	0x94, 0xb6, 0xfc, 0x9e, 0xe5, 0x29, 0xca, 0xba, 0x7c, 0x8a, 0xd2, 0x82, 0xa3, 0x16, 0x9c, 0x37,
+	0x11, 0x6b, 0x03, 0x8b, 0x0e, 0x24, 0x48, 0x68, 0xcf, 0xeb, 0xaf, 0xf6, 0xf4, 0xf4, 0xc3, 0x0e,
+	0x39, 0x3c, 0x43, 0x39, 0x13, 0x89, 0xe0, 0x28, 0x9e, 0x43, 0x12, 0xcf, 0xc4, 0xdb, 0x42, 0xa0,
+	0x71, 0x1f, 0x91, 0x3d, 0x5e, 0x98, 0x39, 0xe4, 0xca, 0x54, 0x9e, 0x73, 0xe2, 0x0c, 0xf7, 0xa6,
+	0xde, 0xb7, 0xaf, 0xa3, 0x03, 0x5b, 0xf4, 0x49, 0x1c, 0xe7, 0x02, 0xf1, 0xa5, 0xc9, 0x95, 0x96,
+	0xb3, 0x4e, 0xea, 0x4e, 0xc8, 0x4d, 0xde, 0x32, 0x6f, 0xe7, 0x3f, 0x59, 0x7f, 0x84, 0x6e, 0x45,
+	0x76, 0x79, 0x0a, 0x85, 0x36, 0x5e, 0xff, 0xa4, 0x3f, 0xdc, 0x9f, 0x1c, 0x51, 0xab, 0xaf, 0x0d,
+	0xa0, 0xd6, 0x00, 0xfa, 0x14, 0x94, 0x9e, 0x3e, 0xbb, 0xfc, 0x71, 0xdc, 0xfb, 0xf2, 0xf3, 0x78,
+	0x28, 0x95, 0x99, 0x17, 0x21, 0x8d, 0x20, 0xb5, 0xff, 0x69, 0x5f, 0x23, 0x8c, 0xdf, 0x30, 0x53,
+	0x65, 0x02, 0x9b, 0x04, 0xfc, 0xb4, 0x5e, 0x04, 0xb7, 0x12, 0x21, 0x79, 0x54, 0x9d, 0xd7, 0x16,
+	0xe2, 0xe7, 0xf5, 0x22, 0x70, 0x66, 0xb6, 0xe1, 0xe3, 0xdb, 0xef, 0xd7, 0x8b, 0xa0, 0x1b, 0xff,
+	0xd4, 0x23, 0x77, 0xff, 0xf6, 0x03, 0x33, 0xd0, 0x28, 0x26, 0x39, 0xe9, 0x9f, 0xa1, 0x74, 0x63,
+	0xb2, 0xbf, 0x41, 0xdd, 0x07, 0xf4, 0xdf, 0x2b, 0xa5, 0x5b, 0x1d, 0x1d, 0x04, 0xd7, 0x91, 0xb6,
+	0xcd, 0x06, 0x37, 0xde, 0xd5, 0x53, 0x4e, 0x5f, 0x5f, 0x2e, 0x7d, 0xe7, 0x6a, 0xe9, 0x3b, 0xbf,
+	0x96, 0xbe, 0xf3, 0x71, 0xe5, 0xf7, 0xae, 0x56, 0x7e, 0xef, 0xfb, 0xca, 0xef, 0x91, 0x43, 0x05,
+	0x5b, 0xca, 0xbd, 0x70, 0x5e, 0x05, 0x1b, 0xc6, 0x74, 0x82, 0x91, 0x82, 0x8d, 0x88, 0x5d, 0x34,
+	0xab, 0x19, 0xee, 0x36, 0x4b, 0xf0, 0xf0, 0xf7, 0x00, 0x4a, 0xa5, 0xd6, 0xf3, 0xb4, 0x02, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ReleaseHold is a governance proposal endpoint for releasing funds that are on hold in an account.
+	// It is intended for use when funds are stuck on hold and the module that placed them there cannot release them.
+	ReleaseHold(ctx context.Context, in *MsgReleaseHoldRequest, opts ...grpc.CallOption) (*MsgReleaseHoldResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ReleaseHold(ctx context.Context, in *MsgReleaseHoldRequest, opts ...grpc.CallOption) (*MsgReleaseHoldResponse, error) {
+	out := new(MsgReleaseHoldResponse)
+	err := c.cc.Invoke(ctx, "/provenance.hold.v1.Msg/ReleaseHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ReleaseHold is a governance proposal endpoint for releasing funds that are on hold in an account.
+	// It is intended for use when funds are stuck on hold and the module that placed them there cannot release them.
+	ReleaseHold(context.Context, *MsgReleaseHoldRequest) (*MsgReleaseHoldResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ReleaseHold(ctx context.Context, req *MsgReleaseHoldRequest) (*MsgReleaseHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHold not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.hold.v1.Msg/ReleaseHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseHold(ctx, req.(*MsgReleaseHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.hold.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _Msg_ReleaseHold_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/hold/v1/tx.proto",
+}
+
+func (m *MsgReleaseHoldRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHoldRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHoldRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseHoldResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHoldResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHoldResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgReleaseHoldRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgReleaseHoldResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgReleaseHoldRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseHoldRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseHoldRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseHoldResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseHoldResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseHoldResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -42,6 +42,7 @@ type TestSuite struct {
 	accountAddresses []sdk.AccAddress
 
 	ratelimiter string
+	rules       []ibcratelimit.RateLimitRule
 }
 
 func TestIntegrationTestSuite(t *testing.T) {
@@ -90,6 +91,11 @@ func (s *TestSuite) SetupSuite() {
 
 	s.ratelimiter = "cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma"
 	ratelimitData := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(s.ratelimiter))
+	s.rules = []ibcratelimit.RateLimitRule{
+		ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600),
+		ibcratelimit.NewRateLimitRule("channel-1", "nhash", 0, 20, 60),
+	}
+	ratelimitData.Rules = s.rules
 
 	ratelimitDataBz, err := s.cfg.Codec.MarshalJSON(ratelimitData)
 	s.Require().NoError(err, "should be able to marshal ibcratelimit genesis state when setting up suite")
@@ -194,3 +200,181 @@ func (s *TestSuite) TestParamsUpdate() {
 		})
 	}
 }
+
+func (s *TestSuite) TestGetRateLimitRules() {
+	clientCtx := s.network.Validators[0].ClientCtx
+	cmd := ibcratelimitcli.GetRateLimitRulesCmd()
+	args := []string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+	outBz := out.Bytes()
+	s.T().Logf("ExecTestCLICmd %q %q\nOutput:\n%s", cmd.Name(), args, string(outBz))
+	s.Require().NoError(err, "should have no error message for valid RateLimitRules request")
+
+	var response ibcratelimit.RateLimitRulesResponse
+	err = s.cfg.Codec.UnmarshalJSON(outBz, &response)
+	s.Require().NoError(err, "should have no error message when unmarshalling response to RateLimitRules request")
+	s.Equal(s.rules, response.Rules, "should have the correct rules")
+}
+
+func (s *TestSuite) TestGetRateLimitFlows() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expChannels  []string
+	}{
+		{
+			name:        "success - all flows",
+			expChannels: []string{"channel-0", "channel-1"},
+		},
+		{
+			name:        "success - by channel",
+			args:        []string{"channel-1"},
+			expChannels: []string{"channel-1"},
+		},
+		{
+			name:        "success - by channel and denom",
+			args:        []string{"channel-0", "nhash"},
+			expChannels: []string{"channel-0"},
+		},
+		{
+			name: "success - no matches",
+			args: []string{"channel-0", "other"},
+		},
+		{
+			name:         "failure - too many args",
+			args:         []string{"channel-0", "nhash", "extra"},
+			expectErrMsg: "accepts at most 2 arg(s), received 3",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			clientCtx := s.network.Validators[0].ClientCtx
+			cmd := ibcratelimitcli.GetRateLimitFlowsCmd()
+			args := append(tc.args, fmt.Sprintf("--%s=json", cmtcli.OutputFlag))
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
+			outBz := out.Bytes()
+			s.T().Logf("ExecTestCLICmd %q %q\nOutput:\n%s", cmd.Name(), args, string(outBz))
+
+			if len(tc.expectErrMsg) > 0 {
+				s.EqualError(err, tc.expectErrMsg, "should have correct error message for invalid RateLimitFlows request")
+				return
+			}
+			s.Require().NoError(err, "should have no error message for valid RateLimitFlows request")
+			var response ibcratelimit.RateLimitFlowsResponse
+			err = s.cfg.Codec.UnmarshalJSON(outBz, &response)
+			s.Require().NoError(err, "should have no error message when unmarshalling response to RateLimitFlows request")
+			var channels []string
+			for _, flow := range response.Flows {
+				channels = append(channels, flow.ChannelId)
+				s.Equal("nhash", flow.Denom, "flow denom")
+			}
+			s.Equal(tc.expChannels, channels, "should have flows for the correct channels")
+		})
+	}
+}
+
+func (s *TestSuite) TestSetRateLimitRule() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - rule proposed",
+			args:         []string{"channel-2", "nhash", "5", "10", "86400"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid number of args",
+			args:         []string{"channel-2", "nhash", "5", "10"},
+			expectErrMsg: "accepts 5 arg(s), received 4",
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid max percent send",
+			args:         []string{"channel-2", "nhash", "x", "10", "86400"},
+			expectErrMsg: `invalid max percent send "x": strconv.ParseUint: parsing "x": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid max percent recv",
+			args:         []string{"channel-2", "nhash", "5", "ten", "86400"},
+			expectErrMsg: `invalid max percent recv "ten": strconv.ParseUint: parsing "ten": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid window",
+			args:         []string{"channel-2", "nhash", "5", "10", "week"},
+			expectErrMsg: `invalid window seconds "week": strconv.ParseUint: parsing "week": invalid syntax`,
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := ibcratelimitcli.GetCmdSetRateLimitRule()
+			tc.args = append(tc.args,
+				"--title", "Set ibc-rate-limit rule", "--summary", "See title.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
+
+func (s *TestSuite) TestRemoveRateLimitRule() {
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErrMsg string
+		expectedCode uint32
+		signer       string
+	}{
+		{
+			name:         "success - removal proposed",
+			args:         []string{"channel-0", "nhash"},
+			expectedCode: 0,
+			signer:       s.accountAddresses[0].String(),
+		},
+		{
+			name:         "failure - invalid number of args",
+			args:         []string{"channel-0"},
+			expectErrMsg: "accepts 2 arg(s), received 1",
+			signer:       s.accountAddresses[0].String(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := ibcratelimitcli.GetCmdRemoveRateLimitRule()
+			tc.args = append(tc.args,
+				"--title", "Remove ibc-rate-limit rule", "--summary", "See title.",
+				fmt.Sprintf("--%s=%s", flags.FlagFrom, tc.signer),
+				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+				fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewInt64Coin(s.cfg.BondDenom, 10)).String()),
+				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
+			)
+
+			testcli.NewTxExecutor(cmd, tc.args).
+				WithExpErrMsg(tc.expectErrMsg).
+				WithExpCode(tc.expectedCode).
+				Execute(s.T(), s.network)
+		})
+	}
+}
@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	queryCmd.AddCommand(
 		GetParamsCmd(),
+		GetRateLimitRulesCmd(),
+		GetRateLimitFlowsCmd(),
 	)
 
 	return queryCmd
@@ -58,3 +60,69 @@ func GetParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// GetRateLimitRulesCmd returns the command handler for querying the native rate limit rules.
+func GetRateLimitRulesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rules",
+		Short:   "Query the native rate limit rules",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf(`$ %s query ibcratelimit rules`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimitRules(context.Background(), &ibcratelimit.RateLimitRulesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRateLimitFlowsCmd returns the command handler for querying the current flows of the native rate limit rules.
+func GetRateLimitFlowsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flows [channel id] [denom]",
+		Short: "Query the current flow usage of the native rate limit rules",
+		Args:  cobra.MaximumNArgs(2),
+		Example: fmt.Sprintf(`$ %[1]s query ibcratelimit flows
+$ %[1]s query ibcratelimit flows channel-0
+$ %[1]s query ibcratelimit flows channel-0 nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &ibcratelimit.RateLimitFlowsRequest{}
+			if len(args) > 0 {
+				req.ChannelId = args[0]
+			}
+			if len(args) > 1 {
+				req.Denom = args[1]
+			}
+
+			queryClient := ibcratelimit.NewQueryClient(clientCtx)
+			res, err := queryClient.RateLimitFlows(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
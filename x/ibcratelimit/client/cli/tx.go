@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		GetCmdParamsUpdate(),
+		GetCmdSetRateLimitRule(),
+		GetCmdRemoveRateLimitRule(),
 	)
 
 	return txCmd
@@ -60,3 +63,75 @@ func GetCmdParamsUpdate() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdSetRateLimitRule is a command to create or update a native rate limit rule.
+func GetCmdSetRateLimitRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-rule <channel id> <denom> <max percent send> <max percent recv> <window seconds>",
+		Short:   "Create or update a native rate limit rule",
+		Long:    "Submit a native rate limit rule via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(5),
+		Aliases: []string{"set-rate-limit-rule"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc set-rule channel-0 nhash 5 10 86400 --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxSend, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max percent send %q: %w", args[2], err)
+			}
+			maxRecv, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid max percent recv %q: %w", args[3], err)
+			}
+			window, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid window seconds %q: %w", args[4], err)
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			rule := ibcratelimit.NewRateLimitRule(args[0], args[1], uint32(maxSend), uint32(maxRecv), window)
+			msg := ibcratelimit.NewMsgSetRateLimitRuleRequest(authority, rule)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdRemoveRateLimitRule is a command to remove a native rate limit rule.
+func GetCmdRemoveRateLimitRule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-rule <channel id> <denom>",
+		Short:   "Remove a native rate limit rule",
+		Long:    "Submit the removal of a native rate limit rule via governance proposal along with an initial deposit.",
+		Args:    cobra.ExactArgs(2),
+		Aliases: []string{"remove-rate-limit-rule"},
+		Example: fmt.Sprintf(`%[1]s tx ratelimitedibc remove-rule channel-0 nhash --deposit 50000nhash`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+			msg := ibcratelimit.NewMsgRemoveRateLimitRuleRequest(authority, args[0], args[1])
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
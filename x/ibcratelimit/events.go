@@ -21,3 +21,19 @@ func NewEventTimeoutRevertFailure(module, packet string) *EventTimeoutRevertFail
 func NewEventParamsUpdated() *EventParamsUpdated {
 	return &EventParamsUpdated{}
 }
+
+// NewEventRateLimitRuleUpdated returns a new EventRateLimitRuleUpdated.
+func NewEventRateLimitRuleUpdated(channelID, denom string) *EventRateLimitRuleUpdated {
+	return &EventRateLimitRuleUpdated{
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// NewEventRateLimitRuleRemoved returns a new EventRateLimitRuleRemoved.
+func NewEventRateLimitRuleRemoved(channelID, denom string) *EventRateLimitRuleRemoved {
+	return &EventRateLimitRuleRemoved{
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
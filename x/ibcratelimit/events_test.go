@@ -31,3 +31,21 @@ func TestNewEventParamsUpdated(t *testing.T) {
 	event := ibcratelimit.NewEventParamsUpdated()
 	assert.Equal(t, expected, event, "should create the correct event type")
 }
+
+func TestNewEventRateLimitRuleUpdated(t *testing.T) {
+	expected := &ibcratelimit.EventRateLimitRuleUpdated{
+		ChannelId: "channel-0",
+		Denom:     "nhash",
+	}
+	event := ibcratelimit.NewEventRateLimitRuleUpdated(expected.ChannelId, expected.Denom)
+	assert.Equal(t, expected, event, "should create the correct event type")
+}
+
+func TestNewEventRateLimitRuleRemoved(t *testing.T) {
+	expected := &ibcratelimit.EventRateLimitRuleRemoved{
+		ChannelId: "channel-0",
+		Denom:     "nhash",
+	}
+	event := ibcratelimit.NewEventRateLimitRuleRemoved(expected.ChannelId, expected.Denom)
+	assert.Equal(t, expected, event, "should create the correct event type")
+}
@@ -1,7 +1,15 @@
 package ibcratelimit
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type PermissionedKeeper interface {
 	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 }
+
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
@@ -1,5 +1,7 @@
 package ibcratelimit
 
+import "fmt"
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,7 +12,38 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	rules := make(map[string]bool, len(gs.Rules))
+	for i, rule := range gs.Rules {
+		if err := rule.Validate(); err != nil {
+			return fmt.Errorf("invalid rules[%d]: %w", i, err)
+		}
+		path := rule.ChannelId + " " + rule.Denom
+		if rules[path] {
+			return fmt.Errorf("invalid rules[%d]: duplicate rule for channel %q denom %q", i, rule.ChannelId, rule.Denom)
+		}
+		rules[path] = true
+	}
+
+	flows := make(map[string]bool, len(gs.Flows))
+	for i, flow := range gs.Flows {
+		if err := flow.Validate(); err != nil {
+			return fmt.Errorf("invalid flows[%d]: %w", i, err)
+		}
+		path := flow.ChannelId + " " + flow.Denom
+		if !rules[path] {
+			return fmt.Errorf("invalid flows[%d]: no rule for channel %q denom %q", i, flow.ChannelId, flow.Denom)
+		}
+		if flows[path] {
+			return fmt.Errorf("invalid flows[%d]: duplicate flow for channel %q denom %q", i, flow.ChannelId, flow.Denom)
+		}
+		flows[path] = true
+	}
+
+	return nil
 }
 
 // NewGenesisState returns a new instance of GenesisState object
@@ -2,6 +2,9 @@ package ibcratelimit_test
 
 import (
 	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +52,74 @@ func TestGenesisValidate(t *testing.T) {
 	}
 }
 
+func TestGenesisValidateRateLimits(t *testing.T) {
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	otherRule := ibcratelimit.NewRateLimitRule("channel-1", "nhash", 5, 10, 3600)
+	flow := ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(1000), time.Unix(1700000000, 0))
+	otherFlow := ibcratelimit.NewRateLimitFlow(otherRule, sdkmath.NewInt(1000), time.Unix(1700000000, 0))
+	badFlow := flow
+	badFlow.Inflow = sdkmath.NewInt(-1)
+
+	testCases := []struct {
+		name  string
+		rules []ibcratelimit.RateLimitRule
+		flows []ibcratelimit.RateLimitFlow
+		err   string
+	}{
+		{
+			name: "success - no rules or flows",
+		},
+		{
+			name:  "success - rules and flows",
+			rules: []ibcratelimit.RateLimitRule{rule, otherRule},
+			flows: []ibcratelimit.RateLimitFlow{flow},
+		},
+		{
+			name:  "failure - invalid rule",
+			rules: []ibcratelimit.RateLimitRule{rule, ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 0)},
+			err:   "invalid rules[1]: invalid window seconds: cannot be zero",
+		},
+		{
+			name:  "failure - duplicate rule",
+			rules: []ibcratelimit.RateLimitRule{rule, otherRule, rule},
+			err:   `invalid rules[2]: duplicate rule for channel "channel-0" denom "nhash"`,
+		},
+		{
+			name:  "failure - invalid flow",
+			rules: []ibcratelimit.RateLimitRule{rule},
+			flows: []ibcratelimit.RateLimitFlow{badFlow},
+			err:   `invalid flows[0]: invalid inflow "-1": cannot be nil or negative`,
+		},
+		{
+			name:  "failure - flow without rule",
+			rules: []ibcratelimit.RateLimitRule{rule},
+			flows: []ibcratelimit.RateLimitFlow{flow, otherFlow},
+			err:   `invalid flows[1]: no rule for channel "channel-1" denom "nhash"`,
+		},
+		{
+			name:  "failure - duplicate flow",
+			rules: []ibcratelimit.RateLimitRule{rule},
+			flows: []ibcratelimit.RateLimitFlow{flow, flow},
+			err:   `invalid flows[1]: duplicate flow for channel "channel-0" denom "nhash"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genesis := ibcratelimit.NewGenesisState(ibcratelimit.NewParams(""))
+			genesis.Rules = tc.rules
+			genesis.Flows = tc.flows
+			err := genesis.Validate()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should have the correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
+
 func TestNewGenesisState(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -13,8 +13,20 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *ibcratelimit.GenesisState {
 		panic(err)
 	}
 
+	rules, err := k.GetAllRateLimitRules(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	flows, err := k.GetAllRateLimitFlows(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &ibcratelimit.GenesisState{
 		Params: params,
+		Rules:  rules,
+		Flows:  flows,
 	}
 }
 
@@ -24,4 +36,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *ibcratelimit.GenesisState) {
 		panic(err)
 	}
 	k.SetParams(ctx, data.Params)
+	for _, rule := range data.Rules {
+		k.SetRateLimitRule(ctx, rule)
+	}
+	for _, flow := range data.Flows {
+		k.SetRateLimitFlow(ctx, flow)
+	}
 }
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
@@ -17,3 +21,20 @@ func (s *TestSuite) TestInitExportGenesis() {
 	exportedGenesis := k.ExportGenesis(s.ctx)
 	s.Assert().Equal(initialGenesis, exportedGenesis)
 }
+
+func (s *TestSuite) TestInitExportGenesisRateLimits() {
+	k := s.app.RateLimitingKeeper
+	rule1 := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	rule2 := ibcratelimit.NewRateLimitRule("channel-1", "nhash", 0, 20, 60)
+	flow := ibcratelimit.NewRateLimitFlow(rule1, sdkmath.NewInt(1000), time.Unix(1700000000, 0))
+	flow.Inflow = sdkmath.NewInt(3)
+	flow.Outflow = sdkmath.NewInt(12)
+
+	initialGenesis := ibcratelimit.NewGenesisState(ibcratelimit.DefaultParams())
+	initialGenesis.Rules = []ibcratelimit.RateLimitRule{rule1, rule2}
+	initialGenesis.Flows = []ibcratelimit.RateLimitFlow{flow}
+
+	k.InitGenesis(s.ctx, initialGenesis)
+	exportedGenesis := k.ExportGenesis(s.ctx)
+	s.Assert().Equal(initialGenesis, exportedGenesis)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -21,3 +22,49 @@ func (k Keeper) Params(ctx context.Context, _ *ibcratelimit.ParamsRequest) (*ibc
 
 	return &ibcratelimit.ParamsResponse{Params: params}, nil
 }
+
+// RateLimitRules returns all of the native rate limit rules.
+func (k Keeper) RateLimitRules(ctx context.Context, _ *ibcratelimit.RateLimitRulesRequest) (*ibcratelimit.RateLimitRulesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	rules, err := k.GetAllRateLimitRules(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibcratelimit.RateLimitRulesResponse{Rules: rules}, nil
+}
+
+// RateLimitFlows returns the current flow usage of each native rate limit rule.
+func (k Keeper) RateLimitFlows(ctx context.Context, req *ibcratelimit.RateLimitFlowsRequest) (*ibcratelimit.RateLimitFlowsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req == nil {
+		req = &ibcratelimit.RateLimitFlowsRequest{}
+	}
+
+	var flows []ibcratelimit.RateLimitFlow
+	var errs []error
+	err := k.IterateRateLimitRules(sdkCtx, func(rule ibcratelimit.RateLimitRule) bool {
+		if len(req.ChannelId) > 0 && req.ChannelId != rule.ChannelId {
+			return false
+		}
+		if len(req.Denom) > 0 && req.Denom != rule.Denom {
+			return false
+		}
+		flow, err := k.GetCurrentRateLimitFlow(sdkCtx, rule)
+		if err != nil {
+			errs = append(errs, err)
+			return false
+		}
+		flows = append(flows, flow)
+		return false
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return &ibcratelimit.RateLimitFlowsResponse{Flows: flows}, nil
+}
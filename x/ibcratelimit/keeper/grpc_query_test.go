@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
 
@@ -42,3 +44,74 @@ func (s *TestSuite) TestQueryParams() {
 		})
 	}
 }
+
+func (s *TestSuite) TestQueryRateLimitRules() {
+	response, err := s.queryClient.RateLimitRules(s.ctx, &ibcratelimit.RateLimitRulesRequest{})
+	s.Assert().NoError(err, "should not throw an error")
+	s.Assert().Empty(response.Rules, "should not have rules before any are set")
+
+	rule1 := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	rule2 := ibcratelimit.NewRateLimitRule("channel-1", "nhash", 0, 20, 60)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule1)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule2)
+
+	response, err = s.queryClient.RateLimitRules(s.ctx, &ibcratelimit.RateLimitRulesRequest{})
+	s.Assert().NoError(err, "should not throw an error")
+	s.Assert().Equal([]ibcratelimit.RateLimitRule{rule1, rule2}, response.Rules, "should return the rules")
+}
+
+func (s *TestSuite) TestQueryRateLimitFlows() {
+	now := s.ctx.BlockTime()
+	rule1 := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	rule2 := ibcratelimit.NewRateLimitRule("channel-1", "nhash", 0, 20, 60)
+	rule3 := ibcratelimit.NewRateLimitRule("channel-1", "other", 5, 0, 60)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule1)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule2)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule3)
+
+	flow1 := ibcratelimit.NewRateLimitFlow(rule1, sdkmath.NewInt(1000), now)
+	flow1.Outflow = sdkmath.NewInt(7)
+	s.app.RateLimitingKeeper.SetRateLimitFlow(s.ctx, flow1)
+	supply := s.app.BankKeeper.GetSupply(s.ctx, "nhash").Amount
+	flow2 := ibcratelimit.NewRateLimitFlow(rule2, supply, now)
+	flow3 := ibcratelimit.NewRateLimitFlow(rule3, sdkmath.ZeroInt(), now)
+
+	tests := []struct {
+		name     string
+		req      *ibcratelimit.RateLimitFlowsRequest
+		expected []ibcratelimit.RateLimitFlow
+	}{
+		{
+			name:     "success - all flows",
+			req:      &ibcratelimit.RateLimitFlowsRequest{},
+			expected: []ibcratelimit.RateLimitFlow{flow1, flow2, flow3},
+		},
+		{
+			name:     "success - by channel",
+			req:      &ibcratelimit.RateLimitFlowsRequest{ChannelId: "channel-1"},
+			expected: []ibcratelimit.RateLimitFlow{flow2, flow3},
+		},
+		{
+			name:     "success - by denom",
+			req:      &ibcratelimit.RateLimitFlowsRequest{Denom: "nhash"},
+			expected: []ibcratelimit.RateLimitFlow{flow1, flow2},
+		},
+		{
+			name:     "success - by channel and denom",
+			req:      &ibcratelimit.RateLimitFlowsRequest{ChannelId: "channel-1", Denom: "other"},
+			expected: []ibcratelimit.RateLimitFlow{flow3},
+		},
+		{
+			name: "success - no matches",
+			req:  &ibcratelimit.RateLimitFlowsRequest{ChannelId: "channel-2"},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			response, err := s.queryClient.RateLimitFlows(s.ctx, tc.req)
+			s.Assert().NoError(err, "should not throw an error")
+			s.Assert().Equal(tc.expected, response.Flows, "should return correct flows")
+		})
+	}
+}
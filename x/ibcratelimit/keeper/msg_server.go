@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/provenance-io/provenance/x/ibcratelimit"
 )
@@ -41,3 +42,31 @@ func (k MsgServer) UpdateParams(goCtx context.Context, msg *ibcratelimit.MsgUpda
 
 	return &ibcratelimit.MsgUpdateParamsResponse{}, nil
 }
+
+// SetRateLimitRule is a governance proposal endpoint for creating or updating a native rate limit rule.
+func (k MsgServer) SetRateLimitRule(goCtx context.Context, msg *ibcratelimit.MsgSetRateLimitRuleRequest) (*ibcratelimit.MsgSetRateLimitRuleResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetRateLimitRule(ctx, msg.Rule)
+	k.emitEvent(ctx, ibcratelimit.NewEventRateLimitRuleUpdated(msg.Rule.ChannelId, msg.Rule.Denom))
+
+	return &ibcratelimit.MsgSetRateLimitRuleResponse{}, nil
+}
+
+// RemoveRateLimitRule is a governance proposal endpoint for removing a native rate limit rule.
+func (k MsgServer) RemoveRateLimitRule(goCtx context.Context, msg *ibcratelimit.MsgRemoveRateLimitRuleRequest) (*ibcratelimit.MsgRemoveRateLimitRuleResponse, error) {
+	if err := k.ValidateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.RemoveRateLimitRule(ctx, msg.ChannelId, msg.Denom); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	k.emitEvent(ctx, ibcratelimit.NewEventRateLimitRuleRemoved(msg.ChannelId, msg.Denom))
+
+	return &ibcratelimit.MsgRemoveRateLimitRuleResponse{}, nil
+}
@@ -62,6 +62,117 @@ func (s *TestSuite) TestUpdateParams() {
 	}
 }
 
+func (s *TestSuite) TestSetRateLimitRule() {
+	authority := s.app.RateLimitingKeeper.GetAuthority()
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+
+	tests := []struct {
+		name  string
+		req   *ibcratelimit.MsgSetRateLimitRuleRequest
+		res   *ibcratelimit.MsgSetRateLimitRuleResponse
+		event *sdk.Event
+		err   string
+	}{
+		{
+			name: "failure - authority does not match module authority",
+			req:  ibcratelimit.NewMsgSetRateLimitRuleRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", rule),
+			res:  nil,
+			err:  fmt.Sprintf("expected \"%s\" got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority),
+		},
+		{
+			name:  "success - rule is set",
+			req:   ibcratelimit.NewMsgSetRateLimitRuleRequest(authority, rule),
+			res:   &ibcratelimit.MsgSetRateLimitRuleResponse{},
+			event: typedEventToEvent(ibcratelimit.NewEventRateLimitRuleUpdated(rule.ChannelId, rule.Denom)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.SetRateLimitRule(ctx, tc.req)
+			events := ctx.EventManager().Events()
+
+			if tc.event != nil {
+				s.Assert().Equal(1, len(events), "should emit the correct number of events")
+				s.Assert().Equal(*tc.event, events[0], "should emit the correct event")
+			} else {
+				s.Assert().Empty(events, "should not emit events")
+			}
+
+			if len(tc.err) > 0 {
+				s.Assert().Nil(res, "should have nil response")
+				s.Assert().EqualError(err, tc.err, "should have correct error")
+			} else {
+				s.Assert().NoError(err, "should not have error")
+				s.Assert().Equal(tc.res, res, "should have the correct response")
+				stored, err := s.app.RateLimitingKeeper.GetRateLimitRule(ctx, tc.req.Rule.ChannelId, tc.req.Rule.Denom)
+				s.Assert().NoError(err, "GetRateLimitRule")
+				s.Assert().Equal(&tc.req.Rule, stored, "should have stored the rule")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestRemoveRateLimitRule() {
+	authority := s.app.RateLimitingKeeper.GetAuthority()
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	s.app.RateLimitingKeeper.SetRateLimitRule(s.ctx, rule)
+
+	tests := []struct {
+		name  string
+		req   *ibcratelimit.MsgRemoveRateLimitRuleRequest
+		res   *ibcratelimit.MsgRemoveRateLimitRuleResponse
+		event *sdk.Event
+		err   string
+	}{
+		{
+			name: "failure - authority does not match module authority",
+			req:  ibcratelimit.NewMsgRemoveRateLimitRuleRequest("cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma", rule.ChannelId, rule.Denom),
+			res:  nil,
+			err:  fmt.Sprintf("expected \"%s\" got \"cosmos1w6t0l7z0yerj49ehnqwqaayxqpe3u7e23edgma\": expected gov account as only signer for proposal message", authority),
+		},
+		{
+			name: "failure - rule does not exist",
+			req:  ibcratelimit.NewMsgRemoveRateLimitRuleRequest(authority, "channel-1", rule.Denom),
+			res:  nil,
+			err:  `rate limit rule for channel "channel-1" denom "nhash" not found: invalid request`,
+		},
+		{
+			name:  "success - rule is removed",
+			req:   ibcratelimit.NewMsgRemoveRateLimitRuleRequest(authority, rule.ChannelId, rule.Denom),
+			res:   &ibcratelimit.MsgRemoveRateLimitRuleResponse{},
+			event: typedEventToEvent(ibcratelimit.NewEventRateLimitRuleRemoved(rule.ChannelId, rule.Denom)),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			res, err := s.msgServer.RemoveRateLimitRule(ctx, tc.req)
+			events := ctx.EventManager().Events()
+
+			if tc.event != nil {
+				s.Assert().Equal(1, len(events), "should emit the correct number of events")
+				s.Assert().Equal(*tc.event, events[0], "should emit the correct event")
+			} else {
+				s.Assert().Empty(events, "should not emit events")
+			}
+
+			if len(tc.err) > 0 {
+				s.Assert().Nil(res, "should have nil response")
+				s.Assert().EqualError(err, tc.err, "should have correct error")
+			} else {
+				s.Assert().NoError(err, "should not have error")
+				s.Assert().Equal(tc.res, res, "should have the correct response")
+				stored, err := s.app.RateLimitingKeeper.GetRateLimitRule(ctx, tc.req.ChannelId, tc.req.Denom)
+				s.Assert().NoError(err, "GetRateLimitRule")
+				s.Assert().Nil(stored, "should have removed the rule")
+			}
+		})
+	}
+}
+
 func typedEventToEvent(tev proto.Message) *sdk.Event {
 	event, _ := sdk.TypedEventToEvent(tev)
 	return &event
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// GetRateLimitRule gets the native rate limit rule for a channel and denom.
+// Returns nil if there isn't one.
+func (k Keeper) GetRateLimitRule(ctx sdk.Context, channelID, denom string) (*ibcratelimit.RateLimitRule, error) {
+	bz := ctx.KVStore(k.storeKey).Get(ibcratelimit.GetRateLimitRuleKey(channelID, denom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var rule ibcratelimit.RateLimitRule
+	if err := k.cdc.Unmarshal(bz, &rule); err != nil {
+		return nil, fmt.Errorf("failed to read rate limit rule for channel %q denom %q: %w", channelID, denom, err)
+	}
+	return &rule, nil
+}
+
+// SetRateLimitRule creates or updates a native rate limit rule.
+// Any flow being tracked for the rule is reset so that the new rule starts with a fresh window.
+func (k Keeper) SetRateLimitRule(ctx sdk.Context, rule ibcratelimit.RateLimitRule) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(ibcratelimit.GetRateLimitRuleKey(rule.ChannelId, rule.Denom), k.cdc.MustMarshal(&rule))
+	store.Delete(ibcratelimit.GetRateLimitFlowKey(rule.ChannelId, rule.Denom))
+}
+
+// RemoveRateLimitRule deletes a native rate limit rule and its flow.
+func (k Keeper) RemoveRateLimitRule(ctx sdk.Context, channelID, denom string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := ibcratelimit.GetRateLimitRuleKey(channelID, denom)
+	if !store.Has(key) {
+		return fmt.Errorf("rate limit rule for channel %q denom %q not found", channelID, denom)
+	}
+	store.Delete(key)
+	store.Delete(ibcratelimit.GetRateLimitFlowKey(channelID, denom))
+	return nil
+}
+
+// IterateRateLimitRules iterates over all native rate limit rules.
+// The process function should return whether to stop: false = keep iterating, true = stop.
+func (k Keeper) IterateRateLimitRules(ctx sdk.Context, process func(rule ibcratelimit.RateLimitRule) bool) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ibcratelimit.RateLimitRuleKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		var rule ibcratelimit.RateLimitRule
+		if err := k.cdc.Unmarshal(iter.Value(), &rule); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read rate limit rule %X: %w", iter.Key(), err))
+			continue
+		}
+		if process(rule) {
+			break
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// hasRateLimitRules returns true if there's at least one native rate limit rule.
+func (k Keeper) hasRateLimitRules(ctx sdk.Context) bool {
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ibcratelimit.RateLimitRuleKeyPrefix)
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// GetAllRateLimitRules gets all the native rate limit rules.
+func (k Keeper) GetAllRateLimitRules(ctx sdk.Context) ([]ibcratelimit.RateLimitRule, error) {
+	var rv []ibcratelimit.RateLimitRule
+	err := k.IterateRateLimitRules(ctx, func(rule ibcratelimit.RateLimitRule) bool {
+		rv = append(rv, rule)
+		return false
+	})
+	return rv, err
+}
+
+// GetRateLimitFlow gets the stored flow for a channel and denom.
+// Returns nil if there isn't one.
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, channelID, denom string) (*ibcratelimit.RateLimitFlow, error) {
+	bz := ctx.KVStore(k.storeKey).Get(ibcratelimit.GetRateLimitFlowKey(channelID, denom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var flow ibcratelimit.RateLimitFlow
+	if err := k.cdc.Unmarshal(bz, &flow); err != nil {
+		return nil, fmt.Errorf("failed to read rate limit flow for channel %q denom %q: %w", channelID, denom, err)
+	}
+	return &flow, nil
+}
+
+// SetRateLimitFlow stores the provided flow.
+func (k Keeper) SetRateLimitFlow(ctx sdk.Context, flow ibcratelimit.RateLimitFlow) {
+	ctx.KVStore(k.storeKey).Set(ibcratelimit.GetRateLimitFlowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+}
+
+// GetAllRateLimitFlows gets all the stored native rate limit flows.
+func (k Keeper) GetAllRateLimitFlows(ctx sdk.Context) ([]ibcratelimit.RateLimitFlow, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ibcratelimit.RateLimitFlowKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	var rv []ibcratelimit.RateLimitFlow
+	var errs []error
+	for ; iter.Valid(); iter.Next() {
+		var flow ibcratelimit.RateLimitFlow
+		if err := k.cdc.Unmarshal(iter.Value(), &flow); err != nil {
+			errs = append(errs, fmt.Errorf("failed to read rate limit flow %X: %w", iter.Key(), err))
+			continue
+		}
+		rv = append(rv, flow)
+	}
+	return rv, errors.Join(errs...)
+}
+
+// GetCurrentRateLimitFlow gets the flow of the current window of the provided rule.
+// If there isn't a stored flow, or its window has ended, a new one is returned (but not stored)
+// using the current supply of the denom as the channel value.
+func (k Keeper) GetCurrentRateLimitFlow(ctx sdk.Context, rule ibcratelimit.RateLimitRule) (ibcratelimit.RateLimitFlow, error) {
+	flow, err := k.GetRateLimitFlow(ctx, rule.ChannelId, rule.Denom)
+	if err != nil {
+		return ibcratelimit.RateLimitFlow{}, err
+	}
+	if flow != nil && !flow.IsExpiredAt(ctx.BlockTime()) {
+		return *flow, nil
+	}
+	supply := k.bankKeeper.GetSupply(ctx, rule.Denom)
+	return ibcratelimit.NewRateLimitFlow(rule, supply.Amount, ctx.BlockTime()), nil
+}
+
+// getPacketRuleAndFlow gets the rule and current flow for the channel and denom of a packet, and the packet's amount.
+// Returns a nil rule if there isn't a rule for the packet's channel and denom.
+// If there aren't any rules at all, the packet isn't even parsed.
+func (k Keeper) getPacketRuleAndFlow(ctx sdk.Context, msgType string, packet exported.PacketI) (*ibcratelimit.RateLimitRule, *ibcratelimit.RateLimitFlow, sdkmath.Int, error) {
+	if !k.hasRateLimitRules(ctx) {
+		return nil, nil, sdkmath.Int{}, nil
+	}
+
+	unwrapped, err := ibcratelimit.UnwrapPacket(packet)
+	if err != nil {
+		return nil, nil, sdkmath.Int{}, err
+	}
+
+	channelID, denom, amount, err := ibcratelimit.GetPacketFlowInfo(msgType, unwrapped)
+	if err != nil {
+		return nil, nil, sdkmath.Int{}, err
+	}
+
+	rule, err := k.GetRateLimitRule(ctx, channelID, denom)
+	if err != nil || rule == nil {
+		return nil, nil, sdkmath.Int{}, err
+	}
+
+	flow, err := k.GetCurrentRateLimitFlow(ctx, *rule)
+	if err != nil {
+		return nil, nil, sdkmath.Int{}, err
+	}
+	return rule, &flow, amount, nil
+}
+
+// CheckAndUpdateNativeRateLimits records a packet's funds in the flow of the native rate limit rule for
+// the packet's channel and denom, and checks that the rule's quota has not been exceeded.
+// If there isn't a rule for the packet's channel and denom, nothing is done.
+// If the denom doesn't have a supply yet (e.g. the first receipt of an ibc denom), there's nothing to
+// limit against, so nothing is done either, and the next packet will start the window.
+func (k Keeper) CheckAndUpdateNativeRateLimits(ctx sdk.Context, msgType string, packet exported.PacketI) error {
+	rule, flow, amount, err := k.getPacketRuleAndFlow(ctx, msgType, packet)
+	if err != nil {
+		return errorsmod.Wrap(ibcratelimit.ErrBadMessage, err.Error())
+	}
+	if rule == nil || flow.ChannelValue.IsZero() {
+		return nil
+	}
+
+	switch msgType {
+	case ibcratelimit.MsgSendPacket:
+		err = flow.AddOutflow(*rule, amount)
+	case ibcratelimit.MsgRecvPacket:
+		err = flow.AddInflow(*rule, amount)
+	}
+	if err != nil {
+		return err
+	}
+
+	k.SetRateLimitFlow(ctx, *flow)
+	return nil
+}
+
+// UndoNativeSendRateLimit removes a sent packet's funds from the outflow of the native rate limit rule
+// for the packet's channel and denom. If the rule's window has ended since the packet was sent, nothing is done.
+func (k Keeper) UndoNativeSendRateLimit(ctx sdk.Context, packet exported.PacketI) error {
+	rule, flow, amount, err := k.getPacketRuleAndFlow(ctx, ibcratelimit.MsgSendPacket, packet)
+	if err != nil || rule == nil {
+		return err
+	}
+
+	// If there's no stored flow, or it's different from the current one, the send was in a previous window.
+	stored, err := k.GetRateLimitFlow(ctx, rule.ChannelId, rule.Denom)
+	if err != nil || stored == nil || !stored.WindowEnd.Equal(flow.WindowEnd) {
+		return err
+	}
+
+	flow.UndoOutflow(amount)
+	k.SetRateLimitFlow(ctx, *flow)
+	return nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+// fundDenomSupply mints some of the mock packet's denom so that it has a supply.
+func (s *TestSuite) fundDenomSupply(amount int64) {
+	addr := sdk.AccAddress("supply_holder_______")
+	err := banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin("denom", amount)))
+	s.Require().NoError(err, "FundAccount")
+}
+
+// newMockAmountPacketData creates a serialized FungibleTokenPacketData for testing with the provided amount.
+func newMockAmountPacketData(amount string) []byte {
+	data := NewMockFungiblePacketData(false)
+	data.Amount = amount
+	bytes, _ := json.Marshal(data)
+	return bytes
+}
+
+func (s *TestSuite) TestRateLimitRuleStore() {
+	k := s.app.RateLimitingKeeper
+	rule1 := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	rule2 := ibcratelimit.NewRateLimitRule("channel-1", "nhash", 0, 20, 60)
+
+	rule, err := k.GetRateLimitRule(s.ctx, rule1.ChannelId, rule1.Denom)
+	s.Require().NoError(err, "GetRateLimitRule before set")
+	s.Assert().Nil(rule, "GetRateLimitRule before set")
+
+	k.SetRateLimitRule(s.ctx, rule1)
+	k.SetRateLimitRule(s.ctx, rule2)
+	rule, err = k.GetRateLimitRule(s.ctx, rule1.ChannelId, rule1.Denom)
+	s.Require().NoError(err, "GetRateLimitRule after set")
+	s.Assert().Equal(&rule1, rule, "GetRateLimitRule after set")
+
+	rules, err := k.GetAllRateLimitRules(s.ctx)
+	s.Require().NoError(err, "GetAllRateLimitRules")
+	s.Assert().Equal([]ibcratelimit.RateLimitRule{rule1, rule2}, rules, "GetAllRateLimitRules")
+
+	// Updating a rule should reset its flow.
+	k.SetRateLimitFlow(s.ctx, ibcratelimit.NewRateLimitFlow(rule1, sdkmath.NewInt(1000), s.ctx.BlockTime()))
+	flow, err := k.GetRateLimitFlow(s.ctx, rule1.ChannelId, rule1.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after set")
+	s.Assert().NotNil(flow, "GetRateLimitFlow after set")
+	k.SetRateLimitRule(s.ctx, rule1)
+	flow, err = k.GetRateLimitFlow(s.ctx, rule1.ChannelId, rule1.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after rule update")
+	s.Assert().Nil(flow, "GetRateLimitFlow after rule update")
+
+	// Removing a rule should also remove its flow.
+	k.SetRateLimitFlow(s.ctx, ibcratelimit.NewRateLimitFlow(rule2, sdkmath.NewInt(1000), s.ctx.BlockTime()))
+	s.Require().NoError(k.RemoveRateLimitRule(s.ctx, rule2.ChannelId, rule2.Denom), "RemoveRateLimitRule")
+	rule, err = k.GetRateLimitRule(s.ctx, rule2.ChannelId, rule2.Denom)
+	s.Require().NoError(err, "GetRateLimitRule after remove")
+	s.Assert().Nil(rule, "GetRateLimitRule after remove")
+	flows, err := k.GetAllRateLimitFlows(s.ctx)
+	s.Require().NoError(err, "GetAllRateLimitFlows after remove")
+	s.Assert().Empty(flows, "GetAllRateLimitFlows after remove")
+
+	err = k.RemoveRateLimitRule(s.ctx, rule2.ChannelId, rule2.Denom)
+	s.Assert().EqualError(err, `rate limit rule for channel "channel-1" denom "nhash" not found`, "RemoveRateLimitRule again")
+}
+
+func (s *TestSuite) TestGetCurrentRateLimitFlow() {
+	k := s.app.RateLimitingKeeper
+	s.fundDenomSupply(10000)
+	now := time.Unix(1700000000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(now)
+	rule := ibcratelimit.NewRateLimitRule("src-channel", "denom", 5, 10, 3600)
+	k.SetRateLimitRule(s.ctx, rule)
+
+	flow, err := k.GetCurrentRateLimitFlow(s.ctx, rule)
+	s.Require().NoError(err, "GetCurrentRateLimitFlow with nothing stored")
+	s.Assert().Equal(ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(10000), now), flow, "GetCurrentRateLimitFlow with nothing stored")
+
+	stored := ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(500), now.Add(-30*time.Minute))
+	stored.Outflow = sdkmath.NewInt(3)
+	k.SetRateLimitFlow(s.ctx, stored)
+	flow, err = k.GetCurrentRateLimitFlow(s.ctx, rule)
+	s.Require().NoError(err, "GetCurrentRateLimitFlow with active flow")
+	s.Assert().Equal(stored, flow, "GetCurrentRateLimitFlow with active flow")
+
+	s.ctx = s.ctx.WithBlockTime(now.Add(30 * time.Minute))
+	flow, err = k.GetCurrentRateLimitFlow(s.ctx, rule)
+	s.Require().NoError(err, "GetCurrentRateLimitFlow with expired flow")
+	s.Assert().Equal(ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(10000), now.Add(30*time.Minute)), flow, "GetCurrentRateLimitFlow with expired flow")
+}
+
+func (s *TestSuite) TestCheckAndUpdateNativeRateLimits() {
+	k := s.app.RateLimitingKeeper
+	s.fundDenomSupply(10000)
+	recvDenom := transfertypes.ParseDenomTrace("dest-port/dest-channel/denom").IBCDenom()
+	sendRule := ibcratelimit.NewRateLimitRule("src-channel", "denom", 5, 0, 3600)
+	recvRule := ibcratelimit.NewRateLimitRule("dest-channel", recvDenom, 0, 10, 3600)
+
+	// Without any rules, everything is allowed, and packets aren't even parsed.
+	err := k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, NewMockPacket(newMockAmountPacketData("100000"), true))
+	s.Require().NoError(err, "send without a rule")
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, NewMockPacket([]byte("garbage"), true))
+	s.Require().NoError(err, "send with bad packet without a rule")
+	flows, err := k.GetAllRateLimitFlows(s.ctx)
+	s.Require().NoError(err, "GetAllRateLimitFlows without a rule")
+	s.Assert().Empty(flows, "flows without a rule")
+
+	k.SetRateLimitRule(s.ctx, sendRule)
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, NewMockPacket(newMockAmountPacketData("500"), true))
+	s.Require().NoError(err, "send within quota")
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, NewMockPacket(newMockAmountPacketData("1"), true))
+	s.Assert().EqualError(err, "501denom outflow on src-channel exceeds quota of 500denom: rate limit exceeded", "send over quota")
+	flow, err := k.GetRateLimitFlow(s.ctx, sendRule.ChannelId, sendRule.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after sends")
+	s.Require().NotNil(flow, "GetRateLimitFlow after sends")
+	s.Assert().Equal(sdkmath.NewInt(500), flow.Outflow, "outflow after sends")
+
+	// The recv denom has no supply, so there's nothing to limit against yet.
+	k.SetRateLimitRule(s.ctx, recvRule)
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, NewMockPacket(newMockAmountPacketData("1000"), true))
+	s.Require().NoError(err, "recv without a supply")
+	flow, err = k.GetRateLimitFlow(s.ctx, recvRule.ChannelId, recvRule.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after recv without a supply")
+	s.Assert().Nil(flow, "GetRateLimitFlow after recv without a supply")
+
+	// Once there's a supply, the window starts and the quota is based on it.
+	addr := sdk.AccAddress("supply_holder_______")
+	err = banktestutil.FundAccount(s.ctx, s.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(recvDenom, 1000)))
+	s.Require().NoError(err, "FundAccount with recv denom")
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, NewMockPacket(newMockAmountPacketData("100"), true))
+	s.Require().NoError(err, "recv within quota")
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgRecvPacket, NewMockPacket(newMockAmountPacketData("1"), true))
+	s.Assert().EqualError(err, "101"+recvDenom+" inflow on dest-channel exceeds quota of 100"+recvDenom+": rate limit exceeded", "recv over quota")
+
+	err = k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, NewMockPacket([]byte("garbage"), true))
+	s.Assert().ErrorContains(err, "bad message", "send with bad packet")
+}
+
+func (s *TestSuite) TestUndoNativeSendRateLimit() {
+	k := s.app.RateLimitingKeeper
+	s.fundDenomSupply(10000)
+	now := time.Unix(1700000000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(now)
+	rule := ibcratelimit.NewRateLimitRule("src-channel", "denom", 5, 0, 3600)
+	k.SetRateLimitRule(s.ctx, rule)
+
+	packet := NewMockPacket(NewMockSerializedPacketData(), true)
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, packet), "send")
+	s.Require().NoError(k.UndoNativeSendRateLimit(s.ctx, packet), "undo")
+	flow, err := k.GetRateLimitFlow(s.ctx, rule.ChannelId, rule.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after undo")
+	s.Require().NotNil(flow, "GetRateLimitFlow after undo")
+	s.Assert().Equal(sdkmath.ZeroInt(), flow.Outflow, "outflow after undo")
+
+	// An undo in a later window should not change anything.
+	s.Require().NoError(k.CheckAndUpdateNativeRateLimits(s.ctx, ibcratelimit.MsgSendPacket, packet), "second send")
+	s.ctx = s.ctx.WithBlockTime(now.Add(2 * time.Hour))
+	s.Require().NoError(k.UndoNativeSendRateLimit(s.ctx, packet), "undo after window")
+	flow, err = k.GetRateLimitFlow(s.ctx, rule.ChannelId, rule.Denom)
+	s.Require().NoError(err, "GetRateLimitFlow after late undo")
+	s.Require().NotNil(flow, "GetRateLimitFlow after late undo")
+	s.Assert().Equal(sdkmath.NewInt(500), flow.Outflow, "outflow after late undo")
+}
@@ -90,12 +90,13 @@ func (k Keeper) buildWasmExecMsg(msgType string, packet exported.PacketI) ([]byt
 }
 
 // RevertSentPacket Notifies the contract that a sent packet wasn't properly received.
+// If the contract isn't configured, the packet is removed from the native rate limits instead.
 func (k Keeper) RevertSentPacket(
 	ctx sdk.Context,
 	packet exported.PacketI,
 ) error {
 	if !k.IsContractConfigured(ctx) {
-		return nil
+		return k.UndoNativeSendRateLimit(ctx, packet)
 	}
 
 	contract := k.GetContractAddress(ctx)
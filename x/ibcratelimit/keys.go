@@ -1,5 +1,7 @@
 package ibcratelimit
 
+import "fmt"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "ratelimitedibc"
@@ -11,4 +13,48 @@ const (
 var (
 	// ParamsKey is the key to obtain the module's params.
 	ParamsKey = []byte{0x01}
+	// RateLimitRuleKeyPrefix is the prefix of the native rate limit rule entries.
+	RateLimitRuleKeyPrefix = []byte{0x02}
+	// RateLimitFlowKeyPrefix is the prefix of the native rate limit flow entries.
+	RateLimitFlowKeyPrefix = []byte{0x03}
 )
+
+// Native rate limit rules and flows are stored with the following keys:
+//
+// Rule:
+// - 0x02<channel id len (1 byte)><channel id><denom> -> <RateLimitRule (protobuf)>
+//
+// Flow:
+// - 0x03<channel id len (1 byte)><channel id><denom> -> <RateLimitFlow (protobuf)>
+
+// createPathKey creates a key with the provided prefix, followed by the length-prefixed channel id, then the denom.
+func createPathKey(prefix []byte, channelID, denom string) []byte {
+	rv := make([]byte, 0, len(prefix)+1+len(channelID)+len(denom))
+	rv = append(rv, prefix...)
+	rv = append(rv, byte(len(channelID)))
+	rv = append(rv, channelID...)
+	rv = append(rv, denom...)
+	return rv
+}
+
+// GetRateLimitRuleKey creates the key for the native rate limit rule of a channel and denom.
+func GetRateLimitRuleKey(channelID, denom string) []byte {
+	return createPathKey(RateLimitRuleKeyPrefix, channelID, denom)
+}
+
+// GetRateLimitFlowKey creates the key for the native rate limit flow of a channel and denom.
+func GetRateLimitFlowKey(channelID, denom string) []byte {
+	return createPathKey(RateLimitFlowKeyPrefix, channelID, denom)
+}
+
+// ParsePathKey extracts the channel id and denom from a rule or flow key (including the type byte).
+func ParsePathKey(key []byte) (channelID, denom string, err error) {
+	if len(key) < 2 {
+		return "", "", fmt.Errorf("cannot parse key %X: too short", key)
+	}
+	chanLen := int(key[1])
+	if len(key) < 2+chanLen {
+		return "", "", fmt.Errorf("cannot parse key %X: channel id length %d is too long", key, chanLen)
+	}
+	return string(key[2 : 2+chanLen]), string(key[2+chanLen:]), nil
+}
@@ -135,12 +135,13 @@ func (im *IBCMiddleware) OnRecvPacket(
 		return ibc.NewEmitErrorAcknowledgement(ctx, ibcratelimit.ErrBadMessage, err.Error())
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
-		return im.app.OnRecvPacket(ctx, packet, relayer)
+	var err error
+	if im.keeper.IsContractConfigured(ctx) {
+		err = im.keeper.CheckAndUpdateRateLimits(ctx, ibcratelimit.MsgRecvPacket, packet)
+	} else {
+		// The contract has not been configured. Use the native rate limit rules.
+		err = im.keeper.CheckAndUpdateNativeRateLimits(ctx, ibcratelimit.MsgRecvPacket, packet)
 	}
-
-	err := im.keeper.CheckAndUpdateRateLimits(ctx, "recv_packet", packet)
 	if err != nil {
 		return ibc.NewEmitErrorAcknowledgement(ctx, err)
 	}
@@ -200,8 +201,8 @@ func (im *IBCMiddleware) OnTimeoutPacket(
 // SendPacket implements the ICS4 interface and is called when sending packets.
 // This method retrieves the contract from the middleware's parameters and checks if the limits have been exceeded for
 // the current transfer, in which case it returns an error preventing the IBC send from taking place.
-// If the contract param is not configured, or the contract doesn't have a configuration for the (channel+denom) being
-// used, transfers are not prevented and handled by the wrapped IBC app
+// If the contract param is not configured, the native rate limit rules are checked instead.
+// If there isn't a limit for the (channel+denom) being used, transfers are not prevented and handled by the wrapped IBC app
 func (im *IBCMiddleware) SendPacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -219,12 +220,7 @@ func (im *IBCMiddleware) SendPacket(
 		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
 	}
 
-	if !im.keeper.IsContractConfigured(ctx) {
-		// The contract has not been configured. Continue as usual
-		return im.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
-	}
-
-	// We need the full packet so the contract can process it. If it can't be cast to a channeltypes.Packet, this
+	// We need the full packet so the rate limits can be checked. If it can't be cast to a channeltypes.Packet, this
 	// should fail. The only reason that would happen is if another middleware is modifying the packet, though. In
 	// that case we can modify the middleware order or change this cast so we have all the data we need.
 	packet := channeltypes.NewPacket(
@@ -238,7 +234,12 @@ func (im *IBCMiddleware) SendPacket(
 		timeoutTimestamp,
 	)
 
-	err = im.keeper.CheckAndUpdateRateLimits(ctx, "send_packet", packet)
+	if im.keeper.IsContractConfigured(ctx) {
+		err = im.keeper.CheckAndUpdateRateLimits(ctx, ibcratelimit.MsgSendPacket, packet)
+	} else {
+		// The contract has not been configured. Use the native rate limit rules.
+		err = im.keeper.CheckAndUpdateNativeRateLimits(ctx, ibcratelimit.MsgSendPacket, packet)
+	}
 	if err != nil {
 		return 0, errorsmod.Wrap(err, "rate limit SendPacket failed to authorize transfer")
 	}
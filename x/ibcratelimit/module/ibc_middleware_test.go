@@ -442,6 +442,82 @@ func (suite *MiddlewareTestSuite) TestRecvTransferWithRateLimitingNonNative() {
 	suite.fullRecvTest(false)
 }
 
+// setNativeRateLimitRule sets a native rate limit rule on chain A.
+func (suite *MiddlewareTestSuite) setNativeRateLimitRule(channel, denom string, sendPercentage, recvPercentage uint32) {
+	provenanceApp := suite.chainA.GetProvenanceApp()
+	rule := ibcratelimit.NewRateLimitRule(channel, denom, sendPercentage, recvPercentage, 604800)
+	suite.Require().NoError(rule.Validate(), "rule.Validate()")
+	provenanceApp.RateLimitingKeeper.SetRateLimitRule(suite.chainA.GetContext(), rule)
+}
+
+// Test native rate limiting on sends
+func (suite *MiddlewareTestSuite) TestSendTransferWithNativeRateLimit() {
+	suite.initializeEscrow()
+	denom := sdk.DefaultBondDenom
+	provenanceApp := suite.chainA.GetProvenanceApp()
+
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), denom, provenanceApp.BankKeeper)
+	quota := channelValue.MulRaw(5).QuoRaw(100)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.setNativeRateLimitRule("channel-0", denom, 5, 0)
+
+	// send 2.5% twice (quota is 5%)
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Assert().NoError(err)
+	_, err = suite.AssertSend(true, suite.MessageFromAToB(denom, sendAmount))
+	suite.Assert().NoError(err)
+
+	flow, err := provenanceApp.RateLimitingKeeper.GetRateLimitFlow(suite.chainA.GetContext(), "channel-0", denom)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(flow)
+	suite.Assert().Equal(sendAmount.MulRaw(2), flow.Outflow)
+
+	// Sending above the quota should fail. We use 2 instead of 1 here to avoid rounding issues
+	_, err = suite.AssertSend(false, suite.MessageFromAToB(denom, sdkmath.NewInt(2)))
+	suite.Assert().Error(err)
+}
+
+// Test native rate limiting on receives
+func (suite *MiddlewareTestSuite) TestRecvTransferWithNativeRateLimit() {
+	suite.initializeEscrow()
+	// Sends denom=stake from B->A, which is received as a voucher on A.
+	sendDenom := sdk.DefaultBondDenom
+	localDenom := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom("transfer", "channel-0", sendDenom)).IBCDenom()
+	provenanceApp := suite.chainA.GetProvenanceApp()
+
+	channelValue := CalculateChannelValue(suite.chainA.GetContext(), localDenom, provenanceApp.BankKeeper)
+	quota := channelValue.MulRaw(4).QuoRaw(100)
+	sendAmount := quota.QuoRaw(2)
+
+	suite.setNativeRateLimitRule("channel-0", localDenom, 0, 4)
+
+	// receive 2% twice (quota is 4%)
+	_, err := suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Assert().NoError(err)
+	_, err = suite.AssertReceive(true, suite.MessageFromBToA(sendDenom, sendAmount))
+	suite.Assert().NoError(err)
+
+	// Receiving above the quota should fail. We send 2 instead of 1 to account for rounding errors
+	_, err = suite.AssertReceive(false, suite.MessageFromBToA(sendDenom, sdkmath.NewInt(2)))
+	suite.Assert().NoError(err)
+}
+
+// Test that the contract takes precedence over native rules when it is configured
+func (suite *MiddlewareTestSuite) TestNativeRateLimitIgnoredWithContract() {
+	suite.setNativeRateLimitRule("channel-0", sdk.DefaultBondDenom, 1, 1)
+
+	suite.chainA.StoreContractRateLimiterDirect(&suite.Suite)
+	initMsg := CreateRateLimiterInitMessage(suite.chainA, "")
+	addr := suite.chainA.InstantiateContract(&suite.Suite, initMsg, 1)
+	suite.chainA.RegisterRateLimiterContract(&suite.Suite, addr)
+
+	provenanceApp := suite.chainA.GetProvenanceApp()
+	supply := provenanceApp.BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom)
+	_, err := suite.AssertSend(true, suite.MessageFromAToB(sdk.DefaultBondDenom, supply.Amount.QuoRaw(20)))
+	suite.Assert().NoError(err)
+}
+
 // Test no rate limiting occurs when the contract is set, but not quotas are condifured for the path
 func (suite *MiddlewareTestSuite) TestSendTransferNoQuota() {
 	// Setup contract
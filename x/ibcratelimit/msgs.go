@@ -11,6 +11,8 @@ import (
 var AllRequestMsgs = []sdk.Msg{
 	(*MsgGovUpdateParamsRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetRateLimitRuleRequest)(nil),
+	(*MsgRemoveRateLimitRuleRequest)(nil),
 }
 
 // ValidateBasic runs stateless validation checks on the message.
@@ -32,3 +34,40 @@ func (m MsgUpdateParamsRequest) ValidateBasic() error {
 	}
 	return m.Params.Validate()
 }
+
+// NewMsgSetRateLimitRuleRequest creates a new SetRateLimitRule message.
+func NewMsgSetRateLimitRuleRequest(authority string, rule RateLimitRule) *MsgSetRateLimitRuleRequest {
+	return &MsgSetRateLimitRuleRequest{
+		Authority: authority,
+		Rule:      rule,
+	}
+}
+
+func (m MsgSetRateLimitRuleRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	return m.Rule.Validate()
+}
+
+// NewMsgRemoveRateLimitRuleRequest creates a new RemoveRateLimitRule message.
+func NewMsgRemoveRateLimitRuleRequest(authority, channelID, denom string) *MsgRemoveRateLimitRuleRequest {
+	return &MsgRemoveRateLimitRuleRequest{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+func (m MsgRemoveRateLimitRuleRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return fmt.Errorf("invalid authority: %w", err)
+	}
+	if len(m.ChannelId) == 0 {
+		return errors.New("invalid channel id: cannot be empty")
+	}
+	if len(m.Denom) == 0 {
+		return errors.New("invalid denom: cannot be empty")
+	}
+	return nil
+}
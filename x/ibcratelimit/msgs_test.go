@@ -16,6 +16,8 @@ func TestAllMsgsGetSigners(t *testing.T) {
 	msgMakers := []testutil.MsgMaker{
 		func(signer string) sdk.Msg { return &MsgGovUpdateParamsRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetRateLimitRuleRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveRateLimitRuleRequest{Authority: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -74,3 +76,111 @@ func TestNewMsgUpdateParamsValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestNewMsgSetRateLimitRuleRequest(t *testing.T) {
+	expected := &MsgSetRateLimitRuleRequest{
+		Authority: "authority",
+		Rule:      NewRateLimitRule("channel-0", "nhash", 5, 10, 3600),
+	}
+	msg := NewMsgSetRateLimitRuleRequest(expected.Authority, expected.Rule)
+	assert.Equal(t, expected, msg, "should create the message with correct content")
+}
+
+func TestMsgSetRateLimitRuleValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		authority string
+		rule      RateLimitRule
+		err       string
+	}{
+		{
+			name:      "success - valid message",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			rule:      NewRateLimitRule("channel-0", "nhash", 5, 10, 3600),
+		},
+		{
+			name:      "failure - invalid authority",
+			authority: "authority",
+			rule:      NewRateLimitRule("channel-0", "nhash", 5, 10, 3600),
+			err:       "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:      "failure - invalid rule",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			rule:      NewRateLimitRule("channel-0", "nhash", 5, 10, 0),
+			err:       "invalid window seconds: cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgSetRateLimitRuleRequest(tc.authority, tc.rule)
+			err := msg.ValidateBasic()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
+
+func TestNewMsgRemoveRateLimitRuleRequest(t *testing.T) {
+	expected := &MsgRemoveRateLimitRuleRequest{
+		Authority: "authority",
+		ChannelId: "channel-0",
+		Denom:     "nhash",
+	}
+	msg := NewMsgRemoveRateLimitRuleRequest(expected.Authority, expected.ChannelId, expected.Denom)
+	assert.Equal(t, expected, msg, "should create the message with correct content")
+}
+
+func TestMsgRemoveRateLimitRuleValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		authority string
+		channelID string
+		denom     string
+		err       string
+	}{
+		{
+			name:      "success - valid message",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			channelID: "channel-0",
+			denom:     "nhash",
+		},
+		{
+			name:      "failure - invalid authority",
+			authority: "authority",
+			channelID: "channel-0",
+			denom:     "nhash",
+			err:       "invalid authority: decoding bech32 failed: invalid separator index -1",
+		},
+		{
+			name:      "failure - empty channel",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			denom:     "nhash",
+			err:       "invalid channel id: cannot be empty",
+		},
+		{
+			name:      "failure - empty denom",
+			authority: "cosmos1qm0hhug8kszhcp9f3ryuecz5yw8s3e5v0n2ckd",
+			channelID: "channel-0",
+			err:       "invalid denom: cannot be empty",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := NewMsgRemoveRateLimitRuleRequest(tc.authority, tc.channelID, tc.denom)
+			err := msg.ValidateBasic()
+
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "should return correct error")
+			} else {
+				assert.NoError(t, err, "should not throw an error")
+			}
+		})
+	}
+}
@@ -112,9 +112,198 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// RateLimitRulesRequest is the request type for the Query/RateLimitRules RPC method.
+type RateLimitRulesRequest struct {
+}
+
+func (m *RateLimitRulesRequest) Reset()         { *m = RateLimitRulesRequest{} }
+func (m *RateLimitRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitRulesRequest) ProtoMessage()    {}
+func (*RateLimitRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{2}
+}
+func (m *RateLimitRulesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitRulesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitRulesRequest.Merge(m, src)
+}
+func (m *RateLimitRulesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitRulesRequest proto.InternalMessageInfo
+
+// RateLimitRulesResponse is the response type for the Query/RateLimitRules RPC method.
+type RateLimitRulesResponse struct {
+	// rules are all of the native rate limit rules.
+	Rules []RateLimitRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules"`
+}
+
+func (m *RateLimitRulesResponse) Reset()         { *m = RateLimitRulesResponse{} }
+func (m *RateLimitRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitRulesResponse) ProtoMessage()    {}
+func (*RateLimitRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{3}
+}
+func (m *RateLimitRulesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitRulesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitRulesResponse.Merge(m, src)
+}
+func (m *RateLimitRulesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitRulesResponse proto.InternalMessageInfo
+
+func (m *RateLimitRulesResponse) GetRules() []RateLimitRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// RateLimitFlowsRequest is the request type for the Query/RateLimitFlows RPC method.
+type RateLimitFlowsRequest struct {
+	// channel_id is an optional channel id to limit the results to.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is an optional denom to limit the results to.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RateLimitFlowsRequest) Reset()         { *m = RateLimitFlowsRequest{} }
+func (m *RateLimitFlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlowsRequest) ProtoMessage()    {}
+func (*RateLimitFlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{4}
+}
+func (m *RateLimitFlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlowsRequest.Merge(m, src)
+}
+func (m *RateLimitFlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlowsRequest proto.InternalMessageInfo
+
+func (m *RateLimitFlowsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitFlowsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitFlowsResponse is the response type for the Query/RateLimitFlows RPC method.
+type RateLimitFlowsResponse struct {
+	// flows are the current flows of the requested rules.
+	// If a rule's window has ended (or it hasn't had any flow yet), the flow is what a new window would start with.
+	Flows []RateLimitFlow `protobuf:"bytes,1,rep,name=flows,proto3" json:"flows"`
+}
+
+func (m *RateLimitFlowsResponse) Reset()         { *m = RateLimitFlowsResponse{} }
+func (m *RateLimitFlowsResponse) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlowsResponse) ProtoMessage()    {}
+func (*RateLimitFlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_530d9ff030c0dc3e, []int{5}
+}
+func (m *RateLimitFlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlowsResponse.Merge(m, src)
+}
+func (m *RateLimitFlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlowsResponse proto.InternalMessageInfo
+
+func (m *RateLimitFlowsResponse) GetFlows() []RateLimitFlow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "provenance.ibcratelimit.v1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "provenance.ibcratelimit.v1.ParamsResponse")
+	proto.RegisterType((*RateLimitRulesRequest)(nil), "provenance.ibcratelimit.v1.RateLimitRulesRequest")
+	proto.RegisterType((*RateLimitRulesResponse)(nil), "provenance.ibcratelimit.v1.RateLimitRulesResponse")
+	proto.RegisterType((*RateLimitFlowsRequest)(nil), "provenance.ibcratelimit.v1.RateLimitFlowsRequest")
+	proto.RegisterType((*RateLimitFlowsResponse)(nil), "provenance.ibcratelimit.v1.RateLimitFlowsResponse")
 }
 
 func init() {
@@ -122,25 +311,36 @@ func init() {
 }
 
 var fileDescriptor_530d9ff030c0dc3e = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2b, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x42, 0xa8, 0xd3, 0x43, 0x56, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e,
-	0x9f, 0x9e, 0x0f, 0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7,
-	0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27, 0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7,
-	0x15, 0x43, 0x65, 0xd5, 0xf1, 0xd8, 0x5b, 0x90, 0x58, 0x94, 0x98, 0x0b, 0x55, 0xa8, 0xc4, 0xcf,
-	0xc5, 0x1b, 0x00, 0xe6, 0x07, 0xa5, 0x16, 0x96, 0xa6, 0x16, 0x97, 0x28, 0x05, 0x71, 0xf1, 0xc1,
-	0x04, 0x8a, 0x0b, 0xf2, 0xf3, 0x8a, 0x53, 0x85, 0x1c, 0xb8, 0xd8, 0x20, 0x5a, 0x24, 0x18, 0x15,
-	0x18, 0x35, 0xb8, 0x8d, 0x94, 0xf4, 0x70, 0x3b, 0x56, 0x0f, 0xa2, 0xd7, 0x89, 0xe5, 0xc4, 0x3d,
-	0x79, 0x86, 0x20, 0xa8, 0x3e, 0xa3, 0xa9, 0x8c, 0x5c, 0xac, 0x81, 0x20, 0xdf, 0x0a, 0x75, 0x33,
-	0x72, 0xb1, 0x41, 0x94, 0x08, 0x69, 0x12, 0x36, 0x06, 0xea, 0x26, 0x29, 0x2d, 0x62, 0x94, 0x42,
-	0x5c, 0xab, 0xa4, 0xd5, 0x74, 0xf9, 0xc9, 0x64, 0x26, 0x15, 0x21, 0x25, 0x7d, 0x82, 0x41, 0xe0,
-	0x94, 0x7b, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x5c, 0xb2, 0x99, 0xf9, 0x78,
-	0xec, 0x0c, 0x60, 0x8c, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0x45,
-	0xb2, 0x48, 0x37, 0x33, 0x1f, 0xd9, 0xda, 0x0a, 0x14, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x6e, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0xae, 0xf7, 0xe5, 0x5c, 0x15, 0x02, 0x00, 0x00,
+	// 452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x8a, 0xd3, 0x40,
+	0x18, 0xc7, 0x33, 0xbb, 0xb6, 0xb0, 0xb3, 0xb8, 0xc2, 0xb0, 0x6a, 0x09, 0x6e, 0x5c, 0xa3, 0xe8,
+	0x76, 0xc5, 0x84, 0xc6, 0x17, 0x90, 0x05, 0x05, 0x61, 0x0f, 0x35, 0x47, 0x2f, 0x65, 0x9a, 0x8e,
+	0xe9, 0x40, 0x32, 0x93, 0x26, 0x93, 0xaa, 0x57, 0xaf, 0x5e, 0x04, 0xdf, 0xc0, 0xa7, 0xe9, 0xb1,
+	0xe0, 0xc5, 0x93, 0x4a, 0xeb, 0x83, 0x48, 0x66, 0x26, 0x6d, 0x22, 0x98, 0x36, 0xb7, 0xcc, 0x37,
+	0xff, 0xff, 0xf7, 0xff, 0xcd, 0x7c, 0x13, 0xf8, 0x38, 0x49, 0xf9, 0x9c, 0x30, 0xcc, 0x02, 0xe2,
+	0xd2, 0x71, 0x90, 0x62, 0x41, 0x22, 0x1a, 0x53, 0xe1, 0xce, 0x07, 0xee, 0x2c, 0x27, 0xe9, 0x47,
+	0x27, 0x49, 0xb9, 0xe0, 0xc8, 0xdc, 0xea, 0x9c, 0xaa, 0xce, 0x99, 0x0f, 0xcc, 0xd3, 0x90, 0x87,
+	0x5c, 0xca, 0xdc, 0xe2, 0x4b, 0x39, 0xcc, 0x7b, 0x21, 0xe7, 0x61, 0x44, 0x5c, 0x9c, 0x50, 0x17,
+	0x33, 0xc6, 0x05, 0x16, 0x94, 0xb3, 0x4c, 0xef, 0x3e, 0x69, 0xc8, 0x4d, 0x70, 0x8a, 0xe3, 0x52,
+	0xf8, 0xb4, 0x41, 0x58, 0x2c, 0x46, 0x0a, 0x43, 0x8a, 0xed, 0x5b, 0xf0, 0xe6, 0x50, 0x9a, 0x7d,
+	0x32, 0xcb, 0x49, 0x26, 0x6c, 0x1f, 0x9e, 0x94, 0x85, 0x2c, 0xe1, 0x2c, 0x23, 0xe8, 0x05, 0xec,
+	0xaa, 0xfe, 0x3d, 0x70, 0x0e, 0x2e, 0x8e, 0x3d, 0xdb, 0xf9, 0xff, 0xc9, 0x1c, 0xe5, 0xbd, 0xba,
+	0xb1, 0xf8, 0x79, 0xdf, 0xf0, 0xb5, 0xcf, 0xbe, 0x0b, 0x6f, 0xfb, 0x58, 0x90, 0xeb, 0x42, 0xe4,
+	0xe7, 0x11, 0xd9, 0x84, 0x8d, 0xe0, 0x9d, 0x7f, 0x37, 0x74, 0xe8, 0x4b, 0xd8, 0x49, 0x8b, 0x42,
+	0x0f, 0x9c, 0x1f, 0x5e, 0x1c, 0x7b, 0xfd, 0xa6, 0xcc, 0x5a, 0x0b, 0x1d, 0xad, 0xdc, 0xf6, 0x75,
+	0x25, 0xf9, 0x55, 0xc4, 0xdf, 0x97, 0xc9, 0xe8, 0x0c, 0xc2, 0x60, 0x8a, 0x19, 0x23, 0xd1, 0x88,
+	0x4e, 0xe4, 0xc1, 0x8e, 0xfc, 0x23, 0x5d, 0x79, 0x3d, 0x41, 0xa7, 0xb0, 0x33, 0x21, 0x8c, 0xc7,
+	0xbd, 0x03, 0xb9, 0xa3, 0x16, 0x35, 0x5c, 0xdd, 0x6d, 0x8b, 0xfb, 0xae, 0x28, 0xb4, 0xc2, 0x2d,
+	0x5a, 0x94, 0xb8, 0xd2, 0xed, 0xfd, 0x3a, 0x84, 0x9d, 0x37, 0xc5, 0x1b, 0x42, 0x9f, 0x01, 0xec,
+	0xaa, 0xbb, 0x44, 0xfd, 0xdd, 0xf7, 0xad, 0x4f, 0x65, 0x5e, 0xee, 0x23, 0x55, 0xc8, 0xf6, 0xe5,
+	0xa7, 0xef, 0x7f, 0xbe, 0x1e, 0x3c, 0x42, 0xb6, 0xbb, 0xf3, 0x61, 0xa1, 0x6f, 0x00, 0x9e, 0xd4,
+	0x07, 0x85, 0x06, 0x7b, 0x4f, 0x64, 0x43, 0xe7, 0xb5, 0xb1, 0x68, 0xca, 0xbe, 0xa4, 0x7c, 0x88,
+	0x1e, 0x34, 0x51, 0xca, 0x59, 0xd7, 0x21, 0xe5, 0x78, 0xf6, 0x84, 0xac, 0x3e, 0x0c, 0xd3, 0x6b,
+	0x63, 0x69, 0x03, 0x29, 0x27, 0x7c, 0x15, 0x2f, 0x56, 0x16, 0x58, 0xae, 0x2c, 0xf0, 0x7b, 0x65,
+	0x81, 0x2f, 0x6b, 0xcb, 0x58, 0xae, 0x2d, 0xe3, 0xc7, 0xda, 0x32, 0xe0, 0x19, 0xe5, 0x0d, 0xd1,
+	0x43, 0xf0, 0xd6, 0x0b, 0xa9, 0x98, 0xe6, 0x63, 0x27, 0xe0, 0x71, 0x25, 0xe7, 0x19, 0xe5, 0xd5,
+	0xd4, 0x0f, 0xb5, 0xdc, 0x71, 0x57, 0xfe, 0xe5, 0xcf, 0xff, 0x0e, 0x00, 0x98, 0x1d, 0x18, 0x8e,
+	0xb5, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +358,10 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// RateLimitRules returns all of the native rate limit rules.
+	RateLimitRules(ctx context.Context, in *RateLimitRulesRequest, opts ...grpc.CallOption) (*RateLimitRulesResponse, error)
+	// RateLimitFlows returns the current flow usage of each native rate limit rule.
+	RateLimitFlows(ctx context.Context, in *RateLimitFlowsRequest, opts ...grpc.CallOption) (*RateLimitFlowsResponse, error)
 }
 
 type queryClient struct {
@@ -177,11 +381,33 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) RateLimitRules(ctx context.Context, in *RateLimitRulesRequest, opts ...grpc.CallOption) (*RateLimitRulesResponse, error) {
+	out := new(RateLimitRulesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/RateLimitRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitFlows(ctx context.Context, in *RateLimitFlowsRequest, opts ...grpc.CallOption) (*RateLimitFlowsResponse, error) {
+	out := new(RateLimitFlowsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Query/RateLimitFlows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the ibcratelimit module's
 	// parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// RateLimitRules returns all of the native rate limit rules.
+	RateLimitRules(context.Context, *RateLimitRulesRequest) (*RateLimitRulesResponse, error)
+	// RateLimitFlows returns the current flow usage of each native rate limit rule.
+	RateLimitFlows(context.Context, *RateLimitFlowsRequest) (*RateLimitFlowsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -191,6 +417,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) RateLimitRules(ctx context.Context, req *RateLimitRulesRequest) (*RateLimitRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitRules not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitFlows(ctx context.Context, req *RateLimitFlowsRequest) (*RateLimitFlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitFlows not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -214,6 +446,42 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RateLimitRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/RateLimitRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitRules(ctx, req.(*RateLimitRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateLimitFlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitFlows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Query/RateLimitFlows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitFlows(ctx, req.(*RateLimitFlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.ibcratelimit.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -222,6 +490,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "RateLimitRules",
+			Handler:    _Query_RateLimitRules_Handler,
+		},
+		{
+			MethodName: "RateLimitFlows",
+			Handler:    _Query_RateLimitFlows_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/ibcratelimit/v1/query.proto",
@@ -283,6 +559,140 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RateLimitRulesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitRulesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitRulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitRulesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitRulesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitRulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for iNdEx := len(m.Rules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -314,13 +724,69 @@ func (m *ParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *RateLimitRulesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *RateLimitRulesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rules) > 0 {
+		for _, e := range m.Rules {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+
+func (m *RateLimitFlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitFlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -453,6 +919,338 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RateLimitRulesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitRulesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitRulesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitRulesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitRulesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitRulesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rules = append(m.Rules, RateLimitRule{})
+			if err := m.Rules[len(m.Rules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlowsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlowsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, RateLimitFlow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
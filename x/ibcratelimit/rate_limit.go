@@ -0,0 +1,166 @@
+package ibcratelimit
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// MaxPercent is the largest percentage allowed in a rate limit rule.
+const MaxPercent = 100
+
+// NewRateLimitRule creates a new RateLimitRule.
+func NewRateLimitRule(channelID, denom string, maxPercentSend, maxPercentRecv uint32, windowSeconds uint64) RateLimitRule {
+	return RateLimitRule{
+		ChannelId:      channelID,
+		Denom:          denom,
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		WindowSeconds:  windowSeconds,
+	}
+}
+
+// Validate returns an error if this rule is invalid.
+func (r RateLimitRule) Validate() error {
+	var errs []error
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		errs = append(errs, fmt.Errorf("invalid channel id: %w", err))
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid denom: %w", err))
+	}
+	if r.MaxPercentSend > MaxPercent {
+		errs = append(errs, fmt.Errorf("invalid max percent send %d: cannot exceed %d", r.MaxPercentSend, MaxPercent))
+	}
+	if r.MaxPercentRecv > MaxPercent {
+		errs = append(errs, fmt.Errorf("invalid max percent recv %d: cannot exceed %d", r.MaxPercentRecv, MaxPercent))
+	}
+	if r.MaxPercentSend == 0 && r.MaxPercentRecv == 0 {
+		errs = append(errs, errors.New("at least one of max percent send and max percent recv must be set"))
+	}
+	if r.WindowSeconds == 0 {
+		errs = append(errs, errors.New("invalid window seconds: cannot be zero"))
+	}
+	return errors.Join(errs...)
+}
+
+// GetWindow returns the length of this rule's windows.
+func (r RateLimitRule) GetWindow() time.Duration {
+	return time.Duration(r.WindowSeconds) * time.Second
+}
+
+// NewRateLimitFlow creates a new, empty, RateLimitFlow for a window of the provided rule that starts at the provided time.
+func NewRateLimitFlow(rule RateLimitRule, channelValue sdkmath.Int, windowStart time.Time) RateLimitFlow {
+	return RateLimitFlow{
+		ChannelId:    rule.ChannelId,
+		Denom:        rule.Denom,
+		Inflow:       sdkmath.ZeroInt(),
+		Outflow:      sdkmath.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowEnd:    windowStart.Add(rule.GetWindow()).UTC(),
+	}
+}
+
+// Validate returns an error if this flow is invalid.
+func (f RateLimitFlow) Validate() error {
+	var errs []error
+	if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+		errs = append(errs, fmt.Errorf("invalid channel id: %w", err))
+	}
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		errs = append(errs, fmt.Errorf("invalid denom: %w", err))
+	}
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		errs = append(errs, fmt.Errorf("invalid inflow %q: cannot be nil or negative", f.Inflow))
+	}
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		errs = append(errs, fmt.Errorf("invalid outflow %q: cannot be nil or negative", f.Outflow))
+	}
+	if f.ChannelValue.IsNil() || f.ChannelValue.IsNegative() {
+		errs = append(errs, fmt.Errorf("invalid channel value %q: cannot be nil or negative", f.ChannelValue))
+	}
+	return errors.Join(errs...)
+}
+
+// IsExpiredAt returns true if this flow's window has ended as of the provided time.
+func (f RateLimitFlow) IsExpiredAt(blockTime time.Time) bool {
+	return !blockTime.Before(f.WindowEnd)
+}
+
+// GetQuota returns the maximum net flow allowed with the provided percent of this flow's channel value.
+func (f RateLimitFlow) GetQuota(percent uint32) sdkmath.Int {
+	return f.ChannelValue.MulRaw(int64(percent)).QuoRaw(MaxPercent)
+}
+
+// AddOutflow records an amount sent and returns an error if the rule's send quota is now exceeded.
+func (f *RateLimitFlow) AddOutflow(rule RateLimitRule, amount sdkmath.Int) error {
+	f.Outflow = f.Outflow.Add(amount)
+	if rule.MaxPercentSend == 0 {
+		return nil
+	}
+	quota := f.GetQuota(rule.MaxPercentSend)
+	if net := f.Outflow.Sub(f.Inflow); net.GT(quota) {
+		return ErrRateLimitExceeded.Wrapf("%s%s outflow on %s exceeds quota of %s%s",
+			net, f.Denom, f.ChannelId, quota, f.Denom)
+	}
+	return nil
+}
+
+// AddInflow records an amount received and returns an error if the rule's receive quota is now exceeded.
+func (f *RateLimitFlow) AddInflow(rule RateLimitRule, amount sdkmath.Int) error {
+	f.Inflow = f.Inflow.Add(amount)
+	if rule.MaxPercentRecv == 0 {
+		return nil
+	}
+	quota := f.GetQuota(rule.MaxPercentRecv)
+	if net := f.Inflow.Sub(f.Outflow); net.GT(quota) {
+		return ErrRateLimitExceeded.Wrapf("%s%s inflow on %s exceeds quota of %s%s",
+			net, f.Denom, f.ChannelId, quota, f.Denom)
+	}
+	return nil
+}
+
+// UndoOutflow removes an amount from the outflow (e.g. because the send failed).
+// The outflow will not go below zero.
+func (f *RateLimitFlow) UndoOutflow(amount sdkmath.Int) {
+	f.Outflow = sdkmath.MaxInt(f.Outflow.Sub(amount), sdkmath.ZeroInt())
+}
+
+// GetPacketFlowInfo returns the channel (on this chain) and local denom that the packet's funds flow through,
+// as well as the amount being transferred.
+//
+// For sends, the channel is the source channel and the packet denom is the full trace of the denom being sent.
+// For receives, the channel is the destination channel, and the denom depends on whether this chain is the source of it.
+func GetPacketFlowInfo(msgType string, packet UnwrappedPacket) (channelID string, denom string, amount sdkmath.Int, err error) {
+	var ok bool
+	amount, ok = sdkmath.NewIntFromString(packet.Data.Amount)
+	if !ok {
+		return "", "", sdkmath.Int{}, ErrBadMessage.Wrapf("invalid packet amount %q", packet.Data.Amount)
+	}
+
+	switch msgType {
+	case MsgSendPacket:
+		channelID = packet.SourceChannel
+		denom = transfertypes.ParseDenomTrace(packet.Data.Denom).IBCDenom()
+	case MsgRecvPacket:
+		channelID = packet.DestinationChannel
+		if transfertypes.ReceiverChainIsSource(packet.SourcePort, packet.SourceChannel, packet.Data.Denom) {
+			prefix := transfertypes.GetDenomPrefix(packet.SourcePort, packet.SourceChannel)
+			denom = transfertypes.ParseDenomTrace(strings.TrimPrefix(packet.Data.Denom, prefix)).IBCDenom()
+		} else {
+			prefix := transfertypes.GetDenomPrefix(packet.DestinationPort, packet.DestinationChannel)
+			denom = transfertypes.ParseDenomTrace(prefix + packet.Data.Denom).IBCDenom()
+		}
+	default:
+		return "", "", sdkmath.Int{}, ErrBadMessage
+	}
+
+	return channelID, denom, amount, nil
+}
@@ -0,0 +1,260 @@
+package ibcratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+
+	"github.com/provenance-io/provenance/x/ibcratelimit"
+)
+
+func TestRateLimitRuleValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		rule ibcratelimit.RateLimitRule
+		err  string
+	}{
+		{
+			name: "success - valid rule",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600),
+		},
+		{
+			name: "success - only send",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 100, 0, 1),
+		},
+		{
+			name: "success - only recv",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 0, 100, 1),
+		},
+		{
+			name: "failure - invalid channel",
+			rule: ibcratelimit.NewRateLimitRule("", "nhash", 5, 10, 3600),
+			err:  "invalid channel id: identifier cannot be blank: invalid identifier",
+		},
+		{
+			name: "failure - invalid denom",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "x", 5, 10, 3600),
+			err:  "invalid denom: invalid denom: x",
+		},
+		{
+			name: "failure - send too large",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 101, 10, 3600),
+			err:  "invalid max percent send 101: cannot exceed 100",
+		},
+		{
+			name: "failure - recv too large",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 101, 3600),
+			err:  "invalid max percent recv 101: cannot exceed 100",
+		},
+		{
+			name: "failure - no quotas",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 0, 0, 3600),
+			err:  "at least one of max percent send and max percent recv must be set",
+		},
+		{
+			name: "failure - no window",
+			rule: ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 0),
+			err:  "invalid window seconds: cannot be zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			if len(tc.err) > 0 {
+				assert.ErrorContains(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestNewRateLimitFlow(t *testing.T) {
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	start := time.Unix(1700000000, 0)
+	flow := ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(1000), start)
+
+	assert.Equal(t, "channel-0", flow.ChannelId, "ChannelId")
+	assert.Equal(t, "nhash", flow.Denom, "Denom")
+	assert.Equal(t, sdkmath.ZeroInt(), flow.Inflow, "Inflow")
+	assert.Equal(t, sdkmath.ZeroInt(), flow.Outflow, "Outflow")
+	assert.Equal(t, sdkmath.NewInt(1000), flow.ChannelValue, "ChannelValue")
+	assert.Equal(t, start.Add(time.Hour).UTC(), flow.WindowEnd, "WindowEnd")
+	assert.NoError(t, flow.Validate(), "Validate")
+
+	assert.False(t, flow.IsExpiredAt(start), "IsExpiredAt(start)")
+	assert.False(t, flow.IsExpiredAt(start.Add(time.Hour-time.Second)), "IsExpiredAt(end - 1s)")
+	assert.True(t, flow.IsExpiredAt(start.Add(time.Hour)), "IsExpiredAt(end)")
+}
+
+func TestRateLimitFlowValidate(t *testing.T) {
+	valid := ibcratelimit.NewRateLimitFlow(ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600), sdkmath.NewInt(1000), time.Unix(1700000000, 0))
+
+	tests := []struct {
+		name   string
+		modify func(f *ibcratelimit.RateLimitFlow)
+		err    string
+	}{
+		{
+			name:   "success - valid flow",
+			modify: func(_ *ibcratelimit.RateLimitFlow) {},
+		},
+		{
+			name:   "failure - invalid channel",
+			modify: func(f *ibcratelimit.RateLimitFlow) { f.ChannelId = "" },
+			err:    "invalid channel id: identifier cannot be blank: invalid identifier",
+		},
+		{
+			name:   "failure - negative inflow",
+			modify: func(f *ibcratelimit.RateLimitFlow) { f.Inflow = sdkmath.NewInt(-1) },
+			err:    `invalid inflow "-1": cannot be nil or negative`,
+		},
+		{
+			name:   "failure - nil outflow",
+			modify: func(f *ibcratelimit.RateLimitFlow) { f.Outflow = sdkmath.Int{} },
+			err:    `invalid outflow "<nil>": cannot be nil or negative`,
+		},
+		{
+			name:   "failure - negative channel value",
+			modify: func(f *ibcratelimit.RateLimitFlow) { f.ChannelValue = sdkmath.NewInt(-5) },
+			err:    `invalid channel value "-5": cannot be nil or negative`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			flow := valid
+			tc.modify(&flow)
+			err := flow.Validate()
+			if len(tc.err) > 0 {
+				assert.ErrorContains(t, err, tc.err, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestRateLimitFlowQuotas(t *testing.T) {
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	flow := ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(1000), time.Unix(1700000000, 0))
+
+	assert.Equal(t, sdkmath.NewInt(50), flow.GetQuota(rule.MaxPercentSend), "send quota")
+	assert.Equal(t, sdkmath.NewInt(100), flow.GetQuota(rule.MaxPercentRecv), "recv quota")
+
+	require.NoError(t, flow.AddOutflow(rule, sdkmath.NewInt(50)), "AddOutflow(50)")
+	err := flow.AddOutflow(rule, sdkmath.NewInt(1))
+	require.EqualError(t, err, "51nhash outflow on channel-0 exceeds quota of 50nhash: rate limit exceeded", "AddOutflow(1)")
+	flow.UndoOutflow(sdkmath.NewInt(1))
+	assert.Equal(t, sdkmath.NewInt(50), flow.Outflow, "Outflow after undo")
+
+	// Inflows offset outflows, so more can now be sent.
+	require.NoError(t, flow.AddInflow(rule, sdkmath.NewInt(20)), "AddInflow(20)")
+	require.NoError(t, flow.AddOutflow(rule, sdkmath.NewInt(20)), "AddOutflow(20)")
+
+	// Net inflow is now -50, so up to 150 can be received.
+	require.NoError(t, flow.AddInflow(rule, sdkmath.NewInt(150)), "AddInflow(150)")
+	err = flow.AddInflow(rule, sdkmath.NewInt(1))
+	require.EqualError(t, err, "101nhash inflow on channel-0 exceeds quota of 100nhash: rate limit exceeded", "AddInflow(1)")
+
+	flow.UndoOutflow(sdkmath.NewInt(1000))
+	assert.Equal(t, sdkmath.ZeroInt(), flow.Outflow, "Outflow after large undo")
+
+	// A percent of zero means that direction is not limited.
+	unlimited := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 0, 10, 3600)
+	assert.NoError(t, flow.AddOutflow(unlimited, sdkmath.NewInt(1_000_000)), "AddOutflow with no send quota")
+}
+
+func TestGetPacketFlowInfo(t *testing.T) {
+	newPacket := func(denom, amount string) ibcratelimit.UnwrappedPacket {
+		return ibcratelimit.UnwrappedPacket{
+			SourcePort:         "transfer",
+			SourceChannel:      "channel-3",
+			DestinationPort:    "transfer",
+			DestinationChannel: "channel-7",
+			Data: transfertypes.FungibleTokenPacketData{
+				Denom:  denom,
+				Amount: amount,
+			},
+		}
+	}
+	ibcDenom := func(trace string) string {
+		return transfertypes.ParseDenomTrace(trace).IBCDenom()
+	}
+
+	tests := []struct {
+		name       string
+		msgType    string
+		packet     ibcratelimit.UnwrappedPacket
+		expChannel string
+		expDenom   string
+		expAmount  sdkmath.Int
+		err        string
+	}{
+		{
+			name:       "send native denom",
+			msgType:    ibcratelimit.MsgSendPacket,
+			packet:     newPacket("nhash", "10"),
+			expChannel: "channel-3",
+			expDenom:   "nhash",
+			expAmount:  sdkmath.NewInt(10),
+		},
+		{
+			name:       "send ibc denom",
+			msgType:    ibcratelimit.MsgSendPacket,
+			packet:     newPacket("transfer/channel-1/uatom", "10"),
+			expChannel: "channel-3",
+			expDenom:   ibcDenom("transfer/channel-1/uatom"),
+			expAmount:  sdkmath.NewInt(10),
+		},
+		{
+			name:       "recv denom native to this chain",
+			msgType:    ibcratelimit.MsgRecvPacket,
+			packet:     newPacket("transfer/channel-3/nhash", "25"),
+			expChannel: "channel-7",
+			expDenom:   "nhash",
+			expAmount:  sdkmath.NewInt(25),
+		},
+		{
+			name:       "recv denom from the other chain",
+			msgType:    ibcratelimit.MsgRecvPacket,
+			packet:     newPacket("uatom", "25"),
+			expChannel: "channel-7",
+			expDenom:   ibcDenom("transfer/channel-7/uatom"),
+			expAmount:  sdkmath.NewInt(25),
+		},
+		{
+			name:    "failure - bad amount",
+			msgType: ibcratelimit.MsgSendPacket,
+			packet:  newPacket("nhash", "abc"),
+			err:     `invalid packet amount "abc": bad message`,
+		},
+		{
+			name:    "failure - unknown msg type",
+			msgType: "unknown",
+			packet:  newPacket("nhash", "10"),
+			err:     "bad message",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			channelID, denom, amount, err := ibcratelimit.GetPacketFlowInfo(tc.msgType, tc.packet)
+			if len(tc.err) > 0 {
+				assert.EqualError(t, err, tc.err, "GetPacketFlowInfo error")
+				return
+			}
+			require.NoError(t, err, "GetPacketFlowInfo error")
+			assert.Equal(t, tc.expChannel, channelID, "channel id")
+			assert.Equal(t, tc.expDenom, denom, "denom")
+			assert.Equal(t, tc.expAmount, amount, "amount")
+		})
+	}
+}
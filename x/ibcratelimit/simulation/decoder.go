@@ -22,6 +22,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &attribB)
 
 			return fmt.Sprintf("Params: A:[%v] B:[%v]\n", attribA, attribB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.RateLimitRuleKeyPrefix):
+			var ruleA, ruleB ibcratelimit.RateLimitRule
+
+			cdc.MustUnmarshal(kvA.Value, &ruleA)
+			cdc.MustUnmarshal(kvB.Value, &ruleB)
+
+			return fmt.Sprintf("RateLimitRule: A:[%v] B:[%v]\n", ruleA, ruleB)
+		case bytes.Equal(kvA.Key[:1], ibcratelimit.RateLimitFlowKeyPrefix):
+			var flowA, flowB ibcratelimit.RateLimitFlow
+
+			cdc.MustUnmarshal(kvA.Value, &flowA)
+			cdc.MustUnmarshal(kvB.Value, &flowB)
+
+			return fmt.Sprintf("RateLimitFlow: A:[%v] B:[%v]\n", flowA, flowB)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", ibcratelimit.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -2,9 +2,12 @@ package simulation_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdkmath "cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/provenance-io/provenance/app"
@@ -20,6 +23,8 @@ func TestDecodeStore(t *testing.T) {
 		p := ibcratelimit.NewParams("contract a")
 		return cdc.MustMarshal(&p)
 	}
+	rule := ibcratelimit.NewRateLimitRule("channel-0", "nhash", 5, 10, 3600)
+	flow := ibcratelimit.NewRateLimitFlow(rule, sdkmath.NewInt(1000), time.Unix(1700000000, 0))
 
 	tests := []struct {
 		name     string
@@ -40,6 +45,19 @@ func TestDecodeStore(t *testing.T) {
 			kvB:  kv.Pair{Key: ibcratelimit.ParamsKey, Value: params("contract b")},
 			exp:  "Params: A:[{contract a}] B:[{contract a}]\n",
 		},
+		{
+			name: "success - RateLimitRule",
+			kvA:  kv.Pair{Key: ibcratelimit.GetRateLimitRuleKey("channel-0", "nhash"), Value: cdc.MustMarshal(&rule)},
+			kvB:  kv.Pair{Key: ibcratelimit.GetRateLimitRuleKey("channel-0", "nhash"), Value: cdc.MustMarshal(&rule)},
+			exp:  "RateLimitRule: A:[{channel-0 nhash 5 10 3600}] B:[{channel-0 nhash 5 10 3600}]\n",
+		},
+		{
+			name: "success - RateLimitFlow",
+			kvA:  kv.Pair{Key: ibcratelimit.GetRateLimitFlowKey("channel-0", "nhash"), Value: cdc.MustMarshal(&flow)},
+			kvB:  kv.Pair{Key: ibcratelimit.GetRateLimitFlowKey("channel-0", "nhash"), Value: cdc.MustMarshal(&flow)},
+			exp: "RateLimitFlow: A:[{channel-0 nhash 0 0 1000 2023-11-14 23:13:20 +0000 UTC}] " +
+				"B:[{channel-0 nhash 0 0 1000 2023-11-14 23:13:20 +0000 UTC}]\n",
+		},
 	}
 
 	for _, tc := range tests {
@@ -67,6 +67,8 @@ func TestRandomizedGenState(t *testing.T) {
 			accounts: nil,
 			expRateLimitGen: &ibcratelimit.GenesisState{
 				Params: ibcratelimit.NewParams(""),
+				Rules:  []ibcratelimit.RateLimitRule{},
+				Flows:  []ibcratelimit.RateLimitFlow{},
 			},
 		},
 		{
@@ -75,6 +77,8 @@ func TestRandomizedGenState(t *testing.T) {
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
 				Params: ibcratelimit.NewParams(""),
+				Rules:  []ibcratelimit.RateLimitRule{},
+				Flows:  []ibcratelimit.RateLimitFlow{},
 			},
 		},
 		{
@@ -83,6 +87,8 @@ func TestRandomizedGenState(t *testing.T) {
 			accounts: accs,
 			expRateLimitGen: &ibcratelimit.GenesisState{
 				Params: ibcratelimit.NewParams("cosmos12jszjrc0qhjt0ugt2uh4ptwu0h55pq6qfp9ecl"),
+				Rules:  []ibcratelimit.RateLimitRule{},
+				Flows:  []ibcratelimit.RateLimitFlow{},
 			},
 		},
 	}
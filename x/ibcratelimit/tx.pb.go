@@ -220,11 +220,208 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetRateLimitRuleRequest is a request message for the SetRateLimitRule endpoint.
+type MsgSetRateLimitRuleRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// rule is the rate limit rule to create or update.
+	Rule RateLimitRule `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule"`
+}
+
+func (m *MsgSetRateLimitRuleRequest) Reset()         { *m = MsgSetRateLimitRuleRequest{} }
+func (m *MsgSetRateLimitRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitRuleRequest) ProtoMessage()    {}
+func (*MsgSetRateLimitRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{4}
+}
+func (m *MsgSetRateLimitRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitRuleRequest.Merge(m, src)
+}
+func (m *MsgSetRateLimitRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitRuleRequest proto.InternalMessageInfo
+
+func (m *MsgSetRateLimitRuleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetRateLimitRuleRequest) GetRule() RateLimitRule {
+	if m != nil {
+		return m.Rule
+	}
+	return RateLimitRule{}
+}
+
+// MsgSetRateLimitRuleResponse is a response message for the SetRateLimitRule endpoint.
+type MsgSetRateLimitRuleResponse struct {
+}
+
+func (m *MsgSetRateLimitRuleResponse) Reset()         { *m = MsgSetRateLimitRuleResponse{} }
+func (m *MsgSetRateLimitRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRateLimitRuleResponse) ProtoMessage()    {}
+func (*MsgSetRateLimitRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{5}
+}
+func (m *MsgSetRateLimitRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRateLimitRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRateLimitRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRateLimitRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRateLimitRuleResponse.Merge(m, src)
+}
+func (m *MsgSetRateLimitRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRateLimitRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRateLimitRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetRateLimitRuleResponse proto.InternalMessageInfo
+
+// MsgRemoveRateLimitRuleRequest is a request message for the RemoveRateLimitRule endpoint.
+type MsgRemoveRateLimitRuleRequest struct {
+	// authority should be the governance module account address.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// channel_id is the id of the channel of the rule to remove.
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom is the denom of the rule to remove.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) Reset()         { *m = MsgRemoveRateLimitRuleRequest{} }
+func (m *MsgRemoveRateLimitRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitRuleRequest) ProtoMessage()    {}
+func (*MsgRemoveRateLimitRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{6}
+}
+func (m *MsgRemoveRateLimitRuleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitRuleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitRuleRequest.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitRuleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitRuleRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveRateLimitRuleRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgRemoveRateLimitRuleResponse is a response message for the RemoveRateLimitRule endpoint.
+type MsgRemoveRateLimitRuleResponse struct {
+}
+
+func (m *MsgRemoveRateLimitRuleResponse) Reset()         { *m = MsgRemoveRateLimitRuleResponse{} }
+func (m *MsgRemoveRateLimitRuleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveRateLimitRuleResponse) ProtoMessage()    {}
+func (*MsgRemoveRateLimitRuleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e09935355436fc3e, []int{7}
+}
+func (m *MsgRemoveRateLimitRuleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveRateLimitRuleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveRateLimitRuleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveRateLimitRuleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveRateLimitRuleResponse.Merge(m, src)
+}
+func (m *MsgRemoveRateLimitRuleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveRateLimitRuleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveRateLimitRuleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveRateLimitRuleResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgGovUpdateParamsRequest)(nil), "provenance.ibcratelimit.v1.MsgGovUpdateParamsRequest")
 	proto.RegisterType((*MsgGovUpdateParamsResponse)(nil), "provenance.ibcratelimit.v1.MsgGovUpdateParamsResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.ibcratelimit.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.ibcratelimit.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRateLimitRuleRequest)(nil), "provenance.ibcratelimit.v1.MsgSetRateLimitRuleRequest")
+	proto.RegisterType((*MsgSetRateLimitRuleResponse)(nil), "provenance.ibcratelimit.v1.MsgSetRateLimitRuleResponse")
+	proto.RegisterType((*MsgRemoveRateLimitRuleRequest)(nil), "provenance.ibcratelimit.v1.MsgRemoveRateLimitRuleRequest")
+	proto.RegisterType((*MsgRemoveRateLimitRuleResponse)(nil), "provenance.ibcratelimit.v1.MsgRemoveRateLimitRuleResponse")
 }
 
 func init() {
@@ -232,32 +429,42 @@ func init() {
 }
 
 var fileDescriptor_e09935355436fc3e = []byte{
-	// 396 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x4c, 0x4a, 0x2e, 0x4a, 0x2c, 0x49, 0xcd, 0xc9,
-	0xcc, 0xcd, 0x2c, 0xd1, 0x2f, 0x33, 0xd4, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x92, 0x42, 0x28, 0xd2, 0x43, 0x56, 0xa4, 0x57, 0x66, 0x28, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f,
-	0x56, 0xa6, 0x0f, 0x62, 0x41, 0x74, 0x48, 0x49, 0x26, 0xe7, 0x17, 0xe7, 0xe6, 0x17, 0xc7, 0x43,
-	0x24, 0x20, 0x1c, 0xa8, 0x94, 0x38, 0x84, 0xa7, 0x9f, 0x5b, 0x9c, 0x0e, 0xb2, 0x24, 0xb7, 0x38,
-	0x1d, 0x2a, 0xa1, 0x8e, 0xc7, 0x29, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x13, 0x94, 0x96, 0x33,
-	0x72, 0x49, 0xfa, 0x16, 0xa7, 0xbb, 0xe7, 0x97, 0x85, 0x16, 0xa4, 0x24, 0x96, 0xa4, 0x06, 0x80,
-	0x25, 0x83, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x84, 0xcc, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32,
-	0xf2, 0x8b, 0x32, 0x4b, 0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1,
-	0x15, 0x81, 0x3a, 0xc2, 0x31, 0x25, 0xa5, 0x28, 0xb5, 0xb8, 0x38, 0xb8, 0xa4, 0x28, 0x33, 0x2f,
-	0x3d, 0x08, 0xa1, 0x54, 0xc8, 0x81, 0x8b, 0x0d, 0x62, 0x8b, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xb7,
-	0x91, 0x92, 0x1e, 0x6e, 0x5f, 0xeb, 0x41, 0xac, 0x74, 0x62, 0x39, 0x71, 0x4f, 0x9e, 0x21, 0x08,
-	0xaa, 0xcf, 0x4a, 0xa0, 0xe9, 0xf9, 0x06, 0x2d, 0x64, 0xcb, 0x95, 0x14, 0xb8, 0xa4, 0xb0, 0x39,
-	0xb4, 0xb8, 0x20, 0x3f, 0xaf, 0x38, 0xd5, 0x8a, 0x49, 0x82, 0x51, 0x69, 0x11, 0x23, 0x97, 0x98,
-	0x6f, 0x71, 0xfa, 0xe0, 0xf2, 0x08, 0x1f, 0xaa, 0x47, 0x94, 0x24, 0xb9, 0xc4, 0x31, 0xdc, 0x08,
-	0xf1, 0x83, 0x51, 0x2f, 0x13, 0x17, 0xb3, 0x6f, 0x71, 0xba, 0x50, 0x2b, 0x23, 0x17, 0x3f, 0x9a,
-	0x3f, 0x85, 0x4c, 0xf1, 0x59, 0x8c, 0x33, 0x02, 0xa5, 0xcc, 0x48, 0xd5, 0x06, 0x71, 0x8a, 0x12,
-	0x73, 0x07, 0x13, 0xa3, 0x50, 0x39, 0x17, 0x0f, 0x8a, 0x1b, 0x8c, 0x08, 0x18, 0x86, 0xcd, 0x01,
-	0xc6, 0x24, 0xe9, 0x81, 0xd8, 0x2e, 0xc5, 0xda, 0xf0, 0x7c, 0x83, 0x16, 0xa3, 0x53, 0xee, 0x89,
-	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3,
-	0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x70, 0xc9, 0x66, 0xe6, 0xe3, 0x31, 0x37, 0x80,
-	0x31, 0xca, 0x28, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xa1, 0x50,
-	0x37, 0x33, 0x1f, 0x89, 0xa7, 0x5f, 0x81, 0x92, 0x35, 0x92, 0xd8, 0xc0, 0x39, 0xc2, 0x18, 0x10,
-	0x00, 0x00, 0xff, 0xff, 0xd9, 0x8d, 0x1a, 0xad, 0xc7, 0x03, 0x00, 0x00,
+	// 554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x94, 0x31, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc6, 0x73, 0x49, 0x5a, 0x29, 0xef, 0xff, 0x2f, 0xa8, 0x8e, 0x88, 0x26, 0x46, 0x31, 0x91,
+	0x19, 0x28, 0x45, 0xb5, 0xd5, 0x54, 0x14, 0x91, 0x09, 0xc2, 0x80, 0x90, 0x88, 0x54, 0xb9, 0x62,
+	0x61, 0x89, 0x9c, 0xf8, 0x74, 0xb1, 0x14, 0xfb, 0x82, 0xef, 0x62, 0xca, 0x86, 0x40, 0x48, 0x0c,
+	0x0c, 0x7c, 0x00, 0xa6, 0x2e, 0x88, 0xad, 0x03, 0x1f, 0xa2, 0x63, 0xc5, 0xc4, 0x84, 0x50, 0x32,
+	0xf4, 0x6b, 0xa0, 0xf8, 0x0e, 0x25, 0x69, 0x62, 0x47, 0x01, 0x06, 0xb6, 0xbc, 0x79, 0x9f, 0xe7,
+	0x7d, 0x9f, 0xdf, 0xf9, 0x6c, 0xb8, 0xd1, 0x0f, 0x59, 0x44, 0x02, 0x27, 0xe8, 0x10, 0xcb, 0x6b,
+	0x77, 0x42, 0x47, 0x90, 0x9e, 0xe7, 0x7b, 0xc2, 0x8a, 0x76, 0x2d, 0x71, 0x64, 0xf6, 0x43, 0x26,
+	0x18, 0xd6, 0x26, 0x22, 0x73, 0x5a, 0x64, 0x46, 0xbb, 0x5a, 0x91, 0x32, 0xca, 0x62, 0x99, 0x35,
+	0xfe, 0x25, 0x1d, 0x5a, 0xb9, 0xc3, 0xb8, 0xcf, 0x78, 0x4b, 0x36, 0x64, 0xa1, 0x5a, 0x9b, 0xb2,
+	0xb2, 0x7c, 0x4e, 0xc7, 0x4b, 0x7c, 0x4e, 0x55, 0xe3, 0x66, 0x4a, 0x94, 0xbe, 0x13, 0x3a, 0xfe,
+	0xaf, 0x09, 0xb7, 0x53, 0x84, 0xe3, 0xa2, 0x25, 0xc3, 0xc5, 0x62, 0xe3, 0x13, 0x82, 0x72, 0x93,
+	0xd3, 0x47, 0x2c, 0x7a, 0xda, 0x77, 0x1d, 0x41, 0x0e, 0xe2, 0x49, 0x36, 0x79, 0x3e, 0x20, 0x5c,
+	0xe0, 0x7d, 0x28, 0x38, 0x03, 0xd1, 0x65, 0xa1, 0x27, 0x5e, 0x96, 0x50, 0x15, 0x6d, 0x15, 0x1a,
+	0xa5, 0xaf, 0x5f, 0x76, 0x8a, 0x2a, 0xf1, 0x03, 0xd7, 0x0d, 0x09, 0xe7, 0x87, 0x22, 0xf4, 0x02,
+	0x6a, 0x4f, 0xa4, 0xf8, 0x3e, 0xac, 0xcb, 0x48, 0xa5, 0x6c, 0x15, 0x6d, 0xfd, 0x57, 0x33, 0xcc,
+	0xe4, 0x23, 0x32, 0xe5, 0xca, 0x46, 0xfe, 0xf4, 0xfb, 0xf5, 0x8c, 0xad, 0x7c, 0xf5, 0x8d, 0xd7,
+	0xe7, 0x27, 0xdb, 0xd3, 0xcb, 0x8d, 0x2a, 0x68, 0x8b, 0x82, 0xf2, 0x3e, 0x0b, 0x38, 0xa9, 0x67,
+	0x4b, 0xc8, 0x38, 0x46, 0x70, 0xb5, 0xc9, 0xe9, 0xbf, 0x05, 0x72, 0x69, 0x16, 0xc4, 0x28, 0xc3,
+	0xe6, 0x5c, 0x46, 0xc9, 0x60, 0x7c, 0x46, 0x31, 0xe2, 0x21, 0x11, 0xb6, 0x23, 0xc8, 0x93, 0xf1,
+	0x58, 0x7b, 0xd0, 0x23, 0x7f, 0xca, 0xf0, 0x10, 0xf2, 0xe1, 0xa0, 0x47, 0x14, 0xc1, 0xad, 0x34,
+	0x82, 0x99, 0xbd, 0x0a, 0x24, 0x36, 0xcf, 0x61, 0x54, 0xe0, 0xda, 0xc2, 0xa8, 0x0a, 0xe5, 0x23,
+	0x82, 0x4a, 0x93, 0x53, 0x9b, 0xf8, 0x2c, 0x22, 0x7f, 0x95, 0xa6, 0x02, 0xd0, 0xe9, 0x3a, 0x41,
+	0x40, 0x7a, 0x2d, 0xcf, 0x8d, 0x99, 0x0a, 0x76, 0x41, 0xfd, 0xf3, 0xd8, 0xc5, 0x45, 0x58, 0x73,
+	0x49, 0xc0, 0xfc, 0x52, 0x2e, 0xee, 0xc8, 0x62, 0x2e, 0x7d, 0x15, 0xf4, 0xa4, 0x74, 0x12, 0xa0,
+	0x76, 0x9c, 0x87, 0x5c, 0x93, 0x53, 0xfc, 0x16, 0xc1, 0xe5, 0x0b, 0x77, 0x0e, 0xdf, 0x49, 0x3b,
+	0xc2, 0xc4, 0x97, 0x49, 0xdb, 0x5f, 0xd5, 0xa6, 0xce, 0x32, 0xf7, 0x2e, 0x8b, 0xf0, 0x0b, 0xf8,
+	0x7f, 0x26, 0x43, 0x6d, 0xc9, 0xb0, 0x45, 0x01, 0xf6, 0x56, 0xf2, 0xc8, 0xed, 0xf8, 0x0d, 0x82,
+	0x8d, 0x8b, 0x8f, 0x19, 0x2f, 0x43, 0x49, 0xb8, 0xc2, 0xda, 0xdd, 0x95, 0x7d, 0x2a, 0xc5, 0x7b,
+	0x04, 0x57, 0x16, 0x3c, 0x2e, 0x7c, 0x6f, 0xc9, 0xc0, 0xe4, 0x0b, 0xa8, 0xd5, 0x7f, 0xc7, 0x2a,
+	0xe3, 0x68, 0x6b, 0xaf, 0xce, 0x4f, 0xb6, 0x51, 0xc3, 0x3f, 0x1d, 0xea, 0xe8, 0x6c, 0xa8, 0xa3,
+	0x1f, 0x43, 0x1d, 0x7d, 0x18, 0xe9, 0x99, 0xb3, 0x91, 0x9e, 0xf9, 0x36, 0xd2, 0x33, 0x50, 0xf1,
+	0x58, 0xca, 0xf8, 0x03, 0xf4, 0xac, 0x46, 0x3d, 0xd1, 0x1d, 0xb4, 0xcd, 0x0e, 0xf3, 0xad, 0x89,
+	0x70, 0xc7, 0x63, 0x53, 0x95, 0x75, 0x34, 0xf3, 0xfd, 0x6e, 0xaf, 0xc7, 0x9f, 0xec, 0xbd, 0x9f,
+	0x03, 0x00, 0x2d, 0x9f, 0xed, 0x28, 0x95, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -277,6 +484,10 @@ type MsgClient interface {
 	GovUpdateParams(ctx context.Context, in *MsgGovUpdateParamsRequest, opts ...grpc.CallOption) (*MsgGovUpdateParamsResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the ibcratelimit module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRateLimitRule is a governance proposal endpoint for creating or updating a native rate limit rule.
+	SetRateLimitRule(ctx context.Context, in *MsgSetRateLimitRuleRequest, opts ...grpc.CallOption) (*MsgSetRateLimitRuleResponse, error)
+	// RemoveRateLimitRule is a governance proposal endpoint for removing a native rate limit rule.
+	RemoveRateLimitRule(ctx context.Context, in *MsgRemoveRateLimitRuleRequest, opts ...grpc.CallOption) (*MsgRemoveRateLimitRuleResponse, error)
 }
 
 type msgClient struct {
@@ -306,6 +517,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) SetRateLimitRule(ctx context.Context, in *MsgSetRateLimitRuleRequest, opts ...grpc.CallOption) (*MsgSetRateLimitRuleResponse, error) {
+	out := new(MsgSetRateLimitRuleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Msg/SetRateLimitRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimitRule(ctx context.Context, in *MsgRemoveRateLimitRuleRequest, opts ...grpc.CallOption) (*MsgRemoveRateLimitRuleResponse, error) {
+	out := new(MsgRemoveRateLimitRuleResponse)
+	err := c.cc.Invoke(ctx, "/provenance.ibcratelimit.v1.Msg/RemoveRateLimitRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// GovUpdateParams is a governance proposal endpoint for updating the exchange module's params.
@@ -313,6 +542,10 @@ type MsgServer interface {
 	GovUpdateParams(context.Context, *MsgGovUpdateParamsRequest) (*MsgGovUpdateParamsResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the ibcratelimit module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// SetRateLimitRule is a governance proposal endpoint for creating or updating a native rate limit rule.
+	SetRateLimitRule(context.Context, *MsgSetRateLimitRuleRequest) (*MsgSetRateLimitRuleResponse, error)
+	// RemoveRateLimitRule is a governance proposal endpoint for removing a native rate limit rule.
+	RemoveRateLimitRule(context.Context, *MsgRemoveRateLimitRuleRequest) (*MsgRemoveRateLimitRuleResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -325,6 +558,12 @@ func (*UnimplementedMsgServer) GovUpdateParams(ctx context.Context, req *MsgGovU
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetRateLimitRule(ctx context.Context, req *MsgSetRateLimitRuleRequest) (*MsgSetRateLimitRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimitRule not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimitRule(ctx context.Context, req *MsgRemoveRateLimitRuleRequest) (*MsgRemoveRateLimitRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimitRule not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -366,6 +605,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRateLimitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Msg/SetRateLimitRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimitRule(ctx, req.(*MsgSetRateLimitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveRateLimitRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimitRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimitRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.ibcratelimit.v1.Msg/RemoveRateLimitRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimitRule(ctx, req.(*MsgRemoveRateLimitRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.ibcratelimit.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -378,6 +653,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetRateLimitRule",
+			Handler:    _Msg_SetRateLimitRule_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimitRule",
+			Handler:    _Msg_RemoveRateLimitRule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/ibcratelimit/v1/tx.proto",
@@ -509,6 +792,136 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetRateLimitRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitRuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRateLimitRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRateLimitRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRateLimitRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitRuleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitRuleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitRuleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -554,27 +967,246 @@ func (m *MsgUpdateParamsRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRateLimitRuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Rule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetRateLimitRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimitRuleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitRuleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgGovUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgGovUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -597,10 +1229,10 @@ func (m *MsgGovUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -689,7 +1321,7 @@ func (m *MsgGovUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -712,10 +1344,10 @@ func (m *MsgGovUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -739,7 +1371,7 @@ func (m *MsgGovUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimitRuleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -762,10 +1394,10 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimitRuleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimitRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -802,7 +1434,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -829,7 +1461,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -854,7 +1486,7 @@ func (m *MsgUpdateParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRateLimitRuleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,10 +1509,206 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRateLimitRuleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRateLimitRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitRuleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitRuleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitRuleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitRuleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitRuleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitRuleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
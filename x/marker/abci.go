@@ -50,4 +50,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper, bk bankkeeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+
+	// Pending actions that didn't get enough approvals in time are dropped.
+	k.RemoveExpiredPendingActions(ctx)
+}
+
+// EndBlocker returns the end blocker for the marker module.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+	// Distributions record their holders at the end of their record height, and push payouts in batches.
+	k.ProcessDistributions(ctx)
 }
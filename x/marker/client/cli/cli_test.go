@@ -437,7 +437,7 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			[]string{
 				fmt.Sprintf("--%s=json", cmtcli.OutputFlag),
 			},
-			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000"}`,
+			`{"max_total_supply":"1000000","enable_governance":true,"unrestricted_denom_regex":"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}","max_supply":"1000000","max_nav_report_age_seconds":"86400"}`,
 		},
 		{
 			"get testcoin marker json",
@@ -551,6 +551,12 @@ func (s *IntegrationTestSuite) TestMarkerQueryCommands() {
 			args:           []string{"testcoin"},
 			expectedOutput: "net_asset_values:\n- price:\n    amount: \"100\"\n    denom: usd\n  updated_block_height: \"0\"\n  volume: \"100\"",
 		},
+		{
+			name:           "marker aggregated net asset value query",
+			cmd:            markercli.AggregatedNetAssetValuesCmd(),
+			args:           []string{"testcoin"},
+			expectedOutput: "net_asset_values:\n- fresh_as_of: null\n  net_asset_value:\n    price:\n      amount: \"100\"\n      denom: usd\n    updated_block_height: \"0\"\n    volume: \"100\"\n  reports: []\n  stale: true",
+		},
 	}
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
@@ -2580,6 +2586,28 @@ func (s *IntegrationTestSuite) TestUpdateMarkerParamsCmd() {
 			},
 			expectErr: `invalid max supply: "invalid"`,
 		},
+		{
+			name: "update marker params with max nav report age, should succeed",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"3600",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update marker params, should fail incorrect max nav report age",
+			cmd:  markercli.GetUpdateMarkerParamsCmd(),
+			args: []string{
+				"true",
+				"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
+				"1000000",
+				"invalid",
+			},
+			expectErr: `invalid max nav report age seconds: strconv.ParseUint: parsing "invalid": invalid syntax`,
+		},
 	}
 
 	for _, tc := range testCases {
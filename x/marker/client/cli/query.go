@@ -3,12 +3,14 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/marker/types"
@@ -33,6 +35,15 @@ func GetQueryCmd() *cobra.Command {
 		MarkerSupplyCmd(),
 		AccountDataCmd(),
 		NetAssetValuesCmd(),
+		AggregatedNetAssetValuesCmd(),
+		MintScheduleCmd(),
+		ApprovalThresholdsCmd(),
+		PendingActionsCmd(),
+		TransferLimitsCmd(),
+		AddressTransferLimitsCmd(),
+		DistributionsCmd(),
+		DistributionPayoutsCmd(),
+		HolderSnapshotCmd(),
 	)
 	return queryCmd
 }
@@ -334,3 +345,331 @@ func NetAssetValuesCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// AggregatedNetAssetValuesCmd is the CLI command for querying a marker's aggregated net asset values.
+func AggregatedNetAssetValuesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "aggregated-net-asset-values [address|denom]",
+		Aliases: []string{"agg-nav", "agg-navs"},
+		Short:   "Get marker's median net asset values along with the individual reports",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker aggregated-net-asset-values "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryAggregatedNetAssetValuesResponse
+			if response, err = queryClient.AggregatedNetAssetValues(
+				context.Background(),
+				&types.QueryAggregatedNetAssetValuesRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q aggregated net asset values details: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// MintScheduleCmd is the CLI command for querying a marker's mint schedule and how much can currently be minted.
+func MintScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "mint-schedule [address|denom]",
+		Aliases: []string{"ms"},
+		Short:   "Get marker's mint schedule along with how much can currently be minted",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker mint-schedule "nhash"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryMintScheduleResponse
+			if response, err = queryClient.MintSchedule(
+				context.Background(),
+				&types.QueryMintScheduleRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q mint schedule details: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// ApprovalThresholdsCmd is the CLI command for querying a marker's approval thresholds.
+func ApprovalThresholdsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approval-thresholds [address|denom]",
+		Aliases: []string{"at"},
+		Short:   "Get the number of approvals that actions using an access on a marker require",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker approval-thresholds "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryApprovalThresholdsResponse
+			if response, err = queryClient.ApprovalThresholds(
+				context.Background(),
+				&types.QueryApprovalThresholdsRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q approval thresholds: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// PendingActionsCmd is the CLI command for querying a marker's actions that are waiting for approvals.
+func PendingActionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pending-actions [address|denom]",
+		Aliases: []string{"pa"},
+		Short:   "List the actions on a marker that are waiting for approvals",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker pending-actions "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryPendingActionsResponse
+			if response, err = queryClient.PendingActions(
+				context.Background(),
+				&types.QueryPendingActionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q pending actions: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "pending actions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// TransferLimitsCmd is the CLI command for querying a marker's transfer limits.
+func TransferLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-limits [address|denom]",
+		Aliases: []string{"tl"},
+		Short:   "Get a marker's holding cap, outflow limit and holder cap along with its number of holders",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker transfer-limits "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+
+			var response *types.QueryTransferLimitsResponse
+			if response, err = queryClient.TransferLimits(
+				context.Background(),
+				&types.QueryTransferLimitsRequest{Id: id},
+			); err != nil {
+				fmt.Printf("failed to query marker %q transfer limits: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddressTransferLimitsCmd is the CLI command for querying how much of a marker's denom an account can receive and send.
+func AddressTransferLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "address-transfer-limits [address|denom] <account address>",
+		Aliases: []string{"atl"},
+		Short:   "Get how much more of a marker's denom an account can receive and send",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker address-transfer-limits "hotdogcoin" pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk`, version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			addr := strings.TrimSpace(args[1])
+
+			var response *types.QueryAddressTransferLimitsResponse
+			if response, err = queryClient.AddressTransferLimits(
+				context.Background(),
+				&types.QueryAddressTransferLimitsRequest{Id: id, Address: addr},
+			); err != nil {
+				fmt.Printf("failed to query marker %q transfer limits for %s: %v\n", id, addr, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionsCmd is the CLI command for querying a marker's distributions.
+func DistributionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distributions [address|denom]",
+		Aliases: []string{"ds"},
+		Short:   "List the distributions to the holders of a marker's denom that have not been completed",
+		Long:    `Note: the address is for the base_account of the denom should you choose to use the address rather than the denom name`,
+		Example: fmt.Sprintf(`$ %s query marker distributions "hotdogcoin"`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id := strings.TrimSpace(args[0])
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionsResponse
+			if response, err = queryClient.Distributions(
+				context.Background(),
+				&types.QueryDistributionsRequest{Id: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query marker %q distributions: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "distributions")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DistributionPayoutsCmd is the CLI command for querying the payouts of a distribution.
+func DistributionPayoutsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "distribution-payouts <distribution id>",
+		Aliases: []string{"dp"},
+		Short:   "List the payouts of a distribution that holders have not yet received",
+		Example: fmt.Sprintf(`$ %s query marker distribution-payouts 3`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			id, err := strconv.ParseUint(strings.TrimSpace(args[0]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[0], err)
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryDistributionPayoutsResponse
+			if response, err = queryClient.DistributionPayouts(
+				context.Background(),
+				&types.QueryDistributionPayoutsRequest{DistributionId: id, Pagination: pageReq},
+			); err != nil {
+				fmt.Printf("failed to query distribution %d payouts: %v\n", id, err)
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+	flags.AddPaginationFlagsToCmd(cmd, "distribution payouts")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// holderSnapshotPageSize is the number of holders requested at a time by the holder snapshot command.
+const holderSnapshotPageSize = 1000
+
+// HolderSnapshotCmd is the CLI command for listing every holder of a marker's denom at a single height.
+func HolderSnapshotCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "holder-snapshot <address|denom>",
+		Aliases: []string{"snapshot", "hs"},
+		Short:   "List every holder of a marker's denom and their balance at a single height",
+		Long: strings.TrimSpace(`List every holder of a marker's denom and their balance at a single height.
+All pages of holders are requested at the height of the first one (the latest block unless --height is provided),
+so the result is a consistent snapshot.`),
+		Example: fmt.Sprintf(`$ %[1]s query marker holder-snapshot hotdogcoin
+$ %[1]s query marker holder-snapshot hotdogcoin --height 1500000 --output json > holders.json`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			id := strings.TrimSpace(args[0])
+
+			var snapshot *types.QueryHolderSnapshotResponse
+			pageReq := &query.PageRequest{Limit: holderSnapshotPageSize}
+			for {
+				queryClient := types.NewQueryClient(clientCtx)
+				response, err := queryClient.HolderSnapshot(
+					context.Background(),
+					&types.QueryHolderSnapshotRequest{Id: id, Pagination: pageReq},
+				)
+				if err != nil {
+					return fmt.Errorf("failed to query holders of %q: %w", id, err)
+				}
+				if snapshot == nil {
+					snapshot = response
+					// Get the rest of the pages at the same height as the first.
+					clientCtx = clientCtx.WithHeight(response.Height)
+				} else {
+					snapshot.Holders = append(snapshot.Holders, response.Holders...)
+				}
+				if response.Pagination == nil || len(response.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: response.Pagination.NextKey, Limit: holderSnapshotPageSize}
+			}
+
+			snapshot.Pagination = &query.PageResponse{Total: uint64(len(snapshot.Holders))}
+			return clientCtx.PrintProto(snapshot)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
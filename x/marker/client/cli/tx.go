@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strconv"
@@ -56,6 +57,19 @@ const (
 	FlagUsdMills               = "usd-mills"
 	FlagVolume                 = "volume"
 	FlagTargetAddress          = "target-address"
+	FlagMintPeriodLimit        = "mint-period-limit"
+	FlagMintPeriodSeconds      = "mint-period-seconds"
+	FlagMintTranche            = "mint-tranche"
+	FlagPendingActionTTL       = "pending-action-ttl-seconds"
+	FlagMaxHolding             = "max-holding"
+	FlagOutflowLimit           = "outflow-limit"
+	FlagOutflowPeriodSeconds   = "outflow-period-seconds"
+	FlagMaxHolders             = "max-holders"
+	FlagRecordHeight           = "record-height"
+	FlagPushPayouts            = "push-payouts"
+	FlagRedemptionDenom        = "redemption-denom"
+	FlagRedeemLimit            = "limit"
+	FlagRedeemPageKey          = "page-key"
 )
 
 // NewTxCmd returns the top-level command for marker CLI transactions.
@@ -96,6 +110,13 @@ func NewTxCmd() *cobra.Command {
 		GetCmdRemoveAdministratorProposal(),
 		GetCmdChangeStatusProposal(),
 		GetCmdWithdrawEscrowProposal(),
+		GetCmdSetMintScheduleProposal(),
+		GetCmdSetApprovalThresholds(),
+		GetCmdApproveMarkerAction(),
+		GetCmdSetTransferLimits(),
+		GetCmdCreateDistribution(),
+		GetCmdClaimDistribution(),
+		GetCmdRedeemAll(),
 		GetUpdateMarkerParamsCmd(),
 	)
 	return txCmd
@@ -165,6 +186,7 @@ with the given supply amount and denomination provided in the coin argument
 				flagVals.UsdMills,
 				flagVals.Volume,
 			)
+			msg.MintSchedule = flagVals.MintSchedule
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -879,6 +901,7 @@ with the given supply amount and denomination provided in the coin argument
 				flagVals.SupplyFixed, flagVals.AllowGovControl,
 				flagVals.AllowForceTransfer, flagVals.RequiredAttributes, accessGrants, flagVals.UsdMills, flagVals.Volume,
 			)
+			msg.MintSchedule = flagVals.MintSchedule
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -1285,6 +1308,333 @@ func GetCmdChangeStatusProposal() *cobra.Command {
 	return cmd
 }
 
+// GetCmdSetMintScheduleProposal returns a CLI command for submitting a set mint schedule proposal.
+func GetCmdSetMintScheduleProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-mint-schedule-proposal <denom>",
+		Aliases: []string{"smsp", "s-m-s-p"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Submit a proposal to set or remove a marker's mint schedule along with a title, summary, and deposit",
+		Long: strings.TrimSpace(`Submit a proposal to set or remove a marker's mint schedule along with a title, summary, and deposit.
+If none of the mint schedule flags are provided, the marker's mint schedule is removed.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-mint-schedule-proposal mycoin --%[2]s 1000 --%[3]s 2592000 --%[4]s 5000@2025-01-01T00:00:00Z --title "My Title" --summary "My summary" --deposit 1000000000nhash`,
+			version.AppName, FlagMintPeriodLimit, FlagMintPeriodSeconds, FlagMintTranche),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			schedule, err := ParseMintScheduleFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintScheduleProposalRequest(args[0], schedule, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	AddMintScheduleFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetApprovalThresholds returns a CLI command for setting or removing a marker's approval thresholds.
+func GetCmdSetApprovalThresholds() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-approval-thresholds <denom> [<access>=<required approvals> ...]",
+		Aliases: []string{"sat", "approval-thresholds"},
+		Args:    cobra.MinimumNArgs(1),
+		Short:   "Set the number of accounts with an access that must approve actions using it on a marker",
+		Long: strings.TrimSpace(`Set the number of accounts with an access that must approve actions using it on a marker.
+Thresholds can be set for the admin, withdraw, and force_transfer accesses.
+If no thresholds are provided, the marker's approval thresholds are removed.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-approval-thresholds hotdogcoin force_transfer=2 withdraw=2 --%[2]s 86400
+$ %[1]s tx marker set-approval-thresholds hotdogcoin`,
+			version.AppName, FlagPendingActionTTL),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			thresholds, err := ParseApprovalThresholds(args[1:])
+			if err != nil {
+				return err
+			}
+			ttl, err := flagSet.GetUint64(FlagPendingActionTTL)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetApprovalThresholdsRequest(args[0], thresholds, ttl, "")
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	cmd.Flags().Uint64(FlagPendingActionTTL, 86400, "how many seconds a pending action can wait for approvals")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdApproveMarkerAction returns a CLI command for approving a pending marker action.
+func GetCmdApproveMarkerAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approve-marker-action <denom> <id>",
+		Aliases: []string{"ama", "approve-action"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Approve an action on a marker that is waiting for approvals",
+		Example: fmt.Sprintf(`$ %s tx marker approve-marker-action hotdogcoin 3`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid pending action id %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgApproveMarkerActionRequest(args[0], id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetTransferLimits returns a CLI command for setting or removing a marker's transfer limits.
+func GetCmdSetTransferLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-transfer-limits <denom>",
+		Aliases: []string{"stl", "transfer-limits"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Set a marker's holding cap, per-period outflow limit and holder cap",
+		Long: strings.TrimSpace(`Set a marker's holding cap, per-period outflow limit and holder cap.
+If none of the limit flags are provided, the marker's transfer limits are removed.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker set-transfer-limits hotdogcoin --%[2]s 1000000 --%[3]s 5000 --%[4]s 86400 --%[5]s 2000
+$ %[1]s tx marker set-transfer-limits hotdogcoin`,
+			version.AppName, FlagMaxHolding, FlagOutflowLimit, FlagOutflowPeriodSeconds, FlagMaxHolders),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			limits, err := ParseTransferLimitsFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetTransferLimitsRequest(args[0], limits, "")
+			authSetter := func(authority string) {
+				msg.Administrator = authority
+			}
+
+			return generateOrBroadcastOptGovProp(clientCtx, flagSet, authSetter, msg)
+		},
+	}
+	cmd.Flags().String(FlagMaxHolding, "", "The most that any one account can hold")
+	cmd.Flags().String(FlagOutflowLimit, "", "The most that any one account can send during each outflow period")
+	cmd.Flags().Uint64(FlagOutflowPeriodSeconds, 0, "The length of each outflow period in seconds")
+	cmd.Flags().Uint64(FlagMaxHolders, 0, "The most accounts that can hold the denom")
+	addOptGovPropFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCreateDistribution returns a CLI command for funding a distribution to the holders of a marker's denom.
+func GetCmdCreateDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-distribution <denom> <funds>",
+		Aliases: []string{"cd", "distribute"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Fund a pro-rata distribution to the holders of a marker's denom",
+		Long: strings.TrimSpace(`Fund a pro-rata distribution to the holders of a marker's denom.
+The holders are recorded at the end of the record height (default is the current block).
+They can then claim their part, or, if --` + FlagPushPayouts + ` is provided, it is sent to them in batches.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker create-distribution hotdogcoin 1000000usd.local --%[2]s 1500000
+$ %[1]s tx marker create-distribution hotdogcoin 1000000usd.local --%[3]s`,
+			version.AppName, FlagRecordHeight, FlagPushPayouts),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			funds, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid funds %q: %w", args[1], err)
+			}
+			recordHeight, err := cmd.Flags().GetInt64(FlagRecordHeight)
+			if err != nil {
+				return err
+			}
+			pushPayouts, err := cmd.Flags().GetBool(FlagPushPayouts)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateDistributionRequest(args[0], funds, recordHeight, pushPayouts, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().Int64(FlagRecordHeight, 0, "The block height at the end of which the holders are recorded (default is the current block)")
+	cmd.Flags().Bool(FlagPushPayouts, false, "Send the payouts to the holders instead of waiting for them to be claimed")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimDistribution returns a CLI command for claiming a holder's part of a distribution.
+func GetCmdClaimDistribution() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "claim-distribution <denom> <id>",
+		Aliases: []string{"claim"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Claim your part of a distribution to the holders of a marker's denom",
+		Example: fmt.Sprintf(`$ %s tx marker claim-distribution hotdogcoin 3`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid distribution id %q: %w", args[1], err)
+			}
+
+			msg := types.NewMsgClaimDistributionRequest(args[0], id, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRedeemAll returns a CLI command for redeeming a page of a marker's holders.
+func GetCmdRedeemAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "redeem-all <denom>",
+		Aliases: []string{"redeem"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Take a page of a marker's denom back from its holders and burn it",
+		Long: strings.TrimSpace(`Take a page of a marker's denom back from its holders and burn it.
+If --` + FlagRedemptionDenom + ` is provided, each holder is paid from your account at the marker's net asset value in that denom.
+Provide the next_page_key of the previous response as --` + FlagRedeemPageKey + ` to continue with the next holders.`),
+		Example: fmt.Sprintf(`$ %[1]s tx marker redeem-all hotdogcoin --%[2]s usd.local
+$ %[1]s tx marker redeem-all hotdogcoin --%[3]s 500 --%[4]s SG90ZG9nQ29pbg==`,
+			version.AppName, FlagRedemptionDenom, FlagRedeemLimit, FlagRedeemPageKey),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			redemptionDenom, err := cmd.Flags().GetString(FlagRedemptionDenom)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32(FlagRedeemLimit)
+			if err != nil {
+				return err
+			}
+			pageKeyStr, err := cmd.Flags().GetString(FlagRedeemPageKey)
+			if err != nil {
+				return err
+			}
+			pageKey, err := base64.StdEncoding.DecodeString(pageKeyStr)
+			if err != nil {
+				return fmt.Errorf("invalid page key %q: %w", pageKeyStr, err)
+			}
+
+			msg := types.NewMsgRedeemAllRequest(args[0], redemptionDenom, limit, pageKey, clientCtx.GetFromAddress().String())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagRedemptionDenom, "", "The denom to pay the holders in at the marker's net asset value")
+	cmd.Flags().Uint32(FlagRedeemLimit, 0, fmt.Sprintf("The most holders to redeem (default %d)", types.DefaultRedeemAllLimit))
+	cmd.Flags().String(FlagRedeemPageKey, "", "The base64 encoded next_page_key of a previous redeem-all")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParseTransferLimitsFlags reads the flags added by GetCmdSetTransferLimits.
+// Nil is returned if none of them were provided.
+func ParseTransferLimitsFlags(cmd *cobra.Command) (*types.TransferLimits, error) {
+	maxHoldingStr, err := cmd.Flags().GetString(FlagMaxHolding)
+	if err != nil {
+		return nil, err
+	}
+	outflowLimitStr, err := cmd.Flags().GetString(FlagOutflowLimit)
+	if err != nil {
+		return nil, err
+	}
+	periodSeconds, err := cmd.Flags().GetUint64(FlagOutflowPeriodSeconds)
+	if err != nil {
+		return nil, err
+	}
+	maxHolders, err := cmd.Flags().GetUint64(FlagMaxHolders)
+	if err != nil {
+		return nil, err
+	}
+	if len(maxHoldingStr) == 0 && len(outflowLimitStr) == 0 && periodSeconds == 0 && maxHolders == 0 {
+		return nil, nil
+	}
+
+	maxHolding := sdkmath.ZeroInt()
+	if len(maxHoldingStr) > 0 {
+		var ok bool
+		maxHolding, ok = sdkmath.NewIntFromString(maxHoldingStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %q", FlagMaxHolding, maxHoldingStr)
+		}
+	}
+	outflowLimit := sdkmath.ZeroInt()
+	if len(outflowLimitStr) > 0 {
+		var ok bool
+		outflowLimit, ok = sdkmath.NewIntFromString(outflowLimitStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %q", FlagOutflowLimit, outflowLimitStr)
+		}
+	}
+
+	rv := types.NewTransferLimits(maxHolding, outflowLimit, periodSeconds, maxHolders)
+	return &rv, nil
+}
+
+// ParseApprovalThresholds parses approval thresholds from strings of the form <access>=<required approvals>.
+func ParseApprovalThresholds(args []string) ([]types.ApprovalThreshold, error) {
+	thresholds := make([]types.ApprovalThreshold, 0, len(args))
+	for _, arg := range args {
+		parts := strings.Split(arg, "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid approval threshold %q: expected format <access>=<required approvals>", arg)
+		}
+		access := types.AccessByName(parts[0])
+		if access == types.Access_Unknown {
+			return nil, fmt.Errorf("invalid approval threshold %q: unknown access %q", arg, parts[0])
+		}
+		required, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid approval threshold %q: %w", arg, err)
+		}
+		thresholds = append(thresholds, types.NewApprovalThreshold(access, uint32(required)))
+	}
+	return thresholds, nil
+}
+
 func getPeriodReset(duration int64) time.Time {
 	return time.Now().Add(getPeriod(duration))
 }
@@ -1445,6 +1795,7 @@ func AddNewMarkerFlags(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(FlagRequiredAttributes, []string{}, "comma delimited list of required attributes needed for a restricted marker to have send authority")
 	cmd.Flags().Uint64(FlagUsdMills, 0, "Indicates the net asset value of marker in usd mills, i.e. 1234 = $1.234")
 	cmd.Flags().Uint64(FlagVolume, 0, "Indicates the volume of the net asset value")
+	AddMintScheduleFlags(cmd)
 }
 
 // NewMarkerFlagValues represents the values provided in the flags added by AddNewMarkerFlags.
@@ -1456,6 +1807,7 @@ type NewMarkerFlagValues struct {
 	RequiredAttributes []string
 	UsdMills           uint64
 	Volume             uint64
+	MintSchedule       *types.MintSchedule
 }
 
 // ParseNewMarkerFlags reads the flags added by AddNewMarkerFlags.
@@ -1509,9 +1861,71 @@ func ParseNewMarkerFlags(cmd *cobra.Command) (*NewMarkerFlagValues, error) {
 		return nil, fmt.Errorf("incorrect value for %s flag.  Must be positive number if %s flag has been set to positive value", FlagVolume, FlagUsdMills)
 	}
 
+	rv.MintSchedule, err = ParseMintScheduleFlags(cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	return rv, nil
 }
 
+// AddMintScheduleFlags adds the flags used to define a marker mint schedule.
+// The provided values can be retrieved using ParseMintScheduleFlags.
+func AddMintScheduleFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagMintPeriodLimit, "", "The most that can be minted during each mint period")
+	cmd.Flags().Uint64(FlagMintPeriodSeconds, 0, "The length of each mint period in seconds")
+	cmd.Flags().StringSlice(FlagMintTranche, []string{}, "A mint tranche in the format <amount>@<RFC3339 unlock time>, can be provided multiple times")
+}
+
+// ParseMintScheduleFlags reads the flags added by AddMintScheduleFlags.
+// Nil is returned if none of those flags were provided.
+func ParseMintScheduleFlags(cmd *cobra.Command) (*types.MintSchedule, error) {
+	periodLimitStr, err := cmd.Flags().GetString(FlagMintPeriodLimit)
+	if err != nil {
+		return nil, err
+	}
+	periodSeconds, err := cmd.Flags().GetUint64(FlagMintPeriodSeconds)
+	if err != nil {
+		return nil, err
+	}
+	trancheStrs, err := cmd.Flags().GetStringSlice(FlagMintTranche)
+	if err != nil {
+		return nil, err
+	}
+	if len(periodLimitStr) == 0 && periodSeconds == 0 && len(trancheStrs) == 0 {
+		return nil, nil
+	}
+
+	periodLimit := sdkmath.ZeroInt()
+	if len(periodLimitStr) > 0 {
+		var ok bool
+		periodLimit, ok = sdkmath.NewIntFromString(periodLimitStr)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %q", FlagMintPeriodLimit, periodLimitStr)
+		}
+	}
+
+	tranches := make([]types.MintTranche, len(trancheStrs))
+	for i, trancheStr := range trancheStrs {
+		parts := strings.Split(trancheStr, "@")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid %s value %q, expected <amount>@<unlock time>", FlagMintTranche, trancheStr)
+		}
+		amount, ok := sdkmath.NewIntFromString(parts[0])
+		if !ok {
+			return nil, fmt.Errorf("invalid %s amount: %q", FlagMintTranche, parts[0])
+		}
+		unlockTime, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s unlock time %q: %w", FlagMintTranche, parts[1], err)
+		}
+		tranches[i] = types.NewMintTranche(amount, unlockTime.UTC())
+	}
+
+	rv := types.NewMintSchedule(periodLimit, periodSeconds, tranches...)
+	return &rv, nil
+}
+
 // ParseBoolStrict converts the provided input into a boolean.
 // Valid strings are "true" and "false"; case is ignored.
 func ParseBoolStrict(input string) (bool, error) {
@@ -1557,11 +1971,12 @@ func generateOrBroadcastOptGovProp(clientCtx client.Context, flagSet *pflag.Flag
 // GetUpdateMarkerParamsCmd creates a command to update the marker module's params via governance proposal.
 func GetUpdateMarkerParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply>",
-		Short:   "Update the marker module's params via governance proposal",
-		Long:    "Submit an update marker params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(3),
-		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 --deposit 50000nhash`, version.AppName),
+		Use:   "update-marker-params <enable-governance> <unrestricted-denom-regex> <max-supply> [max-nav-report-age-seconds]",
+		Short: "Update the marker module's params via governance proposal",
+		Long: fmt.Sprintf(`Submit an update marker params via governance proposal along with an initial deposit.
+The max-nav-report-age-seconds defaults to %d if not provided.`, types.DefaultMaxNavReportAgeSeconds),
+		Args:    cobra.RangeArgs(3, 4),
+		Example: fmt.Sprintf(`%[1]s tx marker update-marker-params true "[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" 1000000000000 3600 --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -1583,10 +1998,19 @@ func GetUpdateMarkerParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid max supply: %q", args[2])
 			}
 
+			maxNavReportAgeSeconds := types.DefaultMaxNavReportAgeSeconds
+			if len(args) > 3 {
+				maxNavReportAgeSeconds, err = strconv.ParseUint(args[3], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid max nav report age seconds: %w", err)
+				}
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				enableGovernance,
 				unrestrictedDenomRegex,
 				maxSupply,
+				maxNavReportAgeSeconds,
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
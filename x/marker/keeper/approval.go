@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetApprovalThresholds sets a marker's approval thresholds. Providing no thresholds removes them.
+// Each threshold cannot require more approvals than there are accounts with its access on the marker.
+func (k Keeper) SetApprovalThresholds(ctx sdk.Context, marker types.MarkerAccountI, thresholds []types.ApprovalThreshold, pendingActionTTLSeconds uint64, administrator string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.ApprovalThresholdsKey(marker.GetAddress())
+	if len(thresholds) == 0 {
+		store.Delete(key)
+		return ctx.EventManager().EmitTypedEvent(types.NewEventApprovalThresholdsSet(marker.GetDenom(), administrator))
+	}
+
+	approvalThresholds := types.NewMarkerApprovalThresholds(marker.GetDenom(), thresholds, pendingActionTTLSeconds)
+	if err := approvalThresholds.Validate(); err != nil {
+		return err
+	}
+	for _, threshold := range thresholds {
+		holders := len(marker.AddressListForPermission(threshold.Access))
+		if int(threshold.RequiredApprovals) > holders {
+			return fmt.Errorf("%s requires %d approvals but only %d accounts have that access on %s marker",
+				threshold.Access, threshold.RequiredApprovals, holders, marker.GetDenom())
+		}
+	}
+
+	if err := k.setMarkerApprovalThresholds(ctx, approvalThresholds); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventApprovalThresholdsSet(marker.GetDenom(), administrator))
+}
+
+// GetApprovalThresholds returns a marker's approval thresholds, or nil if it doesn't have any.
+func (k Keeper) GetApprovalThresholds(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.MarkerApprovalThresholds, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ApprovalThresholdsKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var thresholds types.MarkerApprovalThresholds
+	if err := k.cdc.Unmarshal(bz, &thresholds); err != nil {
+		return nil, err
+	}
+	return &thresholds, nil
+}
+
+// IterateApprovalThresholds iterates all marker approval thresholds.
+func (k Keeper) IterateApprovalThresholds(ctx sdk.Context, handler func(thresholds types.MarkerApprovalThresholds) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ApprovalThresholdsPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var thresholds types.MarkerApprovalThresholds
+		if err := k.cdc.Unmarshal(it.Value(), &thresholds); err != nil {
+			return err
+		}
+		if handler(thresholds) {
+			break
+		}
+	}
+	return nil
+}
+
+// setMarkerApprovalThresholds stores a marker's approval thresholds.
+func (k Keeper) setMarkerApprovalThresholds(ctx sdk.Context, thresholds types.MarkerApprovalThresholds) error {
+	markerAddr, err := types.MarkerAddress(thresholds.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&thresholds)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.ApprovalThresholdsKey(markerAddr), bz)
+	return nil
+}
+
+// RequireApprovals checks if an action taken by the signer using an access on a marker needs approvals from other
+// accounts with that access. If it does, the msg is stored as a pending action and true is returned.
+// False is returned if the action should be executed now: when it is already approved, comes from governance,
+// the marker doesn't have a threshold for the access, or the signer doesn't have the access (so that the regular
+// permission checks can fail it).
+func (k Keeper) RequireApprovals(ctx sdk.Context, marker types.MarkerAccountI, access types.Access, signer sdk.AccAddress, msg sdk.Msg) (bool, error) {
+	if types.IsApprovedAction(ctx) || signer.String() == k.GetAuthority() || !marker.AddressHasAccess(signer, access) {
+		return false, nil
+	}
+	thresholds, err := k.GetApprovalThresholds(ctx, marker.GetAddress())
+	if err != nil {
+		return false, err
+	}
+	if thresholds == nil || thresholds.RequiredApprovals(access) <= 1 {
+		return false, nil
+	}
+
+	id := k.nextPendingActionID(ctx)
+	action, err := types.NewPendingMarkerAction(id, marker.GetDenom(), access, msg, signer.String(), ctx.BlockTime().Add(thresholds.PendingActionTTL()))
+	if err != nil {
+		return false, err
+	}
+	if err = k.setPendingAction(ctx, action); err != nil {
+		return false, err
+	}
+	return true, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerActionPending(id, marker.GetDenom(), access, sdk.MsgTypeURL(msg), signer.String()))
+}
+
+// ApprovePendingAction records an approval of a pending action. It returns true (and removes the action) once the
+// action has enough approvals from accounts that still have its access, in which case the caller must execute it.
+func (k Keeper) ApprovePendingAction(ctx sdk.Context, marker types.MarkerAccountI, id uint64, approver sdk.AccAddress) (*types.PendingMarkerAction, bool, error) {
+	action, err := k.GetPendingAction(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return nil, false, err
+	}
+	if action == nil {
+		return nil, false, fmt.Errorf("pending action %d not found for %s marker", id, marker.GetDenom())
+	}
+	if action.IsExpired(ctx.BlockTime()) {
+		return nil, false, fmt.Errorf("pending action %d has expired", id)
+	}
+	if err = marker.ValidateAddressHasAccess(approver, action.Access); err != nil {
+		return nil, false, err
+	}
+	if action.HasApproved(approver.String()) {
+		return nil, false, fmt.Errorf("%s has already approved pending action %d", approver, id)
+	}
+	action.Approvers = append(action.Approvers, approver.String())
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerActionApproved(id, marker.GetDenom(), approver.String())); err != nil {
+		return nil, false, err
+	}
+
+	required := uint32(1)
+	thresholds, err := k.GetApprovalThresholds(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, false, err
+	}
+	if thresholds != nil {
+		required = thresholds.RequiredApprovals(action.Access)
+	}
+	var approvals uint32
+	for _, addrStr := range action.Approvers {
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err == nil && marker.AddressHasAccess(addr, action.Access) {
+			approvals++
+		}
+	}
+
+	if approvals < required {
+		return action, false, k.setPendingAction(ctx, *action)
+	}
+	k.removePendingAction(ctx, marker.GetAddress(), *action)
+	return action, true, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerActionExecuted(id, marker.GetDenom()))
+}
+
+// GetPendingAction returns a pending action of a marker, or nil if it doesn't exist.
+func (k Keeper) GetPendingAction(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) (*types.PendingMarkerAction, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.PendingActionKey(markerAddr, id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var action types.PendingMarkerAction
+	if err := k.cdc.Unmarshal(bz, &action); err != nil {
+		return nil, err
+	}
+	return &action, nil
+}
+
+// IterateAllPendingActions iterates all pending marker actions.
+func (k Keeper) IterateAllPendingActions(ctx sdk.Context, handler func(action types.PendingMarkerAction) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingActionPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var action types.PendingMarkerAction
+		if err := k.cdc.Unmarshal(it.Value(), &action); err != nil {
+			return err
+		}
+		if handler(action) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveExpiredPendingActions removes the pending actions that have expired.
+func (k Keeper) RemoveExpiredPendingActions(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	end := binary.BigEndian.AppendUint64(types.PendingActionExpirationPrefix, uint64(ctx.BlockTime().Unix())+1)
+	it := store.Iterator(types.PendingActionExpirationPrefix, end)
+	var expired []types.PendingMarkerAction
+	for ; it.Valid(); it.Next() {
+		markerAddr, id := types.ParsePendingActionExpirationKey(it.Key())
+		action, err := k.GetPendingAction(ctx, markerAddr, id)
+		if err != nil || action == nil {
+			ctx.Logger().Error("invalid pending marker action in expiration index", "marker", markerAddr.String(), "id", id, "err", err)
+			continue
+		}
+		if action.IsExpired(ctx.BlockTime()) {
+			expired = append(expired, *action)
+		}
+	}
+	it.Close()
+
+	for _, action := range expired {
+		k.removePendingAction(ctx, types.MustGetMarkerAddress(action.Denom), action)
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerActionExpired(action.Id, action.Denom)); err != nil {
+			ctx.Logger().Error("unable to emit pending marker action expired event", "id", action.Id, "err", err)
+		}
+	}
+}
+
+// RemovePendingActions removes all pending actions for a marker.
+func (k Keeper) RemovePendingActions(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	var actions []types.PendingMarkerAction
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.PendingActionMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		var action types.PendingMarkerAction
+		if err := k.cdc.Unmarshal(it.Value(), &action); err == nil {
+			actions = append(actions, action)
+		}
+	}
+	it.Close()
+
+	for _, action := range actions {
+		k.removePendingAction(ctx, markerAddr, action)
+	}
+}
+
+// setPendingAction stores a pending action along with its expiration index entry.
+func (k Keeper) setPendingAction(ctx sdk.Context, action types.PendingMarkerAction) error {
+	markerAddr, err := types.MarkerAddress(action.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&action)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PendingActionKey(markerAddr, action.Id), bz)
+	store.Set(types.PendingActionExpirationKey(action.ExpiresAt, markerAddr, action.Id), []byte{})
+	return nil
+}
+
+// removePendingAction deletes a pending action along with its expiration index entry.
+func (k Keeper) removePendingAction(ctx sdk.Context, markerAddr sdk.AccAddress, action types.PendingMarkerAction) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingActionKey(markerAddr, action.Id))
+	store.Delete(types.PendingActionExpirationKey(action.ExpiresAt, markerAddr, action.Id))
+}
+
+// GetLastPendingActionID returns the id of the most recently created pending action.
+func (k Keeper) GetLastPendingActionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastPendingActionIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastPendingActionID stores the id of the most recently created pending action.
+func (k Keeper) setLastPendingActionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastPendingActionIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// nextPendingActionID returns the id to use for a new pending action and records it as the last one used.
+func (k Keeper) nextPendingActionID(ctx sdk.Context) uint64 {
+	id := k.GetLastPendingActionID(ctx) + 1
+	k.setLastPendingActionID(ctx, id)
+	return id
+}
@@ -0,0 +1,206 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestApprovalThresholds(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.NewContext(false).WithBlockTime(startTime)
+	msgServer := keeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin1 := sdk.AccAddress("admin1______________")
+	admin2 := sdk.AccAddress("admin2______________")
+	admin3 := sdk.AccAddress("admin3______________")
+	holder := sdk.AccAddress("holder______________")
+	other := sdk.AccAddress("other_______________")
+	holderAcc := app.AccountKeeper.NewAccountWithAddress(ctx, holder)
+	require.NoError(t, holderAcc.SetSequence(1), "SetSequence")
+	app.AccountKeeper.SetAccount(ctx, holderAcc)
+
+	denom := "regfund"
+	perms := types.AccessList{types.Access_Admin, types.Access_Withdraw, types.Access_Transfer, types.Access_ForceTransfer}
+	markerAcc := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1_000),
+		admin1,
+		[]types.AccessGrant{
+			{Address: admin1.String(), Permissions: perms},
+			{Address: admin2.String(), Permissions: perms},
+			{Address: admin3.String(), Permissions: perms},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		false,
+		true,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}, "initial"), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker")
+
+	balance := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+	pendingActions := func(ctx sdk.Context) []types.PendingMarkerAction {
+		t.Helper()
+		resp, err := app.MarkerKeeper.PendingActions(ctx, &types.QueryPendingActionsRequest{Id: denom})
+		require.NoError(t, err, "PendingActions query")
+		return resp.PendingActions
+	}
+	assertEvent := func(ctx sdk.Context, event sdk.Msg, msg string) {
+		t.Helper()
+		expEvent, err := sdk.TypedEventToEvent(event)
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, msg)
+	}
+
+	t.Run("non-admin cannot set thresholds", func(t *testing.T) {
+		msg := types.NewMsgSetApprovalThresholdsRequest(denom, []types.ApprovalThreshold{types.NewApprovalThreshold(types.Access_Withdraw, 2)}, 3600, other.String())
+		_, err := msgServer.SetApprovalThresholds(ctx, msg)
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_ADMIN on regfund marker ("+markerAcc.GetAddress().String()+"): unauthorized", "SetApprovalThresholds")
+	})
+
+	t.Run("threshold above holder count", func(t *testing.T) {
+		msg := types.NewMsgSetApprovalThresholdsRequest(denom, []types.ApprovalThreshold{types.NewApprovalThreshold(types.Access_Withdraw, 4)}, 3600, admin1.String())
+		_, err := msgServer.SetApprovalThresholds(ctx, msg)
+		assert.EqualError(t, err, "ACCESS_WITHDRAW requires 4 approvals but only 3 accounts have that access on regfund marker: invalid request", "SetApprovalThresholds")
+	})
+
+	t.Run("set thresholds", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		thresholds := []types.ApprovalThreshold{
+			types.NewApprovalThreshold(types.Access_Withdraw, 2),
+			types.NewApprovalThreshold(types.Access_ForceTransfer, 2),
+		}
+		_, err := msgServer.SetApprovalThresholds(ctx, types.NewMsgSetApprovalThresholdsRequest(denom, thresholds, 3600, admin1.String()))
+		require.NoError(t, err, "SetApprovalThresholds")
+		assertEvent(ctx, types.NewEventApprovalThresholdsSet(denom, admin1.String()), "emitted events")
+
+		resp, err := app.MarkerKeeper.ApprovalThresholds(ctx, &types.QueryApprovalThresholdsRequest{Id: denom})
+		require.NoError(t, err, "ApprovalThresholds query")
+		require.NotNil(t, resp.ApprovalThresholds, "ApprovalThresholds")
+		assert.Equal(t, thresholds, resp.ApprovalThresholds.Thresholds, "Thresholds")
+	})
+
+	t.Run("withdraw needs a second approval", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgWithdrawRequest(admin1, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100)))
+		_, err := msgServer.Withdraw(ctx, msg)
+		require.NoError(t, err, "Withdraw")
+		assert.Equal(t, int64(0), balance(ctx, holder), "holder balance after first signer")
+		assertEvent(ctx, types.NewEventMarkerActionPending(1, denom, types.Access_Withdraw, sdk.MsgTypeURL(msg), admin1.String()), "emitted events")
+
+		actions := pendingActions(ctx)
+		require.Len(t, actions, 1, "pending actions")
+		assert.Equal(t, uint64(1), actions[0].Id, "pending action id")
+		assert.Equal(t, []string{admin1.String()}, actions[0].Approvers, "pending action approvers")
+		assert.Equal(t, startTime.Add(time.Hour), actions[0].ExpiresAt, "pending action expiration")
+
+		_, err = msgServer.ApproveMarkerAction(ctx, types.NewMsgApproveMarkerActionRequest(denom, 1, admin1.String()))
+		assert.EqualError(t, err, admin1.String()+" has already approved pending action 1: invalid request", "ApproveMarkerAction by proposer")
+		_, err = msgServer.ApproveMarkerAction(ctx, types.NewMsgApproveMarkerActionRequest(denom, 1, other.String()))
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_WITHDRAW on regfund marker ("+markerAcc.GetAddress().String()+"): invalid request", "ApproveMarkerAction by other")
+
+		resp, err := msgServer.ApproveMarkerAction(ctx, types.NewMsgApproveMarkerActionRequest(denom, 1, admin2.String()))
+		require.NoError(t, err, "ApproveMarkerAction by admin2")
+		assert.True(t, resp.Executed, "Executed")
+		assertEvent(ctx, types.NewEventMarkerActionExecuted(1, denom), "emitted events")
+		assert.Equal(t, int64(100), balance(ctx, holder), "holder balance after approval")
+		assert.Empty(t, pendingActions(ctx), "pending actions after execution")
+	})
+
+	t.Run("withdraw by a signer without access is not deferred", func(t *testing.T) {
+		_, err := msgServer.Withdraw(ctx, types.NewMsgWithdrawRequest(other, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_WITHDRAW on regfund marker ("+markerAcc.GetAddress().String()+"): invalid request", "Withdraw")
+		assert.Empty(t, pendingActions(ctx), "pending actions")
+	})
+
+	t.Run("admin actions without a threshold are not deferred", func(t *testing.T) {
+		msg := types.NewMsgAddAccessRequest(denom, admin1, types.AccessGrant{Address: other.String(), Permissions: types.AccessList{types.Access_Deposit}})
+		_, err := msgServer.AddAccess(ctx, msg)
+		require.NoError(t, err, "AddAccess")
+		assert.Empty(t, pendingActions(ctx), "pending actions")
+	})
+
+	t.Run("pending force transfer expires", func(t *testing.T) {
+		msg := types.NewMsgTransferRequest(admin1, holder, admin3, sdk.NewInt64Coin(denom, 50))
+		_, err := msgServer.Transfer(ctx, msg)
+		require.NoError(t, err, "Transfer")
+		assert.Equal(t, int64(100), balance(ctx, holder), "holder balance")
+		require.Len(t, pendingActions(ctx), 1, "pending actions")
+
+		expired := ctx.WithBlockTime(startTime.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+		_, err = msgServer.ApproveMarkerAction(expired, types.NewMsgApproveMarkerActionRequest(denom, 2, admin2.String()))
+		assert.EqualError(t, err, "pending action 2 has expired: invalid request", "ApproveMarkerAction after expiration")
+
+		app.MarkerKeeper.RemoveExpiredPendingActions(expired)
+		assertEvent(expired, types.NewEventMarkerActionExpired(2, denom), "emitted events")
+		assert.Empty(t, pendingActions(expired), "pending actions after expiration")
+		assert.Equal(t, int64(100), balance(expired, holder), "holder balance after expiration")
+	})
+
+	t.Run("genesis export includes thresholds and pending actions", func(t *testing.T) {
+		_, err := msgServer.Withdraw(ctx, types.NewMsgWithdrawRequest(admin2, holder, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 5))))
+		require.NoError(t, err, "Withdraw")
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Len(t, genState.ApprovalThresholds, 1, "ApprovalThresholds")
+		require.Len(t, genState.PendingActions, 1, "PendingActions")
+		assert.Equal(t, uint64(3), genState.PendingActions[0].Id, "pending action id")
+		assert.Equal(t, uint64(3), genState.LastPendingActionId, "LastPendingActionId")
+		assert.NoError(t, genState.Validate(), "Validate")
+	})
+
+	t.Run("admin threshold gates other admin actions", func(t *testing.T) {
+		thresholds := []types.ApprovalThreshold{
+			types.NewApprovalThreshold(types.Access_Admin, 2),
+			types.NewApprovalThreshold(types.Access_Withdraw, 2),
+			types.NewApprovalThreshold(types.Access_ForceTransfer, 2),
+		}
+		_, err := msgServer.SetApprovalThresholds(ctx, types.NewMsgSetApprovalThresholdsRequest(denom, thresholds, 3600, admin1.String()))
+		require.NoError(t, err, "SetApprovalThresholds")
+
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgUpdateSendDenyListRequest(denom, admin1, nil, []string{other.String()})
+		_, err = msgServer.UpdateSendDenyList(ctx, msg)
+		require.NoError(t, err, "UpdateSendDenyList")
+		assertEvent(ctx, types.NewEventMarkerActionPending(4, denom, types.Access_Admin, sdk.MsgTypeURL(msg), admin1.String()), "emitted events")
+		assert.False(t, app.MarkerKeeper.IsSendDeny(ctx, markerAcc.GetAddress(), other), "IsSendDeny before approval")
+
+		resp, err := msgServer.ApproveMarkerAction(ctx, types.NewMsgApproveMarkerActionRequest(denom, 4, admin3.String()))
+		require.NoError(t, err, "ApproveMarkerAction by admin3")
+		assert.True(t, resp.Executed, "Executed")
+		assert.True(t, app.MarkerKeeper.IsSendDeny(ctx, markerAcc.GetAddress(), other), "IsSendDeny after approval")
+
+		_, err = msgServer.Cancel(ctx, types.NewMsgCancelRequest(denom, admin1))
+		require.NoError(t, err, "Cancel")
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		assert.Equal(t, types.StatusActive, marker.GetStatus(), "marker status after Cancel by one admin")
+		assert.Len(t, pendingActions(ctx), 2, "pending actions")
+	})
+
+	t.Run("removing the marker removes thresholds and pending actions", func(t *testing.T) {
+		marker, err := app.MarkerKeeper.GetMarkerByDenom(ctx, denom)
+		require.NoError(t, err, "GetMarkerByDenom")
+		app.MarkerKeeper.RemoveMarker(ctx, marker)
+		thresholds, err := app.MarkerKeeper.GetApprovalThresholds(ctx, marker.GetAddress())
+		require.NoError(t, err, "GetApprovalThresholds")
+		assert.Nil(t, thresholds, "GetApprovalThresholds")
+		action, err := app.MarkerKeeper.GetPendingAction(ctx, marker.GetAddress(), 3)
+		require.NoError(t, err, "GetPendingAction")
+		assert.Nil(t, action, "GetPendingAction")
+	})
+}
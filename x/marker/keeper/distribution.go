@@ -0,0 +1,520 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// CreateDistribution takes the funds from the funder and creates a distribution of them to the holders of the
+// marker's denom at the record height. A record height of zero means the current block.
+func (k Keeper) CreateDistribution(ctx sdk.Context, marker types.MarkerAccountI, funds sdk.Coins, recordHeight int64, pushPayouts bool, funder sdk.AccAddress) (uint64, error) {
+	if marker.GetStatus() != types.StatusActive {
+		return 0, fmt.Errorf("cannot create a distribution for %s marker: marker status (%s) is not %s",
+			marker.GetDenom(), marker.GetStatus(), types.StatusActive)
+	}
+	if recordHeight == 0 {
+		recordHeight = ctx.BlockHeight()
+	}
+	if recordHeight < ctx.BlockHeight() {
+		return 0, fmt.Errorf("record height %d cannot be before the current height %d", recordHeight, ctx.BlockHeight())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.CoinPoolName, funds); err != nil {
+		return 0, fmt.Errorf("could not take distribution funds from %s: %w", funder, err)
+	}
+
+	id := k.nextDistributionID(ctx)
+	distribution := types.NewMarkerDistribution(id, marker.GetDenom(), funds, funder.String(), recordHeight, pushPayouts)
+	if err := k.setDistribution(ctx, distribution); err != nil {
+		return 0, err
+	}
+	return id, ctx.EventManager().EmitTypedEvent(types.NewEventDistributionCreated(id, marker.GetDenom(), funds, recordHeight, funder.String()))
+}
+
+// ClaimDistribution sends a holder their part of a distribution.
+func (k Keeper) ClaimDistribution(ctx sdk.Context, marker types.MarkerAccountI, id uint64, claimant sdk.AccAddress) error {
+	distribution, err := k.GetDistribution(ctx, marker.GetAddress(), id)
+	if err != nil {
+		return err
+	}
+	if distribution == nil {
+		return fmt.Errorf("distribution %d not found for %s marker", id, marker.GetDenom())
+	}
+	if !distribution.SnapshotTaken {
+		return fmt.Errorf("distribution %d has not recorded its holders yet", id)
+	}
+	payout, err := k.GetDistributionPayout(ctx, id, claimant)
+	if err != nil {
+		return err
+	}
+	if payout == nil {
+		return fmt.Errorf("%s does not have a payout in distribution %d", claimant, id)
+	}
+	if err = k.payDistribution(ctx, *distribution, *payout); err != nil {
+		return err
+	}
+	return k.completeDistributionIfPaid(ctx, *distribution)
+}
+
+// ProcessDistributions records the holders of the distributions that have reached their record height, then sends
+// the payouts of the distributions that push them, and returns unclaimed payouts to the funders once they expire.
+// Each distribution records up to DistributionPayoutsPerBlock holders, so a denom's sends are only blocked while
+// its own holders are recorded. At most DistributionPayoutsPerBlock payouts are sent or returned.
+func (k Keeper) ProcessDistributions(ctx sdk.Context) {
+	type activeDistribution struct {
+		id         uint64
+		markerAddr sdk.AccAddress
+	}
+	var active []activeDistribution
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ActiveDistributionPrefix)
+	for ; it.Valid(); it.Next() {
+		id, markerAddr := types.ParseActiveDistributionKey(it.Key())
+		active = append(active, activeDistribution{id: id, markerAddr: markerAddr})
+	}
+	it.Close()
+
+	var distributions []*types.MarkerDistribution
+	for _, entry := range active {
+		distribution, err := k.GetDistribution(ctx, entry.markerAddr, entry.id)
+		if err != nil || distribution == nil {
+			ctx.Logger().Error("invalid marker distribution in active index", "marker", entry.markerAddr.String(), "id", entry.id, "err", err)
+			continue
+		}
+		distributions = append(distributions, distribution)
+	}
+
+	for _, distribution := range distributions {
+		if distribution.SnapshotTaken || ctx.BlockHeight() < distribution.RecordHeight {
+			continue
+		}
+		// Recorded into a copy so that a failure leaves the distribution as it was.
+		recorded := *distribution
+		cacheCtx, writeCache := ctx.CacheContext()
+		if _, err := k.recordDistributionHolders(cacheCtx, &recorded, types.DistributionPayoutsPerBlock); err != nil {
+			ctx.Logger().Error("unable to record marker distribution holders", "id", distribution.Id, "err", err)
+			continue
+		}
+		writeCache()
+		*distribution = recorded
+	}
+
+	budget := types.DistributionPayoutsPerBlock
+	for _, distribution := range distributions {
+		if distribution.SnapshotTaken && distribution.PushPayouts && !distribution.PayoutsPushed && budget > 0 {
+			attempted, err := k.pushDistributionPayouts(ctx, distribution, budget)
+			if err != nil {
+				ctx.Logger().Error("unable to push marker distribution payouts", "id", distribution.Id, "err", err)
+				continue
+			}
+			budget -= attempted
+		}
+		if err := k.completeDistributionIfPaid(ctx, *distribution); err != nil {
+			ctx.Logger().Error("unable to complete marker distribution", "id", distribution.Id, "err", err)
+		}
+	}
+
+	k.expireDistributionPayouts(ctx, budget)
+}
+
+// recordDistributionHolders records up to limit holders of a distribution along with their part of the funds,
+// starting where the previous block left off. The marker's denom cannot be sent until all of the holders are
+// recorded, so every holder's part is based on their balance when the recording started. The marker's own account
+// and the marker module account are not holders. Once all of the holders are recorded, the funds that are left over
+// are returned to the funder and the claim period starts. It returns the number of accounts looked at.
+func (k Keeper) recordDistributionHolders(ctx sdk.Context, distribution *types.MarkerDistribution, limit int) (int, error) {
+	markerAddr := types.MustGetMarkerAddress(distribution.Denom)
+	if len(distribution.NextSnapshotKey) == 0 {
+		// The total is fixed when recording starts, so every holder's part is relative to the same amount.
+		distribution.TotalShares = k.bankKeeper.GetSupply(ctx, distribution.Denom).Amount.
+			Sub(k.bankKeeper.GetBalance(ctx, markerAddr, distribution.Denom).Amount).
+			Sub(k.bankKeeper.GetBalance(ctx, k.markerModuleAddr, distribution.Denom).Amount)
+	}
+
+	var read int
+	var nextKey []byte
+	if distribution.TotalShares.IsPositive() {
+		resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+			Denom:      distribution.Denom,
+			Pagination: &query.PageRequest{Key: distribution.NextSnapshotKey, Limit: uint64(limit)},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("could not get %s holders: %w", distribution.Denom, err)
+		}
+		read = len(resp.DenomOwners)
+		for _, owner := range resp.DenomOwners {
+			if owner.Address == markerAddr.String() || owner.Address == k.markerModuleAddr.String() || !owner.Balance.IsPositive() {
+				continue
+			}
+			distribution.HolderCount++
+			amount := distribution.CalculatePayout(owner.Balance.Amount)
+			if amount.IsZero() {
+				continue
+			}
+			addr, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return 0, err
+			}
+			if err = k.setDistributionPayout(ctx, types.NewDistributionPayout(distribution.Id, addr, owner.Balance.Amount, amount)); err != nil {
+				return 0, err
+			}
+			distribution.Allocated = distribution.Allocated.Add(amount...)
+		}
+		if resp.Pagination != nil {
+			nextKey = resp.Pagination.NextKey
+		}
+	}
+	distribution.NextSnapshotKey = nextKey
+	if len(nextKey) > 0 {
+		return read, k.setDistribution(ctx, *distribution)
+	}
+
+	distribution.SnapshotTaken = true
+	claimExpiration := ctx.BlockTime().Add(types.DistributionClaimPeriod)
+	distribution.ClaimExpiration = &claimExpiration
+	if err := k.setDistribution(ctx, *distribution); err != nil {
+		return 0, err
+	}
+	refund := distribution.Funds.Sub(distribution.Allocated...)
+	if !refund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, sdk.MustAccAddressFromBech32(distribution.Funder), refund); err != nil {
+			ctx.Logger().Error("unable to return left over marker distribution funds", "id", distribution.Id, "funds", refund.String(), "err", err)
+		}
+	}
+	return read, ctx.EventManager().EmitTypedEvent(types.NewEventDistributionSnapshotTaken(distribution.Id, distribution.Denom, distribution.HolderCount, distribution.TotalShares, refund))
+}
+
+// expireDistributionPayouts returns up to limit unclaimed payouts of the distributions whose claim period has
+// ended to their funders. A distribution is removed once it has no payouts left.
+func (k Keeper) expireDistributionPayouts(ctx sdk.Context, limit int) {
+	if limit <= 0 {
+		return
+	}
+	type expiredDistribution struct {
+		id         uint64
+		markerAddr sdk.AccAddress
+	}
+	var expired []expiredDistribution
+	end := binary.BigEndian.AppendUint64(types.DistributionExpirationPrefix, uint64(ctx.BlockTime().Unix())+1)
+	it := ctx.KVStore(k.storeKey).Iterator(types.DistributionExpirationPrefix, end)
+	for ; it.Valid(); it.Next() {
+		markerAddr, id := types.ParseDistributionExpirationKey(it.Key())
+		expired = append(expired, expiredDistribution{id: id, markerAddr: markerAddr})
+	}
+	it.Close()
+
+	for _, entry := range expired {
+		if limit <= 0 {
+			return
+		}
+		distribution, err := k.GetDistribution(ctx, entry.markerAddr, entry.id)
+		if err != nil || distribution == nil {
+			ctx.Logger().Error("invalid marker distribution in expiration index", "marker", entry.markerAddr.String(), "id", entry.id, "err", err)
+			continue
+		}
+		if !distribution.IsClaimExpired(ctx.BlockTime()) {
+			continue
+		}
+		payouts := k.removeDistributionPayouts(ctx, distribution.Id, limit)
+		limit -= len(payouts)
+		refund := sdk.Coins{}
+		for _, payout := range payouts {
+			refund = refund.Add(payout.Amount...)
+		}
+		if !refund.IsZero() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, sdk.MustAccAddressFromBech32(distribution.Funder), refund); err != nil {
+				ctx.Logger().Error("unable to return unclaimed marker distribution funds", "id", distribution.Id, "funds", refund.String(), "err", err)
+			}
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventDistributionExpired(distribution.Id, distribution.Denom, len(payouts), refund)); err != nil {
+			ctx.Logger().Error("unable to emit marker distribution expired event", "id", distribution.Id, "err", err)
+		}
+		if err = k.completeDistributionIfPaid(ctx, *distribution); err != nil {
+			ctx.Logger().Error("unable to complete marker distribution", "id", distribution.Id, "err", err)
+		}
+	}
+}
+
+// validateNotRecordingDistribution returns an error if a distribution of the coin's marker is part way through
+// recording its holders. Sends of that denom would move balances between recorded and unrecorded holders.
+func (k Keeper) validateNotRecordingDistribution(ctx sdk.Context, coin sdk.Coin) error {
+	if !coin.IsPositive() {
+		return nil
+	}
+	store := ctx.KVStore(k.storeKey)
+	markerAddr := types.MustGetMarkerAddress(coin.Denom)
+	// Only markers have distributions, so there's nothing to look for if the denom isn't one.
+	if !store.Has(types.MarkerStoreKey(markerAddr)) {
+		return nil
+	}
+	it := storetypes.KVStorePrefixIterator(store, types.RecordingDistributionMarkerPrefix(markerAddr))
+	defer it.Close()
+	if !it.Valid() {
+		return nil
+	}
+	key := it.Key()
+	return fmt.Errorf("cannot send %s: the holders of %s are being recorded for distribution %d",
+		coin, coin.Denom, binary.BigEndian.Uint64(key[len(key)-8:]))
+}
+
+// pushDistributionPayouts attempts up to limit payouts of a distribution, starting where the previous attempts
+// left off. Payouts that fail are left for the holder to claim. It returns the number of payouts attempted.
+func (k Keeper) pushDistributionPayouts(ctx sdk.Context, distribution *types.MarkerDistribution, limit int) (int, error) {
+	start := types.DistributionPayoutsPrefix(distribution.Id)
+	if len(distribution.NextPayoutAddress) > 0 {
+		start = types.DistributionPayoutKey(distribution.Id, sdk.MustAccAddressFromBech32(distribution.NextPayoutAddress))
+	}
+	it := ctx.KVStore(k.storeKey).Iterator(start, storetypes.PrefixEndBytes(types.DistributionPayoutsPrefix(distribution.Id)))
+	var payouts []types.DistributionPayout
+	nextPayoutAddress := ""
+	for ; it.Valid(); it.Next() {
+		var payout types.DistributionPayout
+		if err := k.cdc.Unmarshal(it.Value(), &payout); err != nil {
+			it.Close()
+			return 0, err
+		}
+		if len(payouts) == limit {
+			nextPayoutAddress = payout.Address
+			break
+		}
+		payouts = append(payouts, payout)
+	}
+	it.Close()
+
+	for _, payout := range payouts {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.payDistribution(cacheCtx, *distribution, payout); err != nil {
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventDistributionPayoutFailed(distribution.Id, distribution.Denom, payout.Address, err)); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		writeCache()
+	}
+
+	distribution.NextPayoutAddress = nextPayoutAddress
+	distribution.PayoutsPushed = len(nextPayoutAddress) == 0
+	return len(payouts), k.setDistribution(ctx, *distribution)
+}
+
+// payDistribution sends a holder their part of a distribution. The send is subject to all of the bank module's
+// send restrictions (e.g. quarantine and sanction).
+func (k Keeper) payDistribution(ctx sdk.Context, distribution types.MarkerDistribution, payout types.DistributionPayout) error {
+	addr, err := sdk.AccAddressFromBech32(payout.Address)
+	if err != nil {
+		return err
+	}
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, addr, payout.Amount); err != nil {
+		return fmt.Errorf("could not send %s to %s: %w", payout.Amount, addr, err)
+	}
+	ctx.KVStore(k.storeKey).Delete(types.DistributionPayoutKey(distribution.Id, addr))
+	return ctx.EventManager().EmitTypedEvent(types.NewEventDistributionPaid(distribution.Id, distribution.Denom, payout.Address, payout.Amount))
+}
+
+// completeDistributionIfPaid removes a distribution once its holders have been recorded and all of them have
+// received their payouts.
+func (k Keeper) completeDistributionIfPaid(ctx sdk.Context, distribution types.MarkerDistribution) error {
+	if !distribution.SnapshotTaken || k.hasDistributionPayouts(ctx, distribution.Id) {
+		return nil
+	}
+	k.removeDistribution(ctx, distribution)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventDistributionCompleted(distribution.Id, distribution.Denom))
+}
+
+// RemoveDistributions removes all of a marker's distributions, returning the funds that haven't been paid out to
+// their funders.
+func (k Keeper) RemoveDistributions(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	var distributions []types.MarkerDistribution
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		var distribution types.MarkerDistribution
+		if err := k.cdc.Unmarshal(it.Value(), &distribution); err == nil {
+			distributions = append(distributions, distribution)
+		}
+	}
+	it.Close()
+
+	for _, distribution := range distributions {
+		refund := sdk.Coins{}
+		if !distribution.SnapshotTaken {
+			refund = distribution.Funds.Sub(distribution.Allocated...)
+		}
+		for _, payout := range k.removeDistributionPayouts(ctx, distribution.Id, 0) {
+			refund = refund.Add(payout.Amount...)
+		}
+		if !refund.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.CoinPoolName, sdk.MustAccAddressFromBech32(distribution.Funder), refund); err != nil {
+				ctx.Logger().Error("unable to return marker distribution funds", "id", distribution.Id, "funds", refund.String(), "err", err)
+			}
+		}
+		k.removeDistribution(ctx, distribution)
+	}
+}
+
+// GetDistribution returns a distribution of a marker, or nil if it doesn't exist.
+func (k Keeper) GetDistribution(ctx sdk.Context, markerAddr sdk.AccAddress, id uint64) (*types.MarkerDistribution, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionKey(markerAddr, id))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var distribution types.MarkerDistribution
+	if err := k.cdc.Unmarshal(bz, &distribution); err != nil {
+		return nil, err
+	}
+	return &distribution, nil
+}
+
+// IterateAllDistributions iterates all marker distributions.
+func (k Keeper) IterateAllDistributions(ctx sdk.Context, handler func(distribution types.MarkerDistribution) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var distribution types.MarkerDistribution
+		if err := k.cdc.Unmarshal(it.Value(), &distribution); err != nil {
+			return err
+		}
+		if handler(distribution) {
+			break
+		}
+	}
+	return nil
+}
+
+// setDistribution stores a distribution, keeping its index entries up to date.
+func (k Keeper) setDistribution(ctx sdk.Context, distribution types.MarkerDistribution) error {
+	markerAddr, err := types.MarkerAddress(distribution.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&distribution)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DistributionKey(markerAddr, distribution.Id), bz)
+	if distribution.IsActive() {
+		store.Set(types.ActiveDistributionKey(distribution.Id, markerAddr), []byte{})
+	} else {
+		store.Delete(types.ActiveDistributionKey(distribution.Id, markerAddr))
+	}
+	if !distribution.SnapshotTaken && len(distribution.NextSnapshotKey) > 0 {
+		store.Set(types.RecordingDistributionKey(markerAddr, distribution.Id), []byte{})
+	} else {
+		store.Delete(types.RecordingDistributionKey(markerAddr, distribution.Id))
+	}
+	if distribution.ClaimExpiration != nil {
+		store.Set(types.DistributionExpirationKey(*distribution.ClaimExpiration, markerAddr, distribution.Id), []byte{})
+	}
+	return nil
+}
+
+// removeDistribution deletes a distribution along with its index entries.
+func (k Keeper) removeDistribution(ctx sdk.Context, distribution types.MarkerDistribution) {
+	markerAddr := types.MustGetMarkerAddress(distribution.Denom)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DistributionKey(markerAddr, distribution.Id))
+	store.Delete(types.ActiveDistributionKey(distribution.Id, markerAddr))
+	store.Delete(types.RecordingDistributionKey(markerAddr, distribution.Id))
+	if distribution.ClaimExpiration != nil {
+		store.Delete(types.DistributionExpirationKey(*distribution.ClaimExpiration, markerAddr, distribution.Id))
+	}
+}
+
+// GetDistributionPayout returns a holder's payout of a distribution, or nil if they don't have one.
+func (k Keeper) GetDistributionPayout(ctx sdk.Context, id uint64, addr sdk.AccAddress) (*types.DistributionPayout, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.DistributionPayoutKey(id, addr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var payout types.DistributionPayout
+	if err := k.cdc.Unmarshal(bz, &payout); err != nil {
+		return nil, err
+	}
+	return &payout, nil
+}
+
+// IterateAllDistributionPayouts iterates all distribution payouts.
+func (k Keeper) IterateAllDistributionPayouts(ctx sdk.Context, handler func(payout types.DistributionPayout) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionPayoutPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var payout types.DistributionPayout
+		if err := k.cdc.Unmarshal(it.Value(), &payout); err != nil {
+			return err
+		}
+		if handler(payout) {
+			break
+		}
+	}
+	return nil
+}
+
+// setDistributionPayout stores a holder's payout of a distribution.
+func (k Keeper) setDistributionPayout(ctx sdk.Context, payout types.DistributionPayout) error {
+	addr, err := sdk.AccAddressFromBech32(payout.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&payout)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.DistributionPayoutKey(payout.DistributionId, addr), bz)
+	return nil
+}
+
+// hasDistributionPayouts returns true if any holder has not yet received their payout of a distribution.
+func (k Keeper) hasDistributionPayouts(ctx sdk.Context, id uint64) bool {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.DistributionPayoutsPrefix(id))
+	defer it.Close()
+	return it.Valid()
+}
+
+// removeDistributionPayouts deletes and returns up to limit payouts of a distribution. A limit of zero means all.
+func (k Keeper) removeDistributionPayouts(ctx sdk.Context, id uint64, limit int) []types.DistributionPayout {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	var payouts []types.DistributionPayout
+	it := storetypes.KVStorePrefixIterator(store, types.DistributionPayoutsPrefix(id))
+	for ; it.Valid() && (limit <= 0 || len(keys) < limit); it.Next() {
+		keys = append(keys, it.Key())
+		var payout types.DistributionPayout
+		if err := k.cdc.Unmarshal(it.Value(), &payout); err == nil {
+			payouts = append(payouts, payout)
+		}
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return payouts
+}
+
+// GetLastDistributionID returns the id of the most recently created distribution.
+func (k Keeper) GetLastDistributionID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.LastDistributionIDKey)
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setLastDistributionID stores the id of the most recently created distribution.
+func (k Keeper) setLastDistributionID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.LastDistributionIDKey, binary.BigEndian.AppendUint64(nil, id))
+}
+
+// nextDistributionID returns the id to use for a new distribution and records it as the last one used.
+func (k Keeper) nextDistributionID(ctx sdk.Context) uint64 {
+	id := k.GetLastDistributionID(ctx) + 1
+	k.setLastDistributionID(ctx, id)
+	return id
+}
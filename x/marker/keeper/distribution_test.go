@@ -0,0 +1,266 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestDistributions(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.NewContext(false).WithBlockHeight(10).WithBlockTime(time.Unix(1700000000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	holder3 := sdk.AccAddress("holder3_____________")
+	other := sdk.AccAddress("other_______________")
+
+	denom := "divfund"
+	markerAcc := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1_000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}, "initial"), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder1, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 600))), "withdraw to holder1")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder2, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 300))), "withdraw to holder2")
+	require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, holder3, denom, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "withdraw to holder3")
+
+	payDenom := "usdf"
+	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, admin, sdk.NewCoins(sdk.NewInt64Coin(payDenom, 5_000))), "FundAccount")
+	paid := func(ctx sdk.Context, addr sdk.AccAddress) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, payDenom).Amount.Int64()
+	}
+	payouts := func(ctx sdk.Context, id uint64) []types.DistributionPayout {
+		t.Helper()
+		resp, err := app.MarkerKeeper.DistributionPayouts(ctx, &types.QueryDistributionPayoutsRequest{DistributionId: id})
+		require.NoError(t, err, "DistributionPayouts query")
+		return resp.Payouts
+	}
+	distributions := func(ctx sdk.Context) []types.MarkerDistribution {
+		t.Helper()
+		resp, err := app.MarkerKeeper.Distributions(ctx, &types.QueryDistributionsRequest{Id: denom})
+		require.NoError(t, err, "Distributions query")
+		return resp.Distributions
+	}
+	claim := func(ctx sdk.Context, id uint64, claimant sdk.AccAddress) error {
+		_, err := msgServer.ClaimDistribution(ctx, types.NewMsgClaimDistributionRequest(denom, id, claimant.String()))
+		return err
+	}
+	funds := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(payDenom, amount))
+	}
+
+	t.Run("non-admin cannot create a distribution", func(t *testing.T) {
+		_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(100), 0, false, other.String()))
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_ADMIN on divfund marker ("+markerAcc.GetAddress().String()+"): unauthorized", "CreateDistribution")
+	})
+
+	t.Run("record height cannot be in the past", func(t *testing.T) {
+		_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(100), 9, false, admin.String()))
+		assert.EqualError(t, err, "record height 9 cannot be before the current height 10: invalid request", "CreateDistribution")
+	})
+
+	t.Run("claimed distribution", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		resp, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(1001), 0, false, admin.String()))
+		require.NoError(t, err, "CreateDistribution")
+		require.Equal(t, uint64(1), resp.DistributionId, "DistributionId")
+		assert.Equal(t, int64(3999), paid(ctx, admin), "admin balance after funding")
+
+		err = claim(ctx, 1, holder1)
+		assert.EqualError(t, err, "distribution 1 has not recorded its holders yet: invalid request", "claim before record height ends")
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		assert.Equal(t, int64(4000), paid(ctx, admin), "admin balance after left over funds are returned")
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventDistributionSnapshotTaken(1, denom, 3, markerAcc.GetSupply().Amount, funds(1)))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+
+		actual := payouts(ctx, 1)
+		require.Len(t, actual, 3, "payouts")
+		amounts := make(map[string]string)
+		for _, payout := range actual {
+			amounts[payout.Address] = payout.Amount.String()
+		}
+		assert.Equal(t, "600usdf", amounts[holder1.String()], "holder1 payout")
+		assert.Equal(t, "300usdf", amounts[holder2.String()], "holder2 payout")
+		assert.Equal(t, "100usdf", amounts[holder3.String()], "holder3 payout")
+
+		require.NoError(t, claim(ctx, 1, holder2), "holder2 claim")
+		assert.Equal(t, int64(300), paid(ctx, holder2), "holder2 balance")
+		err = claim(ctx, 1, holder2)
+		assert.EqualError(t, err, holder2.String()+" does not have a payout in distribution 1: invalid request", "second holder2 claim")
+		err = claim(ctx, 1, other)
+		assert.EqualError(t, err, other.String()+" does not have a payout in distribution 1: invalid request", "other claim")
+
+		require.NoError(t, claim(ctx, 1, holder1), "holder1 claim")
+		require.Len(t, distributions(ctx), 1, "distributions before last claim")
+		require.NoError(t, claim(ctx, 1, holder3), "holder3 claim")
+		assert.Empty(t, distributions(ctx), "distributions after last claim")
+		expEvent, err = sdk.TypedEventToEvent(types.NewEventDistributionCompleted(1, denom))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+	})
+
+	t.Run("pushed distribution at a later record height", func(t *testing.T) {
+		require.NoError(t, app.QuarantineKeeper.SetOptIn(ctx, holder3), "SetOptIn holder3")
+		resp, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(1000), 12, true, admin.String()))
+		require.NoError(t, err, "CreateDistribution")
+		id := resp.DistributionId
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		current := distributions(ctx)
+		require.Len(t, current, 1, "distributions")
+		assert.False(t, current[0].SnapshotTaken, "SnapshotTaken before record height")
+
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 100))), "SendCoins")
+		recordCtx := ctx.WithBlockHeight(12)
+		app.MarkerKeeper.ProcessDistributions(recordCtx)
+
+		assert.Equal(t, int64(600+500), paid(recordCtx, holder1), "holder1 balance")
+		assert.Equal(t, int64(300+400), paid(recordCtx, holder2), "holder2 balance")
+		assert.Equal(t, int64(100), paid(recordCtx, holder3), "holder3 balance")
+		assert.Equal(t, int64(100), paid(recordCtx, app.QuarantineKeeper.GetFundsHolder()), "quarantined funds")
+		assert.Empty(t, payouts(recordCtx, id), "payouts")
+		assert.Empty(t, distributions(recordCtx), "distributions")
+	})
+
+	t.Run("genesis export and marker removal", func(t *testing.T) {
+		_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(1000), 0, false, admin.String()))
+		require.NoError(t, err, "CreateDistribution")
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		require.NoError(t, claim(ctx, 3, holder1), "holder1 claim")
+		adminBalance := paid(ctx, admin)
+
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		require.Len(t, genState.Distributions, 1, "Distributions")
+		assert.Len(t, genState.DistributionPayouts, 2, "DistributionPayouts")
+		assert.Equal(t, uint64(3), genState.LastDistributionId, "LastDistributionId")
+		assert.NoError(t, genState.Validate(), "Validate")
+
+		app.MarkerKeeper.RemoveDistributions(ctx, markerAcc.GetAddress())
+		assert.Empty(t, distributions(ctx), "distributions")
+		assert.Empty(t, payouts(ctx, 3), "payouts")
+		assert.Equal(t, adminBalance+500, paid(ctx, admin), "admin balance after unpaid funds are returned")
+	})
+
+	t.Run("unclaimed payouts expire", func(t *testing.T) {
+		_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(1000), 0, false, admin.String()))
+		require.NoError(t, err, "CreateDistribution")
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		current := distributions(ctx)
+		require.Len(t, current, 1, "distributions")
+		require.NotNil(t, current[0].ClaimExpiration, "ClaimExpiration")
+		assert.Equal(t, ctx.BlockTime().Add(types.DistributionClaimPeriod), *current[0].ClaimExpiration, "ClaimExpiration")
+		require.NoError(t, claim(ctx, 4, holder1), "holder1 claim")
+		adminBalance := paid(ctx, admin)
+
+		beforeExpiration := ctx.WithBlockTime(current[0].ClaimExpiration.Add(-time.Second))
+		app.MarkerKeeper.ProcessDistributions(beforeExpiration)
+		assert.Len(t, payouts(beforeExpiration, 4), 2, "payouts before expiration")
+
+		expiredCtx := ctx.WithBlockTime(*current[0].ClaimExpiration).WithEventManager(sdk.NewEventManager())
+		app.MarkerKeeper.ProcessDistributions(expiredCtx)
+		assert.Empty(t, payouts(expiredCtx, 4), "payouts after expiration")
+		assert.Empty(t, distributions(expiredCtx), "distributions after expiration")
+		assert.Equal(t, adminBalance+500, paid(expiredCtx, admin), "admin balance after unclaimed funds are returned")
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventDistributionExpired(4, denom, 2, funds(500)))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, expiredCtx.EventManager().Events(), expEvent, "emitted events")
+	})
+
+	t.Run("holders are recorded over several blocks", func(t *testing.T) {
+		holderCount := types.DistributionPayoutsPerBlock + 50
+		var lastHolder sdk.AccAddress
+		for i := 0; i < holderCount-3; i++ {
+			addr := sdk.AccAddress(append([]byte("many_holders"), byte(i/256), byte(i%256), 'x', 'x', 'x', 'x', 'x', 'x'))
+			lastHolder = addr
+			require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))), "SendCoins %d", i)
+		}
+		_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(2000), 0, false, admin.String()))
+		require.NoError(t, err, "CreateDistribution")
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		current := distributions(ctx)
+		require.Len(t, current, 1, "distributions after first block")
+		assert.False(t, current[0].SnapshotTaken, "SnapshotTaken after first block")
+		assert.NotEmpty(t, current[0].NextSnapshotKey, "NextSnapshotKey after first block")
+		assert.Equal(t, markerAcc.GetSupply().Amount, current[0].TotalShares, "TotalShares after first block")
+		err = claim(ctx, 5, holder2)
+		assert.EqualError(t, err, "distribution 5 has not recorded its holders yet: invalid request", "claim while recording holders")
+
+		// Moving funds from a recorded holder to one that isn't recorded yet would have them counted twice.
+		payout, err := app.MarkerKeeper.GetDistributionPayout(ctx, 5, holder1)
+		require.NoError(t, err, "GetDistributionPayout holder1")
+		require.NotNil(t, payout, "holder1 payout after first block")
+		payout, err = app.MarkerKeeper.GetDistributionPayout(ctx, 5, lastHolder)
+		require.NoError(t, err, "GetDistributionPayout last holder")
+		require.Nil(t, payout, "last holder payout after first block")
+		moved := sdk.NewCoins(sdk.NewInt64Coin(denom, 10))
+		cacheCtx, _ := ctx.CacheContext()
+		err = app.BankKeeper.SendCoins(cacheCtx, holder1, lastHolder, moved)
+		assert.EqualError(t, err, "cannot send 10divfund: the holders of divfund are being recorded for distribution 5", "SendCoins while recording holders")
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		current = distributions(ctx)
+		require.Len(t, current, 1, "distributions after second block")
+		assert.True(t, current[0].SnapshotTaken, "SnapshotTaken after second block")
+		assert.Equal(t, uint64(holderCount), current[0].HolderCount, "HolderCount")
+		resp, err := app.MarkerKeeper.DistributionPayouts(ctx, &types.QueryDistributionPayoutsRequest{DistributionId: 5, Pagination: &query.PageRequest{Limit: uint64(holderCount), CountTotal: true}})
+		require.NoError(t, err, "DistributionPayouts query")
+		assert.Equal(t, uint64(holderCount), resp.Pagination.Total, "payouts")
+		assert.Equal(t, funds(2000), current[0].Allocated, "Allocated")
+		shares := sdkmath.ZeroInt()
+		for _, payout := range resp.Payouts {
+			shares = shares.Add(payout.Shares)
+		}
+		assert.Equal(t, current[0].TotalShares.String(), shares.String(), "sum of the recorded shares")
+
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, holder2, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))), "SendCoins after recording holders")
+	})
+
+	t.Run("each distribution records its holders with its own budget", func(t *testing.T) {
+		require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, admin, funds(2000)), "FundAccount")
+		for i := 0; i < 2; i++ {
+			_, err := msgServer.CreateDistribution(ctx, types.NewMsgCreateDistributionRequest(denom, funds(1000), 0, false, admin.String()))
+			require.NoError(t, err, "CreateDistribution %d", i)
+		}
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		for _, distribution := range distributions(ctx) {
+			if distribution.Id > 5 {
+				assert.False(t, distribution.SnapshotTaken, "distribution %d SnapshotTaken after first block", distribution.Id)
+			}
+		}
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, holder2, funds(1)), "SendCoins of a denom without a marker while recording holders")
+
+		app.MarkerKeeper.ProcessDistributions(ctx)
+		for _, distribution := range distributions(ctx) {
+			assert.True(t, distribution.SnapshotTaken, "distribution %d SnapshotTaken after second block", distribution.Id)
+		}
+	})
+}
@@ -60,6 +60,51 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			store.Set(types.NetAssetValueKey(address, navCopy.Price.Denom), bz)
 		}
 	}
+	for _, report := range data.NetAssetValueReports {
+		if err := k.SetNetAssetValueReport(ctx, types.MustGetMarkerAddress(report.Denom), report); err != nil {
+			panic(err)
+		}
+	}
+	for _, mintSchedule := range data.MintSchedules {
+		if err := k.setMarkerMintSchedule(ctx, mintSchedule); err != nil {
+			panic(err)
+		}
+	}
+	for _, thresholds := range data.ApprovalThresholds {
+		if err := k.setMarkerApprovalThresholds(ctx, thresholds); err != nil {
+			panic(err)
+		}
+	}
+	for _, action := range data.PendingActions {
+		if err := k.setPendingAction(ctx, action); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastPendingActionID(ctx, data.LastPendingActionId)
+	for _, limits := range data.TransferLimits {
+		if err := k.setMarkerTransferLimits(ctx, limits); err != nil {
+			panic(err)
+		}
+		if err := k.initHolderCount(ctx, limits.Denom); err != nil {
+			panic(err)
+		}
+	}
+	for _, outflow := range data.AddressOutflows {
+		if err := k.setAddressOutflow(ctx, outflow); err != nil {
+			panic(err)
+		}
+	}
+	for _, distribution := range data.Distributions {
+		if err := k.setDistribution(ctx, distribution); err != nil {
+			panic(err)
+		}
+	}
+	for _, payout := range data.DistributionPayouts {
+		if err := k.setDistributionPayout(ctx, payout); err != nil {
+			panic(err)
+		}
+	}
+	k.setLastDistributionID(ctx, data.LastDistributionId)
 }
 
 // ExportGenesis exports the current keeper state of the marker module.ExportGenesis
@@ -114,5 +159,79 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues)
+	var reports []types.NetAssetValueReport
+	err := k.IterateAllNetAssetValueReports(ctx, func(report types.NetAssetValueReport) (stop bool) {
+		reports = append(reports, report)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var mintSchedules []types.MarkerMintSchedule
+	err = k.IterateMintSchedules(ctx, func(mintSchedule types.MarkerMintSchedule) (stop bool) {
+		mintSchedules = append(mintSchedules, mintSchedule)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var approvalThresholds []types.MarkerApprovalThresholds
+	err = k.IterateApprovalThresholds(ctx, func(thresholds types.MarkerApprovalThresholds) (stop bool) {
+		approvalThresholds = append(approvalThresholds, thresholds)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var pendingActions []types.PendingMarkerAction
+	err = k.IterateAllPendingActions(ctx, func(action types.PendingMarkerAction) (stop bool) {
+		pendingActions = append(pendingActions, action)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var transferLimits []types.MarkerTransferLimits
+	err = k.IterateTransferLimits(ctx, func(limits types.MarkerTransferLimits) (stop bool) {
+		transferLimits = append(transferLimits, limits)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var outflows []types.AddressOutflow
+	err = k.IterateAddressOutflows(ctx, func(outflow types.AddressOutflow) (stop bool) {
+		outflows = append(outflows, outflow)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var distributions []types.MarkerDistribution
+	err = k.IterateAllDistributions(ctx, func(distribution types.MarkerDistribution) (stop bool) {
+		distributions = append(distributions, distribution)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	var payouts []types.DistributionPayout
+	err = k.IterateAllDistributionPayouts(ctx, func(payout types.DistributionPayout) (stop bool) {
+		payouts = append(payouts, payout)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, markers, denyAddresses, markerNetAssetValues, reports, mintSchedules,
+		approvalThresholds, pendingActions, k.GetLastPendingActionID(ctx), transferLimits, outflows,
+		distributions, payouts, k.GetLastDistributionID(ctx))
 }
@@ -172,6 +172,14 @@ func (k Keeper) RemoveMarker(ctx sdk.Context, marker types.MarkerAccountI) {
 	k.authKeeper.RemoveAccount(ctx, marker)
 
 	k.RemoveNetAssetValues(ctx, marker.GetAddress())
+	k.RemoveNetAssetValueReports(ctx, marker.GetAddress())
+	store.Delete(types.MintScheduleKey(marker.GetAddress()))
+	store.Delete(types.ApprovalThresholdsKey(marker.GetAddress()))
+	k.RemovePendingActions(ctx, marker.GetAddress())
+	store.Delete(types.TransferLimitsKey(marker.GetAddress()))
+	k.removeHolders(ctx, marker.GetAddress())
+	k.removeAddressOutflows(ctx, marker.GetAddress())
+	k.RemoveDistributions(ctx, marker.GetAddress())
 	k.ClearSendDeny(ctx, marker.GetAddress())
 	store.Delete(types.MarkerStoreKey(marker.GetAddress()))
 }
@@ -228,6 +228,9 @@ func (k Keeper) MintCoin(ctx sdk.Context, caller sdk.AccAddress, coin sdk.Coin)
 	// For proposed, finalized accounts we allow adjusting the total_supply of the marker but we do not
 	// mint actual coin.
 	case m.GetStatus() == types.StatusProposed || m.GetStatus() == types.StatusFinalized:
+		if err = k.recordScheduledMint(ctx, m, coin.Amount); err != nil {
+			return err
+		}
 		total := m.GetSupply().Add(coin)
 		if err = m.SetSupply(total); err != nil {
 			return err
@@ -352,6 +355,10 @@ func (k Keeper) IncreaseSupply(ctx sdk.Context, marker types.MarkerAccountI, coi
 			"requested supply %s exceeds maximum allowed value %s", total.Amount.String(), maxAllowed.Amount.String())
 	}
 
+	if err := k.recordScheduledMint(ctx, marker, coin.Amount); err != nil {
+		return err
+	}
+
 	// If the marker has a fixed supply then adjust the supply to match the new total
 	if marker.HasFixedSupply() {
 		if err := marker.SetSupply(total); err != nil {
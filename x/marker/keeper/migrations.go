@@ -1,5 +1,11 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +15,14 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 sets the max net asset value report age param, which did not exist before version 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if params.MaxNavReportAgeSeconds == 0 {
+		ctx.Logger().Info("Setting marker max net asset value report age.", "seconds", types.DefaultMaxNavReportAgeSeconds)
+		params.MaxNavReportAgeSeconds = types.DefaultMaxNavReportAgeSeconds
+		m.keeper.SetParams(ctx, params)
+	}
+	return nil
+}
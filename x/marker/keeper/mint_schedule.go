@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetMintSchedule sets a marker's mint schedule, resetting the amounts tracked as minted under it.
+func (k Keeper) SetMintSchedule(ctx sdk.Context, denom string, schedule types.MintSchedule) error {
+	mintSchedule := types.NewMarkerMintSchedule(denom, schedule)
+	if err := mintSchedule.Validate(); err != nil {
+		return err
+	}
+	if err := k.setMarkerMintSchedule(ctx, mintSchedule); err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMintScheduleSet(denom))
+}
+
+// RemoveMintSchedule removes a marker's mint schedule (if it has one).
+func (k Keeper) RemoveMintSchedule(ctx sdk.Context, denom string) error {
+	markerAddr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	key := types.MintScheduleKey(markerAddr)
+	if !store.Has(key) {
+		return nil
+	}
+	store.Delete(key)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventMintScheduleRemoved(denom))
+}
+
+// GetMintSchedule returns a marker's mint schedule, or nil if it doesn't have one.
+func (k Keeper) GetMintSchedule(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.MarkerMintSchedule, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.MintScheduleKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var mintSchedule types.MarkerMintSchedule
+	if err := k.cdc.Unmarshal(bz, &mintSchedule); err != nil {
+		return nil, err
+	}
+	return &mintSchedule, nil
+}
+
+// IterateMintSchedules iterates all marker mint schedules.
+func (k Keeper) IterateMintSchedules(ctx sdk.Context, handler func(mintSchedule types.MarkerMintSchedule) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.MintSchedulePrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var mintSchedule types.MarkerMintSchedule
+		if err := k.cdc.Unmarshal(it.Value(), &mintSchedule); err != nil {
+			return err
+		}
+		if handler(mintSchedule) {
+			break
+		}
+	}
+	return nil
+}
+
+// setMarkerMintSchedule stores a marker mint schedule.
+func (k Keeper) setMarkerMintSchedule(ctx sdk.Context, mintSchedule types.MarkerMintSchedule) error {
+	markerAddr, err := types.MarkerAddress(mintSchedule.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&mintSchedule)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.MintScheduleKey(markerAddr), bz)
+	return nil
+}
+
+// recordScheduledMint records an increase in a marker's supply against its mint schedule (if it has one).
+// An error is returned if the increase is more than the schedule currently allows.
+func (k Keeper) recordScheduledMint(ctx sdk.Context, marker types.MarkerAccountI, amount sdkmath.Int) error {
+	mintSchedule, err := k.GetMintSchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return fmt.Errorf("could not read mint schedule for %s: %w", marker.GetDenom(), err)
+	}
+	if mintSchedule == nil {
+		return nil
+	}
+	if err = mintSchedule.RecordMint(amount, ctx.BlockTime()); err != nil {
+		return err
+	}
+	return k.setMarkerMintSchedule(ctx, *mintSchedule)
+}
+
+// GetMintableAmount returns how much of a marker can currently be minted, taking into account its mint
+// schedule and the max supply param.
+func (k Keeper) GetMintableAmount(ctx sdk.Context, marker types.MarkerAccountI, mintSchedule *types.MarkerMintSchedule) sdkmath.Int {
+	supply := marker.GetSupply().Amount
+	if marker.GetStatus() == types.StatusActive {
+		supply = k.bankKeeper.GetSupply(ctx, marker.GetDenom()).Amount
+	}
+	rv := k.GetMaxSupply(ctx).Sub(supply)
+	if mintSchedule != nil {
+		if remaining := mintSchedule.RemainingInPeriod(ctx.BlockTime()); remaining != nil {
+			rv = sdkmath.MinInt(rv, *remaining)
+		}
+		if remaining := mintSchedule.RemainingUnlocked(ctx.BlockTime()); remaining != nil {
+			rv = sdkmath.MinInt(rv, *remaining)
+		}
+	}
+	if rv.IsNegative() {
+		return sdkmath.ZeroInt()
+	}
+	return rv
+}
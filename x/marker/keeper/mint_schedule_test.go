@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMintSchedule(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.NewContext(false).WithBlockTime(startTime)
+
+	admin := sdk.AccAddress("admin_account_______")
+	newMarker := func(denom string, status types.MarkerStatus) types.MarkerAccountI {
+		return types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+			sdk.NewInt64Coin(denom, 1_000),
+			admin,
+			[]types.AccessGrant{{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}}},
+			status,
+			types.MarkerType_Coin,
+			false,
+			true,
+			false,
+			[]string{},
+		)
+	}
+	queryMintSchedule := func(ctx sdk.Context, denom string) *types.QueryMintScheduleResponse {
+		t.Helper()
+		resp, err := app.MarkerKeeper.MintSchedule(ctx, &types.QueryMintScheduleRequest{Id: denom})
+		require.NoError(t, err, "MintSchedule query")
+		require.NotNil(t, resp, "MintSchedule query response")
+		return resp
+	}
+
+	denom := "fundshares"
+	markerAcc := newMarker(denom, types.StatusProposed)
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}, "initial"), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker")
+
+	t.Run("no schedule", func(t *testing.T) {
+		mintSchedule, err := app.MarkerKeeper.GetMintSchedule(ctx, markerAcc.GetAddress())
+		require.NoError(t, err, "GetMintSchedule")
+		assert.Nil(t, mintSchedule, "GetMintSchedule")
+
+		resp := queryMintSchedule(ctx, denom)
+		assert.Nil(t, resp.MintSchedule, "MintSchedule")
+		assert.Nil(t, resp.RemainingInPeriod, "RemainingInPeriod")
+		assert.Nil(t, resp.RemainingUnlocked, "RemainingUnlocked")
+		expMintable := app.MarkerKeeper.GetMaxSupply(ctx).SubRaw(1_000)
+		assert.Equal(t, expMintable.String(), resp.Mintable.String(), "Mintable")
+	})
+
+	schedule := types.NewMintSchedule(sdkmath.NewInt(100), 3600, types.NewMintTranche(sdkmath.NewInt(150), startTime.Add(time.Hour)))
+	t.Run("set schedule", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, denom, schedule), "SetMintSchedule")
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventMintScheduleSet(denom))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+
+		resp := queryMintSchedule(ctx, denom)
+		require.NotNil(t, resp.MintSchedule, "MintSchedule")
+		assert.Equal(t, "100", resp.RemainingInPeriod.String(), "RemainingInPeriod")
+		assert.Equal(t, "0", resp.RemainingUnlocked.String(), "RemainingUnlocked")
+		assert.Equal(t, "0", resp.Mintable.String(), "Mintable")
+	})
+
+	t.Run("mint before tranche unlocks", func(t *testing.T) {
+		err := app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin(denom, 1))
+		assert.EqualError(t, err, "cannot mint 1fundshares: only 0fundshares of the unlocked mint tranches remains", "MintCoin")
+	})
+
+	unlocked := ctx.WithBlockTime(startTime.Add(time.Hour))
+	t.Run("mint up to the period limit", func(t *testing.T) {
+		require.NoError(t, app.MarkerKeeper.MintCoin(unlocked, admin, sdk.NewInt64Coin(denom, 60)), "MintCoin 60")
+		err := app.MarkerKeeper.MintCoin(unlocked, admin, sdk.NewInt64Coin(denom, 41))
+		assert.EqualError(t, err, "cannot mint 41fundshares: only 40fundshares remains in the current mint period", "MintCoin 41")
+		require.NoError(t, app.MarkerKeeper.MintCoin(unlocked, admin, sdk.NewInt64Coin(denom, 40)), "MintCoin 40")
+		assert.Equal(t, "1100", app.BankKeeper.GetSupply(unlocked, denom).Amount.String(), "supply")
+
+		resp := queryMintSchedule(unlocked, denom)
+		assert.Equal(t, "0", resp.RemainingInPeriod.String(), "RemainingInPeriod")
+		assert.Equal(t, "50", resp.RemainingUnlocked.String(), "RemainingUnlocked")
+		assert.Equal(t, "0", resp.Mintable.String(), "Mintable")
+	})
+
+	t.Run("increase supply in the next period", func(t *testing.T) {
+		nextPeriod := unlocked.WithBlockTime(startTime.Add(2 * time.Hour))
+		resp := queryMintSchedule(nextPeriod, denom)
+		assert.Equal(t, "50", resp.Mintable.String(), "Mintable")
+
+		err := app.MarkerKeeper.IncreaseSupply(nextPeriod, markerAcc, sdk.NewInt64Coin(denom, 51))
+		assert.EqualError(t, err, "cannot mint 51fundshares: only 50fundshares of the unlocked mint tranches remains", "IncreaseSupply 51")
+		require.NoError(t, app.MarkerKeeper.IncreaseSupply(nextPeriod, markerAcc, sdk.NewInt64Coin(denom, 50)), "IncreaseSupply 50")
+
+		mintSchedule, err := app.MarkerKeeper.GetMintSchedule(nextPeriod, markerAcc.GetAddress())
+		require.NoError(t, err, "GetMintSchedule")
+		require.NotNil(t, mintSchedule, "GetMintSchedule")
+		assert.Equal(t, "50", mintSchedule.PeriodMinted.String(), "PeriodMinted")
+		assert.Equal(t, "150", mintSchedule.TotalMinted.String(), "TotalMinted")
+	})
+
+	t.Run("schedule applies to proposed markers", func(t *testing.T) {
+		proposed := newMarker("proposedfund", types.StatusProposed)
+		require.NoError(t, app.MarkerKeeper.AddMarkerAccount(ctx, proposed), "AddMarkerAccount")
+		require.NoError(t, app.MarkerKeeper.SetMintSchedule(ctx, "proposedfund", types.NewMintSchedule(sdkmath.NewInt(10), 60)), "SetMintSchedule")
+
+		err := app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("proposedfund", 11))
+		assert.EqualError(t, err, "cannot mint 11proposedfund: only 10proposedfund remains in the current mint period", "MintCoin 11")
+		require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin("proposedfund", 10)), "MintCoin 10")
+	})
+
+	t.Run("genesis export includes schedules", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		require.Len(t, genState.MintSchedules, 2, "MintSchedules")
+		assert.NoError(t, genState.Validate(), "Validate")
+	})
+
+	t.Run("remove schedule", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, app.MarkerKeeper.RemoveMintSchedule(ctx, denom), "RemoveMintSchedule")
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventMintScheduleRemoved(denom))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+
+		mintSchedule, err := app.MarkerKeeper.GetMintSchedule(ctx, markerAcc.GetAddress())
+		require.NoError(t, err, "GetMintSchedule")
+		assert.Nil(t, mintSchedule, "GetMintSchedule")
+		require.NoError(t, app.MarkerKeeper.MintCoin(ctx, admin, sdk.NewInt64Coin(denom, 500)), "MintCoin without schedule")
+	})
+
+	t.Run("removing the marker removes the schedule", func(t *testing.T) {
+		proposedAddr := types.MustGetMarkerAddress("proposedfund")
+		proposed, err := app.MarkerKeeper.GetMarker(ctx, proposedAddr)
+		require.NoError(t, err, "GetMarker")
+		app.MarkerKeeper.RemoveMarker(ctx, proposed)
+		mintSchedule, err := app.MarkerKeeper.GetMintSchedule(ctx, proposedAddr)
+		require.NoError(t, err, "GetMintSchedule")
+		assert.Nil(t, mintSchedule, "GetMintSchedule")
+	})
+}
@@ -47,6 +47,13 @@ func (k msgServer) GrantAllowance(goCtx context.Context, msg *types.MsgGrantAllo
 	if err = m.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
 		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
 	}
+	pending, err := k.RequireApprovals(ctx, m, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgGrantAllowanceResponse{}, nil
+	}
 	allowance, err := msg.GetFeeAllowanceI()
 	if err != nil {
 		return nil, err
@@ -126,6 +133,13 @@ func (k msgServer) AddMarker(goCtx context.Context, msg *types.MsgAddMarkerReque
 		}
 	}
 
+	// The mint schedule is set last so that it only applies to supply added after creation.
+	if msg.MintSchedule != nil {
+		if err = k.SetMintSchedule(ctx, msg.Amount.Denom, *msg.MintSchedule); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
 	return &types.MsgAddMarkerResponse{}, nil
 }
 
@@ -139,6 +153,13 @@ func (k msgServer) AddAccess(goCtx context.Context, msg *types.MsgAddAccessReque
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgAddAccessResponse{}, nil
+	}
 
 	for i := range msg.Access {
 		access := msg.Access[i]
@@ -162,6 +183,13 @@ func (k msgServer) DeleteAccess(goCtx context.Context, msg *types.MsgDeleteAcces
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 	addr := sdk.MustAccAddressFromBech32(msg.RemovedAddress)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgDeleteAccessResponse{}, nil
+	}
 
 	if err := k.Keeper.RemoveAccess(ctx, admin, msg.Denom, addr); err != nil {
 		ctx.Logger().Error("unable to remove access grant from marker", "err", err)
@@ -181,6 +209,13 @@ func (k msgServer) Finalize(goCtx context.Context, msg *types.MsgFinalizeRequest
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgFinalizeResponse{}, nil
+	}
 
 	if err := k.Keeper.FinalizeMarker(ctx, admin, msg.Denom); err != nil {
 		ctx.Logger().Error("unable to finalize marker", "err", err)
@@ -200,6 +235,13 @@ func (k msgServer) Activate(goCtx context.Context, msg *types.MsgActivateRequest
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgActivateResponse{}, nil
+	}
 
 	if err := k.Keeper.ActivateMarker(ctx, admin, msg.Denom); err != nil {
 		ctx.Logger().Error("unable to activate marker", "err", err)
@@ -219,6 +261,13 @@ func (k msgServer) Cancel(goCtx context.Context, msg *types.MsgCancelRequest) (*
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgCancelResponse{}, nil
+	}
 
 	if err := k.Keeper.CancelMarker(ctx, admin, msg.Denom); err != nil {
 		ctx.Logger().Error("unable to cancel marker", "err", err)
@@ -238,6 +287,13 @@ func (k msgServer) Delete(goCtx context.Context, msg *types.MsgDeleteRequest) (*
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgDeleteResponse{}, nil
+	}
 
 	if err := k.Keeper.DeleteMarker(ctx, admin, msg.Denom); err != nil {
 		ctx.Logger().Error("unable to delete marker", "err", err)
@@ -332,6 +388,13 @@ func (k msgServer) Withdraw(goCtx context.Context, msg *types.MsgWithdrawRequest
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
+	pending, err := k.requireApprovals(ctx, msg.Denom, types.Access_Withdraw, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgWithdrawResponse{}, nil
+	}
 
 	if err := k.Keeper.WithdrawCoins(ctx, admin, to, msg.Denom, msg.Amount); err != nil {
 		ctx.Logger().Error("unable to withdraw coins from marker", "err", err)
@@ -377,6 +440,17 @@ func (k msgServer) Transfer(goCtx context.Context, msg *types.MsgTransferRequest
 	to := sdk.MustAccAddressFromBech32(msg.ToAddress)
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
+	// Only forced transfers (from an account other than the admin's) need approvals.
+	if !from.Equals(admin) {
+		pending, err := k.requireApprovals(ctx, msg.Amount.Denom, types.Access_ForceTransfer, admin, msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgTransferResponse{}, nil
+		}
+	}
+
 	err := k.TransferCoin(ctx, from, to, admin, msg.Amount)
 	if err != nil {
 		return nil, err
@@ -419,6 +493,17 @@ func (k msgServer) IbcTransfer(goCtx context.Context, msg *types.MsgIbcTransferR
 	from := sdk.MustAccAddressFromBech32(msg.Transfer.Sender)
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
 
+	// Only forced transfers (from an account other than the admin's) need approvals.
+	if !from.Equals(admin) {
+		pending, err := k.requireApprovals(ctx, msg.Transfer.Token.Denom, types.Access_ForceTransfer, admin, msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgIbcTransferResponse{}, nil
+		}
+	}
+
 	err := k.IbcTransferCoin(ctx, msg.Transfer.SourcePort, msg.Transfer.SourceChannel, msg.Transfer.Token, from, admin, msg.Transfer.Receiver, msg.Transfer.TimeoutHeight, msg.Transfer.TimeoutTimestamp, msg.Transfer.Memo)
 	if err != nil {
 		return nil, err
@@ -460,8 +545,15 @@ func (k msgServer) SetDenomMetadata(
 	}
 
 	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	pending, err := k.requireApprovals(ctx, msg.Metadata.Base, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgSetDenomMetadataResponse{}, nil
+	}
 
-	err := k.SetMarkerDenomMetadata(ctx, msg.Metadata, admin)
+	err = k.SetMarkerDenomMetadata(ctx, msg.Metadata, admin)
 	if err != nil {
 		return nil, err
 	}
@@ -525,6 +617,12 @@ func (k msgServer) AddFinalizeActivateMarker(goCtx context.Context, msg *types.M
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if msg.MintSchedule != nil {
+		if err = k.SetMintSchedule(ctx, msg.Amount.Denom, *msg.MintSchedule); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
 	return &types.MsgAddFinalizeActivateMarkerResponse{}, nil
 }
 
@@ -584,6 +682,14 @@ func (k msgServer) UpdateRequiredAttributes(goCtx context.Context, msg *types.Ms
 		}
 	case !m.AddressHasAccess(caller, types.Access_Transfer):
 		return nil, fmt.Errorf("caller does not have authority to update required attributes %s", msg.TransferAuthority)
+	default:
+		pending, err := k.RequireApprovals(ctx, m, types.Access_Admin, caller, msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgUpdateRequiredAttributesResponse{}, nil
+		}
 	}
 
 	removeList, err := k.NormalizeRequiredAttributes(ctx, msg.RemoveRequiredAttributes)
@@ -657,6 +763,13 @@ func (k msgServer) SetAccountData(goCtx context.Context, msg *types.MsgSetAccoun
 		if err = marker.ValidateHasAccess(msg.Signer, types.Access_Deposit); err != nil {
 			return nil, err
 		}
+		pending, err := k.RequireApprovals(ctx, marker, types.Access_Admin, sdk.MustAccAddressFromBech32(msg.Signer), msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgSetAccountDataResponse{}, nil
+		}
 	}
 
 	err = k.attrKeeper.SetAccountData(ctx, marker.GetAddress().String(), msg.Value)
@@ -684,8 +797,17 @@ func (k msgServer) UpdateSendDenyList(goCtx context.Context, msg *types.MsgUpdat
 		if !marker.HasGovernanceEnabled() {
 			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
 		}
-	} else if err = marker.ValidateHasAccess(msg.Authority, types.Access_Transfer); err != nil {
-		return nil, err
+	} else {
+		if err = marker.ValidateHasAccess(msg.Authority, types.Access_Transfer); err != nil {
+			return nil, err
+		}
+		pending, err := k.RequireApprovals(ctx, marker, types.Access_Admin, sdk.MustAccAddressFromBech32(msg.Authority), msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgUpdateSendDenyListResponse{}, nil
+		}
 	}
 
 	markerAddr := marker.GetAddress()
@@ -727,6 +849,16 @@ func (k msgServer) AddNetAssetValues(goCtx context.Context, msg *types.MsgAddNet
 
 	if !isGovProp {
 		admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+		// Once a marker has nav reporters, only they can provide net asset values (as reports).
+		if len(marker.AddressListForPermission(types.Access_Nav)) > 0 {
+			if !marker.AddressHasAccess(admin, types.Access_Nav) {
+				return nil, fmt.Errorf("signer %v does not have nav access to report net asset value for %q", msg.Administrator, marker.GetDenom())
+			}
+			if err = k.ReportNetAssetValues(ctx, marker, msg.NetAssetValues, admin); err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+			}
+			return &types.MsgAddNetAssetValuesResponse{}, nil
+		}
 		hasGrants := types.GrantsForAddress(admin, marker.GetAccessList()...).GetAccessList()
 		if len(hasGrants) == 0 {
 			return nil, fmt.Errorf("signer %v does not have permission to add net asset value for %q", msg.Administrator, marker.GetDenom())
@@ -821,6 +953,247 @@ func (k msgServer) SetDenomMetadataProposal(goCtx context.Context, msg *types.Ms
 	return &types.MsgSetDenomMetadataProposalResponse{}, nil
 }
 
+// SetMintScheduleProposal can only be called via gov proposal
+func (k msgServer) SetMintScheduleProposal(goCtx context.Context, msg *types.MsgSetMintScheduleProposalRequest) (*types.MsgSetMintScheduleProposalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	err := k.Keeper.HandleSetMintScheduleProposal(ctx, msg.Denom, msg.MintSchedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMintScheduleProposalResponse{}, nil
+}
+
+// SetApprovalThresholds handles a message to set how many accounts with an access must approve actions that use it.
+func (k msgServer) SetApprovalThresholds(goCtx context.Context, msg *types.MsgSetApprovalThresholdsRequest) (*types.MsgSetApprovalThresholdsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+		if err = marker.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+		pending, err := k.RequireApprovals(ctx, marker, types.Access_Admin, admin, msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgSetApprovalThresholdsResponse{}, nil
+		}
+	}
+
+	if err = k.Keeper.SetApprovalThresholds(ctx, marker, msg.Thresholds, msg.PendingActionTtlSeconds, msg.Administrator); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetApprovalThresholdsResponse{}, nil
+}
+
+// ApproveMarkerAction handles a message to approve a pending marker action, executing it once it has enough approvals.
+func (k msgServer) ApproveMarkerAction(goCtx context.Context, msg *types.MsgApproveMarkerActionRequest) (*types.MsgApproveMarkerActionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approver := sdk.MustAccAddressFromBech32(msg.Approver)
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	action, execute, err := k.ApprovePendingAction(ctx, marker, msg.Id, approver)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if execute {
+		if err = k.executeMarkerAction(types.WithApprovedAction(ctx), action); err != nil {
+			return nil, fmt.Errorf("could not execute pending action %d: %w", action.Id, err)
+		}
+	}
+
+	return &types.MsgApproveMarkerActionResponse{Executed: execute}, nil
+}
+
+// SetTransferLimits handles a message to set or remove a marker's holding caps, outflow limits and holder cap.
+func (k msgServer) SetTransferLimits(goCtx context.Context, msg *types.MsgSetTransferLimitsRequest) (*types.MsgSetTransferLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if msg.Administrator == k.GetAuthority() {
+		if !marker.HasGovernanceEnabled() {
+			return nil, fmt.Errorf("%s marker does not allow governance control", msg.Denom)
+		}
+	} else {
+		admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+		if err = marker.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+			return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+		}
+		pending, err := k.RequireApprovals(ctx, marker, types.Access_Admin, admin, msg)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			return &types.MsgSetTransferLimitsResponse{}, nil
+		}
+	}
+
+	if err = k.Keeper.SetTransferLimits(ctx, marker, msg.Limits, msg.Administrator); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgSetTransferLimitsResponse{}, nil
+}
+
+// CreateDistribution handles a message to fund a pro-rata distribution to the holders of a marker's denom.
+func (k msgServer) CreateDistribution(goCtx context.Context, msg *types.MsgCreateDistributionRequest) (*types.MsgCreateDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = marker.ValidateAddressHasAccess(admin, types.Access_Admin); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	pending, err := k.RequireApprovals(ctx, marker, types.Access_Admin, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgCreateDistributionResponse{}, nil
+	}
+
+	id, err := k.Keeper.CreateDistribution(ctx, marker, msg.Funds, msg.RecordHeight, msg.PushPayouts, admin)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgCreateDistributionResponse{DistributionId: id}, nil
+}
+
+// ClaimDistribution handles a message from a holder to receive their part of a distribution.
+func (k msgServer) ClaimDistribution(goCtx context.Context, msg *types.MsgClaimDistributionRequest) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	claimant := sdk.MustAccAddressFromBech32(msg.Claimant)
+	if err = k.Keeper.ClaimDistribution(ctx, marker, msg.DistributionId, claimant); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgClaimDistributionResponse{}, nil
+}
+
+// RedeemAll handles a message to take a page of a marker's denom back from its holders and burn it.
+func (k msgServer) RedeemAll(goCtx context.Context, msg *types.MsgRedeemAllRequest) (*types.MsgRedeemAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	marker, err := k.GetMarkerByDenom(ctx, msg.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	admin := sdk.MustAccAddressFromBech32(msg.Administrator)
+	if err = marker.ValidateAddressHasAccess(admin, types.Access_ForceTransfer); err != nil {
+		return nil, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+	}
+	pending, err := k.RequireApprovals(ctx, marker, types.Access_ForceTransfer, admin, msg)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return &types.MsgRedeemAllResponse{}, nil
+	}
+
+	resp, err := k.Keeper.RedeemAll(ctx, marker, admin, msg.RedemptionDenom, msg.GetLimitOrDefault(), msg.PageKey)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return resp, nil
+}
+
+// requireApprovals checks if a msg needs approvals from other accounts with the access before it is executed, storing
+// it as a pending action if so. If the marker doesn't exist, false is returned so the regular handling can fail it.
+func (k msgServer) requireApprovals(ctx sdk.Context, denom string, access types.Access, signer sdk.AccAddress, msg sdk.Msg) (bool, error) {
+	marker, err := k.GetMarkerByDenom(ctx, denom)
+	if err != nil {
+		return false, nil
+	}
+	return k.RequireApprovals(ctx, marker, access, signer, msg)
+}
+
+// executeMarkerAction executes the msg of a pending action that has enough approvals.
+func (k msgServer) executeMarkerAction(ctx sdk.Context, action *types.PendingMarkerAction) error {
+	msg, err := action.GetSdkMsg()
+	if err != nil {
+		return err
+	}
+	switch m := msg.(type) {
+	case *types.MsgGrantAllowanceRequest:
+		_, err = k.GrantAllowance(ctx, m)
+	case *types.MsgAddAccessRequest:
+		_, err = k.AddAccess(ctx, m)
+	case *types.MsgDeleteAccessRequest:
+		_, err = k.DeleteAccess(ctx, m)
+	case *types.MsgFinalizeRequest:
+		_, err = k.Finalize(ctx, m)
+	case *types.MsgActivateRequest:
+		_, err = k.Activate(ctx, m)
+	case *types.MsgCancelRequest:
+		_, err = k.Cancel(ctx, m)
+	case *types.MsgDeleteRequest:
+		_, err = k.Delete(ctx, m)
+	case *types.MsgWithdrawRequest:
+		_, err = k.Withdraw(ctx, m)
+	case *types.MsgTransferRequest:
+		_, err = k.Transfer(ctx, m)
+	case *types.MsgIbcTransferRequest:
+		_, err = k.IbcTransfer(ctx, m)
+	case *types.MsgSetDenomMetadataRequest:
+		_, err = k.SetDenomMetadata(ctx, m)
+	case *types.MsgUpdateRequiredAttributesRequest:
+		_, err = k.UpdateRequiredAttributes(ctx, m)
+	case *types.MsgSetAccountDataRequest:
+		_, err = k.SetAccountData(ctx, m)
+	case *types.MsgUpdateSendDenyListRequest:
+		_, err = k.UpdateSendDenyList(ctx, m)
+	case *types.MsgSetApprovalThresholdsRequest:
+		_, err = k.SetApprovalThresholds(ctx, m)
+	case *types.MsgSetTransferLimitsRequest:
+		_, err = k.SetTransferLimits(ctx, m)
+	case *types.MsgCreateDistributionRequest:
+		_, err = k.CreateDistribution(ctx, m)
+	case *types.MsgRedeemAllRequest:
+		_, err = k.RedeemAll(ctx, m)
+	default:
+		err = fmt.Errorf("unsupported pending action msg type %s", sdk.MsgTypeURL(msg))
+	}
+	return err
+}
+
 // UpdateParams is a governance proposal endpoint for updating the marker module's params.
 func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParamsRequest) (*types.MsgUpdateParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -830,7 +1203,7 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	}
 
 	k.SetParams(ctx, msg.Params)
-	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply)); err != nil {
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventMarkerParamsUpdated(msg.Params.EnableGovernance, msg.Params.GetUnrestrictedDenomRegex(), msg.Params.MaxSupply, msg.Params.MaxNavReportAgeSeconds)); err != nil {
 		return nil, err
 	}
 
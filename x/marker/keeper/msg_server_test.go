@@ -635,6 +635,15 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 	finalizedMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(finalizedMarkerDenom), nil, 1, 0)
 	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(finalizedMarkerAcct, sdk.NewInt64Coin(finalizedMarkerDenom, 1000), authUser, []types.AccessGrant{{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}}}, types.StatusFinalized, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
 
+	reporterUser := testUserAddress("reporter")
+	reportedMarkerDenom := "reportedjackthecat"
+	reportedMarkerAcct := authtypes.NewBaseAccount(types.MustGetMarkerAddress(reportedMarkerDenom), nil, 2, 0)
+	reportedGrants := []types.AccessGrant{
+		{Address: authUser.String(), Permissions: []types.Access{types.Access_Transfer}},
+		{Address: reporterUser.String(), Permissions: []types.Access{types.Access_Nav}},
+	}
+	s.app.MarkerKeeper.SetNewMarker(s.ctx, types.NewMarkerAccount(reportedMarkerAcct, sdk.NewInt64Coin(reportedMarkerDenom, 1000), authUser, reportedGrants, types.StatusProposed, types.MarkerType_RestrictedCoin, true, false, false, []string{}))
+
 	testCases := []struct {
 		name   string
 		msg    types.MsgAddNetAssetValuesRequest
@@ -711,6 +720,47 @@ func (s *MsgServerTestSuite) TestAddNetAssetValue() {
 				Administrator: authUser.String(),
 			},
 		},
+		{
+			name: "signer without nav access on marker with nav reporters",
+			msg: types.MsgAddNetAssetValuesRequest{
+				Denom: reportedMarkerDenom,
+				NetAssetValues: []types.NetAssetValue{
+					{
+						Price:  sdk.NewInt64Coin(types.UsdDenom, 100),
+						Volume: uint64(100),
+					},
+				},
+				Administrator: authUser.String(),
+			},
+			expErr: fmt.Sprintf(`signer %s does not have nav access to report net asset value for "reportedjackthecat"`, authUser.String()),
+		},
+		{
+			name: "nav reporter with invalid report",
+			msg: types.MsgAddNetAssetValuesRequest{
+				Denom: reportedMarkerDenom,
+				NetAssetValues: []types.NetAssetValue{
+					{
+						Price:  sdk.NewInt64Coin(types.UsdDenom, 0),
+						Volume: uint64(0),
+					},
+				},
+				Administrator: reporterUser.String(),
+			},
+			expErr: "invalid net asset value report: reported net asset value volume must be positive value: invalid request",
+		},
+		{
+			name: "successfully report nav",
+			msg: types.MsgAddNetAssetValuesRequest{
+				Denom: reportedMarkerDenom,
+				NetAssetValues: []types.NetAssetValue{
+					{
+						Price:  sdk.NewInt64Coin(types.UsdDenom, 100),
+						Volume: uint64(100),
+					},
+				},
+				Administrator: reporterUser.String(),
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1846,6 +1896,88 @@ func (s *MsgServerTestSuite) TestSetDenomMetadataProposal() {
 	}
 }
 
+func (s *MsgServerTestSuite) TestSetMintScheduleProposal() {
+	newMarker := func(denom string, allowGov bool) {
+		marker := types.NewMarkerAccount(
+			authtypes.NewBaseAccountWithAddress(types.MustGetMarkerAddress(denom)),
+			sdk.NewInt64Coin(denom, 1000),
+			s.owner1Addr,
+			[]types.AccessGrant{
+				{Address: s.owner1Addr.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Mint}},
+			},
+			types.StatusFinalized,
+			types.MarkerType_Coin,
+			true,
+			allowGov,
+			false,
+			[]string{},
+		)
+		s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(s.ctx, marker), "Failed to add %q marker for tests", denom)
+	}
+	newMarker("hotdog", true)
+	newMarker("nogov", false)
+
+	schedule := types.NewMintSchedule(sdkmath.NewInt(100), 3600)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgSetMintScheduleProposalRequest
+		expErr string
+		expSet bool
+	}{
+		{
+			name:   "failed authority",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("hotdog", &schedule, "wrongauthority"),
+			expErr: "expected " + s.app.MarkerKeeper.GetAuthority() + " got wrongauthority: expected gov account as only signer for proposal message",
+		},
+		{
+			name:   "marker does not exist",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("nonexistent", &schedule, s.app.MarkerKeeper.GetAuthority()),
+			expErr: "nonexistent marker does not exist",
+		},
+		{
+			name:   "governance not allowed",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("nogov", &schedule, s.app.MarkerKeeper.GetAuthority()),
+			expErr: "nogov marker does not allow governance control",
+		},
+		{
+			name:   "invalid schedule",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("hotdog", &types.MintSchedule{}, s.app.MarkerKeeper.GetAuthority()),
+			expErr: "invalid mint schedule for hotdog: mint schedule must have a period limit or at least one tranche",
+		},
+		{
+			name:   "schedule set",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("hotdog", &schedule, s.app.MarkerKeeper.GetAuthority()),
+			expSet: true,
+		},
+		{
+			name:   "schedule removed",
+			msg:    types.NewMsgSetMintScheduleProposalRequest("hotdog", nil, s.app.MarkerKeeper.GetAuthority()),
+			expSet: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.SetMintScheduleProposal(s.ctx, tc.msg)
+			if len(tc.expErr) > 0 {
+				s.Assert().EqualError(err, tc.expErr, "SetMintScheduleProposal() error")
+				return
+			}
+			s.Require().NoError(err, "SetMintScheduleProposal() error")
+			mintSchedule, err := s.app.MarkerKeeper.GetMintSchedule(s.ctx, types.MustGetMarkerAddress(tc.msg.Denom))
+			s.Require().NoError(err, "GetMintSchedule")
+			if tc.expSet {
+				s.Require().NotNil(mintSchedule, "GetMintSchedule")
+				s.Assert().Equal(schedule.PeriodLimit.String(), mintSchedule.Schedule.PeriodLimit.String(), "PeriodLimit")
+				s.Assert().Equal(schedule.PeriodSeconds, mintSchedule.Schedule.PeriodSeconds, "PeriodSeconds")
+			} else {
+				s.Assert().Nil(mintSchedule, "GetMintSchedule")
+			}
+		})
+	}
+}
+
 func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 	authority := s.app.MarkerKeeper.GetAuthority()
 
@@ -1862,6 +1994,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					3600,
 				),
 			},
 		},
@@ -1873,6 +2006,7 @@ func (s *MsgServerTestSuite) TestMsgUpdateParamsRequest() {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					3600,
 				),
 			},
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalidAuthority": expected gov account as only signer for proposal message`,
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// ReportNetAssetValues records the net asset values reported by an account with nav access on the marker.
+// After each report, the marker's net asset value for the report's price denom is set to the median of the
+// current (unexpired) reports from accounts that still have nav access.
+func (k Keeper) ReportNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI, netAssetValues []types.NetAssetValue, reporter sdk.AccAddress) error {
+	if err := marker.ValidateAddressHasAccess(reporter, types.Access_Nav); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, nav := range netAssetValues {
+		if nav.Price.Denom != types.UsdDenom && nav.Price.Denom != marker.GetDenom() {
+			if _, err := k.GetMarkerByDenom(ctx, nav.Price.Denom); err != nil {
+				errs = append(errs, fmt.Errorf("net asset value denom does not exist: %w", err))
+				continue
+			}
+		}
+
+		nav.UpdatedBlockHeight = uint64(ctx.BlockHeight())
+		report := types.NewNetAssetValueReport(marker.GetDenom(), reporter.String(), nav, ctx.BlockTime())
+		if err := report.Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid net asset value report: %w", err))
+			continue
+		}
+		if err := k.SetNetAssetValueReport(ctx, marker.GetAddress(), report); err != nil {
+			errs = append(errs, fmt.Errorf("cannot set net asset value report: %w", err))
+			continue
+		}
+		if err := ctx.EventManager().EmitTypedEvent(types.NewEventNetAssetValueReported(marker.GetDenom(), nav.Price, nav.Volume, reporter.String())); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		agg, err := k.GetAggregatedNetAssetValue(ctx, marker, nav.Price.Denom)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("cannot aggregate net asset values: %w", err))
+			continue
+		}
+		if agg.Stale {
+			continue
+		}
+		if err = k.SetNetAssetValueWithBlockHeight(ctx, marker, agg.NetAssetValue, types.ModuleName, agg.NetAssetValue.UpdatedBlockHeight); err != nil {
+			errs = append(errs, fmt.Errorf("cannot set net asset value: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SetNetAssetValueReport stores a net asset value report, replacing any previous report from the
+// same reporter for the same price denom.
+func (k Keeper) SetNetAssetValueReport(ctx sdk.Context, markerAddr sdk.AccAddress, report types.NetAssetValueReport) error {
+	reporter, err := sdk.AccAddressFromBech32(report.Reporter)
+	if err != nil {
+		return fmt.Errorf("invalid reporter: %w", err)
+	}
+	bz, err := k.cdc.Marshal(&report)
+	if err != nil {
+		return err
+	}
+	key := types.NetAssetValueReportKey(markerAddr, report.NetAssetValue.Price.Denom, reporter)
+	ctx.KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// GetNetAssetValueReports returns all the net asset value reports for a marker in a price denom.
+func (k Keeper) GetNetAssetValueReports(ctx sdk.Context, markerAddr sdk.AccAddress, priceDenom string) ([]types.NetAssetValueReport, error) {
+	var reports []types.NetAssetValueReport
+	err := k.iterateNetAssetValueReports(ctx, types.NetAssetValueReportDenomPrefix(markerAddr, priceDenom), func(report types.NetAssetValueReport) (stop bool) {
+		reports = append(reports, report)
+		return false
+	})
+	return reports, err
+}
+
+// IterateAllNetAssetValueReports iterates all net asset value reports.
+func (k Keeper) IterateAllNetAssetValueReports(ctx sdk.Context, handler func(report types.NetAssetValueReport) (stop bool)) error {
+	return k.iterateNetAssetValueReports(ctx, types.NetAssetValueReportPrefix, handler)
+}
+
+// iterateNetAssetValueReports iterates the net asset value reports under the given prefix.
+func (k Keeper) iterateNetAssetValueReports(ctx sdk.Context, prefix []byte, handler func(report types.NetAssetValueReport) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), prefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var report types.NetAssetValueReport
+		if err := k.cdc.Unmarshal(it.Value(), &report); err != nil {
+			return err
+		}
+		if handler(report) {
+			break
+		}
+	}
+	return nil
+}
+
+// RemoveNetAssetValueReports removes all net asset value reports for a marker.
+func (k Keeper) RemoveNetAssetValueReports(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	it := storetypes.KVStorePrefixIterator(store, types.NetAssetValueReportMarkerPrefix(markerAddr))
+	var keys [][]byte
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetAggregatedNetAssetValue returns the median of the current net asset value reports for a marker in a
+// price denom. A report is current if it hasn't expired and its reporter still has nav access on the marker.
+// If there aren't any current reports, the result is marked stale and has the marker's recorded net asset
+// value (if there is one).
+func (k Keeper) GetAggregatedNetAssetValue(ctx sdk.Context, marker types.MarkerAccountI, priceDenom string) (*types.AggregatedNetAssetValue, error) {
+	reports, err := k.GetNetAssetValueReports(ctx, marker.GetAddress(), priceDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+	maxAge := k.GetMaxNavReportAge(ctx)
+	var current []types.NetAssetValue
+	var freshAsOf time.Time
+	var height uint64
+	for _, report := range reports {
+		if report.IsStale(blockTime, maxAge) {
+			continue
+		}
+		reporter, err := sdk.AccAddressFromBech32(report.Reporter)
+		if err != nil || !marker.AddressHasAccess(reporter, types.Access_Nav) {
+			continue
+		}
+		current = append(current, report.NetAssetValue)
+		if report.ReportedAt.After(freshAsOf) {
+			freshAsOf = report.ReportedAt
+		}
+		if report.NetAssetValue.UpdatedBlockHeight > height {
+			height = report.NetAssetValue.UpdatedBlockHeight
+		}
+	}
+
+	rv := &types.AggregatedNetAssetValue{Reports: reports}
+	if len(current) == 0 {
+		rv.Stale = true
+		nav, err := k.GetNetAssetValue(ctx, marker.GetDenom(), priceDenom)
+		if err != nil {
+			return nil, err
+		}
+		if nav != nil {
+			rv.NetAssetValue = *nav
+		}
+		return rv, nil
+	}
+
+	rv.NetAssetValue = types.MedianNetAssetValue(current)
+	rv.NetAssetValue.UpdatedBlockHeight = height
+	rv.FreshAsOf = &freshAsOf
+	return rv, nil
+}
+
+// GetAggregatedNetAssetValues returns the aggregated net asset values of a marker, one for each price denom
+// that the marker has either a recorded net asset value or a report for, ordered by price denom.
+func (k Keeper) GetAggregatedNetAssetValues(ctx sdk.Context, marker types.MarkerAccountI) ([]types.AggregatedNetAssetValue, error) {
+	priceDenoms := make(map[string]bool)
+	err := k.IterateNetAssetValues(ctx, marker.GetAddress(), func(nav types.NetAssetValue) (stop bool) {
+		priceDenoms[nav.Price.Denom] = true
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = k.iterateNetAssetValueReports(ctx, types.NetAssetValueReportMarkerPrefix(marker.GetAddress()), func(report types.NetAssetValueReport) (stop bool) {
+		priceDenoms[report.NetAssetValue.Price.Denom] = true
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(priceDenoms))
+	for denom := range priceDenoms {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	rv := make([]types.AggregatedNetAssetValue, 0, len(denoms))
+	for _, denom := range denoms {
+		agg, err := k.GetAggregatedNetAssetValue(ctx, marker, denom)
+		if err != nil {
+			return nil, err
+		}
+		rv = append(rv, *agg)
+	}
+	return rv, nil
+}
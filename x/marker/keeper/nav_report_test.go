@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestReportNetAssetValues(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.NewContext(false).WithBlockTime(startTime).WithBlockHeight(5)
+
+	params := app.MarkerKeeper.GetParams(ctx)
+	params.MaxNavReportAgeSeconds = 3600
+	app.MarkerKeeper.SetParams(ctx, params)
+
+	admin := sdk.AccAddress("admin_account_______")
+	reporter1 := sdk.AccAddress("reporter1___________")
+	reporter2 := sdk.AccAddress("reporter2___________")
+	reporter3 := sdk.AccAddress("reporter3___________")
+	other := sdk.AccAddress("other_account_______")
+
+	denom := "navmarker"
+	markerAcc := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1_000),
+		admin,
+		[]types.AccessGrant{
+			{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin}},
+			{Address: reporter1.String(), Permissions: types.AccessList{types.Access_Nav}},
+			{Address: reporter2.String(), Permissions: types.AccessList{types.Access_Nav}},
+			{Address: reporter3.String(), Permissions: types.AccessList{types.Access_Nav}},
+		},
+		types.StatusProposed,
+		types.MarkerType_RestrictedCoin,
+		true,
+		true,
+		false,
+		[]string{},
+	)
+	usdNav := func(amount int64, volume uint64) types.NetAssetValue {
+		return types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, amount), volume)
+	}
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{usdNav(1, 1)}, "initial"), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker")
+
+	report := func(ctx sdk.Context, reporter sdk.AccAddress, nav types.NetAssetValue) {
+		t.Helper()
+		err := app.MarkerKeeper.ReportNetAssetValues(ctx, markerAcc, []types.NetAssetValue{nav}, reporter)
+		require.NoError(t, err, "ReportNetAssetValues %s", reporter)
+	}
+	assertStoredNav := func(ctx sdk.Context, exp types.NetAssetValue) {
+		t.Helper()
+		nav, err := app.MarkerKeeper.GetNetAssetValue(ctx, denom, types.UsdDenom)
+		require.NoError(t, err, "GetNetAssetValue")
+		require.NotNil(t, nav, "GetNetAssetValue")
+		assert.Equal(t, exp.Price.String(), nav.Price.String(), "stored nav price")
+		assert.Equal(t, exp.Volume, nav.Volume, "stored nav volume")
+	}
+
+	t.Run("not a reporter", func(t *testing.T) {
+		err := app.MarkerKeeper.ReportNetAssetValues(ctx, markerAcc, []types.NetAssetValue{usdNav(1, 1)}, other)
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_NAV on navmarker marker ("+markerAcc.GetAddress().String()+")", "ReportNetAssetValues")
+	})
+
+	t.Run("invalid reports", func(t *testing.T) {
+		navs := []types.NetAssetValue{
+			types.NewNetAssetValue(sdk.NewInt64Coin(denom, 1), 1),
+			types.NewNetAssetValue(sdk.NewInt64Coin("nosuchdenom", 1), 1),
+			usdNav(0, 0),
+		}
+		err := app.MarkerKeeper.ReportNetAssetValues(ctx, markerAcc, navs, reporter1)
+		require.Error(t, err, "ReportNetAssetValues")
+		assert.Contains(t, err.Error(), `invalid net asset value report: net asset value denom cannot match marker denom "navmarker"`)
+		assert.Contains(t, err.Error(), "net asset value denom does not exist: ")
+		assert.Contains(t, err.Error(), "invalid net asset value report: reported net asset value volume must be positive value")
+		reports, err := app.MarkerKeeper.GetNetAssetValueReports(ctx, markerAcc.GetAddress(), types.UsdDenom)
+		require.NoError(t, err, "GetNetAssetValueReports")
+		assert.Empty(t, reports, "reports")
+	})
+
+	t.Run("one report", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		report(ctx, reporter1, usdNav(10, 1))
+		assertStoredNav(ctx, usdNav(10, 1))
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventNetAssetValueReported(denom, sdk.NewInt64Coin(types.UsdDenom, 10), 1, reporter1.String()))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+	})
+
+	t.Run("two reports", func(t *testing.T) {
+		report(ctx, reporter2, usdNav(20, 1))
+		assertStoredNav(ctx, usdNav(15, 1))
+	})
+
+	t.Run("three reports", func(t *testing.T) {
+		report(ctx, reporter3, usdNav(60, 2))
+		assertStoredNav(ctx, usdNav(20, 1))
+
+		agg, err := app.MarkerKeeper.GetAggregatedNetAssetValue(ctx, markerAcc, types.UsdDenom)
+		require.NoError(t, err, "GetAggregatedNetAssetValue")
+		assert.False(t, agg.Stale, "Stale")
+		require.NotNil(t, agg.FreshAsOf, "FreshAsOf")
+		assert.Equal(t, startTime, *agg.FreshAsOf, "FreshAsOf")
+		assert.Len(t, agg.Reports, 3, "Reports")
+		assert.Equal(t, uint64(5), agg.NetAssetValue.UpdatedBlockHeight, "UpdatedBlockHeight")
+	})
+
+	t.Run("replaced report", func(t *testing.T) {
+		report(ctx, reporter1, usdNav(50, 1))
+		// 20, 30, 50 per unit.
+		assertStoredNav(ctx, usdNav(60, 2))
+		reports, err := app.MarkerKeeper.GetNetAssetValueReports(ctx, markerAcc.GetAddress(), types.UsdDenom)
+		require.NoError(t, err, "GetNetAssetValueReports")
+		assert.Len(t, reports, 3, "reports")
+	})
+
+	t.Run("some reports expired", func(t *testing.T) {
+		laterTime := startTime.Add(90 * time.Minute)
+		ctx := ctx.WithBlockTime(laterTime).WithBlockHeight(10)
+		report(ctx, reporter2, usdNav(40, 1))
+		// Only reporter2 is current.
+		assertStoredNav(ctx, usdNav(40, 1))
+
+		agg, err := app.MarkerKeeper.GetAggregatedNetAssetValue(ctx, markerAcc, types.UsdDenom)
+		require.NoError(t, err, "GetAggregatedNetAssetValue")
+		assert.False(t, agg.Stale, "Stale")
+		require.NotNil(t, agg.FreshAsOf, "FreshAsOf")
+		assert.Equal(t, laterTime, *agg.FreshAsOf, "FreshAsOf")
+		assert.Equal(t, uint64(10), agg.NetAssetValue.UpdatedBlockHeight, "UpdatedBlockHeight")
+	})
+
+	t.Run("all reports expired", func(t *testing.T) {
+		ctx := ctx.WithBlockTime(startTime.Add(5 * time.Hour))
+		agg, err := app.MarkerKeeper.GetAggregatedNetAssetValue(ctx, markerAcc, types.UsdDenom)
+		require.NoError(t, err, "GetAggregatedNetAssetValue")
+		assert.True(t, agg.Stale, "Stale")
+		assert.Nil(t, agg.FreshAsOf, "FreshAsOf")
+		assert.Equal(t, usdNav(40, 1).Price.String(), agg.NetAssetValue.Price.String(), "NetAssetValue price")
+		assert.Len(t, agg.Reports, 3, "Reports")
+	})
+
+	t.Run("reporter access revoked", func(t *testing.T) {
+		ctx := ctx.WithBlockTime(startTime.Add(90 * time.Minute))
+		require.NoError(t, markerAcc.RevokeAccess(reporter2), "RevokeAccess")
+		app.MarkerKeeper.SetMarker(ctx, markerAcc)
+
+		agg, err := app.MarkerKeeper.GetAggregatedNetAssetValue(ctx, markerAcc, types.UsdDenom)
+		require.NoError(t, err, "GetAggregatedNetAssetValue")
+		assert.True(t, agg.Stale, "Stale")
+	})
+
+	t.Run("aggregated values for all price denoms", func(t *testing.T) {
+		cherryNav := types.NewNetAssetValue(sdk.NewInt64Coin("cherry", 3), 1)
+		cherryAcc := types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress("cherry"), nil, 0, 0),
+			sdk.NewInt64Coin("cherry", 1_000),
+			admin,
+			[]types.AccessGrant{{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin}}},
+			types.StatusProposed,
+			types.MarkerType_Coin,
+			true,
+			true,
+			false,
+			[]string{},
+		)
+		require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, cherryAcc, []types.NetAssetValue{usdNav(1, 1)}, "initial"), "AddSetNetAssetValues cherry")
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, cherryAcc), "AddFinalizeAndActivateMarker cherry")
+		require.NoError(t, app.MarkerKeeper.SetNetAssetValue(ctx, markerAcc, cherryNav, "test"), "SetNetAssetValue cherry")
+
+		aggs, err := app.MarkerKeeper.GetAggregatedNetAssetValues(ctx, markerAcc)
+		require.NoError(t, err, "GetAggregatedNetAssetValues")
+		require.Len(t, aggs, 2, "GetAggregatedNetAssetValues")
+		assert.Equal(t, "3cherry", aggs[0].NetAssetValue.Price.String(), "first price")
+		assert.True(t, aggs[0].Stale, "first Stale")
+		assert.Empty(t, aggs[0].Reports, "first Reports")
+		assert.Equal(t, types.UsdDenom, aggs[1].NetAssetValue.Price.Denom, "second price denom")
+		assert.Len(t, aggs[1].Reports, 3, "second Reports")
+	})
+
+	t.Run("genesis export includes reports", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		assert.Len(t, genState.NetAssetValueReports, 3, "NetAssetValueReports")
+		assert.NoError(t, genState.Validate(), "Validate")
+	})
+
+	t.Run("removing the marker removes the reports", func(t *testing.T) {
+		app.MarkerKeeper.RemoveMarker(ctx, markerAcc)
+		reports, err := app.MarkerKeeper.GetNetAssetValueReports(ctx, markerAcc.GetAddress(), types.UsdDenom)
+		require.NoError(t, err, "GetNetAssetValueReports")
+		assert.Empty(t, reports, "reports")
+	})
+}
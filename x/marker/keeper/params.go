@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 	"regexp"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -49,6 +50,12 @@ func (k Keeper) GetUnrestrictedDenomRegex(ctx sdk.Context) (regex string) {
 	return k.GetParams(ctx).UnrestrictedDenomRegex
 }
 
+// GetMaxNavReportAge returns how long a net asset value report is used for before it is considered stale.
+// Zero means reports never become stale.
+func (k Keeper) GetMaxNavReportAge(ctx sdk.Context) time.Duration {
+	return time.Duration(k.GetParams(ctx).MaxNavReportAgeSeconds) * time.Second
+}
+
 // ValidateUnrestictedDenom checks if the supplied denom is valid based on the module params
 func (k Keeper) ValidateUnrestictedDenom(ctx sdk.Context, denom string) error {
 	// Anchors are enforced on the denom validation expression.  Similar to how the SDK does hits.
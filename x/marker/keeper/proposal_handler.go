@@ -28,6 +28,9 @@ func (k Keeper) HandleSupplyIncreaseProposal(ctx sdk.Context, amount sdk.Coin, t
 	}
 
 	if m.GetStatus() == types.StatusProposed || m.GetStatus() == types.StatusFinalized {
+		if err = k.recordScheduledMint(ctx, m, amount.Amount); err != nil {
+			return err
+		}
 		total := m.GetSupply().Add(amount)
 		if err = m.SetSupply(total); err != nil {
 			return err
@@ -269,3 +272,36 @@ func (k Keeper) HandleSetDenomMetadataProposal(ctx sdk.Context, metadata banktyp
 	k.Logger(ctx).Info("denom metadata set for marker", "marker", metadata.Base, "denom metadata", metadata.String())
 	return nil
 }
+
+// HandleSetMintScheduleProposal handles a Set Mint Schedule governance proposal request.
+// A nil schedule removes the marker's mint schedule.
+func (k Keeper) HandleSetMintScheduleProposal(ctx sdk.Context, denom string, schedule *types.MintSchedule) error {
+	addr, err := types.MarkerAddress(denom)
+	if err != nil {
+		return err
+	}
+	m, err := k.GetMarker(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if m == nil {
+		return fmt.Errorf("%s marker does not exist", denom)
+	}
+	if !m.HasGovernanceEnabled() {
+		return fmt.Errorf("%s marker does not allow governance control", denom)
+	}
+
+	if schedule == nil {
+		if err = k.RemoveMintSchedule(ctx, denom); err != nil {
+			return err
+		}
+		k.Logger(ctx).Info("mint schedule removed from marker", "marker", denom)
+		return nil
+	}
+
+	if err = k.SetMintSchedule(ctx, denom, *schedule); err != nil {
+		return err
+	}
+	k.Logger(ctx).Info("mint schedule set for marker", "marker", denom, "mint schedule", schedule.String())
+	return nil
+}
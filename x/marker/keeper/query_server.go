@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -203,6 +204,168 @@ func (k Keeper) NetAssetValues(c context.Context, req *types.QueryNetAssetValues
 	return &types.QueryNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// AggregatedNetAssetValues query for returning the median net asset values for a marker along with the reports
+func (k Keeper) AggregatedNetAssetValues(c context.Context, req *types.QueryAggregatedNetAssetValuesRequest) (*types.QueryAggregatedNetAssetValuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	navs, err := k.GetAggregatedNetAssetValues(ctx, marker)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAggregatedNetAssetValuesResponse{NetAssetValues: navs}, nil
+}
+
+// MintSchedule query for returning a marker's mint schedule along with how much can currently be minted
+func (k Keeper) MintSchedule(c context.Context, req *types.QueryMintScheduleRequest) (*types.QueryMintScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	mintSchedule, err := k.GetMintSchedule(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	rv := &types.QueryMintScheduleResponse{
+		MintSchedule: mintSchedule,
+		Mintable:     k.GetMintableAmount(ctx, marker, mintSchedule),
+	}
+	if mintSchedule != nil {
+		rv.RemainingInPeriod = mintSchedule.RemainingInPeriod(ctx.BlockTime())
+		rv.RemainingUnlocked = mintSchedule.RemainingUnlocked(ctx.BlockTime())
+	}
+	return rv, nil
+}
+
+// ApprovalThresholds returns the approval thresholds of a marker.
+func (k Keeper) ApprovalThresholds(c context.Context, req *types.QueryApprovalThresholdsRequest) (*types.QueryApprovalThresholdsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds, err := k.GetApprovalThresholds(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryApprovalThresholdsResponse{ApprovalThresholds: thresholds}, nil
+}
+
+// PendingActions returns the actions of a marker that are waiting for approvals.
+func (k Keeper) PendingActions(c context.Context, req *types.QueryPendingActionsRequest) (*types.QueryPendingActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []types.PendingMarkerAction
+	actionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingActionMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(actionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var action types.PendingMarkerAction
+		if err := k.cdc.Unmarshal(value, &action); err != nil {
+			return err
+		}
+		actions = append(actions, action)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingActionsResponse{PendingActions: actions, Pagination: pageRes}, nil
+}
+
+// TransferLimits returns the transfer limits of a marker along with its number of holders.
+func (k Keeper) TransferLimits(c context.Context, req *types.QueryTransferLimitsRequest) (*types.QueryTransferLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := k.GetTransferLimits(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryTransferLimitsResponse{TransferLimits: limits, HolderCount: k.GetHolderCount(ctx, marker.GetDenom())}, nil
+}
+
+// AddressTransferLimits returns how much more of a marker's denom an account can receive and send.
+func (k Keeper) AddressTransferLimits(c context.Context, req *types.QueryAddressTransferLimitsRequest) (*types.QueryAddressTransferLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %v", err)
+	}
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	limits, err := k.GetTransferLimits(ctx, marker.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	rv := &types.QueryAddressTransferLimitsResponse{
+		TransferLimits: limits,
+		Balance:        k.bankKeeper.GetBalance(ctx, addr, marker.GetDenom()).Amount,
+		PeriodOutflow:  sdkmath.ZeroInt(),
+	}
+	if limits == nil {
+		return rv, nil
+	}
+
+	rv.RemainingHolding = limits.Limits.RemainingHolding(rv.Balance)
+	outflow, err := k.GetAddressOutflow(ctx, marker.GetAddress(), addr)
+	if err != nil {
+		return nil, err
+	}
+	if outflow == nil {
+		newOutflow := types.NewAddressOutflow(marker.GetDenom(), addr)
+		outflow = &newOutflow
+	}
+	rv.PeriodOutflow = outflow.SentInPeriod(limits.Limits, ctx.BlockTime())
+	rv.RemainingOutflow = outflow.RemainingInPeriod(limits.Limits, ctx.BlockTime())
+	if rv.PeriodOutflow.IsPositive() {
+		periodStart := outflow.PeriodStart
+		rv.PeriodStart = &periodStart
+	}
+	return rv, nil
+}
+
 // accountForDenomOrAddress attempts to first get a marker by account address and then by denom.
 func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (types.MarkerAccountI, error) {
 	var addrErr, err error
@@ -220,3 +383,96 @@ func accountForDenomOrAddress(ctx sdk.Context, keeper Keeper, lookup string) (ty
 	}
 	return account, nil
 }
+
+// Distributions returns the distributions of a marker that have not been completed.
+func (k Keeper) Distributions(c context.Context, req *types.QueryDistributionsRequest) (*types.QueryDistributionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var distributions []types.MarkerDistribution
+	distributionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionMarkerPrefix(marker.GetAddress()))
+	pageRes, err := query.Paginate(distributionStore, req.Pagination, func(_ []byte, value []byte) error {
+		var distribution types.MarkerDistribution
+		if err := k.cdc.Unmarshal(value, &distribution); err != nil {
+			return err
+		}
+		distributions = append(distributions, distribution)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionsResponse{Distributions: distributions, Pagination: pageRes}, nil
+}
+
+// DistributionPayouts returns the payouts of a distribution that holders have not yet received.
+func (k Keeper) DistributionPayouts(c context.Context, req *types.QueryDistributionPayoutsRequest) (*types.QueryDistributionPayoutsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.DistributionId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "distribution id cannot be zero")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var payouts []types.DistributionPayout
+	payoutStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.DistributionPayoutsPrefix(req.DistributionId))
+	pageRes, err := query.Paginate(payoutStore, req.Pagination, func(_ []byte, value []byte) error {
+		var payout types.DistributionPayout
+		if err := k.cdc.Unmarshal(value, &payout); err != nil {
+			return err
+		}
+		payouts = append(payouts, payout)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDistributionPayoutsResponse{Payouts: payouts, Pagination: pageRes}, nil
+}
+
+// HolderSnapshot returns the holders of a marker's denom along with the height the balances are from.
+func (k Keeper) HolderSnapshot(c context.Context, req *types.QueryHolderSnapshotRequest) (*types.QueryHolderSnapshotResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	marker, err := accountForDenomOrAddress(ctx, k, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := marker.GetDenom()
+	denomOwners, err := k.bankKeeper.DenomOwners(c, &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: req.Pagination,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	holders := make([]types.Balance, len(denomOwners.DenomOwners))
+	for i, owner := range denomOwners.DenomOwners {
+		holders[i] = types.Balance{
+			Address: owner.Address,
+			Coins:   sdk.NewCoins(owner.Balance),
+		}
+	}
+
+	return &types.QueryHolderSnapshotResponse{
+		Denom:      denom,
+		Height:     ctx.BlockHeight(),
+		Supply:     k.bankKeeper.GetSupply(ctx, denom),
+		Holders:    holders,
+		Pagination: denomOwners.Pagination,
+	}, nil
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// RedeemAll takes a page of holders' balances of a marker's denom back into the marker account and burns them.
+// If a redemption denom is provided, each holder is paid by the admin at the marker's net asset value in that
+// denom. Holders that cannot be redeemed (e.g. because they cannot receive their payment) are skipped and can be
+// attempted again by starting over without a page key.
+func (k Keeper) RedeemAll(ctx sdk.Context, marker types.MarkerAccountI, admin sdk.AccAddress, redemptionDenom string, limit uint32, pageKey []byte) (*types.MsgRedeemAllResponse, error) {
+	denom := marker.GetDenom()
+	if marker.GetStatus() != types.StatusActive {
+		return nil, fmt.Errorf("cannot redeem %s: marker status (%s) is not %s", denom, marker.GetStatus(), types.StatusActive)
+	}
+	if marker.GetMarkerType() != types.MarkerType_RestrictedCoin || !marker.AllowsForcedTransfer() {
+		return nil, fmt.Errorf("cannot redeem %s: marker does not allow forced transfers", denom)
+	}
+	if err := marker.ValidateAddressHasAccess(admin, types.Access_ForceTransfer); err != nil {
+		return nil, err
+	}
+	if err := marker.ValidateAddressHasAccess(admin, types.Access_Burn); err != nil {
+		return nil, err
+	}
+
+	var nav *types.NetAssetValue
+	if len(redemptionDenom) > 0 {
+		aggregated, err := k.GetAggregatedNetAssetValue(ctx, marker, redemptionDenom)
+		if err != nil {
+			return nil, err
+		}
+		if aggregated.NetAssetValue.Volume == 0 {
+			return nil, fmt.Errorf("%s marker does not have a net asset value in %s", denom, redemptionDenom)
+		}
+		nav = &aggregated.NetAssetValue
+	}
+
+	resp, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Key: pageKey, Limit: uint64(limit)},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get %s holders: %w", denom, err)
+	}
+
+	rv := &types.MsgRedeemAllResponse{Amount: sdk.NewCoin(denom, sdkmath.ZeroInt()), Payouts: sdk.Coins{}}
+	if resp.Pagination != nil {
+		rv.NextPageKey = resp.Pagination.NextKey
+	}
+	for _, owner := range resp.DenomOwners {
+		if owner.Address == marker.GetAddress().String() || !owner.Balance.IsPositive() {
+			continue
+		}
+		holder, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return nil, err
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		payout, err := k.redeemHolder(cacheCtx, marker, admin, holder, owner.Balance, nav)
+		if err != nil {
+			rv.SkippedHolders++
+			if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedemptionSkipped(denom, owner.Address, err)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		writeCache()
+		rv.RedeemedHolders++
+		rv.Amount = rv.Amount.Add(owner.Balance)
+		rv.Payouts = rv.Payouts.Add(payout...)
+	}
+
+	if rv.Amount.IsPositive() {
+		if err = k.DecreaseSupply(ctx, marker, rv.Amount); err != nil {
+			return nil, err
+		}
+		if err = ctx.EventManager().EmitTypedEvent(types.NewEventMarkerBurn(rv.Amount.Amount.String(), denom, admin.String())); err != nil {
+			return nil, err
+		}
+	}
+	return rv, nil
+}
+
+// redeemHolder moves a holder's balance of a marker's denom into the marker account and, if there's a net asset
+// value, pays the holder for it from the admin. The payment is subject to all of the bank module's send
+// restrictions (e.g. quarantine and sanction).
+func (k Keeper) redeemHolder(ctx sdk.Context, marker types.MarkerAccountI, admin, holder sdk.AccAddress, balance sdk.Coin, nav *types.NetAssetValue) (sdk.Coins, error) {
+	if !k.canForceTransferFrom(ctx, holder) {
+		return nil, fmt.Errorf("funds are not allowed to be removed from %s", holder)
+	}
+	if err := k.bankKeeper.SendCoins(types.WithBypass(ctx), holder, marker.GetAddress(), sdk.NewCoins(balance)); err != nil {
+		return nil, fmt.Errorf("could not take %s from %s: %w", balance, holder, err)
+	}
+
+	payout := sdk.Coins{}
+	if nav != nil {
+		coin, err := types.CalculateRedemptionPayout(balance.Amount, *nav)
+		if err != nil {
+			return nil, err
+		}
+		if coin.IsPositive() {
+			payout = sdk.NewCoins(coin)
+			if err = k.bankKeeper.SendCoins(ctx, admin, holder, payout); err != nil {
+				return nil, fmt.Errorf("could not pay %s to %s: %w", payout, holder, err)
+			}
+		}
+	}
+
+	return payout, ctx.EventManager().EmitTypedEvent(types.NewEventMarkerRedeemed(marker.GetDenom(), holder.String(), balance, payout, admin.String()))
+}
@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestRedeemAll(t *testing.T) {
+	app := simapp.Setup(t)
+	ctx := app.NewContext(false).WithBlockHeight(10).WithBlockTime(time.Unix(1700000000, 0).UTC())
+	msgServer := keeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	holder3 := sdk.AccAddress("holder3_____________")
+	newAcct := sdk.AccAddress("new_account_________")
+	other := sdk.AccAddress("other_______________")
+	for _, addr := range []sdk.AccAddress{admin, holder1, holder2, holder3} {
+		acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+		require.NoError(t, acc.SetSequence(1), "SetSequence %s", addr)
+		app.AccountKeeper.SetAccount(ctx, acc)
+	}
+
+	addMarker := func(denom string, allowForcedTransfer bool) types.MarkerAccountI {
+		markerAcc := types.NewMarkerAccount(
+			authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+			sdk.NewInt64Coin(denom, 1_000),
+			admin,
+			[]types.AccessGrant{{Address: admin.String(), Permissions: types.AccessList{
+				types.Access_Admin, types.Access_Withdraw, types.Access_Burn, types.Access_Transfer, types.Access_ForceTransfer,
+			}}},
+			types.StatusProposed,
+			types.MarkerType_RestrictedCoin,
+			false,
+			false,
+			allowForcedTransfer,
+			[]string{},
+		)
+		require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 3), 2)}, "initial"), "AddSetNetAssetValues %s", denom)
+		require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker %s", denom)
+		for addr, amount := range map[string]int64{holder1.String(): 500, holder2.String(): 200, holder3.String(): 100, newAcct.String(): 50} {
+			coins := sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+			require.NoError(t, app.MarkerKeeper.WithdrawCoins(ctx, admin, sdk.MustAccAddressFromBech32(addr), denom, coins), "withdraw %s to %s", coins, addr)
+		}
+		return markerAcc
+	}
+
+	denom := "windfund"
+	markerAcc := addMarker(denom, true)
+	addMarker("lockedfund", false)
+	require.NoError(t, testutil.FundAccount(ctx, app.BankKeeper, admin, sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, 5_000))), "FundAccount")
+	balance := func(addr sdk.AccAddress, denom string) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr, denom).Amount.Int64()
+	}
+
+	t.Run("holder snapshot", func(t *testing.T) {
+		resp, err := app.MarkerKeeper.HolderSnapshot(ctx, &types.QueryHolderSnapshotRequest{Id: denom})
+		require.NoError(t, err, "HolderSnapshot")
+		assert.Equal(t, denom, resp.Denom, "Denom")
+		assert.Equal(t, int64(10), resp.Height, "Height")
+		assert.Equal(t, "1000windfund", resp.Supply.String(), "Supply")
+		holders := make(map[string]string)
+		for _, holder := range resp.Holders {
+			holders[holder.Address] = holder.Coins.String()
+		}
+		assert.Equal(t, map[string]string{
+			holder1.String():                "500windfund",
+			holder2.String():                "200windfund",
+			holder3.String():                "100windfund",
+			newAcct.String():                "50windfund",
+			markerAcc.GetAddress().String(): "150windfund",
+		}, holders, "Holders")
+
+		resp, err = app.MarkerKeeper.HolderSnapshot(ctx, &types.QueryHolderSnapshotRequest{Id: denom, Pagination: &query.PageRequest{Limit: 2}})
+		require.NoError(t, err, "HolderSnapshot with limit")
+		assert.Len(t, resp.Holders, 2, "Holders with limit")
+		assert.NotEmpty(t, resp.Pagination.NextKey, "NextKey with limit")
+	})
+
+	t.Run("forced transfers not allowed", func(t *testing.T) {
+		_, err := msgServer.RedeemAll(ctx, types.NewMsgRedeemAllRequest("lockedfund", "", 0, nil, admin.String()))
+		assert.EqualError(t, err, "cannot redeem lockedfund: marker does not allow forced transfers: invalid request", "RedeemAll")
+	})
+
+	t.Run("admin without force transfer access", func(t *testing.T) {
+		_, err := msgServer.RedeemAll(ctx, types.NewMsgRedeemAllRequest(denom, "", 0, nil, other.String()))
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_FORCE_TRANSFER on windfund marker ("+markerAcc.GetAddress().String()+"): unauthorized", "RedeemAll")
+	})
+
+	t.Run("no net asset value in redemption denom", func(t *testing.T) {
+		_, err := msgServer.RedeemAll(ctx, types.NewMsgRedeemAllRequest(denom, "eurf", 0, nil, admin.String()))
+		assert.EqualError(t, err, "windfund marker does not have a net asset value in eurf: invalid request", "RedeemAll")
+	})
+
+	t.Run("redeem in pages", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		var pageKey []byte
+		var redeemed, skipped uint64
+		pages := 0
+		for {
+			pages++
+			require.LessOrEqual(t, pages, 10, "number of pages")
+			resp, err := msgServer.RedeemAll(ctx, types.NewMsgRedeemAllRequest(denom, types.UsdDenom, 2, pageKey, admin.String()))
+			require.NoError(t, err, "RedeemAll page %d", pages)
+			redeemed += resp.RedeemedHolders
+			skipped += resp.SkippedHolders
+			if len(resp.NextPageKey) == 0 {
+				break
+			}
+			pageKey = resp.NextPageKey
+		}
+		assert.Equal(t, uint64(3), redeemed, "redeemed holders")
+		assert.Equal(t, uint64(1), skipped, "skipped holders")
+
+		for _, holder := range []sdk.AccAddress{holder1, holder2, holder3} {
+			assert.Zero(t, balance(holder, denom), "%s balance of %s", holder, denom)
+		}
+		assert.Equal(t, int64(750), balance(holder1, types.UsdDenom), "holder1 payout")
+		assert.Equal(t, int64(300), balance(holder2, types.UsdDenom), "holder2 payout")
+		assert.Equal(t, int64(150), balance(holder3, types.UsdDenom), "holder3 payout")
+		assert.Equal(t, int64(3_800), balance(admin, types.UsdDenom), "admin balance after payouts")
+
+		assert.Equal(t, int64(50), balance(newAcct, denom), "balance of account that cannot be force transferred from")
+		assert.Equal(t, int64(150), balance(markerAcc.GetAddress(), denom), "marker account balance")
+		assert.Equal(t, "200windfund", app.BankKeeper.GetSupply(ctx, denom).String(), "supply after redemption")
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventMarkerRedeemed(denom, holder1.String(), sdk.NewInt64Coin(denom, 500), sdk.NewCoins(sdk.NewInt64Coin(types.UsdDenom, 750)), admin.String()))
+		require.NoError(t, err, "TypedEventToEvent redeemed")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+		expEvent, err = sdk.TypedEventToEvent(types.NewEventMarkerRedemptionSkipped(denom, newAcct.String(), errors.New("funds are not allowed to be removed from "+newAcct.String())))
+		require.NoError(t, err, "TypedEventToEvent skipped")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+	})
+}
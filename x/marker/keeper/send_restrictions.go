@@ -18,6 +18,24 @@ var _ banktypes.SendRestrictionFn = Keeper{}.SendRestrictionFn
 
 func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Holding caps and holder caps apply to all sends, including withdrawals and transfers done by a marker's admins.
+	transferLimits, err := k.getTransferLimitsByDenom(ctx, amt)
+	if err != nil {
+		return nil, err
+	}
+	// The holder counts of those markers are also kept up to date here.
+	for _, coin := range amt {
+		// A denom can't be sent while a distribution is recording its holders.
+		if err = k.validateNotRecordingDistribution(ctx, coin); err != nil {
+			return nil, err
+		}
+		k.recordHolderRemoved(ctx, fromAddr, coin, transferLimits[coin.Denom])
+		if err = k.validateHoldingLimits(ctx, fromAddr, toAddr, coin, transferLimits[coin.Denom]); err != nil {
+			return nil, err
+		}
+		k.recordHolderAdded(ctx, toAddr, coin, transferLimits[coin.Denom])
+	}
+
 	// In some cases, it might not be possible to add a bypass to the context.
 	// If it's from either the Marker or IBC Transfer module accounts, assume proper validation has been done elsewhere.
 	if types.HasBypass(ctx) || fromAddr.Equals(k.markerModuleAddr) || fromAddr.Equals(k.ibcTransferModuleAddr) {
@@ -84,6 +102,13 @@ func (k Keeper) SendRestrictionFn(goCtx context.Context, fromAddr, toAddr sdk.Ac
 		}
 	}
 
+	// Outflow limits only apply to sends that aren't done by the marker module (e.g. a withdrawal or transfer).
+	for _, coin := range amt {
+		if err = k.recordOutflow(ctx, fromAddr, toAddr, coin, transferLimits[coin.Denom]); err != nil {
+			return nil, err
+		}
+	}
+
 	return toAddr, nil
 }
 
@@ -0,0 +1,330 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+// SetTransferLimits sets a marker's transfer limits. Providing nil limits removes them.
+// The amounts tracked as sent by each account are reset either way.
+func (k Keeper) SetTransferLimits(ctx sdk.Context, marker types.MarkerAccountI, limits *types.TransferLimits, administrator string) error {
+	if limits == nil {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.TransferLimitsKey(marker.GetAddress()))
+		k.removeHolders(ctx, marker.GetAddress())
+	} else {
+		transferLimits := types.NewMarkerTransferLimits(marker.GetDenom(), *limits)
+		if err := transferLimits.Validate(); err != nil {
+			return err
+		}
+		if err := k.setMarkerTransferLimits(ctx, transferLimits); err != nil {
+			return err
+		}
+		if err := k.initHolderCount(ctx, marker.GetDenom()); err != nil {
+			return err
+		}
+	}
+	k.removeAddressOutflows(ctx, marker.GetAddress())
+	return ctx.EventManager().EmitTypedEvent(types.NewEventTransferLimitsSet(marker.GetDenom(), administrator))
+}
+
+// GetTransferLimits returns a marker's transfer limits, or nil if it doesn't have any.
+func (k Keeper) GetTransferLimits(ctx sdk.Context, markerAddr sdk.AccAddress) (*types.MarkerTransferLimits, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.TransferLimitsKey(markerAddr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var limits types.MarkerTransferLimits
+	if err := k.cdc.Unmarshal(bz, &limits); err != nil {
+		return nil, err
+	}
+	return &limits, nil
+}
+
+// IterateTransferLimits iterates all marker transfer limits.
+func (k Keeper) IterateTransferLimits(ctx sdk.Context, handler func(limits types.MarkerTransferLimits) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.TransferLimitsPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var limits types.MarkerTransferLimits
+		if err := k.cdc.Unmarshal(it.Value(), &limits); err != nil {
+			return err
+		}
+		if handler(limits) {
+			break
+		}
+	}
+	return nil
+}
+
+// setMarkerTransferLimits stores a marker's transfer limits.
+func (k Keeper) setMarkerTransferLimits(ctx sdk.Context, limits types.MarkerTransferLimits) error {
+	markerAddr, err := types.MarkerAddress(limits.Denom)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&limits)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.TransferLimitsKey(markerAddr), bz)
+	return nil
+}
+
+// GetAddressOutflow returns how much of a marker's denom an account has sent during its current outflow period,
+// or nil if it hasn't sent any while the marker has had an outflow limit.
+func (k Keeper) GetAddressOutflow(ctx sdk.Context, markerAddr, addr sdk.AccAddress) (*types.AddressOutflow, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.AddressOutflowKey(markerAddr, addr))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	var outflow types.AddressOutflow
+	if err := k.cdc.Unmarshal(bz, &outflow); err != nil {
+		return nil, err
+	}
+	return &outflow, nil
+}
+
+// IterateAddressOutflows iterates all account outflows.
+func (k Keeper) IterateAddressOutflows(ctx sdk.Context, handler func(outflow types.AddressOutflow) (stop bool)) error {
+	it := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AddressOutflowPrefix)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var outflow types.AddressOutflow
+		if err := k.cdc.Unmarshal(it.Value(), &outflow); err != nil {
+			return err
+		}
+		if handler(outflow) {
+			break
+		}
+	}
+	return nil
+}
+
+// setAddressOutflow stores an account's outflow of a marker's denom.
+func (k Keeper) setAddressOutflow(ctx sdk.Context, outflow types.AddressOutflow) error {
+	markerAddr, err := types.MarkerAddress(outflow.Denom)
+	if err != nil {
+		return err
+	}
+	addr, err := sdk.AccAddressFromBech32(outflow.Address)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&outflow)
+	if err != nil {
+		return err
+	}
+	ctx.KVStore(k.storeKey).Set(types.AddressOutflowKey(markerAddr, addr), bz)
+	return nil
+}
+
+// removeAddressOutflows deletes all account outflows of a marker's denom.
+func (k Keeper) removeAddressOutflows(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.AddressOutflowMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetHolderCount returns the number of accounts, other than the marker's own account and module accounts, that hold
+// a marker's denom. The count is only kept while the marker has transfer limits. Without them, zero is returned.
+func (k Keeper) GetHolderCount(ctx sdk.Context, denom string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.HolderCountKey(types.MustGetMarkerAddress(denom)))
+	if len(bz) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// setHolderCount stores the number of accounts holding a marker's denom.
+func (k Keeper) setHolderCount(ctx sdk.Context, denom string, count uint64) {
+	ctx.KVStore(k.storeKey).Set(types.HolderCountKey(types.MustGetMarkerAddress(denom)), binary.BigEndian.AppendUint64(nil, count))
+}
+
+// initHolderCount records each account holding a marker's denom and stores the number of them as its holder count.
+// This looks at every holder, so it should only be used when transfer limits are set, not during a send.
+func (k Keeper) initHolderCount(ctx sdk.Context, denom string) error {
+	markerAddr := types.MustGetMarkerAddress(denom)
+	k.removeHolders(ctx, markerAddr)
+	store := ctx.KVStore(k.storeKey)
+	var count uint64
+	req := &banktypes.QueryDenomOwnersRequest{Denom: denom, Pagination: &query.PageRequest{Limit: query.PaginationMaxLimit}}
+	for {
+		resp, err := k.bankKeeper.DenomOwners(ctx, req)
+		if err != nil {
+			return fmt.Errorf("could not count %s holders: %w", denom, err)
+		}
+		for _, owner := range resp.DenomOwners {
+			addr, err := sdk.AccAddressFromBech32(owner.Address)
+			if err != nil {
+				return err
+			}
+			if owner.Balance.IsPositive() && k.isCountedHolder(ctx, addr, denom) {
+				store.Set(types.HolderKey(markerAddr, addr), []byte{})
+				count++
+			}
+		}
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: query.PaginationMaxLimit}
+	}
+	k.setHolderCount(ctx, denom, count)
+	return nil
+}
+
+// isCountedHolder returns true if the address counts towards the number of holders of a marker's denom.
+// The marker's own account and module accounts (e.g. the one used for minting) are not counted.
+func (k Keeper) isCountedHolder(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	if addr.Equals(types.MustGetMarkerAddress(denom)) || addr.Equals(k.markerModuleAddr) {
+		return false
+	}
+	_, isModuleAcc := k.authKeeper.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return !isModuleAcc
+}
+
+// isRecordedHolder returns true if the address is currently counted in the holder count of a marker's denom.
+func (k Keeper) isRecordedHolder(ctx sdk.Context, addr sdk.AccAddress, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.HolderKey(types.MustGetMarkerAddress(denom), addr))
+}
+
+// removeHolders deletes the holder count of a marker's denom along with the record of each account counted in it.
+func (k Keeper) removeHolders(ctx sdk.Context, markerAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	var keys [][]byte
+	it := storetypes.KVStorePrefixIterator(store, types.HolderMarkerPrefix(markerAddr))
+	for ; it.Valid(); it.Next() {
+		keys = append(keys, it.Key())
+	}
+	it.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.HolderCountKey(markerAddr))
+}
+
+// recordHolderRemoved lowers the holder count of a marker with transfer limits if a send of its denom leaves the
+// sender without any. It must be called after the funds have been taken from the sender.
+// Each account is recorded when it's counted, so a transfer that applies the send restriction several times for
+// the same sender (e.g. a MultiSend with several outputs) only lowers the count once.
+func (k Keeper) recordHolderRemoved(ctx sdk.Context, fromAddr sdk.AccAddress, coin sdk.Coin, transferLimits *types.MarkerTransferLimits) {
+	if transferLimits == nil || !coin.IsPositive() || !k.isRecordedHolder(ctx, fromAddr, coin.Denom) {
+		return
+	}
+	if !k.bankKeeper.GetBalance(ctx, fromAddr, coin.Denom).IsZero() {
+		return
+	}
+	ctx.KVStore(k.storeKey).Delete(types.HolderKey(types.MustGetMarkerAddress(coin.Denom), fromAddr))
+	if count := k.GetHolderCount(ctx, coin.Denom); count > 0 {
+		k.setHolderCount(ctx, coin.Denom, count-1)
+	}
+}
+
+// recordHolderAdded raises the holder count of a marker with transfer limits if a send of its denom is going to a
+// recipient that isn't counted yet. Since the bank module gives the funds to the recipients after applying all
+// of a transfer's send restrictions, a recipient is recorded the first time it's seen so that it's only counted once.
+func (k Keeper) recordHolderAdded(ctx sdk.Context, toAddr sdk.AccAddress, coin sdk.Coin, transferLimits *types.MarkerTransferLimits) {
+	if transferLimits == nil || !coin.IsPositive() || k.isRecordedHolder(ctx, toAddr, coin.Denom) {
+		return
+	}
+	if k.isCountedHolder(ctx, toAddr, coin.Denom) {
+		ctx.KVStore(k.storeKey).Set(types.HolderKey(types.MustGetMarkerAddress(coin.Denom), toAddr), []byte{})
+		k.setHolderCount(ctx, coin.Denom, k.GetHolderCount(ctx, coin.Denom)+1)
+	}
+}
+
+// getTransferLimitsByDenom reads the transfer limits of each denom being sent.
+// Only denoms that have transfer limits are in the returned map.
+func (k Keeper) getTransferLimitsByDenom(ctx sdk.Context, amt sdk.Coins) (map[string]*types.MarkerTransferLimits, error) {
+	var rv map[string]*types.MarkerTransferLimits
+	for _, coin := range amt {
+		if !coin.IsPositive() {
+			continue
+		}
+		transferLimits, err := k.GetTransferLimits(ctx, types.MustGetMarkerAddress(coin.Denom))
+		if err != nil {
+			return nil, fmt.Errorf("could not read transfer limits for %s: %w", coin.Denom, err)
+		}
+		if transferLimits != nil {
+			if rv == nil {
+				rv = make(map[string]*types.MarkerTransferLimits)
+			}
+			rv[coin.Denom] = transferLimits
+		}
+	}
+	return rv, nil
+}
+
+// validateHoldingLimits makes sure a send won't put the recipient over the marker's holding cap, or the marker
+// over its holder cap. Sends to the marker's own account are not limited.
+func (k Keeper) validateHoldingLimits(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin, transferLimits *types.MarkerTransferLimits) error {
+	if transferLimits == nil || !coin.IsPositive() || fromAddr.Equals(toAddr) {
+		return nil
+	}
+	if toAddr.Equals(types.MustGetMarkerAddress(coin.Denom)) {
+		return nil
+	}
+	limits := transferLimits.Limits
+
+	toBalance := k.bankKeeper.GetBalance(ctx, toAddr, coin.Denom).Amount
+	if limits.HasMaxHolding() {
+		if newBalance := toBalance.Add(coin.Amount); newBalance.GT(limits.MaxHolding) {
+			return fmt.Errorf("cannot send %s to %s: its balance would be %s%s, which is more than the max holding of %s%s",
+				coin, toAddr, newBalance, coin.Denom, limits.MaxHolding, coin.Denom)
+		}
+	}
+
+	if limits.HasMaxHolders() && !k.isRecordedHolder(ctx, toAddr, coin.Denom) && k.isCountedHolder(ctx, toAddr, coin.Denom) {
+		// The bank module takes the funds from the sender before applying send restrictions, so a
+		// sender that is sending its entire balance is already not being counted as a holder.
+		if holders := k.GetHolderCount(ctx, coin.Denom); holders >= limits.MaxHolders {
+			return fmt.Errorf("cannot send %s to %s: %s marker already has the max of %d holders",
+				coin, toAddr, coin.Denom, limits.MaxHolders)
+		}
+	}
+
+	return nil
+}
+
+// recordOutflow records a send against the sender's outflow limit (if the marker has one).
+// An error is returned if the send is more than the sender has left in its current period.
+// Sends from the marker's own account are not limited.
+func (k Keeper) recordOutflow(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, coin sdk.Coin, transferLimits *types.MarkerTransferLimits) error {
+	if transferLimits == nil || !transferLimits.Limits.HasOutflowLimit() || !coin.IsPositive() || fromAddr.Equals(toAddr) {
+		return nil
+	}
+	markerAddr := types.MustGetMarkerAddress(coin.Denom)
+	if fromAddr.Equals(markerAddr) {
+		return nil
+	}
+
+	outflow, err := k.GetAddressOutflow(ctx, markerAddr, fromAddr)
+	if err != nil {
+		return fmt.Errorf("could not read %s outflow for %s: %w", coin.Denom, fromAddr, err)
+	}
+	if outflow == nil {
+		newOutflow := types.NewAddressOutflow(coin.Denom, fromAddr)
+		outflow = &newOutflow
+	}
+	if err = outflow.RecordOutflow(coin.Amount, transferLimits.Limits, ctx.BlockTime()); err != nil {
+		return err
+	}
+	return k.setAddressOutflow(ctx, *outflow)
+}
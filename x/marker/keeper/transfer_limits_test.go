@@ -0,0 +1,203 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	simapp "github.com/provenance-io/provenance/app"
+	"github.com/provenance-io/provenance/x/marker/keeper"
+	"github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestTransferLimits(t *testing.T) {
+	app := simapp.Setup(t)
+	startTime := time.Unix(1700000000, 0).UTC()
+	ctx := app.NewContext(false).WithBlockTime(startTime)
+	msgServer := keeper.NewMsgServerImpl(app.MarkerKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holder1 := sdk.AccAddress("holder1_____________")
+	holder2 := sdk.AccAddress("holder2_____________")
+	holder3 := sdk.AccAddress("holder3_____________")
+	other := sdk.AccAddress("other_______________")
+	multi1 := sdk.AccAddress("multi1______________")
+	multi2 := sdk.AccAddress("multi2______________")
+	multi3 := sdk.AccAddress("multi3______________")
+
+	denom := "cappedfund"
+	markerAcc := types.NewMarkerAccount(
+		authtypes.NewBaseAccount(types.MustGetMarkerAddress(denom), nil, 0, 0),
+		sdk.NewInt64Coin(denom, 1_000),
+		admin,
+		[]types.AccessGrant{{Address: admin.String(), Permissions: types.AccessList{types.Access_Admin, types.Access_Withdraw}}},
+		types.StatusProposed,
+		types.MarkerType_Coin,
+		true,
+		false,
+		false,
+		[]string{},
+	)
+	require.NoError(t, app.MarkerKeeper.AddSetNetAssetValues(ctx, markerAcc, []types.NetAssetValue{types.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1), 1)}, "initial"), "AddSetNetAssetValues")
+	require.NoError(t, app.MarkerKeeper.AddFinalizeAndActivateMarker(ctx, markerAcc), "AddFinalizeAndActivateMarker")
+
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	withdraw := func(ctx sdk.Context, to sdk.AccAddress, amount int64) error {
+		return app.MarkerKeeper.WithdrawCoins(ctx, admin, to, denom, coins(amount))
+	}
+
+	t.Run("non-admin cannot set limits", func(t *testing.T) {
+		limits := types.NewTransferLimits(sdkmath.NewInt(300), sdkmath.NewInt(100), 3600, 2)
+		_, err := msgServer.SetTransferLimits(ctx, types.NewMsgSetTransferLimitsRequest(denom, &limits, other.String()))
+		assert.EqualError(t, err, other.String()+" does not have ACCESS_ADMIN on cappedfund marker ("+markerAcc.GetAddress().String()+"): unauthorized", "SetTransferLimits")
+	})
+
+	t.Run("set limits", func(t *testing.T) {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		limits := types.NewTransferLimits(sdkmath.NewInt(300), sdkmath.NewInt(100), 3600, 2)
+		_, err := msgServer.SetTransferLimits(ctx, types.NewMsgSetTransferLimitsRequest(denom, &limits, admin.String()))
+		require.NoError(t, err, "SetTransferLimits")
+
+		expEvent, err := sdk.TypedEventToEvent(types.NewEventTransferLimitsSet(denom, admin.String()))
+		require.NoError(t, err, "TypedEventToEvent")
+		assert.Contains(t, ctx.EventManager().Events(), expEvent, "emitted events")
+
+		resp, err := app.MarkerKeeper.TransferLimits(ctx, &types.QueryTransferLimitsRequest{Id: denom})
+		require.NoError(t, err, "TransferLimits query")
+		require.NotNil(t, resp.TransferLimits, "TransferLimits")
+		assert.Equal(t, limits, resp.TransferLimits.Limits, "Limits")
+		assert.Equal(t, uint64(0), resp.HolderCount, "HolderCount")
+	})
+
+	t.Run("withdrawals are held to the holding and holder caps", func(t *testing.T) {
+		require.NoError(t, withdraw(ctx, holder1, 80), "withdraw 80 to holder1")
+		require.NoError(t, withdraw(ctx, holder2, 300), "withdraw 300 to holder2")
+		// Failed sends are checked in a cache context since the bank module has already taken the funds from the sender.
+		cacheCtx, _ := ctx.CacheContext()
+		err := withdraw(cacheCtx, holder2, 1)
+		assert.EqualError(t, err, "cannot send 1cappedfund to "+holder2.String()+": its balance would be 301cappedfund, which is more than the max holding of 300cappedfund", "withdraw 1 to holder2")
+		cacheCtx, _ = ctx.CacheContext()
+		err = withdraw(cacheCtx, holder3, 10)
+		assert.EqualError(t, err, "cannot send 10cappedfund to "+holder3.String()+": cappedfund marker already has the max of 2 holders", "withdraw 10 to holder3")
+	})
+
+	t.Run("sending an entire balance to a new holder", func(t *testing.T) {
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, holder3, coins(80)), "SendCoins 80 from holder1 to holder3")
+		resp, err := app.MarkerKeeper.TransferLimits(ctx, &types.QueryTransferLimitsRequest{Id: denom})
+		require.NoError(t, err, "TransferLimits query")
+		assert.Equal(t, uint64(2), resp.HolderCount, "HolderCount")
+	})
+
+	t.Run("sends are held to the outflow limit", func(t *testing.T) {
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder2, holder3, coins(60)), "SendCoins 60")
+		cacheCtx, _ := ctx.CacheContext()
+		err := app.BankKeeper.SendCoins(cacheCtx, holder2, holder3, coins(41))
+		assert.EqualError(t, err, "cannot send 41cappedfund from "+holder2.String()+": only 40cappedfund remains in the current outflow period", "SendCoins 41")
+
+		resp, err := app.MarkerKeeper.AddressTransferLimits(ctx, &types.QueryAddressTransferLimitsRequest{Id: denom, Address: holder2.String()})
+		require.NoError(t, err, "AddressTransferLimits query")
+		assert.Equal(t, "240", resp.Balance.String(), "Balance")
+		assert.Equal(t, "60", resp.RemainingHolding.String(), "RemainingHolding")
+		assert.Equal(t, "60", resp.PeriodOutflow.String(), "PeriodOutflow")
+		assert.Equal(t, "40", resp.RemainingOutflow.String(), "RemainingOutflow")
+		require.NotNil(t, resp.PeriodStart, "PeriodStart")
+		assert.Equal(t, startTime, *resp.PeriodStart, "PeriodStart")
+	})
+
+	t.Run("outflow limit resets in the next period", func(t *testing.T) {
+		nextPeriod := ctx.WithBlockTime(startTime.Add(time.Hour))
+		resp, err := app.MarkerKeeper.AddressTransferLimits(nextPeriod, &types.QueryAddressTransferLimitsRequest{Id: denom, Address: holder2.String()})
+		require.NoError(t, err, "AddressTransferLimits query")
+		assert.Equal(t, "0", resp.PeriodOutflow.String(), "PeriodOutflow")
+		assert.Equal(t, "100", resp.RemainingOutflow.String(), "RemainingOutflow")
+		assert.Nil(t, resp.PeriodStart, "PeriodStart")
+
+		require.NoError(t, app.BankKeeper.SendCoins(nextPeriod, holder2, holder3, coins(100)), "SendCoins 100")
+	})
+
+	t.Run("genesis export includes limits and outflows", func(t *testing.T) {
+		genState := app.MarkerKeeper.ExportGenesis(ctx)
+		require.Len(t, genState.TransferLimits, 1, "TransferLimits")
+		assert.Len(t, genState.AddressOutflows, 2, "AddressOutflows")
+		assert.NoError(t, genState.Validate(), "Validate")
+	})
+
+	t.Run("remove limits", func(t *testing.T) {
+		_, err := msgServer.SetTransferLimits(ctx, types.NewMsgSetTransferLimitsRequest(denom, nil, admin.String()))
+		require.NoError(t, err, "SetTransferLimits")
+
+		limits, err := app.MarkerKeeper.GetTransferLimits(ctx, markerAcc.GetAddress())
+		require.NoError(t, err, "GetTransferLimits")
+		assert.Nil(t, limits, "GetTransferLimits")
+		outflow, err := app.MarkerKeeper.GetAddressOutflow(ctx, markerAcc.GetAddress(), holder2)
+		require.NoError(t, err, "GetAddressOutflow")
+		assert.Nil(t, outflow, "GetAddressOutflow")
+
+		require.NoError(t, withdraw(ctx, holder1, 500), "withdraw 500 to holder1")
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, holder1, other, coins(500)), "SendCoins 500")
+	})
+
+	t.Run("setting limits counts the existing holders", func(t *testing.T) {
+		var expCount uint64
+		for _, addr := range []sdk.AccAddress{holder1, holder2, holder3, other} {
+			if app.BankKeeper.GetBalance(ctx, addr, denom).IsPositive() {
+				expCount++
+			}
+		}
+		require.NotZero(t, expCount, "number of holders")
+
+		limits := types.NewTransferLimits(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, 10)
+		_, err := msgServer.SetTransferLimits(ctx, types.NewMsgSetTransferLimitsRequest(denom, &limits, admin.String()))
+		require.NoError(t, err, "SetTransferLimits")
+		assert.Equal(t, expCount, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount after SetTransferLimits")
+
+		otherBal := app.BankKeeper.GetBalance(ctx, other, denom)
+		if app.BankKeeper.GetBalance(ctx, holder3, denom).IsPositive() {
+			expCount--
+		}
+		require.NoError(t, app.BankKeeper.SendCoins(ctx, other, holder3, sdk.NewCoins(otherBal)), "SendCoins entire balance")
+		assert.Equal(t, expCount, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount after sending entire balance")
+
+		require.NoError(t, withdraw(ctx, other, 1), "withdraw 1 to other")
+		assert.Equal(t, expCount+1, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount after withdraw to new holder")
+	})
+
+	t.Run("multi-send of an entire balance to several new holders", func(t *testing.T) {
+		count := app.MarkerKeeper.GetHolderCount(ctx, denom)
+		bal := app.BankKeeper.GetBalance(ctx, holder2, denom)
+		require.True(t, bal.Amount.Equal(sdkmath.NewInt(140)), "holder2 balance %s", bal)
+
+		input := banktypes.NewInput(holder2, coins(140))
+		outputs := []banktypes.Output{banktypes.NewOutput(multi1, coins(70)), banktypes.NewOutput(multi2, coins(70))}
+		require.NoError(t, app.BankKeeper.InputOutputCoins(ctx, input, outputs), "InputOutputCoins")
+		assert.Equal(t, count+1, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount")
+	})
+
+	t.Run("send from several inputs to a new holder", func(t *testing.T) {
+		count := app.MarkerKeeper.GetHolderCount(ctx, denom)
+		limits := types.NewTransferLimits(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, count+1)
+		_, err := msgServer.SetTransferLimits(ctx, types.NewMsgSetTransferLimitsRequest(denom, &limits, admin.String()))
+		require.NoError(t, err, "SetTransferLimits")
+		require.Equal(t, count, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount after SetTransferLimits")
+
+		inputs := []banktypes.Input{banktypes.NewInput(multi1, coins(10)), banktypes.NewInput(multi2, coins(10))}
+		outputs := []banktypes.Output{banktypes.NewOutput(multi3, coins(20))}
+		require.NoError(t, app.BankKeeper.InputOutputCoinsProv(ctx, inputs, outputs), "InputOutputCoinsProv")
+		assert.Equal(t, count+1, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount")
+
+		inputs = []banktypes.Input{banktypes.NewInput(multi1, coins(60)), banktypes.NewInput(multi2, coins(60))}
+		outputs = []banktypes.Output{banktypes.NewOutput(multi3, coins(120))}
+		require.NoError(t, app.BankKeeper.InputOutputCoinsProv(ctx, inputs, outputs), "InputOutputCoinsProv entire balances")
+		assert.Equal(t, count-1, app.MarkerKeeper.GetHolderCount(ctx, denom), "GetHolderCount after sending entire balances")
+	})
+}
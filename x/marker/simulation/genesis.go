@@ -64,6 +64,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	markerGenesis := types.GenesisState{
 		Params: types.Params{
 			MaxSupply:              maxSupply,
+			MaxNavReportAgeSeconds: types.DefaultMaxNavReportAgeSeconds,
 			EnableGovernance:       enableGovernance,
 			UnrestrictedDenomRegex: unrestrictedDenomRegex,
 		},
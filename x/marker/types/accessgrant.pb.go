@@ -56,6 +56,10 @@ const (
 	// ACCESS_FORCE_TRANSFER is the ability to transfer restricted coins from a 3rd-party account without their signature.
 	// This access right is only supported on RESTRICTED markers and only has meaning when allow_forced_transfer is true.
 	Access_ForceTransfer Access = 8
+	// ACCESS_NAV is the ability to report net asset values of the marker.
+	// Once any account has this access, only those accounts (or governance) can set the marker's net asset values,
+	// and the value for each price denom is the median of their unexpired reports.
+	Access_Nav Access = 9
 )
 
 var Access_name = map[int32]string{
@@ -68,6 +72,7 @@ var Access_name = map[int32]string{
 	6: "ACCESS_ADMIN",
 	7: "ACCESS_TRANSFER",
 	8: "ACCESS_FORCE_TRANSFER",
+	9: "ACCESS_NAV",
 }
 
 var Access_value = map[string]int32{
@@ -80,6 +85,7 @@ var Access_value = map[string]int32{
 	"ACCESS_ADMIN":          6,
 	"ACCESS_TRANSFER":       7,
 	"ACCESS_FORCE_TRANSFER": 8,
+	"ACCESS_NAV":            9,
 }
 
 func (x Access) String() string {
@@ -138,39 +144,39 @@ func init() {
 }
 
 var fileDescriptor_7242c30a84644575 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0x31, 0x6f, 0xd3, 0x4c,
-	0x18, 0xc7, 0xed, 0xb4, 0x4d, 0xd2, 0x4b, 0x9a, 0xd7, 0xef, 0xa9, 0x88, 0xd4, 0x14, 0xc7, 0x80,
-	0x84, 0x2a, 0x44, 0x6d, 0xb5, 0x6c, 0x6c, 0x4e, 0xec, 0x80, 0xa5, 0xc6, 0x8d, 0x1c, 0x47, 0x91,
-	0x58, 0x2a, 0xd7, 0x39, 0xd2, 0x53, 0xc9, 0x5d, 0x74, 0xe7, 0xa6, 0xf4, 0x1b, 0x20, 0x4f, 0x2c,
-	0x48, 0x2c, 0x96, 0x32, 0x33, 0xf7, 0x43, 0x20, 0xa6, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0x63, 0xa0,
-	0xe4, 0x12, 0xe2, 0xa1, 0xdb, 0xf3, 0xdc, 0xff, 0x77, 0x3f, 0x3d, 0xba, 0x7b, 0xc0, 0xf3, 0x11,
-	0xa3, 0x63, 0x44, 0x42, 0x12, 0x21, 0x73, 0x18, 0xb2, 0x4b, 0xc4, 0xcc, 0xf1, 0x91, 0x19, 0x46,
-	0x11, 0xe2, 0x7c, 0xc0, 0x42, 0x12, 0x1b, 0x23, 0x46, 0x63, 0x0a, 0x77, 0xd7, 0x9c, 0x21, 0x38,
-	0x63, 0x7c, 0xa4, 0xee, 0x0e, 0xe8, 0x80, 0x2e, 0x00, 0x73, 0x5e, 0x09, 0x56, 0xdd, 0x8b, 0x28,
-	0x1f, 0x52, 0x7e, 0x26, 0x02, 0xd1, 0x88, 0xe8, 0xe9, 0x17, 0x19, 0x94, 0xac, 0x85, 0xfc, 0xcd,
-	0x5c, 0x0e, 0xab, 0xa0, 0x10, 0xf6, 0xfb, 0x0c, 0x71, 0x5e, 0x95, 0x75, 0xf9, 0x60, 0xdb, 0x5f,
-	0xb5, 0xd0, 0x03, 0xa5, 0x11, 0x62, 0x43, 0xcc, 0x39, 0xa6, 0x84, 0x57, 0x73, 0xfa, 0xc6, 0x41,
-	0xe5, 0x78, 0xdf, 0xb8, 0x6f, 0x0c, 0x43, 0x18, 0xeb, 0x95, 0x6f, 0xbf, 0x6a, 0x40, 0xd4, 0x27,
-	0x98, 0xc7, 0x7e, 0x56, 0xf0, 0x7a, 0xff, 0xd3, 0xa4, 0x26, 0x7d, 0x9d, 0xd4, 0xa4, 0x3f, 0x93,
-	0x9a, 0xfc, 0xe3, 0xf6, 0xb0, 0x9c, 0x19, 0xc3, 0x7d, 0x71, 0x9b, 0x03, 0x79, 0x71, 0x00, 0x9f,
-	0x01, 0x68, 0x35, 0x1a, 0x4e, 0xa7, 0x73, 0xd6, 0xf5, 0x3a, 0x6d, 0xa7, 0xe1, 0x36, 0x5d, 0xc7,
-	0x56, 0x24, 0xb5, 0x94, 0xa4, 0x7a, 0xa1, 0x4b, 0x2e, 0x09, 0xbd, 0x26, 0x70, 0x0f, 0x94, 0x96,
-	0x50, 0xcb, 0xf5, 0x02, 0x45, 0x56, 0x8b, 0x49, 0xaa, 0x6f, 0xb6, 0x30, 0x89, 0x33, 0x51, 0xbd,
-	0xeb, 0x7b, 0x4a, 0x4e, 0x44, 0xf5, 0x2b, 0x46, 0x60, 0x0d, 0x54, 0x96, 0x91, 0xed, 0xb4, 0x4f,
-	0x3b, 0x6e, 0xa0, 0x6c, 0x08, 0xad, 0x8d, 0x46, 0x94, 0xe3, 0x18, 0x3e, 0x01, 0xff, 0x2d, 0x81,
-	0x9e, 0x1b, 0xbc, 0xb5, 0x7d, 0xab, 0xa7, 0x6c, 0xaa, 0xe5, 0x24, 0xd5, 0x8b, 0x3d, 0x1c, 0x5f,
-	0xf4, 0x59, 0x78, 0x0d, 0x1f, 0x83, 0x9d, 0x7f, 0x8e, 0x13, 0x27, 0x70, 0x94, 0x2d, 0x15, 0x24,
-	0xa9, 0x9e, 0xb7, 0xd1, 0x07, 0x14, 0x23, 0xf8, 0x08, 0x94, 0x97, 0xb1, 0x65, 0xb7, 0x5c, 0x4f,
-	0xc9, 0xab, 0xdb, 0x49, 0xaa, 0x6f, 0x59, 0xfd, 0x21, 0x26, 0x19, 0x7d, 0xe0, 0x5b, 0x5e, 0xa7,
-	0xe9, 0xf8, 0x4a, 0x41, 0xe8, 0x03, 0x16, 0x12, 0xfe, 0x1e, 0x31, 0xf8, 0x12, 0x3c, 0x58, 0x22,
-	0xcd, 0x53, 0xbf, 0xe1, 0xac, 0xc1, 0xa2, 0xfa, 0x7f, 0x92, 0xea, 0x3b, 0x4d, 0xca, 0x22, 0xb4,
-	0xa2, 0xeb, 0x37, 0xdf, 0xa7, 0x9a, 0x7c, 0x37, 0xd5, 0xe4, 0xdf, 0x53, 0x4d, 0xfe, 0x3c, 0xd3,
-	0xa4, 0xbb, 0x99, 0x26, 0xfd, 0x9c, 0x69, 0x12, 0x78, 0x88, 0xe9, 0xbd, 0x7f, 0x55, 0x57, 0x32,
-	0xef, 0xde, 0x9e, 0xef, 0x44, 0x5b, 0x7e, 0x77, 0x3c, 0xc0, 0xf1, 0xc5, 0xd5, 0xb9, 0x11, 0xd1,
-	0xa1, 0xb9, 0xbe, 0x74, 0x88, 0x69, 0xa6, 0x33, 0x3f, 0xae, 0xf6, 0x33, 0xbe, 0x19, 0x21, 0x7e,
-	0x9e, 0x5f, 0x2c, 0xd4, 0xab, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7, 0x70, 0xf5, 0x75, 0xc1,
-	0x02, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0xed, 0xa6, 0xcd, 0x8f, 0x4b, 0x1a, 0xcc, 0xa9, 0xa8, 0xa9, 0x29, 0x8e, 0x01, 0x09,
+	0x55, 0x88, 0xda, 0x6a, 0xd9, 0xd8, 0x9c, 0xd8, 0x01, 0x4b, 0x8d, 0x1b, 0x39, 0x0e, 0x91, 0x58,
+	0x2a, 0xd7, 0x39, 0x52, 0xab, 0xe4, 0x2e, 0xba, 0x73, 0x53, 0xfa, 0x1f, 0x20, 0x4f, 0x2c, 0x48,
+	0x2c, 0x96, 0x32, 0x33, 0xf3, 0x47, 0x20, 0x24, 0xa4, 0x8e, 0x6c, 0xa0, 0x64, 0xe1, 0xcf, 0x40,
+	0xc9, 0xb9, 0x8d, 0x87, 0x6e, 0xef, 0xdd, 0xf7, 0x73, 0x1f, 0x3d, 0xe9, 0x3d, 0xf0, 0x6c, 0x4c,
+	0xc9, 0x04, 0x61, 0x1f, 0x07, 0x48, 0x1f, 0xf9, 0xf4, 0x1c, 0x51, 0x7d, 0x72, 0xa0, 0xfb, 0x41,
+	0x80, 0x18, 0x1b, 0x52, 0x1f, 0x47, 0xda, 0x98, 0x92, 0x88, 0xc0, 0xad, 0x15, 0xa7, 0x71, 0x4e,
+	0x9b, 0x1c, 0xc8, 0x5b, 0x43, 0x32, 0x24, 0x4b, 0x40, 0x5f, 0x54, 0x9c, 0x95, 0x77, 0x02, 0xc2,
+	0x46, 0x84, 0x9d, 0xf0, 0x80, 0x37, 0x3c, 0x7a, 0xf2, 0x45, 0x04, 0x65, 0x63, 0x29, 0x7f, 0xbd,
+	0x90, 0xc3, 0x1a, 0x28, 0xf8, 0x83, 0x01, 0x45, 0x8c, 0xd5, 0x44, 0x55, 0xdc, 0x2b, 0xb9, 0x37,
+	0x2d, 0x74, 0x40, 0x79, 0x8c, 0xe8, 0x28, 0x64, 0x2c, 0x24, 0x98, 0xd5, 0xd6, 0xd4, 0xdc, 0x5e,
+	0xf5, 0x70, 0x57, 0xbb, 0x6b, 0x0c, 0x8d, 0x1b, 0x1b, 0xd5, 0x6f, 0x7f, 0xea, 0x80, 0xd7, 0x47,
+	0x21, 0x8b, 0xdc, 0xac, 0xe0, 0xd5, 0xee, 0xa7, 0x69, 0x5d, 0xf8, 0x3a, 0xad, 0x0b, 0xff, 0xa6,
+	0x75, 0xf1, 0xe7, 0xf7, 0xfd, 0x4a, 0x66, 0x0c, 0xfb, 0xf9, 0xaf, 0x35, 0x90, 0xe7, 0x0f, 0xf0,
+	0x29, 0x80, 0x46, 0xb3, 0x69, 0x75, 0xbb, 0x27, 0x3d, 0xa7, 0xdb, 0xb1, 0x9a, 0x76, 0xcb, 0xb6,
+	0x4c, 0x49, 0x90, 0xcb, 0x71, 0xa2, 0x16, 0x7a, 0xf8, 0x1c, 0x93, 0x4b, 0x0c, 0x77, 0x40, 0x39,
+	0x85, 0xda, 0xb6, 0xe3, 0x49, 0xa2, 0x5c, 0x8c, 0x13, 0x75, 0xbd, 0x1d, 0xe2, 0x28, 0x13, 0x35,
+	0x7a, 0xae, 0x23, 0xad, 0xf1, 0xa8, 0x71, 0x41, 0x31, 0xac, 0x83, 0x6a, 0x1a, 0x99, 0x56, 0xe7,
+	0xb8, 0x6b, 0x7b, 0x52, 0x8e, 0x6b, 0x4d, 0x34, 0x26, 0x2c, 0x8c, 0xe0, 0x63, 0x70, 0x2f, 0x05,
+	0xfa, 0xb6, 0xf7, 0xc6, 0x74, 0x8d, 0xbe, 0xb4, 0x2e, 0x57, 0xe2, 0x44, 0x2d, 0xf6, 0xc3, 0xe8,
+	0x6c, 0x40, 0xfd, 0x4b, 0xf8, 0x08, 0x6c, 0xde, 0x3a, 0x8e, 0x2c, 0xcf, 0x92, 0x36, 0x64, 0x10,
+	0x27, 0x6a, 0xde, 0x44, 0x1f, 0x50, 0x84, 0xe0, 0x43, 0x50, 0x49, 0x63, 0xc3, 0x6c, 0xdb, 0x8e,
+	0x94, 0x97, 0x4b, 0x71, 0xa2, 0x6e, 0x18, 0x83, 0x51, 0x88, 0x33, 0x7a, 0xcf, 0x35, 0x9c, 0x6e,
+	0xcb, 0x72, 0xa5, 0x02, 0xd7, 0x7b, 0xd4, 0xc7, 0xec, 0x3d, 0xa2, 0xf0, 0x05, 0x78, 0x90, 0x22,
+	0xad, 0x63, 0xb7, 0x69, 0xad, 0xc0, 0xa2, 0x7c, 0x3f, 0x4e, 0xd4, 0xcd, 0x16, 0xa1, 0x01, 0xba,
+	0xa5, 0xb7, 0x01, 0x48, 0x69, 0xc7, 0x78, 0x2b, 0x95, 0xe4, 0x42, 0x9c, 0xa8, 0x39, 0xc7, 0x9f,
+	0x34, 0xae, 0x7e, 0xcc, 0x14, 0xf1, 0x7a, 0xa6, 0x88, 0x7f, 0x67, 0x8a, 0xf8, 0x79, 0xae, 0x08,
+	0xd7, 0x73, 0x45, 0xf8, 0x3d, 0x57, 0x04, 0xb0, 0x1d, 0x92, 0x3b, 0x97, 0xd8, 0x90, 0x32, 0x0b,
+	0xe9, 0x2c, 0x8e, 0xa5, 0x23, 0xbe, 0x3b, 0x1c, 0x86, 0xd1, 0xd9, 0xc5, 0xa9, 0x16, 0x90, 0x91,
+	0xbe, 0xfa, 0xb4, 0x1f, 0x92, 0x4c, 0xa7, 0x7f, 0xbc, 0x39, 0xdc, 0xe8, 0x6a, 0x8c, 0xd8, 0x69,
+	0x7e, 0x79, 0x69, 0x2f, 0xff, 0x0f, 0x00, 0x81, 0x1f, 0x6f, 0xb5, 0xda, 0x02, 0x00, 0x00,
 }
 
 func (this *AccessGrant) Equal(that interface{}) bool {
@@ -0,0 +1,183 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const approvedActionKey = "approved-marker-action"
+
+// ApprovalThresholdAccesses are the accesses that can have approval thresholds.
+var ApprovalThresholdAccesses = []Access{Access_Admin, Access_Withdraw, Access_ForceTransfer}
+
+// WithApprovedAction returns a new context that will cause approval thresholds to be skipped.
+// It is used when executing a pending action that has enough approvals.
+func WithApprovedAction[C context.Context](ctx C) C {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx = sdkCtx.WithValue(approvedActionKey, true)
+	return context.Context(sdkCtx).(C)
+}
+
+// IsApprovedAction checks the context to see if approval thresholds should be skipped.
+func IsApprovedAction[C context.Context](ctx C) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	approved, isBool := sdkCtx.Value(approvedActionKey).(bool)
+	return isBool && approved
+}
+
+// NewApprovalThreshold returns a new instance of ApprovalThreshold
+func NewApprovalThreshold(access Access, requiredApprovals uint32) ApprovalThreshold {
+	return ApprovalThreshold{
+		Access:            access,
+		RequiredApprovals: requiredApprovals,
+	}
+}
+
+// ValidateApprovalThresholds returns an error if the provided approval thresholds are not valid.
+func ValidateApprovalThresholds(thresholds []ApprovalThreshold, pendingActionTTLSeconds uint64) error {
+	seen := make(map[Access]bool)
+	for _, threshold := range thresholds {
+		if !threshold.Access.IsOneOf(ApprovalThresholdAccesses...) {
+			return fmt.Errorf("approval thresholds are not supported for %s", threshold.Access)
+		}
+		if seen[threshold.Access] {
+			return fmt.Errorf("duplicate approval threshold for %s", threshold.Access)
+		}
+		seen[threshold.Access] = true
+		if threshold.RequiredApprovals < 2 {
+			return fmt.Errorf("required approvals for %s must be at least 2", threshold.Access)
+		}
+	}
+	if len(thresholds) > 0 && pendingActionTTLSeconds == 0 {
+		return errors.New("pending action ttl must be positive")
+	}
+	return nil
+}
+
+// NewMarkerApprovalThresholds returns a new instance of MarkerApprovalThresholds
+func NewMarkerApprovalThresholds(denom string, thresholds []ApprovalThreshold, pendingActionTTLSeconds uint64) MarkerApprovalThresholds {
+	return MarkerApprovalThresholds{
+		Denom:                   denom,
+		Thresholds:              thresholds,
+		PendingActionTtlSeconds: pendingActionTTLSeconds,
+	}
+}
+
+// Validate returns error if MarkerApprovalThresholds is not in a valid state
+func (m MarkerApprovalThresholds) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+	if len(m.Thresholds) == 0 {
+		return fmt.Errorf("invalid approval thresholds for %s: at least one threshold is required", m.Denom)
+	}
+	if err := ValidateApprovalThresholds(m.Thresholds, m.PendingActionTtlSeconds); err != nil {
+		return fmt.Errorf("invalid approval thresholds for %s: %w", m.Denom, err)
+	}
+	return nil
+}
+
+// RequiredApprovals returns how many approvals an action using the given access needs.
+// An action that does not need approvals from other accounts needs 1.
+func (m MarkerApprovalThresholds) RequiredApprovals(access Access) uint32 {
+	for _, threshold := range m.Thresholds {
+		if threshold.Access == access {
+			return threshold.RequiredApprovals
+		}
+	}
+	return 1
+}
+
+// PendingActionTTL returns how long a pending action can wait for approvals.
+func (m MarkerApprovalThresholds) PendingActionTTL() time.Duration {
+	return time.Duration(m.PendingActionTtlSeconds) * time.Second
+}
+
+// NewPendingMarkerAction returns a new PendingMarkerAction for a message, approved by the account that initiated it.
+func NewPendingMarkerAction(id uint64, denom string, access Access, msg sdk.Msg, proposer string, expiresAt time.Time) (PendingMarkerAction, error) {
+	msgAny, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return PendingMarkerAction{}, err
+	}
+	return PendingMarkerAction{
+		Id:        id,
+		Denom:     denom,
+		Access:    access,
+		Msg:       msgAny,
+		Approvers: []string{proposer},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// Validate returns error if PendingMarkerAction is not in a valid state
+func (a PendingMarkerAction) Validate() error {
+	if a.Id == 0 {
+		return errors.New("invalid pending action: id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return fmt.Errorf("invalid pending action %d: %w", a.Id, err)
+	}
+	if !a.Access.IsOneOf(ApprovalThresholdAccesses...) {
+		return fmt.Errorf("invalid pending action %d: approval thresholds are not supported for %s", a.Id, a.Access)
+	}
+	if a.Msg == nil {
+		return fmt.Errorf("invalid pending action %d: msg is required", a.Id)
+	}
+	if len(a.Approvers) == 0 {
+		return fmt.Errorf("invalid pending action %d: at least one approver is required", a.Id)
+	}
+	for _, approver := range a.Approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return fmt.Errorf("invalid pending action %d: invalid approver %q: %w", a.Id, approver, err)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces for this PendingMarkerAction.
+func (a PendingMarkerAction) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(a.Msg, &msg)
+}
+
+// GetSdkMsg returns the unpacked message that the pending action will execute.
+func (a PendingMarkerAction) GetSdkMsg() (sdk.Msg, error) {
+	if a.Msg == nil {
+		return nil, fmt.Errorf("pending action %d does not have a msg", a.Id)
+	}
+	msg, ok := a.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil, fmt.Errorf("could not unpack msg of pending action %d", a.Id)
+	}
+	return msg, nil
+}
+
+// HasApproved returns true if the address has approved the action.
+func (a PendingMarkerAction) HasApproved(addr string) bool {
+	for _, approver := range a.Approvers {
+		if approver == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsExpired returns true if the action can no longer be approved at the given block time.
+func (a PendingMarkerAction) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(a.ExpiresAt)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces for the pending actions.
+func (r QueryPendingActionsResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range r.PendingActions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
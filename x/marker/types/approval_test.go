@@ -0,0 +1,107 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarkerApprovalThresholdsValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		thresholds MarkerApprovalThresholds
+		expErr     string
+	}{
+		{
+			name:       "invalid denom",
+			thresholds: NewMarkerApprovalThresholds("", []ApprovalThreshold{NewApprovalThreshold(Access_Withdraw, 2)}, 60),
+			expErr:     "invalid denom: ",
+		},
+		{
+			name:       "no thresholds",
+			thresholds: NewMarkerApprovalThresholds("hotdog", nil, 60),
+			expErr:     "invalid approval thresholds for hotdog: at least one threshold is required",
+		},
+		{
+			name:       "unsupported access",
+			thresholds: NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{NewApprovalThreshold(Access_Burn, 2)}, 60),
+			expErr:     "invalid approval thresholds for hotdog: approval thresholds are not supported for ACCESS_BURN",
+		},
+		{
+			name: "duplicate access",
+			thresholds: NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{
+				NewApprovalThreshold(Access_Withdraw, 2),
+				NewApprovalThreshold(Access_Withdraw, 3),
+			}, 60),
+			expErr: "invalid approval thresholds for hotdog: duplicate approval threshold for ACCESS_WITHDRAW",
+		},
+		{
+			name:       "one required approval",
+			thresholds: NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{NewApprovalThreshold(Access_Admin, 1)}, 60),
+			expErr:     "invalid approval thresholds for hotdog: required approvals for ACCESS_ADMIN must be at least 2",
+		},
+		{
+			name:       "zero ttl",
+			thresholds: NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{NewApprovalThreshold(Access_ForceTransfer, 2)}, 0),
+			expErr:     "invalid approval thresholds for hotdog: pending action ttl must be positive",
+		},
+		{
+			name: "valid",
+			thresholds: NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{
+				NewApprovalThreshold(Access_Admin, 2),
+				NewApprovalThreshold(Access_Withdraw, 3),
+				NewApprovalThreshold(Access_ForceTransfer, 2),
+			}, 60),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.thresholds.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMarkerApprovalThresholdsRequiredApprovals(t *testing.T) {
+	thresholds := NewMarkerApprovalThresholds("hotdog", []ApprovalThreshold{NewApprovalThreshold(Access_Withdraw, 3)}, 90)
+	assert.Equal(t, uint32(3), thresholds.RequiredApprovals(Access_Withdraw), "RequiredApprovals(withdraw)")
+	assert.Equal(t, uint32(1), thresholds.RequiredApprovals(Access_ForceTransfer), "RequiredApprovals(force transfer)")
+	assert.Equal(t, 90*time.Second, thresholds.PendingActionTTL(), "PendingActionTTL")
+}
+
+func TestPendingMarkerAction(t *testing.T) {
+	proposerAddr := sdk.AccAddress("proposer____________")
+	approverAddr := sdk.AccAddress("approver____________")
+	proposer, approver := proposerAddr.String(), approverAddr.String()
+	expiresAt := time.Unix(1700000000, 0).UTC()
+	msg := NewMsgWithdrawRequest(proposerAddr, approverAddr, "hotdog", sdk.NewCoins(sdk.NewInt64Coin("hotdog", 5)))
+
+	action, err := NewPendingMarkerAction(4, "hotdog", Access_Withdraw, msg, proposer, expiresAt)
+	require.NoError(t, err, "NewPendingMarkerAction")
+	assert.NoError(t, action.Validate(), "Validate")
+	assert.Equal(t, []string{proposer}, action.Approvers, "Approvers")
+	assert.True(t, action.HasApproved(proposer), "HasApproved(proposer)")
+	assert.False(t, action.HasApproved(approver), "HasApproved(approver)")
+
+	sdkMsg, err := action.GetSdkMsg()
+	require.NoError(t, err, "GetSdkMsg")
+	assert.Equal(t, msg, sdkMsg, "GetSdkMsg")
+
+	assert.False(t, action.IsExpired(expiresAt.Add(-time.Second)), "IsExpired before expiration")
+	assert.True(t, action.IsExpired(expiresAt), "IsExpired at expiration")
+
+	action.Id = 0
+	assert.EqualError(t, action.Validate(), "invalid pending action: id cannot be zero", "Validate zero id")
+	action.Id = 4
+	action.Approvers = []string{"invalidaddress"}
+	assert.EqualError(t, action.Validate(), `invalid pending action 4: invalid approver "invalidaddress": decoding bech32 failed: invalid separator index -1`, "Validate invalid approver")
+}
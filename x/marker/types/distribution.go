@@ -0,0 +1,128 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DistributionPayoutsPerBlock is the most distribution holders and payouts that end block will handle in a
+	// single block. It is shared by recording holders, pushing payouts and returning unclaimed payouts.
+	DistributionPayoutsPerBlock = 100
+
+	// DistributionClaimPeriod is how long holders have to claim their payouts once all of a distribution's holders
+	// have been recorded. After that, the unclaimed payouts are returned to the funder.
+	DistributionClaimPeriod = 90 * 24 * time.Hour
+)
+
+// NewMarkerDistribution returns a new MarkerDistribution whose holders have not been recorded yet.
+func NewMarkerDistribution(id uint64, denom string, funds sdk.Coins, funder string, recordHeight int64, pushPayouts bool) MarkerDistribution {
+	return MarkerDistribution{
+		Id:           id,
+		Denom:        denom,
+		Funds:        funds,
+		Funder:       funder,
+		RecordHeight: recordHeight,
+		PushPayouts:  pushPayouts,
+		TotalShares:  sdkmath.ZeroInt(),
+	}
+}
+
+// Validate returns error if MarkerDistribution is not in a valid state
+func (d MarkerDistribution) Validate() error {
+	if d.Id == 0 {
+		return errors.New("invalid distribution: id cannot be zero")
+	}
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return fmt.Errorf("invalid distribution %d: %w", d.Id, err)
+	}
+	if err := d.Funds.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d funds: %w", d.Id, err)
+	}
+	if d.Funds.IsZero() {
+		return fmt.Errorf("invalid distribution %d: funds cannot be empty", d.Id)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Funder); err != nil {
+		return fmt.Errorf("invalid distribution %d funder %q: %w", d.Id, d.Funder, err)
+	}
+	if d.RecordHeight <= 0 {
+		return fmt.Errorf("invalid distribution %d: record height must be positive", d.Id)
+	}
+	if intOrZero(d.TotalShares).IsNegative() {
+		return fmt.Errorf("invalid distribution %d: total shares cannot be negative", d.Id)
+	}
+	if err := d.Allocated.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d allocated: %w", d.Id, err)
+	}
+	if !d.Allocated.IsZero() && !d.Funds.IsAllGTE(d.Allocated) {
+		return fmt.Errorf("invalid distribution %d: allocated %s cannot be more than the funds %s", d.Id, d.Allocated, d.Funds)
+	}
+	if len(d.NextPayoutAddress) > 0 {
+		if _, err := sdk.AccAddressFromBech32(d.NextPayoutAddress); err != nil {
+			return fmt.Errorf("invalid distribution %d next payout address %q: %w", d.Id, d.NextPayoutAddress, err)
+		}
+	}
+	return nil
+}
+
+// IsActive returns true if end block still has work to do for the distribution, i.e. recording its
+// holders or pushing its payouts.
+func (d MarkerDistribution) IsActive() bool {
+	return !d.SnapshotTaken || (d.PushPayouts && !d.PayoutsPushed)
+}
+
+// CalculatePayout returns a holder's part of the distribution's funds, rounded down. The payout is also limited to
+// the funds that haven't been allocated yet, so a distribution never pays out more than it was funded with.
+func (d MarkerDistribution) CalculatePayout(shares sdkmath.Int) sdk.Coins {
+	if !d.TotalShares.IsPositive() {
+		return sdk.Coins{}
+	}
+	rv := sdk.Coins{}
+	for _, coin := range d.Funds {
+		amount := sdkmath.MinInt(coin.Amount.Mul(shares).Quo(d.TotalShares), coin.Amount.Sub(d.Allocated.AmountOf(coin.Denom)))
+		if amount.IsPositive() {
+			rv = rv.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return rv
+}
+
+// IsClaimExpired returns true if the distribution's unclaimed payouts should be returned to the funder.
+func (d MarkerDistribution) IsClaimExpired(blockTime time.Time) bool {
+	return d.ClaimExpiration != nil && !blockTime.Before(*d.ClaimExpiration)
+}
+
+// NewDistributionPayout returns a new instance of DistributionPayout
+func NewDistributionPayout(distributionID uint64, addr sdk.AccAddress, shares sdkmath.Int, amount sdk.Coins) DistributionPayout {
+	return DistributionPayout{
+		DistributionId: distributionID,
+		Address:        addr.String(),
+		Shares:         shares,
+		Amount:         amount,
+	}
+}
+
+// Validate returns error if DistributionPayout is not in a valid state
+func (p DistributionPayout) Validate() error {
+	if p.DistributionId == 0 {
+		return errors.New("invalid distribution payout: distribution id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid distribution %d payout address %q: %w", p.DistributionId, p.Address, err)
+	}
+	if !intOrZero(p.Shares).IsPositive() {
+		return fmt.Errorf("invalid distribution %d payout for %s: shares must be positive", p.DistributionId, p.Address)
+	}
+	if err := p.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid distribution %d payout amount for %s: %w", p.DistributionId, p.Address, err)
+	}
+	if p.Amount.IsZero() {
+		return fmt.Errorf("invalid distribution %d payout for %s: amount cannot be empty", p.DistributionId, p.Address)
+	}
+	return nil
+}
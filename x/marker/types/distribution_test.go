@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMarkerDistributionValidate(t *testing.T) {
+	funder := sdk.AccAddress("funder______________").String()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("usdf", 100))
+	tests := []struct {
+		name         string
+		distribution MarkerDistribution
+		expErr       string
+	}{
+		{
+			name:         "valid",
+			distribution: NewMarkerDistribution(1, "hotdog", funds, funder, 10, true),
+		},
+		{
+			name:         "zero id",
+			distribution: NewMarkerDistribution(0, "hotdog", funds, funder, 10, true),
+			expErr:       "invalid distribution: id cannot be zero",
+		},
+		{
+			name:         "invalid denom",
+			distribution: NewMarkerDistribution(1, "", funds, funder, 10, true),
+			expErr:       "invalid distribution 1: invalid denom: ",
+		},
+		{
+			name:         "no funds",
+			distribution: NewMarkerDistribution(1, "hotdog", sdk.Coins{}, funder, 10, true),
+			expErr:       "invalid distribution 1: funds cannot be empty",
+		},
+		{
+			name:         "invalid funder",
+			distribution: NewMarkerDistribution(1, "hotdog", funds, "invalid", 10, true),
+			expErr:       "invalid distribution 1 funder \"invalid\": decoding bech32 failed: invalid bech32 string length 7",
+		},
+		{
+			name:         "zero record height",
+			distribution: NewMarkerDistribution(1, "hotdog", funds, funder, 0, true),
+			expErr:       "invalid distribution 1: record height must be positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.distribution.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMarkerDistributionIsActive(t *testing.T) {
+	funder := sdk.AccAddress("funder______________").String()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("usdf", 100))
+
+	claimed := NewMarkerDistribution(1, "hotdog", funds, funder, 10, false)
+	assert.True(t, claimed.IsActive(), "IsActive claimed distribution before snapshot")
+	claimed.SnapshotTaken = true
+	assert.False(t, claimed.IsActive(), "IsActive claimed distribution after snapshot")
+
+	pushed := NewMarkerDistribution(2, "hotdog", funds, funder, 10, true)
+	pushed.SnapshotTaken = true
+	assert.True(t, pushed.IsActive(), "IsActive pushed distribution after snapshot")
+	pushed.PayoutsPushed = true
+	assert.False(t, pushed.IsActive(), "IsActive pushed distribution after payouts pushed")
+}
+
+func TestMarkerDistributionCalculatePayout(t *testing.T) {
+	funder := sdk.AccAddress("funder______________").String()
+	funds := sdk.NewCoins(sdk.NewInt64Coin("usdf", 1001), sdk.NewInt64Coin("eurf", 10))
+	distribution := NewMarkerDistribution(1, "hotdog", funds, funder, 10, false)
+	assert.Equal(t, "", distribution.CalculatePayout(sdkmath.NewInt(5)).String(), "CalculatePayout without total shares")
+
+	distribution.TotalShares = sdkmath.NewInt(1000)
+	assert.Equal(t, "6eurf,600usdf", distribution.CalculatePayout(sdkmath.NewInt(600)).String(), "CalculatePayout 600 shares")
+	assert.Equal(t, "1usdf", distribution.CalculatePayout(sdkmath.NewInt(1)).String(), "CalculatePayout 1 share")
+	assert.Equal(t, "", distribution.CalculatePayout(sdkmath.ZeroInt()).String(), "CalculatePayout 0 shares")
+}
+
+func TestDistributionPayoutValidate(t *testing.T) {
+	addr := sdk.AccAddress("holder______________")
+	amount := sdk.NewCoins(sdk.NewInt64Coin("usdf", 100))
+	assert.NoError(t, NewDistributionPayout(1, addr, sdkmath.NewInt(10), amount).Validate(), "Validate valid")
+	assert.EqualError(t, NewDistributionPayout(0, addr, sdkmath.NewInt(10), amount).Validate(),
+		"invalid distribution payout: distribution id cannot be zero", "Validate zero id")
+	assert.EqualError(t, NewDistributionPayout(1, addr, sdkmath.ZeroInt(), amount).Validate(),
+		"invalid distribution 1 payout for "+addr.String()+": shares must be positive", "Validate zero shares")
+	assert.EqualError(t, NewDistributionPayout(1, addr, sdkmath.NewInt(10), sdk.Coins{}).Validate(),
+		"invalid distribution 1 payout for "+addr.String()+": amount cannot be empty", "Validate empty amount")
+}
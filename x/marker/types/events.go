@@ -184,11 +184,164 @@ func NewEventSetNetAssetValue(denom string, price sdk.Coin, volume uint64, sourc
 	}
 }
 
+// NewEventMintScheduleSet returns a new instance of EventMintScheduleSet
+func NewEventMintScheduleSet(denom string) *EventMintScheduleSet {
+	return &EventMintScheduleSet{Denom: denom}
+}
+
+// NewEventMintScheduleRemoved returns a new instance of EventMintScheduleRemoved
+func NewEventMintScheduleRemoved(denom string) *EventMintScheduleRemoved {
+	return &EventMintScheduleRemoved{Denom: denom}
+}
+
+// NewEventApprovalThresholdsSet returns a new instance of EventApprovalThresholdsSet
+func NewEventApprovalThresholdsSet(denom string, administrator string) *EventApprovalThresholdsSet {
+	return &EventApprovalThresholdsSet{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventTransferLimitsSet returns a new instance of EventTransferLimitsSet
+func NewEventTransferLimitsSet(denom string, administrator string) *EventTransferLimitsSet {
+	return &EventTransferLimitsSet{
+		Denom:         denom,
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerActionPending returns a new instance of EventMarkerActionPending
+func NewEventMarkerActionPending(id uint64, denom string, access Access, msgType string, proposer string) *EventMarkerActionPending {
+	return &EventMarkerActionPending{
+		Id:       strconv.FormatUint(id, 10),
+		Denom:    denom,
+		Access:   access.String(),
+		MsgType:  msgType,
+		Proposer: proposer,
+	}
+}
+
+// NewEventMarkerActionApproved returns a new instance of EventMarkerActionApproved
+func NewEventMarkerActionApproved(id uint64, denom string, approver string) *EventMarkerActionApproved {
+	return &EventMarkerActionApproved{
+		Id:       strconv.FormatUint(id, 10),
+		Denom:    denom,
+		Approver: approver,
+	}
+}
+
+// NewEventMarkerActionExecuted returns a new instance of EventMarkerActionExecuted
+func NewEventMarkerActionExecuted(id uint64, denom string) *EventMarkerActionExecuted {
+	return &EventMarkerActionExecuted{
+		Id:    strconv.FormatUint(id, 10),
+		Denom: denom,
+	}
+}
+
+// NewEventMarkerActionExpired returns a new instance of EventMarkerActionExpired
+func NewEventMarkerActionExpired(id uint64, denom string) *EventMarkerActionExpired {
+	return &EventMarkerActionExpired{
+		Id:    strconv.FormatUint(id, 10),
+		Denom: denom,
+	}
+}
+
 // NewEventMarkerParamsUpdated returns a new instance of EventMarkerParamsUpdated
-func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int) *EventMarkerParamsUpdated {
+func NewEventMarkerParamsUpdated(allowGovControl bool, denomRegex string, maxSupply sdkmath.Int, maxNavReportAgeSeconds uint64) *EventMarkerParamsUpdated {
 	return &EventMarkerParamsUpdated{
 		EnableGovernance:       strconv.FormatBool(allowGovControl),
 		UnrestrictedDenomRegex: denomRegex,
 		MaxSupply:              maxSupply.String(),
+		MaxNavReportAgeSeconds: strconv.FormatUint(maxNavReportAgeSeconds, 10),
+	}
+}
+
+// NewEventNetAssetValueReported returns a new instance of EventNetAssetValueReported
+func NewEventNetAssetValueReported(denom string, price sdk.Coin, volume uint64, reporter string) *EventNetAssetValueReported {
+	return &EventNetAssetValueReported{
+		Denom:    denom,
+		Price:    price.String(),
+		Volume:   strconv.FormatUint(volume, 10),
+		Reporter: reporter,
+	}
+}
+
+// NewEventDistributionCreated returns a new instance of EventDistributionCreated
+func NewEventDistributionCreated(id uint64, denom string, funds sdk.Coins, recordHeight int64, administrator string) *EventDistributionCreated {
+	return &EventDistributionCreated{
+		Id:            strconv.FormatUint(id, 10),
+		Denom:         denom,
+		Funds:         funds.String(),
+		RecordHeight:  strconv.FormatInt(recordHeight, 10),
+		Administrator: administrator,
+	}
+}
+
+// NewEventDistributionSnapshotTaken returns a new instance of EventDistributionSnapshotTaken
+func NewEventDistributionSnapshotTaken(id uint64, denom string, holders uint64, totalShares sdkmath.Int, refunded sdk.Coins) *EventDistributionSnapshotTaken {
+	return &EventDistributionSnapshotTaken{
+		Id:          strconv.FormatUint(id, 10),
+		Denom:       denom,
+		Holders:     strconv.FormatUint(holders, 10),
+		TotalShares: totalShares.String(),
+		Refunded:    refunded.String(),
+	}
+}
+
+// NewEventDistributionPaid returns a new instance of EventDistributionPaid
+func NewEventDistributionPaid(id uint64, denom string, address string, amount sdk.Coins) *EventDistributionPaid {
+	return &EventDistributionPaid{
+		Id:      strconv.FormatUint(id, 10),
+		Denom:   denom,
+		Address: address,
+		Amount:  amount.String(),
+	}
+}
+
+// NewEventDistributionPayoutFailed returns a new instance of EventDistributionPayoutFailed
+func NewEventDistributionPayoutFailed(id uint64, denom string, address string, err error) *EventDistributionPayoutFailed {
+	return &EventDistributionPayoutFailed{
+		Id:      strconv.FormatUint(id, 10),
+		Denom:   denom,
+		Address: address,
+		Error:   err.Error(),
+	}
+}
+
+// NewEventDistributionCompleted returns a new instance of EventDistributionCompleted
+func NewEventDistributionCompleted(id uint64, denom string) *EventDistributionCompleted {
+	return &EventDistributionCompleted{
+		Id:    strconv.FormatUint(id, 10),
+		Denom: denom,
+	}
+}
+
+// NewEventDistributionExpired returns a new instance of EventDistributionExpired
+func NewEventDistributionExpired(id uint64, denom string, payouts int, refunded sdk.Coins) *EventDistributionExpired {
+	return &EventDistributionExpired{
+		Id:       strconv.FormatUint(id, 10),
+		Denom:    denom,
+		Payouts:  strconv.Itoa(payouts),
+		Refunded: refunded.String(),
+	}
+}
+
+// NewEventMarkerRedeemed returns a new instance of EventMarkerRedeemed
+func NewEventMarkerRedeemed(denom string, address string, amount sdk.Coin, payout sdk.Coins, administrator string) *EventMarkerRedeemed {
+	return &EventMarkerRedeemed{
+		Denom:         denom,
+		Address:       address,
+		Amount:        amount.String(),
+		Payout:        payout.String(),
+		Administrator: administrator,
+	}
+}
+
+// NewEventMarkerRedemptionSkipped returns a new instance of EventMarkerRedemptionSkipped
+func NewEventMarkerRedemptionSkipped(denom string, address string, err error) *EventMarkerRedemptionSkipped {
+	return &EventMarkerRedemptionSkipped{
+		Denom:   denom,
+		Address: address,
+		Error:   err.Error(),
 	}
 }
@@ -2,17 +2,31 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
+var _ codectypes.UnpackInterfacesMessage = (*GenesisState)(nil)
+
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, markers []MarkerAccount, denySendAddresses []DenySendAddress, netAssetValues []MarkerNetAssetValues) *GenesisState {
+func NewGenesisState(params Params, markers []MarkerAccount, denySendAddresses []DenySendAddress, netAssetValues []MarkerNetAssetValues, netAssetValueReports []NetAssetValueReport, mintSchedules []MarkerMintSchedule, approvalThresholds []MarkerApprovalThresholds, pendingActions []PendingMarkerAction, lastPendingActionID uint64, transferLimits []MarkerTransferLimits, addressOutflows []AddressOutflow, distributions []MarkerDistribution, distributionPayouts []DistributionPayout, lastDistributionID uint64) *GenesisState {
 	return &GenesisState{
-		Params:            params,
-		Markers:           markers,
-		DenySendAddresses: denySendAddresses,
-		NetAssetValues:    netAssetValues,
+		Params:               params,
+		Markers:              markers,
+		DenySendAddresses:    denySendAddresses,
+		NetAssetValues:       netAssetValues,
+		NetAssetValueReports: netAssetValueReports,
+		MintSchedules:        mintSchedules,
+		ApprovalThresholds:   approvalThresholds,
+		PendingActions:       pendingActions,
+		LastPendingActionId:  lastPendingActionID,
+		TransferLimits:       transferLimits,
+		AddressOutflows:      addressOutflows,
+		Distributions:        distributions,
+		DistributionPayouts:  distributionPayouts,
+		LastDistributionId:   lastDistributionID,
 	}
 }
 
@@ -30,13 +44,69 @@ func (state GenesisState) Validate() error {
 			}
 		}
 	}
+	for _, report := range state.NetAssetValueReports {
+		if err := report.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, mintSchedule := range state.MintSchedules {
+		if err := mintSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, thresholds := range state.ApprovalThresholds {
+		if err := thresholds.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, action := range state.PendingActions {
+		if err := action.Validate(); err != nil {
+			return err
+		}
+		if action.Id > state.LastPendingActionId {
+			return fmt.Errorf("pending action id %d is greater than the last pending action id %d", action.Id, state.LastPendingActionId)
+		}
+	}
+	for _, limits := range state.TransferLimits {
+		if err := limits.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, outflow := range state.AddressOutflows {
+		if err := outflow.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, distribution := range state.Distributions {
+		if err := distribution.Validate(); err != nil {
+			return err
+		}
+		if distribution.Id > state.LastDistributionId {
+			return fmt.Errorf("distribution id %d is greater than the last distribution id %d", distribution.Id, state.LastDistributionId)
+		}
+	}
+	for _, payout := range state.DistributionPayouts {
+		if err := payout.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces for the pending actions.
+func (state GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, action := range state.PendingActions {
+		if err := action.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns the initial module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{})
+	return NewGenesisState(DefaultParams(), []MarkerAccount{}, []DenySendAddress{}, []MarkerNetAssetValues{}, []NetAssetValueReport{}, []MarkerMintSchedule{}, []MarkerApprovalThresholds{}, []PendingMarkerAction{}, 0, []MarkerTransferLimits{}, []AddressOutflow{}, []MarkerDistribution{}, []DistributionPayout{}, 0)
 }
 
 // GetGenesisStateFromAppState returns x/marker GenesisState given raw application
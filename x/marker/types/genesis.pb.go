@@ -33,6 +33,26 @@ type GenesisState struct {
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,3,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
 	// list of denom based denied send addresses
 	DenySendAddresses []DenySendAddress `protobuf:"bytes,4,rep,name=deny_send_addresses,json=denySendAddresses,proto3" json:"deny_send_addresses"`
+	// list of net asset value reports
+	NetAssetValueReports []NetAssetValueReport `protobuf:"bytes,5,rep,name=net_asset_value_reports,json=netAssetValueReports,proto3" json:"net_asset_value_reports"`
+	// list of marker mint schedules
+	MintSchedules []MarkerMintSchedule `protobuf:"bytes,6,rep,name=mint_schedules,json=mintSchedules,proto3" json:"mint_schedules"`
+	// list of marker approval thresholds
+	ApprovalThresholds []MarkerApprovalThresholds `protobuf:"bytes,7,rep,name=approval_thresholds,json=approvalThresholds,proto3" json:"approval_thresholds"`
+	// list of marker actions that are waiting for approvals
+	PendingActions []PendingMarkerAction `protobuf:"bytes,8,rep,name=pending_actions,json=pendingActions,proto3" json:"pending_actions"`
+	// the id of the most recently created pending action
+	LastPendingActionId uint64 `protobuf:"varint,9,opt,name=last_pending_action_id,json=lastPendingActionId,proto3" json:"last_pending_action_id,omitempty"`
+	// list of marker transfer limits
+	TransferLimits []MarkerTransferLimits `protobuf:"bytes,10,rep,name=transfer_limits,json=transferLimits,proto3" json:"transfer_limits"`
+	// list of the amounts accounts have sent during their current outflow periods
+	AddressOutflows []AddressOutflow `protobuf:"bytes,11,rep,name=address_outflows,json=addressOutflows,proto3" json:"address_outflows"`
+	// list of distributions that have not been completed
+	Distributions []MarkerDistribution `protobuf:"bytes,12,rep,name=distributions,proto3" json:"distributions"`
+	// list of distribution payouts that holders have not yet received
+	DistributionPayouts []DistributionPayout `protobuf:"bytes,13,rep,name=distribution_payouts,json=distributionPayouts,proto3" json:"distribution_payouts"`
+	// the id of the most recently created distribution
+	LastDistributionId uint64 `protobuf:"varint,14,opt,name=last_distribution_id,json=lastDistributionId,proto3" json:"last_distribution_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -161,33 +181,50 @@ func init() {
 }
 
 var fileDescriptor_5dcc4ab7c9d2f78f = []byte{
-	// 406 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xcd, 0xaa, 0xda, 0x40,
-	0x18, 0x86, 0x13, 0x15, 0x6d, 0x47, 0x6b, 0xdb, 0xa9, 0xd0, 0x20, 0x25, 0xfe, 0x14, 0x41, 0x0a,
-	0x4d, 0xd0, 0xee, 0xdc, 0x69, 0x0b, 0x5d, 0xb5, 0x88, 0x42, 0x17, 0x76, 0x11, 0xc6, 0xe4, 0x23,
-	0x0d, 0xad, 0x33, 0x21, 0x33, 0x86, 0x7a, 0x07, 0xdd, 0xb5, 0x97, 0xe0, 0xe5, 0xb8, 0x94, 0xae,
-	0xce, 0xea, 0x70, 0xd0, 0xcd, 0xb9, 0x8c, 0x83, 0x93, 0x04, 0xcd, 0x61, 0x70, 0x37, 0xf3, 0xf1,
-	0xbc, 0xcf, 0x3b, 0x99, 0x0c, 0xea, 0x86, 0x11, 0x8b, 0x81, 0x12, 0xea, 0x82, 0xbd, 0x22, 0xd1,
-	0x4f, 0x88, 0xec, 0x78, 0x60, 0xfb, 0x40, 0x81, 0x07, 0xdc, 0x0a, 0x23, 0x26, 0x18, 0x6e, 0x9c,
-	0x19, 0x2b, 0x61, 0xac, 0x78, 0xd0, 0x6c, 0xf8, 0xcc, 0x67, 0x12, 0xb0, 0x4f, 0xab, 0x84, 0x6d,
-	0x76, 0x94, 0xbe, 0x34, 0x25, 0x91, 0xee, 0xff, 0x02, 0xaa, 0x7d, 0x4e, 0x0a, 0xe6, 0x82, 0x08,
-	0xc0, 0x23, 0x54, 0x0e, 0x49, 0x44, 0x56, 0xdc, 0xd0, 0xdb, 0x7a, 0xbf, 0x3a, 0x7c, 0x63, 0xa9,
-	0x0a, 0xad, 0xa9, 0x64, 0x26, 0xa5, 0xdd, 0x6d, 0x4b, 0x9b, 0xa5, 0x09, 0xfc, 0x11, 0x55, 0x12,
-	0x82, 0x1b, 0x85, 0x76, 0xb1, 0x5f, 0x1d, 0xbe, 0x55, 0x87, 0xbf, 0xc8, 0xd5, 0xd8, 0x75, 0xd9,
-	0x9a, 0x8a, 0xd4, 0x91, 0x25, 0xf1, 0x02, 0xbd, 0xa0, 0x20, 0x1c, 0xc2, 0x39, 0x08, 0x27, 0x26,
-	0xbf, 0xd6, 0xc0, 0x8d, 0xa2, 0xb4, 0xbd, 0xbb, 0x66, 0xfb, 0x0a, 0x62, 0x7c, 0x8a, 0x7c, 0x93,
-	0x89, 0x54, 0x5a, 0xa7, 0xb9, 0x29, 0xfe, 0x8e, 0x5e, 0x79, 0x40, 0x37, 0x0e, 0x07, 0xea, 0x39,
-	0xc4, 0xf3, 0x22, 0xe0, 0x1c, 0xb8, 0x51, 0x92, 0xfa, 0x9e, 0x5a, 0xff, 0x09, 0xe8, 0x66, 0x0e,
-	0xd4, 0x1b, 0x27, 0x78, 0x6a, 0x7e, 0xe9, 0xe5, 0xc7, 0xc0, 0x47, 0x4f, 0xfe, 0x6c, 0x5b, 0xda,
-	0xfd, 0xb6, 0xa5, 0x75, 0x01, 0x3d, 0x7f, 0x94, 0xc2, 0x3d, 0x54, 0x4f, 0x94, 0x59, 0xad, 0xbc,
-	0xde, 0xa7, 0xb3, 0x67, 0xc9, 0x34, 0xc3, 0x3a, 0xa8, 0x26, 0x0f, 0x98, 0x41, 0x05, 0x09, 0x55,
-	0x4f, 0xb3, 0x14, 0xb9, 0xa8, 0xf9, 0xab, 0xa3, 0x86, 0xea, 0xe3, 0xb1, 0x81, 0x2a, 0xf9, 0x96,
-	0x6c, 0x8b, 0xe7, 0x8a, 0xcb, 0xbd, 0xfa, 0xab, 0x72, 0x66, 0xf5, 0xad, 0x9e, 0x4f, 0x34, 0xf1,
-	0x77, 0x07, 0x53, 0xdf, 0x1f, 0x4c, 0xfd, 0xee, 0x60, 0xea, 0xff, 0x8e, 0xa6, 0xb6, 0x3f, 0x9a,
-	0xda, 0xcd, 0xd1, 0xd4, 0xd0, 0xeb, 0x80, 0x29, 0x0b, 0xa6, 0xfa, 0x62, 0xe8, 0x07, 0xe2, 0xc7,
-	0x7a, 0x69, 0xb9, 0x6c, 0x65, 0x9f, 0x91, 0xf7, 0x01, 0xbb, 0xd8, 0xd9, 0xbf, 0xb3, 0x07, 0x2c,
-	0x36, 0x21, 0xf0, 0x65, 0x59, 0xbe, 0xde, 0x0f, 0x0f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x2d,
-	0x05, 0xd5, 0x32, 0x03, 0x00, 0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x95, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x63, 0xe0, 0xf2, 0x67, 0x42, 0x12, 0xee, 0x24, 0xba, 0x8c, 0xd0, 0x55, 0xf8, 0xd3,
+	0x22, 0xa5, 0x95, 0x9a, 0x14, 0xd8, 0xb1, 0x83, 0x22, 0x55, 0x48, 0xa5, 0x8d, 0x12, 0xa8, 0x5a,
+	0xba, 0xb0, 0x86, 0xcc, 0x90, 0x8c, 0xea, 0xcc, 0x58, 0x3e, 0xe3, 0xb4, 0x79, 0x83, 0xee, 0xda,
+	0x47, 0x60, 0xd1, 0x87, 0x61, 0xc9, 0xb2, 0xab, 0xaa, 0x82, 0x4d, 0x1f, 0xa3, 0xf2, 0x78, 0x5c,
+	0x6c, 0xb0, 0xc2, 0xce, 0x3e, 0xf3, 0x7d, 0xbf, 0x6f, 0xe4, 0x33, 0x73, 0x8c, 0x36, 0xfc, 0x40,
+	0x8d, 0xb8, 0xa4, 0xb2, 0xc7, 0x5b, 0x43, 0x1a, 0x7c, 0xe4, 0x41, 0x6b, 0xb4, 0xd5, 0xea, 0x73,
+	0xc9, 0x41, 0x40, 0xd3, 0x0f, 0x94, 0x56, 0xb8, 0x76, 0xab, 0x69, 0xc6, 0x9a, 0xe6, 0x68, 0x6b,
+	0xa5, 0xd6, 0x57, 0x7d, 0x65, 0x04, 0xad, 0xe8, 0x29, 0xd6, 0xae, 0xac, 0xe7, 0xf2, 0xac, 0xcb,
+	0x48, 0x36, 0xbe, 0x2f, 0xa0, 0xc5, 0x97, 0x71, 0x40, 0x57, 0x53, 0xcd, 0xf1, 0x2e, 0x9a, 0xf5,
+	0x69, 0x40, 0x87, 0x40, 0x9c, 0x35, 0xa7, 0x51, 0xdc, 0xfe, 0xbf, 0x99, 0x17, 0xd8, 0x6c, 0x1b,
+	0xcd, 0xfe, 0xcc, 0xe5, 0xcf, 0xd5, 0x42, 0xc7, 0x3a, 0xf0, 0x0b, 0x34, 0x17, 0x2b, 0x80, 0x4c,
+	0xad, 0x4d, 0x37, 0x8a, 0xdb, 0x8f, 0xf2, 0xcd, 0x47, 0xe6, 0x69, 0xaf, 0xd7, 0x53, 0xa1, 0xd4,
+	0x96, 0x91, 0x38, 0xf1, 0x29, 0x5a, 0x92, 0x5c, 0xbb, 0x14, 0x80, 0x6b, 0x77, 0x44, 0xbd, 0x90,
+	0x03, 0x99, 0x36, 0xb4, 0xa7, 0x93, 0x68, 0xaf, 0xb9, 0xde, 0x8b, 0x2c, 0x6f, 0x8d, 0xc3, 0x42,
+	0xcb, 0x32, 0x53, 0xc5, 0x1f, 0x50, 0x95, 0x71, 0x39, 0x76, 0x81, 0x4b, 0xe6, 0x52, 0xc6, 0x02,
+	0x0e, 0xc0, 0x81, 0xcc, 0x18, 0xfc, 0x66, 0x3e, 0xfe, 0x80, 0xcb, 0x71, 0x97, 0x4b, 0xb6, 0x17,
+	0xcb, 0x2d, 0xf9, 0x5f, 0x96, 0x2d, 0x73, 0xc0, 0xe7, 0x68, 0xf9, 0xce, 0xc6, 0xdd, 0x80, 0xfb,
+	0x2a, 0xd0, 0x40, 0xfe, 0x31, 0x01, 0x4f, 0xf2, 0x03, 0x32, 0x3b, 0xef, 0x18, 0x87, 0x0d, 0xa9,
+	0xc9, 0xfb, 0x4b, 0x80, 0x4f, 0x50, 0x79, 0x28, 0xa4, 0x76, 0xa1, 0x37, 0xe0, 0x2c, 0xf4, 0x38,
+	0x90, 0x59, 0x83, 0x6f, 0x4c, 0xfa, 0x3c, 0x47, 0x42, 0xea, 0xae, 0x35, 0x58, 0x7a, 0x69, 0x98,
+	0xaa, 0x01, 0xe6, 0xa8, 0x4a, 0xfd, 0x88, 0x40, 0x3d, 0x57, 0x0f, 0x02, 0x0e, 0x03, 0xe5, 0x31,
+	0x20, 0x73, 0x86, 0xdd, 0x9c, 0xd8, 0x48, 0x6b, 0x3b, 0xfe, 0xeb, 0xb2, 0x09, 0x98, 0xde, 0x5b,
+	0xc1, 0xef, 0x50, 0xc5, 0xe7, 0x92, 0x09, 0xd9, 0x77, 0x69, 0x4f, 0x0b, 0x25, 0x81, 0xcc, 0x4f,
+	0xfa, 0x3a, 0xed, 0x58, 0x9c, 0x1c, 0x99, 0xc8, 0x91, 0x34, 0xd7, 0x72, 0xe2, 0x22, 0xe0, 0x1d,
+	0xf4, 0x9f, 0x47, 0x41, 0xbb, 0x59, 0xbc, 0x2b, 0x18, 0x59, 0x58, 0x73, 0x1a, 0x33, 0x9d, 0x6a,
+	0xb4, 0xda, 0x4e, 0x7b, 0x0e, 0x19, 0x7e, 0x8f, 0x2a, 0x3a, 0xa0, 0x12, 0xce, 0x79, 0xe0, 0x7a,
+	0x62, 0x28, 0x34, 0x10, 0xf4, 0xf0, 0x61, 0x3b, 0xb6, 0x96, 0x57, 0xc6, 0x91, 0xec, 0x47, 0x67,
+	0xaa, 0xf8, 0x04, 0x2d, 0xd9, 0x23, 0xe6, 0xaa, 0x50, 0x9f, 0x7b, 0xea, 0x13, 0x90, 0xa2, 0x61,
+	0x3f, 0xce, 0x67, 0xdb, 0xa3, 0xf4, 0x26, 0x16, 0x5b, 0x6a, 0x85, 0x66, 0xaa, 0x80, 0x8f, 0x51,
+	0x89, 0x09, 0xd0, 0x81, 0x38, 0x0b, 0xe3, 0xcf, 0xb7, 0xf8, 0x70, 0xf7, 0x0f, 0x52, 0x86, 0xa4,
+	0xfb, 0x19, 0x08, 0xa6, 0xa8, 0x96, 0x2e, 0xb8, 0x3e, 0x1d, 0xab, 0x50, 0x03, 0x29, 0x4d, 0x82,
+	0xa7, 0xb1, 0x6d, 0x63, 0xb0, 0xf0, 0x2a, 0xbb, 0xb7, 0x02, 0xf8, 0x39, 0xaa, 0x99, 0xfe, 0x64,
+	0x72, 0x04, 0x23, 0x65, 0xd3, 0x1d, 0x1c, 0xad, 0xa5, 0x81, 0x87, 0x6c, 0x77, 0xfe, 0xcb, 0xc5,
+	0x6a, 0xe1, 0xf7, 0xc5, 0x6a, 0x61, 0x83, 0xa3, 0xca, 0x9d, 0x7b, 0x88, 0x37, 0x51, 0x39, 0xde,
+	0x49, 0x72, 0x91, 0xcd, 0xc0, 0x5a, 0xe8, 0x94, 0xe2, 0x6a, 0x22, 0x5b, 0x47, 0x8b, 0xe6, 0xca,
+	0x27, 0xa2, 0x29, 0x23, 0x2a, 0x46, 0x35, 0x2b, 0x49, 0xc5, 0x7c, 0x75, 0x50, 0x2d, 0x6f, 0x9c,
+	0x60, 0x82, 0xe6, 0xb2, 0x29, 0xc9, 0x2b, 0xee, 0xe6, 0x8c, 0xab, 0x89, 0xc3, 0x2f, 0x43, 0xce,
+	0x9f, 0x53, 0xb7, 0x3b, 0xda, 0xef, 0x5f, 0x5e, 0xd7, 0x9d, 0xab, 0xeb, 0xba, 0xf3, 0xeb, 0xba,
+	0xee, 0x7c, 0xbb, 0xa9, 0x17, 0xae, 0x6e, 0xea, 0x85, 0x1f, 0x37, 0xf5, 0x02, 0x5a, 0x16, 0x2a,
+	0x37, 0xa0, 0xed, 0x9c, 0x6e, 0xf7, 0x85, 0x1e, 0x84, 0x67, 0xcd, 0x9e, 0x1a, 0xb6, 0x6e, 0x25,
+	0xcf, 0x84, 0x4a, 0xbd, 0xb5, 0x3e, 0x27, 0xbf, 0x04, 0x3d, 0xf6, 0x39, 0x9c, 0xcd, 0x9a, 0xff,
+	0xc1, 0xce, 0x9f, 0x01, 0x00, 0x33, 0xd2, 0x39, 0xd2, 0x84, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -210,6 +247,128 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastDistributionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastDistributionId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.DistributionPayouts) > 0 {
+		for iNdEx := len(m.DistributionPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributionPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for iNdEx := len(m.Distributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Distributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.AddressOutflows) > 0 {
+		for iNdEx := len(m.AddressOutflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressOutflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TransferLimits) > 0 {
+		for iNdEx := len(m.TransferLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.LastPendingActionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastPendingActionId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.PendingActions) > 0 {
+		for iNdEx := len(m.PendingActions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingActions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ApprovalThresholds) > 0 {
+		for iNdEx := len(m.ApprovalThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApprovalThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MintSchedules) > 0 {
+		for iNdEx := len(m.MintSchedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NetAssetValueReports) > 0 {
+		for iNdEx := len(m.NetAssetValueReports) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetAssetValueReports[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenySendAddresses) > 0 {
 		for iNdEx := len(m.DenySendAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -383,6 +542,60 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.NetAssetValueReports) > 0 {
+		for _, e := range m.NetAssetValueReports {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MintSchedules) > 0 {
+		for _, e := range m.MintSchedules {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ApprovalThresholds) > 0 {
+		for _, e := range m.ApprovalThresholds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingActions) > 0 {
+		for _, e := range m.PendingActions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastPendingActionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastPendingActionId))
+	}
+	if len(m.TransferLimits) > 0 {
+		for _, e := range m.TransferLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AddressOutflows) > 0 {
+		for _, e := range m.AddressOutflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Distributions) > 0 {
+		for _, e := range m.Distributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DistributionPayouts) > 0 {
+		for _, e := range m.DistributionPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastDistributionId != 0 {
+		n += 1 + sovGenesis(uint64(m.LastDistributionId))
+	}
 	return n
 }
 
@@ -592,6 +805,316 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetAssetValueReports", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetAssetValueReports = append(m.NetAssetValueReports, NetAssetValueReport{})
+			if err := m.NetAssetValueReports[len(m.NetAssetValueReports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedules = append(m.MintSchedules, MarkerMintSchedule{})
+			if err := m.MintSchedules[len(m.MintSchedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovalThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApprovalThresholds = append(m.ApprovalThresholds, MarkerApprovalThresholds{})
+			if err := m.ApprovalThresholds[len(m.ApprovalThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingActions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingActions = append(m.PendingActions, PendingMarkerAction{})
+			if err := m.PendingActions[len(m.PendingActions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPendingActionId", wireType)
+			}
+			m.LastPendingActionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPendingActionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferLimits = append(m.TransferLimits, MarkerTransferLimits{})
+			if err := m.TransferLimits[len(m.TransferLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressOutflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressOutflows = append(m.AddressOutflows, AddressOutflow{})
+			if err := m.AddressOutflows[len(m.AddressOutflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Distributions = append(m.Distributions, MarkerDistribution{})
+			if err := m.Distributions[len(m.Distributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionPayouts = append(m.DistributionPayouts, DistributionPayout{})
+			if err := m.DistributionPayouts[len(m.DistributionPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastDistributionId", wireType)
+			}
+			m.LastDistributionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastDistributionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
 	"fmt"
+	"time"
 
 	"github.com/cometbft/cometbft/crypto"
 
@@ -35,6 +37,54 @@ var (
 
 	// MarkerParamStoreKey key for marker module's params
 	MarkerParamStoreKey = []byte{0x05}
+
+	// NetAssetValueReportPrefix prefix for net asset values reported for markers by accounts with nav access
+	NetAssetValueReportPrefix = []byte{0x06}
+
+	// MintSchedulePrefix prefix for marker mint schedules
+	MintSchedulePrefix = []byte{0x07}
+
+	// ApprovalThresholdsPrefix prefix for marker approval thresholds
+	ApprovalThresholdsPrefix = []byte{0x08}
+
+	// PendingActionPrefix prefix for marker actions that are waiting for approvals
+	PendingActionPrefix = []byte{0x09}
+
+	// PendingActionExpirationPrefix prefix for the index of pending marker actions by expiration time
+	PendingActionExpirationPrefix = []byte{0x0A}
+
+	// LastPendingActionIDKey key for the id of the most recently created pending marker action
+	LastPendingActionIDKey = []byte{0x0B}
+
+	// TransferLimitsPrefix prefix for marker transfer limits
+	TransferLimitsPrefix = []byte{0x0C}
+
+	// AddressOutflowPrefix prefix for the amounts accounts have sent during their current outflow periods
+	AddressOutflowPrefix = []byte{0x0D}
+
+	// DistributionPrefix prefix for marker distributions that have not been completed
+	DistributionPrefix = []byte{0x0E}
+
+	// DistributionPayoutPrefix prefix for the distribution payouts that holders have not yet received
+	DistributionPayoutPrefix = []byte{0x0F}
+
+	// LastDistributionIDKey key for the id of the most recently created marker distribution
+	LastDistributionIDKey = []byte{0x10}
+
+	// ActiveDistributionPrefix prefix for the index of distributions that still have end block work to do
+	ActiveDistributionPrefix = []byte{0x11}
+
+	// HolderCountPrefix prefix for the number of accounts holding the denom of a marker with transfer limits
+	HolderCountPrefix = []byte{0x12}
+
+	// DistributionExpirationPrefix prefix for the index of distributions by when their unclaimed payouts expire
+	DistributionExpirationPrefix = []byte{0x13}
+
+	// HolderPrefix prefix for the accounts counted as holding the denom of a marker with transfer limits
+	HolderPrefix = []byte{0x14}
+
+	// RecordingDistributionPrefix prefix for the index of distributions that are part way through recording their holders
+	RecordingDistributionPrefix = []byte{0x15}
 )
 
 // MarkerAddress returns the module account address for the given denomination
@@ -103,3 +153,174 @@ func GetMarkerFromNetAssetValueKey(key []byte) sdk.AccAddress {
 	markerAddr := sdk.AccAddress(key[2 : markerKeyLen+2])
 	return markerAddr
 }
+
+// NetAssetValueReportMarkerPrefix returns key [prefix][marker address] for net asset value reports of a marker
+func NetAssetValueReportMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(NetAssetValueReportPrefix)+1+len(markerAddr))
+	key = append(key, NetAssetValueReportPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// NetAssetValueReportDenomPrefix returns key [prefix][marker address][price denom] for net asset value reports
+// of a marker in a price denom
+func NetAssetValueReportDenomPrefix(markerAddr sdk.AccAddress, priceDenom string) []byte {
+	return append(NetAssetValueReportMarkerPrefix(markerAddr), address.MustLengthPrefix([]byte(priceDenom))...)
+}
+
+// NetAssetValueReportKey returns key [prefix][marker address][price denom][reporter address] for a reporter's
+// net asset value report of a marker in a price denom
+func NetAssetValueReportKey(markerAddr sdk.AccAddress, priceDenom string, reporter sdk.AccAddress) []byte {
+	return append(NetAssetValueReportDenomPrefix(markerAddr, priceDenom), address.MustLengthPrefix(reporter.Bytes())...)
+}
+
+// MintScheduleKey returns key [prefix][marker address] for a marker's mint schedule
+func MintScheduleKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(MintSchedulePrefix)+1+len(markerAddr))
+	key = append(key, MintSchedulePrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ApprovalThresholdsKey returns key [prefix][marker address] for a marker's approval thresholds
+func ApprovalThresholdsKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(ApprovalThresholdsPrefix)+1+len(markerAddr))
+	key = append(key, ApprovalThresholdsPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingActionMarkerPrefix returns key [prefix][marker address] for the pending actions of a marker
+func PendingActionMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(PendingActionPrefix)+1+len(markerAddr))
+	key = append(key, PendingActionPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// PendingActionKey returns key [prefix][marker address][id] for a pending action of a marker
+func PendingActionKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(PendingActionMarkerPrefix(markerAddr), id)
+}
+
+// PendingActionExpirationKey returns key [prefix][expiration][marker address][id] for the expiration index
+// entry of a pending action
+func PendingActionExpirationKey(expiresAt time.Time, markerAddr sdk.AccAddress, id uint64) []byte {
+	key := make([]byte, 0, len(PendingActionExpirationPrefix)+8+1+len(markerAddr)+8)
+	key = append(key, PendingActionExpirationPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(expiresAt.Unix()))
+	key = append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// ParsePendingActionExpirationKey returns the marker address and id in a pending action expiration key.
+func ParsePendingActionExpirationKey(key []byte) (sdk.AccAddress, uint64) {
+	addrStart := len(PendingActionExpirationPrefix) + 8
+	addrLen := int(key[addrStart])
+	markerAddr := sdk.AccAddress(key[addrStart+1 : addrStart+1+addrLen])
+	return markerAddr, binary.BigEndian.Uint64(key[addrStart+1+addrLen:])
+}
+
+// TransferLimitsKey returns key [prefix][marker address] for a marker's transfer limits
+func TransferLimitsKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(TransferLimitsPrefix)+1+len(markerAddr))
+	key = append(key, TransferLimitsPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderCountKey returns key [prefix][marker address] for the number of accounts holding a marker's denom
+func HolderCountKey(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(HolderCountPrefix)+1+len(markerAddr))
+	key = append(key, HolderCountPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderMarkerPrefix returns key [prefix][marker address] for the accounts counted as holding a marker's denom
+func HolderMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(HolderPrefix)+1+len(markerAddr))
+	key = append(key, HolderPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// HolderKey returns key [prefix][marker address][address] for an account counted as holding a marker's denom
+func HolderKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(HolderMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// AddressOutflowMarkerPrefix returns key [prefix][marker address] for the outflows of a marker's denom
+func AddressOutflowMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(AddressOutflowPrefix)+1+len(markerAddr))
+	key = append(key, AddressOutflowPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// AddressOutflowKey returns key [prefix][marker address][address] for an account's outflow of a marker's denom
+func AddressOutflowKey(markerAddr, addr sdk.AccAddress) []byte {
+	return append(AddressOutflowMarkerPrefix(markerAddr), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// DistributionMarkerPrefix returns key [prefix][marker address] for the distributions of a marker
+func DistributionMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(DistributionPrefix)+1+len(markerAddr))
+	key = append(key, DistributionPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// DistributionKey returns key [prefix][marker address][id] for a distribution of a marker
+func DistributionKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(DistributionMarkerPrefix(markerAddr), id)
+}
+
+// DistributionPayoutsPrefix returns key [prefix][id] for the payouts of a distribution
+func DistributionPayoutsPrefix(id uint64) []byte {
+	key := make([]byte, 0, len(DistributionPayoutPrefix)+8)
+	key = append(key, DistributionPayoutPrefix...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// DistributionPayoutKey returns key [prefix][id][address] for a holder's payout of a distribution
+func DistributionPayoutKey(id uint64, addr sdk.AccAddress) []byte {
+	return append(DistributionPayoutsPrefix(id), address.MustLengthPrefix(addr.Bytes())...)
+}
+
+// ActiveDistributionKey returns key [prefix][id][marker address] for the active distribution index entry of a
+// distribution
+func ActiveDistributionKey(id uint64, markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(ActiveDistributionPrefix)+8+1+len(markerAddr))
+	key = append(key, ActiveDistributionPrefix...)
+	key = binary.BigEndian.AppendUint64(key, id)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// ParseActiveDistributionKey returns the id and marker address in an active distribution key.
+func ParseActiveDistributionKey(key []byte) (uint64, sdk.AccAddress) {
+	idStart := len(ActiveDistributionPrefix)
+	return binary.BigEndian.Uint64(key[idStart : idStart+8]), sdk.AccAddress(key[idStart+9:])
+}
+
+// RecordingDistributionMarkerPrefix returns key [prefix][marker address] for the distributions of a marker that are
+// part way through recording their holders
+func RecordingDistributionMarkerPrefix(markerAddr sdk.AccAddress) []byte {
+	key := make([]byte, 0, len(RecordingDistributionPrefix)+1+len(markerAddr))
+	key = append(key, RecordingDistributionPrefix...)
+	return append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+}
+
+// RecordingDistributionKey returns key [prefix][marker address][id] for the recording index entry of a distribution
+func RecordingDistributionKey(markerAddr sdk.AccAddress, id uint64) []byte {
+	return binary.BigEndian.AppendUint64(RecordingDistributionMarkerPrefix(markerAddr), id)
+}
+
+// DistributionExpirationKey returns key [prefix][claim expiration][marker address][id] for the expiration index
+// entry of a distribution
+func DistributionExpirationKey(expiresAt time.Time, markerAddr sdk.AccAddress, id uint64) []byte {
+	key := make([]byte, 0, len(DistributionExpirationPrefix)+8+1+len(markerAddr)+8)
+	key = append(key, DistributionExpirationPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(expiresAt.Unix()))
+	key = append(key, address.MustLengthPrefix(markerAddr.Bytes())...)
+	return binary.BigEndian.AppendUint64(key, id)
+}
+
+// ParseDistributionExpirationKey returns the marker address and id in a distribution expiration key.
+func ParseDistributionExpirationKey(key []byte) (sdk.AccAddress, uint64) {
+	addrStart := len(DistributionExpirationPrefix) + 8
+	addrLen := int(key[addrStart])
+	markerAddr := sdk.AccAddress(key[addrStart+1 : addrStart+1+addrLen])
+	return markerAddr, binary.BigEndian.Uint64(key[addrStart+1+addrLen:])
+}
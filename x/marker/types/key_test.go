@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
@@ -63,6 +65,117 @@ func TestGetMarkerFromNetAssetValueKey(t *testing.T) {
 	assert.Equal(t, addr, addr2, "should match original marker address")
 }
 
+func TestNetAssetValueReportKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	reporter := sdk.AccAddress("reporter____________")
+	key := NetAssetValueReportKey(addr, "usd", reporter)
+
+	assert.Equal(t, uint8(6), key[0], "should have correct prefix for nav report key")
+	assert.Equal(t, NetAssetValueReportMarkerPrefix(addr), key[:len(addr)+2], "should start with the marker prefix")
+	assert.Equal(t, NetAssetValueReportDenomPrefix(addr, "usd"), key[:len(addr)+6], "should start with the denom prefix")
+	assert.Equal(t, "usd", string(key[len(addr)+3:len(addr)+6]), "should have price denom")
+	assert.Equal(t, reporter.Bytes(), key[len(addr)+7:], "should end with reporter address")
+}
+
+func TestMintScheduleKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := MintScheduleKey(addr)
+	assert.Equal(t, uint8(7), key[0], "should have correct prefix for mint schedule key")
+	assert.Equal(t, addr.Bytes(), key[2:], "should end with marker address")
+}
+
+func TestApprovalThresholdsKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := ApprovalThresholdsKey(addr)
+	assert.Equal(t, uint8(8), key[0], "should have correct prefix for approval thresholds key")
+	assert.Equal(t, addr.Bytes(), key[2:], "should end with marker address")
+}
+
+func TestPendingActionKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := PendingActionKey(addr, 3)
+	assert.Equal(t, uint8(9), key[0], "should have correct prefix for pending action key")
+	assert.Equal(t, PendingActionMarkerPrefix(addr), key[:len(addr)+2], "should start with marker prefix")
+	assert.Equal(t, []byte{0, 0, 0, 0, 0, 0, 0, 3}, key[len(addr)+2:], "should end with id")
+}
+
+func TestPendingActionExpirationKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := PendingActionExpirationKey(time.Unix(1700000000, 0), addr, 12)
+	assert.Equal(t, uint8(10), key[0], "should have correct prefix for pending action expiration key")
+	markerAddr, id := ParsePendingActionExpirationKey(key)
+	assert.Equal(t, addr, markerAddr, "parsed marker address")
+	assert.Equal(t, uint64(12), id, "parsed id")
+}
+
+func TestTransferLimitsKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := TransferLimitsKey(addr)
+	assert.Equal(t, uint8(12), key[0], "should have correct prefix for transfer limits key")
+	assert.Equal(t, addr.Bytes(), key[2:], "should end with marker address")
+}
+
+func TestHolderCountKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := HolderCountKey(addr)
+	assert.Equal(t, uint8(18), key[0], "should have correct prefix for holder count key")
+	assert.Equal(t, addr.Bytes(), key[2:], "should end with marker address")
+}
+
+func TestHolderKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	holder := sdk.AccAddress("holder______________")
+	key := HolderKey(addr, holder)
+	assert.Equal(t, uint8(20), key[0], "should have correct prefix for holder key")
+	assert.Equal(t, HolderMarkerPrefix(addr), key[:len(addr)+2], "should start with marker prefix")
+	assert.Equal(t, holder.Bytes(), key[len(addr)+3:], "should end with holder address")
+}
+
+func TestAddressOutflowKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	sender := sdk.AccAddress("sender______________")
+	key := AddressOutflowKey(addr, sender)
+	assert.Equal(t, uint8(13), key[0], "should have correct prefix for address outflow key")
+	assert.Equal(t, AddressOutflowMarkerPrefix(addr), key[:len(addr)+2], "should start with marker prefix")
+	assert.Equal(t, sender.Bytes(), key[len(addr)+3:], "should end with sender address")
+}
+
+func TestDistributionKeys(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	holder := sdk.AccAddress("holder______________")
+
+	key := DistributionKey(addr, 3)
+	assert.Equal(t, uint8(14), key[0], "should have correct prefix for distribution key")
+	assert.Equal(t, DistributionMarkerPrefix(addr), key[:len(key)-8], "should start with marker prefix")
+	assert.Equal(t, uint64(3), binary.BigEndian.Uint64(key[len(key)-8:]), "should end with id")
+
+	key = DistributionPayoutKey(3, holder)
+	assert.Equal(t, uint8(15), key[0], "should have correct prefix for distribution payout key")
+	assert.Equal(t, DistributionPayoutsPrefix(3), key[:9], "should start with distribution prefix")
+	assert.Equal(t, holder.Bytes(), key[10:], "should end with holder address")
+
+	key = RecordingDistributionKey(addr, 3)
+	assert.Equal(t, uint8(21), key[0], "should have correct prefix for recording distribution key")
+	assert.Equal(t, RecordingDistributionMarkerPrefix(addr), key[:len(key)-8], "should start with marker prefix")
+	assert.Equal(t, uint64(3), binary.BigEndian.Uint64(key[len(key)-8:]), "should end with id")
+
+	key = ActiveDistributionKey(3, addr)
+	assert.Equal(t, uint8(17), key[0], "should have correct prefix for active distribution key")
+	id, markerAddr := ParseActiveDistributionKey(key)
+	assert.Equal(t, uint64(3), id, "parsed id")
+	assert.Equal(t, addr, markerAddr, "parsed marker address")
+}
+
 func TestDenySendMarkerPrefix(t *testing.T) {
 	addr, err := MarkerAddress("nhash")
 	require.NoError(t, err, "MarkerAddress(nhash)")
@@ -71,3 +184,13 @@ func TestDenySendMarkerPrefix(t *testing.T) {
 	assert.Equal(t, uint8(3), denyKey[0], "should have correct prefix for send deny")
 	assert.Equal(t, denyKey[2:], addr.Bytes(), "should have marker address in iterable prefix")
 }
+
+func TestDistributionExpirationKey(t *testing.T) {
+	addr, err := MarkerAddress("nhash")
+	require.NoError(t, err, "MarkerAddress(nhash)")
+	key := DistributionExpirationKey(time.Unix(1700000000, 0), addr, 7)
+	assert.Equal(t, uint8(19), key[0], "should have correct prefix for distribution expiration key")
+	markerAddr, id := ParseDistributionExpirationKey(key)
+	assert.Equal(t, addr, markerAddr, "parsed marker address")
+	assert.Equal(t, uint64(7), id, "parsed id")
+}
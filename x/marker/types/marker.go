@@ -243,14 +243,14 @@ func ValidateGrantsForMarkerType(markerType MarkerType, grants ...AccessGrant) e
 			switch markerType {
 			case MarkerType_Coin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Nav) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
 			// Restricted Coins also support Transfer access
 			case MarkerType_RestrictedCoin:
 				{
-					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer) {
+					if !access.IsOneOf(Access_Admin, Access_Burn, Access_Delete, Access_Deposit, Access_Mint, Access_Withdraw, Access_Transfer, Access_ForceTransfer, Access_Nav) {
 						return fmt.Errorf("%v is not supported for marker type %v", access, markerType)
 					}
 				}
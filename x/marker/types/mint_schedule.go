@@ -0,0 +1,163 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMintSchedule returns a new instance of MintSchedule
+func NewMintSchedule(periodLimit sdkmath.Int, periodSeconds uint64, tranches ...MintTranche) MintSchedule {
+	return MintSchedule{
+		PeriodLimit:   periodLimit,
+		PeriodSeconds: periodSeconds,
+		Tranches:      tranches,
+	}
+}
+
+// NewMintTranche returns a new instance of MintTranche
+func NewMintTranche(amount sdkmath.Int, unlockTime time.Time) MintTranche {
+	return MintTranche{
+		Amount:     amount,
+		UnlockTime: unlockTime,
+	}
+}
+
+// Validate returns error if MintSchedule is not in a valid state
+func (s MintSchedule) Validate() error {
+	periodLimit := intOrZero(s.PeriodLimit)
+	if periodLimit.IsNegative() {
+		return fmt.Errorf("period limit %s cannot be negative", periodLimit)
+	}
+	if periodLimit.IsPositive() != (s.PeriodSeconds > 0) {
+		return errors.New("period limit and period seconds must either both be positive or both be zero")
+	}
+	if !s.HasPeriodLimit() && len(s.Tranches) == 0 {
+		return errors.New("mint schedule must have a period limit or at least one tranche")
+	}
+	for i, tranche := range s.Tranches {
+		if tranche.Amount.IsNil() || !tranche.Amount.IsPositive() {
+			return fmt.Errorf("tranche %d: amount must be positive", i)
+		}
+		if tranche.UnlockTime.IsZero() {
+			return fmt.Errorf("tranche %d: unlock time is required", i)
+		}
+	}
+	return nil
+}
+
+// HasPeriodLimit returns true if the schedule limits how much can be minted per period.
+func (s MintSchedule) HasPeriodLimit() bool {
+	return s.PeriodSeconds > 0
+}
+
+// Period returns the length of the schedule's periods.
+func (s MintSchedule) Period() time.Duration {
+	return time.Duration(s.PeriodSeconds) * time.Second
+}
+
+// Unlocked returns the sum of the tranches that have unlocked by the given block time.
+func (s MintSchedule) Unlocked(blockTime time.Time) sdkmath.Int {
+	rv := sdkmath.ZeroInt()
+	for _, tranche := range s.Tranches {
+		if !blockTime.Before(tranche.UnlockTime) {
+			rv = rv.Add(tranche.Amount)
+		}
+	}
+	return rv
+}
+
+// NewMarkerMintSchedule returns a new MarkerMintSchedule for the denom with nothing minted under it yet.
+func NewMarkerMintSchedule(denom string, schedule MintSchedule) MarkerMintSchedule {
+	return MarkerMintSchedule{
+		Denom:        denom,
+		Schedule:     schedule,
+		PeriodMinted: sdkmath.ZeroInt(),
+		TotalMinted:  sdkmath.ZeroInt(),
+	}
+}
+
+// Validate returns error if MarkerMintSchedule is not in a valid state
+func (m MarkerMintSchedule) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+	if err := m.Schedule.Validate(); err != nil {
+		return fmt.Errorf("invalid mint schedule for %s: %w", m.Denom, err)
+	}
+	if intOrZero(m.PeriodMinted).IsNegative() {
+		return fmt.Errorf("invalid mint schedule for %s: period minted cannot be negative", m.Denom)
+	}
+	if intOrZero(m.TotalMinted).IsNegative() {
+		return fmt.Errorf("invalid mint schedule for %s: total minted cannot be negative", m.Denom)
+	}
+	return nil
+}
+
+// inCurrentPeriod returns true if the block time is within the period that was last minted in.
+func (m MarkerMintSchedule) inCurrentPeriod(blockTime time.Time) bool {
+	return m.PeriodStart != nil && blockTime.Before(m.PeriodStart.Add(m.Schedule.Period()))
+}
+
+// RemainingInPeriod returns how much more can be minted during the period containing the block time.
+// Nil is returned if the schedule does not have a period limit.
+func (m MarkerMintSchedule) RemainingInPeriod(blockTime time.Time) *sdkmath.Int {
+	if !m.Schedule.HasPeriodLimit() {
+		return nil
+	}
+	rv := m.Schedule.PeriodLimit
+	if m.inCurrentPeriod(blockTime) {
+		rv = rv.Sub(intOrZero(m.PeriodMinted))
+	}
+	if rv.IsNegative() {
+		rv = sdkmath.ZeroInt()
+	}
+	return &rv
+}
+
+// RemainingUnlocked returns how much of the tranches unlocked by the block time has not been minted yet.
+// Nil is returned if the schedule does not have any tranches.
+func (m MarkerMintSchedule) RemainingUnlocked(blockTime time.Time) *sdkmath.Int {
+	if len(m.Schedule.Tranches) == 0 {
+		return nil
+	}
+	rv := m.Schedule.Unlocked(blockTime).Sub(intOrZero(m.TotalMinted))
+	if rv.IsNegative() {
+		rv = sdkmath.ZeroInt()
+	}
+	return &rv
+}
+
+// RecordMint records the minting of the given amount at the block time.
+// An error is returned if the amount is more than the schedule currently allows.
+func (m *MarkerMintSchedule) RecordMint(amount sdkmath.Int, blockTime time.Time) error {
+	if remaining := m.RemainingInPeriod(blockTime); remaining != nil && amount.GT(*remaining) {
+		return fmt.Errorf("cannot mint %s%s: only %s%s remains in the current mint period", amount, m.Denom, remaining, m.Denom)
+	}
+	if remaining := m.RemainingUnlocked(blockTime); remaining != nil && amount.GT(*remaining) {
+		return fmt.Errorf("cannot mint %s%s: only %s%s of the unlocked mint tranches remains", amount, m.Denom, remaining, m.Denom)
+	}
+
+	if m.Schedule.HasPeriodLimit() {
+		if !m.inCurrentPeriod(blockTime) {
+			periodStart := blockTime
+			m.PeriodStart = &periodStart
+			m.PeriodMinted = sdkmath.ZeroInt()
+		}
+		m.PeriodMinted = intOrZero(m.PeriodMinted).Add(amount)
+	}
+	m.TotalMinted = intOrZero(m.TotalMinted).Add(amount)
+	return nil
+}
+
+// intOrZero returns the provided value, or zero if it's nil.
+func intOrZero(val sdkmath.Int) sdkmath.Int {
+	if val.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return val
+}
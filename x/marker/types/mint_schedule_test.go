@@ -0,0 +1,188 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestMintScheduleValidate(t *testing.T) {
+	unlock := time.Unix(1700000000, 0).UTC()
+
+	tests := []struct {
+		name     string
+		schedule MintSchedule
+		expErr   string
+	}{
+		{
+			name:     "empty",
+			schedule: MintSchedule{},
+			expErr:   "mint schedule must have a period limit or at least one tranche",
+		},
+		{
+			name:     "negative period limit",
+			schedule: NewMintSchedule(sdkmath.NewInt(-1), 60),
+			expErr:   "period limit -1 cannot be negative",
+		},
+		{
+			name:     "period limit without period seconds",
+			schedule: NewMintSchedule(sdkmath.NewInt(10), 0),
+			expErr:   "period limit and period seconds must either both be positive or both be zero",
+		},
+		{
+			name:     "period seconds without period limit",
+			schedule: NewMintSchedule(sdkmath.ZeroInt(), 60),
+			expErr:   "period limit and period seconds must either both be positive or both be zero",
+		},
+		{
+			name:     "zero tranche amount",
+			schedule: NewMintSchedule(sdkmath.ZeroInt(), 0, NewMintTranche(sdkmath.ZeroInt(), unlock)),
+			expErr:   "tranche 0: amount must be positive",
+		},
+		{
+			name:     "tranche without unlock time",
+			schedule: NewMintSchedule(sdkmath.ZeroInt(), 0, NewMintTranche(sdkmath.NewInt(5), unlock), NewMintTranche(sdkmath.NewInt(5), time.Time{})),
+			expErr:   "tranche 1: unlock time is required",
+		},
+		{
+			name:     "period limit only",
+			schedule: NewMintSchedule(sdkmath.NewInt(10), 60),
+		},
+		{
+			name:     "tranches only",
+			schedule: NewMintSchedule(sdkmath.Int{}, 0, NewMintTranche(sdkmath.NewInt(5), unlock)),
+		},
+		{
+			name:     "period limit and tranches",
+			schedule: NewMintSchedule(sdkmath.NewInt(10), 60, NewMintTranche(sdkmath.NewInt(5), unlock)),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMarkerMintScheduleValidate(t *testing.T) {
+	schedule := NewMintSchedule(sdkmath.NewInt(10), 60)
+
+	tests := []struct {
+		name   string
+		ms     MarkerMintSchedule
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			ms:     NewMarkerMintSchedule("", schedule),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid schedule",
+			ms:     NewMarkerMintSchedule("hotdog", MintSchedule{}),
+			expErr: "invalid mint schedule for hotdog: mint schedule must have a period limit or at least one tranche",
+		},
+		{
+			name:   "negative period minted",
+			ms:     MarkerMintSchedule{Denom: "hotdog", Schedule: schedule, PeriodMinted: sdkmath.NewInt(-1)},
+			expErr: "invalid mint schedule for hotdog: period minted cannot be negative",
+		},
+		{
+			name:   "negative total minted",
+			ms:     MarkerMintSchedule{Denom: "hotdog", Schedule: schedule, TotalMinted: sdkmath.NewInt(-1)},
+			expErr: "invalid mint schedule for hotdog: total minted cannot be negative",
+		},
+		{
+			name: "valid",
+			ms:   NewMarkerMintSchedule("hotdog", schedule),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.ms.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMarkerMintScheduleRecordMint(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	hour := time.Hour
+	intStr := func(val *sdkmath.Int) string {
+		if val == nil {
+			return "<nil>"
+		}
+		return val.String()
+	}
+
+	t.Run("period limit", func(t *testing.T) {
+		ms := NewMarkerMintSchedule("hotdog", NewMintSchedule(sdkmath.NewInt(100), 3600))
+		assert.Nil(t, ms.RemainingUnlocked(start), "RemainingUnlocked without tranches")
+		assert.Equal(t, "100", intStr(ms.RemainingInPeriod(start)), "RemainingInPeriod before any mints")
+
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(60), start), "RecordMint 60")
+		require.NotNil(t, ms.PeriodStart, "PeriodStart")
+		assert.Equal(t, start, *ms.PeriodStart, "PeriodStart")
+		assert.Equal(t, "40", intStr(ms.RemainingInPeriod(start.Add(30*time.Minute))), "RemainingInPeriod mid period")
+
+		err := ms.RecordMint(sdkmath.NewInt(41), start.Add(30*time.Minute))
+		assert.EqualError(t, err, "cannot mint 41hotdog: only 40hotdog remains in the current mint period", "RecordMint 41")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(40), start.Add(30*time.Minute)), "RecordMint 40")
+		assert.Equal(t, "0", intStr(ms.RemainingInPeriod(start.Add(hour-time.Second))), "RemainingInPeriod at end of period")
+
+		// The next period starts with the first mint after the previous one ended.
+		later := start.Add(90 * time.Minute)
+		assert.Equal(t, "100", intStr(ms.RemainingInPeriod(later)), "RemainingInPeriod after period ended")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(100), later), "RecordMint 100 in next period")
+		assert.Equal(t, later, *ms.PeriodStart, "PeriodStart after second period started")
+		assert.Equal(t, "100", ms.PeriodMinted.String(), "PeriodMinted")
+		assert.Equal(t, "200", ms.TotalMinted.String(), "TotalMinted")
+	})
+
+	t.Run("tranches", func(t *testing.T) {
+		ms := NewMarkerMintSchedule("hotdog", NewMintSchedule(sdkmath.ZeroInt(), 0,
+			NewMintTranche(sdkmath.NewInt(50), start),
+			NewMintTranche(sdkmath.NewInt(25), start.Add(hour)),
+		))
+		assert.Nil(t, ms.RemainingInPeriod(start), "RemainingInPeriod without period limit")
+		assert.Equal(t, "0", intStr(ms.RemainingUnlocked(start.Add(-time.Second))), "RemainingUnlocked before first unlock")
+
+		err := ms.RecordMint(sdkmath.NewInt(1), start.Add(-time.Second))
+		assert.EqualError(t, err, "cannot mint 1hotdog: only 0hotdog of the unlocked mint tranches remains", "RecordMint before unlock")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(30), start), "RecordMint 30")
+		assert.Nil(t, ms.PeriodStart, "PeriodStart")
+		assert.Equal(t, "20", intStr(ms.RemainingUnlocked(start)), "RemainingUnlocked after first mint")
+		assert.Equal(t, "45", intStr(ms.RemainingUnlocked(start.Add(hour))), "RemainingUnlocked after second unlock")
+
+		err = ms.RecordMint(sdkmath.NewInt(46), start.Add(hour))
+		assert.EqualError(t, err, "cannot mint 46hotdog: only 45hotdog of the unlocked mint tranches remains", "RecordMint 46")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(45), start.Add(hour)), "RecordMint 45")
+		assert.Equal(t, "75", ms.TotalMinted.String(), "TotalMinted")
+	})
+
+	t.Run("period limit and tranches", func(t *testing.T) {
+		ms := NewMarkerMintSchedule("hotdog", NewMintSchedule(sdkmath.NewInt(10), 3600, NewMintTranche(sdkmath.NewInt(15), start)))
+		err := ms.RecordMint(sdkmath.NewInt(11), start)
+		assert.EqualError(t, err, "cannot mint 11hotdog: only 10hotdog remains in the current mint period", "RecordMint 11")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(10), start), "RecordMint 10")
+
+		err = ms.RecordMint(sdkmath.NewInt(6), start.Add(2*hour))
+		assert.EqualError(t, err, "cannot mint 6hotdog: only 5hotdog of the unlocked mint tranches remains", "RecordMint 6")
+		require.NoError(t, ms.RecordMint(sdkmath.NewInt(5), start.Add(2*hour)), "RecordMint 5")
+	})
+}
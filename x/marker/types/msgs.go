@@ -46,6 +46,13 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgWithdrawEscrowProposalRequest)(nil),
 	(*MsgSetDenomMetadataProposalRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgSetMintScheduleProposalRequest)(nil),
+	(*MsgSetApprovalThresholdsRequest)(nil),
+	(*MsgApproveMarkerActionRequest)(nil),
+	(*MsgSetTransferLimitsRequest)(nil),
+	(*MsgCreateDistributionRequest)(nil),
+	(*MsgClaimDistributionRequest)(nil),
+	(*MsgRedeemAllRequest)(nil),
 }
 
 func NewMsgFinalizeRequest(denom string, admin sdk.AccAddress) *MsgFinalizeRequest {
@@ -241,6 +248,12 @@ func (msg MsgAddMarkerRequest) ValidateBasic() error {
 		}
 	}
 
+	if msg.MintSchedule != nil {
+		if err := msg.MintSchedule.Validate(); err != nil {
+			return fmt.Errorf("invalid mint schedule: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -436,6 +449,12 @@ func (msg MsgAddFinalizeActivateMarkerRequest) ValidateBasic() error {
 		return fmt.Errorf("required attributes are reserved for restricted markers")
 	}
 
+	if msg.MintSchedule != nil {
+		if err := msg.MintSchedule.Validate(); err != nil {
+			return fmt.Errorf("invalid mint schedule: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -724,10 +743,175 @@ func (msg MsgSetDenomMetadataProposalRequest) ValidateBasic() error {
 	return err
 }
 
+func NewMsgSetMintScheduleProposalRequest(denom string, schedule *MintSchedule, authority string) *MsgSetMintScheduleProposalRequest {
+	return &MsgSetMintScheduleProposalRequest{
+		Denom:        denom,
+		MintSchedule: schedule,
+		Authority:    authority,
+	}
+}
+
+func (msg MsgSetMintScheduleProposalRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.MintSchedule != nil {
+		if err := msg.MintSchedule.Validate(); err != nil {
+			return fmt.Errorf("invalid mint schedule: %w", err)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	return err
+}
+
+func NewMsgSetApprovalThresholdsRequest(denom string, thresholds []ApprovalThreshold, pendingActionTTLSeconds uint64, administrator string) *MsgSetApprovalThresholdsRequest {
+	return &MsgSetApprovalThresholdsRequest{
+		Denom:                   denom,
+		Thresholds:              thresholds,
+		PendingActionTtlSeconds: pendingActionTTLSeconds,
+		Administrator:           administrator,
+	}
+}
+
+func (msg MsgSetApprovalThresholdsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := ValidateApprovalThresholds(msg.Thresholds, msg.PendingActionTtlSeconds); err != nil {
+		return err
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+func NewMsgApproveMarkerActionRequest(denom string, id uint64, approver string) *MsgApproveMarkerActionRequest {
+	return &MsgApproveMarkerActionRequest{
+		Denom:    denom,
+		Id:       id,
+		Approver: approver,
+	}
+}
+
+func (msg MsgApproveMarkerActionRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Id == 0 {
+		return fmt.Errorf("pending action id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Approver)
+	return err
+}
+
+func NewMsgSetTransferLimitsRequest(denom string, limits *TransferLimits, administrator string) *MsgSetTransferLimitsRequest {
+	return &MsgSetTransferLimitsRequest{
+		Denom:         denom,
+		Limits:        limits,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgSetTransferLimitsRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.Limits != nil {
+		if err := msg.Limits.Validate(); err != nil {
+			return fmt.Errorf("invalid transfer limits: %w", err)
+		}
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+func NewMsgCreateDistributionRequest(denom string, funds sdk.Coins, recordHeight int64, pushPayouts bool, administrator string) *MsgCreateDistributionRequest {
+	return &MsgCreateDistributionRequest{
+		Denom:         denom,
+		Funds:         funds,
+		RecordHeight:  recordHeight,
+		PushPayouts:   pushPayouts,
+		Administrator: administrator,
+	}
+}
+
+func (msg MsgCreateDistributionRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if err := msg.Funds.Validate(); err != nil {
+		return fmt.Errorf("invalid funds: %w", err)
+	}
+	if msg.Funds.IsZero() {
+		return errors.New("funds cannot be empty")
+	}
+	if msg.RecordHeight < 0 {
+		return fmt.Errorf("record height %d cannot be negative", msg.RecordHeight)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+func NewMsgClaimDistributionRequest(denom string, distributionID uint64, claimant string) *MsgClaimDistributionRequest {
+	return &MsgClaimDistributionRequest{
+		Denom:          denom,
+		DistributionId: distributionID,
+		Claimant:       claimant,
+	}
+}
+
+func (msg MsgClaimDistributionRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if msg.DistributionId == 0 {
+		return errors.New("distribution id cannot be zero")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Claimant)
+	return err
+}
+
+func NewMsgRedeemAllRequest(denom, redemptionDenom string, limit uint32, pageKey []byte, administrator string) *MsgRedeemAllRequest {
+	return &MsgRedeemAllRequest{
+		Denom:           denom,
+		RedemptionDenom: redemptionDenom,
+		Limit:           limit,
+		PageKey:         pageKey,
+		Administrator:   administrator,
+	}
+}
+
+func (msg MsgRedeemAllRequest) ValidateBasic() error {
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return err
+	}
+	if len(msg.RedemptionDenom) > 0 {
+		if err := sdk.ValidateDenom(msg.RedemptionDenom); err != nil {
+			return fmt.Errorf("invalid redemption denom: %w", err)
+		}
+		if msg.RedemptionDenom == msg.Denom {
+			return fmt.Errorf("redemption denom cannot be the same as the marker denom %s", msg.Denom)
+		}
+	}
+	if msg.Limit > MaxRedeemAllLimit {
+		return fmt.Errorf("limit %d cannot be more than %d", msg.Limit, MaxRedeemAllLimit)
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Administrator)
+	return err
+}
+
+// GetLimitOrDefault returns the most holders to redeem from, applying the default if no limit was provided.
+func (msg MsgRedeemAllRequest) GetLimitOrDefault() uint32 {
+	if msg.Limit == 0 {
+		return DefaultRedeemAllLimit
+	}
+	return msg.Limit
+}
+
 func NewMsgUpdateParamsRequest(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavReportAgeSeconds uint64,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -736,6 +920,7 @@ func NewMsgUpdateParamsRequest(
 			enableGovernance,
 			unrestrictedDenomRegex,
 			maxSupply,
+			maxNavReportAgeSeconds,
 		),
 	}
 }
@@ -52,6 +52,13 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgWithdrawEscrowProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgSetDenomMetadataProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetMintScheduleProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetApprovalThresholdsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgApproveMarkerActionRequest{Approver: signer} },
+		func(signer string) sdk.Msg { return &MsgSetTransferLimitsRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgCreateDistributionRequest{Administrator: signer} },
+		func(signer string) sdk.Msg { return &MsgClaimDistributionRequest{Claimant: signer} },
+		func(signer string) sdk.Msg { return &MsgRedeemAllRequest{Administrator: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -1238,6 +1245,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					3600,
 				),
 			},
 			expectError: false,
@@ -1250,6 +1258,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"^invalidregex$",
 					sdkmath.NewInt(1000000000000),
+					3600,
 				),
 			},
 			expectError:   true,
@@ -1263,6 +1272,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 					true,
 					"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}",
 					sdkmath.NewInt(1000000000000),
+					3600,
 				),
 			},
 			expectError:   true,
@@ -1283,3 +1293,337 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSetMintScheduleProposalRequestValidateBasic(t *testing.T) {
+	authority := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	schedule := NewMintSchedule(sdkmath.NewInt(100), 3600)
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSetMintScheduleProposalRequest
+		expErr string
+	}{
+		{
+			name: "valid schedule",
+			msg:  NewMsgSetMintScheduleProposalRequest("hotdog", &schedule, authority),
+		},
+		{
+			name: "valid removal",
+			msg:  NewMsgSetMintScheduleProposalRequest("hotdog", nil, authority),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetMintScheduleProposalRequest("", &schedule, authority),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid schedule",
+			msg:    NewMsgSetMintScheduleProposalRequest("hotdog", &MintSchedule{}, authority),
+			expErr: "invalid mint schedule: mint schedule must have a period limit or at least one tranche",
+		},
+		{
+			name:   "invalid authority",
+			msg:    NewMsgSetMintScheduleProposalRequest("hotdog", &schedule, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSetApprovalThresholdsRequestValidateBasic(t *testing.T) {
+	admin := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	thresholds := []ApprovalThreshold{NewApprovalThreshold(Access_Withdraw, 2)}
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSetApprovalThresholdsRequest
+		expErr string
+	}{
+		{
+			name: "valid thresholds",
+			msg:  NewMsgSetApprovalThresholdsRequest("hotdog", thresholds, 3600, admin),
+		},
+		{
+			name: "valid removal",
+			msg:  NewMsgSetApprovalThresholdsRequest("hotdog", nil, 0, admin),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetApprovalThresholdsRequest("", thresholds, 3600, admin),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid thresholds",
+			msg:    NewMsgSetApprovalThresholdsRequest("hotdog", []ApprovalThreshold{NewApprovalThreshold(Access_Mint, 2)}, 3600, admin),
+			expErr: "approval thresholds are not supported for ACCESS_MINT",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    NewMsgSetApprovalThresholdsRequest("hotdog", thresholds, 3600, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgApproveMarkerActionRequestValidateBasic(t *testing.T) {
+	approver := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	testCases := []struct {
+		name   string
+		msg    *MsgApproveMarkerActionRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgApproveMarkerActionRequest("hotdog", 1, approver),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgApproveMarkerActionRequest("", 1, approver),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "zero id",
+			msg:    NewMsgApproveMarkerActionRequest("hotdog", 0, approver),
+			expErr: "pending action id cannot be zero",
+		},
+		{
+			name:   "invalid approver",
+			msg:    NewMsgApproveMarkerActionRequest("hotdog", 1, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgSetTransferLimitsRequestValidateBasic(t *testing.T) {
+	admin := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	limits := NewTransferLimits(sdkmath.NewInt(100), sdkmath.NewInt(10), 3600, 5)
+
+	testCases := []struct {
+		name   string
+		msg    *MsgSetTransferLimitsRequest
+		expErr string
+	}{
+		{
+			name: "valid limits",
+			msg:  NewMsgSetTransferLimitsRequest("hotdog", &limits, admin),
+		},
+		{
+			name: "valid removal",
+			msg:  NewMsgSetTransferLimitsRequest("hotdog", nil, admin),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgSetTransferLimitsRequest("", &limits, admin),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid limits",
+			msg:    NewMsgSetTransferLimitsRequest("hotdog", &TransferLimits{}, admin),
+			expErr: "invalid transfer limits: transfer limits must have a max holding, period outflow limit or max holders",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    NewMsgSetTransferLimitsRequest("hotdog", &limits, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgCreateDistributionRequestValidateBasic(t *testing.T) {
+	admin := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+	funds := sdk.NewCoins(sdk.NewInt64Coin("usdf", 100))
+
+	testCases := []struct {
+		name   string
+		msg    *MsgCreateDistributionRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgCreateDistributionRequest("hotdog", funds, 10, true, admin),
+		},
+		{
+			name: "valid current height",
+			msg:  NewMsgCreateDistributionRequest("hotdog", funds, 0, false, admin),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgCreateDistributionRequest("", funds, 10, true, admin),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid funds",
+			msg:    NewMsgCreateDistributionRequest("hotdog", sdk.Coins{sdk.Coin{Denom: "usdf", Amount: sdkmath.NewInt(-1)}}, 10, true, admin),
+			expErr: "invalid funds: coin -1usdf amount is not positive",
+		},
+		{
+			name:   "no funds",
+			msg:    NewMsgCreateDistributionRequest("hotdog", sdk.Coins{}, 10, true, admin),
+			expErr: "funds cannot be empty",
+		},
+		{
+			name:   "negative record height",
+			msg:    NewMsgCreateDistributionRequest("hotdog", funds, -1, true, admin),
+			expErr: "record height -1 cannot be negative",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    NewMsgCreateDistributionRequest("hotdog", funds, 10, true, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgClaimDistributionRequestValidateBasic(t *testing.T) {
+	claimant := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	testCases := []struct {
+		name   string
+		msg    *MsgClaimDistributionRequest
+		expErr string
+	}{
+		{
+			name: "valid",
+			msg:  NewMsgClaimDistributionRequest("hotdog", 1, claimant),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgClaimDistributionRequest("", 1, claimant),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "zero distribution id",
+			msg:    NewMsgClaimDistributionRequest("hotdog", 0, claimant),
+			expErr: "distribution id cannot be zero",
+		},
+		{
+			name:   "invalid claimant",
+			msg:    NewMsgClaimDistributionRequest("hotdog", 1, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+}
+
+func TestMsgRedeemAllRequestValidateBasic(t *testing.T) {
+	admin := "cosmos1sh49f6ze3vn7cdl2amh2gnc70z5mten3y08xck"
+
+	testCases := []struct {
+		name   string
+		msg    *MsgRedeemAllRequest
+		expErr string
+	}{
+		{
+			name: "valid without redemption denom",
+			msg:  NewMsgRedeemAllRequest("hotdog", "", 0, nil, admin),
+		},
+		{
+			name: "valid with redemption denom",
+			msg:  NewMsgRedeemAllRequest("hotdog", "usd", MaxRedeemAllLimit, []byte("next"), admin),
+		},
+		{
+			name:   "invalid denom",
+			msg:    NewMsgRedeemAllRequest("", "", 0, nil, admin),
+			expErr: "invalid denom: ",
+		},
+		{
+			name:   "invalid redemption denom",
+			msg:    NewMsgRedeemAllRequest("hotdog", "x", 0, nil, admin),
+			expErr: "invalid redemption denom: invalid denom: x",
+		},
+		{
+			name:   "redemption denom same as denom",
+			msg:    NewMsgRedeemAllRequest("hotdog", "hotdog", 0, nil, admin),
+			expErr: "redemption denom cannot be the same as the marker denom hotdog",
+		},
+		{
+			name:   "limit too large",
+			msg:    NewMsgRedeemAllRequest("hotdog", "", MaxRedeemAllLimit+1, nil, admin),
+			expErr: "limit 1001 cannot be more than 1000",
+		},
+		{
+			name:   "invalid administrator",
+			msg:    NewMsgRedeemAllRequest("hotdog", "", 0, nil, "invalidaddress"),
+			expErr: "decoding bech32 failed: invalid separator index -1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "ValidateBasic")
+			} else {
+				require.NoError(t, err, "ValidateBasic")
+			}
+		})
+	}
+
+	assert.Equal(t, uint32(DefaultRedeemAllLimit), NewMsgRedeemAllRequest("hotdog", "", 0, nil, admin).GetLimitOrDefault(), "GetLimitOrDefault without limit")
+	assert.Equal(t, uint32(5), NewMsgRedeemAllRequest("hotdog", "", 5, nil, admin).GetLimitOrDefault(), "GetLimitOrDefault with limit")
+}
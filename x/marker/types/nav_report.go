@@ -0,0 +1,84 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewNetAssetValueReport returns a new instance of NetAssetValueReport
+func NewNetAssetValueReport(denom string, reporter string, netAssetValue NetAssetValue, reportedAt time.Time) NetAssetValueReport {
+	return NetAssetValueReport{
+		Denom:         denom,
+		Reporter:      reporter,
+		NetAssetValue: netAssetValue,
+		ReportedAt:    reportedAt,
+	}
+}
+
+// Validate returns error if NetAssetValueReport is not in a valid state
+func (r NetAssetValueReport) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.Reporter); err != nil {
+		return fmt.Errorf("invalid reporter: %w", err)
+	}
+	if err := r.NetAssetValue.Validate(); err != nil {
+		return err
+	}
+	if r.NetAssetValue.Volume == 0 {
+		return errors.New("reported net asset value volume must be positive value")
+	}
+	if r.NetAssetValue.Price.Denom == r.Denom {
+		return fmt.Errorf("net asset value denom cannot match marker denom %q", r.Denom)
+	}
+	return nil
+}
+
+// IsStale returns true if the report is at least maxAge old at the given block time.
+// A zero maxAge means reports never become stale.
+func (r NetAssetValueReport) IsStale(blockTime time.Time, maxAge time.Duration) bool {
+	return maxAge > 0 && !blockTime.Before(r.ReportedAt.Add(maxAge))
+}
+
+// MedianNetAssetValue returns the median of the given net asset values by price per unit of volume.
+// With an odd number of values, the middle one is returned as is. With an even number, the per-unit
+// prices of the two middle values are averaged and returned using the larger of their two volumes.
+// All values must have the same price denom and a positive volume. An empty NetAssetValue is returned
+// if no values are provided.
+func MedianNetAssetValue(navs []NetAssetValue) NetAssetValue {
+	if len(navs) == 0 {
+		return NetAssetValue{}
+	}
+
+	sorted := make([]NetAssetValue, len(navs))
+	copy(sorted, navs)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		// a_i/v_i < a_j/v_j <=> a_i*v_j < a_j*v_i
+		return sorted[i].Price.Amount.Mul(sdkmath.NewIntFromUint64(sorted[j].Volume)).
+			LT(sorted[j].Price.Amount.Mul(sdkmath.NewIntFromUint64(sorted[i].Volume)))
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return NewNetAssetValue(sorted[mid].Price, sorted[mid].Volume)
+	}
+
+	lo, hi := sorted[mid-1], sorted[mid]
+	volume := lo.Volume
+	if hi.Volume > volume {
+		volume = hi.Volume
+	}
+	// amount = (a_lo/v_lo + a_hi/v_hi) / 2 * v = (a_lo*v_hi + a_hi*v_lo) * v / (2*v_lo*v_hi)
+	loVol := sdkmath.NewIntFromUint64(lo.Volume)
+	hiVol := sdkmath.NewIntFromUint64(hi.Volume)
+	num := lo.Price.Amount.Mul(hiVol).Add(hi.Price.Amount.Mul(loVol)).Mul(sdkmath.NewIntFromUint64(volume))
+	den := loVol.Mul(hiVol).MulRaw(2)
+	return NewNetAssetValue(sdk.NewCoin(lo.Price.Denom, num.Quo(den)), volume)
+}
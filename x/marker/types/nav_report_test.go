@@ -0,0 +1,152 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestNetAssetValueReportValidate(t *testing.T) {
+	reporter := sdk.AccAddress("reporter____________").String()
+	now := time.Unix(1700000000, 0).UTC()
+	nav := func(amount int64, volume uint64) NetAssetValue {
+		return NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, amount), volume)
+	}
+
+	tests := []struct {
+		name   string
+		report NetAssetValueReport
+		expErr string
+	}{
+		{
+			name:   "invalid denom",
+			report: NewNetAssetValueReport("", reporter, nav(1, 1), now),
+			expErr: "invalid denom: invalid denom: ",
+		},
+		{
+			name:   "invalid reporter",
+			report: NewNetAssetValueReport("jackthecat", "bad", nav(1, 1), now),
+			expErr: "invalid reporter: decoding bech32 failed: invalid bech32 string length 3",
+		},
+		{
+			name:   "invalid net asset value",
+			report: NewNetAssetValueReport("jackthecat", reporter, nav(1, 0), now),
+			expErr: "marker net asset value volume must be positive value",
+		},
+		{
+			name:   "zero volume",
+			report: NewNetAssetValueReport("jackthecat", reporter, nav(0, 0), now),
+			expErr: "reported net asset value volume must be positive value",
+		},
+		{
+			name:   "price denom is marker denom",
+			report: NewNetAssetValueReport(UsdDenom, reporter, nav(1, 1), now),
+			expErr: `net asset value denom cannot match marker denom "usd"`,
+		},
+		{
+			name:   "valid",
+			report: NewNetAssetValueReport("jackthecat", reporter, nav(15, 10), now),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.report.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestNetAssetValueReportIsStale(t *testing.T) {
+	reportedAt := time.Unix(1700000000, 0).UTC()
+	report := NewNetAssetValueReport("jackthecat", "", NetAssetValue{}, reportedAt)
+
+	tests := []struct {
+		name      string
+		blockTime time.Time
+		maxAge    time.Duration
+		exp       bool
+	}{
+		{name: "zero max age", blockTime: reportedAt.Add(1000 * time.Hour), maxAge: 0, exp: false},
+		{name: "same time", blockTime: reportedAt, maxAge: time.Hour, exp: false},
+		{name: "just before expiry", blockTime: reportedAt.Add(time.Hour - time.Second), maxAge: time.Hour, exp: false},
+		{name: "at expiry", blockTime: reportedAt.Add(time.Hour), maxAge: time.Hour, exp: true},
+		{name: "after expiry", blockTime: reportedAt.Add(2 * time.Hour), maxAge: time.Hour, exp: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.exp, report.IsStale(tc.blockTime, tc.maxAge), "IsStale")
+		})
+	}
+}
+
+func TestMedianNetAssetValue(t *testing.T) {
+	nav := func(amount int64, volume uint64) NetAssetValue {
+		return NewNetAssetValue(sdk.NewInt64Coin(UsdDenom, amount), volume)
+	}
+
+	tests := []struct {
+		name string
+		navs []NetAssetValue
+		exp  NetAssetValue
+	}{
+		{
+			name: "nil",
+			navs: nil,
+			exp:  NetAssetValue{},
+		},
+		{
+			name: "one",
+			navs: []NetAssetValue{nav(15, 10)},
+			exp:  nav(15, 10),
+		},
+		{
+			name: "three with different volumes",
+			// per unit: 2, 1.5, 3
+			navs: []NetAssetValue{nav(20, 10), nav(3, 2), nav(300, 100)},
+			exp:  nav(20, 10),
+		},
+		{
+			name: "two with same volume",
+			navs: []NetAssetValue{nav(30, 10), nav(10, 10)},
+			exp:  nav(20, 10),
+		},
+		{
+			name: "two with different volumes",
+			// per unit: 1 and 2, average 1.5 at volume 4
+			navs: []NetAssetValue{nav(4, 2), nav(4, 4)},
+			exp:  nav(6, 4),
+		},
+		{
+			name: "four with truncation",
+			// per unit: 1, 2, 3, 10; middle two are 2 and 3, average 2.5 at volume 1 truncates to 2
+			navs: []NetAssetValue{nav(10, 1), nav(1, 1), nav(3, 1), nav(2, 1)},
+			exp:  nav(2, 1),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var orig []NetAssetValue
+			if tc.navs != nil {
+				orig = make([]NetAssetValue, len(tc.navs))
+				copy(orig, tc.navs)
+			}
+			var actual NetAssetValue
+			require.NotPanics(t, func() {
+				actual = MedianNetAssetValue(tc.navs)
+			}, "MedianNetAssetValue")
+			assert.Equal(t, tc.exp.String(), actual.String(), "MedianNetAssetValue result")
+			assert.Equal(t, orig, tc.navs, "navs after MedianNetAssetValue")
+		})
+	}
+}
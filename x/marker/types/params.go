@@ -19,6 +19,8 @@ const (
 	DefaultMaxSupply = "100000000000000000000"
 	// DefaultUnrestrictedDenomRegex is a regex that denoms created by normal requests must pass.
 	DefaultUnrestrictedDenomRegex = `[a-zA-Z][a-zA-Z0-9\-\.]{2,83}`
+	// DefaultMaxNavReportAgeSeconds is the number of seconds a net asset value report is used for (one day).
+	DefaultMaxNavReportAgeSeconds = uint64(86400)
 )
 
 // TODO: remove with the umber (v1.19.x) handlers.
@@ -44,11 +46,13 @@ func NewParams(
 	enableGovernance bool,
 	unrestrictedDenomRegex string,
 	maxSupply sdkmath.Int,
+	maxNavReportAgeSeconds uint64,
 ) Params {
 	return Params{
 		EnableGovernance:       enableGovernance,
 		UnrestrictedDenomRegex: unrestrictedDenomRegex,
 		MaxSupply:              maxSupply,
+		MaxNavReportAgeSeconds: maxNavReportAgeSeconds,
 	}
 }
 
@@ -69,6 +73,7 @@ func DefaultParams() Params {
 		DefaultEnableGovernance,
 		DefaultUnrestrictedDenomRegex,
 		StringToBigInt(DefaultMaxSupply),
+		DefaultMaxNavReportAgeSeconds,
 	)
 }
 
@@ -20,11 +20,13 @@ func TestDefaultParams(t *testing.T) {
 	require.Equal(t, DefaultUnrestrictedDenomRegex, p.UnrestrictedDenomRegex)
 	require.Equal(t, DefaultEnableGovernance, p.EnableGovernance)
 	require.Equal(t, DefaultMaxSupply, p.MaxSupply.String())
+	require.Equal(t, DefaultMaxNavReportAgeSeconds, p.MaxNavReportAgeSeconds)
 
-	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply))))
-	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"))))
+	require.True(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavReportAgeSeconds)))
+	require.False(t, p.Equal(NewParams(false, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), DefaultMaxNavReportAgeSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, "a-z", StringToBigInt(DefaultMaxSupply), DefaultMaxNavReportAgeSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt("1000"), DefaultMaxNavReportAgeSeconds)))
+	require.False(t, p.Equal(NewParams(DefaultEnableGovernance, DefaultUnrestrictedDenomRegex, StringToBigInt(DefaultMaxSupply), 0)))
 	require.False(t, p.Equal(nil))
 
 	var p2 *Params
@@ -41,7 +43,8 @@ func TestDefaultParams(t *testing.T) {
 func TestParamString(t *testing.T) {
 	expected := `enable_governance:true ` +
 		`unrestricted_denom_regex:"[a-zA-Z][a-zA-Z0-9\\-\\.]{2,83}" ` +
-		`max_supply:"100000000000000000000" `
+		`max_supply:"100000000000000000000" ` +
+		`max_nav_report_age_seconds:86400 `
 	p := DefaultParams()
 	actual := p.String()
 	require.Equal(t, expected, actual)
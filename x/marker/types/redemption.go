@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultRedeemAllLimit is the number of holders redeemed by a RedeemAll request that doesn't provide a limit.
+	DefaultRedeemAllLimit = 100
+	// MaxRedeemAllLimit is the most holders that a single RedeemAll request can redeem.
+	MaxRedeemAllLimit = 1000
+)
+
+// CalculateRedemptionPayout returns what a holder is paid for redeeming the given amount of a marker's denom at a
+// net asset value, rounded down.
+func CalculateRedemptionPayout(amount sdkmath.Int, nav NetAssetValue) (sdk.Coin, error) {
+	if nav.Volume == 0 {
+		return sdk.Coin{}, fmt.Errorf("net asset value in %s has a volume of zero", nav.Price.Denom)
+	}
+	payout := amount.Mul(nav.Price.Amount).Quo(sdkmath.NewIntFromUint64(nav.Volume))
+	return sdk.NewCoin(nav.Price.Denom, payout), nil
+}
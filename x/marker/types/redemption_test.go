@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCalculateRedemptionPayout(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		nav    NetAssetValue
+		exp    string
+		expErr string
+	}{
+		{
+			name:   "one to one",
+			amount: 25,
+			nav:    NewNetAssetValue(sdk.NewInt64Coin("usdf", 10), 10),
+			exp:    "25usdf",
+		},
+		{
+			name:   "rounded down",
+			amount: 10,
+			nav:    NewNetAssetValue(sdk.NewInt64Coin("usdf", 10), 3),
+			exp:    "33usdf",
+		},
+		{
+			name:   "less than one unit",
+			amount: 1,
+			nav:    NewNetAssetValue(sdk.NewInt64Coin("usdf", 1), 2),
+			exp:    "0usdf",
+		},
+		{
+			name:   "zero volume",
+			amount: 10,
+			nav:    NewNetAssetValue(sdk.NewInt64Coin("usdf", 10), 0),
+			expErr: "net asset value in usdf has a volume of zero",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			payout, err := CalculateRedemptionPayout(sdkmath.NewInt(tc.amount), tc.nav)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "CalculateRedemptionPayout")
+				return
+			}
+			require.NoError(t, err, "CalculateRedemptionPayout")
+			assert.Equal(t, tc.exp, payout.String(), "CalculateRedemptionPayout")
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewTransferLimits returns a new instance of TransferLimits
+func NewTransferLimits(maxHolding, periodOutflowLimit sdkmath.Int, periodSeconds, maxHolders uint64) TransferLimits {
+	return TransferLimits{
+		MaxHolding:         maxHolding,
+		PeriodOutflowLimit: periodOutflowLimit,
+		PeriodSeconds:      periodSeconds,
+		MaxHolders:         maxHolders,
+	}
+}
+
+// Validate returns error if TransferLimits is not in a valid state
+func (l TransferLimits) Validate() error {
+	maxHolding := intOrZero(l.MaxHolding)
+	if maxHolding.IsNegative() {
+		return fmt.Errorf("max holding %s cannot be negative", maxHolding)
+	}
+	outflowLimit := intOrZero(l.PeriodOutflowLimit)
+	if outflowLimit.IsNegative() {
+		return fmt.Errorf("period outflow limit %s cannot be negative", outflowLimit)
+	}
+	if outflowLimit.IsPositive() != (l.PeriodSeconds > 0) {
+		return errors.New("period outflow limit and period seconds must either both be positive or both be zero")
+	}
+	if !l.HasMaxHolding() && !l.HasOutflowLimit() && !l.HasMaxHolders() {
+		return errors.New("transfer limits must have a max holding, period outflow limit or max holders")
+	}
+	return nil
+}
+
+// HasMaxHolding returns true if the limits cap how much any one account can hold.
+func (l TransferLimits) HasMaxHolding() bool {
+	return !l.MaxHolding.IsNil() && l.MaxHolding.IsPositive()
+}
+
+// HasOutflowLimit returns true if the limits cap how much any one account can send per period.
+func (l TransferLimits) HasOutflowLimit() bool {
+	return l.PeriodSeconds > 0
+}
+
+// HasMaxHolders returns true if the limits cap how many accounts can hold the denom.
+func (l TransferLimits) HasMaxHolders() bool {
+	return l.MaxHolders > 0
+}
+
+// Period returns the length of the outflow periods.
+func (l TransferLimits) Period() time.Duration {
+	return time.Duration(l.PeriodSeconds) * time.Second
+}
+
+// RemainingHolding returns how much more an account with the given balance can receive.
+// Nil is returned if there is no holding cap.
+func (l TransferLimits) RemainingHolding(balance sdkmath.Int) *sdkmath.Int {
+	if !l.HasMaxHolding() {
+		return nil
+	}
+	rv := l.MaxHolding.Sub(intOrZero(balance))
+	if rv.IsNegative() {
+		rv = sdkmath.ZeroInt()
+	}
+	return &rv
+}
+
+// NewMarkerTransferLimits returns a new instance of MarkerTransferLimits
+func NewMarkerTransferLimits(denom string, limits TransferLimits) MarkerTransferLimits {
+	return MarkerTransferLimits{
+		Denom:  denom,
+		Limits: limits,
+	}
+}
+
+// Validate returns error if MarkerTransferLimits is not in a valid state
+func (m MarkerTransferLimits) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return err
+	}
+	if err := m.Limits.Validate(); err != nil {
+		return fmt.Errorf("invalid transfer limits for %s: %w", m.Denom, err)
+	}
+	return nil
+}
+
+// NewAddressOutflow returns a new AddressOutflow for an account that hasn't sent anything yet.
+func NewAddressOutflow(denom string, addr sdk.AccAddress) AddressOutflow {
+	return AddressOutflow{
+		Denom:   denom,
+		Address: addr.String(),
+		Amount:  sdkmath.ZeroInt(),
+	}
+}
+
+// Validate returns error if AddressOutflow is not in a valid state
+func (o AddressOutflow) Validate() error {
+	if err := sdk.ValidateDenom(o.Denom); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return fmt.Errorf("invalid %s outflow address %q: %w", o.Denom, o.Address, err)
+	}
+	if intOrZero(o.Amount).IsNegative() {
+		return fmt.Errorf("invalid %s outflow for %s: amount cannot be negative", o.Denom, o.Address)
+	}
+	return nil
+}
+
+// inCurrentPeriod returns true if the block time is within the period that was last sent in.
+func (o AddressOutflow) inCurrentPeriod(limits TransferLimits, blockTime time.Time) bool {
+	return !o.PeriodStart.IsZero() && blockTime.Before(o.PeriodStart.Add(limits.Period()))
+}
+
+// SentInPeriod returns how much was sent during the period containing the block time.
+func (o AddressOutflow) SentInPeriod(limits TransferLimits, blockTime time.Time) sdkmath.Int {
+	if !o.inCurrentPeriod(limits, blockTime) {
+		return sdkmath.ZeroInt()
+	}
+	return intOrZero(o.Amount)
+}
+
+// RemainingInPeriod returns how much more can be sent during the period containing the block time.
+// Nil is returned if there is no outflow limit.
+func (o AddressOutflow) RemainingInPeriod(limits TransferLimits, blockTime time.Time) *sdkmath.Int {
+	if !limits.HasOutflowLimit() {
+		return nil
+	}
+	rv := limits.PeriodOutflowLimit.Sub(o.SentInPeriod(limits, blockTime))
+	if rv.IsNegative() {
+		rv = sdkmath.ZeroInt()
+	}
+	return &rv
+}
+
+// RecordOutflow records the sending of the given amount at the block time.
+// An error is returned if the amount is more than the limits currently allow.
+func (o *AddressOutflow) RecordOutflow(amount sdkmath.Int, limits TransferLimits, blockTime time.Time) error {
+	if !limits.HasOutflowLimit() {
+		return nil
+	}
+	if remaining := o.RemainingInPeriod(limits, blockTime); amount.GT(*remaining) {
+		return fmt.Errorf("cannot send %s%s from %s: only %s%s remains in the current outflow period",
+			amount, o.Denom, o.Address, remaining, o.Denom)
+	}
+	if !o.inCurrentPeriod(limits, blockTime) {
+		o.PeriodStart = blockTime
+		o.Amount = sdkmath.ZeroInt()
+	}
+	o.Amount = intOrZero(o.Amount).Add(amount)
+	return nil
+}
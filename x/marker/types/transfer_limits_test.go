@@ -0,0 +1,119 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestTransferLimitsValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits TransferLimits
+		expErr string
+	}{
+		{
+			name:   "empty",
+			limits: TransferLimits{},
+			expErr: "transfer limits must have a max holding, period outflow limit or max holders",
+		},
+		{
+			name:   "negative max holding",
+			limits: NewTransferLimits(sdkmath.NewInt(-1), sdkmath.ZeroInt(), 0, 0),
+			expErr: "max holding -1 cannot be negative",
+		},
+		{
+			name:   "negative outflow limit",
+			limits: NewTransferLimits(sdkmath.ZeroInt(), sdkmath.NewInt(-1), 60, 0),
+			expErr: "period outflow limit -1 cannot be negative",
+		},
+		{
+			name:   "outflow limit without period seconds",
+			limits: NewTransferLimits(sdkmath.ZeroInt(), sdkmath.NewInt(10), 0, 0),
+			expErr: "period outflow limit and period seconds must either both be positive or both be zero",
+		},
+		{
+			name:   "period seconds without outflow limit",
+			limits: NewTransferLimits(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 60, 0),
+			expErr: "period outflow limit and period seconds must either both be positive or both be zero",
+		},
+		{
+			name:   "max holding only",
+			limits: NewTransferLimits(sdkmath.NewInt(10), sdkmath.Int{}, 0, 0),
+		},
+		{
+			name:   "outflow limit only",
+			limits: NewTransferLimits(sdkmath.Int{}, sdkmath.NewInt(10), 60, 0),
+		},
+		{
+			name:   "max holders only",
+			limits: NewTransferLimits(sdkmath.Int{}, sdkmath.Int{}, 0, 5),
+		},
+		{
+			name:   "all limits",
+			limits: NewTransferLimits(sdkmath.NewInt(10), sdkmath.NewInt(5), 60, 5),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.limits.Validate()
+			if len(tc.expErr) > 0 {
+				assert.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMarkerTransferLimitsValidate(t *testing.T) {
+	limits := NewTransferLimits(sdkmath.NewInt(10), sdkmath.ZeroInt(), 0, 0)
+	assert.EqualError(t, NewMarkerTransferLimits("", limits).Validate(), "invalid denom: ", "Validate invalid denom")
+	assert.EqualError(t, NewMarkerTransferLimits("hotdog", TransferLimits{}).Validate(),
+		"invalid transfer limits for hotdog: transfer limits must have a max holding, period outflow limit or max holders", "Validate invalid limits")
+	assert.NoError(t, NewMarkerTransferLimits("hotdog", limits).Validate(), "Validate valid")
+}
+
+func TestTransferLimitsRemainingHolding(t *testing.T) {
+	assert.Nil(t, NewTransferLimits(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, 5).RemainingHolding(sdkmath.NewInt(3)), "RemainingHolding without cap")
+	limits := NewTransferLimits(sdkmath.NewInt(10), sdkmath.ZeroInt(), 0, 0)
+	assert.Equal(t, "7", limits.RemainingHolding(sdkmath.NewInt(3)).String(), "RemainingHolding under cap")
+	assert.Equal(t, "0", limits.RemainingHolding(sdkmath.NewInt(12)).String(), "RemainingHolding over cap")
+}
+
+func TestAddressOutflowRecordOutflow(t *testing.T) {
+	start := time.Unix(1700000000, 0).UTC()
+	addr := sdk.AccAddress("sender______________")
+	limits := NewTransferLimits(sdkmath.ZeroInt(), sdkmath.NewInt(100), 3600, 0)
+
+	outflow := NewAddressOutflow("hotdog", addr)
+	require.NoError(t, outflow.Validate(), "Validate")
+	assert.Equal(t, "100", outflow.RemainingInPeriod(limits, start).String(), "RemainingInPeriod before any sends")
+
+	require.NoError(t, outflow.RecordOutflow(sdkmath.NewInt(60), limits, start), "RecordOutflow 60")
+	assert.Equal(t, start, outflow.PeriodStart, "PeriodStart")
+	assert.Equal(t, "60", outflow.SentInPeriod(limits, start.Add(time.Minute)).String(), "SentInPeriod")
+
+	err := outflow.RecordOutflow(sdkmath.NewInt(41), limits, start.Add(time.Minute))
+	assert.EqualError(t, err, "cannot send 41hotdog from "+addr.String()+": only 40hotdog remains in the current outflow period", "RecordOutflow 41")
+	require.NoError(t, outflow.RecordOutflow(sdkmath.NewInt(40), limits, start.Add(time.Minute)), "RecordOutflow 40")
+	assert.Equal(t, "0", outflow.RemainingInPeriod(limits, start.Add(time.Hour-time.Second)).String(), "RemainingInPeriod at end of period")
+
+	// The next period starts with the first send after the previous one ended.
+	later := start.Add(90 * time.Minute)
+	assert.Equal(t, "0", outflow.SentInPeriod(limits, later).String(), "SentInPeriod after period ended")
+	require.NoError(t, outflow.RecordOutflow(sdkmath.NewInt(100), limits, later), "RecordOutflow 100 in next period")
+	assert.Equal(t, later, outflow.PeriodStart, "PeriodStart after second period started")
+	assert.Equal(t, "100", outflow.Amount.String(), "Amount")
+
+	noLimit := NewTransferLimits(sdkmath.NewInt(10), sdkmath.ZeroInt(), 0, 0)
+	assert.Nil(t, outflow.RemainingInPeriod(noLimit, later), "RemainingInPeriod without outflow limit")
+	assert.NoError(t, outflow.RecordOutflow(sdkmath.NewInt(1000), noLimit, later), "RecordOutflow without outflow limit")
+}
@@ -61,6 +61,8 @@ const (
 	MarkerPermissionForceTransfer MarkerPermission = "force_transfer"
 	// MarkerPermissionMint is a concrete marker permission type
 	MarkerPermissionMint MarkerPermission = "mint"
+	// MarkerPermissionNav is a concrete marker permission type
+	MarkerPermissionNav MarkerPermission = "nav"
 	// MarkerPermissionTransfer is a concrete marker permission type
 	MarkerPermissionTransfer MarkerPermission = "transfer"
 	// MarkerPermissionUnspecified is a concrete marker permission type
@@ -164,6 +166,8 @@ func permissionFor(input types.Access) MarkerPermission {
 		return MarkerPermissionForceTransfer
 	case types.Access_Mint:
 		return MarkerPermissionMint
+	case types.Access_Nav:
+		return MarkerPermissionNav
 	case types.Access_Transfer:
 		return MarkerPermissionTransfer
 	case types.Access_Withdraw:
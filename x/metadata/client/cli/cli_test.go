@@ -475,6 +475,24 @@ owner: %s`,
 		metadataData.ContractSpecifications = append(metadataData.ContractSpecifications, s.contractSpec)
 		metadataData.RecordSpecifications = append(metadataData.RecordSpecifications, s.recordSpec)
 		metadataData.ObjectStoreLocators = append(metadataData.ObjectStoreLocators, s.objectLocator1, s.objectLocator2)
+		metadataData.ScopeHistory = append(metadataData.ScopeHistory, metadatatypes.ScopeHistoryEntry{
+			ScopeId:       s.scope.ScopeId,
+			Version:       1,
+			BlockHeight:   1,
+			BlockTime:     time.Unix(1_700_000_000, 0).UTC(),
+			ChangedBy:     []string{s.user1AddrStr},
+			ChangedFields: []string{"specification_id", "owners", "data_access", "value_owner_address"},
+			Scope:         s.scope,
+		})
+		metadataData.RecordHistory = append(metadataData.RecordHistory, metadatatypes.RecordHistoryEntry{
+			RecordId:      s.recordID,
+			Version:       1,
+			BlockHeight:   1,
+			BlockTime:     time.Unix(1_700_000_000, 0).UTC(),
+			ChangedBy:     []string{s.user1AddrStr},
+			ChangedFields: []string{"session_id", "process", "inputs", "outputs", "specification_id"},
+			Record:        s.record,
+		})
 		return metadataData
 	})
 
@@ -1787,6 +1805,83 @@ func (s *IntegrationCLITestSuite) TestGetAccountDataCmd() {
 	runQueryCmdTestCases(s, cmd, tests)
 }
 
+func (s *IntegrationCLITestSuite) TestGetScopeHistoryCmd() {
+	cmd := func() *cobra.Command { return cli.GetScopeHistoryCmd() }
+
+	tests := []queryCmdTestCase{
+		{
+			name:   "invalid scope id",
+			args:   []string{"notascope"},
+			expErr: "could not parse [notascope] into either a scope address",
+		},
+		{
+			name:   "no history",
+			args:   []string{metadatatypes.ScopeMetadataAddress(uuid.New()).String()},
+			expOut: []string{"entries: []"},
+		},
+		{
+			name:   "scope with history",
+			args:   []string{s.scopeUUID.String(), "--include-request"},
+			expOut: []string{"version: \"1\"", "block_height: \"1\"", "- " + s.user1AddrStr, "- value_owner_address", s.scopeID.String(), "include_request: true"},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, tests)
+}
+
+func (s *IntegrationCLITestSuite) TestGetRecordHistoryCmd() {
+	cmd := func() *cobra.Command { return cli.GetRecordHistoryCmd() }
+
+	tests := []queryCmdTestCase{
+		{
+			name:   "not a record address",
+			args:   []string{s.scopeID.String()},
+			expErr: "not a record address",
+		},
+		{
+			name:   "record with history",
+			args:   []string{s.recordID.String()},
+			expOut: []string{"version: \"1\"", "- outputs", s.recordID.String(), "name: " + s.recordName},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, tests)
+}
+
+func (s *IntegrationCLITestSuite) TestGetScopeAtHeightCmd() {
+	cmd := func() *cobra.Command { return cli.GetScopeAtHeightCmd() }
+
+	tests := []queryCmdTestCase{
+		{
+			name:   "invalid height",
+			args:   []string{s.scopeID.String(), "one"},
+			expErr: `invalid height "one": strconv.ParseInt: parsing "one": invalid syntax`,
+		},
+		{
+			name:   "zero height",
+			args:   []string{s.scopeID.String(), "0"},
+			expErr: "invalid height 0: must be positive",
+		},
+		{
+			name:   "unknown scope",
+			args:   []string{"scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel", "1"},
+			expErr: "scope scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel not found at height 1",
+		},
+		{
+			name:   "scope without records",
+			args:   []string{s.scopeID.String(), "1"},
+			expOut: []string{s.scopeID.String(), "records: []"},
+		},
+		{
+			name:   "scope with records",
+			args:   []string{s.scopeUUID.String(), "1", "--include-records"},
+			expOut: []string{s.scopeID.String(), s.recordID.String(), "name: " + s.recordName},
+		},
+	}
+
+	runQueryCmdTestCases(s, cmd, tests)
+}
+
 // ---------- tx cmd tests ----------
 
 type txCmdTestCase struct {
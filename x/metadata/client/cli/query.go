@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/google/uuid"
@@ -60,6 +61,9 @@ func GetQueryCmd() *cobra.Command {
 		GetOSLocatorCmd(),
 		GetAccountDataCmd(),
 		GetCmdNetAssetValuesQuery(),
+		GetScopeHistoryCmd(),
+		GetRecordHistoryCmd(),
+		GetScopeAtHeightCmd(),
 	)
 	return queryCmd
 }
@@ -602,6 +606,143 @@ func GetAccountDataCmd() *cobra.Command {
 	return cmd
 }
 
+// GetScopeHistoryCmd returns the command handler for querying the recorded versions of a scope.
+func GetScopeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-history {scope_id|scope_uuid}",
+		Aliases: []string{"sh", "scopehistory"},
+		Short:   "Query the recorded versions of a scope",
+		Long: fmt.Sprintf(`%[1]s scope-history {scope_id} - gets the recorded versions of the scope with the given id.
+%[1]s scope-history {scope_uuid} - gets the recorded versions of the scope with the given uuid.`, cmdStart),
+		Args: cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s scope-history scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel
+%[1]s scope-history 91978ba2-5f35-459a-86a7-feca1b0512e0`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeHistory(
+				cmd.Context(),
+				&types.ScopeHistoryRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "scope history")
+
+	return cmd
+}
+
+// GetRecordHistoryCmd returns the command handler for querying the recorded versions of a record.
+func GetRecordHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "record-history {record_id}",
+		Aliases: []string{"rh", "recordhistory"},
+		Short:   "Query the recorded versions of a record",
+		Long:    fmt.Sprintf(`%[1]s record-history {record_id} - gets the recorded versions of the record with the given id.`, cmdStart),
+		Args:    cobra.ExactArgs(1),
+		Example: fmt.Sprintf(`%[1]s record-history record1q2ge0zaztu65tx5x5llv5xc9ztsw42dq2jdvmdazuwzcaddhh8gmu3mcze3`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RecordHistory(
+				cmd.Context(),
+				&types.RecordHistoryRequest{
+					RecordAddr:     strings.TrimSpace(args[0]),
+					IncludeRequest: includeRequest,
+					Pagination:     pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "record history")
+
+	return cmd
+}
+
+// GetScopeAtHeightCmd returns the command handler for querying a scope as it was at a block height.
+func GetScopeAtHeightCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "scope-at-height {scope_id|scope_uuid} {height}",
+		Aliases: []string{"sah", "scopeatheight"},
+		Short:   "Query a scope as it was at a block height",
+		Long: fmt.Sprintf(`%[1]s scope-at-height {scope_id} {height} - gets the scope with the given id as it was at the given height.
+%[1]s scope-at-height {scope_uuid} {height} - gets the scope with the given uuid as it was at the given height.
+
+The result is built from the recorded versions of the scope (and its records).`, cmdStart),
+		Args: cobra.ExactArgs(2),
+		Example: fmt.Sprintf(`%[1]s scope-at-height scope1qzge0zaztu65tx5x5llv5xc9ztsqxlkwel 1000
+%[1]s scope-at-height 91978ba2-5f35-459a-86a7-feca1b0512e0 1000 --include-records`, cmdStart),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := strconv.ParseInt(strings.TrimSpace(args[1]), 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid height %q: %w", args[1], err)
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ScopeAtHeight(
+				cmd.Context(),
+				&types.ScopeAtHeightRequest{
+					ScopeId:        strings.TrimSpace(args[0]),
+					Height:         height,
+					IncludeRecords: includeRecords,
+					IncludeRequest: includeRequest,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addIncludeRecordsFlag(cmd)
+	addIncludeRequestFlag(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // ------------ private funcs for actually querying and outputting ------------
 
 // outputParams calls the Params query and outputs the response.
@@ -16,6 +16,11 @@ func (k *Keeper) GetStoreKey() storetypes.StoreKey {
 	return k.storeKey
 }
 
+// WithoutHistory is a TEST ONLY exposure of withoutHistory.
+func WithoutHistory(ctx sdk.Context) sdk.Context {
+	return withoutHistory(ctx)
+}
+
 // SetAuthKeeper is a TEST ONLY setter for the keeper's authKeeper.
 // It returns the previously defined AuthKeeper
 func (k *Keeper) SetAuthKeeper(authKeeper AuthKeeper) AuthKeeper {
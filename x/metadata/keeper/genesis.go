@@ -10,6 +10,8 @@ import (
 
 // InitGenesis creates the initial genesis state for the metadata module.
 func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+	// The history is imported as-is, so don't record any new entries while setting the scopes and records.
+	ctx = withoutHistory(ctx)
 	k.SetOSLocatorParams(ctx, data.OSLocatorParams)
 	if err := data.Validate(); err != nil {
 		panic(err)
@@ -61,6 +63,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		}
 	}
 
+	for _, entry := range data.ScopeHistory {
+		k.SetScopeHistoryEntry(ctx, entry)
+	}
+	for _, entry := range data.RecordHistory {
+		k.SetRecordHistoryEntry(ctx, entry)
+	}
+
 	for _, mNavs := range data.NetAssetValues {
 		for _, nav := range mNavs.NetAssetValues {
 			address, err := types.MetadataAddressFromBech32(mNavs.Address)
@@ -161,5 +170,26 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		markerNetAssetValues[i] = markerNavs
 	}
 
-	return types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	scopeHistory := make([]types.ScopeHistoryEntry, 0)
+	err := k.IterateScopeHistory(ctx, types.MetadataAddress{}, func(entry types.ScopeHistoryEntry) bool {
+		scopeHistory = append(scopeHistory, entry)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	recordHistory := make([]types.RecordHistoryEntry, 0)
+	err = k.IterateRecordHistory(ctx, types.MetadataAddress{}, func(entry types.RecordHistoryEntry) bool {
+		recordHistory = append(recordHistory, entry)
+		return false
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	rv := types.NewGenesisState(types.Params{}, oslocatorparams, scopes, sessions, records, scopeSpecs, contractSpecs, recordSpecs, objectStoreLocators, markerNetAssetValues)
+	rv.ScopeHistory = scopeHistory
+	rv.RecordHistory = recordHistory
+	return rv
 }
@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// historyChangedByContextKey is the key used in an sdk.Context to set/get the signers recorded in history entries.
+const historyChangedByContextKey = "historyChangedByContextKey"
+
+// historyDisabledContextKey is the key used in an sdk.Context to indicate that history entries should not be recorded.
+const historyDisabledContextKey = "historyDisabledContextKey"
+
+// AddHistoryChangedByToContext returns a new sdk.Context that will have the provided
+// signers recorded as the changed_by in any history entries created with it.
+func AddHistoryChangedByToContext(ctx sdk.Context, changedBy []string) sdk.Context {
+	return ctx.WithValue(historyChangedByContextKey, changedBy)
+}
+
+// getHistoryChangedBy gets the signers to record in history entries from the context.
+func getHistoryChangedBy(ctx sdk.Context) []string {
+	if changedBy, ok := ctx.Value(historyChangedByContextKey).([]string); ok {
+		return changedBy
+	}
+	return nil
+}
+
+// withoutHistory returns a new sdk.Context in which scope and record changes are not recorded in their history.
+// This is used during InitGenesis so that the history can be imported as-is.
+func withoutHistory(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(historyDisabledContextKey, true)
+}
+
+// isHistoryDisabled returns true if scope and record changes should not be recorded in their history.
+func isHistoryDisabled(ctx sdk.Context) bool {
+	disabled, ok := ctx.Value(historyDisabledContextKey).(bool)
+	return ok && disabled
+}
+
+// addScopeHistory records a new history entry for a scope.
+// The oldScope should be nil if the scope is being created.
+// If the scope already existed but has no history yet, a baseline entry with its old state is recorded first.
+// If deleted is true, the newScope should be the scope as it was before being deleted.
+func (k Keeper) addScopeHistory(ctx sdk.Context, oldScope *types.Scope, newScope types.Scope, deleted bool) {
+	if isHistoryDisabled(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.ScopeHistoryKeyPrefixFor(newScope.ScopeId)
+	version := nextHistoryVersion(store, keyPrefix)
+	if version == 1 && (oldScope != nil || deleted) {
+		// The scope existed before its history was recorded, so start with how it was at the end of the last block.
+		baseline := newScope
+		if oldScope != nil {
+			baseline = *oldScope
+		}
+		store.Set(types.ScopeHistoryKey(newScope.ScopeId, version), k.cdc.MustMarshal(&types.ScopeHistoryEntry{
+			ScopeId:     newScope.ScopeId,
+			Version:     version,
+			BlockHeight: ctx.BlockHeight() - 1,
+			Scope:       baseline,
+		}))
+		version++
+	}
+	entry := types.ScopeHistoryEntry{
+		ScopeId:     newScope.ScopeId,
+		Version:     version,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().UTC(),
+		ChangedBy:   getHistoryChangedBy(ctx),
+		Deleted:     deleted,
+		Scope:       newScope,
+	}
+	if !deleted {
+		entry.ChangedFields = types.GetScopeChangedFields(oldScope, newScope)
+	}
+	store.Set(types.ScopeHistoryKey(entry.ScopeId, entry.Version), k.cdc.MustMarshal(&entry))
+	pruneHistory(store, keyPrefix)
+}
+
+// addRecordHistory records a new history entry for a record.
+// The oldRecord should be nil if the record is being created.
+// If the record already existed but has no history yet, a baseline entry with its old state is recorded first.
+// If deleted is true, the newRecord should be the record as it was before being deleted.
+func (k Keeper) addRecordHistory(ctx sdk.Context, recordID types.MetadataAddress, oldRecord *types.Record, newRecord types.Record, deleted bool) {
+	if isHistoryDisabled(ctx) {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := types.RecordHistoryKeyPrefixFor(recordID)
+	version := nextHistoryVersion(store, keyPrefix)
+	if version == 1 && (oldRecord != nil || deleted) {
+		// The record existed before its history was recorded, so start with how it was at the end of the last block.
+		baseline := newRecord
+		if oldRecord != nil {
+			baseline = *oldRecord
+		}
+		store.Set(types.RecordHistoryKey(recordID, version), k.cdc.MustMarshal(&types.RecordHistoryEntry{
+			RecordId:    recordID,
+			Version:     version,
+			BlockHeight: ctx.BlockHeight() - 1,
+			Record:      baseline,
+		}))
+		version++
+	}
+	entry := types.RecordHistoryEntry{
+		RecordId:    recordID,
+		Version:     version,
+		BlockHeight: ctx.BlockHeight(),
+		BlockTime:   ctx.BlockTime().UTC(),
+		ChangedBy:   getHistoryChangedBy(ctx),
+		Deleted:     deleted,
+		Record:      newRecord,
+	}
+	if !deleted {
+		entry.ChangedFields = types.GetRecordChangedFields(oldRecord, newRecord)
+	}
+	store.Set(types.RecordHistoryKey(entry.RecordId, entry.Version), k.cdc.MustMarshal(&entry))
+	pruneHistory(store, keyPrefix)
+}
+
+// nextHistoryVersion gets the version to use for the next history entry with the given key prefix.
+func nextHistoryVersion(store storetypes.KVStore, keyPrefix []byte) uint64 {
+	iter := storetypes.KVStoreReversePrefixIterator(store, keyPrefix)
+	defer iter.Close()
+	if !iter.Valid() {
+		return 1
+	}
+	version, err := types.ParseHistoryKeyVersion(iter.Key())
+	if err != nil {
+		panic(err)
+	}
+	return version + 1
+}
+
+// pruneHistory deletes the oldest history entries with the given key prefix until there are no more than MaxHistoryEntries.
+func pruneHistory(store storetypes.KVStore, keyPrefix []byte) {
+	iter := storetypes.KVStoreReversePrefixIterator(store, keyPrefix)
+	var toDelete [][]byte
+	for count := 0; iter.Valid(); iter.Next() {
+		count++
+		if count > types.MaxHistoryEntries {
+			toDelete = append(toDelete, iter.Key())
+		}
+	}
+	iter.Close()
+	for _, key := range toDelete {
+		store.Delete(key)
+	}
+}
+
+// SetScopeHistoryEntry stores a scope history entry as-is.
+// This is used to import history and does not do any pruning.
+func (k Keeper) SetScopeHistoryEntry(ctx sdk.Context, entry types.ScopeHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ScopeHistoryKey(entry.ScopeId, entry.Version), k.cdc.MustMarshal(&entry))
+}
+
+// SetRecordHistoryEntry stores a record history entry as-is.
+// This is used to import history and does not do any pruning.
+func (k Keeper) SetRecordHistoryEntry(ctx sdk.Context, entry types.RecordHistoryEntry) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RecordHistoryKey(entry.RecordId, entry.Version), k.cdc.MustMarshal(&entry))
+}
+
+// IterateScopeHistory processes the stored scope history entries with the given handler, oldest first.
+// If the scopeID is an empty MetadataAddress, the history of all scopes will be processed.
+// Otherwise, just the history of the given scope will be processed.
+func (k Keeper) IterateScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress, handler func(types.ScopeHistoryEntry) (stop bool)) error {
+	keyPrefix := types.ScopeHistoryKeyPrefix
+	if !scopeID.Empty() {
+		if !scopeID.IsScopeAddress() {
+			return fmt.Errorf("invalid scope id %s: not a scope address", scopeID)
+		}
+		keyPrefix = types.ScopeHistoryKeyPrefixFor(scopeID)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ScopeHistoryEntry
+		if err := k.cdc.Unmarshal(iter.Value(), &entry); err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// IterateRecordHistory processes the stored record history entries with the given handler.
+// Entries are ordered by record id, then version (oldest first).
+// If the id is an empty MetadataAddress, the history of all records will be processed.
+// If the id is a scope id, the history of all records in that scope will be processed.
+// If the id is a record id, just the history of that record will be processed.
+func (k Keeper) IterateRecordHistory(ctx sdk.Context, id types.MetadataAddress, handler func(types.RecordHistoryEntry) (stop bool)) error {
+	keyPrefix := types.RecordHistoryKeyPrefix
+	if !id.Empty() {
+		if !id.IsScopeAddress() && !id.IsRecordAddress() {
+			return fmt.Errorf("invalid id %s: not a scope or record address", id)
+		}
+		keyPrefix = types.RecordHistoryKeyPrefixFor(id)
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var entry types.RecordHistoryEntry
+		if err := k.cdc.Unmarshal(iter.Value(), &entry); err != nil {
+			return err
+		}
+		if handler(entry) {
+			break
+		}
+	}
+	return nil
+}
+
+// isHistoryStart returns true if a history entry with the given version and changed fields was recorded when
+// its scope or record was created. A baseline entry has no changed fields, and an entry with a
+// later version means the earlier ones were pruned; either way, the history before it is not available.
+func isHistoryStart(version uint64, changedFields []string) bool {
+	return version == 1 && len(changedFields) > 0
+}
+
+// GetScopeAtHeight gets the history entry of a scope that was current at the end of the given block height.
+// Returns nil if the scope did not exist at that height.
+// Returns an ErrHistoryNotAvailable error if the scope's history does not go back to that height.
+func (k Keeper) GetScopeAtHeight(ctx sdk.Context, scopeID types.MetadataAddress, height int64) (*types.ScopeHistoryEntry, error) {
+	var first, rv *types.ScopeHistoryEntry
+	err := k.IterateScopeHistory(ctx, scopeID, func(entry types.ScopeHistoryEntry) bool {
+		if first == nil {
+			first = &entry
+		}
+		if entry.BlockHeight > height {
+			return true
+		}
+		rv = &entry
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if first == nil {
+		if _, found := k.GetScope(ctx, scopeID); found {
+			return nil, types.ErrHistoryNotAvailable.Wrapf("scope %s has no history", scopeID)
+		}
+		return nil, nil
+	}
+	if rv == nil && !isHistoryStart(first.Version, first.ChangedFields) {
+		return nil, types.ErrHistoryNotAvailable.Wrapf("scope %s history starts at height %d", scopeID, first.BlockHeight)
+	}
+	if rv == nil || rv.Deleted {
+		return nil, nil
+	}
+	return rv, nil
+}
+
+// GetRecordsAtHeight gets the history entries of the records in a scope that were current
+// at the end of the given block height. Records that did not exist at that height are not included.
+// Returns an ErrHistoryNotAvailable error if the history of any of the scope's records does not go back to that height.
+func (k Keeper) GetRecordsAtHeight(ctx sdk.Context, scopeID types.MetadataAddress, height int64) ([]types.RecordHistoryEntry, error) {
+	if !scopeID.IsScopeAddress() {
+		return nil, fmt.Errorf("invalid scope id %s: not a scope address", scopeID)
+	}
+
+	var rv []types.RecordHistoryEntry
+	var first, cur *types.RecordHistoryEntry
+	var notAvailable error
+	addCur := func() {
+		if first != nil && cur == nil && !isHistoryStart(first.Version, first.ChangedFields) && notAvailable == nil {
+			notAvailable = types.ErrHistoryNotAvailable.Wrapf("record %s history starts at height %d", first.RecordId, first.BlockHeight)
+		}
+		if cur != nil && !cur.Deleted {
+			rv = append(rv, *cur)
+		}
+		first, cur = nil, nil
+	}
+
+	hasHistory := make(map[string]bool)
+	err := k.IterateRecordHistory(ctx, scopeID, func(entry types.RecordHistoryEntry) bool {
+		if first == nil || !bytes.Equal(first.RecordId, entry.RecordId) {
+			addCur()
+			first = &entry
+			hasHistory[string(entry.RecordId)] = true
+		}
+		if entry.BlockHeight <= height {
+			cur = &entry
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	addCur()
+	if notAvailable != nil {
+		return nil, notAvailable
+	}
+
+	err = k.IterateRecords(ctx, scopeID, func(record types.Record) bool {
+		recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
+		if !hasHistory[string(recordID)] {
+			notAvailable = types.ErrHistoryNotAvailable.Wrapf("record %s has no history", recordID)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	if notAvailable != nil {
+		return nil, notAvailable
+	}
+	return rv, nil
+}
@@ -0,0 +1,338 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/metadata/keeper"
+	"github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// getScopeHistory gets all the history entries of the given scope.
+func (s *ScopeKeeperTestSuite) getScopeHistory(ctx sdk.Context, scopeID types.MetadataAddress) []types.ScopeHistoryEntry {
+	var rv []types.ScopeHistoryEntry
+	err := s.app.MetadataKeeper.IterateScopeHistory(ctx, scopeID, func(entry types.ScopeHistoryEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	s.Require().NoError(err, "IterateScopeHistory(%s)", scopeID)
+	return rv
+}
+
+// getRecordHistory gets all the history entries for the given scope or record id.
+func (s *ScopeKeeperTestSuite) getRecordHistory(ctx sdk.Context, id types.MetadataAddress) []types.RecordHistoryEntry {
+	var rv []types.RecordHistoryEntry
+	err := s.app.MetadataKeeper.IterateRecordHistory(ctx, id, func(entry types.RecordHistoryEntry) bool {
+		rv = append(rv, entry)
+		return false
+	})
+	s.Require().NoError(err, "IterateRecordHistory(%s)", id)
+	return rv
+}
+
+// newHistoryRecord creates a new record in the given scope for use in the history tests.
+func (s *ScopeKeeperTestSuite) newHistoryRecord(scopeUUID uuid.UUID, name string, hash string) types.Record {
+	sessionID := types.SessionMetadataAddress(scopeUUID, uuid.New())
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	outputs := []types.RecordOutput{{Hash: hash, Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	return *types.NewRecord(name, sessionID, *process, nil, outputs, types.RecordSpecMetadataAddress(uuid.New(), name))
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeHistory() {
+	ctx := s.FreshCtx().WithBlockHeight(5).WithBlockTime(time.Unix(1_700_000_000, 0))
+	mdKeeper := s.app.MetadataKeeper
+
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), []string{s.user1}, s.user1, false)
+	mdKeeper.SetScope(keeper.AddHistoryChangedByToContext(ctx, []string{s.user1}), scope)
+
+	ctx = ctx.WithBlockHeight(6)
+	scope2 := scope
+	scope2.Owners = ownerPartyList(s.user1, s.user2)
+	scope2.DataAccess = []string{s.user1, s.user2}
+	mdKeeper.SetScope(keeper.AddHistoryChangedByToContext(ctx, []string{s.user1, s.user2}), scope2)
+
+	ctx = ctx.WithBlockHeight(7)
+	mdKeeper.SetScopeValueOwners(ctx, []*types.Scope{&scope2}, s.user3)
+	scope3 := scope2
+	scope3.ValueOwnerAddress = s.user3
+
+	ctx = ctx.WithBlockHeight(8)
+	mdKeeper.RemoveScope(ctx, s.scopeID)
+
+	history := s.getScopeHistory(ctx, s.scopeID)
+	s.Require().Len(history, 4, "history entries")
+
+	s.Assert().Equal(1, int(history[0].Version), "[0].Version")
+	s.Assert().Equal(5, int(history[0].BlockHeight), "[0].BlockHeight")
+	s.Assert().Equal(time.Unix(1_700_000_000, 0).UTC(), history[0].BlockTime, "[0].BlockTime")
+	s.Assert().Equal([]string{s.user1}, history[0].ChangedBy, "[0].ChangedBy")
+	s.Assert().Equal([]string{"specification_id", "owners", "data_access", "value_owner_address"}, history[0].ChangedFields, "[0].ChangedFields")
+	s.Assert().False(history[0].Deleted, "[0].Deleted")
+	s.Assert().Equal(scope, history[0].Scope, "[0].Scope")
+
+	s.Assert().Equal(2, int(history[1].Version), "[1].Version")
+	s.Assert().Equal(6, int(history[1].BlockHeight), "[1].BlockHeight")
+	s.Assert().Equal([]string{s.user1, s.user2}, history[1].ChangedBy, "[1].ChangedBy")
+	s.Assert().Equal([]string{"owners", "data_access"}, history[1].ChangedFields, "[1].ChangedFields")
+	s.Assert().False(history[1].Deleted, "[1].Deleted")
+
+	s.Assert().Equal(3, int(history[2].Version), "[2].Version")
+	s.Assert().Equal(7, int(history[2].BlockHeight), "[2].BlockHeight")
+	s.Assert().Empty(history[2].ChangedBy, "[2].ChangedBy")
+	s.Assert().Equal([]string{"value_owner_address"}, history[2].ChangedFields, "[2].ChangedFields")
+	s.Assert().Equal(scope3, history[2].Scope, "[2].Scope")
+
+	s.Assert().Equal(4, int(history[3].Version), "[3].Version")
+	s.Assert().Equal(8, int(history[3].BlockHeight), "[3].BlockHeight")
+	s.Assert().Empty(history[3].ChangedFields, "[3].ChangedFields")
+	s.Assert().True(history[3].Deleted, "[3].Deleted")
+	s.Assert().Equal(scope3, history[3].Scope, "[3].Scope")
+
+	s.Run("other scope has no history", func() {
+		otherHistory := s.getScopeHistory(ctx, types.ScopeMetadataAddress(uuid.New()))
+		s.Assert().Empty(otherHistory, "history of other scope")
+	})
+
+	s.Run("not a scope id", func() {
+		err := mdKeeper.IterateScopeHistory(ctx, s.scopeSpecID, func(types.ScopeHistoryEntry) bool { return false })
+		s.Assert().EqualError(err, "invalid scope id "+s.scopeSpecID.String()+": not a scope address", "IterateScopeHistory")
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestScopeHistoryPruning() {
+	ctx := s.FreshCtx()
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, "", false)
+	total := types.MaxHistoryEntries + 5
+	for i := 1; i <= total; i++ {
+		scope.ValueOwnerAddress = sdk.AccAddress(fmt.Sprintf("value_owner_%08d", i)).String()
+		s.app.MetadataKeeper.SetScope(ctx.WithBlockHeight(int64(i)), scope)
+	}
+
+	history := s.getScopeHistory(ctx, s.scopeID)
+	s.Require().Len(history, types.MaxHistoryEntries, "history entries")
+	s.Assert().Equal(6, int(history[0].Version), "first version")
+	s.Assert().Equal(total, int(history[len(history)-1].Version), "last version")
+
+	entry, err := s.app.MetadataKeeper.GetScopeAtHeight(ctx, s.scopeID, 3)
+	s.Assert().ErrorIs(err, types.ErrHistoryNotAvailable, "GetScopeAtHeight(3) error")
+	s.Assert().Nil(entry, "GetScopeAtHeight(3) of a pruned height")
+}
+
+func (s *ScopeKeeperTestSuite) TestHistoryBaseline() {
+	ctx := s.FreshCtx().WithBlockHeight(3)
+	mdKeeper := s.app.MetadataKeeper
+
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	record := s.newHistoryRecord(s.scopeUUID, "record1", "hash1")
+	recordID := types.RecordMetadataAddress(s.scopeUUID, "record1")
+	mdKeeper.SetScope(keeper.WithoutHistory(ctx), scope)
+	mdKeeper.SetRecord(keeper.WithoutHistory(ctx), record)
+
+	ctx = ctx.WithBlockHeight(10)
+	_, err := mdKeeper.GetScopeAtHeight(ctx, s.scopeID, 5)
+	s.Assert().ErrorIs(err, types.ErrHistoryNotAvailable, "GetScopeAtHeight before any history")
+	_, err = mdKeeper.GetRecordsAtHeight(ctx, s.scopeID, 5)
+	s.Assert().ErrorIs(err, types.ErrHistoryNotAvailable, "GetRecordsAtHeight before any history")
+
+	scope2 := scope
+	scope2.ValueOwnerAddress = s.user2
+	mdKeeper.SetScope(keeper.AddHistoryChangedByToContext(ctx, []string{s.user1}), scope2)
+	mdKeeper.RemoveRecord(ctx, recordID)
+
+	history := s.getScopeHistory(ctx, s.scopeID)
+	s.Require().Len(history, 2, "scope history entries")
+	s.Assert().Equal(1, int(history[0].Version), "[0].Version")
+	s.Assert().Equal(9, int(history[0].BlockHeight), "[0].BlockHeight")
+	s.Assert().Empty(history[0].ChangedBy, "[0].ChangedBy")
+	s.Assert().Empty(history[0].ChangedFields, "[0].ChangedFields")
+	s.Assert().Equal(scope, history[0].Scope, "[0].Scope")
+	s.Assert().Equal(2, int(history[1].Version), "[1].Version")
+	s.Assert().Equal(10, int(history[1].BlockHeight), "[1].BlockHeight")
+	s.Assert().Equal([]string{"value_owner_address"}, history[1].ChangedFields, "[1].ChangedFields")
+	s.Assert().Equal(scope2, history[1].Scope, "[1].Scope")
+
+	recordHistory := s.getRecordHistory(ctx, recordID)
+	s.Require().Len(recordHistory, 2, "record history entries")
+	s.Assert().Equal(9, int(recordHistory[0].BlockHeight), "record [0].BlockHeight")
+	s.Assert().False(recordHistory[0].Deleted, "record [0].Deleted")
+	s.Assert().Equal(record, recordHistory[0].Record, "record [0].Record")
+	s.Assert().True(recordHistory[1].Deleted, "record [1].Deleted")
+
+	tests := []struct {
+		height     int64
+		expErr     bool
+		expScope   *types.Scope
+		expRecords []types.Record
+	}{
+		{height: 8, expErr: true},
+		{height: 9, expScope: &scope, expRecords: []types.Record{record}},
+		{height: 10, expScope: &scope2},
+	}
+
+	for _, tc := range tests {
+		s.Run(fmt.Sprintf("height %d", tc.height), func() {
+			entry, err := mdKeeper.GetScopeAtHeight(ctx, s.scopeID, tc.height)
+			records, rerr := mdKeeper.GetRecordsAtHeight(ctx, s.scopeID, tc.height)
+			if tc.expErr {
+				s.Assert().ErrorIs(err, types.ErrHistoryNotAvailable, "GetScopeAtHeight error")
+				s.Assert().ErrorIs(rerr, types.ErrHistoryNotAvailable, "GetRecordsAtHeight error")
+				return
+			}
+			s.Require().NoError(err, "GetScopeAtHeight")
+			s.Require().NoError(rerr, "GetRecordsAtHeight")
+			s.Require().NotNil(entry, "GetScopeAtHeight result")
+			s.Assert().Equal(*tc.expScope, entry.Scope, "GetScopeAtHeight result scope")
+			var actual []types.Record
+			for _, re := range records {
+				actual = append(actual, re.Record)
+			}
+			s.Assert().Equal(tc.expRecords, actual, "GetRecordsAtHeight result records")
+		})
+	}
+
+	s.Run("unknown scope did not exist", func() {
+		entry, err := mdKeeper.GetScopeAtHeight(ctx, types.ScopeMetadataAddress(uuid.New()), 5)
+		s.Assert().NoError(err, "GetScopeAtHeight")
+		s.Assert().Nil(entry, "GetScopeAtHeight result")
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestRecordHistory() {
+	ctx := s.FreshCtx().WithBlockHeight(3)
+	mdKeeper := s.app.MetadataKeeper
+
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, "", false)
+	mdKeeper.SetScope(ctx, scope)
+
+	record1 := s.newHistoryRecord(s.scopeUUID, "record1", "hash1")
+	record2 := s.newHistoryRecord(s.scopeUUID, "record2", "hash2")
+	record1ID := types.RecordMetadataAddress(s.scopeUUID, "record1")
+	record2ID := types.RecordMetadataAddress(s.scopeUUID, "record2")
+
+	mdKeeper.SetRecord(keeper.AddHistoryChangedByToContext(ctx, []string{s.user1}), record1)
+	mdKeeper.SetRecord(ctx, record2)
+
+	ctx = ctx.WithBlockHeight(4)
+	record1b := record1
+	record1b.Outputs = []types.RecordOutput{{Hash: "hash1b", Status: types.ResultStatus_RESULT_STATUS_PASS}}
+	mdKeeper.SetRecord(keeper.AddHistoryChangedByToContext(ctx, []string{s.user2}), record1b)
+
+	ctx = ctx.WithBlockHeight(5)
+	mdKeeper.RemoveRecord(ctx, record2ID)
+
+	history1 := s.getRecordHistory(ctx, record1ID)
+	s.Require().Len(history1, 2, "record1 history")
+	s.Assert().Equal(1, int(history1[0].Version), "record1 [0].Version")
+	s.Assert().Equal([]string{s.user1}, history1[0].ChangedBy, "record1 [0].ChangedBy")
+	s.Assert().Equal([]string{"session_id", "process", "outputs", "specification_id"}, history1[0].ChangedFields, "record1 [0].ChangedFields")
+	s.Assert().Equal(record1, history1[0].Record, "record1 [0].Record")
+	s.Assert().Equal(2, int(history1[1].Version), "record1 [1].Version")
+	s.Assert().Equal([]string{s.user2}, history1[1].ChangedBy, "record1 [1].ChangedBy")
+	s.Assert().Equal([]string{"outputs"}, history1[1].ChangedFields, "record1 [1].ChangedFields")
+	s.Assert().Equal(record1b, history1[1].Record, "record1 [1].Record")
+
+	history2 := s.getRecordHistory(ctx, record2ID)
+	s.Require().Len(history2, 2, "record2 history")
+	s.Assert().False(history2[0].Deleted, "record2 [0].Deleted")
+	s.Assert().True(history2[1].Deleted, "record2 [1].Deleted")
+	s.Assert().Equal(5, int(history2[1].BlockHeight), "record2 [1].BlockHeight")
+	s.Assert().Equal(record2, history2[1].Record, "record2 [1].Record")
+
+	scopeRecordHistory := s.getRecordHistory(ctx, s.scopeID)
+	s.Assert().Len(scopeRecordHistory, 4, "history of all records in the scope")
+
+	s.Run("GetRecordsAtHeight", func() {
+		tests := []struct {
+			height int64
+			exp    []types.Record
+		}{
+			{height: 2, exp: nil},
+			{height: 3, exp: []types.Record{record1, record2}},
+			{height: 4, exp: []types.Record{record1b, record2}},
+			{height: 5, exp: []types.Record{record1b}},
+		}
+
+		for _, tc := range tests {
+			s.Run(fmt.Sprintf("height %d", tc.height), func() {
+				entries, err := mdKeeper.GetRecordsAtHeight(ctx, s.scopeID, tc.height)
+				s.Require().NoError(err, "GetRecordsAtHeight")
+				var actual []types.Record
+				for _, entry := range entries {
+					actual = append(actual, entry.Record)
+				}
+				s.Assert().ElementsMatch(tc.exp, actual, "records at height %d", tc.height)
+			})
+		}
+	})
+}
+
+func (s *ScopeKeeperTestSuite) TestGetScopeAtHeight() {
+	ctx := s.FreshCtx().WithBlockHeight(10)
+	mdKeeper := s.app.MetadataKeeper
+
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	mdKeeper.SetScope(ctx, scope)
+	scope2 := scope
+	scope2.ValueOwnerAddress = s.user2
+	mdKeeper.SetScope(ctx.WithBlockHeight(20), scope2)
+	mdKeeper.RemoveScope(ctx.WithBlockHeight(30), s.scopeID)
+
+	tests := []struct {
+		height int64
+		exp    *types.Scope
+	}{
+		{height: 9, exp: nil},
+		{height: 10, exp: &scope},
+		{height: 19, exp: &scope},
+		{height: 20, exp: &scope2},
+		{height: 29, exp: &scope2},
+		{height: 30, exp: nil},
+		{height: 31, exp: nil},
+	}
+
+	for _, tc := range tests {
+		s.Run(fmt.Sprintf("height %d", tc.height), func() {
+			entry, err := mdKeeper.GetScopeAtHeight(ctx, s.scopeID, tc.height)
+			s.Require().NoError(err, "GetScopeAtHeight")
+			if tc.exp == nil {
+				s.Assert().Nil(entry, "GetScopeAtHeight result")
+				return
+			}
+			s.Require().NotNil(entry, "GetScopeAtHeight result")
+			s.Assert().Equal(*tc.exp, entry.Scope, "GetScopeAtHeight result scope")
+		})
+	}
+}
+
+func (s *ScopeKeeperTestSuite) TestHistoryNotRecordedDuringInitGenesis() {
+	ctx := s.FreshCtx()
+	scope := *types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, "", false)
+	entry := types.ScopeHistoryEntry{
+		ScopeId:     s.scopeID,
+		Version:     7,
+		BlockHeight: 3,
+		BlockTime:   time.Unix(1_600_000_000, 0).UTC(),
+		Scope:       scope,
+	}
+	genState := types.DefaultGenesisState()
+	genState.Scopes = []types.Scope{scope}
+	genState.ScopeHistory = []types.ScopeHistoryEntry{entry}
+	s.app.MetadataKeeper.InitGenesis(ctx, genState)
+
+	history := s.getScopeHistory(ctx, s.scopeID)
+	s.Require().Len(history, 1, "history entries")
+	s.Assert().Equal(entry, history[0], "history entry")
+
+	exported := s.app.MetadataKeeper.ExportGenesis(ctx)
+	s.Assert().Equal([]types.ScopeHistoryEntry{entry}, exported.ScopeHistory, "exported scope history")
+	s.Assert().Empty(exported.RecordHistory, "exported record history")
+
+	s.app.MetadataKeeper.SetScope(ctx.WithBlockHeight(9), scope)
+	history = s.getScopeHistory(ctx, s.scopeID)
+	s.Require().Len(history, 2, "history entries after update")
+	s.Assert().Equal(8, int(history[1].Version), "new entry version")
+}
@@ -32,6 +32,7 @@ func (k msgServer) WriteScope(
 ) (*types.MsgWriteScopeResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteScope")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
@@ -63,6 +64,7 @@ func (k msgServer) DeleteScope(
 ) (*types.MsgDeleteScopeResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScope")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	if err := k.ValidateDeleteScope(ctx, msg); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -83,6 +85,7 @@ func (k msgServer) AddScopeDataAccess(
 ) (*types.MsgAddScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddScopeDataAccess")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -108,6 +111,7 @@ func (k msgServer) DeleteScopeDataAccess(
 ) (*types.MsgDeleteScopeDataAccessResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScopeDataAccess")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	existing, found := k.GetScope(ctx, msg.ScopeId)
 	if !found {
@@ -133,6 +137,7 @@ func (k msgServer) AddScopeOwner(
 ) (*types.MsgAddScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "AddScopeOwner")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -166,6 +171,7 @@ func (k msgServer) DeleteScopeOwner(
 ) (*types.MsgDeleteScopeOwnerResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteScopeOwner")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -199,6 +205,7 @@ func (k msgServer) UpdateValueOwners(
 ) (*types.MsgUpdateValueOwnersResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "UpdateValueOwners")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	scopes := make([]*types.Scope, len(msg.ScopeIds))
 	for i, id := range msg.ScopeIds {
@@ -227,6 +234,7 @@ func (k msgServer) MigrateValueOwner(
 ) (*types.MsgMigrateValueOwnerResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "MigrateValueOwner")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	var scopes []*types.Scope
 	err := k.IterateScopesForValueOwner(ctx, msg.Existing, func(scopeID types.MetadataAddress) (stop bool) {
@@ -290,6 +298,7 @@ func (k msgServer) WriteRecord(
 ) (*types.MsgWriteRecordResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "WriteRecord")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	//nolint:errcheck // the error was checked when msg.ValidateBasic was called before getting here.
 	msg.ConvertOptionalFields()
@@ -328,6 +337,7 @@ func (k msgServer) DeleteRecord(
 ) (*types.MsgDeleteRecordResponse, error) {
 	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "tx", "DeleteRecord")
 	ctx := UnwrapMetadataContext(goCtx)
+	ctx = AddHistoryChangedByToContext(ctx, msg.GetSignerStrs())
 
 	if err := k.ValidateDeleteRecord(ctx, msg.RecordId, msg); err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	b64 "encoding/base64"
+	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -1367,6 +1368,133 @@ func (k Keeper) ScopeNetAssetValues(c context.Context, req *types.QueryScopeNetA
 	return &types.QueryScopeNetAssetValuesResponse{NetAssetValues: navs}, nil
 }
 
+// ScopeHistory returns the recorded versions of a scope.
+func (k Keeper) ScopeHistory(c context.Context, req *types.ScopeHistoryRequest) (*types.ScopeHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeHistoryResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScopeHistoryKeyPrefixFor(scopeAddr))
+	retval.Pagination, err = query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var entry types.ScopeHistoryEntry
+		if vErr := k.cdc.Unmarshal(value, &entry); vErr != nil {
+			return vErr
+		}
+		retval.Entries = append(retval.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// RecordHistory returns the recorded versions of a record.
+func (k Keeper) RecordHistory(c context.Context, req *types.RecordHistoryRequest) (*types.RecordHistoryResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "RecordHistory")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.RecordHistoryResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.RecordAddr) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty record address")
+	}
+	recordAddr, err := ParseRecordAddr(req.RecordAddr)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecordHistoryKeyPrefixFor(recordAddr))
+	retval.Pagination, err = query.Paginate(prefixStore, getPageRequest(req), func(_, value []byte) error {
+		var entry types.RecordHistoryEntry
+		if vErr := k.cdc.Unmarshal(value, &entry); vErr != nil {
+			return vErr
+		}
+		retval.Entries = append(retval.Entries, entry)
+		return nil
+	})
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &retval, nil
+}
+
+// ScopeAtHeight returns a scope, and optionally its records, as they were at the end of a block height.
+func (k Keeper) ScopeAtHeight(c context.Context, req *types.ScopeAtHeightRequest) (*types.ScopeAtHeightResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), types.ModuleName, "query", "ScopeAtHeight")
+	if req == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	retval := types.ScopeAtHeightResponse{}
+	if req.IncludeRequest {
+		retval.Request = req
+	}
+
+	if len(req.ScopeId) == 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap("empty scope id")
+	}
+	scopeAddr, err := ParseScopeID(req.ScopeId)
+	if err != nil {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if req.Height <= 0 {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid height %d: must be positive", req.Height)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if req.Height > ctx.BlockHeight() {
+		return &retval, sdkerrors.ErrInvalidRequest.Wrapf("invalid height %d: cannot be after the current height %d", req.Height, ctx.BlockHeight())
+	}
+
+	entry, err := k.GetScopeAtHeight(ctx, scopeAddr, req.Height)
+	if err != nil {
+		if errors.Is(err, types.ErrHistoryNotAvailable) {
+			return &retval, err
+		}
+		return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if entry == nil {
+		return &retval, sdkerrors.ErrNotFound.Wrapf("scope %s not found at height %d", scopeAddr, req.Height)
+	}
+	retval.Scope = *entry
+
+	if req.IncludeRecords {
+		retval.Records, err = k.GetRecordsAtHeight(ctx, scopeAddr, req.Height)
+		if err != nil {
+			if errors.Is(err, types.ErrHistoryNotAvailable) {
+				return &retval, err
+			}
+			return &retval, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	return &retval, nil
+}
+
 // hasPageRequest is just for use with the getPageRequest func below.
 type hasPageRequest interface {
 	GetPagination() *query.PageRequest
@@ -1365,6 +1365,103 @@ func (s *QueryServerTestSuite) TestScopeNetAssetValuesQuery() {
 	}
 }
 
+func (s *QueryServerTestSuite) TestScopeHistoryQueries() {
+	app := s.app
+	ctx := s.ctx.WithBlockHeight(20)
+
+	scope := types.NewScope(s.scopeID, s.scopeSpecID, ownerPartyList(s.user1), nil, s.user1, false)
+	app.MetadataKeeper.SetScope(ctx.WithBlockHeight(10), *scope)
+	process := types.NewProcess("processname", &types.Process_Hash{Hash: "HASH"}, "process_method")
+	record := types.NewRecord(s.recordName, s.sessionID, *process, nil, []types.RecordOutput{}, s.recSpecID)
+	app.MetadataKeeper.SetRecord(ctx.WithBlockHeight(10), *record)
+	scope2 := *scope
+	scope2.ValueOwnerAddress = s.user2
+	app.MetadataKeeper.SetScope(ctx.WithBlockHeight(12), scope2)
+	app.MetadataKeeper.RemoveRecord(ctx.WithBlockHeight(14), s.recordID)
+
+	s.Run("ScopeHistory", func() {
+		_, err := app.MetadataKeeper.ScopeHistory(ctx, nil)
+		s.Assert().EqualError(err, "empty request: invalid request", "nil request")
+		_, err = app.MetadataKeeper.ScopeHistory(ctx, &types.ScopeHistoryRequest{})
+		s.Assert().EqualError(err, "empty scope id: invalid request", "empty scope id")
+
+		resp, err := app.MetadataKeeper.ScopeHistory(ctx, &types.ScopeHistoryRequest{ScopeId: s.scopeUUID.String(), IncludeRequest: true})
+		s.Require().NoError(err, "ScopeHistory")
+		s.Require().Len(resp.Entries, 2, "entries")
+		s.Assert().Equal(*scope, resp.Entries[0].Scope, "entries[0].Scope")
+		s.Assert().Equal(scope2, resp.Entries[1].Scope, "entries[1].Scope")
+		s.Assert().NotNil(resp.Request, "request")
+		s.Assert().NotNil(resp.Pagination, "pagination")
+	})
+
+	s.Run("RecordHistory", func() {
+		_, err := app.MetadataKeeper.RecordHistory(ctx, &types.RecordHistoryRequest{})
+		s.Assert().EqualError(err, "empty record address: invalid request", "empty record addr")
+
+		resp, err := app.MetadataKeeper.RecordHistory(ctx, &types.RecordHistoryRequest{RecordAddr: s.recordID.String()})
+		s.Require().NoError(err, "RecordHistory")
+		s.Require().Len(resp.Entries, 2, "entries")
+		s.Assert().False(resp.Entries[0].Deleted, "entries[0].Deleted")
+		s.Assert().True(resp.Entries[1].Deleted, "entries[1].Deleted")
+		s.Assert().Nil(resp.Request, "request")
+	})
+
+	s.Run("ScopeAtHeight", func() {
+		tests := []struct {
+			name       string
+			req        *types.ScopeAtHeightRequest
+			expErr     string
+			expScope   *types.Scope
+			expRecords int
+		}{
+			{
+				name:   "zero height",
+				req:    &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String()},
+				expErr: "invalid height 0: must be positive: invalid request",
+			},
+			{
+				name:   "future height",
+				req:    &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String(), Height: 21},
+				expErr: "invalid height 21: cannot be after the current height 20: invalid request",
+			},
+			{
+				name:   "before scope existed",
+				req:    &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String(), Height: 9},
+				expErr: "scope " + s.scopeID.String() + " not found at height 9: not found",
+			},
+			{
+				name:       "first version with records",
+				req:        &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String(), Height: 11, IncludeRecords: true},
+				expScope:   scope,
+				expRecords: 1,
+			},
+			{
+				name:     "second version without records",
+				req:      &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String(), Height: 13},
+				expScope: &scope2,
+			},
+			{
+				name:     "after record deleted",
+				req:      &types.ScopeAtHeightRequest{ScopeId: s.scopeID.String(), Height: 20, IncludeRecords: true},
+				expScope: &scope2,
+			},
+		}
+
+		for _, tc := range tests {
+			s.Run(tc.name, func() {
+				resp, err := app.MetadataKeeper.ScopeAtHeight(ctx, tc.req)
+				if len(tc.expErr) > 0 {
+					s.Require().EqualError(err, tc.expErr, "ScopeAtHeight error")
+					return
+				}
+				s.Require().NoError(err, "ScopeAtHeight error")
+				s.Assert().Equal(*tc.expScope, resp.Scope.Scope, "scope")
+				s.Assert().Len(resp.Records, tc.expRecords, "records")
+			})
+		}
+	})
+}
+
 // TODO: OSLocatorParams tests
 // TODO: OSLocator tests
 // TODO: OSLocatorsByURI tests
@@ -62,14 +62,21 @@ func (k Keeper) SetRecord(ctx sdk.Context, record types.Record) {
 
 	recordID := record.SessionId.MustGetAsRecordAddress(record.Name)
 
+	var oldRecord *types.Record
 	var event proto.Message = types.NewEventRecordCreated(recordID, record.SessionId)
 	action := types.TLAction_Created
-	if store.Has(recordID) {
+	if oldRecordBytes := store.Get(recordID); oldRecordBytes != nil {
 		event = types.NewEventRecordUpdated(recordID, record.SessionId)
 		action = types.TLAction_Updated
+		oldRecord = &types.Record{}
+		if err := k.cdc.Unmarshal(oldRecordBytes, oldRecord); err != nil {
+			k.Logger(ctx).Error("could not unmarshal old record", "err", err, "recordId", recordID.String(), "oldRecordBytes", oldRecordBytes)
+			oldRecord = nil
+		}
 	}
 
 	store.Set(recordID, b)
+	k.addRecordHistory(ctx, recordID, oldRecord, record, false)
 	k.EmitEvent(ctx, event)
 	defer types.GetIncObjFunc(types.TLType_Record, action)
 }
@@ -85,6 +92,7 @@ func (k Keeper) RemoveRecord(ctx sdk.Context, id types.MetadataAddress) {
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(id)
+	k.addRecordHistory(ctx, id, &record, record, true)
 	k.EmitEvent(ctx, types.NewEventRecordDeleted(id))
 	defer types.GetIncObjFunc(types.TLType_Record, types.TLAction_Deleted)
 
@@ -124,7 +124,11 @@ func (k Keeper) SetScope(ctx sdk.Context, scope types.Scope) {
 
 	store.Set(scope.ScopeId, b)
 	k.indexScope(store, &scope, oldScope)
+	k.addScopeHistory(ctx, oldScope, scope, false)
 	k.EmitEvent(ctx, event)
+	if oldScope != nil && oldScope.ValueOwnerAddress != scope.ValueOwnerAddress {
+		k.EmitEvent(ctx, types.NewEventScopeValueOwnerChanged(scope.ScopeId, oldScope.ValueOwnerAddress, scope.ValueOwnerAddress))
+	}
 	defer types.GetIncObjFunc(types.TLType_Scope, action)
 }
 
@@ -156,6 +160,7 @@ func (k Keeper) RemoveScope(ctx sdk.Context, id types.MetadataAddress) {
 
 	k.indexScope(store, nil, &scope)
 	store.Delete(id)
+	k.addScopeHistory(ctx, &scope, scope, true)
 	k.EmitEvent(ctx, types.NewEventScopeDeleted(scope.ScopeId))
 	defer types.GetIncObjFunc(types.TLType_Scope, types.TLAction_Deleted)
 }
@@ -176,7 +181,11 @@ func (k Keeper) SetScopeValueOwners(ctx sdk.Context, scopes []*types.Scope, newV
 		b := k.cdc.MustMarshal(&newScope)
 		store.Set(newScope.ScopeId, b)
 		k.indexScope(store, &newScope, oldScope)
+		k.addScopeHistory(ctx, oldScope, newScope, false)
 		k.EmitEvent(ctx, types.NewEventScopeUpdated(oldScope.ScopeId))
+		if oldScope.ValueOwnerAddress != newValueOwner {
+			k.EmitEvent(ctx, types.NewEventScopeValueOwnerChanged(oldScope.ScopeId, oldScope.ValueOwnerAddress, newValueOwner))
+		}
 	}
 	types.GetIncObjFuncN(types.TLType_Scope, types.TLAction_Updated, len(scopes))()
 }
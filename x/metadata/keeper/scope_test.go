@@ -195,6 +195,14 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 		}
 		return event
 	}
+	newValueOwnerChangedEvent := func(scopeID types.MetadataAddress, previousValueOwner, valueOwner string) sdk.Event {
+		tev := types.NewEventScopeValueOwnerChanged(scopeID, previousValueOwner, valueOwner)
+		event, err := sdk.TypedEventToEvent(tev)
+		if err != nil {
+			panic(err)
+		}
+		return event
+	}
 
 	scopes := []*types.Scope{&scopeWOwner, &scopeWDataAccess, &scopeSolo}
 	addrNewValueOwnerAcc := sdk.AccAddress("addrNewValueOwner___")
@@ -207,8 +215,11 @@ func (s *ScopeKeeperTestSuite) TestSetScopeValueOwners() {
 	s.Run("emitted events", func() {
 		expectedEvents := sdk.Events{
 			newUpdateEvent(scopeWOwner.ScopeId),
+			newValueOwnerChangedEvent(scopeWOwner.ScopeId, addrAlsoOwner, addrNewValueOwner),
 			newUpdateEvent(scopeWDataAccess.ScopeId),
+			newValueOwnerChangedEvent(scopeWDataAccess.ScopeId, addrAlsoOwner, addrNewValueOwner),
 			newUpdateEvent(scopeSolo.ScopeId),
+			newValueOwnerChangedEvent(scopeSolo.ScopeId, addrSolo, addrNewValueOwner),
 		}
 		events := ctx.EventManager().Events()
 		s.Assert().Equal(expectedEvents, events, "events emitted during SetScopeValueOwners")
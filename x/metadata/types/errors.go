@@ -14,4 +14,6 @@ var (
 	ErrOSLocatorURIToolong = cerrs.Register(ModuleName, 5, "uri length greater than allowed")
 	ErrNoRecordsFound      = cerrs.Register(ModuleName, 6, "No records found.")
 	ErrOSLocatorURIInvalid = cerrs.Register(ModuleName, 7, "uri is invalid")
+	// ErrHistoryNotAvailable indicates the history of a scope or record does not go back far enough.
+	ErrHistoryNotAvailable = cerrs.Register(ModuleName, 8, "history not available")
 )
@@ -157,6 +157,14 @@ func NewEventScopeDeleted(scopeID MetadataAddress) *EventScopeDeleted {
 	}
 }
 
+func NewEventScopeValueOwnerChanged(scopeID MetadataAddress, previousValueOwner, valueOwner string) *EventScopeValueOwnerChanged {
+	return &EventScopeValueOwnerChanged{
+		ScopeAddr:          scopeID.String(),
+		PreviousValueOwner: previousValueOwner,
+		ValueOwner:         valueOwner,
+	}
+}
+
 func NewEventSessionCreated(sessionID MetadataAddress) *EventSessionCreated {
 	return &EventSessionCreated{
 		SessionAddr: sessionID.String(),
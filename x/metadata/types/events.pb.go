@@ -224,6 +224,70 @@ func (m *EventScopeDeleted) GetScopeAddr() string {
 	return ""
 }
 
+// EventScopeValueOwnerChanged is an event message indicating a scope's value owner has changed.
+type EventScopeValueOwnerChanged struct {
+	// scope_addr is the bech32 address string of the scope id whose value owner changed.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// previous_value_owner is the bech32 address string of the scope's previous value owner.
+	PreviousValueOwner string `protobuf:"bytes,2,opt,name=previous_value_owner,json=previousValueOwner,proto3" json:"previous_value_owner,omitempty"`
+	// value_owner is the bech32 address string of the scope's new value owner.
+	ValueOwner string `protobuf:"bytes,3,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+}
+
+func (m *EventScopeValueOwnerChanged) Reset()         { *m = EventScopeValueOwnerChanged{} }
+func (m *EventScopeValueOwnerChanged) String() string { return proto.CompactTextString(m) }
+func (*EventScopeValueOwnerChanged) ProtoMessage()    {}
+func (*EventScopeValueOwnerChanged) Descriptor() ([]byte, []int) {
+	return fileDescriptor_476cf6cf9459cf25, []int{4}
+}
+func (m *EventScopeValueOwnerChanged) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScopeValueOwnerChanged) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScopeValueOwnerChanged.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScopeValueOwnerChanged) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScopeValueOwnerChanged.Merge(m, src)
+}
+func (m *EventScopeValueOwnerChanged) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScopeValueOwnerChanged) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScopeValueOwnerChanged.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScopeValueOwnerChanged proto.InternalMessageInfo
+
+func (m *EventScopeValueOwnerChanged) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *EventScopeValueOwnerChanged) GetPreviousValueOwner() string {
+	if m != nil {
+		return m.PreviousValueOwner
+	}
+	return ""
+}
+
+func (m *EventScopeValueOwnerChanged) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
 // EventSessionCreated is an event message indicating a session has been created.
 type EventSessionCreated struct {
 	// session_addr is the bech32 address string of the session id that was created.
@@ -236,7 +300,7 @@ func (m *EventSessionCreated) Reset()         { *m = EventSessionCreated{} }
 func (m *EventSessionCreated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionCreated) ProtoMessage()    {}
 func (*EventSessionCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{4}
+	return fileDescriptor_476cf6cf9459cf25, []int{5}
 }
 func (m *EventSessionCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +355,7 @@ func (m *EventSessionUpdated) Reset()         { *m = EventSessionUpdated{} }
 func (m *EventSessionUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventSessionUpdated) ProtoMessage()    {}
 func (*EventSessionUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{5}
+	return fileDescriptor_476cf6cf9459cf25, []int{6}
 }
 func (m *EventSessionUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +410,7 @@ func (m *EventSessionDeleted) Reset()         { *m = EventSessionDeleted{} }
 func (m *EventSessionDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventSessionDeleted) ProtoMessage()    {}
 func (*EventSessionDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{6}
+	return fileDescriptor_476cf6cf9459cf25, []int{7}
 }
 func (m *EventSessionDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +467,7 @@ func (m *EventRecordCreated) Reset()         { *m = EventRecordCreated{} }
 func (m *EventRecordCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordCreated) ProtoMessage()    {}
 func (*EventRecordCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{7}
+	return fileDescriptor_476cf6cf9459cf25, []int{8}
 }
 func (m *EventRecordCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +531,7 @@ func (m *EventRecordUpdated) Reset()         { *m = EventRecordUpdated{} }
 func (m *EventRecordUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordUpdated) ProtoMessage()    {}
 func (*EventRecordUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{8}
+	return fileDescriptor_476cf6cf9459cf25, []int{9}
 }
 func (m *EventRecordUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -529,7 +593,7 @@ func (m *EventRecordDeleted) Reset()         { *m = EventRecordDeleted{} }
 func (m *EventRecordDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordDeleted) ProtoMessage()    {}
 func (*EventRecordDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{9}
+	return fileDescriptor_476cf6cf9459cf25, []int{10}
 }
 func (m *EventRecordDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +647,7 @@ func (m *EventScopeSpecificationCreated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationCreated) ProtoMessage()    {}
 func (*EventScopeSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{10}
+	return fileDescriptor_476cf6cf9459cf25, []int{11}
 }
 func (m *EventScopeSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +694,7 @@ func (m *EventScopeSpecificationUpdated) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationUpdated) ProtoMessage()    {}
 func (*EventScopeSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{11}
+	return fileDescriptor_476cf6cf9459cf25, []int{12}
 }
 func (m *EventScopeSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,7 +741,7 @@ func (m *EventScopeSpecificationDeleted) Reset()         { *m = EventScopeSpecif
 func (m *EventScopeSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventScopeSpecificationDeleted) ProtoMessage()    {}
 func (*EventScopeSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{12}
+	return fileDescriptor_476cf6cf9459cf25, []int{13}
 }
 func (m *EventScopeSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -724,7 +788,7 @@ func (m *EventContractSpecificationCreated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationCreated) ProtoMessage()    {}
 func (*EventContractSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{13}
+	return fileDescriptor_476cf6cf9459cf25, []int{14}
 }
 func (m *EventContractSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +835,7 @@ func (m *EventContractSpecificationUpdated) Reset()         { *m = EventContract
 func (m *EventContractSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationUpdated) ProtoMessage()    {}
 func (*EventContractSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{14}
+	return fileDescriptor_476cf6cf9459cf25, []int{15}
 }
 func (m *EventContractSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -818,7 +882,7 @@ func (m *EventContractSpecificationDeleted) Reset()         { *m = EventContract
 func (m *EventContractSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventContractSpecificationDeleted) ProtoMessage()    {}
 func (*EventContractSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{15}
+	return fileDescriptor_476cf6cf9459cf25, []int{16}
 }
 func (m *EventContractSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -868,7 +932,7 @@ func (m *EventRecordSpecificationCreated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationCreated) ProtoMessage()    {}
 func (*EventRecordSpecificationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{16}
+	return fileDescriptor_476cf6cf9459cf25, []int{17}
 }
 func (m *EventRecordSpecificationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +989,7 @@ func (m *EventRecordSpecificationUpdated) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationUpdated) ProtoMessage()    {}
 func (*EventRecordSpecificationUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{17}
+	return fileDescriptor_476cf6cf9459cf25, []int{18}
 }
 func (m *EventRecordSpecificationUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1046,7 @@ func (m *EventRecordSpecificationDeleted) Reset()         { *m = EventRecordSpec
 func (m *EventRecordSpecificationDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventRecordSpecificationDeleted) ProtoMessage()    {}
 func (*EventRecordSpecificationDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{18}
+	return fileDescriptor_476cf6cf9459cf25, []int{19}
 }
 func (m *EventRecordSpecificationDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1035,7 +1099,7 @@ func (m *EventOSLocatorCreated) Reset()         { *m = EventOSLocatorCreated{} }
 func (m *EventOSLocatorCreated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorCreated) ProtoMessage()    {}
 func (*EventOSLocatorCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{19}
+	return fileDescriptor_476cf6cf9459cf25, []int{20}
 }
 func (m *EventOSLocatorCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1145,7 @@ func (m *EventOSLocatorUpdated) Reset()         { *m = EventOSLocatorUpdated{} }
 func (m *EventOSLocatorUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorUpdated) ProtoMessage()    {}
 func (*EventOSLocatorUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{20}
+	return fileDescriptor_476cf6cf9459cf25, []int{21}
 }
 func (m *EventOSLocatorUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1127,7 +1191,7 @@ func (m *EventOSLocatorDeleted) Reset()         { *m = EventOSLocatorDeleted{} }
 func (m *EventOSLocatorDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventOSLocatorDeleted) ProtoMessage()    {}
 func (*EventOSLocatorDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{21}
+	return fileDescriptor_476cf6cf9459cf25, []int{22}
 }
 func (m *EventOSLocatorDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1174,7 +1238,7 @@ func (m *EventSetNetAssetValue) Reset()         { *m = EventSetNetAssetValue{} }
 func (m *EventSetNetAssetValue) String() string { return proto.CompactTextString(m) }
 func (*EventSetNetAssetValue) ProtoMessage()    {}
 func (*EventSetNetAssetValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_476cf6cf9459cf25, []int{22}
+	return fileDescriptor_476cf6cf9459cf25, []int{23}
 }
 func (m *EventSetNetAssetValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1229,6 +1293,7 @@ func init() {
 	proto.RegisterType((*EventScopeCreated)(nil), "provenance.metadata.v1.EventScopeCreated")
 	proto.RegisterType((*EventScopeUpdated)(nil), "provenance.metadata.v1.EventScopeUpdated")
 	proto.RegisterType((*EventScopeDeleted)(nil), "provenance.metadata.v1.EventScopeDeleted")
+	proto.RegisterType((*EventScopeValueOwnerChanged)(nil), "provenance.metadata.v1.EventScopeValueOwnerChanged")
 	proto.RegisterType((*EventSessionCreated)(nil), "provenance.metadata.v1.EventSessionCreated")
 	proto.RegisterType((*EventSessionUpdated)(nil), "provenance.metadata.v1.EventSessionUpdated")
 	proto.RegisterType((*EventSessionDeleted)(nil), "provenance.metadata.v1.EventSessionDeleted")
@@ -1255,42 +1320,45 @@ func init() {
 }
 
 var fileDescriptor_476cf6cf9459cf25 = []byte{
-	// 556 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0x13, 0x7d, 0x6d, 0x73, 0xfb, 0x2d, 0xc0, 0x40, 0x70, 0x40, 0xb8, 0x3f, 0x6c, 0xba,
-	0xa9, 0xad, 0x02, 0x0b, 0xc4, 0x02, 0xa9, 0x04, 0x16, 0x48, 0x08, 0x50, 0x52, 0x40, 0xea, 0x86,
-	0x4e, 0x67, 0x2e, 0x65, 0x44, 0xe2, 0xb1, 0x66, 0x26, 0x69, 0x79, 0x0b, 0x5e, 0x80, 0xf7, 0x61,
-	0xd9, 0x25, 0x4b, 0x94, 0xbc, 0x08, 0xf2, 0x78, 0x86, 0xb8, 0x8d, 0x8b, 0x81, 0x50, 0x60, 0x79,
-	0x66, 0xee, 0x39, 0xe7, 0xde, 0xe3, 0x9b, 0x68, 0xe0, 0x66, 0x2a, 0xc5, 0x08, 0x13, 0x92, 0x50,
-	0x8c, 0x07, 0xa8, 0x09, 0x23, 0x9a, 0xc4, 0xa3, 0xad, 0x18, 0x47, 0x98, 0x68, 0x15, 0xa5, 0x52,
-	0x68, 0xe1, 0xb7, 0xa6, 0x45, 0x91, 0x2b, 0x8a, 0x46, 0x5b, 0xeb, 0x7b, 0x70, 0xe1, 0x51, 0x56,
-	0xb7, 0x73, 0xd4, 0x11, 0x83, 0xb4, 0x8f, 0x1a, 0x99, 0xdf, 0x82, 0x85, 0x81, 0x60, 0xc3, 0x3e,
-	0x06, 0xde, 0xaa, 0xb7, 0xd1, 0xec, 0x5a, 0xe4, 0x5f, 0x83, 0x25, 0x4c, 0x58, 0x2a, 0x78, 0xa2,
-	0x83, 0xba, 0xb9, 0xf9, 0x86, 0xfd, 0x00, 0x16, 0x15, 0x3f, 0x48, 0x50, 0xaa, 0xa0, 0xb1, 0xda,
-	0xd8, 0x68, 0x76, 0x1d, 0x5c, 0xbf, 0x05, 0x17, 0x8d, 0x43, 0x8f, 0x8a, 0x14, 0x3b, 0x12, 0x49,
-	0x66, 0x71, 0x03, 0x40, 0x65, 0xf8, 0x35, 0x61, 0x4c, 0x5a, 0x9b, 0xa6, 0x39, 0xd9, 0x66, 0x4c,
-	0x9e, 0xe4, 0xbc, 0x48, 0xd9, 0x4f, 0x73, 0x1e, 0x62, 0x3e, 0x4a, 0x05, 0xe7, 0x15, 0x5c, 0xca,
-	0x39, 0xa8, 0x14, 0x17, 0x89, 0xeb, 0x6e, 0x0d, 0xfe, 0x57, 0xf9, 0x49, 0x91, 0xb7, 0x6c, 0xcf,
-	0x32, 0xe6, 0x29, 0xe1, 0x7a, 0x85, 0xb0, 0x1b, 0xe1, 0xb7, 0x0b, 0xbb, 0x39, 0xe7, 0x17, 0x3e,
-	0x04, 0xdf, 0x08, 0x77, 0x91, 0x0a, 0xc9, 0x5c, 0x12, 0x2b, 0xb0, 0x2c, 0xcd, 0x41, 0x51, 0x16,
-	0xf2, 0x23, 0xa3, 0x7a, 0xda, 0xb8, 0x5e, 0x65, 0xdc, 0xf8, 0xbe, 0xb1, 0x4b, 0xea, 0x0f, 0x18,
-	0xef, 0x9c, 0x30, 0x76, 0x49, 0x56, 0x1a, 0x57, 0xa8, 0xee, 0x42, 0x38, 0x5d, 0xc3, 0x5e, 0x8a,
-	0x94, 0xbf, 0xe1, 0x94, 0xe8, 0xc2, 0x76, 0xdd, 0x85, 0x20, 0x17, 0x50, 0xc5, 0xdb, 0xa2, 0x5d,
-	0x4b, 0xcd, 0x90, 0x2b, 0xb4, 0x5d, 0x6c, 0xe7, 0xa1, 0xed, 0x92, 0xf9, 0x75, 0x6d, 0x0a, 0x6b,
-	0x46, 0xbb, 0x23, 0x12, 0x2d, 0x09, 0xd5, 0xa5, 0xb1, 0xdc, 0x87, 0xeb, 0xd4, 0xde, 0x9f, 0xed,
-	0xd0, 0xa6, 0x65, 0x12, 0xd5, 0x26, 0x2e, 0x9f, 0x73, 0x35, 0x71, 0x41, 0xcd, 0x6b, 0xf2, 0xd1,
-	0x83, 0x95, 0xc2, 0x66, 0x96, 0xa6, 0x75, 0x0f, 0xda, 0x76, 0x4d, 0xcf, 0x74, 0xb8, 0x2a, 0x67,
-	0xe9, 0x66, 0x83, 0x2b, 0xfa, 0xab, 0xcf, 0xd3, 0x9f, 0x0b, 0xfa, 0x5f, 0xed, 0xcf, 0x7d, 0xa3,
-	0xbf, 0xd9, 0xdf, 0x26, 0x5c, 0x31, 0xed, 0x3d, 0xeb, 0x3d, 0x11, 0x94, 0x68, 0x21, 0xdd, 0x47,
-	0xbd, 0x0c, 0xff, 0x89, 0xc3, 0x04, 0x5d, 0x03, 0x39, 0x98, 0x2d, 0x77, 0x19, 0xff, 0x60, 0xb9,
-	0x1b, 0xb9, 0xbc, 0x7c, 0xcf, 0x96, 0xf7, 0x50, 0x3f, 0x45, 0xbd, 0xad, 0x14, 0xea, 0x97, 0xa4,
-	0x3f, 0x44, 0xbf, 0x0d, 0x4b, 0xf9, 0xcf, 0x9d, 0x33, 0xcb, 0x58, 0x34, 0xf8, 0xb1, 0x51, 0x4a,
-	0x25, 0xa7, 0x68, 0x47, 0xcd, 0x41, 0xf6, 0x6c, 0x50, 0x62, 0x28, 0x29, 0xda, 0x3f, 0x45, 0x8b,
-	0x1e, 0xbc, 0xfb, 0x34, 0x0e, 0xbd, 0xe3, 0x71, 0xe8, 0x7d, 0x19, 0x87, 0xde, 0x87, 0x49, 0x58,
-	0x3b, 0x9e, 0x84, 0xb5, 0xcf, 0x93, 0xb0, 0x06, 0x6d, 0x2e, 0xa2, 0xf2, 0x77, 0xc9, 0x73, 0x6f,
-	0xf7, 0xce, 0x01, 0xd7, 0x6f, 0x87, 0xfb, 0x11, 0x15, 0x83, 0x78, 0x5a, 0xb4, 0xc9, 0x45, 0x01,
-	0xc5, 0x47, 0xd3, 0x17, 0x8f, 0x7e, 0x9f, 0xa2, 0xda, 0x5f, 0x30, 0xcf, 0x9d, 0xdb, 0x5f, 0x03,
-	0x00, 0x00, 0xff, 0xff, 0xaa, 0xcf, 0x7c, 0x06, 0x15, 0x09, 0x00, 0x00,
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0x5a, 0xb1, 0xad, 0x5f, 0x39, 0x40, 0x18, 0xa5, 0x65, 0x22, 0xdd, 0xca, 0x65, 0x97,
+	0xb5, 0x0c, 0x38, 0x20, 0x0e, 0x48, 0xa3, 0x70, 0x40, 0x42, 0x0c, 0xb5, 0x03, 0xa4, 0x5d, 0xba,
+	0xcc, 0xf9, 0xe8, 0x2c, 0xda, 0x38, 0xb2, 0xdd, 0x6c, 0x3c, 0x05, 0xbc, 0x00, 0xef, 0xc3, 0x71,
+	0x47, 0x8e, 0xa8, 0x7d, 0x11, 0x14, 0xc7, 0x5e, 0xd2, 0x3f, 0x23, 0x83, 0x32, 0xe0, 0xf8, 0xd9,
+	0xbf, 0x7f, 0xf9, 0xc5, 0x6d, 0x0c, 0x77, 0x03, 0xce, 0x42, 0xf4, 0x5d, 0x9f, 0x60, 0x73, 0x80,
+	0xd2, 0xf5, 0x5c, 0xe9, 0x36, 0xc3, 0xed, 0x26, 0x86, 0xe8, 0x4b, 0xd1, 0x08, 0x38, 0x93, 0xcc,
+	0x2e, 0x27, 0xa0, 0x86, 0x01, 0x35, 0xc2, 0xed, 0xfa, 0x01, 0x5c, 0x7b, 0x1e, 0xe1, 0xf6, 0x4e,
+	0x5a, 0x6c, 0x10, 0xf4, 0x51, 0xa2, 0x67, 0x97, 0x61, 0x69, 0xc0, 0xbc, 0x61, 0x1f, 0x2b, 0xd6,
+	0xba, 0xb5, 0x59, 0x6c, 0xeb, 0xc9, 0xbe, 0x0d, 0x2b, 0xe8, 0x7b, 0x01, 0xa3, 0xbe, 0xac, 0xe4,
+	0xd5, 0xce, 0xd9, 0x6c, 0x57, 0x60, 0x59, 0xd0, 0x9e, 0x8f, 0x5c, 0x54, 0x0a, 0xeb, 0x85, 0xcd,
+	0x62, 0xdb, 0x8c, 0xf5, 0xfb, 0x70, 0x5d, 0x39, 0x74, 0x08, 0x0b, 0xb0, 0xc5, 0xd1, 0x8d, 0x2c,
+	0xee, 0x00, 0x88, 0x68, 0xee, 0xba, 0x9e, 0xc7, 0xb5, 0x4d, 0x51, 0xad, 0xec, 0x78, 0x1e, 0x9f,
+	0xe4, 0xbc, 0x09, 0xbc, 0x5f, 0xe6, 0x3c, 0xc3, 0x3e, 0x5e, 0x80, 0xf3, 0xc9, 0x82, 0xb5, 0x84,
+	0xf4, 0xd6, 0xed, 0x0f, 0x71, 0xf7, 0xd8, 0x47, 0xde, 0x3a, 0x72, 0xfd, 0x5e, 0x26, 0xdd, 0xbe,
+	0x07, 0xab, 0x01, 0xc7, 0x90, 0xb2, 0xa1, 0xe8, 0x86, 0x11, 0xb9, 0xcb, 0x22, 0xb6, 0x2e, 0xc7,
+	0x36, 0x7b, 0x89, 0xae, 0x5d, 0x83, 0x52, 0x1a, 0x58, 0x50, 0x40, 0x08, 0xcf, 0x00, 0xf5, 0x77,
+	0x70, 0x23, 0x0e, 0x84, 0x42, 0x50, 0xe6, 0x9b, 0xbe, 0x36, 0xe0, 0xaa, 0x88, 0x57, 0xd2, 0x51,
+	0x4a, 0x7a, 0x4d, 0x85, 0x99, 0xcc, 0x9a, 0x9f, 0x7e, 0xd4, 0x29, 0x61, 0x53, 0xea, 0x1f, 0x17,
+	0x36, 0xcd, 0x2f, 0x2e, 0x7c, 0x0c, 0xb6, 0x12, 0x6e, 0x23, 0x61, 0xdc, 0x33, 0x4d, 0xd4, 0xa0,
+	0xc4, 0xd5, 0x42, 0x5a, 0x16, 0xe2, 0x25, 0xa5, 0x3a, 0x6d, 0x9c, 0xcf, 0x32, 0x2e, 0xfc, 0xdc,
+	0xd8, 0x34, 0xf5, 0x17, 0x8c, 0xf7, 0x26, 0x8c, 0x4d, 0x93, 0x99, 0xc6, 0x19, 0xaa, 0xfb, 0xe0,
+	0x24, 0x67, 0xbc, 0x13, 0x20, 0xa1, 0xef, 0x29, 0x71, 0x65, 0xea, 0x74, 0x3d, 0x82, 0x4a, 0x2c,
+	0x20, 0xd2, 0xbb, 0x69, 0xbb, 0xb2, 0x98, 0x21, 0x67, 0x68, 0x9b, 0xda, 0x2e, 0x43, 0xdb, 0x34,
+	0xf3, 0xfb, 0xda, 0x04, 0x36, 0x94, 0x76, 0x8b, 0xf9, 0x92, 0xbb, 0x44, 0xce, 0xad, 0xe5, 0x09,
+	0xac, 0x11, 0xbd, 0x7f, 0xbe, 0x43, 0x95, 0xcc, 0x93, 0xc8, 0x36, 0x31, 0xfd, 0x5c, 0xaa, 0x89,
+	0x29, 0x6a, 0x51, 0x93, 0x2f, 0x16, 0xd4, 0x52, 0x27, 0x73, 0x6e, 0x5b, 0x8f, 0xa1, 0xaa, 0x8f,
+	0xe9, 0xb9, 0x0e, 0xb7, 0xf8, 0x2c, 0x5d, 0x9d, 0xe0, 0x8c, 0x7c, 0xf9, 0x45, 0xf2, 0x99, 0xa2,
+	0xff, 0xd7, 0x7c, 0xe6, 0x1d, 0xfd, 0xcb, 0x7c, 0x5b, 0x70, 0x53, 0xc5, 0xdb, 0xed, 0xbc, 0x64,
+	0xc4, 0x95, 0x8c, 0x9b, 0x97, 0xba, 0x0a, 0x57, 0xe2, 0x2f, 0x55, 0x1c, 0x20, 0x1e, 0x66, 0xe1,
+	0xa6, 0xe3, 0x0b, 0xc2, 0xcd, 0x23, 0xcf, 0x87, 0x1f, 0x68, 0x78, 0x07, 0xe5, 0x2b, 0x94, 0x3b,
+	0x42, 0xa0, 0x54, 0x5f, 0x50, 0xbb, 0x0a, 0x2b, 0xf1, 0xcf, 0x9d, 0x7a, 0x9a, 0xb1, 0xac, 0xe6,
+	0x17, 0x4a, 0x29, 0xe0, 0x94, 0xa0, 0x7e, 0xd4, 0x78, 0x88, 0x2e, 0x32, 0x82, 0x0d, 0x39, 0x41,
+	0xfd, 0xa7, 0xa8, 0xa7, 0xa7, 0x1f, 0xbe, 0x8e, 0x1c, 0xeb, 0x74, 0xe4, 0x58, 0xdf, 0x47, 0x8e,
+	0xf5, 0x79, 0xec, 0xe4, 0x4e, 0xc7, 0x4e, 0xee, 0xdb, 0xd8, 0xc9, 0x41, 0x95, 0xb2, 0xc6, 0xfc,
+	0x9b, 0xd2, 0x6b, 0x6b, 0xff, 0x61, 0x8f, 0xca, 0xa3, 0xe1, 0x61, 0x83, 0xb0, 0x41, 0x33, 0x01,
+	0x6d, 0x51, 0x96, 0x9a, 0x9a, 0x27, 0xc9, 0x1d, 0x4c, 0x7e, 0x0c, 0x50, 0x1c, 0x2e, 0xa9, 0x0b,
+	0xd8, 0x83, 0x1f, 0x03, 0x00, 0xf9, 0xfa, 0xfb, 0xb1, 0xa7, 0x09, 0x00, 0x00,
 }
 
 func (m *EventTxCompleted) Marshal() (dAtA []byte, err error) {
@@ -1429,6 +1497,50 @@ func (m *EventScopeDeleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventScopeValueOwnerChanged) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScopeValueOwnerChanged) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScopeValueOwnerChanged) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousValueOwner) > 0 {
+		i -= len(m.PreviousValueOwner)
+		copy(dAtA[i:], m.PreviousValueOwner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousValueOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSessionCreated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2163,6 +2275,27 @@ func (m *EventScopeDeleted) Size() (n int) {
 	return n
 }
 
+func (m *EventScopeValueOwnerChanged) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PreviousValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventSessionCreated) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2860,6 +2993,152 @@ func (m *EventScopeDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventScopeValueOwnerChanged) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScopeValueOwnerChanged: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScopeValueOwnerChanged: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventSessionCreated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -38,6 +38,9 @@ type GenesisState struct {
 	ObjectStoreLocators    []ObjectStoreLocator    `protobuf:"bytes,9,rep,name=object_store_locators,json=objectStoreLocators,proto3" json:"object_store_locators"`
 	// Net asset values assigned to scopes
 	NetAssetValues []MarkerNetAssetValues `protobuf:"bytes,10,rep,name=net_asset_values,json=netAssetValues,proto3" json:"net_asset_values"`
+	// The recorded history of scopes and records
+	ScopeHistory  []ScopeHistoryEntry  `protobuf:"bytes,11,rep,name=scope_history,json=scopeHistory,proto3" json:"scope_history"`
+	RecordHistory []RecordHistoryEntry `protobuf:"bytes,12,rep,name=record_history,json=recordHistory,proto3" json:"record_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -124,42 +127,45 @@ func init() {
 }
 
 var fileDescriptor_a835c20198efc302 = []byte{
-	// 549 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x6d, 0x5a, 0x92, 0x74, 0x8b, 0x00, 0x2d, 0x69, 0x31, 0x95, 0x70, 0xaa, 0x88, 0x8a,
-	0xa8, 0x50, 0x5b, 0x2d, 0x9c, 0x00, 0x21, 0xb5, 0x1c, 0xb8, 0x00, 0xad, 0x12, 0xc1, 0xa1, 0x42,
-	0xb2, 0x36, 0x9b, 0x6d, 0x30, 0x4d, 0x3c, 0xd6, 0xce, 0x36, 0x82, 0x37, 0xe0, 0x08, 0x6f, 0xd0,
-	0xc7, 0xe9, 0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0xae, 0xbc, 0x01, 0xca, 0x7a, 0xdd, 0x34, 0x8d,
-	0x37, 0xdc, 0x6c, 0xcf, 0xff, 0xfd, 0xff, 0x8c, 0x3d, 0x5e, 0xf2, 0x20, 0x95, 0x30, 0x10, 0x09,
-	0x4b, 0xb8, 0x08, 0xfb, 0x42, 0xb1, 0x0e, 0x53, 0x2c, 0x1c, 0x6c, 0x87, 0x5d, 0x91, 0x08, 0x8c,
-	0x31, 0x48, 0x25, 0x28, 0xa0, 0xab, 0x13, 0x55, 0x90, 0xab, 0x82, 0xc1, 0xf6, 0x5a, 0xb5, 0x0b,
-	0x5d, 0xd0, 0x92, 0x70, 0x7c, 0x95, 0xa9, 0xd7, 0x36, 0x2c, 0x9e, 0x17, 0x64, 0x26, 0xab, 0x5b,
-	0x64, 0xc8, 0x21, 0x15, 0x46, 0xb3, 0x69, 0xd3, 0xa4, 0x82, 0xc7, 0x47, 0x31, 0x67, 0x2a, 0x86,
-	0xc4, 0x68, 0x1b, 0x16, 0x2d, 0xb4, 0x3f, 0x0b, 0xae, 0x50, 0x81, 0x34, 0xae, 0xf5, 0xbf, 0x25,
-	0x72, 0xe3, 0x75, 0x36, 0x60, 0x4b, 0x31, 0x25, 0xe8, 0x0b, 0x52, 0x4a, 0x99, 0x64, 0x7d, 0xf4,
-	0xdc, 0x75, 0xb7, 0xb1, 0xbc, 0xe3, 0x07, 0xc5, 0x03, 0x07, 0x07, 0x5a, 0xb5, 0xb7, 0x78, 0xf6,
-	0xab, 0xe6, 0x34, 0x0d, 0x43, 0x9f, 0x93, 0x92, 0xee, 0x19, 0xbd, 0x6b, 0xeb, 0x0b, 0x8d, 0xe5,
-	0x9d, 0xfb, 0x36, 0xba, 0x35, 0x56, 0xe5, 0x70, 0x86, 0xd0, 0x5d, 0x52, 0x41, 0x81, 0x18, 0x43,
-	0x82, 0xde, 0x82, 0xc6, 0x6b, 0x56, 0x3c, 0xd3, 0x19, 0x83, 0x0b, 0x8c, 0xbe, 0x24, 0x65, 0x29,
-	0x38, 0xc8, 0x0e, 0x7a, 0x8b, 0xda, 0xc1, 0xda, 0x7e, 0x53, 0xcb, 0x8c, 0x41, 0x0e, 0x51, 0x4e,
-	0xaa, 0xba, 0x99, 0x68, 0xea, 0xad, 0xa2, 0x77, 0x5d, 0x9b, 0x6d, 0xce, 0x9d, 0xa6, 0x75, 0x19,
-	0x31, 0xc6, 0x77, 0x70, 0xa6, 0x82, 0xb4, 0x47, 0xee, 0x72, 0x48, 0x94, 0x64, 0x5c, 0x5d, 0xcd,
-	0x29, 0xe9, 0x9c, 0x2d, 0x5b, 0xce, 0x2b, 0x83, 0x15, 0x45, 0xad, 0xf2, 0xa2, 0x22, 0xd2, 0x23,
-	0xb2, 0x92, 0x4d, 0x77, 0x35, 0xab, 0xac, 0xb3, 0x1e, 0xcd, 0x7f, 0x41, 0x45, 0x49, 0x55, 0x39,
-	0x5b, 0x42, 0x7a, 0x48, 0x28, 0x44, 0x18, 0xf5, 0x80, 0x33, 0x05, 0x32, 0x32, 0x4b, 0x54, 0xd1,
-	0x4b, 0xf4, 0xd0, 0x16, 0xb2, 0xdf, 0x7a, 0x93, 0xe9, 0xa7, 0xb6, 0xe9, 0x16, 0x4c, 0x3f, 0xa6,
-	0x1d, 0xb2, 0x92, 0xad, 0x6e, 0xa4, 0x77, 0x37, 0x0f, 0x41, 0x6f, 0x69, 0xfe, 0x77, 0xd9, 0xd7,
-	0x50, 0x6b, 0xcc, 0x18, 0xc3, 0xfc, 0xbb, 0xc0, 0x4c, 0x05, 0xe9, 0x47, 0x72, 0x3b, 0x11, 0x2a,
-	0x62, 0x88, 0x42, 0x45, 0x03, 0xd6, 0x3b, 0x11, 0xe8, 0x11, 0x1d, 0xf0, 0xd8, 0x16, 0xf0, 0x96,
-	0xc9, 0x63, 0x21, 0xdf, 0x09, 0xb5, 0x3b, 0x86, 0x3e, 0x68, 0xc6, 0x44, 0xdc, 0x4c, 0xa6, 0x9e,
-	0x3e, 0xab, 0x7c, 0x3b, 0xad, 0x39, 0x7f, 0x4e, 0x6b, 0x4e, 0xfd, 0x87, 0x4b, 0xaa, 0x45, 0x20,
-	0xf5, 0x48, 0x99, 0x75, 0x3a, 0x52, 0x60, 0xf6, 0xf3, 0x2d, 0x35, 0xf3, 0x5b, 0xfa, 0xbe, 0xa0,
-	0xb5, 0xec, 0x0f, 0xdb, 0xb0, 0xb5, 0x36, 0xe5, 0xfd, 0xbf, 0x9e, 0xf6, 0x8e, 0xcf, 0x86, 0xbe,
-	0x7b, 0x3e, 0xf4, 0xdd, 0xdf, 0x43, 0xdf, 0xfd, 0x3e, 0xf2, 0x9d, 0xf3, 0x91, 0xef, 0xfc, 0x1c,
-	0xf9, 0x0e, 0xb9, 0x17, 0x83, 0x25, 0xe2, 0xc0, 0x3d, 0x7c, 0xda, 0x8d, 0xd5, 0xa7, 0x93, 0x76,
-	0xc0, 0xa1, 0x1f, 0x4e, 0x44, 0x5b, 0x31, 0x5c, 0xba, 0x0b, 0xbf, 0x4c, 0xce, 0x20, 0xf5, 0x35,
-	0x15, 0xd8, 0x2e, 0xe9, 0xb3, 0xe7, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x60, 0x12,
-	0x92, 0x72, 0x05, 0x00, 0x00,
+	// 595 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xb6, 0xff, 0xf6, 0x4f, 0xd3, 0xe9, 0x05, 0x34, 0xa4, 0xc5, 0x54, 0xc2, 0xa9, 0x22, 0x2a,
+	0x42, 0xa1, 0xb6, 0x5a, 0x58, 0x01, 0x42, 0x6a, 0x11, 0x82, 0x05, 0xd0, 0x2a, 0xe1, 0x22, 0x55,
+	0x48, 0xd6, 0x64, 0x32, 0x4d, 0x4d, 0x13, 0x8f, 0x35, 0x67, 0x1a, 0x91, 0x37, 0x60, 0x09, 0x6f,
+	0x90, 0xc7, 0xe9, 0xb2, 0x4b, 0x56, 0x08, 0x25, 0x1b, 0x1e, 0x03, 0x65, 0x66, 0xdc, 0x24, 0x8d,
+	0xed, 0x9d, 0x3d, 0xe7, 0xbb, 0x9c, 0x9b, 0x0e, 0xba, 0x17, 0x0b, 0xde, 0x65, 0x11, 0x89, 0x28,
+	0xf3, 0x3b, 0x4c, 0x92, 0x26, 0x91, 0xc4, 0xef, 0xee, 0xfa, 0x2d, 0x16, 0x31, 0x08, 0xc1, 0x8b,
+	0x05, 0x97, 0x1c, 0xaf, 0x8f, 0x51, 0x5e, 0x82, 0xf2, 0xba, 0xbb, 0x1b, 0xa5, 0x16, 0x6f, 0x71,
+	0x05, 0xf1, 0x47, 0x5f, 0x1a, 0xbd, 0xb1, 0x95, 0xa1, 0x79, 0xc5, 0xd4, 0xb0, 0x4a, 0x06, 0x0c,
+	0x28, 0x8f, 0x99, 0xc1, 0x6c, 0x67, 0x61, 0x62, 0x46, 0xc3, 0x93, 0x90, 0x12, 0x19, 0xf2, 0xc8,
+	0x60, 0xab, 0x19, 0x58, 0xde, 0xf8, 0xca, 0xa8, 0x04, 0xc9, 0x85, 0x51, 0xad, 0xf4, 0x8b, 0x68,
+	0xf9, 0xb5, 0x2e, 0xb0, 0x2e, 0x89, 0x64, 0xf8, 0x39, 0x2a, 0xc4, 0x44, 0x90, 0x0e, 0x38, 0xf6,
+	0xa6, 0x5d, 0x5d, 0xda, 0x73, 0xbd, 0xf4, 0x82, 0xbd, 0x23, 0x85, 0x3a, 0x98, 0xbf, 0xf8, 0x5d,
+	0xb6, 0x6a, 0x86, 0x83, 0x9f, 0xa1, 0x82, 0xca, 0x19, 0x9c, 0xff, 0x36, 0xe7, 0xaa, 0x4b, 0x7b,
+	0x77, 0xb3, 0xd8, 0xf5, 0x11, 0x2a, 0x21, 0x6b, 0x0a, 0xde, 0x47, 0x45, 0x60, 0x00, 0x21, 0x8f,
+	0xc0, 0x99, 0x53, 0xf4, 0x72, 0x26, 0x5d, 0xe3, 0x8c, 0xc0, 0x15, 0x0d, 0xbf, 0x40, 0x0b, 0x82,
+	0x51, 0x2e, 0x9a, 0xe0, 0xcc, 0x6f, 0xce, 0xe5, 0xa5, 0x5f, 0x53, 0x30, 0x23, 0x90, 0x90, 0x30,
+	0x45, 0x25, 0x95, 0x4c, 0x30, 0xd5, 0x55, 0x70, 0xfe, 0x57, 0x62, 0xdb, 0xb9, 0xd5, 0xd4, 0x27,
+	0x29, 0x46, 0xf8, 0x16, 0xcc, 0x44, 0x00, 0xb7, 0xd1, 0x6d, 0xca, 0x23, 0x29, 0x08, 0x95, 0xd7,
+	0x7d, 0x0a, 0xca, 0x67, 0x27, 0xcb, 0xe7, 0xa5, 0xa1, 0xa5, 0x59, 0xad, 0xd3, 0xb4, 0x20, 0xe0,
+	0x13, 0xb4, 0xa6, 0xab, 0xbb, 0xee, 0xb5, 0xa0, 0xbc, 0x1e, 0xe6, 0x37, 0x28, 0xcd, 0xa9, 0x24,
+	0x66, 0x43, 0x80, 0x8f, 0x11, 0xe6, 0x01, 0x04, 0x6d, 0x4e, 0x89, 0xe4, 0x22, 0x30, 0x4b, 0x54,
+	0x54, 0x4b, 0x74, 0x3f, 0xcb, 0xe4, 0xb0, 0xfe, 0x56, 0xe3, 0xa7, 0xb6, 0xe9, 0x06, 0x9f, 0x7e,
+	0xc6, 0x4d, 0xb4, 0xa6, 0x57, 0x37, 0x50, 0xbb, 0x9b, 0x98, 0x80, 0xb3, 0x98, 0x3f, 0x97, 0x43,
+	0x45, 0xaa, 0x8f, 0x38, 0x46, 0x30, 0x99, 0x0b, 0x9f, 0x89, 0x00, 0xfe, 0x82, 0x6e, 0x46, 0x4c,
+	0x06, 0x04, 0x80, 0xc9, 0xa0, 0x4b, 0xda, 0xe7, 0x0c, 0x1c, 0xa4, 0x0c, 0x1e, 0x65, 0x19, 0xbc,
+	0x23, 0xe2, 0x8c, 0x89, 0xf7, 0x4c, 0xee, 0x8f, 0x48, 0x9f, 0x14, 0xc7, 0x58, 0xac, 0x46, 0x53,
+	0xaf, 0xf8, 0x03, 0x5a, 0xd1, 0xab, 0x75, 0x1a, 0x8e, 0x8a, 0xe8, 0x39, 0x4b, 0x4a, 0xfa, 0x41,
+	0xee, 0x4e, 0xbd, 0xd1, 0xd8, 0x57, 0x91, 0x14, 0x3d, 0xa3, 0xbb, 0x0c, 0x13, 0x01, 0xfc, 0x19,
+	0xad, 0x9a, 0xe9, 0x26, 0xb2, 0xcb, 0xf9, 0x2d, 0xd1, 0x63, 0x4d, 0xd1, 0x5d, 0x11, 0x93, 0x91,
+	0xa7, 0xc5, 0xef, 0xfd, 0xb2, 0xf5, 0xb7, 0x5f, 0xb6, 0x2a, 0x3f, 0x6d, 0x54, 0x4a, 0xab, 0x13,
+	0x3b, 0x68, 0x81, 0x34, 0x9b, 0x82, 0x81, 0xbe, 0x15, 0x8b, 0xb5, 0xe4, 0x17, 0x7f, 0x4c, 0xe9,
+	0xa4, 0x3e, 0x08, 0x5b, 0x59, 0x79, 0x4d, 0x69, 0xa7, 0xb7, 0x70, 0x9c, 0xd3, 0xc1, 0xd9, 0xc5,
+	0xc0, 0xb5, 0x2f, 0x07, 0xae, 0xfd, 0x67, 0xe0, 0xda, 0x3f, 0x86, 0xae, 0x75, 0x39, 0x74, 0xad,
+	0x5f, 0x43, 0xd7, 0x42, 0x77, 0x42, 0x9e, 0x61, 0x71, 0x64, 0x1f, 0x3f, 0x69, 0x85, 0xf2, 0xf4,
+	0xbc, 0xe1, 0x51, 0xde, 0xf1, 0xc7, 0xa0, 0x9d, 0x90, 0x4f, 0xfc, 0xf9, 0xdf, 0xc6, 0x27, 0x53,
+	0xf6, 0x62, 0x06, 0x8d, 0x82, 0x3a, 0x95, 0x8f, 0xff, 0x0d, 0x00, 0x84, 0x86, 0xa0, 0xc8, 0x21,
+	0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -182,6 +188,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RecordHistory) > 0 {
+		for iNdEx := len(m.RecordHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ScopeHistory) > 0 {
+		for iNdEx := len(m.ScopeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScopeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.NetAssetValues) > 0 {
 		for iNdEx := len(m.NetAssetValues) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -430,6 +464,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ScopeHistory) > 0 {
+		for _, e := range m.ScopeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordHistory) > 0 {
+		for _, e := range m.RecordHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -825,6 +871,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScopeHistory = append(m.ScopeHistory, ScopeHistoryEntry{})
+			if err := m.ScopeHistory[len(m.ScopeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordHistory = append(m.RecordHistory, RecordHistoryEntry{})
+			if err := m.RecordHistory[len(m.RecordHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
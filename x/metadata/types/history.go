@@ -0,0 +1,68 @@
+package types
+
+import (
+	"reflect"
+)
+
+// MaxHistoryEntries is the maximum number of history entries kept for each scope and record.
+// Once reached, the oldest entry is removed whenever a new one is added.
+const MaxHistoryEntries = 100
+
+// GetScopeChangedFields returns the names of the fields that differ between the old and new versions of a scope.
+// If the old scope is nil (i.e. the scope is being created), the names of all the set fields are returned.
+func GetScopeChangedFields(oldScope *Scope, newScope Scope) []string {
+	if oldScope == nil {
+		oldScope = &Scope{}
+	}
+	var rv []string
+	if !oldScope.SpecificationId.Equals(newScope.SpecificationId) {
+		rv = append(rv, "specification_id")
+	}
+	if !EqualParties(oldScope.Owners, newScope.Owners) {
+		rv = append(rv, "owners")
+	}
+	if !equivalentDataAssessors(oldScope.DataAccess, newScope.DataAccess) {
+		rv = append(rv, "data_access")
+	}
+	if oldScope.ValueOwnerAddress != newScope.ValueOwnerAddress {
+		rv = append(rv, "value_owner_address")
+	}
+	if oldScope.RequirePartyRollup != newScope.RequirePartyRollup {
+		rv = append(rv, "require_party_rollup")
+	}
+	return rv
+}
+
+// GetRecordChangedFields returns the names of the fields that differ between the old and new versions of a record.
+// If the old record is nil (i.e. the record is being created), the names of all the set fields are returned.
+func GetRecordChangedFields(oldRecord *Record, newRecord Record) []string {
+	if oldRecord == nil {
+		oldRecord = &Record{}
+	}
+	var rv []string
+	if !oldRecord.SessionId.Equals(newRecord.SessionId) {
+		rv = append(rv, "session_id")
+	}
+	if !reflect.DeepEqual(oldRecord.Process, newRecord.Process) {
+		rv = append(rv, "process")
+	}
+	if !equalSlices(oldRecord.Inputs, newRecord.Inputs) {
+		rv = append(rv, "inputs")
+	}
+	if !equalSlices(oldRecord.Outputs, newRecord.Outputs) {
+		rv = append(rv, "outputs")
+	}
+	if !oldRecord.SpecificationId.Equals(newRecord.SpecificationId) {
+		rv = append(rv, "specification_id")
+	}
+	return rv
+}
+
+// equalSlices returns true if the two slices have the same entries in the same order.
+// A nil slice is considered equal to an empty one.
+func equalSlices[T any](s1, s2 []T) bool {
+	if len(s1) == 0 && len(s2) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(s1, s2)
+}
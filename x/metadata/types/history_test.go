@@ -0,0 +1,160 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetScopeChangedFields(t *testing.T) {
+	scopeID := ScopeMetadataAddress(uuid.New())
+	specID := ScopeSpecMetadataAddress(uuid.New())
+	owners := []Party{{Address: "owner1", Role: PartyType_PARTY_TYPE_OWNER}}
+	base := Scope{
+		ScopeId:           scopeID,
+		SpecificationId:   specID,
+		Owners:            owners,
+		DataAccess:        []string{"addr1", "addr2"},
+		ValueOwnerAddress: "valueowner",
+	}
+	withChange := func(modifier func(*Scope)) Scope {
+		rv := base
+		modifier(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name     string
+		oldScope *Scope
+		newScope Scope
+		exp      []string
+	}{
+		{
+			name:     "new scope",
+			oldScope: nil,
+			newScope: base,
+			exp:      []string{"specification_id", "owners", "data_access", "value_owner_address"},
+		},
+		{
+			name:     "no changes",
+			oldScope: &base,
+			newScope: base,
+			exp:      nil,
+		},
+		{
+			name:     "data access reordered",
+			oldScope: &base,
+			newScope: withChange(func(s *Scope) { s.DataAccess = []string{"addr2", "addr1"} }),
+			exp:      nil,
+		},
+		{
+			name:     "new spec",
+			oldScope: &base,
+			newScope: withChange(func(s *Scope) { s.SpecificationId = ScopeSpecMetadataAddress(uuid.New()) }),
+			exp:      []string{"specification_id"},
+		},
+		{
+			name:     "new owner role",
+			oldScope: &base,
+			newScope: withChange(func(s *Scope) { s.Owners = []Party{{Address: "owner1", Role: PartyType_PARTY_TYPE_SERVICER}} }),
+			exp:      []string{"owners"},
+		},
+		{
+			name:     "value owner and rollup",
+			oldScope: &base,
+			newScope: withChange(func(s *Scope) {
+				s.ValueOwnerAddress = ""
+				s.RequirePartyRollup = true
+			}),
+			exp: []string{"value_owner_address", "require_party_rollup"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := GetScopeChangedFields(tc.oldScope, tc.newScope)
+			assert.Equal(t, tc.exp, actual, "GetScopeChangedFields")
+		})
+	}
+}
+
+func TestGetRecordChangedFields(t *testing.T) {
+	scopeUUID := uuid.New()
+	base := Record{
+		Name:      "recname",
+		SessionId: SessionMetadataAddress(scopeUUID, uuid.New()),
+		Process: Process{
+			ProcessId: &Process_Hash{Hash: "processhash"},
+			Name:      "process",
+			Method:    "method",
+		},
+		Inputs: []RecordInput{{
+			Name:     "input",
+			Source:   &RecordInput_Hash{Hash: "inputhash"},
+			TypeName: "type",
+			Status:   RecordInputStatus_Proposed,
+		}},
+		Outputs:         []RecordOutput{{Hash: "outputhash", Status: ResultStatus_RESULT_STATUS_PASS}},
+		SpecificationId: RecordSpecMetadataAddress(uuid.New(), "recname"),
+	}
+	withChange := func(modifier func(*Record)) Record {
+		rv := base
+		modifier(&rv)
+		return rv
+	}
+
+	tests := []struct {
+		name      string
+		oldRecord *Record
+		newRecord Record
+		exp       []string
+	}{
+		{
+			name:      "new record",
+			oldRecord: nil,
+			newRecord: base,
+			exp:       []string{"session_id", "process", "inputs", "outputs", "specification_id"},
+		},
+		{
+			name:      "no changes",
+			oldRecord: &base,
+			newRecord: base,
+			exp:       nil,
+		},
+		{
+			name:      "nil outputs same as empty",
+			oldRecord: &Record{Name: "recname", Outputs: []RecordOutput{}},
+			newRecord: Record{Name: "recname"},
+			exp:       nil,
+		},
+		{
+			name:      "new session",
+			oldRecord: &base,
+			newRecord: withChange(func(r *Record) { r.SessionId = SessionMetadataAddress(scopeUUID, uuid.New()) }),
+			exp:       []string{"session_id"},
+		},
+		{
+			name:      "new process method",
+			oldRecord: &base,
+			newRecord: withChange(func(r *Record) { r.Process.Method = "othermethod" }),
+			exp:       []string{"process"},
+		},
+		{
+			name:      "inputs and outputs",
+			oldRecord: &base,
+			newRecord: withChange(func(r *Record) {
+				r.Inputs = nil
+				r.Outputs = []RecordOutput{{Hash: "otherhash", Status: ResultStatus_RESULT_STATUS_FAIL}}
+			}),
+			exp: []string{"inputs", "outputs"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := GetRecordChangedFields(tc.oldRecord, tc.newRecord)
+			assert.Equal(t, tc.exp, actual, "GetRecordChangedFields")
+		})
+	}
+}
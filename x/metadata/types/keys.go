@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 )
@@ -39,6 +42,10 @@ const (
 //
 // - 0x21<owner_address>: ObjectStoreLocator
 //
+// - 0x24<scope_id><version>: ScopeHistoryEntry
+//
+// - 0x25<record_id><version>: RecordHistoryEntry
+//
 // These keys are used for indexing and more specific iteration.
 // These keys are handled using the stuff in this file.
 // The "..._address" parts are all bytes of an Account Address.
@@ -91,6 +98,11 @@ var (
 
 	// OSLocatorParamPrefix prefix for os locator params
 	OSLocatorParamPrefix = []byte{0x23}
+
+	// ScopeHistoryKeyPrefix is the key for scope history entries
+	ScopeHistoryKeyPrefix = []byte{0x24}
+	// RecordHistoryKeyPrefix is the key for record history entries
+	RecordHistoryKeyPrefix = []byte{0x25}
 )
 
 // GetAddressScopeCacheIteratorPrefix returns an iterator prefix for all scope cache entries assigned to a given address
@@ -167,3 +179,37 @@ func NetAssetValueKeyPrefix(scopeAddr MetadataAddress) []byte {
 func NetAssetValueKey(scopeAddr MetadataAddress, denom string) []byte {
 	return append(NetAssetValueKeyPrefix(scopeAddr), denom...)
 }
+
+// ScopeHistoryKeyPrefixFor returns key [prefix][scope id] for the history entries of a scope
+func ScopeHistoryKeyPrefixFor(scopeID MetadataAddress) []byte {
+	return append(ScopeHistoryKeyPrefix, scopeID.Bytes()...)
+}
+
+// ScopeHistoryKey returns key [prefix][scope id][version] for a scope history entry
+func ScopeHistoryKey(scopeID MetadataAddress, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(ScopeHistoryKeyPrefixFor(scopeID), version)
+}
+
+// RecordHistoryKeyPrefixFor returns key [prefix][record id] for the history entries of a record.
+// If a scope id is provided, the result is the prefix for the history entries of all the records in that scope.
+func RecordHistoryKeyPrefixFor(id MetadataAddress) []byte {
+	if id.IsScopeAddress() {
+		// Record ids start with the record key prefix followed by the scope's uuid.
+		recordsPrefix, _ := id.ScopeRecordIteratorPrefix()
+		return append(RecordHistoryKeyPrefix, recordsPrefix...)
+	}
+	return append(RecordHistoryKeyPrefix, id.Bytes()...)
+}
+
+// RecordHistoryKey returns key [prefix][record id][version] for a record history entry
+func RecordHistoryKey(recordID MetadataAddress, version uint64) []byte {
+	return binary.BigEndian.AppendUint64(RecordHistoryKeyPrefixFor(recordID), version)
+}
+
+// ParseHistoryKeyVersion extracts the version from the end of a history entry key.
+func ParseHistoryKeyVersion(key []byte) (uint64, error) {
+	if len(key) < 8 {
+		return 0, fmt.Errorf("invalid history key %X: too short", key)
+	}
+	return binary.BigEndian.Uint64(key[len(key)-8:]), nil
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/google/uuid"
@@ -30,3 +31,31 @@ func TestNetAssetValueKey(t *testing.T) {
 	assert.Equal(t, scopeAddr.Bytes(), navKey[2:denomArrLen+2], "should match denom key")
 	assert.Equal(t, "nhash", string(navKey[denomArrLen+2:]))
 }
+
+func TestHistoryKeys(t *testing.T) {
+	scopeUUID := uuid.New()
+	scopeID := ScopeMetadataAddress(scopeUUID)
+	recordID := RecordMetadataAddress(scopeUUID, "recordname")
+
+	scopeKey := ScopeHistoryKey(scopeID, 3)
+	assert.Equal(t, ScopeHistoryKeyPrefix[0], scopeKey[0], "scope history key prefix")
+	assert.Equal(t, scopeID.Bytes(), scopeKey[1:len(scopeKey)-8], "scope history key scope id")
+	assert.Equal(t, ScopeHistoryKeyPrefixFor(scopeID), scopeKey[:len(scopeKey)-8], "scope history key prefix for scope")
+
+	recordKey := RecordHistoryKey(recordID, 5)
+	assert.Equal(t, RecordHistoryKeyPrefix[0], recordKey[0], "record history key prefix")
+	assert.Equal(t, recordID.Bytes(), recordKey[1:len(recordKey)-8], "record history key record id")
+	assert.True(t, bytes.HasPrefix(recordKey, RecordHistoryKeyPrefixFor(scopeID)), "record history key should have the scope's prefix")
+	otherScopeID := ScopeMetadataAddress(uuid.New())
+	assert.False(t, bytes.HasPrefix(recordKey, RecordHistoryKeyPrefixFor(otherScopeID)), "record history key should not have another scope's prefix")
+
+	version, err := ParseHistoryKeyVersion(scopeKey)
+	require.NoError(t, err, "ParseHistoryKeyVersion(scopeKey)")
+	assert.Equal(t, 3, int(version), "scope history key version")
+	version, err = ParseHistoryKeyVersion(recordKey)
+	require.NoError(t, err, "ParseHistoryKeyVersion(recordKey)")
+	assert.Equal(t, 5, int(version), "record history key version")
+
+	_, err = ParseHistoryKeyVersion([]byte{0x24, 0x01})
+	assert.EqualError(t, err, "invalid history key 2401: too short", "ParseHistoryKeyVersion short key")
+}
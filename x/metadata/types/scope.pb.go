@@ -807,6 +807,210 @@ func (m *NetAssetValue) GetUpdatedBlockHeight() uint64 {
 	return 0
 }
 
+// ScopeHistoryEntry is a recorded version of a scope, created each time the scope is written or deleted.
+type ScopeHistoryEntry struct {
+	// scope_id is the id of the scope that changed.
+	ScopeId MetadataAddress `protobuf:"bytes,1,opt,name=scope_id,json=scopeId,proto3,customtype=MetadataAddress" json:"scope_id"`
+	// version is the sequence number of this entry for the scope, starting at 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// block_height is the height of the block in which the change was made.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block in which the change was made.
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// changed_by is the signers of the message that made the change.
+	ChangedBy []string `protobuf:"bytes,5,rep,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// changed_fields is the names of the scope fields that are different from the previous version.
+	ChangedFields []string `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// deleted is true if the scope was deleted by this change.
+	Deleted bool `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// scope is the scope as it was after this change, or, if deleted, as it was just before being deleted.
+	Scope Scope `protobuf:"bytes,8,opt,name=scope,proto3" json:"scope"`
+}
+
+func (m *ScopeHistoryEntry) Reset()         { *m = ScopeHistoryEntry{} }
+func (m *ScopeHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ScopeHistoryEntry) ProtoMessage()    {}
+func (*ScopeHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{9}
+}
+func (m *ScopeHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeHistoryEntry.Merge(m, src)
+}
+func (m *ScopeHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeHistoryEntry proto.InternalMessageInfo
+
+func (m *ScopeHistoryEntry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ScopeHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ScopeHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *ScopeHistoryEntry) GetChangedBy() []string {
+	if m != nil {
+		return m.ChangedBy
+	}
+	return nil
+}
+
+func (m *ScopeHistoryEntry) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *ScopeHistoryEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *ScopeHistoryEntry) GetScope() Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return Scope{}
+}
+
+// RecordHistoryEntry is a recorded version of a record, created each time the record is written or deleted.
+type RecordHistoryEntry struct {
+	// record_id is the id of the record that changed.
+	RecordId MetadataAddress `protobuf:"bytes,1,opt,name=record_id,json=recordId,proto3,customtype=MetadataAddress" json:"record_id"`
+	// version is the sequence number of this entry for the record, starting at 1.
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	// block_height is the height of the block in which the change was made.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block in which the change was made.
+	BlockTime time.Time `protobuf:"bytes,4,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// changed_by is the signers of the message that made the change.
+	ChangedBy []string `protobuf:"bytes,5,rep,name=changed_by,json=changedBy,proto3" json:"changed_by,omitempty"`
+	// changed_fields is the names of the record fields that are different from the previous version.
+	ChangedFields []string `protobuf:"bytes,6,rep,name=changed_fields,json=changedFields,proto3" json:"changed_fields,omitempty"`
+	// deleted is true if the record was deleted by this change.
+	Deleted bool `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// record is the record as it was after this change, or, if deleted, as it was just before being deleted.
+	Record Record `protobuf:"bytes,8,opt,name=record,proto3" json:"record"`
+}
+
+func (m *RecordHistoryEntry) Reset()         { *m = RecordHistoryEntry{} }
+func (m *RecordHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*RecordHistoryEntry) ProtoMessage()    {}
+func (*RecordHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edeea634bfb18aba, []int{10}
+}
+func (m *RecordHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecordHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecordHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecordHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecordHistoryEntry.Merge(m, src)
+}
+func (m *RecordHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecordHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecordHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecordHistoryEntry proto.InternalMessageInfo
+
+func (m *RecordHistoryEntry) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RecordHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *RecordHistoryEntry) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *RecordHistoryEntry) GetChangedBy() []string {
+	if m != nil {
+		return m.ChangedBy
+	}
+	return nil
+}
+
+func (m *RecordHistoryEntry) GetChangedFields() []string {
+	if m != nil {
+		return m.ChangedFields
+	}
+	return nil
+}
+
+func (m *RecordHistoryEntry) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func (m *RecordHistoryEntry) GetRecord() Record {
+	if m != nil {
+		return m.Record
+	}
+	return Record{}
+}
+
 func init() {
 	proto.RegisterEnum("provenance.metadata.v1.RecordInputStatus", RecordInputStatus_name, RecordInputStatus_value)
 	proto.RegisterEnum("provenance.metadata.v1.ResultStatus", ResultStatus_name, ResultStatus_value)
@@ -819,6 +1023,8 @@ func init() {
 	proto.RegisterType((*Party)(nil), "provenance.metadata.v1.Party")
 	proto.RegisterType((*AuditFields)(nil), "provenance.metadata.v1.AuditFields")
 	proto.RegisterType((*NetAssetValue)(nil), "provenance.metadata.v1.NetAssetValue")
+	proto.RegisterType((*ScopeHistoryEntry)(nil), "provenance.metadata.v1.ScopeHistoryEntry")
+	proto.RegisterType((*RecordHistoryEntry)(nil), "provenance.metadata.v1.RecordHistoryEntry")
 }
 
 func init() {
@@ -826,78 +1032,87 @@ func init() {
 }
 
 var fileDescriptor_edeea634bfb18aba = []byte{
-	// 1126 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6e, 0x23, 0x45,
-	0x10, 0xf6, 0xf8, 0xdf, 0x65, 0x2f, 0xeb, 0xed, 0x8d, 0x82, 0x63, 0x88, 0x6d, 0x0c, 0x07, 0x13,
-	0x89, 0xf1, 0xc6, 0xb0, 0x48, 0x2c, 0x20, 0x64, 0xe7, 0x87, 0x58, 0x2c, 0x89, 0x35, 0x4e, 0x38,
-	0x70, 0x19, 0x8d, 0x67, 0x3a, 0xf6, 0x28, 0xf6, 0xf4, 0x30, 0xdd, 0xe3, 0x8d, 0xe1, 0xc2, 0x39,
-	0xa7, 0xe5, 0xc6, 0x25, 0x12, 0x3c, 0x05, 0xaf, 0xb0, 0xdc, 0xf6, 0x88, 0x00, 0x2d, 0x28, 0xb9,
-	0xf2, 0x10, 0xa8, 0x7b, 0x7a, 0xfc, 0x43, 0x9c, 0x28, 0x91, 0xb8, 0x4d, 0x55, 0x7d, 0xd5, 0x55,
-	0xfd, 0xd5, 0xd7, 0xdd, 0x03, 0x55, 0xd7, 0x23, 0x63, 0xec, 0x18, 0x8e, 0x89, 0xeb, 0x23, 0xcc,
-	0x0c, 0xcb, 0x60, 0x46, 0x7d, 0xbc, 0x59, 0xa7, 0x26, 0x71, 0xb1, 0xea, 0x7a, 0x84, 0x11, 0xb4,
-	0x3a, 0xc3, 0xa8, 0x21, 0x46, 0x1d, 0x6f, 0x16, 0x4b, 0x26, 0xa1, 0x23, 0x42, 0xeb, 0x3d, 0x83,
-	0xe2, 0xfa, 0x78, 0xb3, 0x87, 0x99, 0xb1, 0x59, 0x37, 0x89, 0xed, 0x04, 0x79, 0xc5, 0x95, 0x3e,
-	0xe9, 0x13, 0xf1, 0x59, 0xe7, 0x5f, 0xd2, 0x5b, 0xee, 0x13, 0xd2, 0x1f, 0xe2, 0xba, 0xb0, 0x7a,
-	0xfe, 0x71, 0x9d, 0xd9, 0x23, 0x4c, 0x99, 0x31, 0x72, 0x25, 0xa0, 0xf2, 0x5f, 0x80, 0x85, 0xa9,
-	0xe9, 0xd9, 0x2e, 0x23, 0x9e, 0x44, 0x6c, 0x5c, 0xd7, 0xb4, 0x8b, 0x4d, 0xfb, 0xd8, 0x36, 0x0d,
-	0x66, 0x13, 0xd9, 0x44, 0xf5, 0xd7, 0x28, 0x24, 0xba, 0x7c, 0x33, 0xa8, 0x01, 0x69, 0xb1, 0x2b,
-	0xdd, 0xb6, 0x0a, 0x4a, 0x45, 0xa9, 0xe5, 0x5a, 0xaf, 0xbf, 0x78, 0x55, 0x8e, 0xfc, 0xfe, 0xaa,
-	0x7c, 0xff, 0x4b, 0xb9, 0x48, 0xd3, 0xb2, 0x3c, 0x4c, 0xa9, 0x96, 0x12, 0xc0, 0xb6, 0x85, 0x5a,
-	0x90, 0x5f, 0x58, 0x94, 0xe7, 0x46, 0x6f, 0xce, 0xbd, 0xbf, 0x90, 0xd0, 0xb6, 0xd0, 0xc7, 0x90,
-	0x24, 0xcf, 0x1c, 0xec, 0xd1, 0x42, 0xac, 0x12, 0xab, 0x65, 0x1b, 0xeb, 0xea, 0x72, 0x3e, 0xd5,
-	0x8e, 0xe1, 0xb1, 0x49, 0x2b, 0xce, 0x17, 0xd6, 0x64, 0x0a, 0x2a, 0x43, 0x96, 0x87, 0x75, 0xc3,
-	0x34, 0x31, 0xa5, 0x85, 0x78, 0x25, 0x56, 0xcb, 0x68, 0x20, 0xea, 0x09, 0x0f, 0x52, 0xe1, 0xe1,
-	0xd8, 0x18, 0xfa, 0x58, 0x17, 0x09, 0xba, 0x11, 0x74, 0x51, 0x48, 0x54, 0x94, 0x5a, 0x46, 0x7b,
-	0x20, 0x42, 0x07, 0x3c, 0x22, 0xdb, 0x43, 0x8f, 0x60, 0xc5, 0xc3, 0xdf, 0xf8, 0xb6, 0x87, 0x75,
-	0x97, 0xd7, 0xd3, 0x3d, 0x32, 0x1c, 0xfa, 0x6e, 0x21, 0x59, 0x51, 0x6a, 0x69, 0x0d, 0xc9, 0x98,
-	0x68, 0x45, 0x13, 0x91, 0x27, 0xe9, 0x1f, 0x7f, 0x2a, 0x47, 0xbe, 0xff, 0xb3, 0xa2, 0x54, 0x7f,
-	0x89, 0x42, 0xaa, 0x8b, 0x29, 0xb5, 0x89, 0x83, 0x3e, 0x04, 0xa0, 0xc1, 0xe7, 0x2d, 0xf8, 0xcc,
-	0x48, 0xe8, 0xff, 0xc4, 0xe8, 0xa7, 0x90, 0xe2, 0xbd, 0xdb, 0xf8, 0x4e, 0x94, 0x86, 0x39, 0x08,
-	0x41, 0xdc, 0x31, 0x46, 0xb8, 0x10, 0x17, 0x1c, 0x89, 0x6f, 0x54, 0x80, 0x94, 0x49, 0x1c, 0x86,
-	0x4f, 0x99, 0xa0, 0x2e, 0xa7, 0x85, 0x26, 0xfa, 0x08, 0x12, 0x86, 0x6f, 0xd9, 0xac, 0x60, 0x56,
-	0x94, 0x5a, 0xb6, 0xf1, 0xf6, 0x75, 0xa5, 0x9a, 0x1c, 0xb4, 0x6b, 0xe3, 0xa1, 0x45, 0xb5, 0x20,
-	0x63, 0x8e, 0xb9, 0x7f, 0xa2, 0x90, 0xd4, 0xb0, 0x49, 0x3c, 0x6b, 0x5a, 0x5d, 0x99, 0xab, 0xbe,
-	0x48, 0x66, 0xf4, 0xd6, 0x64, 0x7e, 0x06, 0x29, 0xd7, 0x23, 0x42, 0x19, 0x31, 0xd1, 0x5d, 0xf9,
-	0x5a, 0x22, 0x02, 0xd8, 0x94, 0x8a, 0xc0, 0x44, 0x4d, 0x48, 0xda, 0x8e, 0xeb, 0xb3, 0x40, 0x59,
-	0x37, 0xec, 0x2e, 0x68, 0xbe, 0xcd, 0xb1, 0xa1, 0x42, 0x83, 0x44, 0xb4, 0x0d, 0x29, 0xe2, 0x33,
-	0xb1, 0x46, 0x42, 0xac, 0xf1, 0xce, 0xcd, 0x6b, 0x1c, 0x08, 0x70, 0xd8, 0x88, 0x4c, 0x5d, 0x2a,
-	0x8b, 0xe4, 0xdd, 0x64, 0x31, 0x47, 0xf7, 0x77, 0x90, 0x92, 0x1b, 0x46, 0x45, 0x48, 0x85, 0x67,
-	0x42, 0x30, 0xbe, 0x17, 0xd1, 0x42, 0x07, 0x5a, 0x81, 0xf8, 0xc0, 0xa0, 0x03, 0x41, 0x38, 0x0f,
-	0x08, 0x6b, 0x3a, 0xa0, 0xd8, 0xdc, 0x80, 0x56, 0x21, 0x39, 0xc2, 0x6c, 0x40, 0x2c, 0x29, 0x1a,
-	0x69, 0x3d, 0x89, 0xf3, 0x92, 0xad, 0x1c, 0x80, 0x24, 0x54, 0xb7, 0xad, 0xea, 0x1f, 0x0a, 0x64,
-	0xe7, 0xe8, 0x5a, 0x3a, 0xf0, 0x06, 0x64, 0x3c, 0x01, 0x99, 0xcd, 0xfb, 0xe1, 0x92, 0x3d, 0xee,
-	0x45, 0xb4, 0x74, 0x80, 0x6b, 0x5b, 0xd3, 0x6e, 0x63, 0x0b, 0xdd, 0xbe, 0x01, 0x19, 0x36, 0x71,
-	0xb1, 0x3e, 0xa7, 0xe8, 0x34, 0x77, 0xec, 0xf3, 0x32, 0x4d, 0x48, 0x52, 0x66, 0x30, 0x3f, 0xb8,
-	0x0f, 0x5e, 0x6b, 0xbc, 0x7b, 0x8b, 0xf1, 0x76, 0x45, 0x82, 0x26, 0x13, 0xe5, 0x0e, 0xd3, 0x90,
-	0xa4, 0xc4, 0xf7, 0x4c, 0x5c, 0x3d, 0x86, 0xdc, 0xfc, 0x1c, 0xf9, 0xee, 0x44, 0x57, 0x72, 0x77,
-	0xa2, 0xa7, 0x4f, 0xa6, 0x65, 0xa3, 0xa2, 0xec, 0x0d, 0x8a, 0xa0, 0xfe, 0x70, 0x69, 0xc5, 0xea,
-	0xb7, 0x90, 0x10, 0x87, 0x97, 0x9f, 0xcc, 0x85, 0x01, 0xce, 0xc6, 0xf7, 0x18, 0xe2, 0x1e, 0x19,
-	0x62, 0x59, 0xe4, 0xad, 0x1b, 0xef, 0x80, 0xc3, 0x89, 0x8b, 0x35, 0x01, 0x47, 0x45, 0x48, 0x13,
-	0x97, 0x4b, 0xc6, 0x18, 0x0a, 0x2e, 0xd3, 0xda, 0xd4, 0x96, 0xb5, 0x7f, 0x88, 0x42, 0x76, 0xee,
-	0x38, 0xa3, 0xcf, 0x21, 0x67, 0x7a, 0xd8, 0x60, 0xd8, 0xd2, 0x2d, 0x83, 0x05, 0x93, 0xcc, 0x36,
-	0x8a, 0x6a, 0xf0, 0x50, 0xa9, 0xe1, 0x43, 0xa5, 0x1e, 0x86, 0x2f, 0x59, 0x2b, 0xcd, 0x45, 0xfb,
-	0xfc, 0xaf, 0xb2, 0xa2, 0x65, 0x65, 0xe6, 0xb6, 0xc1, 0x30, 0x5a, 0x07, 0x08, 0x17, 0xea, 0x4d,
-	0x02, 0xd9, 0x69, 0x19, 0xe9, 0x69, 0x4d, 0x78, 0x1d, 0xdf, 0xb5, 0x66, 0x75, 0x62, 0x77, 0xa9,
-	0x23, 0x33, 0xc3, 0x3a, 0xe1, 0x42, 0xbd, 0x89, 0x54, 0x45, 0x46, 0x7a, 0x5a, 0x82, 0xd2, 0x31,
-	0xf6, 0xf8, 0x1d, 0x22, 0x74, 0x71, 0x4f, 0x0b, 0x4d, 0x1e, 0x19, 0x61, 0x4a, 0x8d, 0x3e, 0x16,
-	0xa7, 0x2f, 0xa3, 0x85, 0x66, 0xf5, 0x14, 0xee, 0xed, 0x63, 0xd6, 0xa4, 0x14, 0xb3, 0xaf, 0xf8,
-	0xa3, 0x82, 0x1e, 0x43, 0xc2, 0xf5, 0x6c, 0x33, 0x64, 0x63, 0x4d, 0x0d, 0xfe, 0x06, 0x54, 0xfe,
-	0x37, 0xa0, 0xca, 0xbf, 0x01, 0x75, 0x8b, 0xd8, 0x8e, 0x3c, 0xea, 0x01, 0x9a, 0xbf, 0x3f, 0xd3,
-	0xd6, 0x86, 0xc4, 0x3c, 0xd1, 0x07, 0xd8, 0xee, 0x0f, 0x98, 0x20, 0x23, 0xae, 0xa1, 0xb0, 0x49,
-	0x1e, 0xda, 0x13, 0x91, 0x8d, 0x9f, 0x15, 0x78, 0x70, 0x45, 0x9f, 0xe8, 0x11, 0x94, 0xb5, 0x9d,
-	0xad, 0x03, 0x6d, 0x5b, 0x6f, 0xef, 0x77, 0x8e, 0x0e, 0xf5, 0xee, 0x61, 0xf3, 0xf0, 0xa8, 0xab,
-	0x1f, 0xed, 0x77, 0x3b, 0x3b, 0x5b, 0xed, 0xdd, 0xf6, 0xce, 0x76, 0x3e, 0x52, 0xcc, 0x9e, 0x9d,
-	0x57, 0x52, 0x47, 0xce, 0x89, 0x43, 0x9e, 0x39, 0x48, 0x85, 0x37, 0x97, 0x65, 0x74, 0xb4, 0x83,
-	0xce, 0x41, 0x77, 0x67, 0x3b, 0xaf, 0x14, 0x73, 0x67, 0xe7, 0x95, 0x74, 0xc7, 0x23, 0x2e, 0xa1,
-	0xd8, 0x42, 0x1b, 0x50, 0x5c, 0x86, 0x0f, 0x7c, 0xf9, 0x68, 0x11, 0xce, 0xce, 0x2b, 0xf2, 0x52,
-	0xdf, 0xf0, 0xf9, 0xa9, 0x98, 0x69, 0x19, 0xad, 0xc3, 0x9a, 0xb6, 0xd3, 0x3d, 0x7a, 0xba, 0xbc,
-	0x2f, 0xb4, 0x0a, 0x68, 0x31, 0xdc, 0x69, 0x76, 0xbb, 0x79, 0xe5, 0xaa, 0xbf, 0xfb, 0x45, 0xbb,
-	0x93, 0x8f, 0x5e, 0xf5, 0xef, 0x36, 0xdb, 0x4f, 0xf3, 0xb1, 0xd6, 0xc9, 0x8b, 0x8b, 0x92, 0xf2,
-	0xf2, 0xa2, 0xa4, 0xfc, 0x7d, 0x51, 0x52, 0x9e, 0x5f, 0x96, 0x22, 0x2f, 0x2f, 0x4b, 0x91, 0xdf,
-	0x2e, 0x4b, 0x11, 0x58, 0xb3, 0xc9, 0x35, 0xe7, 0xa1, 0xa3, 0x7c, 0xfd, 0x41, 0xdf, 0x66, 0x03,
-	0xbf, 0xa7, 0x9a, 0x64, 0x54, 0x9f, 0x81, 0xde, 0xb3, 0xc9, 0x9c, 0x55, 0x3f, 0x9d, 0xfd, 0x5a,
-	0xf1, 0xfb, 0x84, 0xf6, 0x92, 0x42, 0x7f, 0xef, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x61, 0xf7,
-	0xe7, 0xe3, 0x33, 0x0a, 0x00, 0x00,
+	// 1273 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0xfa, 0xbf, 0x9f, 0xdd, 0xd6, 0x9d, 0x56, 0x65, 0x6b, 0xa8, 0xed, 0x1a, 0x90, 0x42,
+	0x24, 0xd6, 0x4d, 0x68, 0x91, 0x28, 0x45, 0xc8, 0x4e, 0x52, 0x62, 0x51, 0x12, 0x6b, 0x9c, 0x70,
+	0xe0, 0xb2, 0x5a, 0xef, 0x4e, 0xec, 0x55, 0xed, 0x9d, 0x65, 0x67, 0x36, 0xad, 0xe1, 0xc2, 0xb9,
+	0xa7, 0x72, 0xe3, 0x52, 0x09, 0x3e, 0x05, 0x5f, 0xa1, 0xdc, 0x7a, 0x44, 0x80, 0x0a, 0x6a, 0x39,
+	0x21, 0x3e, 0x04, 0x9a, 0xd9, 0x59, 0xff, 0xa1, 0x8e, 0x69, 0x10, 0x27, 0x6e, 0xfb, 0xfe, 0xbf,
+	0xf7, 0x7b, 0x6f, 0xe6, 0xcd, 0x42, 0xc3, 0x0f, 0xe8, 0x31, 0xf1, 0x2c, 0xcf, 0x26, 0xcd, 0x31,
+	0xe1, 0x96, 0x63, 0x71, 0xab, 0x79, 0xbc, 0xd1, 0x64, 0x36, 0xf5, 0x89, 0xe1, 0x07, 0x94, 0x53,
+	0x74, 0x69, 0xa6, 0x63, 0xc4, 0x3a, 0xc6, 0xf1, 0x46, 0xa5, 0x6a, 0x53, 0x36, 0xa6, 0xac, 0xd9,
+	0xb7, 0x18, 0x69, 0x1e, 0x6f, 0xf4, 0x09, 0xb7, 0x36, 0x9a, 0x36, 0x75, 0xbd, 0xc8, 0xae, 0x72,
+	0x71, 0x40, 0x07, 0x54, 0x7e, 0x36, 0xc5, 0x97, 0xe2, 0xd6, 0x06, 0x94, 0x0e, 0x46, 0xa4, 0x29,
+	0xa9, 0x7e, 0x78, 0xd4, 0xe4, 0xee, 0x98, 0x30, 0x6e, 0x8d, 0x7d, 0xa5, 0x50, 0xff, 0xbb, 0x82,
+	0x43, 0x98, 0x1d, 0xb8, 0x3e, 0xa7, 0x81, 0xd2, 0x58, 0x3f, 0x29, 0x69, 0x9f, 0xd8, 0xee, 0x91,
+	0x6b, 0x5b, 0xdc, 0xa5, 0x2a, 0x89, 0xc6, 0x0f, 0x49, 0xc8, 0xf4, 0x44, 0x31, 0x68, 0x13, 0xf2,
+	0xb2, 0x2a, 0xd3, 0x75, 0x74, 0xad, 0xae, 0xad, 0x95, 0xda, 0xaf, 0x3c, 0x7e, 0x5a, 0x4b, 0xfc,
+	0xf4, 0xb4, 0x76, 0xee, 0x13, 0xe5, 0xa4, 0xe5, 0x38, 0x01, 0x61, 0x0c, 0xe7, 0xa4, 0x62, 0xc7,
+	0x41, 0x6d, 0x28, 0x2f, 0x38, 0x15, 0xb6, 0xc9, 0xd5, 0xb6, 0xe7, 0x16, 0x0c, 0x3a, 0x0e, 0x7a,
+	0x1f, 0xb2, 0xf4, 0x9e, 0x47, 0x02, 0xa6, 0xa7, 0xea, 0xa9, 0xb5, 0xe2, 0xe6, 0x15, 0x63, 0x39,
+	0x9e, 0x46, 0xd7, 0x0a, 0xf8, 0xa4, 0x9d, 0x16, 0x8e, 0xb1, 0x32, 0x41, 0x35, 0x28, 0x0a, 0xb1,
+	0x69, 0xd9, 0x36, 0x61, 0x4c, 0x4f, 0xd7, 0x53, 0x6b, 0x05, 0x0c, 0x32, 0x9e, 0xe4, 0x20, 0x03,
+	0x2e, 0x1c, 0x5b, 0xa3, 0x90, 0x98, 0xd2, 0xc0, 0xb4, 0xa2, 0x2c, 0xf4, 0x4c, 0x5d, 0x5b, 0x2b,
+	0xe0, 0xf3, 0x52, 0xb4, 0x2f, 0x24, 0x2a, 0x3d, 0x74, 0x0d, 0x2e, 0x06, 0xe4, 0xf3, 0xd0, 0x0d,
+	0x88, 0xe9, 0x8b, 0x78, 0x66, 0x40, 0x47, 0xa3, 0xd0, 0xd7, 0xb3, 0x75, 0x6d, 0x2d, 0x8f, 0x91,
+	0x92, 0xc9, 0x54, 0xb0, 0x94, 0xdc, 0xcc, 0x7f, 0xf3, 0x6d, 0x2d, 0xf1, 0xd5, 0x2f, 0x75, 0xad,
+	0xf1, 0x7d, 0x12, 0x72, 0x3d, 0xc2, 0x98, 0x4b, 0x3d, 0xf4, 0x2e, 0x00, 0x8b, 0x3e, 0x5f, 0x02,
+	0xcf, 0x82, 0x52, 0xfd, 0x8f, 0x10, 0xfd, 0x00, 0x72, 0x22, 0x77, 0x97, 0x9c, 0x0a, 0xd2, 0xd8,
+	0x06, 0x21, 0x48, 0x7b, 0xd6, 0x98, 0xe8, 0x69, 0x89, 0x91, 0xfc, 0x46, 0x3a, 0xe4, 0x6c, 0xea,
+	0x71, 0x72, 0x9f, 0x4b, 0xe8, 0x4a, 0x38, 0x26, 0xd1, 0x7b, 0x90, 0xb1, 0x42, 0xc7, 0xe5, 0xba,
+	0x5d, 0xd7, 0xd6, 0x8a, 0x9b, 0xaf, 0x9f, 0x14, 0xaa, 0x25, 0x94, 0x6e, 0xbb, 0x64, 0xe4, 0x30,
+	0x1c, 0x59, 0xcc, 0x21, 0xf7, 0x67, 0x12, 0xb2, 0x98, 0xd8, 0x34, 0x70, 0xa6, 0xd1, 0xb5, 0xb9,
+	0xe8, 0x8b, 0x60, 0x26, 0x5f, 0x1a, 0xcc, 0x0f, 0x21, 0xe7, 0x07, 0x54, 0x4e, 0x46, 0x4a, 0x66,
+	0x57, 0x3b, 0x11, 0x88, 0x48, 0x6d, 0x0a, 0x45, 0x44, 0xa2, 0x16, 0x64, 0x5d, 0xcf, 0x0f, 0x79,
+	0x34, 0x59, 0x2b, 0xaa, 0x8b, 0x92, 0xef, 0x08, 0xdd, 0x78, 0x42, 0x23, 0x43, 0xb4, 0x0d, 0x39,
+	0x1a, 0x72, 0xe9, 0x23, 0x23, 0x7d, 0xbc, 0xb1, 0xda, 0xc7, 0x7e, 0xc8, 0x67, 0x4e, 0x62, 0xd3,
+	0xa5, 0x63, 0x91, 0x3d, 0xdd, 0x58, 0xcc, 0xc1, 0xfd, 0x25, 0xe4, 0x54, 0xc1, 0xa8, 0x02, 0xb9,
+	0xf8, 0x4c, 0x48, 0xc4, 0x77, 0x13, 0x38, 0x66, 0xa0, 0x8b, 0x90, 0x1e, 0x5a, 0x6c, 0xa8, 0x27,
+	0x95, 0x40, 0x52, 0xd3, 0x06, 0xa5, 0xe6, 0x1a, 0x74, 0x09, 0xb2, 0x63, 0xc2, 0x87, 0xd4, 0x51,
+	0x43, 0xa3, 0xa8, 0x9b, 0x69, 0x11, 0xb2, 0x5d, 0x02, 0x50, 0x80, 0x9a, 0xae, 0xd3, 0xf8, 0x59,
+	0x83, 0xe2, 0x1c, 0x5c, 0x4b, 0x1b, 0xbe, 0x09, 0x85, 0x40, 0xaa, 0xcc, 0xfa, 0x7d, 0x61, 0x49,
+	0x8d, 0xbb, 0x09, 0x9c, 0x8f, 0xf4, 0x3a, 0xce, 0x34, 0xdb, 0xd4, 0x42, 0xb6, 0xaf, 0x42, 0x81,
+	0x4f, 0x7c, 0x62, 0xce, 0x4d, 0x74, 0x5e, 0x30, 0xf6, 0x44, 0x98, 0x16, 0x64, 0x19, 0xb7, 0x78,
+	0x18, 0xdd, 0x07, 0x67, 0x37, 0xdf, 0x7a, 0x89, 0xf6, 0xf6, 0xa4, 0x01, 0x56, 0x86, 0xaa, 0xc2,
+	0x3c, 0x64, 0x19, 0x0d, 0x03, 0x9b, 0x34, 0x8e, 0xa0, 0x34, 0xdf, 0x47, 0x51, 0x9d, 0xcc, 0x4a,
+	0x55, 0x27, 0x73, 0xba, 0x35, 0x0d, 0x9b, 0x94, 0x61, 0x57, 0x4c, 0x04, 0x0b, 0x47, 0x4b, 0x23,
+	0x36, 0xbe, 0x80, 0x8c, 0x3c, 0xbc, 0xe2, 0x64, 0x2e, 0x34, 0x70, 0xd6, 0xbe, 0x1b, 0x90, 0x0e,
+	0xe8, 0x88, 0xa8, 0x20, 0x57, 0x57, 0xde, 0x01, 0x07, 0x13, 0x9f, 0x60, 0xa9, 0x8e, 0x2a, 0x90,
+	0xa7, 0xbe, 0x18, 0x19, 0x6b, 0x24, 0xb1, 0xcc, 0xe3, 0x29, 0xad, 0x62, 0x7f, 0x9d, 0x84, 0xe2,
+	0xdc, 0x71, 0x46, 0x1f, 0x41, 0xc9, 0x0e, 0x88, 0xc5, 0x89, 0x63, 0x3a, 0x16, 0x8f, 0x3a, 0x59,
+	0xdc, 0xac, 0x18, 0xd1, 0xa2, 0x32, 0xe2, 0x45, 0x65, 0x1c, 0xc4, 0x9b, 0xac, 0x9d, 0x17, 0x43,
+	0xfb, 0xf0, 0xd7, 0x9a, 0x86, 0x8b, 0xca, 0x72, 0xdb, 0xe2, 0x04, 0x5d, 0x01, 0x88, 0x1d, 0xf5,
+	0x27, 0xd1, 0xd8, 0xe1, 0x82, 0xe2, 0xb4, 0x27, 0x22, 0x4e, 0xe8, 0x3b, 0xb3, 0x38, 0xa9, 0xd3,
+	0xc4, 0x51, 0x96, 0x71, 0x9c, 0xd8, 0x51, 0x7f, 0xa2, 0xa6, 0xa2, 0xa0, 0x38, 0x6d, 0x09, 0xe9,
+	0x31, 0x09, 0xc4, 0x1d, 0x22, 0xe7, 0xe2, 0x0c, 0x8e, 0x49, 0x21, 0x19, 0x13, 0xc6, 0xac, 0x01,
+	0x91, 0xa7, 0xaf, 0x80, 0x63, 0xb2, 0x71, 0x1f, 0xce, 0xec, 0x11, 0xde, 0x62, 0x8c, 0xf0, 0x4f,
+	0xc5, 0x52, 0x41, 0x37, 0x20, 0xe3, 0x07, 0xae, 0x1d, 0xa3, 0x71, 0xd9, 0x88, 0x5e, 0x03, 0x86,
+	0x78, 0x0d, 0x18, 0xea, 0x35, 0x60, 0x6c, 0x51, 0xd7, 0x53, 0x47, 0x3d, 0xd2, 0x16, 0xfb, 0x67,
+	0x9a, 0xda, 0x88, 0xda, 0x77, 0xcd, 0x21, 0x71, 0x07, 0x43, 0x2e, 0xc1, 0x48, 0x63, 0x14, 0x27,
+	0x29, 0x44, 0xbb, 0x52, 0xd2, 0xf8, 0x3d, 0x09, 0xe7, 0xe5, 0x06, 0xdf, 0x75, 0x19, 0xa7, 0xc1,
+	0x64, 0xc7, 0xe3, 0xc1, 0xe4, 0x5f, 0x6d, 0xf3, 0xb9, 0xba, 0xa3, 0x70, 0xd3, 0xba, 0xaf, 0x42,
+	0x69, 0x21, 0x1b, 0x81, 0x7c, 0x0a, 0x17, 0xfb, 0xb3, 0x34, 0xd0, 0x16, 0x40, 0xa4, 0x22, 0xde,
+	0x2b, 0x7a, 0xfa, 0x14, 0xad, 0x29, 0x48, 0x3b, 0x21, 0x91, 0x03, 0x30, 0xb4, 0xbc, 0x41, 0xd4,
+	0x98, 0x8c, 0xdc, 0xe6, 0x05, 0xc5, 0x69, 0x4f, 0xd0, 0x9b, 0x70, 0x36, 0x16, 0x1f, 0xc9, 0xd1,
+	0xd3, 0xb3, 0x52, 0xe5, 0x8c, 0xe2, 0xaa, 0x79, 0xd4, 0x21, 0xe7, 0x90, 0x11, 0xe1, 0xc4, 0xd1,
+	0x73, 0x72, 0x80, 0x63, 0x52, 0x2c, 0x2b, 0x59, 0xac, 0x9e, 0xaf, 0x6b, 0xab, 0xf6, 0xa2, 0xc4,
+	0x33, 0x6e, 0x8c, 0xb4, 0x68, 0xfc, 0x91, 0x04, 0x14, 0x9d, 0xec, 0x05, 0x9c, 0xaf, 0xcf, 0xdf,
+	0x54, 0xff, 0x00, 0xf4, 0xec, 0xae, 0xfa, 0xff, 0x23, 0x7d, 0x0b, 0xb2, 0x51, 0xb5, 0x0a, 0xea,
+	0xea, 0xea, 0xab, 0x35, 0x5e, 0x9a, 0x91, 0xcd, 0xfa, 0x77, 0x1a, 0x9c, 0x7f, 0xe1, 0xce, 0x45,
+	0xd7, 0xa0, 0x86, 0x77, 0xb6, 0xf6, 0xf1, 0xb6, 0xd9, 0xd9, 0xeb, 0x1e, 0x1e, 0x98, 0xbd, 0x83,
+	0xd6, 0xc1, 0x61, 0xcf, 0x3c, 0xdc, 0xeb, 0x75, 0x77, 0xb6, 0x3a, 0xb7, 0x3b, 0x3b, 0xdb, 0xe5,
+	0x44, 0xa5, 0xf8, 0xe0, 0x51, 0x3d, 0x77, 0xe8, 0xdd, 0xf5, 0xe8, 0x3d, 0x0f, 0x19, 0xf0, 0xda,
+	0x32, 0x8b, 0x2e, 0xde, 0xef, 0xee, 0xf7, 0x76, 0xb6, 0xcb, 0x5a, 0xa5, 0xf4, 0xe0, 0x51, 0x3d,
+	0xdf, 0x0d, 0xa8, 0x4f, 0x19, 0x71, 0xd0, 0x3a, 0x54, 0x96, 0xe9, 0x47, 0xbc, 0x72, 0xb2, 0x02,
+	0x0f, 0x1e, 0xd5, 0xd5, 0x43, 0x65, 0x3d, 0x84, 0xd2, 0xfc, 0xfd, 0x8c, 0xae, 0xc0, 0x65, 0xbc,
+	0xd3, 0x3b, 0xbc, 0xb3, 0x3c, 0x2f, 0x74, 0x09, 0xd0, 0xa2, 0xb8, 0xdb, 0xea, 0xf5, 0xca, 0xda,
+	0x8b, 0xfc, 0xde, 0xc7, 0x9d, 0x6e, 0x39, 0xf9, 0x22, 0xff, 0x76, 0xab, 0x73, 0xa7, 0x9c, 0x6a,
+	0xdf, 0x7d, 0xfc, 0xac, 0xaa, 0x3d, 0x79, 0x56, 0xd5, 0x7e, 0x7b, 0x56, 0xd5, 0x1e, 0x3e, 0xaf,
+	0x26, 0x9e, 0x3c, 0xaf, 0x26, 0x7e, 0x7c, 0x5e, 0x4d, 0xc0, 0x65, 0x97, 0x9e, 0x00, 0x72, 0x57,
+	0xfb, 0xec, 0xfa, 0xc0, 0xe5, 0xc3, 0xb0, 0x6f, 0xd8, 0x74, 0xdc, 0x9c, 0x29, 0xbd, 0xed, 0xd2,
+	0x39, 0xaa, 0x79, 0x7f, 0xf6, 0xbb, 0x20, 0x76, 0x24, 0xeb, 0x67, 0xe5, 0x38, 0xbd, 0xf3, 0xd7,
+	0x00, 0x26, 0x6b, 0x19, 0xa8, 0x07, 0x0d, 0x00, 0x00,
 }
 
 func (m *Scope) Marshal() (dAtA []byte, err error) {
@@ -1497,6 +1712,184 @@ func (m *NetAssetValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScopeHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Scope.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChangedBy) > 0 {
+		for iNdEx := len(m.ChangedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedBy[iNdEx])
+			copy(dAtA[i:], m.ChangedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ChangedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintScope(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ScopeId.Size()
+		i -= size
+		if _, err := m.ScopeId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RecordHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecordHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecordHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Deleted {
+		i--
+		if m.Deleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.ChangedFields) > 0 {
+		for iNdEx := len(m.ChangedFields) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFields[iNdEx])
+			copy(dAtA[i:], m.ChangedFields[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ChangedFields[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ChangedBy) > 0 {
+		for iNdEx := len(m.ChangedBy) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedBy[iNdEx])
+			copy(dAtA[i:], m.ChangedBy[iNdEx])
+			i = encodeVarintScope(dAtA, i, uint64(len(m.ChangedBy[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintScope(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if m.BlockHeight != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Version != 0 {
+		i = encodeVarintScope(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.RecordId.Size()
+		i -= size
+		if _, err := m.RecordId.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintScope(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintScope(dAtA []byte, offset int, v uint64) int {
 	offset -= sovScope(v)
 	base := offset
@@ -1762,28 +2155,100 @@ func (m *NetAssetValue) Size() (n int) {
 	return n
 }
 
-func sovScope(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozScope(x uint64) (n int) {
-	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *Scope) String() string {
-	if this == nil {
-		return "nil"
+func (m *ScopeHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForOwners := "[]Party{"
-	for _, f := range this.Owners {
-		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = m.ScopeId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
 	}
-	repeatedStringForOwners += "}"
-	s := strings.Join([]string{`&Scope{`,
-		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
-		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
-		`Owners:` + repeatedStringForOwners + `,`,
-		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
-		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
-		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
+	if m.BlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.ChangedBy) > 0 {
+		for _, s := range m.ChangedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Deleted {
+		n += 2
+	}
+	l = m.Scope.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func (m *RecordHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RecordId.Size()
+	n += 1 + l + sovScope(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovScope(uint64(m.Version))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovScope(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovScope(uint64(l))
+	if len(m.ChangedBy) > 0 {
+		for _, s := range m.ChangedBy {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if len(m.ChangedFields) > 0 {
+		for _, s := range m.ChangedFields {
+			l = len(s)
+			n += 1 + l + sovScope(uint64(l))
+		}
+	}
+	if m.Deleted {
+		n += 2
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovScope(uint64(l))
+	return n
+}
+
+func sovScope(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScope(x uint64) (n int) {
+	return sovScope(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Scope) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForOwners := "[]Party{"
+	for _, f := range this.Owners {
+		repeatedStringForOwners += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForOwners += "}"
+	s := strings.Join([]string{`&Scope{`,
+		`ScopeId:` + fmt.Sprintf("%v", this.ScopeId) + `,`,
+		`SpecificationId:` + fmt.Sprintf("%v", this.SpecificationId) + `,`,
+		`Owners:` + repeatedStringForOwners + `,`,
+		`DataAccess:` + fmt.Sprintf("%v", this.DataAccess) + `,`,
+		`ValueOwnerAddress:` + fmt.Sprintf("%v", this.ValueOwnerAddress) + `,`,
+		`RequirePartyRollup:` + fmt.Sprintf("%v", this.RequirePartyRollup) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3510,6 +3975,548 @@ func (m *NetAssetValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ScopeHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScopeId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = append(m.ChangedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecordHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScope
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecordId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedBy = append(m.ChangedBy, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFields", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChangedFields = append(m.ChangedFields, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deleted = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScope
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScope
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScope
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScope(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScope
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipScope(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,11 +3,13 @@ package cli
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/provenance-io/provenance/x/msgfees/types"
 )
@@ -25,6 +27,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		AllMsgFeesCmd(),
 		ListParamsCmd(),
+		UsdFeeDenomsCmd(),
+		FeeSponsorshipsCmd(),
+		FeeSponsorshipUsageCmd(),
+		FeeRevenueCmd(),
 	)
 	return queryCmd
 }
@@ -95,3 +101,185 @@ func ListParamsCmd() *cobra.Command {
 
 	return cmd
 }
+
+// UsdFeeDenomsCmd is the CLI command for listing the denoms that can pay usd msg fees.
+func UsdFeeDenomsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "usd-fee-denoms",
+		Aliases: []string{"ufd", "u-f-d"},
+		Short:   "List the denoms that can be used to pay msg fees priced in usd, and their current rates",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var response *types.QueryUsdFeeDenomsResponse
+			if response, err = queryClient.UsdFeeDenoms(
+				context.Background(),
+				&types.QueryUsdFeeDenomsRequest{},
+			); err != nil {
+				fmt.Printf("failed to query usd fee denoms: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FeeSponsorshipsCmd is the CLI command for listing fee sponsorships and their remaining budgets.
+func FeeSponsorshipsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-sponsorships [sponsor]",
+		Aliases: []string{"fs", "f-s"},
+		Short:   "List the fee sponsorships and their remaining budgets, optionally only those of a sponsor",
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			req := &types.QueryFeeSponsorshipsRequest{Pagination: pageReq}
+			if len(args) > 0 {
+				req.Sponsor = args[0]
+			}
+
+			var response *types.QueryFeeSponsorshipsResponse
+			if response, err = queryClient.FeeSponsorships(context.Background(), req); err != nil {
+				fmt.Printf("failed to query fee sponsorships: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee sponsorships")
+
+	return cmd
+}
+
+// FeeSponsorshipUsageCmd is the CLI command for getting how much of a fee sponsorship a fee payer has used today.
+func FeeSponsorshipUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-sponsorship-usage <sponsor> <fee-payer> <msg-type-url>",
+		Aliases: []string{"fsu", "f-s-u"},
+		Short:   "Get a fee sponsorship's remaining budget and how much of its daily cap a fee payer has used today",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			marketID, markerDenom, err := ParseFeeSponsorshipScope(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			var response *types.QueryFeeSponsorshipUsageResponse
+			if response, err = queryClient.FeeSponsorshipUsage(
+				context.Background(),
+				&types.QueryFeeSponsorshipUsageRequest{
+					Sponsor:     args[0],
+					FeePayer:    args[1],
+					MsgTypeUrl:  args[2],
+					MarketId:    marketID,
+					MarkerDenom: markerDenom,
+				},
+			); err != nil {
+				fmt.Printf("failed to query fee sponsorship usage: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	AddFeeSponsorshipScopeFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// FeeRevenueCmd is the CLI command for getting the daily totals of the msg fees sent to the fee collector and other recipients.
+func FeeRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-revenue [start-date [end-date]]",
+		Aliases: []string{"fr", "f-r", "revenue"},
+		Short:   "Get the daily totals of the msg fees sent to the fee collector and other recipients",
+		Long: `Get the daily totals of the msg fees sent to the fee collector and other recipients.
+
+Dates are in the format YYYY-MM-DD (UTC) and are inclusive. If no end date is given, all days since the start date are included.
+Each entry's day is the number of days since 1970-01-01. Entries with an empty recipient are for the fee collector.
+The fee collector and recipients totals include all matching entries, not just the ones on the requested page.`,
+		Example: fmt.Sprintf(`$ %[1]s query msgfees fee-revenue 2024-03-01 2024-03-31
+$ %[1]s query msgfees fee-revenue 2024-03-01 --recipient pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %[1]s query msgfees fee-revenue --msg-type /cosmos.bank.v1beta1.MsgSend
+`, version.AppName),
+		Args: cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeRevenueRequest{}
+			if len(args) > 0 {
+				if req.StartDay, err = ParseDay(args[0]); err != nil {
+					return fmt.Errorf("invalid start date: %w", err)
+				}
+			}
+			if len(args) > 1 {
+				if req.EndDay, err = ParseDay(args[1]); err != nil {
+					return fmt.Errorf("invalid end date: %w", err)
+				}
+			}
+			if req.Recipient, err = cmd.Flags().GetString(FlagRecipient); err != nil {
+				return err
+			}
+			if req.MsgTypeUrl, err = cmd.Flags().GetString(FlagMsgType); err != nil {
+				return err
+			}
+			if req.Pagination, err = client.ReadPageRequestWithPageKeyDecoded(cmd.Flags()); err != nil {
+				return err
+			}
+
+			var response *types.QueryFeeRevenueResponse
+			if response, err = queryClient.FeeRevenue(context.Background(), req); err != nil {
+				fmt.Printf("failed to query fee revenue: %s\n", err.Error())
+				return nil
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	cmd.Flags().String(FlagRecipient, "", "Only include the fees sent to this recipient")
+	cmd.Flags().String(FlagMsgType, "", "Only include the fees for this msg type url")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "fee revenue")
+
+	return cmd
+}
+
+// ParseDay converts a YYYY-MM-DD date into the number of days since the unix epoch.
+func ParseDay(date string) (int64, error) {
+	t, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return 0, err
+	}
+	return types.GetBlockDay(t), nil
+}
@@ -4,11 +4,16 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	sdkmath "cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
@@ -23,6 +28,18 @@ const (
 	FlagMsgType   = "msg-type"
 	FlagRecipient = "recipient"
 	FlagBips      = "bips"
+
+	FlagAmountTiers      = "amount-tiers"
+	FlagMaxFee           = "max-fee"
+	FlagExemptAttributes = "exempt-attributes"
+	FlagFeeFreeWindows   = "fee-free-windows"
+
+	FlagSetUsdFeeDenoms    = "set"
+	FlagRemoveUsdFeeDenoms = "remove"
+
+	FlagMarketID     = "market-id"
+	FlagMarkerDenom  = "marker-denom"
+	FlagDailyUserCap = "daily-user-cap"
 )
 
 func NewTxCmd() *cobra.Command {
@@ -39,6 +56,9 @@ func NewTxCmd() *cobra.Command {
 		GetCmdMsgFeesProposal(),
 		GetUpdateNhashPerUsdMilProposal(),
 		GetUpdateConversionFeeDenomProposal(),
+		GetUpdateUsdFeeDenomsProposal(),
+		GetSetFeeSponsorshipCmd(),
+		GetRemoveFeeSponsorshipCmd(),
 	)
 
 	return txCmd
@@ -52,9 +72,17 @@ func GetCmdMsgFeesProposal() *cobra.Command {
 		Short:   "Submit a msg based fee proposal along with an initial deposit",
 		Long: strings.TrimSpace(`Submit a msg fees proposal along with an initial deposit.
 For add, update, and removal of msg fees amount and min fee and/or rate fee must be set.
+
+Optional conditions can be provided with an add or update:
+  --amount-tiers: <min amount>:<bips> entries that charge bips of the msg amount once it reaches the min amount.
+    The additional fee is then the minimum fee charged.
+  --max-fee: the maximum amount (in the additional fee denom) charged when using amount tiers.
+  --exempt-attributes: attribute names that waive the fee when held by all signers of the msg.
+  --fee-free-windows: <start>/<end> RFC 3339 times during which the fee is waived.
 `),
 		Example: fmt.Sprintf(`$ %[1]s tx msgfees add --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
 $ %[1]s tx msgfees update --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --additional-fee=612000nhash --recipient=pb... --bips=5000 --deposit 1000000000nhash
+$ %[1]s tx msgfees add --msg-type=/cosmos.bank.v1beta1.MsgSend --additional-fee=1000nhash --amount-tiers=0:10 --amount-tiers=1000000000:5 --max-fee=5000000 --exempt-attributes=marketmaker.pb --deposit 1000000000nhash
 $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordRequest --deposit 1000000000nhash
 `, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -94,6 +122,7 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 			}
 
 			var addFee sdk.Coin
+			var conditions types.MsgFeeConditions
 			if proposalType != "remove" {
 				additionalFee, errMinFee := flagSet.GetString(FlagMinFee)
 				if errMinFee != nil {
@@ -105,14 +134,18 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 						return err
 					}
 				}
+				conditions, err = ParseMsgFeeConditions(flagSet)
+				if err != nil {
+					return err
+				}
 			}
 
 			var msg sdk.Msg
 			switch args[0] {
 			case "add":
-				msg = types.NewMsgAddMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				msg = types.NewMsgAddMsgFeeProposalRequest(msgType, addFee, recipient, bips, conditions, authority)
 			case "update":
-				msg = types.NewMsgUpdateMsgFeeProposalRequest(msgType, addFee, recipient, bips, authority)
+				msg = types.NewMsgUpdateMsgFeeProposalRequest(msgType, addFee, recipient, bips, conditions, authority)
 			case "remove":
 				msg = types.NewMsgRemoveMsgFeeProposalRequest(msgType, authority)
 			default:
@@ -128,9 +161,77 @@ $ %[1]s tx msgfees remove --msg-type=/provenance.metadata.v1.MsgWriteRecordReque
 	cmd.Flags().String(FlagMinFee, "", "additional fee for msg based fee")
 	cmd.Flags().String(FlagRecipient, "", "optional recipient address for receiving partial fee based on basis points")
 	cmd.Flags().String(FlagBips, "", "basis fee points to distribute to recipient")
+	cmd.Flags().StringArray(FlagAmountTiers, nil, "amount tier in the format <min amount>:<bips> (repeatable)")
+	cmd.Flags().String(FlagMaxFee, "", "maximum fee amount when using amount tiers")
+	cmd.Flags().StringSlice(FlagExemptAttributes, nil, "attribute names that exempt signers from the fee")
+	cmd.Flags().StringArray(FlagFeeFreeWindows, nil, "fee-free window in the format <start>/<end> using RFC 3339 times (repeatable)")
 	return cmd
 }
 
+// ParseMsgFeeConditions reads the msg fee condition flags.
+func ParseMsgFeeConditions(flagSet *pflag.FlagSet) (types.MsgFeeConditions, error) {
+	rv := types.MsgFeeConditions{MaxFee: sdkmath.ZeroInt()}
+
+	tiers, err := flagSet.GetStringArray(FlagAmountTiers)
+	if err != nil {
+		return rv, err
+	}
+	for _, tier := range tiers {
+		parts := strings.Split(tier, ":")
+		if len(parts) != 2 {
+			return rv, fmt.Errorf("invalid amount tier %q: expected format <min amount>:<bips>", tier)
+		}
+		minAmount, ok := sdkmath.NewIntFromString(strings.TrimSpace(parts[0]))
+		if !ok {
+			return rv, fmt.Errorf("invalid amount tier %q: invalid min amount %q", tier, parts[0])
+		}
+		bips, err := strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 32)
+		if err != nil {
+			return rv, fmt.Errorf("invalid amount tier %q: invalid bips %q: %w", tier, parts[1], err)
+		}
+		rv.AmountTiers = append(rv.AmountTiers, types.NewMsgFeeTier(minAmount, uint32(bips)))
+	}
+
+	maxFee, err := flagSet.GetString(FlagMaxFee)
+	if err != nil {
+		return rv, err
+	}
+	if len(maxFee) > 0 {
+		var ok bool
+		rv.MaxFee, ok = sdkmath.NewIntFromString(maxFee)
+		if !ok {
+			return rv, fmt.Errorf("invalid %s %q", FlagMaxFee, maxFee)
+		}
+	}
+
+	rv.ExemptAttributes, err = flagSet.GetStringSlice(FlagExemptAttributes)
+	if err != nil {
+		return rv, err
+	}
+
+	windows, err := flagSet.GetStringArray(FlagFeeFreeWindows)
+	if err != nil {
+		return rv, err
+	}
+	for _, window := range windows {
+		parts := strings.Split(window, "/")
+		if len(parts) != 2 {
+			return rv, fmt.Errorf("invalid fee-free window %q: expected format <start>/<end>", window)
+		}
+		start, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[0]))
+		if err != nil {
+			return rv, fmt.Errorf("invalid fee-free window %q start: %w", window, err)
+		}
+		end, err := time.Parse(time.RFC3339, strings.TrimSpace(parts[1]))
+		if err != nil {
+			return rv, fmt.Errorf("invalid fee-free window %q end: %w", window, err)
+		}
+		rv.FeeFreeWindows = append(rv.FeeFreeWindows, types.NewFeeFreeWindow(start.UTC(), end.UTC()))
+	}
+
+	return rv, nil
+}
+
 func GetUpdateNhashPerUsdMilProposal() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "nhash-per-usd-mil <nhash-per-usd-mil>",
@@ -194,3 +295,180 @@ $ %[1]s tx msgfees cfd customcoin --deposit 1000000000nhash
 	provcli.AddAuthorityFlagToCmd(cmd)
 	return cmd
 }
+
+func GetUpdateUsdFeeDenomsProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "usd-fee-denoms",
+		Aliases: []string{"ufd", "u-f-d"},
+		Args:    cobra.NoArgs,
+		Short:   "Submit a proposal to update the denoms that can pay usd msg fees along with an initial deposit",
+		Long: strings.TrimSpace(`Submit a proposal to update the denoms that can pay usd msg fees along with an initial deposit.
+Msg fees priced in usd can be paid in any of these denoms while its rate is fresh.
+Each --set entry has the format <denom>,<rate-source>,<max-age-blocks>[,<market-id>].
+The rate source is either "` + types.UsdRateSourceMarkerNav + `" or "` + types.UsdRateSourceExchange + `". The market id is required with the exchange rate source.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees usd-fee-denoms --set usdstable,marker-nav,14400 --deposit 1000000000nhash
+$ %[1]s tx msgfees ufd --set usdstable,exchange,600,3 --remove olddenom --deposit 1000000000nhash
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+			authority := provcli.GetAuthority(flagSet)
+
+			toSet, err := ParseUsdFeeDenoms(flagSet)
+			if err != nil {
+				return err
+			}
+			toRemove, err := flagSet.GetStringSlice(FlagRemoveUsdFeeDenoms)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateUsdFeeDenomsProposalRequest(toSet, toRemove, authority)
+			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
+		},
+	}
+	cmd.Flags().StringArray(FlagSetUsdFeeDenoms, nil, "A usd fee denom to add or replace: <denom>,<rate-source>,<max-age-blocks>[,<market-id>] (repeatable)")
+	cmd.Flags().StringSlice(FlagRemoveUsdFeeDenoms, nil, "Denoms that can no longer pay usd msg fees (comma-separated or repeatable)")
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	provcli.AddAuthorityFlagToCmd(cmd)
+	return cmd
+}
+
+// ParseUsdFeeDenoms reads the usd fee denoms to set from the provided flag set.
+func ParseUsdFeeDenoms(flagSet *pflag.FlagSet) ([]types.UsdFeeDenom, error) {
+	entries, err := flagSet.GetStringArray(FlagSetUsdFeeDenoms)
+	if err != nil {
+		return nil, err
+	}
+
+	var rv []types.UsdFeeDenom
+	for _, entry := range entries {
+		parts := strings.Split(entry, ",")
+		if len(parts) != 3 && len(parts) != 4 {
+			return nil, fmt.Errorf("invalid usd fee denom %q: expected format <denom>,<rate-source>,<max-age-blocks>[,<market-id>]", entry)
+		}
+		maxAge, err := strconv.ParseUint(strings.TrimSpace(parts[2]), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid usd fee denom %q: invalid max age blocks %q: %w", entry, parts[2], err)
+		}
+		var marketID uint64
+		if len(parts) == 4 {
+			marketID, err = strconv.ParseUint(strings.TrimSpace(parts[3]), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid usd fee denom %q: invalid market id %q: %w", entry, parts[3], err)
+			}
+		}
+		rv = append(rv, types.NewUsdFeeDenom(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), uint32(marketID), maxAge))
+	}
+
+	return rv, nil
+}
+
+func GetSetFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-fee-sponsorship <msg-type-url> <budget>",
+		Aliases: []string{"sfs", "s-f-s"},
+		Args:    cobra.ExactArgs(2),
+		Short:   "Create or update a fee sponsorship",
+		Long: strings.TrimSpace(`Create or update a fee sponsorship that pays the fees of txs with the given msg type for other accounts.
+Users choose the sponsor by setting it as the fee granter of their txs.
+
+With --market-id, the market's account pays the fees, and the signer must be able to withdraw the market's funds.
+With --marker-denom, the marker's account pays the fees, and the signer must have withdraw access on the marker.
+Otherwise, the signer's account pays the fees.
+
+The budget is the most that will be paid in total, including anything already paid under this sponsorship.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees set-fee-sponsorship /provenance.exchange.v1.MsgCreateBidRequest 100000000000nhash --market-id 3 --daily-user-cap 1000000000nhash --from mykey
+$ %[1]s tx msgfees sfs /cosmos.bank.v1beta1.MsgSend 100000000000nhash --marker-denom mycoin --from mykey
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			budget, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid budget %q: %w", args[1], err)
+			}
+			dailyUserCapStr, err := flagSet.GetString(FlagDailyUserCap)
+			if err != nil {
+				return err
+			}
+			var dailyUserCap sdk.Coins
+			if len(dailyUserCapStr) > 0 {
+				dailyUserCap, err = sdk.ParseCoinsNormalized(dailyUserCapStr)
+				if err != nil {
+					return fmt.Errorf("invalid %s %q: %w", FlagDailyUserCap, dailyUserCapStr, err)
+				}
+			}
+			marketID, markerDenom, err := ParseFeeSponsorshipScope(flagSet)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFeeSponsorshipRequest(clientCtx.GetFromAddress().String(), args[0], marketID, markerDenom, budget, dailyUserCap)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
+		},
+	}
+	AddFeeSponsorshipScopeFlags(cmd)
+	cmd.Flags().String(FlagDailyUserCap, "", "The most that will be paid for a single fee payer each day, e.g. 1000000000nhash (default no cap)")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetRemoveFeeSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "remove-fee-sponsorship <msg-type-url>",
+		Aliases: []string{"rfs", "r-f-s"},
+		Args:    cobra.ExactArgs(1),
+		Short:   "Remove a fee sponsorship",
+		Long: strings.TrimSpace(`Remove a fee sponsorship.
+The signer must have the same permission needed to set the sponsorship.`),
+		Example: fmt.Sprintf(`$ %[1]s tx msgfees remove-fee-sponsorship /provenance.exchange.v1.MsgCreateBidRequest --market-id 3 --from mykey
+`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			flagSet := cmd.Flags()
+
+			marketID, markerDenom, err := ParseFeeSponsorshipScope(flagSet)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveFeeSponsorshipRequest(clientCtx.GetFromAddress().String(), args[0], marketID, markerDenom)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, flagSet, msg)
+		},
+	}
+	AddFeeSponsorshipScopeFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// AddFeeSponsorshipScopeFlags adds the flags that identify the market or marker of a fee sponsorship.
+func AddFeeSponsorshipScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32(FlagMarketID, 0, "The exchange market that the sponsored msgs must be for")
+	cmd.Flags().String(FlagMarkerDenom, "", "The marker denom that the sponsored msgs must involve")
+	cmd.MarkFlagsMutuallyExclusive(FlagMarketID, FlagMarkerDenom)
+}
+
+// ParseFeeSponsorshipScope reads the market id and marker denom of a fee sponsorship from the provided flag set.
+func ParseFeeSponsorshipScope(flagSet *pflag.FlagSet) (uint32, string, error) {
+	marketID, err := flagSet.GetUint32(FlagMarketID)
+	if err != nil {
+		return 0, "", err
+	}
+	markerDenom, err := flagSet.GetString(FlagMarkerDenom)
+	if err != nil {
+		return 0, "", err
+	}
+	return marketID, markerDenom, nil
+}
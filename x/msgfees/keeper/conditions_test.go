@@ -0,0 +1,133 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/internal/pioconfig"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *TestSuite) TestCalculateAdditionalFeesWithConditions() {
+	feeDenom := pioconfig.GetProvenanceConfig().FeeDenom
+	nhashCoin := func(amount int64) sdk.Coin {
+		return sdk.NewInt64Coin(feeDenom, amount)
+	}
+	nhashCoins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(nhashCoin(amount))
+	}
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	maker, other, recipient := s.addrs[0], s.addrs[1], s.addrs[2]
+	blockTime := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+
+	const makerAttr = "marketmaker.pb"
+	s.Require().NoError(s.app.NameKeeper.SetNameRecord(ctx, makerAttr, maker, false), "SetNameRecord(%q)", makerAttr)
+	attr := attrtypes.NewAttribute(makerAttr, maker.String(), attrtypes.AttributeType_String, []byte("yes"), nil)
+	s.Require().NoError(s.app.AttributeKeeper.SetAttribute(ctx, attr, maker), "SetAttribute(%q)", makerAttr)
+
+	tiers := []types.MsgFeeTier{
+		types.NewMsgFeeTier(sdkmath.NewInt(1_000_000), 100),
+		types.NewMsgFeeTier(sdkmath.NewInt(100_000_000), 50),
+	}
+
+	tests := []struct {
+		name         string
+		conditions   types.MsgFeeConditions
+		msg          sdk.Msg
+		expTotal     sdk.Coins
+		expRecipient sdk.Coins
+		expAssessed  []types.AssessedMsgFee
+	}{
+		{
+			name:         "no conditions",
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(5_000_000)),
+			expTotal:     nhashCoins(1000),
+			expRecipient: nhashCoins(500),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(1000), recipient.String(), "")},
+		},
+		{
+			name:         "amount below first tier",
+			conditions:   types.MsgFeeConditions{AmountTiers: tiers},
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(999_999)),
+			expTotal:     nhashCoins(1000),
+			expRecipient: nhashCoins(500),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(1000), recipient.String(), "")},
+		},
+		{
+			name:         "amount in first tier",
+			conditions:   types.MsgFeeConditions{AmountTiers: tiers},
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(5_000_000)),
+			expTotal:     nhashCoins(50_000),
+			expRecipient: nhashCoins(25_000),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(50_000), recipient.String(), "")},
+		},
+		{
+			name:         "amount in second tier capped by max fee",
+			conditions:   types.MsgFeeConditions{AmountTiers: tiers, MaxFee: sdkmath.NewInt(300_000)},
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(1_000_000_000)),
+			expTotal:     nhashCoins(300_000),
+			expRecipient: nhashCoins(150_000),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(300_000), recipient.String(), "")},
+		},
+		{
+			name:        "signer has exempt attribute",
+			conditions:  types.MsgFeeConditions{AmountTiers: tiers, ExemptAttributes: []string{"other.pb", makerAttr}},
+			msg:         banktypes.NewMsgSend(maker, other, nhashCoins(5_000_000)),
+			expAssessed: []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nil, recipient.String(), "exempt attribute "+makerAttr)},
+		},
+		{
+			name:         "signer does not have exempt attribute",
+			conditions:   types.MsgFeeConditions{ExemptAttributes: []string{makerAttr}},
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(5_000_000)),
+			expTotal:     nhashCoins(1000),
+			expRecipient: nhashCoins(500),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(1000), recipient.String(), "")},
+		},
+		{
+			name: "in fee-free window",
+			conditions: types.MsgFeeConditions{FeeFreeWindows: []types.FeeFreeWindow{
+				types.NewFeeFreeWindow(blockTime.Add(-time.Hour), blockTime.Add(time.Hour)),
+			}},
+			msg:         banktypes.NewMsgSend(other, maker, nhashCoins(5_000_000)),
+			expAssessed: []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nil, recipient.String(), types.WaivedReasonFeeFreeWindow)},
+		},
+		{
+			name: "fee-free window has ended",
+			conditions: types.MsgFeeConditions{FeeFreeWindows: []types.FeeFreeWindow{
+				types.NewFeeFreeWindow(blockTime.Add(-time.Hour), blockTime),
+			}},
+			msg:          banktypes.NewMsgSend(other, maker, nhashCoins(5_000_000)),
+			expTotal:     nhashCoins(1000),
+			expRecipient: nhashCoins(500),
+			expAssessed:  []types.AssessedMsgFee{types.NewAssessedMsgFee(sendTypeURL, nhashCoins(1000), recipient.String(), "")},
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			msgFee := types.NewMsgFee(sendTypeURL, nhashCoin(1000), recipient.String(), 5_000).WithConditions(tc.conditions)
+			s.Require().NoError(s.app.MsgFeesKeeper.SetMsgFee(ctx, msgFee), "SetMsgFee")
+
+			actual, err := s.app.MsgFeesKeeper.CalculateAdditionalFeesToBePaid(ctx, tc.msg)
+			s.Require().NoError(err, "CalculateAdditionalFeesToBePaid")
+			s.Assert().Equal(tc.expTotal.String(), actual.TotalAdditionalFees.String(), "TotalAdditionalFees")
+			s.Assert().Equal(tc.expRecipient.String(), actual.RecipientDistributions[recipient.String()].String(), "recipient distribution")
+			s.Assert().Equal(tc.expAssessed, actual.Assessed, "Assessed")
+		})
+	}
+}
+
+func (s *TestSuite) TestAddMsgFeeInvalidConditions() {
+	conditions := types.MsgFeeConditions{ExemptAttributes: []string{""}}
+	err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "conditionsTypeURL", "", "", sdk.NewInt64Coin("nhash", 10), conditions)
+	s.Require().EqualError(err, "exempt attribute cannot be empty: invalid msg fee conditions", "AddMsgFee")
+	msgFee, err := s.app.MsgFeesKeeper.GetMsgFee(s.ctx, "conditionsTypeURL")
+	s.Require().NoError(err, "GetMsgFee")
+	s.Assert().Nil(msgFee, "GetMsgFee")
+}
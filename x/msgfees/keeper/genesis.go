@@ -17,7 +17,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateMsgFees(ctx, msgFeeRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, msgFees)
+	usdFeeDenoms, err := k.GetAllUsdFeeDenoms(ctx)
+	if err != nil {
+		panic(err)
+	}
+	feeSponsorships, err := k.GetAllFeeSponsorships(ctx)
+	if err != nil {
+		panic(err)
+	}
+	feeRevenues, err := k.GetAllFeeRevenues(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(params, msgFees, usdFeeDenoms, feeSponsorships, feeRevenues)
 }
 
 // InitGenesis new msgfees genesis
@@ -32,4 +44,15 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, usdFeeDenom := range data.UsdFeeDenoms {
+		k.SetUsdFeeDenom(ctx, usdFeeDenom)
+	}
+	for _, sponsorship := range data.FeeSponsorships {
+		k.SetFeeSponsorship(ctx, sponsorship)
+	}
+	for _, revenue := range data.FeeRevenues {
+		if err := k.SetFeeRevenue(ctx, revenue); err != nil {
+			panic(err)
+		}
+	}
 }
@@ -28,19 +28,23 @@ type baseAppSimulateFunc func(txBytes []byte) (sdk.GasInfo, *sdk.Result, sdk.Con
 // Keeper of the Additional fee store
 type Keeper struct {
 	storeKey         storetypes.StoreKey
-	cdc              codec.BinaryCodec
+	cdc              codec.Codec
 	feeCollectorName string // name of the FeeCollector ModuleAccount
 	defaultFeeDenom  string
 	simulateFunc     baseAppSimulateFunc
 	txDecoder        sdk.TxDecoder
 	registry         cdctypes.InterfaceRegistry
 	authority        string
+	attrKeeper       types.AttributeKeeper
+	usdRateSources   map[string]types.UsdRateSource
+	markerKeeper     types.MarkerKeeper
+	exchangeKeeper   types.ExchangeKeeper
 }
 
 // NewKeeper returns a AdditionalFeeKeeper. It handles:
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
-	cdc codec.BinaryCodec,
+	cdc codec.Codec,
 	key storetypes.StoreKey,
 	feeCollectorName string,
 	defaultFeeDenom string,
@@ -57,9 +61,16 @@ func NewKeeper(
 		txDecoder:        txDecoder,
 		authority:        cosmosauthtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		registry:         registry,
+		usdRateSources:   make(map[string]types.UsdRateSource),
 	}
 }
 
+// SetAttributeKeeper sets the attribute keeper used to check for msg fee exemptions.
+// The attribute keeper needs the name keeper, which is created after this one, so it can't be provided in NewKeeper.
+func (k *Keeper) SetAttributeKeeper(attrKeeper types.AttributeKeeper) {
+	k.attrKeeper = attrKeeper
+}
+
 // GetAuthority is signer of the proposal
 func (k Keeper) GetAuthority() string {
 	return k.authority
@@ -206,9 +217,19 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 		}
 
 		if msgFees != nil {
-			if err := msgFeesDistribution.Increase(msgFees.AdditionalFee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
+			fee, waivedReason, err := k.AssessMsgFee(ctx, msg, *msgFees)
+			if err != nil {
 				return msgFeesDistribution, err
 			}
+			var feeCoins sdk.Coins
+			if len(waivedReason) == 0 {
+				if err := msgFeesDistribution.Increase(fee, msgFees.RecipientBasisPoints, msgFees.Recipient); err != nil {
+					return msgFeesDistribution, err
+				}
+				feeCoins = sdk.NewCoins(fee)
+			}
+			msgFeesDistribution.Assessed = append(msgFeesDistribution.Assessed,
+				types.NewAssessedMsgFee(typeURL, feeCoins, msgFees.Recipient, waivedReason))
 		}
 
 		if typeURL == assessCustomMsgTypeURL {
@@ -227,12 +248,71 @@ func (k Keeper) CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg
 			if err := msgFeesDistribution.Increase(msgFeeCoin, points, assessFee.Recipient); err != nil {
 				return msgFeesDistribution, err
 			}
+			msgFeesDistribution.Assessed = append(msgFeesDistribution.Assessed,
+				types.NewAssessedMsgFee(typeURL, sdk.NewCoins(msgFeeCoin), assessFee.Recipient, ""))
 		}
 	}
 
 	return msgFeesDistribution, nil
 }
 
+// AssessMsgFee applies the conditions of a msg fee to the provided msg and returns the fee to charge.
+// If the fee is waived, the returned reason will be non-empty and the fee should not be charged.
+func (k Keeper) AssessMsgFee(ctx sdk.Context, msg sdk.Msg, msgFee types.MsgFee) (sdk.Coin, string, error) {
+	conditions := msgFee.Conditions
+	if conditions.InFeeFreeWindow(ctx.BlockTime()) {
+		return sdk.Coin{}, types.WaivedReasonFeeFreeWindow, nil
+	}
+
+	if len(conditions.ExemptAttributes) > 0 && k.attrKeeper != nil {
+		attr, err := k.getExemptAttribute(ctx, msg, conditions.ExemptAttributes)
+		if err != nil {
+			return sdk.Coin{}, "", err
+		}
+		if len(attr) > 0 {
+			return sdk.Coin{}, fmt.Sprintf("%s %s", types.WaivedReasonExemptAttribute, attr), nil
+		}
+	}
+
+	amount := types.GetMsgAmount(msg, msgFee.AdditionalFee.Denom)
+	return conditions.CalculateFee(msgFee.AdditionalFee, amount), "", nil
+}
+
+// getExemptAttribute returns the first of the exempt attributes held by all signers of the msg.
+// If the signers don't share one of them, an empty string is returned.
+func (k Keeper) getExemptAttribute(ctx sdk.Context, msg sdk.Msg, exemptAttributes []string) (string, error) {
+	signers, _, err := k.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return "", sdkerrors.ErrInvalidRequest.Wrapf("could not get signers of %s: %v", sdk.MsgTypeURL(msg), err)
+	}
+	if len(signers) == 0 {
+		return "", nil
+	}
+
+	// Count how many signers have each of the exempt attributes.
+	held := make(map[string]int, len(exemptAttributes))
+	for _, signer := range signers {
+		attrs, err := k.attrKeeper.GetAllAttributesAddr(ctx, signer)
+		if err != nil {
+			return "", err
+		}
+		seen := make(map[string]bool)
+		for _, attr := range attrs {
+			if !seen[attr.Name] {
+				seen[attr.Name] = true
+				held[attr.Name]++
+			}
+		}
+	}
+
+	for _, attrName := range exemptAttributes {
+		if held[attrName] == len(signers) {
+			return attrName, nil
+		}
+	}
+	return "", nil
+}
+
 // sortedKeys gets the keys of a map, sorts them and returns them as a slice.
 func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 	keys := make([]K, 0, len(m))
@@ -246,7 +326,7 @@ func sortedKeys[K constraints.Ordered, V any](m map[K]V) []K {
 }
 
 // AddMsgFee adds a new msg fees
-func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin, conditions types.MsgFeeConditions) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
@@ -263,7 +343,10 @@ func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints st
 		return err
 	}
 
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips).WithConditions(conditions)
+	if err = conditions.Validate(additionalFee); err != nil {
+		return types.ErrInvalidConditions.Wrap(err.Error())
+	}
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
@@ -274,7 +357,7 @@ func (k Keeper) AddMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints st
 }
 
 // UpdateMsgFee updates  an existing msg fees
-func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin) error {
+func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints string, additionalFee sdk.Coin, conditions types.MsgFeeConditions) error {
 	if msgTypeURL == "" {
 		return types.ErrEmptyMsgType
 	}
@@ -291,7 +374,10 @@ func (k Keeper) UpdateMsgFee(ctx sdk.Context, msgTypeURL, recipient, basisPoints
 		return err
 	}
 
-	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips)
+	msgFees := types.NewMsgFee(msgTypeURL, additionalFee, recipient, bips).WithConditions(conditions)
+	if err = conditions.Validate(additionalFee); err != nil {
+		return types.ErrInvalidConditions.Wrap(err.Error())
+	}
 
 	err = k.SetMsgFee(ctx, msgFees)
 	if err != nil {
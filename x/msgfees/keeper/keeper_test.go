@@ -427,7 +427,7 @@ func (s *TestSuite) TestAddMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.AddMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee, types.MsgFeeConditions{})
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
@@ -445,7 +445,7 @@ func (s *TestSuite) TestAddMsgFee() {
 }
 
 func (s *TestSuite) TestUpdateMsgFee() {
-	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "initialRecipient", "500", sdk.NewInt64Coin("nhash", 2000)), "AddMsgFee() failed test setup")
+	s.Require().NoError(s.app.MsgFeesKeeper.AddMsgFee(s.ctx, "updateTypeURL", "initialRecipient", "500", sdk.NewInt64Coin("nhash", 2000), types.MsgFeeConditions{}), "AddMsgFee() failed test setup")
 
 	testCases := []struct {
 		name          string
@@ -486,7 +486,7 @@ func (s *TestSuite) TestUpdateMsgFee() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee)
+			err := s.app.MsgFeesKeeper.UpdateMsgFee(s.ctx, tc.msgTypeURL, tc.recipient, tc.basisPoints, tc.additionalFee, types.MsgFeeConditions{})
 			if tc.expectError {
 				s.Require().Error(err, "test was expected to fail")
 				s.Require().Contains(err.Error(), tc.errorMsg)
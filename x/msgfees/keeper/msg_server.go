@@ -50,7 +50,7 @@ func (m msgServer) AddMsgFeeProposal(goCtx context.Context, req *types.MsgAddMsg
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.AddMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	err := m.Keeper.AddMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee, req.Conditions)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func (m msgServer) UpdateMsgFeeProposal(goCtx context.Context, req *types.MsgUpd
 		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
 	}
 
-	err := m.Keeper.UpdateMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee)
+	err := m.Keeper.UpdateMsgFee(sdk.UnwrapSDKContext(goCtx), req.MsgTypeUrl, req.Recipient, req.RecipientBasisPoints, req.AdditionalFee, req.Conditions)
 	if err != nil {
 		return nil, err
 	}
@@ -103,3 +103,34 @@ func (m msgServer) UpdateConversionFeeDenomProposal(goCtx context.Context, req *
 
 	return &types.MsgUpdateConversionFeeDenomProposalResponse{}, nil
 }
+
+func (m msgServer) UpdateUsdFeeDenomsProposal(goCtx context.Context, req *types.MsgUpdateUsdFeeDenomsProposalRequest) (*types.MsgUpdateUsdFeeDenomsProposalResponse, error) {
+	if m.GetAuthority() != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "expected %s got %s", m.GetAuthority(), req.Authority)
+	}
+
+	err := m.Keeper.UpdateUsdFeeDenoms(sdk.UnwrapSDKContext(goCtx), req.ToSet, req.ToRemove)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateUsdFeeDenomsProposalResponse{}, nil
+}
+
+func (m msgServer) SetFeeSponsorship(goCtx context.Context, req *types.MsgSetFeeSponsorshipRequest) (*types.MsgSetFeeSponsorshipResponse, error) {
+	err := m.Keeper.UpdateFeeSponsorship(sdk.UnwrapSDKContext(goCtx), req.Admin, req.MsgTypeUrl, req.MarketId, req.MarkerDenom, req.Budget, req.DailyUserCap)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFeeSponsorshipResponse{}, nil
+}
+
+func (m msgServer) RemoveFeeSponsorship(goCtx context.Context, req *types.MsgRemoveFeeSponsorshipRequest) (*types.MsgRemoveFeeSponsorshipResponse, error) {
+	err := m.Keeper.DeleteFeeSponsorship(sdk.UnwrapSDKContext(goCtx), req.Admin, req.MsgTypeUrl, req.MarketId, req.MarkerDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveFeeSponsorshipResponse{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	sdkmath "cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -50,6 +51,152 @@ func (k Keeper) QueryAllMsgFees(c context.Context, req *types.QueryAllMsgFeesReq
 	return &types.QueryAllMsgFeesResponse{MsgFees: msgFees, Pagination: pageRes}, nil
 }
 
+func (k Keeper) UsdFeeDenoms(c context.Context, _ *types.QueryUsdFeeDenomsRequest) (*types.QueryUsdFeeDenomsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	usdFeeDenoms, err := k.GetAllUsdFeeDenoms(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &types.QueryUsdFeeDenomsResponse{UsdFeeDenoms: make([]types.UsdFeeDenomRate, len(usdFeeDenoms))}
+	for i, usdFeeDenom := range usdFeeDenoms {
+		entry := types.UsdFeeDenomRate{
+			UsdFeeDenom: usdFeeDenom,
+			UsdMils:     sdkmath.ZeroInt(),
+			Volume:      sdkmath.ZeroInt(),
+			Stale:       true,
+		}
+		// A rate that can't be read can't be used to pay fees either, so it's reported as stale.
+		rate, err := k.GetUsdRate(ctx, usdFeeDenom)
+		if err == nil && rate != nil && rate.IsValid() {
+			entry.UsdMils = rate.UsdMils
+			entry.Volume = rate.Volume
+			entry.UpdatedBlockHeight = rate.UpdatedBlockHeight
+			entry.Stale = rate.IsStale(ctx.BlockHeight(), usdFeeDenom.MaxAgeBlocks)
+		}
+		resp.UsdFeeDenoms[i] = entry
+	}
+
+	return resp, nil
+}
+
+func (k Keeper) FeeSponsorships(c context.Context, req *types.QueryFeeSponsorshipsRequest) (*types.QueryFeeSponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	keyPrefix := types.FeeSponsorshipKeyPrefix
+	if len(req.Sponsor) > 0 {
+		sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid sponsor: %v", err)
+		}
+		keyPrefix = types.GetFeeSponsorshipSponsorPrefix(sponsor)
+	}
+
+	resp := &types.QueryFeeSponsorshipsResponse{}
+	sponsorshipStore := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	pageRes, err := query.Paginate(sponsorshipStore, req.Pagination, func(_ []byte, value []byte) error {
+		var sponsorship types.FeeSponsorship
+		if err := k.cdc.Unmarshal(value, &sponsorship); err != nil {
+			return err
+		}
+		resp.FeeSponsorships = append(resp.FeeSponsorships, types.NewFeeSponsorshipBudget(sponsorship))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
+
+	return resp, nil
+}
+
+func (k Keeper) FeeSponsorshipUsage(c context.Context, req *types.QueryFeeSponsorshipUsageRequest) (*types.QueryFeeSponsorshipUsageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsor, err := sdk.AccAddressFromBech32(req.Sponsor)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sponsor: %v", err)
+	}
+	feePayer, err := sdk.AccAddressFromBech32(req.FeePayer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid fee payer: %v", err)
+	}
+	if err = types.ValidateFeeSponsorshipScope(req.MsgTypeUrl, req.MarketId, req.MarkerDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sponsorship, err := k.GetFeeSponsorship(ctx, sponsor, req.MsgTypeUrl, req.MarketId, req.MarkerDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if sponsorship == nil {
+		return nil, status.Errorf(codes.NotFound, "sponsor %s does not sponsor %q", req.Sponsor, req.MsgTypeUrl)
+	}
+
+	userSpend, err := k.GetFeeSponsorshipUserSpend(ctx, *sponsorship, feePayer)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	spentToday := userSpend.SpentOn(types.GetBlockDay(ctx.BlockTime()))
+
+	return &types.QueryFeeSponsorshipUsageResponse{
+		FeeSponsorship: types.NewFeeSponsorshipBudget(*sponsorship),
+		SpentToday:     spentToday,
+		RemainingToday: types.RemainingToday(sponsorship.DailyUserCap, spentToday),
+	}, nil
+}
+
+func (k Keeper) FeeRevenue(c context.Context, req *types.QueryFeeRevenueRequest) (*types.QueryFeeRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.StartDay < 0 || req.EndDay < 0 {
+		return nil, status.Error(codes.InvalidArgument, "days cannot be negative")
+	}
+	if req.EndDay != 0 && req.EndDay < req.StartDay {
+		return nil, status.Errorf(codes.InvalidArgument, "end day %d is before start day %d", req.EndDay, req.StartDay)
+	}
+	if _, err := types.GetFeeRevenueRecipientAddr(req.Recipient); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryFeeRevenueResponse{}
+	// Only the entries of the requested days are iterated.
+	revenueStore := k.getFeeRevenueDaysStore(ctx, req.StartDay, req.EndDay)
+	pageRes, err := query.FilteredPaginate(revenueStore, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var revenue types.FeeRevenue
+		if err := k.cdc.Unmarshal(value, &revenue); err != nil {
+			return false, err
+		}
+		if !revenue.Matches(req.Recipient, req.MsgTypeUrl) {
+			return false, nil
+		}
+		if accumulate {
+			resp.FeeRevenues = append(resp.FeeRevenues, revenue)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Pagination = pageRes
+
+	resp.FeeCollectorTotal, resp.RecipientsTotal, err = k.GetFeeRevenueTotals(ctx, req.StartDay, req.EndDay, req.Recipient, req.MsgTypeUrl)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
 func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateTxFeesRequest) (*types.CalculateTxFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -77,8 +224,9 @@ func (k Keeper) CalculateTxFees(goCtx context.Context, request *types.CalculateT
 	totalFees := gasMeter.FeeConsumed().Add(sdk.NewCoin(baseDenom, minGasPrice.Amount.MulRaw(gasUsed)))
 
 	return &types.CalculateTxFeesResponse{
-		AdditionalFees: gasMeter.FeeConsumed(),
-		TotalFees:      totalFees,
-		EstimatedGas:   uint64(gasUsed),
+		AdditionalFees:  gasMeter.FeeConsumed(),
+		TotalFees:       totalFees,
+		EstimatedGas:    uint64(gasUsed),
+		AssessedMsgFees: gasMeter.AssessedFees(),
 	}, nil
 }
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// SetFeeRevenue stores a fee revenue entry.
+func (k Keeper) SetFeeRevenue(ctx sdk.Context, revenue types.FeeRevenue) error {
+	recipient, err := types.GetFeeRevenueRecipientAddr(revenue.Recipient)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&revenue)
+	if err != nil {
+		return err
+	}
+	store.Set(types.GetFeeRevenueKey(revenue.Day, recipient, revenue.MsgTypeUrl), bz)
+	return nil
+}
+
+// GetFeeRevenue returns the fee revenue entry of a recipient and msg type on a day, or nil if there isn't one.
+// The recipient is empty for the fees sent to the fee collector.
+func (k Keeper) GetFeeRevenue(ctx sdk.Context, day int64, recipient string, msgTypeURL string) (*types.FeeRevenue, error) {
+	recipientAddr, err := types.GetFeeRevenueRecipientAddr(recipient)
+	if err != nil {
+		return nil, err
+	}
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeRevenueKey(day, recipientAddr, msgTypeURL))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var revenue types.FeeRevenue
+	if err = k.cdc.Unmarshal(bz, &revenue); err != nil {
+		return nil, err
+	}
+	return &revenue, nil
+}
+
+// RecordFeeRevenue adds msg fees sent to a recipient to that recipient's total for the msg type on the block's day.
+// The recipient is empty for the fees sent to the fee collector.
+func (k Keeper) RecordFeeRevenue(ctx sdk.Context, msgTypeURL string, recipient string, amount sdk.Coins, count uint64) error {
+	if amount.IsZero() {
+		return nil
+	}
+	day := types.GetBlockDay(ctx.BlockTime())
+	revenue, err := k.GetFeeRevenue(ctx, day, recipient, msgTypeURL)
+	if err != nil {
+		return err
+	}
+	if revenue == nil {
+		revenue = &types.FeeRevenue{Day: day, Recipient: recipient, MsgTypeUrl: msgTypeURL}
+	}
+	revenue.Total = revenue.Total.Add(amount...)
+	revenue.Count += count
+	return k.SetFeeRevenue(ctx, *revenue)
+}
+
+// IterateFeeRevenues iterates the fee revenue entries from the start day through the end day (inclusive), in order by day.
+// A start day of zero means there is no start, and an end day of zero means there is no end.
+func (k Keeper) IterateFeeRevenues(ctx sdk.Context, startDay, endDay int64, handle func(revenue types.FeeRevenue) (stop bool)) error {
+	iterator := k.getFeeRevenueDaysStore(ctx, startDay, endDay).Iterator(nil, nil)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.FeeRevenue{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if handle(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllFeeRevenues returns all of the fee revenue entries.
+func (k Keeper) GetAllFeeRevenues(ctx sdk.Context) ([]types.FeeRevenue, error) {
+	var rv []types.FeeRevenue
+	err := k.IterateFeeRevenues(ctx, 0, 0, func(revenue types.FeeRevenue) bool {
+		rv = append(rv, revenue)
+		return false
+	})
+	return rv, err
+}
+
+// GetFeeRevenueTotals returns the totals sent to the fee collector and to other recipients from the start day through
+// the end day (inclusive), optionally limited to a single recipient and/or msg type.
+func (k Keeper) GetFeeRevenueTotals(ctx sdk.Context, startDay, endDay int64, recipient, msgTypeURL string) (feeCollectorTotal, recipientsTotal sdk.Coins, err error) {
+	feeCollectorTotal, recipientsTotal = sdk.Coins{}, sdk.Coins{}
+	err = k.IterateFeeRevenues(ctx, startDay, endDay, func(revenue types.FeeRevenue) bool {
+		if !revenue.Matches(recipient, msgTypeURL) {
+			return false
+		}
+		if revenue.IsForFeeCollector() {
+			feeCollectorTotal = feeCollectorTotal.Add(revenue.Total...)
+		} else {
+			recipientsTotal = recipientsTotal.Add(revenue.Total...)
+		}
+		return false
+	})
+	return feeCollectorTotal, recipientsTotal, err
+}
+
+// getFeeRevenueDaysStore returns a store that only iterates the fee revenue entries from startDay to endDay
+// (inclusive). An endDay of zero means there's no end.
+func (k Keeper) getFeeRevenueDaysStore(ctx sdk.Context, startDay, endDay int64) storetypes.KVStore {
+	rv := feeRevenueDaysStore{
+		KVStore: ctx.KVStore(k.storeKey),
+		start:   types.GetFeeRevenueDayPrefix(startDay),
+		end:     storetypes.PrefixEndBytes(types.FeeRevenueKeyPrefix),
+	}
+	if endDay != 0 {
+		rv.end = types.GetFeeRevenueDayPrefix(endDay + 1)
+	}
+	return rv
+}
+
+// feeRevenueDaysStore is a KVStore whose iterators are limited to the keys from start (inclusive) to end (exclusive).
+// It lets pagination skip the fee revenue entries of the days that aren't wanted.
+type feeRevenueDaysStore struct {
+	storetypes.KVStore
+	start []byte
+	end   []byte
+}
+
+// Iterator returns an iterator over the keys in both the requested domain and the store's range.
+func (s feeRevenueDaysStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+// ReverseIterator returns a reverse iterator over the keys in both the requested domain and the store's range.
+func (s feeRevenueDaysStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.bound(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
+
+// bound limits an iterator domain to the store's range. A nil start or end means the domain is unbounded on that side.
+func (s feeRevenueDaysStore) bound(start, end []byte) ([]byte, []byte) {
+	if start == nil || bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		end = s.end
+	}
+	if bytes.Compare(start, end) > 0 {
+		start = end
+	}
+	return start, end
+}
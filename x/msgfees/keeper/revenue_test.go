@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+func (s *TestSuite) TestRecordFeeRevenue() {
+	day1 := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(day1)
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	recipient := s.addrs[0].String()
+
+	s.Require().NoError(s.app.MsgFeesKeeper.RecordFeeRevenue(ctx, sendType, recipient, sdk.NewCoins(sdk.NewInt64Coin("nhash", 6)), 1), "first recipient fee")
+	s.Require().NoError(s.app.MsgFeesKeeper.RecordFeeRevenue(ctx, sendType, recipient, sdk.NewCoins(sdk.NewInt64Coin("nhash", 12)), 2), "second recipient fee")
+	s.Require().NoError(s.app.MsgFeesKeeper.RecordFeeRevenue(ctx, sendType, "", sdk.NewCoins(sdk.NewInt64Coin("nhash", 4)), 3), "fee collector fee")
+	s.Require().NoError(s.app.MsgFeesKeeper.RecordFeeRevenue(ctx, sendType, "", sdk.Coins{}, 1), "zero fee")
+	s.Require().ErrorContains(s.app.MsgFeesKeeper.RecordFeeRevenue(ctx, sendType, "bad", sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)), 1),
+		"invalid recipient", "bad recipient")
+
+	day := types.GetBlockDay(day1)
+	revenue, err := s.app.MsgFeesKeeper.GetFeeRevenue(ctx, day, recipient, sendType)
+	s.Require().NoError(err, "GetFeeRevenue recipient")
+	s.Require().NotNil(revenue, "GetFeeRevenue recipient")
+	s.Assert().Equal("18nhash", revenue.Total.String(), "recipient total")
+	s.Assert().Equal(3, int(revenue.Count), "recipient count")
+
+	revenue, err = s.app.MsgFeesKeeper.GetFeeRevenue(ctx, day, "", sendType)
+	s.Require().NoError(err, "GetFeeRevenue fee collector")
+	s.Require().NotNil(revenue, "GetFeeRevenue fee collector")
+	s.Assert().Equal("4nhash", revenue.Total.String(), "fee collector total")
+	s.Assert().Equal(3, int(revenue.Count), "fee collector count")
+
+	revenue, err = s.app.MsgFeesKeeper.GetFeeRevenue(ctx, day+1, recipient, sendType)
+	s.Require().NoError(err, "GetFeeRevenue next day")
+	s.Assert().Nil(revenue, "GetFeeRevenue next day")
+}
+
+func (s *TestSuite) TestFeeRevenueQuery() {
+	day1 := time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	otherType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	recipient := s.addrs[0].String()
+	record := func(blockTime time.Time, msgType, recipient string, amount int64) {
+		err := s.app.MsgFeesKeeper.RecordFeeRevenue(s.ctx.WithBlockTime(blockTime), msgType, recipient, sdk.NewCoins(sdk.NewInt64Coin("nhash", amount)), 1)
+		s.Require().NoError(err, "RecordFeeRevenue(%s, %q, %q, %d)", blockTime, msgType, recipient, amount)
+	}
+	record(day1, sendType, recipient, 30)
+	record(day1, sendType, "", 10)
+	record(day2, otherType, "", 5)
+	record(day3, sendType, recipient, 300)
+	record(day3, sendType, "", 100)
+
+	tests := []struct {
+		name            string
+		req             *types.QueryFeeRevenueRequest
+		expCount        int
+		expFeeCollector string
+		expRecipients   string
+		expErr          string
+	}{
+		{
+			name:            "everything",
+			req:             &types.QueryFeeRevenueRequest{},
+			expCount:        5,
+			expFeeCollector: "115nhash",
+			expRecipients:   "330nhash",
+		},
+		{
+			name:            "first two days",
+			req:             &types.QueryFeeRevenueRequest{StartDay: types.GetBlockDay(day1), EndDay: types.GetBlockDay(day2)},
+			expCount:        3,
+			expFeeCollector: "15nhash",
+			expRecipients:   "30nhash",
+		},
+		{
+			name:            "since the second day",
+			req:             &types.QueryFeeRevenueRequest{StartDay: types.GetBlockDay(day2)},
+			expCount:        3,
+			expFeeCollector: "105nhash",
+			expRecipients:   "300nhash",
+		},
+		{
+			name:            "one recipient",
+			req:             &types.QueryFeeRevenueRequest{Recipient: recipient},
+			expCount:        2,
+			expFeeCollector: "",
+			expRecipients:   "330nhash",
+		},
+		{
+			name:            "one msg type",
+			req:             &types.QueryFeeRevenueRequest{MsgTypeUrl: otherType},
+			expCount:        1,
+			expFeeCollector: "5nhash",
+			expRecipients:   "",
+		},
+		{
+			name:            "totals cover every page",
+			req:             &types.QueryFeeRevenueRequest{Pagination: &query.PageRequest{Limit: 2}},
+			expCount:        2,
+			expFeeCollector: "115nhash",
+			expRecipients:   "330nhash",
+		},
+		{
+			name:   "end before start",
+			req:    &types.QueryFeeRevenueRequest{StartDay: types.GetBlockDay(day2), EndDay: types.GetBlockDay(day1)},
+			expErr: "is before start day",
+		},
+		{
+			name:   "bad recipient",
+			req:    &types.QueryFeeRevenueRequest{Recipient: "bad"},
+			expErr: "invalid recipient",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			resp, err := s.app.MsgFeesKeeper.FeeRevenue(s.ctx, tc.req)
+			if len(tc.expErr) > 0 {
+				s.Require().ErrorContains(err, tc.expErr, "FeeRevenue error")
+				return
+			}
+			s.Require().NoError(err, "FeeRevenue error")
+			s.Assert().Len(resp.FeeRevenues, tc.expCount, "FeeRevenues")
+			s.Assert().Equal(tc.expFeeCollector, resp.FeeCollectorTotal.String(), "FeeCollectorTotal")
+			s.Assert().Equal(tc.expRecipients, resp.RecipientsTotal.String(), "RecipientsTotal")
+			for i := 1; i < len(resp.FeeRevenues); i++ {
+				s.Assert().LessOrEqual(resp.FeeRevenues[i-1].Day, resp.FeeRevenues[i].Day, "FeeRevenues[%d] day", i)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestFeeRevenueQueryOnlyReadsRequestedDays() {
+	day1 := time.Date(2024, 4, 5, 12, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	recipient := s.addrs[0].String()
+	ctx, _ := s.ctx.CacheContext()
+	for _, addr := range []string{"", recipient} {
+		err := s.app.MsgFeesKeeper.RecordFeeRevenue(ctx.WithBlockTime(day2), sendType, addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 7)), 1)
+		s.Require().NoError(err, "RecordFeeRevenue(%q)", addr)
+	}
+	// Entries that can't be read on the days before and after the requested one.
+	store := ctx.KVStore(s.app.GetKey(types.StoreKey))
+	store.Set(append(types.GetFeeRevenueDayPrefix(types.GetBlockDay(day1)), 0xff), []byte("not a fee revenue"))
+	store.Set(append(types.GetFeeRevenueDayPrefix(types.GetBlockDay(day3)), 0x00), []byte("not a fee revenue"))
+
+	day := types.GetBlockDay(day2)
+	for _, reverse := range []bool{false, true} {
+		req := &types.QueryFeeRevenueRequest{StartDay: day, EndDay: day, Pagination: &query.PageRequest{Limit: 1, CountTotal: true, Reverse: reverse}}
+		resp, err := s.app.MsgFeesKeeper.FeeRevenue(ctx, req)
+		s.Require().NoError(err, "FeeRevenue first page, reverse=%t", reverse)
+		s.Assert().Len(resp.FeeRevenues, 1, "FeeRevenues first page, reverse=%t", reverse)
+		s.Assert().Equal(uint64(2), resp.Pagination.Total, "Pagination.Total, reverse=%t", reverse)
+		s.Require().NotEmpty(resp.Pagination.NextKey, "Pagination.NextKey, reverse=%t", reverse)
+
+		req.Pagination = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: 1, Reverse: reverse}
+		resp, err = s.app.MsgFeesKeeper.FeeRevenue(ctx, req)
+		s.Require().NoError(err, "FeeRevenue second page, reverse=%t", reverse)
+		s.Assert().Len(resp.FeeRevenues, 1, "FeeRevenues second page, reverse=%t", reverse)
+		s.Assert().Empty(resp.Pagination.NextKey, "Pagination.NextKey second page, reverse=%t", reverse)
+	}
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// SetSponsorKeepers sets the keepers used to check who can set up market and marker fee sponsorships.
+// Those keepers are created after this one, so they can't be provided in NewKeeper.
+func (k *Keeper) SetSponsorKeepers(markerKeeper types.MarkerKeeper, exchangeKeeper types.ExchangeKeeper) {
+	k.markerKeeper = markerKeeper
+	k.exchangeKeeper = exchangeKeeper
+}
+
+// SetFeeSponsorship stores a fee sponsorship.
+func (k Keeper) SetFeeSponsorship(ctx sdk.Context, sponsorship types.FeeSponsorship) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&sponsorship)
+	store.Set(types.GetFeeSponsorshipKey(sponsorship.GetSponsorAddr(), sponsorship.MsgTypeUrl, sponsorship.MarketId, sponsorship.MarkerDenom), bz)
+}
+
+// GetFeeSponsorship returns a fee sponsorship, or nil if it doesn't exist.
+func (k Keeper) GetFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, msgTypeURL string, marketID uint32, markerDenom string) (*types.FeeSponsorship, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeSponsorshipKey(sponsor, msgTypeURL, marketID, markerDenom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var sponsorship types.FeeSponsorship
+	if err := k.cdc.Unmarshal(bz, &sponsorship); err != nil {
+		return nil, err
+	}
+	return &sponsorship, nil
+}
+
+// RemoveFeeSponsorship removes a fee sponsorship along with what it has paid for each fee payer today.
+// Returns an error if the sponsorship does not exist.
+func (k Keeper) RemoveFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, msgTypeURL string, marketID uint32, markerDenom string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetFeeSponsorshipKey(sponsor, msgTypeURL, marketID, markerDenom)
+	if !store.Has(key) {
+		return types.ErrInvalidSponsorship.Wrapf("sponsor %s does not sponsor %q", sponsor, msgTypeURL)
+	}
+	store.Delete(key)
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetFeeSponsorshipUserSpendPrefix(sponsor, msgTypeURL, marketID, markerDenom))
+	var spendKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		spendKeys = append(spendKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, spendKey := range spendKeys {
+		store.Delete(spendKey)
+	}
+	return nil
+}
+
+// IterateFeeSponsorships iterates the fee sponsorships with keys that start with the provided prefix.
+func (k Keeper) IterateFeeSponsorships(ctx sdk.Context, keyPrefix []byte, handle func(sponsorship types.FeeSponsorship) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, keyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.FeeSponsorship{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if handle(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllFeeSponsorships returns all the fee sponsorships.
+func (k Keeper) GetAllFeeSponsorships(ctx sdk.Context) ([]types.FeeSponsorship, error) {
+	sponsorships := make([]types.FeeSponsorship, 0)
+	err := k.IterateFeeSponsorships(ctx, types.FeeSponsorshipKeyPrefix, func(sponsorship types.FeeSponsorship) bool {
+		sponsorships = append(sponsorships, sponsorship)
+		return false
+	})
+	return sponsorships, err
+}
+
+// GetFeeSponsorshipUserSpend returns what a fee sponsorship has paid for a fee payer on the most recent day it paid anything.
+func (k Keeper) GetFeeSponsorshipUserSpend(ctx sdk.Context, sponsorship types.FeeSponsorship, feePayer sdk.AccAddress) (types.FeeSponsorshipUserSpend, error) {
+	var spend types.FeeSponsorshipUserSpend
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeSponsorshipUserSpendKey(sponsorship.GetSponsorAddr(), sponsorship.MsgTypeUrl, sponsorship.MarketId, sponsorship.MarkerDenom, feePayer))
+	if len(bz) == 0 {
+		return spend, nil
+	}
+	err := k.cdc.Unmarshal(bz, &spend)
+	return spend, err
+}
+
+// setFeeSponsorshipUserSpend stores what a fee sponsorship has paid for a fee payer on a day.
+func (k Keeper) setFeeSponsorshipUserSpend(ctx sdk.Context, sponsorship types.FeeSponsorship, feePayer sdk.AccAddress, spend types.FeeSponsorshipUserSpend) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&spend)
+	store.Set(types.GetFeeSponsorshipUserSpendKey(sponsorship.GetSponsorAddr(), sponsorship.MsgTypeUrl, sponsorship.MarketId, sponsorship.MarkerDenom, feePayer), bz)
+}
+
+// GetSponsorForAdmin returns the account that pays the fees of a sponsorship that the admin sets up.
+// For a market, that's the market's account and the admin must be able to withdraw the market's funds.
+// For a marker denom, that's the marker's account and the admin must have withdraw access on the marker.
+// Otherwise, it's the admin's own account.
+func (k Keeper) GetSponsorForAdmin(ctx sdk.Context, admin string, marketID uint32, markerDenom string) (sdk.AccAddress, error) {
+	adminAddr, err := sdk.AccAddressFromBech32(admin)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case marketID != 0:
+		if k.exchangeKeeper == nil || !k.exchangeKeeper.CanWithdrawMarketFunds(ctx, marketID, admin) {
+			return nil, types.ErrInvalidSponsorship.Wrapf("account %s does not have permission to withdraw funds from market %d", admin, marketID)
+		}
+		return exchange.GetMarketAddress(marketID), nil
+	case len(markerDenom) > 0:
+		if k.markerKeeper == nil {
+			return nil, types.ErrInvalidSponsorship.Wrapf("marker %q not found", markerDenom)
+		}
+		marker, err := k.markerKeeper.GetMarkerByDenom(ctx, markerDenom)
+		if err != nil {
+			return nil, types.ErrInvalidSponsorship.Wrapf("marker %q not found: %v", markerDenom, err)
+		}
+		if !marker.AddressHasAccess(adminAddr, markertypes.Access_Withdraw) {
+			return nil, types.ErrInvalidSponsorship.Wrapf("account %s does not have %s access on marker %q", admin, markertypes.Access_Withdraw, markerDenom)
+		}
+		return marker.GetAddress(), nil
+	}
+	return adminAddr, nil
+}
+
+// UpdateFeeSponsorship sets the budget and daily user cap of a fee sponsorship, creating it if needed.
+// Anything already spent under an existing sponsorship counts against the new budget.
+func (k Keeper) UpdateFeeSponsorship(ctx sdk.Context, admin, msgTypeURL string, marketID uint32, markerDenom string, budget, dailyUserCap sdk.Coins) error {
+	sponsor, err := k.GetSponsorForAdmin(ctx, admin, marketID, markerDenom)
+	if err != nil {
+		return err
+	}
+
+	sponsorship := types.NewFeeSponsorship(sponsor.String(), msgTypeURL, marketID, markerDenom, budget, dailyUserCap)
+	existing, err := k.GetFeeSponsorship(ctx, sponsor, msgTypeURL, marketID, markerDenom)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		sponsorship.Spent = existing.Spent
+	}
+	if err = sponsorship.Validate(); err != nil {
+		return types.ErrInvalidSponsorship.Wrap(err.Error())
+	}
+
+	k.SetFeeSponsorship(ctx, sponsorship)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipUpdated(sponsorship, types.FeeSponsorshipActionSet))
+}
+
+// DeleteFeeSponsorship removes a fee sponsorship that the admin has permission to manage.
+func (k Keeper) DeleteFeeSponsorship(ctx sdk.Context, admin, msgTypeURL string, marketID uint32, markerDenom string) error {
+	sponsor, err := k.GetSponsorForAdmin(ctx, admin, marketID, markerDenom)
+	if err != nil {
+		return err
+	}
+	if err = k.RemoveFeeSponsorship(ctx, sponsor, msgTypeURL, marketID, markerDenom); err != nil {
+		return err
+	}
+	sponsorship := types.NewFeeSponsorship(sponsor.String(), msgTypeURL, marketID, markerDenom, nil, nil)
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsorshipUpdated(sponsorship, types.FeeSponsorshipActionRemoved))
+}
+
+// FindFeeSponsorship returns the sponsor's fee sponsorship that covers all of the msgs, or nil if there isn't one.
+// All the msgs must have the same type.
+func (k Keeper) FindFeeSponsorship(ctx sdk.Context, sponsor sdk.AccAddress, msgs []sdk.Msg) (*types.FeeSponsorship, error) {
+	if len(msgs) == 0 {
+		return nil, nil
+	}
+	msgTypeURL := sdk.MsgTypeURL(msgs[0])
+	for _, msg := range msgs[1:] {
+		if sdk.MsgTypeURL(msg) != msgTypeURL {
+			return nil, nil
+		}
+	}
+
+	var rv *types.FeeSponsorship
+	err := k.IterateFeeSponsorships(ctx, types.GetFeeSponsorshipMsgTypePrefix(sponsor, msgTypeURL), func(sponsorship types.FeeSponsorship) bool {
+		if sponsorship.CoversAll(msgs) {
+			rv = &sponsorship
+			return true
+		}
+		return false
+	})
+	return rv, err
+}
+
+// UseSponsoredFees has the sponsor pay the fee for the fee payer if one of the sponsor's fee sponsorships covers all the msgs.
+// Returns false if there isn't such a sponsorship. Returns an error if the fee would exceed
+// the sponsorship's remaining budget or the fee payer's daily cap.
+func (k Keeper) UseSponsoredFees(ctx sdk.Context, sponsor, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	sponsorship, err := k.FindFeeSponsorship(ctx, sponsor, msgs)
+	if err != nil || sponsorship == nil {
+		return false, err
+	}
+	if fee.IsZero() {
+		return true, nil
+	}
+
+	spent := sponsorship.Spent.Add(fee...)
+	if !spent.IsAllLTE(sponsorship.Budget) {
+		return false, types.ErrSponsorshipExceeded.Wrapf("fee %q is more than the remaining budget %q of sponsor %s for %q",
+			fee, sponsorship.Remaining(), sponsor, sponsorship.MsgTypeUrl)
+	}
+
+	day := types.GetBlockDay(ctx.BlockTime())
+	userSpend, err := k.GetFeeSponsorshipUserSpend(ctx, *sponsorship, feePayer)
+	if err != nil {
+		return false, err
+	}
+	spentToday := userSpend.SpentOn(day).Add(fee...)
+	if !sponsorship.DailyUserCap.IsZero() && !spentToday.IsAllLTE(sponsorship.DailyUserCap) {
+		return false, types.ErrSponsorshipExceeded.Wrapf("fee %q is more than the %q that sponsor %s will still pay for %s today",
+			fee, types.RemainingToday(sponsorship.DailyUserCap, userSpend.SpentOn(day)), sponsor, feePayer)
+	}
+
+	sponsorship.Spent = spent
+	k.SetFeeSponsorship(ctx, *sponsorship)
+	k.setFeeSponsorshipUserSpend(ctx, *sponsorship, feePayer, types.FeeSponsorshipUserSpend{Day: day, Spent: spentToday})
+	return true, ctx.EventManager().EmitTypedEvent(types.NewEventFeeSponsored(*sponsorship, feePayer.String(), fee))
+}
@@ -0,0 +1,324 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// setUpSponsorshipAccounts creates market 3 that addrs[0] can withdraw from and marker "mycoin" that addrs[1] can withdraw from.
+func (s *TestSuite) setUpSponsorshipAccounts(ctx sdk.Context) {
+	_, err := s.app.ExchangeKeeper.CreateMarket(ctx, exchange.Market{
+		MarketId: 3,
+		AccessGrants: []exchange.AccessGrant{
+			{Address: s.addrs[0].String(), Permissions: []exchange.Permission{exchange.Permission_withdraw}},
+		},
+	})
+	s.Require().NoError(err, "CreateMarket")
+
+	grants := []markertypes.AccessGrant{*markertypes.NewAccessGrant(s.addrs[1], markertypes.AccessList{markertypes.Access_Withdraw})}
+	marker := markertypes.NewEmptyMarkerAccount("mycoin", s.addrs[1].String(), grants)
+	s.Require().NoError(s.app.MarkerKeeper.AddMarkerAccount(ctx, marker), "AddMarkerAccount")
+}
+
+func (s *TestSuite) TestUpdateFeeSponsorship() {
+	ctx := s.ctx
+	s.setUpSponsorshipAccounts(ctx)
+	bidType := sdk.MsgTypeURL(&exchange.MsgCreateBidRequest{})
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	budget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	marketAddr := exchange.GetMarketAddress(3)
+	markerAddr := markertypes.MustGetMarkerAddress("mycoin")
+
+	tests := []struct {
+		name       string
+		admin      sdk.AccAddress
+		msgType    string
+		marketID   uint32
+		denom      string
+		expSponsor sdk.AccAddress
+		expErr     string
+	}{
+		{
+			name:       "market withdrawer",
+			admin:      s.addrs[0],
+			msgType:    bidType,
+			marketID:   3,
+			expSponsor: marketAddr,
+		},
+		{
+			name:     "not a market withdrawer",
+			admin:    s.addrs[1],
+			msgType:  bidType,
+			marketID: 3,
+			expErr:   "account " + s.addrs[1].String() + " does not have permission to withdraw funds from market 3: invalid fee sponsorship",
+		},
+		{
+			name:       "marker withdrawer",
+			admin:      s.addrs[1],
+			msgType:    sendType,
+			denom:      "mycoin",
+			expSponsor: markerAddr,
+		},
+		{
+			name:    "not a marker withdrawer",
+			admin:   s.addrs[0],
+			msgType: sendType,
+			denom:   "mycoin",
+			expErr:  "account " + s.addrs[0].String() + " does not have ACCESS_WITHDRAW access on marker \"mycoin\": invalid fee sponsorship",
+		},
+		{
+			name:    "unknown marker",
+			admin:   s.addrs[0],
+			msgType: sendType,
+			denom:   "othercoin",
+			expErr:  "marker \"othercoin\" not found: marker othercoin not found for address: " + markertypes.MustGetMarkerAddress("othercoin").String() + ": invalid fee sponsorship",
+		},
+		{
+			name:       "own account",
+			admin:      s.addrs[2],
+			msgType:    sendType,
+			expSponsor: s.addrs[2],
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			err := s.app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, tc.admin.String(), tc.msgType, tc.marketID, tc.denom, budget, nil)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "UpdateFeeSponsorship")
+				return
+			}
+			s.Require().NoError(err, "UpdateFeeSponsorship")
+			sponsorship, err := s.app.MsgFeesKeeper.GetFeeSponsorship(ctx, tc.expSponsor, tc.msgType, tc.marketID, tc.denom)
+			s.Require().NoError(err, "GetFeeSponsorship")
+			s.Require().NotNil(sponsorship, "GetFeeSponsorship")
+			s.Assert().Equal(budget.String(), sponsorship.Budget.String(), "Budget")
+		})
+	}
+
+	s.Run("update keeps spent", func() {
+		sponsorship, err := s.app.MsgFeesKeeper.GetFeeSponsorship(ctx, s.addrs[2], sendType, 0, "")
+		s.Require().NoError(err, "GetFeeSponsorship")
+		sponsorship.Spent = sdk.NewCoins(sdk.NewInt64Coin("nhash", 300))
+		s.app.MsgFeesKeeper.SetFeeSponsorship(ctx, *sponsorship)
+
+		newBudget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 2000))
+		userCap := sdk.NewCoins(sdk.NewInt64Coin("nhash", 50))
+		err = s.app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, s.addrs[2].String(), sendType, 0, "", newBudget, userCap)
+		s.Require().NoError(err, "UpdateFeeSponsorship")
+		sponsorship, err = s.app.MsgFeesKeeper.GetFeeSponsorship(ctx, s.addrs[2], sendType, 0, "")
+		s.Require().NoError(err, "GetFeeSponsorship after update")
+		s.Assert().Equal("2000nhash", sponsorship.Budget.String(), "Budget")
+		s.Assert().Equal("50nhash", sponsorship.DailyUserCap.String(), "DailyUserCap")
+		s.Assert().Equal("300nhash", sponsorship.Spent.String(), "Spent")
+		s.Assert().Equal("1700nhash", sponsorship.Remaining().String(), "Remaining")
+	})
+
+	s.Run("remove", func() {
+		err := s.app.MsgFeesKeeper.DeleteFeeSponsorship(ctx, s.addrs[0].String(), sendType, 0, "mycoin")
+		s.Require().EqualError(err, "account "+s.addrs[0].String()+" does not have ACCESS_WITHDRAW access on marker \"mycoin\": invalid fee sponsorship", "DeleteFeeSponsorship without permission")
+
+		err = s.app.MsgFeesKeeper.DeleteFeeSponsorship(ctx, s.addrs[1].String(), sendType, 0, "mycoin")
+		s.Require().NoError(err, "DeleteFeeSponsorship")
+		sponsorship, err := s.app.MsgFeesKeeper.GetFeeSponsorship(ctx, markerAddr, sendType, 0, "mycoin")
+		s.Require().NoError(err, "GetFeeSponsorship after remove")
+		s.Assert().Nil(sponsorship, "GetFeeSponsorship after remove")
+
+		err = s.app.MsgFeesKeeper.DeleteFeeSponsorship(ctx, s.addrs[1].String(), sendType, 0, "mycoin")
+		s.Require().EqualError(err, "sponsor "+markerAddr.String()+" does not sponsor \""+sendType+"\": invalid fee sponsorship", "DeleteFeeSponsorship again")
+	})
+
+	all, err := s.app.MsgFeesKeeper.GetAllFeeSponsorships(ctx)
+	s.Require().NoError(err, "GetAllFeeSponsorships")
+	s.Assert().Len(all, 2, "GetAllFeeSponsorships")
+}
+
+func (s *TestSuite) TestUseSponsoredFees() {
+	blockTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := s.ctx.WithBlockTime(blockTime)
+	s.setUpSponsorshipAccounts(ctx)
+	bidType := sdk.MsgTypeURL(&exchange.MsgCreateBidRequest{})
+	sponsor := exchange.GetMarketAddress(3)
+	trader1, trader2 := s.addrs[2], s.addrs[3]
+
+	budget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	userCap := sdk.NewCoins(sdk.NewInt64Coin("nhash", 40))
+	s.Require().NoError(s.app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, s.addrs[0].String(), bidType, 3, "", budget, userCap), "UpdateFeeSponsorship")
+
+	bid := func(marketID uint32) sdk.Msg {
+		return &exchange.MsgCreateBidRequest{BidOrder: exchange.BidOrder{MarketId: marketID}}
+	}
+	nhash := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin("nhash", amount))
+	}
+
+	tests := []struct {
+		name         string
+		ctx          sdk.Context
+		feePayer     sdk.AccAddress
+		fee          sdk.Coins
+		msgs         []sdk.Msg
+		expUsed      bool
+		expErr       string
+		expSpent     string
+		expUserToday string
+	}{
+		{
+			name:     "other market",
+			ctx:      ctx,
+			feePayer: trader1,
+			fee:      nhash(10),
+			msgs:     []sdk.Msg{bid(4)},
+			expUsed:  false,
+			expSpent: "",
+		},
+		{
+			name:     "mixed msg types",
+			ctx:      ctx,
+			feePayer: trader1,
+			fee:      nhash(10),
+			msgs:     []sdk.Msg{bid(3), &banktypes.MsgSend{}},
+			expUsed:  false,
+			expSpent: "",
+		},
+		{
+			name:         "first use",
+			ctx:          ctx,
+			feePayer:     trader1,
+			fee:          nhash(30),
+			msgs:         []sdk.Msg{bid(3)},
+			expUsed:      true,
+			expSpent:     "30nhash",
+			expUserToday: "30nhash",
+		},
+		{
+			name:     "over daily user cap",
+			ctx:      ctx,
+			feePayer: trader1,
+			fee:      nhash(11),
+			msgs:     []sdk.Msg{bid(3), bid(3)},
+			expErr: "fee \"11nhash\" is more than the \"10nhash\" that sponsor " + sponsor.String() +
+				" will still pay for " + trader1.String() + " today: fee sponsorship limit exceeded",
+			expSpent:     "30nhash",
+			expUserToday: "30nhash",
+		},
+		{
+			name:     "fee denom not in budget",
+			ctx:      ctx,
+			feePayer: trader2,
+			fee:      sdk.NewCoins(sdk.NewInt64Coin("stake", 1)),
+			msgs:     []sdk.Msg{bid(3)},
+			expErr: "fee \"1stake\" is more than the remaining budget \"70nhash\" of sponsor " + sponsor.String() +
+				" for \"" + bidType + "\": fee sponsorship limit exceeded",
+			expSpent: "30nhash",
+		},
+		{
+			name:         "other user",
+			ctx:          ctx,
+			feePayer:     trader2,
+			fee:          nhash(40),
+			msgs:         []sdk.Msg{bid(3)},
+			expUsed:      true,
+			expSpent:     "70nhash",
+			expUserToday: "40nhash",
+		},
+		{
+			name:         "next day",
+			ctx:          ctx.WithBlockTime(blockTime.Add(24 * time.Hour)),
+			feePayer:     trader1,
+			fee:          nhash(25),
+			msgs:         []sdk.Msg{bid(3)},
+			expUsed:      true,
+			expSpent:     "95nhash",
+			expUserToday: "25nhash",
+		},
+		{
+			name:     "over budget",
+			ctx:      ctx.WithBlockTime(blockTime.Add(24 * time.Hour)),
+			feePayer: trader2,
+			fee:      nhash(6),
+			msgs:     []sdk.Msg{bid(3)},
+			expErr: "fee \"6nhash\" is more than the remaining budget \"5nhash\" of sponsor " + sponsor.String() +
+				" for \"" + bidType + "\": fee sponsorship limit exceeded",
+			expSpent: "95nhash",
+		},
+		{
+			name:     "zero fee",
+			ctx:      ctx,
+			feePayer: trader2,
+			fee:      sdk.Coins{},
+			msgs:     []sdk.Msg{bid(3)},
+			expUsed:  true,
+			expSpent: "95nhash",
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			em := sdk.NewEventManager()
+			used, err := s.app.MsgFeesKeeper.UseSponsoredFees(tc.ctx.WithEventManager(em), sponsor, tc.feePayer, tc.fee, tc.msgs)
+			if len(tc.expErr) > 0 {
+				s.Require().EqualError(err, tc.expErr, "UseSponsoredFees error")
+			} else {
+				s.Require().NoError(err, "UseSponsoredFees error")
+			}
+			s.Assert().Equal(tc.expUsed, used, "UseSponsoredFees used")
+			if tc.expUsed && !tc.fee.IsZero() {
+				s.Assert().Len(em.Events(), 1, "events emitted")
+			} else {
+				s.Assert().Empty(em.Events(), "events emitted")
+			}
+
+			sponsorship, err := s.app.MsgFeesKeeper.GetFeeSponsorship(ctx, sponsor, bidType, 3, "")
+			s.Require().NoError(err, "GetFeeSponsorship")
+			s.Assert().Equal(tc.expSpent, sponsorship.Spent.String(), "Spent")
+
+			if len(tc.expUserToday) > 0 {
+				resp, err := s.app.MsgFeesKeeper.FeeSponsorshipUsage(tc.ctx, &types.QueryFeeSponsorshipUsageRequest{
+					Sponsor:    sponsor.String(),
+					FeePayer:   tc.feePayer.String(),
+					MsgTypeUrl: bidType,
+					MarketId:   3,
+				})
+				s.Require().NoError(err, "FeeSponsorshipUsage")
+				s.Assert().Equal(tc.expUserToday, resp.SpentToday.String(), "SpentToday")
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestFeeSponsorshipsQuery() {
+	ctx := s.ctx
+	s.setUpSponsorshipAccounts(ctx)
+	bidType := sdk.MsgTypeURL(&exchange.MsgCreateBidRequest{})
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	budget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000))
+	s.Require().NoError(s.app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, s.addrs[0].String(), bidType, 3, "", budget, nil), "UpdateFeeSponsorship market")
+	s.Require().NoError(s.app.MsgFeesKeeper.UpdateFeeSponsorship(ctx, s.addrs[1].String(), sendType, 0, "mycoin", budget, nil), "UpdateFeeSponsorship marker")
+
+	resp, err := s.app.MsgFeesKeeper.FeeSponsorships(ctx, &types.QueryFeeSponsorshipsRequest{})
+	s.Require().NoError(err, "FeeSponsorships all")
+	s.Assert().Len(resp.FeeSponsorships, 2, "FeeSponsorships all")
+
+	resp, err = s.app.MsgFeesKeeper.FeeSponsorships(ctx, &types.QueryFeeSponsorshipsRequest{Sponsor: exchange.GetMarketAddress(3).String()})
+	s.Require().NoError(err, "FeeSponsorships for market")
+	s.Require().Len(resp.FeeSponsorships, 1, "FeeSponsorships for market")
+	s.Assert().Equal(bidType, resp.FeeSponsorships[0].FeeSponsorship.MsgTypeUrl, "MsgTypeUrl")
+	s.Assert().Equal("1000nhash", resp.FeeSponsorships[0].Remaining.String(), "Remaining")
+
+	_, err = s.app.MsgFeesKeeper.FeeSponsorships(ctx, &types.QueryFeeSponsorshipsRequest{Sponsor: "bad"})
+	s.Require().ErrorContains(err, "invalid sponsor", "FeeSponsorships bad sponsor")
+
+	_, err = s.app.MsgFeesKeeper.FeeSponsorshipUsage(ctx, &types.QueryFeeSponsorshipUsageRequest{
+		Sponsor:    s.addrs[3].String(),
+		FeePayer:   s.addrs[2].String(),
+		MsgTypeUrl: sendType,
+	})
+	s.Require().ErrorContains(err, "does not sponsor", "FeeSponsorshipUsage unknown sponsorship")
+}
@@ -0,0 +1,272 @@
+package keeper
+
+import (
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// AddUsdRateSource registers a source of usd rates under the provided name.
+// Usd fee denoms identify the source of their rate using that name.
+func (k *Keeper) AddUsdRateSource(name string, source types.UsdRateSource) {
+	if k.usdRateSources == nil {
+		k.usdRateSources = make(map[string]types.UsdRateSource)
+	}
+	k.usdRateSources[name] = source
+}
+
+// HasUsdRateSource returns true if a usd rate source has been registered with the provided name.
+func (k Keeper) HasUsdRateSource(name string) bool {
+	_, found := k.usdRateSources[name]
+	return found
+}
+
+// SetUsdFeeDenom stores a denom that can be used to pay usd msg fees.
+func (k Keeper) SetUsdFeeDenom(ctx sdk.Context, usdFeeDenom types.UsdFeeDenom) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&usdFeeDenom)
+	store.Set(types.GetUsdFeeDenomKey(usdFeeDenom.Denom), bz)
+}
+
+// GetUsdFeeDenom returns the usd fee denom entry for a denom, or nil if the denom can't be used to pay usd msg fees.
+func (k Keeper) GetUsdFeeDenom(ctx sdk.Context, denom string) (*types.UsdFeeDenom, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetUsdFeeDenomKey(denom))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var usdFeeDenom types.UsdFeeDenom
+	if err := k.cdc.Unmarshal(bz, &usdFeeDenom); err != nil {
+		return nil, err
+	}
+	return &usdFeeDenom, nil
+}
+
+// RemoveUsdFeeDenom removes a usd fee denom or returns an error if it does not exist.
+func (k Keeper) RemoveUsdFeeDenom(ctx sdk.Context, denom string) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetUsdFeeDenomKey(denom)
+	if !store.Has(key) {
+		return types.ErrInvalidUsdFeeDenom.Wrapf("denom %q is not a usd fee denom", denom)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// IterateUsdFeeDenoms iterates all usd fee denoms with the given handler function.
+func (k Keeper) IterateUsdFeeDenoms(ctx sdk.Context, handle func(usdFeeDenom types.UsdFeeDenom) (stop bool)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.UsdFeeDenomKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		record := types.UsdFeeDenom{}
+		if err := k.cdc.Unmarshal(iterator.Value(), &record); err != nil {
+			return err
+		}
+		if handle(record) {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllUsdFeeDenoms returns all the denoms that can be used to pay usd msg fees.
+func (k Keeper) GetAllUsdFeeDenoms(ctx sdk.Context) ([]types.UsdFeeDenom, error) {
+	usdFeeDenoms := make([]types.UsdFeeDenom, 0)
+	err := k.IterateUsdFeeDenoms(ctx, func(usdFeeDenom types.UsdFeeDenom) bool {
+		usdFeeDenoms = append(usdFeeDenoms, usdFeeDenom)
+		return false
+	})
+	return usdFeeDenoms, err
+}
+
+// UpdateUsdFeeDenoms sets and removes usd fee denoms.
+// Each usd fee denom being set must use a registered rate source.
+func (k Keeper) UpdateUsdFeeDenoms(ctx sdk.Context, toSet []types.UsdFeeDenom, toRemove []string) error {
+	if err := types.ValidateUsdFeeDenoms(toSet); err != nil {
+		return types.ErrInvalidUsdFeeDenom.Wrap(err.Error())
+	}
+	for _, usdFeeDenom := range toSet {
+		if !k.HasUsdRateSource(usdFeeDenom.RateSource) {
+			return types.ErrInvalidUsdFeeDenom.Wrapf("denom %q: unknown rate source %q", usdFeeDenom.Denom, usdFeeDenom.RateSource)
+		}
+	}
+
+	for _, denom := range toRemove {
+		if err := k.RemoveUsdFeeDenom(ctx, denom); err != nil {
+			return err
+		}
+	}
+	for _, usdFeeDenom := range toSet {
+		k.SetUsdFeeDenom(ctx, usdFeeDenom)
+	}
+	return nil
+}
+
+// GetUsdRate gets the current rate of a usd fee denom from its rate source.
+// Returns nil if the source doesn't have a rate for it.
+func (k Keeper) GetUsdRate(ctx sdk.Context, usdFeeDenom types.UsdFeeDenom) (*types.UsdRate, error) {
+	source, found := k.usdRateSources[usdFeeDenom.RateSource]
+	if !found {
+		return nil, types.ErrUsdRateUnavailable.Wrapf("denom %q: unknown rate source %q", usdFeeDenom.Denom, usdFeeDenom.RateSource)
+	}
+	return source.GetUsdRate(ctx, usdFeeDenom)
+}
+
+// getFreshUsdRate gets the rate of a denom if it is a usd fee denom and its rate isn't stale.
+// Returns nil if the denom can't currently be used to pay usd fees.
+func (k Keeper) getFreshUsdRate(ctx sdk.Context, denom string) (*types.UsdRate, error) {
+	usdFeeDenom, err := k.GetUsdFeeDenom(ctx, denom)
+	if err != nil || usdFeeDenom == nil {
+		return nil, err
+	}
+	rate, err := k.GetUsdRate(ctx, *usdFeeDenom)
+	if err != nil || rate == nil {
+		return nil, err
+	}
+	if !rate.IsValid() || rate.IsStale(ctx.BlockHeight(), usdFeeDenom.MaxAgeBlocks) {
+		return nil, nil
+	}
+	return rate, nil
+}
+
+// ConvertUsdFee converts a fee priced in usd mils into the first of the fee coins' denoms
+// that is a usd fee denom with a fresh rate. If none of them are, the fee is converted
+// to the conversion fee denom using the nhash per usd mil param.
+// Fees that aren't in usd are returned unchanged.
+func (k Keeper) ConvertUsdFee(ctx sdk.Context, usdFee sdk.Coin, feeCoins sdk.Coins) (sdk.Coin, error) {
+	if usdFee.Denom != types.UsdDenom {
+		return usdFee, nil
+	}
+
+	for _, feeCoin := range feeCoins {
+		rate, err := k.getFreshUsdRate(ctx, feeCoin.Denom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		if rate != nil {
+			return rate.Convert(feeCoin.Denom, usdFee.Amount), nil
+		}
+	}
+
+	return k.ConvertDenomToHash(ctx, usdFee)
+}
+
+// ConvertUsdFees converts all the usd amounts in a fee distribution using ConvertUsdFee.
+// The converted total is the sum of the converted module and recipient amounts.
+func (k Keeper) ConvertUsdFees(ctx sdk.Context, feeDist types.MsgFeesDistribution, feeCoins sdk.Coins) (types.MsgFeesDistribution, error) {
+	if feeDist.TotalAdditionalFees.AmountOf(types.UsdDenom).IsZero() {
+		return feeDist, nil
+	}
+
+	rv := types.MsgFeesDistribution{
+		RecipientDistributions: make(map[string]sdk.Coins, len(feeDist.RecipientDistributions)),
+		Assessed:               feeDist.Assessed,
+	}
+
+	var err error
+	rv.AdditionalModuleFees, err = k.convertUsdCoins(ctx, feeDist.AdditionalModuleFees, feeCoins)
+	if err != nil {
+		return feeDist, err
+	}
+	rv.TotalAdditionalFees = rv.AdditionalModuleFees
+
+	recipients := make([]string, 0, len(feeDist.RecipientDistributions))
+	for recipient := range feeDist.RecipientDistributions {
+		recipients = append(recipients, recipient)
+	}
+	sort.Strings(recipients)
+	for _, recipient := range recipients {
+		coins, err := k.convertUsdCoins(ctx, feeDist.RecipientDistributions[recipient], feeCoins)
+		if err != nil {
+			return feeDist, err
+		}
+		rv.RecipientDistributions[recipient] = coins
+		rv.TotalAdditionalFees = rv.TotalAdditionalFees.Add(coins...)
+	}
+
+	return rv, nil
+}
+
+// convertUsdCoins converts the usd amount (if any) in the provided coins using ConvertUsdFee.
+func (k Keeper) convertUsdCoins(ctx sdk.Context, coins sdk.Coins, feeCoins sdk.Coins) (sdk.Coins, error) {
+	usdAmount := coins.AmountOf(types.UsdDenom)
+	if usdAmount.IsZero() {
+		return coins, nil
+	}
+	converted, err := k.ConvertUsdFee(ctx, sdk.NewCoin(types.UsdDenom, usdAmount), feeCoins)
+	if err != nil {
+		return nil, err
+	}
+	rv := coins.Sub(sdk.NewCoin(types.UsdDenom, usdAmount))
+	return rv.Add(converted), nil
+}
+
+// MarkerNavUsdRateSource is a usd rate source that uses the usd net asset value of a marker.
+type MarkerNavUsdRateSource struct {
+	markerKeeper types.MarkerKeeper
+}
+
+var _ types.UsdRateSource = MarkerNavUsdRateSource{}
+
+// NewMarkerNavUsdRateSource creates a new MarkerNavUsdRateSource.
+func NewMarkerNavUsdRateSource(markerKeeper types.MarkerKeeper) MarkerNavUsdRateSource {
+	return MarkerNavUsdRateSource{markerKeeper: markerKeeper}
+}
+
+// GetUsdRate returns the usd net asset value of the fee denom's marker.
+func (s MarkerNavUsdRateSource) GetUsdRate(ctx sdk.Context, feeDenom types.UsdFeeDenom) (*types.UsdRate, error) {
+	nav, err := s.markerKeeper.GetNetAssetValue(ctx, feeDenom.Denom, types.UsdDenom)
+	if err != nil || nav == nil {
+		return nil, err
+	}
+	return types.NewUsdRate(nav.Price.Amount, sdkmath.NewIntFromUint64(nav.Volume), int64(nav.UpdatedBlockHeight)), nil
+}
+
+// ExchangeUsdRateMaxTrades is the most trades that the exchange usd rate source averages.
+const ExchangeUsdRateMaxTrades = 100
+
+// ExchangeUsdRateMinTrades is the fewest trades that the exchange usd rate source needs to provide a rate.
+const ExchangeUsdRateMinTrades = 3
+
+// ExchangeUsdRateSource is a usd rate source that uses the recent usd trades of the fee denom in an exchange market.
+type ExchangeUsdRateSource struct {
+	exchangeKeeper types.ExchangeKeeper
+}
+
+var _ types.UsdRateSource = ExchangeUsdRateSource{}
+
+// NewExchangeUsdRateSource creates a new ExchangeUsdRateSource.
+func NewExchangeUsdRateSource(exchangeKeeper types.ExchangeKeeper) ExchangeUsdRateSource {
+	return ExchangeUsdRateSource{exchangeKeeper: exchangeKeeper}
+}
+
+// GetUsdRate returns the volume-weighted average price of the fee denom's recent trades for usd in its market.
+// Up to ExchangeUsdRateMaxTrades trades are used, from the previous blocks in which the rate would not be stale.
+// Trades from the current block are not used, so a rate can't be moved by trades in the same block as the fees.
+// No rate is provided unless there are at least ExchangeUsdRateMinTrades of those trades.
+// The rate is as of the most recent of those trades.
+func (s ExchangeUsdRateSource) GetUsdRate(ctx sdk.Context, feeDenom types.UsdFeeDenom) (*types.UsdRate, error) {
+	minBlockHeight := ctx.BlockHeight() - int64(feeDenom.MaxAgeBlocks)
+	if feeDenom.MaxAgeBlocks > uint64(ctx.BlockHeight()) {
+		minBlockHeight = 0
+	}
+	trades, err := s.exchangeKeeper.GetRecentMarketTrades(ctx, feeDenom.MarketId, feeDenom.Denom, types.UsdDenom,
+		minBlockHeight, ctx.BlockHeight()-1, ExchangeUsdRateMaxTrades)
+	if err != nil || len(trades) < ExchangeUsdRateMinTrades {
+		return nil, err
+	}
+	usdMils, volume := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, trade := range trades {
+		usdMils = usdMils.Add(trade.Price.Amount)
+		volume = volume.Add(trade.Assets.Amount)
+	}
+	return types.NewUsdRate(usdMils, volume, trades[0].BlockHeight), nil
+}
@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+	msgfeeskeeper "github.com/provenance-io/provenance/x/msgfees/keeper"
+	"github.com/provenance-io/provenance/x/msgfees/types"
+)
+
+// mockExchangeKeeper is a types.ExchangeKeeper with a fixed list of trades, newest first.
+type mockExchangeKeeper struct {
+	trades []*exchange.Trade
+}
+
+func (m mockExchangeKeeper) GetRecentMarketTrades(_ sdk.Context, marketID uint32, assetDenom, priceDenom string, minBlockHeight, maxBlockHeight int64, maxTrades int) ([]*exchange.Trade, error) {
+	var rv []*exchange.Trade
+	for _, trade := range m.trades {
+		if len(rv) == maxTrades || trade.BlockHeight < minBlockHeight {
+			break
+		}
+		if trade.BlockHeight <= maxBlockHeight && trade.MarketId == marketID && trade.Assets.Denom == assetDenom && trade.Price.Denom == priceDenom {
+			rv = append(rv, trade)
+		}
+	}
+	return rv, nil
+}
+
+func (m mockExchangeKeeper) CanWithdrawMarketFunds(_ sdk.Context, _ uint32, _ string) bool {
+	return false
+}
+
+func (s *TestSuite) TestUpdateUsdFeeDenoms() {
+	ctx := s.ctx
+	stable := types.NewUsdFeeDenom("usdstable", types.UsdRateSourceMarkerNav, 0, 100)
+	traded := types.NewUsdFeeDenom("usdtraded", types.UsdRateSourceExchange, 3, 10)
+
+	err := s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, []types.UsdFeeDenom{types.NewUsdFeeDenom("usdstable", "unknown", 0, 100)}, nil)
+	s.Require().EqualError(err, `denom "usdstable": unknown rate source "unknown": invalid usd fee denom`, "UpdateUsdFeeDenoms unknown rate source")
+
+	err = s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, []types.UsdFeeDenom{stable, traded}, nil)
+	s.Require().NoError(err, "UpdateUsdFeeDenoms set")
+	all, err := s.app.MsgFeesKeeper.GetAllUsdFeeDenoms(ctx)
+	s.Require().NoError(err, "GetAllUsdFeeDenoms after set")
+	s.Assert().Equal([]types.UsdFeeDenom{stable, traded}, all, "GetAllUsdFeeDenoms after set")
+
+	err = s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, nil, []string{"notset"})
+	s.Require().EqualError(err, `denom "notset" is not a usd fee denom: invalid usd fee denom`, "UpdateUsdFeeDenoms remove unknown")
+
+	err = s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, nil, []string{"usdtraded"})
+	s.Require().NoError(err, "UpdateUsdFeeDenoms remove")
+	all, err = s.app.MsgFeesKeeper.GetAllUsdFeeDenoms(ctx)
+	s.Require().NoError(err, "GetAllUsdFeeDenoms after remove")
+	s.Assert().Equal([]types.UsdFeeDenom{stable}, all, "GetAllUsdFeeDenoms after remove")
+}
+
+func (s *TestSuite) TestConvertUsdFee() {
+	ctx := s.ctx.WithBlockHeight(100)
+	conversionDenom := s.app.MsgFeesKeeper.GetConversionFeeDenom(ctx)
+	nhashPerMil := int64(s.app.MsgFeesKeeper.GetNhashPerUsdMil(ctx))
+
+	// usdstable is worth $1.000 for 4 units, i.e. 4 units per usd mil.
+	marker := markertypes.NewEmptyMarkerAccount("usdstable", s.addrs[0].String(), nil)
+	nav := markertypes.NewNetAssetValue(sdk.NewInt64Coin(types.UsdDenom, 1000), 4000)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(ctx.WithBlockHeight(95), marker, nav, "test"), "SetNetAssetValue")
+	s.Require().NoError(s.app.MsgFeesKeeper.UpdateUsdFeeDenoms(ctx, []types.UsdFeeDenom{
+		types.NewUsdFeeDenom("usdstable", types.UsdRateSourceMarkerNav, 0, 10),
+		types.NewUsdFeeDenom("usdstale", types.UsdRateSourceMarkerNav, 0, 10),
+	}, nil), "UpdateUsdFeeDenoms")
+	staleMarker := markertypes.NewEmptyMarkerAccount("usdstale", s.addrs[0].String(), nil)
+	s.Require().NoError(s.app.MarkerKeeper.SetNetAssetValue(ctx.WithBlockHeight(89), staleMarker, nav, "test"), "SetNetAssetValue stale")
+
+	tests := []struct {
+		name     string
+		usdFee   sdk.Coin
+		feeCoins sdk.Coins
+		exp      sdk.Coin
+	}{
+		{
+			name:     "not usd",
+			usdFee:   sdk.NewInt64Coin("nhash", 5),
+			feeCoins: sdk.NewCoins(sdk.NewInt64Coin("usdstable", 1)),
+			exp:      sdk.NewInt64Coin("nhash", 5),
+		},
+		{
+			name:     "fresh usd fee denom provided",
+			usdFee:   sdk.NewInt64Coin(types.UsdDenom, 250),
+			feeCoins: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1000), sdk.NewInt64Coin("usdstable", 1)),
+			exp:      sdk.NewInt64Coin("usdstable", 1000),
+		},
+		{
+			name:     "stale usd fee denom provided",
+			usdFee:   sdk.NewInt64Coin(types.UsdDenom, 250),
+			feeCoins: sdk.NewCoins(sdk.NewInt64Coin("usdstale", 1)),
+			exp:      sdk.NewInt64Coin(conversionDenom, 250*nhashPerMil),
+		},
+		{
+			name:     "no usd fee denom provided",
+			usdFee:   sdk.NewInt64Coin(types.UsdDenom, 3),
+			feeCoins: sdk.NewCoins(sdk.NewInt64Coin("nhash", 1)),
+			exp:      sdk.NewInt64Coin(conversionDenom, 3*nhashPerMil),
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			actual, err := s.app.MsgFeesKeeper.ConvertUsdFee(ctx, tc.usdFee, tc.feeCoins)
+			s.Require().NoError(err, "ConvertUsdFee")
+			s.Assert().Equal(tc.exp.String(), actual.String(), "ConvertUsdFee")
+		})
+	}
+
+	s.Run("distribution", func() {
+		recipient := s.addrs[1].String()
+		feeDist := types.MsgFeesDistribution{RecipientDistributions: make(map[string]sdk.Coins)}
+		s.Require().NoError(feeDist.Increase(sdk.NewInt64Coin(types.UsdDenom, 101), 5_000, recipient), "Increase usd")
+		s.Require().NoError(feeDist.Increase(sdk.NewInt64Coin("nhash", 7), 0, ""), "Increase nhash")
+
+		actual, err := s.app.MsgFeesKeeper.ConvertUsdFees(ctx, feeDist, sdk.NewCoins(sdk.NewInt64Coin("usdstable", 1)))
+		s.Require().NoError(err, "ConvertUsdFees")
+		s.Assert().Equal("7nhash,204usdstable", actual.AdditionalModuleFees.String(), "AdditionalModuleFees")
+		s.Assert().Equal("200usdstable", actual.RecipientDistributions[recipient].String(), "recipient distribution")
+		s.Assert().Equal("7nhash,404usdstable", actual.TotalAdditionalFees.String(), "TotalAdditionalFees")
+	})
+}
+
+func (s *TestSuite) TestExchangeUsdRateSource() {
+	trade := func(blockHeight int64, assets, usdMils int64) *exchange.Trade {
+		return &exchange.Trade{
+			MarketId:    3,
+			Assets:      sdk.NewInt64Coin("usdtraded", assets),
+			Price:       sdk.NewInt64Coin(types.UsdDenom, usdMils),
+			BlockHeight: blockHeight,
+		}
+	}
+	// A small trade at an outlying price barely moves the rate, and trades from the current block
+	// or from before max_age_blocks are ignored.
+	source := msgfeeskeeper.NewExchangeUsdRateSource(mockExchangeKeeper{trades: []*exchange.Trade{
+		trade(45, 1000, 5000),
+		trade(43, 1, 1000),
+		trade(42, 2000, 1000),
+		trade(40, 1000, 800),
+		trade(30, 1000, 100),
+	}})
+	ctx := s.ctx.WithBlockHeight(45)
+
+	rate, err := source.GetUsdRate(ctx, types.NewUsdFeeDenom("usdtraded", types.UsdRateSourceExchange, 3, 10))
+	s.Require().NoError(err, "GetUsdRate")
+	s.Assert().Equal(types.NewUsdRate(sdkmath.NewInt(2800), sdkmath.NewInt(3001), 43), rate, "GetUsdRate")
+
+	rate, err = source.GetUsdRate(ctx, types.NewUsdFeeDenom("usdtraded", types.UsdRateSourceExchange, 3, 100))
+	s.Require().NoError(err, "GetUsdRate all trades")
+	s.Assert().Equal(types.NewUsdRate(sdkmath.NewInt(2900), sdkmath.NewInt(4001), 43), rate, "GetUsdRate all trades")
+
+	rate, err = source.GetUsdRate(ctx, types.NewUsdFeeDenom("usdtraded", types.UsdRateSourceExchange, 3, 4))
+	s.Require().NoError(err, "GetUsdRate too few trades")
+	s.Assert().Nil(rate, "GetUsdRate too few trades")
+
+	rate, err = source.GetUsdRate(ctx, types.NewUsdFeeDenom("usdtraded", types.UsdRateSourceExchange, 4, 10))
+	s.Require().NoError(err, "GetUsdRate other market")
+	s.Assert().Nil(rate, "GetUsdRate other market")
+}
@@ -0,0 +1,177 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+const (
+	// WaivedReasonFeeFreeWindow is the waived reason used when a msg fee is not charged during a fee-free window.
+	WaivedReasonFeeFreeWindow = "fee-free window"
+	// WaivedReasonExemptAttribute is the waived reason prefix used when the signers of a msg have an exempt attribute.
+	WaivedReasonExemptAttribute = "exempt attribute"
+)
+
+// NewAssessedMsgFee creates a new AssessedMsgFee.
+func NewAssessedMsgFee(msgTypeURL string, fee sdk.Coins, recipient string, waivedReason string) AssessedMsgFee {
+	if len(waivedReason) > 0 {
+		fee = nil
+	}
+	return AssessedMsgFee{
+		MsgTypeUrl:   msgTypeURL,
+		Fee:          fee,
+		Recipient:    recipient,
+		WaivedReason: waivedReason,
+	}
+}
+
+// IsWaived returns true if this assessed msg fee was not charged.
+func (a AssessedMsgFee) IsWaived() bool {
+	return len(a.WaivedReason) > 0
+}
+
+// NewMsgFeeTier creates a new MsgFeeTier.
+func NewMsgFeeTier(minAmount sdkmath.Int, basisPoints uint32) MsgFeeTier {
+	return MsgFeeTier{
+		MinAmount:   minAmount,
+		BasisPoints: basisPoints,
+	}
+}
+
+// NewFeeFreeWindow creates a new FeeFreeWindow.
+func NewFeeFreeWindow(start, end time.Time) FeeFreeWindow {
+	return FeeFreeWindow{
+		Start: start,
+		End:   end,
+	}
+}
+
+// Contains returns true if the provided time is in this window.
+func (w FeeFreeWindow) Contains(blockTime time.Time) bool {
+	return !blockTime.Before(w.Start) && blockTime.Before(w.End)
+}
+
+// HasConditions returns true if any conditions are defined.
+func (c MsgFeeConditions) HasConditions() bool {
+	return len(c.AmountTiers) > 0 || !c.GetMaxFee().IsZero() || len(c.ExemptAttributes) > 0 || len(c.FeeFreeWindows) > 0
+}
+
+// GetMaxFee returns the max fee, treating an unset value as zero.
+func (c MsgFeeConditions) GetMaxFee() sdkmath.Int {
+	if c.MaxFee.IsNil() {
+		return sdkmath.ZeroInt()
+	}
+	return c.MaxFee
+}
+
+// Validate returns an error if these conditions are invalid for a msg fee with the provided additional fee.
+func (c MsgFeeConditions) Validate(additionalFee sdk.Coin) error {
+	for i, tier := range c.AmountTiers {
+		if tier.MinAmount.IsNil() || tier.MinAmount.IsNegative() {
+			return fmt.Errorf("amount tier %d: min amount cannot be negative", i)
+		}
+		if tier.BasisPoints > 10_000 {
+			return fmt.Errorf("amount tier %d: basis points can only be between 0 and 10,000 : %v", i, tier.BasisPoints)
+		}
+		if i > 0 && !tier.MinAmount.GT(c.AmountTiers[i-1].MinAmount) {
+			return fmt.Errorf("amount tier %d: min amount %s must be greater than the previous tier's %s", i, tier.MinAmount, c.AmountTiers[i-1].MinAmount)
+		}
+	}
+	maxFee := c.GetMaxFee()
+	if maxFee.IsNegative() {
+		return fmt.Errorf("max fee cannot be negative")
+	}
+	if !maxFee.IsZero() {
+		if len(c.AmountTiers) == 0 {
+			return fmt.Errorf("max fee requires amount tiers")
+		}
+		if maxFee.LT(additionalFee.Amount) {
+			return fmt.Errorf("max fee %s cannot be less than the additional fee %s", maxFee, additionalFee.Amount)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, attr := range c.ExemptAttributes {
+		if len(strings.TrimSpace(attr)) == 0 {
+			return fmt.Errorf("exempt attribute cannot be empty")
+		}
+		if seen[attr] {
+			return fmt.Errorf("duplicate exempt attribute %q", attr)
+		}
+		seen[attr] = true
+	}
+	for i, window := range c.FeeFreeWindows {
+		if !window.End.After(window.Start) {
+			return fmt.Errorf("fee-free window %d: end must be after start", i)
+		}
+	}
+	return nil
+}
+
+// InFeeFreeWindow returns true if the provided block time is in one of the fee-free windows.
+func (c MsgFeeConditions) InFeeFreeWindow(blockTime time.Time) bool {
+	for _, window := range c.FeeFreeWindows {
+		if window.Contains(blockTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// TierFor returns the amount tier that applies to the provided msg amount, or nil if none do.
+func (c MsgFeeConditions) TierFor(amount sdkmath.Int) *MsgFeeTier {
+	var rv *MsgFeeTier
+	for i, tier := range c.AmountTiers {
+		if amount.LT(tier.MinAmount) {
+			break
+		}
+		rv = &c.AmountTiers[i]
+	}
+	return rv
+}
+
+// CalculateFee returns the fee to charge for a msg that moves the provided amount of the additional fee's denom.
+// Without amount tiers, this is just the additional fee.
+func (c MsgFeeConditions) CalculateFee(additionalFee sdk.Coin, amount sdkmath.Int) sdk.Coin {
+	tier := c.TierFor(amount)
+	if tier == nil {
+		return additionalFee
+	}
+	fee := amount.MulRaw(int64(tier.BasisPoints)).QuoRaw(10_000)
+	if fee.LT(additionalFee.Amount) {
+		fee = additionalFee.Amount
+	}
+	if maxFee := c.GetMaxFee(); !maxFee.IsZero() && fee.GT(maxFee) {
+		fee = maxFee
+	}
+	return sdk.NewCoin(additionalFee.Denom, fee)
+}
+
+// GetMsgAmount returns the amount of the provided denom that a msg moves.
+// Msgs that don't move funds have an amount of zero.
+func GetMsgAmount(msg sdk.Msg, denom string) sdkmath.Int {
+	switch m := msg.(type) {
+	case *banktypes.MsgSend:
+		return m.Amount.AmountOf(denom)
+	case *banktypes.MsgMultiSend:
+		total := sdkmath.ZeroInt()
+		for _, input := range m.Inputs {
+			total = total.Add(input.Coins.AmountOf(denom))
+		}
+		return total
+	case interface{ GetAmount() sdk.Coins }:
+		return m.GetAmount().AmountOf(denom)
+	case interface{ GetAmount() sdk.Coin }:
+		amount := m.GetAmount()
+		if amount.Denom != denom || amount.Amount.IsNil() {
+			return sdkmath.ZeroInt()
+		}
+		return amount.Amount
+	}
+	return sdkmath.ZeroInt()
+}
@@ -0,0 +1,194 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestMsgFeeConditionsValidate(t *testing.T) {
+	fee := sdk.NewInt64Coin("nhash", 100)
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		conditions MsgFeeConditions
+		expErr     string
+	}{
+		{
+			name:       "empty",
+			conditions: MsgFeeConditions{},
+		},
+		{
+			name: "all conditions",
+			conditions: MsgFeeConditions{
+				AmountTiers:      []MsgFeeTier{NewMsgFeeTier(sdkmath.ZeroInt(), 10), NewMsgFeeTier(sdkmath.NewInt(1000), 5)},
+				MaxFee:           sdkmath.NewInt(500),
+				ExemptAttributes: []string{"maker.pb", "taker.pb"},
+				FeeFreeWindows:   []FeeFreeWindow{NewFeeFreeWindow(start, start.Add(time.Hour))},
+			},
+		},
+		{
+			name:       "negative tier min amount",
+			conditions: MsgFeeConditions{AmountTiers: []MsgFeeTier{NewMsgFeeTier(sdkmath.NewInt(-1), 10)}},
+			expErr:     "amount tier 0: min amount cannot be negative",
+		},
+		{
+			name:       "tier bips too large",
+			conditions: MsgFeeConditions{AmountTiers: []MsgFeeTier{NewMsgFeeTier(sdkmath.ZeroInt(), 10_001)}},
+			expErr:     "amount tier 0: basis points can only be between 0 and 10,000 : 10001",
+		},
+		{
+			name: "tiers out of order",
+			conditions: MsgFeeConditions{AmountTiers: []MsgFeeTier{
+				NewMsgFeeTier(sdkmath.NewInt(1000), 10), NewMsgFeeTier(sdkmath.NewInt(1000), 5),
+			}},
+			expErr: "amount tier 1: min amount 1000 must be greater than the previous tier's 1000",
+		},
+		{
+			name:       "max fee without tiers",
+			conditions: MsgFeeConditions{MaxFee: sdkmath.NewInt(500)},
+			expErr:     "max fee requires amount tiers",
+		},
+		{
+			name: "max fee less than additional fee",
+			conditions: MsgFeeConditions{
+				AmountTiers: []MsgFeeTier{NewMsgFeeTier(sdkmath.ZeroInt(), 10)},
+				MaxFee:      sdkmath.NewInt(99),
+			},
+			expErr: "max fee 99 cannot be less than the additional fee 100",
+		},
+		{
+			name:       "empty exempt attribute",
+			conditions: MsgFeeConditions{ExemptAttributes: []string{" "}},
+			expErr:     "exempt attribute cannot be empty",
+		},
+		{
+			name:       "duplicate exempt attribute",
+			conditions: MsgFeeConditions{ExemptAttributes: []string{"maker.pb", "maker.pb"}},
+			expErr:     `duplicate exempt attribute "maker.pb"`,
+		},
+		{
+			name:       "window ends at start",
+			conditions: MsgFeeConditions{FeeFreeWindows: []FeeFreeWindow{NewFeeFreeWindow(start, start)}},
+			expErr:     "fee-free window 0: end must be after start",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.conditions.Validate(fee)
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestMsgFeeConditionsCalculateFee(t *testing.T) {
+	fee := sdk.NewInt64Coin("nhash", 100)
+	conditions := MsgFeeConditions{
+		AmountTiers: []MsgFeeTier{
+			NewMsgFeeTier(sdkmath.NewInt(10_000), 100),
+			NewMsgFeeTier(sdkmath.NewInt(1_000_000), 50),
+		},
+		MaxFee: sdkmath.NewInt(25_000),
+	}
+
+	tests := []struct {
+		name       string
+		conditions MsgFeeConditions
+		amount     int64
+		exp        int64
+	}{
+		{name: "no tiers", conditions: MsgFeeConditions{}, amount: 5_000_000, exp: 100},
+		{name: "below first tier", conditions: conditions, amount: 9_999, exp: 100},
+		{name: "first tier under min fee", conditions: conditions, amount: 10_000, exp: 100},
+		{name: "first tier", conditions: conditions, amount: 500_000, exp: 5_000},
+		{name: "second tier", conditions: conditions, amount: 2_000_000, exp: 10_000},
+		{name: "second tier capped", conditions: conditions, amount: 10_000_000, exp: 25_000},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.conditions.CalculateFee(fee, sdkmath.NewInt(tc.amount))
+			assert.Equal(t, sdk.NewInt64Coin("nhash", tc.exp).String(), actual.String(), "CalculateFee")
+		})
+	}
+}
+
+func TestMsgFeeConditionsInFeeFreeWindow(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	conditions := MsgFeeConditions{FeeFreeWindows: []FeeFreeWindow{NewFeeFreeWindow(start, end)}}
+
+	assert.False(t, conditions.InFeeFreeWindow(start.Add(-time.Second)), "just before start")
+	assert.True(t, conditions.InFeeFreeWindow(start), "at start")
+	assert.True(t, conditions.InFeeFreeWindow(end.Add(-time.Second)), "just before end")
+	assert.False(t, conditions.InFeeFreeWindow(end), "at end")
+	assert.False(t, MsgFeeConditions{}.InFeeFreeWindow(start), "no windows")
+}
+
+func TestGetMsgAmount(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("nhash", 500), sdk.NewInt64Coin("other", 3))
+
+	tests := []struct {
+		name string
+		msg  sdk.Msg
+		exp  int64
+	}{
+		{
+			name: "send",
+			msg:  &banktypes.MsgSend{FromAddress: addr, ToAddress: addr, Amount: coins},
+			exp:  500,
+		},
+		{
+			name: "multi send",
+			msg: &banktypes.MsgMultiSend{Inputs: []banktypes.Input{
+				{Address: addr, Coins: coins},
+				{Address: addr, Coins: sdk.NewCoins(sdk.NewInt64Coin("nhash", 25))},
+			}},
+			exp: 525,
+		},
+		{
+			name: "coins amount",
+			msg:  &exchange.MsgCommitFundsRequest{Account: addr, Amount: coins},
+			exp:  500,
+		},
+		{
+			name: "single coin amount",
+			msg:  &markertypes.MsgTransferRequest{Amount: sdk.NewInt64Coin("nhash", 42)},
+			exp:  42,
+		},
+		{
+			name: "single coin amount in other denom",
+			msg:  &markertypes.MsgTransferRequest{Amount: sdk.NewInt64Coin("other", 42)},
+			exp:  0,
+		},
+		{
+			name: "no amount",
+			msg:  &MsgRemoveMsgFeeProposalRequest{},
+			exp:  0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := GetMsgAmount(tc.msg, "nhash")
+			assert.Equal(t, sdkmath.NewInt(tc.exp).String(), actual.String(), "GetMsgAmount")
+		})
+	}
+}
@@ -12,4 +12,9 @@ var (
 	ErrMsgFeeDoesNotExist  = cerrs.Register(ModuleName, 5, "fee for type does not exist")
 	ErrInvalidFeeProposal  = cerrs.Register(ModuleName, 6, "invalid fee proposal")
 	ErrInvalidBipsValue    = cerrs.Register(ModuleName, 7, "invalid bips amount")
+	ErrInvalidConditions   = cerrs.Register(ModuleName, 8, "invalid msg fee conditions")
+	ErrInvalidUsdFeeDenom  = cerrs.Register(ModuleName, 9, "invalid usd fee denom")
+	ErrUsdRateUnavailable  = cerrs.Register(ModuleName, 10, "usd rate unavailable")
+	ErrInvalidSponsorship  = cerrs.Register(ModuleName, 11, "invalid fee sponsorship")
+	ErrSponsorshipExceeded = cerrs.Register(ModuleName, 12, "fee sponsorship limit exceeded")
 )
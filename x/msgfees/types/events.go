@@ -39,6 +39,14 @@ func NewEventMsgs(totalCalls map[string]uint64, totalFees map[string]sdk.Coins)
 	}
 }
 
+// NewEventMsgFeeWaived creates a new EventMsgFeeWaived.
+func NewEventMsgFeeWaived(msgType string, reason string) *EventMsgFeeWaived {
+	return &EventMsgFeeWaived{
+		MsgType: msgType,
+		Reason:  reason,
+	}
+}
+
 // sortAndReduce returns a sorted list of keys that are contained in both totalCalls and totalFees
 func sortAndReduce(totalCalls map[string]uint64, totalFees map[string]sdk.Coins) []string {
 	keys := make([]string, 0, len(totalCalls))
@@ -7,6 +7,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -26,6 +30,10 @@ type MsgFeesKeeper interface {
 	GetNhashPerUsdMil(ctx sdk.Context) uint64
 	ConvertDenomToHash(ctx sdk.Context, coin sdk.Coin) (sdk.Coin, error)
 	CalculateAdditionalFeesToBePaid(ctx sdk.Context, msgs ...sdk.Msg) (MsgFeesDistribution, error)
+	ConvertUsdFee(ctx sdk.Context, usdFee sdk.Coin, feeCoins sdk.Coins) (sdk.Coin, error)
+	ConvertUsdFees(ctx sdk.Context, feeDist MsgFeesDistribution, feeCoins sdk.Coins) (MsgFeesDistribution, error)
+	UseSponsoredFees(ctx sdk.Context, sponsor, feePayer sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) (bool, error)
+	RecordFeeRevenue(ctx sdk.Context, msgTypeURL string, recipient string, amount sdk.Coins, count uint64) error
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
@@ -33,3 +41,20 @@ type FeegrantKeeper interface {
 	GetAllowance(ctx context.Context, granter sdk.AccAddress, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
 	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// AttributeKeeper defines the attribute functionality needed by the msgfees module.
+type AttributeKeeper interface {
+	GetAllAttributesAddr(ctx sdk.Context, addr []byte) ([]attrtypes.Attribute, error)
+}
+
+// MarkerKeeper defines the marker functionality needed by the marker nav usd rate source and marker fee sponsorships.
+type MarkerKeeper interface {
+	GetNetAssetValue(ctx sdk.Context, markerDenom, priceDenom string) (*markertypes.NetAssetValue, error)
+	GetMarkerByDenom(ctx sdk.Context, denom string) (markertypes.MarkerAccountI, error)
+}
+
+// ExchangeKeeper defines the exchange functionality needed by the exchange usd rate source and market fee sponsorships.
+type ExchangeKeeper interface {
+	GetRecentMarketTrades(ctx sdk.Context, marketID uint32, assetDenom, priceDenom string, minBlockHeight, maxBlockHeight int64, maxTrades int) ([]*exchange.Trade, error)
+	CanWithdrawMarketFunds(ctx sdk.Context, marketID uint32, admin string) bool
+}
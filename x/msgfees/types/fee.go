@@ -42,6 +42,8 @@ type MsgFeesDistribution struct {
 	AdditionalModuleFees sdk.Coins
 	// RecipientDistributions is just the additional specific distribution fees.
 	RecipientDistributions map[string]sdk.Coins
+	// Assessed is the itemized list of msg fees that were assessed (or waived).
+	Assessed []AssessedMsgFee
 }
 
 // Increase adds the provided coin to be distributed (as long as it's positive).
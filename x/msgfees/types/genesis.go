@@ -2,15 +2,19 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
 // NewGenesisState creates new GenesisState object
-func NewGenesisState(params Params, entries []MsgFee) *GenesisState {
+func NewGenesisState(params Params, entries []MsgFee, usdFeeDenoms []UsdFeeDenom, feeSponsorships []FeeSponsorship, feeRevenues []FeeRevenue) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		MsgFees: entries,
+		Params:          params,
+		MsgFees:         entries,
+		UsdFeeDenoms:    usdFeeDenoms,
+		FeeSponsorships: feeSponsorships,
+		FeeRevenues:     feeRevenues,
 	}
 }
 
@@ -21,14 +25,30 @@ func (state GenesisState) Validate() error {
 			return err
 		}
 	}
+	if err := ValidateUsdFeeDenoms(state.UsdFeeDenoms); err != nil {
+		return err
+	}
+	for i, sponsorship := range state.FeeSponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("invalid fee sponsorship[%d]: %w", i, err)
+		}
+	}
+	for i, revenue := range state.FeeRevenues {
+		if err := revenue.Validate(); err != nil {
+			return fmt.Errorf("invalid fee revenue[%d]: %w", i, err)
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns default state for msgfee module.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		MsgFees: []MsgFee{},
+		Params:          DefaultParams(),
+		MsgFees:         []MsgFee{},
+		UsdFeeDenoms:    []UsdFeeDenom{},
+		FeeSponsorships: []FeeSponsorship{},
+		FeeRevenues:     []FeeRevenue{},
 	}
 }
 
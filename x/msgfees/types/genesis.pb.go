@@ -29,6 +29,12 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// msg_based_fees are the additional fees on specific tx msgs
 	MsgFees []MsgFee `protobuf:"bytes,2,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+	// usd_fee_denoms are the denoms that can be used to pay msg fees that are priced in usd.
+	UsdFeeDenoms []UsdFeeDenom `protobuf:"bytes,3,rep,name=usd_fee_denoms,json=usdFeeDenoms,proto3" json:"usd_fee_denoms"`
+	// fee_sponsorships are the accounts that pay the fees of txs for other accounts.
+	FeeSponsorships []FeeSponsorship `protobuf:"bytes,4,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships"`
+	// fee_revenues are the daily totals of the msg fees sent to each recipient.
+	FeeRevenues []FeeRevenue `protobuf:"bytes,5,rep,name=fee_revenues,json=feeRevenues,proto3" json:"fee_revenues"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +84,27 @@ func (m *GenesisState) GetMsgFees() []MsgFee {
 	return nil
 }
 
+func (m *GenesisState) GetUsdFeeDenoms() []UsdFeeDenom {
+	if m != nil {
+		return m.UsdFeeDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSponsorships() []FeeSponsorship {
+	if m != nil {
+		return m.FeeSponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRevenues() []FeeRevenue {
+	if m != nil {
+		return m.FeeRevenues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "provenance.msgfees.v1.GenesisState")
 }
@@ -87,22 +114,28 @@ func init() {
 }
 
 var fileDescriptor_34254b1b9555b95c = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2e, 0x28, 0xca, 0x2f,
-	0x4b, 0xcd, 0x4b, 0xcc, 0x4b, 0x4e, 0xd5, 0xcf, 0x2d, 0x4e, 0x4f, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f,
-	0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17,
-	0x12, 0x45, 0x28, 0xd2, 0x83, 0x2a, 0xd2, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07,
-	0xab, 0xd0, 0x07, 0xb1, 0x20, 0x8a, 0xa5, 0x70, 0x98, 0x08, 0xd3, 0x07, 0x56, 0xa4, 0xd4, 0xcd,
-	0xc8, 0xc5, 0xe3, 0x0e, 0xb1, 0x23, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0xc8, 0x9a, 0x8b, 0xad, 0x20,
-	0xb1, 0x28, 0x31, 0xb7, 0x58, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x56, 0x0f, 0xab, 0x9d,
-	0x7a, 0x01, 0x60, 0x45, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0xb5, 0x08, 0xd9, 0x71,
-	0x71, 0xe4, 0x16, 0xa7, 0xc7, 0x83, 0xd4, 0x48, 0x30, 0x29, 0x30, 0xe3, 0xd1, 0xee, 0x5b, 0x9c,
-	0xee, 0x96, 0x9a, 0x0a, 0xd5, 0xce, 0x9e, 0x0b, 0xe6, 0x15, 0x3b, 0x65, 0x9e, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x03, 0x97, 0x44, 0x66, 0x3e, 0x76, 0x93, 0x02, 0x18, 0xa3, 0x8c,
-	0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x11, 0x6a, 0x74, 0x33, 0xf3,
-	0x91, 0x78, 0xfa, 0x15, 0xf0, 0x30, 0x28, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0xfb, 0xdf,
-	0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xad, 0x4f, 0x8e, 0x40, 0x78, 0x01, 0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4b, 0x02, 0x41,
+	0x14, 0xc7, 0x77, 0xd5, 0x2c, 0x46, 0xa9, 0x58, 0x0a, 0x16, 0xa1, 0xcd, 0x8c, 0xa0, 0x4b, 0xbb,
+	0x98, 0xc7, 0xa0, 0x83, 0x84, 0x41, 0x50, 0x88, 0x52, 0x87, 0x2e, 0xb2, 0xea, 0x73, 0xdc, 0xc3,
+	0xce, 0x0c, 0xfb, 0x76, 0x97, 0xfa, 0x16, 0x7d, 0x2c, 0x8f, 0x1e, 0x3b, 0x45, 0xe8, 0x07, 0x29,
+	0x66, 0x9c, 0xdc, 0x02, 0xb7, 0xdb, 0x7b, 0x7f, 0x7e, 0xff, 0xdf, 0x3b, 0x3c, 0x72, 0x2a, 0x22,
+	0x9e, 0x02, 0xf3, 0xd9, 0x08, 0xbc, 0x10, 0xe9, 0x04, 0x00, 0xbd, 0xb4, 0xe9, 0x51, 0x60, 0x80,
+	0x01, 0xba, 0x22, 0xe2, 0x31, 0xb7, 0x0e, 0x33, 0xc8, 0xd5, 0x90, 0x9b, 0x36, 0x6b, 0x07, 0x94,
+	0x53, 0xae, 0x08, 0x4f, 0x4e, 0x2b, 0xb8, 0x96, 0x63, 0xfc, 0xe9, 0x29, 0xa8, 0xf1, 0x55, 0x20,
+	0xd5, 0xdb, 0xd5, 0x8d, 0x7e, 0xec, 0xc7, 0x60, 0x5d, 0x91, 0xb2, 0xf0, 0x23, 0x3f, 0x44, 0xdb,
+	0xac, 0x9b, 0xe7, 0x95, 0xcb, 0x23, 0x77, 0xe3, 0x4d, 0xb7, 0xab, 0xa0, 0x76, 0x69, 0xf6, 0x71,
+	0x6c, 0xf4, 0x74, 0xc5, 0xba, 0x26, 0x3b, 0x21, 0xd2, 0x81, 0x64, 0xec, 0x42, 0xbd, 0xf8, 0x4f,
+	0xfd, 0x1e, 0x69, 0x07, 0x40, 0xd7, 0xb7, 0x43, 0xb5, 0xa1, 0xf5, 0x40, 0x76, 0x13, 0x1c, 0xcb,
+	0xfe, 0x60, 0x0c, 0x8c, 0x87, 0x68, 0x17, 0x95, 0xa5, 0x91, 0x63, 0x79, 0xc4, 0x71, 0x07, 0xe0,
+	0x46, 0xa2, 0x5a, 0x55, 0x4d, 0xb2, 0x08, 0xad, 0x27, 0xb2, 0x2f, 0x5d, 0x28, 0x38, 0x43, 0x1e,
+	0xe1, 0x34, 0x10, 0x68, 0x97, 0x94, 0xf1, 0x2c, 0xc7, 0xd8, 0x01, 0xe8, 0x67, 0xb4, 0x96, 0xee,
+	0x4d, 0xfe, 0xa4, 0x68, 0xdd, 0x91, 0xaa, 0xf4, 0x46, 0x90, 0x02, 0x4b, 0x00, 0xed, 0x2d, 0xe5,
+	0x3c, 0xc9, 0x77, 0xf6, 0x56, 0xa4, 0xf6, 0x55, 0x26, 0xeb, 0x04, 0xdb, 0xc1, 0x6c, 0xe1, 0x98,
+	0xf3, 0x85, 0x63, 0x7e, 0x2e, 0x1c, 0xf3, 0x6d, 0xe9, 0x18, 0xf3, 0xa5, 0x63, 0xbc, 0x2f, 0x1d,
+	0x83, 0xd8, 0x01, 0xdf, 0x6c, 0xec, 0x9a, 0xcf, 0x2d, 0x1a, 0xc4, 0xd3, 0x64, 0xe8, 0x8e, 0x78,
+	0xe8, 0x65, 0xcc, 0x45, 0xc0, 0x7f, 0x6d, 0xde, 0xcb, 0xfa, 0xef, 0xf1, 0xab, 0x00, 0x1c, 0x96,
+	0xd5, 0xcf, 0x5b, 0xdf, 0x03, 0x00, 0xca, 0xa4, 0x69, 0x3d, 0x6c, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +158,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeRevenues) > 0 {
+		for iNdEx := len(m.FeeRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UsdFeeDenoms) > 0 {
+		for iNdEx := len(m.UsdFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.MsgFees) > 0 {
 		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -177,6 +252,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UsdFeeDenoms) > 0 {
+		for _, e := range m.UsdFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeRevenues) > 0 {
+		for _, e := range m.FeeRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -282,6 +375,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdFeeDenoms = append(m.UsdFeeDenoms, UsdFeeDenom{})
+			if err := m.UsdFeeDenoms[len(m.UsdFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, FeeSponsorship{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRevenues = append(m.FeeRevenues, FeeRevenue{})
+			if err := m.FeeRevenues[len(m.FeeRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,8 +2,12 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -34,8 +38,93 @@ var (
 	MsgFeeKeyPrefix = []byte{0x00}
 	// MsgFeesParamStoreKey key for msgfees module's params
 	MsgFeesParamStoreKey = []byte{0x01}
+	// UsdFeeDenomKeyPrefix prefix for the denoms that can pay usd msg fees
+	UsdFeeDenomKeyPrefix = []byte{0x02}
+	// FeeSponsorshipKeyPrefix prefix for fee sponsorship entries
+	FeeSponsorshipKeyPrefix = []byte{0x03}
+	// FeeSponsorshipUserSpendKeyPrefix prefix for the amounts fee sponsorships have paid for each fee payer today
+	FeeSponsorshipUserSpendKeyPrefix = []byte{0x04}
+	// FeeRevenueKeyPrefix prefix for the daily totals of msg fees sent to each recipient
+	FeeRevenueKeyPrefix = []byte{0x05}
+)
+
+const (
+	// feeSponsorshipScopeNone is the scope byte of a fee sponsorship without a market or marker.
+	feeSponsorshipScopeNone = byte(0x00)
+	// feeSponsorshipScopeMarket is the scope byte of a fee sponsorship for a market.
+	feeSponsorshipScopeMarket = byte(0x01)
+	// feeSponsorshipScopeMarker is the scope byte of a fee sponsorship for a marker denom.
+	feeSponsorshipScopeMarker = byte(0x02)
 )
 
+// GetUsdFeeDenomKey takes in a denom and returns the key for its usd fee denom entry
+func GetUsdFeeDenomKey(denom string) []byte {
+	return append(append([]byte{}, UsdFeeDenomKeyPrefix...), []byte(denom)...)
+}
+
+// GetFeeSponsorshipSponsorPrefix returns the key prefix of all the fee sponsorships of a sponsor:
+// <0x03><len(sponsor)><sponsor>
+func GetFeeSponsorshipSponsorPrefix(sponsor sdk.AccAddress) []byte {
+	key := append([]byte{}, FeeSponsorshipKeyPrefix...)
+	return append(key, address.MustLengthPrefix(sponsor)...)
+}
+
+// GetFeeSponsorshipMsgTypePrefix returns the key prefix of all the fee sponsorships of a sponsor for a msg type:
+// <0x03><len(sponsor)><sponsor><len(msg type)><msg type>
+func GetFeeSponsorshipMsgTypePrefix(sponsor sdk.AccAddress, msgTypeURL string) []byte {
+	return append(GetFeeSponsorshipSponsorPrefix(sponsor), address.MustLengthPrefix([]byte(msgTypeURL))...)
+}
+
+// GetFeeSponsorshipKey returns the key of a fee sponsorship:
+// <0x03><len(sponsor)><sponsor><len(msg type)><msg type><scope>
+// where scope is either <0x00>, <0x01><market id> or <0x02><len(marker denom)><marker denom>.
+func GetFeeSponsorshipKey(sponsor sdk.AccAddress, msgTypeURL string, marketID uint32, markerDenom string) []byte {
+	key := GetFeeSponsorshipMsgTypePrefix(sponsor, msgTypeURL)
+	switch {
+	case marketID != 0:
+		key = append(key, feeSponsorshipScopeMarket)
+		key = binary.BigEndian.AppendUint32(key, marketID)
+	case len(markerDenom) > 0:
+		key = append(key, feeSponsorshipScopeMarker)
+		key = append(key, address.MustLengthPrefix([]byte(markerDenom))...)
+	default:
+		key = append(key, feeSponsorshipScopeNone)
+	}
+	return key
+}
+
+// GetFeeSponsorshipUserSpendPrefix returns the key prefix of the amounts a fee sponsorship has paid for each fee payer:
+// <0x04><fee sponsorship key without its 0x03 prefix>
+func GetFeeSponsorshipUserSpendPrefix(sponsor sdk.AccAddress, msgTypeURL string, marketID uint32, markerDenom string) []byte {
+	key := append([]byte{}, FeeSponsorshipUserSpendKeyPrefix...)
+	return append(key, GetFeeSponsorshipKey(sponsor, msgTypeURL, marketID, markerDenom)[len(FeeSponsorshipKeyPrefix):]...)
+}
+
+// GetFeeSponsorshipUserSpendKey returns the key of the amount a fee sponsorship has paid for a fee payer:
+// <0x04><fee sponsorship key without its 0x03 prefix><len(fee payer)><fee payer>
+func GetFeeSponsorshipUserSpendKey(sponsor sdk.AccAddress, msgTypeURL string, marketID uint32, markerDenom string, feePayer sdk.AccAddress) []byte {
+	key := GetFeeSponsorshipUserSpendPrefix(sponsor, msgTypeURL, marketID, markerDenom)
+	return append(key, address.MustLengthPrefix(feePayer)...)
+}
+
+// GetFeeRevenueDayPrefix returns the key prefix of all the fee revenue entries for a day:
+// <0x05><day (8 bytes)>
+func GetFeeRevenueDayPrefix(day int64) []byte {
+	key := append([]byte{}, FeeRevenueKeyPrefix...)
+	return binary.BigEndian.AppendUint64(key, uint64(day))
+}
+
+// GetFeeRevenueKey returns the key of the fee revenue entry of a recipient and msg type on a day:
+// <0x05><day (8 bytes)><len(recipient)><recipient><msg type>
+// The recipient is empty for the fees sent to the fee collector.
+func GetFeeRevenueKey(day int64, recipient sdk.AccAddress, msgTypeURL string) []byte {
+	key := GetFeeRevenueDayPrefix(day)
+	// An empty recipient still gets its (zero) length byte so that it can't be confused with a msg type.
+	key = append(key, byte(len(recipient)))
+	key = append(key, recipient...)
+	return append(key, []byte(msgTypeURL)...)
+}
+
 func GetCompositeKey(msgType string, recipient string) string {
 	if len(recipient) == 0 {
 		return msgType
@@ -44,5 +44,11 @@ func (msg *MsgFee) Validate() error {
 		return fmt.Errorf("recipient basis points can only be between 0 and 10,000 : %v", msg.RecipientBasisPoints)
 	}
 
-	return nil
+	return msg.Conditions.Validate(msg.AdditionalFee)
+}
+
+// WithConditions returns a copy of this msg fee with the provided conditions.
+func (msg MsgFee) WithConditions(conditions MsgFeeConditions) MsgFee {
+	msg.Conditions = conditions
+	return msg
 }
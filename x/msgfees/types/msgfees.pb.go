@@ -4,19 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -113,6 +120,8 @@ type MsgFee struct {
 	// The recipient will receive additional_fee * recipient_basis_points / 10,000.
 	// The fee collector will receive the rest, i.e. additional_fee * (10,000 - recipient_basis_points) / 10,000.
 	RecipientBasisPoints uint32 `protobuf:"varint,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
+	// conditions are optional rules that change the fee based on the msg, its signers, and the block time.
+	Conditions MsgFeeConditions `protobuf:"bytes,5,opt,name=conditions,proto3" json:"conditions"`
 }
 
 func (m *MsgFee) Reset()         { *m = MsgFee{} }
@@ -176,26 +185,40 @@ func (m *MsgFee) GetRecipientBasisPoints() uint32 {
 	return 0
 }
 
-// EventMsgFee final event property for msg fee on type
-type EventMsgFee struct {
-	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
-	Count     string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
-	Total     string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
-	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+func (m *MsgFee) GetConditions() MsgFeeConditions {
+	if m != nil {
+		return m.Conditions
+	}
+	return MsgFeeConditions{}
 }
 
-func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
-func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
-func (*EventMsgFee) ProtoMessage()    {}
-func (*EventMsgFee) Descriptor() ([]byte, []int) {
+// MsgFeeConditions defines optional rules that change the fee charged for a specific msg.
+type MsgFeeConditions struct {
+	// amount_tiers make the fee a portion of the amount of the additional fee's denom that the msg moves.
+	// The tier with the largest min_amount that the msg amount reaches applies to the whole amount.
+	// When there are tiers, the additional fee is the minimum fee charged.
+	AmountTiers []MsgFeeTier `protobuf:"bytes,1,rep,name=amount_tiers,json=amountTiers,proto3" json:"amount_tiers"`
+	// max_fee is the most that is charged when there are amount tiers. Zero means there's no maximum.
+	MaxFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
+	// exempt_attributes are account attribute names. If every signer of a msg has at least one of them,
+	// the fee is not charged for that msg.
+	ExemptAttributes []string `protobuf:"bytes,3,rep,name=exempt_attributes,json=exemptAttributes,proto3" json:"exempt_attributes,omitempty"`
+	// fee_free_windows are periods of block time during which the fee is not charged.
+	FeeFreeWindows []FeeFreeWindow `protobuf:"bytes,4,rep,name=fee_free_windows,json=feeFreeWindows,proto3" json:"fee_free_windows"`
+}
+
+func (m *MsgFeeConditions) Reset()         { *m = MsgFeeConditions{} }
+func (m *MsgFeeConditions) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeConditions) ProtoMessage()    {}
+func (*MsgFeeConditions) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6265859d114362, []int{2}
 }
-func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
+func (m *MsgFeeConditions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFeeConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMsgFee.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFeeConditions.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -205,63 +228,107 @@ func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMsgFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMsgFee.Merge(m, src)
+func (m *MsgFeeConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeConditions.Merge(m, src)
 }
-func (m *EventMsgFee) XXX_Size() int {
+func (m *MsgFeeConditions) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMsgFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMsgFee.DiscardUnknown(m)
+func (m *MsgFeeConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeConditions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMsgFee proto.InternalMessageInfo
+var xxx_messageInfo_MsgFeeConditions proto.InternalMessageInfo
 
-func (m *EventMsgFee) GetMsgType() string {
+func (m *MsgFeeConditions) GetAmountTiers() []MsgFeeTier {
 	if m != nil {
-		return m.MsgType
+		return m.AmountTiers
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMsgFee) GetCount() string {
+func (m *MsgFeeConditions) GetExemptAttributes() []string {
 	if m != nil {
-		return m.Count
+		return m.ExemptAttributes
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMsgFee) GetTotal() string {
+func (m *MsgFeeConditions) GetFeeFreeWindows() []FeeFreeWindow {
 	if m != nil {
-		return m.Total
+		return m.FeeFreeWindows
 	}
-	return ""
+	return nil
 }
 
-func (m *EventMsgFee) GetRecipient() string {
+// MsgFeeTier defines the portion of a msg's amount that is charged once the amount reaches a minimum.
+type MsgFeeTier struct {
+	// min_amount is the smallest msg amount that this tier applies to.
+	MinAmount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_amount,json=minAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_amount"`
+	// basis_points is the portion of the msg amount charged as the fee (0 - 10,000).
+	BasisPoints uint32 `protobuf:"varint,2,opt,name=basis_points,json=basisPoints,proto3" json:"basis_points,omitempty"`
+}
+
+func (m *MsgFeeTier) Reset()         { *m = MsgFeeTier{} }
+func (m *MsgFeeTier) String() string { return proto.CompactTextString(m) }
+func (*MsgFeeTier) ProtoMessage()    {}
+func (*MsgFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{3}
+}
+func (m *MsgFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeeTier.Merge(m, src)
+}
+func (m *MsgFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeeTier proto.InternalMessageInfo
+
+func (m *MsgFeeTier) GetBasisPoints() uint32 {
 	if m != nil {
-		return m.Recipient
+		return m.BasisPoints
 	}
-	return ""
+	return 0
 }
 
-// EventMsgFees event emitted with summary of msg fees
-type EventMsgFees struct {
-	MsgFees []EventMsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+// FeeFreeWindow defines a period of block time during which a msg fee is not charged.
+type FeeFreeWindow struct {
+	// start is the first block time in the window (inclusive).
+	Start time.Time `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	// end is the block time that the window ends (exclusive).
+	End time.Time `protobuf:"bytes,2,opt,name=end,proto3,stdtime" json:"end"`
 }
 
-func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
-func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
-func (*EventMsgFees) ProtoMessage()    {}
-func (*EventMsgFees) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c6265859d114362, []int{3}
+func (m *FeeFreeWindow) Reset()         { *m = FeeFreeWindow{} }
+func (m *FeeFreeWindow) String() string { return proto.CompactTextString(m) }
+func (*FeeFreeWindow) ProtoMessage()    {}
+func (*FeeFreeWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{4}
 }
-func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
+func (m *FeeFreeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeFreeWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMsgFees.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeFreeWindow.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,354 +338,3912 @@ func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMsgFees) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMsgFees.Merge(m, src)
+func (m *FeeFreeWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeFreeWindow.Merge(m, src)
 }
-func (m *EventMsgFees) XXX_Size() int {
+func (m *FeeFreeWindow) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMsgFees) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMsgFees.DiscardUnknown(m)
+func (m *FeeFreeWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeFreeWindow.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMsgFees proto.InternalMessageInfo
+var xxx_messageInfo_FeeFreeWindow proto.InternalMessageInfo
 
-func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
+func (m *FeeFreeWindow) GetStart() time.Time {
 	if m != nil {
-		return m.MsgFees
+		return m.Start
 	}
-	return nil
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
-	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
-	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
-	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
+func (m *FeeFreeWindow) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
 }
 
-func init() {
-	proto.RegisterFile("provenance/msgfees/v1/msgfees.proto", fileDescriptor_0c6265859d114362)
+// UsdFeeDenom defines a denom that can be used to pay msg fees that are priced in usd.
+type UsdFeeDenom struct {
+	// denom is the denom that can be used to pay the usd fees.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate_source is the name of the source of the denom's value in usd, e.g. "marker-nav" or "exchange".
+	RateSource string `protobuf:"bytes,2,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	// market_id is the exchange market whose trades provide the denom's value. Only used by the exchange rate source.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// max_age_blocks is the most blocks that can have passed since the denom's value was last updated
+	// for it to still be used to pay fees.
+	MaxAgeBlocks uint64 `protobuf:"varint,4,opt,name=max_age_blocks,json=maxAgeBlocks,proto3" json:"max_age_blocks,omitempty"`
 }
 
-var fileDescriptor_0c6265859d114362 = []byte{
-	// 495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xcd, 0xd2, 0x10, 0xc8, 0xa6, 0x2d, 0xc2, 0x0a, 0xc8, 0xad, 0x90, 0x1b, 0x85, 0x4b, 0x38,
-	0x60, 0x93, 0x96, 0x13, 0xc7, 0x14, 0xd2, 0x53, 0xa5, 0xc8, 0xd0, 0x0b, 0x97, 0xd5, 0xc6, 0x99,
-	0x38, 0x2b, 0xd9, 0x3b, 0xd6, 0xee, 0xc6, 0xa2, 0x7f, 0xc1, 0x27, 0xf0, 0x11, 0x7c, 0x03, 0xea,
-	0xb1, 0x47, 0x4e, 0x08, 0x25, 0x17, 0x3e, 0x03, 0xad, 0xed, 0xc4, 0x01, 0xf5, 0xd0, 0xdb, 0xce,
-	0xbe, 0x79, 0x6f, 0xde, 0x5b, 0xcd, 0xd2, 0x97, 0x99, 0xc2, 0x1c, 0x24, 0x97, 0x11, 0x04, 0xa9,
-	0x8e, 0xe7, 0x00, 0x3a, 0xc8, 0x87, 0x9b, 0xa3, 0x9f, 0x29, 0x34, 0xe8, 0x3c, 0xab, 0x9b, 0xfc,
-	0x0d, 0x92, 0x0f, 0x8f, 0xbb, 0x31, 0xc6, 0x58, 0x74, 0x04, 0xf6, 0x54, 0x36, 0x1f, 0x7b, 0x11,
-	0xea, 0x14, 0x75, 0x30, 0xe5, 0x1a, 0x82, 0x7c, 0x38, 0x05, 0xc3, 0x87, 0x41, 0x84, 0x42, 0x96,
-	0x78, 0xff, 0x3b, 0xa1, 0xad, 0x09, 0x57, 0x3c, 0xd5, 0xce, 0x05, 0x7d, 0x32, 0x4f, 0x10, 0x15,
-	0x8b, 0xb9, 0x66, 0x99, 0x12, 0x11, 0xb8, 0x0f, 0x7a, 0x64, 0xd0, 0x39, 0x3d, 0xf2, 0x4b, 0x11,
-	0xdf, 0x8a, 0xf8, 0x95, 0x88, 0x7f, 0x8e, 0x42, 0x8e, 0x9a, 0x37, 0xbf, 0x4e, 0x1a, 0xe1, 0x41,
-	0xc1, 0xbb, 0xe0, 0x7a, 0x62, 0x59, 0xce, 0x2b, 0xfa, 0x54, 0x2e, 0xb8, 0x5e, 0xb0, 0x0c, 0x14,
-	0x5b, 0xea, 0x19, 0x4b, 0x45, 0xe2, 0xee, 0xf5, 0xc8, 0xa0, 0x19, 0x1e, 0x16, 0xc0, 0x04, 0xd4,
-	0x95, 0x9e, 0x5d, 0x8a, 0xc4, 0x79, 0x43, 0xbb, 0x11, 0xca, 0x1c, 0x94, 0x16, 0x28, 0xd9, 0x1c,
-	0x80, 0xcd, 0x40, 0x62, 0xea, 0x36, 0x7b, 0x64, 0xd0, 0x0e, 0x9d, 0x1a, 0x1b, 0x03, 0xbc, 0xb7,
-	0xc8, 0xbb, 0xe6, 0x9f, 0x6f, 0x27, 0x8d, 0xfe, 0x0f, 0x42, 0x5b, 0x97, 0x3a, 0x1e, 0x03, 0x38,
-	0x3d, 0xba, 0x9f, 0xea, 0x98, 0x99, 0xeb, 0x0c, 0xd8, 0x52, 0x25, 0x2e, 0x29, 0xa8, 0x34, 0xd5,
-	0xf1, 0xa7, 0xeb, 0x0c, 0xae, 0x54, 0xe2, 0x8c, 0xe9, 0x21, 0x9f, 0xcd, 0x84, 0x11, 0x28, 0x79,
-	0x62, 0x87, 0xdc, 0x3b, 0x57, 0x4d, 0xb3, 0x93, 0x5e, 0xd0, 0xb6, 0x82, 0x48, 0x64, 0x02, 0xa4,
-	0x29, 0xf2, 0xb4, 0xc3, 0xfa, 0xc2, 0x79, 0x4b, 0x9f, 0x6f, 0x0b, 0x36, 0xe5, 0x5a, 0x68, 0x96,
-	0xa1, 0x90, 0x46, 0x17, 0x61, 0x0e, 0xc2, 0xee, 0x16, 0x1d, 0x59, 0x70, 0x52, 0x60, 0x7d, 0x45,
-	0x3b, 0x1f, 0x72, 0x90, 0xa6, 0x0a, 0x73, 0x44, 0x1f, 0x6f, 0xc2, 0x54, 0x41, 0x1e, 0x55, 0x41,
-	0x9c, 0x2e, 0x7d, 0x18, 0xe1, 0x52, 0x9a, 0xc2, 0x7c, 0x3b, 0x2c, 0x0b, 0x7b, 0x6b, 0xd0, 0xf0,
-	0xa4, 0xf2, 0x53, 0x16, 0xff, 0x3a, 0x6d, 0xfe, 0xe7, 0xb4, 0xff, 0x91, 0xee, 0xef, 0xcc, 0xd4,
-	0xce, 0x79, 0x39, 0xd4, 0x2e, 0x92, 0x4b, 0x7a, 0x7b, 0x83, 0xce, 0x69, 0xdf, 0xbf, 0x73, 0xc7,
-	0xfc, 0x1d, 0x5a, 0xf5, 0x44, 0xd6, 0x9e, 0x15, 0x19, 0x89, 0x9b, 0x95, 0x47, 0x6e, 0x57, 0x1e,
-	0xf9, 0xbd, 0xf2, 0xc8, 0xd7, 0xb5, 0xd7, 0xb8, 0x5d, 0x7b, 0x8d, 0x9f, 0x6b, 0xaf, 0x41, 0x5d,
-	0x81, 0x77, 0xcb, 0x4d, 0xc8, 0xe7, 0xb3, 0x58, 0x98, 0xc5, 0x72, 0xea, 0x47, 0x98, 0x06, 0x75,
-	0xcf, 0x6b, 0x81, 0x3b, 0x55, 0xf0, 0x65, 0xfb, 0x17, 0xec, 0xbb, 0xe8, 0x69, 0xab, 0x58, 0xdd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf6, 0xf6, 0x22, 0x0d, 0x2e, 0x03, 0x00, 0x00,
+func (m *UsdFeeDenom) Reset()         { *m = UsdFeeDenom{} }
+func (m *UsdFeeDenom) String() string { return proto.CompactTextString(m) }
+func (*UsdFeeDenom) ProtoMessage()    {}
+func (*UsdFeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{5}
+}
+func (m *UsdFeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsdFeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsdFeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsdFeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsdFeeDenom.Merge(m, src)
+}
+func (m *UsdFeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsdFeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsdFeeDenom.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_UsdFeeDenom proto.InternalMessageInfo
+
+func (m *UsdFeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsdFeeDenom) GetRateSource() string {
+	if m != nil {
+		return m.RateSource
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConversionFeeDenom) > 0 {
-		i -= len(m.ConversionFeeDenom)
-		copy(dAtA[i:], m.ConversionFeeDenom)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
-		i--
-		dAtA[i] = 0x22
+func (m *UsdFeeDenom) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	if m.NhashPerUsdMil != 0 {
-		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *UsdFeeDenom) GetMaxAgeBlocks() uint64 {
+	if m != nil {
+		return m.MaxAgeBlocks
 	}
-	{
-		size, err := m.FloorGasPrice.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// FeeSponsorship defines an account that pays the fees of txs for other accounts.
+// A tx is sponsored when its fee granter is the sponsor and all of its msgs are covered by one of the sponsor's sponsorships.
+type FeeSponsorship struct {
+	// sponsor is the bech32 address of the account that pays the fees.
+	// It is the market's account if there's a market_id, the marker's account if there's a marker_denom,
+	// or else the account that set up the sponsorship.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// msg_type_url is the type-url of the msgs being sponsored, e.g. "/provenance.exchange.v1.MsgCreateBidRequest".
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// market_id is the optional exchange market that the msgs must be for.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// marker_denom is the optional marker denom that the msgs must involve.
+	MarkerDenom string `protobuf:"bytes,4,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	// budget is the most that the sponsor will pay in total.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// daily_user_cap is the most that the sponsor will pay for a single fee payer each day. Empty means there's no cap.
+	DailyUserCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=daily_user_cap,json=dailyUserCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_user_cap"`
+	// spent is the total that the sponsor has paid under this sponsorship.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+}
+
+func (m *FeeSponsorship) Reset()         { *m = FeeSponsorship{} }
+func (m *FeeSponsorship) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorship) ProtoMessage()    {}
+func (*FeeSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{6}
+}
+func (m *FeeSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+}
+func (m *FeeSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorship.Merge(m, src)
+}
+func (m *FeeSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorship.DiscardUnknown(m)
 }
 
-func (m *MsgFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FeeSponsorship proto.InternalMessageInfo
+
+func (m *FeeSponsorship) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FeeSponsorship) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
 
-func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecipientBasisPoints != 0 {
-		i = encodeVarintMsgfees(dAtA, i, uint64(m.RecipientBasisPoints))
-		i--
-		dAtA[i] = 0x20
+func (m *FeeSponsorship) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
 	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *FeeSponsorship) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
 	}
-	{
-		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *FeeSponsorship) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.MsgTypeUrl) > 0 {
-		i -= len(m.MsgTypeUrl)
-		copy(dAtA[i:], m.MsgTypeUrl)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *FeeSponsorship) GetDailyUserCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyUserCap
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeSponsorship) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeSponsorshipUserSpend is the amount a sponsor has paid for a single fee payer on a day.
+type FeeSponsorshipUserSpend struct {
+	// day is the number of days since the unix epoch (in UTC) of the block time that the spend was recorded in.
+	Day int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// spent is the amount paid for the fee payer on that day.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
 }
 
-func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Count) > 0 {
-		i -= len(m.Count)
-		copy(dAtA[i:], m.Count)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
-		i--
-		dAtA[i] = 0x12
+func (m *FeeSponsorshipUserSpend) Reset()         { *m = FeeSponsorshipUserSpend{} }
+func (m *FeeSponsorshipUserSpend) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipUserSpend) ProtoMessage()    {}
+func (*FeeSponsorshipUserSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{7}
+}
+func (m *FeeSponsorshipUserSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSponsorshipUserSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipUserSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.MsgType) > 0 {
-		i -= len(m.MsgType)
-		copy(dAtA[i:], m.MsgType)
-		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FeeSponsorshipUserSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipUserSpend.Merge(m, src)
+}
+func (m *FeeSponsorshipUserSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipUserSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipUserSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSponsorshipUserSpend proto.InternalMessageInfo
+
+func (m *FeeSponsorshipUserSpend) GetDay() int64 {
+	if m != nil {
+		return m.Day
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FeeSponsorshipUserSpend) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Spent
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FeeRevenue is the total of the msg fees for one msg type that were sent to one recipient on one day.
+type FeeRevenue struct {
+	// day is the number of days since the unix epoch (in UTC) of the block time that the fees were collected in.
+	Day int64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	// recipient is the bech32 address of the account that received the fees.
+	// It is empty for the fees sent to the fee collector.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// msg_type_url is the type-url of the msgs that the fees were charged for.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// total is the total amount of those fees.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// count is the number of msgs that those fees were charged for.
+	Count uint64 `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MsgFees) > 0 {
-		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgfees(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *FeeRevenue) Reset()         { *m = FeeRevenue{} }
+func (m *FeeRevenue) String() string { return proto.CompactTextString(m) }
+func (*FeeRevenue) ProtoMessage()    {}
+func (*FeeRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{8}
+}
+func (m *FeeRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FeeRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRevenue.Merge(m, src)
+}
+func (m *FeeRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRevenue.DiscardUnknown(m)
 }
 
-func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgfees(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_FeeRevenue proto.InternalMessageInfo
+
+func (m *FeeRevenue) GetDay() int64 {
+	if m != nil {
+		return m.Day
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *FeeRevenue) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	var l int
-	_ = l
-	l = m.FloorGasPrice.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
-	if m.NhashPerUsdMil != 0 {
-		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	return ""
+}
+
+func (m *FeeRevenue) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	l = len(m.ConversionFeeDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	return ""
+}
+
+func (m *FeeRevenue) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return n
+	return nil
 }
 
-func (m *MsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeeRevenue) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	var l int
-	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	return 0
+}
+
+// AssessedMsgFee is the msg fee assessed for a single msg.
+type AssessedMsgFee struct {
+	// msg_type_url is the type-url of the msg.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// fee is the fee charged for the msg, including any portion paid to the recipient.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+	// recipient is the optional address that receives a portion of the fee.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// waived_reason describes why the msg fee was not charged. It is empty if the fee was charged.
+	WaivedReason string `protobuf:"bytes,4,opt,name=waived_reason,json=waivedReason,proto3" json:"waived_reason,omitempty"`
+}
+
+func (m *AssessedMsgFee) Reset()         { *m = AssessedMsgFee{} }
+func (m *AssessedMsgFee) String() string { return proto.CompactTextString(m) }
+func (*AssessedMsgFee) ProtoMessage()    {}
+func (*AssessedMsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{9}
+}
+func (m *AssessedMsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssessedMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssessedMsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovMsgfees(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+}
+func (m *AssessedMsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssessedMsgFee.Merge(m, src)
+}
+func (m *AssessedMsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssessedMsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssessedMsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssessedMsgFee proto.InternalMessageInfo
+
+func (m *AssessedMsgFee) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
 	}
-	if m.RecipientBasisPoints != 0 {
-		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	return ""
+}
+
+func (m *AssessedMsgFee) GetFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fee
 	}
-	return n
+	return nil
 }
 
-func (m *EventMsgFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AssessedMsgFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	var l int
-	_ = l
-	l = len(m.MsgType)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	return ""
+}
+
+func (m *AssessedMsgFee) GetWaivedReason() string {
+	if m != nil {
+		return m.WaivedReason
 	}
-	l = len(m.Count)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	return ""
+}
+
+// EventMsgFee final event property for msg fee on type
+type EventMsgFee struct {
+	MsgType   string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	Count     string `protobuf:"bytes,2,opt,name=count,proto3" json:"count,omitempty"`
+	Total     string `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventMsgFee) Reset()         { *m = EventMsgFee{} }
+func (m *EventMsgFee) String() string { return proto.CompactTextString(m) }
+func (*EventMsgFee) ProtoMessage()    {}
+func (*EventMsgFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{10}
+}
+func (m *EventMsgFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+}
+func (m *EventMsgFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgFee.Merge(m, src)
+}
+func (m *EventMsgFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgFee proto.InternalMessageInfo
+
+func (m *EventMsgFee) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovMsgfees(uint64(l))
+	return ""
+}
+
+func (m *EventMsgFee) GetCount() string {
+	if m != nil {
+		return m.Count
 	}
-	return n
+	return ""
 }
 
-func (m *EventMsgFees) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventMsgFee) GetTotal() string {
+	if m != nil {
+		return m.Total
 	}
-	var l int
-	_ = l
+	return ""
+}
+
+func (m *EventMsgFee) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// EventMsgFees event emitted with summary of msg fees
+type EventMsgFees struct {
+	MsgFees []EventMsgFee `protobuf:"bytes,1,rep,name=msg_fees,json=msgFees,proto3" json:"msg_fees"`
+}
+
+func (m *EventMsgFees) Reset()         { *m = EventMsgFees{} }
+func (m *EventMsgFees) String() string { return proto.CompactTextString(m) }
+func (*EventMsgFees) ProtoMessage()    {}
+func (*EventMsgFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{11}
+}
+func (m *EventMsgFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMsgFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgFees.Merge(m, src)
+}
+func (m *EventMsgFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgFees proto.InternalMessageInfo
+
+func (m *EventMsgFees) GetMsgFees() []EventMsgFee {
+	if m != nil {
+		return m.MsgFees
+	}
+	return nil
+}
+
+// EventMsgFeeWaived is an event emitted when a msg fee is not charged because of its conditions.
+type EventMsgFeeWaived struct {
+	MsgType string `protobuf:"bytes,1,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventMsgFeeWaived) Reset()         { *m = EventMsgFeeWaived{} }
+func (m *EventMsgFeeWaived) String() string { return proto.CompactTextString(m) }
+func (*EventMsgFeeWaived) ProtoMessage()    {}
+func (*EventMsgFeeWaived) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{12}
+}
+func (m *EventMsgFeeWaived) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMsgFeeWaived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMsgFeeWaived.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventMsgFeeWaived) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMsgFeeWaived.Merge(m, src)
+}
+func (m *EventMsgFeeWaived) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMsgFeeWaived) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMsgFeeWaived.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventMsgFeeWaived proto.InternalMessageInfo
+
+func (m *EventMsgFeeWaived) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *EventMsgFeeWaived) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventFeeSponsored is an event emitted when a sponsor pays fees for a fee payer.
+type EventFeeSponsored struct {
+	Sponsor     string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	FeePayer    string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	MsgType     string `protobuf:"bytes,3,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	MarketId    string `protobuf:"bytes,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarkerDenom string `protobuf:"bytes,5,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	Amount      string `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventFeeSponsored) Reset()         { *m = EventFeeSponsored{} }
+func (m *EventFeeSponsored) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSponsored) ProtoMessage()    {}
+func (*EventFeeSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{13}
+}
+func (m *EventFeeSponsored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSponsored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSponsored.Merge(m, src)
+}
+func (m *EventFeeSponsored) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSponsored proto.InternalMessageInfo
+
+func (m *EventFeeSponsored) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *EventFeeSponsored) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *EventFeeSponsored) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *EventFeeSponsored) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventFeeSponsored) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
+	}
+	return ""
+}
+
+func (m *EventFeeSponsored) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventFeeSponsorshipUpdated is an event emitted when a fee sponsorship is set or removed.
+type EventFeeSponsorshipUpdated struct {
+	Sponsor     string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	MsgType     string `protobuf:"bytes,2,opt,name=msg_type,json=msgType,proto3" json:"msg_type,omitempty"`
+	MarketId    string `protobuf:"bytes,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	MarkerDenom string `protobuf:"bytes,4,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	Action      string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *EventFeeSponsorshipUpdated) Reset()         { *m = EventFeeSponsorshipUpdated{} }
+func (m *EventFeeSponsorshipUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventFeeSponsorshipUpdated) ProtoMessage()    {}
+func (*EventFeeSponsorshipUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6265859d114362, []int{14}
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeeSponsorshipUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeeSponsorshipUpdated.Merge(m, src)
+}
+func (m *EventFeeSponsorshipUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeeSponsorshipUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeeSponsorshipUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeeSponsorshipUpdated proto.InternalMessageInfo
+
+func (m *EventFeeSponsorshipUpdated) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetMsgType() string {
+	if m != nil {
+		return m.MsgType
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
+	}
+	return ""
+}
+
+func (m *EventFeeSponsorshipUpdated) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "provenance.msgfees.v1.Params")
+	proto.RegisterType((*MsgFee)(nil), "provenance.msgfees.v1.MsgFee")
+	proto.RegisterType((*MsgFeeConditions)(nil), "provenance.msgfees.v1.MsgFeeConditions")
+	proto.RegisterType((*MsgFeeTier)(nil), "provenance.msgfees.v1.MsgFeeTier")
+	proto.RegisterType((*FeeFreeWindow)(nil), "provenance.msgfees.v1.FeeFreeWindow")
+	proto.RegisterType((*UsdFeeDenom)(nil), "provenance.msgfees.v1.UsdFeeDenom")
+	proto.RegisterType((*FeeSponsorship)(nil), "provenance.msgfees.v1.FeeSponsorship")
+	proto.RegisterType((*FeeSponsorshipUserSpend)(nil), "provenance.msgfees.v1.FeeSponsorshipUserSpend")
+	proto.RegisterType((*FeeRevenue)(nil), "provenance.msgfees.v1.FeeRevenue")
+	proto.RegisterType((*AssessedMsgFee)(nil), "provenance.msgfees.v1.AssessedMsgFee")
+	proto.RegisterType((*EventMsgFee)(nil), "provenance.msgfees.v1.EventMsgFee")
+	proto.RegisterType((*EventMsgFees)(nil), "provenance.msgfees.v1.EventMsgFees")
+	proto.RegisterType((*EventMsgFeeWaived)(nil), "provenance.msgfees.v1.EventMsgFeeWaived")
+	proto.RegisterType((*EventFeeSponsored)(nil), "provenance.msgfees.v1.EventFeeSponsored")
+	proto.RegisterType((*EventFeeSponsorshipUpdated)(nil), "provenance.msgfees.v1.EventFeeSponsorshipUpdated")
+}
+
+func init() {
+	proto.RegisterFile("provenance/msgfees/v1/msgfees.proto", fileDescriptor_0c6265859d114362)
+}
+
+var fileDescriptor_0c6265859d114362 = []byte{
+	// 1206 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0x13, 0x47,
+	0x14, 0xce, 0xfa, 0x57, 0xe2, 0x67, 0x27, 0x0d, 0xab, 0x40, 0x97, 0x50, 0xd9, 0x66, 0x41, 0x6a,
+	0x2a, 0x84, 0x4d, 0xa0, 0xea, 0x81, 0x5b, 0x12, 0x6a, 0x04, 0x12, 0x52, 0xb4, 0x49, 0x84, 0x54,
+	0xa9, 0x5a, 0x8d, 0xbd, 0xcf, 0x9b, 0x51, 0xbc, 0x3b, 0xdb, 0x99, 0xb1, 0x49, 0xd4, 0x63, 0x0f,
+	0xbd, 0x55, 0x5c, 0x7a, 0xef, 0xad, 0x6a, 0xcf, 0x5c, 0x7b, 0x2e, 0x47, 0x54, 0x55, 0x6a, 0xd5,
+	0x03, 0x54, 0x70, 0xe9, 0x9f, 0x51, 0xcd, 0x0f, 0xc7, 0x76, 0x12, 0x0c, 0x48, 0x70, 0xca, 0xbe,
+	0xf7, 0xe6, 0xcd, 0xf7, 0x7d, 0x6f, 0xe6, 0xbd, 0x89, 0xe1, 0x4a, 0xc6, 0xd9, 0x10, 0x53, 0x92,
+	0x76, 0xb1, 0x95, 0x88, 0xb8, 0x87, 0x28, 0x5a, 0xc3, 0xf5, 0xd1, 0x67, 0x33, 0xe3, 0x4c, 0x32,
+	0xf7, 0xfc, 0x78, 0x51, 0x73, 0x14, 0x19, 0xae, 0xaf, 0xae, 0xc4, 0x2c, 0x66, 0x7a, 0x45, 0x4b,
+	0x7d, 0x99, 0xc5, 0xab, 0x17, 0xbb, 0x4c, 0x24, 0x4c, 0x84, 0x26, 0x60, 0x0c, 0x1b, 0xaa, 0x19,
+	0xab, 0xd5, 0x21, 0x02, 0x5b, 0xc3, 0xf5, 0x0e, 0x4a, 0xb2, 0xde, 0xea, 0x32, 0x9a, 0xda, 0x78,
+	0x3d, 0x66, 0x2c, 0xee, 0x63, 0x4b, 0x5b, 0x9d, 0x41, 0xaf, 0x25, 0x69, 0x82, 0x42, 0x92, 0x24,
+	0x33, 0x0b, 0xfc, 0x27, 0x0e, 0x94, 0xb6, 0x09, 0x27, 0x89, 0x70, 0xef, 0xc2, 0x47, 0xbd, 0x3e,
+	0x63, 0x3c, 0x8c, 0x89, 0xc2, 0xa2, 0x5d, 0xf4, 0x72, 0x0d, 0x67, 0xad, 0x72, 0xf3, 0x62, 0xd3,
+	0x62, 0x2a, 0x94, 0xa6, 0x45, 0x69, 0x6e, 0x31, 0x9a, 0x6e, 0x16, 0x9e, 0x3e, 0xaf, 0xcf, 0x05,
+	0x8b, 0x3a, 0xef, 0x2e, 0x11, 0xdb, 0x2a, 0xcb, 0xfd, 0x0c, 0xce, 0xa5, 0xfb, 0x44, 0xec, 0x87,
+	0x19, 0xf2, 0x70, 0x20, 0xa2, 0x30, 0xa1, 0x7d, 0x2f, 0xdf, 0x70, 0xd6, 0x0a, 0xc1, 0x92, 0x0e,
+	0x6c, 0x23, 0xdf, 0x13, 0xd1, 0x03, 0xda, 0x77, 0x6f, 0xc0, 0x4a, 0x97, 0xa5, 0x43, 0xe4, 0x82,
+	0xb2, 0x34, 0xec, 0x21, 0x86, 0x11, 0xa6, 0x2c, 0xf1, 0x0a, 0x0d, 0x67, 0xad, 0x1c, 0xb8, 0xe3,
+	0x58, 0x1b, 0xf1, 0x8e, 0x8a, 0xdc, 0x2e, 0xfc, 0xf7, 0x53, 0x7d, 0xce, 0xff, 0x31, 0x07, 0xa5,
+	0x07, 0x22, 0x6e, 0x23, 0xba, 0x0d, 0xa8, 0x26, 0x22, 0x0e, 0xe5, 0x51, 0x86, 0xe1, 0x80, 0xf7,
+	0x3d, 0x47, 0xa7, 0x42, 0x22, 0xe2, 0xdd, 0xa3, 0x0c, 0xf7, 0x78, 0xdf, 0x6d, 0xc3, 0x12, 0x89,
+	0x22, 0x2a, 0x29, 0x4b, 0x49, 0x5f, 0x81, 0xbc, 0xb5, 0xae, 0x71, 0x9a, 0x42, 0xfa, 0x04, 0xca,
+	0x1c, 0xbb, 0x34, 0xa3, 0x98, 0x4a, 0xad, 0xa7, 0x1c, 0x8c, 0x1d, 0xee, 0xe7, 0x70, 0xe1, 0xd8,
+	0x08, 0x3b, 0x44, 0x50, 0x11, 0x66, 0x8c, 0xa6, 0x52, 0x68, 0x31, 0x8b, 0xc1, 0xca, 0x71, 0x74,
+	0x53, 0x05, 0xb7, 0x75, 0xcc, 0x7d, 0x00, 0xd0, 0x65, 0xa9, 0x41, 0x11, 0x5e, 0x51, 0xf3, 0xfa,
+	0xb4, 0x79, 0xe6, 0xed, 0x68, 0x1a, 0xc1, 0x5b, 0xc7, 0xcb, 0x2d, 0xcb, 0x89, 0x0d, 0xfc, 0x9f,
+	0x73, 0xb0, 0x7c, 0x72, 0x99, 0x7b, 0x1f, 0xaa, 0x24, 0x61, 0x83, 0x54, 0x86, 0x92, 0x22, 0x17,
+	0x9e, 0xd3, 0xc8, 0xaf, 0x55, 0x6e, 0x5e, 0x9e, 0x89, 0xb2, 0x4b, 0x91, 0xdb, 0xfd, 0x2b, 0x26,
+	0x59, 0x79, 0x84, 0x7b, 0x07, 0xe6, 0x13, 0x72, 0x78, 0x5c, 0xc4, 0xf2, 0xe6, 0x35, 0xb5, 0xe6,
+	0x9f, 0xe7, 0xf5, 0xf3, 0xa6, 0x96, 0x22, 0x3a, 0x68, 0x52, 0xd6, 0x4a, 0x88, 0xdc, 0x6f, 0xde,
+	0x4b, 0xe5, 0x1f, 0x4f, 0xae, 0x83, 0x2d, 0xf2, 0xbd, 0x54, 0x06, 0xa5, 0x84, 0x1c, 0xaa, 0x4a,
+	0x5e, 0x83, 0x73, 0x78, 0x88, 0x49, 0x26, 0x43, 0x22, 0x25, 0xa7, 0x9d, 0x81, 0x44, 0xe1, 0xe5,
+	0x1b, 0xf9, 0xb5, 0x72, 0xb0, 0x6c, 0x02, 0x1b, 0xc7, 0x7e, 0x77, 0x17, 0x96, 0xd5, 0xc5, 0xe8,
+	0x71, 0xc4, 0xf0, 0x11, 0x4d, 0x23, 0xf6, 0x48, 0x95, 0x54, 0x49, 0xb8, 0xfa, 0x1a, 0x09, 0x6d,
+	0xc4, 0x36, 0x47, 0x7c, 0xa8, 0x17, 0x5b, 0x15, 0x4b, 0xbd, 0x49, 0xa7, 0xf0, 0xbf, 0x05, 0x18,
+	0x2b, 0x75, 0xef, 0x03, 0x24, 0x34, 0x0d, 0x8d, 0x52, 0xcf, 0x79, 0x77, 0x65, 0xe5, 0x84, 0xa6,
+	0x1b, 0x3a, 0xdb, 0xbd, 0x0c, 0xd5, 0xa9, 0xe3, 0xcf, 0xe9, 0xe3, 0xaf, 0x74, 0xc6, 0xa7, 0xee,
+	0x7f, 0xe7, 0xc0, 0xe2, 0x14, 0x49, 0xf7, 0x36, 0x14, 0x85, 0x24, 0xdc, 0x60, 0x57, 0x6e, 0xae,
+	0x36, 0x4d, 0xe3, 0x36, 0x47, 0x8d, 0xdb, 0xdc, 0x1d, 0x35, 0xee, 0xe6, 0x82, 0xe2, 0xf5, 0xf8,
+	0x45, 0xdd, 0x09, 0x4c, 0x8a, 0xfb, 0x05, 0xe4, 0x31, 0x8d, 0xbc, 0xdc, 0x3b, 0x64, 0xaa, 0x04,
+	0xff, 0x7b, 0x07, 0x2a, 0x7b, 0x22, 0x1a, 0xb5, 0x96, 0xbb, 0x02, 0x45, 0xd3, 0x7d, 0xa6, 0x85,
+	0x8c, 0xe1, 0xd6, 0xa1, 0xc2, 0x89, 0xc4, 0x50, 0xb0, 0x01, 0xb7, 0x23, 0xa1, 0x1c, 0x80, 0x72,
+	0xed, 0x68, 0x8f, 0x7b, 0x09, 0xca, 0x09, 0xe1, 0x07, 0x28, 0x43, 0x1a, 0xe9, 0xb6, 0x58, 0x0c,
+	0x16, 0x8c, 0xe3, 0x5e, 0xe4, 0x5e, 0x85, 0x25, 0x75, 0x5f, 0x48, 0x8c, 0x61, 0xa7, 0xcf, 0xba,
+	0x07, 0xa6, 0x1b, 0x0a, 0x41, 0x35, 0x21, 0x87, 0x1b, 0x31, 0x6e, 0x6a, 0x9f, 0xff, 0x7b, 0x1e,
+	0x96, 0xda, 0x88, 0x3b, 0x19, 0x4b, 0x05, 0xe3, 0x62, 0x9f, 0x66, 0xae, 0x07, 0xf3, 0xc2, 0x98,
+	0x96, 0xce, 0xc8, 0x3c, 0xd5, 0xf0, 0xb9, 0x53, 0x0d, 0x3f, 0x93, 0xd1, 0x65, 0xa8, 0xea, 0x6f,
+	0x3e, 0x35, 0x6a, 0x2a, 0xc6, 0x67, 0x0a, 0xd1, 0x85, 0x52, 0x67, 0x10, 0xc5, 0x28, 0xbd, 0x62,
+	0x23, 0x3f, 0x7b, 0x50, 0xdc, 0x50, 0x25, 0xfd, 0xf5, 0x45, 0x7d, 0x2d, 0xa6, 0x72, 0x7f, 0xd0,
+	0x69, 0x76, 0x59, 0x62, 0x27, 0xb4, 0xfd, 0x73, 0x5d, 0x44, 0x07, 0x2d, 0xc5, 0x55, 0xe8, 0x04,
+	0x11, 0xd8, 0xad, 0xdd, 0x6f, 0x60, 0x29, 0x22, 0xb4, 0x7f, 0x14, 0x0e, 0x04, 0xf2, 0xb0, 0x4b,
+	0x32, 0xaf, 0xf4, 0xfe, 0xc1, 0xaa, 0x1a, 0x62, 0x4f, 0x20, 0xdf, 0x22, 0x99, 0x4b, 0xa0, 0x28,
+	0x32, 0x35, 0xbc, 0xe6, 0xdf, 0x3f, 0x92, 0xd9, 0xd9, 0xff, 0xc1, 0x81, 0x8f, 0xa7, 0x4f, 0x52,
+	0x81, 0xef, 0x64, 0x98, 0x46, 0xee, 0x32, 0xe4, 0x23, 0x72, 0xa4, 0x8f, 0x33, 0x1f, 0xa8, 0xcf,
+	0x31, 0xa1, 0xdc, 0x07, 0x23, 0xf4, 0x97, 0x03, 0xd0, 0x46, 0x0c, 0x70, 0x88, 0xe9, 0x00, 0xcf,
+	0xe0, 0x30, 0x35, 0xd5, 0x73, 0x27, 0xa7, 0xfa, 0xc9, 0xcb, 0x96, 0x3f, 0x75, 0xd9, 0x08, 0x14,
+	0x25, 0x93, 0xa4, 0xef, 0x15, 0x3e, 0x80, 0x06, 0xbd, 0xb3, 0x6a, 0xcc, 0xae, 0x1e, 0x4c, 0x45,
+	0xdd, 0x3b, 0xc6, 0xf0, 0xff, 0x74, 0x60, 0x69, 0x43, 0x08, 0x14, 0x02, 0xa3, 0xb7, 0x7e, 0x0b,
+	0xbf, 0x86, 0xbc, 0x99, 0xdd, 0xef, 0x9d, 0xab, 0xda, 0xf7, 0x0d, 0x4f, 0xe4, 0x15, 0x58, 0x7c,
+	0x44, 0xe8, 0x10, 0xa3, 0x90, 0x23, 0x11, 0x2c, 0xb5, 0xbd, 0x57, 0x35, 0xce, 0x40, 0xfb, 0x7c,
+	0x0e, 0x95, 0x2f, 0x87, 0x98, 0x4a, 0x2b, 0xe9, 0x22, 0x2c, 0x8c, 0x24, 0x8d, 0x06, 0x81, 0x95,
+	0x33, 0x2e, 0x8b, 0x39, 0x35, 0x63, 0x28, 0xaf, 0x39, 0x0f, 0x03, 0x6f, 0x4b, 0x38, 0x45, 0xac,
+	0x70, 0x82, 0x98, 0xbf, 0x03, 0xd5, 0x09, 0x4c, 0xe1, 0x6e, 0x19, 0x50, 0xf5, 0x9e, 0xd8, 0xd7,
+	0xd2, 0x7f, 0xcd, 0x53, 0x33, 0x91, 0x66, 0x1f, 0x9a, 0xf9, 0xc4, 0x6c, 0xe2, 0xb7, 0xe1, 0xdc,
+	0x44, 0xf4, 0xa1, 0xd6, 0x38, 0x4b, 0xce, 0x05, 0x28, 0xd9, 0xb2, 0x18, 0x3d, 0xd6, 0xf2, 0x7f,
+	0x73, 0xec, 0x46, 0xe3, 0xbe, 0xc2, 0x68, 0xc6, 0x7c, 0xbc, 0x04, 0x65, 0xf5, 0x5e, 0x66, 0xe4,
+	0x08, 0xb9, 0xdd, 0x6a, 0xa1, 0x87, 0xb8, 0xad, 0xec, 0x29, 0xfc, 0xfc, 0x34, 0xfe, 0xd4, 0xd4,
+	0x34, 0x25, 0x7a, 0xfd, 0xd4, 0x2c, 0x9e, 0x9e, 0x9a, 0x17, 0xa0, 0x64, 0xdf, 0xcf, 0x92, 0xe1,
+	0x6f, 0x2c, 0xff, 0x17, 0x07, 0x56, 0x4f, 0xf0, 0xd7, 0x73, 0x21, 0x8b, 0x88, 0x9c, 0x29, 0x64,
+	0x92, 0x6b, 0x6e, 0x06, 0xd7, 0xfc, 0x1b, 0xb8, 0x16, 0xce, 0xe6, 0xda, 0x55, 0xff, 0x1d, 0x59,
+	0x21, 0xd6, 0xda, 0xa4, 0x4f, 0x5f, 0xd6, 0x9c, 0x67, 0x2f, 0x6b, 0xce, 0xbf, 0x2f, 0x6b, 0xce,
+	0xe3, 0x57, 0xb5, 0xb9, 0x67, 0xaf, 0x6a, 0x73, 0x7f, 0xbf, 0xaa, 0xcd, 0x81, 0x47, 0xd9, 0xd9,
+	0x57, 0x60, 0xdb, 0xf9, 0xea, 0xd6, 0x44, 0x93, 0x8c, 0xd7, 0x5c, 0xa7, 0x6c, 0xc2, 0x6a, 0x1d,
+	0x1e, 0xff, 0x1a, 0xd0, 0x5d, 0xd3, 0x29, 0xe9, 0x07, 0xfa, 0xd6, 0xff, 0x03, 0x00, 0x2e, 0x9f,
+	0x64, 0xfb, 0x30, 0x0c, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConversionFeeDenom) > 0 {
+		i -= len(m.ConversionFeeDenom)
+		copy(dAtA[i:], m.ConversionFeeDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ConversionFeeDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NhashPerUsdMil != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.NhashPerUsdMil))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.FloorGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Conditions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.RecipientBasisPoints != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.RecipientBasisPoints))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AdditionalFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeFreeWindows) > 0 {
+		for iNdEx := len(m.FeeFreeWindows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeFreeWindows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ExemptAttributes) > 0 {
+		for iNdEx := len(m.ExemptAttributes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExemptAttributes[iNdEx])
+			copy(dAtA[i:], m.ExemptAttributes[iNdEx])
+			i = encodeVarintMsgfees(dAtA, i, uint64(len(m.ExemptAttributes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AmountTiers) > 0 {
+		for iNdEx := len(m.AmountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AmountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BasisPoints != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.BasisPoints))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinAmount.Size()
+		i -= size
+		if _, err := m.MinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeFreeWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeFreeWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeFreeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintMsgfees(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMsgfees(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UsdFeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsdFeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsdFeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxAgeBlocks != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.MaxAgeBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RateSource) > 0 {
+		i -= len(m.RateSource)
+		copy(dAtA[i:], m.RateSource)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.RateSource)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DailyUserCap) > 0 {
+		for iNdEx := len(m.DailyUserCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyUserCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipUserSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipUserSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipUserSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Day != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Day != 0 {
+		i = encodeVarintMsgfees(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssessedMsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssessedMsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssessedMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WaivedReason) > 0 {
+		i -= len(m.WaivedReason)
+		copy(dAtA[i:], m.WaivedReason)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.WaivedReason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventMsgFeeWaived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventMsgFeeWaived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMsgFeeWaived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSponsored) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSponsored) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSponsored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFeeSponsorshipUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeeSponsorshipUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeeSponsorshipUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MsgType) > 0 {
+		i -= len(m.MsgType)
+		copy(dAtA[i:], m.MsgType)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.MsgType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintMsgfees(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgfees(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgfees(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorGasPrice.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.NhashPerUsdMil != 0 {
+		n += 1 + sovMsgfees(uint64(m.NhashPerUsdMil))
+	}
+	l = len(m.ConversionFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.RecipientBasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.RecipientBasisPoints))
+	}
+	l = m.Conditions.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	return n
+}
+
+func (m *MsgFeeConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AmountTiers) > 0 {
+		for _, e := range m.AmountTiers {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = m.MaxFee.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if len(m.ExemptAttributes) > 0 {
+		for _, s := range m.ExemptAttributes {
+			l = len(s)
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.FeeFreeWindows) > 0 {
+		for _, e := range m.FeeFreeWindows {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinAmount.Size()
+	n += 1 + l + sovMsgfees(uint64(l))
+	if m.BasisPoints != 0 {
+		n += 1 + sovMsgfees(uint64(m.BasisPoints))
+	}
+	return n
+}
+
+func (m *FeeFreeWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovMsgfees(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovMsgfees(uint64(l))
+	return n
+}
+
+func (m *UsdFeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.RateSource)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovMsgfees(uint64(m.MarketId))
+	}
+	if m.MaxAgeBlocks != 0 {
+		n += 1 + sovMsgfees(uint64(m.MaxAgeBlocks))
+	}
+	return n
+}
+
+func (m *FeeSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovMsgfees(uint64(m.MarketId))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.DailyUserCap) > 0 {
+		for _, e := range m.DailyUserCap {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorshipUserSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovMsgfees(uint64(m.Day))
+	}
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovMsgfees(uint64(m.Day))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	if m.Count != 0 {
+		n += 1 + sovMsgfees(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *AssessedMsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovMsgfees(uint64(l))
+		}
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.WaivedReason)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMsgFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventMsgFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.MsgFees) > 0 {
 		for _, e := range m.MsgFees {
 			l = e.Size()
 			n += 1 + l + sovMsgfees(uint64(l))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventMsgFeeWaived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSponsored) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFeeSponsorshipUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MsgType)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovMsgfees(uint64(l))
+	}
+	return n
+}
+
+func sovMsgfees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgfees(x uint64) (n int) {
+	return sovMsgfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			}
+			m.NhashPerUsdMil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			m.RecipientBasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountTiers = append(m.AmountTiers, MsgFeeTier{})
+			if err := m.AmountTiers[len(m.AmountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExemptAttributes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExemptAttributes = append(m.ExemptAttributes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeFreeWindows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeFreeWindows = append(m.FeeFreeWindows, FeeFreeWindow{})
+			if err := m.FeeFreeWindows[len(m.FeeFreeWindows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BasisPoints", wireType)
+			}
+			m.BasisPoints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BasisPoints |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeFreeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeFreeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeFreeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UsdFeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsdFeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsdFeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAgeBlocks", wireType)
+			}
+			m.MaxAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyUserCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyUserCap = append(m.DailyUserCap, types.Coin{})
+			if err := m.DailyUserCap[len(m.DailyUserCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSponsorshipUserSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipUserSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipUserSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssessedMsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssessedMsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssessedMsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaivedReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WaivedReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMsgFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Count = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMsgFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMsgFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMsgFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, EventMsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovMsgfees(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgfees(x uint64) (n int) {
-	return sovMsgfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventMsgFeeWaived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -641,17 +4266,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventMsgFeeWaived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventMsgFeeWaived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FloorGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -661,47 +4286,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FloorGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
-			}
-			m.NhashPerUsdMil = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgfees
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -729,7 +4334,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -752,7 +4357,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFee) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSponsored) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -775,15 +4380,15 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSponsored: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSponsored: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -811,13 +4416,13 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -827,28 +4432,27 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AdditionalFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -876,13 +4480,13 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			m.RecipientBasisPoints = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -892,11 +4496,88 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecipientBasisPoints |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgfees(dAtA[iNdEx:])
@@ -918,7 +4599,7 @@ func (m *MsgFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
+func (m *EventFeeSponsorshipUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -941,15 +4622,15 @@ func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMsgFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeeSponsorshipUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMsgFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeeSponsorshipUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -977,11 +4658,11 @@ func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgType = string(dAtA[iNdEx:postIndex])
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1009,11 +4690,11 @@ func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Count = string(dAtA[iNdEx:postIndex])
+			m.MsgType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1041,11 +4722,11 @@ func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1073,63 +4754,13 @@ func (m *EventMsgFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgfees(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgfees
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMsgFees) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgfees
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMsgFees: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMsgFees: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgfees
@@ -1139,25 +4770,23 @@ func (m *EventMsgFees) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgfees
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgfees
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgFees = append(m.MsgFees, EventMsgFee{})
-			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
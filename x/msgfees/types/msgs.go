@@ -25,6 +25,9 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgRemoveMsgFeeProposalRequest)(nil),
 	(*MsgUpdateConversionFeeDenomProposalRequest)(nil),
 	(*MsgUpdateNhashPerUsdMilProposalRequest)(nil),
+	(*MsgUpdateUsdFeeDenomsProposalRequest)(nil),
+	(*MsgSetFeeSponsorshipRequest)(nil),
+	(*MsgRemoveFeeSponsorshipRequest)(nil),
 }
 
 func NewMsgAssessCustomMsgFeeRequest(
@@ -77,13 +80,14 @@ func (msg MsgAssessCustomMsgFeeRequest) GetBips() (uint32, error) {
 	return uint32(bips), err
 }
 
-func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgAddMsgFeeProposalRequest {
+func NewMsgAddMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, conditions MsgFeeConditions, authority string) *MsgAddMsgFeeProposalRequest {
 	return &MsgAddMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
 		Authority:            authority,
+		Conditions:           conditions,
 	}
 }
 
@@ -104,6 +108,10 @@ func (msg *MsgAddMsgFeeProposalRequest) ValidateBasic() error {
 		return err
 	}
 
+	if err := msg.Conditions.Validate(msg.AdditionalFee); err != nil {
+		return ErrInvalidConditions.Wrap(err.Error())
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -134,13 +142,14 @@ func ValidateBips(recipient, recipientBasisPoints string) error {
 	return nil
 }
 
-func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, authority string) *MsgUpdateMsgFeeProposalRequest {
+func NewMsgUpdateMsgFeeProposalRequest(msgTypeURL string, additionalFee sdk.Coin, recipient string, recipientBasisPoints string, conditions MsgFeeConditions, authority string) *MsgUpdateMsgFeeProposalRequest {
 	return &MsgUpdateMsgFeeProposalRequest{
 		MsgTypeUrl:           msgTypeURL,
 		AdditionalFee:        additionalFee,
 		Recipient:            recipient,
 		RecipientBasisPoints: recipientBasisPoints,
 		Authority:            authority,
+		Conditions:           conditions,
 	}
 }
 
@@ -157,6 +166,10 @@ func (msg *MsgUpdateMsgFeeProposalRequest) ValidateBasic() error {
 		return err
 	}
 
+	if err := msg.Conditions.Validate(msg.AdditionalFee); err != nil {
+		return ErrInvalidConditions.Wrap(err.Error())
+	}
+
 	_, err := sdk.AccAddressFromBech32(msg.Authority)
 	if err != nil {
 		return err
@@ -224,3 +237,88 @@ func (msg *MsgUpdateNhashPerUsdMilProposalRequest) ValidateBasic() error {
 
 	return nil
 }
+
+func NewMsgUpdateUsdFeeDenomsProposalRequest(toSet []UsdFeeDenom, toRemove []string, authority string) *MsgUpdateUsdFeeDenomsProposalRequest {
+	return &MsgUpdateUsdFeeDenomsProposalRequest{
+		ToSet:     toSet,
+		ToRemove:  toRemove,
+		Authority: authority,
+	}
+}
+
+func (msg *MsgUpdateUsdFeeDenomsProposalRequest) ValidateBasic() error {
+	if len(msg.ToSet) == 0 && len(msg.ToRemove) == 0 {
+		return ErrInvalidUsdFeeDenom.Wrap("no usd fee denoms to set or remove")
+	}
+	if err := ValidateUsdFeeDenoms(msg.ToSet); err != nil {
+		return ErrInvalidUsdFeeDenom.Wrap(err.Error())
+	}
+
+	setting := make(map[string]bool, len(msg.ToSet))
+	for _, usdFeeDenom := range msg.ToSet {
+		setting[usdFeeDenom.Denom] = true
+	}
+	removing := make(map[string]bool, len(msg.ToRemove))
+	for _, denom := range msg.ToRemove {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return ErrInvalidUsdFeeDenom.Wrap(err.Error())
+		}
+		if removing[denom] {
+			return ErrInvalidUsdFeeDenom.Wrapf("duplicate denom to remove %q", denom)
+		}
+		if setting[denom] {
+			return ErrInvalidUsdFeeDenom.Wrapf("denom %q cannot be both set and removed", denom)
+		}
+		removing[denom] = true
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func NewMsgSetFeeSponsorshipRequest(admin, msgTypeURL string, marketID uint32, markerDenom string, budget, dailyUserCap sdk.Coins) *MsgSetFeeSponsorshipRequest {
+	return &MsgSetFeeSponsorshipRequest{
+		Admin:        admin,
+		MsgTypeUrl:   msgTypeURL,
+		MarketId:     marketID,
+		MarkerDenom:  markerDenom,
+		Budget:       budget,
+		DailyUserCap: dailyUserCap,
+	}
+}
+
+func (msg *MsgSetFeeSponsorshipRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return err
+	}
+	if err := ValidateFeeSponsorshipScope(msg.MsgTypeUrl, msg.MarketId, msg.MarkerDenom); err != nil {
+		return ErrInvalidSponsorship.Wrap(err.Error())
+	}
+	if err := ValidateFeeSponsorshipLimits(msg.Budget, msg.DailyUserCap); err != nil {
+		return ErrInvalidSponsorship.Wrap(err.Error())
+	}
+	return nil
+}
+
+func NewMsgRemoveFeeSponsorshipRequest(admin, msgTypeURL string, marketID uint32, markerDenom string) *MsgRemoveFeeSponsorshipRequest {
+	return &MsgRemoveFeeSponsorshipRequest{
+		Admin:       admin,
+		MsgTypeUrl:  msgTypeURL,
+		MarketId:    marketID,
+		MarkerDenom: markerDenom,
+	}
+}
+
+func (msg *MsgRemoveFeeSponsorshipRequest) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return err
+	}
+	if err := ValidateFeeSponsorshipScope(msg.MsgTypeUrl, msg.MarketId, msg.MarkerDenom); err != nil {
+		return ErrInvalidSponsorship.Wrap(err.Error())
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package types_test
 import (
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,9 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgRemoveMsgFeeProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateConversionFeeDenomProposalRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateNhashPerUsdMilProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgUpdateUsdFeeDenomsProposalRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgSetFeeSponsorshipRequest{Admin: signer} },
+		func(signer string) sdk.Msg { return &MsgRemoveFeeSponsorshipRequest{Admin: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -243,6 +248,33 @@ func TestMsgAddMsgFeeProposalRequestValidateBasic(t *testing.T) {
 			},
 			errorMsg: "",
 		},
+		{
+			name: "Valid proposal with conditions",
+			msg: MsgAddMsgFeeProposalRequest{
+				MsgTypeUrl:    "msgType",
+				AdditionalFee: sdk.NewInt64Coin("hotdog", 10),
+				Conditions: MsgFeeConditions{
+					AmountTiers:      []MsgFeeTier{NewMsgFeeTier(sdkmath.NewInt(100), 25)},
+					MaxFee:           sdkmath.NewInt(1000),
+					ExemptAttributes: []string{"maker.pb"},
+				},
+				Authority: authority,
+			},
+			errorMsg: "",
+		},
+		{
+			name: "Invalid proposal conditions",
+			msg: MsgAddMsgFeeProposalRequest{
+				MsgTypeUrl:    "msgType",
+				AdditionalFee: sdk.NewInt64Coin("hotdog", 10),
+				Conditions: MsgFeeConditions{
+					AmountTiers: []MsgFeeTier{NewMsgFeeTier(sdkmath.NewInt(100), 25)},
+					MaxFee:      sdkmath.NewInt(5),
+				},
+				Authority: authority,
+			},
+			errorMsg: "max fee 5 cannot be less than the additional fee 10: invalid msg fee conditions",
+		},
 		{
 			name: "invalid authority",
 			msg: MsgAddMsgFeeProposalRequest{
@@ -345,6 +377,33 @@ func TestMsgUpdateMsgFeeProposalRequestValidateBasic(t *testing.T) {
 			},
 			errorMsg: "",
 		},
+		{
+			name: "Valid proposal with conditions",
+			msg: MsgUpdateMsgFeeProposalRequest{
+				MsgTypeUrl:    msgType,
+				AdditionalFee: sdk.NewInt64Coin("hotdog", 10),
+				Conditions: MsgFeeConditions{
+					AmountTiers:      []MsgFeeTier{NewMsgFeeTier(sdkmath.NewInt(100), 25)},
+					MaxFee:           sdkmath.NewInt(1000),
+					ExemptAttributes: []string{"maker.pb"},
+				},
+				Authority: authority,
+			},
+			errorMsg: "",
+		},
+		{
+			name: "Invalid proposal conditions",
+			msg: MsgUpdateMsgFeeProposalRequest{
+				MsgTypeUrl:    msgType,
+				AdditionalFee: sdk.NewInt64Coin("hotdog", 10),
+				Conditions: MsgFeeConditions{
+					AmountTiers: []MsgFeeTier{NewMsgFeeTier(sdkmath.NewInt(100), 25)},
+					MaxFee:      sdkmath.NewInt(5),
+				},
+				Authority: authority,
+			},
+			errorMsg: "max fee 5 cannot be less than the additional fee 10: invalid msg fee conditions",
+		},
 		{
 			name: "invalid authority",
 			msg: MsgUpdateMsgFeeProposalRequest{
@@ -520,6 +579,138 @@ func TestUpdateConversionFeeDenomProposalRequestValidateBasic(t *testing.T) {
 
 }
 
+func TestMsgUpdateUsdFeeDenomsProposalRequestValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress("input111111111111111").String()
+	stable := NewUsdFeeDenom("usdstable", UsdRateSourceMarkerNav, 0, 100)
+
+	cases := []struct {
+		name     string
+		msg      *MsgUpdateUsdFeeDenomsProposalRequest
+		errorMsg string
+	}{
+		{
+			name:     "valid set and remove",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest([]UsdFeeDenom{stable}, []string{"olddenom"}, authority),
+			errorMsg: "",
+		},
+		{
+			name:     "nothing to do",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest(nil, nil, authority),
+			errorMsg: "no usd fee denoms to set or remove: invalid usd fee denom",
+		},
+		{
+			name:     "invalid usd fee denom",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest([]UsdFeeDenom{NewUsdFeeDenom("usdstable", "", 0, 100)}, nil, authority),
+			errorMsg: `denom "usdstable": rate source cannot be empty: invalid usd fee denom`,
+		},
+		{
+			name:     "invalid denom to remove",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest(nil, []string{"??"}, authority),
+			errorMsg: "invalid denom: ??: invalid usd fee denom",
+		},
+		{
+			name:     "duplicate denom to remove",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest(nil, []string{"olddenom", "olddenom"}, authority),
+			errorMsg: `duplicate denom to remove "olddenom": invalid usd fee denom`,
+		},
+		{
+			name:     "set and remove same denom",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest([]UsdFeeDenom{stable}, []string{"usdstable"}, authority),
+			errorMsg: `denom "usdstable" cannot be both set and removed: invalid usd fee denom`,
+		},
+		{
+			name:     "invalid authority",
+			msg:      NewMsgUpdateUsdFeeDenomsProposalRequest([]UsdFeeDenom{stable}, nil, ""),
+			errorMsg: "empty address string is not allowed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetFeeSponsorshipRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("input111111111111111").String()
+	msgType := "/provenance.exchange.v1.MsgCreateBidRequest"
+	budget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000_000))
+	userCap := sdk.NewCoins(sdk.NewInt64Coin("nhash", 1_000))
+
+	cases := []struct {
+		name     string
+		msg      *MsgSetFeeSponsorshipRequest
+		errorMsg string
+	}{
+		{
+			name: "valid market sponsorship",
+			msg:  NewMsgSetFeeSponsorshipRequest(admin, msgType, 3, "", budget, userCap),
+		},
+		{
+			name: "valid marker sponsorship without a cap",
+			msg:  NewMsgSetFeeSponsorshipRequest(admin, msgType, 0, "mycoin", budget, nil),
+		},
+		{
+			name:     "invalid admin",
+			msg:      NewMsgSetFeeSponsorshipRequest("", msgType, 0, "", budget, userCap),
+			errorMsg: "empty address string is not allowed",
+		},
+		{
+			name:     "no msg type",
+			msg:      NewMsgSetFeeSponsorshipRequest(admin, "", 0, "", budget, userCap),
+			errorMsg: "msg type is empty: invalid fee sponsorship",
+		},
+		{
+			name:     "market and marker",
+			msg:      NewMsgSetFeeSponsorshipRequest(admin, msgType, 3, "mycoin", budget, userCap),
+			errorMsg: "a fee sponsorship cannot have both a market id and a marker denom: invalid fee sponsorship",
+		},
+		{
+			name:     "invalid marker denom",
+			msg:      NewMsgSetFeeSponsorshipRequest(admin, msgType, 0, "?", budget, userCap),
+			errorMsg: "invalid marker denom: invalid denom: ?: invalid fee sponsorship",
+		},
+		{
+			name:     "no budget",
+			msg:      NewMsgSetFeeSponsorshipRequest(admin, msgType, 0, "", nil, userCap),
+			errorMsg: "budget cannot be empty: invalid fee sponsorship",
+		},
+		{
+			name:     "invalid daily user cap",
+			msg:      NewMsgSetFeeSponsorshipRequest(admin, msgType, 0, "", budget, sdk.Coins{sdk.NewInt64Coin("nhash", 0)}),
+			errorMsg: `invalid daily user cap "0nhash": coin 0nhash amount is not positive: invalid fee sponsorship`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.errorMsg) > 0 {
+				require.EqualError(t, err, tc.errorMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRemoveFeeSponsorshipRequestValidateBasic(t *testing.T) {
+	admin := sdk.AccAddress("input111111111111111").String()
+	msgType := "/provenance.exchange.v1.MsgCreateBidRequest"
+
+	require.NoError(t, NewMsgRemoveFeeSponsorshipRequest(admin, msgType, 3, "").ValidateBasic(), "valid")
+	require.EqualError(t, NewMsgRemoveFeeSponsorshipRequest("", msgType, 3, "").ValidateBasic(),
+		"empty address string is not allowed", "invalid admin")
+	require.EqualError(t, NewMsgRemoveFeeSponsorshipRequest(admin, "", 3, "").ValidateBasic(),
+		"msg type is empty: invalid fee sponsorship", "no msg type")
+}
+
 func TestValidateBips(t *testing.T) {
 	cases := []struct {
 		name                 string
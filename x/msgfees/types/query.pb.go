@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	encoding_binary "encoding/binary"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
@@ -217,29 +219,22 @@ func (m *QueryAllMsgFeesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-// CalculateTxFeesRequest is the request type for the Query RPC method.
-type CalculateTxFeesRequest struct {
-	// tx_bytes is the transaction to simulate.
-	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
-	// default_base_denom is used to set the denom used for gas fees
-	// if not set it will default to nhash.
-	DefaultBaseDenom string `protobuf:"bytes,2,opt,name=default_base_denom,json=defaultBaseDenom,proto3" json:"default_base_denom,omitempty"`
-	// gas_adjustment is the adjustment factor to be multiplied against the estimate returned by the tx simulation
-	GasAdjustment float32 `protobuf:"fixed32,3,opt,name=gas_adjustment,json=gasAdjustment,proto3" json:"gas_adjustment,omitempty"`
+// QueryUsdFeeDenomsRequest is the request type for the Query/UsdFeeDenoms RPC method.
+type QueryUsdFeeDenomsRequest struct {
 }
 
-func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{} }
-func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*CalculateTxFeesRequest) ProtoMessage()    {}
-func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryUsdFeeDenomsRequest) Reset()         { *m = QueryUsdFeeDenomsRequest{} }
+func (m *QueryUsdFeeDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdFeeDenomsRequest) ProtoMessage()    {}
+func (*QueryUsdFeeDenomsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_73f2d53a5aebf81b, []int{4}
 }
-func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryUsdFeeDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CalculateTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryUsdFeeDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CalculateTxFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryUsdFeeDenomsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -249,62 +244,139 @@ func (m *CalculateTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *CalculateTxFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalculateTxFeesRequest.Merge(m, src)
+func (m *QueryUsdFeeDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdFeeDenomsRequest.Merge(m, src)
 }
-func (m *CalculateTxFeesRequest) XXX_Size() int {
+func (m *QueryUsdFeeDenomsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *CalculateTxFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalculateTxFeesRequest.DiscardUnknown(m)
+func (m *QueryUsdFeeDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdFeeDenomsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalculateTxFeesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryUsdFeeDenomsRequest proto.InternalMessageInfo
 
-func (m *CalculateTxFeesRequest) GetTxBytes() []byte {
+// QueryUsdFeeDenomsResponse is the response type for the Query/UsdFeeDenoms RPC method.
+type QueryUsdFeeDenomsResponse struct {
+	// usd_fee_denoms are the denoms that can be used to pay msg fees that are priced in usd.
+	UsdFeeDenoms []UsdFeeDenomRate `protobuf:"bytes,1,rep,name=usd_fee_denoms,json=usdFeeDenoms,proto3" json:"usd_fee_denoms"`
+}
+
+func (m *QueryUsdFeeDenomsResponse) Reset()         { *m = QueryUsdFeeDenomsResponse{} }
+func (m *QueryUsdFeeDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUsdFeeDenomsResponse) ProtoMessage()    {}
+func (*QueryUsdFeeDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+}
+func (m *QueryUsdFeeDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUsdFeeDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUsdFeeDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUsdFeeDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUsdFeeDenomsResponse.Merge(m, src)
+}
+func (m *QueryUsdFeeDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUsdFeeDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUsdFeeDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUsdFeeDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryUsdFeeDenomsResponse) GetUsdFeeDenoms() []UsdFeeDenomRate {
 	if m != nil {
-		return m.TxBytes
+		return m.UsdFeeDenoms
 	}
 	return nil
 }
 
-func (m *CalculateTxFeesRequest) GetDefaultBaseDenom() string {
+// QueryFeeSponsorshipsRequest is the request type for the Query/FeeSponsorships RPC method.
+type QueryFeeSponsorshipsRequest struct {
+	// sponsor is the optional bech32 address of the sponsor to limit the results to.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFeeSponsorshipsRequest) Reset()         { *m = QueryFeeSponsorshipsRequest{} }
+func (m *QueryFeeSponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipsRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{6}
+}
+func (m *QueryFeeSponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipsRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeSponsorshipsRequest proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipsRequest) GetSponsor() string {
 	if m != nil {
-		return m.DefaultBaseDenom
+		return m.Sponsor
 	}
 	return ""
 }
 
-func (m *CalculateTxFeesRequest) GetGasAdjustment() float32 {
+func (m *QueryFeeSponsorshipsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.GasAdjustment
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// CalculateTxFeesResponse is the response type for the Query RPC method.
-type CalculateTxFeesResponse struct {
-	// additional_fees are the amount of coins to be for addition msg fees
-	AdditionalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=additional_fees,json=additionalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fees"`
-	// total_fees are the total amount of fees needed for the transactions (msg fees + gas fee)
-	// note: the gas fee is calculated with the floor gas price module param.
-	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
-	// estimated_gas is the amount of gas needed for the transaction
-	EstimatedGas uint64 `protobuf:"varint,3,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
+// QueryFeeSponsorshipsResponse is the response type for the Query/FeeSponsorships RPC method.
+type QueryFeeSponsorshipsResponse struct {
+	// fee_sponsorships are the requested fee sponsorships along with their remaining budgets.
+	FeeSponsorships []FeeSponsorshipBudget `protobuf:"bytes,1,rep,name=fee_sponsorships,json=feeSponsorships,proto3" json:"fee_sponsorships"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse{} }
-func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*CalculateTxFeesResponse) ProtoMessage()    {}
-func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73f2d53a5aebf81b, []int{5}
+func (m *QueryFeeSponsorshipsResponse) Reset()         { *m = QueryFeeSponsorshipsResponse{} }
+func (m *QueryFeeSponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipsResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{7}
 }
-func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFeeSponsorshipsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CalculateTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFeeSponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CalculateTxFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFeeSponsorshipsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -314,583 +386,2090 @@ func (m *CalculateTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *CalculateTxFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalculateTxFeesResponse.Merge(m, src)
+func (m *QueryFeeSponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipsResponse.Merge(m, src)
 }
-func (m *CalculateTxFeesResponse) XXX_Size() int {
+func (m *QueryFeeSponsorshipsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *CalculateTxFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalculateTxFeesResponse.DiscardUnknown(m)
+func (m *QueryFeeSponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalculateTxFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFeeSponsorshipsResponse proto.InternalMessageInfo
 
-func (m *CalculateTxFeesResponse) GetAdditionalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryFeeSponsorshipsResponse) GetFeeSponsorships() []FeeSponsorshipBudget {
 	if m != nil {
-		return m.AdditionalFees
+		return m.FeeSponsorships
 	}
 	return nil
 }
 
-func (m *CalculateTxFeesResponse) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryFeeSponsorshipsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.TotalFees
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *CalculateTxFeesResponse) GetEstimatedGas() uint64 {
-	if m != nil {
-		return m.EstimatedGas
-	}
-	return 0
+// QueryFeeSponsorshipUsageRequest is the request type for the Query/FeeSponsorshipUsage RPC method.
+type QueryFeeSponsorshipUsageRequest struct {
+	// sponsor is the bech32 address of the sponsor.
+	Sponsor string `protobuf:"bytes,1,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// fee_payer is the bech32 address of the fee payer.
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// msg_type_url is the type-url of the sponsored msgs.
+	MsgTypeUrl string `protobuf:"bytes,3,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// market_id is the exchange market of the sponsorship, if it has one.
+	MarketId uint32 `protobuf:"varint,4,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// marker_denom is the marker denom of the sponsorship, if it has one.
+	MarkerDenom string `protobuf:"bytes,5,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.msgfees.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
-	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
-	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
-	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
+func (m *QueryFeeSponsorshipUsageRequest) Reset()         { *m = QueryFeeSponsorshipUsageRequest{} }
+func (m *QueryFeeSponsorshipUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipUsageRequest) ProtoMessage()    {}
+func (*QueryFeeSponsorshipUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{8}
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipUsageRequest.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipUsageRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
+var xxx_messageInfo_QueryFeeSponsorshipUsageRequest proto.InternalMessageInfo
 
-var fileDescriptor_73f2d53a5aebf81b = []byte{
-	// 718 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0xcf, 0x4f, 0x13, 0x4d,
-	0x18, 0xc7, 0x3b, 0x85, 0x97, 0x1f, 0xf3, 0xf2, 0xe3, 0x7d, 0x47, 0x84, 0xd2, 0x40, 0xc1, 0x25,
-	0x28, 0x34, 0xb2, 0x9b, 0x82, 0x07, 0xa3, 0x27, 0x8a, 0x81, 0x93, 0x09, 0x6e, 0x3c, 0x79, 0x59,
-	0xa7, 0xbb, 0xc3, 0xb8, 0xba, 0xbb, 0xb3, 0x74, 0xa6, 0x4d, 0x7b, 0x33, 0x1e, 0x8c, 0xf1, 0x64,
-	0xa2, 0x27, 0xe3, 0xd9, 0x18, 0x4f, 0xfc, 0x19, 0x9c, 0x0c, 0x89, 0x17, 0x4f, 0x6a, 0xc0, 0x84,
-	0x7f, 0xc3, 0xcc, 0xcc, 0xb6, 0x5d, 0x68, 0x8b, 0x9c, 0xbc, 0xc0, 0xf6, 0x99, 0xe7, 0x99, 0xef,
-	0x67, 0x9e, 0xf9, 0x3e, 0x03, 0xaf, 0xc5, 0x55, 0x56, 0x27, 0x11, 0x8e, 0x5c, 0x62, 0x85, 0x9c,
-	0xee, 0x11, 0xc2, 0xad, 0x7a, 0xc9, 0xda, 0xaf, 0x91, 0x6a, 0xd3, 0x8c, 0xab, 0x4c, 0x30, 0x74,
-	0xb5, 0x93, 0x62, 0x26, 0x29, 0x66, 0xbd, 0x94, 0xff, 0x1f, 0x87, 0x7e, 0xc4, 0x2c, 0xf5, 0x57,
-	0x67, 0xe6, 0xa7, 0x28, 0xa3, 0x4c, 0x7d, 0x5a, 0xf2, 0x2b, 0x89, 0xce, 0x51, 0xc6, 0x68, 0x40,
-	0x2c, 0x1c, 0xfb, 0x16, 0x8e, 0x22, 0x26, 0xb0, 0xf0, 0x59, 0xc4, 0x93, 0xd5, 0xa5, 0xde, 0x00,
-	0x2d, 0x21, 0x9d, 0x54, 0x70, 0x19, 0x0f, 0x19, 0xb7, 0x2a, 0x98, 0x13, 0xab, 0x5e, 0xaa, 0x10,
-	0x81, 0x4b, 0x96, 0xcb, 0xfc, 0x28, 0x59, 0x2f, 0xa6, 0xd7, 0x15, 0x7b, 0x3b, 0x2b, 0xc6, 0xd4,
-	0x8f, 0x94, 0xa2, 0xce, 0x35, 0xa6, 0x20, 0x7a, 0x20, 0x33, 0x76, 0x71, 0x15, 0x87, 0xdc, 0x26,
-	0xfb, 0x35, 0xc2, 0x85, 0x61, 0xc3, 0x2b, 0x67, 0xa2, 0x3c, 0x66, 0x11, 0x27, 0xe8, 0x2e, 0x1c,
-	0x8a, 0x55, 0x24, 0x07, 0x16, 0xc1, 0xca, 0xbf, 0xeb, 0xf3, 0x66, 0xcf, 0x66, 0x98, 0xba, 0xac,
-	0x3c, 0x78, 0xf8, 0x7d, 0x21, 0x63, 0x27, 0x25, 0xc6, 0x63, 0x38, 0xad, 0xf6, 0xdc, 0x0c, 0x82,
-	0xfb, 0x9c, 0x6e, 0x13, 0xd2, 0x52, 0x43, 0xdb, 0x10, 0x76, 0xb8, 0x72, 0x59, 0xb5, 0xf5, 0x75,
-	0x53, 0x1f, 0xc2, 0x94, 0x87, 0x30, 0xf5, 0x05, 0x24, 0x87, 0x30, 0x77, 0x31, 0x25, 0x49, 0xad,
-	0x9d, 0xaa, 0x34, 0x3e, 0x00, 0x38, 0xd3, 0x25, 0x91, 0xa0, 0xdf, 0x86, 0x23, 0x21, 0xa7, 0x8e,
-	0x24, 0xcc, 0x81, 0xc5, 0x81, 0x0b, 0xe0, 0x75, 0xa5, 0x3d, 0x1c, 0xea, 0x1d, 0xd0, 0x4e, 0x0f,
-	0xba, 0x1b, 0x7f, 0xa4, 0xd3, 0xb2, 0x67, 0xf0, 0x5e, 0x01, 0x38, 0xbd, 0x85, 0x03, 0xb7, 0x16,
-	0x60, 0x41, 0x1e, 0x36, 0xd2, 0x1d, 0x98, 0x85, 0x23, 0xa2, 0xe1, 0x54, 0x9a, 0x82, 0xe8, 0xd6,
-	0x8e, 0xd9, 0xc3, 0xa2, 0x51, 0x96, 0x3f, 0xd1, 0x4d, 0x88, 0x3c, 0xb2, 0x87, 0x6b, 0x81, 0x70,
-	0xa4, 0x98, 0xe3, 0x91, 0x88, 0x85, 0x0a, 0x63, 0xd4, 0xfe, 0x2f, 0x59, 0x29, 0x63, 0x4e, 0xee,
-	0xc9, 0x38, 0x5a, 0x86, 0x13, 0x14, 0x73, 0x07, 0x7b, 0x4f, 0x6b, 0x5c, 0x84, 0x24, 0x12, 0xb9,
-	0x81, 0x45, 0xb0, 0x92, 0xb5, 0xc7, 0x29, 0xe6, 0x9b, 0xed, 0xa0, 0xf1, 0x25, 0x0b, 0x67, 0xba,
-	0x50, 0x92, 0x4e, 0xbd, 0x06, 0x70, 0x12, 0x7b, 0x9e, 0x2f, 0x99, 0x71, 0x90, 0xee, 0xd8, 0xec,
-	0x99, 0x53, 0xb7, 0xce, 0xbb, 0xc5, 0xfc, 0xa8, 0xbc, 0x2d, 0xaf, 0xfa, 0xf3, 0x8f, 0x85, 0x15,
-	0xea, 0x8b, 0x27, 0xb5, 0x8a, 0xe9, 0xb2, 0xd0, 0x4a, 0x5c, 0xa8, 0xff, 0xad, 0x71, 0xef, 0x99,
-	0x25, 0x9a, 0x31, 0xe1, 0xaa, 0x80, 0xbf, 0x3f, 0x3d, 0x28, 0x8e, 0x05, 0x84, 0x62, 0xb7, 0xe9,
-	0x48, 0xeb, 0xf2, 0x4f, 0xa7, 0x07, 0x45, 0x60, 0x4f, 0x74, 0x94, 0x55, 0xf3, 0x9f, 0x03, 0x08,
-	0x05, 0x13, 0x2d, 0x8e, 0xec, 0xdf, 0xe2, 0x18, 0x55, 0xa2, 0x0a, 0x61, 0x09, 0x8e, 0x13, 0x2e,
-	0xfc, 0x10, 0x0b, 0xe2, 0x39, 0x14, 0x73, 0xd5, 0xd1, 0x41, 0x7b, 0xac, 0x1d, 0xdc, 0xc1, 0x7c,
-	0xfd, 0x68, 0x00, 0xfe, 0xa3, 0xac, 0x87, 0x5e, 0x02, 0x38, 0xa4, 0xfd, 0x8f, 0x56, 0xfb, 0x38,
-	0xac, 0x7b, 0xe0, 0xf2, 0xc5, 0xcb, 0xa4, 0xea, 0x0b, 0x32, 0x96, 0x5f, 0x7c, 0xfd, 0xf5, 0x36,
-	0xbb, 0x80, 0xe6, 0xad, 0xde, 0x8f, 0x85, 0x9e, 0x37, 0xf4, 0x0e, 0xc0, 0xc9, 0x73, 0xd3, 0x80,
-	0xd6, 0x2e, 0x92, 0xe9, 0x1a, 0xcc, 0xbc, 0x79, 0xd9, 0xf4, 0x84, 0xcc, 0x50, 0x64, 0x73, 0x28,
-	0xdf, 0x87, 0x0c, 0x07, 0x01, 0xfa, 0x08, 0xe0, 0xe4, 0x39, 0xeb, 0xf5, 0xc5, 0xea, 0x3d, 0x2d,
-	0x7d, 0xb1, 0xfa, 0x38, 0xda, 0xb8, 0xa5, 0xb0, 0xcc, 0x3b, 0xa0, 0x68, 0xac, 0xa6, 0xc9, 0x44,
-	0x43, 0x42, 0xb9, 0xad, 0x2a, 0x47, 0x3e, 0x10, 0xd2, 0x52, 0x9e, 0x34, 0x5b, 0xd9, 0x3f, 0x3c,
-	0x2e, 0x80, 0xa3, 0xe3, 0x02, 0xf8, 0x79, 0x5c, 0x00, 0x6f, 0x4e, 0x0a, 0x99, 0xa3, 0x93, 0x42,
-	0xe6, 0xdb, 0x49, 0x21, 0x03, 0x73, 0x3e, 0xeb, 0x4d, 0xb0, 0x0b, 0x1e, 0x6d, 0xa4, 0x8c, 0xd7,
-	0xc9, 0x59, 0xf3, 0x59, 0x5a, 0xb8, 0xd1, 0x6e, 0x8a, 0x72, 0x62, 0x65, 0x48, 0xbd, 0xc5, 0x1b,
-	0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x36, 0xb4, 0xcd, 0xe3, 0x7f, 0x06, 0x00, 0x00,
+func (m *QueryFeeSponsorshipUsageRequest) GetSponsor() string {
+	if m != nil {
+		return m.Sponsor
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryFeeSponsorshipUsageRequest) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryFeeSponsorshipUsageRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters for x/msgfees
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Query all Msgs which have fees associated with them.
-	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
-	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
-	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
+func (m *QueryFeeSponsorshipUsageRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryFeeSponsorshipUsageRequest) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryFeeSponsorshipUsageResponse is the response type for the Query/FeeSponsorshipUsage RPC method.
+type QueryFeeSponsorshipUsageResponse struct {
+	// fee_sponsorship is the fee sponsorship along with its remaining budget.
+	FeeSponsorship FeeSponsorshipBudget `protobuf:"bytes,1,opt,name=fee_sponsorship,json=feeSponsorship,proto3" json:"fee_sponsorship"`
+	// spent_today is the amount the sponsor has paid for the fee payer today.
+	SpentToday github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spent_today,json=spentToday,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent_today"`
+	// remaining_today is the amount the sponsor will still pay for the fee payer today.
+	// It is empty if the sponsorship doesn't have a daily user cap.
+	RemainingToday github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=remaining_today,json=remainingToday,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_today"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipUsageResponse) Reset()         { *m = QueryFeeSponsorshipUsageResponse{} }
+func (m *QueryFeeSponsorshipUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSponsorshipUsageResponse) ProtoMessage()    {}
+func (*QueryFeeSponsorshipUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{9}
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSponsorshipUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSponsorshipUsageResponse.Merge(m, src)
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSponsorshipUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSponsorshipUsageResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error) {
-	out := new(QueryAllMsgFeesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/QueryAllMsgFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeSponsorshipUsageResponse proto.InternalMessageInfo
+
+func (m *QueryFeeSponsorshipUsageResponse) GetFeeSponsorship() FeeSponsorshipBudget {
+	if m != nil {
+		return m.FeeSponsorship
 	}
-	return out, nil
+	return FeeSponsorshipBudget{}
 }
 
-func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
-	out := new(CalculateTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSponsorshipUsageResponse) GetSpentToday() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpentToday
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters for x/msgfees
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Query all Msgs which have fees associated with them.
-	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
-	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
-	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
+func (m *QueryFeeSponsorshipUsageResponse) GetRemainingToday() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingToday
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// FeeSponsorshipBudget is a fee sponsorship along with its remaining budget.
+type FeeSponsorshipBudget struct {
+	// fee_sponsorship is the fee sponsorship.
+	FeeSponsorship FeeSponsorship `protobuf:"bytes,1,opt,name=fee_sponsorship,json=feeSponsorship,proto3" json:"fee_sponsorship"`
+	// remaining is the part of the budget that hasn't been spent yet.
+	Remaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=remaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *FeeSponsorshipBudget) Reset()         { *m = FeeSponsorshipBudget{} }
+func (m *FeeSponsorshipBudget) String() string { return proto.CompactTextString(m) }
+func (*FeeSponsorshipBudget) ProtoMessage()    {}
+func (*FeeSponsorshipBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{10}
 }
-func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
+func (m *FeeSponsorshipBudget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
+func (m *FeeSponsorshipBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSponsorshipBudget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *FeeSponsorshipBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSponsorshipBudget.Merge(m, src)
+}
+func (m *FeeSponsorshipBudget) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSponsorshipBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSponsorshipBudget.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_FeeSponsorshipBudget proto.InternalMessageInfo
+
+func (m *FeeSponsorshipBudget) GetFeeSponsorship() FeeSponsorship {
+	if m != nil {
+		return m.FeeSponsorship
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+	return FeeSponsorship{}
+}
+
+func (m *FeeSponsorshipBudget) GetRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Remaining
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.msgfees.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllMsgFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllMsgFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.msgfees.v1.Query/QueryAllMsgFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllMsgFees(ctx, req.(*QueryAllMsgFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryFeeRevenueRequest is the request type for the Query/FeeRevenue RPC method.
+type QueryFeeRevenueRequest struct {
+	// start_day is the first day (since the unix epoch, in UTC) to include. Zero means there is no start.
+	StartDay int64 `protobuf:"varint,1,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	// end_day is the last day (since the unix epoch, in UTC) to include. Zero means there is no end.
+	EndDay int64 `protobuf:"varint,2,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// recipient is the optional bech32 address of the recipient to limit the results to.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// msg_type_url is the optional msg type-url to limit the results to.
+	MsgTypeUrl string `protobuf:"bytes,4,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CalculateTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CalculateTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/provenance.msgfees.v1.Query/CalculateTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CalculateTxFees(ctx, req.(*CalculateTxFeesRequest))
+func (m *QueryFeeRevenueRequest) Reset()         { *m = QueryFeeRevenueRequest{} }
+func (m *QueryFeeRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueRequest) ProtoMessage()    {}
+func (*QueryFeeRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{11}
+}
+func (m *QueryFeeRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryFeeRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueRequest.Merge(m, src)
+}
+func (m *QueryFeeRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.msgfees.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryAllMsgFees",
-			Handler:    _Query_QueryAllMsgFees_Handler,
-		},
-		{
-			MethodName: "CalculateTxFees",
-			Handler:    _Query_CalculateTxFees_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/msgfees/v1/query.proto",
+var xxx_messageInfo_QueryFeeRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueRequest) GetStartDay() int64 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRevenueRequest) GetEndDay() int64 {
+	if m != nil {
+		return m.EndDay
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeRevenueRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFeeRevenueRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRevenueRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryFeeRevenueResponse is the response type for the Query/FeeRevenue RPC method.
+type QueryFeeRevenueResponse struct {
+	// fee_revenues are the daily totals for each recipient and msg type, ordered by day.
+	FeeRevenues []FeeRevenue `protobuf:"bytes,1,rep,name=fee_revenues,json=feeRevenues,proto3" json:"fee_revenues"`
+	// fee_collector_total is the total sent to the fee collector by all matching entries (not just this page).
+	FeeCollectorTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fee_collector_total,json=feeCollectorTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector_total"`
+	// recipients_total is the total sent to recipients other than the fee collector by all matching entries (not just this page).
+	RecipientsTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recipients_total,json=recipientsTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipients_total"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageResponse `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryFeeRevenueResponse) Reset()         { *m = QueryFeeRevenueResponse{} }
+func (m *QueryFeeRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueResponse) ProtoMessage()    {}
+func (*QueryFeeRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{12}
+}
+func (m *QueryFeeRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueResponse.Merge(m, src)
+}
+func (m *QueryFeeRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllMsgFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueResponse) GetFeeRevenues() []FeeRevenue {
+	if m != nil {
+		return m.FeeRevenues
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMsgFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeRevenueResponse) GetFeeCollectorTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollectorTotal
+	}
+	return nil
 }
 
-func (m *QueryAllMsgFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryFeeRevenueResponse) GetRecipientsTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecipientsTotal
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAllMsgFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRevenueResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllMsgFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// UsdFeeDenomRate is a usd fee denom along with its current value in usd.
+type UsdFeeDenomRate struct {
+	// usd_fee_denom is the denom's configuration.
+	UsdFeeDenom UsdFeeDenom `protobuf:"bytes,1,opt,name=usd_fee_denom,json=usdFeeDenom,proto3" json:"usd_fee_denom"`
+	// usd_mils is the value of volume of the denom in usd mils. It is zero if the rate source doesn't have a value.
+	UsdMils cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=usd_mils,json=usdMils,proto3,customtype=cosmossdk.io/math.Int" json:"usd_mils"`
+	// volume is the amount of the denom that is worth usd_mils.
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// updated_block_height is the block height that the value was last updated.
+	UpdatedBlockHeight int64 `protobuf:"varint,4,opt,name=updated_block_height,json=updatedBlockHeight,proto3" json:"updated_block_height,omitempty"`
+	// stale is true if the value is too old (or missing) to be used to pay fees.
+	Stale bool `protobuf:"varint,5,opt,name=stale,proto3" json:"stale,omitempty"`
 }
 
-func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *UsdFeeDenomRate) Reset()         { *m = UsdFeeDenomRate{} }
+func (m *UsdFeeDenomRate) String() string { return proto.CompactTextString(m) }
+func (*UsdFeeDenomRate) ProtoMessage()    {}
+func (*UsdFeeDenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{13}
+}
+func (m *UsdFeeDenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsdFeeDenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsdFeeDenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.MsgFees) > 0 {
-		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *UsdFeeDenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsdFeeDenomRate.Merge(m, src)
+}
+func (m *UsdFeeDenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsdFeeDenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsdFeeDenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsdFeeDenomRate proto.InternalMessageInfo
+
+func (m *UsdFeeDenomRate) GetUsdFeeDenom() UsdFeeDenom {
+	if m != nil {
+		return m.UsdFeeDenom
 	}
-	return len(dAtA) - i, nil
+	return UsdFeeDenom{}
 }
 
-func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UsdFeeDenomRate) GetUpdatedBlockHeight() int64 {
+	if m != nil {
+		return m.UpdatedBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UsdFeeDenomRate) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
 }
 
-func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasAdjustment != 0 {
-		i -= 4
-		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
-		i--
-		dAtA[i] = 0x1d
+// CalculateTxFeesRequest is the request type for the Query RPC method.
+type CalculateTxFeesRequest struct {
+	// tx_bytes is the transaction to simulate.
+	TxBytes []byte `protobuf:"bytes,1,opt,name=tx_bytes,json=txBytes,proto3" json:"tx_bytes,omitempty"`
+	// default_base_denom is used to set the denom used for gas fees
+	// if not set it will default to nhash.
+	DefaultBaseDenom string `protobuf:"bytes,2,opt,name=default_base_denom,json=defaultBaseDenom,proto3" json:"default_base_denom,omitempty"`
+	// gas_adjustment is the adjustment factor to be multiplied against the estimate returned by the tx simulation
+	GasAdjustment float32 `protobuf:"fixed32,3,opt,name=gas_adjustment,json=gasAdjustment,proto3" json:"gas_adjustment,omitempty"`
+}
+
+func (m *CalculateTxFeesRequest) Reset()         { *m = CalculateTxFeesRequest{} }
+func (m *CalculateTxFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*CalculateTxFeesRequest) ProtoMessage()    {}
+func (*CalculateTxFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{14}
+}
+func (m *CalculateTxFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalculateTxFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalculateTxFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.DefaultBaseDenom) > 0 {
-		i -= len(m.DefaultBaseDenom)
-		copy(dAtA[i:], m.DefaultBaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *CalculateTxFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculateTxFeesRequest.Merge(m, src)
+}
+func (m *CalculateTxFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalculateTxFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculateTxFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalculateTxFeesRequest proto.InternalMessageInfo
+
+func (m *CalculateTxFeesRequest) GetTxBytes() []byte {
+	if m != nil {
+		return m.TxBytes
 	}
-	if len(m.TxBytes) > 0 {
-		i -= len(m.TxBytes)
-		copy(dAtA[i:], m.TxBytes)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *CalculateTxFeesRequest) GetDefaultBaseDenom() string {
+	if m != nil {
+		return m.DefaultBaseDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CalculateTxFeesRequest) GetGasAdjustment() float32 {
+	if m != nil {
+		return m.GasAdjustment
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CalculateTxFeesResponse is the response type for the Query RPC method.
+type CalculateTxFeesResponse struct {
+	// additional_fees are the amount of coins to be for addition msg fees
+	AdditionalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=additional_fees,json=additionalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"additional_fees"`
+	// total_fees are the total amount of fees needed for the transactions (msg fees + gas fee)
+	// note: the gas fee is calculated with the floor gas price module param.
+	TotalFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+	// estimated_gas is the amount of gas needed for the transaction
+	EstimatedGas uint64 `protobuf:"varint,3,opt,name=estimated_gas,json=estimatedGas,proto3" json:"estimated_gas,omitempty"`
+	// assessed_msg_fees itemizes the msg fees assessed for each msg that has one, including waived fees.
+	AssessedMsgFees []AssessedMsgFee `protobuf:"bytes,4,rep,name=assessed_msg_fees,json=assessedMsgFees,proto3" json:"assessed_msg_fees"`
 }
 
-func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EstimatedGas != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TotalFees) > 0 {
-		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.AdditionalFees) > 0 {
-		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *CalculateTxFeesResponse) Reset()         { *m = CalculateTxFeesResponse{} }
+func (m *CalculateTxFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*CalculateTxFeesResponse) ProtoMessage()    {}
+func (*CalculateTxFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73f2d53a5aebf81b, []int{15}
+}
+func (m *CalculateTxFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CalculateTxFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CalculateTxFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CalculateTxFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalculateTxFeesResponse.Merge(m, src)
+}
+func (m *CalculateTxFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CalculateTxFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalculateTxFeesResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CalculateTxFeesResponse proto.InternalMessageInfo
+
+func (m *CalculateTxFeesResponse) GetAdditionalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AdditionalFees
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CalculateTxFeesResponse) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CalculateTxFeesResponse) GetEstimatedGas() uint64 {
+	if m != nil {
+		return m.EstimatedGas
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryAllMsgFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *CalculateTxFeesResponse) GetAssessedMsgFees() []AssessedMsgFee {
+	if m != nil {
+		return m.AssessedMsgFees
 	}
-	return n
+	return nil
 }
 
-func (m *QueryAllMsgFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MsgFees) > 0 {
-		for _, e := range m.MsgFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "provenance.msgfees.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "provenance.msgfees.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryAllMsgFeesRequest)(nil), "provenance.msgfees.v1.QueryAllMsgFeesRequest")
+	proto.RegisterType((*QueryAllMsgFeesResponse)(nil), "provenance.msgfees.v1.QueryAllMsgFeesResponse")
+	proto.RegisterType((*QueryUsdFeeDenomsRequest)(nil), "provenance.msgfees.v1.QueryUsdFeeDenomsRequest")
+	proto.RegisterType((*QueryUsdFeeDenomsResponse)(nil), "provenance.msgfees.v1.QueryUsdFeeDenomsResponse")
+	proto.RegisterType((*QueryFeeSponsorshipsRequest)(nil), "provenance.msgfees.v1.QueryFeeSponsorshipsRequest")
+	proto.RegisterType((*QueryFeeSponsorshipsResponse)(nil), "provenance.msgfees.v1.QueryFeeSponsorshipsResponse")
+	proto.RegisterType((*QueryFeeSponsorshipUsageRequest)(nil), "provenance.msgfees.v1.QueryFeeSponsorshipUsageRequest")
+	proto.RegisterType((*QueryFeeSponsorshipUsageResponse)(nil), "provenance.msgfees.v1.QueryFeeSponsorshipUsageResponse")
+	proto.RegisterType((*FeeSponsorshipBudget)(nil), "provenance.msgfees.v1.FeeSponsorshipBudget")
+	proto.RegisterType((*QueryFeeRevenueRequest)(nil), "provenance.msgfees.v1.QueryFeeRevenueRequest")
+	proto.RegisterType((*QueryFeeRevenueResponse)(nil), "provenance.msgfees.v1.QueryFeeRevenueResponse")
+	proto.RegisterType((*UsdFeeDenomRate)(nil), "provenance.msgfees.v1.UsdFeeDenomRate")
+	proto.RegisterType((*CalculateTxFeesRequest)(nil), "provenance.msgfees.v1.CalculateTxFeesRequest")
+	proto.RegisterType((*CalculateTxFeesResponse)(nil), "provenance.msgfees.v1.CalculateTxFeesResponse")
 }
 
-func (m *CalculateTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TxBytes)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DefaultBaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.GasAdjustment != 0 {
-		n += 5
-	}
-	return n
+func init() { proto.RegisterFile("provenance/msgfees/v1/query.proto", fileDescriptor_73f2d53a5aebf81b) }
+
+var fileDescriptor_73f2d53a5aebf81b = []byte{
+	// 1473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6b, 0x1c, 0x55,
+	0x14, 0xcf, 0x64, 0xf3, 0x79, 0xb2, 0xc9, 0xb6, 0xb7, 0x69, 0xb3, 0xd9, 0xa6, 0x49, 0x3a, 0x35,
+	0xfd, 0x48, 0xcd, 0x4c, 0xd3, 0x88, 0x8a, 0x3e, 0x75, 0x53, 0x53, 0xab, 0x16, 0xe2, 0x98, 0x22,
+	0x14, 0x71, 0xbc, 0xd9, 0xb9, 0x99, 0x8c, 0x99, 0x99, 0xbb, 0xdd, 0x7b, 0x27, 0x64, 0x29, 0x45,
+	0xf1, 0x41, 0xc4, 0x27, 0x41, 0x41, 0x10, 0x1f, 0x04, 0x41, 0xc5, 0xa7, 0x3e, 0xf4, 0xc9, 0x77,
+	0xa5, 0x8f, 0xc5, 0x82, 0x8a, 0x0f, 0xb5, 0xa4, 0x42, 0xff, 0x0d, 0xb9, 0x1f, 0xfb, 0x95, 0xfd,
+	0xe8, 0xa6, 0xb4, 0xbe, 0x24, 0x73, 0xcf, 0xe7, 0xef, 0x9c, 0x73, 0xef, 0xb9, 0xe7, 0x2e, 0x1c,
+	0x2f, 0x96, 0xe8, 0x36, 0x89, 0x71, 0x5c, 0x20, 0x76, 0xc4, 0xfc, 0x0d, 0x42, 0x98, 0xbd, 0xbd,
+	0x68, 0x5f, 0x4f, 0x48, 0xa9, 0x6c, 0x15, 0x4b, 0x94, 0x53, 0x74, 0xb8, 0x26, 0x62, 0x69, 0x11,
+	0x6b, 0x7b, 0x31, 0x77, 0x10, 0x47, 0x41, 0x4c, 0x6d, 0xf9, 0x57, 0x49, 0xe6, 0xc6, 0x7d, 0xea,
+	0x53, 0xf9, 0x69, 0x8b, 0x2f, 0x4d, 0x9d, 0x2c, 0x50, 0x16, 0x51, 0xe6, 0x2a, 0x86, 0x5a, 0x68,
+	0xd6, 0x94, 0x4f, 0xa9, 0x1f, 0x12, 0x1b, 0x17, 0x03, 0x1b, 0xc7, 0x31, 0xe5, 0x98, 0x07, 0x34,
+	0xae, 0x70, 0x4f, 0xb4, 0xc6, 0x56, 0xc1, 0xa0, 0x84, 0xa6, 0x95, 0x41, 0x7b, 0x1d, 0x33, 0x62,
+	0x6f, 0x2f, 0xae, 0x13, 0x8e, 0x17, 0xed, 0x02, 0x0d, 0x62, 0xcd, 0x9f, 0xaf, 0xe7, 0xcb, 0xb0,
+	0xaa, 0x52, 0x45, 0xec, 0x07, 0xb1, 0xf4, 0xa8, 0x64, 0xcd, 0x71, 0x40, 0x6f, 0x0b, 0x89, 0x55,
+	0x5c, 0xc2, 0x11, 0x73, 0xc8, 0xf5, 0x84, 0x30, 0x6e, 0x3a, 0x70, 0xa8, 0x81, 0xca, 0x8a, 0x34,
+	0x66, 0x04, 0xbd, 0x0a, 0x03, 0x45, 0x49, 0xc9, 0x1a, 0xb3, 0xc6, 0xe9, 0x91, 0xf3, 0xc7, 0xac,
+	0x96, 0x79, 0xb2, 0x94, 0x5a, 0xbe, 0xef, 0xce, 0xfd, 0x99, 0x1e, 0x47, 0xab, 0x98, 0x1f, 0xc0,
+	0x11, 0x69, 0xf3, 0x42, 0x18, 0x5e, 0x61, 0xfe, 0x0a, 0x21, 0x15, 0x6f, 0x68, 0x05, 0xa0, 0x86,
+	0x2b, 0xdb, 0x2b, 0x4d, 0x9f, 0xb4, 0x74, 0xd6, 0x44, 0x10, 0x96, 0xaa, 0x8d, 0x0e, 0xc2, 0x5a,
+	0xc5, 0x3e, 0xd1, 0xba, 0x4e, 0x9d, 0xa6, 0xf9, 0xad, 0x01, 0x13, 0x4d, 0x2e, 0x34, 0xf4, 0x97,
+	0x61, 0x28, 0x62, 0xbe, 0x2b, 0x10, 0x66, 0x8d, 0xd9, 0x54, 0x07, 0xf0, 0x4a, 0xd3, 0x19, 0x8c,
+	0x94, 0x05, 0x74, 0xa9, 0x05, 0xba, 0x53, 0x8f, 0x45, 0xa7, 0xdc, 0x36, 0xc0, 0xcb, 0x41, 0x56,
+	0xa2, 0xbb, 0xca, 0xbc, 0x15, 0x42, 0x2e, 0x92, 0x98, 0xd6, 0x12, 0x4e, 0x61, 0xb2, 0x05, 0x4f,
+	0x63, 0x77, 0x60, 0x2c, 0x61, 0x9e, 0xc0, 0xee, 0x7a, 0x92, 0xa3, 0x23, 0x38, 0xd9, 0x26, 0x82,
+	0x3a, 0x23, 0x0e, 0xe6, 0x44, 0xd7, 0x21, 0x9d, 0xd4, 0xd9, 0x36, 0x3f, 0x82, 0xa3, 0xd2, 0xe1,
+	0x0a, 0x21, 0xef, 0x08, 0x2f, 0xb4, 0xc4, 0x36, 0x83, 0x62, 0xb5, 0x24, 0x59, 0x18, 0x64, 0x8a,
+	0x2c, 0x4b, 0x3d, 0xec, 0x54, 0x96, 0x4f, 0xad, 0x58, 0xbf, 0x1a, 0x30, 0xd5, 0x1a, 0x81, 0x8e,
+	0xfa, 0x3d, 0x38, 0x20, 0x22, 0x66, 0x75, 0x3c, 0x1d, 0xf7, 0xd9, 0x36, 0x71, 0x37, 0x5a, 0xca,
+	0x27, 0x9e, 0x4f, 0xb8, 0x0e, 0x3e, 0xb3, 0xd1, 0xe8, 0xe5, 0xe9, 0x55, 0xf5, 0x17, 0x03, 0x66,
+	0x5a, 0xc4, 0x71, 0x95, 0xd5, 0xe2, 0xee, 0x90, 0xcd, 0xa3, 0x30, 0x2c, 0x82, 0x2c, 0xe2, 0x32,
+	0x29, 0x49, 0x14, 0xc3, 0xce, 0xd0, 0x06, 0x21, 0xab, 0x62, 0x8d, 0x66, 0x21, 0x2d, 0xf6, 0x2c,
+	0x2f, 0x17, 0x89, 0x9b, 0x94, 0xc2, 0x6c, 0x4a, 0xf2, 0x21, 0x62, 0xfe, 0x5a, 0xb9, 0x48, 0xae,
+	0x96, 0x42, 0xa1, 0x1e, 0xe1, 0xd2, 0x16, 0xe1, 0x6e, 0xe0, 0x65, 0xfb, 0x66, 0x8d, 0xd3, 0xa3,
+	0xce, 0x90, 0x22, 0x5c, 0xf6, 0xd0, 0x71, 0x48, 0xcb, 0xef, 0x92, 0xda, 0x35, 0xd9, 0x7e, 0xa9,
+	0x3e, 0xa2, 0x68, 0x72, 0x1b, 0x98, 0xbb, 0xbd, 0x30, 0xdb, 0x1e, 0xbc, 0x2e, 0xc4, 0x35, 0xc8,
+	0xec, 0x29, 0x84, 0x3e, 0xfe, 0x4f, 0x50, 0x87, 0xb1, 0xc6, 0x3a, 0xa0, 0x10, 0x46, 0x58, 0x91,
+	0xc4, 0xdc, 0xe5, 0xd4, 0xc3, 0xe5, 0x6c, 0xaf, 0xac, 0xef, 0x64, 0x43, 0x1d, 0x2a, 0x15, 0x58,
+	0xa6, 0x41, 0x9c, 0x3f, 0x27, 0xac, 0xfc, 0xfc, 0xcf, 0xcc, 0x69, 0x3f, 0xe0, 0x9b, 0xc9, 0xba,
+	0x55, 0xa0, 0x91, 0x6e, 0xaf, 0xfa, 0xdf, 0x02, 0xf3, 0xb6, 0x6c, 0x91, 0x31, 0x26, 0x15, 0x98,
+	0x03, 0xd2, 0xfe, 0x9a, 0x30, 0x8f, 0x38, 0x64, 0x4a, 0x24, 0xc2, 0x41, 0x1c, 0xc4, 0xbe, 0xf6,
+	0x98, 0x7a, 0xfa, 0x1e, 0xc7, 0xaa, 0x3e, 0xa4, 0x57, 0xf3, 0x4f, 0x03, 0xc6, 0x5b, 0xa5, 0x04,
+	0xad, 0xb5, 0x4b, 0xec, 0x5c, 0x77, 0x89, 0x6d, 0x9d, 0xd2, 0x00, 0x86, 0xab, 0x00, 0x9e, 0x45,
+	0x42, 0x6b, 0xd6, 0xcd, 0x3f, 0x0c, 0xdd, 0xd3, 0x45, 0xc3, 0x24, 0xdb, 0x24, 0x4e, 0xaa, 0x5b,
+	0xfe, 0x28, 0x0c, 0x33, 0x8e, 0x4b, 0xdc, 0x15, 0x49, 0x16, 0x51, 0xa5, 0x9c, 0x21, 0x49, 0xb8,
+	0x88, 0xcb, 0x68, 0x02, 0x06, 0x49, 0xec, 0xb9, 0xaa, 0xe2, 0x82, 0x35, 0x40, 0x62, 0x4f, 0x30,
+	0xa6, 0x04, 0xf6, 0x42, 0x50, 0x0c, 0x48, 0xcc, 0xf5, 0x76, 0xaf, 0x11, 0x9a, 0xce, 0x43, 0x5f,
+	0xd3, 0x79, 0x68, 0x6c, 0x4e, 0xfd, 0x4f, 0xdc, 0x9c, 0xbe, 0x4f, 0xc1, 0x44, 0x53, 0x60, 0xfa,
+	0x38, 0xbc, 0x01, 0x69, 0x51, 0xb5, 0x92, 0x22, 0x57, 0x7a, 0xd2, 0xf1, 0xf6, 0x25, 0xd3, 0x06,
+	0x74, 0xb9, 0x46, 0x36, 0xaa, 0x14, 0x86, 0x6e, 0xc0, 0x21, 0x61, 0xab, 0x40, 0xc3, 0x90, 0x14,
+	0x38, 0x2d, 0xb9, 0x9c, 0x72, 0x1c, 0x3e, 0x8b, 0xaa, 0x1d, 0xdc, 0x20, 0x64, 0xb9, 0xe2, 0x66,
+	0x4d, 0x78, 0x41, 0xdb, 0x70, 0xa0, 0x9a, 0x5b, 0xa6, 0x3d, 0x3f, 0x83, 0xe3, 0x90, 0xa9, 0x39,
+	0x51, 0x7e, 0x1b, 0x5b, 0x6f, 0xdf, 0x93, 0xb7, 0xde, 0xdb, 0xbd, 0x90, 0xd9, 0x73, 0xd7, 0xa1,
+	0xb7, 0x60, 0xb4, 0xe1, 0xae, 0xd4, 0x27, 0xca, 0x7c, 0xfc, 0x55, 0x59, 0xa9, 0x4f, 0xdd, 0x35,
+	0x89, 0x56, 0x60, 0x48, 0x58, 0x8b, 0x82, 0x90, 0xa9, 0xee, 0x9c, 0x3f, 0x2b, 0x84, 0xfe, 0xbe,
+	0x3f, 0x73, 0x58, 0xe1, 0x65, 0xde, 0x96, 0x15, 0x50, 0x3b, 0xc2, 0x7c, 0xd3, 0xba, 0x1c, 0xf3,
+	0xdf, 0x6f, 0x2f, 0x80, 0x0e, 0xe4, 0x72, 0xcc, 0x9d, 0xc1, 0x84, 0x79, 0x57, 0x82, 0x90, 0xa1,
+	0x65, 0x18, 0xd8, 0xa6, 0x61, 0x12, 0x91, 0x6c, 0x6a, 0xff, 0x56, 0xb4, 0x2a, 0x3a, 0x07, 0xe3,
+	0x49, 0xd1, 0xc3, 0x9c, 0x78, 0xee, 0x7a, 0x48, 0x0b, 0x5b, 0xee, 0x26, 0x09, 0xfc, 0x4d, 0x2e,
+	0x33, 0x98, 0x72, 0x90, 0xe6, 0xe5, 0x05, 0xeb, 0x75, 0xc9, 0x41, 0xe3, 0xd0, 0xcf, 0x38, 0x0e,
+	0x89, 0x3c, 0x09, 0x43, 0x8e, 0x5a, 0x98, 0x9f, 0x19, 0x70, 0x64, 0x19, 0x87, 0x85, 0x24, 0xc4,
+	0x9c, 0xac, 0xed, 0xd4, 0x4f, 0x62, 0x93, 0x30, 0xc4, 0x77, 0xdc, 0xf5, 0x32, 0x27, 0x6a, 0xc4,
+	0x4b, 0x3b, 0x83, 0x7c, 0x27, 0x2f, 0x96, 0xe8, 0x79, 0x40, 0x1e, 0xd9, 0xc0, 0x49, 0xc8, 0x5d,
+	0x51, 0x23, 0x9d, 0x5d, 0x75, 0x65, 0x1d, 0xd0, 0x9c, 0x3c, 0x66, 0x3a, 0x71, 0x73, 0x30, 0xe6,
+	0x63, 0xe6, 0x62, 0xef, 0xc3, 0x84, 0xf1, 0xa8, 0x72, 0x9a, 0x7b, 0x9d, 0x51, 0x1f, 0xb3, 0x0b,
+	0x55, 0xa2, 0xf9, 0x63, 0x0a, 0x26, 0x9a, 0xa0, 0xe8, 0x73, 0xf6, 0xb9, 0x01, 0x19, 0xec, 0x79,
+	0x81, 0x28, 0x35, 0x0e, 0xeb, 0x27, 0xb7, 0x0e, 0xdb, 0x73, 0x65, 0xbf, 0xdb, 0xf3, 0x9b, 0x47,
+	0xb7, 0xe6, 0xd3, 0x21, 0xf1, 0x71, 0xa1, 0xec, 0x8a, 0x11, 0x9a, 0xfd, 0xf4, 0xe8, 0xd6, 0xbc,
+	0xe1, 0x8c, 0xd5, 0x3c, 0xcb, 0x21, 0xf0, 0x63, 0x03, 0x40, 0x9e, 0x10, 0x85, 0xa3, 0xf7, 0xff,
+	0xc2, 0x31, 0x2c, 0x9d, 0x4a, 0x08, 0x27, 0x60, 0x94, 0x30, 0x1e, 0x44, 0x72, 0x03, 0xf8, 0x98,
+	0xc9, 0x8c, 0xf6, 0x39, 0xe9, 0x2a, 0xf1, 0x12, 0x66, 0xe8, 0x5d, 0x38, 0x88, 0x19, 0x23, 0x8c,
+	0x11, 0xcf, 0xad, 0xce, 0xbb, 0x7d, 0xb3, 0xa9, 0x0e, 0x97, 0xca, 0x05, 0x2d, 0xaf, 0xe6, 0xde,
+	0xca, 0xbc, 0x84, 0x1b, 0xa8, 0xec, 0xfc, 0x6f, 0xc3, 0xd0, 0x2f, 0x3b, 0x22, 0xfa, 0xd4, 0x80,
+	0x01, 0x35, 0xe0, 0xa3, 0x33, 0x6d, 0x4c, 0x36, 0xbf, 0x28, 0x72, 0xf3, 0xdd, 0x88, 0xaa, 0xca,
+	0x9b, 0x73, 0x9f, 0xdc, 0xfb, 0xf7, 0xcb, 0xde, 0x19, 0x74, 0xcc, 0x6e, 0xfd, 0x1a, 0x52, 0x0f,
+	0x0a, 0xf4, 0x95, 0x01, 0x99, 0x3d, 0xe3, 0x3e, 0x5a, 0xe8, 0xe4, 0xa6, 0xe9, 0xe5, 0x91, 0xb3,
+	0xba, 0x15, 0xd7, 0xc8, 0x4c, 0x89, 0x6c, 0x0a, 0xe5, 0xda, 0x20, 0xc3, 0x61, 0x88, 0xbe, 0x33,
+	0x20, 0x5d, 0x3f, 0xc6, 0x23, 0xbb, 0x93, 0x93, 0x16, 0x8f, 0x81, 0xdc, 0xb9, 0xee, 0x15, 0x34,
+	0xae, 0x05, 0x89, 0xeb, 0x14, 0x9a, 0x6b, 0x83, 0xab, 0xf1, 0xf9, 0x80, 0x1e, 0x18, 0x90, 0xd9,
+	0x33, 0x76, 0xa3, 0xf3, 0x9d, 0x9c, 0xb6, 0x7e, 0x25, 0xe4, 0x96, 0xf6, 0xa5, 0xa3, 0xb1, 0x7a,
+	0x12, 0xeb, 0xfb, 0xd7, 0x96, 0xd0, 0x62, 0x1b, 0xb4, 0x7b, 0xc7, 0x7e, 0xfb, 0x86, 0x5e, 0xdd,
+	0x44, 0xa7, 0xba, 0x54, 0x41, 0xf7, 0x0c, 0x38, 0xd4, 0x62, 0xa8, 0x45, 0x2f, 0x76, 0x0f, 0xb9,
+	0x7e, 0x84, 0xcf, 0xbd, 0xb4, 0x6f, 0x3d, 0x1d, 0xee, 0x9b, 0x32, 0xdc, 0xd7, 0xd0, 0xf2, 0xbe,
+	0x83, 0xb5, 0x13, 0x61, 0xc8, 0xbe, 0x51, 0x7d, 0x20, 0xdc, 0x44, 0x5f, 0x1b, 0x00, 0xb5, 0x89,
+	0xa2, 0xf3, 0x6e, 0x6f, 0x9a, 0xc9, 0x72, 0x56, 0xb7, 0xe2, 0x1a, 0xfa, 0xbc, 0x84, 0xfe, 0x1c,
+	0x32, 0x3b, 0x40, 0xd7, 0x63, 0x10, 0xfa, 0xc1, 0x80, 0xcc, 0x9e, 0x4e, 0xde, 0x16, 0x5e, 0xeb,
+	0xcb, 0x27, 0x67, 0x75, 0x2b, 0xae, 0xe1, 0xbd, 0x20, 0xe1, 0x59, 0xaf, 0x18, 0xf3, 0xe6, 0x99,
+	0x7a, 0x84, 0x7c, 0x47, 0x80, 0x2b, 0x54, 0xb4, 0x64, 0x1f, 0x14, 0x1d, 0x5a, 0x1e, 0x81, 0x7c,
+	0x70, 0x67, 0x77, 0xda, 0xb8, 0xbb, 0x3b, 0x6d, 0x3c, 0xd8, 0x9d, 0x36, 0xbe, 0x78, 0x38, 0xdd,
+	0x73, 0xf7, 0xe1, 0x74, 0xcf, 0x5f, 0x0f, 0xa7, 0x7b, 0x20, 0x1b, 0xd0, 0xd6, 0x08, 0x56, 0x8d,
+	0x6b, 0x4b, 0x75, 0x7d, 0xbc, 0x26, 0xb3, 0x10, 0xd0, 0x7a, 0xc7, 0x3b, 0xd5, 0xe4, 0xc8, 0xc6,
+	0xbe, 0x3e, 0x20, 0x7f, 0x62, 0x59, 0xfa, 0x6f, 0x00, 0xf6, 0x7e, 0x4f, 0x9d, 0x71, 0x12, 0x00,
+	0x00,
 }
 
-func (m *CalculateTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters for x/msgfees
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Query all Msgs which have fees associated with them.
+	QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error)
+	// UsdFeeDenoms queries the denoms that can be used to pay msg fees that are priced in usd, and their current rates.
+	UsdFeeDenoms(ctx context.Context, in *QueryUsdFeeDenomsRequest, opts ...grpc.CallOption) (*QueryUsdFeeDenomsResponse, error)
+	// FeeSponsorships queries the fee sponsorships and their remaining budgets, optionally limited to a single sponsor.
+	FeeSponsorships(ctx context.Context, in *QueryFeeSponsorshipsRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipsResponse, error)
+	// FeeSponsorshipUsage queries how much of a fee sponsorship's daily cap a fee payer has used today.
+	FeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error)
+	// FeeRevenue queries the daily totals of the msg fees sent to the fee collector and other recipients.
+	FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error)
+	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
+	CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllMsgFees(ctx context.Context, in *QueryAllMsgFeesRequest, opts ...grpc.CallOption) (*QueryAllMsgFeesResponse, error) {
+	out := new(QueryAllMsgFeesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/QueryAllMsgFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UsdFeeDenoms(ctx context.Context, in *QueryUsdFeeDenomsRequest, opts ...grpc.CallOption) (*QueryUsdFeeDenomsResponse, error) {
+	out := new(QueryUsdFeeDenomsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/UsdFeeDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsorships(ctx context.Context, in *QueryFeeSponsorshipsRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipsResponse, error) {
+	out := new(QueryFeeSponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeSponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSponsorshipUsage(ctx context.Context, in *QueryFeeSponsorshipUsageRequest, opts ...grpc.CallOption) (*QueryFeeSponsorshipUsageResponse, error) {
+	out := new(QueryFeeSponsorshipUsageResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeSponsorshipUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error) {
+	out := new(QueryFeeRevenueResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/FeeRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CalculateTxFees(ctx context.Context, in *CalculateTxFeesRequest, opts ...grpc.CallOption) (*CalculateTxFeesResponse, error) {
+	out := new(CalculateTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Query/CalculateTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters for x/msgfees
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Query all Msgs which have fees associated with them.
+	QueryAllMsgFees(context.Context, *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error)
+	// UsdFeeDenoms queries the denoms that can be used to pay msg fees that are priced in usd, and their current rates.
+	UsdFeeDenoms(context.Context, *QueryUsdFeeDenomsRequest) (*QueryUsdFeeDenomsResponse, error)
+	// FeeSponsorships queries the fee sponsorships and their remaining budgets, optionally limited to a single sponsor.
+	FeeSponsorships(context.Context, *QueryFeeSponsorshipsRequest) (*QueryFeeSponsorshipsResponse, error)
+	// FeeSponsorshipUsage queries how much of a fee sponsorship's daily cap a fee payer has used today.
+	FeeSponsorshipUsage(context.Context, *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error)
+	// FeeRevenue queries the daily totals of the msg fees sent to the fee collector and other recipients.
+	FeeRevenue(context.Context, *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error)
+	// CalculateTxFees simulates executing a transaction for estimating gas usage and additional fees.
+	CalculateTxFees(context.Context, *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllMsgFees(ctx context.Context, req *QueryAllMsgFeesRequest) (*QueryAllMsgFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllMsgFees not implemented")
+}
+func (*UnimplementedQueryServer) UsdFeeDenoms(ctx context.Context, req *QueryUsdFeeDenomsRequest) (*QueryUsdFeeDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UsdFeeDenoms not implemented")
+}
+func (*UnimplementedQueryServer) FeeSponsorships(ctx context.Context, req *QueryFeeSponsorshipsRequest) (*QueryFeeSponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorships not implemented")
+}
+func (*UnimplementedQueryServer) FeeSponsorshipUsage(ctx context.Context, req *QueryFeeSponsorshipUsageRequest) (*QueryFeeSponsorshipUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSponsorshipUsage not implemented")
+}
+func (*UnimplementedQueryServer) FeeRevenue(ctx context.Context, req *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRevenue not implemented")
+}
+func (*UnimplementedQueryServer) CalculateTxFees(ctx context.Context, req *CalculateTxFeesRequest) (*CalculateTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculateTxFees not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllMsgFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllMsgFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllMsgFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/QueryAllMsgFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllMsgFees(ctx, req.(*QueryAllMsgFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UsdFeeDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUsdFeeDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UsdFeeDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/UsdFeeDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UsdFeeDenoms(ctx, req.(*QueryUsdFeeDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeSponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorships(ctx, req.(*QueryFeeSponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSponsorshipUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSponsorshipUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSponsorshipUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeSponsorshipUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSponsorshipUsage(ctx, req.(*QueryFeeSponsorshipUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/FeeRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRevenue(ctx, req.(*QueryFeeRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CalculateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculateTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CalculateTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Query/CalculateTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CalculateTxFees(ctx, req.(*CalculateTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.msgfees.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryAllMsgFees",
+			Handler:    _Query_QueryAllMsgFees_Handler,
+		},
+		{
+			MethodName: "UsdFeeDenoms",
+			Handler:    _Query_UsdFeeDenoms_Handler,
+		},
+		{
+			MethodName: "FeeSponsorships",
+			Handler:    _Query_FeeSponsorships_Handler,
+		},
+		{
+			MethodName: "FeeSponsorshipUsage",
+			Handler:    _Query_FeeSponsorshipUsage_Handler,
+		},
+		{
+			MethodName: "FeeRevenue",
+			Handler:    _Query_FeeRevenue_Handler,
+		},
+		{
+			MethodName: "CalculateTxFees",
+			Handler:    _Query_CalculateTxFees_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/msgfees/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMsgFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMsgFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMsgFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllMsgFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllMsgFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllMsgFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MsgFees) > 0 {
+		for iNdEx := len(m.MsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdFeeDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdFeeDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdFeeDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUsdFeeDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUsdFeeDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUsdFeeDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UsdFeeDenoms) > 0 {
+		for iNdEx := len(m.UsdFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsdFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeSponsorships) > 0 {
+		for iNdEx := len(m.FeeSponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeSponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingToday) > 0 {
+		for iNdEx := len(m.RemainingToday) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingToday[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SpentToday) > 0 {
+		for iNdEx := len(m.SpentToday) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpentToday[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.FeeSponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSponsorshipBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSponsorshipBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSponsorshipBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Remaining) > 0 {
+		for iNdEx := len(m.Remaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Remaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.FeeSponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndDay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartDay != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartDay))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RecipientsTotal) > 0 {
+		for iNdEx := len(m.RecipientsTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientsTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeCollectorTotal) > 0 {
+		for iNdEx := len(m.FeeCollectorTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectorTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeRevenues) > 0 {
+		for iNdEx := len(m.FeeRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UsdFeeDenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsdFeeDenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsdFeeDenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Stale {
+		i--
+		if m.Stale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UpdatedBlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.UsdMils.Size()
+		i -= size
+		if _, err := m.UsdMils.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UsdFeeDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasAdjustment != 0 {
+		i -= 4
+		encoding_binary.LittleEndian.PutUint32(dAtA[i:], uint32(math.Float32bits(float32(m.GasAdjustment))))
+		i--
+		dAtA[i] = 0x1d
+	}
+	if len(m.DefaultBaseDenom) > 0 {
+		i -= len(m.DefaultBaseDenom)
+		copy(dAtA[i:], m.DefaultBaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DefaultBaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxBytes) > 0 {
+		i -= len(m.TxBytes)
+		copy(dAtA[i:], m.TxBytes)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxBytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CalculateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CalculateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CalculateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssessedMsgFees) > 0 {
+		for iNdEx := len(m.AssessedMsgFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AssessedMsgFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EstimatedGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AdditionalFees) > 0 {
+		for iNdEx := len(m.AdditionalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllMsgFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllMsgFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgFees) > 0 {
+		for _, e := range m.MsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUsdFeeDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUsdFeeDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UsdFeeDenoms) > 0 {
+		for _, e := range m.UsdFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeSponsorships) > 0 {
+		for _, e := range m.FeeSponsorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovQuery(uint64(m.MarketId))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeSponsorshipUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SpentToday) > 0 {
+		for _, e := range m.SpentToday {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RemainingToday) > 0 {
+		for _, e := range m.RemainingToday {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeSponsorshipBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeSponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Remaining) > 0 {
+		for _, e := range m.Remaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFeeRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartDay != 0 {
+		n += 1 + sovQuery(uint64(m.StartDay))
+	}
+	if m.EndDay != 0 {
+		n += 1 + sovQuery(uint64(m.EndDay))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeRevenues) > 0 {
+		for _, e := range m.FeeRevenues {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.FeeCollectorTotal) > 0 {
+		for _, e := range m.FeeCollectorTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.RecipientsTotal) > 0 {
+		for _, e := range m.RecipientsTotal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UsdFeeDenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UsdFeeDenom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UsdMils.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.UpdatedBlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedBlockHeight))
+	}
+	if m.Stale {
+		n += 2
+	}
+	return n
+}
+
+func (m *CalculateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxBytes)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DefaultBaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.GasAdjustment != 0 {
+		n += 5
+	}
+	return n
+}
+
+func (m *CalculateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if len(m.AdditionalFees) > 0 {
@@ -899,25 +2478,1207 @@ func (m *CalculateTxFeesResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if len(m.TotalFees) > 0 {
-		for _, e := range m.TotalFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.EstimatedGas != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedGas))
+	}
+	if len(m.AssessedMsgFees) > 0 {
+		for _, e := range m.AssessedMsgFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFees = append(m.MsgFees, &MsgFee{})
+			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdFeeDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdFeeDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdFeeDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUsdFeeDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUsdFeeDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUsdFeeDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsdFeeDenoms = append(m.UsdFeeDenoms, UsdFeeDenomRate{})
+			if err := m.UsdFeeDenoms[len(m.UsdFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSponsorshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSponsorshipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeSponsorships = append(m.FeeSponsorships, FeeSponsorshipBudget{})
+			if err := m.FeeSponsorships[len(m.FeeSponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSponsorshipUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSponsorshipUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSponsorshipUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentToday", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpentToday = append(m.SpentToday, types.Coin{})
+			if err := m.SpentToday[len(m.SpentToday)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingToday", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingToday = append(m.RemainingToday, types.Coin{})
+			if err := m.RemainingToday[len(m.RemainingToday)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.EstimatedGas != 0 {
-		n += 1 + sovQuery(uint64(m.EstimatedGas))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *FeeSponsorshipBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSponsorshipBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSponsorshipBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSponsorship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Remaining = append(m.Remaining, types.Coin{})
+			if err := m.Remaining[len(m.Remaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRevenueRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -927,25 +3688,163 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDay", wireType)
+			}
+			m.StartDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDay", wireType)
+			}
+			m.EndDay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndDay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -967,7 +3866,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeRevenueResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -990,15 +3889,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRevenues", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1025,61 +3924,80 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FeeRevenues = append(m.FeeRevenues, FeeRevenue{})
+			if err := m.FeeRevenues[len(m.FeeRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorTotal", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FeeCollectorTotal = append(m.FeeCollectorTotal, types.Coin{})
+			if err := m.FeeCollectorTotal[len(m.FeeCollectorTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientsTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecipientsTotal = append(m.RecipientsTotal, types.Coin{})
+			if err := m.RecipientsTotal[len(m.RecipientsTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1109,7 +4027,7 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -1136,7 +4054,7 @@ func (m *QueryAllMsgFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *UsdFeeDenomRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,15 +4077,15 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UsdFeeDenomRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllMsgFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UsdFeeDenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdFeeDenom", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1194,16 +4112,15 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgFees = append(m.MsgFees, &MsgFee{})
-			if err := m.MsgFees[len(m.MsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UsdFeeDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsdMils", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1213,28 +4130,99 @@ func (m *QueryAllMsgFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+			if err := m.UsdMils.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedBlockHeight", wireType)
+			}
+			m.UpdatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stale", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stale = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1499,6 +4487,40 @@ func (m *CalculateTxFeesResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssessedMsgFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssessedMsgFees = append(m.AssessedMsgFees, AssessedMsgFee{})
+			if err := m.AssessedMsgFees[len(m.AssessedMsgFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
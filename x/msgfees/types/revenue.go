@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeRevenue creates a new FeeRevenue.
+func NewFeeRevenue(day int64, recipient, msgTypeURL string, total sdk.Coins, count uint64) FeeRevenue {
+	return FeeRevenue{
+		Day:        day,
+		Recipient:  recipient,
+		MsgTypeUrl: msgTypeURL,
+		Total:      total,
+		Count:      count,
+	}
+}
+
+// Validate returns an error if this fee revenue entry is invalid.
+func (r FeeRevenue) Validate() error {
+	if r.Day < 0 {
+		return fmt.Errorf("invalid day %d: cannot be negative", r.Day)
+	}
+	if _, err := GetFeeRevenueRecipientAddr(r.Recipient); err != nil {
+		return err
+	}
+	if len(r.MsgTypeUrl) == 0 {
+		return ErrEmptyMsgType
+	}
+	if r.Total.IsZero() {
+		return errors.New("total cannot be empty")
+	}
+	if err := r.Total.Validate(); err != nil {
+		return fmt.Errorf("invalid total %q: %w", r.Total, err)
+	}
+	return nil
+}
+
+// IsForFeeCollector returns true if this fee revenue entry is for the fees sent to the fee collector.
+func (r FeeRevenue) IsForFeeCollector() bool {
+	return len(r.Recipient) == 0
+}
+
+// Matches returns true if this fee revenue entry is for the provided recipient and msg type.
+// An empty recipient or msg type matches all of them.
+func (r FeeRevenue) Matches(recipient, msgTypeURL string) bool {
+	return (len(recipient) == 0 || r.Recipient == recipient) && (len(msgTypeURL) == 0 || r.MsgTypeUrl == msgTypeURL)
+}
+
+// GetFeeRevenueRecipientAddr converts a fee revenue recipient into the address used in its key.
+// The fee collector (an empty recipient) has an empty address.
+func GetFeeRevenueRecipientAddr(recipient string) (sdk.AccAddress, error) {
+	if len(recipient) == 0 {
+		return nil, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recipient %q: %w", recipient, err)
+	}
+	return addr, nil
+}
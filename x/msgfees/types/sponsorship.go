@@ -0,0 +1,230 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+)
+
+const (
+	// maxSponsoredMsgTypeLength is the longest msg type url that can be sponsored (it's length-prefixed in store keys).
+	maxSponsoredMsgTypeLength = 255
+
+	// FeeSponsorshipActionSet is the action of an EventFeeSponsorshipUpdated when a sponsorship is set.
+	FeeSponsorshipActionSet = "set"
+	// FeeSponsorshipActionRemoved is the action of an EventFeeSponsorshipUpdated when a sponsorship is removed.
+	FeeSponsorshipActionRemoved = "removed"
+)
+
+// NewFeeSponsorship creates a new FeeSponsorship that hasn't spent anything yet.
+func NewFeeSponsorship(sponsor, msgTypeURL string, marketID uint32, markerDenom string, budget, dailyUserCap sdk.Coins) FeeSponsorship {
+	return FeeSponsorship{
+		Sponsor:      sponsor,
+		MsgTypeUrl:   msgTypeURL,
+		MarketId:     marketID,
+		MarkerDenom:  markerDenom,
+		Budget:       budget,
+		DailyUserCap: dailyUserCap,
+	}
+}
+
+// ValidateFeeSponsorshipScope returns an error if the msg type, market id and marker denom can't identify a fee sponsorship.
+func ValidateFeeSponsorshipScope(msgTypeURL string, marketID uint32, markerDenom string) error {
+	if len(msgTypeURL) == 0 {
+		return ErrEmptyMsgType
+	}
+	if len(msgTypeURL) > maxSponsoredMsgTypeLength {
+		return fmt.Errorf("msg type %q is longer than %d characters", msgTypeURL, maxSponsoredMsgTypeLength)
+	}
+	if marketID != 0 && len(markerDenom) > 0 {
+		return errors.New("a fee sponsorship cannot have both a market id and a marker denom")
+	}
+	if len(markerDenom) > 0 {
+		if err := sdk.ValidateDenom(markerDenom); err != nil {
+			return fmt.Errorf("invalid marker denom: %w", err)
+		}
+	}
+	return nil
+}
+
+// ValidateFeeSponsorshipLimits returns an error if the budget or daily user cap of a fee sponsorship is invalid.
+func ValidateFeeSponsorshipLimits(budget, dailyUserCap sdk.Coins) error {
+	if budget.IsZero() {
+		return errors.New("budget cannot be empty")
+	}
+	if err := budget.Validate(); err != nil {
+		return fmt.Errorf("invalid budget %q: %w", budget, err)
+	}
+	if err := dailyUserCap.Validate(); err != nil {
+		return fmt.Errorf("invalid daily user cap %q: %w", dailyUserCap, err)
+	}
+	return nil
+}
+
+// Validate returns an error if this fee sponsorship is invalid.
+func (s FeeSponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(s.Sponsor); err != nil {
+		return fmt.Errorf("invalid sponsor %q: %w", s.Sponsor, err)
+	}
+	if err := ValidateFeeSponsorshipScope(s.MsgTypeUrl, s.MarketId, s.MarkerDenom); err != nil {
+		return err
+	}
+	if err := ValidateFeeSponsorshipLimits(s.Budget, s.DailyUserCap); err != nil {
+		return err
+	}
+	if err := s.Spent.Validate(); err != nil {
+		return fmt.Errorf("invalid spent %q: %w", s.Spent, err)
+	}
+	return nil
+}
+
+// GetSponsorAddr returns the sponsor as an AccAddress. It panics if the sponsor is invalid.
+func (s FeeSponsorship) GetSponsorAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(s.Sponsor)
+}
+
+// Remaining returns the part of the budget that hasn't been spent yet.
+func (s FeeSponsorship) Remaining() sdk.Coins {
+	return subFloorZero(s.Budget, s.Spent)
+}
+
+// Covers returns true if this sponsorship pays the fees for the provided msg.
+func (s FeeSponsorship) Covers(msg sdk.Msg) bool {
+	if sdk.MsgTypeURL(msg) != s.MsgTypeUrl {
+		return false
+	}
+	if s.MarketId != 0 {
+		marketID, ok := GetMsgMarketID(msg)
+		return ok && marketID == s.MarketId
+	}
+	if len(s.MarkerDenom) > 0 {
+		return MsgInvolvesDenom(msg, s.MarkerDenom)
+	}
+	return true
+}
+
+// CoversAll returns true if this sponsorship pays the fees for all of the provided msgs.
+func (s FeeSponsorship) CoversAll(msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+	for _, msg := range msgs {
+		if !s.Covers(msg) {
+			return false
+		}
+	}
+	return true
+}
+
+// NewFeeSponsorshipBudget creates a new FeeSponsorshipBudget for the provided sponsorship.
+func NewFeeSponsorshipBudget(sponsorship FeeSponsorship) FeeSponsorshipBudget {
+	return FeeSponsorshipBudget{
+		FeeSponsorship: sponsorship,
+		Remaining:      sponsorship.Remaining(),
+	}
+}
+
+// GetBlockDay returns the day (since the unix epoch, in UTC) of a block time.
+// Block times before the epoch are in day zero.
+func GetBlockDay(blockTime time.Time) int64 {
+	day := blockTime.UTC().Unix() / (24 * 60 * 60)
+	if day < 0 {
+		return 0
+	}
+	return day
+}
+
+// SpentOn returns the amount spent on the provided day. It's zero if this spend was recorded on a different day.
+func (s FeeSponsorshipUserSpend) SpentOn(day int64) sdk.Coins {
+	if s.Day != day {
+		return sdk.Coins{}
+	}
+	return s.Spent
+}
+
+// RemainingToday returns how much more a daily user cap allows to be spent given the amount already spent today.
+// It's empty if there isn't a daily user cap.
+func RemainingToday(dailyUserCap, spentToday sdk.Coins) sdk.Coins {
+	if dailyUserCap.IsZero() {
+		return sdk.Coins{}
+	}
+	return subFloorZero(dailyUserCap, spentToday)
+}
+
+// GetMsgMarketID returns the exchange market id that a msg is for.
+// The second return value is false if the msg isn't for a specific market.
+func GetMsgMarketID(msg sdk.Msg) (uint32, bool) {
+	switch m := msg.(type) {
+	case *exchange.MsgCreateAskRequest:
+		return m.AskOrder.MarketId, true
+	case *exchange.MsgCreateBidRequest:
+		return m.BidOrder.MarketId, true
+	case *exchange.MsgCreateConditionalOrderRequest:
+		switch {
+		case m.AskOrder != nil:
+			return m.AskOrder.MarketId, true
+		case m.BidOrder != nil:
+			return m.BidOrder.MarketId, true
+		}
+		return 0, false
+	case interface{ GetMarketId() uint32 }:
+		return m.GetMarketId(), true
+	}
+	return 0, false
+}
+
+// MsgInvolvesDenom returns true if a msg is for the provided denom or moves some of it.
+func MsgInvolvesDenom(msg sdk.Msg, denom string) bool {
+	if m, ok := msg.(interface{ GetDenom() string }); ok && m.GetDenom() == denom {
+		return true
+	}
+	return GetMsgAmount(msg, denom).IsPositive()
+}
+
+// NewEventFeeSponsored creates a new EventFeeSponsored.
+func NewEventFeeSponsored(sponsorship FeeSponsorship, feePayer string, amount sdk.Coins) *EventFeeSponsored {
+	return &EventFeeSponsored{
+		Sponsor:     sponsorship.Sponsor,
+		FeePayer:    feePayer,
+		MsgType:     sponsorship.MsgTypeUrl,
+		MarketId:    marketIDString(sponsorship.MarketId),
+		MarkerDenom: sponsorship.MarkerDenom,
+		Amount:      amount.String(),
+	}
+}
+
+// NewEventFeeSponsorshipUpdated creates a new EventFeeSponsorshipUpdated.
+func NewEventFeeSponsorshipUpdated(sponsorship FeeSponsorship, action string) *EventFeeSponsorshipUpdated {
+	return &EventFeeSponsorshipUpdated{
+		Sponsor:     sponsorship.Sponsor,
+		MsgType:     sponsorship.MsgTypeUrl,
+		MarketId:    marketIDString(sponsorship.MarketId),
+		MarkerDenom: sponsorship.MarkerDenom,
+		Action:      action,
+	}
+}
+
+// marketIDString returns the market id as a string for an event, or an empty string if there isn't one.
+func marketIDString(marketID uint32) string {
+	if marketID == 0 {
+		return ""
+	}
+	return strconv.FormatUint(uint64(marketID), 10)
+}
+
+// subFloorZero subtracts b from a, leaving out any denoms that would be zero or negative.
+func subFloorZero(a, b sdk.Coins) sdk.Coins {
+	rv := sdk.Coins{}
+	for _, coin := range a {
+		amount := coin.Amount.Sub(b.AmountOf(coin.Denom))
+		if amount.IsPositive() {
+			rv = rv.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return rv
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/provenance-io/provenance/x/exchange"
+	markertypes "github.com/provenance-io/provenance/x/marker/types"
+)
+
+func TestFeeSponsorshipCovers(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	budget := sdk.NewCoins(sdk.NewInt64Coin("nhash", 100))
+	bidType := sdk.MsgTypeURL(&exchange.MsgCreateBidRequest{})
+	sendType := sdk.MsgTypeURL(&banktypes.MsgSend{})
+
+	bid := func(marketID uint32) sdk.Msg {
+		return &exchange.MsgCreateBidRequest{BidOrder: exchange.BidOrder{MarketId: marketID}}
+	}
+	send := func(coins string) sdk.Msg {
+		amount, err := sdk.ParseCoinsNormalized(coins)
+		require.NoError(t, err, "ParseCoinsNormalized(%q)", coins)
+		return &banktypes.MsgSend{Amount: amount}
+	}
+
+	tests := []struct {
+		name        string
+		sponsorship FeeSponsorship
+		msg         sdk.Msg
+		exp         bool
+	}{
+		{
+			name:        "unscoped, same type",
+			sponsorship: NewFeeSponsorship(addr, sendType, 0, "", budget, nil),
+			msg:         send("5nhash"),
+			exp:         true,
+		},
+		{
+			name:        "unscoped, other type",
+			sponsorship: NewFeeSponsorship(addr, sendType, 0, "", budget, nil),
+			msg:         bid(3),
+			exp:         false,
+		},
+		{
+			name:        "market, same market",
+			sponsorship: NewFeeSponsorship(addr, bidType, 3, "", budget, nil),
+			msg:         bid(3),
+			exp:         true,
+		},
+		{
+			name:        "market, other market",
+			sponsorship: NewFeeSponsorship(addr, bidType, 3, "", budget, nil),
+			msg:         bid(4),
+			exp:         false,
+		},
+		{
+			name:        "market, conditional order in market",
+			sponsorship: NewFeeSponsorship(addr, sdk.MsgTypeURL(&exchange.MsgCreateConditionalOrderRequest{}), 3, "", budget, nil),
+			msg:         &exchange.MsgCreateConditionalOrderRequest{AskOrder: &exchange.AskOrder{MarketId: 3}},
+			exp:         true,
+		},
+		{
+			name:        "market, msg without market",
+			sponsorship: NewFeeSponsorship(addr, sendType, 3, "", budget, nil),
+			msg:         send("5nhash"),
+			exp:         false,
+		},
+		{
+			name:        "marker, msg moves denom",
+			sponsorship: NewFeeSponsorship(addr, sendType, 0, "mycoin", budget, nil),
+			msg:         send("5mycoin,5nhash"),
+			exp:         true,
+		},
+		{
+			name:        "marker, msg moves other denom",
+			sponsorship: NewFeeSponsorship(addr, sendType, 0, "mycoin", budget, nil),
+			msg:         send("5nhash"),
+			exp:         false,
+		},
+		{
+			name:        "marker, msg for denom",
+			sponsorship: NewFeeSponsorship(addr, sdk.MsgTypeURL(&markertypes.MsgWithdrawRequest{}), 0, "mycoin", budget, nil),
+			msg:         &markertypes.MsgWithdrawRequest{Denom: "mycoin"},
+			exp:         true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := tc.sponsorship.Covers(tc.msg)
+			assert.Equal(t, tc.exp, actual, "Covers")
+		})
+	}
+
+	t.Run("CoversAll", func(t *testing.T) {
+		sponsorship := NewFeeSponsorship(addr, bidType, 3, "", budget, nil)
+		assert.True(t, sponsorship.CoversAll([]sdk.Msg{bid(3), bid(3)}), "two msgs in the market")
+		assert.False(t, sponsorship.CoversAll([]sdk.Msg{bid(3), bid(4)}), "one msg in another market")
+		assert.False(t, sponsorship.CoversAll(nil), "no msgs")
+	})
+}
+
+func TestFeeSponsorshipRemaining(t *testing.T) {
+	sponsorship := NewFeeSponsorship("", "", 0, "", sdk.NewCoins(sdk.NewInt64Coin("nhash", 100), sdk.NewInt64Coin("usdf", 10)), nil)
+	assert.Equal(t, "100nhash,10usdf", sponsorship.Remaining().String(), "nothing spent")
+
+	sponsorship.Spent = sdk.NewCoins(sdk.NewInt64Coin("nhash", 40), sdk.NewInt64Coin("usdf", 10))
+	assert.Equal(t, "60nhash", sponsorship.Remaining().String(), "some spent")
+
+	sponsorship.Spent = sdk.NewCoins(sdk.NewInt64Coin("nhash", 140))
+	assert.Equal(t, "10usdf", sponsorship.Remaining().String(), "more spent than a lowered budget")
+}
+
+func TestFeeSponsorshipUserSpendSpentOn(t *testing.T) {
+	blockTime := time.Date(2024, 3, 5, 23, 59, 59, 0, time.UTC)
+	day := GetBlockDay(blockTime)
+	assert.Equal(t, day+1, GetBlockDay(blockTime.Add(time.Second)), "day after midnight")
+
+	spend := FeeSponsorshipUserSpend{Day: day, Spent: sdk.NewCoins(sdk.NewInt64Coin("nhash", 7))}
+	assert.Equal(t, "7nhash", spend.SpentOn(day).String(), "same day")
+	assert.Equal(t, "", spend.SpentOn(day+1).String(), "next day")
+
+	userCap := sdk.NewCoins(sdk.NewInt64Coin("nhash", 10))
+	assert.Equal(t, "3nhash", RemainingToday(userCap, spend.SpentOn(day)).String(), "remaining with a cap")
+	assert.Equal(t, "", RemainingToday(nil, spend.SpentOn(day)).String(), "remaining without a cap")
+}
+
+func TestGetFeeSponsorshipKey(t *testing.T) {
+	sponsor := sdk.AccAddress("sponsor_____________")
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+
+	none := GetFeeSponsorshipKey(sponsor, msgType, 0, "")
+	market := GetFeeSponsorshipKey(sponsor, msgType, 1, "")
+	marker := GetFeeSponsorshipKey(sponsor, msgType, 0, "a")
+	longerMarker := GetFeeSponsorshipKey(sponsor, msgType, 0, "ab")
+
+	msgTypePrefix := GetFeeSponsorshipMsgTypePrefix(sponsor, msgType)
+	for _, key := range [][]byte{none, market, marker, longerMarker} {
+		assert.Equal(t, msgTypePrefix, key[:len(msgTypePrefix)], "msg type prefix of %v", key)
+	}
+	assert.NotEqual(t, marker, longerMarker[:len(marker)], "a marker denom's key should not be a prefix of a longer denom's key")
+
+	spendKey := GetFeeSponsorshipUserSpendKey(sponsor, msgType, 0, "a", sdk.AccAddress("user________________"))
+	spendPrefix := GetFeeSponsorshipUserSpendPrefix(sponsor, msgType, 0, "a")
+	assert.Equal(t, spendPrefix, spendKey[:len(spendPrefix)], "user spend prefix")
+	assert.Equal(t, FeeSponsorshipUserSpendKeyPrefix[0], spendKey[0], "user spend key type byte")
+	assert.Equal(t, marker[1:], spendPrefix[1:], "user spend prefix after the type byte")
+}
+
+func TestGetFeeRevenueKey(t *testing.T) {
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	recipient := sdk.AccAddress("recipient___________")
+
+	feeCollectorKey := GetFeeRevenueKey(5, nil, msgType)
+	recipientKey := GetFeeRevenueKey(5, recipient, msgType)
+	nextDayKey := GetFeeRevenueKey(6, nil, msgType)
+
+	dayPrefix := GetFeeRevenueDayPrefix(5)
+	assert.Equal(t, dayPrefix, feeCollectorKey[:len(dayPrefix)], "fee collector key day prefix")
+	assert.Equal(t, dayPrefix, recipientKey[:len(dayPrefix)], "recipient key day prefix")
+	assert.Equal(t, byte(0), feeCollectorKey[len(dayPrefix)], "fee collector recipient length")
+	assert.Equal(t, byte(len(recipient)), recipientKey[len(dayPrefix)], "recipient length")
+	assert.Less(t, string(recipientKey), string(nextDayKey), "a day's keys should come before the next day's keys")
+}
+
+func TestFeeRevenueValidate(t *testing.T) {
+	total := sdk.NewCoins(sdk.NewInt64Coin("nhash", 5))
+	msgType := "/cosmos.bank.v1beta1.MsgSend"
+	recipient := sdk.AccAddress("recipient___________").String()
+
+	assert.NoError(t, NewFeeRevenue(5, recipient, msgType, total, 1).Validate(), "recipient")
+	assert.NoError(t, NewFeeRevenue(5, "", msgType, total, 1).Validate(), "fee collector")
+	assert.ErrorContains(t, NewFeeRevenue(-1, "", msgType, total, 1).Validate(), "cannot be negative", "negative day")
+	assert.ErrorContains(t, NewFeeRevenue(5, "bad", msgType, total, 1).Validate(), "invalid recipient", "bad recipient")
+	assert.ErrorIs(t, NewFeeRevenue(5, "", "", total, 1).Validate(), ErrEmptyMsgType, "no msg type")
+	assert.ErrorContains(t, NewFeeRevenue(5, "", msgType, nil, 1).Validate(), "total cannot be empty", "no total")
+
+	assert.True(t, NewFeeRevenue(5, "", msgType, total, 1).Matches("", msgType), "fee collector entry matches msg type")
+	assert.False(t, NewFeeRevenue(5, "", msgType, total, 1).Matches(recipient, ""), "fee collector entry matches a recipient")
+}
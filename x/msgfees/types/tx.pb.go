@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -123,6 +124,8 @@ type MsgAddMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional conditions that change the fee for specific msgs
+	Conditions MsgFeeConditions `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions"`
 }
 
 func (m *MsgAddMsgFeeProposalRequest) Reset()         { *m = MsgAddMsgFeeProposalRequest{} }
@@ -193,6 +196,13 @@ func (m *MsgAddMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgAddMsgFeeProposalRequest) GetConditions() MsgFeeConditions {
+	if m != nil {
+		return m.Conditions
+	}
+	return MsgFeeConditions{}
+}
+
 // MsgAddMsgFeeProposalResponse defines the Msg/AddMsgFeeProposal response type
 type MsgAddMsgFeeProposalResponse struct {
 }
@@ -242,6 +252,8 @@ type MsgUpdateMsgFeeProposalRequest struct {
 	RecipientBasisPoints string `protobuf:"bytes,4,opt,name=recipient_basis_points,json=recipientBasisPoints,proto3" json:"recipient_basis_points,omitempty"`
 	// the signing authority for the proposal
 	Authority string `protobuf:"bytes,5,opt,name=authority,proto3" json:"authority,omitempty"`
+	// optional conditions that change the fee for specific msgs
+	Conditions MsgFeeConditions `protobuf:"bytes,6,opt,name=conditions,proto3" json:"conditions"`
 }
 
 func (m *MsgUpdateMsgFeeProposalRequest) Reset()         { *m = MsgUpdateMsgFeeProposalRequest{} }
@@ -312,6 +324,13 @@ func (m *MsgUpdateMsgFeeProposalRequest) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgUpdateMsgFeeProposalRequest) GetConditions() MsgFeeConditions {
+	if m != nil {
+		return m.Conditions
+	}
+	return MsgFeeConditions{}
+}
+
 // MsgUpdateMsgFeeProposalResponse defines the Msg/RemoveMsgFeeProposal response type
 type MsgUpdateMsgFeeProposalResponse struct {
 }
@@ -637,6 +656,349 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateConversionFeeDenomProposalResponse proto.InternalMessageInfo
 
+// MsgUpdateUsdFeeDenomsProposalRequest defines a governance proposal to update the denoms that can pay usd msg fees
+type MsgUpdateUsdFeeDenomsProposalRequest struct {
+	// to_set are the usd fee denoms to add or replace.
+	ToSet []UsdFeeDenom `protobuf:"bytes,1,rep,name=to_set,json=toSet,proto3" json:"to_set"`
+	// to_remove are the denoms that can no longer be used to pay usd msg fees.
+	ToRemove []string `protobuf:"bytes,2,rep,name=to_remove,json=toRemove,proto3" json:"to_remove,omitempty"`
+	// the signing authority for the proposal
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) Reset()         { *m = MsgUpdateUsdFeeDenomsProposalRequest{} }
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateUsdFeeDenomsProposalRequest) ProtoMessage()    {}
+func (*MsgUpdateUsdFeeDenomsProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{12}
+}
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalRequest.Merge(m, src)
+}
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalRequest proto.InternalMessageInfo
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) GetToSet() []UsdFeeDenom {
+	if m != nil {
+		return m.ToSet
+	}
+	return nil
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) GetToRemove() []string {
+	if m != nil {
+		return m.ToRemove
+	}
+	return nil
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgUpdateUsdFeeDenomsProposalResponse defines the Msg/UpdateUsdFeeDenomsProposal response type
+type MsgUpdateUsdFeeDenomsProposalResponse struct {
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) Reset()         { *m = MsgUpdateUsdFeeDenomsProposalResponse{} }
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateUsdFeeDenomsProposalResponse) ProtoMessage()    {}
+func (*MsgUpdateUsdFeeDenomsProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{13}
+}
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalResponse.Merge(m, src)
+}
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateUsdFeeDenomsProposalResponse proto.InternalMessageInfo
+
+// MsgSetFeeSponsorshipRequest defines a request to create or update a fee sponsorship.
+// With a market_id, the admin must be able to withdraw the market's funds, and the market's account pays the fees.
+// With a marker_denom, the admin must have withdraw access on the marker, and the marker's account pays the fees.
+// Otherwise, the admin's account pays the fees.
+type MsgSetFeeSponsorshipRequest struct {
+	// admin is the bech32 address of the account setting up the sponsorship.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// msg_type_url is the type-url of the msgs being sponsored.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// market_id is the optional exchange market that the msgs must be for.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// marker_denom is the optional marker denom that the msgs must involve.
+	MarkerDenom string `protobuf:"bytes,4,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+	// budget is the most that the sponsor will pay in total, including anything already spent under this sponsorship.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// daily_user_cap is the most that the sponsor will pay for a single fee payer each day. Empty means there's no cap.
+	DailyUserCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=daily_user_cap,json=dailyUserCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"daily_user_cap"`
+}
+
+func (m *MsgSetFeeSponsorshipRequest) Reset()         { *m = MsgSetFeeSponsorshipRequest{} }
+func (m *MsgSetFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorshipRequest) ProtoMessage()    {}
+func (*MsgSetFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{14}
+}
+func (m *MsgSetFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorshipRequest.Merge(m, src)
+}
+func (m *MsgSetFeeSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSponsorshipRequest proto.InternalMessageInfo
+
+func (m *MsgSetFeeSponsorshipRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetFeeSponsorshipRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgSetFeeSponsorshipRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgSetFeeSponsorshipRequest) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
+	}
+	return ""
+}
+
+func (m *MsgSetFeeSponsorshipRequest) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
+}
+
+func (m *MsgSetFeeSponsorshipRequest) GetDailyUserCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DailyUserCap
+	}
+	return nil
+}
+
+// MsgSetFeeSponsorshipResponse defines the Msg/SetFeeSponsorship response type
+type MsgSetFeeSponsorshipResponse struct {
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Reset()         { *m = MsgSetFeeSponsorshipResponse{} }
+func (m *MsgSetFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{15}
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFeeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFeeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFeeSponsorshipResponse proto.InternalMessageInfo
+
+// MsgRemoveFeeSponsorshipRequest defines a request to remove a fee sponsorship.
+// The admin must have the same permission needed to set the sponsorship.
+type MsgRemoveFeeSponsorshipRequest struct {
+	// admin is the bech32 address of the account removing the sponsorship.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	// msg_type_url is the type-url of the sponsored msgs.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// market_id is the exchange market of the sponsorship, if it has one.
+	MarketId uint32 `protobuf:"varint,3,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// marker_denom is the marker denom of the sponsorship, if it has one.
+	MarkerDenom string `protobuf:"bytes,4,opt,name=marker_denom,json=markerDenom,proto3" json:"marker_denom,omitempty"`
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) Reset()         { *m = MsgRemoveFeeSponsorshipRequest{} }
+func (m *MsgRemoveFeeSponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeSponsorshipRequest) ProtoMessage()    {}
+func (*MsgRemoveFeeSponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{16}
+}
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.Merge(m, src)
+}
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeSponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeSponsorshipRequest proto.InternalMessageInfo
+
+func (m *MsgRemoveFeeSponsorshipRequest) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) GetMarkerDenom() string {
+	if m != nil {
+		return m.MarkerDenom
+	}
+	return ""
+}
+
+// MsgRemoveFeeSponsorshipResponse defines the Msg/RemoveFeeSponsorship response type
+type MsgRemoveFeeSponsorshipResponse struct {
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) Reset()         { *m = MsgRemoveFeeSponsorshipResponse{} }
+func (m *MsgRemoveFeeSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveFeeSponsorshipResponse) ProtoMessage()    {}
+func (*MsgRemoveFeeSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c6bb65eaf858b5f, []int{17}
+}
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveFeeSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveFeeSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveFeeSponsorshipResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgAssessCustomMsgFeeRequest)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeRequest")
 	proto.RegisterType((*MsgAssessCustomMsgFeeResponse)(nil), "provenance.msgfees.v1.MsgAssessCustomMsgFeeResponse")
@@ -650,64 +1012,89 @@ func init() {
 	proto.RegisterType((*MsgUpdateNhashPerUsdMilProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateNhashPerUsdMilProposalResponse")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalRequest")
 	proto.RegisterType((*MsgUpdateConversionFeeDenomProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateConversionFeeDenomProposalResponse")
+	proto.RegisterType((*MsgUpdateUsdFeeDenomsProposalRequest)(nil), "provenance.msgfees.v1.MsgUpdateUsdFeeDenomsProposalRequest")
+	proto.RegisterType((*MsgUpdateUsdFeeDenomsProposalResponse)(nil), "provenance.msgfees.v1.MsgUpdateUsdFeeDenomsProposalResponse")
+	proto.RegisterType((*MsgSetFeeSponsorshipRequest)(nil), "provenance.msgfees.v1.MsgSetFeeSponsorshipRequest")
+	proto.RegisterType((*MsgSetFeeSponsorshipResponse)(nil), "provenance.msgfees.v1.MsgSetFeeSponsorshipResponse")
+	proto.RegisterType((*MsgRemoveFeeSponsorshipRequest)(nil), "provenance.msgfees.v1.MsgRemoveFeeSponsorshipRequest")
+	proto.RegisterType((*MsgRemoveFeeSponsorshipResponse)(nil), "provenance.msgfees.v1.MsgRemoveFeeSponsorshipResponse")
 }
 
 func init() { proto.RegisterFile("provenance/msgfees/v1/tx.proto", fileDescriptor_4c6bb65eaf858b5f) }
 
 var fileDescriptor_4c6bb65eaf858b5f = []byte{
-	// 828 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcf, 0x6b, 0x3b, 0x45,
-	0x14, 0xcf, 0xe4, 0x9b, 0x16, 0x3a, 0xd6, 0x42, 0x86, 0xa8, 0xe9, 0x5a, 0x37, 0x31, 0x07, 0x6d,
-	0x23, 0xd9, 0x35, 0x4d, 0xad, 0x50, 0x50, 0x68, 0x2a, 0xb9, 0x45, 0x42, 0xb4, 0x17, 0x2f, 0xcb,
-	0x66, 0x77, 0xba, 0x19, 0xcc, 0xce, 0xac, 0xfb, 0x36, 0xa1, 0x01, 0x41, 0x11, 0x84, 0xe2, 0xc9,
-	0xb3, 0x22, 0xf4, 0x24, 0xea, 0xa9, 0x07, 0xff, 0x88, 0x1e, 0x8b, 0x27, 0x4f, 0x2a, 0x2d, 0x34,
-	0xfa, 0x5f, 0xc8, 0xee, 0x8e, 0x49, 0xdb, 0x24, 0x1b, 0xfb, 0xc3, 0x9b, 0x97, 0x64, 0x66, 0x3f,
-	0x9f, 0x79, 0xef, 0xf3, 0xde, 0xdb, 0x37, 0x6f, 0xb1, 0xea, 0xf9, 0x62, 0x40, 0xb9, 0xc9, 0x2d,
-	0xaa, 0xbb, 0xe0, 0x1c, 0x51, 0x0a, 0xfa, 0xa0, 0xaa, 0x07, 0xc7, 0x9a, 0xe7, 0x8b, 0x40, 0x90,
-	0x17, 0x26, 0xb8, 0x26, 0x71, 0x6d, 0x50, 0x55, 0xb2, 0xa6, 0xcb, 0xb8, 0xd0, 0xa3, 0xdf, 0x98,
-	0xa9, 0xe4, 0x1c, 0xe1, 0x88, 0x68, 0xa9, 0x87, 0x2b, 0xf9, 0x74, 0xdd, 0x12, 0xe0, 0x0a, 0x30,
-	0x62, 0x20, 0xde, 0x48, 0x48, 0x8d, 0x77, 0x7a, 0xc7, 0x04, 0xaa, 0x0f, 0xaa, 0x1d, 0x1a, 0x98,
-	0x55, 0xdd, 0x12, 0x8c, 0x4b, 0xfc, 0x25, 0x89, 0xbb, 0xe0, 0x84, 0x92, 0x5c, 0x70, 0x62, 0xa0,
-	0x74, 0x8d, 0xf0, 0x46, 0x13, 0x9c, 0x7d, 0x00, 0x0a, 0x70, 0xd0, 0x87, 0x40, 0xb8, 0x4d, 0x70,
-	0x1a, 0x94, 0xb6, 0xe9, 0x27, 0x7d, 0x0a, 0x01, 0x21, 0x38, 0xc3, 0x4d, 0x97, 0xe6, 0x51, 0x11,
-	0x6d, 0xae, 0xb4, 0xa3, 0x35, 0x79, 0x1b, 0x2f, 0x9b, 0xae, 0xe8, 0xf3, 0x20, 0x9f, 0x2e, 0xa2,
-	0xcd, 0xe7, 0xb6, 0xd7, 0x35, 0x29, 0x26, 0x74, 0xaf, 0x49, 0xf7, 0xda, 0x81, 0x60, 0xbc, 0x9e,
-	0x39, 0xff, 0xad, 0x90, 0x6a, 0x4b, 0x3a, 0xd9, 0xc0, 0x2b, 0x3e, 0xb5, 0x98, 0xc7, 0x28, 0x0f,
-	0xf2, 0xcf, 0x22, 0x8b, 0x93, 0x07, 0xa1, 0xab, 0x23, 0x5f, 0xb8, 0xf9, 0x4c, 0xec, 0x2a, 0x5c,
-	0x93, 0x1d, 0xfc, 0xe2, 0x98, 0x60, 0x74, 0x4c, 0x60, 0x60, 0x78, 0x82, 0xf1, 0x00, 0xf2, 0x4b,
-	0x11, 0x2b, 0x37, 0x46, 0xeb, 0x21, 0xd8, 0x8a, 0xb0, 0xbd, 0xec, 0xc9, 0x69, 0x21, 0xf5, 0xe7,
-	0x69, 0x21, 0xf5, 0xc5, 0xe8, 0xac, 0x1c, 0x19, 0x2a, 0x15, 0xf0, 0x2b, 0x73, 0xe2, 0x04, 0x4f,
-	0x70, 0xa0, 0xa5, 0xeb, 0x34, 0x7e, 0x39, 0x64, 0xd8, 0x76, 0x0c, 0xb4, 0x7c, 0xe1, 0x09, 0x30,
-	0x7b, 0xff, 0x24, 0xa2, 0x88, 0x57, 0x5d, 0x70, 0x8c, 0x60, 0xe8, 0x51, 0xa3, 0xef, 0xf7, 0x64,
-	0x42, 0xb0, 0x0b, 0xce, 0x87, 0x43, 0x8f, 0x1e, 0xfa, 0x3d, 0x72, 0x82, 0xf0, 0x9a, 0x69, 0xdb,
-	0x2c, 0x60, 0x82, 0x9b, 0x3d, 0xe3, 0x88, 0xd2, 0xc5, 0xf9, 0x69, 0x84, 0xf9, 0xf9, 0xe9, 0xf7,
-	0xc2, 0xa6, 0xc3, 0x82, 0x6e, 0xbf, 0xa3, 0x59, 0xc2, 0x95, 0x95, 0x95, 0x7f, 0x15, 0xb0, 0x3f,
-	0xd6, 0x43, 0xa7, 0x10, 0x1d, 0x80, 0x6f, 0x46, 0x67, 0xe5, 0xd5, 0x1e, 0x75, 0x4c, 0x6b, 0x68,
-	0x84, 0x05, 0x86, 0x1f, 0x46, 0x67, 0x65, 0xd4, 0x7e, 0x7e, 0xe2, 0xb8, 0x41, 0xe9, 0x82, 0x44,
-	0xcf, 0x4f, 0x6a, 0x66, 0x7e, 0x52, 0xc9, 0x2e, 0x5e, 0x31, 0xfb, 0x41, 0x57, 0xf8, 0x2c, 0x18,
-	0xc6, 0xd9, 0xaf, 0xe7, 0x7f, 0xf9, 0xb9, 0x92, 0x93, 0xb1, 0xed, 0xdb, 0xb6, 0x4f, 0x01, 0x3e,
-	0x08, 0x7c, 0xc6, 0x9d, 0xf6, 0x84, 0xba, 0xb7, 0x16, 0x16, 0x61, 0xb2, 0x2f, 0xa9, 0xf1, 0x1b,
-	0x37, 0x9d, 0x67, 0x59, 0x88, 0xbf, 0xd2, 0x58, 0x6d, 0x82, 0x73, 0xe8, 0xd9, 0x66, 0x40, 0xff,
-	0xaf, 0xc5, 0x7f, 0x5a, 0x8b, 0x57, 0x71, 0x61, 0x6e, 0xaa, 0x65, 0x39, 0xbe, 0x42, 0x51, 0x39,
-	0xda, 0xd4, 0x15, 0x83, 0x07, 0x97, 0xe3, 0x96, 0xde, 0xf4, 0x63, 0xf5, 0xce, 0xd6, 0x22, 0xf5,
-	0x7e, 0x8b, 0xf0, 0x6b, 0xe3, 0x98, 0xde, 0xef, 0x9a, 0xd0, 0x6d, 0x51, 0xff, 0x10, 0xec, 0x26,
-	0xeb, 0xdd, 0xd5, 0xbd, 0x85, 0xb3, 0x3c, 0x24, 0x18, 0x1e, 0xf5, 0x8d, 0x3e, 0xd8, 0x86, 0xcb,
-	0x62, 0xf1, 0x99, 0xf6, 0x1a, 0xbf, 0x75, 0xf2, 0xc9, 0x02, 0xd8, 0xc2, 0xaf, 0x2f, 0x14, 0x27,
-	0x03, 0xf9, 0x1e, 0xe1, 0xf2, 0x98, 0x7b, 0x20, 0xf8, 0x80, 0xfa, 0xc0, 0x04, 0x6f, 0x50, 0xfa,
-	0x1e, 0xe5, 0xc2, 0xbd, 0x1b, 0xcc, 0x9b, 0x38, 0x67, 0x8d, 0x49, 0xe1, 0x0b, 0x6f, 0xd8, 0x21,
-	0x4d, 0x16, 0x83, 0x58, 0x53, 0x06, 0x9e, 0x2c, 0xa6, 0x0a, 0x7e, 0xe3, 0x5f, 0xe9, 0x8c, 0xe3,
-	0xda, 0x1e, 0x2d, 0xe3, 0x67, 0x4d, 0x70, 0xc8, 0x67, 0x98, 0x4c, 0x5f, 0xc7, 0xa4, 0xa6, 0xcd,
-	0x9c, 0x92, 0x5a, 0xd2, 0x90, 0x52, 0x76, 0xee, 0x77, 0x28, 0x16, 0x42, 0x3e, 0xc5, 0xd9, 0xa9,
-	0x5b, 0x88, 0x6c, 0x27, 0x98, 0x9a, 0x33, 0x1a, 0x94, 0xda, 0xbd, 0xce, 0x48, 0xef, 0x5f, 0x22,
-	0x9c, 0x9b, 0xd5, 0x78, 0xe4, 0xad, 0xf9, 0xd6, 0x12, 0xee, 0x44, 0x65, 0xf7, 0xbe, 0xc7, 0x6e,
-	0xe8, 0x98, 0xd5, 0x50, 0x49, 0x3a, 0x12, 0x2e, 0x83, 0x24, 0x1d, 0x49, 0x7d, 0x4b, 0xbe, 0x43,
-	0x78, 0x23, 0xa9, 0x2f, 0xc8, 0x3b, 0x8b, 0x02, 0x4c, 0x6c, 0x76, 0xe5, 0xdd, 0x87, 0x1e, 0x97,
-	0xfa, 0x7e, 0x44, 0xb8, 0xb8, 0xe8, 0x1d, 0x27, 0xfb, 0x8b, 0x9c, 0x2c, 0xec, 0x63, 0xa5, 0xfe,
-	0x18, 0x13, 0xb1, 0x56, 0x65, 0xe9, 0xf3, 0x70, 0x10, 0xd5, 0xd9, 0xf9, 0xa5, 0x8a, 0x2e, 0x2e,
-	0x55, 0xf4, 0xc7, 0xa5, 0x8a, 0xbe, 0xbe, 0x52, 0x53, 0x17, 0x57, 0x6a, 0xea, 0xd7, 0x2b, 0x35,
-	0x85, 0xf3, 0x4c, 0xcc, 0x76, 0xd3, 0x42, 0x1f, 0xd5, 0x6e, 0x8c, 0xbf, 0x09, 0xa7, 0xc2, 0xc4,
-	0x8d, 0x9d, 0x7e, 0x3c, 0xfe, 0xc2, 0x8d, 0xe6, 0x61, 0x67, 0x39, 0xfa, 0x9c, 0xac, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x92, 0xa9, 0x86, 0xe4, 0x04, 0x0b, 0x00, 0x00,
+	// 1134 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcf, 0x6b, 0x24, 0xc5,
+	0x17, 0x9f, 0xce, 0x24, 0xc3, 0xce, 0x4b, 0x36, 0x90, 0x22, 0xdf, 0xaf, 0xbd, 0x9d, 0x38, 0x33,
+	0x1b, 0x7f, 0x24, 0x1b, 0xc9, 0xcc, 0x26, 0x59, 0x23, 0xc4, 0x5f, 0x24, 0x91, 0x80, 0x87, 0x91,
+	0x30, 0x31, 0x17, 0x2f, 0x4d, 0x4f, 0x77, 0xa5, 0x53, 0xec, 0x74, 0x57, 0x6f, 0xbf, 0x9a, 0x61,
+	0x03, 0x82, 0x22, 0x88, 0x8b, 0x27, 0xc1, 0x83, 0xa0, 0x08, 0x7b, 0x12, 0xf5, 0x94, 0x83, 0x82,
+	0x27, 0xcf, 0x7b, 0xf0, 0xb0, 0x78, 0xf2, 0xa4, 0x92, 0x80, 0xf1, 0xcf, 0x90, 0xee, 0xaa, 0xcc,
+	0x4c, 0x32, 0xd3, 0xd3, 0x3b, 0x31, 0x97, 0x05, 0x2f, 0xbb, 0xd5, 0xf5, 0x7e, 0x7d, 0x3e, 0xef,
+	0xbd, 0xaa, 0x57, 0x19, 0x28, 0x04, 0x21, 0x6f, 0x51, 0xdf, 0xf2, 0x6d, 0x5a, 0xf1, 0xd0, 0xdd,
+	0xa7, 0x14, 0x2b, 0xad, 0xe5, 0x8a, 0xb8, 0x5f, 0x0e, 0x42, 0x2e, 0x38, 0xf9, 0x5f, 0x47, 0x5e,
+	0x56, 0xf2, 0x72, 0x6b, 0xd9, 0x98, 0xb2, 0x3c, 0xe6, 0xf3, 0x4a, 0xfc, 0xaf, 0xd4, 0x34, 0xa6,
+	0x5d, 0xee, 0xf2, 0x78, 0x59, 0x89, 0x56, 0x6a, 0xf7, 0x86, 0xcd, 0xd1, 0xe3, 0x68, 0x4a, 0x81,
+	0xfc, 0x50, 0xa2, 0x82, 0xfc, 0xaa, 0xd4, 0x2d, 0xa4, 0x95, 0xd6, 0x72, 0x9d, 0x0a, 0x6b, 0xb9,
+	0x62, 0x73, 0xe6, 0x2b, 0xf9, 0x33, 0x4a, 0xee, 0xa1, 0x1b, 0x41, 0xf2, 0xd0, 0x55, 0x82, 0xe7,
+	0xfa, 0x63, 0x3e, 0x83, 0x17, 0x2b, 0xcd, 0xfd, 0xa5, 0xc1, 0x6c, 0x15, 0xdd, 0x0d, 0x44, 0x8a,
+	0xb8, 0xd5, 0x44, 0xc1, 0xbd, 0x2a, 0xba, 0xdb, 0x94, 0xd6, 0xe8, 0xbd, 0x26, 0x45, 0x41, 0x08,
+	0x8c, 0xfa, 0x96, 0x47, 0x75, 0xad, 0xa4, 0x2d, 0xe4, 0x6b, 0xf1, 0x9a, 0xbc, 0x02, 0x39, 0xcb,
+	0xe3, 0x4d, 0x5f, 0xe8, 0x23, 0x25, 0x6d, 0x61, 0x7c, 0xe5, 0x46, 0x59, 0x21, 0x8e, 0x30, 0x96,
+	0x15, 0xc6, 0xf2, 0x16, 0x67, 0xfe, 0xe6, 0xe8, 0xa3, 0xdf, 0x8b, 0x99, 0x9a, 0x52, 0x27, 0xb3,
+	0x90, 0x0f, 0xa9, 0xcd, 0x02, 0x46, 0x7d, 0xa1, 0x67, 0x63, 0x8f, 0x9d, 0x8d, 0x28, 0xd4, 0x7e,
+	0xc8, 0x3d, 0x7d, 0x54, 0x86, 0x8a, 0xd6, 0xe4, 0x0e, 0xfc, 0xbf, 0xad, 0x60, 0xd6, 0x2d, 0x64,
+	0x68, 0x06, 0x9c, 0xf9, 0x02, 0xf5, 0xb1, 0x58, 0x6b, 0xba, 0x2d, 0xdd, 0x8c, 0x84, 0x3b, 0xb1,
+	0x6c, 0x7d, 0xea, 0xc1, 0xc3, 0x62, 0xe6, 0xef, 0x87, 0xc5, 0xcc, 0x47, 0xa7, 0x47, 0x8b, 0xb1,
+	0xa3, 0xb9, 0x22, 0x3c, 0x9b, 0xc0, 0x13, 0x03, 0xee, 0x23, 0x9d, 0xfb, 0x31, 0x0b, 0x33, 0x91,
+	0x86, 0xe3, 0x48, 0xc1, 0x4e, 0xc8, 0x03, 0x8e, 0x56, 0xe3, 0x2c, 0x11, 0x25, 0x98, 0xf0, 0xd0,
+	0x35, 0xc5, 0x61, 0x40, 0xcd, 0x66, 0xd8, 0x50, 0x09, 0x01, 0x0f, 0xdd, 0x77, 0x0f, 0x03, 0xba,
+	0x17, 0x36, 0xc8, 0x03, 0x0d, 0x26, 0x2d, 0xc7, 0x61, 0x82, 0x71, 0xdf, 0x6a, 0x98, 0xfb, 0x94,
+	0xa6, 0xe7, 0x67, 0x3b, 0xca, 0xcf, 0xf7, 0x7f, 0x14, 0x17, 0x5c, 0x26, 0x0e, 0x9a, 0xf5, 0xb2,
+	0xcd, 0x3d, 0x55, 0x7e, 0xf5, 0xdf, 0x12, 0x3a, 0x77, 0x2b, 0x51, 0x50, 0x8c, 0x0d, 0xf0, 0xcb,
+	0xd3, 0xa3, 0xc5, 0x89, 0x06, 0x75, 0x2d, 0xfb, 0xd0, 0x8c, 0xba, 0x00, 0xbf, 0x3d, 0x3d, 0x5a,
+	0xd4, 0x6a, 0xd7, 0x3b, 0x81, 0xb7, 0x29, 0x4d, 0x49, 0x74, 0x72, 0x52, 0x47, 0x93, 0x93, 0x4a,
+	0xd6, 0x20, 0x6f, 0x35, 0xc5, 0x01, 0x0f, 0x99, 0x38, 0x94, 0xd9, 0xdf, 0xd4, 0x7f, 0xfd, 0x61,
+	0x69, 0x5a, 0x71, 0xdb, 0x70, 0x9c, 0x90, 0x22, 0xee, 0x8a, 0x90, 0xf9, 0x6e, 0xad, 0xa3, 0x4a,
+	0xaa, 0x00, 0x36, 0xf7, 0x25, 0x3a, 0xd4, 0x73, 0x71, 0x46, 0xe6, 0xcb, 0x7d, 0x0f, 0x4c, 0x59,
+	0xa6, 0x7e, 0xab, 0xad, 0xae, 0xfa, 0xa7, 0xcb, 0xc1, 0xfa, 0x64, 0x54, 0xd3, 0x8e, 0xfb, 0xb9,
+	0x02, 0xcc, 0xf6, 0x2f, 0x9b, 0xaa, 0xeb, 0x4f, 0x59, 0x28, 0x54, 0xd1, 0xdd, 0x0b, 0x1c, 0x4b,
+	0xd0, 0xff, 0x4a, 0xfb, 0x34, 0x95, 0xf6, 0x26, 0x14, 0x13, 0x2b, 0xa7, 0xaa, 0xfb, 0xa9, 0x16,
+	0x57, 0xb7, 0x46, 0x3d, 0xde, 0xba, 0x74, 0x75, 0xcf, 0xd1, 0x1f, 0x79, 0x62, 0xfa, 0x09, 0x78,
+	0xfb, 0x63, 0x51, 0x78, 0xbf, 0xd2, 0xe0, 0xc5, 0x36, 0xa7, 0x77, 0x0e, 0x2c, 0x3c, 0xd8, 0xa1,
+	0xe1, 0x1e, 0x3a, 0x55, 0xd6, 0xb8, 0x88, 0xfb, 0x16, 0x4c, 0xf9, 0x91, 0x82, 0x19, 0xd0, 0xd0,
+	0x6c, 0xa2, 0x63, 0x7a, 0x4c, 0x82, 0x1f, 0xad, 0x4d, 0xfa, 0xe7, 0x2c, 0xaf, 0x8c, 0xc0, 0x2d,
+	0x98, 0x4f, 0x05, 0xa7, 0x88, 0x7c, 0xa3, 0xc1, 0x62, 0x5b, 0x77, 0x8b, 0xfb, 0x2d, 0x1a, 0x22,
+	0xe3, 0xfe, 0x36, 0xa5, 0x6f, 0x51, 0x9f, 0x7b, 0x17, 0xc9, 0xdc, 0x86, 0x69, 0xbb, 0xad, 0x14,
+	0x9d, 0x1f, 0xd3, 0x89, 0xd4, 0x54, 0x31, 0x88, 0xdd, 0xe3, 0xe0, 0xca, 0x38, 0x2d, 0xc1, 0x4b,
+	0x4f, 0x84, 0x53, 0xf1, 0xfa, 0x45, 0x83, 0xe7, 0xdb, 0xfa, 0x7b, 0xe8, 0x9c, 0x29, 0xe2, 0x45,
+	0x46, 0x6f, 0x42, 0x4e, 0x70, 0x13, 0xa9, 0xd0, 0xb5, 0x52, 0x76, 0x61, 0x7c, 0x65, 0x2e, 0xa1,
+	0xef, 0xbb, 0x7c, 0xa8, 0x96, 0x1f, 0x13, 0x7c, 0x97, 0x0a, 0x32, 0x03, 0x79, 0xc1, 0xcd, 0x30,
+	0xee, 0x16, 0x7d, 0xa4, 0x94, 0x5d, 0xc8, 0xd7, 0xae, 0x09, 0x2e, 0xbb, 0xe7, 0x3c, 0xfb, 0xec,
+	0xe5, 0xd9, 0xcf, 0xc3, 0x0b, 0x29, 0x6c, 0x14, 0xef, 0xcf, 0xe5, 0xf8, 0xdb, 0xa5, 0x62, 0x9b,
+	0xd2, 0xdd, 0x68, 0x8f, 0x87, 0x78, 0xc0, 0x82, 0x33, 0xba, 0x65, 0x18, 0xb3, 0x1c, 0x8f, 0xf9,
+	0xba, 0x96, 0x02, 0x46, 0xaa, 0xf5, 0x9c, 0xba, 0x91, 0x9e, 0x53, 0x37, 0x03, 0x79, 0xcf, 0x0a,
+	0xef, 0x52, 0x61, 0x32, 0x27, 0xa6, 0x78, 0xbd, 0x76, 0x4d, 0x6e, 0xbc, 0xed, 0x90, 0x9b, 0x30,
+	0x11, 0xaf, 0x43, 0xd5, 0x27, 0xf2, 0xf6, 0x1a, 0x97, 0x7b, 0xb2, 0x41, 0x6c, 0xc8, 0xd5, 0x9b,
+	0x8e, 0x4b, 0x85, 0x3e, 0x56, 0xca, 0x0e, 0xbe, 0x8a, 0x6f, 0x0f, 0x7b, 0x15, 0xd7, 0x94, 0x6b,
+	0x72, 0x0f, 0x26, 0x1d, 0x8b, 0x35, 0x0e, 0xcd, 0x26, 0xd2, 0xd0, 0xb4, 0xad, 0x40, 0xcf, 0x5d,
+	0x7d, 0xb0, 0x89, 0x38, 0xc4, 0x1e, 0xd2, 0x70, 0xcb, 0x0a, 0xd6, 0x21, 0x2a, 0xa1, 0xcc, 0xa2,
+	0x1a, 0x6e, 0x7d, 0x8a, 0xa2, 0xaa, 0xf6, 0x73, 0xf7, 0xf5, 0xf7, 0x94, 0x14, 0xee, 0x1c, 0xc1,
+	0xee, 0x2b, 0xb3, 0x3f, 0xc7, 0x95, 0x4f, 0x00, 0xb2, 0x55, 0x74, 0xc9, 0x07, 0x40, 0x7a, 0x9f,
+	0x6f, 0x64, 0x35, 0x79, 0xdc, 0x24, 0x3e, 0x6a, 0x8d, 0x3b, 0xc3, 0x19, 0x49, 0x20, 0xe4, 0x7d,
+	0x98, 0xea, 0x79, 0x66, 0x90, 0x95, 0x01, 0xae, 0x12, 0x9e, 0x92, 0xc6, 0xea, 0x50, 0x36, 0x2a,
+	0xfa, 0xc7, 0x1a, 0x4c, 0xf7, 0x1b, 0x85, 0xe4, 0xe5, 0x64, 0x6f, 0x03, 0x1e, 0x3d, 0xc6, 0xda,
+	0xb0, 0x66, 0x5d, 0x38, 0xfa, 0x8d, 0xb8, 0x41, 0x38, 0x06, 0x8c, 0x67, 0x63, 0x6d, 0x58, 0x33,
+	0x85, 0xe3, 0x6b, 0x0d, 0x66, 0x07, 0x4d, 0x2a, 0xf2, 0x7a, 0x1a, 0xc1, 0x81, 0xe3, 0xd7, 0x78,
+	0xe3, 0xb2, 0xe6, 0x0a, 0xdf, 0x77, 0x1a, 0x94, 0xd2, 0xa6, 0x0e, 0xd9, 0x48, 0x0b, 0x92, 0x3a,
+	0x59, 0x8d, 0xcd, 0x7f, 0xe3, 0x42, 0x61, 0xfd, 0x42, 0x03, 0x23, 0x79, 0x46, 0x90, 0x57, 0xd3,
+	0x42, 0x0c, 0x98, 0x93, 0xc6, 0x6b, 0x97, 0x33, 0xee, 0x9c, 0xb9, 0x9e, 0xdb, 0x6f, 0xd0, 0x99,
+	0x4b, 0x9a, 0x5f, 0xc6, 0xea, 0x50, 0x36, 0x3d, 0xbd, 0x7e, 0x01, 0x41, 0x6a, 0xaf, 0xf7, 0x07,
+	0xb1, 0x36, 0xac, 0x99, 0xc4, 0x61, 0x8c, 0x7d, 0x18, 0xfd, 0x25, 0xb0, 0xc9, 0x1e, 0x1d, 0x17,
+	0xb4, 0xc7, 0xc7, 0x05, 0xed, 0xcf, 0xe3, 0x82, 0xf6, 0xd9, 0x49, 0x21, 0xf3, 0xf8, 0xa4, 0x90,
+	0xf9, 0xed, 0xa4, 0x90, 0x01, 0x9d, 0xf1, 0xfe, 0xae, 0x77, 0xb4, 0xf7, 0x56, 0xbb, 0xe6, 0x50,
+	0x47, 0x67, 0x89, 0xf1, 0xae, 0xaf, 0xca, 0xfd, 0xf6, 0x4f, 0x04, 0xf1, 0x60, 0xaa, 0xe7, 0xe2,
+	0x9f, 0x07, 0x56, 0xff, 0x19, 0x00, 0x31, 0xfc, 0x3a, 0x18, 0xf9, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -737,6 +1124,12 @@ type MsgClient interface {
 	UpdateNhashPerUsdMilProposal(ctx context.Context, in *MsgUpdateNhashPerUsdMilProposalRequest, opts ...grpc.CallOption) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(ctx context.Context, in *MsgUpdateConversionFeeDenomProposalRequest, opts ...grpc.CallOption) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateUsdFeeDenomsProposal defines a governance proposal to update the denoms that can pay usd msg fees
+	UpdateUsdFeeDenomsProposal(ctx context.Context, in *MsgUpdateUsdFeeDenomsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateUsdFeeDenomsProposalResponse, error)
+	// SetFeeSponsorship creates or updates a fee sponsorship.
+	SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error)
+	// RemoveFeeSponsorship removes a fee sponsorship.
+	RemoveFeeSponsorship(ctx context.Context, in *MsgRemoveFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgRemoveFeeSponsorshipResponse, error)
 }
 
 type msgClient struct {
@@ -801,6 +1194,33 @@ func (c *msgClient) UpdateConversionFeeDenomProposal(ctx context.Context, in *Ms
 	return out, nil
 }
 
+func (c *msgClient) UpdateUsdFeeDenomsProposal(ctx context.Context, in *MsgUpdateUsdFeeDenomsProposalRequest, opts ...grpc.CallOption) (*MsgUpdateUsdFeeDenomsProposalResponse, error) {
+	out := new(MsgUpdateUsdFeeDenomsProposalResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/UpdateUsdFeeDenomsProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSponsorship(ctx context.Context, in *MsgSetFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgSetFeeSponsorshipResponse, error) {
+	out := new(MsgSetFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/SetFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeSponsorship(ctx context.Context, in *MsgRemoveFeeSponsorshipRequest, opts ...grpc.CallOption) (*MsgRemoveFeeSponsorshipResponse, error) {
+	out := new(MsgRemoveFeeSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/provenance.msgfees.v1.Msg/RemoveFeeSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// AssessCustomMsgFee endpoint executes the additional fee charges.
@@ -818,6 +1238,12 @@ type MsgServer interface {
 	UpdateNhashPerUsdMilProposal(context.Context, *MsgUpdateNhashPerUsdMilProposalRequest) (*MsgUpdateNhashPerUsdMilProposalResponse, error)
 	// UpdateConversionFeeDenomProposal defines a governance proposal to update the msg fee conversion denom
 	UpdateConversionFeeDenomProposal(context.Context, *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error)
+	// UpdateUsdFeeDenomsProposal defines a governance proposal to update the denoms that can pay usd msg fees
+	UpdateUsdFeeDenomsProposal(context.Context, *MsgUpdateUsdFeeDenomsProposalRequest) (*MsgUpdateUsdFeeDenomsProposalResponse, error)
+	// SetFeeSponsorship creates or updates a fee sponsorship.
+	SetFeeSponsorship(context.Context, *MsgSetFeeSponsorshipRequest) (*MsgSetFeeSponsorshipResponse, error)
+	// RemoveFeeSponsorship removes a fee sponsorship.
+	RemoveFeeSponsorship(context.Context, *MsgRemoveFeeSponsorshipRequest) (*MsgRemoveFeeSponsorshipResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -842,6 +1268,15 @@ func (*UnimplementedMsgServer) UpdateNhashPerUsdMilProposal(ctx context.Context,
 func (*UnimplementedMsgServer) UpdateConversionFeeDenomProposal(ctx context.Context, req *MsgUpdateConversionFeeDenomProposalRequest) (*MsgUpdateConversionFeeDenomProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateConversionFeeDenomProposal not implemented")
 }
+func (*UnimplementedMsgServer) UpdateUsdFeeDenomsProposal(ctx context.Context, req *MsgUpdateUsdFeeDenomsProposalRequest) (*MsgUpdateUsdFeeDenomsProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUsdFeeDenomsProposal not implemented")
+}
+func (*UnimplementedMsgServer) SetFeeSponsorship(ctx context.Context, req *MsgSetFeeSponsorshipRequest) (*MsgSetFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) RemoveFeeSponsorship(ctx context.Context, req *MsgRemoveFeeSponsorshipRequest) (*MsgRemoveFeeSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeSponsorship not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -955,44 +1390,110 @@ func _Msg_UpdateConversionFeeDenomProposal_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "provenance.msgfees.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AssessCustomMsgFee",
-			Handler:    _Msg_AssessCustomMsgFee_Handler,
-		},
-		{
-			MethodName: "AddMsgFeeProposal",
-			Handler:    _Msg_AddMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "UpdateMsgFeeProposal",
-			Handler:    _Msg_UpdateMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "RemoveMsgFeeProposal",
-			Handler:    _Msg_RemoveMsgFeeProposal_Handler,
-		},
-		{
-			MethodName: "UpdateNhashPerUsdMilProposal",
-			Handler:    _Msg_UpdateNhashPerUsdMilProposal_Handler,
-		},
-		{
-			MethodName: "UpdateConversionFeeDenomProposal",
-			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "provenance/msgfees/v1/tx.proto",
-}
-
-func (m *MsgAssessCustomMsgFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateUsdFeeDenomsProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateUsdFeeDenomsProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateUsdFeeDenomsProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/UpdateUsdFeeDenomsProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateUsdFeeDenomsProposal(ctx, req.(*MsgUpdateUsdFeeDenomsProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/SetFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSponsorship(ctx, req.(*MsgSetFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeSponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.msgfees.v1.Msg/RemoveFeeSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeSponsorship(ctx, req.(*MsgRemoveFeeSponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "provenance.msgfees.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AssessCustomMsgFee",
+			Handler:    _Msg_AssessCustomMsgFee_Handler,
+		},
+		{
+			MethodName: "AddMsgFeeProposal",
+			Handler:    _Msg_AddMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "UpdateMsgFeeProposal",
+			Handler:    _Msg_UpdateMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "RemoveMsgFeeProposal",
+			Handler:    _Msg_RemoveMsgFeeProposal_Handler,
+		},
+		{
+			MethodName: "UpdateNhashPerUsdMilProposal",
+			Handler:    _Msg_UpdateNhashPerUsdMilProposal_Handler,
+		},
+		{
+			MethodName: "UpdateConversionFeeDenomProposal",
+			Handler:    _Msg_UpdateConversionFeeDenomProposal_Handler,
+		},
+		{
+			MethodName: "UpdateUsdFeeDenomsProposal",
+			Handler:    _Msg_UpdateUsdFeeDenomsProposal_Handler,
+		},
+		{
+			MethodName: "SetFeeSponsorship",
+			Handler:    _Msg_SetFeeSponsorship_Handler,
+		},
+		{
+			MethodName: "RemoveFeeSponsorship",
+			Handler:    _Msg_RemoveFeeSponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "provenance/msgfees/v1/tx.proto",
+}
+
+func (m *MsgAssessCustomMsgFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
 		return nil, err
 	}
 	return dAtA[:n], nil
@@ -1092,6 +1593,16 @@ func (m *MsgAddMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Conditions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1176,6 +1687,16 @@ func (m *MsgUpdateMsgFeeProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Conditions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -1418,98 +1939,348 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToRemove) > 0 {
+		for iNdEx := len(m.ToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ToRemove[iNdEx])
+			copy(dAtA[i:], m.ToRemove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.RecipientBasisPoints)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ToSet) > 0 {
+		for iNdEx := len(m.ToSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ToSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.DailyUserCap) > 0 {
+		for iNdEx := len(m.DailyUserCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyUserCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = m.AdditionalFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.RecipientBasisPoints)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddMsgFeeProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MsgTypeUrl)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MarkerDenom) > 0 {
+		i -= len(m.MarkerDenom)
+		copy(dAtA[i:], m.MarkerDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarkerDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAssessCustomMsgFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientBasisPoints)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAssessCustomMsgFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddMsgFeeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AdditionalFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecipientBasisPoints)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Conditions.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAddMsgFeeProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
 	}
 	l = m.AdditionalFee.Size()
 	n += 1 + l + sovTx(uint64(l))
@@ -1525,6 +2296,8 @@ func (m *MsgUpdateMsgFeeProposalRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = m.Conditions.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -1614,6 +2387,118 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ToSet) > 0 {
+		for _, e := range m.ToSet {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ToRemove) > 0 {
+		for _, s := range m.ToRemove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.DailyUserCap) > 0 {
+		for _, e := range m.DailyUserCap {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveFeeSponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovTx(uint64(m.MarketId))
+	}
+	l = len(m.MarkerDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveFeeSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2071,6 +2956,39 @@ func (m *MsgAddMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2270,7 +3188,519 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientBasisPoints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Conditions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			}
+			m.NhashPerUsdMil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2298,9 +3728,9 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecipientBasisPoints = string(dAtA[iNdEx:postIndex])
+			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2353,7 +3783,7 @@ func (m *MsgUpdateMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,10 +3806,10 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2403,7 +3833,7 @@ func (m *MsgUpdateMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUsdFeeDenomsProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2426,15 +3856,49 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUsdFeeDenomsProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUsdFeeDenomsProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToSet = append(m.ToSet, UsdFeeDenom{})
+			if err := m.ToSet[len(m.ToSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToRemove", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2462,9 +3926,9 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			m.ToRemove = append(m.ToRemove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
@@ -2517,7 +3981,7 @@ func (m *MsgRemoveMsgFeeProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateUsdFeeDenomsProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2540,10 +4004,10 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateUsdFeeDenomsProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveMsgFeeProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateUsdFeeDenomsProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2567,7 +4031,7 @@ func (m *MsgRemoveMsgFeeProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2590,17 +4054,17 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NhashPerUsdMil", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
-			m.NhashPerUsdMil = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2610,14 +4074,27 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NhashPerUsdMil |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2645,7 +4122,126 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyUserCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyUserCap = append(m.DailyUserCap, types.Coin{})
+			if err := m.DailyUserCap[len(m.DailyUserCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2668,7 +4264,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2691,10 +4287,10 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNhashPerUsdMilProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2718,7 +4314,7 @@ func (m *MsgUpdateNhashPerUsdMilProposalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeSponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,15 +4337,15 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConversionFeeDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2777,11 +4373,11 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConversionFeeDenom = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2809,7 +4405,58 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarkerDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2832,7 +4479,7 @@ func (m *MsgUpdateConversionFeeDenomProposalRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveFeeSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2855,10 +4502,10 @@ func (m *MsgUpdateConversionFeeDenomProposalResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateConversionFeeDenomProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveFeeSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
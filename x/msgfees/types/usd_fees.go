@@ -0,0 +1,120 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// UsdRateSourceMarkerNav is the name of the rate source that uses a marker's usd net asset value.
+	UsdRateSourceMarkerNav = "marker-nav"
+	// UsdRateSourceExchange is the name of the rate source that uses the recent usd trades in an exchange market.
+	UsdRateSourceExchange = "exchange"
+)
+
+// UsdRateSource provides the value of a denom in usd mils.
+type UsdRateSource interface {
+	// GetUsdRate returns the current value of the usd fee denom. If the source doesn't have one, nil is returned.
+	GetUsdRate(ctx sdk.Context, feeDenom UsdFeeDenom) (*UsdRate, error)
+}
+
+// UsdFeeConverter converts a fee that is priced in usd into one of the denoms provided to pay it.
+type UsdFeeConverter interface {
+	ConvertUsdFee(ctx sdk.Context, usdFee sdk.Coin, feeCoins sdk.Coins) (sdk.Coin, error)
+}
+
+// UsdRate is the value of an amount of a denom in usd mils.
+type UsdRate struct {
+	// UsdMils is the value of the volume in usd mils.
+	UsdMils sdkmath.Int
+	// Volume is the amount of the denom that is worth UsdMils.
+	Volume sdkmath.Int
+	// UpdatedBlockHeight is the height of the block that this rate was last updated in.
+	UpdatedBlockHeight int64
+}
+
+// NewUsdRate creates a new UsdRate.
+func NewUsdRate(usdMils, volume sdkmath.Int, updatedBlockHeight int64) *UsdRate {
+	return &UsdRate{
+		UsdMils:            usdMils,
+		Volume:             volume,
+		UpdatedBlockHeight: updatedBlockHeight,
+	}
+}
+
+// IsValid returns true if this rate can be used for conversion.
+func (r UsdRate) IsValid() bool {
+	return !r.UsdMils.IsNil() && r.UsdMils.IsPositive() && !r.Volume.IsNil() && r.Volume.IsPositive()
+}
+
+// IsStale returns true if more than maxAgeBlocks have passed since this rate was last updated.
+func (r UsdRate) IsStale(blockHeight int64, maxAgeBlocks uint64) bool {
+	if r.UpdatedBlockHeight > blockHeight {
+		return false
+	}
+	return uint64(blockHeight-r.UpdatedBlockHeight) > maxAgeBlocks
+}
+
+// Convert returns the amount of the denom needed to pay the provided usd mils, rounded up.
+func (r UsdRate) Convert(denom string, usdMils sdkmath.Int) sdk.Coin {
+	numerator := usdMils.Mul(r.Volume)
+	amount := numerator.Quo(r.UsdMils)
+	if !numerator.Mod(r.UsdMils).IsZero() {
+		amount = amount.AddRaw(1)
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// NewUsdFeeDenom creates a new UsdFeeDenom.
+func NewUsdFeeDenom(denom, rateSource string, marketID uint32, maxAgeBlocks uint64) UsdFeeDenom {
+	return UsdFeeDenom{
+		Denom:        denom,
+		RateSource:   rateSource,
+		MarketId:     marketID,
+		MaxAgeBlocks: maxAgeBlocks,
+	}
+}
+
+// Validate returns an error if this usd fee denom is invalid.
+func (d UsdFeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(d.Denom); err != nil {
+		return err
+	}
+	if d.Denom == UsdDenom {
+		return fmt.Errorf("denom cannot be %q", UsdDenom)
+	}
+	if len(d.RateSource) == 0 {
+		return fmt.Errorf("denom %q: rate source cannot be empty", d.Denom)
+	}
+	if d.RateSource == UsdRateSourceExchange && d.MarketId == 0 {
+		return fmt.Errorf("denom %q: market id is required with the %q rate source", d.Denom, UsdRateSourceExchange)
+	}
+	if d.RateSource != UsdRateSourceExchange && d.MarketId != 0 {
+		return fmt.Errorf("denom %q: market id can only be used with the %q rate source", d.Denom, UsdRateSourceExchange)
+	}
+	if d.MaxAgeBlocks == 0 {
+		return fmt.Errorf("denom %q: max age blocks must be greater than 0", d.Denom)
+	}
+	return nil
+}
+
+// ValidateUsdFeeDenoms returns an error if any of the provided usd fee denoms are invalid or duplicated.
+func ValidateUsdFeeDenoms(usdFeeDenoms []UsdFeeDenom) error {
+	seen := make(map[string]bool, len(usdFeeDenoms))
+	var errs []error
+	for _, usdFeeDenom := range usdFeeDenoms {
+		if err := usdFeeDenom.Validate(); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if seen[usdFeeDenom.Denom] {
+			errs = append(errs, fmt.Errorf("duplicate usd fee denom %q", usdFeeDenom.Denom))
+		}
+		seen[usdFeeDenom.Denom] = true
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestUsdRateIsStale(t *testing.T) {
+	rate := NewUsdRate(sdkmath.NewInt(1000), sdkmath.NewInt(1000), 100)
+
+	assert.False(t, rate.IsStale(100, 10), "same block")
+	assert.False(t, rate.IsStale(110, 10), "at max age")
+	assert.True(t, rate.IsStale(111, 10), "past max age")
+	assert.False(t, rate.IsStale(99, 10), "updated after block height")
+}
+
+func TestUsdRateConvert(t *testing.T) {
+	tests := []struct {
+		name    string
+		usdMils int64
+		volume  int64
+		fee     int64
+		exp     string
+	}{
+		{name: "one to one", usdMils: 1000, volume: 1000, fee: 250, exp: "250stable"},
+		{name: "more volume than mils", usdMils: 1000, volume: 4000, fee: 250, exp: "1000stable"},
+		{name: "fewer volume than mils", usdMils: 4000, volume: 1000, fee: 250, exp: "63stable"},
+		{name: "exact fewer volume than mils", usdMils: 4000, volume: 1000, fee: 400, exp: "100stable"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rate := NewUsdRate(sdkmath.NewInt(tc.usdMils), sdkmath.NewInt(tc.volume), 1)
+			actual := rate.Convert("stable", sdkmath.NewInt(tc.fee))
+			assert.Equal(t, tc.exp, actual.String(), "Convert")
+		})
+	}
+}
+
+func TestUsdFeeDenomValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		usdFeeDenom UsdFeeDenom
+		expErr      string
+	}{
+		{
+			name:        "marker nav",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", UsdRateSourceMarkerNav, 0, 100),
+		},
+		{
+			name:        "exchange",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", UsdRateSourceExchange, 3, 100),
+		},
+		{
+			name:        "invalid denom",
+			usdFeeDenom: NewUsdFeeDenom("x", UsdRateSourceMarkerNav, 0, 100),
+			expErr:      "invalid denom: x",
+		},
+		{
+			name:        "usd denom",
+			usdFeeDenom: NewUsdFeeDenom(UsdDenom, UsdRateSourceMarkerNav, 0, 100),
+			expErr:      `denom cannot be "usd"`,
+		},
+		{
+			name:        "no rate source",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", "", 0, 100),
+			expErr:      `denom "usdstable": rate source cannot be empty`,
+		},
+		{
+			name:        "exchange without market",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", UsdRateSourceExchange, 0, 100),
+			expErr:      `denom "usdstable": market id is required with the "exchange" rate source`,
+		},
+		{
+			name:        "market without exchange",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", UsdRateSourceMarkerNav, 3, 100),
+			expErr:      `denom "usdstable": market id can only be used with the "exchange" rate source`,
+		},
+		{
+			name:        "zero max age",
+			usdFeeDenom: NewUsdFeeDenom("usdstable", UsdRateSourceMarkerNav, 0, 0),
+			expErr:      `denom "usdstable": max age blocks must be greater than 0`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.usdFeeDenom.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr, "Validate")
+			} else {
+				require.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestValidateUsdFeeDenoms(t *testing.T) {
+	stable := NewUsdFeeDenom("usdstable", UsdRateSourceMarkerNav, 0, 100)
+	traded := NewUsdFeeDenom("usdtraded", UsdRateSourceExchange, 3, 100)
+
+	require.NoError(t, ValidateUsdFeeDenoms(nil), "nil")
+	require.NoError(t, ValidateUsdFeeDenoms([]UsdFeeDenom{stable, traded}), "two different denoms")
+	require.EqualError(t, ValidateUsdFeeDenoms([]UsdFeeDenom{stable, traded, stable}),
+		`duplicate usd fee denom "usdstable"`, "duplicate denom")
+}
@@ -202,12 +202,13 @@ func (s *IntegrationTestSuite) TestGetNameParamsCmd() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=json", cmtcli.OutputFlag)},
-			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true}",
+			"{\"max_segment_length\":32,\"min_segment_length\":1,\"max_name_levels\":2,\"allow_unrestricted_names\":true,\"lease_settings\":[]}",
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=text", cmtcli.OutputFlag)},
 			`allow_unrestricted_names: true
+lease_settings: []
 max_name_levels: 2
 max_segment_length: 32
 min_segment_length: 1`,
@@ -777,6 +778,30 @@ func (s *IntegrationTestSuite) TestUpdateNameParamsCmd() {
 			},
 			expectErr: `invalid allow unrestricted names flag: strconv.ParseBool: parsing "invalid": invalid syntax`,
 		},
+		{
+			name: "update name params with lease settings, should succeed",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"--lease-settings", "leased:100:10:5stake:parent",
+			},
+			expectedCode: 0,
+		},
+		{
+			name: "update name params, should fail invalid lease settings",
+			cmd:  namecli.GetUpdateNameParamsCmd(),
+			args: []string{
+				"16",
+				"2",
+				"5",
+				"true",
+				"--lease-settings", "leased:100:10:5stake:nowhere",
+			},
+			expectErr: `invalid lease settings "leased:100:10:5stake:nowhere" fee destination: expected parent or pool`,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -29,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		QueryParamsCmd(),
 		ResolveNameCommand(),
 		ReverseLookupCommand(),
+		LeaseCommand(),
+		LeasesCommand(),
 	)
 
 	return queryCmd
@@ -138,3 +140,67 @@ $ %[1]s query name lookup pb1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk --page=2 --l
 
 	return cmd
 }
+
+// LeaseCommand returns the command handler for getting the lease of a name.
+func LeaseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "lease [name]",
+		Short:   "Query the lease of a name",
+		Example: fmt.Sprintf(`$ %s query name lease sample.leased`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			name := strings.ToLower(strings.TrimSpace(args[0]))
+
+			response, err := queryClient.Lease(context.Background(), &types.QueryLeaseRequest{Name: name})
+			if err != nil {
+				return fmt.Errorf("failed to query lease of %q: %w", name, err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// LeasesCommand returns the command handler for listing all name leases in order of release time.
+func LeasesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leases",
+		Short: "Query all name leases in order of release time",
+		Example: fmt.Sprintf(`$ %[1]s query name leases
+$ %[1]s query name leases --page=2 --limit=100
+`, version.AppName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequestWithPageKeyDecoded(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			response, err := queryClient.Leases(context.Background(), &types.QueryLeasesRequest{Pagination: pageReq})
+			if err != nil {
+				return fmt.Errorf("failed to query name leases: %w", err)
+			}
+			return clientCtx.PrintProto(response)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "leases")
+
+	return cmd
+}
@@ -29,6 +29,9 @@ const (
 
 	// FlagUnrestricted is the flag for creating unrestricted names
 	FlagUnrestricted = "unrestrict"
+
+	// FlagLeaseSettings is the flag for the lease settings of a parent name
+	FlagLeaseSettings = "lease-settings"
 )
 
 // NewTxCmd is the top-level command for name CLI transactions.
@@ -45,6 +48,7 @@ func NewTxCmd() *cobra.Command {
 		GetDeleteNameCmd(),
 		GetModifyNameCmd(),
 		GetGovRootNameCmd(),
+		GetRenewNameCmd(),
 	)
 	return txCmd
 }
@@ -86,6 +90,29 @@ func GetBindNameCmd() *cobra.Command {
 	return cmd
 }
 
+// GetRenewNameCmd is the CLI command for renewing the lease of a name.
+func GetRenewNameCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renew [name]",
+		Short:   "Renew the lease of a name, paying its renewal fee",
+		Example: fmt.Sprintf(`$ %s tx name renew sample.leased`, version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRenewNameRequest(
+				strings.TrimSpace(strings.ToLower(args[0])),
+				clientCtx.GetFromAddress().String(),
+			)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetDeleteNameCmd is the CLI command for deleting a bound name.
 func GetDeleteNameCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -236,11 +263,12 @@ func owner(ctx client.Context, flags *pflag.FlagSet) (string, error) {
 // GetUpdateNameParamsCmd creates a command to update the name module's params via governance proposal.
 func GetUpdateNameParamsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names>",
-		Short:   "Update the name module's params via governance proposal",
-		Long:    "Submit an update name params via governance proposal along with an initial deposit.",
-		Args:    cobra.ExactArgs(4),
-		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true --deposit 50000nhash`, version.AppName),
+		Use:   "update-name-params <max-segment-length> <min-segment-length> <max-name-levels> <allow-unrestricted-names>",
+		Short: "Update the name module's params via governance proposal",
+		Long:  "Submit an update name params via governance proposal along with an initial deposit.",
+		Args:  cobra.ExactArgs(4),
+		Example: fmt.Sprintf(`%[1]s tx name update-name-params 16 2 5 true --deposit 50000nhash
+%[1]s tx name update-name-params 16 2 5 true --lease-settings leased:31536000:2592000:1000nhash:parent --deposit 50000nhash`, version.AppName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -270,20 +298,69 @@ func GetUpdateNameParamsCmd() *cobra.Command {
 				return fmt.Errorf("invalid allow unrestricted names flag: %w", err)
 			}
 
+			leaseSettingsStrs, err := flagSet.GetStringArray(FlagLeaseSettings)
+			if err != nil {
+				return err
+			}
+			leaseSettings := make([]types.LeaseSettings, len(leaseSettingsStrs))
+			for i, str := range leaseSettingsStrs {
+				leaseSettings[i], err = ParseLeaseSettings(str)
+				if err != nil {
+					return err
+				}
+			}
+
 			msg := types.NewMsgUpdateParamsRequest(
 				uint32(maxSegmentLength),
 				uint32(minSegmentLength),
 				uint32(maxNameLevels),
 				allowUnrestrictedNames,
+				leaseSettings,
 				authority,
 			)
 			return provcli.GenerateOrBroadcastTxCLIAsGovProp(clientCtx, flagSet, msg)
 		},
 	}
 
+	cmd.Flags().StringArray(FlagLeaseSettings, nil,
+		"Lease settings for the child names of a parent, as <parent>:<duration-seconds>:<grace-period-seconds>:<renewal-fee>:<parent|pool> (repeatable)")
 	govcli.AddGovPropFlagsToCmd(cmd)
 	provcli.AddAuthorityFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
 }
+
+// ParseLeaseSettings parses a <parent>:<duration-seconds>:<grace-period-seconds>:<renewal-fee>:<parent|pool> string
+// into lease settings. The renewal fee can be empty for free renewals.
+func ParseLeaseSettings(str string) (types.LeaseSettings, error) {
+	parts := strings.Split(str, ":")
+	if len(parts) < 5 {
+		return types.LeaseSettings{}, fmt.Errorf("invalid lease settings %q: expected format <parent>:<duration-seconds>:<grace-period-seconds>:<renewal-fee>:<parent|pool>", str)
+	}
+	parent := strings.ToLower(strings.TrimSpace(parts[0]))
+	duration, err := strconv.ParseUint(parts[1], 10, 64)
+	if err != nil {
+		return types.LeaseSettings{}, fmt.Errorf("invalid lease settings %q duration: %w", str, err)
+	}
+	grace, err := strconv.ParseUint(parts[2], 10, 64)
+	if err != nil {
+		return types.LeaseSettings{}, fmt.Errorf("invalid lease settings %q grace period: %w", str, err)
+	}
+	// Denoms can contain colons, so the fee is everything between the grace period and the fee destination.
+	fee, err := sdk.ParseCoinsNormalized(strings.Join(parts[3:len(parts)-1], ":"))
+	if err != nil {
+		return types.LeaseSettings{}, fmt.Errorf("invalid lease settings %q renewal fee: %w", str, err)
+	}
+	var toParentOwner bool
+	switch strings.ToLower(parts[len(parts)-1]) {
+	case "parent":
+		toParentOwner = true
+	case "pool":
+		toParentOwner = false
+	default:
+		return types.LeaseSettings{}, fmt.Errorf("invalid lease settings %q fee destination: expected parent or pool", str)
+	}
+	settings := types.NewLeaseSettings(parent, duration, grace, fee, toParentOwner)
+	return settings, settings.Validate()
+}
@@ -18,6 +18,11 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, lease := range data.Leases {
+		if err := k.SetNameLease(ctx, lease); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis exports the current keeper state of the name module.
@@ -34,5 +39,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	if err := k.IterateRecords(ctx, types.NameKeyPrefix, appendToRecords); err != nil {
 		panic(err)
 	}
-	return types.NewGenesisState(params, records)
+	leases, err := k.GetAllNameLeases(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return types.NewGenesisState(params, records, leases)
 }
@@ -28,21 +28,29 @@ type Keeper struct {
 	authority string
 
 	attrKeeper types.AttributeKeeper
+
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 }
 
 // NewKeeper returns a name keeper. It handles:
 // - managing a hierarchy of names
 // - enforcing permissions for name creation/deletion
+// - collecting renewal fees for leased names
 //
 // CONTRACT: the parameter Subspace must have the param key table already initialized
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	return Keeper{
-		storeKey:  key,
-		cdc:       cdc,
-		authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		storeKey:    key,
+		cdc:         cdc,
+		authority:   authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		bankKeeper:  bankKeeper,
+		distrKeeper: distrKeeper,
 	}
 }
 
@@ -138,6 +146,13 @@ func (k Keeper) UpdateNameRecord(ctx sdk.Context, name string, addr sdk.AccAddre
 		oldAddrKey = append(oldAddrKey, oldNameKeyPre...)
 		store := ctx.KVStore(k.storeKey)
 		store.Delete(oldAddrKey)
+
+		// The attribute issuers of a name were granted by its previous owner, so they don't carry over.
+		if k.attrKeeper != nil {
+			if err = k.attrKeeper.RevokeAllAttributeIssuers(ctx, name, oldAddr); err != nil {
+				return err
+			}
+		}
 	}
 
 	if err = k.addRecord(ctx, name, addr, restrict, true); err != nil {
@@ -229,6 +244,18 @@ func (k Keeper) DeleteRecord(ctx sdk.Context, name string) error {
 	if store.Has(addrPrefix) {
 		store.Delete(addrPrefix)
 	}
+	// Delete the parent name index record
+	childKey, err := types.GetChildNameKey(name)
+	if err != nil {
+		return err
+	}
+	if childKey != nil {
+		store.Delete(childKey)
+	}
+	// Delete any lease of the name
+	if err = k.DeleteNameLease(ctx, name); err != nil {
+		return err
+	}
 
 	nameUnboundEvent := types.NewEventNameUnbound(record.Address, name, record.Restricted)
 
@@ -305,10 +332,42 @@ func (k Keeper) addRecord(ctx sdk.Context, name string, addr sdk.AccAddress, res
 	}
 	addrPrefix = append(addrPrefix, key...) // [0x04] :: [addr-bytes] :: [name-key-bytes]
 	store.Set(addrPrefix, bz)
+	// And by parent name
+	childKey, err := types.GetChildNameKey(name)
+	if err != nil {
+		return err
+	}
+	if childKey != nil {
+		store.Set(childKey, []byte(name)) // [0x09] :: [parent-name-key-bytes] :: [name-key-bytes]
+	}
 
 	return nil
 }
 
+// HasChildNames returns true if any names are bound under the given name.
+func (k Keeper) HasChildNames(ctx sdk.Context, name string) (bool, error) {
+	keyPrefix, err := types.GetChildNameKeyPrefix(name)
+	if err != nil {
+		return false, err
+	}
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), keyPrefix)
+	defer iterator.Close()
+	return iterator.Valid(), nil
+}
+
+// buildChildNameIndex indexes all of the existing names by their parent name.
+func (k Keeper) buildChildNameIndex(ctx sdk.Context) error {
+	store := ctx.KVStore(k.storeKey)
+	return k.IterateRecords(ctx, types.NameKeyPrefix, func(record types.NameRecord) error {
+		childKey, err := types.GetChildNameKey(record.Name)
+		if err != nil || childKey == nil {
+			return err
+		}
+		store.Set(childKey, []byte(record.Name))
+		return nil
+	})
+}
+
 // DeleteInvalidAddressIndexEntries is only for the rust upgrade. It goes over all the address -> name entries and
 // deletes any that are no longer accurate.
 func (k Keeper) DeleteInvalidAddressIndexEntries(ctx sdk.Context) {
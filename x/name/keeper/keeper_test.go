@@ -104,8 +104,10 @@ func (s *KeeperTestSuite) TestSetup() {
 - address: %[3]s
   name: %[2]s
   restricted: true
+leases: []
 params:
   allow_unrestricted_names: false
+  lease_settings: []
   max_name_levels: 16
   max_segment_length: 16
   min_segment_length: 2
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// GetNameLease returns the lease of a name, or nil if the name isn't leased.
+func (k Keeper) GetNameLease(ctx sdk.Context, name string) (*types.NameLease, error) {
+	key, err := types.GetNameLeaseKey(name)
+	if err != nil {
+		return nil, err
+	}
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil, nil
+	}
+	lease := &types.NameLease{}
+	if err = k.cdc.Unmarshal(bz, lease); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// SetNameLease stores the lease of a name and indexes it by its release time, replacing any existing lease.
+func (k Keeper) SetNameLease(ctx sdk.Context, lease types.NameLease) error {
+	if err := lease.Validate(); err != nil {
+		return err
+	}
+	if err := k.DeleteNameLease(ctx, lease.Name); err != nil {
+		return err
+	}
+	key, err := types.GetNameLeaseKey(lease.Name)
+	if err != nil {
+		return err
+	}
+	indexKey, err := types.GetLeaseReleaseKey(lease.ReleaseTime, lease.Name)
+	if err != nil {
+		return err
+	}
+	bz, err := k.cdc.Marshal(&lease)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, bz)
+	store.Set(indexKey, []byte(lease.Name))
+	return nil
+}
+
+// DeleteNameLease removes the lease of a name (and its release time index entry) if there is one.
+func (k Keeper) DeleteNameLease(ctx sdk.Context, name string) error {
+	existing, err := k.GetNameLease(ctx, name)
+	if err != nil || existing == nil {
+		return err
+	}
+	key, err := types.GetNameLeaseKey(name)
+	if err != nil {
+		return err
+	}
+	indexKey, err := types.GetLeaseReleaseKey(existing.ReleaseTime, name)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(key)
+	store.Delete(indexKey)
+	return nil
+}
+
+// IterateNameLeases iterates over all the name leases in order of release time.
+// The callback should return true to stop iterating.
+func (k Keeper) IterateNameLeases(ctx sdk.Context, handle func(lease types.NameLease) (stop bool, err error)) error {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LeaseReleaseKeyPrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lease, err := k.GetNameLease(ctx, string(iterator.Value()))
+		if err != nil {
+			return err
+		}
+		if lease == nil {
+			continue
+		}
+		stop, err := handle(*lease)
+		if err != nil {
+			return err
+		}
+		if stop {
+			break
+		}
+	}
+	return nil
+}
+
+// GetAllNameLeases returns all the name leases in order of release time.
+func (k Keeper) GetAllNameLeases(ctx sdk.Context) ([]types.NameLease, error) {
+	var leases []types.NameLease
+	err := k.IterateNameLeases(ctx, func(lease types.NameLease) (bool, error) {
+		leases = append(leases, lease)
+		return false, nil
+	})
+	return leases, err
+}
+
+// StartNameLease starts a lease of a newly bound name if its parent has lease settings.
+// Returns nil if the name isn't leased.
+func (k Keeper) StartNameLease(ctx sdk.Context, name string, addr sdk.AccAddress) (*types.NameLease, error) {
+	settings := k.GetParams(ctx).GetLeaseSettingsFor(types.ParentName(name))
+	if settings == nil {
+		return nil, nil
+	}
+	lease := types.NewNameLease(name, ctx.BlockTime(), *settings)
+	if err := k.SetNameLease(ctx, lease); err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventNameLeaseStarted(addr.String(), lease)); err != nil {
+		return nil, err
+	}
+	return &lease, nil
+}
+
+// RenewNameLease extends the lease of a name by its parent's lease duration and collects the renewal fee from the owner.
+// The fee is paid to the owner of the parent name, or the community pool, depending on the lease settings.
+func (k Keeper) RenewNameLease(ctx sdk.Context, name string, owner sdk.AccAddress) (*types.NameLease, error) {
+	record, err := k.GetRecordByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if record.Address != owner.String() {
+		return nil, fmt.Errorf("name %q is not owned by %s", name, owner)
+	}
+	parent := types.ParentName(name)
+	settings := k.GetParams(ctx).GetLeaseSettingsFor(parent)
+	if settings == nil {
+		return nil, fmt.Errorf("names under %q are not leased", parent)
+	}
+	existing, err := k.GetNameLease(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		// A name bound before its parent got lease settings is leased from its first renewal.
+		existing = &types.NameLease{Name: name, Expiration: ctx.BlockTime(), ReleaseTime: ctx.BlockTime()}
+	}
+
+	if !settings.RenewalFee.IsZero() {
+		if err = k.payRenewalFee(ctx, parent, owner, *settings); err != nil {
+			return nil, fmt.Errorf("could not pay renewal fee %s for %q: %w", settings.RenewalFee, name, err)
+		}
+	}
+
+	lease := existing.Renew(ctx.BlockTime(), *settings)
+	if err = k.SetNameLease(ctx, lease); err != nil {
+		return nil, err
+	}
+	if err = ctx.EventManager().EmitTypedEvent(types.NewEventNameLeaseRenewed(owner.String(), lease, settings.RenewalFee)); err != nil {
+		return nil, err
+	}
+	return &lease, nil
+}
+
+// payRenewalFee sends a renewal fee from the owner of a leased name to wherever the lease settings say it should go.
+func (k Keeper) payRenewalFee(ctx sdk.Context, parent string, owner sdk.AccAddress, settings types.LeaseSettings) error {
+	if !settings.FeeToParentOwner {
+		return k.distrKeeper.FundCommunityPool(ctx, settings.RenewalFee, owner)
+	}
+	parentRecord, err := k.GetRecordByName(ctx, parent)
+	if err != nil {
+		return fmt.Errorf("could not get parent name %q: %w", parent, err)
+	}
+	parentAddr, err := sdk.AccAddressFromBech32(parentRecord.Address)
+	if err != nil {
+		return fmt.Errorf("invalid parent name %q address: %w", parent, err)
+	}
+	return k.bankKeeper.SendCoins(ctx, owner, parentAddr, settings.RenewalFee)
+}
+
+// MaxNamesReleasedPerBlock is the maximum number of name leases that will be released in a single block.
+// Any other leases due for release are left for the following blocks.
+const MaxNamesReleasedPerBlock = 1000
+
+// ReleaseExpiredNames deletes up to MaxNamesReleasedPerBlock leased names whose grace period has ended, and purges
+// their attributes. If a name's parent no longer has lease settings, only the lease is removed, and the name is kept.
+// A lease is always removed, even if its name cannot be released, so that it isn't retried in every block.
+func (k Keeper) ReleaseExpiredNames(ctx sdk.Context) {
+	var toRelease []types.NameLease
+	err := k.IterateNameLeases(ctx, func(lease types.NameLease) (bool, error) {
+		if lease.ReleaseTime.After(ctx.BlockTime()) {
+			return true, nil
+		}
+		toRelease = append(toRelease, lease)
+		return len(toRelease) >= MaxNamesReleasedPerBlock, nil
+	})
+	if err != nil {
+		k.Logger(ctx).Error("could not iterate name leases", "err", err)
+	}
+
+	params := k.GetParams(ctx)
+	for _, lease := range toRelease {
+		if params.GetLeaseSettingsFor(types.ParentName(lease.Name)) == nil {
+			if err = k.DeleteNameLease(ctx, lease.Name); err != nil {
+				k.Logger(ctx).Error("could not delete name lease", "name", lease.Name, "err", err)
+			}
+			continue
+		}
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err = k.releaseName(cacheCtx, lease.Name); err != nil {
+			k.Logger(ctx).Error("could not release expired name", "name", lease.Name, "err", err)
+			k.dropUnreleasedLease(ctx, lease.Name, err)
+			continue
+		}
+		writeCache()
+	}
+}
+
+// dropUnreleasedLease removes the lease of a name that could not be released and emits an EventNameLeaseReleaseFailed.
+// The name is kept, and is no longer leased.
+func (k Keeper) dropUnreleasedLease(ctx sdk.Context, name string, releaseErr error) {
+	if err := k.DeleteNameLease(ctx, name); err != nil {
+		k.Logger(ctx).Error("could not delete name lease", "name", name, "err", err)
+		return
+	}
+	var address string
+	if record, err := k.GetRecordByName(ctx, name); err == nil {
+		address = record.Address
+	}
+	if err := ctx.EventManager().EmitTypedEvent(types.NewEventNameLeaseReleaseFailed(address, name, releaseErr)); err != nil {
+		k.Logger(ctx).Error("could not emit lease release failed event", "name", name, "err", err)
+	}
+}
+
+// releaseName unbinds a name, purges its attributes, and emits an EventNameLeaseReleased.
+// A name that still has child names is not released.
+func (k Keeper) releaseName(ctx sdk.Context, name string) error {
+	record, err := k.GetRecordByName(ctx, name)
+	if err != nil {
+		// The name is already gone, so there's nothing to release but the lease.
+		return k.DeleteNameLease(ctx, name)
+	}
+	hasChildren, err := k.HasChildNames(ctx, name)
+	if err != nil {
+		return err
+	}
+	if hasChildren {
+		return fmt.Errorf("name %q has child names", name)
+	}
+	address, err := sdk.AccAddressFromBech32(record.Address)
+	if err != nil {
+		return err
+	}
+	if err = k.DeleteRecord(ctx, name); err != nil {
+		return err
+	}
+	if k.attrKeeper != nil {
+		if err = k.attrKeeper.PurgeAttribute(ctx, name, address); err != nil {
+			return err
+		}
+	}
+	return ctx.EventManager().EmitTypedEvent(types.NewEventNameLeaseReleased(record.Address, name))
+}
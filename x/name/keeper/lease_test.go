@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
+
+	"github.com/provenance-io/provenance/x/name/keeper"
+	"github.com/provenance-io/provenance/x/name/types"
+)
+
+// setLeaseSettings adds lease settings to the name params.
+func (s *MsgServerTestSuite) setLeaseSettings(settings ...types.LeaseSettings) {
+	params := s.app.NameKeeper.GetParams(s.ctx)
+	params.LeaseSettings = settings
+	s.app.NameKeeper.SetParams(s.ctx, params)
+}
+
+// bindName binds a name under a parent using the msg server.
+func (s *MsgServerTestSuite) bindName(name, parent string, owner sdk.AccAddress) {
+	msg := types.NewMsgBindNameRequest(types.NewNameRecord(name, owner, false), types.NewNameRecord(parent, s.owner1Addr, false))
+	_, err := s.msgServer.BindName(s.ctx, msg)
+	s.Require().NoError(err, "BindName %s.%s", name, parent)
+}
+
+func (s *MsgServerTestSuite) TestBindLeasedName() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start).WithEventManager(sdk.NewEventManager())
+	s.setLeaseSettings(types.NewLeaseSettings("name", 100, 10, nil, false))
+
+	s.bindName("leased", "name", s.owner2Addr)
+	lease, err := s.app.NameKeeper.GetNameLease(s.ctx, "leased.name")
+	s.Require().NoError(err, "GetNameLease")
+	s.Require().NotNil(lease, "GetNameLease")
+	s.Assert().Equal(start.Add(100*time.Second), lease.Expiration, "Expiration")
+	s.Assert().Equal(start.Add(110*time.Second), lease.ReleaseTime, "ReleaseTime")
+	s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventNameLeaseStarted(s.owner2, *lease)), "lease started event")
+
+	// Grandchildren of a leased parent are not leased.
+	s.bindName("sub", "leased.name", s.owner2Addr)
+	lease, err = s.app.NameKeeper.GetNameLease(s.ctx, "sub.leased.name")
+	s.Require().NoError(err, "GetNameLease sub")
+	s.Assert().Nil(lease, "GetNameLease sub")
+
+	res, err := s.app.NameKeeper.Lease(s.ctx, &types.QueryLeaseRequest{Name: "leased.name"})
+	s.Require().NoError(err, "Lease query")
+	s.Assert().False(res.Expired, "Lease query Expired")
+	_, err = s.app.NameKeeper.Lease(s.ctx, &types.QueryLeaseRequest{Name: "example.name"})
+	s.Assert().ErrorContains(err, "name \"example.name\" is not leased", "Lease query unleased name")
+
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "leased.name"), "DeleteRecord")
+	lease, err = s.app.NameKeeper.GetNameLease(s.ctx, "leased.name")
+	s.Require().NoError(err, "GetNameLease after delete")
+	s.Assert().Nil(lease, "GetNameLease after delete")
+	leases, err := s.app.NameKeeper.Leases(s.ctx, &types.QueryLeasesRequest{})
+	s.Require().NoError(err, "Leases query after delete")
+	s.Assert().Empty(leases.Leases, "Leases query after delete")
+}
+
+func (s *MsgServerTestSuite) TestRenewName() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	fee := sdk.NewCoins(sdk.NewInt64Coin("nhash", 50))
+	s.setLeaseSettings(
+		types.NewLeaseSettings("name", 100, 10, fee, true),
+		types.NewLeaseSettings("example.name", 100, 10, fee, false),
+	)
+	s.Require().NoError(banktestutil.FundAccount(s.ctx, s.app.BankKeeper, s.owner2Addr, sdk.NewCoins(sdk.NewInt64Coin("nhash", 120))), "FundAccount")
+
+	s.bindName("leased", "name", s.owner2Addr)
+	s.bindName("pooled", "example.name", s.owner2Addr)
+
+	_, err := s.msgServer.RenewName(s.ctx, types.NewMsgRenewNameRequest("leased.name", s.owner1))
+	s.Assert().ErrorContains(err, "msg sender cannot renew name", "RenewName by non-owner")
+	_, err = s.msgServer.RenewName(s.ctx, types.NewMsgRenewNameRequest("name", s.owner1))
+	s.Assert().ErrorContains(err, "names under \"\" are not leased", "RenewName of name without lease settings")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(50 * time.Second)).WithEventManager(sdk.NewEventManager())
+	res, err := s.msgServer.RenewName(s.ctx, types.NewMsgRenewNameRequest("leased.name", s.owner2))
+	s.Require().NoError(err, "RenewName to parent owner")
+	s.Assert().Equal(start.Add(200*time.Second), res.Expiration, "Expiration after early renewal")
+	s.Assert().Equal("50nhash", s.app.BankKeeper.GetBalance(s.ctx, s.owner1Addr, "nhash").String(), "parent owner balance")
+	lease, err := s.app.NameKeeper.GetNameLease(s.ctx, "leased.name")
+	s.Require().NoError(err, "GetNameLease")
+	s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventNameLeaseRenewed(s.owner2, *lease, fee)), "lease renewed event")
+
+	// Renewing in the grace period extends from the block time.
+	s.ctx = s.ctx.WithBlockTime(start.Add(105 * time.Second))
+	poolBefore, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err, "FeePool before")
+	res, err = s.msgServer.RenewName(s.ctx, types.NewMsgRenewNameRequest("pooled.example.name", s.owner2))
+	s.Require().NoError(err, "RenewName to community pool")
+	s.Assert().Equal(start.Add(205*time.Second), res.Expiration, "Expiration after renewal in grace period")
+	poolAfter, err := s.app.DistrKeeper.FeePool.Get(s.ctx)
+	s.Require().NoError(err, "FeePool after")
+	s.Assert().Equal("50.000000000000000000nhash", poolAfter.CommunityPool.Sub(poolBefore.CommunityPool).String(), "community pool increase")
+
+	// The owner can't afford another renewal, and the lease is left alone.
+	_, err = s.msgServer.RenewName(s.ctx, types.NewMsgRenewNameRequest("leased.name", s.owner2))
+	s.Assert().ErrorContains(err, "could not pay renewal fee 50nhash for \"leased.name\"", "RenewName without funds")
+	lease, err = s.app.NameKeeper.GetNameLease(s.ctx, "leased.name")
+	s.Require().NoError(err, "GetNameLease after failed renewal")
+	s.Assert().Equal(start.Add(200*time.Second), lease.Expiration, "Expiration after failed renewal")
+}
+
+func (s *MsgServerTestSuite) TestReleaseExpiredNames() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseSettings(types.NewLeaseSettings("name", 100, 10, nil, false))
+
+	s.bindName("first", "name", s.owner2Addr)
+	s.ctx = s.ctx.WithBlockTime(start.Add(5 * time.Second))
+	s.bindName("second", "name", s.owner2Addr)
+
+	leases, err := s.app.NameKeeper.Leases(s.ctx, &types.QueryLeasesRequest{})
+	s.Require().NoError(err, "Leases query")
+	s.Require().Len(leases.Leases, 2, "Leases query")
+	s.Assert().Equal("first.name", leases.Leases[0].Name, "first lease released")
+	s.Assert().Equal("second.name", leases.Leases[1].Name, "second lease released")
+
+	// Expired, but still in the grace period.
+	s.ctx = s.ctx.WithBlockTime(start.Add(105 * time.Second))
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "first.name"), "first.name exists in grace period")
+	res, err := s.app.NameKeeper.Lease(s.ctx, &types.QueryLeaseRequest{Name: "first.name"})
+	s.Require().NoError(err, "Lease query in grace period")
+	s.Assert().True(res.Expired, "Lease query Expired in grace period")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(110 * time.Second)).WithEventManager(sdk.NewEventManager())
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	s.Assert().False(s.app.NameKeeper.NameExists(s.ctx, "first.name"), "first.name exists after grace period")
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "second.name"), "second.name exists")
+	s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), types.NewEventNameLeaseReleased(s.owner2, "first.name")), "lease released event")
+
+	// Once the parent is no longer leased, the name is kept and only the lease is dropped.
+	s.setLeaseSettings()
+	s.ctx = s.ctx.WithBlockTime(start.Add(200 * time.Second))
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "second.name"), "second.name exists after lease settings removed")
+	lease, err := s.app.NameKeeper.GetNameLease(s.ctx, "second.name")
+	s.Require().NoError(err, "GetNameLease second.name")
+	s.Assert().Nil(lease, "GetNameLease second.name")
+}
+
+func (s *MsgServerTestSuite) TestReleaseExpiredNamesFailure() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	settings := types.NewLeaseSettings("name", 100, 10, nil, false)
+	s.setLeaseSettings(settings)
+
+	// A record that can't be unbound since its address is invalid.
+	record := types.NameRecord{Name: "broken.name", Address: "not-an-address"}
+	bz, err := s.app.AppCodec().Marshal(&record)
+	s.Require().NoError(err, "Marshal name record")
+	key, err := types.GetNameKeyPrefix(record.Name)
+	s.Require().NoError(err, "GetNameKeyPrefix")
+	s.ctx.KVStore(s.app.GetKey(types.StoreKey)).Set(key, bz)
+	s.Require().NoError(s.app.NameKeeper.SetNameLease(s.ctx, types.NewNameLease(record.Name, start, settings)), "SetNameLease")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(110 * time.Second)).WithEventManager(sdk.NewEventManager())
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, record.Name), "broken.name exists after failed release")
+	lease, err := s.app.NameKeeper.GetNameLease(s.ctx, record.Name)
+	s.Require().NoError(err, "GetNameLease broken.name")
+	s.Assert().Nil(lease, "GetNameLease broken.name")
+	_, expErr := sdk.AccAddressFromBech32(record.Address)
+	s.Require().Error(expErr, "AccAddressFromBech32")
+	expEvent := types.NewEventNameLeaseReleaseFailed(record.Address, record.Name, expErr)
+	s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "lease release failed event")
+}
+
+func (s *MsgServerTestSuite) TestReleaseExpiredNamesWithChildNames() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	s.setLeaseSettings(types.NewLeaseSettings("name", 100, 10, nil, false))
+
+	s.bindName("leased", "name", s.owner2Addr)
+	s.bindName("sub", "leased.name", s.owner2Addr)
+	hasChildren, err := s.app.NameKeeper.HasChildNames(s.ctx, "leased.name")
+	s.Require().NoError(err, "HasChildNames leased.name")
+	s.Assert().True(hasChildren, "HasChildNames leased.name")
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(110 * time.Second)).WithEventManager(sdk.NewEventManager())
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "leased.name"), "leased.name exists after failed release")
+	s.Assert().True(s.app.NameKeeper.NameExists(s.ctx, "sub.leased.name"), "sub.leased.name exists after failed release")
+	lease, err := s.app.NameKeeper.GetNameLease(s.ctx, "leased.name")
+	s.Require().NoError(err, "GetNameLease leased.name")
+	s.Assert().Nil(lease, "GetNameLease leased.name")
+	expEvent := types.NewEventNameLeaseReleaseFailed(s.owner2, "leased.name", fmt.Errorf("name %q has child names", "leased.name"))
+	s.Assert().True(s.containsMessage(s.ctx.EventManager().ABCIEvents(), expEvent), "lease release failed event")
+
+	s.Require().NoError(s.app.NameKeeper.DeleteRecord(s.ctx, "sub.leased.name"), "DeleteRecord sub.leased.name")
+	hasChildren, err = s.app.NameKeeper.HasChildNames(s.ctx, "leased.name")
+	s.Require().NoError(err, "HasChildNames leased.name after delete")
+	s.Assert().False(hasChildren, "HasChildNames leased.name after delete")
+}
+
+func (s *MsgServerTestSuite) TestReleaseExpiredNamesPerBlockLimit() {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	s.ctx = s.ctx.WithBlockTime(start)
+	settings := types.NewLeaseSettings("name", 100, 10, nil, false)
+	s.setLeaseSettings(settings)
+
+	for i := 0; i <= keeper.MaxNamesReleasedPerBlock; i++ {
+		lease := types.NewNameLease(fmt.Sprintf("gone%d.name", i), start, settings)
+		s.Require().NoError(s.app.NameKeeper.SetNameLease(s.ctx, lease), "SetNameLease %s", lease.Name)
+	}
+
+	s.ctx = s.ctx.WithBlockTime(start.Add(110 * time.Second))
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	leases, err := s.app.NameKeeper.GetAllNameLeases(s.ctx)
+	s.Require().NoError(err, "GetAllNameLeases after first block")
+	s.Assert().Len(leases, 1, "leases after first block")
+
+	s.app.NameKeeper.ReleaseExpiredNames(s.ctx)
+	leases, err = s.app.NameKeeper.GetAllNameLeases(s.ctx)
+	s.Require().NoError(err, "GetAllNameLeases after second block")
+	s.Assert().Empty(leases, "leases after second block")
+}
@@ -1,5 +1,9 @@
 package keeper
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	keeper Keeper
@@ -9,3 +13,13 @@ type Migrator struct {
 func NewMigrator(keeper Keeper) Migrator {
 	return Migrator{keeper: keeper}
 }
+
+// Migrate2to3 builds the child name index from the existing names.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	ctx.Logger().Info("Building name child name index.")
+	if err := m.keeper.buildChildNameIndex(ctx); err != nil {
+		return err
+	}
+	ctx.Logger().Info("Done building name child name index.")
+	return nil
+}
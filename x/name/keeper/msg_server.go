@@ -74,6 +74,10 @@ func (s msgServer) BindName(goCtx context.Context, msg *types.MsgBindNameRequest
 		ctx.Logger().Error("unable to bind name", "err", err)
 		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
+	if _, err := s.Keeper.StartNameLease(ctx, name, address); err != nil {
+		ctx.Logger().Error("unable to lease name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
 
 	// key: modulename+name+bind
 	defer func() {
@@ -218,3 +222,29 @@ func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RenewName extends the lease of a name, collecting the renewal fee from its owner.
+func (s msgServer) RenewName(goCtx context.Context, msg *types.MsgRenewNameRequest) (*types.MsgRenewNameResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	name, err := s.Keeper.Normalize(ctx, msg.Name)
+	if err != nil {
+		ctx.Logger().Error("invalid name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		ctx.Logger().Error("invalid address", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	if !s.Keeper.ResolvesTo(ctx, name, owner) {
+		ctx.Logger().Error("msg sender cannot renew name", "name", name)
+		return nil, sdkerrors.ErrUnauthorized.Wrap("msg sender cannot renew name")
+	}
+	lease, err := s.Keeper.RenewNameLease(ctx, name, owner)
+	if err != nil {
+		ctx.Logger().Error("unable to renew name", "err", err)
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return &types.MsgRenewNameResponse{Expiration: lease.Expiration}, nil
+}
@@ -393,6 +393,7 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				nil,
 				authority,
 			),
 			expectedEvent: types.NewEventNameParamsUpdated(
@@ -409,6 +410,7 @@ func (s *MsgServerTestSuite) TestUpdateParams() {
 				3,
 				10,
 				true,
+				nil,
 				"invalid-authority",
 			),
 			expErr: `expected "cosmos10d07y265gmmuvt4z0w9aw880jnsr700j6zn9kn" got "invalid-authority": expected gov account as only signer for proposal message`,
@@ -3,6 +3,9 @@ package keeper
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"cosmossdk.io/store/prefix"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -71,3 +74,47 @@ func (k Keeper) ReverseLookup(c context.Context, request *types.QueryReverseLook
 
 	return &types.QueryReverseLookupResponse{Name: names, Pagination: pageRes}, nil
 }
+
+// Lease returns the lease of a name.
+func (k Keeper) Lease(c context.Context, request *types.QueryLeaseRequest) (*types.QueryLeaseResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	name, err := k.Normalize(ctx, request.Name)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	lease, err := k.GetNameLease(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if lease == nil {
+		return nil, status.Errorf(codes.NotFound, "name %q is not leased", name)
+	}
+	return &types.QueryLeaseResponse{Lease: *lease, Expired: lease.IsExpired(ctx.BlockTime())}, nil
+}
+
+// Leases returns all name leases in order of release time.
+func (k Keeper) Leases(c context.Context, request *types.QueryLeasesRequest) (*types.QueryLeasesResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	leases := make([]types.NameLease, 0)
+	leaseStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LeaseReleaseKeyPrefix)
+	pageRes, err := query.Paginate(leaseStore, request.Pagination, func(_ []byte, value []byte) error {
+		lease, err := k.GetNameLease(ctx, string(value))
+		if err != nil {
+			return err
+		}
+		if lease != nil {
+			leases = append(leases, *lease)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryLeasesResponse{Leases: leases, Pagination: pageRes}, nil
+}
@@ -29,6 +29,15 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &nameB)
 
 			return fmt.Sprintf("Addr: A:[%v], B:[%v]\n", nameA, nameB)
+		case bytes.HasPrefix(kvA.Key, types.NameLeaseKeyPrefix):
+			var leaseA, leaseB types.NameLease
+
+			cdc.MustUnmarshal(kvA.Value, &leaseA)
+			cdc.MustUnmarshal(kvB.Value, &leaseB)
+
+			return fmt.Sprintf("Lease: A:[%v], B:[%v]\n", leaseA, leaseB)
+		case bytes.HasPrefix(kvA.Key, types.LeaseReleaseKeyPrefix):
+			return fmt.Sprintf("Release: A:[%s], B:[%s]\n", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("unexpected %s key %X (%s)", types.ModuleName, kvA.Key, kvA.Key))
 		}
@@ -3,6 +3,7 @@ package simulation_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,11 +20,14 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	testNameRecord := types.NewNameRecord("test", sdk.AccAddress{}, true)
+	testNameLease := types.NewNameLease("test.leased", time.Unix(1000, 0).UTC(), types.NewLeaseSettings("leased", 100, 10, nil, false))
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.NameKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
 			{Key: types.AddressKeyPrefix, Value: cdc.MustMarshal(&testNameRecord)},
+			{Key: types.NameLeaseKeyPrefix, Value: cdc.MustMarshal(&testNameLease)},
+			{Key: types.LeaseReleaseKeyPrefix, Value: []byte(testNameLease.Name)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -34,6 +38,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Name Record", fmt.Sprintf("Name: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
 		{"Address Cache", fmt.Sprintf("Addr: A:[%v], B:[%v]\n", testNameRecord, testNameRecord)},
+		{"Name Lease", fmt.Sprintf("Lease: A:[%v], B:[%v]\n", testNameLease, testNameLease)},
+		{"Lease Release Index", "Release: A:[test.leased], B:[test.leased]\n"},
 		{"other", ""},
 	}
 
@@ -1,6 +1,11 @@
 package types
 
-import "strconv"
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 const (
 	// EventTypeNameBound is the type of event generated when a name is bound to an address.
@@ -47,3 +52,41 @@ func NewEventNameParamsUpdated(allowUnrestrictedNames bool, maxNameLevels, minSe
 		MaxSegmentLength:       strconv.FormatUint(uint64(maxSegmentLength), 10),
 	}
 }
+
+// NewEventNameLeaseStarted returns a new instance of EventNameLeaseStarted
+func NewEventNameLeaseStarted(address string, lease NameLease) *EventNameLeaseStarted {
+	return &EventNameLeaseStarted{
+		Name:        lease.Name,
+		Address:     address,
+		Expiration:  lease.Expiration.UTC().Format(time.RFC3339),
+		ReleaseTime: lease.ReleaseTime.UTC().Format(time.RFC3339),
+	}
+}
+
+// NewEventNameLeaseRenewed returns a new instance of EventNameLeaseRenewed
+func NewEventNameLeaseRenewed(address string, lease NameLease, fee sdk.Coins) *EventNameLeaseRenewed {
+	return &EventNameLeaseRenewed{
+		Name:        lease.Name,
+		Address:     address,
+		Expiration:  lease.Expiration.UTC().Format(time.RFC3339),
+		ReleaseTime: lease.ReleaseTime.UTC().Format(time.RFC3339),
+		Fee:         fee.String(),
+	}
+}
+
+// NewEventNameLeaseReleased returns a new instance of EventNameLeaseReleased
+func NewEventNameLeaseReleased(address string, name string) *EventNameLeaseReleased {
+	return &EventNameLeaseReleased{
+		Name:    name,
+		Address: address,
+	}
+}
+
+// NewEventNameLeaseReleaseFailed returns a new instance of EventNameLeaseReleaseFailed
+func NewEventNameLeaseReleaseFailed(address string, name string, err error) *EventNameLeaseReleaseFailed {
+	return &EventNameLeaseReleaseFailed{
+		Name:    name,
+		Address: address,
+		Error:   err.Error(),
+	}
+}
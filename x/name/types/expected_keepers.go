@@ -1,6 +1,8 @@
 package types
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -14,4 +16,15 @@ type ParamSubspace interface {
 type AttributeKeeper interface {
 	PurgeAttribute(ctx sdk.Context, name string, owner sdk.AccAddress) error
 	AccountsByAttribute(ctx sdk.Context, name string) (addresses []sdk.AccAddress, err error)
+	RevokeAllAttributeIssuers(ctx sdk.Context, name string, previousOwner sdk.AccAddress) error
+}
+
+// BankKeeper defines the expected bank keeper interface (noalias)
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected distribution keeper interface (noalias)
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 }
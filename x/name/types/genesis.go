@@ -12,10 +12,11 @@ import (
 type NameRecords []NameRecord
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, nameRecords NameRecords) *GenesisState {
+func NewGenesisState(params Params, nameRecords NameRecords, leases []NameLease) *GenesisState {
 	return &GenesisState{
 		Params:   params,
 		Bindings: nameRecords,
+		Leases:   leases,
 	}
 }
 
@@ -50,6 +51,22 @@ func (state GenesisState) Validate() error {
 			return fmt.Errorf("address cannot be empty")
 		}
 	}
+	if err := state.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	for _, lease := range state.Leases {
+		if err := lease.Validate(); err != nil {
+			return err
+		}
+		if seen[lease.Name] {
+			return fmt.Errorf("duplicate lease of %s", lease.Name)
+		}
+		seen[lease.Name] = true
+		if !NameRecords(state.Bindings).Contains(lease.Name) {
+			return fmt.Errorf("lease of %s does not have a binding", lease.Name)
+		}
+	}
 	return nil
 }
 
@@ -2,8 +2,10 @@ package types
 
 import (
 	"crypto/sha256"
+	"encoding/binary"
 	"fmt"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -27,6 +29,12 @@ var (
 	AddressKeyPrefix = []byte{0x05}
 	// NameParamStoreKey key for marker module's params
 	NameParamStoreKey = []byte{0x06}
+	// NameLeaseKeyPrefix is a prefix added to keys for name leases.
+	NameLeaseKeyPrefix = []byte{0x07}
+	// LeaseReleaseKeyPrefix is a prefix added to keys for indexing name leases by release time.
+	LeaseReleaseKeyPrefix = []byte{0x08}
+	// ChildNameKeyPrefix is a prefix added to keys for indexing names by their parent name.
+	ChildNameKeyPrefix = []byte{0x09}
 )
 
 // GetNameKeyPrefix converts a name into key format.
@@ -35,6 +43,38 @@ func GetNameKeyPrefix(name string) (key []byte, err error) {
 	return getNamePrefixByType(name, key)
 }
 
+// GetNameLeaseKey returns a store key for the lease of a name.
+func GetNameLeaseKey(name string) ([]byte, error) {
+	return getNamePrefixByType(name, NameLeaseKeyPrefix)
+}
+
+// GetLeaseReleaseKey returns a store key for indexing the lease of a name by its release time.
+func GetLeaseReleaseKey(releaseTime time.Time, name string) ([]byte, error) {
+	key := make([]byte, 0, len(LeaseReleaseKeyPrefix)+8+sha256.Size)
+	key = append(key, LeaseReleaseKeyPrefix...)
+	key = binary.BigEndian.AppendUint64(key, uint64(releaseTime.Unix()))
+	return getNamePrefixByType(name, key)
+}
+
+// GetChildNameKeyPrefix returns a store key prefix for the index entries of a name's child names.
+func GetChildNameKeyPrefix(parent string) ([]byte, error) {
+	return getNamePrefixByType(parent, ChildNameKeyPrefix)
+}
+
+// GetChildNameKey returns a store key for indexing a name by its parent name.
+// Returns nil if the name does not have a parent.
+func GetChildNameKey(name string) ([]byte, error) {
+	parent := ParentName(name)
+	if len(parent) == 0 {
+		return nil, nil
+	}
+	key, err := GetChildNameKeyPrefix(parent)
+	if err != nil {
+		return nil, err
+	}
+	return getNamePrefixByType(name, key)
+}
+
 // internal common code for legacy and current way.
 func getNamePrefixByType(name string, key []byte) ([]byte, error) {
 	var err error
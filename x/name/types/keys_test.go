@@ -1,9 +1,12 @@
 package types
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256r1"
@@ -109,6 +112,54 @@ func (s *NameKeyTestSuite) TestAddressKeyPrefix() {
 	s.Assert().Equal(AddressKeyPrefix, key[0:1])
 }
 
+func (s *NameKeyTestSuite) TestLeaseKeys() {
+	nameKey, err := GetNameKeyPrefix("name.domain")
+	s.Require().NoError(err)
+
+	key, err := GetNameLeaseKey("name.domain")
+	s.Require().NoError(err)
+	s.Assert().Equal(NameLeaseKeyPrefix, key[0:1])
+	s.Assert().Equal(nameKey[1:], key[1:], "name hash")
+
+	releaseTime := time.Unix(1700000000, 0)
+	key, err = GetLeaseReleaseKey(releaseTime, "name.domain")
+	s.Require().NoError(err)
+	s.Assert().Equal(LeaseReleaseKeyPrefix, key[0:1])
+	s.Assert().Equal(uint64(1700000000), binary.BigEndian.Uint64(key[1:9]), "release time")
+	s.Assert().Equal(nameKey[1:], key[9:], "name hash")
+
+	earlier, err := GetLeaseReleaseKey(releaseTime.Add(-time.Second), "zzz.domain")
+	s.Require().NoError(err)
+	s.Assert().Negative(bytes.Compare(earlier, key), "earlier release time should sort first")
+
+	_, err = GetLeaseReleaseKey(releaseTime, "")
+	s.Assert().Error(err)
+}
+
+func (s *NameKeyTestSuite) TestChildNameKeys() {
+	parentKey, err := GetNameKeyPrefix("domain")
+	s.Require().NoError(err)
+	nameKey, err := GetNameKeyPrefix("name.domain")
+	s.Require().NoError(err)
+
+	prefix, err := GetChildNameKeyPrefix("domain")
+	s.Require().NoError(err)
+	s.Assert().Equal(ChildNameKeyPrefix, prefix[0:1])
+	s.Assert().Equal(parentKey[1:], prefix[1:], "parent name hash")
+
+	key, err := GetChildNameKey("name.domain")
+	s.Require().NoError(err)
+	s.Assert().Equal(prefix, key[:len(prefix)], "child name key prefix")
+	s.Assert().Equal(nameKey[1:], key[len(prefix):], "name hash")
+
+	key, err = GetChildNameKey("domain")
+	s.Assert().NoError(err)
+	s.Assert().Nil(key, "key of a root name")
+
+	_, err = GetChildNameKey("name..domain")
+	s.Assert().Error(err)
+}
+
 func mustHexDecode(h string) []byte {
 	var err error
 	var result []byte
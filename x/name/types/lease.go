@@ -0,0 +1,122 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewLeaseSettings creates the lease settings for the child names of a parent name.
+func NewLeaseSettings(parent string, durationSeconds, gracePeriodSeconds uint64, renewalFee sdk.Coins, feeToParentOwner bool) LeaseSettings {
+	return LeaseSettings{
+		Parent:             parent,
+		DurationSeconds:    durationSeconds,
+		GracePeriodSeconds: gracePeriodSeconds,
+		RenewalFee:         renewalFee,
+		FeeToParentOwner:   feeToParentOwner,
+	}
+}
+
+// Validate performs basic stateless validity checks.
+func (s LeaseSettings) Validate() error {
+	if len(s.Parent) == 0 {
+		return errors.New("lease settings parent cannot be empty")
+	}
+	if s.Parent != NormalizeName(s.Parent) {
+		return fmt.Errorf("lease settings parent %q is not normalized", s.Parent)
+	}
+	if err := ValidateName(s.Parent); err != nil {
+		return fmt.Errorf("invalid lease settings parent %q: %w", s.Parent, err)
+	}
+	if s.DurationSeconds == 0 {
+		return fmt.Errorf("lease settings for %s: duration must be positive", s.Parent)
+	}
+	if err := s.RenewalFee.Validate(); err != nil {
+		return fmt.Errorf("lease settings for %s: invalid renewal fee: %w", s.Parent, err)
+	}
+	return nil
+}
+
+// Duration returns how long a lease lasts.
+func (s LeaseSettings) Duration() time.Duration {
+	return time.Duration(s.DurationSeconds) * time.Second
+}
+
+// GracePeriod returns how long after a lease expires that it can still be renewed.
+func (s LeaseSettings) GracePeriod() time.Duration {
+	return time.Duration(s.GracePeriodSeconds) * time.Second
+}
+
+// Equal returns true if the given lease settings are the same as these.
+func (s LeaseSettings) Equal(that LeaseSettings) bool {
+	return s.Parent == that.Parent &&
+		s.DurationSeconds == that.DurationSeconds &&
+		s.GracePeriodSeconds == that.GracePeriodSeconds &&
+		s.RenewalFee.Equal(that.RenewalFee) &&
+		s.FeeToParentOwner == that.FeeToParentOwner
+}
+
+// ValidateLeaseSettings returns an error if any of the lease settings are invalid or there's more than one for a parent.
+func ValidateLeaseSettings(leaseSettings []LeaseSettings) error {
+	seen := make(map[string]bool)
+	for _, settings := range leaseSettings {
+		if err := settings.Validate(); err != nil {
+			return err
+		}
+		if seen[settings.Parent] {
+			return fmt.Errorf("duplicate lease settings for %s", settings.Parent)
+		}
+		seen[settings.Parent] = true
+	}
+	return nil
+}
+
+// ParentName returns the name that the provided (normalized) name is bound under, or an empty string for root names.
+func ParentName(name string) string {
+	if i := strings.Index(name, "."); i >= 0 {
+		return name[i+1:]
+	}
+	return ""
+}
+
+// NewNameLease creates a lease of a name that expires after the settings' duration from the provided time.
+func NewNameLease(name string, from time.Time, settings LeaseSettings) NameLease {
+	expiration := from.Add(settings.Duration())
+	return NameLease{
+		Name:        name,
+		Expiration:  expiration,
+		ReleaseTime: expiration.Add(settings.GracePeriod()),
+	}
+}
+
+// Validate performs basic stateless validity checks.
+func (l NameLease) Validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return errors.New("lease name cannot be empty")
+	}
+	if l.Expiration.IsZero() {
+		return fmt.Errorf("lease of %s: expiration cannot be empty", l.Name)
+	}
+	if l.ReleaseTime.Before(l.Expiration) {
+		return fmt.Errorf("lease of %s: release time cannot be before expiration", l.Name)
+	}
+	return nil
+}
+
+// IsExpired returns true if the lease has expired at the provided block time.
+func (l NameLease) IsExpired(blockTime time.Time) bool {
+	return !blockTime.Before(l.Expiration)
+}
+
+// Renew returns a copy of this lease extended by the settings' duration from its expiration, or from the
+// provided block time if it has already expired.
+func (l NameLease) Renew(blockTime time.Time, settings LeaseSettings) NameLease {
+	from := l.Expiration
+	if l.IsExpired(blockTime) {
+		from = blockTime
+	}
+	return NewNameLease(l.Name, from, settings)
+}
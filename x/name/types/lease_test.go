@@ -0,0 +1,105 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestLeaseSettingsValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings LeaseSettings
+		expErr   string
+	}{
+		{
+			name:     "valid",
+			settings: NewLeaseSettings("leased.root", 100, 10, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)), true),
+		},
+		{
+			name:     "valid without fee or grace period",
+			settings: NewLeaseSettings("leased", 100, 0, nil, false),
+		},
+		{
+			name:     "empty parent",
+			settings: NewLeaseSettings("", 100, 10, nil, false),
+			expErr:   "lease settings parent cannot be empty",
+		},
+		{
+			name:     "parent not normalized",
+			settings: NewLeaseSettings("Leased", 100, 10, nil, false),
+			expErr:   "lease settings parent \"Leased\" is not normalized",
+		},
+		{
+			name:     "zero duration",
+			settings: NewLeaseSettings("leased", 0, 10, nil, false),
+			expErr:   "lease settings for leased: duration must be positive",
+		},
+		{
+			name:     "invalid fee",
+			settings: NewLeaseSettings("leased", 100, 10, sdk.Coins{sdk.Coin{Denom: "nhash", Amount: sdkmath.NewInt(0)}}, false),
+			expErr:   "lease settings for leased: invalid renewal fee: coin 0nhash amount is not positive",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.settings.Validate()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateLeaseSettings(t *testing.T) {
+	a := NewLeaseSettings("a.root", 100, 10, nil, false)
+	b := NewLeaseSettings("b.root", 100, 10, nil, false)
+	assert.NoError(t, ValidateLeaseSettings(nil), "nil")
+	assert.NoError(t, ValidateLeaseSettings([]LeaseSettings{a, b}), "two parents")
+	assert.EqualError(t, ValidateLeaseSettings([]LeaseSettings{a, b, a}), "duplicate lease settings for a.root", "duplicate parent")
+	assert.EqualError(t, ValidateLeaseSettings([]LeaseSettings{a, NewLeaseSettings("c", 0, 0, nil, false)}),
+		"lease settings for c: duration must be positive", "invalid entry")
+}
+
+func TestParentName(t *testing.T) {
+	assert.Equal(t, "", ParentName("root"), "root name")
+	assert.Equal(t, "root", ParentName("child.root"), "child name")
+	assert.Equal(t, "parent.root", ParentName("child.parent.root"), "grandchild name")
+}
+
+func TestNameLeaseRenew(t *testing.T) {
+	settings := NewLeaseSettings("leased", 100, 10, nil, false)
+	start := time.Unix(1000, 0).UTC()
+
+	lease := NewNameLease("name.leased", start, settings)
+	require.NoError(t, lease.Validate(), "Validate")
+	assert.Equal(t, start.Add(100*time.Second), lease.Expiration, "Expiration")
+	assert.Equal(t, start.Add(110*time.Second), lease.ReleaseTime, "ReleaseTime")
+	assert.False(t, lease.IsExpired(start.Add(99*time.Second)), "IsExpired before expiration")
+	assert.True(t, lease.IsExpired(start.Add(100*time.Second)), "IsExpired at expiration")
+
+	early := lease.Renew(start.Add(50*time.Second), settings)
+	assert.Equal(t, start.Add(200*time.Second), early.Expiration, "Expiration after early renewal")
+	assert.Equal(t, start.Add(210*time.Second), early.ReleaseTime, "ReleaseTime after early renewal")
+
+	late := lease.Renew(start.Add(105*time.Second), settings)
+	assert.Equal(t, start.Add(205*time.Second), late.Expiration, "Expiration after renewal in grace period")
+	assert.Equal(t, start.Add(215*time.Second), late.ReleaseTime, "ReleaseTime after renewal in grace period")
+}
+
+func TestNameLeaseValidate(t *testing.T) {
+	now := time.Unix(1000, 0).UTC()
+	assert.EqualError(t, NameLease{Expiration: now, ReleaseTime: now}.Validate(), "lease name cannot be empty", "no name")
+	assert.EqualError(t, NameLease{Name: "a.b", ReleaseTime: now}.Validate(), "lease of a.b: expiration cannot be empty", "no expiration")
+	assert.EqualError(t, NameLease{Name: "a.b", Expiration: now, ReleaseTime: now.Add(-time.Second)}.Validate(),
+		"lease of a.b: release time cannot be before expiration", "release before expiration")
+}
@@ -15,6 +15,7 @@ var AllRequestMsgs = []sdk.Msg{
 	(*MsgModifyNameRequest)(nil),
 	(*MsgCreateRootNameRequest)(nil),
 	(*MsgUpdateParamsRequest)(nil),
+	(*MsgRenewNameRequest)(nil),
 }
 
 func NewMsgBindNameRequest(record, parent NameRecord) *MsgBindNameRequest {
@@ -108,6 +109,7 @@ func NewMsgUpdateParamsRequest(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	leaseSettings []LeaseSettings,
 	authority string,
 ) *MsgUpdateParamsRequest {
 	return &MsgUpdateParamsRequest{
@@ -117,11 +119,29 @@ func NewMsgUpdateParamsRequest(
 			minSegmentLength,
 			maxNameLevels,
 			allowUnrestrictedNames,
+			leaseSettings,
 		),
 	}
 }
 
 func (msg MsgUpdateParamsRequest) ValidateBasic() error {
-	_, err := sdk.AccAddressFromBech32(msg.Authority)
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return err
+	}
+	return msg.Params.Validate()
+}
+
+func NewMsgRenewNameRequest(name string, owner string) *MsgRenewNameRequest {
+	return &MsgRenewNameRequest{
+		Name:  name,
+		Owner: owner,
+	}
+}
+
+func (msg MsgRenewNameRequest) ValidateBasic() error {
+	if strings.TrimSpace(msg.Name) == "" {
+		return fmt.Errorf("name cannot be empty")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Owner)
 	return err
 }
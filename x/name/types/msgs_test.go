@@ -19,6 +19,7 @@ func TestAllMsgsGetSigners(t *testing.T) {
 		func(signer string) sdk.Msg { return &MsgModifyNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgCreateRootNameRequest{Authority: signer} },
 		func(signer string) sdk.Msg { return &MsgUpdateParamsRequest{Authority: signer} },
+		func(signer string) sdk.Msg { return &MsgRenewNameRequest{Owner: signer} },
 	}
 
 	testutil.RunGetSignersTests(t, AllRequestMsgs, msgMakers, nil)
@@ -80,6 +81,7 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		minSegmentLength       uint32
 		maxNameLevels          uint32
 		allowUnrestrictedNames bool
+		leaseSettings          []LeaseSettings
 		authority              string
 		shouldFail             bool
 		expectedErr            string
@@ -90,6 +92,18 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 			3,
 			10,
 			true,
+			nil,
+			authority,
+			false,
+			"",
+		},
+		{
+			"valid request with lease settings",
+			100,
+			3,
+			10,
+			true,
+			[]LeaseSettings{NewLeaseSettings("leased", 100, 10, sdk.NewCoins(sdk.NewInt64Coin("nhash", 5)), true)},
 			authority,
 			false,
 			"",
@@ -100,14 +114,26 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 			3,
 			10,
 			true,
+			nil,
 			"blah",
 			true,
 			"decoding bech32 failed: invalid bech32 string length 4",
 		},
+		{
+			"duplicate lease settings",
+			100,
+			3,
+			10,
+			true,
+			[]LeaseSettings{NewLeaseSettings("leased", 100, 10, nil, true), NewLeaseSettings("leased", 50, 0, nil, false)},
+			authority,
+			true,
+			"duplicate lease settings for leased",
+		},
 	}
 
 	for _, tc := range testCases {
-		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, tc.authority)
+		msg := NewMsgUpdateParamsRequest(tc.maxSegmentLength, tc.minSegmentLength, tc.maxNameLevels, tc.allowUnrestrictedNames, tc.leaseSettings, tc.authority)
 		err := msg.ValidateBasic()
 		if tc.shouldFail {
 			require.EqualError(t, err, tc.expectedErr, "expected error for case: %s", tc.name)
@@ -116,3 +142,28 @@ func TestMsgUpdateParamsRequestValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgRenewNameRequestValidateBasic(t *testing.T) {
+	owner := sdk.AccAddress("input111111111111111").String()
+
+	tests := []struct {
+		name   string
+		msg    *MsgRenewNameRequest
+		expErr string
+	}{
+		{name: "valid", msg: NewMsgRenewNameRequest("name.leased", owner)},
+		{name: "empty name", msg: NewMsgRenewNameRequest(" ", owner), expErr: "name cannot be empty"},
+		{name: "invalid owner", msg: NewMsgRenewNameRequest("name.leased", "blah"), expErr: "decoding bech32 failed: invalid bech32 string length 4"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if len(tc.expErr) > 0 {
+				require.EqualError(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,18 +6,24 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +41,8 @@ type Params struct {
 	MaxNameLevels uint32 `protobuf:"varint,3,opt,name=max_name_levels,json=maxNameLevels,proto3" json:"max_name_levels,omitempty"`
 	// determines if unrestricted name keys are allowed or not
 	AllowUnrestrictedNames bool `protobuf:"varint,4,opt,name=allow_unrestricted_names,json=allowUnrestrictedNames,proto3" json:"allow_unrestricted_names,omitempty"`
+	// the parent names whose child names are leased for a period instead of being bound permanently
+	LeaseSettings []LeaseSettings `protobuf:"bytes,5,rep,name=lease_settings,json=leaseSettings,proto3" json:"lease_settings"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -98,6 +106,159 @@ func (m *Params) GetAllowUnrestrictedNames() bool {
 	return false
 }
 
+func (m *Params) GetLeaseSettings() []LeaseSettings {
+	if m != nil {
+		return m.LeaseSettings
+	}
+	return nil
+}
+
+// LeaseSettings defines how names bound directly under a parent name are leased.
+type LeaseSettings struct {
+	// the parent name whose direct child names are leased
+	Parent string `protobuf:"bytes,1,opt,name=parent,proto3" json:"parent,omitempty"`
+	// how long a lease lasts, both when a name is bound and each time it is renewed
+	DurationSeconds uint64 `protobuf:"varint,2,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// how long after a lease expires that the owner can still renew it before the name is released
+	GracePeriodSeconds uint64 `protobuf:"varint,3,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	// the fee the owner pays each time a lease is renewed
+	RenewalFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=renewal_fee,json=renewalFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"renewal_fee"`
+	// whether renewal fees are paid to the owner of the parent name; if false, they are paid to the community pool
+	FeeToParentOwner bool `protobuf:"varint,5,opt,name=fee_to_parent_owner,json=feeToParentOwner,proto3" json:"fee_to_parent_owner,omitempty"`
+}
+
+func (m *LeaseSettings) Reset()         { *m = LeaseSettings{} }
+func (m *LeaseSettings) String() string { return proto.CompactTextString(m) }
+func (*LeaseSettings) ProtoMessage()    {}
+func (*LeaseSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{1}
+}
+func (m *LeaseSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LeaseSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseSettings.Merge(m, src)
+}
+func (m *LeaseSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseSettings proto.InternalMessageInfo
+
+func (m *LeaseSettings) GetParent() string {
+	if m != nil {
+		return m.Parent
+	}
+	return ""
+}
+
+func (m *LeaseSettings) GetDurationSeconds() uint64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *LeaseSettings) GetGracePeriodSeconds() uint64 {
+	if m != nil {
+		return m.GracePeriodSeconds
+	}
+	return 0
+}
+
+func (m *LeaseSettings) GetRenewalFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RenewalFee
+	}
+	return nil
+}
+
+func (m *LeaseSettings) GetFeeToParentOwner() bool {
+	if m != nil {
+		return m.FeeToParentOwner
+	}
+	return false
+}
+
+// NameLease defines when a leased name expires and when it will be released.
+type NameLease struct {
+	// the leased name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the time the lease expires; the owner can renew it until the release time
+	Expiration time.Time `protobuf:"bytes,2,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// the time the name will be unbound if the lease has not been renewed
+	ReleaseTime time.Time `protobuf:"bytes,3,opt,name=release_time,json=releaseTime,proto3,stdtime" json:"release_time"`
+}
+
+func (m *NameLease) Reset()         { *m = NameLease{} }
+func (m *NameLease) String() string { return proto.CompactTextString(m) }
+func (*NameLease) ProtoMessage()    {}
+func (*NameLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{2}
+}
+func (m *NameLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NameLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NameLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NameLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NameLease.Merge(m, src)
+}
+func (m *NameLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *NameLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_NameLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NameLease proto.InternalMessageInfo
+
+func (m *NameLease) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NameLease) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *NameLease) GetReleaseTime() time.Time {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return time.Time{}
+}
+
 // NameRecord is a structure used to bind ownership of a name hierarchy to a collection of addresses
 type NameRecord struct {
 	// the bound name
@@ -111,7 +272,7 @@ type NameRecord struct {
 func (m *NameRecord) Reset()      { *m = NameRecord{} }
 func (*NameRecord) ProtoMessage() {}
 func (*NameRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{1}
+	return fileDescriptor_a314256905bb00ec, []int{3}
 }
 func (m *NameRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -184,7 +345,7 @@ type CreateRootNameProposal struct {
 func (m *CreateRootNameProposal) Reset()      { *m = CreateRootNameProposal{} }
 func (*CreateRootNameProposal) ProtoMessage() {}
 func (*CreateRootNameProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{2}
+	return fileDescriptor_a314256905bb00ec, []int{4}
 }
 func (m *CreateRootNameProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +385,7 @@ func (m *EventNameBound) Reset()         { *m = EventNameBound{} }
 func (m *EventNameBound) String() string { return proto.CompactTextString(m) }
 func (*EventNameBound) ProtoMessage()    {}
 func (*EventNameBound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{3}
+	return fileDescriptor_a314256905bb00ec, []int{5}
 }
 func (m *EventNameBound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -285,7 +446,7 @@ func (m *EventNameUnbound) Reset()         { *m = EventNameUnbound{} }
 func (m *EventNameUnbound) String() string { return proto.CompactTextString(m) }
 func (*EventNameUnbound) ProtoMessage()    {}
 func (*EventNameUnbound) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{4}
+	return fileDescriptor_a314256905bb00ec, []int{6}
 }
 func (m *EventNameUnbound) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +507,7 @@ func (m *EventNameUpdate) Reset()         { *m = EventNameUpdate{} }
 func (m *EventNameUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventNameUpdate) ProtoMessage()    {}
 func (*EventNameUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{5}
+	return fileDescriptor_a314256905bb00ec, []int{7}
 }
 func (m *EventNameUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -408,7 +569,7 @@ func (m *EventNameParamsUpdated) Reset()         { *m = EventNameParamsUpdated{}
 func (m *EventNameParamsUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventNameParamsUpdated) ProtoMessage()    {}
 func (*EventNameParamsUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a314256905bb00ec, []int{6}
+	return fileDescriptor_a314256905bb00ec, []int{8}
 }
 func (m *EventNameParamsUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -465,215 +626,411 @@ func (m *EventNameParamsUpdated) GetMaxSegmentLength() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
-	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
-	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
-	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
-	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
-	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
-	proto.RegisterType((*EventNameParamsUpdated)(nil), "provenance.name.v1.EventNameParamsUpdated")
+// EventNameLeaseStarted event emitted when a name is bound with a lease.
+type EventNameLeaseStarted struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Expiration  string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	ReleaseTime string `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
 }
 
-func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
-
-var fileDescriptor_a314256905bb00ec = []byte{
-	// 563 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3d, 0x6f, 0x13, 0x4d,
-	0x10, 0xc7, 0x6f, 0x13, 0x3b, 0x4f, 0xbc, 0x8f, 0x92, 0x58, 0x2b, 0x63, 0x8e, 0x48, 0x9c, 0x2d,
-	0x17, 0x28, 0x42, 0xc4, 0x47, 0xa0, 0x41, 0x74, 0x18, 0xd1, 0x45, 0xc8, 0xba, 0x28, 0x0d, 0x05,
-	0xc7, 0xfa, 0x6e, 0x75, 0x59, 0xe9, 0x76, 0xf7, 0xb4, 0xbb, 0x76, 0x4c, 0x4b, 0x81, 0x28, 0x29,
-	0x29, 0x5d, 0x53, 0x51, 0xf0, 0x19, 0x10, 0x65, 0x44, 0x81, 0x28, 0x91, 0x5d, 0xc0, 0xc7, 0x40,
-	0xb7, 0xeb, 0x97, 0xe3, 0x62, 0xa0, 0x81, 0xea, 0x76, 0x66, 0xfe, 0x73, 0xf3, 0xdb, 0xd1, 0xce,
-	0xc0, 0xeb, 0x99, 0x14, 0x23, 0xc2, 0x31, 0x8f, 0x88, 0xcf, 0x31, 0x23, 0xfe, 0xe8, 0xc8, 0x7c,
-	0xbb, 0x99, 0x14, 0x5a, 0x20, 0xb4, 0x0a, 0x77, 0x8d, 0x7b, 0x74, 0xb4, 0x7f, 0x35, 0x12, 0x8a,
-	0x09, 0xe5, 0x33, 0x95, 0xe4, 0x6a, 0xa6, 0x12, 0x2b, 0xde, 0xbf, 0x66, 0x03, 0xa1, 0xb1, 0x7c,
-	0x6b, 0xcc, 0x43, 0x8d, 0x44, 0x24, 0xc2, 0xfa, 0xf3, 0x93, 0xf5, 0x76, 0x3e, 0x00, 0xb8, 0xd5,
-	0xc7, 0x12, 0x33, 0x85, 0x6e, 0x41, 0xc4, 0xf0, 0x38, 0x54, 0x24, 0x61, 0x84, 0xeb, 0x30, 0x25,
-	0x3c, 0xd1, 0x67, 0x2e, 0x68, 0x83, 0x83, 0x9d, 0xa0, 0xce, 0xf0, 0xf8, 0xc4, 0x06, 0x8e, 0x8d,
-	0xdf, 0xa8, 0x29, 0x2f, 0xab, 0x37, 0xe6, 0x6a, 0xca, 0x7f, 0x56, 0xdf, 0x80, 0x7b, 0xf9, 0xbf,
-	0x73, 0xfe, 0x30, 0x25, 0x23, 0x92, 0x2a, 0x77, 0xd3, 0x48, 0x77, 0x18, 0x1e, 0x3f, 0xc6, 0x8c,
-	0x1c, 0x1b, 0x27, 0xba, 0x07, 0x5d, 0x9c, 0xa6, 0xe2, 0x3c, 0x1c, 0x72, 0x49, 0x94, 0x96, 0x34,
-	0xd2, 0x24, 0x36, 0x69, 0xca, 0xad, 0xb4, 0xc1, 0xc1, 0x76, 0xd0, 0x34, 0xf1, 0xd3, 0x42, 0x38,
-	0x4f, 0x57, 0x9d, 0x97, 0x00, 0xc2, 0xfc, 0x14, 0x90, 0x48, 0xc8, 0x18, 0x21, 0x58, 0xc9, 0xb3,
-	0x0c, 0x7e, 0x2d, 0x30, 0x67, 0x74, 0x07, 0xfe, 0x87, 0xe3, 0x58, 0x12, 0xa5, 0x0c, 0x67, 0xad,
-	0xe7, 0x7e, 0x7a, 0x7f, 0xd8, 0x98, 0x37, 0xe9, 0x81, 0x8d, 0x9c, 0x68, 0x49, 0x79, 0x12, 0x2c,
-	0x84, 0xc8, 0x83, 0x70, 0x55, 0xc9, 0x30, 0x6f, 0x07, 0x05, 0xcf, 0xfd, 0xfa, 0x9b, 0x49, 0xcb,
-	0x79, 0xf1, 0xed, 0xdd, 0xcd, 0x45, 0x46, 0xe7, 0x2d, 0x80, 0xcd, 0x87, 0x92, 0x60, 0x4d, 0x02,
-	0x21, 0x74, 0x8e, 0xd4, 0x97, 0x22, 0x13, 0x0a, 0xa7, 0xa8, 0x01, 0xab, 0x9a, 0xea, 0x74, 0x41,
-	0x65, 0x0d, 0xd4, 0x86, 0xff, 0xc7, 0x44, 0x45, 0x92, 0x66, 0x9a, 0x0a, 0x6e, 0xd1, 0x82, 0xa2,
-	0x6b, 0x79, 0x99, 0xcd, 0xc2, 0x65, 0x1a, 0xb0, 0x2a, 0xce, 0x39, 0x91, 0xa6, 0x2d, 0xb5, 0xc0,
-	0x1a, 0x25, 0xdc, 0xea, 0x25, 0xdc, 0xdd, 0x57, 0x93, 0x96, 0x93, 0x23, 0x7f, 0x9f, 0xb4, 0x1c,
-	0x17, 0x74, 0x9e, 0xc2, 0xdd, 0x47, 0x23, 0xc2, 0x0d, 0x66, 0x4f, 0x0c, 0x79, 0x8c, 0xdc, 0x55,
-	0x93, 0x2c, 0xe5, 0xb2, 0x15, 0x0b, 0x8a, 0x8d, 0x02, 0xc5, 0x1f, 0xda, 0xd3, 0x79, 0x06, 0xeb,
-	0xcb, 0xff, 0x9f, 0xf2, 0xc1, 0x3f, 0xa8, 0x10, 0xc2, 0xbd, 0x55, 0x85, 0x2c, 0xc6, 0x9a, 0xfc,
-	0xe5, 0x02, 0x9f, 0x01, 0x6c, 0x2e, 0x2b, 0xd8, 0x51, 0xb1, 0x75, 0xe2, 0xdf, 0xbe, 0x56, 0x5b,
-	0xf9, 0x17, 0xaf, 0x75, 0xdd, 0x3c, 0x58, 0xa6, 0xd2, 0x3c, 0xac, 0x9f, 0x32, 0xfb, 0x0e, 0x2e,
-	0x4f, 0xd9, 0xfa, 0x09, 0xae, 0xcc, 0xd5, 0xa5, 0x09, 0xee, 0x45, 0x1f, 0xa7, 0x1e, 0xb8, 0x98,
-	0x7a, 0xe0, 0xeb, 0xd4, 0x03, 0xaf, 0x67, 0x9e, 0x73, 0x31, 0xf3, 0x9c, 0x2f, 0x33, 0xcf, 0x81,
-	0x57, 0xa8, 0x59, 0x0f, 0xa5, 0xad, 0xd3, 0x07, 0x4f, 0x6e, 0x27, 0x54, 0x9f, 0x0d, 0x07, 0xdd,
-	0x48, 0x30, 0x7f, 0x25, 0x38, 0xa4, 0xa2, 0x60, 0xf9, 0x63, 0xbb, 0xc5, 0xf4, 0xf3, 0x8c, 0xa8,
-	0xc1, 0x96, 0x59, 0x33, 0x77, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xfb, 0xa1, 0x20, 0x2f, 0xe5,
-	0x04, 0x00, 0x00,
+func (m *EventNameLeaseStarted) Reset()         { *m = EventNameLeaseStarted{} }
+func (m *EventNameLeaseStarted) String() string { return proto.CompactTextString(m) }
+func (*EventNameLeaseStarted) ProtoMessage()    {}
+func (*EventNameLeaseStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{9}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameLeaseStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameLeaseStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameLeaseStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameLeaseStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameLeaseStarted.Merge(m, src)
+}
+func (m *EventNameLeaseStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameLeaseStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameLeaseStarted.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowUnrestrictedNames {
-		i--
-		if m.AllowUnrestrictedNames {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.MaxNameLevels != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_EventNameLeaseStarted proto.InternalMessageInfo
+
+func (m *EventNameLeaseStarted) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if m.MinSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *EventNameLeaseStarted) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	if m.MaxSegmentLength != 0 {
-		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventNameLeaseStarted) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NameRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameLeaseStarted) GetReleaseTime() string {
+	if m != nil {
+		return m.ReleaseTime
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventNameLeaseRenewed event emitted when a name's lease is renewed.
+type EventNameLeaseRenewed struct {
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address     string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Expiration  string `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	ReleaseTime string `protobuf:"bytes,4,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+	Fee         string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Restricted {
-		i--
-		if m.Restricted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventNameLeaseRenewed) Reset()         { *m = EventNameLeaseRenewed{} }
+func (m *EventNameLeaseRenewed) String() string { return proto.CompactTextString(m) }
+func (*EventNameLeaseRenewed) ProtoMessage()    {}
+func (*EventNameLeaseRenewed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{10}
+}
+func (m *EventNameLeaseRenewed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameLeaseRenewed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameLeaseRenewed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EventNameLeaseRenewed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameLeaseRenewed.Merge(m, src)
+}
+func (m *EventNameLeaseRenewed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameLeaseRenewed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameLeaseRenewed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameLeaseRenewed proto.InternalMessageInfo
+
+func (m *EventNameLeaseRenewed) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventNameLeaseRenewed) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventNameLeaseRenewed) GetExpiration() string {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventNameLeaseRenewed) GetReleaseTime() string {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return ""
 }
 
-func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
+func (m *EventNameLeaseRenewed) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+// EventNameLeaseReleased event emitted when a name is unbound because its lease was not renewed.
+type EventNameLeaseReleased struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventNameLeaseReleased) Reset()         { *m = EventNameLeaseReleased{} }
+func (m *EventNameLeaseReleased) String() string { return proto.CompactTextString(m) }
+func (*EventNameLeaseReleased) ProtoMessage()    {}
+func (*EventNameLeaseReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{11}
+}
+func (m *EventNameLeaseReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameLeaseReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameLeaseReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameLeaseReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameLeaseReleased.Merge(m, src)
+}
+func (m *EventNameLeaseReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameLeaseReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameLeaseReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameLeaseReleased proto.InternalMessageInfo
+
+func (m *EventNameLeaseReleased) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameLeaseReleased) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// EventNameLeaseReleaseFailed event emitted when a name whose lease was not renewed could not be unbound.
+// Its lease is removed, and the name is kept.
+type EventNameLeaseReleaseFailed struct {
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Error   string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventNameLeaseReleaseFailed) Reset()         { *m = EventNameLeaseReleaseFailed{} }
+func (m *EventNameLeaseReleaseFailed) String() string { return proto.CompactTextString(m) }
+func (*EventNameLeaseReleaseFailed) ProtoMessage()    {}
+func (*EventNameLeaseReleaseFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a314256905bb00ec, []int{12}
+}
+func (m *EventNameLeaseReleaseFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventNameLeaseReleaseFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventNameLeaseReleaseFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventNameLeaseReleaseFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventNameLeaseReleaseFailed.Merge(m, src)
+}
+func (m *EventNameLeaseReleaseFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventNameLeaseReleaseFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventNameLeaseReleaseFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventNameLeaseReleaseFailed proto.InternalMessageInfo
+
+func (m *EventNameLeaseReleaseFailed) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventNameLeaseReleaseFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EventNameLeaseReleaseFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "provenance.name.v1.Params")
+	proto.RegisterType((*LeaseSettings)(nil), "provenance.name.v1.LeaseSettings")
+	proto.RegisterType((*NameLease)(nil), "provenance.name.v1.NameLease")
+	proto.RegisterType((*NameRecord)(nil), "provenance.name.v1.NameRecord")
+	proto.RegisterType((*CreateRootNameProposal)(nil), "provenance.name.v1.CreateRootNameProposal")
+	proto.RegisterType((*EventNameBound)(nil), "provenance.name.v1.EventNameBound")
+	proto.RegisterType((*EventNameUnbound)(nil), "provenance.name.v1.EventNameUnbound")
+	proto.RegisterType((*EventNameUpdate)(nil), "provenance.name.v1.EventNameUpdate")
+	proto.RegisterType((*EventNameParamsUpdated)(nil), "provenance.name.v1.EventNameParamsUpdated")
+	proto.RegisterType((*EventNameLeaseStarted)(nil), "provenance.name.v1.EventNameLeaseStarted")
+	proto.RegisterType((*EventNameLeaseRenewed)(nil), "provenance.name.v1.EventNameLeaseRenewed")
+	proto.RegisterType((*EventNameLeaseReleased)(nil), "provenance.name.v1.EventNameLeaseReleased")
+	proto.RegisterType((*EventNameLeaseReleaseFailed)(nil), "provenance.name.v1.EventNameLeaseReleaseFailed")
+}
+
+func init() { proto.RegisterFile("provenance/name/v1/name.proto", fileDescriptor_a314256905bb00ec) }
+
+var fileDescriptor_a314256905bb00ec = []byte{
+	// 929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x31, 0x6f, 0x23, 0x45,
+	0x14, 0xf6, 0xc6, 0x76, 0x48, 0x5e, 0x48, 0x62, 0x0d, 0x3e, 0xb3, 0x17, 0x84, 0x9d, 0x73, 0x81,
+	0x02, 0x22, 0xeb, 0x24, 0x34, 0x88, 0x0e, 0x1f, 0x84, 0x26, 0x3a, 0xac, 0xcd, 0x5d, 0x43, 0xc1,
+	0x32, 0xde, 0x7d, 0xd9, 0x5b, 0xb1, 0x3b, 0xb3, 0x9a, 0x19, 0x3b, 0xa6, 0xa5, 0x40, 0x57, 0x5e,
+	0x85, 0x10, 0xd5, 0xd1, 0x5e, 0x81, 0x28, 0xf8, 0x11, 0x57, 0x9e, 0x28, 0x10, 0x15, 0x87, 0x92,
+	0x02, 0x7e, 0x06, 0x9a, 0x99, 0xb5, 0xbd, 0x71, 0x0c, 0x28, 0x12, 0x88, 0xca, 0xfb, 0xde, 0xfb,
+	0xe6, 0xcd, 0xf7, 0xde, 0xbc, 0xf9, 0xc6, 0xf0, 0x7a, 0x2e, 0xf8, 0x18, 0x19, 0x65, 0x21, 0xf6,
+	0x18, 0xcd, 0xb0, 0x37, 0x3e, 0x34, 0xbf, 0x5e, 0x2e, 0xb8, 0xe2, 0x84, 0xcc, 0xc3, 0x9e, 0x71,
+	0x8f, 0x0f, 0x77, 0xda, 0x21, 0x97, 0x19, 0x97, 0xbd, 0x21, 0x95, 0x1a, 0x3e, 0x44, 0x45, 0x0f,
+	0x7b, 0x21, 0x4f, 0x98, 0x5d, 0xb3, 0xf3, 0x6a, 0x11, 0xcf, 0x64, 0xac, 0xb3, 0x65, 0x32, 0x2e,
+	0x02, 0xb7, 0x6d, 0x20, 0x30, 0x56, 0xcf, 0x1a, 0x45, 0xa8, 0x19, 0xf3, 0x98, 0x5b, 0xbf, 0xfe,
+	0x2a, 0xbc, 0x9d, 0x98, 0xf3, 0x38, 0xc5, 0x9e, 0xb1, 0x86, 0xa3, 0xb3, 0x9e, 0x4a, 0x32, 0x94,
+	0x8a, 0x66, 0xb9, 0x05, 0x74, 0xbf, 0x5e, 0x81, 0xd5, 0x01, 0x15, 0x34, 0x93, 0xe4, 0x6d, 0x20,
+	0x19, 0x9d, 0x04, 0x12, 0xe3, 0x0c, 0x99, 0x0a, 0x52, 0x64, 0xb1, 0x7a, 0xe8, 0x3a, 0xbb, 0xce,
+	0xde, 0xa6, 0xdf, 0xc8, 0xe8, 0xe4, 0xd4, 0x06, 0x4e, 0x8c, 0xdf, 0xa0, 0x13, 0xb6, 0x88, 0x5e,
+	0x29, 0xd0, 0x09, 0xbb, 0x8a, 0x7e, 0x03, 0xb6, 0x75, 0x6e, 0xdd, 0x80, 0x20, 0xc5, 0x31, 0xa6,
+	0xd2, 0xad, 0x1a, 0xe8, 0x66, 0x46, 0x27, 0xf7, 0x68, 0x86, 0x27, 0xc6, 0x49, 0xde, 0x05, 0x97,
+	0xa6, 0x29, 0x3f, 0x0f, 0x46, 0x4c, 0xa0, 0x54, 0x22, 0x09, 0x15, 0x46, 0x66, 0x99, 0x74, 0x6b,
+	0xbb, 0xce, 0xde, 0x9a, 0xdf, 0x32, 0xf1, 0x07, 0xa5, 0xb0, 0x5e, 0x2e, 0xc9, 0x3d, 0xd8, 0x4a,
+	0x91, 0x4a, 0x0c, 0x24, 0x2a, 0x95, 0xb0, 0x58, 0xba, 0xf5, 0xdd, 0xea, 0xde, 0xc6, 0xd1, 0x1d,
+	0xef, 0xfa, 0x01, 0x78, 0x27, 0x1a, 0x79, 0x5a, 0x00, 0xfb, 0xb5, 0x67, 0xbf, 0x76, 0x2a, 0xfe,
+	0x66, 0x5a, 0x76, 0x76, 0xbf, 0x5b, 0x81, 0xcd, 0x2b, 0x30, 0xd2, 0x82, 0xd5, 0x9c, 0x0a, 0x64,
+	0xca, 0xf4, 0x64, 0xdd, 0x2f, 0x2c, 0xf2, 0x26, 0x34, 0xa2, 0x91, 0xa0, 0x2a, 0xe1, 0xba, 0x1d,
+	0x21, 0x67, 0x91, 0x34, 0x7d, 0xa8, 0xf9, 0xdb, 0x53, 0xff, 0xa9, 0x75, 0x93, 0x03, 0x68, 0xc6,
+	0x82, 0x86, 0x18, 0xe4, 0x28, 0x12, 0x1e, 0xcd, 0xe0, 0x55, 0x03, 0x27, 0x26, 0x36, 0x30, 0xa1,
+	0xe9, 0x8a, 0x14, 0x36, 0x04, 0x32, 0x3c, 0xa7, 0x69, 0x70, 0x86, 0xe8, 0xd6, 0x4c, 0x4d, 0xb7,
+	0xbd, 0xe2, 0xe8, 0xf5, 0x00, 0x79, 0xc5, 0x00, 0x79, 0x77, 0x79, 0xc2, 0xfa, 0x07, 0xba, 0x96,
+	0xa7, 0x2f, 0x3a, 0x7b, 0x71, 0xa2, 0x1e, 0x8e, 0x86, 0x5e, 0xc8, 0xb3, 0x62, 0x4e, 0x8a, 0x9f,
+	0x7d, 0x19, 0x7d, 0xde, 0x53, 0x5f, 0xe4, 0x28, 0xcd, 0x02, 0xe9, 0x43, 0x91, 0xff, 0x18, 0x91,
+	0xec, 0xc3, 0x2b, 0x67, 0x88, 0x81, 0xe2, 0x81, 0xad, 0x2d, 0xe0, 0xe7, 0x0c, 0x85, 0x5b, 0x37,
+	0x9d, 0x6f, 0x9c, 0x21, 0xde, 0xe7, 0x03, 0x13, 0xf8, 0x58, 0xfb, 0xbb, 0xdf, 0x3b, 0xb0, 0x6e,
+	0x0f, 0x8f, 0x4a, 0x24, 0x04, 0x6a, 0xba, 0xbf, 0x45, 0x77, 0xcc, 0x37, 0xf9, 0x00, 0x00, 0x27,
+	0x79, 0x62, 0xbb, 0x60, 0xba, 0xb2, 0x71, 0xb4, 0xe3, 0xd9, 0xa1, 0xf4, 0xa6, 0x43, 0xe9, 0xdd,
+	0x9f, 0x0e, 0x65, 0x7f, 0x4d, 0xd3, 0x7f, 0xfc, 0xa2, 0xe3, 0xf8, 0xa5, 0x75, 0xe4, 0x23, 0x78,
+	0x59, 0xa0, 0x3d, 0x5d, 0x3d, 0xbf, 0x6e, 0xf5, 0x06, 0x79, 0x36, 0x8a, 0x95, 0x3a, 0xd6, 0xfd,
+	0xca, 0x01, 0xd0, 0x84, 0x7d, 0x0c, 0xb9, 0x88, 0x96, 0x32, 0x3e, 0x82, 0x97, 0x68, 0x14, 0x09,
+	0x94, 0xf6, 0x10, 0xd7, 0xfb, 0xee, 0x4f, 0x3f, 0xee, 0x37, 0x8b, 0x7e, 0xbf, 0x6f, 0x23, 0xa7,
+	0x4a, 0x24, 0x2c, 0xf6, 0xa7, 0x40, 0xd2, 0x06, 0x98, 0x8f, 0xa3, 0x61, 0xb7, 0xe6, 0x97, 0x3c,
+	0xef, 0x35, 0xbe, 0xfc, 0xfd, 0x87, 0xb7, 0xa6, 0xe8, 0x6f, 0x9e, 0x74, 0x2a, 0xdd, 0xa7, 0x0e,
+	0xb4, 0xee, 0x0a, 0xa4, 0x0a, 0x7d, 0xce, 0x95, 0xa6, 0x34, 0x10, 0x3c, 0xe7, 0x92, 0xa6, 0xa4,
+	0x09, 0x75, 0x95, 0xa8, 0x74, 0xca, 0xca, 0x1a, 0x64, 0x17, 0x36, 0x22, 0x94, 0xa1, 0x48, 0xf2,
+	0x59, 0x27, 0xd7, 0xfd, 0xb2, 0x6b, 0x56, 0x4c, 0xb5, 0x54, 0x4c, 0x13, 0xea, 0xf6, 0x04, 0x6b,
+	0x36, 0x97, 0x31, 0x16, 0xe8, 0xd6, 0xaf, 0xd1, 0xdd, 0x72, 0x9d, 0x47, 0x4f, 0x3a, 0x15, 0x4d,
+	0xf4, 0x0f, 0x4d, 0xf6, 0x53, 0xd8, 0xfa, 0x70, 0x8c, 0xcc, 0xd0, 0xec, 0xf3, 0x11, 0x8b, 0x88,
+	0x3b, 0x6f, 0x92, 0x65, 0x39, 0x35, 0x67, 0x2c, 0x56, 0x4a, 0x2c, 0xfe, 0xa1, 0x3d, 0xdd, 0xcf,
+	0xa0, 0x31, 0xcb, 0xff, 0x80, 0x0d, 0xff, 0x83, 0x1d, 0x02, 0xd8, 0x9e, 0xef, 0x90, 0x47, 0x54,
+	0xe1, 0xbf, 0xbc, 0xc1, 0xcf, 0x0e, 0xb4, 0x66, 0x3b, 0x58, 0x3d, 0xb5, 0xfb, 0x44, 0x7f, 0x2b,
+	0x69, 0x76, 0xe7, 0xbf, 0x92, 0xb4, 0x25, 0xa2, 0x69, 0x39, 0x2d, 0x88, 0xe6, 0x72, 0x29, 0xb6,
+	0x73, 0x70, 0x5d, 0x8a, 0x97, 0xcb, 0x7c, 0xad, 0x40, 0x2f, 0xc8, 0x7c, 0xf7, 0x91, 0x03, 0xb7,
+	0x66, 0x85, 0x59, 0x3d, 0x54, 0x54, 0xe8, 0xba, 0x96, 0x5d, 0x1e, 0x77, 0xe1, 0xf2, 0x5c, 0xb9,
+	0x22, 0x25, 0x21, 0xb0, 0xdc, 0x4a, 0x1e, 0x72, 0x67, 0xe1, 0x8a, 0x5b, 0x3e, 0x57, 0x2e, 0xef,
+	0xb7, 0xd7, 0xa8, 0xf8, 0x5a, 0xb9, 0xfe, 0x07, 0x2a, 0xa4, 0x01, 0x55, 0xad, 0xc6, 0x75, 0x13,
+	0xd1, 0x9f, 0xdd, 0x63, 0x68, 0x2d, 0x72, 0x33, 0xf0, 0x1b, 0x92, 0xeb, 0x52, 0x78, 0x6d, 0x69,
+	0x9e, 0x63, 0x9a, 0xa4, 0x37, 0xae, 0xb4, 0x09, 0x75, 0x14, 0x82, 0x8b, 0xa2, 0x48, 0x6b, 0xf4,
+	0x43, 0xb8, 0x95, 0xf0, 0x25, 0xaf, 0xe2, 0xc0, 0xf9, 0xe4, 0xa0, 0xf4, 0x6a, 0xcc, 0x01, 0xfb,
+	0x09, 0x2f, 0x59, 0xbd, 0x89, 0xfd, 0x9b, 0x63, 0xde, 0x90, 0x67, 0x17, 0x6d, 0xe7, 0xf9, 0x45,
+	0xdb, 0xf9, 0xed, 0xa2, 0xed, 0x3c, 0xbe, 0x6c, 0x57, 0x9e, 0x5f, 0xb6, 0x2b, 0xbf, 0x5c, 0xb6,
+	0x2b, 0xc3, 0x55, 0x23, 0xca, 0xef, 0xfc, 0x39, 0x00, 0xb0, 0xea, 0x40, 0xd7, 0x1e, 0x09, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	if m.Restricted {
+	if len(m.LeaseSettings) > 0 {
+		for iNdEx := len(m.LeaseSettings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LeaseSettings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AllowUnrestrictedNames {
 		i--
-		if m.Restricted {
+		if m.AllowUnrestrictedNames {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+	if m.MaxNameLevels != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxNameLevels))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+	if m.MinSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MinSegmentLength))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
+	if m.MaxSegmentLength != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.MaxSegmentLength))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
+func (m *LeaseSettings) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -683,44 +1040,107 @@ func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
+func (m *LeaseSettings) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LeaseSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Restricted {
+	if m.FeeToParentOwner {
 		i--
-		if m.Restricted {
+		if m.FeeToParentOwner {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RenewalFee) > 0 {
+		for iNdEx := len(m.RenewalFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RenewalFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintName(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GracePeriodSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.GracePeriodSeconds))
+		i--
 		dAtA[i] = 0x18
 	}
+	if m.DurationSeconds != 0 {
+		i = encodeVarintName(dAtA, i, uint64(m.DurationSeconds))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Parent) > 0 {
+		i -= len(m.Parent)
+		copy(dAtA[i:], m.Parent)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Parent)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NameLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NameLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NameLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ReleaseTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintName(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintName(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
 		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+func (m *NameRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -730,12 +1150,12 @@ func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+func (m *NameRecord) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NameRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -750,24 +1170,24 @@ func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.Address) > 0 {
 		i -= len(m.Address)
 		copy(dAtA[i:], m.Address)
 		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
+func (m *CreateRootNameProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -777,12 +1197,12 @@ func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateRootNameProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateRootNameProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -795,26 +1215,40 @@ func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x28
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
 		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Description)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventNameParamsUpdated) Marshal() (dAtA []byte, err error) {
+func (m *EventNameBound) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -824,152 +1258,534 @@ func (m *EventNameParamsUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventNameParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventNameBound) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventNameBound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MaxSegmentLength) > 0 {
-		i -= len(m.MaxSegmentLength)
-		copy(dAtA[i:], m.MaxSegmentLength)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MaxSegmentLength)))
+	if m.Restricted {
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MinSegmentLength) > 0 {
-		i -= len(m.MinSegmentLength)
-		copy(dAtA[i:], m.MinSegmentLength)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MinSegmentLength)))
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.MaxNameLevels) > 0 {
-		i -= len(m.MaxNameLevels)
-		copy(dAtA[i:], m.MaxNameLevels)
-		i = encodeVarintName(dAtA, i, uint64(len(m.MaxNameLevels)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.AllowUnrestrictedNames) > 0 {
-		i -= len(m.AllowUnrestrictedNames)
-		copy(dAtA[i:], m.AllowUnrestrictedNames)
-		i = encodeVarintName(dAtA, i, uint64(len(m.AllowUnrestrictedNames)))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintName(dAtA []byte, offset int, v uint64) int {
-	offset -= sovName(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventNameUnbound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventNameUnbound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUnbound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.MaxSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MaxSegmentLength))
-	}
-	if m.MinSegmentLength != 0 {
-		n += 1 + sovName(uint64(m.MinSegmentLength))
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.MaxNameLevels != 0 {
-		n += 1 + sovName(uint64(m.MaxNameLevels))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.AllowUnrestrictedNames {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NameRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if m.Restricted {
+		i--
+		if m.Restricted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Restricted {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CreateRootNameProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameParamsUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameParamsUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameParamsUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.MaxSegmentLength) > 0 {
+		i -= len(m.MaxSegmentLength)
+		copy(dAtA[i:], m.MaxSegmentLength)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxSegmentLength)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.MinSegmentLength) > 0 {
+		i -= len(m.MinSegmentLength)
+		copy(dAtA[i:], m.MinSegmentLength)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MinSegmentLength)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.MaxNameLevels) > 0 {
+		i -= len(m.MaxNameLevels)
+		copy(dAtA[i:], m.MaxNameLevels)
+		i = encodeVarintName(dAtA, i, uint64(len(m.MaxNameLevels)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Restricted {
-		n += 2
+	if len(m.AllowUnrestrictedNames) > 0 {
+		i -= len(m.AllowUnrestrictedNames)
+		copy(dAtA[i:], m.AllowUnrestrictedNames)
+		i = encodeVarintName(dAtA, i, uint64(len(m.AllowUnrestrictedNames)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNameBound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameLeaseStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameLeaseStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameLeaseStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.ReleaseTime) > 0 {
+		i -= len(m.ReleaseTime)
+		copy(dAtA[i:], m.ReleaseTime)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ReleaseTime)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Restricted {
-		n += 2
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventNameUnbound) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventNameLeaseRenewed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *EventNameLeaseRenewed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameLeaseRenewed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ReleaseTime) > 0 {
+		i -= len(m.ReleaseTime)
+		copy(dAtA[i:], m.ReleaseTime)
+		i = encodeVarintName(dAtA, i, uint64(len(m.ReleaseTime)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Expiration) > 0 {
+		i -= len(m.Expiration)
+		copy(dAtA[i:], m.Expiration)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Expiration)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameLeaseReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameLeaseReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameLeaseReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventNameLeaseReleaseFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventNameLeaseReleaseFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventNameLeaseReleaseFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintName(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintName(dAtA []byte, offset int, v uint64) int {
+	offset -= sovName(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MaxSegmentLength))
+	}
+	if m.MinSegmentLength != 0 {
+		n += 1 + sovName(uint64(m.MinSegmentLength))
+	}
+	if m.MaxNameLevels != 0 {
+		n += 1 + sovName(uint64(m.MaxNameLevels))
+	}
+	if m.AllowUnrestrictedNames {
+		n += 2
+	}
+	if len(m.LeaseSettings) > 0 {
+		for _, e := range m.LeaseSettings {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *LeaseSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Parent)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.DurationSeconds != 0 {
+		n += 1 + sovName(uint64(m.DurationSeconds))
+	}
+	if m.GracePeriodSeconds != 0 {
+		n += 1 + sovName(uint64(m.GracePeriodSeconds))
+	}
+	if len(m.RenewalFee) > 0 {
+		for _, e := range m.RenewalFee {
+			l = e.Size()
+			n += 1 + l + sovName(uint64(l))
+		}
+	}
+	if m.FeeToParentOwner {
+		n += 2
+	}
+	return n
+}
+
+func (m *NameLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovName(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ReleaseTime)
+	n += 1 + l + sovName(uint64(l))
+	return n
+}
+
+func (m *NameRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateRootNameProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameBound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	if m.Restricted {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventNameUnbound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
@@ -1002,41 +1818,1082 @@ func (m *EventNameUpdate) Size() (n int) {
 	if m.Restricted {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventNameParamsUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AllowUnrestrictedNames)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxNameLevels)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MinSegmentLength)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.MaxSegmentLength)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameLeaseStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ReleaseTime)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameLeaseRenewed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Expiration)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.ReleaseTime)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameLeaseReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func (m *EventNameLeaseReleaseFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovName(uint64(l))
+	}
+	return n
+}
+
+func sovName(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozName(x uint64) (n int) {
+	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			}
+			m.MaxSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			}
+			m.MinSegmentLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+			}
+			m.MaxNameLevels = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowUnrestrictedNames = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseSettings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeaseSettings = append(m.LeaseSettings, LeaseSettings{})
+			if err := m.LeaseSettings[len(m.LeaseSettings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseSettings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseSettings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Parent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Parent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationSeconds", wireType)
+			}
+			m.DurationSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriodSeconds", wireType)
+			}
+			m.GracePeriodSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriodSeconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RenewalFee = append(m.RenewalFee, types.Coin{})
+			if err := m.RenewalFee[len(m.RenewalFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToParentOwner", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FeeToParentOwner = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ReleaseTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NameRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventNameParamsUpdated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AllowUnrestrictedNames)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MaxNameLevels)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MinSegmentLength)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
-	}
-	l = len(m.MaxSegmentLength)
-	if l > 0 {
-		n += 1 + l + sovName(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovName(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozName(x uint64) (n int) {
-	return sovName(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1059,17 +2916,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1079,16 +2936,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			m.MinSegmentLength = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1098,16 +2968,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinSegmentLength |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
 			}
-			m.MaxNameLevels = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1117,14 +3000,129 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxNameLevels |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			m.Restricted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipName(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthName
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowName
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -1141,7 +3139,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.AllowUnrestrictedNames = bool(v != 0)
+			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1163,7 +3161,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NameRecord) Unmarshal(dAtA []byte) error {
+func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1186,15 +3184,47 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NameRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NameRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowName
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,11 +3252,11 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1254,13 +3284,13 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1270,12 +3300,24 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthName
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1297,7 +3339,7 @@ func (m *NameRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
+func (m *EventNameLeaseStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1320,15 +3362,15 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRootNameProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameLeaseStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRootNameProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameLeaseStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1356,11 +3398,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1388,11 +3430,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1420,11 +3462,11 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Expiration = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1452,28 +3494,8 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.ReleaseTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1495,7 +3517,7 @@ func (m *CreateRootNameProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameBound) Unmarshal(dAtA []byte) error {
+func (m *EventNameLeaseRenewed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1518,15 +3540,15 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameBound: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameLeaseRenewed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameBound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameLeaseRenewed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1554,11 +3576,11 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1586,13 +3608,13 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowName
@@ -1602,65 +3624,27 @@ func (m *EventNameBound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Restricted = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipName(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthName
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowName
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthName
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUnbound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUnbound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Expiration = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1688,11 +3672,11 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ReleaseTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1720,28 +3704,8 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Fee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1763,7 +3727,7 @@ func (m *EventNameUnbound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventNameLeaseReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1786,15 +3750,15 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameLeaseReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameLeaseReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1822,11 +3786,11 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1854,28 +3818,8 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Restricted", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Restricted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipName(dAtA[iNdEx:])
@@ -1897,7 +3841,7 @@ func (m *EventNameUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventNameLeaseReleaseFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1920,15 +3864,15 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNameLeaseReleaseFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNameParamsUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNameLeaseReleaseFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowUnrestrictedNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1956,11 +3900,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowUnrestrictedNames = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxNameLevels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1988,43 +3932,11 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxNameLevels = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinSegmentLength", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowName
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthName
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthName
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MinSegmentLength = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSegmentLength", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2052,7 +3964,7 @@ func (m *EventNameParamsUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSegmentLength = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
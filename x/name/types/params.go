@@ -39,12 +39,14 @@ func NewParams(
 	minSegmentLength uint32,
 	maxNameLevels uint32,
 	allowUnrestrictedNames bool,
+	leaseSettings []LeaseSettings,
 ) Params {
 	return Params{
 		MaxSegmentLength:       maxSegmentLength,
 		MinSegmentLength:       minSegmentLength,
 		MaxNameLevels:          maxNameLevels,
 		AllowUnrestrictedNames: allowUnrestrictedNames,
+		LeaseSettings:          leaseSettings,
 	}
 }
 
@@ -66,9 +68,25 @@ func DefaultParams() Params {
 		DefaultMinSegmentLength,
 		DefaultMaxNameLevels,
 		DefaultAllowUnrestrictedNames,
+		[]LeaseSettings{},
 	)
 }
 
+// Validate returns an error if the params are invalid.
+func (p Params) Validate() error {
+	return ValidateLeaseSettings(p.LeaseSettings)
+}
+
+// GetLeaseSettingsFor returns the lease settings for the child names of a parent name, or nil if they aren't leased.
+func (p Params) GetLeaseSettingsFor(parent string) *LeaseSettings {
+	for _, settings := range p.LeaseSettings {
+		if settings.Parent == parent {
+			return &settings
+		}
+	}
+	return nil
+}
+
 // Equal returns true if the given value is equivalent to the current instance of params
 func (p *Params) Equal(that interface{}) bool {
 	if that == nil {
@@ -101,6 +119,14 @@ func (p *Params) Equal(that interface{}) bool {
 	if p.MinSegmentLength != that1.MinSegmentLength {
 		return false
 	}
+	if len(p.LeaseSettings) != len(that1.LeaseSettings) {
+		return false
+	}
+	for i := range p.LeaseSettings {
+		if !p.LeaseSettings[i].Equal(that1.LeaseSettings[i]) {
+			return false
+		}
+	}
 
 	return true
 }
@@ -11,18 +11,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -466,6 +470,107 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgRenewNameRequest defines an sdk.Msg type that is used to renew the lease of a leased name.
+type MsgRenewNameRequest struct {
+	// The name to renew
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The address the name resolves to. It pays the renewal fee.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRenewNameRequest) Reset()         { *m = MsgRenewNameRequest{} }
+func (m *MsgRenewNameRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameRequest) ProtoMessage()    {}
+func (*MsgRenewNameRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{10}
+}
+func (m *MsgRenewNameRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameRequest.Merge(m, src)
+}
+func (m *MsgRenewNameRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameRequest proto.InternalMessageInfo
+
+func (m *MsgRenewNameRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRenewNameRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// MsgRenewNameResponse defines the Msg/RenewName response type.
+type MsgRenewNameResponse struct {
+	// The new expiration of the name's lease
+	Expiration time.Time `protobuf:"bytes,1,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *MsgRenewNameResponse) Reset()         { *m = MsgRenewNameResponse{} }
+func (m *MsgRenewNameResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRenewNameResponse) ProtoMessage()    {}
+func (*MsgRenewNameResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacf6cd967218635, []int{11}
+}
+func (m *MsgRenewNameResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRenewNameResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRenewNameResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRenewNameResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRenewNameResponse.Merge(m, src)
+}
+func (m *MsgRenewNameResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRenewNameResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRenewNameResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRenewNameResponse proto.InternalMessageInfo
+
+func (m *MsgRenewNameResponse) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*MsgBindNameRequest)(nil), "provenance.name.v1.MsgBindNameRequest")
 	proto.RegisterType((*MsgBindNameResponse)(nil), "provenance.name.v1.MsgBindNameResponse")
@@ -477,47 +582,56 @@ func init() {
 	proto.RegisterType((*MsgModifyNameResponse)(nil), "provenance.name.v1.MsgModifyNameResponse")
 	proto.RegisterType((*MsgUpdateParamsRequest)(nil), "provenance.name.v1.MsgUpdateParamsRequest")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "provenance.name.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRenewNameRequest)(nil), "provenance.name.v1.MsgRenewNameRequest")
+	proto.RegisterType((*MsgRenewNameResponse)(nil), "provenance.name.v1.MsgRenewNameResponse")
 }
 
 func init() { proto.RegisterFile("provenance/name/v1/tx.proto", fileDescriptor_eacf6cd967218635) }
 
 var fileDescriptor_eacf6cd967218635 = []byte{
-	// 558 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x7d, 0x50, 0x2a, 0x7a, 0xa0, 0x0e, 0xd7, 0x84, 0x24, 0xae, 0x70, 0x50, 0x06, 0x28,
-	0x81, 0xd8, 0xb4, 0x48, 0x15, 0x42, 0x2c, 0x04, 0x56, 0xa3, 0x2a, 0x88, 0x05, 0x06, 0x74, 0x8d,
-	0x8f, 0xab, 0x25, 0xce, 0x67, 0xee, 0x2e, 0x51, 0xb3, 0x21, 0x16, 0x18, 0x99, 0x11, 0x43, 0x17,
-	0xf6, 0x0e, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0x13, 0x42, 0xc9, 0x50, 0x3e, 0x06, 0x8a, 0xef, 0xc0,
-	0x6e, 0x6c, 0x8b, 0x54, 0x61, 0xb3, 0xef, 0xbd, 0xff, 0xfb, 0xff, 0xf4, 0xde, 0xbb, 0x83, 0xeb,
-	0xb1, 0xe0, 0x43, 0x12, 0xe1, 0xa8, 0x4f, 0xbc, 0x08, 0x33, 0xe2, 0x0d, 0x37, 0x3d, 0xb5, 0xef,
-	0xc6, 0x82, 0x2b, 0x8e, 0x50, 0x1a, 0x74, 0xa7, 0x41, 0x77, 0xb8, 0x69, 0x57, 0x28, 0xa7, 0x3c,
-	0x09, 0x7b, 0xd3, 0x2f, 0x9d, 0x69, 0xd7, 0xfa, 0x5c, 0x32, 0x2e, 0x3d, 0x26, 0xe9, 0xb4, 0x02,
-	0x93, 0xd4, 0x04, 0x1a, 0x3a, 0xf0, 0x52, 0x2b, 0xf4, 0x8f, 0x09, 0x5d, 0x2d, 0xb0, 0x4e, 0x5c,
-	0x92, 0x70, 0xeb, 0x0b, 0x80, 0xc8, 0x97, 0xb4, 0x1b, 0x46, 0xc1, 0x13, 0xcc, 0x48, 0x8f, 0xbc,
-	0x19, 0x10, 0xa9, 0xd0, 0x03, 0xb8, 0x1c, 0x63, 0x41, 0x22, 0x55, 0x07, 0xd7, 0xc0, 0xc6, 0xa5,
-	0x2d, 0xc7, 0xcd, 0x43, 0xba, 0x5a, 0xd0, 0xe7, 0x22, 0xe8, 0x2e, 0x1d, 0xfd, 0x68, 0x5a, 0x3d,
-	0xa3, 0x99, 0xaa, 0x45, 0x72, 0x5e, 0x3f, 0x77, 0x16, 0xb5, 0xd6, 0xdc, 0x5f, 0xfb, 0x70, 0xd0,
-	0xb4, 0x7e, 0x1d, 0x34, 0xad, 0x77, 0x27, 0x87, 0x6d, 0x53, 0xb2, 0x55, 0x85, 0x6b, 0xa7, 0x30,
-	0x65, 0xcc, 0x23, 0x49, 0x5a, 0x21, 0xac, 0xf8, 0x92, 0x3e, 0x26, 0xaf, 0x89, 0x22, 0x33, 0xfc,
-	0x86, 0x00, 0x2c, 0x4c, 0xa0, 0x0f, 0x5b, 0x35, 0x58, 0x9d, 0xb1, 0x32, 0x0c, 0x9f, 0x00, 0xac,
-	0xfb, 0x92, 0x3e, 0x12, 0x04, 0x2b, 0xd2, 0xe3, 0x5c, 0x65, 0x41, 0xb6, 0xe1, 0x0a, 0x1e, 0xa8,
-	0x3d, 0x2e, 0x42, 0x35, 0x4a, 0x58, 0x56, 0xba, 0xf5, 0x6f, 0x5f, 0x3b, 0x15, 0x33, 0xa3, 0x87,
-	0x41, 0x20, 0x88, 0x94, 0x4f, 0x95, 0x08, 0x23, 0xda, 0x4b, 0x53, 0xd1, 0xf6, 0xd9, 0x5a, 0xf8,
-	0x17, 0x7d, 0x75, 0x8a, 0x9c, 0xd6, 0x69, 0xad, 0xc3, 0x46, 0x01, 0x9b, 0x21, 0xff, 0x0c, 0x92,
-	0xf6, 0xf9, 0x3c, 0x08, 0x5f, 0x8d, 0xfe, 0x07, 0xf5, 0x62, 0x83, 0x9f, 0x65, 0xd7, 0x1d, 0xcf,
-	0xd2, 0xa5, 0x1d, 0xbf, 0xe2, 0x4b, 0xfa, 0x2c, 0x0e, 0xb0, 0x22, 0x3b, 0x58, 0x60, 0x26, 0x17,
-	0x25, 0xbf, 0x97, 0x2c, 0x3c, 0x66, 0xd2, 0x90, 0xdb, 0x45, 0xe4, 0xda, 0x2a, 0xb3, 0xec, 0x98,
-	0xc9, 0x1c, 0x75, 0x03, 0xd6, 0x72, 0x6c, 0x9a, 0x7b, 0xeb, 0xfd, 0x12, 0x3c, 0xef, 0x4b, 0x8a,
-	0x5e, 0xc0, 0x8b, 0x7f, 0x36, 0x19, 0x5d, 0x2f, 0x32, 0xca, 0xdf, 0x48, 0xfb, 0xc6, 0x3f, 0xf3,
-	0xb4, 0x09, 0xc2, 0x10, 0xa6, 0x4b, 0x8a, 0x36, 0x4a, 0x64, 0xb9, 0x2b, 0x63, 0xdf, 0x9c, 0x23,
-	0x33, 0xb5, 0x48, 0xa7, 0x52, 0x6a, 0x91, 0x5b, 0xab, 0x52, 0x8b, 0xfc, 0x88, 0x11, 0x83, 0xab,
-	0xa7, 0x97, 0x16, 0xdd, 0x2e, 0x11, 0x17, 0xde, 0x3b, 0xbb, 0x33, 0x67, 0xb6, 0xb1, 0xa3, 0xf0,
-	0x72, 0x76, 0x62, 0xa8, 0x5d, 0x22, 0x2f, 0x58, 0x39, 0xfb, 0xd6, 0x5c, 0xb9, 0xda, 0xc8, 0xbe,
-	0xf0, 0xf6, 0xe4, 0xb0, 0x0d, 0xba, 0xfd, 0xa3, 0xb1, 0x03, 0x8e, 0xc7, 0x0e, 0xf8, 0x39, 0x76,
-	0xc0, 0xc7, 0x89, 0x63, 0x1d, 0x4f, 0x1c, 0xeb, 0xfb, 0xc4, 0xb1, 0x60, 0x35, 0xe4, 0x05, 0xf5,
-	0x76, 0xc0, 0xf3, 0x3b, 0x34, 0x54, 0x7b, 0x83, 0x5d, 0xb7, 0xcf, 0x99, 0x97, 0x26, 0x74, 0x42,
-	0x9e, 0xf9, 0xf3, 0xf6, 0xf5, 0x1b, 0xaf, 0x46, 0x31, 0x91, 0xbb, 0xcb, 0xc9, 0x13, 0x7f, 0xf7,
-	0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x27, 0xc8, 0xa2, 0x05, 0x7e, 0x06, 0x00, 0x00,
+	// 672 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x3f, 0x4f, 0x14, 0x4f,
+	0x18, 0xc7, 0x6f, 0xf8, 0x01, 0x81, 0xe7, 0x67, 0x28, 0x86, 0x43, 0x8e, 0x25, 0xee, 0x99, 0x2b,
+	0x14, 0x50, 0x76, 0x05, 0x13, 0x62, 0x88, 0x8d, 0x27, 0xed, 0x19, 0xb2, 0x6a, 0xa3, 0x46, 0x33,
+	0xdc, 0x0d, 0xc3, 0x26, 0xcc, 0xce, 0x3a, 0x33, 0xc7, 0x9f, 0xce, 0x58, 0x59, 0x52, 0x1b, 0x0b,
+	0x1a, 0x7b, 0x0a, 0x5f, 0x04, 0x25, 0xb1, 0xb2, 0x52, 0x03, 0x05, 0xbe, 0x0c, 0xb3, 0x3b, 0x03,
+	0xbb, 0xdc, 0xed, 0x85, 0x23, 0xd8, 0xed, 0xdc, 0xf3, 0x7d, 0xe6, 0xfb, 0x99, 0x67, 0x9e, 0x67,
+	0x0e, 0xa6, 0x63, 0x29, 0xb6, 0x68, 0x44, 0xa2, 0x26, 0xf5, 0x23, 0xc2, 0xa9, 0xbf, 0xb5, 0xe0,
+	0xeb, 0x1d, 0x2f, 0x96, 0x42, 0x0b, 0x8c, 0xb3, 0xa0, 0x97, 0x04, 0xbd, 0xad, 0x05, 0xa7, 0xcc,
+	0x04, 0x13, 0x69, 0xd8, 0x4f, 0xbe, 0x8c, 0xd2, 0xa9, 0x32, 0x21, 0xd8, 0x26, 0xf5, 0xd3, 0xd5,
+	0x5a, 0x7b, 0xdd, 0xd7, 0x21, 0xa7, 0x4a, 0x13, 0x1e, 0x5b, 0xc1, 0x64, 0x53, 0x28, 0x2e, 0x94,
+	0xcf, 0x15, 0x4b, 0x2c, 0xb8, 0x62, 0x36, 0x30, 0x65, 0x02, 0xef, 0xcc, 0x96, 0x66, 0x61, 0x43,
+	0xb7, 0x0a, 0xd8, 0x52, 0x8c, 0x34, 0x5c, 0xfb, 0x8a, 0x00, 0x37, 0x14, 0xab, 0x87, 0x51, 0xeb,
+	0x19, 0xe1, 0x34, 0xa0, 0xef, 0xdb, 0x54, 0x69, 0xfc, 0x18, 0x86, 0x63, 0x22, 0x69, 0xa4, 0x2b,
+	0xe8, 0x36, 0x9a, 0xf9, 0x7f, 0xd1, 0xf5, 0xba, 0x4f, 0xe1, 0x99, 0x84, 0xa6, 0x90, 0xad, 0xfa,
+	0xe0, 0xe1, 0xcf, 0x6a, 0x29, 0xb0, 0x39, 0x49, 0xb6, 0x4c, 0x7f, 0xaf, 0x0c, 0x5c, 0x25, 0xdb,
+	0xe4, 0x2c, 0x8f, 0x7f, 0xda, 0xaf, 0x96, 0xfe, 0xec, 0x57, 0x4b, 0x1f, 0x4f, 0x0f, 0xe6, 0xec,
+	0x96, 0xb5, 0x09, 0x18, 0xbf, 0x80, 0xa9, 0x62, 0x11, 0x29, 0x5a, 0x0b, 0xa1, 0xdc, 0x50, 0x6c,
+	0x85, 0x6e, 0x52, 0x4d, 0x3b, 0xf8, 0x2d, 0x01, 0xba, 0x36, 0x81, 0xf9, 0xb1, 0x36, 0x09, 0x13,
+	0x1d, 0x56, 0x96, 0xe1, 0x33, 0x82, 0x4a, 0x43, 0xb1, 0xa7, 0x92, 0x12, 0x4d, 0x03, 0x21, 0x74,
+	0x1e, 0x64, 0x09, 0x46, 0x49, 0x5b, 0x6f, 0x08, 0x19, 0xea, 0xdd, 0x94, 0x65, 0xb4, 0x5e, 0xf9,
+	0xfe, 0x6d, 0xbe, 0x6c, 0xef, 0xe8, 0x49, 0xab, 0x25, 0xa9, 0x52, 0xcf, 0xb5, 0x0c, 0x23, 0x16,
+	0x64, 0x52, 0xbc, 0x74, 0xb5, 0x12, 0x9e, 0xa3, 0x8f, 0x25, 0xc8, 0xd9, 0x3e, 0xb5, 0x69, 0x98,
+	0x2a, 0x60, 0xb3, 0xe4, 0x5f, 0x50, 0x5a, 0xbe, 0x86, 0x68, 0x85, 0xeb, 0xbb, 0xff, 0x82, 0xfa,
+	0x7a, 0x17, 0xdf, 0xc9, 0x6e, 0x2a, 0x9e, 0xa7, 0xcb, 0x2a, 0x7e, 0xb3, 0xa1, 0xd8, 0xcb, 0xb8,
+	0x45, 0x34, 0x5d, 0x25, 0x92, 0x70, 0x75, 0x5d, 0xf2, 0x47, 0x69, 0xc3, 0x13, 0xae, 0x2c, 0xb9,
+	0x53, 0x44, 0x6e, 0xac, 0x72, 0xcd, 0x4e, 0xb8, 0xea, 0xa2, 0x9e, 0x82, 0xc9, 0x2e, 0x36, 0xcb,
+	0x4d, 0xd3, 0x26, 0x0e, 0x68, 0x44, 0xb7, 0xf3, 0xd5, 0xc6, 0x30, 0x98, 0x38, 0x18, 0xdc, 0x20,
+	0xfd, 0xc6, 0x1e, 0x0c, 0x89, 0xed, 0x88, 0xca, 0xca, 0xc0, 0x25, 0x67, 0x30, 0xb2, 0x65, 0x48,
+	0x28, 0xcc, 0x77, 0xed, 0x0d, 0x94, 0x2f, 0xda, 0x18, 0x7b, 0xbc, 0x02, 0x40, 0x77, 0xe2, 0x50,
+	0x12, 0x1d, 0x8a, 0xc8, 0x0e, 0x86, 0xe3, 0x99, 0x47, 0xc7, 0x3b, 0x7b, 0x74, 0xbc, 0x17, 0x67,
+	0x8f, 0x4e, 0x7d, 0x24, 0x39, 0xe7, 0xde, 0xaf, 0x2a, 0x0a, 0x72, 0x79, 0x8b, 0xa7, 0x83, 0xf0,
+	0x5f, 0x43, 0x31, 0xfc, 0x1a, 0x46, 0xce, 0xc6, 0x11, 0xdf, 0x29, 0xaa, 0x56, 0xf7, 0xb3, 0xe2,
+	0xdc, 0xbd, 0x54, 0x67, 0x51, 0x09, 0x40, 0x36, 0x69, 0x78, 0xa6, 0x47, 0x5a, 0xd7, 0xdc, 0x3b,
+	0xb3, 0x7d, 0x28, 0x33, 0x8b, 0xac, 0xb5, 0x7a, 0x5a, 0x74, 0xcd, 0x86, 0x33, 0xdb, 0x87, 0xd2,
+	0x5a, 0x70, 0x18, 0xbb, 0x38, 0x79, 0xf8, 0x7e, 0x8f, 0xe4, 0xc2, 0xc7, 0xc3, 0x99, 0xef, 0x53,
+	0x6d, 0xed, 0x18, 0xdc, 0xc8, 0xb7, 0x1d, 0x9e, 0xeb, 0x91, 0x5e, 0x30, 0x37, 0xce, 0xbd, 0xbe,
+	0xb4, 0xd6, 0xe8, 0x2d, 0x8c, 0x9e, 0x77, 0x17, 0xee, 0x75, 0xa7, 0x9d, 0x6d, 0xee, 0xcc, 0x5c,
+	0x2e, 0x34, 0xfb, 0x3b, 0x43, 0x1f, 0x4e, 0x0f, 0xe6, 0x50, 0xbd, 0x79, 0x78, 0xec, 0xa2, 0xa3,
+	0x63, 0x17, 0xfd, 0x3e, 0x76, 0xd1, 0xde, 0x89, 0x5b, 0x3a, 0x3a, 0x71, 0x4b, 0x3f, 0x4e, 0xdc,
+	0x12, 0x4c, 0x84, 0xa2, 0x60, 0xb3, 0x55, 0xf4, 0xea, 0x01, 0x0b, 0xf5, 0x46, 0x7b, 0xcd, 0x6b,
+	0x0a, 0xee, 0x67, 0x82, 0xf9, 0x50, 0xe4, 0x56, 0xfe, 0x8e, 0xf9, 0x23, 0xd4, 0xbb, 0x31, 0x55,
+	0x6b, 0xc3, 0x69, 0xe3, 0x3f, 0xfc, 0x3b, 0x00, 0xca, 0xf9, 0x88, 0x59, 0xc4, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -542,6 +656,8 @@ type MsgClient interface {
 	CreateRootName(ctx context.Context, in *MsgCreateRootNameRequest, opts ...grpc.CallOption) (*MsgCreateRootNameResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the name module's params.
 	UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RenewName extends the lease of a leased name, paying its renewal fee.
+	RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error)
 }
 
 type msgClient struct {
@@ -597,6 +713,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParamsRequest
 	return out, nil
 }
 
+func (c *msgClient) RenewName(ctx context.Context, in *MsgRenewNameRequest, opts ...grpc.CallOption) (*MsgRenewNameResponse, error) {
+	out := new(MsgRenewNameResponse)
+	err := c.cc.Invoke(ctx, "/provenance.name.v1.Msg/RenewName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// BindName binds a name to an address under a root name.
@@ -609,6 +734,8 @@ type MsgServer interface {
 	CreateRootName(context.Context, *MsgCreateRootNameRequest) (*MsgCreateRootNameResponse, error)
 	// UpdateParams is a governance proposal endpoint for updating the name module's params.
 	UpdateParams(context.Context, *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error)
+	// RenewName extends the lease of a leased name, paying its renewal fee.
+	RenewName(context.Context, *MsgRenewNameRequest) (*MsgRenewNameResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -630,6 +757,9 @@ func (*UnimplementedMsgServer) CreateRootName(ctx context.Context, req *MsgCreat
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParamsRequest) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) RenewName(ctx context.Context, req *MsgRenewNameRequest) (*MsgRenewNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewName not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -725,6 +855,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RenewName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRenewNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RenewName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/provenance.name.v1.Msg/RenewName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RenewName(ctx, req.(*MsgRenewNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "provenance.name.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -749,6 +897,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RenewName",
+			Handler:    _Msg_RenewName_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "provenance/name/v1/tx.proto",
@@ -1067,6 +1219,74 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRenewNameRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRenewNameResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRenewNameResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRenewNameResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1194,6 +1414,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRenewNameRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRenewNameResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1997,6 +2245,203 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRenewNameRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRenewNameResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRenewNameResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -443,6 +443,30 @@ func (s *IntegrationTestSuite) TestAddTransactionTrigger() {
 			expectedCode: 0,
 			expectedIds:  []int{},
 		},
+		{
+			name:         "invalid typed tx event",
+			fileContent:  "",
+			txEvent:      `{"@type": "/provenance.trigger.v1.OrderFilledEvent", "order_id": "1"}`,
+			expectErrMsg: "market id cannot be zero",
+			expectedCode: 0,
+			expectedIds:  []int{},
+		},
+		{
+			name:         "typed tx event with unknown type",
+			fileContent:  "",
+			txEvent:      `{"@type": "/provenance.trigger.v1.UnknownEvent", "name": "kyc.pb"}`,
+			expectErrMsg: "unable to parse event file: unable to resolve type URL /provenance.trigger.v1.UnknownEvent",
+			expectedCode: 0,
+			expectedIds:  []int{},
+		},
+		{
+			name:         "typed event that is not a tx event",
+			fileContent:  "",
+			txEvent:      `{"@type": "/provenance.trigger.v1.BlockHeightEvent", "block_height": "1000"}`,
+			expectErrMsg: "unable to parse event file: *types.BlockHeightEvent is not a transaction event",
+			expectedCode: 0,
+			expectedIds:  []int{},
+		},
 		{
 			name:         "invalid file format",
 			fileContent:  "abc",
@@ -48,7 +48,13 @@ func GetCmdAddTransactionTrigger() *cobra.Command {
 		Args:    cobra.ExactArgs(2),
 		Aliases: []string{"tx"},
 		Short:   "Creates a new trigger that fires when a tx event is detected.",
-		Long:    strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the tx event has occurred`),
+		Long: strings.TrimSpace(`Creates a new trigger.  This will delay the execution of the provided message until the tx event has occurred.
+The event can either be a generic tx event with a name and attributes, or a typed event identified by its "@type".
+The typed events are:
+  /provenance.trigger.v1.MarkerReceivedEvent: an account (optional "receiver") receives a marker "denom".
+  /provenance.trigger.v1.OrderFilledEvent: an order (optional "order_id") is filled in a market ("market_id").
+  /provenance.trigger.v1.AttributeAddedEvent: an attribute ("name") is added to an account (optional "account").
+  /provenance.trigger.v1.ScopeValueOwnerChangedEvent: a scope's ("scope_addr") value owner changes (optionally to "value_owner").`),
 		Example: fmt.Sprintf(`$ %[1]s tx trigger create-tx-trigger event.json message.json
 		
 Example of event.json contents:
@@ -66,6 +72,13 @@ Example of event.json contents:
 	]
 }
 
+Example of typed event.json contents:
+{
+	"@type": "/provenance.trigger.v1.MarkerReceivedEvent",
+	"denom": "mycoin",
+	"receiver": "tp19yjn905u442gh430hw362zrq5m6gj0klhk8ghx"
+}
+
 Example of message.json contents:
 {
 	"@type": "/cosmos.bank.v1beta1.MsgSend",
@@ -86,7 +99,7 @@ Example of message.json contents:
 			}
 			callerAddr := clientCtx.GetFromAddress()
 
-			event, err := parseEvent(args[0])
+			event, err := parseEvent(clientCtx.Codec, args[0])
 			if err != nil {
 				return fmt.Errorf("unable to parse event file: %w", err)
 			}
@@ -281,12 +294,31 @@ func parseMessages(cdc codec.Codec, path string) ([]sdk.Msg, error) {
 }
 
 // parseEvent reads and parses the transaction event from a file.
-func parseEvent(path string) (*types.TransactionEvent, error) {
+// If the event has an "@type", it is parsed as that typed trigger event, otherwise it's parsed as a TransactionEvent.
+func parseEvent(cdc codec.Codec, path string) (types.TriggerEventI, error) {
 	contents, err := os.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
+	var fields map[string]json.RawMessage
+	err = json.Unmarshal(contents, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, hasType := fields["@type"]; hasType {
+		var event types.TriggerEventI
+		err = cdc.UnmarshalInterfaceJSON(contents, &event)
+		if err != nil {
+			return nil, err
+		}
+		if _, isTxEvent := event.(types.TransactionEventMatcherI); !isTxEvent {
+			return nil, fmt.Errorf("%T is not a transaction event", event)
+		}
+		return event, nil
+	}
+
 	var event types.TransactionEvent
 	err = json.Unmarshal(contents, &event)
 	if err != nil {
@@ -36,11 +36,12 @@ func (k Keeper) detectTransactionEvents(ctx sdk.Context) (triggers []types.Trigg
 
 	for _, event := range abciEventHistory.GetABCIEventHistory() {
 		matched := k.getMatchingTriggersUntil(ctx, event.GetType(), func(trigger types.Trigger, triggerEvent types.TriggerEventI) bool {
-			if _, isDetected := detectedTriggers[trigger.Id]; isDetected {
+			// A trigger that didn't match an earlier event can still match a later one of the same type.
+			if detectedTriggers[trigger.Id] {
 				return false
 			}
-			txEvent := triggerEvent.(*types.TransactionEvent)
-			detected := txEvent.Matches(event)
+			txEvent, isTxEvent := triggerEvent.(types.TransactionEventMatcherI)
+			detected := isTxEvent && txEvent.Matches(event)
 			detectedTriggers[trigger.Id] = detected
 			return detected
 		}, terminator)
@@ -3,10 +3,18 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/google/uuid"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/gogoproto/proto"
+
 	"github.com/provenance-io/provenance/testutil/assertions"
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
 	"github.com/provenance-io/provenance/x/trigger/types"
 )
 
@@ -298,3 +306,76 @@ func (s *KeeperTestSuite) TestDetectBlockEvents() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestDetectTypedTransactionEvents() {
+	addr0, addr1 := s.accountAddresses[0].String(), s.accountAddresses[1].String()
+	scopeAddr := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	otherScopeAddr := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	typedEvent := func(tev proto.Message) sdk.Event {
+		event, err := sdk.TypedEventToEvent(tev)
+		s.Require().NoError(err, "TypedEventToEvent(%T)", tev)
+		return event
+	}
+	coinReceived := func(receiver, amount string) sdk.Event {
+		return sdk.NewEvent(banktypes.EventTypeCoinReceived,
+			sdk.NewAttribute(banktypes.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount))
+	}
+	history := sdk.Events{
+		coinReceived(addr0, "5nhash"),
+		coinReceived(addr1, "3mycoin,5nhash"),
+		typedEvent(&exchange.EventOrderFilled{OrderId: 7, MarketId: 2}),
+		typedEvent(&exchange.EventOrderFilled{OrderId: 8, MarketId: 3}),
+		typedEvent(&attrtypes.EventAttributeAdd{Name: "kyc.pb", Account: addr0}),
+		typedEvent(&metadatatypes.EventScopeValueOwnerChanged{ScopeAddr: otherScopeAddr, ValueOwner: addr1}),
+		typedEvent(&metadatatypes.EventScopeValueOwnerChanged{ScopeAddr: scopeAddr, PreviousValueOwner: addr0, ValueOwner: addr1}),
+	}
+
+	tests := []struct {
+		name     string
+		event    types.TriggerEventI
+		detected bool
+	}{
+		{name: "marker received by anyone", event: &types.MarkerReceivedEvent{Denom: "mycoin"}, detected: true},
+		{name: "marker received by receiver", event: &types.MarkerReceivedEvent{Denom: "mycoin", Receiver: addr1}, detected: true},
+		{name: "marker received by other receiver", event: &types.MarkerReceivedEvent{Denom: "mycoin", Receiver: addr0}, detected: false},
+		{name: "other denom received", event: &types.MarkerReceivedEvent{Denom: "othercoin"}, detected: false},
+		{name: "order filled in market", event: &types.OrderFilledEvent{MarketId: 3}, detected: true},
+		{name: "specific order filled in market", event: &types.OrderFilledEvent{MarketId: 2, OrderId: 7}, detected: true},
+		{name: "order filled in other market", event: &types.OrderFilledEvent{MarketId: 3, OrderId: 7}, detected: false},
+		{name: "no order filled in market", event: &types.OrderFilledEvent{MarketId: 4}, detected: false},
+		{name: "attribute added to anyone", event: &types.AttributeAddedEvent{Name: "kyc.pb"}, detected: true},
+		{name: "attribute added to account", event: &types.AttributeAddedEvent{Name: "kyc.pb", Account: addr0}, detected: true},
+		{name: "attribute added to other account", event: &types.AttributeAddedEvent{Name: "kyc.pb", Account: addr1}, detected: false},
+		{name: "other attribute added", event: &types.AttributeAddedEvent{Name: "other.pb"}, detected: false},
+		{name: "scope value owner changed", event: &types.ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr}, detected: true},
+		{name: "scope value owner changed to owner", event: &types.ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: addr1}, detected: true},
+		{name: "scope value owner changed to other owner", event: &types.ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: addr0}, detected: false},
+		{name: "raw transaction event", event: &types.TransactionEvent{Name: "provenance.exchange.v1.EventOrderFilled", Attributes: []types.Attribute{{Name: "market_id", Value: "3"}}}, detected: true},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.Require().NoError(tc.event.Validate(), "Validate")
+			ctx := s.ctx.WithEventManager(sdk.NewEventManagerWithHistory(history.ToABCIEvents()))
+			actions, _ := sdktx.SetMsgs([]sdk.Msg{&types.MsgDestroyTriggerRequest{Id: 1, Authority: addr0}})
+			anyEvent, err := codectypes.NewAnyWithValue(tc.event)
+			s.Require().NoError(err, "NewAnyWithValue")
+			trigger := s.app.TriggerKeeper.NewTriggerWithID(ctx, addr0, anyEvent, actions)
+			s.app.TriggerKeeper.RegisterTrigger(ctx, trigger)
+
+			s.app.TriggerKeeper.DetectBlockEvents(ctx)
+
+			_, err = s.app.TriggerKeeper.GetTrigger(ctx, trigger.Id)
+			if tc.detected {
+				s.Assert().Error(err, "GetTrigger should fail after the trigger is detected")
+			} else {
+				s.Assert().NoError(err, "GetTrigger should still find the undetected trigger")
+				s.app.TriggerKeeper.UnregisterTrigger(ctx, trigger)
+			}
+			for !s.app.TriggerKeeper.QueueIsEmpty(ctx) {
+				s.app.TriggerKeeper.Dequeue(ctx)
+			}
+		})
+	}
+}
@@ -17,6 +17,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&TransactionEvent{},
 		&BlockHeightEvent{},
 		&BlockTimeEvent{},
+		&MarkerReceivedEvent{},
+		&OrderFilledEvent{},
+		&AttributeAddedEvent{},
+		&ScopeValueOwnerChangedEvent{},
 	)
 
 	registry.RegisterInterface(
@@ -36,4 +40,28 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*TriggerEventI)(nil),
 		&BlockTimeEvent{},
 	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.MarkerReceivedEvent",
+		(*TriggerEventI)(nil),
+		&MarkerReceivedEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.OrderFilledEvent",
+		(*TriggerEventI)(nil),
+		&OrderFilledEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.AttributeAddedEvent",
+		(*TriggerEventI)(nil),
+		&AttributeAddedEvent{},
+	)
+
+	registry.RegisterInterface(
+		"provenance.trigger.v1.ScopeValueOwnerChangedEvent",
+		(*TriggerEventI)(nil),
+		&ScopeValueOwnerChangedEvent{},
+	)
 }
@@ -368,6 +368,226 @@ func (m *Attribute) GetValue() string {
 	return ""
 }
 
+// MarkerReceivedEvent is detected when an account receives some of a marker's denom.
+type MarkerReceivedEvent struct {
+	// The denom that must be received.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The bech32 address of the account that must receive the denom. If empty, any receiver matches.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *MarkerReceivedEvent) Reset()         { *m = MarkerReceivedEvent{} }
+func (m *MarkerReceivedEvent) String() string { return proto.CompactTextString(m) }
+func (*MarkerReceivedEvent) ProtoMessage()    {}
+func (*MarkerReceivedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{6}
+}
+func (m *MarkerReceivedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarkerReceivedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarkerReceivedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarkerReceivedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarkerReceivedEvent.Merge(m, src)
+}
+func (m *MarkerReceivedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarkerReceivedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarkerReceivedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarkerReceivedEvent proto.InternalMessageInfo
+
+func (m *MarkerReceivedEvent) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MarkerReceivedEvent) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// OrderFilledEvent is detected when an order is filled in full in an exchange market.
+type OrderFilledEvent struct {
+	// The id of the market that the order must be filled in.
+	MarketId uint32 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// The id of the order that must be filled. If zero, any order in the market matches.
+	OrderId uint64 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *OrderFilledEvent) Reset()         { *m = OrderFilledEvent{} }
+func (m *OrderFilledEvent) String() string { return proto.CompactTextString(m) }
+func (*OrderFilledEvent) ProtoMessage()    {}
+func (*OrderFilledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{7}
+}
+func (m *OrderFilledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFilledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFilledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderFilledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFilledEvent.Merge(m, src)
+}
+func (m *OrderFilledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFilledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFilledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFilledEvent proto.InternalMessageInfo
+
+func (m *OrderFilledEvent) GetMarketId() uint32 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *OrderFilledEvent) GetOrderId() uint64 {
+	if m != nil {
+		return m.OrderId
+	}
+	return 0
+}
+
+// AttributeAddedEvent is detected when an attribute is added to an account.
+type AttributeAddedEvent struct {
+	// The name of the attribute that must be added.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The bech32 address of the account that the attribute must be added to. If empty, any account matches.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (m *AttributeAddedEvent) Reset()         { *m = AttributeAddedEvent{} }
+func (m *AttributeAddedEvent) String() string { return proto.CompactTextString(m) }
+func (*AttributeAddedEvent) ProtoMessage()    {}
+func (*AttributeAddedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{8}
+}
+func (m *AttributeAddedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttributeAddedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttributeAddedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttributeAddedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttributeAddedEvent.Merge(m, src)
+}
+func (m *AttributeAddedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttributeAddedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttributeAddedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttributeAddedEvent proto.InternalMessageInfo
+
+func (m *AttributeAddedEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AttributeAddedEvent) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+// ScopeValueOwnerChangedEvent is detected when a scope's value owner changes.
+type ScopeValueOwnerChangedEvent struct {
+	// The bech32 address of the scope whose value owner must change.
+	ScopeAddr string `protobuf:"bytes,1,opt,name=scope_addr,json=scopeAddr,proto3" json:"scope_addr,omitempty"`
+	// The bech32 address of the scope's new value owner. If empty, any new value owner matches.
+	ValueOwner string `protobuf:"bytes,2,opt,name=value_owner,json=valueOwner,proto3" json:"value_owner,omitempty"`
+}
+
+func (m *ScopeValueOwnerChangedEvent) Reset()         { *m = ScopeValueOwnerChangedEvent{} }
+func (m *ScopeValueOwnerChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*ScopeValueOwnerChangedEvent) ProtoMessage()    {}
+func (*ScopeValueOwnerChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fe59296a7b42130c, []int{9}
+}
+func (m *ScopeValueOwnerChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScopeValueOwnerChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScopeValueOwnerChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScopeValueOwnerChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeValueOwnerChangedEvent.Merge(m, src)
+}
+func (m *ScopeValueOwnerChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScopeValueOwnerChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeValueOwnerChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScopeValueOwnerChangedEvent proto.InternalMessageInfo
+
+func (m *ScopeValueOwnerChangedEvent) GetScopeAddr() string {
+	if m != nil {
+		return m.ScopeAddr
+	}
+	return ""
+}
+
+func (m *ScopeValueOwnerChangedEvent) GetValueOwner() string {
+	if m != nil {
+		return m.ValueOwner
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Trigger)(nil), "provenance.trigger.v1.Trigger")
 	proto.RegisterType((*QueuedTrigger)(nil), "provenance.trigger.v1.QueuedTrigger")
@@ -375,6 +595,10 @@ func init() {
 	proto.RegisterType((*BlockTimeEvent)(nil), "provenance.trigger.v1.BlockTimeEvent")
 	proto.RegisterType((*TransactionEvent)(nil), "provenance.trigger.v1.TransactionEvent")
 	proto.RegisterType((*Attribute)(nil), "provenance.trigger.v1.Attribute")
+	proto.RegisterType((*MarkerReceivedEvent)(nil), "provenance.trigger.v1.MarkerReceivedEvent")
+	proto.RegisterType((*OrderFilledEvent)(nil), "provenance.trigger.v1.OrderFilledEvent")
+	proto.RegisterType((*AttributeAddedEvent)(nil), "provenance.trigger.v1.AttributeAddedEvent")
+	proto.RegisterType((*ScopeValueOwnerChangedEvent)(nil), "provenance.trigger.v1.ScopeValueOwnerChangedEvent")
 }
 
 func init() {
@@ -382,40 +606,49 @@ func init() {
 }
 
 var fileDescriptor_fe59296a7b42130c = []byte{
-	// 517 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xcd, 0xa6, 0x2e, 0x21, 0x13, 0x5a, 0x15, 0x2b, 0x95, 0xdc, 0x1c, 0x1c, 0x13, 0x2e, 0xb9,
-	0x64, 0xad, 0xb6, 0x17, 0x54, 0x04, 0x52, 0x2c, 0x81, 0x40, 0xe2, 0x00, 0x26, 0x27, 0x2e, 0x95,
-	0x3f, 0x16, 0x67, 0x45, 0xb2, 0x1b, 0xd9, 0x6b, 0x43, 0x7e, 0x00, 0xf7, 0xfe, 0x04, 0x7e, 0x03,
-	0xea, 0x99, 0x73, 0xc5, 0xa9, 0xe2, 0xc4, 0x09, 0x50, 0x72, 0xe1, 0x67, 0x20, 0xef, 0xae, 0x9b,
-	0x8a, 0x26, 0x12, 0xdc, 0x3c, 0xb3, 0xef, 0xcd, 0x7b, 0xf3, 0x46, 0x86, 0xfb, 0xb3, 0x94, 0x17,
-	0x84, 0x05, 0x2c, 0x22, 0xae, 0x48, 0x69, 0x92, 0x90, 0xd4, 0x2d, 0x0e, 0xab, 0x4f, 0x3c, 0x4b,
-	0xb9, 0xe0, 0xe6, 0xfe, 0x0a, 0x84, 0xab, 0x97, 0xe2, 0xb0, 0x73, 0x10, 0xf1, 0x6c, 0xca, 0xb3,
-	0x53, 0x09, 0x72, 0x55, 0xa1, 0x18, 0x9d, 0x76, 0xc2, 0x13, 0xae, 0xfa, 0xe5, 0x97, 0xee, 0x1e,
-	0x24, 0x9c, 0x27, 0x13, 0xe2, 0xca, 0x2a, 0xcc, 0xdf, 0xba, 0x01, 0x9b, 0xeb, 0xa7, 0xee, 0xdf,
-	0x4f, 0x82, 0x4e, 0x49, 0x26, 0x82, 0xe9, 0x4c, 0x01, 0x7a, 0x5f, 0x10, 0x34, 0x46, 0x4a, 0xdb,
-	0xdc, 0x85, 0x3a, 0x8d, 0x2d, 0xe4, 0xa0, 0xbe, 0xe1, 0xd7, 0x69, 0x6c, 0x62, 0xd8, 0xe6, 0xef,
-	0x19, 0x49, 0xad, 0xba, 0x83, 0xfa, 0x4d, 0xcf, 0xfa, 0x76, 0x3e, 0x68, 0x6b, 0x3b, 0xc3, 0x38,
-	0x4e, 0x49, 0x96, 0xbd, 0x16, 0x29, 0x65, 0x89, 0xaf, 0x60, 0xe6, 0x23, 0xd8, 0x26, 0x05, 0x61,
-	0xc2, 0xda, 0x72, 0x50, 0xbf, 0x75, 0xd4, 0xc6, 0x4a, 0x1c, 0x57, 0xe2, 0x78, 0xc8, 0xe6, 0xde,
-	0xdd, 0xaf, 0xe7, 0x83, 0x1d, 0xad, 0xf8, 0xa4, 0x44, 0x3f, 0xf7, 0x15, 0xcb, 0xc4, 0xd0, 0x08,
-	0x22, 0x41, 0x39, 0xcb, 0x2c, 0xc3, 0xd9, 0xda, 0x34, 0xc0, 0xaf, 0x40, 0x27, 0xc6, 0xef, 0x4f,
-	0x5d, 0xd4, 0xfb, 0x8c, 0x60, 0xe7, 0x55, 0x4e, 0x72, 0x12, 0x57, 0x6b, 0xdc, 0x83, 0x3b, 0xe1,
-	0x84, 0x47, 0xef, 0x4e, 0xc7, 0x84, 0x26, 0x63, 0xa1, 0x17, 0x6a, 0xc9, 0xde, 0x33, 0xd9, 0x32,
-	0x1f, 0x80, 0x51, 0x06, 0x21, 0x17, 0x6b, 0x1d, 0x75, 0x6e, 0xe8, 0x8c, 0xaa, 0x94, 0xbc, 0xdb,
-	0x17, 0x3f, 0xba, 0xb5, 0xb3, 0x9f, 0x5d, 0xe4, 0x4b, 0x86, 0xf9, 0x18, 0x1a, 0xfa, 0x54, 0x7a,
-	0x4b, 0x1b, 0xaf, 0xbd, 0x22, 0xd6, 0x6e, 0x3c, 0xa3, 0x1c, 0xe0, 0x57, 0x24, 0x6d, 0xfa, 0x05,
-	0xec, 0x79, 0x2b, 0x3b, 0x32, 0x86, 0x7f, 0xb0, 0x7d, 0xb2, 0x5f, 0x92, 0x6f, 0xe4, 0xd7, 0x0b,
-	0x60, 0x57, 0x4e, 0x2b, 0x5d, 0xab, 0x59, 0xd5, 0x7e, 0xe8, 0x7f, 0xf7, 0xdb, 0x24, 0xf1, 0x11,
-	0xc1, 0xde, 0x28, 0x0d, 0x58, 0xa6, 0xc2, 0x57, 0x2a, 0x26, 0x18, 0x2c, 0xd0, 0x2a, 0x4d, 0x5f,
-	0x7e, 0x9b, 0x4f, 0x01, 0x02, 0x21, 0x52, 0x1a, 0xe6, 0x82, 0x64, 0x56, 0x5d, 0xde, 0xd1, 0xd9,
-	0x10, 0xd1, 0xb0, 0x02, 0xea, 0x90, 0xae, 0x31, 0x37, 0xf9, 0x78, 0x08, 0xcd, 0x2b, 0xd6, 0x5a,
-	0xfd, 0x36, 0x6c, 0x17, 0xc1, 0x24, 0x57, 0xa7, 0x6d, 0xfa, 0xaa, 0x50, 0xa9, 0x7b, 0xf4, 0x62,
-	0x61, 0xa3, 0xcb, 0x85, 0x8d, 0x7e, 0x2d, 0x6c, 0x74, 0xb6, 0xb4, 0x6b, 0x97, 0x4b, 0xbb, 0xf6,
-	0x7d, 0x69, 0xd7, 0xc0, 0xa2, 0x7c, 0xbd, 0xc7, 0x97, 0xe8, 0xcd, 0x71, 0x42, 0xc5, 0x38, 0x0f,
-	0x71, 0xc4, 0xa7, 0xee, 0x0a, 0x33, 0xa0, 0xfc, 0x5a, 0xe5, 0x7e, 0xb8, 0xfa, 0xcb, 0xc5, 0x7c,
-	0x46, 0xb2, 0xf0, 0x96, 0x8c, 0xfa, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xf1, 0x6b,
-	0x40, 0x08, 0x04, 0x00, 0x00,
+	// 669 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xce, 0xa6, 0xc9, 0x2f, 0xc9, 0xe4, 0xd7, 0xaa, 0xb8, 0xa9, 0xe4, 0xa6, 0x22, 0x09, 0xe5,
+	0xd2, 0x4b, 0x6d, 0xb5, 0xbd, 0xa0, 0x22, 0x90, 0x12, 0x44, 0x45, 0x25, 0x50, 0xc1, 0xad, 0x38,
+	0xf4, 0x40, 0xe4, 0x78, 0x17, 0x67, 0xd5, 0xc4, 0x1b, 0xad, 0xd7, 0x86, 0x3e, 0x00, 0xf7, 0x3e,
+	0x02, 0xcf, 0x80, 0x7a, 0xe6, 0x5c, 0x71, 0xaa, 0x38, 0x71, 0x02, 0xd4, 0x5e, 0x78, 0x0c, 0xb4,
+	0x7f, 0x9c, 0x56, 0x34, 0x96, 0xe0, 0xb6, 0x33, 0xf3, 0xcd, 0x7c, 0x33, 0xdf, 0xec, 0x2e, 0xdc,
+	0x9f, 0x70, 0x96, 0x92, 0xc8, 0x8f, 0x02, 0xe2, 0x0a, 0x4e, 0xc3, 0x90, 0x70, 0x37, 0xdd, 0xcc,
+	0x8e, 0xce, 0x84, 0x33, 0xc1, 0xac, 0xe5, 0x6b, 0x90, 0x93, 0x45, 0xd2, 0xcd, 0xe6, 0x4a, 0xc0,
+	0xe2, 0x31, 0x8b, 0xfb, 0x0a, 0xe4, 0x6a, 0x43, 0x67, 0x34, 0x1b, 0x21, 0x0b, 0x99, 0xf6, 0xcb,
+	0x93, 0xf1, 0xae, 0x84, 0x8c, 0x85, 0x23, 0xe2, 0x2a, 0x6b, 0x90, 0xbc, 0x75, 0xfd, 0xe8, 0xc4,
+	0x84, 0xda, 0x7f, 0x86, 0x04, 0x1d, 0x93, 0x58, 0xf8, 0xe3, 0x89, 0x06, 0xac, 0x7d, 0x46, 0x50,
+	0x39, 0xd4, 0xdc, 0xd6, 0x02, 0x14, 0x29, 0xb6, 0x51, 0x07, 0xad, 0x97, 0xbc, 0x22, 0xc5, 0x96,
+	0x03, 0x65, 0xf6, 0x2e, 0x22, 0xdc, 0x2e, 0x76, 0xd0, 0x7a, 0xad, 0x67, 0x7f, 0x3d, 0xdb, 0x68,
+	0x98, 0x76, 0xba, 0x18, 0x73, 0x12, 0xc7, 0x07, 0x82, 0xd3, 0x28, 0xf4, 0x34, 0xcc, 0x7a, 0x04,
+	0x65, 0x92, 0x92, 0x48, 0xd8, 0x73, 0x1d, 0xb4, 0x5e, 0xdf, 0x6a, 0x38, 0x9a, 0xdc, 0xc9, 0xc8,
+	0x9d, 0x6e, 0x74, 0xd2, 0xbb, 0xf3, 0xe5, 0x6c, 0x63, 0xde, 0x30, 0x3e, 0x95, 0xe8, 0x3d, 0x4f,
+	0x67, 0x59, 0x0e, 0x54, 0xfc, 0x40, 0x50, 0x16, 0xc5, 0x76, 0xa9, 0x33, 0x97, 0x57, 0xc0, 0xcb,
+	0x40, 0x3b, 0xa5, 0x5f, 0x1f, 0xdb, 0x68, 0xed, 0x13, 0x82, 0xf9, 0x57, 0x09, 0x49, 0x08, 0xce,
+	0xc6, 0xb8, 0x07, 0xff, 0x0f, 0x46, 0x2c, 0x38, 0xee, 0x0f, 0x09, 0x0d, 0x87, 0xc2, 0x0c, 0x54,
+	0x57, 0xbe, 0x67, 0xca, 0x65, 0x3d, 0x80, 0x92, 0x14, 0x42, 0x0d, 0x56, 0xdf, 0x6a, 0xde, 0xe2,
+	0x39, 0xcc, 0x54, 0xea, 0x55, 0xcf, 0xbf, 0xb7, 0x0b, 0xa7, 0x3f, 0xda, 0xc8, 0x53, 0x19, 0xd6,
+	0x63, 0xa8, 0x98, 0x55, 0x99, 0x29, 0x5b, 0xce, 0xcc, 0x2d, 0x3a, 0xa6, 0x9b, 0x5e, 0x49, 0x16,
+	0xf0, 0xb2, 0x24, 0xd3, 0xf4, 0x73, 0x58, 0xec, 0x5d, 0xb7, 0xa3, 0x64, 0xf8, 0x8b, 0xb6, 0x77,
+	0x96, 0x65, 0xf2, 0x2d, 0xfd, 0xd6, 0x7c, 0x58, 0x50, 0xd5, 0x64, 0xd7, 0xba, 0x56, 0x36, 0x1f,
+	0xfa, 0xd7, 0xf9, 0xf2, 0x28, 0x3e, 0x20, 0x58, 0x3c, 0xe4, 0x7e, 0x14, 0x6b, 0xf1, 0x35, 0x8b,
+	0x05, 0xa5, 0xc8, 0x37, 0x2c, 0x35, 0x4f, 0x9d, 0xad, 0x5d, 0x00, 0x5f, 0x08, 0x4e, 0x07, 0x89,
+	0x20, 0xb1, 0x5d, 0x54, 0x7b, 0xec, 0xe4, 0x48, 0xd4, 0xcd, 0x80, 0x46, 0xa4, 0x1b, 0x99, 0x79,
+	0x7d, 0x3c, 0x84, 0xda, 0x34, 0x6b, 0x26, 0x7f, 0x03, 0xca, 0xa9, 0x3f, 0x4a, 0xf4, 0x6a, 0x6b,
+	0x9e, 0x36, 0x8c, 0xea, 0x6f, 0x60, 0xe9, 0x85, 0xcf, 0x8f, 0x09, 0xf7, 0x48, 0x40, 0x68, 0x4a,
+	0xb0, 0x1e, 0xa3, 0x01, 0x65, 0x4c, 0x22, 0x36, 0x36, 0x75, 0xb4, 0x61, 0x35, 0xa1, 0xca, 0x35,
+	0xcc, 0xdc, 0x7f, 0x6f, 0x6a, 0xe7, 0xef, 0x61, 0x71, 0x9f, 0x63, 0xc2, 0x77, 0xe9, 0x68, 0x94,
+	0x15, 0x5f, 0x85, 0xda, 0x58, 0x72, 0x8a, 0xbe, 0x79, 0x5a, 0xf3, 0x5e, 0x55, 0x3b, 0xf6, 0xb0,
+	0xb5, 0x02, 0x55, 0x26, 0x13, 0x64, 0xac, 0xa8, 0xd6, 0x5d, 0x51, 0xf6, 0x1e, 0xce, 0xa3, 0x38,
+	0x82, 0xa5, 0xe9, 0xfc, 0x5d, 0x8c, 0x33, 0x96, 0x59, 0x4a, 0xd8, 0xf2, 0x39, 0x05, 0x2c, 0x89,
+	0x84, 0xe9, 0x3f, 0x33, 0xf3, 0x6a, 0x0b, 0x58, 0x3d, 0x08, 0xd8, 0x84, 0xbc, 0x96, 0x92, 0xed,
+	0xcb, 0x17, 0xfd, 0x64, 0xe8, 0x47, 0x61, 0xc6, 0x71, 0x17, 0x20, 0x96, 0xe1, 0xbe, 0x8f, 0x31,
+	0x37, 0x4c, 0x35, 0xe5, 0x91, 0x9f, 0x81, 0xd5, 0x86, 0xba, 0xd2, 0xba, 0x7f, 0xe3, 0xcb, 0xf0,
+	0x20, 0x9d, 0xd6, 0xca, 0x61, 0xed, 0xd1, 0xf3, 0xcb, 0x16, 0xba, 0xb8, 0x6c, 0xa1, 0x9f, 0x97,
+	0x2d, 0x74, 0x7a, 0xd5, 0x2a, 0x5c, 0x5c, 0xb5, 0x0a, 0xdf, 0xae, 0x5a, 0x05, 0xb0, 0x29, 0x9b,
+	0x7d, 0x71, 0x5e, 0xa2, 0xa3, 0xed, 0x90, 0x8a, 0x61, 0x32, 0x70, 0x02, 0x36, 0x76, 0xaf, 0x31,
+	0x1b, 0x94, 0xdd, 0xb0, 0xdc, 0xf7, 0xd3, 0xaf, 0x57, 0x9c, 0x4c, 0x48, 0x3c, 0xf8, 0x4f, 0xdd,
+	0xff, 0xed, 0xdf, 0x03, 0x00, 0x01, 0x05, 0x32, 0x7b, 0x9d, 0x05, 0x00, 0x00,
 }
 
 func (this *Trigger) Equal(that interface{}) bool {
@@ -593,6 +826,114 @@ func (this *Attribute) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MarkerReceivedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MarkerReceivedEvent)
+	if !ok {
+		that2, ok := that.(MarkerReceivedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Receiver != that1.Receiver {
+		return false
+	}
+	return true
+}
+func (this *OrderFilledEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OrderFilledEvent)
+	if !ok {
+		that2, ok := that.(OrderFilledEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	if this.OrderId != that1.OrderId {
+		return false
+	}
+	return true
+}
+func (this *AttributeAddedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AttributeAddedEvent)
+	if !ok {
+		that2, ok := that.(AttributeAddedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.Account != that1.Account {
+		return false
+	}
+	return true
+}
+func (this *ScopeValueOwnerChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScopeValueOwnerChangedEvent)
+	if !ok {
+		that2, ok := that.(ScopeValueOwnerChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScopeAddr != that1.ScopeAddr {
+		return false
+	}
+	if this.ValueOwner != that1.ValueOwner {
+		return false
+	}
+	return true
+}
 func (m *Trigger) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -840,36 +1181,180 @@ func (m *Attribute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTrigger(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MarkerReceivedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Trigger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MarkerReceivedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarkerReceivedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTrigger(uint64(m.Id))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTrigger(uint64(l))
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Event != nil {
-		l = m.Event.Size()
-		n += 1 + l + sovTrigger(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderFilledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFilledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFilledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OrderId != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.OrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintTrigger(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttributeAddedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttributeAddedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttributeAddedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeValueOwnerChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeValueOwnerChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeValueOwnerChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValueOwner) > 0 {
+		i -= len(m.ValueOwner)
+		copy(dAtA[i:], m.ValueOwner)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.ValueOwner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScopeAddr) > 0 {
+		i -= len(m.ScopeAddr)
+		copy(dAtA[i:], m.ScopeAddr)
+		i = encodeVarintTrigger(dAtA, i, uint64(len(m.ScopeAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTrigger(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTrigger(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Trigger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTrigger(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if m.Event != nil {
+		l = m.Event.Size()
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
 			l = e.Size()
 			n += 1 + l + sovTrigger(uint64(l))
 		}
@@ -949,16 +1434,540 @@ func (m *Attribute) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTrigger(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MarkerReceivedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	return n
+}
+
+func (m *OrderFilledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovTrigger(uint64(m.MarketId))
+	}
+	if m.OrderId != 0 {
+		n += 1 + sovTrigger(uint64(m.OrderId))
+	}
+	return n
+}
+
+func (m *AttributeAddedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	return n
+}
+
+func (m *ScopeValueOwnerChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScopeAddr)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	l = len(m.ValueOwner)
+	if l > 0 {
+		n += 1 + l + sovTrigger(uint64(l))
+	}
+	return n
+}
+
+func sovTrigger(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTrigger(x uint64) (n int) {
+	return sovTrigger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Event == nil {
+				m.Event = &types.Any{}
+			}
+			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, &types.Any{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedTrigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockHeightEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockHeightEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTrigger
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTimeEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTimeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTrigger(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTrigger(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTrigger(x uint64) (n int) {
-	return sovTrigger(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -981,34 +1990,15 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: TransactionEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TransactionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrigger
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1036,47 +2026,11 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrigger
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Event == nil {
-				m.Event = &types.Any{}
-			}
-			if err := m.Event.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1103,8 +2057,8 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, &types.Any{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attributes = append(m.Attributes, Attribute{})
+			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1129,7 +2083,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
+func (m *Attribute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1152,36 +2106,17 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueuedTrigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueuedTrigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTrigger
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1191,30 +2126,29 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1224,24 +2158,23 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Trigger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1264,7 +2197,7 @@ func (m *QueuedTrigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
+func (m *MarkerReceivedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +2220,17 @@ func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockHeightEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarkerReceivedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockHeightEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarkerReceivedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1307,11 +2240,56 @@ func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTrigger
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrigger(dAtA[iNdEx:])
@@ -1333,7 +2311,7 @@ func (m *BlockHeightEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
+func (m *OrderFilledEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1356,17 +2334,17 @@ func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTimeEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrderFilledEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTimeEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrderFilledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			m.MarketId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1376,25 +2354,30 @@ func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MarketId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTrigger
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTrigger
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTrigger(dAtA[iNdEx:])
@@ -1416,7 +2399,7 @@ func (m *BlockTimeEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
+func (m *AttributeAddedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1439,10 +2422,10 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TransactionEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttributeAddedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TransactionEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttributeAddedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1479,9 +2462,9 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attributes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTrigger
@@ -1491,25 +2474,23 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTrigger
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTrigger
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attributes = append(m.Attributes, Attribute{})
-			if err := m.Attributes[len(m.Attributes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1532,7 +2513,7 @@ func (m *TransactionEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Attribute) Unmarshal(dAtA []byte) error {
+func (m *ScopeValueOwnerChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1555,15 +2536,15 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attribute: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScopeValueOwnerChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attribute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScopeValueOwnerChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScopeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1591,11 +2572,11 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ScopeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1623,7 +2604,7 @@ func (m *Attribute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ValueOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
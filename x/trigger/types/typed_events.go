@@ -0,0 +1,208 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	proto "github.com/cosmos/gogoproto/proto"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+// TransactionEventMatcherI is a TriggerEventI that is detected by matching the events emitted by transactions.
+// Its event prefix is the type of the events it can match.
+type TransactionEventMatcherI interface {
+	TriggerEventI
+	Matches(event abci.Event) bool
+}
+
+var _ TransactionEventMatcherI = &TransactionEvent{}
+var _ TransactionEventMatcherI = &MarkerReceivedEvent{}
+var _ TransactionEventMatcherI = &OrderFilledEvent{}
+var _ TransactionEventMatcherI = &AttributeAddedEvent{}
+var _ TransactionEventMatcherI = &ScopeValueOwnerChangedEvent{}
+
+// Matches checks if the event is the receipt of this event's denom by this event's receiver (if it has one).
+func (e MarkerReceivedEvent) Matches(event abci.Event) bool {
+	if event.GetType() != e.GetEventPrefix() {
+		return false
+	}
+	if len(e.Receiver) > 0 {
+		receiver, found := getAttributeValue(event, banktypes.AttributeKeyReceiver)
+		if !found || receiver != e.Receiver {
+			return false
+		}
+	}
+	amount, found := getAttributeValue(event, sdk.AttributeKeyAmount)
+	if !found {
+		return false
+	}
+	coins, err := sdk.ParseCoinsNormalized(amount)
+	return err == nil && coins.AmountOf(e.Denom).IsPositive()
+}
+
+// GetEventPrefix gets the prefix for a MarkerReceivedEvent.
+func (e MarkerReceivedEvent) GetEventPrefix() string {
+	return banktypes.EventTypeCoinReceived
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e MarkerReceivedEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e MarkerReceivedEvent) Validate() error {
+	if err := sdk.ValidateDenom(e.Denom); err != nil {
+		return fmt.Errorf("invalid denom: %w", err)
+	}
+	return validateOptionalAddress("receiver", e.Receiver)
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e MarkerReceivedEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// Matches checks if the event is the fill of an order in this event's market (and of this event's order, if it has one).
+func (e OrderFilledEvent) Matches(event abci.Event) bool {
+	filled, ok := parseTypedEvent[*exchange.EventOrderFilled](event, e.GetEventPrefix())
+	if !ok || filled.MarketId != e.MarketId {
+		return false
+	}
+	return e.OrderId == 0 || filled.OrderId == e.OrderId
+}
+
+// GetEventPrefix gets the prefix for an OrderFilledEvent.
+func (e OrderFilledEvent) GetEventPrefix() string {
+	return proto.MessageName(&exchange.EventOrderFilled{})
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e OrderFilledEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e OrderFilledEvent) Validate() error {
+	if e.MarketId == 0 {
+		return errors.New("market id cannot be zero")
+	}
+	return nil
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e OrderFilledEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// Matches checks if the event is the addition of this event's attribute to this event's account (if it has one).
+func (e AttributeAddedEvent) Matches(event abci.Event) bool {
+	added, ok := parseTypedEvent[*attrtypes.EventAttributeAdd](event, e.GetEventPrefix())
+	if !ok || added.Name != e.Name {
+		return false
+	}
+	return len(e.Account) == 0 || added.Account == e.Account
+}
+
+// GetEventPrefix gets the prefix for an AttributeAddedEvent.
+func (e AttributeAddedEvent) GetEventPrefix() string {
+	return proto.MessageName(&attrtypes.EventAttributeAdd{})
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e AttributeAddedEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e AttributeAddedEvent) Validate() error {
+	if strings.TrimSpace(e.Name) == "" {
+		return errors.New("empty attribute name")
+	}
+	return validateOptionalAddress("account", e.Account)
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e AttributeAddedEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// Matches checks if the event is a change of this event's scope's value owner (to this event's value owner, if it has one).
+func (e ScopeValueOwnerChangedEvent) Matches(event abci.Event) bool {
+	changed, ok := parseTypedEvent[*metadatatypes.EventScopeValueOwnerChanged](event, e.GetEventPrefix())
+	if !ok || changed.ScopeAddr != e.ScopeAddr {
+		return false
+	}
+	return len(e.ValueOwner) == 0 || changed.ValueOwner == e.ValueOwner
+}
+
+// GetEventPrefix gets the prefix for a ScopeValueOwnerChangedEvent.
+func (e ScopeValueOwnerChangedEvent) GetEventPrefix() string {
+	return proto.MessageName(&metadatatypes.EventScopeValueOwnerChanged{})
+}
+
+// GetEventOrder gets the order for which this event should be processed
+func (e ScopeValueOwnerChangedEvent) GetEventOrder() uint64 {
+	return 0
+}
+
+// Validate checks if the event data is valid.
+func (e ScopeValueOwnerChangedEvent) Validate() error {
+	scopeID, err := metadatatypes.MetadataAddressFromBech32(e.ScopeAddr)
+	if err != nil {
+		return fmt.Errorf("invalid scope address %q: %w", e.ScopeAddr, err)
+	}
+	if !scopeID.IsScopeAddress() {
+		return fmt.Errorf("invalid scope address %q: not a scope", e.ScopeAddr)
+	}
+	return validateOptionalAddress("value owner", e.ValueOwner)
+}
+
+// ValidateContext checks if this event is valid with the current context.
+func (e ScopeValueOwnerChangedEvent) ValidateContext(_ sdk.Context) error {
+	return nil
+}
+
+// getAttributeValue gets the value of the first attribute in the event with the provided key.
+func getAttributeValue(event abci.Event, key string) (string, bool) {
+	for _, attr := range event.Attributes {
+		if attr.GetKey() == key {
+			return attr.GetValue(), true
+		}
+	}
+	return "", false
+}
+
+// parseTypedEvent converts an event emitted as a typed event back into its proto message.
+// Returns false if the event doesn't have the expected type or can't be parsed.
+func parseTypedEvent[T proto.Message](event abci.Event, eventType string) (T, bool) {
+	var zero T
+	if event.GetType() != eventType {
+		return zero, false
+	}
+	msg, err := sdk.ParseTypedEvent(event)
+	if err != nil {
+		return zero, false
+	}
+	rv, ok := msg.(T)
+	return rv, ok
+}
+
+// validateOptionalAddress returns an error if the address is provided but is not a valid bech32 address.
+func validateOptionalAddress(field, address string) error {
+	if len(address) == 0 {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(address); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", field, address, err)
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	attrtypes "github.com/provenance-io/provenance/x/attribute/types"
+	"github.com/provenance-io/provenance/x/exchange"
+	metadatatypes "github.com/provenance-io/provenance/x/metadata/types"
+)
+
+func TestTypedTriggerEventsMatch(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________").String()
+	addr2 := sdk.AccAddress("addr2_______________").String()
+	scopeAddr := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	typedEvent := func(tev proto.Message) abci.Event {
+		event, err := sdk.TypedEventToEvent(tev)
+		require.NoError(t, err, "TypedEventToEvent(%T)", tev)
+		return abci.Event(event)
+	}
+	coinReceived := abci.Event{Type: "coin_received", Attributes: []abci.EventAttribute{
+		{Key: "receiver", Value: addr1},
+		{Key: "amount", Value: "3mycoin,5nhash"},
+	}}
+
+	tests := []struct {
+		name        string
+		event       TransactionEventMatcherI
+		other       abci.Event
+		shouldMatch bool
+	}{
+		{name: "marker received", event: &MarkerReceivedEvent{Denom: "mycoin"}, other: coinReceived, shouldMatch: true},
+		{name: "marker received by receiver", event: &MarkerReceivedEvent{Denom: "mycoin", Receiver: addr1}, other: coinReceived, shouldMatch: true},
+		{name: "marker received by other receiver", event: &MarkerReceivedEvent{Denom: "mycoin", Receiver: addr2}, other: coinReceived, shouldMatch: false},
+		{name: "other denom received", event: &MarkerReceivedEvent{Denom: "othercoin"}, other: coinReceived, shouldMatch: false},
+		{
+			name:        "marker received from a coin_spent event",
+			event:       &MarkerReceivedEvent{Denom: "mycoin"},
+			other:       abci.Event{Type: "coin_spent", Attributes: []abci.EventAttribute{{Key: "amount", Value: "3mycoin"}}},
+			shouldMatch: false,
+		},
+		{name: "order filled", event: &OrderFilledEvent{MarketId: 3}, other: typedEvent(&exchange.EventOrderFilled{MarketId: 3, OrderId: 1}), shouldMatch: true},
+		{name: "order filled in other market", event: &OrderFilledEvent{MarketId: 3}, other: typedEvent(&exchange.EventOrderFilled{MarketId: 4, OrderId: 1}), shouldMatch: false},
+		{name: "other order filled", event: &OrderFilledEvent{MarketId: 3, OrderId: 2}, other: typedEvent(&exchange.EventOrderFilled{MarketId: 3, OrderId: 1}), shouldMatch: false},
+		{name: "order partially filled", event: &OrderFilledEvent{MarketId: 3}, other: typedEvent(&exchange.EventOrderPartiallyFilled{MarketId: 3, OrderId: 1}), shouldMatch: false},
+		{name: "attribute added", event: &AttributeAddedEvent{Name: "kyc.pb"}, other: typedEvent(&attrtypes.EventAttributeAdd{Name: "kyc.pb", Account: addr1}), shouldMatch: true},
+		{name: "attribute added to account", event: &AttributeAddedEvent{Name: "kyc.pb", Account: addr1}, other: typedEvent(&attrtypes.EventAttributeAdd{Name: "kyc.pb", Account: addr1}), shouldMatch: true},
+		{name: "attribute added to other account", event: &AttributeAddedEvent{Name: "kyc.pb", Account: addr2}, other: typedEvent(&attrtypes.EventAttributeAdd{Name: "kyc.pb", Account: addr1}), shouldMatch: false},
+		{name: "attribute updated", event: &AttributeAddedEvent{Name: "kyc.pb"}, other: typedEvent(&attrtypes.EventAttributeUpdate{Name: "kyc.pb", Account: addr1}), shouldMatch: false},
+		{name: "scope value owner changed", event: &ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr}, other: typedEvent(&metadatatypes.EventScopeValueOwnerChanged{ScopeAddr: scopeAddr, ValueOwner: addr1}), shouldMatch: true},
+		{name: "scope value owner changed to owner", event: &ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: addr1}, other: typedEvent(&metadatatypes.EventScopeValueOwnerChanged{ScopeAddr: scopeAddr, ValueOwner: addr1}), shouldMatch: true},
+		{name: "scope value owner changed to other owner", event: &ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: addr2}, other: typedEvent(&metadatatypes.EventScopeValueOwnerChanged{ScopeAddr: scopeAddr, ValueOwner: addr1}), shouldMatch: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.shouldMatch, tc.event.Matches(tc.other), "Matches")
+		})
+	}
+}
+
+func TestTypedTriggerEventsValidate(t *testing.T) {
+	addr := sdk.AccAddress("addr________________").String()
+	scopeAddr := metadatatypes.ScopeMetadataAddress(uuid.New()).String()
+	sessionAddr := metadatatypes.SessionMetadataAddress(uuid.New(), uuid.New()).String()
+
+	tests := []struct {
+		name   string
+		event  TriggerEventI
+		expErr string
+	}{
+		{name: "marker received", event: &MarkerReceivedEvent{Denom: "mycoin", Receiver: addr}},
+		{name: "marker received without denom", event: &MarkerReceivedEvent{}, expErr: "invalid denom"},
+		{name: "marker received bad receiver", event: &MarkerReceivedEvent{Denom: "mycoin", Receiver: "bad"}, expErr: `invalid receiver "bad"`},
+		{name: "order filled", event: &OrderFilledEvent{MarketId: 1}},
+		{name: "order filled without market", event: &OrderFilledEvent{OrderId: 1}, expErr: "market id cannot be zero"},
+		{name: "attribute added", event: &AttributeAddedEvent{Name: "kyc.pb", Account: addr}},
+		{name: "attribute added without name", event: &AttributeAddedEvent{Name: " "}, expErr: "empty attribute name"},
+		{name: "attribute added bad account", event: &AttributeAddedEvent{Name: "kyc.pb", Account: "bad"}, expErr: `invalid account "bad"`},
+		{name: "scope value owner changed", event: &ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: addr}},
+		{name: "scope value owner changed without scope", event: &ScopeValueOwnerChangedEvent{}, expErr: "invalid scope address"},
+		{name: "scope value owner changed for session", event: &ScopeValueOwnerChangedEvent{ScopeAddr: sessionAddr}, expErr: "not a scope"},
+		{name: "scope value owner changed bad owner", event: &ScopeValueOwnerChangedEvent{ScopeAddr: scopeAddr, ValueOwner: "bad"}, expErr: `invalid value owner "bad"`},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.event.Validate()
+			if len(tc.expErr) > 0 {
+				assert.ErrorContains(t, err, tc.expErr, "Validate")
+			} else {
+				assert.NoError(t, err, "Validate")
+			}
+		})
+	}
+}
+
+func TestTypedTriggerEventsGetEventPrefix(t *testing.T) {
+	assert.Equal(t, "coin_received", MarkerReceivedEvent{}.GetEventPrefix(), "MarkerReceivedEvent")
+	assert.Equal(t, "provenance.exchange.v1.EventOrderFilled", OrderFilledEvent{}.GetEventPrefix(), "OrderFilledEvent")
+	assert.Equal(t, "provenance.attribute.v1.EventAttributeAdd", AttributeAddedEvent{}.GetEventPrefix(), "AttributeAddedEvent")
+	assert.Equal(t, "provenance.metadata.v1.EventScopeValueOwnerChanged", ScopeValueOwnerChangedEvent{}.GetEventPrefix(), "ScopeValueOwnerChangedEvent")
+}